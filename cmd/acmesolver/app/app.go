@@ -65,6 +65,8 @@ func NewACMESolverCommand(stopCh <-chan struct{}) *cobra.Command {
 	cmd.Flags().StringVar(&s.Domain, "domain", "", "the domain name to verify")
 	cmd.Flags().StringVar(&s.Token, "token", "", "the challenge token to verify against")
 	cmd.Flags().StringVar(&s.Key, "key", "", "the challenge key to respond with")
+	cmd.Flags().StringVar(&s.ChallengesDir, "challenges-dir", "", "a directory containing a file named after each challenge token holding the key to respond with. "+
+		"If set, the keys of all challenges in the directory are served and --domain, --token and --key are ignored")
 
 	return cmd
 }
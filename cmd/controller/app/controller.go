@@ -23,12 +23,17 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -48,17 +53,24 @@ import (
 	"github.com/jetstack/cert-manager/cmd/controller/app/options"
 	cmdutil "github.com/jetstack/cert-manager/cmd/util"
 	"github.com/jetstack/cert-manager/pkg/acme/accounts"
+	"github.com/jetstack/cert-manager/pkg/api/check"
 	clientset "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	intscheme "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/scheme"
 	informers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
 	"github.com/jetstack/cert-manager/pkg/controller"
-	"github.com/jetstack/cert-manager/pkg/controller/clusterissuers"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	"github.com/jetstack/cert-manager/pkg/controller/issuerkinds"
 	"github.com/jetstack/cert-manager/pkg/feature"
 	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/metrics"
+	"github.com/jetstack/cert-manager/pkg/secretstore"
+	"github.com/jetstack/cert-manager/pkg/tracing"
 	"github.com/jetstack/cert-manager/pkg/util"
+	"github.com/jetstack/cert-manager/pkg/util/cmapichecker"
 	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
+	"github.com/jetstack/cert-manager/pkg/util/kmssigner"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 const controllerAgentName = "cert-manager"
@@ -68,6 +80,10 @@ const controllerAgentName = "cert-manager"
 //and following discussion: https://github.com/kubernetes-sigs/controller-runtime/pull/88#issuecomment-408500629
 const resyncPeriod = 10 * time.Hour
 
+// apiCheckInterval is how often the cert-manager API is checked when
+// --enable-api-check is set.
+const apiCheckInterval = 10 * time.Second
+
 func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 	rootCtx := cmdutil.ContextWithStopCh(context.Background(), stopCh)
 	rootCtx, cancelContext := context.WithCancel(rootCtx)
@@ -81,6 +97,27 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 		return fmt.Errorf("error building controller context (options %v): %v", opts, err)
 	}
 
+	if len(opts.TracingOTLPEndpoint) > 0 {
+		shutdownTracing, err := tracing.Setup(rootCtx, tracing.Options{
+			Endpoint:      opts.TracingOTLPEndpoint,
+			Insecure:      opts.TracingOTLPInsecure,
+			SamplingRatio: opts.TracingSamplingRatio,
+			ServiceName:   "cert-manager-controller",
+		})
+		if err != nil {
+			return fmt.Errorf("error setting up tracing: %v", err)
+		}
+		defer func() {
+			// allow a timeout for the remaining spans to be exported
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				log.Error(err, "failed to export remaining spans")
+			}
+		}()
+		log.V(logf.InfoLevel).Info("exporting traces", "endpoint", opts.TracingOTLPEndpoint)
+	}
+
 	enabledControllers := opts.EnabledControllers()
 	log.Info(fmt.Sprintf("enabled controllers: %s", enabledControllers.List()))
 
@@ -88,7 +125,25 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 	if err != nil {
 		return fmt.Errorf("failed to listen on prometheus address %s: %v", opts.MetricsListenAddress, err)
 	}
-	server := ctx.Metrics.NewServer(ln, opts.EnablePprof)
+
+	var apiCheck *check.Probe
+	if opts.EnableAPICheck {
+		newChecker := cmapichecker.New
+		if !opts.ConversionWebhookEnabled {
+			newChecker = cmapichecker.NewV1Only
+		}
+		webhookChecker, err := newChecker(kubeCfg, runtime.NewScheme(), opts.ClusterResourceNamespace)
+		if err != nil {
+			return fmt.Errorf("error creating cert-manager API checker: %v", err)
+		}
+		apiCheck = check.NewProbe(&check.Checker{
+			Discovery: ctx.DiscoveryClient,
+			Webhook:   webhookChecker,
+		}, apiCheckInterval)
+		ctx.Metrics.SetReadinessProbe(apiCheck)
+	}
+
+	server := ctx.Metrics.NewServer(ln, opts.EnablePprof, opts.EnableLogLevelEndpoint)
 
 	g.Go(func() error {
 		<-rootCtx.Done()
@@ -109,6 +164,56 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 		return nil
 	})
 
+	if apiCheck != nil {
+		// Wait for the CRDs and webhook to be ready before starting the
+		// controllers, since they would otherwise fail to sync or create
+		// resources while a Helm upgrade is rolling out.
+		log.V(logf.InfoLevel).Info("waiting for the cert-manager API to be ready", "timeout", opts.APICheckTimeout)
+		waitCtx, cancel := context.WithTimeout(rootCtx, opts.APICheckTimeout)
+		err := apiCheck.WaitUntilReady(waitCtx)
+		cancel()
+		if err != nil {
+			if rootCtx.Err() != nil {
+				// We are shutting down, so return without an additional error
+				return g.Wait()
+			}
+			cancelContext()
+			err2 := g.Wait() // Don't process errors, we already have an error
+			if err2 != nil {
+				return utilerrors.NewAggregate([]error{err, err2})
+			}
+			return err
+		}
+		log.V(logf.InfoLevel).Info("the cert-manager API is ready")
+
+		g.Go(func() error {
+			apiCheck.Start(rootCtx)
+			return nil
+		})
+	}
+
+	// Leadership is held until all controllers have stopped, so that another
+	// instance does not start processing resources which are still being
+	// updated by this one, and is then released straight away rather than
+	// left to expire.
+	leaderElectionCtx, releaseLeadership := context.WithCancel(context.Background())
+	defer releaseLeadership()
+	controllersStopped := make(chan struct{})
+	var controllersStoppedOnce sync.Once
+	markControllersStopped := func() {
+		controllersStoppedOnce.Do(func() { close(controllersStopped) })
+	}
+	controllersStarted := false
+	g.Go(func() error {
+		<-rootCtx.Done()
+		<-controllersStopped
+		if controllersStarted {
+			recordShutdownEvent(ctx.Recorder, opts.DrainTimeout)
+		}
+		releaseLeadership()
+		return nil
+	})
+
 	elected := make(chan struct{})
 	if opts.LeaderElect {
 		g.Go(func() error {
@@ -119,7 +224,7 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 			}
 
 			errorCh := make(chan error, 1)
-			if err := startLeaderElection(rootCtx, opts, leaderElectionClient, ctx.Recorder, leaderelection.LeaderCallbacks{
+			if err := startLeaderElection(leaderElectionCtx, opts, leaderElectionClient, ctx.Recorder, leaderelection.LeaderCallbacks{
 				OnStartedLeading: func(_ context.Context) {
 					close(elected)
 				},
@@ -149,12 +254,14 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 
 	select {
 	case <-rootCtx.Done(): // Exit early if we are shutting down or if the errgroup has already exited with an error
+		markControllersStopped()
 		// Wait for error group to complete and return
 		return g.Wait()
 	case <-elected: // Don't launch the controllers unless we have been elected leader
 		// Continue with setting up controller
 	}
 
+	var controllers sync.WaitGroup
 	for n, fn := range controller.Known() {
 		log := log.WithValues("controller", n)
 
@@ -164,17 +271,26 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 			continue
 		}
 
-		// don't run clusterissuers controller if scoped to a single namespace
-		if ctx.Namespace != "" && n == clusterissuers.ControllerName {
+		// don't run the controllers of cluster scoped issuer kinds, such as
+		// clusterissuers, if scoped to a single namespace
+		if kind, ok := issuerkinds.Get(n); ok && kind.ClusterScoped && ctx.Namespace != "" {
 			log.V(logf.InfoLevel).Info("not starting controller as cert-manager has been scoped to a single namespace")
 			continue
 		}
 
-		iface, err := fn(ctx)
+		// Each controller records Events according to its own policy.
+		recorder := events.NewRecorder(ctx.Recorder, ctx.Clock, opts.EventPolicy(n))
+		go recorder.Run(rootCtx.Done())
+		controllerCtx := *ctx
+		controllerCtx.Recorder = recorder
+
+		iface, err := fn(&controllerCtx)
 		if err != nil {
 			err = fmt.Errorf("error starting controller: %v", err)
 
 			cancelContext()
+			controllers.Wait()
+			markControllersStopped()
 			err2 := g.Wait() // Don't process errors, we already have an error
 			if err2 != nil {
 				return utilerrors.NewAggregate([]error{err, err2})
@@ -182,7 +298,9 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 			return err
 		}
 
+		controllers.Add(1)
 		g.Go(func() error {
+			defer controllers.Done()
 			log.V(logf.InfoLevel).Info("starting controller")
 
 			// TODO: make this either a constant or a command line flag
@@ -190,6 +308,11 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 			return iface.Run(workers, rootCtx.Done())
 		})
 	}
+	controllersStarted = true
+	go func() {
+		controllers.Wait()
+		markControllersStopped()
+	}()
 
 	log.V(logf.DebugLevel).Info("starting shared informer factories")
 	ctx.SharedInformerFactory.Start(rootCtx.Done())
@@ -304,6 +427,22 @@ func buildControllerContext(ctx context.Context, opts *options.ControllerOptions
 
 	acmeAccountRegistry := accounts.NewDefaultRegistry()
 
+	externalKeyProviders, err := buildExternalKeyProviders(opts.ExternalKeyProviders)
+	if err != nil {
+		return nil, nil, err
+	}
+	kmsPlugins, err := buildKMSPlugins(opts.KMSPlugins, opts.AWSKMSPluginKeyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var secretStore secretstore.Store
+	if opts.SecretStore != "" {
+		secretStore, err = secretstore.Known()[opts.SecretStore].New()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error creating %q secret store: %w", opts.SecretStore, err)
+		}
+	}
+
 	return &controller.Context{
 		RootContext:               ctx,
 		StopCh:                    ctx.Done(),
@@ -320,20 +459,25 @@ func buildControllerContext(ctx context.Context, opts *options.ControllerOptions
 		Namespace:                 opts.Namespace,
 		Clock:                     clock.RealClock{},
 		Metrics:                   metrics.New(log, clock.RealClock{}),
+		DrainTimeout:              opts.DrainTimeout,
 		ACMEOptions: controller.ACMEOptions{
 			HTTP01SolverImage:                 opts.ACMEHTTP01SolverImage,
 			HTTP01SolverResourceRequestCPU:    HTTP01SolverResourceRequestCPU,
 			HTTP01SolverResourceRequestMemory: HTTP01SolverResourceRequestMemory,
 			HTTP01SolverResourceLimitsCPU:     HTTP01SolverResourceLimitsCPU,
 			HTTP01SolverResourceLimitsMemory:  HTTP01SolverResourceLimitsMemory,
+			HTTP01SelfCheckService:            opts.ACMEHTTP01SelfCheckService,
+			HTTP01SelfCheckURLTemplate:        opts.ACMEHTTP01SelfCheckURLTemplate,
 			DNS01CheckAuthoritative:           !opts.DNS01RecursiveNameserversOnly,
 			DNS01Nameservers:                  nameservers,
 			AccountRegistry:                   acmeAccountRegistry,
 			DNS01CheckRetryPeriod:             opts.DNS01CheckRetryPeriod,
+			OrderAuthorizationRetries:         opts.ACMEOrderAuthorizationRetries,
 		},
 		IssuerOptions: controller.IssuerOptions{
 			ClusterIssuerAmbientCredentials: opts.ClusterIssuerAmbientCredentials,
 			IssuerAmbientCredentials:        opts.IssuerAmbientCredentials,
+			IssuanceBackdate:                opts.IssuanceBackdate,
 			ClusterResourceNamespace:        opts.ClusterResourceNamespace,
 		},
 		IngressShimOptions: controller.IngressShimOptions{
@@ -343,8 +487,17 @@ func buildControllerContext(ctx context.Context, opts *options.ControllerOptions
 			DefaultAutoCertificateAnnotations: opts.DefaultAutoCertificateAnnotations,
 		},
 		CertificateOptions: controller.CertificateOptions{
-			EnableOwnerRef:           opts.EnableCertificateOwnerRef,
-			CopiedAnnotationPrefixes: opts.CopiedAnnotationPrefixes,
+			EnableOwnerRef:             opts.EnableCertificateOwnerRef,
+			CopiedAnnotationPrefixes:   opts.CopiedAnnotationPrefixes,
+			RenewalJitterPercentage:    opts.RenewalJitterPercentage,
+			RenewalJitterWindow:        opts.RenewalJitterWindow,
+			AdoptExistingSecrets:       opts.AdoptExistingSecrets,
+			AIAFetchAllowedHosts:       opts.AIAFetchAllowedHosts,
+			AIAFetchCacheTTL:           opts.AIAFetchCacheTTL,
+			PublicTrustBundleConfigMap: opts.PublicTrustBundleConfigMap,
+			ExternalKeyProviders:       externalKeyProviders,
+			KMSPlugins:                 kmsPlugins,
+			SecretStore:                secretStore,
 		},
 		SchedulerOptions: controller.SchedulerOptions{
 			MaxConcurrentChallenges: opts.MaxConcurrentChallenges,
@@ -352,6 +505,68 @@ func buildControllerContext(ctx context.Context, opts *options.ControllerOptions
 	}, kubeCfg, nil
 }
 
+// buildExternalKeyProviders returns the enabled external key providers, keyed
+// by the name that Certificates refer to them by.
+func buildExternalKeyProviders(names []string) (map[string]pki.ExternalKeyProvider, error) {
+	providers := make(map[string]pki.ExternalKeyProvider)
+	for _, name := range names {
+		switch name {
+		case kmssigner.AWSExternalKeyProviderName:
+			sess, err := newAWSSession()
+			if err != nil {
+				return nil, fmt.Errorf("error creating aws session for the %q external key provider: %w", name, err)
+			}
+			providers[name] = kmssigner.NewAWSExternalKeyProvider(kms.New(sess))
+		default:
+			return nil, fmt.Errorf("unknown external key provider %q", name)
+		}
+	}
+	return providers, nil
+}
+
+// buildKMSPlugins returns the enabled KMS plugins, keyed by name.
+func buildKMSPlugins(names []string, awsKeyID string) (map[string]pki.KMSPlugin, error) {
+	plugins := make(map[string]pki.KMSPlugin)
+	for _, name := range names {
+		switch name {
+		case kmssigner.AWSKMSPluginName:
+			sess, err := newAWSSession()
+			if err != nil {
+				return nil, fmt.Errorf("error creating aws session for the %q KMS plugin: %w", name, err)
+			}
+			plugins[name] = kmssigner.NewAWSKMSPlugin(kms.New(sess), awsKeyID)
+		default:
+			return nil, fmt.Errorf("unknown KMS plugin %q", name)
+		}
+	}
+	return plugins, nil
+}
+
+// newAWSSession returns an AWS session using the region and credentials of
+// the controller's environment.
+func newAWSSession() (*session.Session, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, err
+	}
+	sess.Handlers.Build.PushBack(request.WithAppendUserAgent(util.CertManagerUserAgent))
+	return sess, nil
+}
+
+// recordShutdownEvent records an Event against the Pod that the controller
+// is running in, if known from the POD_NAMESPACE environment variable,
+// noting that it has stopped processing work.
+func recordShutdownEvent(recorder record.EventRecorder, drainTimeout time.Duration) {
+	namespace := os.Getenv("POD_NAMESPACE")
+	name, err := os.Hostname()
+	if namespace == "" || err != nil {
+		return
+	}
+	pod := &corev1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: namespace, Name: name}
+	recorder.Eventf(pod, corev1.EventTypeNormal, events.ReasonShutdown,
+		"Stopped processing work: in-flight work has completed or been cancelled after the drain timeout of %s", drainTimeout)
+}
+
 func startLeaderElection(ctx context.Context, opts *options.ControllerOptions, leaderElectionClient kubernetes.Interface, recorder record.EventRecorder, callbacks leaderelection.LeaderCallbacks) error {
 	// Identity used to distinguish between multiple controller manager instances
 	id, err := os.Hostname()
@@ -19,11 +19,14 @@ package options
 import (
 	"fmt"
 	"net"
+	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
 
 	cmdutil "github.com/jetstack/cert-manager/cmd/util"
 	cm "github.com/jetstack/cert-manager/pkg/apis/certmanager"
@@ -34,27 +37,44 @@ import (
 	cracmecontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/acme"
 	crapprovercontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/approver"
 	crcacontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/ca"
+	crcmpcontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/cmp"
+	crscepcontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/scep"
 	crselfsignedcontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/selfsigned"
 	crvaultcontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/vault"
 	crvenaficontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/venafi"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/adoption"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/dualcertificate"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/issuing"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/keymanager"
 	certificatesmetricscontroller "github.com/jetstack/cert-manager/pkg/controller/certificates/metrics"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/ocspstaple"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/readiness"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/requestmanager"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/revisionmanager"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/revocation"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/serviceips"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/solverdryrun"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/trigger"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/truststore"
 	csracmecontroller "github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/acme"
 	csrcacontroller "github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/ca"
 	csrselfsignedcontroller "github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/selfsigned"
 	csrvaultcontroller "github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/vault"
 	csrvenaficontroller "github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/venafi"
+	clustercertificatescontroller "github.com/jetstack/cert-manager/pkg/controller/clustercertificates"
 	clusterissuerscontroller "github.com/jetstack/cert-manager/pkg/controller/clusterissuers"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	issuancequotascontroller "github.com/jetstack/cert-manager/pkg/controller/issuancequotas"
+	"github.com/jetstack/cert-manager/pkg/controller/issuerkinds"
 	issuerscontroller "github.com/jetstack/cert-manager/pkg/controller/issuers"
+	notificationscontroller "github.com/jetstack/cert-manager/pkg/controller/notifications"
 	"github.com/jetstack/cert-manager/pkg/feature"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/metrics"
+	"github.com/jetstack/cert-manager/pkg/secretstore"
 	"github.com/jetstack/cert-manager/pkg/util"
 	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
+	"github.com/jetstack/cert-manager/pkg/util/kmssigner"
 )
 
 type ControllerOptions struct {
@@ -80,9 +100,26 @@ type ControllerOptions struct {
 	ACMEHTTP01SolverResourceLimitsCPU     string
 	ACMEHTTP01SolverResourceLimitsMemory  string
 
+	// ACMEHTTP01SelfCheckService makes HTTP01 self checks connect to the
+	// solver Service from inside the cluster rather than to the public
+	// address of the domain being validated.
+	ACMEHTTP01SelfCheckService bool
+	// ACMEHTTP01SelfCheckURLTemplate is a Go template for the URL which HTTP01
+	// self checks are performed against instead of the public address of the
+	// domain being validated.
+	ACMEHTTP01SelfCheckURLTemplate string
+
+	// ACMEOrderAuthorizationRetries is the number of times an Order is
+	// resubmitted to the ACME server after one of its authorizations failed.
+	ACMEOrderAuthorizationRetries int
+
 	ClusterIssuerAmbientCredentials bool
 	IssuerAmbientCredentials        bool
 
+	// IssuanceBackdate is the default amount of time that the notBefore of
+	// certificates signed by cert-manager is backdated by.
+	IssuanceBackdate time.Duration
+
 	// Default issuer/certificates details consumed by ingress-shim
 	DefaultIssuerName                 string
 	DefaultIssuerKind                 string
@@ -106,12 +143,95 @@ type ControllerOptions struct {
 	// the HTTP listener.
 	EnablePprof bool
 
+	// LoggingFormat is the format that logs are written in, one of text or
+	// json.
+	LoggingFormat string
+	// ControllerLogLevels overrides the log verbosity of individual
+	// controllers, in the form <controller>=<level>,<controller>=<level>.
+	ControllerLogLevels string
+	// EnableLogLevelEndpoint controls whether the endpoint which allows log
+	// levels to be changed at runtime is registered with the metrics server.
+	EnableLogLevelEndpoint bool
+
+	// TracingOTLPEndpoint is the host:port of the OTLP gRPC collector that
+	// spans recording the issuance of Certificates are exported to. Tracing
+	// is disabled if empty.
+	TracingOTLPEndpoint string
+	// TracingOTLPInsecure disables TLS when connecting to the collector.
+	TracingOTLPInsecure bool
+	// TracingSamplingRatio is the fraction of issuances which are traced.
+	TracingSamplingRatio float64
+
+	// DrainTimeout is how long in-flight work is given to complete when
+	// shutting down, before it is cancelled.
+	DrainTimeout time.Duration
+
+	// DisableNormalEvents and WarningEventAggregationControllers are lists of
+	// controllers, in the same form as the list of enabled controllers, which
+	// do not record Normal Events and which aggregate repeated Warning Events
+	// over WarningEventAggregationPeriod.
+	DisableNormalEvents                []string
+	WarningEventAggregationPeriod      time.Duration
+	WarningEventAggregationControllers []string
+
+	// EnableAPICheck causes the controllers to only be started once the
+	// cert-manager CRDs are installed and the webhook is working, and serves
+	// the result of periodic checks as a readiness probe.
+	EnableAPICheck bool
+	// APICheckTimeout is how long to wait for the cert-manager API to become
+	// ready on startup before exiting.
+	APICheckTimeout time.Duration
+	// ConversionWebhookEnabled is false if the cert-manager CRDs are
+	// installed without the conversion webhook, in which case only the v1
+	// API is served and checked by the API check.
+	ConversionWebhookEnabled bool
+
 	DNS01CheckRetryPeriod time.Duration
 
 	// Annotations copied Certificate -> CertificateRequest,
 	// CertificateRequest -> Order. Slice of string literals that are
 	// treated as prefixes for annotation keys.
 	CopiedAnnotationPrefixes []string
+
+	// RenewalJitterPercentage and RenewalJitterWindow configure how far the
+	// renewal of a certificate may be brought forward by, to avoid
+	// certificates that were issued together all renewing at once.
+	RenewalJitterPercentage int
+	RenewalJitterWindow     time.Duration
+
+	// AdoptExistingSecrets configures whether a Certificate adopts an
+	// existing Secret containing a certificate that was not issued by
+	// cert-manager, rather than re-issuing it straight away.
+	AdoptExistingSecrets bool
+
+	// AIAFetchAllowedHosts and AIAFetchCacheTTL configure the hosts from
+	// which missing certificates of a Certificate's chain may be fetched, and
+	// how long fetched certificates are cached for.
+	AIAFetchAllowedHosts []string
+	AIAFetchCacheTTL     time.Duration
+
+	// PublicTrustBundleConfigMap is the ConfigMap, in the form
+	// <namespace>/<name>, containing the public CA certificates stored in the
+	// trust store of Certificates.
+	PublicTrustBundleConfigMap string
+
+	// ExternalKeyProviders are the names of the external key providers which
+	// are enabled to generate and hold the private keys of Certificates with
+	// spec.privateKey.external set.
+	ExternalKeyProviders []string
+
+	// KMSPlugins are the names of the KMS plugins which are enabled to seal
+	// the private keys of Certificates with the
+	// cert-manager.io/private-key-kms-plugin annotation.
+	KMSPlugins []string
+	// AWSKMSPluginKeyID is the ID, ARN or alias of the symmetric AWS KMS key
+	// that the 'aws-kms' KMS plugin encrypts data keys with.
+	AWSKMSPluginKeyID string
+
+	// SecretStore is the name of the external secret store backend which the
+	// data issued for Certificates is written to, in addition to their
+	// Secrets. If empty, data is only written to Kubernetes Secrets.
+	SecretStore string
 }
 
 const (
@@ -123,9 +243,16 @@ const (
 	defaultClusterResourceNamespace = "kube-system"
 	defaultNamespace                = ""
 
+	defaultAPICheckTimeout          = time.Minute * 5
+	defaultConversionWebhookEnabled = true
+
+	defaultDrainTimeout = time.Second * 20
+
 	defaultClusterIssuerAmbientCredentials = true
 	defaultIssuerAmbientCredentials        = false
 
+	defaultIssuanceBackdate = time.Duration(0)
+
 	defaultTLSACMEIssuerName         = ""
 	defaultTLSACMEIssuerKind         = "Issuer"
 	defaultTLSACMEIssuerGroup        = cm.GroupName
@@ -137,7 +264,11 @@ const (
 
 	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
 
+	defaultTracingSamplingRatio = 1.0
+
 	defaultDNS01CheckRetryPeriod = 10 * time.Second
+
+	defaultAIAFetchCacheTTL = 24 * time.Hour
 )
 
 var (
@@ -149,9 +280,20 @@ var (
 
 	defaultAutoCertificateAnnotations = []string{"kubernetes.io/tls-acme"}
 
+	allExternalKeyProviders = []string{
+		kmssigner.AWSExternalKeyProviderName,
+	}
+
+	allKMSPlugins = []string{
+		kmssigner.AWSKMSPluginName,
+	}
+
 	allControllers = []string{
 		issuerscontroller.ControllerName,
 		clusterissuerscontroller.ControllerName,
+		clustercertificatescontroller.ControllerName,
+		issuancequotascontroller.ControllerName,
+		notificationscontroller.ControllerName,
 		certificatesmetricscontroller.ControllerName,
 		shimingresscontroller.ControllerName,
 		shimgatewaycontroller.ControllerName,
@@ -160,6 +302,8 @@ var (
 		cracmecontroller.CRControllerName,
 		crapprovercontroller.ControllerName,
 		crcacontroller.CRControllerName,
+		crcmpcontroller.CRControllerName,
+		crscepcontroller.CRControllerName,
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
@@ -170,6 +314,13 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		adoption.ControllerName,
+		ocspstaple.ControllerName,
+		revocation.ControllerName,
+		serviceips.ControllerName,
+		solverdryrun.ControllerName,
+		truststore.ControllerName,
+		dualcertificate.ControllerName,
 	}
 
 	defaultEnabledControllers = []string{
@@ -182,6 +333,8 @@ var (
 		cracmecontroller.CRControllerName,
 		crapprovercontroller.ControllerName,
 		crcacontroller.CRControllerName,
+		crcmpcontroller.CRControllerName,
+		crscepcontroller.CRControllerName,
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
@@ -192,6 +345,9 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		adoption.ControllerName,
+		truststore.ControllerName,
+		dualcertificate.ControllerName,
 	}
 
 	experimentalCertificateSigningRequestControllers = []string{
@@ -226,6 +382,7 @@ func NewControllerOptions() *ControllerOptions {
 		controllers:                       defaultEnabledControllers,
 		ClusterIssuerAmbientCredentials:   defaultClusterIssuerAmbientCredentials,
 		IssuerAmbientCredentials:          defaultIssuerAmbientCredentials,
+		IssuanceBackdate:                  defaultIssuanceBackdate,
 		DefaultIssuerName:                 defaultTLSACMEIssuerName,
 		DefaultIssuerKind:                 defaultTLSACMEIssuerKind,
 		DefaultIssuerGroup:                defaultTLSACMEIssuerGroup,
@@ -234,6 +391,11 @@ func NewControllerOptions() *ControllerOptions {
 		DNS01RecursiveNameserversOnly:     defaultDNS01RecursiveNameserversOnly,
 		EnableCertificateOwnerRef:         defaultEnableCertificateOwnerRef,
 		MetricsListenAddress:              defaultPrometheusMetricsServerAddress,
+		APICheckTimeout:                   defaultAPICheckTimeout,
+		ConversionWebhookEnabled:          defaultConversionWebhookEnabled,
+		DrainTimeout:                      defaultDrainTimeout,
+		LoggingFormat:                     logf.TextFormat,
+		TracingSamplingRatio:              defaultTracingSamplingRatio,
 		DNS01CheckRetryPeriod:             defaultDNS01CheckRetryPeriod,
 		EnablePprof:                       false,
 	}
@@ -295,6 +457,21 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.ACMEHTTP01SolverResourceLimitsMemory, "acme-http01-solver-resource-limits-memory", defaultACMEHTTP01SolverResourceLimitsMemory, ""+
 		"Defines the resource limits Memory size when spawning new ACME HTTP01 challenge solver pods.")
 
+	fs.BoolVar(&s.ACMEHTTP01SelfCheckService, "acme-http01-self-check-service", false, ""+
+		"If true, ACME HTTP01 self checks are performed against the challenge solver Service from within the cluster, "+
+		"instead of against the public address of the domain being validated. "+
+		"Use this if the public address cannot be reached from within the cluster, e.g. without hairpin NAT.")
+
+	fs.StringVar(&s.ACMEHTTP01SelfCheckURLTemplate, "acme-http01-self-check-url-template", "", ""+
+		"A Go template for the URL that ACME HTTP01 self checks are performed against, instead of the public address of the domain being validated. "+
+		"The template may refer to {{.Domain}}, {{.Token}} and {{.Path}}, the path of the challenge. "+
+		"Requests are sent with the domain being validated as their Host header, "+
+		"e.g. http://ingress-nginx-controller.ingress-nginx.svc{{.Path}}.")
+
+	fs.IntVar(&s.ACMEOrderAuthorizationRetries, "acme-order-authorization-retries", 0, ""+
+		"The number of times an ACME order is resubmitted to the ACME server after one of its authorizations failed, before the Order is marked as failed. "+
+		"Authorizations which are already valid are reused by ACME servers which support it, so only the failed authorizations are attempted again.")
+
 	fs.BoolVar(&s.ClusterIssuerAmbientCredentials, "cluster-issuer-ambient-credentials", defaultClusterIssuerAmbientCredentials, ""+
 		"Whether a cluster-issuer may make use of ambient credentials for issuers. 'Ambient Credentials' are credentials drawn from the environment, metadata services, or local files which are not explicitly configured in the ClusterIssuer API object. "+
 		"When this flag is enabled, the following sources for credentials are also used: "+
@@ -303,6 +480,10 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"Whether an issuer may make use of ambient credentials. 'Ambient Credentials' are credentials drawn from the environment, metadata services, or local files which are not explicitly configured in the Issuer API object. "+
 		"When this flag is enabled, the following sources for credentials are also used: "+
 		"AWS - All sources the Go SDK defaults to, notably including any EC2 IAM roles available via instance metadata.")
+	fs.DurationVar(&s.IssuanceBackdate, "issuance-backdate", defaultIssuanceBackdate, ""+
+		"The default amount of time to backdate the notBefore of certificates signed by the CA and SelfSigned issuers by, "+
+		"to tolerate clock skew between the controller and clients validating the certificate. "+
+		"This can be overridden per CertificateRequest with spec.backdate.")
 	fs.StringSliceVar(&s.DefaultAutoCertificateAnnotations, "auto-certificate-annotations", defaultAutoCertificateAnnotations, ""+
 		"The annotation consumed by the ingress-shim controller to indicate a ingress is requesting a certificate")
 
@@ -337,6 +518,52 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"A prefix starting with a dash(-) specifies an annotation that shouldn't be copied. Example: '*,-kubectl.kuberenetes.io/'- all annotations"+
 		"will be copied apart from the ones where the key is prefixed with 'kubectl.kubernetes.io/'.")
 
+	fs.IntVar(&s.RenewalJitterPercentage, "renewal-jitter-percentage", 0, ""+
+		"The maximum amount that the renewal of a certificate may be brought forward by, as a percentage of the "+
+		"period between its renewal time and its expiry. A different amount is chosen for each certificate so that "+
+		"certificates issued at the same time are not all renewed at the same time. Must be between 0 and 100.")
+	fs.DurationVar(&s.RenewalJitterWindow, "renewal-jitter-window", 0, ""+
+		"The maximum amount of time that the renewal of a certificate may be brought forward by. A different amount "+
+		"is chosen for each certificate so that certificates issued at the same time are not all renewed at the same time. "+
+		"Cannot be used together with --renewal-jitter-percentage.")
+	fs.BoolVar(&s.AdoptExistingSecrets, "adopt-existing-secrets", false, ""+
+		"Whether a Certificate whose Secret already contains a certificate that was not issued by cert-manager, for "+
+		"example when migrating from manually managed certificates, adopts the existing certificate rather than "+
+		"re-issuing it straight away. The existing certificate is only adopted if it is valid for the Certificate's "+
+		"spec and is not due for renewal. Requires the certificates-adoption controller to be enabled.")
+
+	fs.StringSliceVar(&s.AIAFetchAllowedHosts, "aia-fetch-allowed-hosts", nil, ""+
+		"The hosts from which certificates missing from the chain of a Certificate with spec.caChain.completeChain "+
+		"set may be fetched, using the Authority Information Access extension of the certificates in the chain. "+
+		"A host may be prefixed with '*.' to allow all of its subdomains. If not set, no certificates are fetched.")
+	fs.DurationVar(&s.AIAFetchCacheTTL, "aia-fetch-cache-ttl", defaultAIAFetchCacheTTL, ""+
+		"The amount of time that certificates fetched using the Authority Information Access extension are cached for.")
+	fs.StringVar(&s.PublicTrustBundleConfigMap, "public-trust-bundle-configmap", "", ""+
+		"The ConfigMap, in the form <namespace>/<name>, whose '"+truststore.PublicTrustBundleKey+"' key contains the PEM encoded "+
+		"public CA certificates stored in the 'trust.pem' entry of the Secret of Certificates with spec.trustStore.create set. "+
+		"If not set, only the CA of the issued certificate is stored.")
+	fs.StringSliceVar(&s.ExternalKeyProviders, "external-key-providers", nil, fmt.Sprintf(""+
+		"The external key providers which are enabled to generate and hold the private keys of Certificates "+
+		"with spec.privateKey.external set, one of: %s. The '%s' provider creates a new AWS KMS key for each "+
+		"private key, using the AWS region and credentials of the controller's environment.",
+		strings.Join(allExternalKeyProviders, ", "), kmssigner.AWSExternalKeyProviderName))
+	fs.StringSliceVar(&s.KMSPlugins, "kms-plugins", nil, fmt.Sprintf(""+
+		"The KMS plugins which are enabled to seal the private keys of Certificates with the "+
+		"'cert-manager.io/private-key-kms-plugin' annotation when the SealedPrivateKeys feature is enabled, one of: %s. "+
+		"The '%s' plugin encrypts data keys with the AWS KMS key set by --aws-kms-plugin-key-id, using the AWS "+
+		"region and credentials of the controller's environment.",
+		strings.Join(allKMSPlugins, ", "), kmssigner.AWSKMSPluginName))
+	fs.StringVar(&s.AWSKMSPluginKeyID, "aws-kms-plugin-key-id", "", ""+
+		"The ID, ARN or alias of the symmetric AWS KMS key that the '"+kmssigner.AWSKMSPluginName+"' KMS plugin "+
+		"encrypts the data keys of sealed private keys with.")
+	fs.StringVar(&s.SecretStore, "secret-store", "", fmt.Sprintf(""+
+		"The external secret store which the private key, certificate and CA issued for Certificates are "+
+		"written to, in addition to their Secrets, one of: %s. If not set, data is only written to Kubernetes Secrets.",
+		strings.Join(knownSecretStores(), ", ")))
+	for _, name := range knownSecretStores() {
+		secretstore.Known()[name].AddFlags(fs)
+	}
+
 	fs.IntVar(&s.MaxConcurrentChallenges, "max-concurrent-challenges", defaultMaxConcurrentChallenges, ""+
 		"The maximum number of challenges that can be scheduled as 'processing' at once.")
 	fs.DurationVar(&s.DNS01CheckRetryPeriod, "dns01-check-retry-period", defaultDNS01CheckRetryPeriod, ""+
@@ -347,6 +574,50 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"The host and port that the metrics endpoint should listen on.")
 	fs.BoolVar(&s.EnablePprof, "enable-profiling", false, ""+
 		"Enable profiling for controller.")
+
+	fs.StringVar(&s.LoggingFormat, "logging-format", logf.TextFormat, fmt.Sprintf(""+
+		"The format that logs are written in, one of %s.", strings.Join(logf.Formats, ", ")))
+	fs.StringVar(&s.ControllerLogLevels, "controller-log-levels", "", ""+
+		"Comma separated list of log verbosity overrides for individual controllers, for example "+
+		"'certificates-issuing=4,orders=5'. Controllers which are not listed log at the verbosity set with -v.")
+	fs.BoolVar(&s.EnableLogLevelEndpoint, "enable-log-level-endpoint", false, ""+
+		"Serve "+logf.LevelPath+" on the metrics server, which allows the global and per-controller "+
+		"log verbosity to be read and changed at runtime.")
+
+	fs.DurationVar(&s.DrainTimeout, "drain-timeout", defaultDrainTimeout, ""+
+		"How long to wait for in-flight work to complete when shutting down before cancelling it. "+
+		"Leadership is released once all work has completed or been cancelled. Should be less than "+
+		"the termination grace period of the controller Pod.")
+
+	fs.StringSliceVar(&s.DisableNormalEvents, "disable-normal-events", nil, ""+
+		"A list of controllers which do not record Events of type Normal, in the same form as --controllers. "+
+		"'--disable-normal-events=*' disables Normal Events for all controllers, '--disable-normal-events=*,-foo' "+
+		"for all controllers except the controller named 'foo'.")
+	fs.DurationVar(&s.WarningEventAggregationPeriod, "warning-event-aggregation-period", 0, ""+
+		"The period over which repeated Warning Events are aggregated. A Warning Event is recorded the first time it "+
+		"occurs, and its repetitions for the same resource during the period are recorded as a single summary Event "+
+		"at the end of the period. Aggregation is disabled if zero.")
+	fs.StringSliceVar(&s.WarningEventAggregationControllers, "warning-event-aggregation-controllers", []string{"*"}, ""+
+		"A list of controllers whose Warning Events are aggregated when --warning-event-aggregation-period is set, "+
+		"in the same form as --controllers.")
+
+	fs.BoolVar(&s.EnableAPICheck, "enable-api-check", false, ""+
+		"Wait until the cert-manager CRDs are installed at the expected versions and the webhook is reachable "+
+		"and serving a certificate signed by its configured CA before starting the controllers. The result of "+
+		"periodic checks is served on "+metrics.ReadinessPath+" on the metrics server, for use as a readiness probe.")
+	fs.DurationVar(&s.APICheckTimeout, "api-check-timeout", defaultAPICheckTimeout, ""+
+		"How long to wait for the cert-manager API to become ready on startup when --enable-api-check is set.")
+	fs.BoolVar(&s.ConversionWebhookEnabled, "conversion-webhook-enabled", defaultConversionWebhookEnabled, ""+
+		"Whether the cert-manager CRDs are installed with the conversion webhook. If false, only the v1 API is "+
+		"served, and the API check enabled by --enable-api-check only checks the v1 API.")
+
+	fs.StringVar(&s.TracingOTLPEndpoint, "tracing-otlp-endpoint", "", ""+
+		"The host:port of an OTLP gRPC collector to export OpenTelemetry spans recording the issuance of "+
+		"Certificates to. Tracing is disabled if not set.")
+	fs.BoolVar(&s.TracingOTLPInsecure, "tracing-otlp-insecure", false, ""+
+		"Connect to the OTLP collector without TLS.")
+	fs.Float64Var(&s.TracingSamplingRatio, "tracing-sampling-ratio", defaultTracingSamplingRatio, ""+
+		"The fraction of Certificate issuances which are traced, between 0 and 1.")
 }
 
 func (o *ControllerOptions) Validate() error {
@@ -357,6 +628,14 @@ func (o *ControllerOptions) Validate() error {
 		return fmt.Errorf("invalid default issuer kind: %v", o.DefaultIssuerKind)
 	}
 
+	if o.DrainTimeout < 0 {
+		return fmt.Errorf("invalid value for drain-timeout: %v must not be negative", o.DrainTimeout)
+	}
+
+	if o.EnableAPICheck && o.APICheckTimeout <= 0 {
+		return fmt.Errorf("invalid value for api-check-timeout: %v must be higher than 0", o.APICheckTimeout)
+	}
+
 	if o.KubernetesAPIBurst <= 0 {
 		return fmt.Errorf("invalid value for kube-api-burst: %v must be higher than 0", o.KubernetesAPIBurst)
 	}
@@ -369,6 +648,65 @@ func (o *ControllerOptions) Validate() error {
 		return fmt.Errorf("invalid value for kube-api-burst: %v must be higher or equal to kube-api-qps: %v", o.KubernetesAPIQPS, o.KubernetesAPIQPS)
 	}
 
+	if o.RenewalJitterPercentage < 0 || o.RenewalJitterPercentage > 100 {
+		return fmt.Errorf("invalid value for renewal-jitter-percentage: %v must be between 0 and 100", o.RenewalJitterPercentage)
+	}
+
+	if o.RenewalJitterWindow < 0 {
+		return fmt.Errorf("invalid value for renewal-jitter-window: %v must not be negative", o.RenewalJitterWindow)
+	}
+
+	if o.RenewalJitterPercentage > 0 && o.RenewalJitterWindow > 0 {
+		return fmt.Errorf("only one of renewal-jitter-percentage and renewal-jitter-window may be set")
+	}
+
+	if o.PublicTrustBundleConfigMap != "" {
+		if namespace, name, err := cache.SplitMetaNamespaceKey(o.PublicTrustBundleConfigMap); err != nil || namespace == "" || name == "" {
+			return fmt.Errorf("invalid value for public-trust-bundle-configmap: %q must be in the form <namespace>/<name>", o.PublicTrustBundleConfigMap)
+		}
+	}
+
+	for _, name := range o.ExternalKeyProviders {
+		if !sets.NewString(allExternalKeyProviders...).Has(name) {
+			return fmt.Errorf("invalid value for external-key-providers: unknown external key provider %q", name)
+		}
+	}
+
+	for _, name := range o.KMSPlugins {
+		if !sets.NewString(allKMSPlugins...).Has(name) {
+			return fmt.Errorf("invalid value for kms-plugins: unknown KMS plugin %q", name)
+		}
+	}
+	if sets.NewString(o.KMSPlugins...).Has(kmssigner.AWSKMSPluginName) && o.AWSKMSPluginKeyID == "" {
+		return fmt.Errorf("aws-kms-plugin-key-id must be set if the %q KMS plugin is enabled", kmssigner.AWSKMSPluginName)
+	}
+
+	if _, ok := secretstore.Known()[o.SecretStore]; o.SecretStore != "" && !ok {
+		return fmt.Errorf("invalid value for secret-store: unknown secret store %q", o.SecretStore)
+	}
+
+	if o.AIAFetchCacheTTL < 0 {
+		return fmt.Errorf("invalid value for aia-fetch-cache-ttl: %v must not be negative", o.AIAFetchCacheTTL)
+	}
+
+	if o.IssuanceBackdate < 0 {
+		return fmt.Errorf("invalid value for issuance-backdate: %v must not be negative", o.IssuanceBackdate)
+	}
+
+	if o.ACMEHTTP01SelfCheckService && o.ACMEHTTP01SelfCheckURLTemplate != "" {
+		return fmt.Errorf("only one of acme-http01-self-check-service and acme-http01-self-check-url-template may be set")
+	}
+
+	if o.ACMEHTTP01SelfCheckURLTemplate != "" {
+		if _, err := template.New("").Parse(o.ACMEHTTP01SelfCheckURLTemplate); err != nil {
+			return fmt.Errorf("invalid value for acme-http01-self-check-url-template: %v", err)
+		}
+	}
+
+	if o.ACMEOrderAuthorizationRetries < 0 {
+		return fmt.Errorf("invalid value for acme-order-authorization-retries: %v must not be negative", o.ACMEOrderAuthorizationRetries)
+	}
+
 	for _, server := range o.DNS01RecursiveNameservers {
 		// ensure all servers have a port number
 		_, _, err := net.SplitHostPort(server)
@@ -377,9 +715,44 @@ func (o *ControllerOptions) Validate() error {
 		}
 	}
 
+	switch o.LoggingFormat {
+	case logf.TextFormat, logf.JSONFormat:
+	default:
+		return fmt.Errorf("invalid value for logging-format: %q must be one of %s", o.LoggingFormat, strings.Join(logf.Formats, ", "))
+	}
+
+	if o.TracingSamplingRatio < 0 || o.TracingSamplingRatio > 1 {
+		return fmt.Errorf("invalid value for tracing-sampling-ratio: %v must be between 0 and 1", o.TracingSamplingRatio)
+	}
+
+	if _, err := logf.ParseLevels(o.ControllerLogLevels); err != nil {
+		return fmt.Errorf("invalid value for controller-log-levels: %v", err)
+	}
+
+	if o.WarningEventAggregationPeriod < 0 {
+		return fmt.Errorf("invalid value for warning-event-aggregation-period: %v must not be negative", o.WarningEventAggregationPeriod)
+	}
+
+	if err := validateControllerList("controllers", o.controllers); err != nil {
+		return err
+	}
+	if err := validateControllerList("disable-normal-events", o.DisableNormalEvents); err != nil {
+		return err
+	}
+	if err := validateControllerList("warning-event-aggregation-controllers", o.WarningEventAggregationControllers); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateControllerList checks that every controller in a list of
+// controllers given in the same form as --controllers is known.
+func validateControllerList(flag string, controllers []string) error {
 	errs := []error{}
-	allControllersSet := sets.NewString(allControllers...)
-	for _, controller := range o.controllers {
+	// Issuer kinds compiled into the controller are known controllers too.
+	allControllersSet := sets.NewString(allControllers...).Insert(issuerkinds.ControllerNames()...)
+	for _, controller := range controllers {
 		if controller == "*" {
 			continue
 		}
@@ -391,12 +764,41 @@ func (o *ControllerOptions) Validate() error {
 	}
 
 	if len(errs) > 0 {
-		return fmt.Errorf("validation failed for '--controllers': %v", errs)
+		return fmt.Errorf("validation failed for '--%s': %v", flag, errs)
 	}
 
 	return nil
 }
 
+// EventPolicy returns the policy used to record the Events of the named
+// controller.
+func (o *ControllerOptions) EventPolicy(controller string) events.Policy {
+	policy := events.Policy{
+		DisableNormal: controllerListIncludes(o.DisableNormalEvents, controller),
+	}
+	if controllerListIncludes(o.WarningEventAggregationControllers, controller) {
+		policy.WarningAggregationPeriod = o.WarningEventAggregationPeriod
+	}
+	return policy
+}
+
+// controllerListIncludes returns true if a list of controllers given in the
+// same form as --controllers includes the named controller. Unlike
+// --controllers, '*' includes every controller rather than only those
+// enabled by default.
+func controllerListIncludes(controllers []string, controller string) bool {
+	included := false
+	for _, c := range controllers {
+		switch c {
+		case "-" + controller:
+			return false
+		case "*", controller:
+			included = true
+		}
+	}
+	return included
+}
+
 func (o *ControllerOptions) EnabledControllers() sets.String {
 	var disabled []string
 	enabled := sets.NewString()
@@ -405,6 +807,7 @@ func (o *ControllerOptions) EnabledControllers() sets.String {
 		switch {
 		case controller == "*":
 			enabled = enabled.Insert(defaultEnabledControllers...)
+			enabled = enabled.Insert(issuerkinds.ControllerNames()...)
 		case strings.HasPrefix(controller, "-"):
 			disabled = append(disabled, strings.TrimPrefix(controller, "-"))
 		default:
@@ -426,3 +829,14 @@ func (o *ControllerOptions) EnabledControllers() sets.String {
 
 	return enabled
 }
+
+// knownSecretStores returns the names of the registered secret store
+// backends, in order.
+func knownSecretStores() []string {
+	var names []string
+	for name := range secretstore.Known() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
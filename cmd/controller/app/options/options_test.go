@@ -18,8 +18,11 @@ package options
 
 import (
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 )
 
 func TestEnabledControllers(t *testing.T) {
@@ -63,3 +66,50 @@ func TestEnabledControllers(t *testing.T) {
 		})
 	}
 }
+
+func TestEventPolicy(t *testing.T) {
+	tests := map[string]struct {
+		disableNormalEvents    []string
+		aggregationControllers []string
+		controller             string
+		expPolicy              events.Policy
+	}{
+		"if no lists are set, all events are recorded": {
+			controller: "orders",
+			expPolicy:  events.Policy{},
+		},
+		"if all controllers are listed, normal events are disabled and warnings aggregated": {
+			disableNormalEvents:    []string{"*"},
+			aggregationControllers: []string{"*"},
+			controller:             "orders",
+			expPolicy:              events.Policy{DisableNormal: true, WarningAggregationPeriod: time.Minute},
+		},
+		"if the controller is listed, normal events are disabled": {
+			disableNormalEvents: []string{"challenges", "orders"},
+			controller:          "orders",
+			expPolicy:           events.Policy{DisableNormal: true},
+		},
+		"if the controller is excluded, all events are recorded": {
+			disableNormalEvents:    []string{"*", "-orders"},
+			aggregationControllers: []string{"-orders", "*"},
+			controller:             "orders",
+			expPolicy:              events.Policy{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			o := ControllerOptions{
+				DisableNormalEvents:                test.disableNormalEvents,
+				WarningEventAggregationPeriod:      time.Minute,
+				WarningEventAggregationControllers: test.aggregationControllers,
+			}
+
+			got := o.EventPolicy(test.controller)
+			if got != test.expPolicy {
+				t.Errorf("got unexpected policy, exp=%+v got=%+v",
+					test.expPolicy, got)
+			}
+		})
+	}
+}
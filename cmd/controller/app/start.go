@@ -33,10 +33,13 @@ import (
 	_ "github.com/jetstack/cert-manager/pkg/controller/issuers"
 	_ "github.com/jetstack/cert-manager/pkg/issuer/acme"
 	_ "github.com/jetstack/cert-manager/pkg/issuer/ca"
+	_ "github.com/jetstack/cert-manager/pkg/issuer/cmp"
+	_ "github.com/jetstack/cert-manager/pkg/issuer/scep"
 	_ "github.com/jetstack/cert-manager/pkg/issuer/selfsigned"
 	_ "github.com/jetstack/cert-manager/pkg/issuer/vault"
 	_ "github.com/jetstack/cert-manager/pkg/issuer/venafi"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	_ "github.com/jetstack/cert-manager/pkg/secretstore/vaultkv"
 	"github.com/jetstack/cert-manager/pkg/util"
 	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
 )
@@ -71,6 +74,9 @@ to renew certificates at an appropriate time before expiry.`,
 			if err := o.Validate(args); err != nil {
 				return fmt.Errorf("error validating options: %s", err)
 			}
+			if err := configureLogging(o.ControllerOptions); err != nil {
+				return fmt.Errorf("error configuring logging: %s", err)
+			}
 
 			logf.Log.V(logf.InfoLevel).Info("starting controller", "version", util.AppVersion, "git-commit", util.AppGitCommit)
 			if err := o.RunCertManagerController(stopCh); err != nil {
@@ -96,6 +102,21 @@ func (o CertManagerControllerOptions) Validate(args []string) error {
 	return utilerrors.NewAggregate(errors)
 }
 
+// configureLogging sets the log format and the per-controller log levels.
+func configureLogging(opts *options.ControllerOptions) error {
+	if err := logf.SetFormat(opts.LoggingFormat); err != nil {
+		return err
+	}
+	levels, err := logf.ParseLevels(opts.ControllerLogLevels)
+	if err != nil {
+		return err
+	}
+	for controller, level := range levels {
+		logf.SetLevel(controller, level)
+	}
+	return nil
+}
+
 func (o CertManagerControllerOptions) RunCertManagerController(stopCh <-chan struct{}) error {
 	return Run(o.ControllerOptions, stopCh)
 }
@@ -19,6 +19,8 @@ package main
 import (
 	"flag"
 
+	ctrl "sigs.k8s.io/controller-runtime"
+
 	"github.com/jetstack/cert-manager/cmd/controller/app"
 	"github.com/jetstack/cert-manager/cmd/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
@@ -30,6 +32,8 @@ func main() {
 
 	logf.InitLogs(flag.CommandLine)
 	defer logf.FlushLogs()
+	// The issuer kind controllers are built on controller-runtime.
+	ctrl.SetLogger(logf.Log)
 
 	cmd := app.NewCommandStartCertManagerController(stopCh)
 	cmd.Flags().AddGoFlagSet(flag.CommandLine)
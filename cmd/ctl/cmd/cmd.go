@@ -36,6 +36,7 @@ import (
 	"github.com/jetstack/cert-manager/cmd/ctl/pkg/inspect"
 	"github.com/jetstack/cert-manager/cmd/ctl/pkg/renew"
 	"github.com/jetstack/cert-manager/cmd/ctl/pkg/status"
+	"github.com/jetstack/cert-manager/cmd/ctl/pkg/upgrade"
 	"github.com/jetstack/cert-manager/cmd/ctl/pkg/version"
 )
 
@@ -67,6 +68,7 @@ kubectl cert-manager is a CLI tool manage and configure cert-manager resources f
 	cmds.AddCommand(approve.NewCmdApprove(ctx, ioStreams))
 	cmds.AddCommand(deny.NewCmdDeny(ctx, ioStreams))
 	cmds.AddCommand(check.NewCmdCheck(ctx, ioStreams))
+	cmds.AddCommand(upgrade.NewCmdUpgrade(ctx, ioStreams))
 
 	// Experimental features
 	cmds.AddCommand(experimental.NewCmdExperimental(ctx, ioStreams))
@@ -51,6 +51,11 @@ type Options struct {
 	// Print details regarding encountered errors
 	Verbose bool
 
+	// ConversionWebhookEnabled is false if the cert-manager CRDs are
+	// installed without the conversion webhook, in which case only the v1
+	// API is checked
+	ConversionWebhookEnabled bool
+
 	genericclioptions.IOStreams
 	*factory.Factory
 }
@@ -60,7 +65,8 @@ This check attempts to perform a dry-run create of a cert-manager *v1alpha2*
 Certificate resource in order to verify that CRDs are installed and all the
 required webhooks are reachable by the K8S API server.
 We use v1alpha2 API to ensure that the API server has also connected to the
-cert-manager conversion webhook.`))
+cert-manager conversion webhook, unless --conversion-webhook-enabled=false is
+set because cert-manager has been installed without the conversion webhook.`))
 
 // NewOptions returns initialized Options
 func NewOptions(ioStreams genericclioptions.IOStreams) *Options {
@@ -76,7 +82,11 @@ func (o *Options) Complete() error {
 	// We pass the scheme that is used in the RESTConfig's NegotiatedSerializer,
 	// this makes sure that the cmapi is also added to NegotiatedSerializer's scheme
 	// see: https://github.com/jetstack/cert-manager/pull/4205#discussion_r668660271
-	o.APIChecker, err = cmapichecker.New(o.RESTConfig, scheme.Scheme, o.Namespace)
+	newChecker := cmapichecker.New
+	if !o.ConversionWebhookEnabled {
+		newChecker = cmapichecker.NewV1Only
+	}
+	o.APIChecker, err = newChecker(o.RESTConfig, scheme.Scheme, o.Namespace)
 	if err != nil {
 		return fmt.Errorf("Error: %v", err)
 	}
@@ -105,6 +115,7 @@ func NewCmdCheckApi(ctx context.Context, ioStreams genericclioptions.IOStreams)
 	cmd.Flags().DurationVar(&o.Wait, "wait", 0, "Wait until the cert-manager API is ready (default 0s)")
 	cmd.Flags().DurationVar(&o.Interval, "interval", 5*time.Second, "Time between checks when waiting, must include unit, e.g. 1m or 10m")
 	cmd.Flags().BoolVarP(&o.Verbose, "verbose", "v", false, "Print detailed error messages")
+	cmd.Flags().BoolVar(&o.ConversionWebhookEnabled, "conversion-webhook-enabled", true, "Whether cert-manager is installed with the conversion webhook. If false, only the v1 API is checked")
 
 	o.Factory = factory.New(cmd)
 
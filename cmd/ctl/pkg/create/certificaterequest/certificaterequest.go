@@ -291,7 +291,7 @@ func buildCertificateRequest(crt *cmapi.Certificate, pk []byte, crName string) (
 			Duration:  crt.Spec.Duration,
 			IssuerRef: crt.Spec.IssuerRef,
 			IsCA:      crt.Spec.IsCA,
-			Usages:    crt.Spec.Usages,
+			Usages:    apiutil.CertificateSpecUsages(&crt.Spec),
 		},
 	}
 
@@ -347,7 +347,7 @@ func buildCertificateSigningRequest(crt *cmapi.Certificate, pk []byte, crName, s
 		return nil, err
 	}
 
-	ku, eku, err := pki.BuildKeyUsages(crt.Spec.Usages, crt.Spec.IsCA)
+	ku, eku, err := pki.BuildKeyUsages(apiutil.CertificateSpecUsages(&crt.Spec), crt.Spec.IsCA)
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +38,7 @@ import (
 
 	"github.com/jetstack/cert-manager/cmd/ctl/pkg/factory"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/util"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -184,7 +185,7 @@ func describeValidFor(cert *x509.Certificate) string {
 		EmailAddresses string
 		KeyUsage       string
 	}{
-		DNSNames:       printSlice(cert.DNSNames),
+		DNSNames:       printSlice(dnsNamesForDisplay(cert.DNSNames)),
 		URIs:           printSlice(pki.URLsToString(cert.URIs)),
 		IPAddresses:    printSlice(pki.IPAddressesToString(cert.IPAddresses)),
 		EmailAddresses: printSlice(cert.EmailAddresses),
@@ -194,6 +195,19 @@ func describeValidFor(cert *x509.Certificate) string {
 	return b.String()
 }
 
+// dnsNamesForDisplay appends the unicode form of any internationalized domain
+// names, which are encoded in certificates in their A-label form.
+func dnsNamesForDisplay(dnsNames []string) []string {
+	var display []string
+	for _, name := range dnsNames {
+		if unicode := util.DNSNameToUnicode(name); unicode != name {
+			name = fmt.Sprintf("%s (%s)", name, unicode)
+		}
+		display = append(display, name)
+	}
+	return display
+}
+
 func describeValidityPeriod(cert *x509.Certificate) string {
 	var b bytes.Buffer
 	template.Must(template.New("validityPeriodTemplate").Parse(validityPeriodTemplate)).Execute(&b, struct {
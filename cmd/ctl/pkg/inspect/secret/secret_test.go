@@ -278,6 +278,23 @@ func Test_describeValidFor(t *testing.T) {
 		- server auth
 		- client auth`,
 		},
+		{
+			name: "Describe certificate with internationalized domain names",
+			cert: &x509.Certificate{
+				DNSNames: []string{"xn--bcher-kva.example", "*.xn--bcher-kva.example", "example.com"},
+				KeyUsage: x509.KeyUsageDigitalSignature,
+			},
+			want: `Valid for:
+	DNS Names: 
+		- xn--bcher-kva.example (bücher.example)
+		- *.xn--bcher-kva.example (*.bücher.example)
+		- example.com
+	URIs: <none>
+	IP Addresses: <none>
+	Email Addresses: <none>
+	Usages: 
+		- digital signature`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrateapiversion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/jetstack/cert-manager/cmd/ctl/pkg/factory"
+)
+
+var long = templates.LongDesc(i18n.T(`
+Ensures that all resources of the cert-manager CRDs are stored at the storage
+version of their CRD, which is v1, and removes the older API versions from the
+stored versions in the status of the CRDs.
+
+This must be run before upgrading to CRDs which no longer serve the older API
+versions, for example when installing cert-manager without the conversion
+webhook. It is safe to run this command more than once.`))
+
+var example = templates.Examples(i18n.T(`
+# Migrate all cert-manager resources to the storage version of their CRD
+kubectl cert-manager upgrade migrate-api-version`))
+
+// Options is a struct to support the migrate-api-version command
+type Options struct {
+	// SkipStoredVersionCheck migrates the resources of every CRD, even if
+	// the CRD's status only lists the storage version as stored
+	SkipStoredVersionCheck bool
+
+	genericclioptions.IOStreams
+	*factory.Factory
+}
+
+// NewOptions returns initialized Options
+func NewOptions(ioStreams genericclioptions.IOStreams) *Options {
+	return &Options{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdMigrate returns a cobra command for migrating the stored versions
+// of the cert-manager resources
+func NewCmdMigrate(ctx context.Context, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:     "migrate-api-version",
+		Short:   "Migrate all cert-manager resources to the storage version of their CRD",
+		Long:    long,
+		Example: example,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.Run(ctx)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().BoolVar(&o.SkipStoredVersionCheck, "skip-stored-version-check", false, ""+
+		"Migrate the resources of every CRD, even if its status shows that all resources are stored at the storage version")
+
+	o.Factory = factory.New(cmd)
+
+	return cmd
+}
+
+// Run executes the migrate-api-version command
+func (o *Options) Run(ctx context.Context) error {
+	apiextClient, err := apiextensionsclient.NewForConfig(o.RESTConfig)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := dynamic.NewForConfig(o.RESTConfig)
+	if err != nil {
+		return err
+	}
+
+	migrator := &Migrator{
+		APIExtensionsClient:    apiextClient,
+		DynamicClient:          dynamicClient,
+		SkipStoredVersionCheck: o.SkipStoredVersionCheck,
+		Out:                    o.Out,
+	}
+	migrated, err := migrator.Run(ctx)
+	if err != nil {
+		return err
+	}
+	if migrated {
+		fmt.Fprintln(o.Out, "Migrated all cert-manager resources to the storage version of their CRD")
+	} else {
+		fmt.Fprintln(o.Out, "All cert-manager resources are already stored at the storage version of their CRD")
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrateapiversion
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/jetstack/cert-manager/pkg/apis/acme"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+)
+
+// listPageSize is the number of resources listed at a time while migrating.
+const listPageSize = 500
+
+// Migrator migrates the resources of the cert-manager CRDs which may be
+// stored at an older API version to the storage version of their CRD, and
+// then removes the older API versions from the stored versions in the CRD's
+// status. Once no older API version is stored, the older API versions can be
+// removed from the CRDs, and cert-manager can be run without the conversion
+// webhook.
+type Migrator struct {
+	APIExtensionsClient apiextensionsclient.Interface
+	DynamicClient       dynamic.Interface
+
+	// SkipStoredVersionCheck causes the resources of every CRD to be
+	// migrated, even if the CRD's status only lists the storage version as
+	// stored.
+	SkipStoredVersionCheck bool
+
+	// Out is where progress is reported.
+	Out io.Writer
+}
+
+// Run migrates the resources of all of the cert-manager CRDs which store
+// resources at an older API version. It returns true if any resources were
+// migrated.
+func (m *Migrator) Run(ctx context.Context) (bool, error) {
+	crdList, err := m.APIExtensionsClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to list CustomResourceDefinitions: %w", err)
+	}
+	var crds []apiextensionsv1.CustomResourceDefinition
+	for _, crd := range crdList.Items {
+		if crd.Spec.Group == certmanager.GroupName || crd.Spec.Group == acme.GroupName {
+			crds = append(crds, crd)
+		}
+	}
+	sort.Slice(crds, func(i, j int) bool { return crds[i].Name < crds[j].Name })
+
+	migrated := false
+	for i := range crds {
+		crd := &crds[i]
+		storageVersion := storageVersion(crd)
+		if storageVersion == "" {
+			return migrated, fmt.Errorf("CustomResourceDefinition %s does not have a storage version", crd.Name)
+		}
+		if !m.SkipStoredVersionCheck && len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == storageVersion {
+			fmt.Fprintf(m.Out, "Nothing to do for %s: all resources are stored at %s\n", crd.Name, storageVersion)
+			continue
+		}
+
+		fmt.Fprintf(m.Out, "Migrating %s to %s (stored versions: %v)\n", crd.Name, storageVersion, crd.Status.StoredVersions)
+		if err := m.migrateResources(ctx, crd, storageVersion); err != nil {
+			return migrated, err
+		}
+		if err := m.setStoredVersion(ctx, crd.Name, storageVersion); err != nil {
+			return migrated, err
+		}
+		migrated = true
+	}
+
+	return migrated, nil
+}
+
+// migrateResources re-writes every resource of the CRD, which causes the
+// Kubernetes API server to store it at the CRD's storage version.
+func (m *Migrator) migrateResources(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition, version string) error {
+	gvr := schema.GroupVersionResource{Group: crd.Spec.Group, Version: version, Resource: crd.Spec.Names.Plural}
+	client := m.DynamicClient.Resource(gvr)
+
+	count := 0
+	opts := metav1.ListOptions{Limit: listPageSize}
+	for {
+		list, err := client.List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", gvr.GroupResource(), err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			_, err := client.Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{})
+			// A resource which has been deleted or modified since it was
+			// listed does not need to be migrated, as it has either been
+			// removed or written at the storage version.
+			if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to migrate %s %s/%s: %w", gvr.GroupResource(), obj.GetNamespace(), obj.GetName(), err)
+			}
+			count++
+		}
+		if list.GetContinue() == "" {
+			break
+		}
+		opts.Continue = list.GetContinue()
+	}
+
+	fmt.Fprintf(m.Out, "Migrated %d %s\n", count, gvr.GroupResource())
+	return nil
+}
+
+// setStoredVersion sets the stored versions in the status of the named CRD
+// to only the given version.
+func (m *Migrator) setStoredVersion(ctx context.Context, name, version string) error {
+	crds := m.APIExtensionsClient.ApiextensionsV1().CustomResourceDefinitions()
+	crd, err := crds.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get CustomResourceDefinition %s: %w", name, err)
+	}
+	crd.Status.StoredVersions = []string{version}
+	if _, err := crds.UpdateStatus(ctx, crd, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update the stored versions of CustomResourceDefinition %s: %w", name, err)
+	}
+	return nil
+}
+
+func storageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrateapiversion
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestMigrator(t *testing.T) {
+	crd := func(group, plural string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: plural + "." + group},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: group,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: plural},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1alpha2", Served: true},
+					{Name: "v1", Served: true, Storage: true},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+		}
+	}
+	resource := func(group, kind, namespace, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(group + "/v1")
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+
+	tests := map[string]struct {
+		skipStoredVersionCheck bool
+
+		expectedMigrated      bool
+		expectedUpdates       []string
+		expectedStoredVersion map[string][]string
+	}{
+		"migrate the resources of CRDs which store older versions": {
+			expectedMigrated: true,
+			expectedUpdates:  []string{"certificates/ns/a", "certificates/ns/b"},
+			expectedStoredVersion: map[string][]string{
+				"certificates.cert-manager.io": {"v1"},
+				"orders.acme.cert-manager.io":  {"v1"},
+				"widgets.example.com":          {"v1alpha2", "v1"},
+			},
+		},
+		"migrate the resources of all cert-manager CRDs if the stored version check is skipped": {
+			skipStoredVersionCheck: true,
+			expectedMigrated:       true,
+			expectedUpdates:        []string{"certificates/ns/a", "certificates/ns/b", "orders/ns/c"},
+			expectedStoredVersion: map[string][]string{
+				"certificates.cert-manager.io": {"v1"},
+				"orders.acme.cert-manager.io":  {"v1"},
+				"widgets.example.com":          {"v1alpha2", "v1"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			apiextClient := apiextensionsfake.NewSimpleClientset(
+				crd("cert-manager.io", "certificates", "v1alpha2", "v1"),
+				crd("acme.cert-manager.io", "orders", "v1"),
+				crd("example.com", "widgets", "v1alpha2", "v1"),
+			)
+			dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}: "CertificateList",
+					{Group: "acme.cert-manager.io", Version: "v1", Resource: "orders"}:  "OrderList",
+				},
+				resource("cert-manager.io", "Certificate", "ns", "a"),
+				resource("cert-manager.io", "Certificate", "ns", "b"),
+				resource("acme.cert-manager.io", "Order", "ns", "c"),
+			)
+
+			m := &Migrator{
+				APIExtensionsClient:    apiextClient,
+				DynamicClient:          dynamicClient,
+				SkipStoredVersionCheck: test.skipStoredVersionCheck,
+				Out:                    &bytes.Buffer{},
+			}
+			migrated, err := m.Run(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if migrated != test.expectedMigrated {
+				t.Errorf("unexpected result, exp=%t, got=%t", test.expectedMigrated, migrated)
+			}
+
+			var updates []string
+			for _, a := range dynamicClient.Actions() {
+				if a.GetVerb() != "update" {
+					continue
+				}
+				obj := a.(interface{ GetObject() runtime.Object }).GetObject().(*unstructured.Unstructured)
+				updates = append(updates, a.GetResource().Resource+"/"+obj.GetNamespace()+"/"+obj.GetName())
+			}
+			if !reflect.DeepEqual(updates, test.expectedUpdates) {
+				t.Errorf("unexpected updates, exp=%v, got=%v", test.expectedUpdates, updates)
+			}
+
+			for name, expected := range test.expectedStoredVersion {
+				crd, err := apiextClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.Background(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(crd.Status.StoredVersions, expected) {
+					t.Errorf("unexpected stored versions for %s, exp=%v, got=%v", name, expected, crd.Status.StoredVersions)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	"github.com/jetstack/cert-manager/pkg/istioca"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util"
+	servertls "github.com/jetstack/cert-manager/pkg/webhook/server/tls"
+)
+
+type IstioCAOptions struct {
+	APIServerHost string
+	Kubeconfig    string
+
+	ListenAddress string
+	TLSCertFile   string
+	TLSKeyFile    string
+
+	IssuerName               string
+	IssuerKind               string
+	IssuerNamespace          string
+	ClusterResourceNamespace string
+
+	TrustDomain            string
+	MaxCertificateDuration time.Duration
+	TokenAudiences         []string
+}
+
+func (o *IstioCAOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.APIServerHost, "master", "", ""+
+		"Optional apiserver host address to connect to. If not specified, autoconfiguration "+
+		"will be attempted.")
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", "", ""+
+		"Paths to a kubeconfig. Only required if out-of-cluster.")
+
+	fs.StringVar(&o.ListenAddress, "listen-address", ":6443", ""+
+		"The address to serve the Istio CA gRPC API on.")
+	fs.StringVar(&o.TLSCertFile, "tls-cert-file", "", ""+
+		"Path to the PEM encoded certificate used to serve the gRPC API. The file is "+
+		"reloaded when it changes.")
+	fs.StringVar(&o.TLSKeyFile, "tls-private-key-file", "", ""+
+		"Path to the PEM encoded private key used to serve the gRPC API. The file is "+
+		"reloaded when it changes.")
+
+	fs.StringVar(&o.IssuerName, "issuer-name", "", ""+
+		"Name of the CA Issuer or ClusterIssuer used to sign workload certificates.")
+	fs.StringVar(&o.IssuerKind, "issuer-kind", cmapi.IssuerKind, ""+
+		"Kind of the issuer used to sign workload certificates, either Issuer or ClusterIssuer.")
+	fs.StringVar(&o.IssuerNamespace, "issuer-namespace", "", ""+
+		"Namespace of the Issuer used to sign workload certificates. Ignored for ClusterIssuers.")
+	fs.StringVar(&o.ClusterResourceNamespace, "cluster-resource-namespace", "kube-system", ""+
+		"Namespace to read the CA key pair of a ClusterIssuer from. This must match the "+
+		"--cluster-resource-namespace flag of the cert-manager controller.")
+
+	fs.StringVar(&o.TrustDomain, "trust-domain", "cluster.local", ""+
+		"The SPIFFE trust domain of the mesh. Workloads may only request certificates for "+
+		"their own SPIFFE ID in this trust domain.")
+	fs.DurationVar(&o.MaxCertificateDuration, "max-certificate-duration", time.Hour, ""+
+		"The maximum duration of workload certificates. Also used when a request does not "+
+		"specify a duration.")
+	fs.StringSliceVar(&o.TokenAudiences, "token-audiences", []string{"istio-ca"}, ""+
+		"Audiences that ServiceAccount tokens presented by workloads must be valid for.")
+}
+
+func (o *IstioCAOptions) Validate() error {
+	if o.TLSCertFile == "" || o.TLSKeyFile == "" {
+		return fmt.Errorf("--tls-cert-file and --tls-private-key-file must be set")
+	}
+	if o.IssuerName == "" {
+		return fmt.Errorf("--issuer-name must be set")
+	}
+	switch o.IssuerKind {
+	case cmapi.IssuerKind:
+		if o.IssuerNamespace == "" {
+			return fmt.Errorf("--issuer-namespace must be set when --issuer-kind is %s", cmapi.IssuerKind)
+		}
+	case cmapi.ClusterIssuerKind:
+	default:
+		return fmt.Errorf("--issuer-kind must be one of %s or %s", cmapi.IssuerKind, cmapi.ClusterIssuerKind)
+	}
+	if o.TrustDomain == "" {
+		return fmt.Errorf("--trust-domain must be set")
+	}
+	if o.MaxCertificateDuration <= 0 {
+		return fmt.Errorf("--max-certificate-duration must be positive")
+	}
+	return nil
+}
+
+// NewCommandStartIstioCA is a CLI handler for starting the Istio CA server
+func NewCommandStartIstioCA(ctx context.Context) *cobra.Command {
+	o := &IstioCAOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "istio-ca",
+		Short: fmt.Sprintf("Istio CA backed by cert-manager issuers (%s) (%s)", util.AppVersion, util.AppGitCommit),
+		Long: `
+cert-manager istio-ca serves Istio's CA gRPC API, signing workload certificates
+requested by Istio proxies using the key pair of a cert-manager CA Issuer or
+ClusterIssuer.
+
+Workloads authenticate using their ServiceAccount token and may only be issued
+certificates for their own SPIFFE ID. No CertificateRequest resources are
+created for workload certificates.`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			logf.V(logf.InfoLevel).InfoS("starting", "version", util.AppVersion, "revision", util.AppGitCommit)
+			return o.Run(ctx)
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *IstioCAOptions) Run(ctx context.Context) error {
+	log := logf.FromContext(ctx, "istio-ca")
+
+	kubeCfg, err := clientcmd.BuildConfigFromFlags(o.APIServerHost, o.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("error creating rest config: %v", err)
+	}
+	kubeCfg = rest.AddUserAgent(kubeCfg, util.CertManagerUserAgent)
+
+	cl, err := kubernetes.NewForConfig(kubeCfg)
+	if err != nil {
+		return fmt.Errorf("error creating kubernetes client: %v", err)
+	}
+	cmCl, err := cmclient.NewForConfig(kubeCfg)
+	if err != nil {
+		return fmt.Errorf("error creating internal group client: %v", err)
+	}
+
+	issuerOptions := controllerpkg.IssuerOptions{
+		ClusterResourceNamespace: o.ClusterResourceNamespace,
+	}
+	secretsNamespace := o.IssuerNamespace
+	if o.IssuerKind == cmapi.ClusterIssuerKind {
+		secretsNamespace = o.ClusterResourceNamespace
+	}
+
+	// Only the referenced issuer and the Secrets in its resource namespace
+	// are read, so the informers are scoped as narrowly as possible.
+	cmFactory := cminformers.NewSharedInformerFactoryWithOptions(cmCl, 0, cminformers.WithNamespace(o.IssuerNamespace))
+	kubeFactory := kubeinformers.NewSharedInformerFactoryWithOptions(cl, 0, kubeinformers.WithNamespace(secretsNamespace))
+
+	// Only the informer for the kind of the referenced issuer is created, so
+	// that the other kind is never listed.
+	var (
+		issuerLister        cmlisters.IssuerLister
+		clusterIssuerLister cmlisters.ClusterIssuerLister
+	)
+	secretsInformer := kubeFactory.Core().V1().Secrets()
+	mustSync := []cache.InformerSynced{secretsInformer.Informer().HasSynced}
+	if o.IssuerKind == cmapi.ClusterIssuerKind {
+		clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	} else {
+		issuerInformer := cmFactory.Certmanager().V1().Issuers()
+		issuerLister = issuerInformer.Lister()
+		mustSync = append(mustSync, issuerInformer.Informer().HasSynced)
+	}
+
+	cmFactory.Start(ctx.Done())
+	kubeFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), mustSync...) {
+		return fmt.Errorf("error waiting for informer caches to sync")
+	}
+
+	server := &istioca.Server{
+		Authenticator: &istioca.TokenReviewAuthenticator{
+			Client:    cl,
+			Audiences: o.TokenAudiences,
+		},
+		Signer: &istioca.CAIssuerSigner{
+			IssuerRef: cmmeta.ObjectReference{
+				Name:  o.IssuerName,
+				Kind:  o.IssuerKind,
+				Group: cmapi.SchemeGroupVersion.Group,
+			},
+			Namespace:     o.IssuerNamespace,
+			IssuerHelper:  issuer.NewHelper(issuerLister, clusterIssuerLister),
+			SecretsLister: secretsInformer.Lister(),
+			IssuerOptions: issuerOptions,
+		},
+		TrustDomain:            o.TrustDomain,
+		MaxCertificateDuration: o.MaxCertificateDuration,
+	}
+
+	certSource := &servertls.FileCertificateSource{
+		CertPath: o.TLSCertFile,
+		KeyPath:  o.TLSKeyFile,
+		Log:      log,
+	}
+
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		GetCertificate: certSource.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	})))
+	istioca.RegisterIstioCertificateServiceServer(grpcServer, server)
+
+	ln, err := net.Listen("tcp", o.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %v", o.ListenAddress, err)
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return certSource.Run(gctx.Done())
+	})
+	g.Go(func() error {
+		log.V(logf.InfoLevel).Info("serving Istio CA gRPC API", "address", ln.Addr().String())
+		return grpcServer.Serve(ln)
+	})
+	g.Go(func() error {
+		<-gctx.Done()
+		grpcServer.GracefulStop()
+		return nil
+	})
+
+	return g.Wait()
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/unsealer"
+	"github.com/jetstack/cert-manager/pkg/util"
+	"github.com/jetstack/cert-manager/pkg/util/kmssigner"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+var allKMSPlugins = []string{
+	kmssigner.AWSKMSPluginName,
+}
+
+type UnsealerOptions struct {
+	SourceDir string
+	TargetDir string
+
+	KMSPlugins []string
+
+	SyncPeriod time.Duration
+}
+
+func (o *UnsealerOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.SourceDir, "source-dir", "", ""+
+		"The directory the Secret of the Certificate is mounted at.")
+	fs.StringVar(&o.TargetDir, "target-dir", "", ""+
+		"The directory the files of the Secret are written to, with sealed private keys "+
+		"decrypted. This should be an in-memory volume shared with the application.")
+
+	fs.StringSliceVar(&o.KMSPlugins, "kms-plugins", []string{kmssigner.AWSKMSPluginName}, fmt.Sprintf(""+
+		"The KMS plugins which are enabled to unseal private keys, one of: %s. The '%s' plugin "+
+		"uses the AWS region and credentials of the Pod's environment.",
+		strings.Join(allKMSPlugins, ", "), kmssigner.AWSKMSPluginName))
+
+	fs.DurationVar(&o.SyncPeriod, "sync-period", 0, ""+
+		"How often the Secret is written to the target directory again, so that renewed "+
+		"certificates are picked up when running as a sidecar. If zero, the Secret is written "+
+		"once and the unsealer exits, as is expected of an init container.")
+}
+
+func (o *UnsealerOptions) Validate() error {
+	if o.SourceDir == "" || o.TargetDir == "" {
+		return fmt.Errorf("--source-dir and --target-dir must be set")
+	}
+	for _, name := range o.KMSPlugins {
+		found := false
+		for _, p := range allKMSPlugins {
+			found = found || p == name
+		}
+		if !found {
+			return fmt.Errorf("invalid value for kms-plugins: unknown KMS plugin %q", name)
+		}
+	}
+	if o.SyncPeriod < 0 {
+		return fmt.Errorf("--sync-period must not be negative")
+	}
+	return nil
+}
+
+// NewCommandStartUnsealer is a CLI handler for starting the unsealer
+func NewCommandStartUnsealer(ctx context.Context) *cobra.Command {
+	o := &UnsealerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "unsealer",
+		Short: fmt.Sprintf("Unseals private keys sealed by cert-manager KMS plugins (%s) (%s)", util.AppVersion, util.AppGitCommit),
+		Long: `
+cert-manager unsealer writes the files of the Secret of a Certificate, mounted
+as a volume, to another directory, decrypting private keys which were sealed by
+a KMS plugin because the Certificate has the
+cert-manager.io/private-key-kms-plugin annotation.
+
+Run it as an init container writing to an in-memory emptyDir volume shared with
+the application, or as a sidecar with --sync-period set to pick up renewed
+certificates. The Pod needs permission to decrypt with the KMS key that the
+cert-manager controller seals private keys with.`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			logf.V(logf.InfoLevel).InfoS("starting", "version", util.AppVersion, "revision", util.AppGitCommit)
+			return o.Run(ctx)
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *UnsealerOptions) Run(ctx context.Context) error {
+	log := logf.FromContext(ctx, "unsealer")
+
+	plugins, err := buildKMSPlugins(o.KMSPlugins)
+	if err != nil {
+		return err
+	}
+	u := &unsealer.Unsealer{
+		Plugins:   plugins,
+		SourceDir: o.SourceDir,
+		TargetDir: o.TargetDir,
+	}
+
+	if err := u.Unseal(ctx); err != nil {
+		return err
+	}
+	log.V(logf.InfoLevel).Info("wrote unsealed Secret files", "target", o.TargetDir)
+	if o.SyncPeriod == 0 {
+		return nil
+	}
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := u.Unseal(ctx); err != nil {
+			log.Error(err, "error writing unsealed Secret files")
+		}
+	}, o.SyncPeriod)
+	return nil
+}
+
+// buildKMSPlugins returns the enabled KMS plugins, keyed by name. The plugins
+// are only used to decrypt, so no key to encrypt with is configured.
+func buildKMSPlugins(names []string) (map[string]pki.KMSPlugin, error) {
+	plugins := make(map[string]pki.KMSPlugin)
+	for _, name := range names {
+		switch name {
+		case kmssigner.AWSKMSPluginName:
+			sess, err := session.NewSessionWithOptions(session.Options{SharedConfigState: session.SharedConfigEnable})
+			if err != nil {
+				return nil, fmt.Errorf("error creating aws session for the %q KMS plugin: %w", name, err)
+			}
+			sess.Handlers.Build.PushBack(request.WithAppendUserAgent(util.CertManagerUserAgent))
+			plugins[name] = kmssigner.NewAWSKMSPlugin(kms.New(sess), "")
+		default:
+			return nil, fmt.Errorf("unknown KMS plugin %q", name)
+		}
+	}
+	return plugins, nil
+}
@@ -43,6 +43,17 @@ type WebhookOptions struct {
 	// List of DNSNames that must be present on serving certificates.
 	DynamicServingDNSNames []string
 
+	// Name of a kubernetes.io/tls Secret resource in the
+	// DynamicServingCASecretNamespace containing a serving certificate,
+	// typically managed by a cert-manager Certificate. The dynamic serving
+	// CA is used until this Secret contains a valid key pair.
+	// Requires the dynamic serving CA to be configured.
+	ServingCertificateSecretName string
+	// Name of a Secret resource in the DynamicServingCASecretNamespace that
+	// will be kept up to date with a CA bundle containing both the dynamic
+	// serving CA and the CA of the ServingCertificateSecretName Secret.
+	CABundleSecretName string
+
 	// Optional path to the kubeconfig used to connect to the apiserver when
 	// using the 'dynamic serving' certificate sources.
 	// If not specified, in cluster config will be used.
@@ -56,6 +67,14 @@ type WebhookOptions struct {
 	// MinTLSVersion is the minimum TLS version supported.
 	// Values are from tls package constants (https://golang.org/pkg/crypto/tls/#pkg-constants).
 	MinTLSVersion string
+
+	// Limits placed on the subject alternative names of Certificates. Zero
+	// values place no limits. Certificates in
+	// CertificateSANPolicyExemptNamespaces are not subject to them.
+	CertificateMaxSANs                   int
+	CertificateMaxWildcardDepth          int
+	CertificateDenyPublicSuffixWildcards bool
+	CertificateSANPolicyExemptNamespaces []string
 }
 
 func (o *WebhookOptions) AddFlags(fs *pflag.FlagSet) {
@@ -67,6 +86,8 @@ func (o *WebhookOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.DynamicServingCASecretNamespace, "dynamic-serving-ca-secret-namespace", "", "namespace of the secret used to store the CA that signs serving certificates")
 	fs.StringVar(&o.DynamicServingCASecretName, "dynamic-serving-ca-secret-name", "", "name of the secret used to store the CA that signs serving certificates certificates")
 	fs.StringSliceVar(&o.DynamicServingDNSNames, "dynamic-serving-dns-names", []string{""}, "DNS names that should be present on certificates generated by the dynamic serving CA")
+	fs.StringVar(&o.ServingCertificateSecretName, "serving-certificate-secret-name", "", "name of a secret in the dynamic-serving-ca-secret-namespace containing a serving certificate to use instead of those generated by the dynamic serving CA once it is valid")
+	fs.StringVar(&o.CABundleSecretName, "ca-bundle-secret-name", "", "name of a secret in the dynamic-serving-ca-secret-namespace to store a CA bundle containing both the dynamic serving CA and the CA of the serving certificate secret")
 	fs.StringVar(&o.Kubeconfig, "kubeconfig", "", "optional path to the kubeconfig used to connect to the apiserver. If not specified, in-cluster-config will be used")
 	fs.StringVar(&o.APIServerHost, "api-server-host", "", ""+
 		"Optional apiserver host address to connect to. If not specified, autoconfiguration "+
@@ -77,6 +98,18 @@ func (o *WebhookOptions) AddFlags(fs *pflag.FlagSet) {
 		"Comma-separated list of cipher suites for the server. "+
 			"If omitted, the default Go cipher suites will be use.  "+
 			"Possible values: "+strings.Join(tlsCipherPossibleValues, ","))
+	fs.IntVar(&o.CertificateMaxSANs, "certificate-max-sans", 0, ""+
+		"Maximum number of subject alternative names that a Certificate may request. "+
+		"If 0, no limit is enforced.")
+	fs.IntVar(&o.CertificateMaxWildcardDepth, "certificate-max-wildcard-depth", 0, ""+
+		"Maximum number of wildcard labels in each DNS name of a Certificate, "+
+		"such that a value of 1 rejects names such as '*.*.example.com'. If 0, no limit is enforced.")
+	fs.BoolVar(&o.CertificateDenyPublicSuffixWildcards, "certificate-deny-public-suffix-wildcards", false, ""+
+		"If true, Certificates may not request wildcard DNS names for a public suffix, such as '*.com' or '*.co.uk'.")
+	fs.StringSliceVar(&o.CertificateSANPolicyExemptNamespaces, "certificate-san-policy-exempt-namespaces", nil, ""+
+		"Namespaces whose Certificates are not subject to the --certificate-max-sans, "+
+		"--certificate-max-wildcard-depth and --certificate-deny-public-suffix-wildcards limits.")
+
 	tlsPossibleVersions := cliflag.TLSPossibleVersions()
 	fs.StringVar(&o.MinTLSVersion, "tls-min-version", o.MinTLSVersion,
 		"Minimum TLS version supported. "+
@@ -96,3 +129,10 @@ func DynamicTLSSourceEnabled(o WebhookOptions) bool {
 	}
 	return false
 }
+
+func SecretTLSSourceEnabled(o WebhookOptions) bool {
+	if o.ServingCertificateSecretName != "" {
+		return true
+	}
+	return false
+}
@@ -22,13 +22,18 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
+	clientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 
 	cmdutil "github.com/jetstack/cert-manager/cmd/util"
 	"github.com/jetstack/cert-manager/cmd/webhook/app/options"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util"
+	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
 	"github.com/jetstack/cert-manager/pkg/webhook"
 	"github.com/jetstack/cert-manager/pkg/webhook/authority"
 	"github.com/jetstack/cert-manager/pkg/webhook/handlers"
@@ -50,7 +55,25 @@ func NewServerWithOptions(log logr.Logger, opts options.WebhookOptions) (*server
 	if err != nil {
 		return nil, fmt.Errorf("error creating kubernetes client: %s", err)
 	}
-	validationHook.InitPlugins(cl)
+
+	cmcl, err := cmclient.NewForConfig(restcfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cert-manager client: %s", err)
+	}
+	validationHook.InitPlugins(cl, cmcl)
+	validationHook.ConfigurePlugins(handlers.PluginConfig{
+		SANPolicy: handlers.SANPolicy{
+			MaxSANs:                   opts.CertificateMaxSANs,
+			MaxWildcardDepth:          opts.CertificateMaxWildcardDepth,
+			DenyPublicSuffixWildcards: opts.CertificateDenyPublicSuffixWildcards,
+			ExemptNamespaces:          opts.CertificateSANPolicyExemptNamespaces,
+		},
+	})
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logf.WithInfof(log.V(logf.DebugLevel)).Infof)
+	eventBroadcaster.StartRecordingToSink(&clientv1.EventSinkImpl{Interface: cl.CoreV1().Events("")})
+	validationHook.SetEventRecorder(eventBroadcaster.NewRecorder(webhook.Scheme, corev1.EventSource{Component: "cert-manager-webhook"}))
 
 	var source tls.CertificateSource
 	switch {
@@ -68,7 +91,7 @@ func NewServerWithOptions(log logr.Logger, opts options.WebhookOptions) (*server
 		}
 
 		log.V(logf.InfoLevel).Info("using dynamic certificate generating using CA stored in Secret resource", "secret_namespace", opts.DynamicServingCASecretNamespace, "secret_name", opts.DynamicServingCASecretName)
-		source = &tls.DynamicSource{
+		dynamicSource := &tls.DynamicSource{
 			DNSNames: opts.DynamicServingDNSNames,
 			Authority: &authority.DynamicAuthority{
 				SecretNamespace: opts.DynamicServingCASecretNamespace,
@@ -78,6 +101,21 @@ func NewServerWithOptions(log logr.Logger, opts options.WebhookOptions) (*server
 			},
 			Log: log,
 		}
+		source = dynamicSource
+
+		if options.SecretTLSSourceEnabled(opts) {
+			log.V(logf.InfoLevel).Info("using serving certificate stored in Secret resource once issued", "secret_namespace", opts.DynamicServingCASecretNamespace, "secret_name", opts.ServingCertificateSecretName, "ca_bundle_secret_name", opts.CABundleSecretName)
+			source = &tls.SecretSource{
+				SecretNamespace:    opts.DynamicServingCASecretNamespace,
+				SecretName:         opts.ServingCertificateSecretName,
+				CABundleSecretName: opts.CABundleSecretName,
+				Fallback:           dynamicSource,
+				RESTConfig:         restcfg,
+				Log:                log,
+			}
+		}
+	case options.SecretTLSSourceEnabled(opts):
+		return nil, fmt.Errorf("the dynamic serving CA must be configured to use a serving certificate Secret")
 	default:
 		log.V(logf.WarnLevel).Info("serving insecurely as tls certificate data not provided")
 	}
@@ -117,6 +155,7 @@ func NewServerCommand(stopCh <-chan struct{}) *cobra.Command {
 	}
 
 	opts.AddFlags(cmd.Flags())
+	utilfeature.DefaultMutableFeatureGate.AddFlag(cmd.Flags())
 
 	return cmd
 }
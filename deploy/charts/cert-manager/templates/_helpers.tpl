@@ -58,8 +58,12 @@ If release name contains chart name it will be used as a full name.
 {{- end -}}
 
 {{- define "webhook.caRef" -}}
+{{- if .Values.webhook.servingCertificate.enabled -}}
+{{ .Release.Namespace}}/{{ template "webhook.fullname" . }}-ca-bundle
+{{- else -}}
 {{ .Release.Namespace}}/{{ template "webhook.fullname" . }}-ca
 {{- end -}}
+{{- end -}}
 
 {{/*
 Create the name of the service account to use
@@ -21,6 +21,7 @@ require (
 	github.com/cpu/goacmedns v0.1.1
 	github.com/digitalocean/godo v1.65.0
 	github.com/go-logr/logr v0.4.0
+	github.com/golang/protobuf v1.5.2
 	github.com/google/gofuzz v1.2.0
 	github.com/googleapis/gnostic v0.5.5
 	github.com/hashicorp/vault/api v1.1.1
@@ -39,12 +40,19 @@ require (
 	github.com/spf13/cobra v1.2.1
 	github.com/spf13/pflag v1.0.5
 	github.com/stretchr/testify v1.7.0
+	go.mozilla.org/pkcs7 v0.0.0-20210826202110-33d05740a352
+	go.opentelemetry.io/otel v0.20.0
+	go.opentelemetry.io/otel/exporters/otlp v0.20.0
+	go.opentelemetry.io/otel/sdk v0.20.0
+	go.opentelemetry.io/otel/trace v0.20.0
 	golang.org/x/crypto v0.0.0-20210711020723-a769d52b0f97
 	golang.org/x/net v0.0.0-20210805182204-aaa1db679c0d
 	golang.org/x/oauth2 v0.0.0-20210810183815-faf39c7919d5
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
 	gomodules.xyz/jsonpatch/v2 v2.2.0
 	google.golang.org/api v0.53.0
+	google.golang.org/grpc v1.39.1
+	google.golang.org/protobuf v1.27.1
 	helm.sh/helm/v3 v3.6.3
 	k8s.io/api v0.22.0
 	k8s.io/apiextensions-apiserver v0.22.0
@@ -4686,6 +4686,15 @@ def go_repositories():
         version = "v1.1.2",
     )
 
+    go_repository(
+        name = "org_mozilla_go_pkcs7",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "go.mozilla.org/pkcs7",
+        sum = "h1:CCriYyAfq1Br1aIYettdHZTy8mBTIPo7We18TuO/bak=",
+        version = "v0.0.0-20210826202110-33d05740a352",
+    )
+
     go_repository(
         name = "org_uber_go_atomic",
         build_file_generation = "on",
@@ -80,3 +80,33 @@ for file in ${crdPath}/*.yaml; do
 done
 
 echo "SUCCESS: generated CRDs don't contain any status fields"
+
+# The schemapatch generator used by './hack/update-crds.sh' only updates the
+# schema of each version, so verify that the additionalPrinterColumns in the
+# CRDs match the +kubebuilder:printcolumn markers on the API types by
+# generating full CRDs from the markers and comparing the columns.
+generatedPath="${TEST_TMPDIR}/generated-crds"
+controllergen=$(realpath "$3")
+
+(
+  cd "$tmpfiles"
+  export HOME=$(realpath "$TEST_TMPDIR/home")
+  unset GOPATH
+  go=$(realpath "$2")
+  export PATH=$(dirname "$go"):$PATH
+  "$controllergen" crd output:dir="$generatedPath" paths=./pkg/apis/...
+)
+
+echo "Verifying that CRD printer columns match the +kubebuilder:printcolumn markers..."
+columns='[.spec.versions[] | {"name": .name, "additionalPrinterColumns": .additionalPrinterColumns}] | sortKeys(..)'
+for file in ${crdPath}/*.yaml; do
+  name=$($yq e '.metadata.name' $file)
+  # controller-gen names CRD files <group>_<plural>.yaml
+  generated="${generatedPath}/${name#*.}_${name%%.*}.yaml"
+  if ! diff -u <($yq e "$columns" "$generated") <($yq e "$columns" "$file"); then
+    echo "printer columns for $name do not match the +kubebuilder:printcolumn markers in pkg/apis" >&2
+    exit 1
+  fi
+done
+
+echo "SUCCESS: CRD printer columns match the API type markers"
@@ -57,6 +57,14 @@ func NewClient(client *http.Client, config cmacme.ACMEIssuer, privateKey *rsa.Pr
 // In future, we may change to having two global HTTP clients - one that ignores
 // TLS connection errors, and the other that does not.
 func BuildHTTPClient(metrics *metrics.Metrics, skipTLSVerify bool) *http.Client {
+	return BuildHTTPClientWithTLSConfig(metrics, &tls.Config{InsecureSkipVerify: skipTLSVerify})
+}
+
+// BuildHTTPClientWithTLSConfig returns an instrumented HTTP client to be used
+// by the ACME client, which connects to the ACME server with the given TLS
+// configuration. This is used for private ACME servers with a custom CA or
+// which require a client certificate.
+func BuildHTTPClientWithTLSConfig(metrics *metrics.Metrics, tlsConfig *tls.Config) *http.Client {
 	return acmecl.NewInstrumentedClient(metrics,
 		&http.Client{
 			Transport: &http.Transport{
@@ -65,7 +73,7 @@ func BuildHTTPClient(metrics *metrics.Metrics, skipTLSVerify bool) *http.Client
 					Timeout:   30 * time.Second,
 					KeepAlive: 30 * time.Second,
 				}).DialContext,
-				TLSClientConfig:       &tls.Config{InsecureSkipVerify: skipTLSVerify},
+				TLSClientConfig:       tlsConfig,
 				MaxIdleConns:          100,
 				IdleConnTimeout:       90 * time.Second,
 				TLSHandshakeTimeout:   10 * time.Second,
@@ -77,11 +77,13 @@ type registry struct {
 // for 'equality' between two clients. This is used to determine whether any
 // options that should trigger a re-initialisation of a client have changed.
 type stableOptions struct {
-	serverURL     string
-	skipVerifyTLS bool
-	issuerUID     string
-	publicKey     string
-	exponent      int
+	serverURL                  string
+	skipVerifyTLS              bool
+	caBundle                   string
+	clientCertificateSecretRef string
+	issuerUID                  string
+	publicKey                  string
+	exponent                   int
 }
 
 func (c stableOptions) equalTo(c2 stableOptions) bool {
@@ -91,12 +93,18 @@ func (c stableOptions) equalTo(c2 stableOptions) bool {
 func newStableOptions(uid string, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey) stableOptions {
 	// Encoding a big.Int cannot fail
 	publicNBytes, _ := privateKey.PublicKey.N.GobEncode()
+	var clientCertificateSecretRef string
+	if config.ClientCertificateSecretRef != nil {
+		clientCertificateSecretRef = config.ClientCertificateSecretRef.Name
+	}
 	return stableOptions{
-		serverURL:     config.Server,
-		skipVerifyTLS: config.SkipTLSVerify,
-		issuerUID:     uid,
-		publicKey:     string(publicNBytes),
-		exponent:      privateKey.PublicKey.E,
+		serverURL:                  config.Server,
+		skipVerifyTLS:              config.SkipTLSVerify,
+		caBundle:                   string(config.CABundle),
+		clientCertificateSecretRef: clientCertificateSecretRef,
+		issuerUID:                  uid,
+		publicKey:                  string(publicNBytes),
+		exponent:                   privateKey.PublicKey.E,
 	}
 }
 
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -145,3 +146,41 @@ func TestRegistry_AddClient_UpdatesExistingWhenPrivateKeyChanges(t *testing.T) {
 		t.Errorf("expected ListClients to have 1 item but it has %d", len(l))
 	}
 }
+
+func TestRegistry_AddClient_ReplacesExistingWhenTLSOptionsChange(t *testing.T) {
+	r := NewDefaultRegistry()
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Register a new client
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{}, pk)
+	c, err := r.GetClient("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update the client with a CA bundle
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{CABundle: []byte("ca")}, pk)
+	c2, err := r.GetClient("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c == c2 {
+		t.Errorf("expected client to be replaced when the CA bundle changes")
+	}
+
+	// Update the client with a client certificate
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{
+		CABundle:                   []byte("ca"),
+		ClientCertificateSecretRef: &cmmeta.LocalObjectReference{Name: "client-cert"},
+	}, pk)
+	c3, err := r.GetClient("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c2 == c3 {
+		t.Errorf("expected client to be replaced when the client certificate Secret changes")
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package acme
 
 import (
+	"errors"
+
+	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
 
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
@@ -43,6 +46,33 @@ func IsFailureState(s cmacme.State) bool {
 	return false
 }
 
+// Problem returns the problem document carried by an error returned by the
+// ACME client. For an AuthorizationError, the problem of the first failed
+// challenge is returned. If err does not carry a problem document, nil is
+// returned.
+func Problem(err error) *cmacme.ACMEProblem {
+	var authErr *acmeapi.AuthorizationError
+	if errors.As(err, &authErr) {
+		for _, err := range authErr.Errors {
+			if p := Problem(err); p != nil {
+				return p
+			}
+		}
+		return nil
+	}
+
+	var acmeErr *acmeapi.Error
+	if !errors.As(err, &acmeErr) {
+		return nil
+	}
+	return &cmacme.ACMEProblem{
+		Type:     acmeErr.ProblemType,
+		Detail:   acmeErr.Detail,
+		Status:   acmeErr.StatusCode,
+		Instance: acmeErr.Instance,
+	}
+}
+
 // PrivateKeySelector will default the SecretKeySelector with a default secret key
 // if one is not already specified.
 func PrivateKeySelector(sel cmmeta.SecretKeySelector) cmmeta.SecretKeySelector {
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package check verifies that the cert-manager API is ready to be used: that
+// the CRDs are installed at the expected versions and that the webhook is
+// reachable by the Kubernetes API server and serving a certificate signed by
+// the CA it has been configured with.
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/jetstack/cert-manager/pkg/util/cmapichecker"
+)
+
+// ErrCRDsNotServed is returned when one or more of the cert-manager resources
+// is not served by the Kubernetes API server at the expected version.
+var ErrCRDsNotServed = errors.New("the cert-manager CRDs are not installed at the expected versions")
+
+// ExpectedResources are the resources which must be served by the Kubernetes
+// API server for cert-manager to work.
+var ExpectedResources = []schema.GroupVersionResource{
+	{Group: "cert-manager.io", Version: "v1", Resource: "certificates"},
+	{Group: "cert-manager.io", Version: "v1", Resource: "certificaterequests"},
+	{Group: "cert-manager.io", Version: "v1", Resource: "issuers"},
+	{Group: "cert-manager.io", Version: "v1", Resource: "clusterissuers"},
+	{Group: "acme.cert-manager.io", Version: "v1", Resource: "orders"},
+	{Group: "acme.cert-manager.io", Version: "v1", Resource: "challenges"},
+}
+
+// Interface is used to check that the cert-manager API is ready.
+type Interface interface {
+	Check(context.Context) error
+}
+
+// Checker checks that the cert-manager CRDs are served at the expected
+// versions, and then that the webhook is working by performing a dry-run
+// create of a Certificate. The dry-run fails if the webhook cannot be
+// reached or if it serves a certificate which is not signed by the CA
+// injected into its configuration.
+type Checker struct {
+	Discovery discovery.DiscoveryInterface
+	Webhook   cmapichecker.Interface
+}
+
+var _ Interface = &Checker{}
+
+// Check returns nil if the cert-manager API is ready.
+func (c *Checker) Check(ctx context.Context) error {
+	if err := CRDs(c.Discovery); err != nil {
+		return err
+	}
+	if c.Webhook == nil {
+		return nil
+	}
+	return c.Webhook.Check(ctx)
+}
+
+// CRDs returns an error wrapping ErrCRDsNotServed if any of the
+// ExpectedResources are not served by the Kubernetes API server.
+func CRDs(client discovery.DiscoveryInterface) error {
+	var groupVersions []schema.GroupVersion
+	byGroupVersion := map[schema.GroupVersion][]string{}
+	for _, gvr := range ExpectedResources {
+		gv := gvr.GroupVersion()
+		if _, ok := byGroupVersion[gv]; !ok {
+			groupVersions = append(groupVersions, gv)
+		}
+		byGroupVersion[gv] = append(byGroupVersion[gv], gvr.Resource)
+	}
+
+	var missing []string
+	for _, gv := range groupVersions {
+		resources := byGroupVersion[gv]
+		list, err := client.ServerResourcesForGroupVersion(gv.String())
+		if apierrors.IsNotFound(err) {
+			for _, resource := range resources {
+				missing = append(missing, resource+"."+gv.String())
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("while discovering resources for %s: %w", gv, err)
+		}
+
+		served := map[string]bool{}
+		for _, r := range list.APIResources {
+			served[r.Name] = true
+		}
+		for _, resource := range resources {
+			if !served[resource] {
+				missing = append(missing, resource+"."+gv.String())
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%w: %s not served", ErrCRDsNotServed, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+)
+
+// fakeDiscovery serves the given resources, and returns a NotFound error
+// for group versions which are not listed, as the API server does.
+type fakeDiscovery struct {
+	*fakediscovery.FakeDiscovery
+	resources map[string][]string
+	err       error
+}
+
+func (f *fakeDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	names, ok := f.resources[groupVersion]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, groupVersion)
+	}
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, name := range names {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: name})
+	}
+	return list, nil
+}
+
+var allResources = map[string][]string{
+	"cert-manager.io/v1":      {"certificates", "certificaterequests", "issuers", "clusterissuers"},
+	"acme.cert-manager.io/v1": {"orders", "challenges"},
+}
+
+type fakeChecker struct {
+	err error
+}
+
+func (f *fakeChecker) Check(context.Context) error {
+	return f.err
+}
+
+func TestChecker(t *testing.T) {
+	errWebhook := errors.New("webhook not ready")
+
+	tests := map[string]struct {
+		resources    map[string][]string
+		discoveryErr error
+		webhookErr   error
+
+		expectedErr string
+	}{
+		"ready if all resources are served and the webhook works": {
+			resources: allResources,
+		},
+		"not ready if a group version is not served": {
+			resources: map[string][]string{
+				"cert-manager.io/v1": allResources["cert-manager.io/v1"],
+			},
+			expectedErr: "the cert-manager CRDs are not installed at the expected versions: challenges.acme.cert-manager.io/v1, orders.acme.cert-manager.io/v1 not served",
+		},
+		"not ready if a resource is not served": {
+			resources: map[string][]string{
+				"cert-manager.io/v1":      {"certificates", "issuers", "clusterissuers"},
+				"acme.cert-manager.io/v1": allResources["acme.cert-manager.io/v1"],
+			},
+			expectedErr: "the cert-manager CRDs are not installed at the expected versions: certificaterequests.cert-manager.io/v1 not served",
+		},
+		"not ready if discovery fails": {
+			discoveryErr: errors.New("connection refused"),
+			expectedErr:  "while discovering resources for cert-manager.io/v1: connection refused",
+		},
+		"not ready if the webhook fails": {
+			resources:   allResources,
+			webhookErr:  errWebhook,
+			expectedErr: "webhook not ready",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &Checker{
+				Discovery: &fakeDiscovery{resources: test.resources, err: test.discoveryErr},
+				Webhook:   &fakeChecker{err: test.webhookErr},
+			}
+			err := c.Check(context.Background())
+			if test.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != test.expectedErr {
+				t.Errorf("expected error %q, got: %v", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestProbe(t *testing.T) {
+	checker := &fakeChecker{err: errors.New("not ready")}
+	probe := NewProbe(checker, time.Millisecond)
+
+	get := func() int {
+		rec := httptest.NewRecorder()
+		probe.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		return rec.Code
+	}
+
+	if code := get(); code != http.StatusServiceUnavailable {
+		t.Errorf("expected probe to fail before the first check, got %d", code)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	if err := probe.WaitUntilReady(ctx); err == nil || err.Error() != "cert-manager API not ready: not ready" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if code := get(); code != http.StatusServiceUnavailable {
+		t.Errorf("expected probe to fail, got %d", code)
+	}
+
+	checker.err = nil
+	if err := probe.WaitUntilReady(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if code := get(); code != http.StatusOK {
+		t.Errorf("expected probe to pass, got %d", code)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var errNotChecked = errors.New("the cert-manager API has not been checked yet")
+
+// Probe periodically runs a check and records the result, which is served
+// over HTTP so that it can be used as a readiness probe.
+type Probe struct {
+	checker  Interface
+	interval time.Duration
+
+	lock sync.RWMutex
+	err  error
+}
+
+// NewProbe returns a Probe which runs the given check every interval once
+// started. The Probe is not ready until the check has passed.
+func NewProbe(checker Interface, interval time.Duration) *Probe {
+	return &Probe{
+		checker:  checker,
+		interval: interval,
+		err:      errNotChecked,
+	}
+}
+
+// Start runs the check periodically until the context is cancelled.
+func (p *Probe) Start(ctx context.Context) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		p.check(ctx)
+	}, p.interval)
+}
+
+// WaitUntilReady runs the check periodically until it passes, returning an
+// error if the context is cancelled first.
+func (p *Probe) WaitUntilReady(ctx context.Context) error {
+	err := wait.PollImmediateUntil(p.interval, func() (bool, error) {
+		return p.check(ctx) == nil, nil
+	}, ctx.Done())
+	if err != nil {
+		return fmt.Errorf("cert-manager API not ready: %w", p.Err())
+	}
+	return nil
+}
+
+// Err returns the result of the most recent check.
+func (p *Probe) Err() error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.err
+}
+
+func (p *Probe) check(ctx context.Context) error {
+	err := p.checker.Check(ctx)
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.err = err
+	return err
+}
+
+// ServeHTTP responds with 200 if the most recent check passed, and with 503
+// and the error otherwise.
+func (p *Probe) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if err := p.Err(); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintln(w, err.Error())
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion converts cert-manager resources between API versions.
+// The scheme passed to the functions in this package must have the internal
+// API version and the conversion functions of all external API versions
+// registered, such as the webhook's scheme.
+package conversion
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Convert converts the given versioned object to the given API version, by
+// converting it to the internal API version first. The returned object is a
+// new object of the kind of the given object in the given API version.
+func Convert(scheme *runtime.Scheme, in runtime.Object, gv schema.GroupVersion) (runtime.Object, error) {
+	gvk, err := objectKind(scheme, in)
+	if err != nil {
+		return nil, err
+	}
+
+	internal, err := scheme.ConvertToVersion(in.DeepCopyObject(), runtime.InternalGroupVersioner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to the internal version: %w", gvk, err)
+	}
+
+	out, err := scheme.ConvertToVersion(internal, gv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to %s: %w", gvk, gv, err)
+	}
+	out.GetObjectKind().SetGroupVersionKind(gv.WithKind(gvk.Kind))
+
+	return out, nil
+}
+
+// ConvertUnstructured converts the given unstructured object to the given API
+// version. The apiVersion and kind of the given object must be set.
+func ConvertUnstructured(scheme *runtime.Scheme, in *unstructured.Unstructured, gv schema.GroupVersion) (*unstructured.Unstructured, error) {
+	typed, err := FromUnstructured(scheme, in)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := Convert(scheme, typed, gv)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToUnstructured(scheme, out)
+}
+
+// FromUnstructured returns the typed object of the given unstructured object,
+// using the apiVersion and kind of the unstructured object.
+func FromUnstructured(scheme *runtime.Scheme, in *unstructured.Unstructured) (runtime.Object, error) {
+	gvk := in.GroupVersionKind()
+	if gvk.Empty() || gvk.Kind == "" {
+		return nil, fmt.Errorf("unstructured object %q does not have an apiVersion and kind set", in.GetName())
+	}
+
+	out, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(in.UnstructuredContent(), out); err != nil {
+		return nil, fmt.Errorf("failed to decode unstructured %s: %w", gvk, err)
+	}
+	out.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return out, nil
+}
+
+// ToUnstructured returns the unstructured representation of the given
+// versioned object, with its apiVersion and kind set.
+func ToUnstructured(scheme *runtime.Scheme, in runtime.Object) (*unstructured.Unstructured, error) {
+	gvk, err := objectKind(scheme, in)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s as unstructured: %w", gvk, err)
+	}
+	out := &unstructured.Unstructured{Object: content}
+	out.SetGroupVersionKind(gvk)
+
+	return out, nil
+}
+
+// objectKind returns the GroupVersionKind of the given versioned object,
+// looking it up in the scheme if it is not set on the object.
+func objectKind(scheme *runtime.Scheme, obj runtime.Object) (schema.GroupVersionKind, error) {
+	if gvk := obj.GetObjectKind().GroupVersionKind(); !gvk.Empty() {
+		return gvk, nil
+	}
+
+	gvks, unversioned, err := scheme.ObjectKinds(obj)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+	if unversioned || len(gvks) == 0 || gvks[0].Version == runtime.APIVersionInternal {
+		return schema.GroupVersionKind{}, fmt.Errorf("%T is not a versioned object", obj)
+	}
+
+	return gvks[0], nil
+}
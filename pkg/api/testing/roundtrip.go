@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+
+	"github.com/jetstack/cert-manager/pkg/api/conversion"
+)
+
+// RoundTripExternalVersions fuzzes objects of every kind of the API groups
+// installed by installFn, and converts each of them through every external
+// version of its group in turn as unstructured objects, before converting it
+// back to the internal version. The test fails if the resulting object
+// differs from the fuzzed object.
+// Unlike the apimachinery round trip tests, which only convert between the
+// internal version and a single external version, this catches fields which
+// are dropped when converting between external versions, such as fields
+// missing from older versions or from hand-written conversion functions.
+func RoundTripExternalVersions(t *testing.T, installFn roundtrip.InstallFunc, fuzzingFuncs fuzzer.FuzzerFuncs) {
+	scheme := runtime.NewScheme()
+	installFn(scheme)
+	f := fuzzer.FuzzerFor(
+		fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, fuzzingFuncs),
+		rand.NewSource(rand.Int63()),
+		serializer.NewCodecFactory(scheme),
+	)
+
+	for _, group := range groupsFromScheme(scheme) {
+		versions := scheme.PrioritizedVersionsForGroup(group)
+		internalGV := schema.GroupVersion{Group: group, Version: runtime.APIVersionInternal}
+		for kind := range scheme.KnownTypes(internalGV) {
+			if roundtrip.GlobalNonRoundTrippableTypes().Has(kind) {
+				continue
+			}
+
+			var kindVersions []schema.GroupVersion
+			for _, gv := range versions {
+				if scheme.Recognizes(gv.WithKind(kind)) {
+					kindVersions = append(kindVersions, gv)
+				}
+			}
+			if len(kindVersions) == 0 {
+				continue
+			}
+
+			t.Run(internalGV.WithKind(kind).GroupKind().String(), func(t *testing.T) {
+				for i := 0; i < *roundtrip.FuzzIters; i++ {
+					roundTripThroughVersions(t, scheme, f, internalGV.WithKind(kind), kindVersions)
+					// also convert through the versions in the reverse order,
+					// in case a field is only dropped when converting from a
+					// newer to an older version.
+					reversed := make([]schema.GroupVersion, len(kindVersions))
+					for i, gv := range kindVersions {
+						reversed[len(kindVersions)-1-i] = gv
+					}
+					roundTripThroughVersions(t, scheme, f, internalGV.WithKind(kind), reversed)
+				}
+			})
+		}
+	}
+}
+
+func roundTripThroughVersions(t *testing.T, scheme *runtime.Scheme, f *fuzz.Fuzzer, internalGVK schema.GroupVersionKind, versions []schema.GroupVersion) {
+	object, err := scheme.New(internalGVK)
+	if err != nil {
+		t.Fatalf("couldn't make a %v? %v", internalGVK, err)
+	}
+	f.Fuzz(object)
+	// the internal version of an object does not have a kind or apiVersion
+	accessor, err := apimeta.TypeAccessor(object)
+	if err != nil {
+		t.Fatalf("%q is not a TypeMeta and cannot be tested: %v", internalGVK, err)
+	}
+	accessor.SetKind("")
+	accessor.SetAPIVersion("")
+
+	external, err := scheme.ConvertToVersion(object.DeepCopyObject(), versions[0])
+	if err != nil {
+		t.Fatalf("failed to convert %s to %s: %v", internalGVK.Kind, versions[0], err)
+	}
+	external.GetObjectKind().SetGroupVersionKind(versions[0].WithKind(internalGVK.Kind))
+
+	u, err := conversion.ToUnstructured(scheme, external)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, gv := range versions[1:] {
+		u, err = conversion.ConvertUnstructured(scheme, u, gv)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	typed, err := conversion.FromUnstructured(scheme, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	final, err := scheme.ConvertToVersion(typed, runtime.InternalGroupVersioner)
+	if err != nil {
+		t.Fatalf("failed to convert %s to the internal version: %v", u.GroupVersionKind(), err)
+	}
+	accessor, err = apimeta.TypeAccessor(final)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accessor.SetKind("")
+	accessor.SetAPIVersion("")
+
+	if !apiequality.Semantic.DeepEqual(object, final) {
+		t.Errorf("%s was changed when converted through the versions %v: diff: %s",
+			internalGVK.Kind, versions, diff.ObjectReflectDiff(object, final))
+	}
+}
+
+func groupsFromScheme(scheme *runtime.Scheme) []string {
+	groups := map[string]struct{}{}
+	for gvk := range scheme.AllKnownTypes() {
+		if gvk.Version == runtime.APIVersionInternal {
+			groups[gvk.Group] = struct{}{}
+		}
+	}
+
+	var sorted []string
+	for group := range groups {
+		sorted = append(sorted, group)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// ApplyIssuerCertificateDefaults applies the given issuer certificate
+// defaults to the fields of the given Certificate spec which are not set,
+// modifying the spec in place. A description of each value that was applied
+// is returned, or nil if none was.
+//
+// The webhook defaults the usages and renewBefore of Certificates, so usages
+// equal to the default key usages and a renewBefore of one third of the
+// default duration are treated as not set.
+func ApplyIssuerCertificateDefaults(defaults *cmapi.IssuerCertificateDefaults, spec *cmapi.CertificateSpec) []string {
+	if defaults == nil {
+		return nil
+	}
+
+	var applied []string
+	if defaults.Duration != nil && spec.Duration == nil {
+		if spec.RenewBefore != nil && spec.RenewBefore.Duration == cmapi.DefaultCertificateDuration/3 {
+			spec.RenewBefore = &metav1.Duration{Duration: defaults.Duration.Duration / 3}
+		}
+		spec.Duration = defaults.Duration.DeepCopy()
+		applied = append(applied, fmt.Sprintf("duration %s", defaults.Duration.Duration))
+	}
+
+	if len(defaults.Usages) > 0 && len(spec.Profile) == 0 && usagesNotSet(spec.Usages) {
+		spec.Usages = append([]cmapi.KeyUsage(nil), defaults.Usages...)
+		applied = append(applied, fmt.Sprintf("usages %s", joinUsages(defaults.Usages)))
+	}
+
+	if len(defaults.Organizations) > 0 && (spec.Subject == nil || len(spec.Subject.Organizations) == 0) {
+		if spec.Subject == nil {
+			spec.Subject = &cmapi.X509Subject{}
+		}
+		spec.Subject.Organizations = append([]string(nil), defaults.Organizations...)
+		applied = append(applied, fmt.Sprintf("organizations %s", strings.Join(defaults.Organizations, ", ")))
+	}
+
+	return applied
+}
+
+// usagesNotSet returns true if the given usages are empty or are the usages
+// defaulted by the webhook.
+func usagesNotSet(usages []cmapi.KeyUsage) bool {
+	if len(usages) == 0 {
+		return true
+	}
+
+	defaults := cmapi.DefaultKeyUsages()
+	if len(usages) != len(defaults) {
+		return false
+	}
+	for _, usage := range usages {
+		found := false
+		for _, d := range defaults {
+			if usage == d {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func joinUsages(usages []cmapi.KeyUsage) string {
+	s := make([]string, len(usages))
+	for i, usage := range usages {
+		s[i] = string(usage)
+	}
+	return strings.Join(s, ", ")
+}
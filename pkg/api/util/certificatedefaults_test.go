@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestApplyIssuerCertificateDefaults(t *testing.T) {
+	defaults := &cmapi.IssuerCertificateDefaults{
+		Duration:      &metav1.Duration{Duration: time.Hour * 24 * 30},
+		Usages:        []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+		Organizations: []string{"Example Org"},
+	}
+
+	tests := map[string]struct {
+		defaults    *cmapi.IssuerCertificateDefaults
+		spec        cmapi.CertificateSpec
+		wantSpec    cmapi.CertificateSpec
+		wantApplied []string
+	}{
+		"no defaults leaves the spec unchanged": {
+			spec:     cmapi.CertificateSpec{CommonName: "example.com"},
+			wantSpec: cmapi.CertificateSpec{CommonName: "example.com"},
+		},
+		"defaults are applied to an empty spec": {
+			defaults: defaults,
+			spec:     cmapi.CertificateSpec{},
+			wantSpec: cmapi.CertificateSpec{
+				Duration: &metav1.Duration{Duration: time.Hour * 24 * 30},
+				Usages:   []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+				Subject:  &cmapi.X509Subject{Organizations: []string{"Example Org"}},
+			},
+			wantApplied: []string{
+				"duration 720h0m0s",
+				"usages digital signature, server auth",
+				"organizations Example Org",
+			},
+		},
+		"webhook defaulted usages and renewBefore are replaced": {
+			defaults: defaults,
+			spec: cmapi.CertificateSpec{
+				RenewBefore: &metav1.Duration{Duration: cmapi.DefaultCertificateDuration / 3},
+				Usages:      []cmapi.KeyUsage{cmapi.UsageKeyEncipherment, cmapi.UsageDigitalSignature},
+				Subject:     &cmapi.X509Subject{Countries: []string{"GB"}},
+			},
+			wantSpec: cmapi.CertificateSpec{
+				Duration:    &metav1.Duration{Duration: time.Hour * 24 * 30},
+				RenewBefore: &metav1.Duration{Duration: time.Hour * 24 * 10},
+				Usages:      []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+				Subject:     &cmapi.X509Subject{Countries: []string{"GB"}, Organizations: []string{"Example Org"}},
+			},
+			wantApplied: []string{
+				"duration 720h0m0s",
+				"usages digital signature, server auth",
+				"organizations Example Org",
+			},
+		},
+		"values set on the Certificate are not overridden": {
+			defaults: defaults,
+			spec: cmapi.CertificateSpec{
+				Duration:    &metav1.Duration{Duration: time.Hour * 24 * 7},
+				RenewBefore: &metav1.Duration{Duration: time.Hour},
+				Usages:      []cmapi.KeyUsage{cmapi.UsageClientAuth},
+				Subject:     &cmapi.X509Subject{Organizations: []string{"Other Org"}},
+			},
+			wantSpec: cmapi.CertificateSpec{
+				Duration:    &metav1.Duration{Duration: time.Hour * 24 * 7},
+				RenewBefore: &metav1.Duration{Duration: time.Hour},
+				Usages:      []cmapi.KeyUsage{cmapi.UsageClientAuth},
+				Subject:     &cmapi.X509Subject{Organizations: []string{"Other Org"}},
+			},
+		},
+		"an explicit renewBefore is kept when the duration is defaulted": {
+			defaults: &cmapi.IssuerCertificateDefaults{Duration: &metav1.Duration{Duration: time.Hour * 24 * 30}},
+			spec: cmapi.CertificateSpec{
+				RenewBefore: &metav1.Duration{Duration: time.Hour * 24},
+			},
+			wantSpec: cmapi.CertificateSpec{
+				Duration:    &metav1.Duration{Duration: time.Hour * 24 * 30},
+				RenewBefore: &metav1.Duration{Duration: time.Hour * 24},
+			},
+			wantApplied: []string{"duration 720h0m0s"},
+		},
+		"usages are not applied to Certificates with a profile": {
+			defaults: &cmapi.IssuerCertificateDefaults{Usages: []cmapi.KeyUsage{cmapi.UsageServerAuth}},
+			spec:     cmapi.CertificateSpec{Profile: cmapi.ClientCertificateProfile},
+			wantSpec: cmapi.CertificateSpec{Profile: cmapi.ClientCertificateProfile},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := test.spec
+			applied := ApplyIssuerCertificateDefaults(test.defaults, &spec)
+			if !reflect.DeepEqual(spec, test.wantSpec) {
+				t.Errorf("unexpected spec, exp=%#v got=%#v", test.wantSpec, spec)
+			}
+			if !reflect.DeepEqual(applied, test.wantApplied) {
+				t.Errorf("unexpected applied defaults, exp=%v got=%v", test.wantApplied, applied)
+			}
+		})
+	}
+}
@@ -34,6 +34,10 @@ const (
 	IssuerSelfSigned string = "selfsigned"
 	// IssuerVenafi uses Venafi Trust Protection Platform and Venafi Cloud
 	IssuerVenafi string = "venafi"
+	// IssuerSCEP enrolls certificates with a SCEP server
+	IssuerSCEP string = "scep"
+	// IssuerCMP enrolls certificates with a CMP server
+	IssuerCMP string = "cmp"
 )
 
 // NameForIssuer determines the name of the Issuer implementation given an
@@ -50,6 +54,10 @@ func NameForIssuer(i cmapi.GenericIssuer) (string, error) {
 		return IssuerSelfSigned, nil
 	case i.GetSpec().Venafi != nil:
 		return IssuerVenafi, nil
+	case i.GetSpec().SCEP != nil:
+		return IssuerSCEP, nil
+	case i.GetSpec().CMP != nil:
+		return IssuerCMP, nil
 	}
 	return "", fmt.Errorf("no issuer specified for Issuer '%s/%s'", i.GetObjectMeta().Namespace, i.GetObjectMeta().Name)
 }
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// NotificationRouteExpiryThresholds returns the expiry thresholds of the
+// given NotificationRoute, or the default thresholds if it does not specify
+// any.
+func NotificationRouteExpiryThresholds(route *v1.NotificationRoute) []time.Duration {
+	if len(route.Spec.ExpiryThresholds) == 0 {
+		return v1.DefaultNotificationExpiryThresholds
+	}
+	thresholds := make([]time.Duration, len(route.Spec.ExpiryThresholds))
+	for i, threshold := range route.Spec.ExpiryThresholds {
+		thresholds[i] = threshold.Duration
+	}
+	return thresholds
+}
+
+// NotificationRouteIssuanceFailureThreshold returns the number of consecutive
+// failed issuances after which the given NotificationRoute sends an
+// `IssuanceFailed` notification.
+func NotificationRouteIssuanceFailureThreshold(route *v1.NotificationRoute) int {
+	if route.Spec.IssuanceFailureThreshold == nil {
+		return v1.DefaultNotificationIssuanceFailureThreshold
+	}
+	return int(*route.Spec.IssuanceFailureThreshold)
+}
+
+// NotificationRouteSendsEvent returns true if the given NotificationRoute
+// sends notifications for the given event.
+func NotificationRouteSendsEvent(route *v1.NotificationRoute, event v1.NotificationEvent) bool {
+	if len(route.Spec.Events) == 0 {
+		return true
+	}
+	for _, e := range route.Spec.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// IssuerPolicyDuration returns the duration that a certificate with the
+// requested duration should be signed with according to the given policy.
+// An error is returned if the requested duration is outside of the durations
+// allowed by the policy and the policy rejects such requests. If the policy
+// clamps such requests, the clamped duration is returned instead.
+func IssuerPolicyDuration(policy *v1.IssuerPolicy, duration time.Duration) (time.Duration, error) {
+	if policy == nil {
+		return duration, nil
+	}
+
+	clamped := duration
+	if policy.MinDuration != nil && duration < policy.MinDuration.Duration {
+		clamped = policy.MinDuration.Duration
+	}
+	if policy.MaxDuration != nil && duration > policy.MaxDuration.Duration {
+		clamped = policy.MaxDuration.Duration
+	}
+	if clamped == duration || policy.DurationEnforcement == v1.ClampDurationEnforcement {
+		return clamped, nil
+	}
+
+	if duration < clamped {
+		return duration, fmt.Errorf("duration %s is shorter than the minimum duration %s allowed by the issuer", duration, clamped)
+	}
+	return duration, fmt.Errorf("duration %s is longer than the maximum duration %s allowed by the issuer", duration, clamped)
+}
+
+// IssuerPolicyAllowsKeyAlgorithm returns true if the given policy allows
+// certificates with the given private key algorithm to be signed.
+func IssuerPolicyAllowsKeyAlgorithm(policy *v1.IssuerPolicy, algorithm v1.PrivateKeyAlgorithm) bool {
+	if policy == nil || len(policy.AllowedPrivateKeyAlgorithms) == 0 {
+		return true
+	}
+
+	for _, allowed := range policy.AllowedPrivateKeyAlgorithms {
+		if allowed == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+// IssuerPolicyAllowsExtension returns true if the given policy allows the
+// additional X.509 extension with the given object identifier to be added to
+// certificates. Additional extensions are only allowed if they are listed by
+// the policy.
+func IssuerPolicyAllowsExtension(policy *v1.IssuerPolicy, id string) bool {
+	if policy == nil {
+		return false
+	}
+
+	for _, allowed := range policy.AllowedExtensions {
+		if allowed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// requesterIdentityTemplateVariables are the variables that may be used in
+// the templates of a RequesterIdentityPolicy.
+var requesterIdentityTemplateVariables = []string{
+	"username",
+	"uid",
+	"namespace",
+	"serviceaccount.name",
+	"serviceaccount.namespace",
+}
+
+// ValidateRequesterIdentityTemplate returns an error if the given template
+// of a RequesterIdentityPolicy uses an unsupported variable.
+func ValidateRequesterIdentityTemplate(template string) error {
+	var unsupported []string
+	os.Expand(template, func(name string) string {
+		for _, supported := range requesterIdentityTemplateVariables {
+			if name == supported {
+				return ""
+			}
+		}
+		unsupported = append(unsupported, name)
+		return ""
+	})
+	if len(unsupported) > 0 {
+		return fmt.Errorf("unsupported variables: %s", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// IssuerPolicyCheckRequesterIdentity returns an error if the subject of the
+// given x509 certificate request does not match the identity of the user that
+// created the CertificateRequest, according to the given policy.
+func IssuerPolicyCheckRequesterIdentity(policy *v1.IssuerPolicy, cr *v1.CertificateRequest, csr *x509.CertificateRequest) error {
+	if policy == nil || policy.RequesterIdentity == nil {
+		return nil
+	}
+	identity := policy.RequesterIdentity
+
+	vars := map[string]string{
+		"username":  cr.Spec.Username,
+		"uid":       cr.Spec.UID,
+		"namespace": cr.Namespace,
+	}
+	if namespace, name, err := serviceaccount.SplitUsername(cr.Spec.Username); err == nil {
+		vars["serviceaccount.name"] = name
+		vars["serviceaccount.namespace"] = namespace
+	}
+
+	if len(identity.CommonName) > 0 {
+		want, err := expandRequesterIdentityTemplate(identity.CommonName, vars)
+		if err != nil {
+			return err
+		}
+		if csr.Subject.CommonName != want {
+			return fmt.Errorf("common name %q does not match the identity of the requester %q", csr.Subject.CommonName, cr.Spec.Username)
+		}
+	}
+
+	if len(identity.DNSNames) > 0 {
+		allowed, err := expandRequesterIdentityTemplates(identity.DNSNames, vars)
+		if err != nil {
+			return err
+		}
+		for _, dnsName := range csr.DNSNames {
+			if !allowed[dnsName] {
+				return fmt.Errorf("DNS name %q does not match the identity of the requester %q", dnsName, cr.Spec.Username)
+			}
+		}
+	}
+
+	if len(identity.URIs) > 0 {
+		allowed, err := expandRequesterIdentityTemplates(identity.URIs, vars)
+		if err != nil {
+			return err
+		}
+		for _, uri := range csr.URIs {
+			if !allowed[uri.String()] {
+				return fmt.Errorf("URI %q does not match the identity of the requester %q", uri, cr.Spec.Username)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandRequesterIdentityTemplates(templates []string, vars map[string]string) (map[string]bool, error) {
+	expanded := make(map[string]bool, len(templates))
+	for _, template := range templates {
+		value, err := expandRequesterIdentityTemplate(template, vars)
+		if err != nil {
+			return nil, err
+		}
+		expanded[value] = true
+	}
+	return expanded, nil
+}
+
+// expandRequesterIdentityTemplate expands the variables in the given template.
+// An error is returned if the template uses a variable which is not set, so
+// that templates using the service account variables never match requests
+// from other users.
+func expandRequesterIdentityTemplate(template string, vars map[string]string) (string, error) {
+	var missing []string
+	expanded := os.Expand(template, func(name string) string {
+		value, ok := vars[name]
+		if !ok || len(value) == 0 {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("template %q uses variables which are not set for the requester: %s", template, strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
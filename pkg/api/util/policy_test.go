@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestIssuerPolicyDuration(t *testing.T) {
+	bounds := func(enforcement cmapi.DurationEnforcement) *cmapi.IssuerPolicy {
+		return &cmapi.IssuerPolicy{
+			MinDuration:         &metav1.Duration{Duration: time.Hour * 24},
+			MaxDuration:         &metav1.Duration{Duration: time.Hour * 24 * 30},
+			DurationEnforcement: enforcement,
+		}
+	}
+
+	tests := map[string]struct {
+		policy   *cmapi.IssuerPolicy
+		duration time.Duration
+		want     time.Duration
+		wantErr  bool
+	}{
+		"no policy allows any duration": {
+			duration: time.Minute,
+			want:     time.Minute,
+		},
+		"a duration within the bounds is allowed": {
+			policy:   bounds(""),
+			duration: time.Hour * 24 * 7,
+			want:     time.Hour * 24 * 7,
+		},
+		"a duration equal to the bounds is allowed": {
+			policy:   bounds(""),
+			duration: time.Hour * 24 * 30,
+			want:     time.Hour * 24 * 30,
+		},
+		"a duration shorter than the minimum is rejected by default": {
+			policy:   bounds(""),
+			duration: time.Hour,
+			want:     time.Hour,
+			wantErr:  true,
+		},
+		"a duration longer than the maximum is rejected": {
+			policy:   bounds(cmapi.RejectDurationEnforcement),
+			duration: time.Hour * 24 * 90,
+			want:     time.Hour * 24 * 90,
+			wantErr:  true,
+		},
+		"a duration shorter than the minimum is clamped": {
+			policy:   bounds(cmapi.ClampDurationEnforcement),
+			duration: time.Hour,
+			want:     time.Hour * 24,
+		},
+		"a duration longer than the maximum is clamped": {
+			policy:   bounds(cmapi.ClampDurationEnforcement),
+			duration: time.Hour * 24 * 90,
+			want:     time.Hour * 24 * 30,
+		},
+		"only a maximum duration is enforced if no minimum is set": {
+			policy: &cmapi.IssuerPolicy{
+				MaxDuration: &metav1.Duration{Duration: time.Hour * 24},
+			},
+			duration: time.Minute,
+			want:     time.Minute,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := IssuerPolicyDuration(test.policy, test.duration)
+			if (err != nil) != test.wantErr {
+				t.Errorf("unexpected error, wantErr=%t got=%v", test.wantErr, err)
+			}
+			if got != test.want {
+				t.Errorf("unexpected duration, want=%s got=%s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestIssuerPolicyAllowsKeyAlgorithm(t *testing.T) {
+	policy := &cmapi.IssuerPolicy{
+		AllowedPrivateKeyAlgorithms: []cmapi.PrivateKeyAlgorithm{cmapi.ECDSAKeyAlgorithm, cmapi.Ed25519KeyAlgorithm},
+	}
+
+	if !IssuerPolicyAllowsKeyAlgorithm(nil, cmapi.RSAKeyAlgorithm) {
+		t.Errorf("expected no policy to allow any key algorithm")
+	}
+	if !IssuerPolicyAllowsKeyAlgorithm(&cmapi.IssuerPolicy{}, cmapi.RSAKeyAlgorithm) {
+		t.Errorf("expected a policy without allowed key algorithms to allow any key algorithm")
+	}
+	if !IssuerPolicyAllowsKeyAlgorithm(policy, cmapi.ECDSAKeyAlgorithm) {
+		t.Errorf("expected ECDSA to be allowed")
+	}
+	if IssuerPolicyAllowsKeyAlgorithm(policy, cmapi.RSAKeyAlgorithm) {
+		t.Errorf("expected RSA not to be allowed")
+	}
+}
+
+func TestIssuerPolicyAllowsExtension(t *testing.T) {
+	policy := &cmapi.IssuerPolicy{
+		AllowedExtensions: []string{"1.3.6.1.4.1.311.20.2"},
+	}
+
+	if IssuerPolicyAllowsExtension(nil, "1.3.6.1.4.1.311.20.2") {
+		t.Errorf("expected no policy not to allow any extension")
+	}
+	if !IssuerPolicyAllowsExtension(policy, "1.3.6.1.4.1.311.20.2") {
+		t.Errorf("expected the listed extension to be allowed")
+	}
+	if IssuerPolicyAllowsExtension(policy, "1.3.6.1.4.1.311.21.7") {
+		t.Errorf("expected an unlisted extension not to be allowed")
+	}
+}
+
+func TestIssuerPolicyCheckRequesterIdentity(t *testing.T) {
+	policy := &cmapi.IssuerPolicy{
+		RequesterIdentity: &cmapi.RequesterIdentityPolicy{
+			CommonName: "${serviceaccount.name}",
+			DNSNames:   []string{"${serviceaccount.name}.${serviceaccount.namespace}.svc"},
+			URIs:       []string{"spiffe://cluster.local/ns/${namespace}/sa/${serviceaccount.name}"},
+		},
+	}
+
+	cr := func(username string) *cmapi.CertificateRequest {
+		return &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team"},
+			Spec:       cmapi.CertificateRequestSpec{Username: username},
+		}
+	}
+	csr := func(commonName string, dnsNames []string, uris ...string) *x509.CertificateRequest {
+		csr := &x509.CertificateRequest{
+			Subject:  pkix.Name{CommonName: commonName},
+			DNSNames: dnsNames,
+		}
+		for _, uri := range uris {
+			u, err := url.Parse(uri)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csr.URIs = append(csr.URIs, u)
+		}
+		return csr
+	}
+
+	tests := map[string]struct {
+		policy  *cmapi.IssuerPolicy
+		cr      *cmapi.CertificateRequest
+		csr     *x509.CertificateRequest
+		wantErr bool
+	}{
+		"no policy allows any subject": {
+			cr:  cr("alice"),
+			csr: csr("bob", nil),
+		},
+		"a subject matching the requester's service account is allowed": {
+			policy: policy,
+			cr:     cr("system:serviceaccount:team:app"),
+			csr:    csr("app", []string{"app.team.svc"}, "spiffe://cluster.local/ns/team/sa/app"),
+		},
+		"a subject without any SANs is allowed": {
+			policy: policy,
+			cr:     cr("system:serviceaccount:team:app"),
+			csr:    csr("app", nil),
+		},
+		"a common name not matching the requester is rejected": {
+			policy:  policy,
+			cr:      cr("system:serviceaccount:team:app"),
+			csr:     csr("other", nil),
+			wantErr: true,
+		},
+		"a DNS name not matching the requester is rejected": {
+			policy:  policy,
+			cr:      cr("system:serviceaccount:team:app"),
+			csr:     csr("app", []string{"other.team.svc"}),
+			wantErr: true,
+		},
+		"a URI not matching the requester is rejected": {
+			policy:  policy,
+			cr:      cr("system:serviceaccount:team:app"),
+			csr:     csr("app", nil, "spiffe://cluster.local/ns/team/sa/other"),
+			wantErr: true,
+		},
+		"a requester which is not a service account is rejected": {
+			policy:  policy,
+			cr:      cr("app"),
+			csr:     csr("app", nil),
+			wantErr: true,
+		},
+		"the username of a requester which is not a service account can be used": {
+			policy: &cmapi.IssuerPolicy{
+				RequesterIdentity: &cmapi.RequesterIdentityPolicy{CommonName: "${username}"},
+			},
+			cr:  cr("alice"),
+			csr: csr("alice", nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := IssuerPolicyCheckRequesterIdentity(test.policy, test.cr, test.csr)
+			if test.wantErr != (err != nil) {
+				t.Errorf("unexpected error, wantErr=%t got=%v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateRequesterIdentityTemplate(t *testing.T) {
+	if err := ValidateRequesterIdentityTemplate("spiffe://cluster.local/ns/${namespace}/sa/${serviceaccount.name}"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ValidateRequesterIdentityTemplate("${groups}"); err == nil {
+		t.Errorf("expected an unsupported variable to be rejected")
+	}
+}
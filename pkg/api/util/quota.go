@@ -0,0 +1,32 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// IssuanceQuotaCertificateRequestsPeriod returns the period over which
+// CertificateRequests are counted against the given IssuanceQuota.
+func IssuanceQuotaCertificateRequestsPeriod(quota *v1.IssuanceQuota) time.Duration {
+	if quota.Spec.CertificateRequestsPeriod == nil {
+		return v1.DefaultIssuanceQuotaCertificateRequestsPeriod
+	}
+	return quota.Spec.CertificateRequestsPeriod.Duration
+}
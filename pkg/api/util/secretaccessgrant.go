@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// CertificateSecretNamespace returns the namespace of the Secrets that the
+// given Certificate stores its private key and certificate in.
+func CertificateSecretNamespace(crt *v1.Certificate) string {
+	if crt.Spec.SecretNamespace == "" {
+		return crt.Namespace
+	}
+	return crt.Spec.SecretNamespace
+}
+
+// CertificateSecretNames returns the names of all Secrets that the given
+// Certificate stores its private key and certificate in, starting with
+// spec.secretName.
+func CertificateSecretNames(crt *v1.Certificate) []string {
+	return append([]string{crt.Spec.SecretName}, crt.Spec.AdditionalSecretNames...)
+}
+
+// SecretAccessGrantPermits returns true if the given SecretAccessGrant permits
+// the Certificate with the given namespace and name to store its data in all
+// of the named Secrets in the namespace of the grant.
+func SecretAccessGrantPermits(grant *v1.SecretAccessGrant, namespace, name string, secretNames []string) bool {
+	if !secretAccessGrantPermitsCertificate(grant, namespace, name) {
+		return false
+	}
+	if len(grant.Spec.SecretNames) == 0 {
+		return true
+	}
+	for _, secretName := range secretNames {
+		if !containsString(grant.Spec.SecretNames, secretName) {
+			return false
+		}
+	}
+	return true
+}
+
+func secretAccessGrantPermitsCertificate(grant *v1.SecretAccessGrant, namespace, name string) bool {
+	for _, from := range grant.Spec.From {
+		if from.Namespace != namespace {
+			continue
+		}
+		if len(from.CertificateNames) == 0 || containsString(from.CertificateNames, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestSecretAccessGrantPermits(t *testing.T) {
+	grant := &cmapi.SecretAccessGrant{
+		Spec: cmapi.SecretAccessGrantSpec{
+			From: []cmapi.SecretAccessGrantFrom{
+				{Namespace: "team-a"},
+				{Namespace: "team-b", CertificateNames: []string{"web"}},
+			},
+			SecretNames: []string{"web-tls", "web-tls-copy"},
+		},
+	}
+	tests := map[string]struct {
+		namespace, name string
+		secretNames     []string
+		want            bool
+	}{
+		"any Certificate in a permitted namespace": {
+			namespace: "team-a", name: "anything", secretNames: []string{"web-tls"}, want: true,
+		},
+		"named Certificate in a permitted namespace": {
+			namespace: "team-b", name: "web", secretNames: []string{"web-tls", "web-tls-copy"}, want: true,
+		},
+		"Certificate not listed for the namespace": {
+			namespace: "team-b", name: "api", secretNames: []string{"web-tls"}, want: false,
+		},
+		"namespace not permitted": {
+			namespace: "team-c", name: "web", secretNames: []string{"web-tls"}, want: false,
+		},
+		"one Secret not permitted": {
+			namespace: "team-a", name: "web", secretNames: []string{"web-tls", "other"}, want: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := SecretAccessGrantPermits(grant, test.namespace, test.name, test.secretNames); got != test.want {
+				t.Errorf("SecretAccessGrantPermits() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCertificateSecretNames(t *testing.T) {
+	crt := &cmapi.Certificate{
+		Spec: cmapi.CertificateSpec{
+			SecretName:            "web-tls",
+			AdditionalSecretNames: []string{"web-tls-copy", "web-tls-backup"},
+		},
+	}
+	want := []string{"web-tls", "web-tls-copy", "web-tls-backup"}
+	if got := CertificateSecretNames(crt); !reflect.DeepEqual(got, want) {
+		t.Errorf("CertificateSecretNames() = %v, want %v", got, want)
+	}
+	if len(crt.Spec.AdditionalSecretNames) != 2 {
+		t.Errorf("CertificateSecretNames() modified spec.additionalSecretNames: %v", crt.Spec.AdditionalSecretNames)
+	}
+}
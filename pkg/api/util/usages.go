@@ -52,6 +52,33 @@ var extKeyUsages = map[cmapi.KeyUsage]x509.ExtKeyUsage{
 	cmapi.UsageNetscapeSGC:     x509.ExtKeyUsageNetscapeServerGatedCrypto,
 }
 
+// profileUsages are the usages requested by each certificate profile.
+var profileUsages = map[cmapi.CertificateProfile][]cmapi.KeyUsage{
+	cmapi.ServerCertificateProfile:      {cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth},
+	cmapi.ClientCertificateProfile:      {cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageClientAuth},
+	cmapi.PeerCertificateProfile:        {cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth, cmapi.UsageClientAuth},
+	cmapi.CodeSigningCertificateProfile: {cmapi.UsageDigitalSignature, cmapi.UsageCodeSigning},
+}
+
+// ProfileUsages returns the usages requested by the given certificate
+// profile, or nil if the profile is not known.
+func ProfileUsages(profile cmapi.CertificateProfile) []cmapi.KeyUsage {
+	usages, ok := profileUsages[profile]
+	if !ok {
+		return nil
+	}
+	return append([]cmapi.KeyUsage(nil), usages...)
+}
+
+// CertificateSpecUsages returns the usages requested by the given Certificate
+// spec, which are the usages of its profile if one is set.
+func CertificateSpecUsages(spec *cmapi.CertificateSpec) []cmapi.KeyUsage {
+	if len(spec.Profile) > 0 {
+		return ProfileUsages(spec.Profile)
+	}
+	return spec.Usages
+}
+
 // KeyUsageType returns the relevant x509.KeyUsage or false if not found
 func KeyUsageType(usage cmapi.KeyUsage) (x509.KeyUsage, bool) {
 	u, ok := keyUsages[usage]
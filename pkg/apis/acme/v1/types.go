@@ -32,6 +32,13 @@ const (
 	// solver for each ingress class.
 	ACMECertificateHTTP01IngressClassOverride = "acme.cert-manager.io/http01-override-ingress-class"
 
+	// If this annotation is specified on a Certificate or Order resource, the
+	// challenges for all of its DNS names will be solved by the solver of the
+	// issuer with the given name, instead of the solver chosen by matching
+	// selectors. This is useful when selector based matching picks the wrong
+	// DNS01 provider, e.g. for a zone that is delegated elsewhere.
+	ACMECertificateSolverNameOverride = "acme.cert-manager.io/solver-name"
+
 	// IngressEditInPlaceAnnotation is used to toggle the use of ingressClass instead
 	// of ingress on the created Certificate resource
 	IngressEditInPlaceAnnotationKey = "acme.cert-manager.io/http01-edit-in-place"
@@ -47,6 +54,11 @@ const (
 	// SolverIdentificationLabelKey is added to the labels of a Pod serving an ACME challenge.
 	// Its value will be the "true" if the Pod is an HTTP-01 solver.
 	SolverIdentificationLabelKey = "acme.cert-manager.io/http01-solver"
+
+	// SharedSolverLabelKey is added to the labels of the resources making up a
+	// shared HTTP-01 solver. Its value will be the hash of the solver
+	// configuration that the shared solver serves.
+	SharedSolverLabelKey = "acme.cert-manager.io/http01-shared-solver"
 )
 
 const (
@@ -27,6 +27,10 @@ import (
 
 // Order is a type to represent an Order with an ACME server
 // +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Issuer",type="string",JSONPath=".spec.issuerRef.name",priority=1
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.reason",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
 type Order struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -122,6 +126,25 @@ type OrderStatus struct {
 	// This is used to influence garbage collection and back-off.
 	// +optional
 	FailureTime *metav1.Time `json:"failureTime,omitempty"`
+
+	// AuthorizationRetries is the number of times the order has been
+	// resubmitted to the ACME server after one of its authorizations failed.
+	// Authorizations which were already valid are reused by the ACME server,
+	// so that only the failed authorizations are attempted again.
+	// +optional
+	AuthorizationRetries int `json:"authorizationRetries,omitempty"`
+
+	// Problem is the problem document returned by the ACME server when the
+	// order was rejected or became invalid.
+	// +optional
+	Problem *ACMEProblem `json:"problem,omitempty"`
+
+	// FailedAuthorizations records the authorizations of this Order which
+	// failed, including those of ACME orders which were replaced when the
+	// Order was resubmitted after a failed authorization. Only the most
+	// recent failures are kept.
+	// +optional
+	FailedAuthorizations []ACMEAuthorizationFailure `json:"failedAuthorizations,omitempty"`
 }
 
 // ACMEAuthorization contains data returned from the ACME server on an
@@ -182,6 +205,62 @@ type ACMEChallenge struct {
 	Type string `json:"type"`
 }
 
+// ACMEProblem is a problem document (RFC 7807) returned by the ACME server,
+// either in response to a request or on an object which has become invalid.
+type ACMEProblem struct {
+	// Type is a URI reference identifying the type of the problem, usually
+	// of the form 'urn:ietf:params:acme:error:<type>'.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Detail is a human readable explanation of this occurrence of the
+	// problem.
+	// +optional
+	Detail string `json:"detail,omitempty"`
+
+	// Status is the HTTP status code of the response which carried the
+	// problem document, if any.
+	// +optional
+	Status int `json:"status,omitempty"`
+
+	// Instance is a URL identifying this occurrence of the problem. Some
+	// problem types use it to point at a page the user must visit before the
+	// request can succeed.
+	// +optional
+	Instance string `json:"instance,omitempty"`
+}
+
+// ACMEAuthorizationFailure records an authorization of an Order which could
+// not be completed.
+type ACMEAuthorizationFailure struct {
+	// URL is the URL of the challenge which was attempted to complete the
+	// authorization.
+	URL string `json:"url"`
+
+	// Identifier is the DNS name which failed to be validated.
+	Identifier string `json:"identifier"`
+
+	// Wildcard will be true if the authorization was for a wildcard DNS name.
+	// +optional
+	Wildcard bool `json:"wildcard,omitempty"`
+
+	// Type is the type of the challenge which was attempted, e.g. 'http-01'
+	// or 'dns-01'.
+	Type string `json:"type"`
+
+	// Reason is the reason recorded on the Challenge when it failed.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Problem is the problem document returned by the ACME server for the
+	// failed challenge, if any.
+	// +optional
+	Problem *ACMEProblem `json:"problem,omitempty"`
+
+	// Time is the time at which the failure was observed.
+	Time metav1.Time `json:"time"`
+}
+
 // State represents the state of an ACME resource, such as an Order.
 // The possible options here map to the corresponding values in the
 // ACME specification.
@@ -29,7 +29,8 @@ import (
 // +k8s:openapi-gen=true
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
 // +kubebuilder:printcolumn:name="Domain",type="string",JSONPath=".spec.dnsName"
-// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.reason",description="",priority=1
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.reason",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=challenges
@@ -142,4 +143,27 @@ type ChallengeStatus struct {
 	// If not set, the state of the challenge is unknown.
 	// +optional
 	State State `json:"state,omitempty"`
+
+	// Details of the acme-dns account used to present the challenge. Only
+	// set for challenges solved with the acme-dns DNS01 provider.
+	// +optional
+	AcmeDNS *ACMEDNSAccountStatus `json:"acmeDNS,omitempty"`
+
+	// Problem is the problem document returned by the ACME server when the
+	// challenge or its authorization failed.
+	// +optional
+	Problem *ACMEProblem `json:"problem,omitempty"`
+}
+
+// ACMEDNSAccountStatus describes the acme-dns account used to present a
+// challenge.
+type ACMEDNSAccountStatus struct {
+	// The full domain of the account. The _acme-challenge record of the
+	// domain being validated must be a CNAME pointing at it.
+	FullDomain string `json:"fullDomain"`
+
+	// True if the account was registered by cert-manager and stored in the
+	// account secret.
+	// +optional
+	Registered bool `json:"registered,omitempty"`
 }
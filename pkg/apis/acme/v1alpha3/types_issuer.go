@@ -63,6 +63,23 @@ type ACMEIssuer struct {
 	// +optional
 	SkipTLSVerify bool `json:"skipTLSVerify,omitempty"`
 
+	// CABundle is a PEM encoded bundle of CA certificates used to validate
+	// the TLS certificate of the ACME server, for private ACME servers whose
+	// certificate is not issued by a publicly trusted CA. If not set the
+	// system root certificates are used. Mutually exclusive with
+	// SkipTLSVerify.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// ClientCertificateSecretRef is a reference to a kubernetes.io/tls Secret
+	// containing a client certificate and private key to present to the ACME
+	// server, for private ACME servers which require mutual TLS.
+	// The Secret is read whenever a new connection is made to the ACME
+	// server, so renewed client certificates are used without changes to the
+	// issuer.
+	// +optional
+	ClientCertificateSecretRef *cmmeta.LocalObjectReference `json:"clientCertificateSecretRef,omitempty"`
+
 	// ExternalAccountBinding is a reference to a CA external account of the ACME
 	// server.
 	// If set, upon registration cert-manager will attempt to associate the given
@@ -138,6 +155,13 @@ const (
 // Configures an issuer to solve challenges using the specified options.
 // Only one of HTTP01 or DNS01 may be provided.
 type ACMEChallengeSolver struct {
+	// Name of the solver. It must be unique within the list of solvers of
+	// the issuer. A Certificate can pin this solver for all of its DNS names,
+	// bypassing selector based matching, by setting the
+	// `acme.cert-manager.io/solver-name` annotation to this name.
+	// +optional
+	Name string `json:"name,omitempty"`
+
 	// Selector selects a set of DNSNames on the Certificate resource that
 	// should be solved using this challenge solver.
 	// If not specified, the solver will be treated as the 'default' solver
@@ -243,6 +267,26 @@ type ACMEChallengeSolverHTTP01Ingress struct {
 	// ingress used for HTTP01 challenges
 	// +optional
 	IngressTemplate *ACMEChallengeSolverHTTP01IngressTemplate `json:"ingressTemplate,omitempty"`
+
+	// If set, challenges are solved by a long-lived solver Deployment which
+	// is shared by all Challenges in the namespace that use this solver
+	// configuration, rather than by a pod and service created for each
+	// Challenge. The keys of Challenges are registered with the shared
+	// solver through a ConfigMap. An Ingress is still created for each
+	// Challenge, unless 'name' is set.
+	// +optional
+	SharedSolver *ACMEChallengeSolverHTTP01SharedSolver `json:"sharedSolver,omitempty"`
+}
+
+// ACMEChallengeSolverHTTP01SharedSolver configures the long-lived challenge
+// solver Deployment shared by the Challenges which use an HTTP01 ingress
+// solver.
+type ACMEChallengeSolverHTTP01SharedSolver struct {
+	// Replicas is the number of pods of the shared solver Deployment.
+	// Defaults to 1. Leave unset if the Deployment is scaled by a
+	// HorizontalPodAutoscaler.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
 type ACMEChallengeSolverHTTP01GatewayHTTPRoute struct {
@@ -359,6 +403,22 @@ type ACMEChallengeSolverDNS01 struct {
 	// +optional
 	DigitalOcean *ACMEIssuerDNS01ProviderDigitalOcean `json:"digitalocean,omitempty"`
 
+	// Use the OVH API to manage DNS01 challenge records.
+	// +optional
+	OVH *ACMEIssuerDNS01ProviderOVH `json:"ovh,omitempty"`
+
+	// Use the Gandi LiveDNS API to manage DNS01 challenge records.
+	// +optional
+	Gandi *ACMEIssuerDNS01ProviderGandi `json:"gandi,omitempty"`
+
+	// Use the Infoblox WAPI to manage DNS01 challenge records.
+	// +optional
+	Infoblox *ACMEIssuerDNS01ProviderInfoblox `json:"infoblox,omitempty"`
+
+	// Use the BlueCat Address Manager API to manage DNS01 challenge records.
+	// +optional
+	BlueCat *ACMEIssuerDNS01ProviderBlueCat `json:"bluecat,omitempty"`
+
 	// Use the 'ACME DNS' (https://github.com/joohoi/acme-dns) API to manage
 	// DNS01 challenge records.
 	// +optional
@@ -391,6 +451,8 @@ const (
 	// This is useful if you do not want to grant cert-manager access to your
 	// root DNS zone, and instead delegate the _acme-challenge.example.com
 	// subdomain to some other, less privileged domain.
+	// The dnsZones selectors of solvers using this strategy are also matched
+	// against the name the CNAMEs point to.
 	FollowStrategy = "Follow"
 )
 
@@ -419,7 +481,8 @@ type ACMEIssuerDNS01ProviderCloudDNS struct {
 
 // ACMEIssuerDNS01ProviderCloudflare is a structure containing the DNS
 // configuration for Cloudflare.
-// One of `apiKeySecretRef` or `apiTokenSecretRef` must be provided.
+// One of `apiKeySecretRef`, `apiTokenSecretRef` or `zoneAPITokenSecretRefs`
+// must be provided.
 type ACMEIssuerDNS01ProviderCloudflare struct {
 	// Email of the account, only required when using API key based authentication.
 	// +optional
@@ -434,6 +497,13 @@ type ACMEIssuerDNS01ProviderCloudflare struct {
 	// API token used to authenticate with Cloudflare.
 	// +optional
 	APIToken *cmmeta.SecretKeySelector `json:"apiTokenSecretRef,omitempty"`
+
+	// API tokens used to authenticate with Cloudflare, keyed by the DNS zone
+	// they are scoped to. The token of the longest zone containing the
+	// challenge record is used, falling back to apiTokenSecretRef or
+	// apiKeySecretRef if no zone matches.
+	// +optional
+	ZoneAPITokens map[string]cmmeta.SecretKeySelector `json:"zoneAPITokenSecretRefs,omitempty"`
 }
 
 // ACMEIssuerDNS01ProviderDigitalOcean is a structure containing the DNS
@@ -442,6 +512,100 @@ type ACMEIssuerDNS01ProviderDigitalOcean struct {
 	Token cmmeta.SecretKeySelector `json:"tokenSecretRef"`
 }
 
+// ACMEIssuerDNS01ProviderOVH is a structure containing the DNS
+// configuration for OVH.
+type ACMEIssuerDNS01ProviderOVH struct {
+	// Endpoint of the OVH API. Either the name of an OVH API region
+	// (`ovh-eu`, `ovh-ca`, `ovh-us`, `kimsufi-eu`, `kimsufi-ca`,
+	// `soyoustart-eu` or `soyoustart-ca`) or the URL of the API.
+	// Defaults to `ovh-eu`.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Application key of the OVH API application.
+	ApplicationKey string `json:"applicationKey"`
+
+	// Application secret of the OVH API application.
+	ApplicationSecret cmmeta.SecretKeySelector `json:"applicationSecretSecretRef"`
+
+	// Consumer key authorizing the OVH API application to manage the DNS
+	// zones of the account.
+	ConsumerKey cmmeta.SecretKeySelector `json:"consumerKeySecretRef"`
+}
+
+// ACMEIssuerDNS01ProviderGandi is a structure containing the DNS
+// configuration for Gandi LiveDNS.
+type ACMEIssuerDNS01ProviderGandi struct {
+	// Personal access token used to authenticate with the Gandi API. The
+	// token must be allowed to manage the technical configuration of the
+	// domains.
+	PersonalAccessToken cmmeta.SecretKeySelector `json:"personalAccessTokenSecretRef"`
+}
+
+// ACMEIssuerDNS01ProviderInfoblox is a structure containing the DNS
+// configuration for the Infoblox WAPI.
+type ACMEIssuerDNS01ProviderInfoblox struct {
+	// URL of the Infoblox Grid Manager, for example `https://gm.example.com`.
+	Server string `json:"server"`
+
+	// Version of the WAPI. Defaults to `2.10`.
+	// +optional
+	WAPIVersion string `json:"wapiVersion,omitempty"`
+
+	// Username used to authenticate with the WAPI.
+	Username string `json:"username"`
+
+	// Password used to authenticate with the WAPI.
+	Password cmmeta.SecretKeySelector `json:"passwordSecretRef"`
+
+	// DNS view in which challenge records are created. Defaults to
+	// `default`.
+	// +optional
+	View string `json:"view,omitempty"`
+
+	// TTL of challenge records in seconds. Defaults to 60.
+	// +optional
+	TTL int32 `json:"ttl,omitempty"`
+
+	// PEM encoded CA bundle used to validate the certificate of the Grid
+	// Manager. If not set, the system trust store is used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderBlueCat is a structure containing the DNS
+// configuration for BlueCat Address Manager.
+type ACMEIssuerDNS01ProviderBlueCat struct {
+	// URL of BlueCat Address Manager, for example `https://bam.example.com`.
+	Server string `json:"server"`
+
+	// Username of the API user used to authenticate with Address Manager.
+	Username string `json:"username"`
+
+	// Password of the API user used to authenticate with Address Manager.
+	Password cmmeta.SecretKeySelector `json:"passwordSecretRef"`
+
+	// Name of the configuration containing the DNS view.
+	Configuration string `json:"configuration"`
+
+	// Name of the DNS view in which challenge records are created.
+	View string `json:"view"`
+
+	// Name of the zone in which challenge records are created. If not set,
+	// the deepest zone of the view containing the challenge record is used.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// TTL of challenge records in seconds. Defaults to 60.
+	// +optional
+	TTL int32 `json:"ttl,omitempty"`
+
+	// PEM encoded CA bundle used to validate the certificate of Address
+	// Manager. If not set, the system trust store is used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
 // ACMEIssuerDNS01ProviderRoute53 is a structure containing the Route 53
 // configuration for AWS
 type ACMEIssuerDNS01ProviderRoute53 struct {
@@ -528,6 +692,15 @@ type ACMEIssuerDNS01ProviderAcmeDNS struct {
 	Host string `json:"host"`
 
 	AccountSecret cmmeta.SecretKeySelector `json:"accountSecretRef"`
+
+	// If true, an account is registered with the acme-dns server for each
+	// domain that has no account in the account secret, and is stored back
+	// into the account secret. A CNAME record pointing the _acme-challenge
+	// record of the domain at the full domain of the account must be created
+	// for the challenge to succeed; it is reported in the status of the
+	// Challenge. The account secret must exist, but the key may be missing.
+	// +optional
+	AllowRegistration bool `json:"allowRegistration,omitempty"`
 }
 
 // ACMEIssuerDNS01ProviderRFC2136 is a structure containing the
@@ -594,4 +767,33 @@ type ACMEIssuerStatus struct {
 	// associated with the  Issuer
 	// +optional
 	LastRegisteredEmail string `json:"lastRegisteredEmail,omitempty"`
+
+	// Directory is the metadata published in the directory of the ACME
+	// server, as observed when the Issuer was last set up.
+	// +optional
+	Directory *ACMEDirectoryMetadata `json:"directory,omitempty"`
+}
+
+// ACMEDirectoryMetadata is the metadata published in the directory of an ACME
+// server, as defined in RFC 8555 section 7.1.1.
+type ACMEDirectoryMetadata struct {
+	// TermsOfService is the URL of the current terms of service of the ACME
+	// server.
+	// +optional
+	TermsOfService string `json:"termsOfService,omitempty"`
+
+	// Website is the URL of a website providing more information about the
+	// ACME server.
+	// +optional
+	Website string `json:"website,omitempty"`
+
+	// CAAIdentities are the hostnames that the ACME server recognises as
+	// referring to itself for the purposes of CAA record validation.
+	// +optional
+	CAAIdentities []string `json:"caaIdentities,omitempty"`
+
+	// ExternalAccountRequired indicates that the ACME server requires an
+	// External Account Binding to register new accounts.
+	// +optional
+	ExternalAccountRequired bool `json:"externalAccountRequired,omitempty"`
 }
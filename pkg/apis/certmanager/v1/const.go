@@ -30,6 +30,10 @@ const (
 
 	// Deprecated: the default is now 2/3 of Certificate's duration
 	DefaultRenewBefore = time.Hour * 24 * 30
+
+	// default number of consecutive failed issuances before failing over to
+	// the next issuer if Certificate.spec.issuerFailoverThreshold is not set
+	DefaultIssuerFailoverThreshold = 3
 )
 
 const (
@@ -51,6 +51,25 @@ const (
 	// Annotation key for the name of the certificate that a resource is related to.
 	CertificateNameKey = "cert-manager.io/certificate-name"
 
+	// Annotation key recording the comma-separated keys of the labels which
+	// were copied to a Secret from a Certificate's `spec.secretTemplate`.
+	// It allows labels removed from the template to be removed from the
+	// Secret without removing labels set by other tools.
+	SecretTemplateLabelsAnnotationKey = "cert-manager.io/secret-template-labels"
+
+	// Annotation key recording the comma-separated keys of the annotations
+	// which were copied to a Secret from a Certificate's
+	// `spec.secretTemplate`.
+	SecretTemplateAnnotationsAnnotationKey = "cert-manager.io/secret-template-annotations"
+
+	// Annotation key recording the comma-separated custom data keys which
+	// were written to a Secret from a Certificate's `spec.secretTemplate`.
+	SecretTemplateKeysAnnotationKey = "cert-manager.io/secret-template-keys"
+
+	// Label key for the name of the ClusterCertificate that a Secret has been
+	// copied from.
+	ClusterCertificateNameLabelKey = "cert-manager.io/cluster-certificate-name"
+
 	// Annotation key used to denote whether a Secret is named on a Certificate
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
@@ -67,6 +86,11 @@ const (
 	// acmeIssuerHTTP01IngressClassAnnotation can be used to override the http01 ingressClass
 	// if the challenge type is set to http01
 	IngressACMEIssuerHTTP01IngressClassAnnotationKey = "acme.cert-manager.io/http01-ingress-class"
+	// IngressCollapseWildcardHostsAnnotationKey can be set to "true" to
+	// replace the hosts of each TLS entry with wildcards covering them, so
+	// that "a.example.com" and "b.example.com" are requested as
+	// "example.com" and "*.example.com" on a single created Certificate.
+	IngressCollapseWildcardHostsAnnotationKey = "cert-manager.io/collapse-wildcard-hosts"
 
 	// IngressClassAnnotationKey picks a specific "class" for the Ingress. The
 	// controller only processes Ingresses with this annotation either unset, or
@@ -85,6 +109,17 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation added to the CertificateRequests of Certificates, listing
+	// the comma separated Certificate fields which were changed by CSR
+	// transformers, and so are expected to differ from the Certificate spec.
+	CertificateRequestTransformedFieldsAnnotationKey = "cert-manager.io/csr-transformed-fields"
+
+	// Annotation added to the CertificateRequests of the second certificate
+	// of Certificates which set `spec.dualCertificate`, and to the Secret of
+	// the Certificate once the second certificate is stored, denoting the
+	// revision of the Certificate it was issued for.
+	DualCertificateRevisionAnnotationKey = "cert-manager.io/dual-certificate-revision"
 )
 
 const (
@@ -94,6 +129,68 @@ const (
 	// stored in the target Secret resource whilst the real Issuer is processing
 	// the certificate request.
 	IssueTemporaryCertificateAnnotation = "cert-manager.io/issue-temporary-certificate"
+
+	// AllowSecretNameCollisionAnnotationKey is an annotation that can be added
+	// to Certificate resources.
+	// If it is set to "true", the webhook will not reject the Certificate if
+	// another Certificate already references one of the same Secrets, named by
+	// spec.secretName or spec.additionalSecretNames in spec.secretNamespace.
+	AllowSecretNameCollisionAnnotationKey = "cert-manager.io/allow-secret-name-collision"
+
+	// IssuanceDryRunAnnotationKey is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true", the certificates controller will not create a
+	// CertificateRequest for the Certificate and will instead record the
+	// request it would have created in `status.issuancePlan`.
+	IssuanceDryRunAnnotationKey = "cert-manager.io/issuance-dry-run"
+
+	// ACMESolverDryRunAnnotationKey is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true", the ACME challenge solvers that would be used to
+	// solve the challenges for the Certificate are recorded in
+	// `status.acmeSolvers`. It does not prevent the Certificate from being
+	// issued; use IssuanceDryRunAnnotationKey for that.
+	ACMESolverDryRunAnnotationKey = "acme.cert-manager.io/solver-dry-run"
+
+	// IssuancePausedAnnotationKey is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true", the certificates controllers will not trigger,
+	// request or complete an issuance for the Certificate until the annotation
+	// is removed or set to "false".
+	IssuancePausedAnnotationKey = "cert-manager.io/issuance-paused"
+
+	// ReissueOnCARotationAnnotationKey is an annotation that can be added to
+	// Certificate resources issued by a CA issuer.
+	// If it is set to "true", the Certificate is re-issued once the CA
+	// certificate of its issuer has been renewed and the new CA certificate
+	// has become valid, so that the Secret does not keep embedding the
+	// chain of the previous CA certificate.
+	ReissueOnCARotationAnnotationKey = "cert-manager.io/reissue-on-ca-rotation"
+
+	// IssuerChangeCanaryAnnotationKey is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true", a certificate issued by an issuer other than the
+	// one which issued the certificate currently stored in the Secret, e.g.
+	// after `spec.issuerRef` has been changed, is first stored in the canary
+	// Secret `<secretName>-next`. It is only promoted to the Secrets of the
+	// Certificate once the `CanaryVerified` condition of the Certificate has
+	// been set to True, either manually or by an automated probe.
+	IssuerChangeCanaryAnnotationKey = "cert-manager.io/issuer-change-canary"
+
+	// ServiceIPSANsAnnotationKey is an annotation that can be added to
+	// Certificate resources, or to ingress-like resources managed by
+	// ingress-shim, to name a Service in the same namespace.
+	// The ClusterIPs and LoadBalancer ingress IPs of the named Service will
+	// be kept in sync with the Certificate's `spec.ipAddresses`.
+	ServiceIPSANsAnnotationKey = "cert-manager.io/ip-sans-from-service"
+
+	// PrivateKeyKMSPluginAnnotationKey is an annotation that can be added to
+	// Certificate resources.
+	// If the SealedPrivateKeys feature is enabled, the private key of the
+	// Certificate is stored in its Secret encrypted with a data key which is
+	// in turn encrypted by the named KMS plugin, rather than in plain text.
+	// Pods which mount the Secret can run the unsealer to decrypt it.
+	PrivateKeyKMSPluginAnnotationKey = "cert-manager.io/private-key-kms-plugin"
 )
 
 // Common/known resource kinds.
@@ -102,6 +199,10 @@ const (
 	IssuerKind             = "Issuer"
 	CertificateKind        = "Certificate"
 	CertificateRequestKind = "CertificateRequest"
+	ClusterCertificateKind = "ClusterCertificate"
+	IssuanceQuotaKind      = "IssuanceQuota"
+	NotificationRouteKind  = "NotificationRoute"
+	SecretAccessGrantKind  = "SecretAccessGrant"
 )
 
 const (
@@ -141,11 +242,30 @@ const (
 	// Venafi Pickup ID of a certificate signing request that has been submitted
 	// to the Venafi API for collection later.
 	VenafiPickupIDAnnotationKey = "venafi.cert-manager.io/pickup-id"
+
+	// VenafiApplicationAnnotationKey is the annotation that selects the
+	// Venafi as a Service application a certificate is requested for,
+	// overriding the application in the zone of a Venafi Cloud issuer.
+	// It must be set together with VenafiIssuingTemplateAnnotationKey.
+	VenafiApplicationAnnotationKey = "venafi.cert-manager.io/application"
+
+	// VenafiIssuingTemplateAnnotationKey is the annotation that selects the
+	// alias of the Venafi as a Service issuing template a certificate is
+	// requested with, overriding the template in the zone of a Venafi Cloud
+	// issuer. It must be set together with VenafiApplicationAnnotationKey.
+	VenafiIssuingTemplateAnnotationKey = "venafi.cert-manager.io/issuing-template"
+
+	// CMPTransactionIDAnnotationKey is the annotation key used to record the
+	// CMP transaction ID of a certificate request which the CMP server has
+	// not issued a certificate for yet, to poll for the certificate later.
+	CMPTransactionIDAnnotationKey = "cmp.cert-manager.io/transaction-id"
 )
 
 // KeyUsage specifies valid usage contexts for keys.
 // See: https://tools.ietf.org/html/rfc5280#section-4.2.1.3
-//      https://tools.ietf.org/html/rfc5280#section-4.2.1.12
+//
+//	https://tools.ietf.org/html/rfc5280#section-4.2.1.12
+//
 // Valid KeyUsage values are as follows:
 // "signing",
 // "digital signature",
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+
+// An IssuanceQuota limits the number of Certificates in a namespace, and the
+// rate at which CertificateRequests may be created in it.
+//
+// Quotas are enforced by the webhook when Certificates and
+// CertificateRequests are created. If a namespace has more than one
+// IssuanceQuota, all of them are enforced.
+// +kubebuilder:printcolumn:name="Certificates",type="integer",JSONPath=".status.certificates"
+// +kubebuilder:printcolumn:name="Max Certificates",type="integer",JSONPath=".spec.maxCertificates"
+// +kubebuilder:printcolumn:name="Requests",type="integer",JSONPath=".status.certificateRequests"
+// +kubebuilder:printcolumn:name="Max Requests",type="integer",JSONPath=".spec.maxCertificateRequests"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+type IssuanceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Desired state of the IssuanceQuota resource.
+	Spec IssuanceQuotaSpec `json:"spec"`
+
+	// Status of the IssuanceQuota. This is set and managed automatically by
+	// the issuancequotas controller, if it is enabled.
+	// +optional
+	Status IssuanceQuotaStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IssuanceQuotaList is a list of IssuanceQuotas
+type IssuanceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []IssuanceQuota `json:"items"`
+}
+
+// IssuanceQuotaSpec defines the limits of an IssuanceQuota.
+type IssuanceQuotaSpec struct {
+	// MaxCertificates is the maximum number of Certificates that may exist in
+	// the namespace. If not set, the number of Certificates is not limited.
+	// +optional
+	MaxCertificates *int32 `json:"maxCertificates,omitempty"`
+
+	// MaxCertificateRequests is the maximum number of CertificateRequests that
+	// may be created in the namespace within `certificateRequestsPeriod`.
+	// If not set, the rate of CertificateRequests is not limited.
+	// +optional
+	MaxCertificateRequests *int32 `json:"maxCertificateRequests,omitempty"`
+
+	// CertificateRequestsPeriod is the period over which CertificateRequests
+	// are counted against `maxCertificateRequests`. Defaults to 1 hour.
+	// +optional
+	CertificateRequestsPeriod *metav1.Duration `json:"certificateRequestsPeriod,omitempty"`
+}
+
+// IssuanceQuotaStatus records how much of an IssuanceQuota is used.
+type IssuanceQuotaStatus struct {
+	// Certificates is the number of Certificates in the namespace.
+	// +optional
+	Certificates int32 `json:"certificates"`
+
+	// CertificateRequests is the number of CertificateRequests created in the
+	// namespace within the last `certificateRequestsPeriod`.
+	// +optional
+	CertificateRequests int32 `json:"certificateRequests"`
+
+	// LastUpdateTime is the time at which the usage was last counted.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// DefaultIssuanceQuotaCertificateRequestsPeriod is the period over which
+// CertificateRequests are counted if an IssuanceQuota does not specify one.
+const DefaultIssuanceQuotaCertificateRequestsPeriod = time.Hour
@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+
+// A NotificationRoute sends a webhook notification when a Certificate in its
+// namespace is close to expiry, repeatedly fails to be issued, or is revoked.
+//
+// Each notification is sent once per occurrence. Notifications are sent by
+// the notifications controller, if it is enabled.
+// +kubebuilder:printcolumn:name="Format",type="string",JSONPath=".spec.webhook.format"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+type NotificationRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Desired state of the NotificationRoute resource.
+	Spec NotificationRouteSpec `json:"spec"`
+
+	// Status of the NotificationRoute. This is set and managed automatically
+	// by the notifications controller, if it is enabled.
+	// +optional
+	Status NotificationRouteStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationRouteList is a list of NotificationRoutes
+type NotificationRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []NotificationRoute `json:"items"`
+}
+
+// NotificationRouteSpec defines which notifications are sent and where to.
+type NotificationRouteSpec struct {
+	// Selector selects the Certificates in the namespace that notifications
+	// are sent for. If not set, notifications are sent for all Certificates
+	// in the namespace.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Events is the list of events that notifications are sent for. If not
+	// set, notifications are sent for all events.
+	// +optional
+	Events []NotificationEvent `json:"events,omitempty"`
+
+	// ExpiryThresholds are the durations before the expiry of a certificate
+	// at which an `Expiring` notification is sent. Defaults to 7 days and
+	// 1 day.
+	// +optional
+	ExpiryThresholds []metav1.Duration `json:"expiryThresholds,omitempty"`
+
+	// IssuanceFailureThreshold is the number of consecutive failed issuances
+	// of a Certificate after which an `IssuanceFailed` notification is sent.
+	// Defaults to 3.
+	// +optional
+	IssuanceFailureThreshold *int32 `json:"issuanceFailureThreshold,omitempty"`
+
+	// Webhook configures the webhook that notifications are sent to.
+	Webhook NotificationWebhook `json:"webhook"`
+}
+
+// NotificationEvent is an event that a notification can be sent for.
+// +kubebuilder:validation:Enum=Expiring;IssuanceFailed;Revoked
+type NotificationEvent string
+
+const (
+	// NotificationEventExpiring is sent when a certificate crosses one of the
+	// expiry thresholds of a NotificationRoute.
+	NotificationEventExpiring NotificationEvent = "Expiring"
+
+	// NotificationEventIssuanceFailed is sent when the issuance of a
+	// Certificate fails the number of consecutive times configured by the
+	// NotificationRoute.
+	NotificationEventIssuanceFailed NotificationEvent = "IssuanceFailed"
+
+	// NotificationEventRevoked is sent when a certificate is found to be
+	// revoked by its OCSP responder.
+	NotificationEventRevoked NotificationEvent = "Revoked"
+)
+
+// NotificationWebhook configures the webhook that notifications are sent to
+// with an HTTP POST request.
+type NotificationWebhook struct {
+	// URL is the URL that notifications are posted to. Exactly one of `url`
+	// or `urlSecretRef` must be set, unless `format` is `PagerDuty`, in
+	// which case the PagerDuty Events API is used if neither is set.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecretRef references a key of a Secret in the namespace of the
+	// NotificationRoute containing the URL that notifications are posted
+	// to. Use this for URLs which contain credentials, such as Slack
+	// incoming webhook URLs.
+	// +optional
+	URLSecretRef *cmmeta.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// Format is the format of the payload that is posted, one of `JSON`,
+	// `Slack` or `PagerDuty`. Ignored if `template` is set. Defaults to
+	// `JSON`.
+	// +optional
+	Format NotificationFormat `json:"format,omitempty"`
+
+	// RoutingKeySecretRef references a key of a Secret in the namespace of
+	// the NotificationRoute containing the PagerDuty integration key
+	// notifications are routed with. Required if `format` is `PagerDuty`.
+	// +optional
+	RoutingKeySecretRef *cmmeta.SecretKeySelector `json:"routingKeySecretRef,omitempty"`
+
+	// Template is a Go template used to render the payload that is posted,
+	// overriding `format`. The template is executed with the fields of the
+	// notification: `.Event`, `.Namespace`, `.Certificate`, `.Route`,
+	// `.Message`, `.NotAfter`, `.Threshold`, `.FailedIssuanceAttempts`,
+	// `.Time` and, if `routingKeySecretRef` is set, `.RoutingKey`. The
+	// `json` function encodes a value as JSON.
+	// +optional
+	Template string `json:"template,omitempty"`
+}
+
+// NotificationFormat is the format of the payload of a notification.
+// +kubebuilder:validation:Enum=JSON;Slack;PagerDuty
+type NotificationFormat string
+
+const (
+	// NotificationFormatJSON posts the notification as a JSON object.
+	NotificationFormatJSON NotificationFormat = "JSON"
+
+	// NotificationFormatSlack posts the notification in the format of a
+	// Slack incoming webhook message.
+	NotificationFormatSlack NotificationFormat = "Slack"
+
+	// NotificationFormatPagerDuty posts the notification as a PagerDuty
+	// Events API v2 event.
+	NotificationFormatPagerDuty NotificationFormat = "PagerDuty"
+)
+
+// NotificationRouteStatus records the notifications that have been sent by a
+// NotificationRoute.
+type NotificationRouteStatus struct {
+	// Notifications are the notifications which have been sent and whose
+	// event is still ongoing. Each notification is only sent once while its
+	// event is ongoing.
+	// +optional
+	Notifications []SentNotification `json:"notifications,omitempty"`
+}
+
+// SentNotification records a notification that has been sent.
+type SentNotification struct {
+	// Certificate is the name of the Certificate the notification was sent
+	// for.
+	Certificate string `json:"certificate"`
+
+	// Event is the event the notification was sent for.
+	Event NotificationEvent `json:"event"`
+
+	// ID identifies the occurrence of the event the notification was sent
+	// for, such as the expiry threshold which was crossed.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Time is the time at which the notification was sent.
+	Time metav1.Time `json:"time"`
+}
+
+// DefaultNotificationExpiryThresholds are the expiry thresholds used if a
+// NotificationRoute does not specify any.
+var DefaultNotificationExpiryThresholds = []time.Duration{7 * 24 * time.Hour, 24 * time.Hour}
+
+// DefaultNotificationIssuanceFailureThreshold is the number of consecutive
+// failed issuances after which a notification is sent if a NotificationRoute
+// does not specify one.
+const DefaultNotificationIssuanceFailureThreshold = 3
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+
+// A SecretAccessGrant permits Certificates in other namespaces to store their
+// private key and certificate in Secrets in the namespace of the
+// SecretAccessGrant, by setting `spec.secretNamespace`.
+//
+// Grants are checked by the webhook when Certificates are created or updated,
+// and by the certificates-issuing controller before it writes to a Secret in
+// another namespace. If a namespace has more than one SecretAccessGrant, a
+// Certificate is permitted if any of them permits it.
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+type SecretAccessGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Desired state of the SecretAccessGrant resource.
+	Spec SecretAccessGrantSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecretAccessGrantList is a list of SecretAccessGrants
+type SecretAccessGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []SecretAccessGrant `json:"items"`
+}
+
+// SecretAccessGrantSpec defines which Certificates may store their data in
+// which Secrets of the namespace.
+type SecretAccessGrantSpec struct {
+	// From lists the Certificates which are permitted to target Secrets in
+	// this namespace.
+	From []SecretAccessGrantFrom `json:"from"`
+
+	// SecretNames limits the Secrets that permitted Certificates may target.
+	// If not set, any Secret in the namespace may be targeted.
+	// +optional
+	SecretNames []string `json:"secretNames,omitempty"`
+}
+
+// SecretAccessGrantFrom selects Certificates which are permitted by a
+// SecretAccessGrant.
+type SecretAccessGrantFrom struct {
+	// Namespace is the namespace of the permitted Certificates.
+	Namespace string `json:"namespace"`
+
+	// CertificateNames limits the permitted Certificates to those with the
+	// given names. If not set, all Certificates in the namespace are
+	// permitted.
+	// +optional
+	CertificateNames []string `json:"certificateNames,omitempty"`
+}
@@ -22,8 +22,8 @@ package v1
 
 import (
 	acmev1 "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
-	apismetav1 "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -40,6 +40,16 @@ func (in *CAIssuer) DeepCopyInto(out *CAIssuer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(CAIssuerKMS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PKCS11 != nil {
+		in, out := &in.PKCS11, &out.PKCS11
+		*out = new(CAIssuerPKCS11)
+		**out = **in
+	}
 	return
 }
 
@@ -54,130 +64,157 @@ func (in *CAIssuer) DeepCopy() *CAIssuer {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Certificate) DeepCopyInto(out *Certificate) {
+func (in *CAIssuerAWSKMS) DeepCopyInto(out *CAIssuerAWSKMS) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
-func (in *Certificate) DeepCopy() *Certificate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerAWSKMS.
+func (in *CAIssuerAWSKMS) DeepCopy() *CAIssuerAWSKMS {
 	if in == nil {
 		return nil
 	}
-	out := new(Certificate)
+	out := new(CAIssuerAWSKMS)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Certificate) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAIssuerGoogleCloudKMS) DeepCopyInto(out *CAIssuerGoogleCloudKMS) {
+	*out = *in
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
 	}
-	return nil
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerGoogleCloudKMS.
+func (in *CAIssuerGoogleCloudKMS) DeepCopy() *CAIssuerGoogleCloudKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(CAIssuerGoogleCloudKMS)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateCondition) DeepCopyInto(out *CertificateCondition) {
+func (in *CAIssuerKMS) DeepCopyInto(out *CAIssuerKMS) {
 	*out = *in
-	if in.LastTransitionTime != nil {
-		in, out := &in.LastTransitionTime, &out.LastTransitionTime
-		*out = (*in).DeepCopy()
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(CAIssuerAWSKMS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(CAIssuerGoogleCloudKMS)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateCondition.
-func (in *CertificateCondition) DeepCopy() *CertificateCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerKMS.
+func (in *CAIssuerKMS) DeepCopy() *CAIssuerKMS {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateCondition)
+	out := new(CAIssuerKMS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateKeystores) DeepCopyInto(out *CertificateKeystores) {
+func (in *CAIssuerPKCS11) DeepCopyInto(out *CAIssuerPKCS11) {
 	*out = *in
-	if in.JKS != nil {
-		in, out := &in.JKS, &out.JKS
-		*out = new(JKSKeystore)
-		**out = **in
-	}
-	if in.PKCS12 != nil {
-		in, out := &in.PKCS12, &out.PKCS12
-		*out = new(PKCS12Keystore)
-		**out = **in
-	}
+	out.PIN = in.PIN
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateKeystores.
-func (in *CertificateKeystores) DeepCopy() *CertificateKeystores {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerPKCS11.
+func (in *CAIssuerPKCS11) DeepCopy() *CAIssuerPKCS11 {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateKeystores)
+	out := new(CAIssuerPKCS11)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateList) DeepCopyInto(out *CertificateList) {
+func (in *CMPIssuer) DeepCopyInto(out *CMPIssuer) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Certificate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(CMPMACProtection)
+		**out = **in
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(CMPSignatureProtection)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateList.
-func (in *CertificateList) DeepCopy() *CertificateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CMPIssuer.
+func (in *CMPIssuer) DeepCopy() *CMPIssuer {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateList)
+	out := new(CMPIssuer)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CertificateList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CMPMACProtection) DeepCopyInto(out *CMPMACProtection) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CMPMACProtection.
+func (in *CMPMACProtection) DeepCopy() *CMPMACProtection {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CMPMACProtection)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatePrivateKey) DeepCopyInto(out *CertificatePrivateKey) {
+func (in *CMPSignatureProtection) DeepCopyInto(out *CMPSignatureProtection) {
 	*out = *in
+	out.SecretRef = in.SecretRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePrivateKey.
-func (in *CertificatePrivateKey) DeepCopy() *CertificatePrivateKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CMPSignatureProtection.
+func (in *CMPSignatureProtection) DeepCopy() *CMPSignatureProtection {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatePrivateKey)
+	out := new(CMPSignatureProtection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateRequest) DeepCopyInto(out *CertificateRequest) {
+func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -186,18 +223,18 @@ func (in *CertificateRequest) DeepCopyInto(out *CertificateRequest) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequest.
-func (in *CertificateRequest) DeepCopy() *CertificateRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
+func (in *Certificate) DeepCopy() *Certificate {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateRequest)
+	out := new(Certificate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CertificateRequest) DeepCopyObject() runtime.Object {
+func (in *Certificate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -205,194 +242,161 @@ func (in *CertificateRequest) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateRequestCondition) DeepCopyInto(out *CertificateRequestCondition) {
+func (in *CertificateACMESolverStatus) DeepCopyInto(out *CertificateACMESolverStatus) {
 	*out = *in
-	if in.LastTransitionTime != nil {
-		in, out := &in.LastTransitionTime, &out.LastTransitionTime
-		*out = (*in).DeepCopy()
+	if in.SolverIndex != nil {
+		in, out := &in.SolverIndex, &out.SolverIndex
+		*out = new(int)
+		**out = **in
+	}
+	if in.MatchedSelectors != nil {
+		in, out := &in.MatchedSelectors, &out.MatchedSelectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestCondition.
-func (in *CertificateRequestCondition) DeepCopy() *CertificateRequestCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateACMESolverStatus.
+func (in *CertificateACMESolverStatus) DeepCopy() *CertificateACMESolverStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateRequestCondition)
+	out := new(CertificateACMESolverStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateRequestList) DeepCopyInto(out *CertificateRequestList) {
+func (in *CertificateAdditionalOutputFormat) DeepCopyInto(out *CertificateAdditionalOutputFormat) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]CertificateRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestList.
-func (in *CertificateRequestList) DeepCopy() *CertificateRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAdditionalOutputFormat.
+func (in *CertificateAdditionalOutputFormat) DeepCopy() *CertificateAdditionalOutputFormat {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateRequestList)
+	out := new(CertificateAdditionalOutputFormat)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CertificateRequestList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateCAChain) DeepCopyInto(out *CertificateCAChain) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateCAChain.
+func (in *CertificateCAChain) DeepCopy() *CertificateCAChain {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CertificateCAChain)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateRequestSpec) DeepCopyInto(out *CertificateRequestSpec) {
+func (in *CertificateCondition) DeepCopyInto(out *CertificateCondition) {
 	*out = *in
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	out.IssuerRef = in.IssuerRef
-	if in.Request != nil {
-		in, out := &in.Request, &out.Request
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
-	if in.Usages != nil {
-		in, out := &in.Usages, &out.Usages
-		*out = make([]KeyUsage, len(*in))
-		copy(*out, *in)
-	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Extra != nil {
-		in, out := &in.Extra, &out.Extra
-		*out = make(map[string][]string, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestSpec.
-func (in *CertificateRequestSpec) DeepCopy() *CertificateRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateCondition.
+func (in *CertificateCondition) DeepCopy() *CertificateCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateRequestSpec)
+	out := new(CertificateCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateRequestStatus) DeepCopyInto(out *CertificateRequestStatus) {
+func (in *CertificateDualCertificate) DeepCopyInto(out *CertificateDualCertificate) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]CertificateRequestCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Certificate != nil {
-		in, out := &in.Certificate, &out.Certificate
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
-	if in.CA != nil {
-		in, out := &in.CA, &out.CA
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
-	if in.FailureTime != nil {
-		in, out := &in.FailureTime, &out.FailureTime
-		*out = (*in).DeepCopy()
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestStatus.
-func (in *CertificateRequestStatus) DeepCopy() *CertificateRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateDualCertificate.
+func (in *CertificateDualCertificate) DeepCopy() *CertificateDualCertificate {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateRequestStatus)
+	out := new(CertificateDualCertificate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateSecretTemplate) DeepCopyInto(out *CertificateSecretTemplate) {
+func (in *CertificateExternalPrivateKey) DeepCopyInto(out *CertificateExternalPrivateKey) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSecretTemplate.
-func (in *CertificateSecretTemplate) DeepCopy() *CertificateSecretTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalPrivateKey.
+func (in *CertificateExternalPrivateKey) DeepCopy() *CertificateExternalPrivateKey {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateSecretTemplate)
+	out := new(CertificateExternalPrivateKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
+func (in *CertificateIssuanceAttempt) DeepCopyInto(out *CertificateIssuanceAttempt) {
 	*out = *in
-	if in.Subject != nil {
-		in, out := &in.Subject, &out.Subject
-		*out = new(X509Subject)
-		(*in).DeepCopyInto(*out)
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuanceAttempt.
+func (in *CertificateIssuanceAttempt) DeepCopy() *CertificateIssuanceAttempt {
+	if in == nil {
+		return nil
 	}
+	out := new(CertificateIssuanceAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuanceDetails) DeepCopyInto(out *CertificateIssuanceDetails) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
-		*out = new(metav1.Duration)
+		*out = new(apismetav1.Duration)
 		**out = **in
 	}
-	if in.RenewBefore != nil {
-		in, out := &in.RenewBefore, &out.RenewBefore
-		*out = new(metav1.Duration)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuanceDetails.
+func (in *CertificateIssuanceDetails) DeepCopy() *CertificateIssuanceDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuanceDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuancePlan) DeepCopyInto(out *CertificateIssuancePlan) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
 	}
 	if in.DNSNames != nil {
 		in, out := &in.DNSNames, &out.DNSNames
@@ -414,101 +418,1148 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.SecretTemplate != nil {
-		in, out := &in.SecretTemplate, &out.SecretTemplate
-		*out = new(CertificateSecretTemplate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Keystores != nil {
-		in, out := &in.Keystores, &out.Keystores
-		*out = new(CertificateKeystores)
-		(*in).DeepCopyInto(*out)
-	}
-	out.IssuerRef = in.IssuerRef
 	if in.Usages != nil {
 		in, out := &in.Usages, &out.Usages
 		*out = make([]KeyUsage, len(*in))
 		copy(*out, *in)
 	}
-	if in.PrivateKey != nil {
-		in, out := &in.PrivateKey, &out.PrivateKey
-		*out = new(CertificatePrivateKey)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(apismetav1.Duration)
 		**out = **in
 	}
-	if in.EncodeUsagesInRequest != nil {
-		in, out := &in.EncodeUsagesInRequest, &out.EncodeUsagesInRequest
-		*out = new(bool)
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]X509Extension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.IssuerRef = in.IssuerRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuancePlan.
+func (in *CertificateIssuancePlan) DeepCopy() *CertificateIssuancePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuancePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateKeystores) DeepCopyInto(out *CertificateKeystores) {
+	*out = *in
+	if in.JKS != nil {
+		in, out := &in.JKS, &out.JKS
+		*out = new(JKSKeystore)
 		**out = **in
 	}
-	if in.RevisionHistoryLimit != nil {
-		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
-		*out = new(int32)
+	if in.PKCS12 != nil {
+		in, out := &in.PKCS12, &out.PKCS12
+		*out = new(PKCS12Keystore)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSpec.
-func (in *CertificateSpec) DeepCopy() *CertificateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateKeystores.
+func (in *CertificateKeystores) DeepCopy() *CertificateKeystores {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateSpec)
+	out := new(CertificateKeystores)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+func (in *CertificateList) DeepCopyInto(out *CertificateList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]CertificateCondition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Certificate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.LastFailureTime != nil {
-		in, out := &in.LastFailureTime, &out.LastFailureTime
-		*out = (*in).DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateList.
+func (in *CertificateList) DeepCopy() *CertificateList {
+	if in == nil {
+		return nil
 	}
-	if in.NotBefore != nil {
-		in, out := &in.NotBefore, &out.NotBefore
-		*out = (*in).DeepCopy()
+	out := new(CertificateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.NotAfter != nil {
-		in, out := &in.NotAfter, &out.NotAfter
-		*out = (*in).DeepCopy()
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatePrivateKey) DeepCopyInto(out *CertificatePrivateKey) {
+	*out = *in
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(CertificateExternalPrivateKey)
+		**out = **in
 	}
-	if in.RenewalTime != nil {
-		in, out := &in.RenewalTime, &out.RenewalTime
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePrivateKey.
+func (in *CertificatePrivateKey) DeepCopy() *CertificatePrivateKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatePrivateKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRenewalWindow) DeepCopyInto(out *CertificateRenewalWindow) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]CertificateRenewalWindowRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRenewalWindow.
+func (in *CertificateRenewalWindow) DeepCopy() *CertificateRenewalWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRenewalWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRenewalWindowRange) DeepCopyInto(out *CertificateRenewalWindowRange) {
+	*out = *in
+	if in.Weekdays != nil {
+		in, out := &in.Weekdays, &out.Weekdays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRenewalWindowRange.
+func (in *CertificateRenewalWindowRange) DeepCopy() *CertificateRenewalWindowRange {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRenewalWindowRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequest) DeepCopyInto(out *CertificateRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequest.
+func (in *CertificateRequest) DeepCopy() *CertificateRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestCondition) DeepCopyInto(out *CertificateRequestCondition) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
 		*out = (*in).DeepCopy()
 	}
-	if in.Revision != nil {
-		in, out := &in.Revision, &out.Revision
-		*out = new(int)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestCondition.
+func (in *CertificateRequestCondition) DeepCopy() *CertificateRequestCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestList) DeepCopyInto(out *CertificateRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertificateRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestList.
+func (in *CertificateRequestList) DeepCopy() *CertificateRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestSpec) DeepCopyInto(out *CertificateRequestSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.Backdate != nil {
+		in, out := &in.Backdate, &out.Backdate
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	out.IssuerRef = in.IssuerRef
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]X509Extension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestSpec.
+func (in *CertificateRequestSpec) DeepCopy() *CertificateRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestStatus) DeepCopyInto(out *CertificateRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CertificateRequestCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureTime != nil {
+		in, out := &in.FailureTime, &out.FailureTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestStatus.
+func (in *CertificateRequestStatus) DeepCopy() *CertificateRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateSecretTemplate) DeepCopyInto(out *CertificateSecretTemplate) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSecretTemplate.
+func (in *CertificateSecretTemplate) DeepCopy() *CertificateSecretTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateSecretTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
+	*out = *in
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(X509Subject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.RenewalWindow != nil {
+		in, out := &in.RenewalWindow, &out.RenewalWindow
+		*out = new(CertificateRenewalWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IssuanceDeadline != nil {
+		in, out := &in.IssuanceDeadline, &out.IssuanceDeadline
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.RenewRequestTime != nil {
+		in, out := &in.RenewRequestTime, &out.RenewRequestTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URIs != nil {
+		in, out := &in.URIs, &out.URIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmailAddresses != nil {
+		in, out := &in.EmailAddresses, &out.EmailAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalSecretNames != nil {
+		in, out := &in.AdditionalSecretNames, &out.AdditionalSecretNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(CertificateSecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Keystores != nil {
+		in, out := &in.Keystores, &out.Keystores
+		*out = new(CertificateKeystores)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalOutputFormats != nil {
+		in, out := &in.AdditionalOutputFormats, &out.AdditionalOutputFormats
+		*out = make([]CertificateAdditionalOutputFormat, len(*in))
+		copy(*out, *in)
+	}
+	if in.CAChain != nil {
+		in, out := &in.CAChain, &out.CAChain
+		*out = new(CertificateCAChain)
+		**out = **in
+	}
+	if in.TrustStore != nil {
+		in, out := &in.TrustStore, &out.TrustStore
+		*out = new(CertificateTrustStore)
+		**out = **in
+	}
+	if in.DualCertificate != nil {
+		in, out := &in.DualCertificate, &out.DualCertificate
+		*out = new(CertificateDualCertificate)
+		**out = **in
+	}
+	out.IssuerRef = in.IssuerRef
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]metav1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.IssuerFailoverThreshold != nil {
+		in, out := &in.IssuerFailoverThreshold, &out.IssuerFailoverThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(CertificatePrivateKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EncodeUsagesInRequest != nil {
+		in, out := &in.EncodeUsagesInRequest, &out.EncodeUsagesInRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSpec.
+func (in *CertificateSpec) DeepCopy() *CertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CertificateCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FailedIssuanceAttempts != nil {
+		in, out := &in.FailedIssuanceAttempts, &out.FailedIssuanceAttempts
+		*out = new(int)
+		**out = **in
+	}
+	if in.LastRenewRequestTime != nil {
+		in, out := &in.LastRenewRequestTime, &out.LastRenewRequestTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(int)
+		**out = **in
+	}
+	if in.LastIssuanceDetails != nil {
+		in, out := &in.LastIssuanceDetails, &out.LastIssuanceDetails
+		*out = new(CertificateIssuanceDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IssuanceHistory != nil {
+		in, out := &in.IssuanceHistory, &out.IssuanceHistory
+		*out = make([]CertificateIssuanceAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextPrivateKeySecretName != nil {
+		in, out := &in.NextPrivateKeySecretName, &out.NextPrivateKeySecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.IssuancePlan != nil {
+		in, out := &in.IssuancePlan, &out.IssuancePlan
+		*out = new(CertificateIssuancePlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ACMESolvers != nil {
+		in, out := &in.ACMESolvers, &out.ACMESolvers
+		*out = make([]CertificateACMESolverStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateTrustStore) DeepCopyInto(out *CertificateTrustStore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateTrustStore.
+func (in *CertificateTrustStore) DeepCopy() *CertificateTrustStore {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateTrustStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCertificate) DeepCopyInto(out *ClusterCertificate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCertificate.
+func (in *ClusterCertificate) DeepCopy() *ClusterCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCertificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCertificateList) DeepCopyInto(out *ClusterCertificateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCertificateList.
+func (in *ClusterCertificateList) DeepCopy() *ClusterCertificateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCertificateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCertificateSpec) DeepCopyInto(out *ClusterCertificateSpec) {
+	*out = *in
+	if in.SecretNamespaces != nil {
+		in, out := &in.SecretNamespaces, &out.SecretNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCertificateSpec.
+func (in *ClusterCertificateSpec) DeepCopy() *ClusterCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIssuer) DeepCopyInto(out *ClusterIssuer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIssuer.
+func (in *ClusterIssuer) DeepCopy() *ClusterIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterIssuer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIssuerList) DeepCopyInto(out *ClusterIssuerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterIssuer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIssuerList.
+func (in *ClusterIssuerList) DeepCopy() *ClusterIssuerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIssuerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterIssuerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuanceQuota) DeepCopyInto(out *IssuanceQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuanceQuota.
+func (in *IssuanceQuota) DeepCopy() *IssuanceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuanceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssuanceQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuanceQuotaList) DeepCopyInto(out *IssuanceQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IssuanceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuanceQuotaList.
+func (in *IssuanceQuotaList) DeepCopy() *IssuanceQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuanceQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssuanceQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuanceQuotaSpec) DeepCopyInto(out *IssuanceQuotaSpec) {
+	*out = *in
+	if in.MaxCertificates != nil {
+		in, out := &in.MaxCertificates, &out.MaxCertificates
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCertificateRequests != nil {
+		in, out := &in.MaxCertificateRequests, &out.MaxCertificateRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CertificateRequestsPeriod != nil {
+		in, out := &in.CertificateRequestsPeriod, &out.CertificateRequestsPeriod
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuanceQuotaSpec.
+func (in *IssuanceQuotaSpec) DeepCopy() *IssuanceQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuanceQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuanceQuotaStatus) DeepCopyInto(out *IssuanceQuotaStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuanceQuotaStatus.
+func (in *IssuanceQuotaStatus) DeepCopy() *IssuanceQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuanceQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Issuer) DeepCopyInto(out *Issuer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Issuer.
+func (in *Issuer) DeepCopy() *Issuer {
+	if in == nil {
+		return nil
+	}
+	out := new(Issuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Issuer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerCertificateDefaults) DeepCopyInto(out *IssuerCertificateDefaults) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerCertificateDefaults.
+func (in *IssuerCertificateDefaults) DeepCopy() *IssuerCertificateDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerCertificateDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerCondition) DeepCopyInto(out *IssuerCondition) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerCondition.
+func (in *IssuerCondition) DeepCopy() *IssuerCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerConfig) DeepCopyInto(out *IssuerConfig) {
+	*out = *in
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(acmev1.ACMEIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(CAIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelfSigned != nil {
+		in, out := &in.SelfSigned, &out.SelfSigned
+		*out = new(SelfSignedIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Venafi != nil {
+		in, out := &in.Venafi, &out.Venafi
+		*out = new(VenafiIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SCEP != nil {
+		in, out := &in.SCEP, &out.SCEP
+		*out = new(SCEPIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CMP != nil {
+		in, out := &in.CMP, &out.CMP
+		*out = new(CMPIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerConfig.
+func (in *IssuerConfig) DeepCopy() *IssuerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerList) DeepCopyInto(out *IssuerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Issuer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerList.
+func (in *IssuerList) DeepCopy() *IssuerList {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssuerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerPolicy) DeepCopyInto(out *IssuerPolicy) {
+	*out = *in
+	if in.MinDuration != nil {
+		in, out := &in.MinDuration, &out.MinDuration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.AllowedPrivateKeyAlgorithms != nil {
+		in, out := &in.AllowedPrivateKeyAlgorithms, &out.AllowedPrivateKeyAlgorithms
+		*out = make([]PrivateKeyAlgorithm, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequesterIdentity != nil {
+		in, out := &in.RequesterIdentity, &out.RequesterIdentity
+		*out = new(RequesterIdentityPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedExtensions != nil {
+		in, out := &in.AllowedExtensions, &out.AllowedExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerPolicy.
+func (in *IssuerPolicy) DeepCopy() *IssuerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerSpec) DeepCopyInto(out *IssuerSpec) {
+	*out = *in
+	in.IssuerConfig.DeepCopyInto(&out.IssuerConfig)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(IssuerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateDefaults != nil {
+		in, out := &in.CertificateDefaults, &out.CertificateDefaults
+		*out = new(IssuerCertificateDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerSpec.
+func (in *IssuerSpec) DeepCopy() *IssuerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerStatus) DeepCopyInto(out *IssuerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]IssuerCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(acmev1.ACMEIssuerStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.NextPrivateKeySecretName != nil {
-		in, out := &in.NextPrivateKeySecretName, &out.NextPrivateKeySecretName
-		*out = new(string)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerStatus.
+func (in *IssuerStatus) DeepCopy() *IssuerStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(IssuerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JKSKeystore) DeepCopyInto(out *JKSKeystore) {
+	*out = *in
+	out.PasswordSecretRef = in.PasswordSecretRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
-func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JKSKeystore.
+func (in *JKSKeystore) DeepCopy() *JKSKeystore {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateStatus)
+	out := new(JKSKeystore)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterIssuer) DeepCopyInto(out *ClusterIssuer) {
+func (in *NotificationRoute) DeepCopyInto(out *NotificationRoute) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -517,18 +1568,18 @@ func (in *ClusterIssuer) DeepCopyInto(out *ClusterIssuer) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIssuer.
-func (in *ClusterIssuer) DeepCopy() *ClusterIssuer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRoute.
+func (in *NotificationRoute) DeepCopy() *NotificationRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterIssuer)
+	out := new(NotificationRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterIssuer) DeepCopyObject() runtime.Object {
+func (in *NotificationRoute) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -536,13 +1587,13 @@ func (in *ClusterIssuer) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterIssuerList) DeepCopyInto(out *ClusterIssuerList) {
+func (in *NotificationRouteList) DeepCopyInto(out *NotificationRouteList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterIssuer, len(*in))
+		*out = make([]NotificationRoute, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -550,18 +1601,18 @@ func (in *ClusterIssuerList) DeepCopyInto(out *ClusterIssuerList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIssuerList.
-func (in *ClusterIssuerList) DeepCopy() *ClusterIssuerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRouteList.
+func (in *NotificationRouteList) DeepCopy() *NotificationRouteList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterIssuerList)
+	out := new(NotificationRouteList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterIssuerList) DeepCopyObject() runtime.Object {
+func (in *NotificationRouteList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -569,121 +1620,181 @@ func (in *ClusterIssuerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Issuer) DeepCopyInto(out *Issuer) {
+func (in *NotificationRouteSpec) DeepCopyInto(out *NotificationRouteSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(apismetav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiryThresholds != nil {
+		in, out := &in.ExpiryThresholds, &out.ExpiryThresholds
+		*out = make([]apismetav1.Duration, len(*in))
+		copy(*out, *in)
+	}
+	if in.IssuanceFailureThreshold != nil {
+		in, out := &in.IssuanceFailureThreshold, &out.IssuanceFailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	in.Webhook.DeepCopyInto(&out.Webhook)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Issuer.
-func (in *Issuer) DeepCopy() *Issuer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRouteSpec.
+func (in *NotificationRouteSpec) DeepCopy() *NotificationRouteSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Issuer)
+	out := new(NotificationRouteSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Issuer) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRouteStatus) DeepCopyInto(out *NotificationRouteStatus) {
+	*out = *in
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]SentNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	return nil
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRouteStatus.
+func (in *NotificationRouteStatus) DeepCopy() *NotificationRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRouteStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IssuerCondition) DeepCopyInto(out *IssuerCondition) {
+func (in *NotificationWebhook) DeepCopyInto(out *NotificationWebhook) {
 	*out = *in
-	if in.LastTransitionTime != nil {
-		in, out := &in.LastTransitionTime, &out.LastTransitionTime
-		*out = (*in).DeepCopy()
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
+	if in.RoutingKeySecretRef != nil {
+		in, out := &in.RoutingKeySecretRef, &out.RoutingKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerCondition.
-func (in *IssuerCondition) DeepCopy() *IssuerCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationWebhook.
+func (in *NotificationWebhook) DeepCopy() *NotificationWebhook {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerCondition)
+	out := new(NotificationWebhook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IssuerConfig) DeepCopyInto(out *IssuerConfig) {
+func (in *PKCS12Keystore) DeepCopyInto(out *PKCS12Keystore) {
 	*out = *in
-	if in.ACME != nil {
-		in, out := &in.ACME, &out.ACME
-		*out = new(acmev1.ACMEIssuer)
-		(*in).DeepCopyInto(*out)
+	out.PasswordSecretRef = in.PasswordSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKCS12Keystore.
+func (in *PKCS12Keystore) DeepCopy() *PKCS12Keystore {
+	if in == nil {
+		return nil
 	}
-	if in.CA != nil {
-		in, out := &in.CA, &out.CA
-		*out = new(CAIssuer)
-		(*in).DeepCopyInto(*out)
+	out := new(PKCS12Keystore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequesterIdentityPolicy) DeepCopyInto(out *RequesterIdentityPolicy) {
+	*out = *in
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Vault != nil {
-		in, out := &in.Vault, &out.Vault
-		*out = new(VaultIssuer)
-		(*in).DeepCopyInto(*out)
+	if in.URIs != nil {
+		in, out := &in.URIs, &out.URIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.SelfSigned != nil {
-		in, out := &in.SelfSigned, &out.SelfSigned
-		*out = new(SelfSignedIssuer)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequesterIdentityPolicy.
+func (in *RequesterIdentityPolicy) DeepCopy() *RequesterIdentityPolicy {
+	if in == nil {
+		return nil
 	}
-	if in.Venafi != nil {
-		in, out := &in.Venafi, &out.Venafi
-		*out = new(VenafiIssuer)
-		(*in).DeepCopyInto(*out)
+	out := new(RequesterIdentityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCEPIssuer) DeepCopyInto(out *SCEPIssuer) {
+	*out = *in
+	if in.ChallengePasswordSecretRef != nil {
+		in, out := &in.ChallengePasswordSecretRef, &out.ChallengePasswordSecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerConfig.
-func (in *IssuerConfig) DeepCopy() *IssuerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCEPIssuer.
+func (in *SCEPIssuer) DeepCopy() *SCEPIssuer {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerConfig)
+	out := new(SCEPIssuer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IssuerList) DeepCopyInto(out *IssuerList) {
+func (in *SecretAccessGrant) DeepCopyInto(out *SecretAccessGrant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Issuer, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerList.
-func (in *IssuerList) DeepCopy() *IssuerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretAccessGrant.
+func (in *SecretAccessGrant) DeepCopy() *SecretAccessGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerList)
+	out := new(SecretAccessGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IssuerList) DeepCopyObject() runtime.Object {
+func (in *SecretAccessGrant) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -691,80 +1802,114 @@ func (in *IssuerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IssuerSpec) DeepCopyInto(out *IssuerSpec) {
+func (in *SecretAccessGrantFrom) DeepCopyInto(out *SecretAccessGrantFrom) {
 	*out = *in
-	in.IssuerConfig.DeepCopyInto(&out.IssuerConfig)
+	if in.CertificateNames != nil {
+		in, out := &in.CertificateNames, &out.CertificateNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerSpec.
-func (in *IssuerSpec) DeepCopy() *IssuerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretAccessGrantFrom.
+func (in *SecretAccessGrantFrom) DeepCopy() *SecretAccessGrantFrom {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerSpec)
+	out := new(SecretAccessGrantFrom)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IssuerStatus) DeepCopyInto(out *IssuerStatus) {
+func (in *SecretAccessGrantList) DeepCopyInto(out *SecretAccessGrantList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]IssuerCondition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretAccessGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ACME != nil {
-		in, out := &in.ACME, &out.ACME
-		*out = new(acmev1.ACMEIssuerStatus)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerStatus.
-func (in *IssuerStatus) DeepCopy() *IssuerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretAccessGrantList.
+func (in *SecretAccessGrantList) DeepCopy() *SecretAccessGrantList {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerStatus)
+	out := new(SecretAccessGrantList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretAccessGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JKSKeystore) DeepCopyInto(out *JKSKeystore) {
+func (in *SecretAccessGrantSpec) DeepCopyInto(out *SecretAccessGrantSpec) {
 	*out = *in
-	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]SecretAccessGrantFrom, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecretNames != nil {
+		in, out := &in.SecretNames, &out.SecretNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JKSKeystore.
-func (in *JKSKeystore) DeepCopy() *JKSKeystore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretAccessGrantSpec.
+func (in *SecretAccessGrantSpec) DeepCopy() *SecretAccessGrantSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JKSKeystore)
+	out := new(SecretAccessGrantSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKCS12Keystore) DeepCopyInto(out *PKCS12Keystore) {
+func (in *SelfSignedBootstrapCA) DeepCopyInto(out *SelfSignedBootstrapCA) {
 	*out = *in
-	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(X509Subject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootDuration != nil {
+		in, out := &in.RootDuration, &out.RootDuration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.IntermediateDuration != nil {
+		in, out := &in.IntermediateDuration, &out.IntermediateDuration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKCS12Keystore.
-func (in *PKCS12Keystore) DeepCopy() *PKCS12Keystore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfSignedBootstrapCA.
+func (in *SelfSignedBootstrapCA) DeepCopy() *SelfSignedBootstrapCA {
 	if in == nil {
 		return nil
 	}
-	out := new(PKCS12Keystore)
+	out := new(SelfSignedBootstrapCA)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -777,6 +1922,11 @@ func (in *SelfSignedIssuer) DeepCopyInto(out *SelfSignedIssuer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BootstrapCA != nil {
+		in, out := &in.BootstrapCA, &out.BootstrapCA
+		*out = new(SelfSignedBootstrapCA)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -790,6 +1940,23 @@ func (in *SelfSignedIssuer) DeepCopy() *SelfSignedIssuer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SentNotification) DeepCopyInto(out *SentNotification) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SentNotification.
+func (in *SentNotification) DeepCopy() *SentNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(SentNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultAppRole) DeepCopyInto(out *VaultAppRole) {
 	*out = *in
@@ -812,7 +1979,7 @@ func (in *VaultAuth) DeepCopyInto(out *VaultAuth) {
 	*out = *in
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
-		*out = new(apismetav1.SecretKeySelector)
+		*out = new(metav1.SecretKeySelector)
 		**out = **in
 	}
 	if in.AppRole != nil {
@@ -942,6 +2109,27 @@ func (in *VenafiTPP) DeepCopy() *VenafiTPP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *X509Extension) DeepCopyInto(out *X509Extension) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509Extension.
+func (in *X509Extension) DeepCopy() *X509Extension {
+	if in == nil {
+		return nil
+	}
+	out := new(X509Extension)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *X509Subject) DeepCopyInto(out *X509Subject) {
 	*out = *in
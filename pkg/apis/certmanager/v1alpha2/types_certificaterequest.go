@@ -53,6 +53,14 @@ const (
 // A CertificateRequest is a one-shot resource, meaning it represents a single
 // point in time request for a certificate and cannot be re-used.
 // +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Approved",type="string",JSONPath=".status.conditions[?(@.type==\"Approved\")].status"
+// +kubebuilder:printcolumn:name="Denied",type="string",JSONPath=".status.conditions[?(@.type==\"Denied\")].status"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Issuer",type="string",JSONPath=".spec.issuerRef.name"
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".metadata.annotations.cert-manager\\.io/certificate-revision"
+// +kubebuilder:printcolumn:name="Requestor",type="string",JSONPath=".spec.username"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].message",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
 type CertificateRequest struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -81,6 +89,15 @@ type CertificateRequestSpec struct {
 	// +optional
 	Duration *metav1.Duration `json:"duration,omitempty"`
 
+	// The amount of time to backdate the 'notBefore' time of the issued
+	// certificate by, to tolerate clock skew between the issuer and the
+	// clients that validate the certificate. The 'notAfter' time of the
+	// certificate is not changed. If not set, the default configured on the
+	// cert-manager controller is used.
+	// This option may be ignored by some issuer types.
+	// +optional
+	Backdate *metav1.Duration `json:"backdate,omitempty"`
+
 	// IssuerRef is a reference to the issuer for this CertificateRequest.  If
 	// the `kind` field is not set, or set to `Issuer`, an Issuer resource with
 	// the given name in the same namespace as the CertificateRequest will be
@@ -105,6 +122,13 @@ type CertificateRequestSpec struct {
 	// +optional
 	Usages []KeyUsage `json:"usages,omitempty"`
 
+	// Extensions is a list of additional X.509 extensions to add to the
+	// certificate. Extensions are only added by the CA and SelfSigned issuers,
+	// and only if they are allowed by the `allowedExtensions` policy of the
+	// issuer.
+	// +optional
+	Extensions []X509Extension `json:"extensions,omitempty"`
+
 	// Username contains the name of the user that created the CertificateRequest.
 	// Populated by the cert-manager webhook on creation and immutable.
 	// +optional
@@ -124,6 +148,20 @@ type CertificateRequestSpec struct {
 	Extra map[string][]string `json:"extra,omitempty"`
 }
 
+// X509Extension is an additional X.509 extension to add to a certificate.
+type X509Extension struct {
+	// ID is the object identifier of the extension in dotted notation, for
+	// example `1.3.6.1.4.1.311.20.2`.
+	ID string `json:"id"`
+
+	// Critical marks the extension as critical.
+	// +optional
+	Critical bool `json:"critical,omitempty"`
+
+	// Value is the DER encoded value of the extension.
+	Value []byte `json:"value"`
+}
+
 // CertificateRequestStatus defines the observed state of CertificateRequest and
 // resulting signed certificate.
 type CertificateRequestStatus struct {
@@ -204,4 +242,11 @@ const (
 	// `False`, and cannot be modified once set. Cannot be set alongside
 	// `Approved`.
 	CertificateRequestConditionDenied CertificateRequestConditionType = "Denied"
+
+	// CertificateRequestConditionDurationClamped indicates that the duration
+	// of the certificate was clamped to the durations allowed by the policy
+	// of the issuer, as the requested duration was outside of them. It is
+	// also set if the issuer signed the certificate with a shorter duration
+	// than requested, such as when it exceeds the maximum TTL of a Vault role.
+	CertificateRequestConditionDurationClamped CertificateRequestConditionType = "DurationClamped"
 )
@@ -30,6 +30,12 @@ import (
 //
 // The stored certificate will be renewed before it expires (as configured by `spec.renewBefore`).
 // +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretName"
+// +kubebuilder:printcolumn:name="Issuer",type="string",JSONPath=".spec.issuerRef.name",priority=1
+// +kubebuilder:printcolumn:name="Revision",type="integer",JSONPath=".status.revision",priority=1
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].message",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
 type Certificate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -79,6 +85,57 @@ const (
 	PKCS8 PrivateKeyEncoding = "PKCS8"
 )
 
+// +kubebuilder:validation:Enum=SHA256WithRSA;SHA384WithRSA;SHA512WithRSA;SHA256WithRSAPSS;SHA384WithRSAPSS;SHA512WithRSAPSS;ECDSAWithSHA256;ECDSAWithSHA384;ECDSAWithSHA512;PureEd25519
+type SignatureAlgorithm string
+
+const (
+	// RSA PKCS #1 v1.5 signature algorithms.
+	SHA256WithRSA SignatureAlgorithm = "SHA256WithRSA"
+	SHA384WithRSA SignatureAlgorithm = "SHA384WithRSA"
+	SHA512WithRSA SignatureAlgorithm = "SHA512WithRSA"
+
+	// RSA-PSS signature algorithms.
+	SHA256WithRSAPSS SignatureAlgorithm = "SHA256WithRSAPSS"
+	SHA384WithRSAPSS SignatureAlgorithm = "SHA384WithRSAPSS"
+	SHA512WithRSAPSS SignatureAlgorithm = "SHA512WithRSAPSS"
+
+	// ECDSA signature algorithms. The digest must match the curve of the
+	// key: SHA-256 for P-256, SHA-384 for P-384 and SHA-512 for P-521.
+	ECDSAWithSHA256 SignatureAlgorithm = "ECDSAWithSHA256"
+	ECDSAWithSHA384 SignatureAlgorithm = "ECDSAWithSHA384"
+	ECDSAWithSHA512 SignatureAlgorithm = "ECDSAWithSHA512"
+
+	// Denotes the Ed25519 signature algorithm.
+	PureEd25519 SignatureAlgorithm = "PureEd25519"
+)
+
+// +kubebuilder:validation:Enum=server;client;peer;code-signing
+type CertificateProfile string
+
+const (
+	// ServerCertificateProfile is for TLS server certificates. It requests the
+	// `digital signature`, `key encipherment` and `server auth` usages, and
+	// requires at least one DNS name or IP address.
+	ServerCertificateProfile CertificateProfile = "server"
+
+	// ClientCertificateProfile is for TLS client certificates, such as those
+	// used for mutual TLS. It requests the `digital signature`,
+	// `key encipherment` and `client auth` usages, and requires a common name,
+	// URI or email address identifying the client.
+	ClientCertificateProfile CertificateProfile = "client"
+
+	// PeerCertificateProfile is for certificates used both as a TLS server and
+	// a TLS client, such as between the members of a cluster. It requests the
+	// `digital signature`, `key encipherment`, `server auth` and `client auth`
+	// usages, and requires at least one DNS name or IP address.
+	PeerCertificateProfile CertificateProfile = "peer"
+
+	// CodeSigningCertificateProfile is for code signing certificates. It
+	// requests the `digital signature` and `code signing` usages, and requires
+	// a common name and no DNS names or IP addresses.
+	CodeSigningCertificateProfile CertificateProfile = "code-signing"
+)
+
 // CertificateSpec defines the desired state of Certificate.
 // A valid Certificate requires at least one of a CommonName, DNSName, or
 // URISAN to be valid.
@@ -112,6 +169,31 @@ type CertificateSpec struct {
 	// +optional
 	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 
+	// RenewalWindow restricts the time based renewal of the certificate to
+	// approved change windows. When the certificate is due for renewal
+	// outside of a window, the renewal is deferred until the next window
+	// opens, unless the certificate would expire before that. Re-issuance
+	// for any other reason, such as a change to the spec, is not restricted.
+	// +optional
+	RenewalWindow *CertificateRenewalWindow `json:"renewalWindow,omitempty"`
+
+	// IssuanceDeadline is the maximum amount of time a CertificateRequest
+	// created for this Certificate may take to become ready. If it is
+	// exceeded, the CertificateRequest is deleted and the issuance is marked
+	// as failed, to be retried with the usual backoff.
+	// Value must be in units accepted by Go time.ParseDuration
+	// https://golang.org/pkg/time/#ParseDuration
+	// +optional
+	IssuanceDeadline *metav1.Duration `json:"issuanceDeadline,omitempty"`
+
+	// RenewRequestTime requests that the Certificate is re-issued
+	// immediately, regardless of its renewal time. Re-issuance is triggered
+	// when this time is later than `status.lastRenewRequestTime`, so setting
+	// it to the current time may be used by automation to renew a
+	// Certificate on demand.
+	// +optional
+	RenewRequestTime *metav1.Time `json:"renewRequestTime,omitempty"`
+
 	// DNSNames is a list of DNS subjectAltNames to be set on the Certificate.
 	// +optional
 	DNSNames []string `json:"dnsNames,omitempty"`
@@ -134,10 +216,28 @@ type CertificateSpec struct {
 	// denoted issuer.
 	SecretName string `json:"secretName"`
 
+	// SecretNamespace is the namespace of the Secret resources named by
+	// `secretName` and `additionalSecretNames`. If not set, the namespace of
+	// the Certificate is used.
+	// Secrets may only be stored in another namespace if a SecretAccessGrant
+	// in that namespace permits it.
+	// +optional
+	SecretNamespace string `json:"secretNamespace,omitempty"`
+
+	// AdditionalSecretNames is a list of names of further Secret resources
+	// that will be populated with the same private key and certificate as
+	// the Secret named by `secretName`, and kept in sync with it on every
+	// issuance.
+	// This allows a single issuance to serve consumers which expect the
+	// certificate under different Secret names.
+	// +optional
+	AdditionalSecretNames []string `json:"additionalSecretNames,omitempty"`
+
 	// SecretTemplate defines annotations and labels to be propagated
-	// to the Kubernetes Secret when it is created or updated. Once created,
-	// labels and annotations are not yet removed from the Secret when they are
-	// removed from the template. See https://github.com/jetstack/cert-manager/issues/4292
+	// to the Kubernetes Secret when it is created or updated. The keys copied
+	// from the template are recorded on the Secret, so that labels and
+	// annotations removed from the template are also removed from the Secret,
+	// while those set by other tools are left untouched.
 	// +optional
 	SecretTemplate *CertificateSecretTemplate `json:"secretTemplate,omitempty"`
 
@@ -146,6 +246,37 @@ type CertificateSpec struct {
 	// +optional
 	Keystores *CertificateKeystores `json:"keystores,omitempty"`
 
+	// AdditionalOutputFormats defines extra output formats of the private key
+	// and signed certificate chain to be written to this Certificate's target
+	// Secret. This is useful for consumers, such as HAProxy or some embedded
+	// systems, which cannot read the standard PEM encoded `tls.crt` and
+	// `tls.key` entries.
+	// +optional
+	AdditionalOutputFormats []CertificateAdditionalOutputFormat `json:"additionalOutputFormats,omitempty"`
+
+	// CAChain configures which certificates are stored in the `ca.crt` entry
+	// of this Certificate's target Secret, and whether intermediate
+	// certificates missing from the chain returned by the issuer should be
+	// fetched. If not set, `ca.crt` contains the CA returned by the issuer.
+	// +optional
+	CAChain *CertificateCAChain `json:"caChain,omitempty"`
+
+	// TrustStore configures a `trust.pem` entry in this Certificate's target
+	// Secret containing a bundle of public CA certificates merged with the
+	// CA of the issued certificate, for use as the trust anchors of clients.
+	// +optional
+	TrustStore *CertificateTrustStore `json:"trustStore,omitempty"`
+
+	// DualCertificate configures a second certificate for the same subject
+	// and names, but with a private key of a different algorithm, to be
+	// issued alongside this certificate and stored in its target Secret
+	// under the `tls-<algorithm>.crt` and `tls-<algorithm>.key` entries,
+	// for servers which select a certificate based on the algorithms
+	// supported by the client. The second certificate is renewed whenever
+	// this certificate is.
+	// +optional
+	DualCertificate *CertificateDualCertificate `json:"dualCertificate,omitempty"`
+
 	// IssuerRef is a reference to the issuer for this certificate.
 	// If the `kind` field is not set, or set to `Issuer`, an Issuer resource
 	// with the given name in the same namespace as the Certificate will be used.
@@ -154,6 +285,20 @@ type CertificateSpec struct {
 	// The `name` field in this stanza is required at all times.
 	IssuerRef cmmeta.ObjectReference `json:"issuerRef"`
 
+	// FallbackIssuerRefs is an ordered list of issuers to fail over to if
+	// issuance from `issuerRef` fails. Each issuer is used for
+	// `issuerFailoverThreshold` consecutive failed issuances before failing
+	// over to the next one, returning to `issuerRef` after the last.
+	// Once an issuance succeeds, the next issuance will use `issuerRef` again.
+	// +optional
+	FallbackIssuerRefs []cmmeta.ObjectReference `json:"fallbackIssuerRefs,omitempty"`
+
+	// IssuerFailoverThreshold is the number of consecutive failed issuances
+	// after which the next issuer in `fallbackIssuerRefs` is used.
+	// Defaults to 3.
+	// +optional
+	IssuerFailoverThreshold *int `json:"issuerFailoverThreshold,omitempty"`
+
 	// IsCA will mark this Certificate as valid for certificate signing.
 	// This will automatically add the `cert sign` usage to the list of `usages`.
 	// +optional
@@ -164,6 +309,13 @@ type CertificateSpec struct {
 	// +optional
 	Usages []KeyUsage `json:"usages,omitempty"`
 
+	// Profile is a named preset of the key usages and validation rules of the
+	// certificate. One of `server`, `client`, `peer` or `code-signing`.
+	// The usages of the certificate are determined by the profile, so
+	// `usages` may not be set together with it.
+	// +optional
+	Profile CertificateProfile `json:"profile,omitempty"`
+
 	// Options to control private keys used for the Certificate.
 	// +optional
 	PrivateKey *CertificatePrivateKey `json:"privateKey,omitempty"`
@@ -201,6 +353,26 @@ type CertificatePrivateKey struct {
 	// +optional
 	RotationPolicy PrivateKeyRotationPolicy `json:"rotationPolicy,omitempty"`
 
+	// External configures the private key to be generated and held by an
+	// external signer, such as an HSM or a cloud KMS, rather than being
+	// stored in the Secret. The certificate signing request is signed by the
+	// external signer, and the Secret contains a reference to the key in place
+	// of the private key. Keys are not deleted from the external signer when
+	// they are rotated or when the Certificate is deleted.
+	// Cannot be used with keystores or additional output formats.
+	// +optional
+	External *CertificateExternalPrivateKey `json:"external,omitempty"`
+
+	// SecretRef configures the private key to be supplied by the user, or by
+	// another system, in the referenced Secret in the namespace of the
+	// Certificate, rather than being generated by cert-manager. The key is
+	// never generated or rotated by cert-manager; changes to the key in the
+	// Secret are used from the next issuance. The key defaults to `tls.key`.
+	// Cannot be used with `external`, `algorithm`, `size` or the `Always`
+	// rotation policy.
+	// +optional
+	SecretRef *cmmeta.SecretKeySelector `json:"secretRef,omitempty"`
+
 	// The private key cryptography standards (PKCS) encoding for this
 	// certificate's private key to be encoded in.
 	// If provided, allowed values are `PKCS1` and `PKCS8` standing for PKCS#1
@@ -225,6 +397,26 @@ type CertificatePrivateKey struct {
 	// No other values are allowed.
 	// +optional
 	Size int `json:"size,omitempty"` // Validated by webhook. Be mindful of adding OpenAPI validation- see https://github.com/jetstack/cert-manager/issues/3644 .
+
+	// SignatureAlgorithm is the algorithm used to sign the certificate
+	// signing request. If an RSA-PSS algorithm is requested, the CA and
+	// SelfSigned issuers also sign the issued certificate with it, which
+	// requires the key of a CA issuer to be an RSA key.
+	// If provided, it must be compatible with the private key algorithm, and
+	// ECDSA digests must match the curve of the key.
+	// If not provided, an algorithm is chosen based on the private key
+	// algorithm and size.
+	// +optional
+	SignatureAlgorithm SignatureAlgorithm `json:"signatureAlgorithm,omitempty"`
+}
+
+// CertificateExternalPrivateKey configures a private key which is generated
+// and held by an external signer.
+type CertificateExternalPrivateKey struct {
+	// Provider is the name of the external key provider which generates and
+	// holds the private key. Providers are configured on the cert-manager
+	// controller.
+	Provider string `json:"provider"`
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -269,6 +461,138 @@ type X509Subject struct {
 	// Serial number to be used on the Certificate.
 	// +optional
 	SerialNumber string `json:"serialNumber,omitempty"`
+	// User ID (UID, OID 0.9.2342.19200300.100.1.1) to be used on the
+	// Certificate.
+	// +optional
+	UID string `json:"uid,omitempty"`
+	// Extra names to add to the Certificate in the format n.n.n=value.
+	// +optional
+	ExtraNames []string `json:"extraNames,omitempty"`
+}
+
+// CertificateOutputFormatType specifies which additional output formats
+// should be written to the Certificate's target Secret.
+// Allowed values are `DER` or `CombinedPEM`.
+// +kubebuilder:validation:Enum=DER;CombinedPEM
+type CertificateOutputFormatType string
+
+const (
+	// CertificateOutputFormatDER writes the private key to the `key.der`
+	// entry, and the leaf certificate to the `cert.der` entry, of the
+	// Certificate's target Secret, both in DER binary format.
+	CertificateOutputFormatDER CertificateOutputFormatType = "DER"
+
+	// CertificateOutputFormatCombinedPEM writes the PEM encoded private key
+	// followed by the PEM encoded certificate chain to the `tls-combined.pem`
+	// entry of the Certificate's target Secret.
+	CertificateOutputFormatCombinedPEM CertificateOutputFormatType = "CombinedPEM"
+)
+
+// CertificateAdditionalOutputFormat defines an additional output format of a
+// Certificate resource. These contain supplementary data formats of the signed
+// certificate chain and paired private key.
+type CertificateAdditionalOutputFormat struct {
+	// Type is the name of the format type that should be written to the
+	// Certificate's target Secret.
+	Type CertificateOutputFormatType `json:"type"`
+}
+
+// CertificateCAChainComposition specifies which certificates of the chain are
+// stored in the `ca.crt` entry of the Certificate's target Secret.
+// Allowed values are `IssuerProvided`, `Root`, `Intermediates` or `FullChain`.
+// +kubebuilder:validation:Enum=IssuerProvided;Root;Intermediates;FullChain
+type CertificateCAChainComposition string
+
+const (
+	// CAChainCompositionIssuerProvided stores the CA returned by the issuer
+	// in `ca.crt` without modification.
+	CAChainCompositionIssuerProvided CertificateCAChainComposition = "IssuerProvided"
+
+	// CAChainCompositionRoot stores only the root CA of the chain in
+	// `ca.crt`. If the root CA is not known, the highest intermediate
+	// certificate is stored instead.
+	CAChainCompositionRoot CertificateCAChainComposition = "Root"
+
+	// CAChainCompositionIntermediates stores only the intermediate
+	// certificates of the chain in `ca.crt`, omitting the root CA.
+	CAChainCompositionIntermediates CertificateCAChainComposition = "Intermediates"
+
+	// CAChainCompositionFullChain stores the intermediate certificates of the
+	// chain followed by the root CA in `ca.crt`.
+	CAChainCompositionFullChain CertificateCAChainComposition = "FullChain"
+)
+
+// CertificateRenewalWindow configures the change windows within which the
+// renewal of a certificate may be initiated.
+type CertificateRenewalWindow struct {
+	// Windows is the list of change windows within which renewals may be
+	// initiated. A renewal may be initiated if the current time lies within
+	// any of the windows.
+	Windows []CertificateRenewalWindowRange `json:"windows"`
+
+	// TimeZone is the name of the IANA time zone in which the start and end
+	// times of the windows are given, e.g. `Europe/Berlin`. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// CertificateRenewalWindowRange is a daily range of time, optionally limited
+// to some days of the week.
+type CertificateRenewalWindowRange struct {
+	// Weekdays are the days of the week on which the window opens, given as
+	// their English names, e.g. `Saturday`. If empty, the window opens every
+	// day.
+	// +optional
+	Weekdays []string `json:"weekdays,omitempty"`
+
+	// Start is the time of day at which the window opens, in the 24-hour
+	// format `HH:MM`.
+	Start string `json:"start"`
+
+	// End is the time of day at which the window closes, in the 24-hour
+	// format `HH:MM`. If it is not after `start`, the window closes on the
+	// following day.
+	End string `json:"end"`
+}
+
+// CertificateCAChain configures how the certificate chain returned by the
+// issuer is stored in the Certificate's target Secret.
+type CertificateCAChain struct {
+	// Composition specifies which certificates of the chain are stored in the
+	// `ca.crt` entry of the target Secret. Defaults to `IssuerProvided`.
+	// +optional
+	Composition CertificateCAChainComposition `json:"composition,omitempty"`
+
+	// CompleteChain enables fetching certificates missing from the chain
+	// returned by the issuer, using the "CA Issuers" URLs of their Authority
+	// Information Access extension. Fetched intermediate certificates are
+	// appended to `tls.crt`. Only URLs on hosts allowed by the controller's
+	// `--aia-fetch-allowed-hosts` flag are fetched.
+	// +optional
+	CompleteChain bool `json:"completeChain,omitempty"`
+}
+
+// CertificateTrustStore configures the `trust.pem` entry of the Certificate's
+// target Secret.
+type CertificateTrustStore struct {
+	// Create enables storing a `trust.pem` entry containing the public CA
+	// bundle configured by the controller's `--public-trust-bundle-configmap`
+	// flag, followed by the CA certificates stored in `ca.crt`.
+	Create bool `json:"create"`
+}
+
+// CertificateDualCertificate configures the second certificate of a
+// Certificate which is issued for a private key of a different algorithm.
+type CertificateDualCertificate struct {
+	// Algorithm is the private key algorithm of the second certificate.
+	// Allowed values are `RSA`, `ECDSA` or `Ed25519`, and it must differ
+	// from the algorithm of `spec.privateKey`.
+	Algorithm PrivateKeyAlgorithm `json:"algorithm"`
+
+	// Size is the key bit size of the private key of the second
+	// certificate, following the same rules as `spec.privateKey.size`.
+	// +optional
+	Size int `json:"size,omitempty"`
 }
 
 // CertificateKeystores configures additional keystore output formats to be
@@ -318,7 +642,7 @@ type PKCS12Keystore struct {
 // CertificateStatus defines the observed state of Certificate
 type CertificateStatus struct {
 	// List of status conditions to indicate the status of certificates.
-	// Known condition types are `Ready` and `Issuing`.
+	// Known condition types are `Ready`, `Issuing` and `Paused`.
 	// +optional
 	Conditions []CertificateCondition `json:"conditions,omitempty"`
 
@@ -330,6 +654,17 @@ type CertificateStatus struct {
 	// +optional
 	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
 
+	// FailedIssuanceAttempts is the number of consecutive failed issuances
+	// of this Certificate. It is reset once an issuance succeeds, and is
+	// used to select the issuer when `fallbackIssuerRefs` is set.
+	// +optional
+	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
+
+	// LastRenewRequestTime is the value of `spec.renewRequestTime` that most
+	// recently triggered a re-issuance of this Certificate.
+	// +optional
+	LastRenewRequestTime *metav1.Time `json:"lastRenewRequestTime,omitempty"`
+
 	// The time after which the certificate stored in the secret named
 	// by this resource in spec.secretName is valid.
 	// +optional
@@ -363,6 +698,23 @@ type CertificateStatus struct {
 	// +optional
 	Revision *int `json:"revision,omitempty"`
 
+	// The name of the CertificateRequest resource that was used to issue the
+	// certificate currently stored in the Secret, i.e. the request for
+	// `status.revision`.
+	// +optional
+	ActiveRequestName string `json:"activeRequestName,omitempty"`
+
+	// Details of the certificate currently stored in the Secret, as recorded
+	// by the issuing controller when it was stored.
+	// +optional
+	LastIssuanceDetails *CertificateIssuanceDetails `json:"lastIssuanceDetails,omitempty"`
+
+	// IssuanceHistory records the most recent issuance attempts of this
+	// Certificate, oldest first, so that failures remain visible after a
+	// later attempt succeeds. At most 10 attempts are kept.
+	// +optional
+	IssuanceHistory []CertificateIssuanceAttempt `json:"issuanceHistory,omitempty"`
+
 	// The name of the Secret resource containing the private key to be used
 	// for the next certificate iteration.
 	// The keymanager controller will automatically set this field if the
@@ -371,6 +723,165 @@ type CertificateStatus struct {
 	// not set or False.
 	// +optional
 	NextPrivateKeySecretName *string `json:"nextPrivateKeySecretName,omitempty"`
+
+	// IssuancePlan describes the certificate signing request that would be
+	// sent to the issuer for the next issuance.
+	// It is only set by the certificates controller when the Certificate is
+	// annotated with `cert-manager.io/issuance-dry-run: "true"`, in which
+	// case no CertificateRequest will be created and the issuer is never
+	// contacted.
+	// +optional
+	IssuancePlan *CertificateIssuancePlan `json:"issuancePlan,omitempty"`
+
+	// ACMESolvers describes the ACME challenge solver that would be selected
+	// for each DNS name of the Certificate, along with the selectors that
+	// matched and the DNS zone that DNS01 challenge records would be created
+	// in.
+	// It is only set by the certificates-acme-solver-dry-run controller when
+	// the Certificate is annotated with
+	// `acme.cert-manager.io/solver-dry-run: "true"` and its issuer is an ACME
+	// issuer. No Order or Challenge is created to compute it.
+	// +optional
+	ACMESolvers []CertificateACMESolverStatus `json:"acmeSolvers,omitempty"`
+}
+
+// CertificateIssuanceDetails describes the certificate issued for a revision
+// of a Certificate.
+type CertificateIssuanceDetails struct {
+	// IssuerRef is a reference to the issuer that signed the certificate.
+	IssuerRef cmmeta.ObjectReference `json:"issuerRef"`
+
+	// Duration is the validity period granted by the issuer, which may differ
+	// from the duration requested in the Certificate's spec.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// SerialNumber is the decimal encoded serial number of the certificate.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// Fingerprint is the hex encoded SHA-256 fingerprint of the DER encoded
+	// certificate.
+	// +optional
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// CertificateIssuanceAttempt records the outcome of an issuance attempt of a
+// Certificate.
+type CertificateIssuanceAttempt struct {
+	// Time is the time at which the attempt completed.
+	Time metav1.Time `json:"time"`
+
+	// Reason is the reason the issuance was triggered, as recorded on the
+	// `Issuing` condition, e.g. `Renewing` or `DoesNotExist`.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Outcome of the attempt, one of (`Succeeded`, `Failed`).
+	Outcome CertificateIssuanceOutcome `json:"outcome"`
+
+	// Message describes why the attempt failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// RequestName is the name of the CertificateRequest of the attempt.
+	// +optional
+	RequestName string `json:"requestName,omitempty"`
+}
+
+// CertificateIssuanceOutcome is the outcome of an issuance attempt.
+type CertificateIssuanceOutcome string
+
+const (
+	// CertificateIssuanceSucceeded indicates that the issued certificate was
+	// stored in the Certificate's Secret.
+	CertificateIssuanceSucceeded CertificateIssuanceOutcome = "Succeeded"
+
+	// CertificateIssuanceFailed indicates that the CertificateRequest of the
+	// attempt failed, was denied, or did not complete in time.
+	CertificateIssuanceFailed CertificateIssuanceOutcome = "Failed"
+)
+
+// CertificateIssuancePlan describes the CertificateRequest that the
+// certificates controller would create for a Certificate if it were not
+// running in dry-run mode.
+type CertificateIssuancePlan struct {
+	// The PEM encoded x509 certificate signing request that would be sent to
+	// the issuer.
+	Request []byte `json:"request"`
+
+	// The requested common name.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// The requested DNS subjectAltNames.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// The requested IP address subjectAltNames.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// The requested URI subjectAltNames.
+	// +optional
+	URIs []string `json:"uris,omitempty"`
+
+	// The requested email subjectAltNames.
+	// +optional
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+
+	// The requested key usages and extended key usages.
+	// +optional
+	Usages []KeyUsage `json:"usages,omitempty"`
+
+	// The requested 'duration' (i.e. lifetime) of the certificate.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Whether the requested certificate would be marked as a CA certificate.
+	// +optional
+	IsCA bool `json:"isCA,omitempty"`
+
+	// The additional X.509 extensions that would be requested, as added by
+	// CSR transformers.
+	// +optional
+	Extensions []X509Extension `json:"extensions,omitempty"`
+
+	// The issuer that the request would be sent to.
+	IssuerRef cmmeta.ObjectReference `json:"issuerRef"`
+}
+
+// CertificateACMESolverStatus describes the ACME challenge solver that would
+// be selected for a DNS name of a Certificate.
+type CertificateACMESolverStatus struct {
+	// The DNS name the solver would be selected for.
+	DNSName string `json:"dnsName"`
+
+	// The type of challenge the selected solver would complete, one of
+	// (`HTTP-01`, `DNS-01`).
+	// Not set if no solver can be selected.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// The index of the selected solver in the list of solvers of the issuer.
+	// Not set if no solver can be selected.
+	// +optional
+	SolverIndex *int `json:"solverIndex,omitempty"`
+
+	// The parts of the selector of the selected solver which matched the DNS
+	// name, any of (`matchLabels`, `dnsNames`, `dnsZones`).
+	// Empty if the solver has no selector.
+	// +optional
+	MatchedSelectors []string `json:"matchedSelectors,omitempty"`
+
+	// The DNS zone that the DNS01 challenge record would be created in.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// A human readable description of why no solver can be selected, or why
+	// the DNS zone cannot be determined.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // CertificateCondition contains condition information for an Certificate.
@@ -434,10 +945,17 @@ const (
 	//
 	// It will be removed by the 'issuing' controller upon completing issuance.
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
+
+	// A condition added to Certificate resources by the 'readiness' controller
+	// when the `cert-manager.io/issuance-paused` annotation is set to "true".
+	// Whilst this condition is True, the certificates controllers will not
+	// trigger, request or complete an issuance for the Certificate.
+	CertificateConditionPaused CertificateConditionType = "Paused"
 )
 
-// CertificateSecretTemplate defines the default labels and annotations
-// to be copied to the Kubernetes Secret resource named in `CertificateSpec.secretName`.
+// CertificateSecretTemplate defines the default labels, annotations and
+// additional data keys to be copied to the Kubernetes Secret resource named
+// in `CertificateSpec.secretName`.
 type CertificateSecretTemplate struct {
 	// Annotations is a key value map to be copied to the target Kubernetes Secret.
 	// +optional
@@ -446,4 +964,15 @@ type CertificateSecretTemplate struct {
 	// Labels is a key value map to be copied to the target Kubernetes Secret.
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Keys is a map of additional data keys to be written to the target
+	// Kubernetes Secret, keyed by the standard data key whose value should be
+	// copied. Supported standard keys are `tls.crt`, `tls.key` and `ca.crt`.
+	// For example, `{"tls.crt": "server.crt"}` will write the certificate to
+	// both the `tls.crt` and `server.crt` entries of the Secret.
+	// Custom keys are removed from the Secret when they are removed from the
+	// template. Keys written by cert-manager, such as `ca.crt`,
+	// `keystore.jks` or `tls-combined.pem`, cannot be used as custom keys.
+	// +optional
+	Keys map[string]string `json:"keys,omitempty"`
 }
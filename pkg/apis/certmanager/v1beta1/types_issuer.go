@@ -33,6 +33,9 @@ import (
 // It is similar to an Issuer, however it is cluster-scoped and therefore can
 // be referenced by resources that exist in *any* namespace, not just the same
 // namespace as the referent.
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].message",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
 type ClusterIssuer struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -63,6 +66,9 @@ type ClusterIssuerList struct {
 // referenced as part of `issuerRef` fields.
 // It is scoped to a single namespace and can therefore only be referenced by
 // resources within the same namespace.
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].message",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
 type Issuer struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -89,8 +95,130 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig `json:",inline"`
+
+	// Policy restricts the certificates which will be signed by this issuer.
+	// Certificates and CertificateRequests which violate the policy are
+	// rejected by the webhook, and CertificateRequests are rejected or have
+	// their duration clamped by the issuer's controller.
+	// +optional
+	Policy *IssuerPolicy `json:"policy,omitempty"`
+
+	// CertificateDefaults are applied to the Certificates which reference
+	// this issuer when the corresponding fields are not set on the
+	// Certificate. The values that were applied are recorded in the
+	// `IssuerDefaultsApplied` condition of the Certificate.
+	// +optional
+	CertificateDefaults *IssuerCertificateDefaults `json:"certificateDefaults,omitempty"`
+}
+
+// IssuerCertificateDefaults are the values applied to the Certificates which
+// reference an issuer when the corresponding fields are not set.
+type IssuerCertificateDefaults struct {
+	// Duration is applied to Certificates that do not set `spec.duration`.
+	// If the Certificate's `spec.renewBefore` is the defaulted value, it is
+	// recalculated from this duration.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Usages are applied to Certificates that do not set `spec.usages`, or
+	// whose usages are the defaults set by the webhook, `digital signature`
+	// and `key encipherment`.
+	// +optional
+	Usages []KeyUsage `json:"usages,omitempty"`
+
+	// Organizations are applied to Certificates that do not set
+	// `spec.subject.organizations`.
+	// +optional
+	Organizations []string `json:"organizations,omitempty"`
 }
 
+// IssuerPolicy restricts the certificates which will be signed by an issuer.
+type IssuerPolicy struct {
+	// MinDuration is the minimum duration of the certificates signed by the
+	// issuer.
+	// +optional
+	MinDuration *metav1.Duration `json:"minDuration,omitempty"`
+
+	// MaxDuration is the maximum duration of the certificates signed by the
+	// issuer.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// DurationEnforcement controls what happens to a request whose duration
+	// is outside of the minimum and maximum durations. If set to `Reject`,
+	// the request is rejected. If set to `Clamp`, the certificate is signed
+	// with its duration clamped to the allowed range, and the clamp is
+	// reported in the CertificateRequest's conditions.
+	// Defaults to `Reject`.
+	// +kubebuilder:validation:Enum=Reject;Clamp
+	// +optional
+	DurationEnforcement DurationEnforcement `json:"durationEnforcement,omitempty"`
+
+	// AllowedPrivateKeyAlgorithms is the list of private key algorithms
+	// that the issuer will sign certificates for.
+	// If not set, all private key algorithms are allowed.
+	// +optional
+	AllowedPrivateKeyAlgorithms []PrivateKeyAlgorithm `json:"allowedPrivateKeyAlgorithms,omitempty"`
+
+	// RequesterIdentity restricts the subject of certificates to the identity
+	// of the user that created the CertificateRequest, for example requiring
+	// the common name to be the name of the requester's service account.
+	// As the CertificateRequests of Certificates are created by cert-manager,
+	// this is intended for issuers used to sign CertificateRequests that are
+	// created directly.
+	// +optional
+	RequesterIdentity *RequesterIdentityPolicy `json:"requesterIdentity,omitempty"`
+
+	// AllowedExtensions is the list of object identifiers, in dotted
+	// notation, of the additional X.509 extensions that may be requested in
+	// the `extensions` of CertificateRequests. Requests for any other
+	// extension are rejected.
+	// +optional
+	AllowedExtensions []string `json:"allowedExtensions,omitempty"`
+}
+
+// RequesterIdentityPolicy restricts the subject of certificates to the
+// identity of the user that created the CertificateRequest.
+//
+// Each field is a template which is expanded with the identity of the
+// requester. The following variables are supported:
+// `${username}`, `${uid}` and `${namespace}`, which is the namespace of the
+// CertificateRequest; and `${serviceaccount.name}` and
+// `${serviceaccount.namespace}`, which are only set if the requester is a
+// service account. Requests from other users are rejected by templates which
+// use them.
+type RequesterIdentityPolicy struct {
+	// CommonName is a template for the common name of the certificate. If
+	// set, the common name must be equal to the expanded template.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// DNSNames is a list of templates for the DNS names of the certificate.
+	// If set, each DNS name must be equal to one of the expanded templates.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// URIs is a list of templates for the URIs of the certificate. If set,
+	// each URI must be equal to one of the expanded templates.
+	// +optional
+	URIs []string `json:"uris,omitempty"`
+}
+
+// DurationEnforcement controls what happens to a request whose duration is
+// outside of the durations allowed by an issuer's policy.
+type DurationEnforcement string
+
+const (
+	// RejectDurationEnforcement rejects requests whose duration is outside
+	// of the allowed durations.
+	RejectDurationEnforcement DurationEnforcement = "Reject"
+
+	// ClampDurationEnforcement signs requests whose duration is outside of
+	// the allowed durations with their duration clamped to the allowed
+	// range.
+	ClampDurationEnforcement DurationEnforcement = "Clamp"
+)
+
 // The configuration for the issuer.
 // Only one of these can be set.
 type IssuerConfig struct {
@@ -119,6 +247,18 @@ type IssuerConfig struct {
 	// or Venafi Cloud policy zone.
 	// +optional
 	Venafi *VenafiIssuer `json:"venafi,omitempty"`
+
+	// SCEP configures this issuer to enroll certificates with a Simple
+	// Certificate Enrollment Protocol (RFC 8894) server, such as Microsoft
+	// NDES.
+	// +optional
+	SCEP *SCEPIssuer `json:"scep,omitempty"`
+
+	// CMP configures this issuer to enroll certificates with a Certificate
+	// Management Protocol (RFC 4210) server, such as EJBCA or Insta
+	// Certifier.
+	// +optional
+	CMP *CMPIssuer `json:"cmp,omitempty"`
 }
 
 // Configures an issuer to sign certificates using a Venafi TPP
@@ -173,6 +313,87 @@ type VenafiCloud struct {
 	APITokenSecretRef cmmeta.SecretKeySelector `json:"apiTokenSecretRef"`
 }
 
+// SCEPIssuer configures an issuer to enroll certificates with a Simple
+// Certificate Enrollment Protocol (SCEP) server.
+type SCEPIssuer struct {
+	// URL is the URL of the SCEP server, for example:
+	// "https://ndes.example.com/certsrv/mscep/mscep.dll".
+	URL string `json:"url"`
+
+	// ChallengePasswordSecretRef is a reference to a key in a Secret containing
+	// the challenge password to include in certificate signing requests.
+	// The challenge password is visible to anyone able to read the
+	// CertificateRequests of this issuer.
+	// +optional
+	ChallengePasswordSecretRef *cmmeta.SecretKeySelector `json:"challengePasswordSecretRef,omitempty"`
+
+	// CAFingerprint is the hex encoded SHA-256 fingerprint of the DER encoded
+	// CA certificate of the SCEP server, optionally separated by colons.
+	// If specified, the CA certificates returned by the server are only
+	// trusted if one of them has this fingerprint.
+	// +optional
+	CAFingerprint string `json:"caFingerprint,omitempty"`
+
+	// CABundle is a PEM encoded bundle of CA certificates used to validate
+	// the TLS certificate of the SCEP server. Only used if the URL is using
+	// HTTPS. If not set the system root certificates are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// CMPIssuer configures an issuer to enroll certificates with a Certificate
+// Management Protocol (CMPv2) server.
+// Certificates are requested with an initialization request when MAC
+// protection is configured, and with a certification request when signature
+// protection is configured. Certificates which were issued by this issuer and
+// have not expired are renewed with a key update request, signed by the
+// private key of the certificate being renewed.
+// As the private key of a request is not available to the issuer, the CMP
+// server must accept proof of possession verified by a registration
+// authority.
+type CMPIssuer struct {
+	// URL is the URL of the CMP server, for example:
+	// "https://ejbca.example.com/ejbca/publicweb/cmp/cert-manager".
+	URL string `json:"url"`
+
+	// MAC protects requests with a password-based MAC, using a shared secret
+	// issued by the CA. Exactly one of mac or signature must be specified.
+	// +optional
+	MAC *CMPMACProtection `json:"mac,omitempty"`
+
+	// Signature protects requests with a signature, using a private key and
+	// certificate trusted by the CA. Exactly one of mac or signature must be
+	// specified.
+	// +optional
+	Signature *CMPSignatureProtection `json:"signature,omitempty"`
+
+	// CABundle is a PEM encoded bundle of CA certificates used to validate
+	// the TLS certificate of the CMP server, and the signers of responses
+	// protected by a signature. If not set the system root certificates are
+	// used to validate the TLS certificate of the CMP server, and responses
+	// protected by a signature are rejected.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// CMPMACProtection configures password-based MAC protection of CMP messages.
+type CMPMACProtection struct {
+	// Reference is the reference value the CA associates with the shared
+	// secret, sent as the sender key identifier of requests.
+	Reference string `json:"reference"`
+
+	// SecretRef is a reference to a key in a Secret containing the shared
+	// secret.
+	SecretRef cmmeta.SecretKeySelector `json:"secretRef"`
+}
+
+// CMPSignatureProtection configures signature protection of CMP messages.
+type CMPSignatureProtection struct {
+	// SecretRef is a reference to a kubernetes.io/tls Secret containing the
+	// private key and certificate used to sign requests.
+	SecretRef cmmeta.LocalObjectReference `json:"secretRef"`
+}
+
 // Configures an issuer to 'self sign' certificates using the
 // private key used to create the CertificateRequest object.
 type SelfSignedIssuer struct {
@@ -181,6 +402,46 @@ type SelfSignedIssuer struct {
 	// If not set certificate will be issued without CDP. Values are strings.
 	// +optional
 	CRLDistributionPoints []string `json:"crlDistributionPoints,omitempty"`
+
+	// BootstrapCA configures the issuer to bootstrap a CA hierarchy: a
+	// self-signed root CA certificate, an intermediate CA certificate signed
+	// by the root, and a CA issuer which signs using the intermediate.
+	// The Certificates are created in the namespace of the issuer, or the
+	// cluster resource namespace for a ClusterIssuer, and all of the
+	// created resources are owned by this issuer.
+	// +optional
+	BootstrapCA *SelfSignedBootstrapCA `json:"bootstrapCA,omitempty"`
+}
+
+// SelfSignedBootstrapCA configures the CA hierarchy bootstrapped by a
+// SelfSigned issuer.
+type SelfSignedBootstrapCA struct {
+	// IssuerName is the name of the CA issuer which signs using the
+	// intermediate CA certificate, which is of the same kind as the SelfSigned
+	// issuer. The intermediate CA Certificate and its Secret are given the
+	// same name. The root CA Certificate, its Secret and the CA issuer which
+	// signs using it are given this name suffixed with "-root".
+	IssuerName string `json:"issuerName"`
+
+	// CommonName of the CA certificates. The common names of the root and
+	// intermediate CA certificates are suffixed with " Root CA" and
+	// " Intermediate CA" respectively.
+	CommonName string `json:"commonName"`
+
+	// Full X509 name specification of the CA certificates.
+	// +optional
+	Subject *X509Subject `json:"subject,omitempty"`
+
+	// RootDuration is the requested duration of the root CA certificate.
+	// Defaults to 10 years.
+	// +optional
+	RootDuration *metav1.Duration `json:"rootDuration,omitempty"`
+
+	// IntermediateDuration is the requested duration of the intermediate CA
+	// certificate. It must be shorter than the duration of the root CA
+	// certificate. Defaults to 5 years.
+	// +optional
+	IntermediateDuration *metav1.Duration `json:"intermediateDuration,omitempty"`
 }
 
 // Configures an issuer to sign certificates using a HashiCorp Vault
@@ -194,8 +455,21 @@ type VaultIssuer struct {
 
 	// Path is the mount path of the Vault PKI backend's `sign` endpoint, e.g:
 	// "my_pki_mount/sign/my-role-name".
+	// CA certificates are signed as intermediates by the
+	// `root/sign-intermediate` endpoint of the same PKI backend.
 	Path string `json:"path"`
 
+	// SigningMode selects the endpoint of the Vault PKI backend which signs
+	// certificates. If set to `Role`, certificates are signed by the `sign`
+	// endpoint of the role in `path`, which applies the constraints and
+	// defaults of the role to the request. If set to `Verbatim`, they are
+	// signed by the `sign-verbatim` endpoint of the same PKI backend, which
+	// keeps the subject and extensions of the request as they are.
+	// Defaults to `Role`.
+	// +kubebuilder:validation:Enum=Role;Verbatim
+	// +optional
+	SigningMode VaultSigningMode `json:"signingMode,omitempty"`
+
 	// Name of the vault namespace. Namespaces is a set of features within Vault Enterprise that allows Vault environments to support Secure Multi-tenancy. e.g: "ns1"
 	// More about namespaces can be found here https://www.vaultproject.io/docs/enterprise/namespaces
 	// +optional
@@ -209,6 +483,20 @@ type VaultIssuer struct {
 	CABundle []byte `json:"caBundle,omitempty"`
 }
 
+// VaultSigningMode selects the endpoint of a Vault PKI backend which signs
+// certificates.
+type VaultSigningMode string
+
+const (
+	// RoleVaultSigningMode signs certificates using the `sign` endpoint of a
+	// role.
+	RoleVaultSigningMode VaultSigningMode = "Role"
+
+	// VerbatimVaultSigningMode signs certificates using the `sign-verbatim`
+	// endpoint.
+	VerbatimVaultSigningMode VaultSigningMode = "Verbatim"
+)
+
 // Configuration used to authenticate with a Vault server.
 // Only one of `tokenSecretRef`, `appRole` or `kubernetes` may be specified.
 type VaultAuth struct {
@@ -283,6 +571,94 @@ type CAIssuer struct {
 	// OCSP server URL could be "http://ocsp.int-x3.letsencrypt.org".
 	// +optional
 	OCSPServers []string `json:"ocspServers,omitempty"`
+
+	// KMS configures the private key of the CA to be held by a cloud key
+	// management service, so that it never leaves the service. If set, the
+	// Secret named by `secretName` only needs to contain the CA certificate,
+	// and any private key in it is ignored.
+	// +optional
+	KMS *CAIssuerKMS `json:"kms,omitempty"`
+
+	// PKCS11 configures the private key of the CA to be held by a PKCS#11
+	// token, such as a hardware security module, so that it never leaves the
+	// token. If set, the Secret named by `secretName` only needs to contain
+	// the CA certificate, and any private key in it is ignored. Only one of
+	// `kms` or `pkcs11` may be set. PKCS#11 support is only available if
+	// cert-manager is built with the `pkcs11` build tag.
+	// +optional
+	PKCS11 *CAIssuerPKCS11 `json:"pkcs11,omitempty"`
+}
+
+// CAIssuerKMS configures the cloud key management service holding the private
+// key of a CA issuer. Exactly one of `aws` or `googleCloud` must be set.
+type CAIssuerKMS struct {
+	// AWS configures an asymmetric signing key held by AWS KMS.
+	// +optional
+	AWS *CAIssuerAWSKMS `json:"aws,omitempty"`
+
+	// GoogleCloud configures an asymmetric signing key version held by
+	// Google Cloud KMS.
+	// +optional
+	GoogleCloud *CAIssuerGoogleCloudKMS `json:"googleCloud,omitempty"`
+}
+
+// CAIssuerAWSKMS configures an asymmetric signing key held by AWS KMS.
+type CAIssuerAWSKMS struct {
+	// KeyID is the ID or ARN of the key, or of an alias of the key.
+	KeyID string `json:"keyID"`
+
+	// Region is the AWS region of the key.
+	Region string `json:"region"`
+
+	// The AccessKeyID is used for authentication. If not set we fall-back to
+	// using ambient credentials, if the Issuer is allowed to use them.
+	// +optional
+	AccessKeyID string `json:"accessKeyID,omitempty"`
+
+	// The SecretAccessKey is used for authentication, and must be set if
+	// AccessKeyID is set.
+	// +optional
+	SecretAccessKey *cmmeta.SecretKeySelector `json:"secretAccessKeySecretRef,omitempty"`
+
+	// Role is a Role ARN which will be assumed using either the explicit
+	// credentials AccessKeyID/SecretAccessKey or the ambient credentials.
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// CAIssuerGoogleCloudKMS configures an asymmetric signing key version held by
+// Google Cloud KMS.
+type CAIssuerGoogleCloudKMS struct {
+	// KeyVersion is the resource name of the key version, in the form
+	// `projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*`.
+	KeyVersion string `json:"keyVersion"`
+
+	// ServiceAccount is a reference to a key of a Secret containing a service
+	// account key in JSON format. If not set we fall-back to using ambient
+	// credentials, if the Issuer is allowed to use them.
+	// +optional
+	ServiceAccount *cmmeta.SecretKeySelector `json:"serviceAccountSecretRef,omitempty"`
+}
+
+// CAIssuerPKCS11 configures the PKCS#11 token holding the private key of a CA
+// issuer.
+type CAIssuerPKCS11 struct {
+	// ModulePath is the path of the PKCS#11 module of the token, which is a
+	// shared library that must be present in the filesystem of the
+	// cert-manager controller.
+	ModulePath string `json:"modulePath"`
+
+	// Slot is the ID of the slot holding the token.
+	Slot int64 `json:"slot"`
+
+	// KeyLabel is the label of the private key on the token. If not set, the
+	// token must hold exactly one private key.
+	// +optional
+	KeyLabel string `json:"keyLabel,omitempty"`
+
+	// PIN is a reference to a key of a Secret containing the user PIN of the
+	// token.
+	PIN cmmeta.SecretKeySelector `json:"pinSecretRef"`
 }
 
 // IssuerStatus contains status information about an Issuer
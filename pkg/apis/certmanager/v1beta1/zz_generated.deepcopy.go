@@ -22,8 +22,8 @@ package v1beta1
 
 import (
 	acmev1beta1 "github.com/jetstack/cert-manager/pkg/apis/acme/v1beta1"
-	metav1 "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -40,6 +40,16 @@ func (in *CAIssuer) DeepCopyInto(out *CAIssuer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(CAIssuerKMS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PKCS11 != nil {
+		in, out := &in.PKCS11, &out.PKCS11
+		*out = new(CAIssuerPKCS11)
+		**out = **in
+	}
 	return
 }
 
@@ -53,6 +63,156 @@ func (in *CAIssuer) DeepCopy() *CAIssuer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAIssuerAWSKMS) DeepCopyInto(out *CAIssuerAWSKMS) {
+	*out = *in
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerAWSKMS.
+func (in *CAIssuerAWSKMS) DeepCopy() *CAIssuerAWSKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(CAIssuerAWSKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAIssuerGoogleCloudKMS) DeepCopyInto(out *CAIssuerGoogleCloudKMS) {
+	*out = *in
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerGoogleCloudKMS.
+func (in *CAIssuerGoogleCloudKMS) DeepCopy() *CAIssuerGoogleCloudKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(CAIssuerGoogleCloudKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAIssuerKMS) DeepCopyInto(out *CAIssuerKMS) {
+	*out = *in
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(CAIssuerAWSKMS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(CAIssuerGoogleCloudKMS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerKMS.
+func (in *CAIssuerKMS) DeepCopy() *CAIssuerKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(CAIssuerKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAIssuerPKCS11) DeepCopyInto(out *CAIssuerPKCS11) {
+	*out = *in
+	out.PIN = in.PIN
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerPKCS11.
+func (in *CAIssuerPKCS11) DeepCopy() *CAIssuerPKCS11 {
+	if in == nil {
+		return nil
+	}
+	out := new(CAIssuerPKCS11)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CMPIssuer) DeepCopyInto(out *CMPIssuer) {
+	*out = *in
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(CMPMACProtection)
+		**out = **in
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(CMPSignatureProtection)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CMPIssuer.
+func (in *CMPIssuer) DeepCopy() *CMPIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(CMPIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CMPMACProtection) DeepCopyInto(out *CMPMACProtection) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CMPMACProtection.
+func (in *CMPMACProtection) DeepCopy() *CMPMACProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(CMPMACProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CMPSignatureProtection) DeepCopyInto(out *CMPSignatureProtection) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CMPSignatureProtection.
+func (in *CMPSignatureProtection) DeepCopy() *CMPSignatureProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(CMPSignatureProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
@@ -81,6 +241,64 @@ func (in *Certificate) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateACMESolverStatus) DeepCopyInto(out *CertificateACMESolverStatus) {
+	*out = *in
+	if in.SolverIndex != nil {
+		in, out := &in.SolverIndex, &out.SolverIndex
+		*out = new(int)
+		**out = **in
+	}
+	if in.MatchedSelectors != nil {
+		in, out := &in.MatchedSelectors, &out.MatchedSelectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateACMESolverStatus.
+func (in *CertificateACMESolverStatus) DeepCopy() *CertificateACMESolverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateACMESolverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAdditionalOutputFormat) DeepCopyInto(out *CertificateAdditionalOutputFormat) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAdditionalOutputFormat.
+func (in *CertificateAdditionalOutputFormat) DeepCopy() *CertificateAdditionalOutputFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAdditionalOutputFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateCAChain) DeepCopyInto(out *CertificateCAChain) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateCAChain.
+func (in *CertificateCAChain) DeepCopy() *CertificateCAChain {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateCAChain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateCondition) DeepCopyInto(out *CertificateCondition) {
 	*out = *in
@@ -101,6 +319,136 @@ func (in *CertificateCondition) DeepCopy() *CertificateCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateDualCertificate) DeepCopyInto(out *CertificateDualCertificate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateDualCertificate.
+func (in *CertificateDualCertificate) DeepCopy() *CertificateDualCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateDualCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateExternalPrivateKey) DeepCopyInto(out *CertificateExternalPrivateKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalPrivateKey.
+func (in *CertificateExternalPrivateKey) DeepCopy() *CertificateExternalPrivateKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateExternalPrivateKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuanceAttempt) DeepCopyInto(out *CertificateIssuanceAttempt) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuanceAttempt.
+func (in *CertificateIssuanceAttempt) DeepCopy() *CertificateIssuanceAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuanceAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuanceDetails) DeepCopyInto(out *CertificateIssuanceDetails) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuanceDetails.
+func (in *CertificateIssuanceDetails) DeepCopy() *CertificateIssuanceDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuanceDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuancePlan) DeepCopyInto(out *CertificateIssuancePlan) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URIs != nil {
+		in, out := &in.URIs, &out.URIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmailAddresses != nil {
+		in, out := &in.EmailAddresses, &out.EmailAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]X509Extension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.IssuerRef = in.IssuerRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuancePlan.
+func (in *CertificateIssuancePlan) DeepCopy() *CertificateIssuancePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuancePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateKeystores) DeepCopyInto(out *CertificateKeystores) {
 	*out = *in
@@ -163,6 +511,16 @@ func (in *CertificateList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificatePrivateKey) DeepCopyInto(out *CertificatePrivateKey) {
 	*out = *in
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(CertificateExternalPrivateKey)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 	return
 }
 
@@ -176,6 +534,50 @@ func (in *CertificatePrivateKey) DeepCopy() *CertificatePrivateKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRenewalWindow) DeepCopyInto(out *CertificateRenewalWindow) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]CertificateRenewalWindowRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRenewalWindow.
+func (in *CertificateRenewalWindow) DeepCopy() *CertificateRenewalWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRenewalWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRenewalWindowRange) DeepCopyInto(out *CertificateRenewalWindowRange) {
+	*out = *in
+	if in.Weekdays != nil {
+		in, out := &in.Weekdays, &out.Weekdays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRenewalWindowRange.
+func (in *CertificateRenewalWindowRange) DeepCopy() *CertificateRenewalWindowRange {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRenewalWindowRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequest) DeepCopyInto(out *CertificateRequest) {
 	*out = *in
@@ -262,7 +664,12 @@ func (in *CertificateRequestSpec) DeepCopyInto(out *CertificateRequestSpec) {
 	*out = *in
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Backdate != nil {
+		in, out := &in.Backdate, &out.Backdate
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	out.IssuerRef = in.IssuerRef
@@ -276,6 +683,13 @@ func (in *CertificateRequestSpec) DeepCopyInto(out *CertificateRequestSpec) {
 		*out = make([]KeyUsage, len(*in))
 		copy(*out, *in)
 	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]X509Extension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Groups != nil {
 		in, out := &in.Groups, &out.Groups
 		*out = make([]string, len(*in))
@@ -363,6 +777,13 @@ func (in *CertificateSecretTemplate) DeepCopyInto(out *CertificateSecretTemplate
 			(*out)[key] = val
 		}
 	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -386,14 +807,28 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	}
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.RenewBefore != nil {
 		in, out := &in.RenewBefore, &out.RenewBefore
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.RenewalWindow != nil {
+		in, out := &in.RenewalWindow, &out.RenewalWindow
+		*out = new(CertificateRenewalWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IssuanceDeadline != nil {
+		in, out := &in.IssuanceDeadline, &out.IssuanceDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewRequestTime != nil {
+		in, out := &in.RenewRequestTime, &out.RenewRequestTime
+		*out = (*in).DeepCopy()
+	}
 	if in.DNSNames != nil {
 		in, out := &in.DNSNames, &out.DNSNames
 		*out = make([]string, len(*in))
@@ -414,6 +849,11 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalSecretNames != nil {
+		in, out := &in.AdditionalSecretNames, &out.AdditionalSecretNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.SecretTemplate != nil {
 		in, out := &in.SecretTemplate, &out.SecretTemplate
 		*out = new(CertificateSecretTemplate)
@@ -424,7 +864,37 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		*out = new(CertificateKeystores)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalOutputFormats != nil {
+		in, out := &in.AdditionalOutputFormats, &out.AdditionalOutputFormats
+		*out = make([]CertificateAdditionalOutputFormat, len(*in))
+		copy(*out, *in)
+	}
+	if in.CAChain != nil {
+		in, out := &in.CAChain, &out.CAChain
+		*out = new(CertificateCAChain)
+		**out = **in
+	}
+	if in.TrustStore != nil {
+		in, out := &in.TrustStore, &out.TrustStore
+		*out = new(CertificateTrustStore)
+		**out = **in
+	}
+	if in.DualCertificate != nil {
+		in, out := &in.DualCertificate, &out.DualCertificate
+		*out = new(CertificateDualCertificate)
+		**out = **in
+	}
 	out.IssuerRef = in.IssuerRef
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.IssuerFailoverThreshold != nil {
+		in, out := &in.IssuerFailoverThreshold, &out.IssuerFailoverThreshold
+		*out = new(int)
+		**out = **in
+	}
 	if in.Usages != nil {
 		in, out := &in.Usages, &out.Usages
 		*out = make([]KeyUsage, len(*in))
@@ -433,7 +903,7 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	if in.PrivateKey != nil {
 		in, out := &in.PrivateKey, &out.PrivateKey
 		*out = new(CertificatePrivateKey)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.EncodeUsagesInRequest != nil {
 		in, out := &in.EncodeUsagesInRequest, &out.EncodeUsagesInRequest
@@ -472,6 +942,15 @@ func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 		in, out := &in.LastFailureTime, &out.LastFailureTime
 		*out = (*in).DeepCopy()
 	}
+	if in.FailedIssuanceAttempts != nil {
+		in, out := &in.FailedIssuanceAttempts, &out.FailedIssuanceAttempts
+		*out = new(int)
+		**out = **in
+	}
+	if in.LastRenewRequestTime != nil {
+		in, out := &in.LastRenewRequestTime, &out.LastRenewRequestTime
+		*out = (*in).DeepCopy()
+	}
 	if in.NotBefore != nil {
 		in, out := &in.NotBefore, &out.NotBefore
 		*out = (*in).DeepCopy()
@@ -489,11 +968,35 @@ func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.LastIssuanceDetails != nil {
+		in, out := &in.LastIssuanceDetails, &out.LastIssuanceDetails
+		*out = new(CertificateIssuanceDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IssuanceHistory != nil {
+		in, out := &in.IssuanceHistory, &out.IssuanceHistory
+		*out = make([]CertificateIssuanceAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NextPrivateKeySecretName != nil {
 		in, out := &in.NextPrivateKeySecretName, &out.NextPrivateKeySecretName
 		*out = new(string)
 		**out = **in
 	}
+	if in.IssuancePlan != nil {
+		in, out := &in.IssuancePlan, &out.IssuancePlan
+		*out = new(CertificateIssuancePlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ACMESolvers != nil {
+		in, out := &in.ACMESolvers, &out.ACMESolvers
+		*out = make([]CertificateACMESolverStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -507,6 +1010,22 @@ func (in *CertificateStatus) DeepCopy() *CertificateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateTrustStore) DeepCopyInto(out *CertificateTrustStore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateTrustStore.
+func (in *CertificateTrustStore) DeepCopy() *CertificateTrustStore {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateTrustStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterIssuer) DeepCopyInto(out *ClusterIssuer) {
 	*out = *in
@@ -596,6 +1115,37 @@ func (in *Issuer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerCertificateDefaults) DeepCopyInto(out *IssuerCertificateDefaults) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerCertificateDefaults.
+func (in *IssuerCertificateDefaults) DeepCopy() *IssuerCertificateDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerCertificateDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IssuerCondition) DeepCopyInto(out *IssuerCondition) {
 	*out = *in
@@ -644,6 +1194,16 @@ func (in *IssuerConfig) DeepCopyInto(out *IssuerConfig) {
 		*out = new(VenafiIssuer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SCEP != nil {
+		in, out := &in.SCEP, &out.SCEP
+		*out = new(SCEPIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CMP != nil {
+		in, out := &in.CMP, &out.CMP
+		*out = new(CMPIssuer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -690,10 +1250,61 @@ func (in *IssuerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerPolicy) DeepCopyInto(out *IssuerPolicy) {
+	*out = *in
+	if in.MinDuration != nil {
+		in, out := &in.MinDuration, &out.MinDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AllowedPrivateKeyAlgorithms != nil {
+		in, out := &in.AllowedPrivateKeyAlgorithms, &out.AllowedPrivateKeyAlgorithms
+		*out = make([]PrivateKeyAlgorithm, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequesterIdentity != nil {
+		in, out := &in.RequesterIdentity, &out.RequesterIdentity
+		*out = new(RequesterIdentityPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedExtensions != nil {
+		in, out := &in.AllowedExtensions, &out.AllowedExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerPolicy.
+func (in *IssuerPolicy) DeepCopy() *IssuerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IssuerSpec) DeepCopyInto(out *IssuerSpec) {
 	*out = *in
 	in.IssuerConfig.DeepCopyInto(&out.IssuerConfig)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(IssuerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateDefaults != nil {
+		in, out := &in.CertificateDefaults, &out.CertificateDefaults
+		*out = new(IssuerCertificateDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -720,7 +1331,7 @@ func (in *IssuerStatus) DeepCopyInto(out *IssuerStatus) {
 	if in.ACME != nil {
 		in, out := &in.ACME, &out.ACME
 		*out = new(acmev1beta1.ACMEIssuerStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -769,6 +1380,89 @@ func (in *PKCS12Keystore) DeepCopy() *PKCS12Keystore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequesterIdentityPolicy) DeepCopyInto(out *RequesterIdentityPolicy) {
+	*out = *in
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URIs != nil {
+		in, out := &in.URIs, &out.URIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequesterIdentityPolicy.
+func (in *RequesterIdentityPolicy) DeepCopy() *RequesterIdentityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RequesterIdentityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCEPIssuer) DeepCopyInto(out *SCEPIssuer) {
+	*out = *in
+	if in.ChallengePasswordSecretRef != nil {
+		in, out := &in.ChallengePasswordSecretRef, &out.ChallengePasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCEPIssuer.
+func (in *SCEPIssuer) DeepCopy() *SCEPIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(SCEPIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfSignedBootstrapCA) DeepCopyInto(out *SelfSignedBootstrapCA) {
+	*out = *in
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(X509Subject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootDuration != nil {
+		in, out := &in.RootDuration, &out.RootDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.IntermediateDuration != nil {
+		in, out := &in.IntermediateDuration, &out.IntermediateDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfSignedBootstrapCA.
+func (in *SelfSignedBootstrapCA) DeepCopy() *SelfSignedBootstrapCA {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfSignedBootstrapCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelfSignedIssuer) DeepCopyInto(out *SelfSignedIssuer) {
 	*out = *in
@@ -777,6 +1471,11 @@ func (in *SelfSignedIssuer) DeepCopyInto(out *SelfSignedIssuer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BootstrapCA != nil {
+		in, out := &in.BootstrapCA, &out.BootstrapCA
+		*out = new(SelfSignedBootstrapCA)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -812,7 +1511,7 @@ func (in *VaultAuth) DeepCopyInto(out *VaultAuth) {
 	*out = *in
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
-		*out = new(metav1.SecretKeySelector)
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
 	if in.AppRole != nil {
@@ -942,6 +1641,27 @@ func (in *VenafiTPP) DeepCopy() *VenafiTPP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *X509Extension) DeepCopyInto(out *X509Extension) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509Extension.
+func (in *X509Extension) DeepCopy() *X509Extension {
+	if in == nil {
+		return nil
+	}
+	out := new(X509Extension)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *X509Subject) DeepCopyInto(out *X509Subject) {
 	*out = *in
@@ -980,6 +1700,11 @@ func (in *X509Subject) DeepCopyInto(out *X509Subject) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExtraNames != nil {
+		in, out := &in.ExtraNames, &out.ExtraNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
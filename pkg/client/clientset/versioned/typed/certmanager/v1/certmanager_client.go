@@ -28,8 +28,12 @@ type CertmanagerV1Interface interface {
 	RESTClient() rest.Interface
 	CertificatesGetter
 	CertificateRequestsGetter
+	ClusterCertificatesGetter
 	ClusterIssuersGetter
+	IssuanceQuotasGetter
 	IssuersGetter
+	NotificationRoutesGetter
+	SecretAccessGrantsGetter
 }
 
 // CertmanagerV1Client is used to interact with features provided by the cert-manager.io group.
@@ -45,14 +49,30 @@ func (c *CertmanagerV1Client) CertificateRequests(namespace string) CertificateR
 	return newCertificateRequests(c, namespace)
 }
 
+func (c *CertmanagerV1Client) ClusterCertificates() ClusterCertificateInterface {
+	return newClusterCertificates(c)
+}
+
 func (c *CertmanagerV1Client) ClusterIssuers() ClusterIssuerInterface {
 	return newClusterIssuers(c)
 }
 
+func (c *CertmanagerV1Client) IssuanceQuotas(namespace string) IssuanceQuotaInterface {
+	return newIssuanceQuotas(c, namespace)
+}
+
 func (c *CertmanagerV1Client) Issuers(namespace string) IssuerInterface {
 	return newIssuers(c, namespace)
 }
 
+func (c *CertmanagerV1Client) NotificationRoutes(namespace string) NotificationRouteInterface {
+	return newNotificationRoutes(c, namespace)
+}
+
+func (c *CertmanagerV1Client) SecretAccessGrants(namespace string) SecretAccessGrantInterface {
+	return newSecretAccessGrants(c, namespace)
+}
+
 // NewForConfig creates a new CertmanagerV1Client for the given config.
 func NewForConfig(c *rest.Config) (*CertmanagerV1Client, error) {
 	config := *c
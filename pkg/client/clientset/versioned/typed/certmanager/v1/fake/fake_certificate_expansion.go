@@ -0,0 +1,38 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/client/clientset/versioned/typed/certmanager/v1/internal"
+)
+
+func (c *FakeCertificates) UpdateStatusCondition(ctx context.Context, name string, condition cmapi.CertificateCondition) (*cmapi.Certificate, error) {
+	return internal.UpdateCertificateStatusCondition(ctx, c, name, condition)
+}
+
+func (c *FakeCertificates) MarkIssuing(ctx context.Context, name, reason, message string) (*cmapi.Certificate, error) {
+	return c.UpdateStatusCondition(ctx, name, cmapi.CertificateCondition{
+		Type:    cmapi.CertificateConditionIssuing,
+		Status:  cmmeta.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+}
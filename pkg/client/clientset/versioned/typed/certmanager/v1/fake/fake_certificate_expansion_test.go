@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+)
+
+func TestMarkIssuing(t *testing.T) {
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "crt", Generation: 3},
+		Status: cmapi.CertificateStatus{Conditions: []cmapi.CertificateCondition{
+			{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue},
+		}},
+	}
+	client := fake.NewSimpleClientset(crt)
+
+	result, err := client.CertmanagerV1().Certificates("ns").MarkIssuing(context.Background(), "crt", "ManuallyTriggered", "Re-issuance triggered")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := client.CertmanagerV1().Certificates("ns").Get(context.Background(), "crt", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, got := range []*cmapi.Certificate{result, stored} {
+		if !apiutil.CertificateHasCondition(got, cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}) {
+			t.Errorf("expected the Ready condition to be kept, got %v", got.Status.Conditions)
+		}
+		cond := apiutil.GetCertificateCondition(got, cmapi.CertificateConditionIssuing)
+		if cond == nil || cond.Status != cmmeta.ConditionTrue || cond.Reason != "ManuallyTriggered" || cond.ObservedGeneration != 3 || cond.LastTransitionTime == nil {
+			t.Errorf("unexpected Issuing condition: %+v", cond)
+		}
+	}
+}
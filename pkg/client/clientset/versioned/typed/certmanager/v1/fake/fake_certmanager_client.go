@@ -36,14 +36,30 @@ func (c *FakeCertmanagerV1) CertificateRequests(namespace string) v1.Certificate
 	return &FakeCertificateRequests{c, namespace}
 }
 
+func (c *FakeCertmanagerV1) ClusterCertificates() v1.ClusterCertificateInterface {
+	return &FakeClusterCertificates{c}
+}
+
 func (c *FakeCertmanagerV1) ClusterIssuers() v1.ClusterIssuerInterface {
 	return &FakeClusterIssuers{c}
 }
 
+func (c *FakeCertmanagerV1) IssuanceQuotas(namespace string) v1.IssuanceQuotaInterface {
+	return &FakeIssuanceQuotas{c, namespace}
+}
+
 func (c *FakeCertmanagerV1) Issuers(namespace string) v1.IssuerInterface {
 	return &FakeIssuers{c, namespace}
 }
 
+func (c *FakeCertmanagerV1) NotificationRoutes(namespace string) v1.NotificationRouteInterface {
+	return &FakeNotificationRoutes{c, namespace}
+}
+
+func (c *FakeCertmanagerV1) SecretAccessGrants(namespace string) v1.SecretAccessGrantInterface {
+	return &FakeSecretAccessGrants{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCertmanagerV1) RESTClient() rest.Interface {
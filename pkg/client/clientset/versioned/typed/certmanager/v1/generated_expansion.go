@@ -18,10 +18,16 @@ limitations under the License.
 
 package v1
 
-type CertificateExpansion interface{}
-
 type CertificateRequestExpansion interface{}
 
+type ClusterCertificateExpansion interface{}
+
 type ClusterIssuerExpansion interface{}
 
+type IssuanceQuotaExpansion interface{}
+
 type IssuerExpansion interface{}
+
+type NotificationRouteExpansion interface{}
+
+type SecretAccessGrantExpansion interface{}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package internal contains the implementation of the Certificate client
+// expansion methods, shared by the real and the fake clientsets.
+package internal
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// CertificateClient is the subset of the Certificate client used to update
+// the status conditions of a Certificate.
+type CertificateClient interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*cmapi.Certificate, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*cmapi.Certificate, error)
+}
+
+// statusConditionsPatch is a merge patch of the status conditions of a
+// Certificate. The resourceVersion of the Certificate that the conditions
+// were computed from is included, so that the patch fails with a conflict
+// if the Certificate has been modified since.
+type statusConditionsPatch struct {
+	Metadata statusConditionsPatchMetadata `json:"metadata"`
+	Status   statusConditionsPatchStatus   `json:"status"`
+}
+
+type statusConditionsPatchMetadata struct {
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+type statusConditionsPatchStatus struct {
+	Conditions []cmapi.CertificateCondition `json:"conditions"`
+}
+
+// UpdateCertificateStatusCondition sets the given condition on the named
+// Certificate, replacing any existing condition of the same type, and
+// patches the Certificate's status. The patch is retried if the Certificate
+// is modified concurrently.
+func UpdateCertificateStatusCondition(ctx context.Context, client CertificateClient, name string, condition cmapi.CertificateCondition) (*cmapi.Certificate, error) {
+	var result *cmapi.Certificate
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crt, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		patch, err := json.Marshal(statusConditionsPatch{
+			Metadata: statusConditionsPatchMetadata{ResourceVersion: crt.ResourceVersion},
+			Status:   statusConditionsPatchStatus{Conditions: setCondition(crt, condition)},
+		})
+		if err != nil {
+			return err
+		}
+		result, err = client.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+		return err
+	})
+	return result, err
+}
+
+// setCondition returns the conditions of the Certificate with the given
+// condition set. If the condition does not have a lastTransitionTime, it is
+// set to the current time if the condition's status has changed, and kept
+// otherwise. If the condition does not have an observedGeneration, it is set
+// to the Certificate's generation.
+func setCondition(crt *cmapi.Certificate, condition cmapi.CertificateCondition) []cmapi.CertificateCondition {
+	if condition.ObservedGeneration == 0 {
+		condition.ObservedGeneration = crt.Generation
+	}
+	if condition.LastTransitionTime == nil {
+		now := metav1.Now()
+		condition.LastTransitionTime = &now
+		for _, existing := range crt.Status.Conditions {
+			if existing.Type == condition.Type && existing.Status == condition.Status {
+				condition.LastTransitionTime = existing.LastTransitionTime
+			}
+		}
+	}
+
+	conditions := make([]cmapi.CertificateCondition, 0, len(crt.Status.Conditions)+1)
+	for _, existing := range crt.Status.Conditions {
+		if existing.Type != condition.Type {
+			conditions = append(conditions, existing)
+		}
+	}
+	return append(conditions, condition)
+}
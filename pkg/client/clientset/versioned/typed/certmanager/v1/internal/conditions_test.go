@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+)
+
+func TestSetCondition(t *testing.T) {
+	earlier := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	ready := cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue, LastTransitionTime: &earlier, ObservedGeneration: 1}
+	issuing := func(status cmmeta.ConditionStatus, ltt *metav1.Time) cmapi.CertificateCondition {
+		return cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: status, Reason: "Reason", LastTransitionTime: ltt, ObservedGeneration: 2}
+	}
+
+	tests := map[string]struct {
+		existing  []cmapi.CertificateCondition
+		condition cmapi.CertificateCondition
+		// transitioned is true if the lastTransitionTime of the condition is
+		// expected to be set to the current time, in which case the updated
+		// condition is appended to the expected conditions.
+		transitioned bool
+		expected     []cmapi.CertificateCondition
+	}{
+		"add a new condition": {
+			existing:     []cmapi.CertificateCondition{ready},
+			condition:    issuing(cmmeta.ConditionTrue, nil),
+			transitioned: true,
+			expected:     []cmapi.CertificateCondition{ready},
+		},
+		"keep the lastTransitionTime if the status has not changed": {
+			existing:  []cmapi.CertificateCondition{issuing(cmmeta.ConditionTrue, &earlier), ready},
+			condition: issuing(cmmeta.ConditionTrue, nil),
+			expected:  []cmapi.CertificateCondition{ready, issuing(cmmeta.ConditionTrue, &earlier)},
+		},
+		"update the lastTransitionTime if the status has changed": {
+			existing:     []cmapi.CertificateCondition{issuing(cmmeta.ConditionFalse, &earlier), ready},
+			condition:    issuing(cmmeta.ConditionTrue, nil),
+			transitioned: true,
+			expected:     []cmapi.CertificateCondition{ready},
+		},
+		"keep an explicit lastTransitionTime": {
+			condition: issuing(cmmeta.ConditionTrue, &earlier),
+			expected:  []cmapi.CertificateCondition{issuing(cmmeta.ConditionTrue, &earlier)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     cmapi.CertificateStatus{Conditions: test.existing},
+			}
+			condition := test.condition
+			condition.ObservedGeneration = 0
+
+			conditions := setCondition(crt, condition)
+
+			last := conditions[len(conditions)-1]
+			if test.transitioned {
+				if last.LastTransitionTime == nil || last.LastTransitionTime.Time.Before(earlier.Add(time.Minute)) {
+					t.Fatalf("expected lastTransitionTime to be set to the current time, got %v", last.LastTransitionTime)
+				}
+				test.expected = append(test.expected, issuing(cmmeta.ConditionTrue, last.LastTransitionTime))
+			}
+			if !reflect.DeepEqual(test.expected, conditions) {
+				t.Errorf("unexpected conditions\nexp=%+v\ngot=%+v", test.expected, conditions)
+			}
+			if len(crt.Status.Conditions) != len(test.existing) {
+				t.Errorf("expected the Certificate not to be modified")
+			}
+		})
+	}
+}
@@ -28,10 +28,18 @@ type Interface interface {
 	Certificates() CertificateInformer
 	// CertificateRequests returns a CertificateRequestInformer.
 	CertificateRequests() CertificateRequestInformer
+	// ClusterCertificates returns a ClusterCertificateInformer.
+	ClusterCertificates() ClusterCertificateInformer
 	// ClusterIssuers returns a ClusterIssuerInformer.
 	ClusterIssuers() ClusterIssuerInformer
+	// IssuanceQuotas returns a IssuanceQuotaInformer.
+	IssuanceQuotas() IssuanceQuotaInformer
 	// Issuers returns a IssuerInformer.
 	Issuers() IssuerInformer
+	// NotificationRoutes returns a NotificationRouteInformer.
+	NotificationRoutes() NotificationRouteInformer
+	// SecretAccessGrants returns a SecretAccessGrantInformer.
+	SecretAccessGrants() SecretAccessGrantInformer
 }
 
 type version struct {
@@ -55,12 +63,32 @@ func (v *version) CertificateRequests() CertificateRequestInformer {
 	return &certificateRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterCertificates returns a ClusterCertificateInformer.
+func (v *version) ClusterCertificates() ClusterCertificateInformer {
+	return &clusterCertificateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterIssuers returns a ClusterIssuerInformer.
 func (v *version) ClusterIssuers() ClusterIssuerInformer {
 	return &clusterIssuerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// IssuanceQuotas returns a IssuanceQuotaInformer.
+func (v *version) IssuanceQuotas() IssuanceQuotaInformer {
+	return &issuanceQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Issuers returns a IssuerInformer.
 func (v *version) Issuers() IssuerInformer {
 	return &issuerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// NotificationRoutes returns a NotificationRouteInformer.
+func (v *version) NotificationRoutes() NotificationRouteInformer {
+	return &notificationRouteInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SecretAccessGrants returns a SecretAccessGrantInformer.
+func (v *version) SecretAccessGrants() SecretAccessGrantInformer {
+	return &secretAccessGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -88,10 +88,18 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().Certificates().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("certificaterequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().CertificateRequests().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("clustercertificates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().ClusterCertificates().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("clusterissuers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().ClusterIssuers().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("issuancequotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().IssuanceQuotas().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("issuers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().Issuers().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("notificationroutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().NotificationRoutes().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("secretaccessgrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().SecretAccessGrants().Informer()}, nil
 
 		// Group=cert-manager.io, Version=v1alpha2
 	case certmanagerv1alpha2.SchemeGroupVersion.WithResource("certificates"):
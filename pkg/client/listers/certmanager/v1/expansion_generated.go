@@ -34,10 +34,22 @@ type CertificateRequestListerExpansion interface{}
 // CertificateRequestNamespaceLister.
 type CertificateRequestNamespaceListerExpansion interface{}
 
+// ClusterCertificateListerExpansion allows custom methods to be added to
+// ClusterCertificateLister.
+type ClusterCertificateListerExpansion interface{}
+
 // ClusterIssuerListerExpansion allows custom methods to be added to
 // ClusterIssuerLister.
 type ClusterIssuerListerExpansion interface{}
 
+// IssuanceQuotaListerExpansion allows custom methods to be added to
+// IssuanceQuotaLister.
+type IssuanceQuotaListerExpansion interface{}
+
+// IssuanceQuotaNamespaceListerExpansion allows custom methods to be added to
+// IssuanceQuotaNamespaceLister.
+type IssuanceQuotaNamespaceListerExpansion interface{}
+
 // IssuerListerExpansion allows custom methods to be added to
 // IssuerLister.
 type IssuerListerExpansion interface{}
@@ -45,3 +57,19 @@ type IssuerListerExpansion interface{}
 // IssuerNamespaceListerExpansion allows custom methods to be added to
 // IssuerNamespaceLister.
 type IssuerNamespaceListerExpansion interface{}
+
+// NotificationRouteListerExpansion allows custom methods to be added to
+// NotificationRouteLister.
+type NotificationRouteListerExpansion interface{}
+
+// NotificationRouteNamespaceListerExpansion allows custom methods to be added to
+// NotificationRouteNamespaceLister.
+type NotificationRouteNamespaceListerExpansion interface{}
+
+// SecretAccessGrantListerExpansion allows custom methods to be added to
+// SecretAccessGrantLister.
+type SecretAccessGrantListerExpansion interface{}
+
+// SecretAccessGrantNamespaceListerExpansion allows custom methods to be added to
+// SecretAccessGrantNamespaceLister.
+type SecretAccessGrantNamespaceListerExpansion interface{}
@@ -0,0 +1,25 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testing provides utilities for unit testing controllers and other
+// consumers of the cert-manager APIs against fake clientsets, informers and
+// listers, without a running API server.
+//
+// The fake clientsets are generated alongside the real clientsets, in
+// pkg/client/clientset/versioned/fake. This package builds on them to
+// construct fake clientsets and informer factories which are pre-populated
+// with objects, and listers which are backed by an in-memory store.
+package testing
@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+)
+
+// Fixture holds fake cert-manager and Kubernetes clientsets which are
+// populated with the objects passed to NewFixture, and shared informer
+// factories which are backed by them.
+type Fixture struct {
+	CMClient            *cmfake.Clientset
+	KubeClient          *kubefake.Clientset
+	CMInformerFactory   cminformers.SharedInformerFactory
+	KubeInformerFactory kubeinformers.SharedInformerFactory
+}
+
+// NewFixture returns a Fixture whose fake clientsets contain the given
+// cert-manager and Kubernetes objects.
+func NewFixture(cmObjects, kubeObjects []runtime.Object) *Fixture {
+	cmClient := cmfake.NewSimpleClientset(cmObjects...)
+	kubeClient := kubefake.NewSimpleClientset(kubeObjects...)
+	return &Fixture{
+		CMClient:            cmClient,
+		KubeClient:          kubeClient,
+		CMInformerFactory:   cminformers.NewSharedInformerFactory(cmClient, 0),
+		KubeInformerFactory: kubeinformers.NewSharedInformerFactory(kubeClient, 0),
+	}
+}
+
+// Start starts the informers which have been requested from the Fixture's
+// informer factories, and waits for their caches to sync. Informers must be
+// requested, e.g. by calling their Lister or Informer method, before Start
+// is called. The informers are stopped when the given context is cancelled.
+func (f *Fixture) Start(ctx context.Context) error {
+	f.CMInformerFactory.Start(ctx.Done())
+	f.KubeInformerFactory.Start(ctx.Done())
+	for typ, synced := range f.CMInformerFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to sync informer for %v", typ)
+		}
+	}
+	for typ, synced := range f.KubeInformerFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to sync informer for %v", typ)
+		}
+	}
+	return nil
+}
+
+// Actions returns the actions performed against the Fixture's clientsets,
+// excluding the list and watch actions performed by informers.
+func (f *Fixture) Actions() []coretesting.Action {
+	var actions []coretesting.Action
+	for _, a := range append(f.CMClient.Actions(), f.KubeClient.Actions()...) {
+		if a.GetVerb() == "list" || a.GetVerb() == "watch" {
+			continue
+		}
+		actions = append(actions, a)
+	}
+	return actions
+}
+
+// ClearActions clears the actions recorded by the Fixture's clientsets, for
+// example to discard the actions performed while setting up a test.
+func (f *Fixture) ClearActions() {
+	f.CMClient.ClearActions()
+	f.KubeClient.ClearActions()
+}
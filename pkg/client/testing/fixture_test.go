@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestFixture(t *testing.T) {
+	crt := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "crt"}}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "secret"}}
+
+	f := NewFixture([]runtime.Object{crt}, []runtime.Object{secret})
+	crtLister := f.CMInformerFactory.Certmanager().V1().Certificates().Lister()
+	secretLister := f.KubeInformerFactory.Core().V1().Secrets().Lister()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := f.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := crtLister.Certificates("ns").Get("crt"); err != nil {
+		t.Errorf("expected Certificate to be listed: %v", err)
+	}
+	if _, err := secretLister.Secrets("ns").Get("secret"); err != nil {
+		t.Errorf("expected Secret to be listed: %v", err)
+	}
+	if actions := f.Actions(); len(actions) != 0 {
+		t.Errorf("expected informer actions to be excluded, got %v", actions)
+	}
+
+	if err := f.CMClient.CertmanagerV1().Certificates("ns").Delete(ctx, "crt", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if actions := f.Actions(); len(actions) != 1 || actions[0].GetVerb() != "delete" {
+		t.Errorf("expected a single delete action, got %v", actions)
+	}
+	f.ClearActions()
+	if actions := f.Actions(); len(actions) != 0 {
+		t.Errorf("expected actions to be cleared, got %v", actions)
+	}
+}
+
+func TestNewCertificateLister(t *testing.T) {
+	lister := NewCertificateLister(
+		&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "a"}},
+		&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "b"}},
+		&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "a"}},
+	)
+
+	crts, err := lister.Certificates("ns").List(labels.Everything())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(crts) != 2 {
+		t.Errorf("expected 2 Certificates in namespace, got %d", len(crts))
+	}
+	if _, err := lister.Certificates("other").Get("a"); err != nil {
+		t.Errorf("expected Certificate to be found: %v", err)
+	}
+	if _, err := lister.Certificates("other").Get("b"); err == nil {
+		t.Errorf("expected Certificate not to be found")
+	}
+}
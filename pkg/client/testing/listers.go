@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmacmelisters "github.com/jetstack/cert-manager/pkg/client/listers/acme/v1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+)
+
+// The listers returned by the functions below are backed by an in-memory
+// store containing the given objects, and can be used to test code which
+// only reads from listers without starting any informers.
+
+// NewCertificateLister returns a CertificateLister listing the given
+// Certificates.
+func NewCertificateLister(crts ...*cmapi.Certificate) cmlisters.CertificateLister {
+	indexer := newIndexer()
+	for _, crt := range crts {
+		add(indexer, crt)
+	}
+	return cmlisters.NewCertificateLister(indexer)
+}
+
+// NewCertificateRequestLister returns a CertificateRequestLister listing the
+// given CertificateRequests.
+func NewCertificateRequestLister(reqs ...*cmapi.CertificateRequest) cmlisters.CertificateRequestLister {
+	indexer := newIndexer()
+	for _, req := range reqs {
+		add(indexer, req)
+	}
+	return cmlisters.NewCertificateRequestLister(indexer)
+}
+
+// NewIssuerLister returns an IssuerLister listing the given Issuers.
+func NewIssuerLister(issuers ...*cmapi.Issuer) cmlisters.IssuerLister {
+	indexer := newIndexer()
+	for _, issuer := range issuers {
+		add(indexer, issuer)
+	}
+	return cmlisters.NewIssuerLister(indexer)
+}
+
+// NewClusterIssuerLister returns a ClusterIssuerLister listing the given
+// ClusterIssuers.
+func NewClusterIssuerLister(issuers ...*cmapi.ClusterIssuer) cmlisters.ClusterIssuerLister {
+	indexer := newIndexer()
+	for _, issuer := range issuers {
+		add(indexer, issuer)
+	}
+	return cmlisters.NewClusterIssuerLister(indexer)
+}
+
+// NewOrderLister returns an OrderLister listing the given Orders.
+func NewOrderLister(orders ...*cmacme.Order) cmacmelisters.OrderLister {
+	indexer := newIndexer()
+	for _, order := range orders {
+		add(indexer, order)
+	}
+	return cmacmelisters.NewOrderLister(indexer)
+}
+
+// NewChallengeLister returns a ChallengeLister listing the given Challenges.
+func NewChallengeLister(challenges ...*cmacme.Challenge) cmacmelisters.ChallengeLister {
+	indexer := newIndexer()
+	for _, ch := range challenges {
+		add(indexer, ch)
+	}
+	return cmacmelisters.NewChallengeLister(indexer)
+}
+
+// newIndexer returns an indexer with the namespace index used by the
+// generated listers.
+func newIndexer() cache.Indexer {
+	return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+// add adds the object to the indexer. Adding an object only fails if its
+// key cannot be computed, which cannot happen for API objects.
+func add(indexer cache.Indexer, obj interface{}) {
+	if err := indexer.Add(obj); err != nil {
+		panic(err)
+	}
+}
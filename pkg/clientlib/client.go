@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clientlib provides helpers for programs which request and consume
+// certificates from cert-manager using the Kubernetes API, such as operators
+// embedding certificate issuance.
+package clientlib
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+)
+
+const (
+	// ReasonManuallyTriggered is the reason set on the Issuing condition of a
+	// Certificate when re-issuance is triggered using RenewNow.
+	ReasonManuallyTriggered = "ManuallyTriggered"
+)
+
+// Client wraps the Kubernetes and cert-manager clientsets with helpers for
+// requesting certificates and consuming the resulting key pairs.
+type Client struct {
+	kubeClient kubernetes.Interface
+	cmClient   cmclient.Interface
+}
+
+// New returns a new Client using the given clientsets.
+func New(kubeClient kubernetes.Interface, cmClient cmclient.Interface) *Client {
+	return &Client{
+		kubeClient: kubeClient,
+		cmClient:   cmClient,
+	}
+}
+
+// NewForConfig returns a new Client for the given REST config.
+func NewForConfig(restConfig *rest.Config) (*Client, error) {
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating kubernetes client: %w", err)
+	}
+
+	cmClient, err := cmclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cert-manager client: %w", err)
+	}
+
+	return New(kubeClient, cmClient), nil
+}
+
+// CreateCertificateAndWait will create the given Certificate and block until
+// it has become Ready, its issuance has failed, or the context is cancelled.
+// The Ready Certificate is returned.
+func (c *Client) CreateCertificateAndWait(ctx context.Context, crt *cmapi.Certificate) (*cmapi.Certificate, error) {
+	crt, err := c.cmClient.CertmanagerV1().Certificates(crt.Namespace).Create(ctx, crt, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error creating Certificate: %w", err)
+	}
+
+	return c.WaitForReady(ctx, crt.Namespace, crt.Name)
+}
+
+// WaitForReady will block until the named Certificate is Ready for its
+// current generation and is not being re-issued. If the most recent issuance
+// attempt has failed, an *IssuanceFailedError is returned. If the context is
+// cancelled before the Certificate becomes Ready, a *TimeoutError is returned.
+// Waiting is performed using a watch on the Certificate resource.
+func (c *Client) WaitForReady(ctx context.Context, namespace, name string) (*cmapi.Certificate, error) {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return c.cmClient.CertmanagerV1().Certificates(namespace).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return c.cmClient.CertmanagerV1().Certificates(namespace).Watch(ctx, options)
+		},
+	}
+
+	ev, err := watchtools.UntilWithSync(ctx, lw, &cmapi.Certificate{}, nil, func(ev watch.Event) (bool, error) {
+		crt, ok := ev.Object.(*cmapi.Certificate)
+		if !ok || crt.Name != name {
+			return false, nil
+		}
+
+		if ev.Type == watch.Deleted {
+			return false, &NotFoundError{Namespace: namespace, Name: name}
+		}
+
+		if err := issuanceFailed(crt); err != nil {
+			return false, err
+		}
+
+		return certificateIsReady(crt), nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, &TimeoutError{Namespace: namespace, Name: name, Err: ctx.Err()}
+		}
+		return nil, err
+	}
+
+	return ev.Object.(*cmapi.Certificate), nil
+}
+
+// RenewNow will trigger immediate re-issuance of the named Certificate, in
+// the same way as `kubectl cert-manager renew`. WaitForReady may then be used
+// to wait for the re-issuance to complete.
+func (c *Client) RenewNow(ctx context.Context, namespace, name string) error {
+	crt, err := c.cmClient.CertmanagerV1().Certificates(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return &NotFoundError{Namespace: namespace, Name: name}
+	}
+	if err != nil {
+		return err
+	}
+
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue,
+		ReasonManuallyTriggered, "Certificate re-issuance manually triggered")
+	if _, err := c.cmClient.CertmanagerV1().Certificates(namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to trigger issuance of Certificate %s/%s: %w", namespace, name, err)
+	}
+
+	return nil
+}
+
+// certificateIsReady returns true if the Certificate is Ready for its current
+// generation, and is not currently being issued.
+func certificateIsReady(crt *cmapi.Certificate) bool {
+	if apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
+		Type:   cmapi.CertificateConditionIssuing,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		return false
+	}
+
+	return apiutil.CertificateHasConditionWithObservedGeneration(crt, cmapi.CertificateCondition{
+		Type:               cmapi.CertificateConditionReady,
+		Status:             cmmeta.ConditionTrue,
+		ObservedGeneration: crt.Generation,
+	})
+}
+
+// issuanceFailed returns an *IssuanceFailedError if the most recent issuance
+// attempt for the current generation of the Certificate has failed.
+func issuanceFailed(crt *cmapi.Certificate) error {
+	cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing)
+	if cond == nil || cond.Status != cmmeta.ConditionFalse || cond.ObservedGeneration < crt.Generation {
+		return nil
+	}
+
+	return &IssuanceFailedError{
+		Namespace: crt.Namespace,
+		Name:      crt.Name,
+		Reason:    cond.Reason,
+		Message:   cond.Message,
+	}
+}
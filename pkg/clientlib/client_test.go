@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientlib
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestWaitForReady(t *testing.T) {
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateGeneration(2),
+	)
+
+	tests := map[string]struct {
+		existing *cmapi.Certificate
+		// update, if set, is applied to the Certificate after waiting has
+		// started.
+		update func(*cmapi.Certificate)
+
+		expReady bool
+		expErr   interface{}
+	}{
+		"if the Certificate is Ready for the current generation, return it": {
+			existing: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue, ObservedGeneration: 2}),
+			),
+			expReady: true,
+		},
+		"if the Certificate becomes Ready whilst waiting, return it": {
+			existing: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionFalse, ObservedGeneration: 2}),
+			),
+			update: func(crt *cmapi.Certificate) {
+				apiutil.SetCertificateCondition(crt, 2, cmapi.CertificateConditionReady, cmmeta.ConditionTrue, "Ready", "")
+			},
+			expReady: true,
+		},
+		"if the Certificate is Ready but being re-issued, wait": {
+			existing: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue, ObservedGeneration: 2}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue, ObservedGeneration: 2}),
+			),
+			expErr: new(*TimeoutError),
+		},
+		"if the Certificate is Ready for an old generation, wait": {
+			existing: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue, ObservedGeneration: 1}),
+			),
+			expErr: new(*TimeoutError),
+		},
+		"if issuance of the current generation has failed, return an IssuanceFailedError": {
+			existing: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionFalse, Reason: "Failed", Message: "no", ObservedGeneration: 2}),
+			),
+			expErr: new(*IssuanceFailedError),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmClient := cmfake.NewSimpleClientset(test.existing)
+			client := New(kubefake.NewSimpleClientset(), cmClient)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			if test.update != nil {
+				go func() {
+					// Give the watch a chance to be established.
+					time.Sleep(100 * time.Millisecond)
+					crt := test.existing.DeepCopy()
+					test.update(crt)
+					if _, err := cmClient.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(context.TODO(), crt, metav1.UpdateOptions{}); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+
+			crt, err := client.WaitForReady(ctx, test.existing.Namespace, test.existing.Name)
+			if test.expErr != nil {
+				if !errors.As(err, test.expErr) {
+					t.Errorf("expected error of type %T, got: %v", test.expErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.expReady != certificateIsReady(crt) {
+				t.Errorf("expected ready=%t, got Certificate %#v", test.expReady, crt)
+			}
+		})
+	}
+}
+
+func TestRenewNow(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateGeneration(2),
+	)
+	cmClient := cmfake.NewSimpleClientset(crt)
+	client := New(kubefake.NewSimpleClientset(), cmClient)
+
+	if err := client.RenewNow(context.TODO(), crt.Namespace, crt.Name); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := cmClient.CertmanagerV1().Certificates(crt.Namespace).Get(context.TODO(), crt.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cond := apiutil.GetCertificateCondition(got, cmapi.CertificateConditionIssuing)
+	if cond == nil || cond.Status != cmmeta.ConditionTrue || cond.Reason != ReasonManuallyTriggered || cond.ObservedGeneration != 2 {
+		t.Errorf("expected Issuing condition to be set, got %#v", cond)
+	}
+
+	var notFound *NotFoundError
+	if err := client.RenewNow(context.TODO(), crt.Namespace, "does-not-exist"); !errors.As(err, &notFound) {
+		t.Errorf("expected NotFoundError, got: %v", err)
+	}
+}
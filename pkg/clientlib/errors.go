@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientlib
+
+import (
+	"fmt"
+)
+
+// NotFoundError is returned when the named Certificate does not exist, or
+// was deleted whilst being waited on.
+type NotFoundError struct {
+	Namespace, Name string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("certificate %s/%s not found", e.Namespace, e.Name)
+}
+
+// IssuanceFailedError is returned when the most recent issuance attempt of a
+// Certificate has failed. Issuance will be retried by cert-manager with an
+// exponential back-off.
+type IssuanceFailedError struct {
+	Namespace, Name string
+
+	// Reason and Message are those of the Certificate's Issuing condition.
+	Reason, Message string
+}
+
+func (e *IssuanceFailedError) Error() string {
+	return fmt.Sprintf("issuance of certificate %s/%s failed: %s: %s", e.Namespace, e.Name, e.Reason, e.Message)
+}
+
+// TimeoutError is returned when the context is cancelled before a
+// Certificate becomes Ready.
+type TimeoutError struct {
+	Namespace, Name string
+
+	// Err is the error returned by the context.
+	Err error
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timed out waiting for certificate %s/%s to become ready: %v", e.Namespace, e.Name, e.Err)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// KeyPairNotReadyError is returned when the Secret of a Certificate does not
+// exist or does not yet contain a private key and signed certificate.
+type KeyPairNotReadyError struct {
+	Namespace, SecretName string
+
+	// Reason is a human readable explanation of why the key pair is not
+	// ready.
+	Reason string
+}
+
+func (e *KeyPairNotReadyError) Error() string {
+	return fmt.Sprintf("key pair in secret %s/%s is not ready: %s", e.Namespace, e.SecretName, e.Reason)
+}
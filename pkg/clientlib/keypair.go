@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientlib
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// KeyPair is a private key and signed certificate chain read from the Secret
+// of a Certificate.
+type KeyPair struct {
+	// PrivateKey is the decoded private key.
+	PrivateKey crypto.Signer
+	// Certificates is the decoded signed certificate chain, with the leaf
+	// certificate first.
+	Certificates []*x509.Certificate
+
+	// PrivateKeyPEM, CertificatePEM and CAPEM are the raw PEM encoded
+	// contents of the `tls.key`, `tls.crt` and `ca.crt` entries of the
+	// Secret. CAPEM may be empty if the issuer did not return a CA.
+	PrivateKeyPEM, CertificatePEM, CAPEM []byte
+}
+
+// TLSCertificate returns the key pair as a tls.Certificate, for use in a
+// tls.Config.
+func (k *KeyPair) TLSCertificate() (tls.Certificate, error) {
+	return tls.X509KeyPair(k.CertificatePEM, k.PrivateKeyPEM)
+}
+
+// FetchKeyPair will read the private key and signed certificate chain from
+// the Secret of the named Certificate. A *KeyPairNotReadyError is returned if
+// the Secret does not yet contain a key pair, and a *NotFoundError if the
+// Certificate does not exist.
+func (c *Client) FetchKeyPair(ctx context.Context, namespace, name string) (*KeyPair, error) {
+	crt, err := c.cmClient.CertmanagerV1().Certificates(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, &NotFoundError{Namespace: namespace, Name: name}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secretName := crt.Spec.SecretName
+	secret, err := c.kubeClient.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, &KeyPairNotReadyError{Namespace: namespace, SecretName: secretName, Reason: "secret does not exist"}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keyPEM, certPEM := secret.Data[corev1.TLSPrivateKeyKey], secret.Data[corev1.TLSCertKey]
+	if len(keyPEM) == 0 || len(certPEM) == 0 {
+		return nil, &KeyPairNotReadyError{Namespace: namespace, SecretName: secretName, Reason: "secret does not contain a private key and certificate"}
+	}
+
+	pk, err := pki.DecodePrivateKeyBytes(keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding private key in secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	certs, err := pki.DecodeX509CertificateChainBytes(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding certificate chain in secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	return &KeyPair{
+		PrivateKey:     pk,
+		Certificates:   certs,
+		PrivateKeyPEM:  keyPEM,
+		CertificatePEM: certPEM,
+		CAPEM:          secret.Data[cmmeta.TLSCAKey],
+	}, nil
+}
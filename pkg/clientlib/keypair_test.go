@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientlib
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestFetchKeyPair(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateCommonName("example.com"),
+	)
+
+	pk, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM, err := pki.EncodePrivateKey(pk, cmapi.PKCS1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := pki.GenerateTemplate(crt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, _, err := pki.SignCertificate(template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "output"},
+			Data:       data,
+		}
+	}
+
+	tests := map[string]struct {
+		name        string
+		kubeObjects []runtime.Object
+
+		expErr interface{}
+	}{
+		"if the Certificate does not exist, return a NotFoundError": {
+			name:   "does-not-exist",
+			expErr: new(*NotFoundError),
+		},
+		"if the Secret does not exist, return a KeyPairNotReadyError": {
+			name:   "test",
+			expErr: new(*KeyPairNotReadyError),
+		},
+		"if the Secret does not contain a certificate, return a KeyPairNotReadyError": {
+			name:        "test",
+			kubeObjects: []runtime.Object{secret(map[string][]byte{corev1.TLSPrivateKeyKey: keyPEM})},
+			expErr:      new(*KeyPairNotReadyError),
+		},
+		"if the Secret contains a key pair, return it": {
+			name: "test",
+			kubeObjects: []runtime.Object{secret(map[string][]byte{
+				corev1.TLSPrivateKeyKey: keyPEM,
+				corev1.TLSCertKey:       certPEM,
+			})},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := New(kubefake.NewSimpleClientset(test.kubeObjects...), cmfake.NewSimpleClientset(crt))
+
+			kp, err := client.FetchKeyPair(context.TODO(), gen.DefaultTestNamespace, test.name)
+			if test.expErr != nil {
+				if !errors.As(err, test.expErr) {
+					t.Errorf("expected error of type %T, got: %v", test.expErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(kp.Certificates) != 1 || kp.Certificates[0].Subject.CommonName != "example.com" {
+				t.Errorf("unexpected certificate chain: %v", kp.Certificates)
+			}
+			if ok, err := pki.PublicKeyMatchesCertificate(kp.PrivateKey.Public(), kp.Certificates[0]); err != nil || !ok {
+				t.Errorf("expected private key to match certificate, got ok=%t err=%v", ok, err)
+			}
+			if _, err := kp.TLSCertificate(); err != nil {
+				t.Errorf("unexpected error building tls.Certificate: %v", err)
+			}
+		})
+	}
+}
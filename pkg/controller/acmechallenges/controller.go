@@ -21,20 +21,26 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/jetstack/cert-manager/pkg/acme"
 	"github.com/jetstack/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	cmacmelisters "github.com/jetstack/cert-manager/pkg/client/listers/acme/v1"
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/acmechallenges/scheduler"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	"github.com/jetstack/cert-manager/pkg/internal/ingress"
 	"github.com/jetstack/cert-manager/pkg/issuer"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/http"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/tracing"
 	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -78,6 +84,9 @@ type controller struct {
 
 	dns01Nameservers []string
 
+	// the namespace that the Secrets of ClusterIssuers are stored in
+	clusterResourceNamespace string
+
 	DNS01CheckRetryPeriod time.Duration
 }
 
@@ -133,6 +142,9 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 
 	// register handler functions
 	challengeInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	// retry Challenges as soon as the credentials of their DNS provider
+	// change, rather than waiting for them to be retried after a back-off
+	secretInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleSecret})
 
 	c.helper = issuer.NewHelper(c.issuerLister, c.clusterIssuerLister)
 	c.scheduler = scheduler.New(logf.NewContext(ctx.RootContext, c.log), c.challengeLister, ctx.SchedulerOptions.MaxConcurrentChallenges)
@@ -152,10 +164,57 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	// read options from context
 	c.dns01Nameservers = ctx.ACMEOptions.DNS01Nameservers
 	c.DNS01CheckRetryPeriod = ctx.ACMEOptions.DNS01CheckRetryPeriod
+	c.clusterResourceNamespace = ctx.IssuerOptions.ClusterResourceNamespace
 
 	return c.queue, mustSync, nil
 }
 
+// handleSecret enqueues the Challenges which have not yet reached a final
+// state and whose DNS01 solver reads its credentials from the given Secret.
+func (c *controller) handleSecret(obj interface{}) {
+	secret, ok := obj.(metav1.Object)
+	if !ok {
+		c.log.Error(nil, "item passed to handleSecret does not implement metav1.Object")
+		return
+	}
+
+	challenges, err := c.challengeLister.List(labels.Everything())
+	if err != nil {
+		c.log.Error(err, "error listing challenges")
+		return
+	}
+
+	for _, ch := range challenges {
+		if ch.Spec.Solver.DNS01 == nil || acme.IsFinalState(ch.Status.State) {
+			continue
+		}
+
+		// the credentials of ClusterIssuers are read from the cluster
+		// resource namespace, and those of Issuers from the namespace of the
+		// Challenge
+		namespace := ch.Namespace
+		if ch.Spec.IssuerRef.Kind == cmapi.ClusterIssuerKind {
+			namespace = c.clusterResourceNamespace
+		}
+		if namespace != secret.GetNamespace() {
+			continue
+		}
+
+		for _, name := range dns.SecretNames(ch.Spec.Solver.DNS01) {
+			if name != secret.GetName() {
+				continue
+			}
+			key, err := controllerpkg.KeyFunc(ch)
+			if err != nil {
+				c.log.Error(err, "error computing key for resource")
+				break
+			}
+			c.queue.Add(key)
+			break
+		}
+	}
+}
+
 // MaxChallengesPerSchedule is the maximum number of challenges that can be
 // scheduled with a single call to the scheduler.
 // This provides a very crude rate limit on how many challenges we will schedule
@@ -188,7 +247,7 @@ func (c *controller) runScheduler(ctx context.Context) {
 			return
 		}
 
-		c.recorder.Event(ch, corev1.EventTypeNormal, "Started", "Challenge scheduled for processing")
+		c.recorder.Event(ch, corev1.EventTypeNormal, events.ReasonStarted, "Challenge scheduled for processing")
 	}
 
 	if len(toSchedule) > 0 {
@@ -216,7 +275,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	}
 
 	ctx = logf.NewContext(ctx, logf.WithResource(log, ch))
-	return c.Sync(ctx, ch)
+	ctx, span := tracing.Start(tracing.ObjectContext(ctx, ch), ControllerName, cmacme.ChallengeKind, ch)
+	err = c.Sync(ctx, ch)
+	tracing.End(span, err)
+	return err
 }
 
 const (
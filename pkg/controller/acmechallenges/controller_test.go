@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmacmelisters "github.com/jetstack/cert-manager/pkg/client/listers/acme/v1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestHandleSecret(t *testing.T) {
+	cloudflare := func(secretName string) gen.ChallengeModifier {
+		return func(ch *cmacme.Challenge) {
+			ch.Spec.Solver = cmacme.ACMEChallengeSolver{
+				DNS01: &cmacme.ACMEChallengeSolverDNS01{
+					Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+						APIToken: &cmmeta.SecretKeySelector{
+							LocalObjectReference: cmmeta.LocalObjectReference{Name: secretName},
+							Key:                  "token",
+						},
+					},
+				},
+			}
+		}
+	}
+	issuerRef := gen.SetChallengeIssuer(cmmeta.ObjectReference{Name: "issuer", Kind: v1.IssuerKind})
+	clusterIssuerRef := gen.SetChallengeIssuer(cmmeta.ObjectReference{Name: "issuer", Kind: v1.ClusterIssuerKind})
+
+	challenges := []*cmacme.Challenge{
+		gen.Challenge("pending", gen.SetChallengeNamespace("ns"), issuerRef, cloudflare("creds"), gen.SetChallengeState(cmacme.Pending)),
+		gen.Challenge("other-secret", gen.SetChallengeNamespace("ns"), issuerRef, cloudflare("other"), gen.SetChallengeState(cmacme.Pending)),
+		gen.Challenge("valid", gen.SetChallengeNamespace("ns"), issuerRef, cloudflare("creds"), gen.SetChallengeState(cmacme.Valid)),
+		gen.Challenge("http01", gen.SetChallengeNamespace("ns"), issuerRef),
+		gen.Challenge("other-namespace", gen.SetChallengeNamespace("other-ns"), issuerRef, cloudflare("creds")),
+		gen.Challenge("cluster-issuer", gen.SetChallengeNamespace("other-ns"), clusterIssuerRef, cloudflare("creds")),
+	}
+
+	tests := map[string]struct {
+		secret       *corev1.Secret
+		expectedKeys []string
+	}{
+		"enqueues unfinished DNS01 challenges using the secret": {
+			secret:       &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "creds"}},
+			expectedKeys: []string{"ns/pending"},
+		},
+		"enqueues ClusterIssuer challenges for secrets in the cluster resource namespace": {
+			secret:       &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "cert-manager", Name: "creds"}},
+			expectedKeys: []string{"other-ns/cluster-issuer"},
+		},
+		"enqueues nothing for an unreferenced secret": {
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "unrelated"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, ch := range challenges {
+				if err := indexer.Add(ch); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer queue.ShutDown()
+			c := &controller{
+				challengeLister:          cmacmelisters.NewChallengeLister(indexer),
+				queue:                    queue,
+				log:                      logf.Log,
+				clusterResourceNamespace: "cert-manager",
+			}
+			c.handleSecret(test.secret)
+
+			var keys []string
+			for queue.Len() > 0 {
+				item, _ := queue.Get()
+				keys = append(keys, item.(string))
+				queue.Done(item)
+			}
+			sort.Strings(keys)
+			if len(keys) != len(test.expectedKeys) {
+				t.Fatalf("expected keys %v, got %v", test.expectedKeys, keys)
+			}
+			for i := range keys {
+				if keys[i] != test.expectedKeys[i] {
+					t.Errorf("expected keys %v, got %v", test.expectedKeys, keys)
+				}
+			}
+		})
+	}
+}
@@ -32,20 +32,13 @@ import (
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	"github.com/jetstack/cert-manager/pkg/feature"
 	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
 )
 
-const (
-	reasonDomainVerified = "DomainVerified"
-	reasonCleanUpError   = "CleanUpError"
-	reasonPresentError   = "PresentError"
-	reasonPresented      = "Presented"
-	reasonFailed         = "Failed"
-)
-
 // solver solves ACME challenges by presenting the given token and key in an
 // appropriate way given the config in the Issuer and Certificate.
 type solver interface {
@@ -105,7 +98,7 @@ func (c *controller) Sync(ctx context.Context, ch *cmacme.Challenge) (err error)
 
 			err = solver.CleanUp(ctx, genericIssuer, ch)
 			if err != nil {
-				c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "Error cleaning up challenge: %v", err)
+				c.recorder.Eventf(ch, corev1.EventTypeWarning, events.ReasonCleanUpError, "Error cleaning up challenge: %v", err)
 				ch.Status.Reason = err.Error()
 				log.Error(err, "error cleaning up challenge")
 				return err
@@ -172,13 +165,13 @@ func (c *controller) Sync(ctx context.Context, ch *cmacme.Challenge) (err error)
 	if !ch.Status.Presented {
 		err := solver.Present(ctx, genericIssuer, ch)
 		if err != nil {
-			c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonPresentError, "Error presenting challenge: %v", err)
+			c.recorder.Eventf(ch, corev1.EventTypeWarning, events.ReasonPresentError, "Error presenting challenge: %v", err)
 			ch.Status.Reason = err.Error()
 			return err
 		}
 
 		ch.Status.Presented = true
-		c.recorder.Eventf(ch, corev1.EventTypeNormal, reasonPresented, "Presented challenge using %s challenge mechanism", ch.Spec.Type)
+		c.recorder.Eventf(ch, corev1.EventTypeNormal, events.ReasonPresented, "Presented challenge using %s challenge mechanism", ch.Spec.Type)
 	}
 
 	err = solver.Check(ctx, genericIssuer, ch)
@@ -227,12 +220,14 @@ func handleError(ch *cmacme.Challenge, err error) error {
 	// more informative to the user by further inspecting the Error response.
 	case "urn:ietf:params:acme:error:malformed":
 		ch.Status.State = cmacme.Expired
+		ch.Status.Problem = acme.Problem(acmeErr)
 		// absorb the error as updating the challenge's status will trigger a sync
 		return nil
 	}
 	if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 		ch.Status.State = cmacme.Errored
 		ch.Status.Reason = fmt.Sprintf("Failed to retrieve Order resource: %v", err)
+		ch.Status.Problem = acme.Problem(acmeErr)
 		return nil
 	}
 
@@ -284,7 +279,7 @@ func (c *controller) handleFinalizer(ctx context.Context, ch *cmacme.Challenge)
 
 	err = solver.CleanUp(ctx, genericIssuer, ch)
 	if err != nil {
-		c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "Error cleaning up challenge: %v", err)
+		c.recorder.Eventf(ch, corev1.EventTypeWarning, events.ReasonCleanUpError, "Error cleaning up challenge: %v", err)
 		ch.Status.Reason = err.Error()
 		log.Error(err, "error cleaning up challenge")
 		return nil
@@ -350,6 +345,7 @@ func (c *controller) syncChallengeStatus(ctx context.Context, cl acmecl.Interfac
 			ch.Status.Reason = acmeChallenge.Error.Error()
 		}
 	}
+	ch.Status.Problem = acme.Problem(acmeChallenge.Error)
 	ch.Status.State = cmState
 
 	return nil
@@ -389,7 +385,8 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 
 	ch.Status.State = cmacme.State(authorization.Status)
 	ch.Status.Reason = "Successfully authorized domain"
-	c.recorder.Eventf(ch, corev1.EventTypeNormal, reasonDomainVerified, "Domain %q verified with %q validation", ch.Spec.DNSName, ch.Spec.Type)
+	ch.Status.Problem = nil
+	c.recorder.Eventf(ch, corev1.EventTypeNormal, events.ReasonDomainVerified, "Domain %q verified with %q validation", ch.Spec.DNSName, ch.Spec.Type)
 
 	return nil
 }
@@ -408,7 +405,8 @@ func (c *controller) handleAuthorizationError(ch *cmacme.Challenge, err error) e
 	//   if the returned state is 'invalid'
 	ch.Status.State = cmacme.Invalid
 	ch.Status.Reason = fmt.Sprintf("Error accepting authorization: %v", authErr)
-	c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonFailed, "Accepting challenge authorization failed: %v", authErr)
+	ch.Status.Problem = acme.Problem(authErr)
+	c.recorder.Eventf(ch, corev1.EventTypeWarning, events.ReasonFailed, "Accepting challenge authorization failed: %v", authErr)
 
 	// return nil here, as accepting the challenge did not error, the challenge
 	// simply failed
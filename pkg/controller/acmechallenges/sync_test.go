@@ -363,6 +363,11 @@ func TestSyncHappyPath(t *testing.T) {
 							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
 							gen.SetChallengePresented(true),
 							gen.SetChallengeReason("Error accepting authorization: acme: authorization error for example.com: 400 fakeerror: this is a very detailed error"),
+							gen.SetChallengeProblem(&cmacme.ACMEProblem{
+								Type:   "fakeerror",
+								Detail: "this is a very detailed error",
+								Status: 400,
+							}),
 						))),
 				},
 				ExpectedEvents: []string{
@@ -30,14 +30,18 @@ import (
 	"k8s.io/utils/clock"
 
 	"github.com/jetstack/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
 	cmacmelisters "github.com/jetstack/cert-manager/pkg/client/listers/acme/v1"
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/issuer"
+	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/metrics"
 	"github.com/jetstack/cert-manager/pkg/scheduler"
+	"github.com/jetstack/cert-manager/pkg/tracing"
 )
 
 var keyFunc = controllerpkg.KeyFunc
@@ -62,6 +66,8 @@ type controller struct {
 	recorder record.EventRecorder
 	// clientset used to update cert-manager API resources
 	cmClient cmclient.Interface
+	// used to estimate the remaining Let's Encrypt rate limit budgets
+	metrics *metrics.Metrics
 
 	// maintain a reference to the workqueue for this controller
 	// so the handleOwnedResource method can enqueue resources
@@ -70,6 +76,14 @@ type controller struct {
 	// scheduledWorkQueue holds items to be re-queued after a period of time.
 	scheduledWorkQueue scheduler.ScheduledWorkQueue
 
+	// maxAuthorizationRetries is the number of times an Order is resubmitted
+	// to the ACME server after one of its authorizations failed.
+	maxAuthorizationRetries int
+
+	// cnameTarget follows the CNAMEs of DNS01 challenge records, so that
+	// solvers can be selected by the zone the records are created in.
+	cnameTarget CNAMETargetFunc
+
 	// logger to be used by this controller
 	log logr.Logger
 }
@@ -83,7 +97,10 @@ func NewController(
 	accountRegistry accounts.Getter,
 	recorder record.EventRecorder,
 	clock clock.Clock,
+	metrics *metrics.Metrics,
 	isNamespaced bool,
+	maxAuthorizationRetries int,
+	dns01Nameservers []string,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
 
 	// Create a queue used to queue up Orders to be processed.
@@ -152,7 +169,13 @@ func NewController(
 		helper:              issuer.NewHelper(issuerLister, clusterIssuerLister),
 		recorder:            recorder,
 		cmClient:            cmClient,
+		metrics:             metrics,
 		accountRegistry:     accountRegistry,
+
+		maxAuthorizationRetries: maxAuthorizationRetries,
+		cnameTarget: func(domain string) (string, error) {
+			return dnsutil.DNS01LookupFQDN(domain, true, dns01Nameservers...)
+		},
 	}, queue, mustSync
 
 }
@@ -176,7 +199,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	}
 
 	ctx = logf.NewContext(ctx, logf.WithResource(log, order))
-	return c.Sync(ctx, order)
+	ctx, span := tracing.Start(tracing.ObjectContext(ctx, order), ControllerName, cmacme.OrderKind, order)
+	err = c.Sync(ctx, order)
+	tracing.End(span, err)
+	return err
 }
 
 // Returns a function that finds a named Order in a particular namespace.
@@ -216,7 +242,10 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		ctx.ACMEOptions.AccountRegistry,
 		ctx.Recorder,
 		ctx.Clock,
+		ctx.Metrics,
 		isNamespaced,
+		ctx.ACMEOptions.OrderAuthorizationRetries,
+		ctx.ACMEOptions.DNS01Nameservers,
 	)
 	c.controller = ctrl
 
@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	"github.com/jetstack/cert-manager/pkg/util"
 )
 
 func DNSNames(sel cmacme.CertificateDNSNameSelector) Selector {
@@ -37,8 +38,11 @@ func (s *dnsNamesSelector) Matches(meta metav1.ObjectMeta, dnsName string) (bool
 		return true, 0
 	}
 
+	// Challenges are created for the A-label form of internationalized
+	// domain names, whereas selectors may list their unicode form.
+	dnsName = util.NormalizeDNSName(dnsName)
 	for _, d := range s.allowedDNSNames {
-		if dnsName == d {
+		if dnsName == util.NormalizeDNSName(d) {
 			return true, 1
 		}
 	}
@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	"github.com/jetstack/cert-manager/pkg/util"
 )
 
 func DNSZones(sel cmacme.CertificateDNSNameSelector) Selector {
@@ -39,7 +40,9 @@ func (s *dnsZonesSelector) Matches(meta metav1.ObjectMeta, dnsName string) (bool
 	}
 
 	maxMatchingLabels := 0
+	dnsName = util.NormalizeDNSName(dnsName)
 	for _, zone := range s.allowedDNSZones {
+		zone = util.NormalizeDNSName(zone)
 		numMatchingLabels := dns.CompareDomainName(zone, dnsName)
 		if numMatchingLabels != dns.CountLabel(zone) {
 			continue
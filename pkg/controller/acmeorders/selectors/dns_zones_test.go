@@ -49,6 +49,15 @@ func TestDNSZones(t *testing.T) {
 			matches: true,
 			score:   2,
 		},
+		{
+			name: "matching the A-label form of a domain in an internationalized zone",
+			selector: cmacme.CertificateDNSNameSelector{
+				DNSZones: []string{"bücher.example"},
+			},
+			dnsName: "www.xn--bcher-kva.example",
+			matches: true,
+			score:   2,
+		},
 		{
 			name: "matching a wildcard domain in a zone",
 			selector: cmacme.CertificateDNSNameSelector{
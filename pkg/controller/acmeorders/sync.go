@@ -22,6 +22,8 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	acmeapi "golang.org/x/crypto/acme"
@@ -30,6 +32,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/duration"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
@@ -38,12 +41,10 @@ import (
 	acmecl "github.com/jetstack/cert-manager/pkg/acme/client"
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
-)
-
-const (
-	reasonSolver  = "Solver"
-	reasonCreated = "Created"
+	"github.com/jetstack/cert-manager/pkg/metrics"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 var (
@@ -52,6 +53,10 @@ var (
 	RequeuePeriod time.Duration = time.Second * 5
 )
 
+// maxFailedAuthorizations is the number of failed authorizations which are
+// kept in the failedAuthorizations field of an Order's status.
+const maxFailedAuthorizations = 10
+
 func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	log := logf.FromContext(ctx)
 	dbg := log.V(logf.DebugLevel)
@@ -83,10 +88,16 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		return err
 	}
 
+	c.observeRateLimitUsage(ctx, o, genericIssuer, o.CreationTimestamp.Time)
+
 	switch {
 	case o.Status.URL == "":
 		log.V(logf.DebugLevel).Info("Creating new ACME order as status.url is not set")
-		return c.createOrder(ctx, cl, o)
+		if err := c.createOrder(ctx, cl, o); err != nil {
+			return err
+		}
+		c.observeRateLimitUsage(ctx, o, genericIssuer, c.clock.Now())
+		return nil
 	case o.Status.FinalizeURL == "":
 		log.V(logf.DebugLevel).Info("Updating Order status as status.finalizeURL is not set")
 		_, err := c.updateOrderStatus(ctx, cl, o)
@@ -117,10 +128,10 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	}
 
 	dbg.Info("Computing list of Challenge resources that need to exist to complete this Order")
-	requiredChallenges, err := buildRequiredChallenges(ctx, cl, genericIssuer, o)
+	requiredChallenges, err := buildRequiredChallenges(ctx, cl, genericIssuer, o, c.cnameTarget)
 	if err != nil {
 		log.Error(err, "Failed to determine the list of Challenge resources needed for the Order")
-		c.recorder.Eventf(o, corev1.EventTypeWarning, reasonSolver, "Failed to determine a valid solver configuration for the set of domains on the Order: %v", err)
+		c.recorder.Eventf(o, corev1.EventTypeWarning, events.ReasonSolver, "Failed to determine a valid solver configuration for the set of domains on the Order: %v", err)
 		return nil
 	}
 
@@ -185,7 +196,18 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 				return nil
 			}
 		}
-		return err
+		if err != nil {
+			return err
+		}
+		if acme.IsFailureState(o.Status.State) {
+			c.recordFailedAuthorizations(&o.Status, challenges)
+		}
+		// The invalid state has not been persisted yet, so the Order can be
+		// resubmitted before the owning CertificateRequest observes it.
+		if o.Status.State == cmacme.Invalid && o.Status.AuthorizationRetries < c.maxAuthorizationRetries {
+			return c.retryFailedAuthorizations(ctx, o, challenges)
+		}
+		return nil
 
 	// anyChallengesFailed(challenges) == false is already implied by the above
 	// case, but explicitly check it in the following cases for if anything changes in future.
@@ -233,6 +255,50 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	return nil
 }
 
+// observeRateLimitUsage counts the Order, and its certificate once issued,
+// against the estimated Let's Encrypt rate limits of the issuer. Orders are
+// counted at orderCreated, and certificates when they became valid. As the
+// controller observes every existing Order when it starts, the estimates
+// survive restarts. A warning Event is recorded on the Order when it brings
+// any rate limit close to being exhausted.
+func (c *controller) observeRateLimitUsage(ctx context.Context, o *cmacme.Order, issuer cmapi.GenericIssuer, orderCreated time.Time) {
+	log := logf.FromContext(ctx)
+
+	acmeSpec := issuer.GetSpec().ACME
+	if acmeSpec == nil {
+		return
+	}
+
+	var budgets []metrics.ACMERateLimitBudget
+	if o.Status.URL != "" {
+		account := issuer.GetNamespace() + "/" + issuer.GetName()
+		if status := issuer.GetStatus().ACME; status != nil && status.URI != "" {
+			account = status.URI
+		}
+		if b := c.metrics.ObserveACMEOrder(acmeSpec.Server, account, o.Status.URL, orderCreated); b != nil {
+			budgets = append(budgets, *b)
+		}
+	}
+	if o.Status.State == cmacme.Valid && len(o.Status.Certificate) > 0 {
+		cert, err := pki.DecodeX509CertificateBytes(o.Status.Certificate)
+		if err != nil {
+			log.V(logf.DebugLevel).Info("failed to decode certificate to estimate rate limit usage", "error", err.Error())
+		} else {
+			budgets = append(budgets, c.metrics.ObserveACMECertificate(acmeSpec.Server, o.Status.URL, cert.DNSNames, cert.NotBefore)...)
+		}
+	}
+
+	for _, b := range budgets {
+		if !b.NearlyExhausted() {
+			continue
+		}
+		log.V(logf.WarnLevel).Info("Let's Encrypt rate limit nearly exhausted", "limit", b.Limit, "key", b.Key, "remaining", b.Remaining())
+		c.recorder.Eventf(o, corev1.EventTypeWarning, events.ReasonRateLimitNearlyExhausted,
+			"An estimated %d of the %d %s allowed for %q every %s remain before the Let's Encrypt rate limit is reached",
+			b.Remaining(), b.Max, b.Description, b.Key, duration.HumanDuration(b.Window))
+	}
+}
+
 func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cmacme.Order) error {
 	log := logf.FromContext(ctx)
 
@@ -264,6 +330,7 @@ func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cm
 			log.Error(err, "failed to create Order resource due to bad request, marking Order as failed")
 			c.setOrderState(&o.Status, string(cmacme.Errored))
 			o.Status.Reason = fmt.Sprintf("Failed to create Order: %v", err)
+			o.Status.Problem = acme.Problem(err)
 			return nil
 		}
 	}
@@ -280,6 +347,70 @@ func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cm
 	return nil
 }
 
+// retryFailedAuthorizations resets the status of an Order whose ACME order
+// has become invalid because some of its authorizations failed, so that a new
+// ACME order is created for the same identifiers. ACME servers which reuse
+// valid authorizations will only require the failed authorizations to be
+// completed again.
+func (c *controller) retryFailedAuthorizations(ctx context.Context, o *cmacme.Order, challenges []*cmacme.Challenge) error {
+	log := logf.FromContext(ctx)
+
+	var failed []string
+	for _, ch := range challenges {
+		if acme.IsFailureState(ch.Status.State) {
+			failed = append(failed, ch.Spec.DNSName)
+		}
+	}
+	sort.Strings(failed)
+
+	retries := o.Status.AuthorizationRetries + 1
+	reason := fmt.Sprintf("Authorization failed for %s, resubmitting order to the ACME server (retry %d of %d)",
+		strings.Join(failed, ", "), retries, c.maxAuthorizationRetries)
+	log.V(logf.InfoLevel).Info("resubmitting order after failed authorizations", "failed_identifiers", failed, "retry", retries)
+	c.recorder.Event(o, corev1.EventTypeWarning, events.ReasonRetrying, reason)
+
+	// Reset everything that refers to the invalid ACME order. The Challenges
+	// of the invalid order are deleted, and those for the new order are
+	// created once it has been submitted. The record of failed authorizations
+	// is kept so that earlier failures can still be inspected.
+	o.Status = cmacme.OrderStatus{
+		Reason:               reason,
+		AuthorizationRetries: retries,
+		FailedAuthorizations: o.Status.FailedAuthorizations,
+	}
+	return c.deleteAllChallenges(ctx, o)
+}
+
+// recordFailedAuthorizations appends the failed Challenges of an Order to the
+// failedAuthorizations field of its status, together with the problem
+// returned by the ACME server. Challenges which have already been recorded are
+// skipped, and only the most recent maxFailedAuthorizations entries are kept.
+func (c *controller) recordFailedAuthorizations(status *cmacme.OrderStatus, challenges []*cmacme.Challenge) {
+	recorded := sets.NewString()
+	for _, f := range status.FailedAuthorizations {
+		recorded.Insert(f.URL)
+	}
+
+	for _, ch := range challenges {
+		if !acme.IsFailureState(ch.Status.State) || recorded.Has(ch.Spec.URL) {
+			continue
+		}
+		status.FailedAuthorizations = append(status.FailedAuthorizations, cmacme.ACMEAuthorizationFailure{
+			URL:        ch.Spec.URL,
+			Identifier: ch.Spec.DNSName,
+			Wildcard:   ch.Spec.Wildcard,
+			Type:       strings.ToLower(string(ch.Spec.Type)),
+			Reason:     ch.Status.Reason,
+			Problem:    ch.Status.Problem,
+			Time:       metav1.NewTime(c.clock.Now()),
+		})
+	}
+
+	if n := len(status.FailedAuthorizations); n > maxFailedAuthorizations {
+		status.FailedAuthorizations = status.FailedAuthorizations[n-maxFailedAuthorizations:]
+	}
+}
+
 func (c *controller) updateOrderStatus(ctx context.Context, cl acmecl.Interface, o *cmacme.Order) (*acmeapi.Order, error) {
 	acmeOrder, err := getACMEOrder(ctx, cl, o)
 	if err != nil {
@@ -293,6 +424,9 @@ func (c *controller) updateOrderStatus(ctx context.Context, cl acmecl.Interface,
 	}
 	o.Status.FinalizeURL = acmeOrder.FinalizeURL
 	c.setOrderState(&o.Status, acmeOrder.Status)
+	if acmeOrder.Error != nil {
+		o.Status.Problem = acme.Problem(acmeOrder.Error)
+	}
 	// once the 'authorizations' slice contains at least one item, it cannot be
 	// updated. If it does not contain any items, update it containing the list
 	// of authorizations returned on the Order.
@@ -394,7 +528,7 @@ func (c *controller) createRequiredChallenges(ctx context.Context, o *cmacme.Ord
 		if err != nil {
 			return err
 		}
-		c.recorder.Eventf(o, corev1.EventTypeNormal, reasonCreated, "Created Challenge resource %q for domain %q", ch.Name, ch.Spec.DNSName)
+		c.recorder.Eventf(o, corev1.EventTypeNormal, events.ReasonCreated, "Created Challenge resource %q for domain %q", ch.Name, ch.Spec.DNSName)
 	}
 	return nil
 }
@@ -503,6 +637,7 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 			log.Error(err, "failed to finalize Order resource due to bad request, marking Order as failed")
 			c.setOrderState(&o.Status, string(cmacme.Errored))
 			o.Status.Reason = fmt.Sprintf("Failed to finalize Order: %v", err)
+			o.Status.Problem = acme.Problem(err)
 			return nil
 		}
 	}
@@ -572,7 +707,7 @@ func (c *controller) storeCertificateOnStatus(ctx context.Context, o *cmacme.Ord
 	}
 
 	o.Status.Certificate = certBuffer.Bytes()
-	c.recorder.Event(o, corev1.EventTypeNormal, "Complete", "Order completed successfully")
+	c.recorder.Event(o, corev1.EventTypeNormal, events.ReasonComplete, "Order completed successfully")
 
 	return nil
 }
@@ -18,9 +18,15 @@ package acmeorders
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
+	"reflect"
 	"testing"
 	"time"
 
@@ -34,7 +40,10 @@ import (
 	acmecl "github.com/jetstack/cert-manager/pkg/acme/client"
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/metrics"
 	schedulertest "github.com/jetstack/cert-manager/pkg/scheduler/test"
 	"github.com/jetstack/cert-manager/test/unit/gen"
 )
@@ -120,6 +129,15 @@ func TestSyncHappyPath(t *testing.T) {
 dGVzdA==
 -----END CERTIFICATE-----
 `)
+	testOrderRetried := testOrderPending.DeepCopy()
+	testOrderRetried.Status = cmacme.OrderStatus{
+		Reason:               "Authorization failed for test.com, resubmitting order to the ACME server (retry 1 of 2)",
+		AuthorizationRetries: 1,
+	}
+	testOrderPendingRetriesUsed := testOrderPending.DeepCopy()
+	testOrderPendingRetriesUsed.Status.AuthorizationRetries = 2
+	testOrderInvalidRetriesUsed := testOrderInvalid.DeepCopy()
+	testOrderInvalidRetriesUsed.Status.AuthorizationRetries = 2
 	testOrderReady := testOrderPending.DeepCopy()
 	testOrderReady.Status.State = cmacme.Ready
 
@@ -168,7 +186,7 @@ rUCGwbCUDI0mxadJ3Bz4WxR6fyNpBK2yAinWEsikxqEt
 			return "key", nil
 		},
 	}
-	testAuthorizationChallenge, err := buildChallenge(context.TODO(), fakeHTTP01ACMECl, testIssuerHTTP01TestCom, testOrderPending, testOrderPending.Status.Authorizations[0])
+	testAuthorizationChallenge, err := buildChallenge(context.TODO(), fakeHTTP01ACMECl, testIssuerHTTP01TestCom, testOrderPending, testOrderPending.Status.Authorizations[0], nil)
 	if err != nil {
 		t.Fatalf("error building Challenge resource test fixture: %v", err)
 	}
@@ -176,6 +194,26 @@ rUCGwbCUDI0mxadJ3Bz4WxR6fyNpBK2yAinWEsikxqEt
 	testAuthorizationChallengeValid.Status.State = cmacme.Valid
 	testAuthorizationChallengeInvalid := testAuthorizationChallenge.DeepCopy()
 	testAuthorizationChallengeInvalid.Status.State = cmacme.Invalid
+	testAuthorizationChallengeInvalid.Status.Reason = "Error accepting authorization: acme: authorization error for test.com: 403 urn:ietf:params:acme:error:unauthorized: invalid response"
+	testAuthorizationChallengeInvalid.Status.Problem = &cmacme.ACMEProblem{
+		Type:   "urn:ietf:params:acme:error:unauthorized",
+		Detail: "invalid response",
+		Status: 403,
+	}
+	testFailedAuthorizations := []cmacme.ACMEAuthorizationFailure{
+		{
+			URL:        "http://chalurl",
+			Identifier: "test.com",
+			Type:       "http-01",
+			Reason:     testAuthorizationChallengeInvalid.Status.Reason,
+			Problem:    testAuthorizationChallengeInvalid.Status.Problem,
+			Time:       nowMetaTime,
+		},
+	}
+	testOrderInvalidFailedAuthorizations := testOrderInvalid.DeepCopy()
+	testOrderInvalidFailedAuthorizations.Status.FailedAuthorizations = testFailedAuthorizations
+	testOrderRetried.Status.FailedAuthorizations = testFailedAuthorizations
+	testOrderInvalidRetriesUsed.Status.FailedAuthorizations = testFailedAuthorizations
 
 	testACMEAuthorizationPending := &acmeapi.Authorization{
 		URI:    "http://authzurl",
@@ -215,6 +253,17 @@ rUCGwbCUDI0mxadJ3Bz4WxR6fyNpBK2yAinWEsikxqEt
 	testACMEOrderInvalid := &acmeapi.Order{}
 	*testACMEOrderInvalid = *testACMEOrderPending
 	testACMEOrderInvalid.Status = acmeapi.StatusInvalid
+	testACMEOrderInvalidProblem := &acmeapi.Order{}
+	*testACMEOrderInvalidProblem = *testACMEOrderInvalid
+	testACMEOrderInvalidProblem.Error = &acmeapi.Error{
+		ProblemType: "urn:ietf:params:acme:error:unauthorized",
+		Detail:      "Some of the authorizations for this order are invalid",
+	}
+	testOrderInvalidProblem := testOrderInvalidFailedAuthorizations.DeepCopy()
+	testOrderInvalidProblem.Status.Problem = &cmacme.ACMEProblem{
+		Type:   "urn:ietf:params:acme:error:unauthorized",
+		Detail: "Some of the authorizations for this order are invalid",
+	}
 
 	tests := map[string]testT{
 		"create a new order with the acme server, set the order url on the status resource and return nil to avoid cache timing issues": {
@@ -549,7 +598,77 @@ rUCGwbCUDI0mxadJ3Bz4WxR6fyNpBK2yAinWEsikxqEt
 				ExpectedActions: []testpkg.Action{
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
 						"status",
-						testOrderInvalid.Namespace, testOrderInvalid)),
+						testOrderInvalidFailedAuthorizations.Namespace, testOrderInvalidFailedAuthorizations)),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetOrder: func(_ context.Context, url string) (*acmeapi.Order, error) {
+					return testACMEOrderInvalid, nil
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					return "key", nil
+				},
+			},
+		},
+		"record the problem returned by the ACME server if the order is 'invalid'": {
+			order: testOrderPending,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderPending, testAuthorizationChallengeInvalid},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderInvalidProblem.Namespace, testOrderInvalidProblem)),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetOrder: func(_ context.Context, url string) (*acmeapi.Order, error) {
+					return testACMEOrderInvalidProblem, nil
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					return "key", nil
+				},
+			},
+		},
+		"resubmit the order if a challenge is 'failed' and authorization retries remain": {
+			order: testOrderPending,
+			builder: &testpkg.Builder{
+				Context: &controllerpkg.Context{
+					RootContext: context.Background(),
+					ACMEOptions: controllerpkg.ACMEOptions{OrderAuthorizationRetries: 2},
+				},
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderPending, testAuthorizationChallengeInvalid},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewDeleteAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						testAuthorizationChallengeInvalid.Namespace, testAuthorizationChallengeInvalid.Name)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderRetried.Namespace, testOrderRetried)),
+				},
+				ExpectedEvents: []string{
+					"Warning Retrying " + testOrderRetried.Status.Reason,
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetOrder: func(_ context.Context, url string) (*acmeapi.Order, error) {
+					return testACMEOrderInvalid, nil
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					return "key", nil
+				},
+			},
+		},
+		"mark the order as failed if a challenge is 'failed' and all authorization retries are used": {
+			order: testOrderPendingRetriesUsed,
+			builder: &testpkg.Builder{
+				Context: &controllerpkg.Context{
+					RootContext: context.Background(),
+					ACMEOptions: controllerpkg.ACMEOptions{OrderAuthorizationRetries: 2},
+				},
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderPendingRetriesUsed, testAuthorizationChallengeInvalid},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderInvalidRetriesUsed.Namespace, testOrderInvalidRetriesUsed)),
 				},
 			},
 			acmeClient: &acmecl.FakeACME{
@@ -656,3 +775,132 @@ func runTest(t *testing.T, test testT) {
 
 	test.builder.CheckAndFinish(err)
 }
+
+func TestRecordFailedAuthorizations(t *testing.T) {
+	now := time.Now()
+	nowMetaTime := metav1.NewTime(now)
+	c := &controller{clock: fakeclock.NewFakeClock(now)}
+
+	failedChallenge := func(url string) *cmacme.Challenge {
+		return gen.Challenge("test",
+			gen.SetChallengeURL(url),
+			gen.SetChallengeDNSName("example.com"),
+			gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+			gen.SetChallengeState(cmacme.Invalid),
+			gen.SetChallengeReason("failed"),
+		)
+	}
+	failure := func(url string) cmacme.ACMEAuthorizationFailure {
+		return cmacme.ACMEAuthorizationFailure{
+			URL:        url,
+			Identifier: "example.com",
+			Type:       "dns-01",
+			Reason:     "failed",
+			Time:       nowMetaTime,
+		}
+	}
+
+	var manyFailures []cmacme.ACMEAuthorizationFailure
+	for i := 0; i < maxFailedAuthorizations; i++ {
+		manyFailures = append(manyFailures, failure(fmt.Sprintf("http://chal/%d", i)))
+	}
+
+	tests := map[string]struct {
+		existing   []cmacme.ACMEAuthorizationFailure
+		challenges []*cmacme.Challenge
+		expected   []cmacme.ACMEAuthorizationFailure
+	}{
+		"only failed challenges are recorded": {
+			challenges: []*cmacme.Challenge{
+				failedChallenge("http://chal/a"),
+				gen.ChallengeFrom(failedChallenge("http://chal/b"), gen.SetChallengeState(cmacme.Valid)),
+			},
+			expected: []cmacme.ACMEAuthorizationFailure{failure("http://chal/a")},
+		},
+		"challenges which have already been recorded are skipped": {
+			existing:   []cmacme.ACMEAuthorizationFailure{failure("http://chal/a")},
+			challenges: []*cmacme.Challenge{failedChallenge("http://chal/a"), failedChallenge("http://chal/b")},
+			expected:   []cmacme.ACMEAuthorizationFailure{failure("http://chal/a"), failure("http://chal/b")},
+		},
+		"only the most recent failures are kept": {
+			existing:   manyFailures,
+			challenges: []*cmacme.Challenge{failedChallenge("http://chal/new")},
+			expected:   append(append([]cmacme.ACMEAuthorizationFailure{}, manyFailures[1:]...), failure("http://chal/new")),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			status := &cmacme.OrderStatus{FailedAuthorizations: test.existing}
+			c.recordFailedAuthorizations(status, test.challenges)
+			if !reflect.DeepEqual(status.FailedAuthorizations, test.expected) {
+				t.Errorf("unexpected failed authorizations, exp=%+v, got=%+v", test.expected, status.FailedAuthorizations)
+			}
+		})
+	}
+}
+
+func TestObserveRateLimitUsage(t *testing.T) {
+	now := time.Now()
+	fakeClock := fakeclock.NewFakeClock(now)
+	recorder := new(testpkg.FakeRecorder)
+	c := &controller{
+		clock:    fakeClock,
+		recorder: recorder,
+		metrics:  metrics.New(logf.Log, fakeClock),
+	}
+
+	issuer := gen.Issuer("letsencrypt", gen.SetIssuerACME(cmacme.ACMEIssuer{
+		Server: "https://acme-v02.api.letsencrypt.org/directory",
+	}))
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		DNSNames:     []string{"example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour * 24 * 90),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	// Only the fifth duplicate certificate leaves less than a tenth of the
+	// duplicate certificates budget, and each Order is only counted once.
+	for i := 0; i < 5; i++ {
+		order := gen.Order(fmt.Sprintf("order-%d", i),
+			gen.SetOrderURL(fmt.Sprintf("http://order/%d", i)),
+			gen.SetOrderState(cmacme.Valid),
+			gen.SetOrderCertificate(certPEM),
+		)
+		c.observeRateLimitUsage(context.Background(), order, issuer, now)
+		c.observeRateLimitUsage(context.Background(), order, issuer, now)
+	}
+
+	expectedEvents := []string{
+		`Warning RateLimitNearlyExhausted An estimated 0 of the 5 duplicate certificates allowed for "example.com" every 7d remain before the Let's Encrypt rate limit is reached`,
+	}
+	if !reflect.DeepEqual(recorder.Events, expectedEvents) {
+		t.Errorf("unexpected events, exp=%q, got=%q", expectedEvents, recorder.Events)
+	}
+
+	// Orders for other ACME servers are not counted.
+	otherIssuer := gen.Issuer("other", gen.SetIssuerACME(cmacme.ACMEIssuer{
+		Server: "https://acme.example.com/directory",
+	}))
+	for i := 0; i < 5; i++ {
+		order := gen.Order(fmt.Sprintf("other-%d", i),
+			gen.SetOrderURL(fmt.Sprintf("http://other/%d", i)),
+			gen.SetOrderState(cmacme.Valid),
+			gen.SetOrderCertificate(certPEM),
+		)
+		c.observeRateLimitUsage(context.Background(), order, otherIssuer, now)
+	}
+	if len(recorder.Events) != len(expectedEvents) {
+		t.Errorf("expected no events for other ACME servers, got=%q", recorder.Events[len(expectedEvents):])
+	}
+}
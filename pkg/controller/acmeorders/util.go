@@ -19,6 +19,7 @@ package acmeorders
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -29,13 +30,18 @@ import (
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/jetstack/cert-manager/pkg/controller/acmeorders/selectors"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/tracing"
 )
 
 var (
 	orderGvk = cmacme.SchemeGroupVersion.WithKind("Order")
 )
 
-func buildRequiredChallenges(ctx context.Context, cl acmecl.Interface, issuer cmapi.GenericIssuer, o *cmacme.Order) ([]cmacme.Challenge, error) {
+// CNAMETargetFunc returns the name that the DNS01 challenge record for the
+// given domain is created at when CNAMEs are followed.
+type CNAMETargetFunc func(domain string) (string, error)
+
+func buildRequiredChallenges(ctx context.Context, cl acmecl.Interface, issuer cmapi.GenericIssuer, o *cmacme.Order, cnameTarget CNAMETargetFunc) ([]cmacme.Challenge, error) {
 	chs := make([]cmacme.Challenge, 0)
 	for _, a := range o.Status.Authorizations {
 		if a.InitialState == cmacme.Valid {
@@ -46,7 +52,7 @@ func buildRequiredChallenges(ctx context.Context, cl acmecl.Interface, issuer cm
 			logf.FromContext(ctx).V(logf.DebugLevel).Info("Authorization already valid, not creating Challenge resource", "identifier", a.Identifier, "is_wildcard", wc)
 			continue
 		}
-		ch, err := buildChallenge(ctx, cl, issuer, o, a)
+		ch, err := buildChallenge(ctx, cl, issuer, o, a, cnameTarget)
 		if err != nil {
 			return nil, err
 		}
@@ -55,8 +61,8 @@ func buildRequiredChallenges(ctx context.Context, cl acmecl.Interface, issuer cm
 	return chs, nil
 }
 
-func buildChallenge(ctx context.Context, cl acmecl.Interface, issuer cmapi.GenericIssuer, o *cmacme.Order, authz cmacme.ACMEAuthorization) (*cmacme.Challenge, error) {
-	chSpec, err := challengeSpecForAuthorization(ctx, cl, issuer, o, authz)
+func buildChallenge(ctx context.Context, cl acmecl.Interface, issuer cmapi.GenericIssuer, o *cmacme.Order, authz cmacme.ACMEAuthorization, cnameTarget CNAMETargetFunc) (*cmacme.Challenge, error) {
+	chSpec, err := challengeSpecForAuthorization(ctx, cl, issuer, o, authz, cnameTarget)
 	if err != nil {
 		// TODO: in this case, we should probably not return the error as it's
 		//  unlikely we can make it succeed by retrying.
@@ -68,7 +74,7 @@ func buildChallenge(ctx context.Context, cl acmecl.Interface, issuer cmapi.Gener
 		return nil, err
 	}
 
-	return &cmacme.Challenge{
+	ch := &cmacme.Challenge{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            chName,
 			Namespace:       o.Namespace,
@@ -76,11 +82,87 @@ func buildChallenge(ctx context.Context, cl acmecl.Interface, issuer cmapi.Gener
 			Finalizers:      []string{cmacme.ACMEFinalizer},
 		},
 		Spec: *chSpec,
+	}
+	tracing.InjectAnnotation(ctx, ch)
+	return ch, nil
+}
+
+func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, issuer cmapi.GenericIssuer, o *cmacme.Order, authz cmacme.ACMEAuthorization, cnameTarget CNAMETargetFunc) (*cmacme.ChallengeSpec, error) {
+	wc := false
+	if authz.Wildcard != nil {
+		wc = *authz.Wildcard
+	}
+
+	// 1-3. select the solver of the issuer to use and the challenge it completes
+	selection := SelectSolver(ctx, issuer, o.ObjectMeta, authz, cnameTarget)
+	if selection == nil {
+		if name := o.Annotations[cmacme.ACMECertificateSolverNameOverride]; name != "" {
+			return nil, fmt.Errorf("the solver %q pinned by the %s annotation does not exist or cannot be used for this challenge", name, cmacme.ACMECertificateSolverNameOverride)
+		}
+		return nil, fmt.Errorf("no configured challenge solvers can be used for this challenge")
+	}
+	selectedSolver := selection.Solver
+	selectedChallenge := selection.Challenge
+
+	// It should never be possible for this case to be hit as the selected
+	// challenge is always one of 'http-01' or 'dns-01'.
+	chType, err := challengeType(selectedChallenge.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keyForChallenge(cl, selectedChallenge.Token, chType)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. handle overriding the HTTP01 ingress class and name fields using the
+	//    ACMECertificateHTTP01IngressNameOverride & Class annotations
+	if err := applyIngressParameterAnnotationOverrides(o, selectedSolver); err != nil {
+		return nil, err
+	}
+
+	// 5. construct Challenge resource with spec.solver field set
+	return &cmacme.ChallengeSpec{
+		AuthorizationURL: authz.URL,
+		Type:             chType,
+		URL:              selectedChallenge.URL,
+		DNSName:          authz.Identifier,
+		Token:            selectedChallenge.Token,
+		Key:              key,
+		// selectedSolver cannot be nil due to the check above.
+		Solver:    *selectedSolver,
+		Wildcard:  wc,
+		IssuerRef: o.Spec.IssuerRef,
 	}, nil
 }
 
-func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, issuer cmapi.GenericIssuer, o *cmacme.Order, authz cmacme.ACMEAuthorization) (*cmacme.ChallengeSpec, error) {
-	log := logf.FromContext(ctx, "challengeSpecForAuthorization")
+// SolverSelection describes the solver selected to complete an ACME
+// authorization.
+type SolverSelection struct {
+	// Solver is the selected solver, and Index its position in the list of
+	// solvers of the issuer.
+	Solver *cmacme.ACMEChallengeSolver
+	Index  int
+
+	// Challenge is the challenge of the authorization that the solver
+	// completes.
+	Challenge *cmacme.ACMEChallenge
+
+	// The number of labels, dnsNames and dnsZone segments of the selector of
+	// the solver which matched.
+	NumLabelsMatch   int
+	NumDNSNamesMatch int
+	NumDNSZonesMatch int
+}
+
+// SelectSolver selects the solver of the issuer that is used to complete the
+// given authorization, for an Order with the given metadata. If the metadata
+// pins a solver by name using the ACMECertificateSolverNameOverride
+// annotation, only that solver is considered. It returns nil if none of the
+// solvers can complete any of the challenges of the authorization.
+func SelectSolver(ctx context.Context, issuer cmapi.GenericIssuer, meta metav1.ObjectMeta, authz cmacme.ACMEAuthorization, cnameTarget CNAMETargetFunc) *SolverSelection {
+	log := logf.FromContext(ctx, "selectSolver")
 	dbg := log.V(logf.DebugLevel)
 
 	// 1. fetch solvers from issuer
@@ -95,8 +177,32 @@ func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, iss
 		domainToFind = "*." + domainToFind
 	}
 
+	// DNS01 solvers which follow CNAMEs create the challenge record in the
+	// zone that _acme-challenge.<domain> points to, so their dnsZones
+	// selectors are also matched against that name. It is only looked up
+	// once, if a solver needs it.
+	var resolvedTarget *string
+	targetToFind := func() string {
+		if resolvedTarget != nil {
+			return *resolvedTarget
+		}
+		target := ""
+		if cnameTarget != nil {
+			var err error
+			target, err = cnameTarget(authz.Identifier)
+			if err != nil {
+				log.Error(err, "failed to follow CNAMEs of the challenge record, not matching dnsZones selectors against its target", "domain", authz.Identifier)
+				target = ""
+			}
+		}
+		target = strings.TrimSuffix(target, ".")
+		resolvedTarget = &target
+		return target
+	}
+
 	var selectedSolver *cmacme.ACMEChallengeSolver
 	var selectedChallenge *cmacme.ACMEChallenge
+	selectedIndex := 0
 	selectedNumLabelsMatch := 0
 	selectedNumDNSNamesMatch := 0
 	selectedNumDNSZonesMatch := 0
@@ -113,8 +219,31 @@ func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, iss
 		return nil
 	}
 
+	// A solver pinned by name is used regardless of the selectors of the
+	// solvers, and no other solver is considered if it cannot be used.
+	if name := meta.Annotations[cmacme.ACMECertificateSolverNameOverride]; name != "" {
+		for i, cfg := range solvers {
+			if cfg.Name != name {
+				continue
+			}
+			acmech := challengeForSolver(&cfg)
+			if acmech == nil {
+				dbg.Info("cannot use pinned solver as the ACME authorization does not allow solvers of this type", "solver", name)
+				return nil
+			}
+			dbg.Info("selecting solver pinned by annotation", "solver", name)
+			return &SolverSelection{
+				Solver:    cfg.DeepCopy(),
+				Index:     i,
+				Challenge: acmech,
+			}
+		}
+		dbg.Info("issuer has no solver with the pinned name", "solver", name)
+		return nil
+	}
+
 	// 2. filter solvers to only those that matchLabels
-	for _, cfg := range solvers {
+	for i, cfg := range solvers {
 		acmech := challengeForSolver(&cfg)
 		if acmech == nil {
 			dbg.Info("cannot use solver as the ACME authorization does not allow solvers of this type")
@@ -129,12 +258,19 @@ func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, iss
 			dbg.Info("selecting solver due to match all selector and no previously selected solver")
 			selectedSolver = cfg.DeepCopy()
 			selectedChallenge = acmech
+			selectedIndex = i
 			continue
 		}
 
-		labelsMatch, numLabelsMatch := selectors.Labels(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
-		dnsNamesMatch, numDNSNamesMatch := selectors.DNSNames(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
-		dnsZonesMatch, numDNSZonesMatch := selectors.DNSZones(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
+		labelsMatch, numLabelsMatch := selectors.Labels(*cfg.Selector).Matches(meta, domainToFind)
+		dnsNamesMatch, numDNSNamesMatch := selectors.DNSNames(*cfg.Selector).Matches(meta, domainToFind)
+		dnsZonesMatch, numDNSZonesMatch := selectors.DNSZones(*cfg.Selector).Matches(meta, domainToFind)
+		if !dnsZonesMatch && cfg.DNS01 != nil && cfg.DNS01.CNAMEStrategy == cmacme.FollowStrategy {
+			if target := targetToFind(); target != "" {
+				dnsZonesMatch, numDNSZonesMatch = selectors.DNSZones(*cfg.Selector).Matches(meta, target)
+				dbg.Info("matched dnsZones against the CNAME target of the challenge record", "target", target, "dnszones_match", dnsZonesMatch)
+			}
+		}
 
 		if !labelsMatch || !dnsNamesMatch || !dnsZonesMatch {
 			dbg.Info("not selecting solver", "labels_match", labelsMatch, "dnsnames_match", dnsNamesMatch, "dnszones_match", dnsZonesMatch)
@@ -146,6 +282,7 @@ func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, iss
 		selectSolver := func() {
 			selectedSolver = cfg.DeepCopy()
 			selectedChallenge = acmech
+			selectedIndex = i
 			selectedNumLabelsMatch = numLabelsMatch
 			selectedNumDNSNamesMatch = numDNSNamesMatch
 			selectedNumDNSZonesMatch = numDNSZonesMatch
@@ -248,41 +385,17 @@ func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, iss
 	}
 
 	if selectedSolver == nil || selectedChallenge == nil {
-		return nil, fmt.Errorf("no configured challenge solvers can be used for this challenge")
-	}
-
-	// It should never be possible for this case to be hit as earlier in this
-	// method we already assert that the challenge type is one of 'http-01'
-	// or 'dns-01'.
-	chType, err := challengeType(selectedChallenge.Type)
-	if err != nil {
-		return nil, err
-	}
-
-	key, err := keyForChallenge(cl, selectedChallenge.Token, chType)
-	if err != nil {
-		return nil, err
+		return nil
 	}
 
-	// 4. handle overriding the HTTP01 ingress class and name fields using the
-	//    ACMECertificateHTTP01IngressNameOverride & Class annotations
-	if err := applyIngressParameterAnnotationOverrides(o, selectedSolver); err != nil {
-		return nil, err
+	return &SolverSelection{
+		Solver:           selectedSolver,
+		Index:            selectedIndex,
+		Challenge:        selectedChallenge,
+		NumLabelsMatch:   selectedNumLabelsMatch,
+		NumDNSNamesMatch: selectedNumDNSNamesMatch,
+		NumDNSZonesMatch: selectedNumDNSZonesMatch,
 	}
-
-	// 5. construct Challenge resource with spec.solver field set
-	return &cmacme.ChallengeSpec{
-		AuthorizationURL: authz.URL,
-		Type:             chType,
-		URL:              selectedChallenge.URL,
-		DNSName:          authz.Identifier,
-		Token:            selectedChallenge.Token,
-		Key:              key,
-		// selectedSolver cannot be nil due to the check above.
-		Solver:    *selectedSolver,
-		Wildcard:  wc,
-		IssuerRef: o.Spec.IssuerRef,
-	}, nil
 }
 
 func challengeType(t string) (cmacme.ACMEChallengeType, error) {
@@ -18,6 +18,7 @@ package acmeorders
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -79,6 +80,17 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 			},
 		},
 	}
+	pinnedSolverDNS01 := cmacme.ACMEChallengeSolver{
+		Name: "pinned",
+		Selector: &cmacme.CertificateDNSNameSelector{
+			DNSZones: []string{"delegated.example.net"},
+		},
+		DNS01: &cmacme.ACMEChallengeSolverDNS01{
+			Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+				Email: "test-pinned-cloudflare-email",
+			},
+		},
+	}
 	// define ACME challenges that are used during tests
 	acmeChallengeHTTP01 := &cmacme.ACMEChallenge{
 		Type:  "http-01",
@@ -94,6 +106,8 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 		issuer     v1.GenericIssuer
 		order      *cmacme.Order
 		authz      *cmacme.ACMEAuthorization
+		// cnameTarget is used to follow CNAMEs of DNS01 challenge records
+		cnameTarget CNAMETargetFunc
 
 		expectedChallengeSpec *cmacme.ChallengeSpec
 		expectedError         bool
@@ -916,6 +930,147 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 				},
 			},
 		},
+		"should match dnsZones against the CNAME target of the challenge record if the solver follows CNAMEs": {
+			acmeClient: basicACMEClient,
+			issuer: &v1.Issuer{
+				Spec: v1.IssuerSpec{
+					IssuerConfig: v1.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverDNS01,
+								{
+									Selector: &cmacme.CertificateDNSNameSelector{
+										DNSZones: []string{"acme.example.net"},
+									},
+									DNS01: &cmacme.ACMEChallengeSolverDNS01{
+										CNAMEStrategy: cmacme.FollowStrategy,
+										Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+											Email: "delegated-dnszone-selector-solver",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"www.example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "www.example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNS01},
+			},
+			cnameTarget: func(domain string) (string, error) {
+				return "www.example.com.acme.example.net.", nil
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "www.example.com",
+				Token:   acmeChallengeDNS01.Token,
+				Key:     "dns01",
+				Solver: cmacme.ACMEChallengeSolver{
+					Selector: &cmacme.CertificateDNSNameSelector{
+						DNSZones: []string{"acme.example.net"},
+					},
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						CNAMEStrategy: cmacme.FollowStrategy,
+						Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+							Email: "delegated-dnszone-selector-solver",
+						},
+					},
+				},
+			},
+		},
+		"should not match dnsZones against the CNAME target of the challenge record if the solver does not follow CNAMEs": {
+			acmeClient: basicACMEClient,
+			issuer: &v1.Issuer{
+				Spec: v1.IssuerSpec{
+					IssuerConfig: v1.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverDNS01,
+								{
+									Selector: &cmacme.CertificateDNSNameSelector{
+										DNSZones: []string{"acme.example.net"},
+									},
+									DNS01: &cmacme.ACMEChallengeSolverDNS01{
+										Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+											Email: "delegated-dnszone-selector-solver",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"www.example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "www.example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNS01},
+			},
+			cnameTarget: func(domain string) (string, error) {
+				return "www.example.com.acme.example.net.", nil
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "www.example.com",
+				Token:   acmeChallengeDNS01.Token,
+				Key:     "dns01",
+				Solver:  emptySelectorSolverDNS01,
+			},
+		},
+		"should fall back to other solvers if the CNAME target of the challenge record cannot be looked up": {
+			acmeClient: basicACMEClient,
+			issuer: &v1.Issuer{
+				Spec: v1.IssuerSpec{
+					IssuerConfig: v1.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverDNS01,
+								{
+									Selector: &cmacme.CertificateDNSNameSelector{
+										DNSZones: []string{"acme.example.net"},
+									},
+									DNS01: &cmacme.ACMEChallengeSolverDNS01{
+										CNAMEStrategy: cmacme.FollowStrategy,
+										Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+											Email: "delegated-dnszone-selector-solver",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"www.example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "www.example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNS01},
+			},
+			cnameTarget: func(domain string) (string, error) {
+				return "", errors.New("lookup failed")
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "www.example.com",
+				Token:   acmeChallengeDNS01.Token,
+				Key:     "dns01",
+				Solver:  emptySelectorSolverDNS01,
+			},
+		},
 		"most specific dnsZone should be selected if multiple match": {
 			acmeClient: basicACMEClient,
 			issuer: &v1.Issuer{
@@ -1256,11 +1411,107 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 				Solver:  exampleComDNSNameSelectorSolver,
 			},
 		},
+		"uses the solver pinned by name even if another solver has a more specific selector": {
+			acmeClient: basicACMEClient,
+			issuer: &v1.Issuer{
+				Spec: v1.IssuerSpec{
+					IssuerConfig: v1.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								exampleComDNSNameSelectorSolver,
+								pinnedSolverDNS01,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						cmacme.ACMECertificateSolverNameOverride: "pinned",
+					},
+				},
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01, *acmeChallengeDNS01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "example.com",
+				Token:   acmeChallengeDNS01.Token,
+				Key:     "dns01",
+				Solver:  pinnedSolverDNS01,
+			},
+		},
+		"returns an error if the issuer has no solver with the pinned name": {
+			acmeClient: basicACMEClient,
+			issuer: &v1.Issuer{
+				Spec: v1.IssuerSpec{
+					IssuerConfig: v1.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverHTTP01,
+								pinnedSolverDNS01,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						cmacme.ACMECertificateSolverNameOverride: "does-not-exist",
+					},
+				},
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01, *acmeChallengeDNS01},
+			},
+			expectedError: true,
+		},
+		"returns an error if the pinned solver cannot complete any challenge of the authorization": {
+			acmeClient: basicACMEClient,
+			issuer: &v1.Issuer{
+				Spec: v1.IssuerSpec{
+					IssuerConfig: v1.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverHTTP01,
+								pinnedSolverDNS01,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						cmacme.ACMECertificateSolverNameOverride: "pinned",
+					},
+				},
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01},
+			},
+			expectedError: true,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctx := context.Background()
-			cs, err := challengeSpecForAuthorization(ctx, test.acmeClient, test.issuer, test.order, *test.authz)
+			cs, err := challengeSpecForAuthorization(ctx, test.acmeClient, test.issuer, test.order, *test.authz, test.cnameTarget)
 			if err != nil && !test.expectedError {
 				t.Errorf("expected to not get an error, but got: %v", err)
 				t.Fail()
@@ -19,6 +19,7 @@ package shimhelper
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +44,7 @@ var (
 //       cert-manager.io/duration: 2160h
 //       cert-manager.io/renew-before: 1440h
 //       cert-manager.io/usages: "digital signature,key encipherment"
+//       cert-manager.io/collapse-wildcard-hosts: "true"
 //
 // is mapped to the following Certificate:
 //
@@ -54,6 +56,8 @@ var (
 //     usages:
 //       - digital signature
 //       - key encipherment
+//
+// with the DNS names collapsed into wildcards by collapseWildcardHosts.
 func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]string) error {
 	if crt == nil {
 		return errNilCertificate
@@ -92,5 +96,44 @@ func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]
 		}
 		crt.Spec.Usages = newUsages
 	}
+
+	if collapse, found := ingLikeAnnotations[cmapi.IngressCollapseWildcardHostsAnnotationKey]; found {
+		enabled, err := strconv.ParseBool(collapse)
+		if err != nil {
+			return fmt.Errorf("%w %q: %v", errInvalidIngressAnnotation, cmapi.IngressCollapseWildcardHostsAnnotationKey, err)
+		}
+		if enabled {
+			crt.Spec.DNSNames = collapseWildcardHosts(crt.Spec.DNSNames)
+		}
+	}
 	return nil
 }
+
+// collapseWildcardHosts replaces each host with its parent domain and a
+// wildcard for that domain, so that for example "a.example.com" and
+// "b.example.com" both become "example.com" and "*.example.com". Hosts which
+// are already wildcards, or whose parent would be a top level domain, are
+// kept as they are. The order of first appearance is preserved.
+func collapseWildcardHosts(hosts []string) []string {
+	var collapsed []string
+	seen := make(map[string]struct{})
+	add := func(host string) {
+		if _, ok := seen[host]; ok {
+			return
+		}
+		seen[host] = struct{}{}
+		collapsed = append(collapsed, host)
+	}
+
+	for _, host := range hosts {
+		labels := strings.SplitN(host, ".", 2)
+		if len(labels) < 2 || labels[0] == "*" || !strings.Contains(labels[1], ".") {
+			add(host)
+			continue
+		}
+		add(labels[1])
+		add("*." + labels[1])
+	}
+
+	return collapsed
+}
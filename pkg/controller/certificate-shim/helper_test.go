@@ -102,6 +102,25 @@ func Test_translateAnnotations(t *testing.T) {
 			},
 			expectedError: errInvalidIngressAnnotation,
 		},
+		"collapse wildcard hosts": {
+			crt:         gen.Certificate("example-cert", gen.SetCertificateDNSNames("a.example.com", "b.example.com")),
+			annotations: map[string]string{cmapi.IngressCollapseWildcardHostsAnnotationKey: "true"},
+			check: func(a *assert.Assertions, crt *cmapi.Certificate) {
+				a.Equal([]string{"example.com", "*.example.com"}, crt.Spec.DNSNames)
+			},
+		},
+		"do not collapse wildcard hosts if disabled": {
+			crt:         gen.Certificate("example-cert", gen.SetCertificateDNSNames("a.example.com", "b.example.com")),
+			annotations: map[string]string{cmapi.IngressCollapseWildcardHostsAnnotationKey: "false"},
+			check: func(a *assert.Assertions, crt *cmapi.Certificate) {
+				a.Equal([]string{"a.example.com", "b.example.com"}, crt.Spec.DNSNames)
+			},
+		},
+		"bad collapse wildcard hosts": {
+			crt:           gen.Certificate("example-cert"),
+			annotations:   map[string]string{cmapi.IngressCollapseWildcardHostsAnnotationKey: "yes please"},
+			expectedError: errInvalidIngressAnnotation,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -124,6 +143,35 @@ func Test_translateAnnotations(t *testing.T) {
 	}
 }
 
+func Test_collapseWildcardHosts(t *testing.T) {
+	tests := map[string]struct {
+		hosts    []string
+		expected []string
+	}{
+		"apex and subdomains are collapsed into apex and wildcard": {
+			hosts:    []string{"example.com", "www.example.com", "api.example.com"},
+			expected: []string{"example.com", "*.example.com"},
+		},
+		"existing wildcards are kept": {
+			hosts:    []string{"*.example.com", "www.example.com"},
+			expected: []string{"*.example.com", "example.com"},
+		},
+		"subdomains of different domains are collapsed separately": {
+			hosts:    []string{"a.example.com", "a.b.example.com", "a.example.org"},
+			expected: []string{"example.com", "*.example.com", "b.example.com", "*.b.example.com", "example.org", "*.example.org"},
+		},
+		"hosts directly below a top level domain are kept": {
+			hosts:    []string{"example.com", "localhost"},
+			expected: []string{"example.com", "localhost"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, collapseWildcardHosts(test.hosts))
+		})
+	}
+}
+
 // assertErrorIs checks that the supplied error has the target error in its chain.
 // TODO Upgrade to next release of testify package which has this built in.
 func assertErrorIs(t *testing.T, err, target error) {
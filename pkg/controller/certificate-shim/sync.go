@@ -41,19 +41,13 @@ import (
 	clientset "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	"github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	ingress "github.com/jetstack/cert-manager/pkg/internal/ingress"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha1"
 )
 
-const (
-	reasonBadConfig         = "BadConfig"
-	reasonCreateCertificate = "CreateCertificate"
-	reasonUpdateCertificate = "UpdateCertificate"
-	reasonDeleteCertificate = "DeleteCertificate"
-)
-
 var ingressV1GVK = networkingv1.SchemeGroupVersion.WithKind("Ingress")
 var ingressV1Beta1GVK = networkingv1beta1.SchemeGroupVersion.WithKind("Ingress")
 var gatewayGVK = gwapi.SchemeGroupVersion.WithKind("Gateway")
@@ -104,14 +98,14 @@ func SyncFnFor(
 		issuerName, issuerKind, issuerGroup, err := issuerForIngressLike(defaults, ingLike)
 		if err != nil {
 			log.Error(err, "failed to determine issuer to be used for ingress resource")
-			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, "Could not determine issuer for ingress due to bad annotations: %s",
+			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, events.ReasonBadConfig, "Could not determine issuer for ingress due to bad annotations: %s",
 				err)
 			return nil
 		}
 
 		err = validateIngressLike(ingLike).ToAggregate()
 		if err != nil {
-			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, err.Error())
+			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, events.ReasonBadConfig, err.Error())
 			return nil
 		}
 
@@ -125,7 +119,7 @@ func SyncFnFor(
 			if err != nil {
 				return err
 			}
-			rec.Eventf(ingLikeObj, corev1.EventTypeNormal, reasonCreateCertificate, "Successfully created Certificate %q", crt.Name)
+			rec.Eventf(ingLikeObj, corev1.EventTypeNormal, events.ReasonCreateCertificate, "Successfully created Certificate %q", crt.Name)
 		}
 
 		for _, crt := range updateCrts {
@@ -133,7 +127,7 @@ func SyncFnFor(
 			if err != nil {
 				return err
 			}
-			rec.Eventf(ingLikeObj, corev1.EventTypeNormal, reasonUpdateCertificate, "Successfully updated Certificate %q", crt.Name)
+			rec.Eventf(ingLikeObj, corev1.EventTypeNormal, events.ReasonUpdateCertificate, "Successfully updated Certificate %q", crt.Name)
 		}
 
 		certs, err := cmLister.Certificates(ingLike.GetNamespace()).List(labels.Everything())
@@ -147,7 +141,7 @@ func SyncFnFor(
 			if err != nil {
 				return err
 			}
-			rec.Eventf(ingLikeObj, corev1.EventTypeNormal, reasonDeleteCertificate, "Successfully deleted unrequired Certificate %q", certName)
+			rec.Eventf(ingLikeObj, corev1.EventTypeNormal, events.ReasonDeleteCertificate, "Successfully deleted unrequired Certificate %q", certName)
 		}
 
 		return nil
@@ -282,7 +276,7 @@ func buildCertificates(
 			path := field.NewPath("spec", "tls").Index(i)
 			err := validateIngressTLSBlock(path, tls).ToAggregate()
 			if err != nil {
-				rec.Eventf(ingLike, corev1.EventTypeWarning, reasonBadConfig, "Skipped a TLS block: "+err.Error())
+				rec.Eventf(ingLike, corev1.EventTypeWarning, events.ReasonBadConfig, "Skipped a TLS block: "+err.Error())
 				continue
 			}
 			tlsHosts[corev1.ObjectReference{
@@ -294,7 +288,7 @@ func buildCertificates(
 		for i, l := range ingLike.Spec.Listeners {
 			err := validateGatewayListenerBlock(field.NewPath("spec", "listeners").Index(i), l).ToAggregate()
 			if err != nil {
-				rec.Eventf(ingLike, corev1.EventTypeWarning, reasonBadConfig, "Skipped a listener block: "+err.Error())
+				rec.Eventf(ingLike, corev1.EventTypeWarning, events.ReasonBadConfig, "Skipped a listener block: "+err.Error())
 				continue
 			}
 
@@ -359,6 +353,18 @@ func buildCertificates(
 			return nil, nil, err
 		}
 
+		// The IP addresses of Certificates using this annotation are managed
+		// by the service IPs controller, so preserve them.
+		if serviceName, ok := ingLike.GetAnnotations()[cmapi.ServiceIPSANsAnnotationKey]; ok {
+			if crt.Annotations == nil {
+				crt.Annotations = make(map[string]string)
+			}
+			crt.Annotations[cmapi.ServiceIPSANsAnnotationKey] = serviceName
+			if existingCrt != nil {
+				crt.Spec.IPAddresses = existingCrt.Spec.IPAddresses
+			}
+		}
+
 		// check if a Certificate for this TLS entry already exists, and if it
 		// does then skip this entry
 		if existingCrt != nil {
@@ -384,6 +390,14 @@ func buildCertificates(
 
 			updateCrt.Spec = crt.Spec
 			updateCrt.Labels = crt.Labels
+			if serviceName, ok := crt.Annotations[cmapi.ServiceIPSANsAnnotationKey]; ok {
+				if updateCrt.Annotations == nil {
+					updateCrt.Annotations = make(map[string]string)
+				}
+				updateCrt.Annotations[cmapi.ServiceIPSANsAnnotationKey] = serviceName
+			} else {
+				delete(updateCrt.Annotations, cmapi.ServiceIPSANsAnnotationKey)
+			}
 
 			setIssuerSpecificConfig(crt, ingLike)
 
@@ -449,6 +463,10 @@ func certNeedsUpdate(a, b *cmapi.Certificate) bool {
 		return true
 	}
 
+	if a.Annotations[cmapi.ServiceIPSANsAnnotationKey] != b.Annotations[cmapi.ServiceIPSANsAnnotationKey] {
+		return true
+	}
+
 	if len(a.Spec.DNSNames) != len(b.Spec.DNSNames) {
 		return true
 	}
@@ -478,26 +496,28 @@ func certNeedsUpdate(a, b *cmapi.Certificate) bool {
 // two Ingress-specific annotations.
 //
 // (1) The edit-in-place Ingress annotation allows the use of Ingress
-//     controllers that map a single IP address to a single Ingress
-//     resource, such as the GCE ingress controller. The the following
-//     annotation on an Ingress named "my-ingress":
 //
-//       acme.cert-manager.io/http01-edit-in-place: "true"
+//	controllers that map a single IP address to a single Ingress
+//	resource, such as the GCE ingress controller. The the following
+//	annotation on an Ingress named "my-ingress":
 //
-//     configures the Certificate with two annotations:
+//	  acme.cert-manager.io/http01-edit-in-place: "true"
 //
-//       acme.cert-manager.io/http01-override-ingress-name: my-ingress
-//       cert-manager.io/issue-temporary-certificate: "true"
+//	configures the Certificate with two annotations:
+//
+//	  acme.cert-manager.io/http01-override-ingress-name: my-ingress
+//	  cert-manager.io/issue-temporary-certificate: "true"
 //
 // (2) The ingress-class Ingress annotation allows users to override the
-//     Issuer's acme.solvers[0].http01.ingress.class. For example, on the
-//     Ingress:
 //
-//       acme.cert-manager.io/http01-ingress-class: traefik
+//	Issuer's acme.solvers[0].http01.ingress.class. For example, on the
+//	Ingress:
+//
+//	  acme.cert-manager.io/http01-ingress-class: traefik
 //
-//     configures the Certificate using the override-ingress-class annotation:
+//	configures the Certificate using the override-ingress-class annotation:
 //
-//       acme.cert-manager.io/http01-override-ingress-class: traefik
+//	  acme.cert-manager.io/http01-override-ingress-class: traefik
 func setIssuerSpecificConfig(crt *cmapi.Certificate, ingLike metav1.Object) {
 	ingAnnotations := ingLike.GetAnnotations()
 	if ingAnnotations == nil {
@@ -531,15 +551,14 @@ func setIssuerSpecificConfig(crt *cmapi.Certificate, ingLike metav1.Object) {
 // hasShimAnnotation returns true if the given ingress-like resource contains
 // one of the trigger annotations:
 //
-//   cert-manager.io/issuer
-//   cert-manager.io/cluster-issuer
+//	cert-manager.io/issuer
+//	cert-manager.io/cluster-issuer
 //
 // The autoCertificateAnnotations can also be used to customize additional
 // annotations to trigger a Certificate shim. For example, for Ingress
 // resources, we default autoCertificateAnnotations to:
 //
-//   kubernetes.io/tls-acme: "true"
-//
+//	kubernetes.io/tls-acme: "true"
 func hasShimAnnotation(ingLike metav1.Object, autoCertificateAnnotations []string) bool {
 	annotations := ingLike.GetAnnotations()
 	if annotations == nil {
@@ -566,10 +585,10 @@ func hasShimAnnotation(ingLike metav1.Object, autoCertificateAnnotations []strin
 // the default issuer given to the controller is used. We look up the following
 // Ingress annotations:
 //
-//   cert-manager.io/cluster-issuer
-//   cert-manager.io/issuer
-//   cert-manager.io/issuer-kind
-//   cert-manager.io/issuer-group
+//	cert-manager.io/cluster-issuer
+//	cert-manager.io/issuer
+//	cert-manager.io/issuer-kind
+//	cert-manager.io/issuer-group
 func issuerForIngressLike(defaults controller.IngressShimOptions, ingLike metav1.Object) (name, kind, group string, err error) {
 	var errs []string
 
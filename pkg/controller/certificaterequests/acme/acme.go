@@ -34,8 +34,10 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	issuerpkg "github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/tracing"
 	"github.com/jetstack/cert-manager/pkg/util"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
@@ -96,7 +98,7 @@ func (a *ACME) Sign(ctx context.Context, cr *v1.CertificateRequest, issuer v1.Ge
 	if err != nil {
 		message := "Failed to decode CSR in spec.request"
 
-		a.reporter.Failed(cr, err, "RequestParsingError", message)
+		a.reporter.Failed(cr, err, events.ReasonRequestParsingError, message)
 		log.Error(err, message)
 
 		return nil, nil
@@ -107,7 +109,7 @@ func (a *ACME) Sign(ctx context.Context, cr *v1.CertificateRequest, issuer v1.Ge
 		err = fmt.Errorf("%q does not exist in %s or %s", csr.Subject.CommonName, csr.DNSNames, pki.IPAddressesToString(csr.IPAddresses))
 		message := "The CSR PEM requests a commonName that is not present in the list of dnsNames or ipAddresses. If a commonName is set, ACME requires that the value is also present in the list of dnsNames or ipAddresses"
 
-		a.reporter.Failed(cr, err, "InvalidOrder", message)
+		a.reporter.Failed(cr, err, events.ReasonInvalidOrder, message)
 
 		log.V(logf.DebugLevel).Info(fmt.Sprintf("%s: %s", message, err))
 
@@ -119,7 +121,7 @@ func (a *ACME) Sign(ctx context.Context, cr *v1.CertificateRequest, issuer v1.Ge
 	if err != nil {
 		message := "Failed to build order"
 
-		a.reporter.Failed(cr, err, "OrderBuildingError", message)
+		a.reporter.Failed(cr, err, events.ReasonOrderBuildingError, message)
 		log.Error(err, message)
 
 		return nil, nil
@@ -129,11 +131,12 @@ func (a *ACME) Sign(ctx context.Context, cr *v1.CertificateRequest, issuer v1.Ge
 	if k8sErrors.IsNotFound(err) {
 		// Failing to create the order here is most likely network related.
 		// We should backoff and keep trying.
+		tracing.InjectAnnotation(ctx, expectedOrder)
 		_, err = a.acmeClientV.Orders(expectedOrder.Namespace).Create(ctx, expectedOrder, metav1.CreateOptions{})
 		if err != nil {
 			message := fmt.Sprintf("Failed create new order resource %s/%s", expectedOrder.Namespace, expectedOrder.Name)
 
-			a.reporter.Pending(cr, err, "OrderCreatingError", message)
+			a.reporter.Pending(cr, err, events.ReasonOrderCreatingError, message)
 			log.Error(err, message)
 
 			return nil, err
@@ -141,7 +144,7 @@ func (a *ACME) Sign(ctx context.Context, cr *v1.CertificateRequest, issuer v1.Ge
 
 		message := fmt.Sprintf("Created Order resource %s/%s",
 			expectedOrder.Namespace, expectedOrder.Name)
-		a.reporter.Pending(cr, nil, "OrderCreated", message)
+		a.reporter.Pending(cr, nil, events.ReasonOrderCreated, message)
 		log.V(logf.DebugLevel).Info(message)
 
 		return nil, nil
@@ -150,7 +153,7 @@ func (a *ACME) Sign(ctx context.Context, cr *v1.CertificateRequest, issuer v1.Ge
 		// We are probably in a network error here so we should backoff and retry
 		message := fmt.Sprintf("Failed to get order resource %s/%s", expectedOrder.Namespace, expectedOrder.Name)
 
-		a.reporter.Pending(cr, err, "OrderGetError", message)
+		a.reporter.Pending(cr, err, events.ReasonOrderGetError, message)
 		log.Error(err, message)
 
 		return nil, err
@@ -171,13 +174,13 @@ func (a *ACME) Sign(ctx context.Context, cr *v1.CertificateRequest, issuer v1.Ge
 	if acme.IsFailureState(order.Status.State) {
 		message := fmt.Sprintf("Failed to wait for order resource %q to become ready", expectedOrder.Name)
 		err := fmt.Errorf("order is in %q state: %s", order.Status.State, order.Status.Reason)
-		a.reporter.Failed(cr, err, "OrderFailed", message)
+		a.reporter.Failed(cr, err, events.ReasonOrderFailed, message)
 		return nil, nil
 	}
 
 	if order.Status.State != cmacme.Valid {
 		// We update here to just pending while we wait for the order to be resolved.
-		a.reporter.Pending(cr, nil, "OrderPending",
+		a.reporter.Pending(cr, nil, events.ReasonOrderPending,
 			fmt.Sprintf("Waiting on certificate issuance from order %s/%s: %q",
 				expectedOrder.Namespace, order.Name, order.Status.State))
 
@@ -187,7 +190,7 @@ func (a *ACME) Sign(ctx context.Context, cr *v1.CertificateRequest, issuer v1.Ge
 	}
 
 	if len(order.Status.Certificate) == 0 {
-		a.reporter.Pending(cr, nil, "OrderPending",
+		a.reporter.Pending(cr, nil, events.ReasonOrderPending,
 			fmt.Sprintf("Waiting for order-controller to add certificate data to Order %s/%s",
 				expectedOrder.Namespace, order.Name))
 
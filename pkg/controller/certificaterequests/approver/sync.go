@@ -25,7 +25,9 @@ import (
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/audit"
 )
 
 const (
@@ -66,7 +68,8 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	if err != nil {
 		return err
 	}
-	c.recorder.Event(cr, corev1.EventTypeNormal, "cert-manager.io", ApprovedMessage)
+	annotations := audit.ForCertificateRequest(audit.DecisionApproved, cr).Set(audit.ReasonKey, "cert-manager.io")
+	c.recorder.AnnotatedEventf(cr, annotations.EventAnnotations(), corev1.EventTypeNormal, events.ReasonApproved, ApprovedMessage)
 
 	log.V(logf.DebugLevel).Info("approved certificate request")
 
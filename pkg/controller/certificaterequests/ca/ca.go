@@ -30,10 +30,11 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	issuerpkg "github.com/jetstack/cert-manager/pkg/issuer"
+	caissuer "github.com/jetstack/cert-manager/pkg/issuer/ca"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	cmerrors "github.com/jetstack/cert-manager/pkg/util/errors"
-	"github.com/jetstack/cert-manager/pkg/util/kube"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -84,11 +85,11 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
 
 	// get a copy of the CA certificate named on the Issuer
-	caCerts, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, c.secretsLister, resourceNamespace, issuerObj.GetSpec().CA.SecretName)
+	caCerts, caKey, err := caissuer.KeyPair(ctx, c.secretsLister, c.issuerOptions, issuerObj)
 	if k8sErrors.IsNotFound(err) {
 		message := fmt.Sprintf("Referenced secret %s/%s not found", resourceNamespace, secretName)
 
-		c.reporter.Pending(cr, err, "SecretMissing", message)
+		c.reporter.Pending(cr, err, events.ReasonSecretMissing, message)
 		log.Error(err, message)
 
 		return nil, nil
@@ -97,7 +98,7 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	if cmerrors.IsInvalidData(err) {
 		message := fmt.Sprintf("Failed to parse signing CA keypair from secret %s/%s", resourceNamespace, secretName)
 
-		c.reporter.Pending(cr, err, "SecretInvalidData", message)
+		c.reporter.Pending(cr, err, events.ReasonSecretInvalidData, message)
 		log.Error(err, message)
 		return nil, nil
 	}
@@ -105,7 +106,7 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	if err != nil {
 		// We are probably in a network error here so we should backoff and retry
 		message := fmt.Sprintf("Failed to get certificate key pair from secret %s/%s", resourceNamespace, secretName)
-		c.reporter.Pending(cr, err, "SecretGetError", message)
+		c.reporter.Pending(cr, err, events.ReasonSecretGetError, message)
 		log.Error(err, message)
 		return nil, err
 	}
@@ -113,18 +114,19 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	template, err := c.templateGenerator(cr)
 	if err != nil {
 		message := "Error generating certificate template"
-		c.reporter.Failed(cr, err, "SigningError", message)
+		c.reporter.Failed(cr, err, events.ReasonSigningError, message)
 		log.Error(err, message)
 		return nil, nil
 	}
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().CA.CRLDistributionPoints
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
+	template.NotBefore = template.NotBefore.Add(-c.issuerOptions.Backdate(cr.Spec.Backdate))
 
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := "Error signing certificate"
-		c.reporter.Failed(cr, err, "SigningError", message)
+		c.reporter.Failed(cr, err, events.ReasonSigningError, message)
 		log.Error(err, message)
 		return nil, err
 	}
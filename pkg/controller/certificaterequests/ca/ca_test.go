@@ -476,6 +476,7 @@ func TestCA_Sign(t *testing.T) {
 		givenCASecret    *corev1.Secret
 		givenCAIssuer    cmapi.GenericIssuer
 		givenCR          *cmapi.CertificateRequest
+		issuanceBackdate time.Duration
 		assertSignedCert func(t *testing.T, got *x509.Certificate)
 		wantErr          string
 	}{
@@ -520,6 +521,58 @@ func TestCA_Sign(t *testing.T) {
 				assert.LessOrEqualf(t, deltaSec, 1., "expected a time delta lower than 1 second. Time expected='%s', got='%s'", expectNotAfter.String(), got.NotAfter.String())
 			},
 		},
+		"when the CertificateRequest has the backdate field set, notBefore on the signed ca should be backdated": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+				gen.SetCertificateRequestDuration(&metav1.Duration{
+					Duration: 30 * time.Minute,
+				}),
+				gen.SetCertificateRequestBackdate(&metav1.Duration{
+					Duration: 5 * time.Minute,
+				}),
+			),
+			issuanceBackdate: time.Minute,
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				// See above for why a delta of 1 second is used.
+				expectNotBefore := time.Now().UTC().Add(-5 * time.Minute)
+				deltaSec := math.Abs(expectNotBefore.Sub(got.NotBefore).Seconds())
+				assert.LessOrEqualf(t, deltaSec, 1., "expected a time delta lower than 1 second. Time expected='%s', got='%s'", expectNotBefore.String(), got.NotBefore.String())
+
+				expectNotAfter := time.Now().UTC().Add(30 * time.Minute)
+				deltaSec = math.Abs(expectNotAfter.Sub(got.NotAfter).Seconds())
+				assert.LessOrEqualf(t, deltaSec, 1., "expected a time delta lower than 1 second. Time expected='%s', got='%s'", expectNotAfter.String(), got.NotAfter.String())
+			},
+		},
+		"when the CertificateRequest does not have the backdate field set, notBefore should be backdated by the controller default": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			issuanceBackdate: time.Minute,
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				// See above for why a delta of 1 second is used.
+				expectNotBefore := time.Now().UTC().Add(-time.Minute)
+				deltaSec := math.Abs(expectNotBefore.Sub(got.NotBefore).Seconds())
+				assert.LessOrEqualf(t, deltaSec, 1., "expected a time delta lower than 1 second. Time expected='%s', got='%s'", expectNotBefore.String(), got.NotBefore.String())
+			},
+		},
 		"when the CertificateRequest has the isCA field set, it should appear on the signed ca": {
 			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
 			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
@@ -585,6 +638,7 @@ func TestCA_Sign(t *testing.T) {
 					ClusterResourceNamespace:        "",
 					ClusterIssuerAmbientCredentials: false,
 					IssuerAmbientCredentials:        false,
+					IssuanceBackdate:                test.issuanceBackdate,
 				},
 				reporter: util.NewReporter(fixedClock, rec),
 				secretsLister: testlisters.FakeSecretListerFrom(testlisters.NewFakeSecretLister(),
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmp
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	issuerpkg "github.com/jetstack/cert-manager/pkg/issuer"
+	cmpclient "github.com/jetstack/cert-manager/pkg/issuer/cmp/client"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
+	utilpki "github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-cmp"
+
+	// temporaryCertificateIssuer is the common name of the issuer of
+	// temporary certificates, see
+	// certificates.GenerateLocallySignedTemporaryCertificate.
+	temporaryCertificateIssuer = "cert-manager.local"
+)
+
+type CMP struct {
+	issuerOptions      controllerpkg.IssuerOptions
+	secretsLister      corelisters.SecretLister
+	certificatesLister cmlisters.CertificateLister
+	reporter           *crutil.Reporter
+	clock              clock.Clock
+
+	clientBuilder cmpclient.ClientBuilder
+}
+
+func init() {
+	// create certificate request controller for cmp issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		// The Certificate informer is waited for so that renewals are sent
+		// as key update requests as soon as the controller starts.
+		certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates().Informer()
+
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerCMP, NewCMP(ctx), certificateInformer)).
+			Complete()
+	})
+}
+
+func NewCMP(ctx *controllerpkg.Context) *CMP {
+	return &CMP{
+		issuerOptions:      ctx.IssuerOptions,
+		secretsLister:      ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		certificatesLister: ctx.SharedInformerFactory.Certmanager().V1().Certificates().Lister(),
+		reporter:           crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		clock:              ctx.Clock,
+		clientBuilder:      cmpclient.New,
+	}
+}
+
+func (c *CMP) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		message := "Failed to decode CSR in spec.request"
+
+		c.reporter.Failed(cr, err, events.ReasonRequestParsingError, message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := c.clientBuilder(c.issuerOptions.ResourceNamespace(issuerObj), c.secretsLister, issuerObj.GetSpec().CMP)
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		c.reporter.Pending(cr, err, events.ReasonSecretMissing, message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise CMP client for signing"
+
+		c.reporter.Pending(cr, err, events.ReasonCMPInitError, message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	update, err := c.keyUpdatePair(cr)
+	if err != nil {
+		message := "Failed to read the certificate being renewed"
+
+		c.reporter.Pending(cr, err, events.ReasonSecretGetError, message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+	if update != nil {
+		log = log.WithValues("serial_number", update.Certificate.SerialNumber.String())
+		log.V(logf.DebugLevel).Info("renewing certificate with a key update request")
+	}
+
+	// check if the transaction ID annotation is there, if not send a new
+	// request.
+	transactionID := cr.ObjectMeta.Annotations[cmapi.CMPTransactionIDAnnotationKey]
+	var certs []*x509.Certificate
+	if transactionID == "" {
+		certs, err = client.Enroll(ctx, csr.Raw, update)
+	} else {
+		certs, err = client.Poll(ctx, csr.Raw, transactionID, update)
+	}
+	if err != nil {
+		var pendingErr *cmpclient.PendingError
+		var failedErr *cmpclient.FailedError
+		switch {
+		case errors.As(err, &pendingErr) && transactionID == "":
+			c.reporter.Pending(cr, err, events.ReasonIssuancePending, "CMP certificate is requested")
+
+			metav1.SetMetaDataAnnotation(&cr.ObjectMeta, cmapi.CMPTransactionIDAnnotationKey, pendingErr.TransactionID)
+
+			return nil, nil
+
+		case errors.As(err, &pendingErr):
+			message := "CMP certificate still in a pending state, the request will be retried"
+
+			c.reporter.Pending(cr, err, events.ReasonIssuancePending, message)
+			log.V(logf.DebugLevel).Info(message, "transaction_id", transactionID)
+
+			return nil, err
+
+		case errors.As(err, &failedErr):
+			message := "Failed to request CMP certificate"
+
+			c.reporter.Failed(cr, err, events.ReasonRequestError, message)
+			log.Error(err, message)
+
+			return nil, nil
+
+		default:
+			message := "Failed to request CMP certificate, the request will be retried"
+
+			c.reporter.Pending(cr, err, events.ReasonRequestError, message)
+			log.Error(err, message)
+
+			return nil, err
+		}
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	bundle, err := utilpki.ParseSingleCertificateChain(certs)
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+
+		c.reporter.Failed(cr, err, events.ReasonParseError, message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
+
+// keyUpdatePair returns the key pair to renew with a key update request, if
+// the request is for a Certificate whose Secret holds an unexpired
+// certificate issued by the same issuer. Otherwise nil is returned, and a new
+// certificate is requested.
+func (c *CMP) keyUpdatePair(cr *cmapi.CertificateRequest) (*cmpclient.KeyPair, error) {
+	name := cr.Annotations[cmapi.CertificateNameKey]
+	if name == "" {
+		return nil, nil
+	}
+	crt, err := c.certificatesLister.Certificates(cr.Namespace).Get(name)
+	if k8sErrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := c.secretsLister.Secrets(cr.Namespace).Get(crt.Spec.SecretName)
+	if k8sErrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if secret.Annotations[cmapi.IssuerNameAnnotationKey] != cr.Spec.IssuerRef.Name ||
+		secret.Annotations[cmapi.IssuerKindAnnotationKey] != apiutil.IssuerKind(cr.Spec.IssuerRef) {
+		return nil, nil
+	}
+
+	// Secrets which cannot be read are replaced with a new certificate.
+	certs, key, err := kube.SecretTLSKeyPair(context.TODO(), c.secretsLister, secret.Namespace, secret.Name)
+	if err != nil {
+		return nil, nil
+	}
+	cert := certs[0]
+	if c.clock.Now().After(cert.NotAfter) || c.clock.Now().Before(cert.NotBefore) {
+		return nil, nil
+	}
+	// Temporary certificates are signed by a local CA unknown to the CMP
+	// server, and have the annotations of the issuer they are waiting for.
+	if cert.Issuer.CommonName == temporaryCertificateIssuer {
+		return nil, nil
+	}
+	return &cmpclient.KeyPair{Certificate: cert, PrivateKey: key}, nil
+}
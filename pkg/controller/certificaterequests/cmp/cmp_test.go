@@ -0,0 +1,356 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmp
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/issuer/cmp/client"
+	cmpfake "github.com/jetstack/cert-manager/pkg/issuer/cmp/client/fake"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             rootPK.Public(),
+		IsCA:                  true,
+		Subject: pkix.Name{
+			CommonName: "root-ca",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Minute),
+		KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	rootPEM, rootCert, err := pki.SignCertificate(rootTmpl, rootTmpl, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csrPEM, testPK, err := gen.CSR(x509.ECDSA, gen.SetCSRCommonName("test-common-name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testPKPEM, err := pki.EncodePKCS8PrivateKey(testPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseIssuer := gen.Issuer("test-issuer",
+		gen.SetIssuerCMP(cmapi.CMPIssuer{URL: "https://cmp.example.com/pkix/"}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  baseIssuer.Name,
+			Kind:  baseIssuer.Kind,
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+	pollingCR := gen.CertificateRequestFrom(baseCR,
+		gen.AddCertificateRequestAnnotations(map[string]string{cmapi.CMPTransactionIDAnnotationKey: "0123"}),
+	)
+	renewalCR := gen.CertificateRequestFrom(baseCR,
+		gen.AddCertificateRequestAnnotations(map[string]string{cmapi.CertificateNameKey: "test-crt"}),
+	)
+
+	template, err := pki.GenerateTemplateFromCertificateRequest(baseCR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, cert, err := pki.SignCertificate(template, rootCert, testPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crt := gen.Certificate("test-crt",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateSecretName("test-secret"),
+	)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-secret",
+			Namespace: gen.DefaultTestNamespace,
+			Annotations: map[string]string{
+				cmapi.IssuerNameAnnotationKey: baseIssuer.Name,
+				cmapi.IssuerKindAnnotationKey: cmapi.IssuerKind,
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: testPKPEM,
+		},
+	}
+
+	clientReturnsCert := &cmpfake.Client{
+		EnrollFn: func(_ context.Context, _ []byte, update *client.KeyPair) ([]*x509.Certificate, error) {
+			if update != nil {
+				return nil, errors.New("unexpected key update request")
+			}
+			return []*x509.Certificate{cert, rootCert}, nil
+		},
+	}
+	clientReturnsCertForKeyUpdate := &cmpfake.Client{
+		EnrollFn: func(_ context.Context, _ []byte, update *client.KeyPair) ([]*x509.Certificate, error) {
+			if update == nil || !update.Certificate.Equal(cert) {
+				return nil, errors.New("expected key update request for the certificate in the Secret")
+			}
+			return []*x509.Certificate{cert, rootCert}, nil
+		},
+	}
+	clientReturnsPending := &cmpfake.Client{
+		EnrollFn: func(context.Context, []byte, *client.KeyPair) ([]*x509.Certificate, error) {
+			return nil, &client.PendingError{TransactionID: "0123"}
+		},
+		PollFn: func(context.Context, []byte, string, *client.KeyPair) ([]*x509.Certificate, error) {
+			return nil, &client.PendingError{TransactionID: "0123"}
+		},
+	}
+	clientReturnsFailed := &cmpfake.Client{
+		EnrollFn: func(context.Context, []byte, *client.KeyPair) ([]*x509.Certificate, error) {
+			return nil, &client.FailedError{Status: "status 2: badRequest"}
+		},
+	}
+
+	tests := map[string]testT{
+		"if the CMP server returns a certificate then return cert": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+			fakeClient: clientReturnsCert,
+		},
+		"if the Secret of the Certificate holds a certificate from the issuer then send a key update request": {
+			certificateRequest: renewalCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects:        []runtime.Object{secret},
+				CertManagerObjects: []runtime.Object{renewalCR.DeepCopy(), baseIssuer.DeepCopy(), crt},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(renewalCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+			fakeClient: clientReturnsCertForKeyUpdate,
+		},
+		"if the request is pending then set the transaction ID annotation and set pending": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending CMP certificate is requested: certificate request 0123 is pending on the CMP server",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(pollingCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "CMP certificate is requested: certificate request 0123 is pending on the CMP server",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			fakeClient: clientReturnsPending,
+		},
+		"if polling returns pending then return error and set pending": {
+			certificateRequest: pollingCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{pollingCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending CMP certificate still in a pending state, the request will be retried: certificate request 0123 is pending on the CMP server",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(pollingCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "CMP certificate still in a pending state, the request will be retried: certificate request 0123 is pending on the CMP server",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			fakeClient:  clientReturnsPending,
+			expectedErr: true,
+		},
+		"if the CMP server rejects the request then hard fail": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RequestError Failed to request CMP certificate: CMP server rejected the certificate request: status 2: badRequest",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Failed to request CMP certificate: CMP server rejected the certificate request: status 2: badRequest",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeClient: clientReturnsFailed,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fixedClock.SetTime(fixedClockStart)
+			test.builder.Clock = fixedClock
+			runTest(t, test)
+		})
+	}
+}
+
+type testT struct {
+	builder            *controllertest.Builder
+	certificateRequest *cmapi.CertificateRequest
+
+	fakeClient *cmpfake.Client
+
+	expectedErr bool
+}
+
+func runTest(t *testing.T, test testT) {
+	test.builder.T = t
+	test.builder.Init()
+	defer test.builder.Stop()
+
+	c := NewCMP(test.builder.Context)
+	c.clientBuilder = func(string, corelisters.SecretLister, *cmapi.CMPIssuer) (client.Interface, error) {
+		return test.fakeClient, nil
+	}
+
+	controller := certificaterequests.New(apiutil.IssuerCMP, c)
+	controller.Register(test.builder.Context)
+	test.builder.Start()
+
+	err := controller.Sync(context.Background(), test.certificateRequest)
+	if err != nil && !test.expectedErr {
+		t.Errorf("expected to not get an error, but got: %v", err)
+	}
+	if err == nil && test.expectedErr {
+		t.Errorf("expected to get an error but did not get one")
+	}
+
+	test.builder.CheckAndFinish(err)
+}
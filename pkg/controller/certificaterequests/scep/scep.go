@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scep
+
+import (
+	"context"
+	"errors"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	issuerpkg "github.com/jetstack/cert-manager/pkg/issuer"
+	scepclient "github.com/jetstack/cert-manager/pkg/issuer/scep/client"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	utilpki "github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-scep"
+)
+
+type SCEP struct {
+	reporter *crutil.Reporter
+
+	clientBuilder scepclient.ClientBuilder
+}
+
+func init() {
+	// create certificate request controller for scep issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerSCEP, NewSCEP(ctx))).
+			Complete()
+	})
+}
+
+func NewSCEP(ctx *controllerpkg.Context) *SCEP {
+	return &SCEP{
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		clientBuilder: scepclient.New,
+	}
+}
+
+func (s *SCEP) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	iss := issuerObj.GetSpec().SCEP
+
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		message := "Failed to decode CSR in spec.request"
+
+		s.reporter.Failed(cr, err, events.ReasonRequestParsingError, message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	// The challenge password is added to the request by the certificates
+	// controller, which holds the private key. CertificateRequests created
+	// by other means must include it themselves.
+	if iss.ChallengePasswordSecretRef != nil {
+		_, ok, err := utilpki.ChallengePasswordFromCSR(csr.Raw)
+		if err == nil && !ok {
+			err = errors.New("issuer requires a challenge password")
+		}
+		if err != nil {
+			message := "Failed to read the challenge password from the CSR in spec.request"
+
+			s.reporter.Failed(cr, err, events.ReasonBadConfig, message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+	}
+
+	client, err := s.clientBuilder(iss)
+	if err != nil {
+		message := "Failed to initialise SCEP client for signing"
+
+		s.reporter.Pending(cr, err, events.ReasonSCEPInitError, message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	certs, err := client.Enroll(ctx, csr.Raw)
+	if err != nil {
+		var pendingErr *scepclient.PendingError
+		var failedErr *scepclient.FailedError
+		switch {
+		case errors.As(err, &pendingErr):
+			message := "SCEP certificate still in a pending state, the request will be retried"
+
+			s.reporter.Pending(cr, err, events.ReasonIssuancePending, message)
+			log.V(logf.DebugLevel).Info(message, "transaction_id", pendingErr.TransactionID)
+
+			return nil, err
+
+		case errors.As(err, &failedErr):
+			message := "Failed to request SCEP certificate"
+
+			s.reporter.Failed(cr, err, events.ReasonRequestError, message)
+			log.Error(err, message)
+
+			return nil, nil
+
+		default:
+			message := "Failed to request SCEP certificate, the request will be retried"
+
+			s.reporter.Pending(cr, err, events.ReasonRequestError, message)
+			log.Error(err, message)
+
+			return nil, err
+		}
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	bundle, err := utilpki.ParseSingleCertificateChain(certs)
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+
+		s.reporter.Failed(cr, err, events.ReasonParseError, message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
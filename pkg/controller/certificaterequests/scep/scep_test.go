@@ -0,0 +1,356 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scep
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/issuer/scep/client"
+	scepfake "github.com/jetstack/cert-manager/pkg/issuer/scep/client/fake"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+func generateCSR(t *testing.T, secretKey crypto.Signer, password string) []byte {
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName: "test-common-name",
+		},
+		DNSNames: []string{
+			"foo.example.com", "bar.example.com",
+		},
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+		PublicKey:          secretKey.Public(),
+	}
+
+	var csrBytes []byte
+	var err error
+	if password != "" {
+		csrBytes, err = pki.EncodeCSRWithChallengePassword(template, secretKey, password)
+	} else {
+		csrBytes, err = x509.CreateCertificateRequest(rand.Reader, template, secretKey)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes})
+}
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             rootPK.Public(),
+		IsCA:                  true,
+		Subject: pkix.Name{
+			CommonName: "root-ca",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Minute),
+		KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	rootPEM, rootCert, err := pki.SignCertificate(rootTmpl, rootTmpl, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseIssuer := gen.Issuer("test-issuer",
+		gen.SetIssuerSCEP(cmapi.SCEPIssuer{URL: "https://scep.example.com/scep"}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+	challengeIssuer := gen.IssuerFrom(baseIssuer,
+		gen.SetIssuerSCEP(cmapi.SCEPIssuer{
+			URL: "https://scep.example.com/scep",
+			ChallengePasswordSecretRef: &cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: "scep-challenge"},
+				Key:                  "password",
+			},
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(generateCSR(t, testPK, "")),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  baseIssuer.Name,
+			Kind:  baseIssuer.Kind,
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+	challengeCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestCSR(generateCSR(t, testPK, "test-password")),
+	)
+
+	template, err := pki.GenerateTemplateFromCertificateRequest(baseCR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, cert, err := pki.SignCertificate(template, rootCert, testPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientReturnsCert := &scepfake.Client{
+		EnrollFn: func(context.Context, []byte) ([]*x509.Certificate, error) {
+			return []*x509.Certificate{cert, rootCert}, nil
+		},
+	}
+	clientReturnsPending := &scepfake.Client{
+		EnrollFn: func(context.Context, []byte) ([]*x509.Certificate, error) {
+			return nil, &client.PendingError{TransactionID: "test-transaction"}
+		},
+	}
+	clientReturnsFailed := &scepfake.Client{
+		EnrollFn: func(context.Context, []byte) ([]*x509.Certificate, error) {
+			return nil, &client.FailedError{FailInfo: "badRequest"}
+		},
+	}
+	clientReturnsGenericError := &scepfake.Client{
+		EnrollFn: func(context.Context, []byte) ([]*x509.Certificate, error) {
+			return nil, errors.New("this is an error")
+		},
+	}
+
+	tests := map[string]testT{
+		"if the issuer requires a challenge password and the CSR has none then hard fail": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), challengeIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning BadConfig Failed to read the challenge password from the CSR in spec.request: issuer requires a challenge password",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Failed to read the challenge password from the CSR in spec.request: issuer requires a challenge password",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeClient: clientReturnsCert,
+		},
+		"if the request is pending then return error and set pending": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending SCEP certificate still in a pending state, the request will be retried: certificate request test-transaction is pending on the SCEP server",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "SCEP certificate still in a pending state, the request will be retried: certificate request test-transaction is pending on the SCEP server",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			fakeClient:  clientReturnsPending,
+			expectedErr: true,
+		},
+		"if the SCEP server rejects the request then hard fail": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RequestError Failed to request SCEP certificate: SCEP server rejected the certificate request: badRequest",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Failed to request SCEP certificate: SCEP server rejected the certificate request: badRequest",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeClient: clientReturnsFailed,
+		},
+		"if enrolling fails with a transient error then return error and set pending": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal RequestError Failed to request SCEP certificate, the request will be retried: this is an error",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Failed to request SCEP certificate, the request will be retried: this is an error",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			fakeClient:  clientReturnsGenericError,
+			expectedErr: true,
+		},
+		"if the issuer requires a challenge password and the CSR has one then return cert": {
+			certificateRequest: challengeCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{challengeCR.DeepCopy(), challengeIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(challengeCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+			fakeClient: clientReturnsCert,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fixedClock.SetTime(fixedClockStart)
+			test.builder.Clock = fixedClock
+			runTest(t, test)
+		})
+	}
+}
+
+type testT struct {
+	builder            *controllertest.Builder
+	certificateRequest *cmapi.CertificateRequest
+
+	fakeClient *scepfake.Client
+
+	expectedErr bool
+}
+
+func runTest(t *testing.T, test testT) {
+	test.builder.T = t
+	test.builder.Init()
+	defer test.builder.Stop()
+
+	s := NewSCEP(test.builder.Context)
+	s.clientBuilder = func(*cmapi.SCEPIssuer) (client.Interface, error) {
+		return test.fakeClient, nil
+	}
+
+	controller := certificaterequests.New(apiutil.IssuerSCEP, s)
+	controller.Register(test.builder.Context)
+	test.builder.Start()
+
+	err := controller.Sync(context.Background(), test.certificateRequest)
+	if err != nil && !test.expectedErr {
+		t.Errorf("expected to not get an error, but got: %v", err)
+	}
+	if err == nil && test.expectedErr {
+		t.Errorf("expected to get an error but did not get one")
+	}
+
+	test.builder.CheckAndFinish(err)
+}
@@ -33,6 +33,7 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	cmerrors "github.com/jetstack/cert-manager/pkg/util/errors"
@@ -88,7 +89,7 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 			cmapi.CertificateRequestPrivateKeyAnnotationKey)
 		err := errors.New("secret name missing")
 
-		s.reporter.Failed(cr, err, "MissingAnnotation", message)
+		s.reporter.Failed(cr, err, events.ReasonMissingAnnotation, message)
 		log.Error(err, message)
 
 		return nil, nil
@@ -98,7 +99,7 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 	if k8sErrors.IsNotFound(err) {
 		message := fmt.Sprintf("Referenced secret %s/%s not found", cr.Namespace, secretName)
 
-		s.reporter.Pending(cr, err, "MissingSecret", message)
+		s.reporter.Pending(cr, err, events.ReasonMissingSecret, message)
 		log.Error(err, message)
 
 		return nil, nil
@@ -108,7 +109,7 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 		message := fmt.Sprintf("Failed to get key %q referenced in annotation %q",
 			secretName, cmapi.CertificateRequestPrivateKeyAnnotationKey)
 
-		s.reporter.Pending(cr, err, "ErrorParsingKey", message)
+		s.reporter.Pending(cr, err, events.ReasonErrorParsingKey, message)
 		log.Error(err, message)
 
 		return nil, nil
@@ -117,7 +118,7 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 	if err != nil {
 		// We are probably in a network error here so we should backoff and retry
 		message := fmt.Sprintf("Failed to get certificate key pair from secret %s/%s", resourceNamespace, secretName)
-		s.reporter.Pending(cr, err, "ErrorGettingSecret", message)
+		s.reporter.Pending(cr, err, events.ReasonErrorGettingSecret, message)
 		log.Error(err, message)
 		return nil, err
 	}
@@ -125,12 +126,13 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 	template, err := pki.GenerateTemplateFromCertificateRequest(cr)
 	if err != nil {
 		message := "Error generating certificate template"
-		s.reporter.Failed(cr, err, "ErrorGenerating", message)
+		s.reporter.Failed(cr, err, events.ReasonErrorGenerating, message)
 		log.Error(err, message)
 		return nil, nil
 	}
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().SelfSigned.CRLDistributionPoints
+	template.NotBefore = template.NotBefore.Add(-s.issuerOptions.Backdate(cr.Spec.Backdate))
 
 	if template.Subject.String() == "" {
 		// RFC 5280 (https://tools.ietf.org/html/rfc5280#section-4.1.2.4) says that:
@@ -138,14 +140,14 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 		// Since we're creating a self-signed cert, the issuer will match whatever is
 		// in the template's subject DN.
 		log.V(logf.DebugLevel).Info("issued cert will have an empty issuer DN, which contravenes RFC 5280. emitting warning event")
-		s.recorder.Event(cr, corev1.EventTypeWarning, "BadConfig", emptyDNMessage)
+		s.recorder.Event(cr, corev1.EventTypeWarning, events.ReasonBadConfig, emptyDNMessage)
 	}
 
 	// extract the public component of the key
 	publickey, err := pki.PublicKeyForPrivateKey(privatekey)
 	if err != nil {
 		message := "Failed to get public key from private key"
-		s.reporter.Failed(cr, err, "ErrorPublicKey", message)
+		s.reporter.Failed(cr, err, events.ReasonErrorPublicKey, message)
 		log.Error(err, message)
 		return nil, nil
 	}
@@ -158,7 +160,7 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 		}
 
 		message := "Error generating certificate template"
-		s.reporter.Failed(cr, err, "ErrorKeyMatch", message)
+		s.reporter.Failed(cr, err, events.ReasonErrorKeyMatch, message)
 		log.Error(err, message)
 
 		return nil, nil
@@ -168,7 +170,7 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 	certPem, _, err := s.signingFn(template, template, publickey, privatekey)
 	if err != nil {
 		message := "Error signing certificate"
-		s.reporter.Failed(cr, err, "ErrorSigning", message)
+		s.reporter.Failed(cr, err, events.ReasonErrorSigning, message)
 		log.Error(err, message)
 		return nil, nil
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/kr/pretty"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -31,7 +32,9 @@ import (
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/tracing"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -83,7 +86,7 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 
 	issuerObj, err := c.helper.GetGenericIssuer(crCopy.Spec.IssuerRef, crCopy.Namespace)
 	if k8sErrors.IsNotFound(err) {
-		c.reporter.Pending(crCopy, err, "IssuerNotFound",
+		c.reporter.Pending(crCopy, err, events.ReasonIssuerNotFound,
 			fmt.Sprintf("Referenced %q not found", apiutil.IssuerKind(crCopy.Spec.IssuerRef)))
 		return nil
 	}
@@ -98,7 +101,7 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 
 	issuerType, err := apiutil.NameForIssuer(issuerObj)
 	if err != nil {
-		c.reporter.Pending(crCopy, err, "IssuerTypeMissing",
+		c.reporter.Pending(crCopy, err, events.ReasonIssuerTypeMissing,
 			"Missing issuer type")
 		return nil
 	}
@@ -116,7 +119,7 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		Type:   cmapi.IssuerConditionReady,
 		Status: cmmeta.ConditionTrue,
 	}) {
-		c.reporter.Pending(crCopy, nil, "IssuerNotReady",
+		c.reporter.Pending(crCopy, nil, events.ReasonIssuerNotReady,
 			"Referenced issuer does not have a Ready status condition")
 		return nil
 	}
@@ -128,10 +131,34 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	dbg.Info("enforcing the policy of the issuer")
+
+	duration, err := issuerPolicyDuration(crCopy, issuerObj)
+	if err != nil {
+		c.reporter.InvalidRequest(crCopy, events.ReasonIssuerPolicyViolation, err.Error())
+		c.reporter.Failed(crCopy, err, events.ReasonIssuerPolicyViolation, "Request violates the policy of the issuer")
+		return nil
+	}
+
+	if requested := apiutil.DefaultCertDuration(crCopy.Spec.Duration); duration != requested {
+		c.reporter.DurationClamped(crCopy, requested, duration)
+
+		// The issuer signs using the duration in the spec, so sign using the
+		// clamped duration and restore the requested duration before the
+		// CertificateRequest is updated, as its spec is immutable.
+		requestedDuration := crCopy.Spec.Duration
+		crCopy.Spec.Duration = &metav1.Duration{Duration: duration}
+		defer func() {
+			crCopy.Spec.Duration = requestedDuration
+		}()
+	}
+
 	dbg.Info("invoking sign function as existing certificate does not exist")
 
 	// Attempt to call the Sign function on our issuer
-	resp, err := c.issuer.Sign(ctx, crCopy, issuerObj)
+	signCtx, span := tracing.Start(tracing.ObjectContext(ctx, crCopy), ControllerName+"-"+c.issuerType, cmapi.CertificateRequestKind, crCopy)
+	resp, err := c.issuer.Sign(signCtx, crCopy, issuerObj)
+	tracing.End(span, err)
 	if err != nil {
 		log.Error(err, "error issuing certificate request")
 		return err
@@ -151,7 +178,7 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	// invalid cert
 	_, err = pki.DecodeX509CertificateBytes(crCopy.Status.Certificate)
 	if err != nil {
-		c.reporter.Failed(crCopy, err, "DecodeError", "Failed to decode returned certificate")
+		c.reporter.Failed(crCopy, err, events.ReasonDecodeError, "Failed to decode returned certificate")
 		return nil
 	}
 
@@ -161,6 +188,36 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	return nil
 }
 
+// issuerPolicyDuration returns the duration that the CertificateRequest should
+// be signed with according to the policy of the given issuer, or an error if
+// the CertificateRequest violates the policy.
+func issuerPolicyDuration(cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (time.Duration, error) {
+	requested := apiutil.DefaultCertDuration(cr.Spec.Duration)
+
+	policy := issuerObj.GetSpec().Policy
+	for _, extension := range cr.Spec.Extensions {
+		if !apiutil.IssuerPolicyAllowsExtension(policy, extension.ID) {
+			return 0, fmt.Errorf("extension %s is not allowed by the issuer", extension.ID)
+		}
+	}
+	if policy == nil {
+		return requested, nil
+	}
+
+	// An invalid request is reported by the issuer when signing
+	if csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request); err == nil {
+		algorithm := pki.PrivateKeyAlgorithmForPublicKeyAlgorithm(csr.PublicKeyAlgorithm)
+		if !apiutil.IssuerPolicyAllowsKeyAlgorithm(policy, algorithm) {
+			return 0, fmt.Errorf("private key algorithm %q is not allowed by the issuer", algorithm)
+		}
+		if err := apiutil.IssuerPolicyCheckRequesterIdentity(policy, cr, csr); err != nil {
+			return 0, err
+		}
+	}
+
+	return apiutil.IssuerPolicyDuration(policy, requested)
+}
+
 func (c *Controller) updateCertificateRequestStatusAndAnnotations(ctx context.Context, old, new *cmapi.CertificateRequest) (*cmapi.CertificateRequest, error) {
 	log := logf.FromContext(ctx, "updateStatus")
 
@@ -26,6 +26,7 @@ import (
 	"encoding/asn1"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -760,3 +761,213 @@ func runTest(t *testing.T, test testT) {
 	}
 	test.builder.CheckAndFinish(err)
 }
+
+func TestSyncIssuerPolicy(t *testing.T) {
+	nowMetaTime := metav1.NewTime(fixedClockStart)
+
+	skRSA, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrRSAPEM := generateCSR(t, skRSA, x509.SHA256WithRSA)
+
+	policyIssuer := func(policy cmapi.IssuerPolicy) *cmapi.Issuer {
+		return gen.Issuer("test-issuer",
+			gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			gen.SetIssuerPolicy(policy),
+			gen.AddIssuerCondition(cmapi.IssuerCondition{
+				Type:   cmapi.IssuerConditionReady,
+				Status: cmmeta.ConditionTrue,
+			}),
+		)
+	}
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrRSAPEM),
+		gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour * 24 * 90}),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Kind: cmapi.IssuerKind,
+			Name: "test-issuer",
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &nowMetaTime,
+		}),
+	)
+
+	certRSAPEM := generateSelfSignedCert(t, baseCR, skRSA, fixedClockStart, fixedClockStart.Add(time.Hour*24))
+
+	failedCR := func(message string) *cmapi.CertificateRequest {
+		return gen.CertificateRequestFrom(baseCR,
+			gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+				Type:               cmapi.CertificateRequestConditionInvalidRequest,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             "IssuerPolicyViolation",
+				Message:            message,
+				LastTransitionTime: &nowMetaTime,
+			}),
+			gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+				Type:               cmapi.CertificateRequestConditionReady,
+				Status:             cmmeta.ConditionFalse,
+				Reason:             "Failed",
+				Message:            "Request violates the policy of the issuer: " + message,
+				LastTransitionTime: &nowMetaTime,
+			}),
+			gen.SetCertificateRequestFailureTime(nowMetaTime),
+		)
+	}
+
+	keyAlgorithmIssuer := policyIssuer(cmapi.IssuerPolicy{
+		AllowedPrivateKeyAlgorithms: []cmapi.PrivateKeyAlgorithm{cmapi.ECDSAKeyAlgorithm},
+	})
+	rejectIssuer := policyIssuer(cmapi.IssuerPolicy{
+		MaxDuration: &metav1.Duration{Duration: time.Hour * 24},
+	})
+	clampIssuer := policyIssuer(cmapi.IssuerPolicy{
+		MaxDuration:         &metav1.Duration{Duration: time.Hour * 24},
+		DurationEnforcement: cmapi.ClampDurationEnforcement,
+	})
+	identityIssuer := policyIssuer(cmapi.IssuerPolicy{
+		RequesterIdentity: &cmapi.RequesterIdentityPolicy{
+			CommonName: "${serviceaccount.name}",
+		},
+	})
+	extension := cmapi.X509Extension{ID: "1.3.6.1.4.1.311.20.2", Value: []byte{0x0c, 0x02, 0x43, 0x41}}
+	extensionsCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestExtensions(extension),
+	)
+	identityCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestUsername("system:serviceaccount:"+gen.DefaultTestNamespace+":other"),
+	)
+
+	clampedMessage := "Requested duration 2160h0m0s is outside of the durations allowed by the issuer, signing with a duration of 24h0m0s"
+
+	tests := map[string]testT{
+		"should fail the request if its private key algorithm is not allowed by the issuer": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{keyAlgorithmIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning IssuerPolicyViolation Request violates the policy of the issuer: private key algorithm "RSA" is not allowed by the issuer`,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						failedCR(`private key algorithm "RSA" is not allowed by the issuer`),
+					)),
+				},
+			},
+		},
+		"should fail the request if it requests an extension not allowed by the issuer": {
+			certificateRequest: extensionsCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{keyAlgorithmIssuer, extensionsCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning IssuerPolicyViolation Request violates the policy of the issuer: extension 1.3.6.1.4.1.311.20.2 is not allowed by the issuer",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(failedCR("extension 1.3.6.1.4.1.311.20.2 is not allowed by the issuer"),
+							gen.SetCertificateRequestExtensions(extension),
+						),
+					)),
+				},
+			},
+		},
+		"should fail the request if its subject does not match the identity of the requester": {
+			certificateRequest: identityCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{identityIssuer, identityCR.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning IssuerPolicyViolation Request violates the policy of the issuer: common name "test" does not match the identity of the requester "system:serviceaccount:` + gen.DefaultTestNamespace + `:other"`,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(failedCR(`common name "test" does not match the identity of the requester "system:serviceaccount:`+gen.DefaultTestNamespace+`:other"`),
+							gen.SetCertificateRequestUsername("system:serviceaccount:"+gen.DefaultTestNamespace+":other"),
+						),
+					)),
+				},
+			},
+		},
+		"should fail the request if its duration is not allowed by the issuer": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{rejectIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning IssuerPolicyViolation Request violates the policy of the issuer: duration 2160h0m0s is longer than the maximum duration 24h0m0s allowed by the issuer",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						failedCR("duration 2160h0m0s is longer than the maximum duration 24h0m0s allowed by the issuer"),
+					)),
+				},
+			},
+		},
+		"should sign the request with a clamped duration and report the clamp": {
+			certificateRequest: baseCR.DeepCopy(),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(_ context.Context, cr *cmapi.CertificateRequest, _ cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					if cr.Spec.Duration.Duration != time.Hour*24 {
+						return nil, fmt.Errorf("expected the clamped duration to be signed, got %s", cr.Spec.Duration.Duration)
+					}
+					return &issuer.IssueResponse{
+						Certificate: certRSAPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{clampIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal DurationClamped " + clampedMessage,
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCertificate(certRSAPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionDurationClamped,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "DurationClamped",
+								Message:            clampedMessage,
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+	}
+
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			fixedClock.SetTime(fixedClockStart)
+			runTest(t, test)
+		})
+	}
+}
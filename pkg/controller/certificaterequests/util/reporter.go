@@ -18,6 +18,7 @@ package util
 
 import (
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +28,8 @@ import (
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	"github.com/jetstack/cert-manager/pkg/util/audit"
 )
 
 const (
@@ -84,6 +87,38 @@ func (r *Reporter) InvalidRequest(cr *cmapi.CertificateRequest, reason, message
 		cmmeta.ConditionTrue, reason, message)
 }
 
+// DurationClamped marks a CertificateRequest as having had its duration
+// clamped to the durations allowed by the policy of its issuer and sends a
+// corresponding event.
+//
+// The event is only sent if the CertificateRequest is not already marked.
+func (r *Reporter) DurationClamped(cr *cmapi.CertificateRequest, requested, clamped time.Duration) {
+	message := fmt.Sprintf("Requested duration %s is outside of the durations allowed by the issuer, signing with a duration of %s", requested, clamped)
+
+	if apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionDurationClamped) == nil {
+		r.recorder.Event(cr, corev1.EventTypeNormal, events.ReasonDurationClamped, message)
+	}
+
+	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionDurationClamped,
+		cmmeta.ConditionTrue, events.ReasonDurationClamped, message)
+}
+
+// DurationNotGranted marks a CertificateRequest as having been signed with a
+// shorter duration than requested, as the issuer did not grant the requested
+// duration, and sends a corresponding event.
+//
+// The event is only sent if the CertificateRequest is not already marked.
+func (r *Reporter) DurationNotGranted(cr *cmapi.CertificateRequest, requested, granted time.Duration) {
+	message := fmt.Sprintf("Requested duration %s was not granted by the issuer, the certificate was signed with a duration of %s", requested, granted)
+
+	if apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionDurationClamped) == nil {
+		r.recorder.Event(cr, corev1.EventTypeNormal, events.ReasonDurationClamped, message)
+	}
+
+	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionDurationClamped,
+		cmmeta.ConditionTrue, events.ReasonDurationClamped, message)
+}
+
 // Pending marks a CertificateRequest as pending and sends a corresponding event.
 //
 // The event is only sent if the CertificateRequest is not already pending.
@@ -104,8 +139,10 @@ func (r *Reporter) Pending(cr *cmapi.CertificateRequest, err error, reason, mess
 }
 
 // Ready marks a CertificateRequest as Ready and sends a corresponding event.
+// The event is annotated with an audit record of the signed certificate.
 func (r *Reporter) Ready(cr *cmapi.CertificateRequest) {
-	r.recorder.Event(cr, corev1.EventTypeNormal, "CertificateIssued", readyMessage)
+	annotations := audit.ForCertificateRequest(audit.DecisionSigned, cr).SetCertificate(cr.Status.Certificate)
+	r.recorder.AnnotatedEventf(cr, annotations.EventAnnotations(), corev1.EventTypeNormal, events.ReasonCertificateIssued, readyMessage)
 	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady,
 		cmmeta.ConditionTrue, cmapi.CertificateRequestReasonIssued, readyMessage)
 }
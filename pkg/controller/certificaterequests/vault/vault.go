@@ -18,6 +18,7 @@ package vault
 
 import (
 	"context"
+	"time"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	corelisters "k8s.io/client-go/listers/core/v1"
@@ -27,14 +28,21 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	vaultinternal "github.com/jetstack/cert-manager/pkg/internal/vault"
 	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 const (
 	// CRControllerName is the name of Vault certificate requests controller.
 	CRControllerName = "certificaterequests-issuer-vault"
+
+	// grantedDurationTolerance is how much shorter than requested the
+	// duration of a signed certificate may be without being reported, to
+	// allow for the time taken to sign it.
+	grantedDurationTolerance = time.Minute
 )
 
 // Vault is a Vault-specific implementation of
@@ -78,7 +86,7 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 	if k8sErrors.IsNotFound(err) {
 		message := "Required secret resource not found"
 
-		v.reporter.Pending(cr, err, "SecretMissing", message)
+		v.reporter.Pending(cr, err, events.ReasonSecretMissing, message)
 		log.Error(err, message)
 		return nil, nil
 	}
@@ -86,22 +94,28 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 	// TODO: distinguish between network errors and other which might warrant a failure.
 	if err != nil {
 		message := "Failed to initialise vault client for signing"
-		v.reporter.Pending(cr, err, "VaultInitError", message)
+		v.reporter.Pending(cr, err, events.ReasonVaultInitError, message)
 		log.Error(err, message)
 		return nil, nil
 	}
 
 	certDuration := apiutil.DefaultCertDuration(cr.Spec.Duration)
-	certPem, caPem, err := client.Sign(cr.Spec.Request, certDuration)
+	certPem, caPem, err := client.Sign(cr.Spec.Request, certDuration, cr.Spec.IsCA)
 	if err != nil {
 		message := "Vault failed to sign certificate"
 
-		v.reporter.Failed(cr, err, "SigningError", message)
+		v.reporter.Failed(cr, err, events.ReasonSigningError, message)
 		log.Error(err, message)
 
 		return nil, nil
 	}
 
+	// Vault signs certificates with a shorter duration than requested if
+	// the duration exceeds the maximum TTL of the role or of the PKI backend
+	if granted, ok := grantedDuration(certPem); ok && certDuration-granted > grantedDurationTolerance {
+		v.reporter.DurationNotGranted(cr, certDuration, granted)
+	}
+
 	log.V(logf.DebugLevel).Info("certificate issued")
 
 	return &issuer.IssueResponse{
@@ -109,3 +123,13 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 		CA:          caPem,
 	}, nil
 }
+
+// grantedDuration returns the duration of the given signed certificate, and
+// false if it cannot be decoded.
+func grantedDuration(certPEM []byte) (time.Duration, bool) {
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return 0, false
+	}
+	return cert.NotAfter.Sub(cert.NotBefore), true
+}
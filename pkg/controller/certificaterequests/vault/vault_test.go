@@ -148,6 +148,16 @@ func TestSign(t *testing.T) {
 		t.FailNow()
 	}
 
+	// Vault signs certificates with the maximum TTL of the role if a longer
+	// duration is requested
+	shortPEMCert, err := generateSelfSignedCertFromCR(gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour * 24}),
+	), rsaSK, time.Hour*24)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
 	tokenSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: gen.DefaultTestNamespace,
@@ -497,6 +507,54 @@ func TestSign(t *testing.T) {
 			},
 			fakeVault: fakevault.New().WithSign(rsaPEMCert, rsaPEMCert, nil),
 		},
+		"a certificate signed with a shorter duration than requested should be reported": {
+			certificateRequest: baseCR,
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), gen.IssuerFrom(baseIssuer,
+					gen.SetIssuerVault(cmapi.VaultIssuer{
+						Auth: cmapi.VaultAuth{
+							TokenSecretRef: &cmmeta.SecretKeySelector{
+								Key: "my-token-key",
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "token-secret",
+								},
+							},
+						},
+					}),
+				)},
+				ExpectedEvents: []string{
+					"Normal DurationClamped Requested duration 1440h0m0s was not granted by the issuer, the certificate was signed with a duration of 24h0m0s",
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCertificate(shortPEMCert),
+							gen.SetCertificateRequestCA(rsaPEMCert),
+							gen.AddCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionDurationClamped,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "DurationClamped",
+								Message:            "Requested duration 1440h0m0s was not granted by the issuer, the certificate was signed with a duration of 24h0m0s",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			fakeVault: fakevault.New().WithSign(shortPEMCert, rsaPEMCert, nil),
+		},
 	}
 
 	for name, test := range tests {
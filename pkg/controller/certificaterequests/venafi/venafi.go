@@ -19,6 +19,7 @@ package venafi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
@@ -33,6 +34,7 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	issuerpkg "github.com/jetstack/cert-manager/pkg/issuer"
 	venaficlient "github.com/jetstack/cert-manager/pkg/issuer/venafi/client"
 	"github.com/jetstack/cert-manager/pkg/issuer/venafi/client/api"
@@ -80,7 +82,7 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 	if k8sErrors.IsNotFound(err) {
 		message := "Required secret resource not found"
 
-		v.reporter.Pending(cr, err, "SecretMissing", message)
+		v.reporter.Pending(cr, err, events.ReasonSecretMissing, message)
 		log.Error(err, message)
 
 		return nil, nil
@@ -89,7 +91,7 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 	if err != nil {
 		message := "Failed to initialise venafi client for signing"
 
-		v.reporter.Pending(cr, err, "VenafiInitError", message)
+		v.reporter.Pending(cr, err, events.ReasonVenafiInitError, message)
 		log.Error(err, message)
 
 		return nil, err
@@ -101,13 +103,33 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 		if err != nil {
 			message := fmt.Sprintf("Failed to parse %q annotation", cmapi.VenafiCustomFieldsAnnotationKey)
 
-			v.reporter.Failed(cr, err, "CustomFieldsError", message)
+			v.reporter.Failed(cr, err, events.ReasonCustomFieldsError, message)
 			log.Error(err, message)
 
 			return nil, nil
 		}
 	}
 
+	// The Venafi as a Service application and issuing template may be
+	// selected per request, overriding the zone configured on the issuer.
+	// Both annotations are required to be set together by the webhook.
+	application := cr.GetAnnotations()[cmapi.VenafiApplicationAnnotationKey]
+	template := cr.GetAnnotations()[cmapi.VenafiIssuingTemplateAnnotationKey]
+	if application != "" || template != "" {
+		if issuerObj.GetSpec().Venafi.Cloud == nil {
+			err := errors.New("issuer is not a Venafi Cloud issuer")
+			message := fmt.Sprintf("Failed to select the application and issuing template with the %q and %q annotations",
+				cmapi.VenafiApplicationAnnotationKey, cmapi.VenafiIssuingTemplateAnnotationKey)
+
+			v.reporter.Failed(cr, err, events.ReasonBadConfig, message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+
+		client.SetZone(application + "\\" + template)
+	}
+
 	duration := apiutil.DefaultCertDuration(cr.Spec.Duration)
 	pickupID := cr.ObjectMeta.Annotations[cmapi.VenafiPickupIDAnnotationKey]
 
@@ -119,7 +141,7 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 			switch err.(type) {
 
 			case venaficlient.ErrCustomFieldsType:
-				v.reporter.Failed(cr, err, "CustomFieldsError", err.Error())
+				v.reporter.Failed(cr, err, events.ReasonCustomFieldsError, err.Error())
 				log.Error(err, err.Error())
 
 				return nil, nil
@@ -127,14 +149,14 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 			default:
 				message := "Failed to request venafi certificate"
 
-				v.reporter.Failed(cr, err, "RequestError", message)
+				v.reporter.Failed(cr, err, events.ReasonRequestError, message)
 				log.Error(err, message)
 
 				return nil, err
 			}
 		}
 
-		v.reporter.Pending(cr, err, "IssuancePending", "Venafi certificate is requested")
+		v.reporter.Pending(cr, err, events.ReasonIssuancePending, "Venafi certificate is requested")
 
 		metav1.SetMetaDataAnnotation(&cr.ObjectMeta, cmapi.VenafiPickupIDAnnotationKey, pickupID)
 
@@ -147,14 +169,14 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 		case endpoint.ErrCertificatePending, endpoint.ErrRetrieveCertificateTimeout:
 			message := "Venafi certificate still in a pending state, the request will be retried"
 
-			v.reporter.Pending(cr, err, "IssuancePending", message)
+			v.reporter.Pending(cr, err, events.ReasonIssuancePending, message)
 			log.Error(err, message)
 			return nil, err
 
 		default:
 			message := "Failed to obtain venafi certificate"
 
-			v.reporter.Failed(cr, err, "RetrieveError", message)
+			v.reporter.Failed(cr, err, events.ReasonRetrieveError, message)
 			log.Error(err, message)
 
 			return nil, err
@@ -166,7 +188,7 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 	bundle, err := utilpki.ParseSingleCertificateChainPEM(certPem)
 	if err != nil {
 		message := "Failed to parse returned certificate bundle"
-		v.reporter.Failed(cr, err, "ParseError", message)
+		v.reporter.Failed(cr, err, events.ReasonParseError, message)
 		log.Error(err, message)
 		return nil, err
 	}
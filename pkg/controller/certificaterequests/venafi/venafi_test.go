@@ -24,6 +24,7 @@ import (
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
@@ -208,6 +209,13 @@ func TestSign(t *testing.T) {
 		}),
 	)
 
+	zoneAnnotations := map[string]string{
+		cmapi.VenafiApplicationAnnotationKey:     "test-application",
+		cmapi.VenafiIssuingTemplateAnnotationKey: "test-template",
+	}
+	cloudCRWithZone := gen.CertificateRequestFrom(cloudCR, gen.SetCertificateRequestAnnotations(zoneAnnotations))
+	tppCRWithZone := gen.CertificateRequestFrom(tppCR, gen.SetCertificateRequestAnnotations(zoneAnnotations))
+
 	failGetSecretLister := &testlisters.FakeSecretLister{
 		SecretsFn: func(namespace string) corelisters.SecretNamespaceLister {
 			return &testlisters.FakeSecretNamespaceLister{
@@ -271,6 +279,22 @@ func TestSign(t *testing.T) {
 		},
 	}
 
+	var zone string
+	clientReturnsCertIfZoneSet := &internalvenafifake.Venafi{
+		SetZoneFn: func(z string) {
+			zone = z
+		},
+		RequestCertificateFn: func(csrPEM []byte, duration time.Duration, customFields []api.CustomField) (string, error) {
+			if zone != `test-application\test-template` {
+				return "", fmt.Errorf("unexpected zone %q", zone)
+			}
+			return "test", nil
+		},
+		RetrieveCertificateFn: func(string, []byte, time.Duration, []api.CustomField) ([]byte, error) {
+			return append(certPEM, rootPEM...), nil
+		},
+	}
+
 	tests := map[string]testT{
 		"a CertificateRequest without an approved condition should do nothing": {
 			certificateRequest: baseCRNotApproved.DeepCopy(),
@@ -791,6 +815,81 @@ func TestSign(t *testing.T) {
 			fakeClient:       clientReturnsInvalidCustomFieldType,
 			expectedErr:      false,
 		},
+		"annotations: Application and issuing template select the Venafi Cloud zone": {
+			certificateRequest: cloudCRWithZone.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{cloudCRWithZone.DeepCopy(), cloudIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending Venafi certificate is requested",
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(cloudCRWithZone,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Venafi certificate is requested",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+						),
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(cloudCRWithZone,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+						),
+					)),
+				},
+			},
+			fakeSecretLister: failGetSecretLister,
+			fakeClient:       clientReturnsCertIfZoneSet,
+		},
+		"annotations: Error on application and issuing template with a TPP issuer": {
+			certificateRequest: tppCRWithZone.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{tppCRWithZone.DeepCopy(), tppIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning BadConfig Failed to select the application and issuing template with the "venafi.cert-manager.io/application" and "venafi.cert-manager.io/issuing-template" annotations: issuer is not a Venafi Cloud issuer`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithZone,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            `Failed to select the application and issuing template with the "venafi.cert-manager.io/application" and "venafi.cert-manager.io/issuing-template" annotations: issuer is not a Venafi Cloud issuer`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeSecretLister:   failGetSecretLister,
+			fakeClient:         clientReturnsCert,
+			skipSecondSignCall: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,506 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adoption
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmacmelisters "github.com/jetstack/cert-manager/pkg/client/listers/acme/v1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/trigger/policies"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/pkg/util/predicate"
+)
+
+const (
+	ControllerName = "certificates-adoption"
+)
+
+var (
+	certificateGvk        = cmapi.SchemeGroupVersion.WithKind(cmapi.CertificateKind)
+	certificateRequestGvk = cmapi.SchemeGroupVersion.WithKind(cmapi.CertificateRequestKind)
+)
+
+// This controller re-adopts CertificateRequests, Orders and Secrets which
+// belong to a Certificate but are not controlled by it, for example because
+// they have been restored from a backup without their owner references or
+// with owner references to the UID of the Certificate before it was
+// restored. Without this, the Certificate would not see its existing
+// requests and would create new ones, re-issuing every restored Certificate.
+//
+// A CertificateRequest is adopted if it is annotated with the name of the
+// Certificate and its CSR matches the Certificate's spec. An Order is
+// adopted by the CertificateRequest controlled by the Certificate with the
+// same CSR. The Certificate's Secret is adopted only if Secret owner
+// references are enabled.
+//
+// If adopting existing Secrets is enabled, a Certificate which has not been
+// issued yet also adopts an existing Secret which was not issued by
+// cert-manager, provided that the certificate it contains is valid for the
+// Certificate's spec and is not due for renewal. The Secret is annotated as
+// if it had been issued for the Certificate and the Certificate's revision
+// is set, so that it is not re-issued until it is due for renewal.
+type controller struct {
+	certificateLister        cmlisters.CertificateLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+	orderLister              cmacmelisters.OrderLister
+	secretLister             corelisters.SecretLister
+	client                   cmclient.Interface
+	kubeClient               kubernetes.Interface
+	recorder                 record.EventRecorder
+
+	enableSecretOwnerReferences bool
+	adoptExistingSecrets        bool
+	// shouldReissue decides whether an existing Secret must be re-issued
+	// rather than adopted.
+	shouldReissue policies.Func
+}
+
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	enableSecretOwnerReferences bool,
+	adoptExistingSecrets bool,
+	shouldReissue policies.Func,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
+	orderInformer := cmFactory.Acme().V1().Orders()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	c := &controller{
+		certificateLister:           certificateInformer.Lister(),
+		certificateRequestLister:    certificateRequestInformer.Lister(),
+		orderLister:                 orderInformer.Lister(),
+		secretLister:                secretsInformer.Lister(),
+		client:                      client,
+		kubeClient:                  kubeClient,
+		recorder:                    recorder,
+		enableSecretOwnerReferences: enableSecretOwnerReferences,
+		adoptExistingSecrets:        adoptExistingSecrets,
+		shouldReissue:               shouldReissue,
+	}
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a CertificateRequest, Order or Secret is orphaned, enqueue the
+	// Certificate named in its annotations.
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: c.enqueueCertificateOfOrphan(log, queue, func(o metav1.Object, crt *cmapi.Certificate) bool {
+			return isOrphanedBy(o, crt)
+		}),
+	})
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: c.enqueueCertificateOfOrphan(log, queue, func(o metav1.Object, crt *cmapi.Certificate) bool {
+			return isOrphanedBy(o, crt)
+		}),
+	})
+	orderInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: c.enqueueCertificateOfOrphan(log, queue, func(o metav1.Object, crt *cmapi.Certificate) bool {
+			return c.isOrphanedOrder(o.(*cmacme.Order), crt)
+		}),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		orderInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return c, queue, mustSync
+}
+
+// enqueueCertificateOfOrphan returns a function which enqueues the
+// Certificate named in the cert-manager.io/certificate-name annotation of a
+// resource, if that resource is orphaned.
+func (c *controller) enqueueCertificateOfOrphan(log logr.Logger, queue workqueue.Interface, isOrphaned func(metav1.Object, *cmapi.Certificate) bool) func(obj interface{}) {
+	return func(obj interface{}) {
+		o, ok := obj.(metav1.Object)
+		if !ok {
+			log.V(logf.ErrorLevel).Info("Non-Object type resource passed to enqueueCertificateOfOrphan")
+			return
+		}
+		name := o.GetAnnotations()[cmapi.CertificateNameKey]
+		if name == "" {
+			return
+		}
+		crt, err := c.certificateLister.Certificates(o.GetNamespace()).Get(name)
+		if err != nil {
+			return
+		}
+		if !isOrphaned(o, crt) {
+			return
+		}
+		key, err := controllerpkg.KeyFunc(crt)
+		if err != nil {
+			log.Error(err, "Error determining 'key' for resource")
+			return
+		}
+		queue.Add(key)
+	}
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	adopted, err := c.adoptCertificateRequests(ctx, crt)
+	if err != nil {
+		return err
+	}
+	if err := c.adoptOrders(ctx, crt, adopted); err != nil {
+		return err
+	}
+	if c.adoptExistingSecrets {
+		if err := c.adoptExistingSecret(ctx, crt); err != nil {
+			return err
+		}
+	}
+	if c.enableSecretOwnerReferences {
+		if err := c.adoptSecret(ctx, crt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// adoptCertificateRequests adopts the orphaned CertificateRequests which
+// match the Certificate's spec, returning the updated CertificateRequests.
+func (c *controller) adoptCertificateRequests(ctx context.Context, crt *cmapi.Certificate) ([]*cmapi.CertificateRequest, error) {
+	log := logf.FromContext(ctx)
+
+	reqs, err := certificates.ListCertificateRequestsMatchingPredicates(c.certificateRequestLister.CertificateRequests(crt.Namespace), labels.Everything(),
+		func(obj runtime.Object) bool { return isOrphanedBy(obj.(metav1.Object), crt) })
+	if err != nil {
+		return nil, err
+	}
+
+	var adopted []*cmapi.CertificateRequest
+	for _, req := range reqs {
+		log := logf.WithRelatedResource(log, req)
+
+		mismatches, err := certificates.RequestMatchesSpec(req, crt.Spec)
+		if err != nil {
+			log.V(logf.DebugLevel).Info("orphaned CertificateRequest cannot be decoded, not adopting it", "error", err.Error())
+			continue
+		}
+		if len(mismatches) > 0 {
+			log.V(logf.DebugLevel).Info("orphaned CertificateRequest does not match the Certificate, not adopting it", "mismatches", mismatches)
+			continue
+		}
+
+		req = req.DeepCopy()
+		req.OwnerReferences = adoptedOwnerReferences(req.OwnerReferences, crt, certificateGvk)
+		req, err = c.client.CertmanagerV1().CertificateRequests(req.Namespace).Update(ctx, req, metav1.UpdateOptions{})
+		if err != nil {
+			return adopted, err
+		}
+		adopted = append(adopted, req)
+
+		log.V(logf.InfoLevel).Info("adopted orphaned CertificateRequest")
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonAdopted, "Adopted orphaned CertificateRequest %q", req.Name)
+	}
+
+	return adopted, nil
+}
+
+// adoptOrders adopts the orphaned Orders whose request matches that of a
+// CertificateRequest controlled by the Certificate. CertificateRequests which
+// have just been adopted are passed in, since the lister may not have
+// observed the update yet.
+func (c *controller) adoptOrders(ctx context.Context, crt *cmapi.Certificate, adopted []*cmapi.CertificateRequest) error {
+	log := logf.FromContext(ctx)
+
+	orders, err := c.orderLister.Orders(crt.Namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var reqs []*cmapi.CertificateRequest
+	for _, order := range orders {
+		if !c.isOrphanedOrder(order, crt) {
+			continue
+		}
+		log := logf.WithRelatedResource(log, order)
+
+		if reqs == nil {
+			reqs, err = certificates.ListCertificateRequestsMatchingPredicates(c.certificateRequestLister.CertificateRequests(crt.Namespace), labels.Everything(),
+				predicate.ResourceOwnedBy(crt))
+			if err != nil {
+				return err
+			}
+			reqs = append(reqs, adopted...)
+		}
+
+		var owner *cmapi.CertificateRequest
+		for _, req := range reqs {
+			if bytes.Equal(req.Spec.Request, order.Spec.Request) {
+				owner = req
+				break
+			}
+		}
+		if owner == nil {
+			log.V(logf.DebugLevel).Info("no CertificateRequest controlled by the Certificate matches the orphaned Order, not adopting it")
+			continue
+		}
+
+		order = order.DeepCopy()
+		order.OwnerReferences = adoptedOwnerReferences(order.OwnerReferences, owner, certificateRequestGvk)
+		if _, err := c.client.AcmeV1().Orders(order.Namespace).Update(ctx, order, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+
+		log.V(logf.InfoLevel).Info("adopted orphaned Order", "certificaterequest", owner.Name)
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonAdopted, "Adopted orphaned Order %q for CertificateRequest %q", order.Name, owner.Name)
+	}
+
+	return nil
+}
+
+func (c *controller) adoptSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	// Secrets in another namespace cannot be owned by the Certificate.
+	if apiutil.CertificateSecretNamespace(crt) != crt.Namespace {
+		return nil
+	}
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !isOrphanedBy(secret, crt) {
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	secret.OwnerReferences = adoptedOwnerReferences(secret.OwnerReferences, crt, certificateGvk)
+	if _, err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	logf.WithRelatedResource(logf.FromContext(ctx), secret).V(logf.InfoLevel).Info("adopted orphaned Secret")
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonAdopted, "Adopted orphaned Secret %q", secret.Name)
+
+	return nil
+}
+
+// adoptExistingSecret adopts the Certificate's Secret if it was not issued
+// by cert-manager, the Certificate has not been issued yet and the Secret
+// does not need to be re-issued. The readiness controller then observes the
+// adopted Secret and marks the Certificate as Ready.
+func (c *controller) adoptExistingSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	secret, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !policies.SecretAdoptable(crt, secret) || apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
+		Type:   cmapi.CertificateConditionIssuing,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		return nil
+	}
+	log = logf.WithRelatedResource(log, secret)
+
+	if reason, message, reissue := c.shouldReissue(policies.Input{Certificate: crt, Secret: secret}); reissue {
+		log.V(logf.DebugLevel).Info("existing Secret must be re-issued, not adopting it", "reason", reason, "message", message)
+		return nil
+	}
+	x509Cert, err := pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		// The trigger policies decode the certificate, so this should
+		// never happen.
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[cmapi.CertificateNameKey] = crt.Name
+	secret.Annotations[cmapi.IssuerNameAnnotationKey] = crt.Spec.IssuerRef.Name
+	secret.Annotations[cmapi.IssuerKindAnnotationKey] = apiutil.IssuerKind(crt.Spec.IssuerRef)
+	secret.Annotations[cmapi.IssuerGroupAnnotationKey] = crt.Spec.IssuerRef.Group
+	if c.enableSecretOwnerReferences && secret.Namespace == crt.Namespace {
+		secret.OwnerReferences = adoptedOwnerReferences(secret.OwnerReferences, crt, certificateGvk)
+	}
+	if _, err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	crt = crt.DeepCopy()
+	revision := 1
+	crt.Status.Revision = &revision
+	crt.Status.NotBefore = &metav1.Time{Time: x509Cert.NotBefore}
+	crt.Status.NotAfter = &metav1.Time{Time: x509Cert.NotAfter}
+	if _, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	log.V(logf.InfoLevel).Info("adopted existing Secret")
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonAdopted, "Adopted existing Secret %q", secret.Name)
+
+	return nil
+}
+
+// isOrphanedBy returns true if the resource is annotated with the name of
+// the given Certificate but is not controlled by it. This is the case if the
+// resource has no controller, or if it is controlled by a Certificate with
+// the same name but a different UID, as happens when the Certificate is
+// deleted and restored from a backup.
+func isOrphanedBy(obj metav1.Object, crt *cmapi.Certificate) bool {
+	if obj.GetAnnotations()[cmapi.CertificateNameKey] != crt.Name {
+		return false
+	}
+	ref := metav1.GetControllerOf(obj)
+	if ref == nil {
+		return true
+	}
+	return ref.Kind == cmapi.CertificateKind && ref.APIVersion == cmapi.SchemeGroupVersion.String() &&
+		ref.Name == crt.Name && ref.UID != crt.UID
+}
+
+// isOrphanedOrder returns true if the Order is annotated with the name of the
+// given Certificate and either has no controller, or is controlled by a
+// CertificateRequest which no longer exists with the same UID.
+func (c *controller) isOrphanedOrder(order *cmacme.Order, crt *cmapi.Certificate) bool {
+	if order.Annotations[cmapi.CertificateNameKey] != crt.Name {
+		return false
+	}
+	ref := metav1.GetControllerOf(order)
+	if ref == nil {
+		return true
+	}
+	if ref.Kind != cmapi.CertificateRequestKind || ref.APIVersion != cmapi.SchemeGroupVersion.String() {
+		return false
+	}
+	req, err := c.certificateRequestLister.CertificateRequests(order.Namespace).Get(ref.Name)
+	if apierrors.IsNotFound(err) {
+		return true
+	}
+	return err == nil && req.UID != ref.UID
+}
+
+// adoptedOwnerReferences returns the owner references of a resource adopted
+// by the given owner, with any existing controller reference replaced.
+func adoptedOwnerReferences(refs []metav1.OwnerReference, owner metav1.Object, ownerGvk schema.GroupVersionKind) []metav1.OwnerReference {
+	var adopted []metav1.OwnerReference
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller {
+			continue
+		}
+		adopted = append(adopted, ref)
+	}
+	return append(adopted, *metav1.NewControllerRef(owner, ownerGvk))
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	renewalJitter := certificates.RenewalJitter{
+		Percentage: ctx.CertificateOptions.RenewalJitterPercentage,
+		Window:     ctx.CertificateOptions.RenewalJitterWindow,
+	}
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.CertificateOptions.EnableOwnerRef,
+		ctx.CertificateOptions.AdoptExistingSecrets,
+		policies.NewTriggerPolicyChain(ctx.Clock, renewalJitter, true).Evaluate,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
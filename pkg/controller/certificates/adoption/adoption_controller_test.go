@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adoption
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/test"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	fixedClock := fakeclock.NewFakeClock(time.Now())
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateUID("crt-uid"),
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer"}),
+		gen.SetCertificateSecretName("test-secret"),
+	)
+	bundle := test.MustCreateCryptoBundle(t, crt, fixedClock)
+	otherBundle := test.MustCreateCryptoBundle(t, gen.CertificateFrom(crt,
+		gen.SetCertificateDNSNames("example.com", "other.example.com"),
+	), fixedClock)
+
+	withOwners := func(req *cmapi.CertificateRequest, refs ...metav1.OwnerReference) *cmapi.CertificateRequest {
+		req = req.DeepCopy()
+		req.OwnerReferences = refs
+		return req
+	}
+	crtRef := *metav1.NewControllerRef(crt, certificateGvk)
+	staleCrtRef := *metav1.NewControllerRef(gen.CertificateFrom(crt, gen.SetCertificateUID("old-uid")), certificateGvk)
+	otherRef := *metav1.NewControllerRef(gen.Certificate("other", gen.SetCertificateUID("other-uid")), certificateGvk)
+
+	ownedReq := withOwners(bundle.CertificateRequest, crtRef)
+	ownedReq.UID = "req-uid"
+	orphanedReq := withOwners(bundle.CertificateRequest)
+
+	order := gen.Order("test-order",
+		gen.SetOrderNamespace(gen.DefaultTestNamespace),
+		gen.SetOrderCsr(bundle.CSRBytes),
+		gen.SetOrderAnnotations(map[string]string{cmapi.CertificateNameKey: crt.Name}),
+	)
+	adoptedOrder := gen.OrderFrom(order,
+		gen.SetOrderOwnerReference(*metav1.NewControllerRef(ownedReq, certificateRequestGvk)),
+	)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   gen.DefaultTestNamespace,
+			Name:        "test-secret",
+			Annotations: map[string]string{cmapi.CertificateNameKey: crt.Name},
+		},
+	}
+	adoptedSecret := secret.DeepCopy()
+	adoptedSecret.OwnerReferences = []metav1.OwnerReference{crtRef}
+
+	existingSecret := func(certBytes, pkBytes []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "test-secret"},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       certBytes,
+				corev1.TLSPrivateKeyKey: pkBytes,
+			},
+		}
+	}
+	adoptedExistingSecret := existingSecret(bundle.CertBytes, bundle.PrivateKeyBytes)
+	adoptedExistingSecret.Annotations = map[string]string{
+		cmapi.CertificateNameKey:       crt.Name,
+		cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+		cmapi.IssuerKindAnnotationKey:  "Issuer",
+		cmapi.IssuerGroupAnnotationKey: "",
+	}
+	adoptedCrt := gen.CertificateFrom(crt,
+		gen.SetCertificateRevision(1),
+		gen.SetCertificateNotBefore(metav1.NewTime(bundle.Cert.NotBefore)),
+		gen.SetCertificateNotAfter(metav1.NewTime(bundle.Cert.NotAfter)),
+	)
+
+	tests := map[string]struct {
+		existingCMObjects   []runtime.Object
+		existingKubeObjects []runtime.Object
+		enableOwnerRef      bool
+		adoptExisting       bool
+
+		expectedCMActions   []runtime.Object
+		expectedKubeActions []runtime.Object
+		expectedEvents      []string
+	}{
+		"do nothing if the CertificateRequest is controlled by the Certificate": {
+			existingCMObjects: []runtime.Object{crt, ownedReq},
+		},
+		"adopt an orphaned CertificateRequest which matches the Certificate": {
+			existingCMObjects: []runtime.Object{crt, orphanedReq},
+			expectedCMActions: []runtime.Object{withOwners(orphanedReq, crtRef)},
+			expectedEvents:    []string{`Normal Adopted Adopted orphaned CertificateRequest "` + orphanedReq.Name + `"`},
+		},
+		"adopt a CertificateRequest controlled by a previous Certificate with the same name": {
+			existingCMObjects: []runtime.Object{crt, withOwners(orphanedReq, staleCrtRef)},
+			expectedCMActions: []runtime.Object{withOwners(orphanedReq, crtRef)},
+			expectedEvents:    []string{`Normal Adopted Adopted orphaned CertificateRequest "` + orphanedReq.Name + `"`},
+		},
+		"do not adopt an orphaned CertificateRequest which does not match the Certificate": {
+			existingCMObjects: []runtime.Object{crt, withOwners(otherBundle.CertificateRequest)},
+		},
+		"do not adopt a CertificateRequest controlled by another Certificate": {
+			existingCMObjects: []runtime.Object{crt, withOwners(orphanedReq, otherRef)},
+		},
+		"adopt an orphaned Order for the CertificateRequest with the same CSR": {
+			existingCMObjects: []runtime.Object{crt, ownedReq, order},
+			expectedCMActions: []runtime.Object{adoptedOrder},
+			expectedEvents:    []string{`Normal Adopted Adopted orphaned Order "test-order" for CertificateRequest "` + ownedReq.Name + `"`},
+		},
+		"do not adopt an Order which does not match any CertificateRequest": {
+			existingCMObjects: []runtime.Object{crt, ownedReq, gen.OrderFrom(order, gen.SetOrderCsr(otherBundle.CSRBytes))},
+		},
+		"do not adopt an orphaned Secret if Secret owner references are disabled": {
+			existingCMObjects:   []runtime.Object{crt},
+			existingKubeObjects: []runtime.Object{secret},
+		},
+		"adopt an orphaned Secret if Secret owner references are enabled": {
+			existingCMObjects:   []runtime.Object{crt},
+			existingKubeObjects: []runtime.Object{secret},
+			enableOwnerRef:      true,
+			expectedKubeActions: []runtime.Object{adoptedSecret},
+			expectedEvents:      []string{`Normal Adopted Adopted orphaned Secret "test-secret"`},
+		},
+		"adopt an existing Secret which is valid for the Certificate": {
+			existingCMObjects:   []runtime.Object{crt},
+			existingKubeObjects: []runtime.Object{existingSecret(bundle.CertBytes, bundle.PrivateKeyBytes)},
+			adoptExisting:       true,
+			expectedKubeActions: []runtime.Object{adoptedExistingSecret},
+			expectedCMActions:   []runtime.Object{adoptedCrt},
+			expectedEvents:      []string{`Normal Adopted Adopted existing Secret "test-secret"`},
+		},
+		"do not adopt an existing Secret if adopting existing Secrets is disabled": {
+			existingCMObjects:   []runtime.Object{crt},
+			existingKubeObjects: []runtime.Object{existingSecret(bundle.CertBytes, bundle.PrivateKeyBytes)},
+		},
+		"do not adopt an existing Secret which is not valid for the Certificate": {
+			existingCMObjects:   []runtime.Object{crt},
+			existingKubeObjects: []runtime.Object{existingSecret(otherBundle.CertBytes, otherBundle.PrivateKeyBytes)},
+			adoptExisting:       true,
+		},
+		"do not adopt an existing Secret if the Certificate has already been issued": {
+			existingCMObjects:   []runtime.Object{gen.CertificateFrom(crt, gen.SetCertificateRevision(1))},
+			existingKubeObjects: []runtime.Object{existingSecret(bundle.CertBytes, bundle.PrivateKeyBytes)},
+			adoptExisting:       true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				CertManagerObjects: test.existingCMObjects,
+				KubeObjects:        test.existingKubeObjects,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			for _, obj := range test.expectedCMActions {
+				switch obj := obj.(type) {
+				case *cmapi.CertificateRequest:
+					builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"), obj.Namespace, obj)))
+				case *cmacme.Order:
+					builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateAction(
+						cmacme.SchemeGroupVersion.WithResource("orders"), obj.Namespace, obj)))
+				}
+			}
+			for _, obj := range test.expectedKubeActions {
+				secret := obj.(*corev1.Secret)
+				builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"), secret.Namespace, secret)))
+			}
+			for _, obj := range test.expectedCMActions {
+				if crt, ok := obj.(*cmapi.Certificate); ok {
+					builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"), "status", crt.Namespace, crt)))
+				}
+			}
+			builder.Init()
+			builder.Context.CertificateOptions.EnableOwnerRef = test.enableOwnerRef
+			builder.Context.CertificateOptions.AdoptExistingSecrets = test.adoptExisting
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(crt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := w.ProcessItem(context.Background(), key); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
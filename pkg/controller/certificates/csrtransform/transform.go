@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package csrtransform allows the certificate signing requests created for
+// Certificates to be adjusted before they are signed. Transformers are
+// registered in-process, so that organisations with additional requirements
+// on their requests can build cert-manager with their own transformers
+// without forking the certificate controllers.
+package csrtransform
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Request is a certificate signing request which is about to be created for
+// a Certificate.
+type Request struct {
+	// CSR is the certificate signing request, which is signed with the
+	// private key of the Certificate once all transformers have run.
+	CSR *x509.CertificateRequest
+
+	// Extensions are the additional X.509 extensions requested in the
+	// CertificateRequest. They are only added if allowed by the policy of
+	// the issuer.
+	Extensions []cmapi.X509Extension
+}
+
+// Transformer adjusts the certificate signing requests created for
+// Certificates. Transformers may change the subject of the request and add
+// extensions to it, but may not change its identity: the common name,
+// subject alternative names and key algorithms are checked to be unchanged.
+type Transformer interface {
+	Transform(ctx context.Context, crt *cmapi.Certificate, req *Request) error
+}
+
+// TransformerFunc is a function which implements Transformer.
+type TransformerFunc func(ctx context.Context, crt *cmapi.Certificate, req *Request) error
+
+// Transform calls f.
+func (f TransformerFunc) Transform(ctx context.Context, crt *cmapi.Certificate, req *Request) error {
+	return f(ctx, crt, req)
+}
+
+var (
+	transformers     = make(map[string]Transformer)
+	transformersLock sync.RWMutex
+)
+
+// Register registers a transformer which is run on every certificate signing
+// request created for a Certificate. 'name' should be unique, and is used to
+// order the transformers and to report their failures.
+func Register(name string, t Transformer) {
+	transformersLock.Lock()
+	defer transformersLock.Unlock()
+	transformers[name] = t
+}
+
+// Unregister removes the transformer registered with the given name.
+func Unregister(name string) {
+	transformersLock.Lock()
+	defer transformersLock.Unlock()
+	delete(transformers, name)
+}
+
+// Names returns the sorted names of all registered transformers.
+func Names() []string {
+	transformersLock.RLock()
+	defer transformersLock.RUnlock()
+	return sortedNames()
+}
+
+// Transform runs all registered transformers on the given request, in the
+// order of their names. An error is returned if a transformer fails, or if
+// the transformed request is no longer valid for the Certificate.
+func Transform(ctx context.Context, crt *cmapi.Certificate, req *Request) error {
+	transformersLock.RLock()
+	defer transformersLock.RUnlock()
+	if len(transformers) == 0 {
+		return nil
+	}
+
+	before := identityOf(req.CSR)
+	for _, name := range sortedNames() {
+		if err := transformers[name].Transform(ctx, crt, req); err != nil {
+			return fmt.Errorf("CSR transformer %q failed: %w", name, err)
+		}
+	}
+
+	if req.CSR == nil {
+		return fmt.Errorf("CSR transformers removed the certificate signing request")
+	}
+	if changed := before.changedFields(identityOf(req.CSR)); len(changed) > 0 {
+		return fmt.Errorf("CSR transformers may not change the %v of the request", changed)
+	}
+	for _, extension := range req.Extensions {
+		if _, err := pki.ParseExtensionID(extension.ID); err != nil {
+			return fmt.Errorf("CSR transformers added an invalid extension: %w", err)
+		}
+	}
+	return nil
+}
+
+// sortedNames returns the sorted names of all registered transformers. The
+// caller must hold transformersLock.
+func sortedNames() []string {
+	names := make([]string, 0, len(transformers))
+	for name := range transformers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// identity is the part of a certificate signing request which transformers
+// may not change.
+type identity struct {
+	commonName         string
+	dnsNames           []string
+	ipAddresses        []string
+	uris               []string
+	emailAddresses     []string
+	publicKeyAlgorithm x509.PublicKeyAlgorithm
+	signatureAlgorithm x509.SignatureAlgorithm
+}
+
+func identityOf(csr *x509.CertificateRequest) identity {
+	if csr == nil {
+		return identity{}
+	}
+	return identity{
+		commonName:         csr.Subject.CommonName,
+		dnsNames:           append([]string(nil), csr.DNSNames...),
+		ipAddresses:        pki.IPAddressesToString(csr.IPAddresses),
+		uris:               pki.URLsToString(csr.URIs),
+		emailAddresses:     append([]string(nil), csr.EmailAddresses...),
+		publicKeyAlgorithm: csr.PublicKeyAlgorithm,
+		signatureAlgorithm: csr.SignatureAlgorithm,
+	}
+}
+
+// changedFields returns the names of the fields which differ between i and
+// other.
+func (i identity) changedFields(other identity) []string {
+	var changed []string
+	if i.commonName != other.commonName {
+		changed = append(changed, "common name")
+	}
+	if !reflect.DeepEqual(i.dnsNames, other.dnsNames) {
+		changed = append(changed, "DNS names")
+	}
+	if !reflect.DeepEqual(i.ipAddresses, other.ipAddresses) {
+		changed = append(changed, "IP addresses")
+	}
+	if !reflect.DeepEqual(i.uris, other.uris) {
+		changed = append(changed, "URIs")
+	}
+	if !reflect.DeepEqual(i.emailAddresses, other.emailAddresses) {
+		changed = append(changed, "email addresses")
+	}
+	if i.publicKeyAlgorithm != other.publicKeyAlgorithm {
+		changed = append(changed, "public key algorithm")
+	}
+	if i.signatureAlgorithm != other.signatureAlgorithm {
+		changed = append(changed, "signature algorithm")
+	}
+	return changed
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csrtransform
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"reflect"
+	"testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestTransform(t *testing.T) {
+	addOU := TransformerFunc(func(_ context.Context, _ *cmapi.Certificate, req *Request) error {
+		req.CSR.Subject.OrganizationalUnit = append(req.CSR.Subject.OrganizationalUnit, "added")
+		return nil
+	})
+	addExtension := func(id string) Transformer {
+		return TransformerFunc(func(_ context.Context, _ *cmapi.Certificate, req *Request) error {
+			req.Extensions = append(req.Extensions, cmapi.X509Extension{ID: id, Value: []byte{0x05, 0x00}})
+			return nil
+		})
+	}
+	changeDNSNames := TransformerFunc(func(_ context.Context, _ *cmapi.Certificate, req *Request) error {
+		req.CSR.DNSNames[0] = "other.example.com"
+		return nil
+	})
+	fail := TransformerFunc(func(context.Context, *cmapi.Certificate, *Request) error {
+		return errors.New("boom")
+	})
+
+	tests := map[string]struct {
+		transformers       map[string]Transformer
+		expectedOUs        []string
+		expectedExtensions []cmapi.X509Extension
+		expectedErr        bool
+	}{
+		"no transformers leave the request unchanged": {},
+		"transformers may adjust the subject and add extensions": {
+			transformers: map[string]Transformer{
+				"ou":        addOU,
+				"extension": addExtension("1.2.3.4"),
+			},
+			expectedOUs:        []string{"added"},
+			expectedExtensions: []cmapi.X509Extension{{ID: "1.2.3.4", Value: []byte{0x05, 0x00}}},
+		},
+		"transformers are run in the order of their names": {
+			transformers: map[string]Transformer{
+				"b": addExtension("1.2.3.5"),
+				"a": addExtension("1.2.3.4"),
+			},
+			expectedExtensions: []cmapi.X509Extension{
+				{ID: "1.2.3.4", Value: []byte{0x05, 0x00}},
+				{ID: "1.2.3.5", Value: []byte{0x05, 0x00}},
+			},
+		},
+		"a failing transformer fails the transform": {
+			transformers: map[string]Transformer{"fail": fail},
+			expectedErr:  true,
+		},
+		"transformers may not change the identity of the request": {
+			transformers: map[string]Transformer{"dns": changeDNSNames},
+			expectedErr:  true,
+		},
+		"transformers may not add standard certificate extensions": {
+			transformers: map[string]Transformer{"extension": addExtension("2.5.29.17")},
+			expectedErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for name, transformer := range test.transformers {
+				Register(name, transformer)
+				defer Unregister(name)
+			}
+
+			req := &Request{CSR: &x509.CertificateRequest{
+				Subject:  pkix.Name{CommonName: "example.com"},
+				DNSNames: []string{"example.com"},
+			}}
+			err := Transform(context.TODO(), &cmapi.Certificate{}, req)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("unexpected error, exp=%t, got=%v", test.expectedErr, err)
+			}
+			if test.expectedErr {
+				return
+			}
+			if !reflect.DeepEqual(req.CSR.Subject.OrganizationalUnit, test.expectedOUs) {
+				t.Errorf("unexpected organizational units, exp=%v, got=%v", test.expectedOUs, req.CSR.Subject.OrganizationalUnit)
+			}
+			if !reflect.DeepEqual(req.Extensions, test.expectedExtensions) {
+				t.Errorf("unexpected extensions, exp=%v, got=%v", test.expectedExtensions, req.Extensions)
+			}
+		})
+	}
+}
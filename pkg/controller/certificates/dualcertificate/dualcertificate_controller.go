@@ -0,0 +1,607 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dualcertificate
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/pem"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/scheduler"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/pkg/util/predicate"
+)
+
+const (
+	ControllerName = "certificates-dual-certificate"
+)
+
+var certificateGvk = cmapi.SchemeGroupVersion.WithKind("Certificate")
+
+// This controller issues the second certificate of Certificates which set
+// `spec.dualCertificate`, for the same subject and names as the Certificate
+// but with a private key of the configured algorithm. Once the Certificate
+// has been issued, a private key is generated and stored in a temporary
+// Secret, and a CertificateRequest is created for it. When the request is
+// issued, the certificate and private key are stored in the Certificate's
+// Secret under the `tls-<algorithm>.crt` and `tls-<algorithm>.key` entries.
+// Each revision of the Certificate is followed by a new second certificate,
+// so that both are always renewed together.
+//
+// The CertificateRequests and private key Secrets of the second certificate
+// are owned, but not controlled, by the Certificate so that they are ignored
+// by the controllers which manage the Certificate's own requests and keys.
+type controller struct {
+	certificateLister        cmlisters.CertificateLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+	secretLister             corelisters.SecretLister
+	client                   cmclient.Interface
+	kubeClient               kubernetes.Interface
+	recorder                 record.EventRecorder
+	scheduledWorkQueue       scheduler.ScheduledWorkQueue
+	clock                    clock.Clock
+}
+
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a CertificateRequest of a second certificate changes, enqueue the
+	// Certificate resource that owns it.
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: controllerpkg.HandleOwnedResourceNamespacedFunc(log, queue, certificateGvk, certificateGetter(certificateInformer.Lister())),
+	})
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister:        certificateInformer.Lister(),
+		certificateRequestLister: certificateRequestInformer.Lister(),
+		secretLister:             secretsInformer.Lister(),
+		client:                   client,
+		kubeClient:               kubeClient,
+		recorder:                 recorder,
+		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		clock:                    clock,
+	}, queue, mustSync
+}
+
+func certificateGetter(lister cmlisters.CertificateLister) func(namespace, name string) (interface{}, error) {
+	return func(namespace, name string) (interface{}, error) {
+		return lister.Certificates(namespace).Get(name)
+	}
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("certificate not found for key")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, crt)
+	dbg = log.V(logf.DebugLevel)
+	ctx = logf.NewContext(ctx, log)
+
+	requests, err := c.listRequests(crt)
+	if err != nil {
+		return err
+	}
+
+	if crt.Spec.DualCertificate == nil {
+		if err := c.deleteRequests(ctx, crt, requests); err != nil {
+			return err
+		}
+		return c.removeDualCertificate(ctx, crt)
+	}
+
+	if crt.Status.Revision == nil || apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
+		Type:   cmapi.CertificateConditionIssuing,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		dbg.Info("certificate is being issued, waiting for it to be issued before issuing the dual certificate")
+		return nil
+	}
+	revision := strconv.Itoa(*crt.Status.Revision)
+
+	secret, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("secret not found, waiting for it to be issued")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Requests for previous revisions are superseded by the current one.
+	var req *cmapi.CertificateRequest
+	var stale []*cmapi.CertificateRequest
+	for _, r := range requests {
+		if req == nil && r.Annotations[cmapi.DualCertificateRevisionAnnotationKey] == revision {
+			req = r
+			continue
+		}
+		stale = append(stale, r)
+	}
+	if err := c.deleteRequests(ctx, crt, stale); err != nil {
+		return err
+	}
+
+	if dualCertificateUpToDate(crt, secret, revision) {
+		dbg.Info("dual certificate is up to date")
+		return nil
+	}
+
+	if req == nil {
+		return c.createRequest(ctx, crt, revision)
+	}
+	log = logf.WithRelatedResource(log, req)
+	dbg = log.V(logf.DebugLevel)
+
+	pk, err := c.requestPrivateKey(ctx, crt, req)
+	if err != nil {
+		return err
+	}
+	if pk == nil {
+		dbg.Info("private key of the dual certificate request is missing or does not match the spec, deleting the request")
+		return c.deleteRequests(ctx, crt, []*cmapi.CertificateRequest{req})
+	}
+
+	cond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady)
+	if apiutil.CertificateRequestIsDenied(req) && cond == nil {
+		cond = apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionDenied)
+	}
+	switch {
+	case cond == nil || cond.Reason == cmapi.CertificateRequestReasonPending:
+		dbg.Info("dual certificate request is pending")
+		return nil
+	case cond.Reason == cmapi.CertificateRequestReasonIssued:
+		return c.storeDualCertificate(ctx, crt, secret, req, pk, revision)
+	default:
+		return c.retryFailedRequest(ctx, crt, req, cond)
+	}
+}
+
+// createRequest generates a new private key for the dual certificate of the
+// given revision of the Certificate, stores it in a Secret, and creates a
+// CertificateRequest for it.
+func (c *controller) createRequest(ctx context.Context, crt *cmapi.Certificate, revision string) error {
+	log := logf.FromContext(ctx)
+	dualCrt := dualCertificate(crt)
+
+	pk, err := pki.GeneratePrivateKeyForCertificate(dualCrt)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDualCertificateFailed, "Failed to generate the private key of the dual certificate: %v", err)
+		return nil
+	}
+	pkData, err := pki.EncodePrivateKey(pk, dualCrt.Spec.PrivateKey.Encoding)
+	if err != nil {
+		return err
+	}
+	x509CSR, err := pki.GenerateCSR(dualCrt)
+	if err != nil {
+		log.Error(err, "Failed to generate CSR for the dual certificate - will not retry")
+		return nil
+	}
+	csrDER, err := pki.EncodeCSR(x509CSR, pk)
+	if err != nil {
+		return err
+	}
+
+	ownerRefs := []metav1.OwnerReference{ownerReference(crt)}
+	prefix := apiutil.DNSSafeShortenTo52Characters(crt.Name) + "-dual-"
+	keySecret, err := c.kubeClient.CoreV1().Secrets(crt.Namespace).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    prefix,
+			Namespace:       crt.Namespace,
+			Annotations:     map[string]string{cmapi.DualCertificateRevisionAnnotationKey: revision},
+			OwnerReferences: ownerRefs,
+		},
+		Data: map[string][]byte{corev1.TLSPrivateKeyKey: pkData},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	req, err := c.client.CertmanagerV1().CertificateRequests(crt.Namespace).Create(ctx, &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: prefix,
+			Namespace:    crt.Namespace,
+			Annotations: map[string]string{
+				cmapi.CertificateNameKey:                        crt.Name,
+				cmapi.DualCertificateRevisionAnnotationKey:      revision,
+				cmapi.CertificateRequestPrivateKeyAnnotationKey: keySecret.Name,
+			},
+			Labels:          crt.Labels,
+			OwnerReferences: ownerRefs,
+		},
+		Spec: cmapi.CertificateRequestSpec{
+			Duration:  crt.Spec.Duration,
+			IssuerRef: crt.Spec.IssuerRef,
+			Request:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}),
+			IsCA:      crt.Spec.IsCA,
+			Usages:    apiutil.CertificateSpecUsages(&crt.Spec),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDualCertificateFailed, "Failed to create CertificateRequest for the dual certificate: %v", err)
+		return err
+	}
+
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonRequested, "Created new CertificateRequest resource %q for the %s dual certificate", req.Name, dualCrt.Spec.PrivateKey.Algorithm)
+	return nil
+}
+
+// requestPrivateKey returns the private key of the given CertificateRequest,
+// or nil if it does not exist, does not match the request or does not match
+// the dual certificate spec of the Certificate.
+func (c *controller) requestPrivateKey(ctx context.Context, crt *cmapi.Certificate, req *cmapi.CertificateRequest) (crypto.Signer, error) {
+	name := req.Annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey]
+	if name == "" {
+		return nil, nil
+	}
+	// The Secret is read from the API server, as it is created just before
+	// the request and so may not be in the cache yet.
+	secret, err := c.kubeClient.CoreV1().Secrets(req.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ownedBy(secret, crt) {
+		return nil, nil
+	}
+
+	pk, err := pki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil || !privateKeyMatchesSpec(pk, crt.Spec.DualCertificate) {
+		return nil, nil
+	}
+	csr, err := pki.DecodeX509CertificateRequestBytes(req.Spec.Request)
+	if err != nil {
+		return nil, nil
+	}
+	if matches, err := pki.PublicKeyMatchesCSR(pk.Public(), csr); err != nil || !matches {
+		return nil, nil
+	}
+	return pk, nil
+}
+
+// storeDualCertificate stores the certificate issued for the given request
+// and its private key in the Secret of the Certificate, replacing any dual
+// certificate of another algorithm.
+func (c *controller) storeDualCertificate(ctx context.Context, crt *cmapi.Certificate, secret *corev1.Secret, req *cmapi.CertificateRequest, pk crypto.Signer, revision string) error {
+	cert, err := pki.DecodeX509CertificateBytes(req.Status.Certificate)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDualCertificateFailed, "Failed to decode the dual certificate issued for CertificateRequest %q: %v", req.Name, err)
+		return nil
+	}
+	if matches, err := pki.PublicKeyMatchesCertificate(pk.Public(), cert); err != nil || !matches {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDualCertificateFailed, "The dual certificate issued for CertificateRequest %q does not match its private key", req.Name)
+		return nil
+	}
+	pkData, err := pki.EncodePrivateKey(pk, dualCertificate(crt).Spec.PrivateKey.Encoding)
+	if err != nil {
+		return err
+	}
+
+	secret = secret.DeepCopy()
+	removeDualCertificateData(secret)
+	certKey, keyKey := secretKeys(crt.Spec.DualCertificate.Algorithm)
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	secret.Data[certKey] = req.Status.Certificate
+	secret.Data[keyKey] = pkData
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[cmapi.DualCertificateRevisionAnnotationKey] = revision
+	if _, err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonDualCertificateIssued, "Stored the %s dual certificate in Secret %q", crt.Spec.DualCertificate.Algorithm, secret.Name)
+
+	// The private key is no longer needed once it has been stored.
+	return c.deletePrivateKeySecret(ctx, crt, req)
+}
+
+// retryFailedRequest records the failure of the given request, and deletes
+// it once RetryAfterLastFailure has passed so that a new request is created.
+func (c *controller) retryFailedRequest(ctx context.Context, crt *cmapi.Certificate, req *cmapi.CertificateRequest, cond *cmapi.CertificateRequestCondition) error {
+	failedAt := c.clock.Now()
+	if req.Status.FailureTime != nil {
+		failedAt = req.Status.FailureTime.Time
+	} else if cond.LastTransitionTime != nil {
+		failedAt = cond.LastTransitionTime.Time
+	}
+	retryIn := failedAt.Add(certificates.RetryAfterLastFailure).Sub(c.clock.Now())
+	if retryIn > 0 {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDualCertificateFailed, "The dual certificate request %q failed and will be retried in %s: %s: %s",
+			req.Name, retryIn.Round(time.Second), cond.Reason, cond.Message)
+		key, err := controllerpkg.KeyFunc(crt)
+		if err != nil {
+			return err
+		}
+		c.scheduledWorkQueue.Add(key, retryIn)
+		return nil
+	}
+
+	logf.FromContext(ctx).V(logf.InfoLevel).Info("retrying failed dual certificate request")
+	return c.deleteRequests(ctx, crt, []*cmapi.CertificateRequest{req})
+}
+
+// removeDualCertificate removes any dual certificate from the Secret of a
+// Certificate which no longer sets `spec.dualCertificate`.
+func (c *controller) removeDualCertificate(ctx context.Context, crt *cmapi.Certificate) error {
+	secret, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, ok := secret.Annotations[cmapi.DualCertificateRevisionAnnotationKey]; !ok {
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	removeDualCertificateData(secret)
+	delete(secret.Annotations, cmapi.DualCertificateRevisionAnnotationKey)
+	if _, err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonDeleted, "Removed the dual certificate from Secret %q", secret.Name)
+	return nil
+}
+
+// listRequests returns the CertificateRequests of the dual certificates of
+// the given Certificate.
+func (c *controller) listRequests(crt *cmapi.Certificate) ([]*cmapi.CertificateRequest, error) {
+	return certificates.ListCertificateRequestsMatchingPredicates(c.certificateRequestLister.CertificateRequests(crt.Namespace), labels.Everything(),
+		func(obj runtime.Object) bool {
+			req := obj.(*cmapi.CertificateRequest)
+			_, ok := req.Annotations[cmapi.DualCertificateRevisionAnnotationKey]
+			return ok && ownedBy(req, crt)
+		})
+}
+
+// deleteRequests deletes the given dual certificate CertificateRequests and
+// their private key Secrets.
+func (c *controller) deleteRequests(ctx context.Context, crt *cmapi.Certificate, reqs []*cmapi.CertificateRequest) error {
+	for _, req := range reqs {
+		if err := c.deletePrivateKeySecret(ctx, crt, req); err != nil {
+			return err
+		}
+		err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		logf.WithRelatedResource(logf.FromContext(ctx), req).V(logf.DebugLevel).Info("deleted dual certificate request")
+	}
+	return nil
+}
+
+func (c *controller) deletePrivateKeySecret(ctx context.Context, crt *cmapi.Certificate, req *cmapi.CertificateRequest) error {
+	name := req.Annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey]
+	if name == "" {
+		return nil
+	}
+	secret, err := c.secretLister.Secrets(req.Namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// Only delete Secrets which were created by this controller.
+	if !ownedBy(secret, crt) {
+		return nil
+	}
+	err = c.kubeClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// dualCertificateUpToDate returns true if the Secret holds a dual certificate
+// of the configured algorithm which was issued for the given revision of the
+// Certificate and matches its private key.
+func dualCertificateUpToDate(crt *cmapi.Certificate, secret *corev1.Secret, revision string) bool {
+	if secret.Annotations[cmapi.DualCertificateRevisionAnnotationKey] != revision {
+		return false
+	}
+	certKey, keyKey := secretKeys(crt.Spec.DualCertificate.Algorithm)
+	pk, err := pki.DecodePrivateKeyBytes(secret.Data[keyKey])
+	if err != nil || !privateKeyMatchesSpec(pk, crt.Spec.DualCertificate) {
+		return false
+	}
+	cert, err := pki.DecodeX509CertificateBytes(secret.Data[certKey])
+	if err != nil {
+		return false
+	}
+	matches, err := pki.PublicKeyMatchesCertificate(pk.Public(), cert)
+	return err == nil && matches
+}
+
+// dualCertificate returns a copy of the Certificate whose private key is
+// configured to be that of its dual certificate.
+func dualCertificate(crt *cmapi.Certificate) *cmapi.Certificate {
+	dual := crt.DeepCopy()
+	var encoding cmapi.PrivateKeyEncoding
+	if crt.Spec.PrivateKey != nil {
+		encoding = crt.Spec.PrivateKey.Encoding
+	}
+	dual.Spec.PrivateKey = &cmapi.CertificatePrivateKey{
+		Algorithm: crt.Spec.DualCertificate.Algorithm,
+		Size:      crt.Spec.DualCertificate.Size,
+		Encoding:  encoding,
+	}
+	return dual
+}
+
+// privateKeyMatchesSpec returns true if the private key is of the algorithm
+// and size configured for the dual certificate.
+func privateKeyMatchesSpec(pk crypto.Signer, spec *cmapi.CertificateDualCertificate) bool {
+	switch pk := pk.(type) {
+	case *rsa.PrivateKey:
+		size := spec.Size
+		if size == 0 {
+			size = pki.MinRSAKeySize
+		}
+		return spec.Algorithm == cmapi.RSAKeyAlgorithm && pk.N.BitLen() == size
+	case *ecdsa.PrivateKey:
+		size := spec.Size
+		if size == 0 {
+			size = pki.ECCurve256
+		}
+		return spec.Algorithm == cmapi.ECDSAKeyAlgorithm && pk.Curve.Params().BitSize == size
+	case ed25519.PrivateKey:
+		return spec.Algorithm == cmapi.Ed25519KeyAlgorithm
+	}
+	return false
+}
+
+var dualCertificateAlgorithms = []cmapi.PrivateKeyAlgorithm{cmapi.RSAKeyAlgorithm, cmapi.ECDSAKeyAlgorithm, cmapi.Ed25519KeyAlgorithm}
+
+// secretKeys returns the Secret keys of the certificate and private key of a
+// dual certificate of the given algorithm.
+func secretKeys(algorithm cmapi.PrivateKeyAlgorithm) (string, string) {
+	prefix := "tls-" + strings.ToLower(string(algorithm))
+	return prefix + ".crt", prefix + ".key"
+}
+
+func removeDualCertificateData(secret *corev1.Secret) {
+	for _, algorithm := range dualCertificateAlgorithms {
+		certKey, keyKey := secretKeys(algorithm)
+		delete(secret.Data, certKey)
+		delete(secret.Data, keyKey)
+	}
+}
+
+func ownerReference(crt *cmapi.Certificate) metav1.OwnerReference {
+	ref := metav1.NewControllerRef(crt, certificateGvk)
+	ref.Controller = nil
+	return *ref
+}
+
+func ownedBy(obj metav1.Object, crt *cmapi.Certificate) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == crt.UID {
+			return true
+		}
+	}
+	return false
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Clock,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
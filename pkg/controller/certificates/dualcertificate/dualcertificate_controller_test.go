@@ -0,0 +1,290 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dualcertificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	internaltest "github.com/jetstack/cert-manager/pkg/controller/certificates/internal/test"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	fixedNow := metav1.NewTime(time.Now())
+	fixedClock := fakeclock.NewFakeClock(fixedNow.Time)
+
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateUID("test-uid"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer"}),
+		gen.SetCertificateRevision(1),
+	)
+	dualCrt := gen.CertificateFrom(baseCrt,
+		gen.SetCertificateDualCertificate(cmapi.CertificateDualCertificate{Algorithm: cmapi.ECDSAKeyAlgorithm}),
+	)
+
+	pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkPEM, err := pki.EncodePrivateKey(pk, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dualCert := internaltest.MustCreateCert(t, pkPEM, dualCertificate(dualCrt))
+
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       gen.DefaultTestNamespace,
+			Name:            "test-dual-key",
+			OwnerReferences: []metav1.OwnerReference{ownerReference(dualCrt)},
+		},
+		Data: map[string][]byte{corev1.TLSPrivateKeyKey: pkPEM},
+	}
+	request := func(revision string, mods ...gen.CertificateRequestModifier) *cmapi.CertificateRequest {
+		return gen.CertificateRequest("test-dual-req", append([]gen.CertificateRequestModifier{
+			gen.SetCertificateRequestNamespace(gen.DefaultTestNamespace),
+			gen.SetCertificateRequestAnnotations(map[string]string{
+				cmapi.CertificateNameKey:                        "test",
+				cmapi.DualCertificateRevisionAnnotationKey:      revision,
+				cmapi.CertificateRequestPrivateKeyAnnotationKey: "test-dual-key",
+			}),
+			gen.SetCertificateRequestCSR(internaltest.MustGenerateCSRImpl(t, pkPEM, dualCertificate(dualCrt))),
+			func(req *cmapi.CertificateRequest) {
+				req.OwnerReferences = []metav1.OwnerReference{ownerReference(dualCrt)}
+			},
+		}, mods...)...)
+	}
+	issued := gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+		Type:   cmapi.CertificateRequestConditionReady,
+		Status: cmmeta.ConditionTrue,
+		Reason: cmapi.CertificateRequestReasonIssued,
+	})
+	failed := gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+		Type:    cmapi.CertificateRequestConditionReady,
+		Status:  cmmeta.ConditionFalse,
+		Reason:  cmapi.CertificateRequestReasonFailed,
+		Message: "rate limited",
+	})
+	secret := func(revision string, data map[string][]byte) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "test-secret"},
+			Data:       map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+		}
+		if revision != "" {
+			s.Annotations = map[string]string{cmapi.DualCertificateRevisionAnnotationKey: revision}
+		}
+		for k, v := range data {
+			s.Data[k] = v
+		}
+		return s
+	}
+	dualData := map[string][]byte{"tls-ecdsa.crt": dualCert, "tls-ecdsa.key": pkPEM}
+
+	secretsResource := corev1.SchemeGroupVersion.WithResource("secrets")
+	requestsResource := cmapi.SchemeGroupVersion.WithResource("certificaterequests")
+	getKeySecret := testpkg.NewAction(coretesting.NewGetAction(secretsResource, gen.DefaultTestNamespace, "test-dual-key"))
+	deleteKeySecret := testpkg.NewAction(coretesting.NewDeleteAction(secretsResource, gen.DefaultTestNamespace, "test-dual-key"))
+	deleteRequest := testpkg.NewAction(coretesting.NewDeleteAction(requestsResource, gen.DefaultTestNamespace, "test-dual-req"))
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		secret      *corev1.Secret
+		kubeObjects []runtime.Object
+		requests    []runtime.Object
+
+		expectedActions []testpkg.Action
+		expectedEvents  []string
+	}{
+		"do nothing if the dual certificate is not enabled": {
+			certificate: baseCrt,
+			secret:      secret("", nil),
+		},
+		"remove the dual certificate if it is no longer enabled": {
+			certificate: baseCrt,
+			secret:      secret("1", dualData),
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(secretsResource, gen.DefaultTestNamespace, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "test-secret", Annotations: map[string]string{}},
+					Data:       secret("", nil).Data,
+				})),
+			},
+			expectedEvents: []string{`Normal Deleted Removed the dual certificate from Secret "test-secret"`},
+		},
+		"wait whilst the certificate is being issued": {
+			certificate: gen.CertificateFrom(dualCrt, gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+				Type:   cmapi.CertificateConditionIssuing,
+				Status: cmmeta.ConditionTrue,
+			})),
+			secret: secret("", nil),
+		},
+		"create a private key and request for the current revision": {
+			certificate: dualCrt,
+			secret:      secret("", nil),
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(secretsResource, gen.DefaultTestNamespace, nil), func(_, act coretesting.Action) error {
+					s := act.(coretesting.CreateAction).GetObject().(*corev1.Secret)
+					if s.Annotations[cmapi.DualCertificateRevisionAnnotationKey] != "1" || !ownedBy(s, dualCrt) {
+						return fmt.Errorf("unexpected private key Secret metadata: %v", s.ObjectMeta)
+					}
+					key, err := pki.DecodePrivateKeyBytes(s.Data[corev1.TLSPrivateKeyKey])
+					if err != nil {
+						return err
+					}
+					if _, ok := key.(*ecdsa.PrivateKey); !ok {
+						return fmt.Errorf("expected an ECDSA private key, got %T", key)
+					}
+					return nil
+				}),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(requestsResource, gen.DefaultTestNamespace, nil), func(_, act coretesting.Action) error {
+					req := act.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+					if req.Annotations[cmapi.DualCertificateRevisionAnnotationKey] != "1" ||
+						req.Annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey] != "test-dual-abcde" ||
+						!ownedBy(req, dualCrt) || metav1.GetControllerOf(req) != nil {
+						return fmt.Errorf("unexpected CertificateRequest metadata: %v", req.ObjectMeta)
+					}
+					csr, err := pki.DecodeX509CertificateRequestBytes(req.Spec.Request)
+					if err != nil {
+						return err
+					}
+					if _, ok := csr.PublicKey.(*ecdsa.PublicKey); !ok || len(csr.DNSNames) != 1 || csr.DNSNames[0] != "example.com" {
+						return fmt.Errorf("unexpected CSR for %T public key and DNS names %v", csr.PublicKey, csr.DNSNames)
+					}
+					return nil
+				}),
+			},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-dual-abcde" for the ECDSA dual certificate`},
+		},
+		"store an issued dual certificate and delete its private key Secret": {
+			certificate: dualCrt,
+			secret:      secret("", nil),
+			kubeObjects: []runtime.Object{keySecret},
+			requests:    []runtime.Object{request("1", issued, gen.SetCertificateRequestCertificate(dualCert))},
+			expectedActions: []testpkg.Action{
+				getKeySecret,
+				testpkg.NewAction(coretesting.NewUpdateAction(secretsResource, gen.DefaultTestNamespace, secret("1", dualData))),
+				deleteKeySecret,
+			},
+			expectedEvents: []string{`Normal DualCertificateIssued Stored the ECDSA dual certificate in Secret "test-secret"`},
+		},
+		"do nothing if the dual certificate is up to date": {
+			certificate: dualCrt,
+			secret:      secret("1", dualData),
+			requests:    []runtime.Object{request("1", issued, gen.SetCertificateRequestCertificate(dualCert))},
+		},
+		"delete requests for previous revisions": {
+			certificate: gen.CertificateFrom(dualCrt, gen.SetCertificateRevision(2)),
+			secret:      secret("2", dualData),
+			kubeObjects: []runtime.Object{keySecret},
+			requests:    []runtime.Object{request("1")},
+			expectedActions: []testpkg.Action{
+				deleteKeySecret,
+				deleteRequest,
+			},
+		},
+		"wait before retrying a recently failed request": {
+			certificate: dualCrt,
+			secret:      secret("", nil),
+			kubeObjects: []runtime.Object{keySecret},
+			requests:    []runtime.Object{request("1", failed, gen.SetCertificateRequestFailureTime(metav1.NewTime(fixedNow.Add(-10*time.Minute))))},
+			expectedActions: []testpkg.Action{
+				getKeySecret,
+			},
+			expectedEvents: []string{`Warning DualCertificateFailed The dual certificate request "test-dual-req" failed and will be retried in 50m0s: Failed: rate limited`},
+		},
+		"delete a failed request once the retry period has passed": {
+			certificate: dualCrt,
+			secret:      secret("", nil),
+			kubeObjects: []runtime.Object{keySecret},
+			requests:    []runtime.Object{request("1", failed, gen.SetCertificateRequestFailureTime(metav1.NewTime(fixedNow.Add(-2*time.Hour))))},
+			expectedActions: []testpkg.Action{
+				getKeySecret,
+				deleteKeySecret,
+				deleteRequest,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				CertManagerObjects: append([]runtime.Object{test.certificate}, test.requests...),
+				KubeObjects:        append([]runtime.Object{test.secret}, test.kubeObjects...),
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+
+			// Generate predictable names for the created resources.
+			generateName := func(tracker coretesting.ObjectTracker) coretesting.ReactionFunc {
+				return func(action coretesting.Action) (bool, runtime.Object, error) {
+					create := action.(coretesting.CreateAction)
+					obj := create.GetObject()
+					if o := obj.(metav1.Object); o.GetName() == "" {
+						o.SetName(o.GetGenerateName() + "abcde")
+					}
+					return true, obj, tracker.Create(create.GetResource(), obj, create.GetNamespace())
+				}
+			}
+			builder.FakeKubeClient().PrependReactor("create", "*", generateName(builder.FakeKubeClient().Tracker()))
+			builder.FakeCMClient().PrependReactor("create", "*", generateName(builder.FakeCMClient().Tracker()))
+
+			ctrl, _, _ := NewController(logf.Log,
+				builder.CMClient,
+				builder.Client,
+				builder.KubeSharedInformerFactory,
+				builder.SharedInformerFactory,
+				builder.Recorder,
+				fixedClock,
+			)
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.certificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := ctrl.ProcessItem(context.Background(), key); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
@@ -17,12 +17,15 @@ limitations under the License.
 package certificates
 
 import (
+	"fmt"
+
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/workqueue"
 
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
@@ -57,13 +60,174 @@ func EnqueueCertificatesForResourceUsingPredicates(log logr.Logger, queue workqu
 			return
 		}
 
-		for _, cert := range certs {
-			key, err := controllerpkg.KeyFunc(cert)
+		enqueueCertificates(log, queue, certs)
+	}
+}
+
+// EnqueueCertificatesForSecretUsingPredicates will return a function that can
+// be used as an OnAdd handler for a Secret SharedIndexInformer.
+// It behaves like EnqueueCertificatesForResourceUsingPredicates, except that
+// Certificates in all namespaces which store their Secrets in the namespace
+// of the Secret being processed are enqueued, as Certificates may set
+// `spec.secretNamespace`.
+func EnqueueCertificatesForSecretUsingPredicates(log logr.Logger, queue workqueue.Interface, lister cmlisters.CertificateLister, selector labels.Selector, predicateBuilders ...predicate.ExtractorFunc) func(obj interface{}) {
+	return func(obj interface{}) {
+		s, ok := obj.(metav1.Object)
+		if !ok {
+			log.V(logf.ErrorLevel).Info("Non-Object type resource passed to EnqueueCertificatesForSecretUsingPredicates")
+			return
+		}
+
+		predicates := predicate.Funcs{predicate.CertificateSecretNamespace(s.GetNamespace())}
+		for _, b := range predicateBuilders {
+			predicates = append(predicates, b(s.(runtime.Object)))
+		}
+
+		all, err := lister.List(selector)
+		if err != nil {
+			log.Error(err, "Failed listing Certificate resources")
+			return
+		}
+
+		var certs []*cmapi.Certificate
+		for _, cert := range all {
+			if predicates.Evaluate(cert) {
+				certs = append(certs, cert)
+			}
+		}
+
+		enqueueCertificates(log, queue, certs)
+	}
+}
+
+// EnqueueCertificatesForIssuer will return a function that can be used as an
+// OnAdd handler for an Issuer or ClusterIssuer SharedIndexInformer.
+// All Certificates which reference the issuer being processed in
+// `spec.issuerRef` or `spec.fallbackIssuerRefs` are enqueued.
+func EnqueueCertificatesForIssuer(log logr.Logger, queue workqueue.Interface, lister cmlisters.CertificateLister) func(obj interface{}) {
+	return func(obj interface{}) {
+		switch obj.(type) {
+		case *cmapi.Issuer, *cmapi.ClusterIssuer:
+		default:
+			log.V(logf.ErrorLevel).Info("Non-issuer type resource passed to EnqueueCertificatesForIssuer")
+			return
+		}
+
+		certs, err := certificatesReferencingIssuer(lister, obj)
+		if err != nil {
+			log.Error(err, "Failed listing Certificate resources")
+			return
+		}
+
+		enqueueCertificates(log, queue, certs)
+	}
+}
+
+// EnqueueCertificatesForCASecret will return a function that can be used as
+// an OnAdd handler for a Secret SharedIndexInformer.
+// If the Secret holds the CA of a CA Issuer or ClusterIssuer, all
+// Certificates of that issuer which have opted in to being re-issued when
+// its CA certificate is rotated are enqueued. The clusterIssuerLister may be
+// nil if ClusterIssuers cannot be read.
+func EnqueueCertificatesForCASecret(log logr.Logger, queue workqueue.Interface, lister cmlisters.CertificateLister,
+	issuerLister cmlisters.IssuerLister, clusterIssuerLister cmlisters.ClusterIssuerLister, clusterResourceNamespace string) func(obj interface{}) {
+	return func(obj interface{}) {
+		s, ok := obj.(metav1.Object)
+		if !ok {
+			log.V(logf.ErrorLevel).Info("Non-Object type resource passed to EnqueueCertificatesForCASecret")
+			return
+		}
+
+		var issuers []interface{}
+		issuerList, err := issuerLister.Issuers(s.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			log.Error(err, "Failed listing Issuer resources")
+			return
+		}
+		for _, iss := range issuerList {
+			if iss.Spec.CA != nil && iss.Spec.CA.SecretName == s.GetName() {
+				issuers = append(issuers, iss)
+			}
+		}
+		if clusterIssuerLister != nil && s.GetNamespace() == clusterResourceNamespace {
+			clusterIssuerList, err := clusterIssuerLister.List(labels.Everything())
+			if err != nil {
+				log.Error(err, "Failed listing ClusterIssuer resources")
+				return
+			}
+			for _, iss := range clusterIssuerList {
+				if iss.Spec.CA != nil && iss.Spec.CA.SecretName == s.GetName() {
+					issuers = append(issuers, iss)
+				}
+			}
+		}
+
+		for _, iss := range issuers {
+			certs, err := certificatesReferencingIssuer(lister, iss)
 			if err != nil {
-				log.Error(err, "Error determining 'key' for resource")
-				continue
+				log.Error(err, "Failed listing Certificate resources")
+				return
 			}
-			queue.Add(key)
+			var rotating []*cmapi.Certificate
+			for _, crt := range certs {
+				if ReissueOnCARotation(crt) {
+					rotating = append(rotating, crt)
+				}
+			}
+			enqueueCertificates(log, queue, rotating)
+		}
+	}
+}
+
+// certificatesReferencingIssuer returns the Certificates which reference the
+// given Issuer or ClusterIssuer in `spec.issuerRef` or
+// `spec.fallbackIssuerRefs`.
+func certificatesReferencingIssuer(lister cmlisters.CertificateLister, obj interface{}) ([]*cmapi.Certificate, error) {
+	var name, kind string
+	var certs []*cmapi.Certificate
+	var err error
+	switch iss := obj.(type) {
+	case *cmapi.Issuer:
+		name, kind = iss.Name, cmapi.IssuerKind
+		certs, err = lister.Certificates(iss.Namespace).List(labels.Everything())
+	case *cmapi.ClusterIssuer:
+		name, kind = iss.Name, cmapi.ClusterIssuerKind
+		certs, err = lister.List(labels.Everything())
+	default:
+		return nil, fmt.Errorf("unexpected type %T, expected an Issuer or ClusterIssuer", obj)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var referencing []*cmapi.Certificate
+	for _, crt := range certs {
+		for _, ref := range IssuerRefs(crt.Spec) {
+			if ref.Name == name && issuerRefKind(ref.Kind) == kind {
+				referencing = append(referencing, crt)
+				break
+			}
+		}
+	}
+	return referencing, nil
+}
+
+// issuerRefKind returns the kind of issuer referenced by an issuerRef with
+// the given kind, which defaults to Issuer.
+func issuerRefKind(kind string) string {
+	if kind == "" {
+		return cmapi.IssuerKind
+	}
+	return kind
+}
+
+func enqueueCertificates(log logr.Logger, queue workqueue.Interface, certs []*cmapi.Certificate) {
+	for _, cert := range certs {
+		key, err := controllerpkg.KeyFunc(cert)
+		if err != nil {
+			log.Error(err, "Error determining 'key' for resource")
+			continue
 		}
+		queue.Add(key)
 	}
 }
@@ -0,0 +1,323 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chain builds the certificate chain of an issued certificate,
+// optionally completing missing intermediate certificates using their
+// Authority Information Access (AIA) extension, and composes the `tls.crt`
+// and `ca.crt` entries of a Certificate's target Secret from it.
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	// maxChainLength is the maximum number of certificates in a chain,
+	// preventing loops when completing chains.
+	maxChainLength = 10
+
+	// maxResponseSize is the maximum size of a certificate fetched from an
+	// AIA URL.
+	maxResponseSize = 1 << 20
+)
+
+// Fetcher fetches issuer certificates from the "CA Issuers" URLs of the AIA
+// extension of certificates. Only URLs on allowed hosts are fetched, and
+// fetched certificates are cached.
+type Fetcher struct {
+	allowedHosts []string
+	cacheTTL     time.Duration
+	client       *http.Client
+	clock        clock.Clock
+
+	lock  sync.Mutex
+	cache map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	certs   []*x509.Certificate
+	expires time.Time
+}
+
+// NewFetcher returns a Fetcher which only fetches URLs on the given hosts.
+// A host may be prefixed with "*." to allow any of its subdomains. If no
+// hosts are given, no URLs are fetched. Fetched certificates are cached for
+// cacheTTL.
+func NewFetcher(allowedHosts []string, cacheTTL time.Duration, clock clock.Clock) *Fetcher {
+	return &Fetcher{
+		allowedHosts: allowedHosts,
+		cacheTTL:     cacheTTL,
+		client:       &http.Client{Timeout: time.Second * 10},
+		clock:        clock,
+		cache:        make(map[string]cacheEntry),
+	}
+}
+
+// Build returns the certificate chain formed by the PEM encoded certificate
+// and CA data, ordered from the leaf certificate to the root-most
+// certificate. If complete is true and the root-most certificate is not
+// self-signed, its issuers are fetched until a self-signed certificate is
+// found. An error is returned alongside the chain built so far if the chain
+// could not be completed.
+func (f *Fetcher) Build(ctx context.Context, certPEM, caPEM []byte, complete bool) ([]*x509.Certificate, error) {
+	chain, err := orderedChain(certPEM, caPEM)
+	if err != nil {
+		return nil, err
+	}
+	if !complete {
+		return chain, nil
+	}
+
+	for len(chain) < maxChainLength {
+		top := chain[len(chain)-1]
+		if isSelfSigned(top) {
+			return chain, nil
+		}
+
+		issuer, err := f.fetchIssuer(ctx, top)
+		if err != nil {
+			return chain, err
+		}
+		chain = append(chain, issuer)
+	}
+
+	return chain, fmt.Errorf("certificate chain is longer than %d certificates", maxChainLength)
+}
+
+// fetchIssuer returns the certificate which signed cert, found using the
+// "CA Issuers" URLs of its AIA extension.
+func (f *Fetcher) fetchIssuer(ctx context.Context, cert *x509.Certificate) (*x509.Certificate, error) {
+	if len(cert.IssuingCertificateURL) == 0 {
+		return nil, fmt.Errorf("certificate %q does not contain any AIA issuing certificate URLs", cert.Subject)
+	}
+
+	var errs []string
+	for _, u := range cert.IssuingCertificateURL {
+		candidates, err := f.fetch(ctx, u)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		for _, candidate := range candidates {
+			if cert.CheckSignatureFrom(candidate) == nil {
+				return candidate, nil
+			}
+		}
+		errs = append(errs, fmt.Sprintf("%s: no certificate found which signed %q", u, cert.Subject))
+	}
+
+	return nil, fmt.Errorf("failed to fetch issuer of certificate %q: %s", cert.Subject, strings.Join(errs, "; "))
+}
+
+// fetch returns the certificates served at the given URL, using the cache if
+// possible.
+func (f *Fetcher) fetch(ctx context.Context, rawURL string) ([]*x509.Certificate, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("%s: unsupported URL scheme %q", rawURL, u.Scheme)
+	}
+	if !f.hostAllowed(u.Hostname()) {
+		return nil, fmt.Errorf("%s: host %q is not allowed", rawURL, u.Hostname())
+	}
+
+	f.lock.Lock()
+	entry, ok := f.cache[rawURL]
+	f.lock.Unlock()
+	if ok && f.clock.Now().Before(entry.expires) {
+		return entry.certs, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status code %d", rawURL, resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := decodeCertificates(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", rawURL, err)
+	}
+
+	f.lock.Lock()
+	f.cache[rawURL] = cacheEntry{certs: certs, expires: f.clock.Now().Add(f.cacheTTL)}
+	f.lock.Unlock()
+
+	return certs, nil
+}
+
+func (f *Fetcher) hostAllowed(host string) bool {
+	for _, allowed := range f.allowedHosts {
+		if strings.HasPrefix(allowed, "*.") {
+			if strings.HasSuffix(host, allowed[1:]) {
+				return true
+			}
+			continue
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeCertificates decodes DER or PEM encoded certificates.
+func decodeCertificates(data []byte) ([]*x509.Certificate, error) {
+	if certs, err := x509.ParseCertificates(data); err == nil && len(certs) > 0 {
+		return certs, nil
+	}
+	return pki.DecodeX509CertificateChainBytes(data)
+}
+
+// orderedChain returns the certificates in the PEM encoded certificate and CA
+// data ordered from the leaf certificate to the root-most certificate.
+func orderedChain(certPEM, caPEM []byte) ([]*x509.Certificate, error) {
+	leaf, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := pki.DecodeX509CertificateChainBytes(append(append([]byte{}, certPEM...), caPEM...))
+	if err != nil {
+		return nil, err
+	}
+
+	chain := []*x509.Certificate{leaf}
+	used := make([]bool, len(certs))
+	for len(chain) < maxChainLength {
+		top := chain[len(chain)-1]
+		if isSelfSigned(top) {
+			break
+		}
+		found := false
+		for i, c := range certs {
+			if used[i] || c.Equal(top) || top.CheckSignatureFrom(c) != nil {
+				continue
+			}
+			used[i] = true
+			chain = append(chain, c)
+			found = true
+			break
+		}
+		if !found {
+			break
+		}
+	}
+
+	return chain, nil
+}
+
+// Compose returns the PEM encoded `tls.crt` and `ca.crt` data for the given
+// chain, which must be ordered from the leaf certificate to the root-most
+// certificate. `tls.crt` contains the leaf certificate followed by all
+// intermediate certificates. If composition is empty or IssuerProvided, the
+// returned `ca.crt` data is nil.
+func Compose(chain []*x509.Certificate, composition cmapi.CertificateCAChainComposition) (certPEM, caPEM []byte, err error) {
+	if len(chain) == 0 {
+		return nil, nil, fmt.Errorf("certificate chain is empty")
+	}
+
+	intermediates := chain[1:]
+	var root *x509.Certificate
+	if top := chain[len(chain)-1]; isSelfSigned(top) {
+		// a self-signed leaf certificate is its own root
+		root = top
+		if len(chain) > 1 {
+			intermediates = chain[1 : len(chain)-1]
+		}
+	}
+
+	certPEM, err = encodeCertificates(append([]*x509.Certificate{chain[0]}, intermediates...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cas []*x509.Certificate
+	switch composition {
+	case "", cmapi.CAChainCompositionIssuerProvided:
+		return certPEM, nil, nil
+	case cmapi.CAChainCompositionRoot:
+		if root != nil {
+			cas = []*x509.Certificate{root}
+		} else if len(intermediates) > 0 {
+			cas = intermediates[len(intermediates)-1:]
+		}
+	case cmapi.CAChainCompositionIntermediates:
+		cas = intermediates
+	case cmapi.CAChainCompositionFullChain:
+		cas = intermediates
+		if root != nil {
+			cas = append(append([]*x509.Certificate{}, intermediates...), root)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown CA chain composition %q", composition)
+	}
+
+	if len(cas) == 0 {
+		return certPEM, nil, nil
+	}
+	caPEM, err = encodeCertificates(cas)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certPEM, caPEM, nil
+}
+
+// encodeCertificates PEM encodes the given certificates. Unlike
+// pki.EncodeX509Chain, self-signed certificates are included.
+func encodeCertificates(certs []*x509.Certificate) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	for _, cert := range certs {
+		if err := pem.Encode(buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return cert.CheckSignatureFrom(cert) == nil
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func mustCreateCertificate(t *testing.T, cn string, isCA bool, aiaURL string, issuer *x509.Certificate, issuerKey crypto.Signer) (*x509.Certificate, crypto.Signer) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if aiaURL != "" {
+		tmpl.IssuingCertificateURL = []string{aiaURL}
+	}
+	if issuer == nil {
+		issuer, issuerKey = tmpl, pk
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, issuer, pk.Public(), issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, pk
+}
+
+func mustEncode(t *testing.T, certs ...*x509.Certificate) []byte {
+	if len(certs) == 0 {
+		return nil
+	}
+	var data []byte
+	for _, cert := range certs {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	return data
+}
+
+func TestFetcher_Build(t *testing.T) {
+	var (
+		root, intermediate *x509.Certificate
+		requests           int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/root.crt":
+			w.Write(root.Raw)
+		case "/intermediate.pem":
+			w.Write(mustEncode(t, intermediate))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, rootKey := mustCreateCertificate(t, "root", true, "", nil, nil)
+	intermediate, intermediateKey := mustCreateCertificate(t, "intermediate", true, server.URL+"/root.crt", root, rootKey)
+	leaf, _ := mustCreateCertificate(t, "leaf", false, server.URL+"/intermediate.pem", intermediate, intermediateKey)
+	brokenLeaf, _ := mustCreateCertificate(t, "leaf", false, server.URL+"/missing.crt", intermediate, intermediateKey)
+
+	tests := map[string]struct {
+		certPEM, caPEM []byte
+		complete       bool
+		allowedHosts   []string
+
+		expectedChain    []*x509.Certificate
+		expectedErr      bool
+		expectedRequests int
+	}{
+		"order the certificates returned by the issuer": {
+			certPEM:       mustEncode(t, leaf),
+			caPEM:         mustEncode(t, root, intermediate),
+			expectedChain: []*x509.Certificate{leaf, intermediate, root},
+		},
+		"do not fetch missing certificates if completion is disabled": {
+			certPEM:       mustEncode(t, leaf),
+			caPEM:         mustEncode(t, root),
+			allowedHosts:  []string{serverURL.Hostname()},
+			expectedChain: []*x509.Certificate{leaf},
+		},
+		"fetch missing DER and PEM encoded certificates from allowed hosts": {
+			certPEM:          mustEncode(t, leaf),
+			complete:         true,
+			allowedHosts:     []string{serverURL.Hostname()},
+			expectedChain:    []*x509.Certificate{leaf, intermediate, root},
+			expectedRequests: 2,
+		},
+		"do not fetch certificates from hosts which are not allowed": {
+			certPEM:       mustEncode(t, leaf),
+			complete:      true,
+			allowedHosts:  []string{"*.example.com"},
+			expectedChain: []*x509.Certificate{leaf},
+			expectedErr:   true,
+		},
+		"fetch only the certificates missing from the chain": {
+			certPEM:          mustEncode(t, brokenLeaf),
+			caPEM:            mustEncode(t, intermediate),
+			complete:         true,
+			allowedHosts:     []string{serverURL.Hostname()},
+			expectedChain:    []*x509.Certificate{brokenLeaf, intermediate, root},
+			expectedRequests: 1,
+		},
+		"return the partial chain if a certificate cannot be fetched": {
+			certPEM:          mustEncode(t, brokenLeaf),
+			complete:         true,
+			allowedHosts:     []string{serverURL.Hostname()},
+			expectedChain:    []*x509.Certificate{brokenLeaf},
+			expectedErr:      true,
+			expectedRequests: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			requests = 0
+			f := NewFetcher(test.allowedHosts, time.Hour, clock.RealClock{})
+
+			chain, err := f.Build(context.TODO(), test.certPEM, test.caPEM, test.complete)
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if !bytes.Equal(mustEncode(t, chain...), mustEncode(t, test.expectedChain...)) {
+				t.Errorf("unexpected chain, exp=%d certificates, got=%d certificates", len(test.expectedChain), len(chain))
+			}
+			if requests != test.expectedRequests {
+				t.Errorf("expected %d requests, got %d", test.expectedRequests, requests)
+			}
+
+			// fetched certificates should be cached
+			if test.expectedErr {
+				return
+			}
+			if _, err := f.Build(context.TODO(), test.certPEM, test.caPEM, test.complete); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if requests != test.expectedRequests {
+				t.Errorf("expected cached certificates to be used, got %d requests", requests)
+			}
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	root, rootKey := mustCreateCertificate(t, "root", true, "", nil, nil)
+	intermediate, intermediateKey := mustCreateCertificate(t, "intermediate", true, "", root, rootKey)
+	leaf, _ := mustCreateCertificate(t, "leaf", false, "", intermediate, intermediateKey)
+	selfSigned, _ := mustCreateCertificate(t, "self-signed", true, "", nil, nil)
+
+	tests := map[string]struct {
+		chain       []*x509.Certificate
+		composition cmapi.CertificateCAChainComposition
+
+		expectedCert, expectedCA []byte
+	}{
+		"do not return a CA if the issuer provided CA should be used": {
+			chain:        []*x509.Certificate{leaf, intermediate, root},
+			composition:  cmapi.CAChainCompositionIssuerProvided,
+			expectedCert: mustEncode(t, leaf, intermediate),
+		},
+		"return only the root CA": {
+			chain:        []*x509.Certificate{leaf, intermediate, root},
+			composition:  cmapi.CAChainCompositionRoot,
+			expectedCert: mustEncode(t, leaf, intermediate),
+			expectedCA:   mustEncode(t, root),
+		},
+		"return the highest intermediate if the root CA is not known": {
+			chain:        []*x509.Certificate{leaf, intermediate},
+			composition:  cmapi.CAChainCompositionRoot,
+			expectedCert: mustEncode(t, leaf, intermediate),
+			expectedCA:   mustEncode(t, intermediate),
+		},
+		"return only the intermediates": {
+			chain:        []*x509.Certificate{leaf, intermediate, root},
+			composition:  cmapi.CAChainCompositionIntermediates,
+			expectedCert: mustEncode(t, leaf, intermediate),
+			expectedCA:   mustEncode(t, intermediate),
+		},
+		"return the full chain": {
+			chain:        []*x509.Certificate{leaf, intermediate, root},
+			composition:  cmapi.CAChainCompositionFullChain,
+			expectedCert: mustEncode(t, leaf, intermediate),
+			expectedCA:   mustEncode(t, intermediate, root),
+		},
+		"return a self-signed certificate as its own root": {
+			chain:        []*x509.Certificate{selfSigned},
+			composition:  cmapi.CAChainCompositionRoot,
+			expectedCert: mustEncode(t, selfSigned),
+			expectedCA:   mustEncode(t, selfSigned),
+		},
+		"return no intermediates for a self-signed certificate": {
+			chain:        []*x509.Certificate{selfSigned},
+			composition:  cmapi.CAChainCompositionIntermediates,
+			expectedCert: mustEncode(t, selfSigned),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cert, ca, err := Compose(test.chain, test.composition)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(cert, test.expectedCert) {
+				t.Errorf("unexpected certificate data, exp=%q, got=%q", test.expectedCert, cert)
+			}
+			if !bytes.Equal(ca, test.expectedCA) {
+				t.Errorf("unexpected CA data, exp=%q, got=%q", test.expectedCA, ca)
+			}
+		})
+	}
+}
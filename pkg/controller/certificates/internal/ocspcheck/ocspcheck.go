@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ocspcheck fetches the status of certificates from the OCSP
+// responders named in them.
+package ocspcheck
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	// DefaultRefreshInterval is how long an OCSP response is used for if the
+	// responder does not specify when newer information will be available.
+	DefaultRefreshInterval = time.Hour
+
+	// maxResponseSize is the maximum size of an OCSP response that will be
+	// read from a responder.
+	maxResponseSize = 1024 * 1024
+)
+
+// Fetch requests the status of the given certificate from the first OCSP
+// responder named in the certificate. The parsed and DER encoded responses
+// are returned.
+func Fetch(ctx context.Context, client *http.Client, leaf, issuer *x509.Certificate) (*ocsp.Response, []byte, error) {
+	reqBytes, err := ocsp.CreateRequest(leaf, issuer, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OCSP request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, leaf.OCSPServer[0], bytes.NewReader(reqBytes))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+	req.Header.Set("Accept", "application/ocsp-response")
+
+	httpResp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("unexpected response status %q", httpResp.Status)
+	}
+
+	der, err := ioutil.ReadAll(io.LimitReader(httpResp.Body, maxResponseSize))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := ocsp.ParseResponseForCert(der, leaf, issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid OCSP response: %w", err)
+	}
+
+	return resp, der, nil
+}
+
+// IssuerForCertificate returns the certificate that signed leaf, taken from
+// the rest of the chain or else from the CA stored in the Secret. If no
+// issuer can be found, nil is returned.
+func IssuerForCertificate(leaf *x509.Certificate, chain []*x509.Certificate, caData []byte) *x509.Certificate {
+	candidates := chain[1:]
+	if ca, err := pki.DecodeX509CertificateChainBytes(caData); err == nil {
+		candidates = append(candidates, ca...)
+	}
+
+	for _, candidate := range candidates {
+		if leaf.CheckSignatureFrom(candidate) == nil {
+			return candidate
+		}
+	}
+
+	return nil
+}
+
+// RefreshTime returns the time at which a new OCSP response should be
+// fetched to replace the given one, which is half way through its validity
+// period.
+func RefreshTime(resp *ocsp.Response) time.Time {
+	if resp.NextUpdate.IsZero() {
+		return resp.ThisUpdate.Add(DefaultRefreshInterval)
+	}
+	return resp.ThisUpdate.Add(resp.NextUpdate.Sub(resp.ThisUpdate) / 2)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"context"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/secretstore"
+)
+
+// externalSecretStore writes data to an external secret store before writing
+// it to the Kubernetes Secrets of the Certificate.
+type externalSecretStore struct {
+	SecretStore
+	external secretstore.Store
+}
+
+// WithExternalStore returns a SecretStore which writes data to the given
+// external secret store, and then to the given SecretStore. Data is written
+// to the external store first so that a failed write is retried: a Certificate
+// is only considered issued once its Secret is up to date.
+func WithExternalStore(store SecretStore, external secretstore.Store) SecretStore {
+	return &externalSecretStore{SecretStore: store, external: external}
+}
+
+func (s *externalSecretStore) UpdateData(ctx context.Context, crt *cmapi.Certificate, data SecretData) error {
+	if err := s.external.Put(ctx, crt, secretstore.Data{
+		PrivateKey:  data.PrivateKey,
+		Certificate: data.Certificate,
+		CA:          data.CA,
+	}); err != nil {
+		return err
+	}
+	return s.SecretStore.UpdateData(ctx, crt, data)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/secretstore"
+)
+
+type fakeSecretStore struct {
+	calls *[]string
+	err   error
+}
+
+func (f *fakeSecretStore) UpdateData(context.Context, *cmapi.Certificate, SecretData) error {
+	*f.calls = append(*f.calls, "kubernetes")
+	return f.err
+}
+
+type fakeExternalStore struct {
+	calls *[]string
+	data  secretstore.Data
+	err   error
+}
+
+func (f *fakeExternalStore) Put(_ context.Context, _ *cmapi.Certificate, data secretstore.Data) error {
+	*f.calls = append(*f.calls, "external")
+	f.data = data
+	return f.err
+}
+
+func TestWithExternalStore(t *testing.T) {
+	data := SecretData{PrivateKey: []byte("key"), Certificate: []byte("cert"), CA: []byte("ca")}
+
+	tests := map[string]struct {
+		externalErr   error
+		expectedCalls []string
+		expectedErr   bool
+	}{
+		"data is written to the external store and then the Secret": {
+			expectedCalls: []string{"external", "kubernetes"},
+		},
+		"the Secret is not written if the external store fails": {
+			externalErr:   errors.New("unavailable"),
+			expectedCalls: []string{"external"},
+			expectedErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			external := &fakeExternalStore{calls: &calls, err: test.externalErr}
+			store := WithExternalStore(&fakeSecretStore{calls: &calls}, external)
+
+			err := store.UpdateData(context.TODO(), &cmapi.Certificate{}, data)
+			if test.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if !reflect.DeepEqual(calls, test.expectedCalls) {
+				t.Errorf("unexpected calls, exp=%v, got=%v", test.expectedCalls, calls)
+			}
+			exp := secretstore.Data{PrivateKey: data.PrivateKey, Certificate: data.Certificate, CA: data.CA}
+			if !reflect.DeepEqual(external.data, exp) {
+				t.Errorf("unexpected data written to the external store, exp=%v, got=%v", exp, external.data)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"bytes"
+	"encoding/pem"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	// derPrivateKeySecretKey is the name of the data entry in the Secret
+	// resource used to store the DER encoded private key.
+	derPrivateKeySecretKey = "key.der"
+	// derCertificateSecretKey is the name of the data entry in the Secret
+	// resource used to store the DER encoded leaf certificate.
+	derCertificateSecretKey = "cert.der"
+
+	// combinedPEMSecretKey is the name of the data entry in the Secret
+	// resource used to store the PEM encoded private key followed by the PEM
+	// encoded certificate chain.
+	combinedPEMSecretKey = "tls-combined.pem"
+)
+
+// setAdditionalOutputFormats will write or remove the additional output
+// format entries on the Secret, according to the Certificate's
+// spec.additionalOutputFormats.
+// Entries for formats which are not requested, or which cannot be generated
+// because the Secret has no private key or certificate data, are removed.
+func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
+	var der, combinedPEM bool
+	for _, f := range crt.Spec.AdditionalOutputFormats {
+		switch f.Type {
+		case cmapi.CertificateOutputFormatDER:
+			der = true
+		case cmapi.CertificateOutputFormatCombinedPEM:
+			combinedPEM = true
+		}
+	}
+
+	hasData := len(data.PrivateKey) > 0 && len(data.Certificate) > 0
+
+	if der && hasData {
+		keyDER, err := firstPEMBlockBytes(data.PrivateKey)
+		if err != nil {
+			return err
+		}
+		certDER, err := firstPEMBlockBytes(data.Certificate)
+		if err != nil {
+			return err
+		}
+		secret.Data[derPrivateKeySecretKey] = keyDER
+		secret.Data[derCertificateSecretKey] = certDER
+	} else {
+		delete(secret.Data, derPrivateKeySecretKey)
+		delete(secret.Data, derCertificateSecretKey)
+	}
+
+	if combinedPEM && hasData {
+		secret.Data[combinedPEMSecretKey] = encodeCombinedPEM(data.PrivateKey, data.Certificate)
+	} else {
+		delete(secret.Data, combinedPEMSecretKey)
+	}
+
+	return nil
+}
+
+// firstPEMBlockBytes returns the DER bytes of the first PEM block in data.
+func firstPEMBlockBytes(data []byte) ([]byte, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("error decoding PEM data: no PEM block found")
+	}
+	return block.Bytes, nil
+}
+
+// encodeCombinedPEM returns the PEM encoded private key followed by the PEM
+// encoded certificate chain, ensuring the two are separated by a newline.
+func encodeCombinedPEM(key, cert []byte) []byte {
+	combined := make([]byte, 0, len(key)+len(cert)+1)
+	combined = append(combined, key...)
+	if !bytes.HasSuffix(key, []byte("\n")) {
+		combined = append(combined, '\n')
+	}
+	return append(combined, cert...)
+}
@@ -19,12 +19,15 @@ package secretsmanager
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
@@ -38,7 +41,25 @@ var (
 	certificateGvk = cmapi.SchemeGroupVersion.WithKind("Certificate")
 )
 
+// SecretStore stores the private key, certificate and CA data issued for a
+// Certificate. The issuing controller writes all issued data through a
+// SecretStore.
+// Data is always stored in Kubernetes Secret resources, as the other
+// certificates controllers read the issued data from the Secret named by
+// spec.secretName, and may also be written to an external secret store using
+// WithExternalStore.
+type SecretStore interface {
+	// UpdateData will store the given data for the Certificate, replacing
+	// any data previously stored for it.
+	UpdateData(ctx context.Context, crt *cmapi.Certificate, data SecretData) error
+}
+
+var _ SecretStore = &SecretsManager{}
+
 // SecretsManager creates and updates secrets with certificate and key data.
+// It is the SecretStore implementation which stores data in the Kubernetes
+// Secret named by a Certificate's spec.secretName, as well as in each Secret
+// named by its spec.additionalSecretNames.
 type SecretsManager struct {
 	kubeClient   kubernetes.Interface
 	secretLister corelisters.SecretLister
@@ -53,6 +74,10 @@ type SecretsManager struct {
 // SecretData is a structure wrapping private key, Certificate and CA data
 type SecretData struct {
 	PrivateKey, Certificate, CA []byte
+
+	// IssuerRef is the issuer which issued the Certificate. If not set, the
+	// Certificate's `spec.issuerRef` is recorded on the Secret.
+	IssuerRef *cmmeta.ObjectReference
 }
 
 // New returns a new SecretsManager. Setting enableSecretOwnerReferences to
@@ -70,16 +95,27 @@ func New(
 	}
 }
 
-// UpdateData will ensure the Secret resource contains the given secret
-// data as well as appropriate metadata.
-// If the Secret resource does not exist, it will be created.
-// Otherwise, the existing resource will be updated.
-// The first return argument will be true if the resource was updated/created
-// without error.
+// UpdateData will ensure the Secret resources of the Certificate contain the
+// given secret data as well as appropriate metadata.
+// If a Secret resource does not exist, it will be created.
+// Otherwise, the existing resource will be updated, unless its contents
+// and metadata already match the intended state.
 // UpdateData will also update deprecated annotations if they exist.
 func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate, data SecretData) error {
+	for _, name := range apiutil.CertificateSecretNames(crt) {
+		if err := s.updateSecret(ctx, crt, name, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateSecret stores the given data in the named Secret resource.
+func (s *SecretsManager) updateSecret(ctx context.Context, crt *cmapi.Certificate, name string, data SecretData) error {
+	namespace := apiutil.CertificateSecretNamespace(crt)
+
 	// Fetch a copy of the existing Secret resource
-	secret, err := s.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	secret, err := s.secretLister.Secrets(namespace).Get(name)
 	if !apierrors.IsNotFound(err) && err != nil {
 		// If secret doesn't exist yet, then don't error
 		return err
@@ -90,19 +126,28 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 	if !secretExists {
 		secret = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      crt.Spec.SecretName,
-				Namespace: crt.Namespace,
+				Name:      name,
+				Namespace: namespace,
 			},
 			Type: corev1.SecretTypeTLS,
 		}
 	}
 
-	// secret will be overwritten by 'existingSecret' if existingSecret is non-nil
-	if s.enableSecretOwnerReferences {
-		secret.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(crt, certificateGvk)}
+	// Hash the existing Secret before it is modified so that we can tell
+	// whether an update is actually required.
+	existingHash, err := secretContentHash(secret)
+	if err != nil {
+		return err
 	}
 
+	// Always work on a copy to avoid modifying the object in the lister cache.
 	secret = secret.DeepCopy()
+	// Owner references cannot cross namespaces, so Secrets in another
+	// namespace are never owned by the Certificate.
+	if s.enableSecretOwnerReferences && namespace == crt.Namespace {
+		secret.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(crt, certificateGvk)}
+	}
+
 	err = s.setValues(crt, secret, data)
 	if err != nil {
 		return err
@@ -110,16 +155,48 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 
 	// If secret does not exist then create it
 	if !secretExists {
-
 		_, err = s.kubeClient.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
 		return err
 	}
 
-	// Currently we are always updating. We should devise a way to not have to call an update if it is not necessary.
+	// Skip the update if the intended Secret is identical to the existing
+	// one, so that we do not needlessly bump its resourceVersion.
+	intendedHash, err := secretContentHash(secret)
+	if err != nil {
+		return err
+	}
+	if intendedHash == existingHash {
+		return nil
+	}
+
+	// All data and metadata is written in a single Update call.
 	_, err = s.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
 	return err
 }
 
+// secretContentHash returns a hash of the fields of the Secret which are
+// managed by the SecretsManager. Map keys are serialized in sorted order, so
+// the hash is stable for equal contents.
+func secretContentHash(secret *corev1.Secret) (string, error) {
+	b, err := json.Marshal(struct {
+		Type            corev1.SecretType
+		Data            map[string][]byte
+		Labels          map[string]string
+		Annotations     map[string]string
+		OwnerReferences []metav1.OwnerReference
+	}{
+		Type:            secret.Type,
+		Data:            secret.Data,
+		Labels:          secret.Labels,
+		Annotations:     secret.Annotations,
+		OwnerReferences: secret.OwnerReferences,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash Secret contents: %w", err)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
+
 // setValues will update the Secret resource 'secret' with the data contained
 // in the given secretData.
 // It will update labels and annotations on the Secret resource appropriately.
@@ -203,6 +280,12 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 			delete(secret.Data, jksSecretKey)
 			delete(secret.Data, jksTruststoreKey)
 		}
+
+		// An existing OCSP staple is only valid for the previous certificate.
+		// It will be re-fetched if the OCSP staple controller is enabled.
+		if !bytes.Equal(secret.Data[corev1.TLSCertKey], data.Certificate) {
+			delete(secret.Data, cmmeta.TLSOCSPStapleKey)
+		}
 	}
 
 	secret.Data[corev1.TLSPrivateKeyKey] = data.PrivateKey
@@ -213,6 +296,10 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		delete(secret.Data, cmmeta.TLSCAKey)
 	}
 
+	if err := setAdditionalOutputFormats(crt, secret, data); err != nil {
+		return fmt.Errorf("error encoding additional output formats: %w", err)
+	}
+
 	if secret.Annotations == nil {
 		secret.Annotations = make(map[string]string)
 	}
@@ -220,25 +307,27 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		secret.Labels = make(map[string]string)
 	}
 
-	// TODO: Labels and annotations are not yet removed from the Secret if removed from the template.
-	// An extra annotation will be required to keep track of which labels and annotations were created
-	// by cert-manager to allow them to be removed/updated safely.
-
-	// See https://github.com/jetstack/cert-manager/issues/4292
-
+	var templateLabels, templateAnnotations, templateKeys map[string]string
 	if crt.Spec.SecretTemplate != nil {
-		for k, v := range crt.Spec.SecretTemplate.Labels {
-			secret.Labels[k] = v
-		}
-		for k, v := range crt.Spec.SecretTemplate.Annotations {
-			secret.Annotations[k] = v
-		}
+		templateLabels = crt.Spec.SecretTemplate.Labels
+		templateAnnotations = crt.Spec.SecretTemplate.Annotations
+		templateKeys = crt.Spec.SecretTemplate.Keys
 	}
+	applyTemplateKeys(secret, templateKeys)
+	// The keys copied from the template are recorded on the Secret so that
+	// keys removed from the template can later be removed from the Secret,
+	// without removing labels and annotations set by other tools.
+	applyTemplate(secret.Annotations, cmapi.SecretTemplateLabelsAnnotationKey, secret.Labels, templateLabels)
+	applyTemplate(secret.Annotations, cmapi.SecretTemplateAnnotationsAnnotationKey, secret.Annotations, templateAnnotations)
 
 	secret.Annotations[cmapi.CertificateNameKey] = crt.Name
-	secret.Annotations[cmapi.IssuerNameAnnotationKey] = crt.Spec.IssuerRef.Name
-	secret.Annotations[cmapi.IssuerKindAnnotationKey] = apiutil.IssuerKind(crt.Spec.IssuerRef)
-	secret.Annotations[cmapi.IssuerGroupAnnotationKey] = crt.Spec.IssuerRef.Group
+	issuerRef := crt.Spec.IssuerRef
+	if data.IssuerRef != nil {
+		issuerRef = *data.IssuerRef
+	}
+	secret.Annotations[cmapi.IssuerNameAnnotationKey] = issuerRef.Name
+	secret.Annotations[cmapi.IssuerKindAnnotationKey] = apiutil.IssuerKind(issuerRef)
+	secret.Annotations[cmapi.IssuerGroupAnnotationKey] = issuerRef.Group
 
 	// if the certificate data is empty, clear the subject related annotations
 	if len(data.Certificate) == 0 {
@@ -261,3 +350,60 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 
 	return nil
 }
+
+// applyTemplateKeys copies the standard data keys of the Secret to the custom
+// keys configured in the secretTemplate, so that applications which expect
+// non-standard key names can consume the Secret directly. Custom keys which
+// were written for a previous version of the template, as recorded in the
+// Secret's annotations, are removed.
+func applyTemplateKeys(secret *corev1.Secret, keys map[string]string) {
+	targets := sets.NewString()
+	for _, target := range keys {
+		targets.Insert(target)
+	}
+
+	if previous := secret.Annotations[cmapi.SecretTemplateKeysAnnotationKey]; previous != "" {
+		for _, k := range strings.Split(previous, ",") {
+			if !targets.Has(k) {
+				delete(secret.Data, k)
+			}
+		}
+	}
+
+	for src, target := range keys {
+		if v, ok := secret.Data[src]; ok {
+			secret.Data[target] = v
+		} else {
+			delete(secret.Data, target)
+		}
+	}
+
+	if targets.Len() == 0 {
+		delete(secret.Annotations, cmapi.SecretTemplateKeysAnnotationKey)
+		return
+	}
+	secret.Annotations[cmapi.SecretTemplateKeysAnnotationKey] = strings.Join(targets.List(), ",")
+}
+
+// applyTemplate copies the template entries into target and removes the
+// entries of target which were copied from a previous version of the
+// template, as recorded in annotations under trackingKey. Entries of target
+// which were not copied from the template are left untouched. The keys of
+// the template are then recorded under trackingKey.
+func applyTemplate(annotations map[string]string, trackingKey string, target, template map[string]string) {
+	if previous := annotations[trackingKey]; previous != "" {
+		for _, k := range strings.Split(previous, ",") {
+			if _, ok := template[k]; !ok {
+				delete(target, k)
+			}
+		}
+	}
+	for k, v := range template {
+		target[k] = v
+	}
+	if len(template) == 0 {
+		delete(annotations, trackingKey)
+		return
+	}
+	annotations[trackingKey] = strings.Join(sets.StringKeySet(template).List(), ",")
+}
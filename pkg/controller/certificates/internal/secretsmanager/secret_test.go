@@ -18,6 +18,7 @@ package secretsmanager
 
 import (
 	"context"
+	"encoding/pem"
 	"strings"
 	"testing"
 	"time"
@@ -72,6 +73,22 @@ func TestSecretsManager(t *testing.T) {
 		}),
 	)
 
+	baseCertWithAdditionalOutputFormats := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateAdditionalOutputFormats(
+			cmapi.CertificateAdditionalOutputFormat{Type: cmapi.CertificateOutputFormatDER},
+			cmapi.CertificateAdditionalOutputFormat{Type: cmapi.CertificateOutputFormatCombinedPEM},
+		),
+	)
+	baseCertWithSecretTemplateKeys := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateSecretTemplateKeys(map[string]string{
+			corev1.TLSCertKey:       "server.crt",
+			corev1.TLSPrivateKeyKey: "server.key",
+			cmmeta.TLSCAKey:         "ca.pem",
+		}),
+	)
+	keyDER, _ := pem.Decode(baseCertBundle.PrivateKeyBytes)
+	certDER, _ := pem.Decode(baseCertBundle.CertBytes)
+
 	tests := map[string]testT{
 		"if secret does not exists and unable to decode certificate, then error": {
 			certificate: baseCertBundle.Certificate,
@@ -224,6 +241,9 @@ func TestSecretsManager(t *testing.T) {
 									"my-custom": "annotation-from-secret",
 									"template":  "annotation",
 
+									cmapi.SecretTemplateAnnotationsAnnotationKey: "my-custom,template",
+									cmapi.SecretTemplateLabelsAnnotationKey:      "template",
+
 									cmapi.CertificateNameKey:       "test",
 									cmapi.IssuerGroupAnnotationKey: "foo.io",
 									cmapi.IssuerKindAnnotationKey:  "Issuer",
@@ -294,6 +314,83 @@ func TestSecretsManager(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if secret does exist, remove labels and annotations no longer in the secretTemplate but leave those set by other tools": {
+			certificate: baseCertWithSecretTemplate,
+			certificateOptions: controllerpkg.CertificateOptions{
+				EnableOwnerRef: false,
+			},
+			SecretData: SecretData{Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key")},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							Annotations: map[string]string{
+								"removed":    "annotation",
+								"replicator": "annotation",
+
+								cmapi.SecretTemplateAnnotationsAnnotationKey: "removed,template",
+								cmapi.SecretTemplateLabelsAnnotationKey:      "removed,template",
+							},
+							Labels: map[string]string{
+								"removed":    "label",
+								"replicator": "label",
+								"template":   "label",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       []byte("foo"),
+							corev1.TLSPrivateKeyKey: []byte("foo"),
+							cmmeta.TLSCAKey:         []byte("foo"),
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								Annotations: map[string]string{
+									"my-custom":  "annotation-from-secret",
+									"replicator": "annotation",
+									"template":   "annotation",
+
+									cmapi.SecretTemplateAnnotationsAnnotationKey: "my-custom,template",
+									cmapi.SecretTemplateLabelsAnnotationKey:      "template",
+
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+									cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+									cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+									cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+									cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								},
+								Labels: map[string]string{
+									"replicator": "label",
+									"template":   "label",
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       baseCertBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: []byte("test-key"),
+								cmmeta.TLSCAKey:         []byte("test-ca"),
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
 		"if secret does not exist, create new Secret using the secret template": {
 			certificate: baseCertWithSecretTemplate,
 			certificateOptions: controllerpkg.CertificateOptions{
@@ -314,6 +411,9 @@ func TestSecretsManager(t *testing.T) {
 									"template":  "annotation",
 									"my-custom": "annotation-from-secret",
 
+									cmapi.SecretTemplateAnnotationsAnnotationKey: "my-custom,template",
+									cmapi.SecretTemplateLabelsAnnotationKey:      "template",
+
 									cmapi.CertificateNameKey:       "test",
 									cmapi.IssuerGroupAnnotationKey: "foo.io",
 									cmapi.IssuerKindAnnotationKey:  "Issuer",
@@ -400,6 +500,369 @@ func TestSecretsManager(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+
+		"if secret does exist and already matches the intended state, do not update it": {
+			certificate: baseCertBundle.Certificate,
+			certificateOptions: controllerpkg.CertificateOptions{
+				EnableOwnerRef: true,
+			},
+			SecretData: SecretData{Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key")},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							Annotations: map[string]string{
+								"my-custom": "annotation",
+
+								cmapi.CertificateNameKey:       "test",
+								cmapi.IssuerGroupAnnotationKey: "foo.io",
+								cmapi.IssuerKindAnnotationKey:  "Issuer",
+								cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+								cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+							},
+							Labels:          map[string]string{},
+							OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(baseCertBundle.Certificate, certificateGvk)},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       baseCertBundle.CertBytes,
+							corev1.TLSPrivateKeyKey: []byte("test-key"),
+							cmmeta.TLSCAKey:         []byte("test-ca"),
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+			},
+			expectedErr: false,
+		},
+
+		"if additional secret names are set, store the data in each Secret that does not already match": {
+			certificate: gen.CertificateFrom(baseCertBundle.Certificate, gen.SetCertificateAdditionalSecretNames("output-2")),
+			SecretData:  SecretData{Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key")},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							Annotations: map[string]string{
+								cmapi.CertificateNameKey:       "test",
+								cmapi.IssuerGroupAnnotationKey: "foo.io",
+								cmapi.IssuerKindAnnotationKey:  "Issuer",
+								cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+								cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+							},
+							Labels: map[string]string{},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       baseCertBundle.CertBytes,
+							corev1.TLSPrivateKeyKey: []byte("test-key"),
+							cmmeta.TLSCAKey:         []byte("test-ca"),
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output-2",
+								Annotations: map[string]string{
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+									cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+									cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+									cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+									cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								},
+								Labels: map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       baseCertBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: []byte("test-key"),
+								cmmeta.TLSCAKey:         []byte("test-ca"),
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if secret does exist, update existing Secret adding additional output formats and removing stale ones": {
+			certificate: baseCertWithAdditionalOutputFormats,
+			SecretData:  SecretData{Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+						},
+						Data: map[string][]byte{
+							derCertificateSecretKey: []byte("stale"),
+							cmmeta.TLSCAKey:         []byte("stale-ca"),
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								Annotations: map[string]string{
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+									cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+									cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+									cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+									cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								},
+								Labels: map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       baseCertBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: baseCertBundle.PrivateKeyBytes,
+								derPrivateKeySecretKey:  keyDER.Bytes,
+								derCertificateSecretKey: certDER.Bytes,
+								combinedPEMSecretKey:    encodeCombinedPEM(baseCertBundle.PrivateKeyBytes, baseCertBundle.CertBytes),
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if additional output formats are removed from the spec, remove them from the Secret": {
+			certificate: baseCertBundle.Certificate,
+			SecretData:  SecretData{Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+						},
+						Data: map[string][]byte{
+							derPrivateKeySecretKey:  []byte("stale"),
+							derCertificateSecretKey: []byte("stale"),
+							combinedPEMSecretKey:    []byte("stale"),
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								Annotations: map[string]string{
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+									cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+									cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+									cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+									cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								},
+								Labels: map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       baseCertBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: baseCertBundle.PrivateKeyBytes,
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if the certificate in the Secret changes, remove the stale OCSP staple": {
+			certificate: baseCertBundle.Certificate,
+			SecretData:  SecretData{Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       []byte("old"),
+							corev1.TLSPrivateKeyKey: baseCertBundle.PrivateKeyBytes,
+							cmmeta.TLSOCSPStapleKey: []byte("stale"),
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								Annotations: map[string]string{
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+									cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+									cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+									cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+									cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								},
+								Labels: map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       baseCertBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: baseCertBundle.PrivateKeyBytes,
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if secret does not exist, create new Secret with both standard and custom data keys": {
+			certificate: baseCertWithSecretTemplateKeys,
+			SecretData:  SecretData{Certificate: baseCertBundle.CertBytes, PrivateKey: []byte("test-key")},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								Annotations: map[string]string{
+									cmapi.SecretTemplateKeysAnnotationKey: "ca.pem,server.crt,server.key",
+
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+									cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+									cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+									cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+									cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								},
+								Labels: map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       baseCertBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: []byte("test-key"),
+								"server.crt":            baseCertBundle.CertBytes,
+								"server.key":            []byte("test-key"),
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if secret does exist, remove custom data keys no longer in the secretTemplate but leave those set by other tools": {
+			certificate: baseCertWithSecretTemplateKeys,
+			SecretData:  SecretData{Certificate: baseCertBundle.CertBytes, PrivateKey: []byte("test-key")},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							Annotations: map[string]string{
+								cmapi.SecretTemplateKeysAnnotationKey: "removed.crt,server.crt",
+							},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       []byte("foo"),
+							corev1.TLSPrivateKeyKey: []byte("foo"),
+							"removed.crt":           []byte("foo"),
+							"server.crt":            []byte("foo"),
+							"replicator.crt":        []byte("foo"),
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								Annotations: map[string]string{
+									cmapi.SecretTemplateKeysAnnotationKey: "ca.pem,server.crt,server.key",
+
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+									cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+									cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+									cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+									cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								},
+								Labels: map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       baseCertBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: []byte("test-key"),
+								"server.crt":            baseCertBundle.CertBytes,
+								"server.key":            []byte("test-key"),
+								"replicator.crt":        []byte("foo"),
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
 	}
 
 	// TODO: add to these tests once the JKS/PKCS12 support is updated
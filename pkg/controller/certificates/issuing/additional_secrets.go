@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"bytes"
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/secretsmanager"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+// replicatedSecretKeys are the keys of the Secret named by spec.secretName
+// which must be identical in each of the Secrets named by
+// spec.additionalSecretNames.
+var replicatedSecretKeys = []string{
+	corev1.TLSCertKey,
+	corev1.TLSPrivateKeyKey,
+	cmmeta.TLSCAKey,
+}
+
+// ensureAdditionalSecrets will copy the certificate and private key stored in
+// the Secret named by spec.secretName into the Secrets named by
+// spec.additionalSecretNames if any of them does not exist yet or holds
+// different data, for example because it was added to the Certificate after
+// the last issuance or was modified by something other than cert-manager.
+func (c *controller) ensureAdditionalSecrets(ctx context.Context, crt *cmapi.Certificate) error {
+	if len(crt.Spec.AdditionalSecretNames) == 0 {
+		return nil
+	}
+
+	secret, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		// Nothing has been issued yet, the additional Secrets will be
+		// populated on issuance.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(secret.Data[corev1.TLSCertKey]) == 0 || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		return nil
+	}
+
+	inSync, err := c.additionalSecretsInSync(crt, secret)
+	if err != nil || inSync {
+		return err
+	}
+
+	logf.FromContext(ctx).V(logf.DebugLevel).Info("copying issued certificate into additional secrets", "secrets", crt.Spec.AdditionalSecretNames)
+	return c.secretStore.UpdateData(ctx, crt, secretsmanager.SecretData{
+		PrivateKey:  secret.Data[corev1.TLSPrivateKeyKey],
+		Certificate: secret.Data[corev1.TLSCertKey],
+		CA:          secret.Data[cmmeta.TLSCAKey],
+		IssuerRef:   secretIssuerRef(secret),
+	})
+}
+
+// additionalSecretsInSync returns true if all Secrets named by
+// spec.additionalSecretNames hold the same data as the given Secret.
+func (c *controller) additionalSecretsInSync(crt *cmapi.Certificate, secret *corev1.Secret) (bool, error) {
+	for _, name := range crt.Spec.AdditionalSecretNames {
+		additional, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(name)
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		for _, key := range replicatedSecretKeys {
+			if !bytes.Equal(additional.Data[key], secret.Data[key]) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// secretIssuerRef returns the issuer recorded on the Secret by the issuing
+// controller, or nil if none is recorded.
+func secretIssuerRef(secret *corev1.Secret) *cmmeta.ObjectReference {
+	name := secret.Annotations[cmapi.IssuerNameAnnotationKey]
+	if name == "" {
+		return nil
+	}
+	return &cmmeta.ObjectReference{
+		Name:  name,
+		Kind:  secret.Annotations[cmapi.IssuerKindAnnotationKey],
+		Group: secret.Annotations[cmapi.IssuerGroupAnnotationKey],
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/secretsmanager"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+// holdCanary stores the given data in the canary Secret of the Certificate,
+// rather than in its Secrets, if the Certificate has opted in to canary
+// issuance and the data was issued by an issuer other than the one which
+// issued the certificate currently stored. It returns true if the data must
+// not be stored in the Certificate's Secrets yet, because the canary has not
+// been verified.
+func (c *controller) holdCanary(ctx context.Context, crt *cmapi.Certificate, req *cmapi.CertificateRequest, data secretsmanager.SecretData) (bool, error) {
+	if !certificates.IssuerChangeCanary(crt) {
+		return false, nil
+	}
+
+	namespace := apiutil.CertificateSecretNamespace(crt)
+	secret, err := c.secretLister.Secrets(namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		// Nothing is served yet, so there is nothing to protect.
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	current := secretIssuerRef(secret)
+	if len(secret.Data[corev1.TLSCertKey]) == 0 || current == nil || certificates.IssuerRefsEqual(*current, req.Spec.IssuerRef) {
+		return false, nil
+	}
+
+	canaryName := certificates.CanarySecretName(crt)
+	canary, err := c.secretLister.Secrets(namespace).Get(canaryName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	canaryUpToDate := canary != nil && bytes.Equal(canary.Data[corev1.TLSCertKey], data.Certificate)
+	cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionCanaryVerified)
+	if canaryUpToDate && cond != nil && cond.Status == cmmeta.ConditionTrue {
+		return false, nil
+	}
+
+	log := logf.FromContext(ctx).WithValues("canary_secret", canaryName)
+	canaryCrt := crt.DeepCopy()
+	canaryCrt.Spec.SecretName = canaryName
+	canaryCrt.Spec.AdditionalSecretNames = nil
+	if err := c.secretStore.UpdateData(ctx, canaryCrt, data); err != nil {
+		return false, err
+	}
+
+	// A verification of a previous canary does not apply to a new one.
+	if canaryUpToDate && cond != nil && cond.Status == cmmeta.ConditionFalse {
+		log.V(logf.DebugLevel).Info("canary certificate awaiting verification")
+		return true, nil
+	}
+
+	message := fmt.Sprintf("Certificate issued by %s %q has been stored in Secret %q and must be verified before it is promoted to Secret %q",
+		apiutil.IssuerKind(req.Spec.IssuerRef), req.Spec.IssuerRef.Name, canaryName, crt.Spec.SecretName)
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionCanaryVerified, cmmeta.ConditionFalse, "Pending", message)
+	if _, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+	log.V(logf.InfoLevel).Info("stored canary certificate, awaiting verification")
+	c.recorder.Event(crt, corev1.EventTypeNormal, events.ReasonCanaryIssued, message)
+
+	return true, nil
+}
+
+// removeCanary deletes the canary Secret of the Certificate once the
+// certificate it held has been promoted, and removes the CanaryVerified
+// condition from the given Certificate. It returns true if a canary was
+// promoted.
+func (c *controller) removeCanary(ctx context.Context, crt *cmapi.Certificate) (bool, error) {
+	cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionCanaryVerified)
+	promoted := cond != nil && cond.Status == cmmeta.ConditionTrue
+	apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionCanaryVerified)
+	if cond == nil && !certificates.IssuerChangeCanary(crt) {
+		return false, nil
+	}
+
+	canaryName := certificates.CanarySecretName(crt)
+	for _, name := range apiutil.CertificateSecretNames(crt) {
+		if name == canaryName {
+			return promoted, nil
+		}
+	}
+	namespace := apiutil.CertificateSecretNamespace(crt)
+	canary, err := c.secretLister.Secrets(namespace).Get(canaryName)
+	if apierrors.IsNotFound(err) {
+		return promoted, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	// Only delete Secrets which were written for this Certificate.
+	if canary.Annotations[cmapi.CertificateNameKey] != crt.Name {
+		return promoted, nil
+	}
+
+	err = c.kubeClient.CoreV1().Secrets(namespace).Delete(ctx, canaryName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	return promoted, nil
+}
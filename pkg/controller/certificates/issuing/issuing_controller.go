@@ -19,7 +19,10 @@ package issuing
 import (
 	"context"
 	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -43,8 +46,13 @@ import (
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/chain"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/secretsmanager"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/scheduler"
+	"github.com/jetstack/cert-manager/pkg/tracing"
 	utilkube "github.com/jetstack/cert-manager/pkg/util/kube"
 	utilpki "github.com/jetstack/cert-manager/pkg/util/pki"
 	"github.com/jetstack/cert-manager/pkg/util/predicate"
@@ -52,6 +60,10 @@ import (
 
 const (
 	ControllerName = "certificates-issuing"
+
+	// maxIssuanceHistory is the number of issuance attempts kept in the
+	// issuance history of a Certificate.
+	maxIssuanceHistory = 10
 )
 
 type localTemporarySignerFn func(crt *cmapi.Certificate, pk []byte) ([]byte, error)
@@ -63,15 +75,25 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             corelisters.SecretLister
+	secretAccessGrantLister  cmlisters.SecretAccessGrantLister
+	issuerHelper             issuer.Helper
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
+	// scheduledWorkQueue is used to re-process Certificates once the
+	// issuance deadline of a pending CertificateRequest has passed
+	scheduledWorkQueue scheduler.ScheduledWorkQueue
 
 	client cmclient.Interface
+	// kubeClient is used to delete canary Secrets once they are promoted
+	kubeClient kubernetes.Interface
 
-	// secretManager is used to create and update Secrets with certificate and key data
-	secretsManager *secretsmanager.SecretsManager
+	// secretStore is used to store the issued certificate and key data
+	secretStore secretsmanager.SecretStore
 	// localTemporarySigner signs a certificate that is stored temporarily
 	localTemporarySigner localTemporarySignerFn
+	// chainFetcher builds the certificate chain of Certificates that
+	// configure `spec.caChain`
+	chainFetcher *chain.Fetcher
 }
 
 func NewController(
@@ -83,6 +105,7 @@ func NewController(
 	recorder record.EventRecorder,
 	clock clock.Clock,
 	certificateControllerOptions controllerpkg.CertificateOptions,
+	namespace string,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
 
 	// create a queue used to queue up items to be processed
@@ -92,6 +115,8 @@ func NewController(
 	certificateInformer := cmFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := factory.Core().V1().Secrets()
+	secretAccessGrantInformer := cmFactory.Certmanager().V1().SecretAccessGrants()
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
 
 	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
 	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
@@ -105,37 +130,66 @@ func NewController(
 	})
 	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
 		// Issuer reconciles on changes to the Secret named `spec.secretName`
-		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
 			predicate.ExtractResourceName(predicate.CertificateSecretName)),
 	})
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		// Issuer reconciles on changes to the Secrets named in `spec.additionalSecretNames`
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateAdditionalSecretName)),
+	})
+	secretAccessGrantInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		// Issuer reconciles Certificates which store their Secrets in the
+		// namespace of a SecretAccessGrant when the grant changes
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything()),
+	})
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
+		secretAccessGrantInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
 	}
 
-	secretsManager := secretsmanager.New(
+	// Issuers are read to apply their certificate defaults. ClusterIssuers
+	// can only be read when not scoped to a single namespace.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if namespace == "" {
+		clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	var secretStore secretsmanager.SecretStore = secretsmanager.New(
 		kubeClient,
 		secretsInformer.Lister(),
 		certificateControllerOptions.EnableOwnerRef,
 	)
+	if certificateControllerOptions.SecretStore != nil {
+		secretStore = secretsmanager.WithExternalStore(secretStore, certificateControllerOptions.SecretStore)
+	}
 
 	return &controller{
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		secretAccessGrantLister:  secretAccessGrantInformer.Lister(),
+		issuerHelper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
 		client:                   client,
+		kubeClient:               kubeClient,
 		recorder:                 recorder,
 		clock:                    clock,
-		secretsManager:           secretsManager,
+		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		secretStore:              secretStore,
 		localTemporarySigner:     certificates.GenerateLocallySignedTemporaryCertificate,
+		chainFetcher:             chain.NewFetcher(certificateControllerOptions.AIAFetchAllowedHosts, certificateControllerOptions.AIAFetchCacheTTL, clock),
 	}, queue, mustSync
 }
 
-func (c *controller) ProcessItem(ctx context.Context, key string) error {
+func (c *controller) ProcessItem(ctx context.Context, key string) (err error) {
 	// Set context deadline for full sync in 10 seconds
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	defer cancel()
@@ -156,15 +210,37 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
-	log = logf.WithResource(log, crt)
+	log = logf.WithCertificate(logf.WithResource(log, crt), crt)
 	ctx = logf.NewContext(ctx, log)
 
+	ctx, span := tracing.Start(tracing.CertificateContext(ctx, crt), ControllerName, cmapi.CertificateKind, crt)
+	defer func() { tracing.End(span, err) }()
+
+	if certificates.IssuancePaused(crt) {
+		log.V(logf.DebugLevel).Info("issuance is paused for certificate, skipping")
+		return nil
+	}
+
+	// The Certificate is reconciled again when a SecretAccessGrant changes,
+	// so there is nothing to retry if the Secrets may not be written.
+	permitted, err := c.secretAccessPermitted(crt)
+	if err != nil {
+		return err
+	}
+	if !permitted {
+		log.V(logf.DebugLevel).Info("no SecretAccessGrant permits writing to the Secrets of the certificate, skipping")
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonSecretAccessDenied,
+			"No SecretAccessGrant in namespace %q permits this Certificate to write to Secrets %v", crt.Spec.SecretNamespace, apiutil.CertificateSecretNames(crt))
+		return nil
+	}
+
 	if !apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
 	}) {
-		// Do nothing if an issuance is not in progress.
-		return nil
+		// If an issuance is not in progress, only keep the additional
+		// Secrets in sync with the issued certificate.
+		return c.ensureAdditionalSecrets(ctx, crt)
 	}
 
 	if crt.Status.NextPrivateKeySecretName == nil ||
@@ -227,7 +303,8 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 	// Verify the CSR options match what is requested in certificate.spec.
 	// If there are violations in the spec, then the requestmanager will handle this.
-	requestViolations, err := certificates.RequestMatchesSpec(req, crt.Spec)
+	effective, _ := certificates.WithIssuerDefaults(c.issuerHelper, crt)
+	requestViolations, err := certificates.RequestMatchesSpec(req, effective.Spec)
 	if err != nil {
 		return err
 	}
@@ -249,7 +326,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	cond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady)
 	if cond == nil {
 		if apiutil.CertificateRequestIsDenied(req) {
-			return c.failIssueCertificate(ctx, log, crt, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionDenied))
+			return c.failIssueCertificate(ctx, log, crt, req, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionDenied))
+		}
+
+		if c.issuanceDeadlineExceeded(key, crt, req) {
+			return c.failIssuanceDeadline(ctx, log, crt, req)
 		}
 
 		log.V(logf.DebugLevel).Info("CertificateRequest does not have Ready condition, waiting...")
@@ -259,7 +340,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	// If the certificate request has failed, set the last failure time to now,
 	// and set the Issuing status condition to False with reason.
 	if cond.Reason == cmapi.CertificateRequestReasonFailed {
-		return c.failIssueCertificate(ctx, log, crt, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady))
+		return c.failIssueCertificate(ctx, log, crt, req, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady))
 	}
 
 	// If public key does not match, do nothing (requestmanager will handle this).
@@ -289,17 +370,64 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	if c.issuanceDeadlineExceeded(key, crt, req) {
+		return c.failIssuanceDeadline(ctx, log, crt, req)
+	}
+
 	// CertificateRequest is not in a final state so do nothing.
 	log.V(logf.DebugLevel).Info("CertificateRequest not in final state, waiting...", "reason", cond.Reason)
 	return nil
 }
 
+// issuanceDeadlineExceeded returns true if the CertificateRequest was created
+// longer ago than the Certificate's issuance deadline. If the deadline has not
+// yet been exceeded, the Certificate is scheduled to be processed again once it
+// has.
+func (c *controller) issuanceDeadlineExceeded(key string, crt *cmapi.Certificate, req *cmapi.CertificateRequest) bool {
+	if crt.Spec.IssuanceDeadline == nil {
+		return false
+	}
+
+	remaining := req.CreationTimestamp.Add(crt.Spec.IssuanceDeadline.Duration).Sub(c.clock.Now())
+	if remaining > 0 {
+		c.scheduledWorkQueue.Add(key, remaining)
+		return false
+	}
+
+	return true
+}
+
+// failIssuanceDeadline deletes a CertificateRequest which has exceeded the
+// Certificate's issuance deadline and marks the issuance as failed, so that it
+// will be retried with a new CertificateRequest after the usual backoff.
+func (c *controller) failIssuanceDeadline(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, req *cmapi.CertificateRequest) error {
+	// Delete the request first, so that it is not picked up again by the next
+	// issuance of the same revision.
+	err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return c.failIssueCertificate(ctx, log, crt, req, &cmapi.CertificateRequestCondition{
+		Reason:  events.ReasonIssuanceDeadlineExceeded,
+		Message: fmt.Sprintf("CertificateRequest %q was not ready within the issuance deadline of %s", req.Name, crt.Spec.IssuanceDeadline.Duration),
+	})
+}
+
 // failIssueCertificate will mark the Issuing condition of this Certificate as
 // failed, and log an appropriate event. The reason and message of the
 // condition will be that of the CertificateRequest condition passed.
-func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, condition *cmapi.CertificateRequestCondition) error {
+func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, req *cmapi.CertificateRequest, condition *cmapi.CertificateRequestCondition) error {
+	crt = crt.DeepCopy()
 	nowTime := metav1.NewTime(c.clock.Now())
 	crt.Status.LastFailureTime = &nowTime
+	recordIssuanceAttempt(crt, req, cmapi.CertificateIssuanceFailed, fmt.Sprintf("%s: %s", condition.Reason, condition.Message), nowTime)
+
+	failedIssuanceAttempts := 1
+	if crt.Status.FailedIssuanceAttempts != nil {
+		failedIssuanceAttempts = *crt.Status.FailedIssuanceAttempts + 1
+	}
+	crt.Status.FailedIssuanceAttempts = &failedIssuanceAttempts
 
 	log.V(logf.DebugLevel).Info("CertificateRequest in failed state so retrying issuance later")
 
@@ -308,7 +436,6 @@ func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger,
 	message = fmt.Sprintf("The certificate request has failed to complete and will be retried: %s",
 		condition.Message)
 
-	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionFalse, reason, message)
 
 	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
@@ -338,21 +465,57 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		PrivateKey:  pkData,
 		Certificate: req.Status.Certificate,
 		CA:          req.Status.CA,
+		IssuerRef:   &req.Spec.IssuerRef,
 	}
 
-	err = c.secretsManager.UpdateData(ctx, crt, secretData)
+	if crt.Spec.CAChain != nil {
+		secretData.Certificate, secretData.CA, err = c.composeChain(ctx, crt, req)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Certificates opting in to canary issuance only store a certificate
+	// from a new issuer once it has been verified in the canary Secret.
+	held, err := c.holdCanary(ctx, crt, req, secretData)
+	if err != nil || held {
+		return err
+	}
+
+	secretCtx, span := tracing.Start(ctx, "write-secret", cmapi.CertificateKind, crt)
+	err = c.secretStore.UpdateData(secretCtx, crt, secretData)
+	tracing.End(span, err)
+	if err != nil {
+		return err
+	}
+	promoted, err := c.removeCanary(ctx, crt)
 	if err != nil {
 		return err
 	}
 
 	//Set status.revision to revision of the CertificateRequest
 	crt.Status.Revision = &nextRevision
+	crt.Status.ActiveRequestName = req.Name
+	crt.Status.LastIssuanceDetails = issuanceDetails(req)
+	recordIssuanceAttempt(crt, req, cmapi.CertificateIssuanceSucceeded, "", metav1.NewTime(c.clock.Now()))
 
 	// Remove Issuing status condition
 	apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionIssuing)
 
+	// Record whether the issuer honoured the spec, rather than silently
+	// storing whatever it returned.
+	effective, _ := certificates.WithIssuerDefaults(c.issuerHelper, crt)
+	discrepancies := issuedCertificateDiscrepancies(effective, req)
+	if len(discrepancies) > 0 {
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuedWithWarnings, cmmeta.ConditionTrue,
+			events.ReasonIssuedWithWarnings, fmt.Sprintf("The issued certificate does not match the requested %s", strings.Join(discrepancies, ", ")))
+	} else {
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionIssuedWithWarnings)
+	}
+
 	//Clear status.lastFailureTime (if set)
 	crt.Status.LastFailureTime = nil
+	crt.Status.FailedIssuanceAttempts = nil
 
 	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
 	if err != nil {
@@ -360,11 +523,100 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 	}
 
 	message := "The certificate has been successfully issued"
-	c.recorder.Event(crt, corev1.EventTypeNormal, "Issuing", message)
+	c.recorder.Event(crt, corev1.EventTypeNormal, events.ReasonIssuing, message)
+	if promoted {
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonCanaryPromoted,
+			"The verified canary certificate has been promoted to Secret %q", crt.Spec.SecretName)
+	}
+	if len(discrepancies) > 0 {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonIssuedWithWarnings,
+			"The issued certificate does not match the requested %s", strings.Join(discrepancies, ", "))
+	}
 
 	return nil
 }
 
+// issuanceDetails describes the certificate stored from the given
+// CertificateRequest. Details which cannot be read from the certificate are
+// left unset.
+func issuanceDetails(req *cmapi.CertificateRequest) *cmapi.CertificateIssuanceDetails {
+	details := &cmapi.CertificateIssuanceDetails{
+		IssuerRef: req.Spec.IssuerRef,
+	}
+	x509cert, err := utilpki.DecodeX509CertificateBytes(req.Status.Certificate)
+	if err != nil {
+		return details
+	}
+	sum := sha256.Sum256(x509cert.Raw)
+	details.Duration = &metav1.Duration{Duration: x509cert.NotAfter.Sub(x509cert.NotBefore)}
+	details.SerialNumber = x509cert.SerialNumber.String()
+	details.Fingerprint = hex.EncodeToString(sum[:])
+	return details
+}
+
+// recordIssuanceAttempt appends an attempt of the given CertificateRequest to
+// the issuance history of the Certificate, dropping the oldest attempts once
+// more than maxIssuanceHistory are recorded. The reason of the attempt is read
+// from the Issuing condition, so this must be called before it is updated.
+func recordIssuanceAttempt(crt *cmapi.Certificate, req *cmapi.CertificateRequest, outcome cmapi.CertificateIssuanceOutcome, message string, now metav1.Time) {
+	attempt := cmapi.CertificateIssuanceAttempt{
+		Time:        now,
+		Outcome:     outcome,
+		Message:     message,
+		RequestName: req.Name,
+	}
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil {
+		attempt.Reason = cond.Reason
+	}
+
+	history := append(crt.Status.IssuanceHistory, attempt)
+	if len(history) > maxIssuanceHistory {
+		history = history[len(history)-maxIssuanceHistory:]
+	}
+	crt.Status.IssuanceHistory = history
+}
+
+// issuedCertificateDiscrepancies returns the fields of the Certificate's spec
+// which are not honoured by the certificate returned in the
+// CertificateRequest.
+func issuedCertificateDiscrepancies(crt *cmapi.Certificate, req *cmapi.CertificateRequest) []string {
+	x509cert, err := utilpki.DecodeX509CertificateBytes(req.Status.Certificate)
+	if err != nil {
+		return []string{"certificate, as it could not be decoded: " + err.Error()}
+	}
+	return certificates.IssuedCertificateMatchesSpec(x509cert, crt.Spec)
+}
+
+// composeChain returns the certificate and CA data to store in the Secret of
+// a Certificate which configures `spec.caChain`. If missing certificates of
+// the chain cannot be fetched, the chain returned by the issuer is used and a
+// warning event is recorded.
+func (c *controller) composeChain(ctx context.Context, crt *cmapi.Certificate, req *cmapi.CertificateRequest) ([]byte, []byte, error) {
+	certData, caData := req.Status.Certificate, req.Status.CA
+
+	certChain, err := c.chainFetcher.Build(ctx, req.Status.Certificate, req.Status.CA, crt.Spec.CAChain.CompleteChain)
+	if certChain == nil {
+		return nil, nil, fmt.Errorf("failed to build certificate chain: %w", err)
+	}
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to complete certificate chain")
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonChainIncomplete, "Failed to complete certificate chain: %v", err)
+	}
+
+	composedCert, composedCA, err := chain.Compose(certChain, crt.Spec.CAChain.Composition)
+	if err != nil {
+		return nil, nil, err
+	}
+	if crt.Spec.CAChain.CompleteChain {
+		certData = composedCert
+	}
+	if crt.Spec.CAChain.Composition != "" && crt.Spec.CAChain.Composition != cmapi.CAChainCompositionIssuerProvided {
+		caData = composedCA
+	}
+
+	return certData, caData, nil
+}
+
 // controllerWrapper wraps the `controller` structure to make it implement
 // the controllerpkg.queueingController interface
 type controllerWrapper struct {
@@ -383,6 +635,7 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		ctx.Recorder,
 		ctx.Clock,
 		ctx.CertificateOptions,
+		ctx.Namespace,
 	)
 	c.controller = ctrl
 
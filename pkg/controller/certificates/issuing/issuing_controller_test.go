@@ -18,6 +18,8 @@ package issuing
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
@@ -82,6 +84,79 @@ func TestIssuingController(t *testing.T) {
 
 	metaFixedClockStart := metav1.NewTime(fixedClockStart)
 
+	succeededAttempt := cmapi.CertificateIssuanceAttempt{
+		Time:        metaFixedClockStart,
+		Outcome:     cmapi.CertificateIssuanceSucceeded,
+		RequestName: exampleBundle.CertificateRequestReady.Name,
+	}
+	failedAttempt := func(reason, message string) cmapi.CertificateIssuanceAttempt {
+		return cmapi.CertificateIssuanceAttempt{
+			Time:        metaFixedClockStart,
+			Outcome:     cmapi.CertificateIssuanceFailed,
+			Message:     reason + ": " + message,
+			RequestName: exampleBundle.CertificateRequestReady.Name,
+		}
+	}
+
+	fallbackIssuerRef := cmmeta.ObjectReference{Name: "fallback-issuer", Kind: "ClusterIssuer", Group: "foo.io"}
+
+	exampleFingerprint := sha256.Sum256(exampleBundle.Cert.Raw)
+	exampleIssuanceDetails := func(issuerRef cmmeta.ObjectReference) *cmapi.CertificateIssuanceDetails {
+		return &cmapi.CertificateIssuanceDetails{
+			IssuerRef:    issuerRef,
+			Duration:     &metav1.Duration{Duration: exampleBundle.Cert.NotAfter.Sub(exampleBundle.Cert.NotBefore)},
+			SerialNumber: exampleBundle.Cert.SerialNumber.String(),
+			Fingerprint:  hex.EncodeToString(exampleFingerprint[:]),
+		}
+	}
+
+	exampleSecretAnnotations := map[string]string{
+		cmapi.CertificateNameKey:       "test",
+		cmapi.IssuerKindAnnotationKey:  "Issuer",
+		cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+		cmapi.IssuerGroupAnnotationKey: "foo.io",
+		cmapi.CommonNameAnnotationKey:  "",
+		cmapi.AltNamesAnnotationKey:    "example.com",
+		cmapi.IPSANAnnotationKey:       "",
+		cmapi.URISANAnnotationKey:      "",
+	}
+
+	canaryAnnotation := gen.AddCertificateAnnotations(map[string]string{cmapi.IssuerChangeCanaryAnnotationKey: "true"})
+	oldIssuerSecretAnnotations := map[string]string{}
+	for k, v := range exampleSecretAnnotations {
+		oldIssuerSecretAnnotations[k] = v
+	}
+	oldIssuerSecretAnnotations[cmapi.IssuerNameAnnotationKey] = "old-issuer"
+	oldIssuerSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   exampleBundle.Certificate.Namespace,
+			Name:        "output",
+			Annotations: oldIssuerSecretAnnotations,
+			Labels:      map[string]string{},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       exampleBundleAlt.CertBytes,
+			corev1.TLSPrivateKeyKey: exampleBundleAlt.PrivateKeyBytes,
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+	issuedSecret := func(name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   exampleBundle.Certificate.Namespace,
+				Name:        name,
+				Annotations: exampleSecretAnnotations,
+				Labels:      map[string]string{},
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       exampleBundle.CertificateRequestReady.Status.Certificate,
+				corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+			},
+			Type: corev1.SecretTypeTLS,
+		}
+	}
+	canaryMessage := `Certificate issued by Issuer "ca-issuer" has been stored in Secret "output-next" and must be verified before it is promoted to Secret "output"`
+
 	tests := map[string]testT{
 		"if certificate is not in Issuing state, then do nothing": {
 			certificate: exampleBundle.Certificate,
@@ -95,6 +170,87 @@ func TestIssuingController(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if certificate is not in Issuing state and an additional Secret does not exist, copy the issued certificate into it": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(baseCert, gen.SetCertificateAdditionalSecretNames("output-2")),
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:   exampleBundle.Certificate.Namespace,
+							Name:        "output",
+							Annotations: exampleSecretAnnotations,
+							Labels:      map[string]string{},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       exampleBundle.CertBytes,
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						exampleBundle.Certificate.Namespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace:   exampleBundle.Certificate.Namespace,
+								Name:        "output-2",
+								Annotations: exampleSecretAnnotations,
+								Labels:      map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       exampleBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is not in Issuing state and the additional Secrets hold the issued certificate, then do nothing": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(baseCert, gen.SetCertificateAdditionalSecretNames("output-2")),
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:   exampleBundle.Certificate.Namespace,
+							Name:        "output",
+							Annotations: exampleSecretAnnotations,
+							Labels:      map[string]string{},
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       exampleBundle.CertBytes,
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: exampleBundle.Certificate.Namespace,
+							Name:      "output-2",
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       exampleBundle.CertBytes,
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+			},
+			expectedErr: false,
+		},
+
 		"if certificate is an Issuing state but is set to False, then do nothing": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -177,6 +333,98 @@ func TestIssuingController(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if certificate is in Issuing state, one CertificateRequest not in final state within the issuance deadline, do nothing": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateIssuanceDeadline(time.Minute*10)),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+						gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(fixedClockStart.Add(-time.Minute*5))),
+						gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+							Type:   cmapi.CertificateRequestConditionReady,
+							Status: cmmeta.ConditionFalse,
+							Reason: cmapi.CertificateRequestReasonPending,
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequest not in final state past the issuance deadline, delete it and set failed state": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateIssuanceDeadline(time.Minute*10)),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+						gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(fixedClockStart.Add(-time.Minute*15))),
+						gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+							Type:   cmapi.CertificateRequestConditionReady,
+							Status: cmmeta.ConditionFalse,
+							Reason: cmapi.CertificateRequestReasonPending,
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						exampleBundle.CertificateRequestReady.Namespace,
+						exampleBundle.CertificateRequestReady.Name,
+					)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateIssuanceDeadline(time.Minute*10),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionIssuing,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "IssuanceDeadlineExceeded",
+								Message:            fmt.Sprintf("The certificate request has failed to complete and will be retried: CertificateRequest %q was not ready within the issuance deadline of 10m0s", exampleBundle.CertificateRequestReady.Name),
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+							gen.SetCertificateIssuanceHistory(failedAttempt("IssuanceDeadlineExceeded", fmt.Sprintf("CertificateRequest %q was not ready within the issuance deadline of 10m0s", exampleBundle.CertificateRequestReady.Name))),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					fmt.Sprintf("Warning IssuanceDeadlineExceeded The certificate request has failed to complete and will be retried: CertificateRequest %q was not ready within the issuance deadline of 10m0s", exampleBundle.CertificateRequestReady.Name),
+				},
+			},
+			expectedErr: false,
+		},
+
 		"if certificate is in Issuing state, one CertificateRequest, but has failed and does not match the certificate spec, do nothing": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -256,6 +504,8 @@ func TestIssuingController(t *testing.T) {
 								ObservedGeneration: 3,
 							}),
 							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+							gen.SetCertificateIssuanceHistory(failedAttempt("Failed", "The certificate request failed because of reasons")),
 						),
 					)),
 				},
@@ -411,6 +661,9 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificateActiveRequestName(exampleBundle.CertificateRequestReady.Name),
+							gen.SetCertificateLastIssuanceDetails(exampleIssuanceDetails(exampleBundle.CertificateRequestReady.Spec.IssuerRef)),
+							gen.SetCertificateIssuanceHistory(succeededAttempt),
 						),
 					)),
 					testpkg.NewAction(coretesting.NewCreateAction(
@@ -447,6 +700,258 @@ func TestIssuingController(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if certificate is in Issuing state and targets a Secret in another namespace without a SecretAccessGrant, do nothing and log an event": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateSecretNamespace("other")),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					),
+					&cmapi.SecretAccessGrant{
+						ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "other"},
+						Spec: cmapi.SecretAccessGrantSpec{
+							From: []cmapi.SecretAccessGrantFrom{{Namespace: "elsewhere"}},
+						},
+					},
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+				ExpectedEvents: []string{
+					`Warning SecretAccessDenied No SecretAccessGrant in namespace "other" permits this Certificate to write to Secrets [output]`,
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state and targets a Secret in another namespace permitted by a SecretAccessGrant, store the signed certificate in that namespace": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateSecretNamespace("other")),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					),
+					&cmapi.SecretAccessGrant{
+						ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "other"},
+						Spec: cmapi.SecretAccessGrantSpec{
+							From:        []cmapi.SecretAccessGrantFrom{{Namespace: exampleBundle.Certificate.Namespace, CertificateNames: []string{"test"}}},
+							SecretNames: []string{"output"},
+						},
+					},
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateSecretNamespace("other"),
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateActiveRequestName(exampleBundle.CertificateRequestReady.Name),
+							gen.SetCertificateLastIssuanceDetails(exampleIssuanceDetails(exampleBundle.CertificateRequestReady.Spec.IssuerRef)),
+							gen.SetCertificateIssuanceHistory(succeededAttempt),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						"other",
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace:   "other",
+								Name:        "output",
+								Annotations: exampleSecretAnnotations,
+								Labels:      map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       exampleBundle.CertificateRequestReady.Status.Certificate,
+								corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequest, and is ready, but the issued certificate does not honour the requested duration, store it and set the IssuedWithWarnings condition": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateDuration(time.Hour*24*30)),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateDuration(time.Hour*24*30),
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateActiveRequestName(exampleBundle.CertificateRequestReady.Name),
+							gen.SetCertificateLastIssuanceDetails(exampleIssuanceDetails(exampleBundle.CertificateRequestReady.Spec.IssuerRef)),
+							gen.SetCertificateIssuanceHistory(succeededAttempt),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionIssuedWithWarnings,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "IssuedWithWarnings",
+								Message:            "The issued certificate does not match the requested spec.duration",
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						exampleBundle.Certificate.Namespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: exampleBundle.Certificate.Namespace,
+								Name:      "output",
+								Annotations: map[string]string{
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.CommonNameAnnotationKey:  "",
+									cmapi.AltNamesAnnotationKey:    "example.com",
+									cmapi.IPSANAnnotationKey:       "",
+									cmapi.URISANAnnotationKey:      "",
+								},
+								Labels: map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       exampleBundle.CertificateRequestReady.Status.Certificate,
+								corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+					"Warning IssuedWithWarnings The issued certificate does not match the requested spec.duration",
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequest from a fallback issuer, and is ready, record the fallback issuer on the secret and reset failed attempts": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificateFallbackIssuers(fallbackIssuerRef),
+						gen.SetCertificateFailedIssuanceAttempts(3),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.SetCertificateRequestIssuer(fallbackIssuerRef),
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateFallbackIssuers(fallbackIssuerRef),
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateActiveRequestName(exampleBundle.CertificateRequestReady.Name),
+							gen.SetCertificateLastIssuanceDetails(exampleIssuanceDetails(fallbackIssuerRef)),
+							gen.SetCertificateIssuanceHistory(succeededAttempt),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						exampleBundle.Certificate.Namespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: exampleBundle.Certificate.Namespace,
+								Name:      "output",
+								Annotations: map[string]string{
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerKindAnnotationKey:  "ClusterIssuer",
+									cmapi.IssuerNameAnnotationKey:  "fallback-issuer",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.CommonNameAnnotationKey:  "",
+									cmapi.AltNamesAnnotationKey:    "example.com",
+									cmapi.IPSANAnnotationKey:       "",
+									cmapi.URISANAnnotationKey:      "",
+								},
+								Labels: map[string]string{},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       exampleBundle.CertificateRequestReady.Status.Certificate,
+								corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expectedErr: false,
+		},
+
 		"if certificate is in Issuing state, one CertificateRequests, and is ready, store the signed certificate, ca, and private key to an existing secret, and log an event": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -486,6 +991,9 @@ func TestIssuingController(t *testing.T) {
 						exampleBundle.Certificate.Namespace,
 						gen.CertificateFrom(exampleBundle.Certificate,
 							gen.SetCertificateRevision(2),
+							gen.SetCertificateActiveRequestName(exampleBundle.CertificateRequestReady.Name),
+							gen.SetCertificateLastIssuanceDetails(exampleIssuanceDetails(exampleBundle.CertificateRequestReady.Spec.IssuerRef)),
+							gen.SetCertificateIssuanceHistory(succeededAttempt),
 						),
 					)),
 					testpkg.NewAction(coretesting.NewUpdateAction(
@@ -523,6 +1031,118 @@ func TestIssuingController(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if certificate opted in to canary issuance is in Issuing state, and the CertificateRequest from a new issuer is ready, store the certificate in the canary secret and await verification": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, canaryAnnotation),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+					oldIssuerSecret,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						exampleBundle.Certificate.Namespace,
+						issuedSecret("output-next"),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(issuingCert, canaryAnnotation,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionCanaryVerified,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Pending",
+								Message:            canaryMessage,
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal CanaryIssued " + canaryMessage,
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate opted in to canary issuance is in Issuing state, and the canary certificate has been verified, promote it to the secret and delete the canary secret": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, canaryAnnotation,
+						gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+							Type:               cmapi.CertificateConditionCanaryVerified,
+							Status:             cmmeta.ConditionTrue,
+							Reason:             "Verified",
+							ObservedGeneration: 3,
+						}),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+					oldIssuerSecret,
+					issuedSecret("output-next"),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						exampleBundle.Certificate.Namespace,
+						issuedSecret("output"),
+					)),
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						exampleBundle.Certificate.Namespace,
+						"output-next",
+					)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate, canaryAnnotation,
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateActiveRequestName(exampleBundle.CertificateRequestReady.Name),
+							gen.SetCertificateLastIssuanceDetails(exampleIssuanceDetails(exampleBundle.CertificateRequestReady.Spec.IssuerRef)),
+							gen.SetCertificateIssuanceHistory(succeededAttempt),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+					`Normal CanaryPromoted The verified canary certificate has been promoted to Secret "output"`,
+				},
+			},
+			expectedErr: false,
+		},
+
 		"if certificate is in Issuing state with temp annotation, one CertificateRequest Pending, no target Secret, create target secret with temporary certificate": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -856,6 +1476,9 @@ func TestIssuingController(t *testing.T) {
 								cmapi.IssueTemporaryCertificateAnnotation: "true",
 							}),
 							gen.SetCertificateRevision(2),
+							gen.SetCertificateActiveRequestName(exampleBundle.CertificateRequestReady.Name),
+							gen.SetCertificateLastIssuanceDetails(exampleIssuanceDetails(exampleBundle.CertificateRequestReady.Spec.IssuerRef)),
+							gen.SetCertificateIssuanceHistory(succeededAttempt),
 						),
 					)),
 					testpkg.NewAction(coretesting.NewCreateAction(
@@ -940,6 +1563,8 @@ func TestIssuingController(t *testing.T) {
 								ObservedGeneration: 3,
 							}),
 							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+							gen.SetCertificateIssuanceHistory(failedAttempt("Failed", "The certificate request failed because of reasons")),
 						),
 					)),
 				},
@@ -991,12 +1616,17 @@ func TestIssuingController(t *testing.T) {
 								ObservedGeneration: 3,
 							}),
 							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateFailedIssuanceAttempts(1),
+							gen.SetCertificateIssuanceHistory(failedAttempt("DeniedReason", "The certificate request has been denied")),
 						),
 					)),
 				},
 				ExpectedEvents: []string{
 					"Warning DeniedReason The certificate request has failed to complete and will be retried: The certificate request has been denied",
 				},
+				// The Denied condition reason is set by the approver, so is
+				// not required to be registered.
+				AllowUnregisteredEventReasons: true,
 			},
 			expectedErr: false,
 		},
@@ -1033,3 +1663,36 @@ func TestIssuingController(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordIssuanceAttempt(t *testing.T) {
+	now := metav1.NewTime(fixedClockStart)
+	req := gen.CertificateRequest("test-1")
+
+	var history []cmapi.CertificateIssuanceAttempt
+	for i := 0; i < maxIssuanceHistory; i++ {
+		history = append(history, cmapi.CertificateIssuanceAttempt{
+			Time:        now,
+			Outcome:     cmapi.CertificateIssuanceFailed,
+			RequestName: fmt.Sprintf("old-%d", i),
+		})
+	}
+	crt := gen.Certificate("test",
+		gen.SetCertificateIssuanceHistory(history...),
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:   cmapi.CertificateConditionIssuing,
+			Status: cmmeta.ConditionTrue,
+			Reason: "Renewing",
+		}),
+	)
+
+	recordIssuanceAttempt(crt, req, cmapi.CertificateIssuanceSucceeded, "", now)
+
+	require.Len(t, crt.Status.IssuanceHistory, maxIssuanceHistory)
+	require.Equal(t, "old-1", crt.Status.IssuanceHistory[0].RequestName, "expected the oldest attempt to be dropped")
+	require.Equal(t, cmapi.CertificateIssuanceAttempt{
+		Time:        now,
+		Reason:      "Renewing",
+		Outcome:     cmapi.CertificateIssuanceSucceeded,
+		RequestName: "test-1",
+	}, crt.Status.IssuanceHistory[maxIssuanceHistory-1])
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// secretAccessPermitted returns true if the controller may write to the
+// Secrets of the Certificate. Secrets in the namespace of the Certificate may
+// always be written; Secrets in another namespace only if a SecretAccessGrant
+// in that namespace permits the Certificate to target all of them.
+func (c *controller) secretAccessPermitted(crt *cmapi.Certificate) (bool, error) {
+	namespace := apiutil.CertificateSecretNamespace(crt)
+	if namespace == crt.Namespace {
+		return true, nil
+	}
+
+	grants, err := c.secretAccessGrantLister.SecretAccessGrants(namespace).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+
+	secretNames := apiutil.CertificateSecretNames(crt)
+	for _, grant := range grants {
+		if apiutil.SecretAccessGrantPermits(grant, crt.Namespace, crt.Name, secretNames) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
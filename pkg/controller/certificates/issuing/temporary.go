@@ -23,9 +23,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/secretsmanager"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/trigger/policies"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	utilpki "github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -53,7 +55,7 @@ func (c *controller) ensureTemporaryCertificate(ctx context.Context, crt *cmapi.
 	}
 
 	// Attempt to fetch the Secret being managed but tolerate NotFound errors.
-	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	secret, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
 	if err != nil && !apierrors.IsNotFound(err) {
 		return false, err
 	}
@@ -77,11 +79,11 @@ func (c *controller) ensureTemporaryCertificate(ctx context.Context, crt *cmapi.
 		Certificate: certData,
 		PrivateKey:  pkData,
 	}
-	if err := c.secretsManager.UpdateData(ctx, crt, secretData); err != nil {
+	if err := c.secretStore.UpdateData(ctx, crt, secretData); err != nil {
 		return false, err
 	}
 
-	c.recorder.Event(crt, corev1.EventTypeNormal, "Issuing", "Issued temporary certificate")
+	c.recorder.Event(crt, corev1.EventTypeNormal, events.ReasonIssuing, "Issued temporary certificate")
 
 	return true, nil
 }
@@ -43,15 +43,17 @@ import (
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	"github.com/jetstack/cert-manager/pkg/feature"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/tracing"
+	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 	"github.com/jetstack/cert-manager/pkg/util/predicate"
 )
 
 const (
-	ControllerName     = "certificates-key-manager"
-	reasonDecodeFailed = "DecodeFailed"
-	reasonDeleted      = "Deleted"
+	ControllerName = "certificates-key-manager"
 )
 
 var (
@@ -64,6 +66,13 @@ type controller struct {
 	client            cmclient.Interface
 	coreClient        kubernetes.Interface
 	recorder          record.EventRecorder
+
+	// externalKeyProviders generate the private keys of Certificates with
+	// an external private key
+	externalKeyProviders map[string]pki.ExternalKeyProvider
+	// kmsPlugins encrypt the data keys of the private keys of Certificates
+	// with sealed private keys
+	kmsPlugins map[string]pki.KMSPlugin
 }
 
 func NewController(
@@ -73,6 +82,8 @@ func NewController(
 	factory informers.SharedInformerFactory,
 	cmFactory cminformers.SharedInformerFactory,
 	recorder record.EventRecorder,
+	externalKeyProviders map[string]pki.ExternalKeyProvider,
+	kmsPlugins map[string]pki.KMSPlugin,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
 	// create a queue used to queue up items to be processed
 	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
@@ -91,10 +102,16 @@ func NewController(
 	})
 	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
 		// Trigger reconciles on changes to certificates named as spec.secretName
-		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
 			predicate.ExtractResourceName(predicate.CertificateSecretName),
 		),
 	})
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		// Trigger reconciles on changes to private keys supplied in spec.privateKey.secretRef
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificatePrivateKeySecretName),
+		),
+	})
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
@@ -109,6 +126,9 @@ func NewController(
 		client:            client,
 		coreClient:        coreClient,
 		recorder:          recorder,
+
+		externalKeyProviders: externalKeyProviders,
+		kmsPlugins:           kmsPlugins,
 	}, queue, mustSync
 }
 
@@ -124,7 +144,7 @@ func init() {
 	isNextPrivateKeyLabelSelector = labels.NewSelector().Add(*r)
 }
 
-func (c *controller) ProcessItem(ctx context.Context, key string) error {
+func (c *controller) ProcessItem(ctx context.Context, key string) (err error) {
 	log := logf.FromContext(ctx).WithValues("key", key)
 	ctx = logf.NewContext(ctx, log)
 
@@ -143,6 +163,12 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	log = logf.WithCertificate(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	ctx, span := tracing.Start(tracing.CertificateContext(ctx, crt), ControllerName, cmapi.CertificateKind, crt)
+	defer func() { tracing.End(span, err) }()
+
 	// Discover all 'owned' secrets that have the `next-private-key` label
 	secrets, err := certificates.ListSecretsMatchingPredicates(c.secretLister.Secrets(crt.Namespace), isNextPrivateKeyLabelSelector, predicate.ResourceOwnedBy(crt))
 	if err != nil {
@@ -162,6 +188,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 	// if there is no existing Secret resource, create a new one
 	if len(secrets) == 0 {
+		if crt.Spec.PrivateKey != nil && crt.Spec.PrivateKey.SecretRef != nil {
+			return c.createNextPrivateKeyFromSecretRef(ctx, crt)
+		}
+
 		rotationPolicy := cmapi.RotationPolicyNever
 		if crt.Spec.PrivateKey != nil && crt.Spec.PrivateKey.RotationPolicy != "" {
 			rotationPolicy = crt.Spec.PrivateKey.RotationPolicy
@@ -216,7 +246,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	}
 	if len(violations) > 0 {
 		log.V(logf.DebugLevel).Info("Regenerating private key due to change in fields", "violations", violations)
-		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonDeleted, "Regenerating private key due to change in fields: %v", violations)
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonDeleted, "Regenerating private key due to change in fields: %v", violations)
 		return c.deleteSecretResources(ctx, secrets)
 	}
 
@@ -225,7 +255,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context, crt *cmapi.Certificate) error {
 	log := logf.FromContext(ctx)
-	s, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	s, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
 	if apierrors.IsNotFound(err) {
 		log.V(logf.DebugLevel).Info("Creating new nextPrivateKeySecretName Secret because no existing Secret found and rotation policy is Never")
 		return c.createAndSetNextPrivateKey(ctx, crt)
@@ -240,45 +270,142 @@ func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context
 	existingPKData := s.Data[corev1.TLSPrivateKeyKey]
 	pk, err := pki.DecodePrivateKeyBytes(existingPKData)
 	if err != nil {
-		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonDecodeFailed, "Failed to decode private key stored in Secret %q - generating new key", crt.Spec.SecretName)
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDecodeFailed, "Failed to decode private key stored in Secret %q - generating new key", crt.Spec.SecretName)
 		return c.createAndSetNextPrivateKey(ctx, crt)
 	}
 	violations, err := certificates.PrivateKeyMatchesSpec(pk, crt.Spec)
 	if err != nil {
-		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonDecodeFailed, "Failed to check if private key stored in Secret %q is up to date - generating new key", crt.Spec.SecretName)
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDecodeFailed, "Failed to check if private key stored in Secret %q is up to date - generating new key", crt.Spec.SecretName)
 		return c.createAndSetNextPrivateKey(ctx, crt)
 	}
 	if len(violations) > 0 {
-		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonDecodeFailed, "Existing private key in Secret %q does not match requirements on Certificate resource, mismatching fields: %v", crt.Spec.SecretName, violations)
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDecodeFailed, "Existing private key in Secret %q does not match requirements on Certificate resource, mismatching fields: %v", crt.Spec.SecretName, violations)
 		return nil
 	}
 
 	nextPkSecret, err := c.createNewPrivateKeySecret(ctx, crt, pk)
+	if err != nil || nextPkSecret == nil {
+		return err
+	}
+
+	c.recorder.Event(crt, corev1.EventTypeNormal, events.ReasonReused, fmt.Sprintf("Reusing private key stored in existing Secret resource %q", s.Name))
+
+	return c.setNextPrivateKeySecretName(ctx, crt, &nextPkSecret.Name)
+}
+
+// createNextPrivateKeyFromSecretRef stores the private key supplied in the
+// Secret referenced by spec.privateKey.secretRef as the next private key. The
+// Certificate is resynced when the Secret changes, so nothing is retried if
+// the key cannot be loaded.
+func (c *controller) createNextPrivateKeyFromSecretRef(ctx context.Context, crt *cmapi.Certificate) error {
+	ref := crt.Spec.PrivateKey.SecretRef
+	key := ref.Key
+	if key == "" {
+		key = corev1.TLSPrivateKeyKey
+	}
+
+	s, err := c.secretLister.Secrets(crt.Namespace).Get(ref.Name)
+	if apierrors.IsNotFound(err) {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonSecretNotFound, "Private key Secret %q does not exist", ref.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if s.Data == nil || len(s.Data[key]) == 0 {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonSecretNotFound, "Private key Secret %q does not contain key %q", ref.Name, key)
+		return nil
+	}
+	pk, err := pki.DecodePrivateKeyBytes(s.Data[key])
 	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonDecodeFailed, "Failed to decode private key stored in Secret %q: %v", ref.Name, err)
+		return nil
+	}
+
+	nextPkSecret, err := c.createNewPrivateKeySecret(ctx, crt, pk)
+	if err != nil || nextPkSecret == nil {
 		return err
 	}
 
-	c.recorder.Event(crt, corev1.EventTypeNormal, "Reused", fmt.Sprintf("Reusing private key stored in existing Secret resource %q", s.Name))
+	c.recorder.Event(crt, corev1.EventTypeNormal, events.ReasonReused, fmt.Sprintf("Using private key supplied in Secret resource %q", ref.Name))
 
 	return c.setNextPrivateKeySecretName(ctx, crt, &nextPkSecret.Name)
 }
 
 func (c *controller) createAndSetNextPrivateKey(ctx context.Context, crt *cmapi.Certificate) error {
-	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
+	pk, err := c.generatePrivateKey(ctx, crt)
 	if err != nil {
 		return err
 	}
+	if pk == nil {
+		return nil
+	}
 
 	s, err := c.createNewPrivateKeySecret(ctx, crt, pk)
-	if err != nil {
+	if err != nil || s == nil {
 		return err
 	}
 
-	c.recorder.Event(crt, corev1.EventTypeNormal, "Generated", fmt.Sprintf("Stored new private key in temporary Secret resource %q", s.Name))
+	c.recorder.Event(crt, corev1.EventTypeNormal, events.ReasonGenerated, fmt.Sprintf("Stored new private key in temporary Secret resource %q", s.Name))
 
 	return c.setNextPrivateKeySecretName(ctx, crt, &s.Name)
 }
 
+// generatePrivateKey generates a new private key for the Certificate, using
+// its external key provider if the Certificate has an external private key.
+// A nil key and error are returned if the external key provider is not
+// configured, as retrying will not succeed until the controller is
+// reconfigured.
+func (c *controller) generatePrivateKey(ctx context.Context, crt *cmapi.Certificate) (crypto.Signer, error) {
+	if crt.Spec.PrivateKey == nil || crt.Spec.PrivateKey.External == nil {
+		return pki.GeneratePrivateKeyForCertificate(crt)
+	}
+
+	providerName := crt.Spec.PrivateKey.External.Provider
+	provider, ok := c.externalKeyProviders[providerName]
+	if !ok {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonExternalKeyProviderNotFound, "External key provider %q is not configured", providerName)
+		return nil, nil
+	}
+
+	key, err := provider.Generate(ctx, crt)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonExternalKeyFailed, "Failed to generate private key using external key provider %q: %v", providerName, err)
+		return nil, err
+	}
+	return key, nil
+}
+
+// sealPrivateKey encrypts the given private key with a KMS envelope if the
+// SealedPrivateKeys feature is enabled and the Certificate names a KMS plugin.
+// Keys held by an external signer and keys which are already sealed are
+// returned as they are. A nil key and error are returned if the KMS plugin is
+// not configured, as retrying will not succeed until the controller is
+// reconfigured.
+func (c *controller) sealPrivateKey(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer) (crypto.Signer, error) {
+	pluginName := crt.Annotations[cmapi.PrivateKeyKMSPluginAnnotationKey]
+	if pluginName == "" || !utilfeature.DefaultFeatureGate.Enabled(feature.SealedPrivateKeys) {
+		return pk, nil
+	}
+	switch pk.(type) {
+	case *pki.ExternalKey, *pki.SealedKey:
+		return pk, nil
+	}
+
+	plugin, ok := c.kmsPlugins[pluginName]
+	if !ok {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonKMSPluginNotFound, "KMS plugin %q is not configured", pluginName)
+		return nil, nil
+	}
+
+	sealed, err := pki.SealPrivateKey(ctx, plugin, pluginName, pk)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonSealedKeyFailed, "Failed to seal private key using KMS plugin %q: %v", pluginName, err)
+		return nil, err
+	}
+	return sealed, nil
+}
+
 // deleteSecretResources will delete the given secret resources
 func (c *controller) deleteSecretResources(ctx context.Context, secrets []*corev1.Secret) error {
 	log := logf.FromContext(ctx)
@@ -307,6 +434,10 @@ func (c *controller) setNextPrivateKeySecretName(ctx context.Context, crt *cmapi
 	return err
 }
 
+// createNewPrivateKeySecret stores the given private key in a new 'next
+// private key' Secret, sealing it first if the Certificate has a sealed
+// private key. A nil Secret and error are returned if the private key cannot
+// be sealed as its KMS plugin is not configured.
 func (c *controller) createNewPrivateKeySecret(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer) (*corev1.Secret, error) {
 	// if the 'nextPrivateKeySecretName' field is already set, use this as the
 	// name of the Secret resource.
@@ -315,6 +446,11 @@ func (c *controller) createNewPrivateKeySecret(ctx context.Context, crt *cmapi.C
 		name = *crt.Status.NextPrivateKeySecretName
 	}
 
+	pk, err := c.sealPrivateKey(ctx, crt, pk)
+	if err != nil || pk == nil {
+		return nil, err
+	}
+
 	pkData, err := pki.EncodePrivateKey(pk, cmapi.PKCS8)
 	if err != nil {
 		return nil, err
@@ -360,6 +496,8 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		ctx.KubeSharedInformerFactory,
 		ctx.SharedInformerFactory,
 		ctx.Recorder,
+		ctx.CertificateOptions.ExternalKeyProviders,
+		ctx.CertificateOptions.KMSPlugins,
 	)
 	c.controller = ctrl
 
@@ -18,6 +18,7 @@ package keymanager
 
 import (
 	"context"
+	"crypto"
 	"fmt"
 	"reflect"
 	"testing"
@@ -28,12 +29,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/pointer"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/feature"
+	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -76,6 +81,38 @@ func relaxedSecretMatcher(l coretesting.Action, r coretesting.Action) error {
 	return nil
 }
 
+// externalKeySecretMatcher matches Secrets like relaxedSecretMatcher, and
+// additionally checks that the created Secret contains a reference to an
+// external key rather than a private key.
+func externalKeySecretMatcher(l coretesting.Action, r coretesting.Action) error {
+	if err := relaxedSecretMatcher(l, r); err != nil {
+		return err
+	}
+	pkData := r.(coretesting.CreateAction).GetObject().(*corev1.Secret).Data[corev1.TLSPrivateKeyKey]
+	pk, err := pki.DecodePrivateKeyBytes(pkData)
+	if err != nil {
+		return err
+	}
+	if _, ok := pk.(*pki.ExternalKey); !ok {
+		return fmt.Errorf("expected Secret to contain an external key reference, got %T", pk)
+	}
+	return nil
+}
+
+type fakeExternalKeyProvider struct{}
+
+func (fakeExternalKeyProvider) Generate(_ context.Context, crt *cmapi.Certificate) (*pki.ExternalKey, error) {
+	pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		return nil, err
+	}
+	return &pki.ExternalKey{Provider: "fake", Reference: crt.Name, PublicKey: pk.Public()}, nil
+}
+
+func (fakeExternalKeyProvider) Signer(context.Context, *pki.ExternalKey) (crypto.Signer, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func TestProcessItem(t *testing.T) {
 	ownedSecretWithName := func(namespace, name, owner string, data map[string][]byte) *corev1.Secret {
 		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
@@ -93,6 +130,28 @@ func TestProcessItem(t *testing.T) {
 			Data: data,
 		}
 	}
+	suppliedKey := mustGenerateECDSA(t, pki.ECCurve384)
+	suppliedKeyCertificate := func(key string) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+			Spec: cmapi.CertificateSpec{
+				PrivateKey: &cmapi.CertificatePrivateKey{
+					SecretRef: &cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{Name: "supplied-key"},
+						Key:                  key,
+					},
+				},
+			},
+			Status: cmapi.CertificateStatus{
+				Conditions: []cmapi.CertificateCondition{
+					{
+						Type:   cmapi.CertificateConditionIssuing,
+						Status: cmmeta.ConditionTrue,
+					},
+				},
+			},
+		}
+	}
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'Certificate' field will be used.
@@ -189,6 +248,142 @@ func TestProcessItem(t *testing.T) {
 				), relaxedSecretMatcher),
 			},
 		},
+		"create a secret containing a reference to a key generated by the external key provider": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateSpec{
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						Algorithm: cmapi.ECDSAKeyAlgorithm,
+						External:  &cmapi.CertificateExternalPrivateKey{Provider: "fake"},
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			expectedEvents: []string{`Normal Generated Stored new private key in temporary Secret resource "test-notrandom"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					&cmapi.Certificate{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+						Spec: cmapi.CertificateSpec{
+							PrivateKey: &cmapi.CertificatePrivateKey{
+								Algorithm: cmapi.ECDSAKeyAlgorithm,
+								External:  &cmapi.CertificateExternalPrivateKey{Provider: "fake"},
+							},
+						},
+						Status: cmapi.CertificateStatus{
+							NextPrivateKeySecretName: pointer.StringPtr("test-notrandom"),
+							Conditions: []cmapi.CertificateCondition{
+								{
+									Type:   cmapi.CertificateConditionIssuing,
+									Status: cmmeta.ConditionTrue,
+								},
+							},
+						},
+					},
+				)),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:       "testns",
+							GenerateName:    "test-",
+							Labels:          map[string]string{cmapi.IsNextPrivateKeySecretLabelKey: "true"},
+							OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"}}, certificateGvk)},
+						},
+						Data: map[string][]byte{"tls.key": nil},
+					},
+				), externalKeySecretMatcher),
+			},
+		},
+		"do nothing if the external key provider is not configured": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateSpec{
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						External: &cmapi.CertificateExternalPrivateKey{Provider: "missing"},
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			expectedEvents: []string{`Warning ExternalKeyProviderNotFound External key provider "missing" is not configured`},
+		},
+		"create a secret containing the private key supplied in spec.privateKey.secretRef": {
+			certificate: suppliedKeyCertificate("custom.key"),
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "supplied-key"},
+					Data:       map[string][]byte{"custom.key": suppliedKey},
+				},
+			},
+			expectedEvents: []string{`Normal Reused Using private key supplied in Secret resource "supplied-key"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					func() *cmapi.Certificate {
+						crt := suppliedKeyCertificate("custom.key")
+						crt.Status.NextPrivateKeySecretName = pointer.StringPtr("test-notrandom")
+						return crt
+					}(),
+				)),
+				testpkg.NewAction(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:       "testns",
+							GenerateName:    "test-",
+							Labels:          map[string]string{cmapi.IsNextPrivateKeySecretLabelKey: "true"},
+							OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"}}, certificateGvk)},
+						},
+						Data: map[string][]byte{"tls.key": suppliedKey},
+					},
+				)),
+			},
+		},
+		"do nothing if the Secret referenced by spec.privateKey.secretRef does not exist": {
+			certificate:    suppliedKeyCertificate(""),
+			expectedEvents: []string{`Warning SecretNotFound Private key Secret "supplied-key" does not exist`},
+		},
+		"do nothing if the Secret referenced by spec.privateKey.secretRef does not contain the key": {
+			certificate: suppliedKeyCertificate(""),
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "supplied-key"},
+					Data:       map[string][]byte{"custom.key": suppliedKey},
+				},
+			},
+			expectedEvents: []string{`Warning SecretNotFound Private key Secret "supplied-key" does not contain key "tls.key"`},
+		},
+		"do nothing if the Secret referenced by spec.privateKey.secretRef contains an invalid key": {
+			certificate: suppliedKeyCertificate(""),
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "supplied-key"},
+					Data:       map[string][]byte{"tls.key": []byte("invalid")},
+				},
+			},
+			expectedEvents: []string{`Warning DecodeFailed Failed to decode private key stored in Secret "supplied-key": error decoding private key PEM block`},
+		},
 		"create a secret using the already allocated name if it is set": {
 			certificate: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
@@ -481,6 +676,9 @@ func TestProcessItem(t *testing.T) {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, req)
 			}
 			builder.Init()
+			builder.Context.CertificateOptions.ExternalKeyProviders = map[string]pki.ExternalKeyProvider{
+				"fake": fakeExternalKeyProvider{},
+			}
 
 			// Register informers used by the controller using the registration wrapper
 			w := &controllerWrapper{}
@@ -525,3 +723,112 @@ func TestProcessItem(t *testing.T) {
 		})
 	}
 }
+
+type fakeKMSPlugin struct{}
+
+func (fakeKMSPlugin) Encrypt(_ context.Context, dataKey []byte) ([]byte, error) {
+	return dataKey, nil
+}
+
+func (fakeKMSPlugin) Decrypt(_ context.Context, encryptedDataKey []byte) ([]byte, error) {
+	return encryptedDataKey, nil
+}
+
+func TestSealPrivateKey(t *testing.T) {
+	pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	externalKey := &pki.ExternalKey{Provider: "fake", Reference: "key", PublicKey: pk.Public()}
+	crt := func(plugin string) *cmapi.Certificate {
+		crt := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"}}
+		if plugin != "" {
+			crt.Annotations = map[string]string{cmapi.PrivateKeyKMSPluginAnnotationKey: plugin}
+		}
+		return crt
+	}
+
+	tests := map[string]struct {
+		featureEnabled bool
+		certificate    *cmapi.Certificate
+		key            crypto.Signer
+
+		expSealed bool
+		expNil    bool
+		expEvents []string
+	}{
+		"should not seal the key if the feature is disabled": {
+			certificate: crt("fake"),
+			key:         pk,
+		},
+		"should not seal the key if the Certificate does not name a KMS plugin": {
+			featureEnabled: true,
+			certificate:    crt(""),
+			key:            pk,
+		},
+		"should seal the key with the KMS plugin named by the Certificate": {
+			featureEnabled: true,
+			certificate:    crt("fake"),
+			key:            pk,
+			expSealed:      true,
+		},
+		"should not seal a key held by an external signer": {
+			featureEnabled: true,
+			certificate:    crt("fake"),
+			key:            externalKey,
+		},
+		"should return no key if the KMS plugin is not configured": {
+			featureEnabled: true,
+			certificate:    crt("missing"),
+			key:            pk,
+			expNil:         true,
+			expEvents:      []string{`Warning KMSPluginNotFound KMS plugin "missing" is not configured`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.SealedPrivateKeys, test.featureEnabled)()
+			recorder := record.NewFakeRecorder(10)
+			c := &controller{
+				recorder:   recorder,
+				kmsPlugins: map[string]pki.KMSPlugin{"fake": fakeKMSPlugin{}},
+			}
+
+			got, err := c.sealPrivateKey(context.Background(), test.certificate, test.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if !reflect.DeepEqual(events, test.expEvents) {
+				t.Errorf("unexpected events, exp=%v got=%v", test.expEvents, events)
+			}
+
+			switch {
+			case test.expNil:
+				if got != nil {
+					t.Errorf("expected no key, got %T", got)
+				}
+			case test.expSealed:
+				sealed, ok := got.(*pki.SealedKey)
+				if !ok {
+					t.Fatalf("expected a sealed key, got %T", got)
+				}
+				if sealed.Plugin != "fake" {
+					t.Errorf("unexpected plugin %q", sealed.Plugin)
+				}
+				if matches, err := pki.PublicKeysEqual(sealed.Public(), pk.Public()); err != nil || !matches {
+					t.Errorf("expected the sealed key to have the public key of the private key, err=%v", err)
+				}
+			default:
+				if got != test.key {
+					t.Errorf("expected the key to be returned as it is, got %T", got)
+				}
+			}
+		})
+	}
+}
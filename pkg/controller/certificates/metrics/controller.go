@@ -61,6 +61,7 @@ func NewController(
 
 	// obtain references to all the informers used by this controller
 	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	orderInformer := cmFactory.Acme().V1().Orders()
 
 	// Reconcile over all Certificate events. We do _not_ reconcile on Secret
 	// events that are related to Certificates. It is the responsibility of the
@@ -74,6 +75,11 @@ func NewController(
 		certificateInformer.Informer().HasSynced,
 	}
 
+	// The certificate analysis served by the metrics server also reports on
+	// stuck Orders, but this controller does not need to wait for them.
+	metrics.SetAnalysisListers(certificateInformer.Lister(), orderInformer.Lister(),
+		certificateInformer.Informer().HasSynced, orderInformer.Informer().HasSynced)
+
 	return &controller{
 		certificateLister: certificateInformer.Lister(),
 		metrics:           metrics,
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspstaple
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/ocspcheck"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/scheduler"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the OCSP staple controller. It is not
+	// enabled by default.
+	ControllerName = "certificates-ocsp-staple"
+)
+
+// This controller fetches an OCSP response for the certificate stored in a
+// Certificate's `spec.secretName` Secret from the OCSP responder named in the
+// certificate, and stores it in the Secret under the `tls.ocsp-staple` key
+// so that it can be stapled by applications which cannot fetch it themselves.
+// The response is refreshed half way through its validity period.
+type controller struct {
+	certificateLister  cmlisters.CertificateLister
+	secretLister       corelisters.SecretLister
+	kubeClient         kubernetes.Interface
+	recorder           record.EventRecorder
+	scheduledWorkQueue scheduler.ScheduledWorkQueue
+	clock              clock.Clock
+	httpClient         *http.Client
+}
+
+func NewController(
+	log logr.Logger,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	httpClient *http.Client,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister:  certificateInformer.Lister(),
+		secretLister:       secretsInformer.Lister(),
+		kubeClient:         kubeClient,
+		recorder:           recorder,
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		clock:              clock,
+		httpClient:         httpClient,
+	}, queue, mustSync
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("certificate not found for key")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, crt)
+	dbg = log.V(logf.DebugLevel)
+
+	secret, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("secret not found, waiting for it to be issued")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithRelatedResource(log, secret)
+	dbg = log.V(logf.DebugLevel)
+
+	chain, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		dbg.Info("secret does not contain a valid certificate, skipping", "error", err.Error())
+		return nil
+	}
+	leaf := chain[0]
+	if len(leaf.OCSPServer) == 0 {
+		dbg.Info("certificate does not name an OCSP responder, skipping")
+		return nil
+	}
+	issuer := ocspcheck.IssuerForCertificate(leaf, chain, secret.Data[cmmeta.TLSCAKey])
+	if issuer == nil {
+		dbg.Info("issuer of certificate not found in secret, skipping")
+		return nil
+	}
+
+	if staple := secret.Data[cmmeta.TLSOCSPStapleKey]; len(staple) > 0 {
+		resp, err := ocsp.ParseResponseForCert(staple, leaf, issuer)
+		if err == nil {
+			if refreshIn := ocspcheck.RefreshTime(resp).Sub(c.clock.Now()); refreshIn > 0 {
+				dbg.Info("existing OCSP staple is up to date, scheduling refresh", "refresh_in", refreshIn.String())
+				c.scheduledWorkQueue.Add(key, refreshIn)
+				return nil
+			}
+		}
+	}
+
+	resp, der, err := ocspcheck.Fetch(ctx, c.httpClient, leaf, issuer)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonOCSPStapleFailed, "Failed to fetch OCSP response from %s: %v", leaf.OCSPServer[0], err)
+		return err
+	}
+
+	secret = secret.DeepCopy()
+	secret.Data[cmmeta.TLSOCSPStapleKey] = der
+	if _, err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	if resp.Status == ocsp.Revoked {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonCertificateRevoked, "OCSP responder reports the certificate as revoked at %s", resp.RevokedAt.Format(time.RFC3339))
+	}
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonOCSPStapleUpdated, "Updated OCSP staple in Secret %q", secret.Name)
+
+	refreshIn := ocspcheck.RefreshTime(resp).Sub(c.clock.Now())
+	if refreshIn <= 0 {
+		refreshIn = ocspcheck.DefaultRefreshInterval
+	}
+	c.scheduledWorkQueue.Add(key, refreshIn)
+
+	return nil
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Clock,
+		&http.Client{Timeout: time.Second * 10},
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
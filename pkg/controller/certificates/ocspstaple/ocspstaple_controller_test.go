@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspstaple
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func mustCreateCertificate(t *testing.T, template, parent *x509.Certificate, pub crypto.PublicKey, priv crypto.Signer) (*x509.Certificate, []byte) {
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crt, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crt, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour * 24),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	ca, caPEM := mustCreateCertificate(t, caTemplate, caTemplate, caKey.Public(), caKey)
+
+	// ocspResponse is returned by the OCSP responder, unless the responder is
+	// configured to fail.
+	var ocspResponse []byte
+	failResponder := false
+	responder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failResponder {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.Write(ocspResponse)
+	}))
+	defer responder.Close()
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour * 12),
+		OCSPServer:   []string{responder.URL},
+	}
+	leaf, leafPEM := mustCreateCertificate(t, leafTemplate, ca, leafKey.Public(), caKey)
+
+	chainPEM := append(append([]byte{}, leafPEM...), caPEM...)
+
+	leafTemplate.SerialNumber = big.NewInt(3)
+	leafTemplate.OCSPServer = nil
+	_, leafNoOCSPPEM := mustCreateCertificate(t, leafTemplate, ca, leafKey.Public(), caKey)
+
+	ocspResponse, err = ocsp.CreateResponse(ca, ca, ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: leaf.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(time.Hour * 4),
+	}, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateSecretName("test-secret"),
+	)
+	secret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "test-secret"},
+			Data:       data,
+		}
+	}
+
+	tests := map[string]struct {
+		secret        *corev1.Secret
+		failResponder bool
+
+		expectedSecret *corev1.Secret
+		expectedEvents []string
+		expectedErr    bool
+	}{
+		"do nothing if the Secret does not exist": {},
+		"do nothing if the certificate does not name an OCSP responder": {
+			secret: secret(map[string][]byte{
+				corev1.TLSCertKey: leafNoOCSPPEM,
+				cmmeta.TLSCAKey:   caPEM,
+			}),
+		},
+		"do nothing if the issuer of the certificate is not in the Secret": {
+			secret: secret(map[string][]byte{
+				corev1.TLSCertKey: leafPEM,
+			}),
+		},
+		"store an OCSP staple in the Secret if one does not exist": {
+			secret: secret(map[string][]byte{
+				corev1.TLSCertKey: leafPEM,
+				cmmeta.TLSCAKey:   caPEM,
+			}),
+			expectedSecret: secret(map[string][]byte{
+				corev1.TLSCertKey:       leafPEM,
+				cmmeta.TLSCAKey:         caPEM,
+				cmmeta.TLSOCSPStapleKey: ocspResponse,
+			}),
+			expectedEvents: []string{`Normal OCSPStapleUpdated Updated OCSP staple in Secret "test-secret"`},
+		},
+		"store an OCSP staple in the Secret if the existing staple is invalid": {
+			secret: secret(map[string][]byte{
+				corev1.TLSCertKey:       chainPEM,
+				cmmeta.TLSOCSPStapleKey: []byte("invalid"),
+			}),
+			expectedSecret: secret(map[string][]byte{
+				corev1.TLSCertKey:       chainPEM,
+				cmmeta.TLSOCSPStapleKey: ocspResponse,
+			}),
+			expectedEvents: []string{`Normal OCSPStapleUpdated Updated OCSP staple in Secret "test-secret"`},
+		},
+		"do nothing if the existing OCSP staple is up to date": {
+			secret: secret(map[string][]byte{
+				corev1.TLSCertKey:       leafPEM,
+				cmmeta.TLSCAKey:         caPEM,
+				cmmeta.TLSOCSPStapleKey: ocspResponse,
+			}),
+		},
+		"fire an event and return an error if the OCSP responder fails": {
+			secret: secret(map[string][]byte{
+				corev1.TLSCertKey: leafPEM,
+				cmmeta.TLSCAKey:   caPEM,
+			}),
+			failResponder:  true,
+			expectedEvents: []string{`Warning OCSPStapleFailed Failed to fetch OCSP response from ` + responder.URL + `: unexpected response status "500 Internal Server Error"`},
+			expectedErr:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			failResponder = test.failResponder
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{crt},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.secret != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.secret)
+			}
+			if test.expectedSecret != nil {
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						test.expectedSecret.Namespace,
+						test.expectedSecret,
+					)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			w.httpClient = responder.Client()
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(crt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = w.ProcessItem(context.Background(), key)
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
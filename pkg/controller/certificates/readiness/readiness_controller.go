@@ -18,6 +18,8 @@ package readiness
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -41,6 +43,7 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/trigger/policies"
+	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 	"github.com/jetstack/cert-manager/pkg/util/predicate"
@@ -51,6 +54,12 @@ const (
 	ControllerName = "certificates-readiness"
 	// ReadyReason is the 'Ready' reason of a Certificate.
 	ReadyReason = "Ready"
+	// IssuancePausedReason is the 'Paused' reason of a Certificate that has
+	// the 'cert-manager.io/issuance-paused' annotation set.
+	IssuancePausedReason = "IssuancePaused"
+	// IssuerDefaultsAppliedReason is the 'IssuerDefaultsApplied' reason of a
+	// Certificate to which the certificate defaults of its issuer apply.
+	IssuerDefaultsAppliedReason = "IssuerDefaultsApplied"
 )
 
 type controller struct {
@@ -59,6 +68,7 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             corelisters.SecretLister
+	issuerHelper             issuer.Helper
 	client                   cmclient.Interface
 	gatherer                 *policies.Gatherer
 	// policyEvaluator builds Ready condition of a Certificate based on policy evaluation
@@ -79,6 +89,7 @@ func NewController(
 	chain policies.Chain,
 	renewalTimeCalculator certificates.RenewalTimeFunc,
 	policyEvaluator policyEvaluatorFunc,
+	namespace string,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
 	// create a queue used to queue up items to be processed
 	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
@@ -87,6 +98,7 @@ func NewController(
 	certificateInformer := cmFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := factory.Core().V1().Secrets()
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
 
 	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
 
@@ -97,9 +109,14 @@ func NewController(
 	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
 	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
 		// Trigger reconciles on changes to the Secret named `spec.secretName`
-		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
 			predicate.ExtractResourceName(predicate.CertificateSecretName)),
 	})
+	// When an Issuer changes, enqueue the Certificates which reference it so
+	// that changes to its certificate defaults are reflected.
+	issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForIssuer(log, queue, certificateInformer.Lister()),
+	})
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
@@ -107,6 +124,19 @@ func NewController(
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// Issuers are read to apply their certificate defaults. ClusterIssuers
+	// can only be read when not scoped to a single namespace.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if namespace == "" {
+		clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: certificates.EnqueueCertificatesForIssuer(log, queue, certificateInformer.Lister()),
+		})
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
 	}
 
 	return &controller{
@@ -114,6 +144,7 @@ func NewController(
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		issuerHelper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
 		client:                   client,
 		gatherer: &policies.Gatherer{
 			CertificateRequestLister: certificateRequestInformer.Lister(),
@@ -146,7 +177,13 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
-	input, err := c.gatherer.DataForCertificate(ctx, crt)
+	log = logf.WithCertificate(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	// Readiness is evaluated against the spec with the certificate defaults
+	// of the issuer applied.
+	effective, appliedDefaults := certificates.WithIssuerDefaults(c.issuerHelper, crt)
+	input, err := c.gatherer.DataForCertificate(ctx, effective)
 	if err != nil {
 		return err
 	}
@@ -156,6 +193,20 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, condition.Type, condition.Status, condition.Reason, condition.Message)
 
+	if certificates.IssuancePaused(crt) {
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionPaused, cmmeta.ConditionTrue, IssuancePausedReason,
+			fmt.Sprintf("Issuance is paused by the %q annotation", cmapi.IssuancePausedAnnotationKey))
+	} else {
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionPaused)
+	}
+
+	if len(appliedDefaults) > 0 {
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuerDefaultsApplied, cmmeta.ConditionTrue, IssuerDefaultsAppliedReason,
+			fmt.Sprintf("Applied certificate defaults of the issuer: %s", strings.Join(appliedDefaults, "; ")))
+	} else {
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionIssuerDefaultsApplied)
+	}
+
 	switch {
 	case input.Secret != nil && input.Secret.Data != nil:
 		x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
@@ -169,7 +220,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 		notBefore := metav1.NewTime(x509cert.NotBefore)
 		notAfter := metav1.NewTime(x509cert.NotAfter)
-		renewBeforeHint := crt.Spec.RenewBefore
+		renewBeforeHint := effective.Spec.RenewBefore
 		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, renewBeforeHint)
 
 		//update Certificate's Status
@@ -222,6 +273,7 @@ func NewReadinessPolicyChain(c clock.Clock) policies.Chain {
 		policies.SecretDoesNotExist,
 		policies.SecretIsMissingData,
 		policies.SecretPublicKeysDiffer,
+		policies.SecretCertificateMatchesIssued,
 		policies.CurrentCertificateRequestNotValidForSpec,
 		policies.CurrentCertificateHasExpired(c),
 	}
@@ -244,6 +296,7 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		NewReadinessPolicyChain(ctx.Clock),
 		certificates.RenewalTime,
 		policyEvaluator,
+		ctx.Namespace,
 	)
 	c.controller = ctrl
 
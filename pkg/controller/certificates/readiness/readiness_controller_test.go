@@ -26,6 +26,7 @@ import (
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
@@ -79,12 +80,18 @@ func TestProcessItem(t *testing.T) {
 		// cert to be loaded to fake clientset
 		cert *cmapi.Certificate
 
+		// issuer to be loaded to fake clientset
+		issuer *cmapi.Issuer
+
 		// whether we expect an update action against the Certificate
 		certShouldUpdate bool
 
 		// Certificate's Ready condition to be applied with the update
 		condition cmapi.CertificateCondition
 
+		// additional modifications expected to be applied with the update
+		expectedCertModifiers []gen.CertificateModifier
+
 		// whether secret should be loaded into the fake clientset
 		// if notAfter, notBefore and renewalTime are set, an X509 cert will also be built and
 		// added as tls.crt value to the secret data
@@ -235,6 +242,104 @@ func TestProcessItem(t *testing.T) {
 			secretShouldExist: true,
 			certShouldUpdate:  false,
 		},
+		"set the Paused condition for a Certificate that has the issuance-paused annotation": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionFalse,
+				Reason:             "some reason",
+				Message:            "some message",
+				LastTransitionTime: &metaNow,
+			},
+			cert: gen.CertificateFrom(cert, gen.AddCertificateAnnotations(map[string]string{
+				cmapi.IssuancePausedAnnotationKey: "true",
+			})),
+			expectedCertModifiers: []gen.CertificateModifier{
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionPaused,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             IssuancePausedReason,
+					Message:            `Issuance is paused by the "cert-manager.io/issuance-paused" annotation`,
+					LastTransitionTime: &metaNow,
+				}),
+			},
+			certShouldUpdate: true,
+		},
+		"set the IssuerDefaultsApplied condition for a Certificate whose issuer sets certificate defaults": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionFalse,
+				Reason:             "some reason",
+				Message:            "some message",
+				LastTransitionTime: &metaNow,
+			},
+			cert: gen.CertificateFrom(cert, gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "test-issuer"})),
+			issuer: gen.Issuer("test-issuer",
+				gen.SetIssuerNamespace("testns"),
+				gen.SetIssuerCertificateDefaults(cmapi.IssuerCertificateDefaults{
+					Duration:      &metav1.Duration{Duration: time.Hour * 24 * 30},
+					Organizations: []string{"Example Org"},
+				}),
+			),
+			expectedCertModifiers: []gen.CertificateModifier{
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionIssuerDefaultsApplied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             IssuerDefaultsAppliedReason,
+					Message:            "Applied certificate defaults of the issuer: duration 720h0m0s; organizations Example Org",
+					LastTransitionTime: &metaNow,
+				}),
+			},
+			certShouldUpdate: true,
+		},
+		"remove the IssuerDefaultsApplied condition for a Certificate whose issuer no longer sets certificate defaults": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionFalse,
+				Reason:             "some reason",
+				Message:            "some message",
+				LastTransitionTime: &metaNow,
+			},
+			cert: gen.CertificateFrom(cert,
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "test-issuer"}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionIssuerDefaultsApplied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             IssuerDefaultsAppliedReason,
+					Message:            "some message",
+					LastTransitionTime: &metaNow,
+				}),
+			),
+			issuer: gen.Issuer("test-issuer", gen.SetIssuerNamespace("testns")),
+			expectedCertModifiers: []gen.CertificateModifier{
+				func(crt *cmapi.Certificate) {
+					apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionIssuerDefaultsApplied)
+				},
+			},
+			certShouldUpdate: true,
+		},
+		"remove the Paused condition for a Certificate that no longer has the issuance-paused annotation": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionFalse,
+				Reason:             "some reason",
+				Message:            "some message",
+				LastTransitionTime: &metaNow,
+			},
+			cert: gen.CertificateFrom(cert, gen.SetCertificateStatusCondition(
+				cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionPaused,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             IssuancePausedReason,
+					Message:            "some message",
+					LastTransitionTime: &metaNow,
+				})),
+			expectedCertModifiers: []gen.CertificateModifier{
+				func(crt *cmapi.Certificate) {
+					apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionPaused)
+				},
+			},
+			certShouldUpdate: true,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -248,6 +353,9 @@ func TestProcessItem(t *testing.T) {
 				// Ensures cert is loaded into the builder's fake clientset.
 				builder.CertManagerObjects = append(builder.CertManagerObjects, test.cert)
 			}
+			if test.issuer != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.issuer)
+			}
 
 			if test.secretShouldExist {
 				mods := make([]gen.SecretModifier, 0)
@@ -284,6 +392,7 @@ func TestProcessItem(t *testing.T) {
 			if test.certShouldUpdate {
 				c := gen.CertificateFrom(test.cert,
 					gen.SetCertificateStatusCondition(test.condition))
+				c = gen.CertificateFrom(c, test.expectedCertModifiers...)
 
 				// gen package functions don't accept pointers- we need to test setting these values to nil in some scenarios.
 				c.Status.NotAfter = test.notAfter
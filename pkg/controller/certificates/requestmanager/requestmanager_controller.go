@@ -20,16 +20,20 @@ import (
 	"bytes"
 	"context"
 	"crypto"
+	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	corelisters "k8s.io/client-go/listers/core/v1"
@@ -46,15 +50,18 @@ import (
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/csrtransform"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	scepclient "github.com/jetstack/cert-manager/pkg/issuer/scep/client"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/tracing"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 	"github.com/jetstack/cert-manager/pkg/util/predicate"
 )
 
 const (
-	ControllerName      = "certificates-request-manager"
-	reasonRequestFailed = "RequestFailed"
-	reasonRequested     = "Requested"
+	ControllerName = "certificates-request-manager"
 )
 
 var (
@@ -65,10 +72,19 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             corelisters.SecretLister
+	issuerHelper             issuer.Helper
+	issuerOptions            controllerpkg.IssuerOptions
 	client                   cmclient.Interface
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
 	copiedAnnotationPrefixes []string
+
+	// externalKeyProviders sign the requests of Certificates with an
+	// external private key
+	externalKeyProviders map[string]pki.ExternalKeyProvider
+	// kmsPlugins decrypt the private keys of Certificates with a sealed
+	// private key
+	kmsPlugins map[string]pki.KMSPlugin
 }
 
 func NewController(
@@ -79,6 +95,8 @@ func NewController(
 	recorder record.EventRecorder,
 	clock clock.Clock,
 	certificateControllerOptions controllerpkg.CertificateOptions,
+	issuerOptions controllerpkg.IssuerOptions,
+	namespace string,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
 	// create a queue used to queue up items to be processed
 	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
@@ -87,6 +105,7 @@ func NewController(
 	certificateInformer := cmFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := factory.Core().V1().Secrets()
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
 
 	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
 	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
@@ -108,20 +127,35 @@ func NewController(
 		secretsInformer.Informer().HasSynced,
 		certificateRequestInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// Issuers are read to add the challenge password of SCEP issuers to
+	// requests. ClusterIssuers can only be read when not scoped to a single
+	// namespace.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if namespace == "" {
+		clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
 	}
 
 	return &controller{
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		issuerHelper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+		issuerOptions:            issuerOptions,
 		client:                   client,
 		recorder:                 recorder,
 		clock:                    clock,
 		copiedAnnotationPrefixes: certificateControllerOptions.CopiedAnnotationPrefixes,
+		externalKeyProviders:     certificateControllerOptions.ExternalKeyProviders,
+		kmsPlugins:               certificateControllerOptions.KMSPlugins,
 	}, queue, mustSync
 }
 
-func (c *controller) ProcessItem(ctx context.Context, key string) error {
+func (c *controller) ProcessItem(ctx context.Context, key string) (err error) {
 	log := logf.FromContext(ctx).WithValues("key", key)
 
 	ctx = logf.NewContext(ctx, log)
@@ -140,6 +174,25 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	log = logf.WithCertificate(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	ctx, span := tracing.Start(tracing.CertificateContext(ctx, crt), ControllerName, cmapi.CertificateKind, crt)
+	defer func() { tracing.End(span, err) }()
+
+	if certificates.IssuancePaused(crt) {
+		log.V(logf.DebugLevel).Info("issuance is paused for certificate, skipping")
+		return nil
+	}
+
+	// Remove any stale issuance plan once dry-run mode has been disabled.
+	if !isDryRun(crt) && crt.Status.IssuancePlan != nil {
+		crt = crt.DeepCopy()
+		crt.Status.IssuancePlan = nil
+		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+		return err
+	}
+
 	if !apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
@@ -217,7 +270,165 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
-	return c.createNewCertificateRequest(ctx, crt, pk, nextRevision, nextPrivateKeySecret.Name)
+	signer, err := c.privateKeySigner(ctx, crt, pk)
+	if err != nil || signer == nil {
+		return err
+	}
+
+	if isDryRun(crt) {
+		return c.updateIssuancePlan(ctx, crt, signer)
+	}
+
+	return c.createNewCertificateRequest(ctx, crt, signer, nextRevision, nextPrivateKeySecret.Name)
+}
+
+// privateKeySigner returns a crypto.Signer which signs using the given private
+// key. References to private keys held by an external signer are resolved
+// using their external key provider, and sealed private keys are decrypted
+// using their KMS plugin. A nil signer and error are returned if the external
+// key provider or KMS plugin is not configured, as retrying will not succeed
+// until the controller is reconfigured.
+func (c *controller) privateKeySigner(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer) (crypto.Signer, error) {
+	if sealed, ok := pk.(*pki.SealedKey); ok {
+		return c.unsealPrivateKey(ctx, crt, sealed)
+	}
+
+	key, ok := pk.(*pki.ExternalKey)
+	if !ok {
+		return pk, nil
+	}
+
+	provider, ok := c.externalKeyProviders[key.Provider]
+	if !ok {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonExternalKeyProviderNotFound, "External key provider %q is not configured", key.Provider)
+		return nil, nil
+	}
+
+	signer, err := provider.Signer(ctx, key)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonExternalKeyFailed, "Failed to load private key from external key provider %q: %v", key.Provider, err)
+		return nil, err
+	}
+	return signer, nil
+}
+
+// unsealPrivateKey decrypts the given sealed private key using its KMS plugin.
+// A nil signer and error are returned if the KMS plugin is not configured.
+func (c *controller) unsealPrivateKey(ctx context.Context, crt *cmapi.Certificate, key *pki.SealedKey) (crypto.Signer, error) {
+	plugin, ok := c.kmsPlugins[key.Plugin]
+	if !ok {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonKMSPluginNotFound, "KMS plugin %q is not configured", key.Plugin)
+		return nil, nil
+	}
+
+	signer, err := pki.UnsealPrivateKey(ctx, plugin, key)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonSealedKeyFailed, "Failed to unseal private key using KMS plugin %q: %v", key.Plugin, err)
+		return nil, err
+	}
+	return signer, nil
+}
+
+// isDryRun returns true if the Certificate has been annotated to only report
+// the request that would be created, rather than creating it.
+func isDryRun(crt *cmapi.Certificate) bool {
+	return crt.Annotations[cmapi.IssuanceDryRunAnnotationKey] == "true"
+}
+
+// updateIssuancePlan records the CertificateRequest that would be created for
+// the Certificate on its status, without contacting the issuer.
+func (c *controller) updateIssuancePlan(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer) error {
+	effective, _ := certificates.WithIssuerDefaults(c.issuerHelper, crt)
+
+	// Avoid generating a new CSR (and so a status update) on every resync if
+	// the existing plan still matches the spec and private key.
+	if plan := crt.Status.IssuancePlan; plan != nil {
+		violations, err := certificates.RequestMatchesSpec(&cmapi.CertificateRequest{
+			Spec: cmapi.CertificateRequestSpec{
+				Request:   plan.Request,
+				Duration:  plan.Duration,
+				IssuerRef: plan.IssuerRef,
+				IsCA:      plan.IsCA,
+				Usages:    plan.Usages,
+			},
+		}, effective.Spec)
+		if err == nil && len(violations) == 0 {
+			x509Req, err := pki.DecodeX509CertificateRequestBytes(plan.Request)
+			if err != nil {
+				return err
+			}
+			matches, err := pki.PublicKeyMatchesCSR(pk.Public(), x509Req)
+			if err != nil {
+				return err
+			}
+			if matches {
+				return nil
+			}
+		}
+	}
+
+	csrPEM, extensions, err := c.buildCSR(ctx, crt, effective, pk)
+	if err != nil || csrPEM == nil {
+		return err
+	}
+
+	x509Req, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+	if err != nil {
+		return err
+	}
+
+	plan := &cmapi.CertificateIssuancePlan{
+		Request:        csrPEM,
+		CommonName:     x509Req.Subject.CommonName,
+		DNSNames:       x509Req.DNSNames,
+		IPAddresses:    pki.IPAddressesToString(x509Req.IPAddresses),
+		URIs:           pki.URLsToString(x509Req.URIs),
+		EmailAddresses: x509Req.EmailAddresses,
+		Usages:         apiutil.CertificateSpecUsages(&effective.Spec),
+		Duration:       effective.Spec.Duration,
+		IsCA:           effective.Spec.IsCA,
+		IssuerRef:      certificates.ActiveIssuerRef(crt),
+		Extensions:     extensions,
+	}
+
+	// The subject of a transformed request does not match the spec, so
+	// compare the rebuilt plan with the recorded one instead. The request
+	// itself differs on every build as signatures are randomised.
+	if existing := crt.Status.IssuancePlan; existing != nil {
+		matches, err := issuancePlansMatch(existing, plan, pk.Public())
+		if err != nil {
+			return err
+		}
+		if matches {
+			return nil
+		}
+	}
+
+	crt = crt.DeepCopy()
+	crt.Status.IssuancePlan = plan
+
+	if _, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	c.recorder.Event(crt, corev1.EventTypeNormal, events.ReasonDryRun, "Recorded issuance plan; no CertificateRequest was created as dry-run is enabled")
+
+	return nil
+}
+
+// issuancePlansMatch returns true if the given issuance plans request the
+// same certificate, and the request of the existing plan is signed by the
+// given public key.
+func issuancePlansMatch(existing, plan *cmapi.CertificateIssuancePlan, publicKey crypto.PublicKey) (bool, error) {
+	l, r := existing.DeepCopy(), plan.DeepCopy()
+	l.Request, r.Request = nil, nil
+	if !apiequality.Semantic.DeepEqual(l, r) {
+		return false, nil
+	}
+	x509Req, err := pki.DecodeX509CertificateRequestBytes(existing.Request)
+	if err != nil {
+		return false, nil
+	}
+	return pki.PublicKeyMatchesCSR(publicKey, x509Req)
 }
 
 func (c *controller) deleteCurrentFailedRequests(ctx context.Context, reqs ...*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, error) {
@@ -299,10 +510,11 @@ func requestsWithRevision(reqs []*cmapi.CertificateRequest, revision int) ([]*cm
 
 func (c *controller) deleteRequestsNotMatchingSpec(ctx context.Context, crt *cmapi.Certificate, publicKey crypto.PublicKey, reqs ...*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, error) {
 	log := logf.FromContext(ctx)
+	effective, _ := certificates.WithIssuerDefaults(c.issuerHelper, crt)
 	var remaining []*cmapi.CertificateRequest
 	for _, req := range reqs {
 		log := logf.WithRelatedResource(log, req)
-		violations, err := certificates.RequestMatchesSpec(req, crt.Spec)
+		violations, err := certificates.RequestMatchesSpec(req, effective.Spec)
 		if err != nil {
 			log.Error(err, "Failed to check if CertificateRequest matches spec, deleting CertificateRequest")
 			if err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{}); err != nil {
@@ -340,19 +552,9 @@ func (c *controller) deleteRequestsNotMatchingSpec(ctx context.Context, crt *cma
 
 func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer, nextRevision int, nextPrivateKeySecretName string) error {
 	log := logf.FromContext(ctx)
-	x509CSR, err := pki.GenerateCSR(crt)
-	if err != nil {
-		log.Error(err, "Failed to generate CSR - will not retry")
-		return nil
-	}
-	csrDER, err := pki.EncodeCSR(x509CSR, pk)
-	if err != nil {
-		return err
-	}
-
-	csrPEM := bytes.NewBuffer([]byte{})
-	err = pem.Encode(csrPEM, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
-	if err != nil {
+	effective, _ := certificates.WithIssuerDefaults(c.issuerHelper, crt)
+	csrPEM, extensions, err := c.buildCSR(ctx, crt, effective, pk)
+	if err != nil || csrPEM == nil {
 		return err
 	}
 
@@ -364,45 +566,219 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 	cr := &cmapi.CertificateRequest{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:       crt.Namespace,
-			GenerateName:    apiutil.DNSSafeShortenTo52Characters(crt.Name) + "-",
 			Annotations:     annotations,
 			Labels:          crt.Labels,
 			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(crt, certificateGvk)},
 		},
 		Spec: cmapi.CertificateRequestSpec{
-			Duration:  crt.Spec.Duration,
-			IssuerRef: crt.Spec.IssuerRef,
-			Request:   csrPEM.Bytes(),
-			IsCA:      crt.Spec.IsCA,
-			Usages:    crt.Spec.Usages,
+			Duration:   effective.Spec.Duration,
+			IssuerRef:  certificates.ActiveIssuerRef(crt),
+			Request:    csrPEM,
+			IsCA:       effective.Spec.IsCA,
+			Usages:     apiutil.CertificateSpecUsages(&effective.Spec),
+			Extensions: extensions,
 		},
 	}
+	if len(csrtransform.Names()) > 0 {
+		// Record the fields changed by the transformers so that the request
+		// is not deleted for not matching the spec.
+		transformed, err := certificates.RequestMatchesSpec(cr, effective.Spec)
+		if err != nil {
+			return err
+		}
+		if len(transformed) > 0 {
+			annotations[cmapi.CertificateRequestTransformedFieldsAnnotationKey] = strings.Join(transformed, ",")
+		}
+	}
+	tracing.InjectAnnotation(ctx, cr)
 
-	cr, err = c.client.CertmanagerV1().CertificateRequests(cr.Namespace).Create(ctx, cr, metav1.CreateOptions{})
+	cr, created, err := c.createNamedCertificateRequest(ctx, crt, cr, nextRevision)
 	if err != nil {
-		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRequestFailed, "Failed to create CertificateRequest: "+err.Error())
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonRequestFailed, "Failed to create CertificateRequest: "+err.Error())
 		return err
 	}
-	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRequested, "Created new CertificateRequest resource %q", cr.Name)
-	if err := c.waitForCertificateRequestToExist(cr.Namespace, cr.Name); err != nil {
+	if !created {
+		log.V(logf.DebugLevel).Info("CertificateRequest for the next revision already exists", "name", cr.Name)
+		return c.waitForCertificateRequestToExist(cr.Namespace, cr.Name, cr.UID)
+	}
+	if cr.Spec.IssuerRef != crt.Spec.IssuerRef {
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonRequested, "Created new CertificateRequest resource %q using fallback issuer %q after %d failed issuance attempts",
+			cr.Name, cr.Spec.IssuerRef.Name, *crt.Status.FailedIssuanceAttempts)
+	} else {
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonRequested, "Created new CertificateRequest resource %q", cr.Name)
+	}
+	if err := c.waitForCertificateRequestToExist(cr.Namespace, cr.Name, cr.UID); err != nil {
 		return fmt.Errorf("failed whilst waiting for CertificateRequest to exist - this may indicate an apiserver running slowly. Request will be retried")
 	}
 	return nil
 }
 
-func (c *controller) waitForCertificateRequestToExist(namespace, name string) error {
+// buildCSR builds the PEM encoded certificate signing request for the
+// Certificate, runs the registered CSR transformers on it and signs it with
+// the given private key. The additional extensions added by the transformers
+// are returned with it. A nil request and error are returned if the request
+// cannot be built and retrying will not succeed, in which case the failure has
+// already been logged.
+func (c *controller) buildCSR(ctx context.Context, crt, effective *cmapi.Certificate, pk crypto.Signer) ([]byte, []cmapi.X509Extension, error) {
+	log := logf.FromContext(ctx)
+	x509CSR, err := pki.GenerateCSR(effective)
+	if err != nil {
+		log.Error(err, "Failed to generate CSR - will not retry")
+		return nil, nil, nil
+	}
+
+	transformReq := &csrtransform.Request{CSR: x509CSR}
+	if err := csrtransform.Transform(ctx, effective, transformReq); err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonCSRTransformFailed, "Failed to transform CSR: %v", err)
+		return nil, nil, err
+	}
+	violation, err := c.checkTransformedExtensions(crt, transformReq.Extensions)
+	if err != nil {
+		return nil, nil, err
+	}
+	if violation != nil {
+		log.Error(violation, "Transformed CSR violates the policy of the issuer - will not retry")
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonIssuerPolicyViolation, "Transformed CSR violates the policy of the issuer: %v", violation)
+		return nil, nil, nil
+	}
+
+	csrDER, err := c.encodeCSR(crt, transformReq.CSR, pk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csrPEM := bytes.NewBuffer([]byte{})
+	err = pem.Encode(csrPEM, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return csrPEM.Bytes(), transformReq.Extensions, nil
+}
+
+// checkTransformedExtensions returns a violation if the active issuer of the
+// Certificate does not allow one of the additional extensions added by CSR
+// transformers, so that a request which would be rejected is not created.
+func (c *controller) checkTransformedExtensions(crt *cmapi.Certificate, extensions []cmapi.X509Extension) (violation error, err error) {
+	if len(extensions) == 0 {
+		return nil, nil
+	}
+
+	iss, err := c.issuerHelper.GetGenericIssuer(certificates.ActiveIssuerRef(crt), crt.Namespace)
+	if apierrors.IsNotFound(err) {
+		// the CertificateRequest controllers report missing issuers
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, extension := range extensions {
+		if !apiutil.IssuerPolicyAllowsExtension(iss.GetSpec().Policy, extension.ID) {
+			return fmt.Errorf("extension %s is not allowed by the issuer", extension.ID), nil
+		}
+	}
+	return nil, nil
+}
+
+// encodeCSR encodes and signs the certificate signing request for the
+// Certificate. If the active issuer of the Certificate is a SCEP issuer with a
+// challenge password, the password is added to the request, as only this
+// controller holds the private key needed to sign it.
+func (c *controller) encodeCSR(crt *cmapi.Certificate, x509CSR *x509.CertificateRequest, pk crypto.Signer) ([]byte, error) {
+	iss, err := c.issuerHelper.GetGenericIssuer(certificates.ActiveIssuerRef(crt), crt.Namespace)
+	if apierrors.IsNotFound(err) {
+		// the CertificateRequest controllers report missing issuers
+		return pki.EncodeCSR(x509CSR, pk)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scep := iss.GetSpec().SCEP
+	if scep == nil || scep.ChallengePasswordSecretRef == nil {
+		return pki.EncodeCSR(x509CSR, pk)
+	}
+	password, err := scepclient.ChallengePassword(c.secretLister, c.issuerOptions.ResourceNamespace(iss), scep)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SCEP challenge password: %w", err)
+	}
+	return pki.EncodeCSRWithChallengePassword(x509CSR, pk, password)
+}
+
+// waitForCertificateRequestToExist waits for the CertificateRequest with the
+// given UID to be observed, so that a request with the same name which has
+// just been deleted is not mistaken for it.
+func (c *controller) waitForCertificateRequestToExist(namespace, name string, uid types.UID) error {
 	return wait.Poll(time.Millisecond*100, time.Second*5, func() (bool, error) {
-		_, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
+		cr, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
 		if apierrors.IsNotFound(err) {
 			return false, nil
 		}
 		if err != nil {
 			return false, err
 		}
-		return true, nil
+		return cr.UID == uid, nil
 	})
 }
 
+// maxCertificateRequestNameAttempts is the number of names tried when
+// creating a CertificateRequest before falling back to a generated name.
+const maxCertificateRequestNameAttempts = 5
+
+// certificateRequestName returns the name of the CertificateRequest for the
+// given revision of the Certificate, `<certificate>-<revision>`. If that name
+// is taken by another resource, subsequent attempts add a numbered suffix.
+func certificateRequestName(crt *cmapi.Certificate, revision, attempt int) string {
+	name := fmt.Sprintf("%s-%d", apiutil.DNSSafeShortenTo52Characters(crt.Name), revision)
+	if attempt > 0 {
+		name = fmt.Sprintf("%s-%d", name, attempt)
+	}
+	return name
+}
+
+// createNamedCertificateRequest creates the CertificateRequest for the given
+// revision of the Certificate with a deterministic name. If a
+// CertificateRequest for the same revision of the Certificate already exists
+// with that name, for example because the controller restarted before
+// observing it, it is returned instead and created is false. Names taken by
+// other CertificateRequests are skipped.
+func (c *controller) createNamedCertificateRequest(ctx context.Context, crt *cmapi.Certificate, cr *cmapi.CertificateRequest, revision int) (_ *cmapi.CertificateRequest, created bool, _ error) {
+	log := logf.FromContext(ctx)
+	client := c.client.CertmanagerV1().CertificateRequests(cr.Namespace)
+
+	for attempt := 0; attempt < maxCertificateRequestNameAttempts; attempt++ {
+		cr.Name = certificateRequestName(crt, revision, attempt)
+		newCR, err := client.Create(ctx, cr, metav1.CreateOptions{})
+		if err == nil {
+			return newCR, true, nil
+		}
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, false, err
+		}
+
+		existing, err := client.Get(ctx, cr.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			// deleted in the meantime, so the name may be free again, but
+			// move on to avoid racing with whoever deleted it
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if metav1.IsControlledBy(existing, crt) && existing.Annotations[cmapi.CertificateRequestRevisionAnnotationKey] == strconv.Itoa(revision) {
+			return existing, false, nil
+		}
+		log.V(logf.DebugLevel).Info("CertificateRequest name is already in use, trying another name", "name", cr.Name)
+	}
+
+	log.V(logf.InfoLevel).Info("CertificateRequest names are already in use, using a generated name", "attempts", maxCertificateRequestNameAttempts)
+	cr.Name = ""
+	cr.GenerateName = apiutil.DNSSafeShortenTo52Characters(crt.Name) + "-"
+	newCR, err := client.Create(ctx, cr, metav1.CreateOptions{})
+	return newCR, err == nil, err
+}
+
 // controllerWrapper wraps the `controller` structure to make it implement
 // the controllerpkg.queueingController interface
 type controllerWrapper struct {
@@ -420,6 +796,8 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		ctx.Recorder,
 		ctx.Clock,
 		ctx.CertificateOptions,
+		ctx.IssuerOptions,
+		ctx.Namespace,
 	)
 	c.controller = ctrl
 
@@ -18,6 +18,8 @@ package requestmanager
 
 import (
 	"context"
+	"crypto"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -25,17 +27,21 @@ import (
 
 	"github.com/kr/pretty"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 	fakeclock "k8s.io/utils/clock/testing"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/csrtransform"
 	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 	"github.com/jetstack/cert-manager/test/unit/gen"
+	testlisters "github.com/jetstack/cert-manager/test/unit/listers"
 )
 
 func mustGenerateRSA(t *testing.T, keySize int) []byte {
@@ -61,6 +67,52 @@ func relaxedCertificateRequestMatcher(l coretesting.Action, r coretesting.Action
 	return nil
 }
 
+// setCertificateRequestName sets the deterministic name a CertificateRequest
+// is expected to be created with.
+func setCertificateRequestName(name string) gen.CertificateRequestModifier {
+	return func(cr *cmapi.CertificateRequest) {
+		cr.GenerateName = ""
+		cr.Name = name
+	}
+}
+
+func relaxedIssuancePlanMatcher(l coretesting.Action, r coretesting.Action) error {
+	objL := l.(coretesting.UpdateAction).GetObject().(*cmapi.Certificate).DeepCopy()
+	objR := r.(coretesting.UpdateAction).GetObject().(*cmapi.Certificate).DeepCopy()
+	if objL.Status.IssuancePlan != nil && objR.Status.IssuancePlan != nil {
+		objL.Status.IssuancePlan.Request = nil
+		objR.Status.IssuancePlan.Request = nil
+	}
+	if !reflect.DeepEqual(objL, objR) {
+		return fmt.Errorf("unexpected difference between actions: %s", pretty.Diff(objL, objR))
+	}
+	return nil
+}
+
+// fakeExternalKeyProvider holds a single private key, referenced as "key".
+type fakeExternalKeyProvider struct {
+	key crypto.Signer
+}
+
+func (f fakeExternalKeyProvider) Generate(context.Context, *cmapi.Certificate) (*pki.ExternalKey, error) {
+	return &pki.ExternalKey{Provider: "fake", Reference: "key", PublicKey: f.key.Public()}, nil
+}
+
+func (f fakeExternalKeyProvider) Signer(_ context.Context, key *pki.ExternalKey) (crypto.Signer, error) {
+	if key.Reference != "key" {
+		return nil, fmt.Errorf("key %q not found", key.Reference)
+	}
+	return f.key, nil
+}
+
+func mustEncodeExternalKey(t *testing.T, key *pki.ExternalKey) []byte {
+	d, err := pki.EncodeExternalKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
 func TestProcessItem(t *testing.T) {
 	bundle1 := mustCreateCryptoBundle(t, &cmapi.Certificate{
 		ObjectMeta: metav1.ObjectMeta{
@@ -78,6 +130,7 @@ func TestProcessItem(t *testing.T) {
 		},
 		Spec: cmapi.CertificateSpec{CommonName: "test-bundle-2"}},
 	)
+	externalKey := &pki.ExternalKey{Provider: "fake", Reference: "key", PublicKey: bundle1.privateKey.Public()}
 	fixedNow := metav1.NewTime(time.Now())
 	fixedClock := fakeclock.NewFakeClock(fixedNow.Time)
 	failedCRCondition := cmapi.CertificateRequestCondition{
@@ -168,10 +221,109 @@ func TestProcessItem(t *testing.T) {
 				gen.SetCertificateNextPrivateKeySecretName("exists"),
 				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
 			),
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
+							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
+						}),
+					)), relaxedCertificateRequestMatcher),
+			},
+		},
+		"create a CertificateRequest with the next name if the name for the revision is taken by an unrelated request": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle1.certificate.Namespace, Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: bundle1.privateKeyBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			requests: []runtime.Object{
+				gen.CertificateRequest("test-1", gen.SetCertificateRequestNamespace("testns")),
+			},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1-1"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
+							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
+						}),
+					)), relaxedCertificateRequestMatcher),
+				testpkg.NewAction(coretesting.NewGetAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test-1")),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1-1"),
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
+							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
+						}),
+					)), relaxedCertificateRequestMatcher),
+			},
+		},
+		"create a CertificateRequest signed by the external key provider if the next private key is held by it": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle1.certificate.Namespace, Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: mustEncodeExternalKey(t, externalKey)},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
+							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
+						}),
+					)), relaxedCertificateRequestMatcher),
+			},
+		},
+		"do nothing if the next private key is held by an external key provider which is not configured": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle1.certificate.Namespace, Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: mustEncodeExternalKey(t, &pki.ExternalKey{Provider: "missing", Reference: "key", PublicKey: bundle1.privateKey.Public()})},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Warning ExternalKeyProviderNotFound External key provider "missing" is not configured`},
+		},
+		"create a CertificateRequest using the fallback issuer once the failover threshold is reached": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle1.certificate.Namespace, Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: bundle1.privateKeyBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateFallbackIssuers(cmmeta.ObjectReference{Name: "fallback-issuer"}),
+				gen.SetCertificateIssuerFailoverThreshold(2),
+				gen.SetCertificateFailedIssuanceAttempts(2),
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1" using fallback issuer "fallback-issuer" after 2 failed issuance attempts`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
+						gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "fallback-issuer"}),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
@@ -179,6 +331,63 @@ func TestProcessItem(t *testing.T) {
 					)), relaxedCertificateRequestMatcher),
 			},
 		},
+		"record an issuance plan instead of creating a CertificateRequest if dry-run is enabled": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle1.certificate.Namespace, Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: bundle1.privateKeyBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.IssuanceDryRunAnnotationKey: "true"}),
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Normal DryRun Recorded issuance plan; no CertificateRequest was created as dry-run is enabled`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(bundle1.certificate,
+						gen.AddCertificateAnnotations(map[string]string{cmapi.IssuanceDryRunAnnotationKey: "true"}),
+						gen.SetCertificateNextPrivateKeySecretName("exists"),
+						gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+						gen.SetCertificateIssuancePlan(&cmapi.CertificateIssuancePlan{
+							CommonName: "test-bundle-1",
+							IssuerRef:  bundle1.certificate.Spec.IssuerRef,
+						}),
+					)), relaxedIssuancePlanMatcher),
+			},
+		},
+		"do nothing if dry-run is enabled and the existing issuance plan is up to date": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle1.certificate.Namespace, Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: bundle1.privateKeyBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.IssuanceDryRunAnnotationKey: "true"}),
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+				gen.SetCertificateIssuancePlan(&cmapi.CertificateIssuancePlan{
+					Request:    bundle1.certificateRequest.Spec.Request,
+					CommonName: "test-bundle-1",
+					IssuerRef:  bundle1.certificate.Spec.IssuerRef,
+				}),
+			),
+		},
+		"remove a stale issuance plan if dry-run is no longer enabled": {
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateIssuancePlan(&cmapi.CertificateIssuancePlan{
+					Request:   bundle1.certificateRequest.Spec.Request,
+					IssuerRef: bundle1.certificate.Spec.IssuerRef,
+				}),
+			),
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					bundle1.certificate,
+				)),
+			},
+		},
 		"delete the owned CertificateRequest and create a new one if existing one does not have the annotation": {
 			secrets: []runtime.Object{
 				&corev1.Secret{
@@ -198,11 +407,12 @@ func TestProcessItem(t *testing.T) {
 					}),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test")),
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
@@ -229,11 +439,12 @@ func TestProcessItem(t *testing.T) {
 					}),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test")),
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
@@ -281,11 +492,12 @@ func TestProcessItem(t *testing.T) {
 					gen.SetCertificateRequestCSR([]byte("invalid")),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test")),
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
@@ -319,10 +531,11 @@ func TestProcessItem(t *testing.T) {
 					}),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
@@ -358,11 +571,12 @@ func TestProcessItem(t *testing.T) {
 					}),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test")),
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-1"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
@@ -399,11 +613,12 @@ func TestProcessItem(t *testing.T) {
 					}),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-6"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test")),
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle2.certificateRequest,
+						setCertificateRequestName("test-6"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "6",
@@ -431,11 +646,12 @@ func TestProcessItem(t *testing.T) {
 					}),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-6"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test")),
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-6"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "6",
@@ -464,11 +680,12 @@ func TestProcessItem(t *testing.T) {
 					}),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-6"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test")),
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-6"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "6",
@@ -546,11 +763,12 @@ func TestProcessItem(t *testing.T) {
 					gen.AddCertificateRequestStatusCondition(failedCRCondition),
 				),
 			},
-			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-6"`},
 			expectedActions: []testpkg.Action{
 				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test")),
 				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
 					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						setCertificateRequestName("test-6"),
 						gen.SetCertificateRequestAnnotations(map[string]string{
 							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
 							cmapi.CertificateRequestRevisionAnnotationKey:   "6",
@@ -579,6 +797,9 @@ func TestProcessItem(t *testing.T) {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, req)
 			}
 			builder.Init()
+			builder.Context.CertificateOptions.ExternalKeyProviders = map[string]pki.ExternalKeyProvider{
+				"fake": fakeExternalKeyProvider{key: bundle1.privateKey},
+			}
 
 			// Register informers used by the controller using the registration wrapper
 			w := &controllerWrapper{}
@@ -623,3 +844,322 @@ func TestProcessItem(t *testing.T) {
 		})
 	}
 }
+
+type fakeIssuerHelper struct {
+	issuer cmapi.GenericIssuer
+}
+
+func (f fakeIssuerHelper) GetGenericIssuer(ref cmmeta.ObjectReference, ns string) (cmapi.GenericIssuer, error) {
+	if f.issuer == nil || f.issuer.GetObjectMeta().Name != ref.Name {
+		return nil, apierrors.NewNotFound(cmapi.Resource("issuers"), ref.Name)
+	}
+	return f.issuer, nil
+}
+
+func TestEncodeCSR(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "scep-issuer"}),
+	)
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	challengeIssuer := gen.Issuer("scep-issuer",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerSCEP(cmapi.SCEPIssuer{
+			URL: "https://scep.example.com/scep",
+			ChallengePasswordSecretRef: &cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: "scep-challenge"},
+				Key:                  "password",
+			},
+		}),
+	)
+	challengeSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "scep-challenge"},
+		Data:       map[string][]byte{"password": []byte("test-password\n")},
+	}
+
+	tests := map[string]struct {
+		issuer      cmapi.GenericIssuer
+		secret      *corev1.Secret
+		expPassword string
+		expErr      bool
+	}{
+		"should not add a challenge password if the issuer does not exist": {},
+		"should not add a challenge password if the issuer is not a SCEP issuer": {
+			issuer: gen.Issuer("scep-issuer", gen.SetIssuerNamespace("testns"), gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{})),
+		},
+		"should add the challenge password of a SCEP issuer": {
+			issuer:      challengeIssuer,
+			secret:      challengeSecret,
+			expPassword: "test-password",
+		},
+		"should error if the challenge password secret does not exist": {
+			issuer: challengeIssuer,
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			secretLister := testlisters.FakeSecretListerFrom(testlisters.NewFakeSecretLister(),
+				testlisters.SetFakeSecretNamespaceListerGet(test.secret, nil),
+			)
+			if test.secret == nil {
+				secretLister = testlisters.FakeSecretListerFrom(testlisters.NewFakeSecretLister(),
+					testlisters.SetFakeSecretNamespaceListerGet(nil, apierrors.NewNotFound(corev1.Resource("secrets"), "scep-challenge")),
+				)
+			}
+			c := &controller{
+				secretLister: secretLister,
+				issuerHelper: fakeIssuerHelper{issuer: test.issuer},
+			}
+
+			x509CSR, err := pki.GenerateCSR(crt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrDER, err := c.encodeCSR(crt, x509CSR, pk)
+			if (err != nil) != test.expErr {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if test.expErr {
+				return
+			}
+
+			password, ok, err := pki.ChallengePasswordFromCSR(csrDER)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != (test.expPassword != "") || password != test.expPassword {
+				t.Errorf("unexpected challenge password, exp=%q got=%q", test.expPassword, password)
+			}
+		})
+	}
+}
+
+func TestCheckTransformedExtensions(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer"}),
+	)
+	issuer := func(mods ...gen.IssuerModifier) cmapi.GenericIssuer {
+		mods = append(mods, gen.SetIssuerNamespace("testns"), gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}))
+		return gen.Issuer("ca-issuer", mods...)
+	}
+	extensions := []cmapi.X509Extension{{ID: "1.2.3.4", Value: []byte{0x05, 0x00}}}
+
+	tests := map[string]struct {
+		issuer       cmapi.GenericIssuer
+		extensions   []cmapi.X509Extension
+		expViolation bool
+	}{
+		"should allow requests without extensions": {
+			issuer: issuer(),
+		},
+		"should allow extensions if the issuer does not exist": {
+			extensions: extensions,
+		},
+		"should allow extensions allowed by the policy of the issuer": {
+			issuer:     issuer(gen.SetIssuerPolicy(cmapi.IssuerPolicy{AllowedExtensions: []string{"1.2.3.4"}})),
+			extensions: extensions,
+		},
+		"should not allow extensions not allowed by the policy of the issuer": {
+			issuer:       issuer(),
+			extensions:   extensions,
+			expViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &controller{issuerHelper: fakeIssuerHelper{issuer: test.issuer}}
+			violation, err := c.checkTransformedExtensions(crt, test.extensions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (violation != nil) != test.expViolation {
+				t.Errorf("unexpected violation, exp=%t got=%v", test.expViolation, violation)
+			}
+		})
+	}
+}
+
+func TestBuildCSR(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer"}),
+	)
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := func(mods ...gen.IssuerModifier) cmapi.GenericIssuer {
+		mods = append(mods, gen.SetIssuerNamespace("testns"), gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}))
+		return gen.Issuer("ca-issuer", mods...)
+	}
+	extensions := []cmapi.X509Extension{{ID: "1.2.3.4", Value: []byte{0x05, 0x00}}}
+	addExtension := csrtransform.TransformerFunc(func(_ context.Context, _ *cmapi.Certificate, req *csrtransform.Request) error {
+		req.CSR.Subject.Organization = []string{"transformed"}
+		req.Extensions = append(req.Extensions, extensions...)
+		return nil
+	})
+
+	tests := map[string]struct {
+		transformer csrtransform.Transformer
+		issuer      cmapi.GenericIssuer
+
+		expCSR          bool
+		expOrganization []string
+		expExtensions   []cmapi.X509Extension
+		expEvents       []string
+		expErr          bool
+	}{
+		"should build the request if no transformers are registered": {
+			issuer: issuer(),
+			expCSR: true,
+		},
+		"should build the transformed request with the extensions allowed by the issuer": {
+			transformer:     addExtension,
+			issuer:          issuer(gen.SetIssuerPolicy(cmapi.IssuerPolicy{AllowedExtensions: []string{"1.2.3.4"}})),
+			expCSR:          true,
+			expOrganization: []string{"transformed"},
+			expExtensions:   extensions,
+		},
+		"should not build the request if the issuer does not allow the extensions": {
+			transformer: addExtension,
+			issuer:      issuer(),
+			expEvents:   []string{"Warning IssuerPolicyViolation Transformed CSR violates the policy of the issuer: extension 1.2.3.4 is not allowed by the issuer"},
+		},
+		"should error if a transformer fails": {
+			transformer: csrtransform.TransformerFunc(func(context.Context, *cmapi.Certificate, *csrtransform.Request) error {
+				return errors.New("failed")
+			}),
+			issuer:    issuer(),
+			expEvents: []string{`Warning CSRTransformFailed Failed to transform CSR: CSR transformer "test" failed: failed`},
+			expErr:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.transformer != nil {
+				csrtransform.Register("test", test.transformer)
+				defer csrtransform.Unregister("test")
+			}
+			recorder := record.NewFakeRecorder(10)
+			c := &controller{
+				issuerHelper: fakeIssuerHelper{issuer: test.issuer},
+				recorder:     recorder,
+			}
+
+			csrPEM, gotExtensions, err := c.buildCSR(context.Background(), crt, crt, pk)
+			if (err != nil) != test.expErr {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if (csrPEM != nil) != test.expCSR {
+				t.Fatalf("unexpected request, exp=%t got=%q", test.expCSR, csrPEM)
+			}
+			if !reflect.DeepEqual(gotExtensions, test.expExtensions) {
+				t.Errorf("unexpected extensions, exp=%v got=%v", test.expExtensions, gotExtensions)
+			}
+			close(recorder.Events)
+			var gotEvents []string
+			for event := range recorder.Events {
+				gotEvents = append(gotEvents, event)
+			}
+			if !reflect.DeepEqual(gotEvents, test.expEvents) {
+				t.Errorf("unexpected events, exp=%v got=%v", test.expEvents, gotEvents)
+			}
+			if csrPEM == nil {
+				return
+			}
+
+			x509Req, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if x509Req.Subject.CommonName != "example.com" || !reflect.DeepEqual(x509Req.Subject.Organization, test.expOrganization) {
+				t.Errorf("unexpected subject %v", x509Req.Subject)
+			}
+		})
+	}
+}
+
+type fakeKMSPlugin struct{}
+
+func (fakeKMSPlugin) Encrypt(_ context.Context, dataKey []byte) ([]byte, error) {
+	return dataKey, nil
+}
+
+func (fakeKMSPlugin) Decrypt(_ context.Context, encryptedDataKey []byte) ([]byte, error) {
+	return encryptedDataKey, nil
+}
+
+func TestPrivateKeySignerSealedKey(t *testing.T) {
+	crt := gen.Certificate("test", gen.SetCertificateNamespace("testns"))
+	pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := pki.SealPrivateKey(context.Background(), fakeKMSPlugin{}, "fake", pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	missing := *sealed
+	missing.Plugin = "missing"
+
+	tests := map[string]struct {
+		key       *pki.SealedKey
+		expSigner bool
+		expEvents []string
+	}{
+		"should unseal the key using its KMS plugin": {
+			key:       sealed,
+			expSigner: true,
+		},
+		"should return no signer if the KMS plugin is not configured": {
+			key:       &missing,
+			expEvents: []string{`Warning KMSPluginNotFound KMS plugin "missing" is not configured`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			c := &controller{
+				recorder:   recorder,
+				kmsPlugins: map[string]pki.KMSPlugin{"fake": fakeKMSPlugin{}},
+			}
+
+			signer, err := c.privateKeySigner(context.Background(), crt, test.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if !reflect.DeepEqual(events, test.expEvents) {
+				t.Errorf("unexpected events, exp=%v got=%v", test.expEvents, events)
+			}
+			if (signer != nil) != test.expSigner {
+				t.Fatalf("unexpected signer, exp=%t got=%T", test.expSigner, signer)
+			}
+			if signer == nil {
+				return
+			}
+
+			if _, ok := signer.(*pki.SealedKey); ok {
+				t.Fatalf("expected the key to be unsealed")
+			}
+			if matches, err := pki.PublicKeysEqual(signer.Public(), pk.Public()); err != nil || !matches {
+				t.Errorf("expected the unsealed key to match the sealed key, err=%v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func mustCreateCertificate(t *testing.T, template, parent *x509.Certificate, pub crypto.PublicKey, priv crypto.Signer) (*x509.Certificate, []byte) {
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crt, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crt, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	metaNow := metav1.NewTime(now)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour * 24),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	ca, caPEM := mustCreateCertificate(t, caTemplate, caTemplate, caKey.Public(), caKey)
+
+	// ocspResponse is returned by the OCSP responder, unless the responder is
+	// configured to fail.
+	var ocspResponse []byte
+	failResponder := false
+	responder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failResponder {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.Write(ocspResponse)
+	}))
+	defer responder.Close()
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour * 12),
+		OCSPServer:   []string{responder.URL},
+	}
+	leaf, leafPEM := mustCreateCertificate(t, leafTemplate, ca, leafKey.Public(), caKey)
+
+	mustCreateResponse := func(status int) []byte {
+		resp, err := ocsp.CreateResponse(ca, ca, ocsp.Response{
+			Status:       status,
+			SerialNumber: leaf.SerialNumber,
+			ThisUpdate:   now,
+			NextUpdate:   now.Add(time.Hour * 4),
+			RevokedAt:    now.Add(-time.Minute),
+		}, caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	goodResponse := mustCreateResponse(ocsp.Good)
+	revokedResponse := mustCreateResponse(ocsp.Revoked)
+	revokedMessage := "OCSP responder reports the certificate as revoked at " + now.Add(-time.Minute).UTC().Format(time.RFC3339)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "test-secret"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey: leafPEM,
+			cmmeta.TLSCAKey:   caPEM,
+		},
+	}
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateGeneration(42),
+	)
+
+	tests := map[string]struct {
+		certificate   *cmapi.Certificate
+		ocspResponse  []byte
+		failResponder bool
+
+		expectedCertificate *cmapi.Certificate
+		expectedEvents      []string
+		expectedErr         bool
+	}{
+		"do nothing if the certificate has not been revoked": {
+			certificate:  crt,
+			ocspResponse: goodResponse,
+		},
+		"trigger issuance if the certificate has been revoked": {
+			certificate:  crt,
+			ocspResponse: revokedResponse,
+			expectedCertificate: gen.CertificateFrom(crt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionIssuing,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "Revoked",
+					Message:            revokedMessage,
+					LastTransitionTime: &metaNow,
+					ObservedGeneration: 42,
+				}),
+			),
+			expectedEvents: []string{"Warning Revoked " + revokedMessage},
+		},
+		"do nothing if the certificate is already being issued": {
+			certificate: gen.CertificateFrom(crt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:   cmapi.CertificateConditionIssuing,
+					Status: cmmeta.ConditionTrue,
+				}),
+			),
+			ocspResponse: revokedResponse,
+		},
+		"do nothing if the certificate has been revoked but issuance failed in the last hour": {
+			certificate: gen.CertificateFrom(crt,
+				gen.SetCertificateLastFailureTime(metav1.NewTime(now.Add(-time.Minute*30))),
+			),
+			ocspResponse: revokedResponse,
+		},
+		"fire an event and return an error if the OCSP responder fails": {
+			certificate:    crt,
+			failResponder:  true,
+			expectedEvents: []string{`Warning OCSPCheckFailed Failed to check revocation status with ` + responder.URL + `: unexpected response status "500 Internal Server Error"`},
+			expectedErr:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ocspResponse = test.ocspResponse
+			failResponder = test.failResponder
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{test.certificate},
+				KubeObjects:        []runtime.Object{secret},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.expectedCertificate != nil {
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						test.expectedCertificate.Namespace,
+						test.expectedCertificate,
+					)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			w.httpClient = responder.Client()
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.certificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = w.ProcessItem(context.Background(), key)
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceips
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the service IPs controller. It is not
+	// enabled by default.
+	ControllerName = "certificates-service-ips"
+)
+
+// This controller keeps the `spec.ipAddresses` of Certificates annotated with
+// `cert-manager.io/ip-sans-from-service` in sync with the ClusterIPs and
+// LoadBalancer ingress IPs of the named Service. Changes to the IP addresses
+// cause the Certificate to be re-issued by the trigger controller.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	serviceLister     corelisters.ServiceLister
+	client            cmclient.Interface
+	recorder          record.EventRecorder
+}
+
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	serviceInformer := factory.Core().V1().Services()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Service resource changes, enqueue any Certificate resources that name it in their annotations.
+	serviceInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateServiceIPSANsService)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		serviceInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister: certificateInformer.Lister(),
+		serviceLister:     serviceInformer.Lister(),
+		client:            client,
+		recorder:          recorder,
+	}, queue, mustSync
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("certificate not found for key")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, crt)
+	dbg = log.V(logf.DebugLevel)
+
+	serviceName := crt.Annotations[cmapi.ServiceIPSANsAnnotationKey]
+	if serviceName == "" {
+		return nil
+	}
+
+	svc, err := c.serviceLister.Services(crt.Namespace).Get(serviceName)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("service not found, waiting for it to be created", "service", serviceName)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithRelatedResource(log, svc)
+	dbg = log.V(logf.DebugLevel)
+
+	ips := serviceIPAddresses(svc)
+	if len(ips) == 0 {
+		dbg.Info("service does not have any IP addresses yet, skipping")
+		return nil
+	}
+	if sets.NewString(ips...).Equal(sets.NewString(crt.Spec.IPAddresses...)) {
+		dbg.Info("certificate IP addresses are up to date")
+		return nil
+	}
+
+	crt = crt.DeepCopy()
+	crt.Spec.IPAddresses = ips
+	if _, err := c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonIPAddressesUpdated, "Updated IP addresses to match Service %q: %s", svc.Name, strings.Join(ips, ", "))
+
+	return nil
+}
+
+// serviceIPAddresses returns the ClusterIPs and LoadBalancer ingress IPs of
+// the given Service, in that order and without duplicates. Both IPv4 and IPv6
+// addresses are returned for dual-stack Services.
+func serviceIPAddresses(svc *corev1.Service) []string {
+	candidates := svc.Spec.ClusterIPs
+	if len(candidates) == 0 {
+		candidates = []string{svc.Spec.ClusterIP}
+	}
+	for _, ing := range svc.Status.LoadBalancer.Ingress {
+		candidates = append(candidates, ing.IP)
+	}
+
+	var ips []string
+	seen := sets.NewString()
+	for _, candidate := range candidates {
+		// headless Services have a ClusterIP of "None"
+		if net.ParseIP(candidate) == nil || seen.Has(candidate) {
+			continue
+		}
+		seen.Insert(candidate)
+		ips = append(ips, candidate)
+	}
+
+	return ips
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceips
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.AddCertificateAnnotations(map[string]string{cmapi.ServiceIPSANsAnnotationKey: "test-service"}),
+	)
+	service := func(clusterIPs []string, lbIPs ...string) *corev1.Service {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "test-service"},
+			Spec: corev1.ServiceSpec{
+				ClusterIPs: clusterIPs,
+			},
+		}
+		if len(clusterIPs) > 0 {
+			svc.Spec.ClusterIP = clusterIPs[0]
+		}
+		for _, ip := range lbIPs {
+			svc.Status.LoadBalancer.Ingress = append(svc.Status.LoadBalancer.Ingress, corev1.LoadBalancerIngress{IP: ip})
+		}
+		return svc
+	}
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		service     *corev1.Service
+
+		expectedIPs    []string
+		expectedEvents []string
+	}{
+		"do nothing if the Certificate does not have the annotation": {
+			certificate: gen.Certificate("test",
+				gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+				gen.SetCertificateSecretName("test-secret"),
+			),
+			service: service([]string{"10.0.0.1"}),
+		},
+		"do nothing if the Service does not exist": {
+			certificate: baseCrt,
+		},
+		"do nothing if the Service is headless": {
+			certificate: baseCrt,
+			service:     service([]string{corev1.ClusterIPNone}),
+		},
+		"set the IPv4 and IPv6 ClusterIPs and LoadBalancer IPs of the Service": {
+			certificate:    baseCrt,
+			service:        service([]string{"10.0.0.1", "fd00::1"}, "203.0.113.1", "2001:db8::1", "10.0.0.1"),
+			expectedIPs:    []string{"10.0.0.1", "fd00::1", "203.0.113.1", "2001:db8::1"},
+			expectedEvents: []string{`Normal IPAddressesUpdated Updated IP addresses to match Service "test-service": 10.0.0.1, fd00::1, 203.0.113.1, 2001:db8::1`},
+		},
+		"replace IP addresses that no longer match the Service": {
+			certificate:    gen.CertificateFrom(baseCrt, gen.SetCertificateIPs("10.0.0.1", "10.0.0.2")),
+			service:        service([]string{"10.0.0.3"}),
+			expectedIPs:    []string{"10.0.0.3"},
+			expectedEvents: []string{`Normal IPAddressesUpdated Updated IP addresses to match Service "test-service": 10.0.0.3`},
+		},
+		"do nothing if the IP addresses already match in a different order": {
+			certificate: gen.CertificateFrom(baseCrt, gen.SetCertificateIPs("fd00::1", "10.0.0.1")),
+			service:     service([]string{"10.0.0.1", "fd00::1"}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.certificate},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.service != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.service)
+			}
+			if test.expectedIPs != nil {
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						test.certificate.Namespace,
+						gen.CertificateFrom(test.certificate, gen.SetCertificateIPs(test.expectedIPs...)),
+					)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.certificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := w.ProcessItem(context.Background(), key); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
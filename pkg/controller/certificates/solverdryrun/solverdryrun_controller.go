@@ -0,0 +1,324 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solverdryrun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/acmeorders"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ControllerName is the name of the ACME solver dry-run controller. It is
+	// not enabled by default.
+	ControllerName = "certificates-acme-solver-dry-run"
+)
+
+// This controller records the ACME challenge solver that would be selected
+// for each DNS name of Certificates annotated with
+// `acme.cert-manager.io/solver-dry-run: "true"` in `status.acmeSolvers`.
+// Solvers are selected in the same way as by the orders controller, assuming
+// that the ACME server offers both HTTP01 and DNS01 challenges for names
+// which are not wildcards. No Order is created and the ACME server is never
+// contacted.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	client            cmclient.Interface
+	helper            issuer.Helper
+
+	// lookupFQDN returns the name of the DNS01 challenge record for a
+	// domain, and findZone the DNS zone that a record is created in. They
+	// are overridden in tests.
+	lookupFQDN func(domain string, followCNAME bool) (string, error)
+	findZone   func(fqdn string) (string, error)
+}
+
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	cmFactory cminformers.SharedInformerFactory,
+	isNamespaced bool,
+	dns01Nameservers []string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When the solvers of an issuer change, re-compute the selected solvers
+	// of all Certificates in dry-run mode.
+	issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueueDryRunCertificates(log, queue, certificateInformer.Lister()),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if !isNamespaced {
+		clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: enqueueDryRunCertificates(log, queue, certificateInformer.Lister()),
+		})
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+	}
+
+	return &controller{
+		certificateLister: certificateInformer.Lister(),
+		client:            client,
+		helper:            issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+		lookupFQDN: func(domain string, followCNAME bool) (string, error) {
+			return dnsutil.DNS01LookupFQDN(domain, followCNAME, dns01Nameservers...)
+		},
+		findZone: func(fqdn string) (string, error) {
+			return dnsutil.FindZoneByFqdn(fqdn, dns01Nameservers)
+		},
+	}, queue, mustSync
+}
+
+// enqueueDryRunCertificates enqueues all Certificates which are annotated
+// to record the ACME solvers that would be selected for them.
+func enqueueDryRunCertificates(log logr.Logger, queue workqueue.Interface, lister cmlisters.CertificateLister) func(obj interface{}) {
+	return func(obj interface{}) {
+		crts, err := lister.List(labels.Everything())
+		if err != nil {
+			log.Error(err, "failed listing Certificate resources")
+			return
+		}
+		for _, crt := range crts {
+			if !isDryRun(crt) {
+				continue
+			}
+			key, err := controllerpkg.KeyFunc(crt)
+			if err != nil {
+				log.Error(err, "error computing key for resource")
+				continue
+			}
+			queue.Add(key)
+		}
+	}
+}
+
+func isDryRun(crt *cmapi.Certificate) bool {
+	return crt.Annotations[cmapi.ACMESolverDryRunAnnotationKey] == "true"
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("certificate not found for key")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	var solvers []cmapi.CertificateACMESolverStatus
+	if isDryRun(crt) {
+		genericIssuer, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+		if apierrors.IsNotFound(err) {
+			log.V(logf.DebugLevel).Info("issuer not found, waiting for it to be created", "issuer", crt.Spec.IssuerRef.Name)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if genericIssuer.GetSpec().ACME != nil {
+			solvers = c.selectSolvers(ctx, crt, genericIssuer)
+		}
+	}
+
+	if apiequality.Semantic.DeepEqual(solvers, crt.Status.ACMESolvers) {
+		return nil
+	}
+
+	dbg.Info("updating the ACME solvers recorded in the certificate status")
+	crt = crt.DeepCopy()
+	crt.Status.ACMESolvers = solvers
+	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+	return err
+}
+
+// selectSolvers selects a solver of the ACME issuer for each DNS name of the
+// Certificate.
+func (c *controller) selectSolvers(ctx context.Context, crt *cmapi.Certificate, genericIssuer cmapi.GenericIssuer) []cmapi.CertificateACMESolverStatus {
+	cnameTarget := func(domain string) (string, error) {
+		return c.lookupFQDN(domain, true)
+	}
+
+	var solvers []cmapi.CertificateACMESolverStatus
+	for _, dnsName := range certificateDNSNames(crt) {
+		wc := strings.HasPrefix(dnsName, "*.")
+		identifier := strings.TrimPrefix(dnsName, "*.")
+		// Wildcard names can only be validated using DNS01 challenges.
+		challenges := []cmacme.ACMEChallenge{{Type: "http-01"}, {Type: "dns-01"}}
+		if wc {
+			challenges = []cmacme.ACMEChallenge{{Type: "dns-01"}}
+		}
+		authz := cmacme.ACMEAuthorization{
+			Identifier: identifier,
+			Wildcard:   &wc,
+			Challenges: challenges,
+		}
+
+		status := cmapi.CertificateACMESolverStatus{DNSName: dnsName}
+		selection := acmeorders.SelectSolver(ctx, genericIssuer, crt.ObjectMeta, authz, cnameTarget)
+		if selection == nil {
+			status.Message = "No configured challenge solvers can be used for this DNS name"
+			if name := crt.Annotations[cmacme.ACMECertificateSolverNameOverride]; name != "" {
+				status.Message = fmt.Sprintf("The solver %q pinned by the %s annotation does not exist or cannot be used for this DNS name", name, cmacme.ACMECertificateSolverNameOverride)
+			}
+			solvers = append(solvers, status)
+			continue
+		}
+
+		index := selection.Index
+		status.SolverIndex = &index
+		if selection.NumLabelsMatch > 0 {
+			status.MatchedSelectors = append(status.MatchedSelectors, "matchLabels")
+		}
+		if selection.NumDNSNamesMatch > 0 {
+			status.MatchedSelectors = append(status.MatchedSelectors, "dnsNames")
+		}
+		if selection.NumDNSZonesMatch > 0 {
+			status.MatchedSelectors = append(status.MatchedSelectors, "dnsZones")
+		}
+
+		if selection.Solver.DNS01 == nil {
+			status.Type = string(cmacme.ACMEChallengeTypeHTTP01)
+			solvers = append(solvers, status)
+			continue
+		}
+
+		status.Type = string(cmacme.ACMEChallengeTypeDNS01)
+		zone, err := c.challengeZone(identifier, selection.Solver.DNS01)
+		if err != nil {
+			status.Message = fmt.Sprintf("Failed to determine the DNS zone of the challenge record: %v", err)
+		}
+		status.Zone = zone
+		solvers = append(solvers, status)
+	}
+
+	return solvers
+}
+
+// challengeZone returns the DNS zone that the DNS01 challenge record for the
+// given domain would be created in by the given solver.
+func (c *controller) challengeZone(domain string, solver *cmacme.ACMEChallengeSolverDNS01) (string, error) {
+	fqdn, err := c.lookupFQDN(domain, solver.CNAMEStrategy == cmacme.FollowStrategy)
+	if err != nil {
+		return "", err
+	}
+	zone, err := c.findZone(fqdn)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(zone, "."), nil
+}
+
+// certificateDNSNames returns the DNS names that would be validated by the
+// ACME server when issuing the Certificate. Internationalized domain names
+// are returned in their A-label form, as they are in ACME identifiers.
+func certificateDNSNames(crt *cmapi.Certificate) []string {
+	var dnsNames []string
+	for _, dnsName := range crt.Spec.DNSNames {
+		if ascii, err := util.DNSNameToASCII(dnsName); err == nil {
+			dnsName = ascii
+		}
+		dnsNames = append(dnsNames, dnsName)
+	}
+	commonName := pki.CommonNameToASCII(crt.Spec.CommonName)
+	if commonName == "" {
+		return dnsNames
+	}
+	for _, dnsName := range dnsNames {
+		if dnsName == commonName {
+			return dnsNames
+		}
+	}
+	return append([]string{commonName}, dnsNames...)
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.SharedInformerFactory,
+		ctx.Namespace != "",
+		ctx.ACMEOptions.DNS01Nameservers,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
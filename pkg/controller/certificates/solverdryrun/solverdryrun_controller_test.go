@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solverdryrun
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	httpSolver := cmacme.ACMEChallengeSolver{
+		HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+			Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+		},
+	}
+	dnsSolver := cmacme.ACMEChallengeSolver{
+		Selector: &cmacme.CertificateDNSNameSelector{
+			MatchLabels: map[string]string{"dns": "true"},
+			DNSZones:    []string{"example.com"},
+		},
+		DNS01: &cmacme.ACMEChallengeSolverDNS01{
+			Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{},
+		},
+	}
+	acmeIssuer := gen.Issuer("test-issuer",
+		gen.SetIssuerACME(cmacme.ACMEIssuer{
+			Solvers: []cmacme.ACMEChallengeSolver{httpSolver, dnsSolver},
+		}),
+	)
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "test-issuer"}),
+		gen.SetCertificateDNSNames("example.com", "*.example.com"),
+		gen.AddCertificateAnnotations(map[string]string{cmapi.ACMESolverDryRunAnnotationKey: "true"}),
+	)
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		issuer      *cmapi.Issuer
+		findZoneErr error
+
+		expectedSolvers []cmapi.CertificateACMESolverStatus
+		expectUpdate    bool
+	}{
+		"do nothing if the Certificate does not have the annotation": {
+			certificate: gen.Certificate("test",
+				gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "test-issuer"}),
+				gen.SetCertificateDNSNames("example.com"),
+			),
+			issuer: acmeIssuer,
+		},
+		"do nothing if the issuer does not exist": {
+			certificate: baseCrt,
+		},
+		"do nothing if the issuer is not an ACME issuer": {
+			certificate: baseCrt,
+			issuer:      gen.Issuer("test-issuer", gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{})),
+		},
+		"record the selected solver of each DNS name": {
+			certificate: baseCrt,
+			issuer:      acmeIssuer,
+			expectedSolvers: []cmapi.CertificateACMESolverStatus{
+				{
+					DNSName:     "example.com",
+					Type:        "HTTP-01",
+					SolverIndex: pointer.IntPtr(0),
+				},
+				{
+					DNSName: "*.example.com",
+					Message: "No configured challenge solvers can be used for this DNS name",
+				},
+			},
+			expectUpdate: true,
+		},
+		"record the matched selectors and zone of DNS01 solvers": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.AddCertificateLabels(map[string]string{"dns": "true"}),
+			),
+			issuer: acmeIssuer,
+			expectedSolvers: []cmapi.CertificateACMESolverStatus{
+				{
+					DNSName:          "example.com",
+					Type:             "DNS-01",
+					SolverIndex:      pointer.IntPtr(1),
+					MatchedSelectors: []string{"matchLabels", "dnsZones"},
+					Zone:             "example.com",
+				},
+				{
+					DNSName:          "*.example.com",
+					Type:             "DNS-01",
+					SolverIndex:      pointer.IntPtr(1),
+					MatchedSelectors: []string{"matchLabels", "dnsZones"},
+					Zone:             "example.com",
+				},
+			},
+			expectUpdate: true,
+		},
+		"record a message if the zone of the challenge record cannot be determined": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateDNSNames("*.example.com"),
+				gen.AddCertificateLabels(map[string]string{"dns": "true"}),
+			),
+			issuer:      acmeIssuer,
+			findZoneErr: errors.New("no SOA record"),
+			expectedSolvers: []cmapi.CertificateACMESolverStatus{
+				{
+					DNSName:          "*.example.com",
+					Type:             "DNS-01",
+					SolverIndex:      pointer.IntPtr(1),
+					MatchedSelectors: []string{"matchLabels", "dnsZones"},
+					Message:          "Failed to determine the DNS zone of the challenge record: no SOA record",
+				},
+			},
+			expectUpdate: true,
+		},
+		"do nothing if the recorded solvers are up to date": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateDNSNames("example.com"),
+				gen.SetCertificateACMESolvers(cmapi.CertificateACMESolverStatus{
+					DNSName:     "example.com",
+					Type:        "HTTP-01",
+					SolverIndex: pointer.IntPtr(0),
+				}),
+			),
+			issuer: acmeIssuer,
+		},
+		"remove the recorded solvers if the annotation is removed": {
+			certificate: gen.Certificate("test",
+				gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "test-issuer"}),
+				gen.SetCertificateDNSNames("example.com"),
+				gen.SetCertificateACMESolvers(cmapi.CertificateACMESolverStatus{
+					DNSName:     "example.com",
+					Type:        "HTTP-01",
+					SolverIndex: pointer.IntPtr(0),
+				}),
+			),
+			issuer:       acmeIssuer,
+			expectUpdate: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.certificate},
+			}
+			if test.issuer != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects,
+					gen.IssuerFrom(test.issuer, gen.SetIssuerNamespace(test.certificate.Namespace)))
+			}
+			if test.expectUpdate {
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						test.certificate.Namespace,
+						gen.CertificateFrom(test.certificate, gen.SetCertificateACMESolvers(test.expectedSolvers...)),
+					)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			w.lookupFQDN = func(domain string, followCNAME bool) (string, error) {
+				return "_acme-challenge." + domain + ".", nil
+			}
+			w.findZone = func(fqdn string) (string, error) {
+				return "example.com.", test.findZoneErr
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.certificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := w.ProcessItem(context.Background(), key); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
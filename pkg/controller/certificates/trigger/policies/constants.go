@@ -41,4 +41,15 @@ const (
 	// Expired is a policy violation reason for a scenario where Certificate has
 	// expired.
 	Expired string = "Expired"
+	// SecretTampered is a policy violation reason for a scenario where the
+	// certificate or CA stored in Certificate's spec.secretName secret has
+	// been replaced since it was issued.
+	SecretTampered string = "SecretTampered"
+	// RenewRequested is a reason for a scenario where re-issuance of the
+	// Certificate has been requested using spec.renewRequestTime.
+	RenewRequested string = "RenewRequested"
+	// CARotated is a policy violation reason for a scenario where the CA
+	// certificate of the Certificate's CA issuer has been renewed since the
+	// certificate stored in spec.secretName secret was issued.
+	CARotated string = "CARotated"
 )
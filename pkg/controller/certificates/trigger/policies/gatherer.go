@@ -209,16 +209,20 @@ package policies
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
 	"github.com/jetstack/cert-manager/pkg/util/predicate"
 )
 
@@ -227,6 +231,14 @@ import (
 type Gatherer struct {
 	CertificateRequestLister cmlisters.CertificateRequestLister
 	SecretLister             corelisters.SecretLister
+
+	// IssuerHelper is used to look up the CA certificate of the issuer of
+	// Certificates which are re-issued when it is rotated. If nil, the CA
+	// certificate is not gathered.
+	IssuerHelper issuer.Helper
+	// ClusterResourceNamespace is the namespace that the CA Secrets of
+	// ClusterIssuers are read from.
+	ClusterResourceNamespace string
 }
 
 // DataForCertificate returns the secret as well as the "current" and "next"
@@ -242,7 +254,7 @@ type Gatherer struct {
 func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificate) (Input, error) {
 	log := logf.FromContext(ctx)
 	// Attempt to fetch the Secret being managed but tolerate NotFound errors.
-	secret, err := g.SecretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	secret, err := g.SecretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
 	if err != nil && !apierrors.IsNotFound(err) {
 		return Input{}, err
 	}
@@ -317,5 +329,37 @@ func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificat
 		Secret:                 secret,
 		CurrentRevisionRequest: curCR,
 		NextRevisionRequest:    nextCR,
+		IssuerCA:               g.issuerCA(ctx, crt),
 	}, nil
 }
+
+// issuerCA returns the current CA certificate of the CA issuer of the given
+// Certificate, if it has opted in to being re-issued when the CA certificate
+// is rotated. It returns nil if the CA certificate cannot be read, in which
+// case the Certificate is not re-issued until it can be.
+func (g *Gatherer) issuerCA(ctx context.Context, crt *cmapi.Certificate) *x509.Certificate {
+	if g.IssuerHelper == nil || !certificates.ReissueOnCARotation(crt) {
+		return nil
+	}
+	log := logf.FromContext(ctx)
+
+	iss, err := g.IssuerHelper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("Cannot get issuer to check whether its CA certificate has been rotated", "error", err.Error())
+		return nil
+	}
+	if iss.GetSpec().CA == nil {
+		return nil
+	}
+
+	namespace := iss.GetObjectMeta().Namespace
+	if namespace == "" {
+		namespace = g.ClusterResourceNamespace
+	}
+	ca, err := kube.SecretTLSCert(ctx, g.SecretLister, namespace, iss.GetSpec().CA.SecretName)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("Cannot get CA certificate of issuer to check whether it has been rotated", "error", err.Error())
+		return nil
+	}
+	return ca
+}
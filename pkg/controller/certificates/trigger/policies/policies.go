@@ -18,7 +18,10 @@ limitations under the License.
 package policies
 
 import (
+	"bytes"
+	"crypto"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	"k8s.io/utils/clock"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,6 +51,11 @@ type Input struct {
 	// Take a look at the gatherer package's documentation to see more about why
 	// we care about the "next" certificate request.
 	NextRevisionRequest *cmapi.CertificateRequest
+
+	// IssuerCA is the current CA certificate of the Certificate's issuer. It
+	// is only gathered for Certificates issued by a CA issuer which have
+	// opted in to being re-issued when the CA certificate is rotated.
+	IssuerCA *x509.Certificate
 }
 
 // A Func evaluates the given input data and decides whether a
@@ -70,15 +79,27 @@ func (c Chain) Evaluate(input Input) (string, string, bool) {
 	return "", "", false
 }
 
-func NewTriggerPolicyChain(c clock.Clock) Chain {
+// NewTriggerPolicyChain constructs the chain of policies used to decide
+// whether a Certificate must be (re-)issued. If adoptExistingSecrets is true,
+// a Secret which may be adopted by a Certificate that has not been issued
+// yet is not re-issued merely because it was not issued by cert-manager; it
+// is still re-issued if it is not valid for the Certificate's spec or is due
+// for renewal.
+func NewTriggerPolicyChain(c clock.Clock, jitter certificates.RenewalJitter, adoptExistingSecrets bool) Chain {
+	issuerAnnotationsNotUpToDate := SecretIssuerAnnotationsNotUpToDate
+	if adoptExistingSecrets {
+		issuerAnnotationsNotUpToDate = unlessSecretAdoptable(SecretIssuerAnnotationsNotUpToDate)
+	}
 	return Chain{
 		SecretDoesNotExist,
 		SecretIsMissingData,
 		SecretPublicKeysDiffer,
+		SecretCertificateMatchesIssued,
 		SecretPrivateKeyMatchesSpec,
-		SecretIssuerAnnotationsNotUpToDate,
+		issuerAnnotationsNotUpToDate,
 		CurrentCertificateRequestNotValidForSpec,
-		CurrentCertificateNearingExpiry(c),
+		CurrentCertificateNearingExpiry(c, jitter),
+		CurrentCertificateIssuedByRotatedCA(c),
 	}
 }
 
@@ -107,6 +128,17 @@ func SecretIsMissingData(input Input) (string, string, bool) {
 func SecretPublicKeysDiffer(input Input) (string, string, bool) {
 	pkData := input.Secret.Data[corev1.TLSPrivateKeyKey]
 	certData := input.Secret.Data[corev1.TLSCertKey]
+	// The Secret of a Certificate with an external private key contains a
+	// reference to the key, and that of a Certificate with a sealed private
+	// key contains the encrypted key. Only their public keys can be checked.
+	if pk, err := pki.DecodePrivateKeyBytes(pkData); err == nil {
+		switch key := pk.(type) {
+		case *pki.ExternalKey:
+			return publicKeyDiffers(key, "external private key", certData)
+		case *pki.SealedKey:
+			return publicKeyDiffers(key, "sealed private key", certData)
+		}
+	}
 	// TODO: replace this with a generic decoder that can handle different
 	//  formats such as JKS, P12 etc (i.e. add proper support for keystores)
 	_, err := tls.X509KeyPair(certData, pkData)
@@ -116,6 +148,51 @@ func SecretPublicKeysDiffer(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+func publicKeyDiffers(key crypto.Signer, description string, certData []byte) (string, string, bool) {
+	cert, err := pki.DecodeX509CertificateBytes(certData)
+	if err != nil {
+		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains an invalid key-pair: %v", err), true
+	}
+	matches, err := pki.PublicKeyMatchesCertificate(key.Public(), cert)
+	if err != nil || !matches {
+		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains an invalid key-pair: %s does not match the certificate", description), true
+	}
+	return "", "", false
+}
+
+// SecretCertificateMatchesIssued checks that the certificate and CA stored in
+// the Secret are those that were issued by the current CertificateRequest,
+// catching the case where they have since been replaced by hand.
+// If the current CertificateRequest is not available, the check is skipped.
+func SecretCertificateMatchesIssued(input Input) (string, string, bool) {
+	req := input.CurrentRevisionRequest
+	if req == nil || len(req.Status.Certificate) == 0 {
+		return "", "", false
+	}
+
+	issued, err := pki.DecodeX509CertificateBytes(req.Status.Certificate)
+	if err != nil {
+		// The Secret cannot be compared against a CertificateRequest that
+		// cannot be decoded.
+		return "", "", false
+	}
+	stored, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains invalid certificate data: %v", err), true
+	}
+	if !stored.Equal(issued) {
+		return SecretTampered, "Issuing certificate as Secret contains a certificate which was not issued for this Certificate", true
+	}
+
+	// The CA stored in the Secret is composed from the issued chain, rather
+	// than copied from the CertificateRequest, if `spec.caChain` is set.
+	if input.Certificate.Spec.CAChain == nil && len(req.Status.CA) > 0 && !bytes.Equal(input.Secret.Data[cmmeta.TLSCAKey], req.Status.CA) {
+		return SecretTampered, "Issuing certificate as Secret contains a CA which does not match the issued CA", true
+	}
+
+	return "", "", false
+}
+
 func SecretPrivateKeyMatchesSpec(input Input) (string, string, bool) {
 	if input.Secret.Data == nil || len(input.Secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
 		return SecretMismatch, "Existing issued Secret does not contain private key data", true
@@ -141,12 +218,38 @@ func SecretIssuerAnnotationsNotUpToDate(input Input) (string, string, bool) {
 	name := input.Secret.Annotations[cmapi.IssuerNameAnnotationKey]
 	kind := input.Secret.Annotations[cmapi.IssuerKindAnnotationKey]
 	group := input.Secret.Annotations[cmapi.IssuerGroupAnnotationKey]
-	if name != input.Certificate.Spec.IssuerRef.Name ||
-		!issuerKindsEqual(kind, input.Certificate.Spec.IssuerRef.Kind) ||
-		!issuerGroupsEqual(group, input.Certificate.Spec.IssuerRef.Group) {
-		return IncorrectIssuer, fmt.Sprintf("Issuing certificate as Secret was previously issued by %s", formatIssuerRef(name, kind, group)), true
+	// The Secret may have been issued by any of the issuers the Certificate
+	// may fail over to.
+	for _, ref := range certificates.IssuerRefs(input.Certificate.Spec) {
+		if name == ref.Name && issuerKindsEqual(kind, ref.Kind) && issuerGroupsEqual(group, ref.Group) {
+			return "", "", false
+		}
+	}
+	return IncorrectIssuer, fmt.Sprintf("Issuing certificate as Secret was previously issued by %s", formatIssuerRef(name, kind, group)), true
+}
+
+// SecretAdoptable returns true if the given Secret was not issued by
+// cert-manager and may be adopted by the given Certificate, which must not
+// have been issued yet. Secrets annotated with the name of a Certificate or
+// an issuer were written by cert-manager and are never adopted.
+func SecretAdoptable(crt *cmapi.Certificate, secret *corev1.Secret) bool {
+	if crt.Status.Revision != nil || secret == nil {
+		return false
+	}
+	_, hasCertificateName := secret.Annotations[cmapi.CertificateNameKey]
+	_, hasIssuerName := secret.Annotations[cmapi.IssuerNameAnnotationKey]
+	return !hasCertificateName && !hasIssuerName
+}
+
+// unlessSecretAdoptable returns a policy function which skips the given
+// policy if the input's Secret may be adopted by its Certificate.
+func unlessSecretAdoptable(policy Func) Func {
+	return func(input Input) (string, string, bool) {
+		if SecretAdoptable(input.Certificate, input.Secret) {
+			return "", "", false
+		}
+		return policy(input)
 	}
-	return "", "", false
 }
 
 func CurrentCertificateRequestNotValidForSpec(input Input) (string, string, bool) {
@@ -194,8 +297,8 @@ func currentSecretValidForSpec(input Input) (string, string, bool) {
 
 // CurrentCertificateNearingExpiry returns a policy function that can be used to
 // check whether an X.509 cert currently issued for a Certificate should be
-// renewed.
-func CurrentCertificateNearingExpiry(c clock.Clock) Func {
+// renewed. The renewal time is brought forward by the given jitter.
+func CurrentCertificateNearingExpiry(c clock.Clock, jitter certificates.RenewalJitter) Func {
 
 	return func(input Input) (string, string, bool) {
 
@@ -214,13 +317,19 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 		notAfter := metav1.NewTime(x509cert.NotAfter)
 		crt := input.Certificate
 		renewalTime := certificates.RenewalTime(notBefore.Time, notAfter.Time, crt.Spec.RenewBefore)
+		jitteredRenewalTime := jitter.Apply(crt, notBefore.Time, notAfter.Time, renewalTime.Time)
 
-		renewIn := renewalTime.Time.Sub(c.Now())
+		renewIn := jitteredRenewalTime.Sub(c.Now())
 		if renewIn > 0 {
 			//renewal time is in future, no need to renew
 			return "", "", false
 		}
 
+		if jitteredRenewalTime.Before(renewalTime.Time) {
+			return Renewing, fmt.Sprintf("Renewing certificate as renewal was scheduled at %s, brought forward from %s by renewal jitter",
+				metav1.NewTime(jitteredRenewalTime), input.Certificate.Status.RenewalTime), true
+		}
+
 		return Renewing, fmt.Sprintf("Renewing certificate as renewal was scheduled at %s", input.Certificate.Status.RenewalTime), true
 	}
 }
@@ -249,6 +358,52 @@ func CurrentCertificateHasExpired(c clock.Clock) Func {
 	}
 }
 
+// CurrentCertificateIssuedByRotatedCA returns a policy function that triggers
+// a re-issuance of Certificates which opted in to it using the
+// `cert-manager.io/reissue-on-ca-rotation` annotation, once the CA
+// certificate of their CA issuer has been renewed.
+// The stored certificate is considered to be issued by a previous CA
+// certificate if it was not signed by the current one, or if its chain
+// embeds an older CA certificate with the same subject.
+// Re-issuance waits until the new CA certificate is valid, so that the
+// previous chain remains valid while Certificates are rolled over.
+func CurrentCertificateIssuedByRotatedCA(c clock.Clock) Func {
+	return func(input Input) (string, string, bool) {
+		ca := input.IssuerCA
+		if ca == nil || !certificates.ReissueOnCARotation(input.Certificate) {
+			return "", "", false
+		}
+		if c.Now().Before(ca.NotBefore) {
+			return "", "", false
+		}
+
+		certs, err := pki.DecodeX509CertificateChainBytes(input.Secret.Data[corev1.TLSCertKey])
+		if err != nil || len(certs) == 0 {
+			return "", "", false
+		}
+		leaf := certs[0]
+		// Certificates issued after the current CA certificate cannot have
+		// been issued by an earlier one.
+		if !leaf.NotBefore.Before(ca.NotBefore) {
+			return "", "", false
+		}
+		if leaf.CheckSignatureFrom(ca) != nil {
+			return CARotated, "Re-issuing certificate as it was not signed by the current CA certificate of its issuer", true
+		}
+
+		if caCerts, err := pki.DecodeX509CertificateChainBytes(input.Secret.Data[cmmeta.TLSCAKey]); err == nil {
+			certs = append(certs, caCerts...)
+		}
+		for _, cert := range certs[1:] {
+			if bytes.Equal(cert.RawSubject, ca.RawSubject) && !cert.Equal(ca) && cert.NotBefore.Before(ca.NotBefore) {
+				return CARotated, "Re-issuing certificate as its chain contains a previous CA certificate of its issuer", true
+			}
+		}
+
+		return "", "", false
+	}
+}
+
 func formatIssuerRef(name, kind, group string) string {
 	if group == "" {
 		group = "cert-manager.io"
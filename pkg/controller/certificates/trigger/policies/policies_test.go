@@ -17,6 +17,12 @@ limitations under the License.
 package policies
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
 	"testing"
 	"time"
 
@@ -26,7 +32,9 @@ import (
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
 	internaltest "github.com/jetstack/cert-manager/pkg/controller/certificates/internal/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 // Runs a full set of tests against the 'policy chain' once it is composed
@@ -34,9 +42,93 @@ import (
 // These tests account for the ordering of the policy chain, and are in place
 // to ensure we do not break behaviour when introducing a new policy or
 // modifying existing code.
+// mustEncodeExternalKey returns a reference to an external key with the
+// public key of the given PEM encoded private key.
+func mustEncodeExternalKey(t *testing.T, pkData []byte) []byte {
+	pk, err := pki.DecodePrivateKeyBytes(pkData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := pki.EncodeExternalKey(&pki.ExternalKey{Provider: "kms", Reference: "key", PublicKey: pk.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// mustEncodeSealedKey returns the given PEM encoded private key sealed with a
+// KMS plugin which does not encrypt its data key.
+func mustEncodeSealedKey(t *testing.T, pkData []byte) []byte {
+	pk, err := pki.DecodePrivateKeyBytes(pkData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := pki.SealPrivateKey(context.Background(), plainKMSPlugin{}, "kms", pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := pki.EncodeSealedKey(sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+type plainKMSPlugin struct{}
+
+func (plainKMSPlugin) Encrypt(_ context.Context, dataKey []byte) ([]byte, error) {
+	return dataKey, nil
+}
+
+func (plainKMSPlugin) Decrypt(_ context.Context, encryptedDataKey []byte) ([]byte, error) {
+	return encryptedDataKey, nil
+}
+
 func TestDefaultPolicyChain(t *testing.T) {
 	clock := &fakeclock.FakeClock{}
 	staticFixedPrivateKey := internaltest.MustCreatePEMPrivateKey(t)
+	issuedCert := internaltest.MustCreateCert(t, staticFixedPrivateKey,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	replacedCert := internaltest.MustCreateCert(t, staticFixedPrivateKey,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	issuedRequest := func(ca []byte) *cmapi.CertificateRequest {
+		return &cmapi.CertificateRequest{
+			Spec: cmapi.CertificateRequestSpec{
+				IssuerRef: cmmeta.ObjectReference{
+					Name:  "testissuer",
+					Kind:  "IssuerKind",
+					Group: "group.example.com",
+				},
+				Request: internaltest.MustGenerateCSRImpl(t, staticFixedPrivateKey, &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+				}}),
+			},
+			Status: cmapi.CertificateRequestStatus{
+				Certificate: issuedCert,
+				CA:          ca,
+			},
+		}
+	}
+	issuedSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "something",
+				Annotations: map[string]string{
+					cmapi.IssuerNameAnnotationKey:  "testissuer",
+					cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+					cmapi.IssuerGroupAnnotationKey: "group.example.com",
+				},
+			},
+			Data: data,
+		}
+	}
+	issuedCertificate := &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+		CommonName: "example.com",
+		IssuerRef: cmmeta.ObjectReference{
+			Name:  "testissuer",
+			Kind:  "IssuerKind",
+			Group: "group.example.com",
+		},
+	}}
 	tests := map[string]struct {
 		// policy inputs
 		certificate *cmapi.Certificate
@@ -130,6 +222,34 @@ func TestDefaultPolicyChain(t *testing.T) {
 			message: "Issuing certificate as Secret contains an invalid key-pair: tls: private key does not match public key",
 			reissue: true,
 		},
+		"trigger issuance as Secret contains an external private key which does not match the certificate": {
+			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretName: "something"}},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: mustEncodeExternalKey(t, internaltest.MustCreatePEMPrivateKey(t)),
+					corev1.TLSCertKey: internaltest.MustCreateCert(t, internaltest.MustCreatePEMPrivateKey(t),
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+					),
+				},
+			},
+			reason:  InvalidKeyPair,
+			message: "Issuing certificate as Secret contains an invalid key-pair: external private key does not match the certificate",
+			reissue: true,
+		},
+		"trigger issuance as Secret contains a sealed private key which does not match the certificate": {
+			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretName: "something"}},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: mustEncodeSealedKey(t, internaltest.MustCreatePEMPrivateKey(t)),
+					corev1.TLSCertKey: internaltest.MustCreateCert(t, internaltest.MustCreatePEMPrivateKey(t),
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+					),
+				},
+			},
+			reason:  InvalidKeyPair,
+			message: "Issuing certificate as Secret contains an invalid key-pair: sealed private key does not match the certificate",
+			reissue: true,
+		},
 		"trigger issuance as Secret has old/incorrect 'issuer name' annotation": {
 			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
 				SecretName: "something",
@@ -289,6 +409,76 @@ func TestDefaultPolicyChain(t *testing.T) {
 				}}),
 			}},
 		},
+		"do nothing if Secret and CertificateRequest were issued by a fallback issuer": {
+			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				CommonName: "example.com",
+				IssuerRef: cmmeta.ObjectReference{
+					Name: "testissuer",
+				},
+				FallbackIssuerRefs: []cmmeta.ObjectReference{{
+					Name:  "fallbackissuer",
+					Kind:  "IssuerKind",
+					Group: "group.example.com",
+				}},
+			}},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "fallbackissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: internaltest.MustCreateCert(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "does-not-matter.example.com"}},
+					),
+				},
+			},
+			request: &cmapi.CertificateRequest{Spec: cmapi.CertificateRequestSpec{
+				IssuerRef: cmmeta.ObjectReference{
+					Name:  "fallbackissuer",
+					Kind:  "IssuerKind",
+					Group: "group.example.com",
+				},
+				Request: internaltest.MustGenerateCSRImpl(t, staticFixedPrivateKey, &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+				}}),
+			}},
+		},
+		"trigger issuance as Secret contains a certificate which was not issued by the current CertificateRequest": {
+			certificate: issuedCertificate,
+			secret: issuedSecret(map[string][]byte{
+				corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+				corev1.TLSCertKey:       replacedCert,
+			}),
+			request: issuedRequest(nil),
+			reason:  SecretTampered,
+			message: "Issuing certificate as Secret contains a certificate which was not issued for this Certificate",
+			reissue: true,
+		},
+		"trigger issuance as Secret contains a CA which was not issued by the current CertificateRequest": {
+			certificate: issuedCertificate,
+			secret: issuedSecret(map[string][]byte{
+				corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+				corev1.TLSCertKey:       issuedCert,
+				cmmeta.TLSCAKey:         replacedCert,
+			}),
+			request: issuedRequest(issuedCert),
+			reason:  SecretTampered,
+			message: "Issuing certificate as Secret contains a CA which does not match the issued CA",
+			reissue: true,
+		},
+		"do nothing if Secret contains the certificate and CA issued by the current CertificateRequest": {
+			certificate: issuedCertificate,
+			secret: issuedSecret(map[string][]byte{
+				corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+				corev1.TLSCertKey:       issuedCert,
+				cmmeta.TLSCAKey:         issuedCert,
+			}),
+			request: issuedRequest(issuedCert),
+		},
 		"compare signed x509 certificate in Secret with spec if CertificateRequest does not exist": {
 			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
 				CommonName: "new.example.com",
@@ -485,7 +675,7 @@ func TestDefaultPolicyChain(t *testing.T) {
 			},
 		},
 	}
-	policyChain := NewTriggerPolicyChain(clock)
+	policyChain := NewTriggerPolicyChain(clock, certificates.RenewalJitter{}, false)
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			reason, message, reissue := policyChain.Evaluate(Input{
@@ -506,3 +696,208 @@ func TestDefaultPolicyChain(t *testing.T) {
 		})
 	}
 }
+
+func TestTriggerPolicyChainAdoptExistingSecrets(t *testing.T) {
+	clock := &fakeclock.FakeClock{}
+	pk := internaltest.MustCreatePEMPrivateKey(t)
+	existingSecret := func(annotations map[string]string, commonName string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "something", Annotations: annotations},
+			Data: map[string][]byte{
+				corev1.TLSPrivateKeyKey: pk,
+				corev1.TLSCertKey: internaltest.MustCreateCert(t, pk,
+					&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: commonName}}),
+			},
+		}
+	}
+	certificate := func(revision *int) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{
+				CommonName: "example.com",
+				IssuerRef:  cmmeta.ObjectReference{Name: "testissuer"},
+			},
+			Status: cmapi.CertificateStatus{Revision: revision},
+		}
+	}
+	revision := 1
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		secret      *corev1.Secret
+
+		reason  string
+		reissue bool
+	}{
+		"do not reissue a valid Secret which was not issued by cert-manager": {
+			certificate: certificate(nil),
+			secret:      existingSecret(nil, "example.com"),
+		},
+		"reissue an existing Secret which is not valid for the spec": {
+			certificate: certificate(nil),
+			secret:      existingSecret(nil, "other.example.com"),
+			reason:      SecretMismatch,
+			reissue:     true,
+		},
+		"reissue a Secret which was issued by another issuer": {
+			certificate: certificate(nil),
+			secret:      existingSecret(map[string]string{cmapi.IssuerNameAnnotationKey: "oldissuer"}, "example.com"),
+			reason:      IncorrectIssuer,
+			reissue:     true,
+		},
+		"reissue a Secret without issuer annotations once the Certificate has been issued": {
+			certificate: certificate(&revision),
+			secret:      existingSecret(nil, "example.com"),
+			reason:      IncorrectIssuer,
+			reissue:     true,
+		},
+	}
+	policyChain := NewTriggerPolicyChain(clock, certificates.RenewalJitter{}, true)
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, _, reissue := policyChain.Evaluate(Input{
+				Certificate: test.certificate,
+				Secret:      test.secret,
+			})
+			if test.reason != reason {
+				t.Errorf("unexpected 'reason' exp=%s, got=%s", test.reason, reason)
+			}
+			if test.reissue != reissue {
+				t.Errorf("unexpected 'reissue' exp=%v, got=%v", test.reissue, reissue)
+			}
+		})
+	}
+}
+
+func TestCurrentCertificateIssuedByRotatedCA(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	clock := fakeclock.NewFakeClock(now)
+
+	mustGenerateKey := func() *ecdsa.PrivateKey {
+		key, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+	mustSign := func(template, parent *x509.Certificate, pub crypto.PublicKey, signer crypto.Signer) ([]byte, *x509.Certificate) {
+		pemBytes, cert, err := pki.SignCertificate(template, parent, pub, signer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pemBytes, cert
+	}
+	// createCA returns a self-signed CA certificate with a fixed subject,
+	// which is valid from the given time.
+	createCA := func(key *ecdsa.PrivateKey, notBefore time.Time) ([]byte, *x509.Certificate) {
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(notBefore.Unix()),
+			Subject:               pkix.Name{CommonName: "test-ca"},
+			NotBefore:             notBefore,
+			NotAfter:              notBefore.Add(365 * 24 * time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}
+		return mustSign(template, template, key.Public(), key)
+	}
+	// createLeaf returns a certificate for example.com signed by the given
+	// CA, which is valid from the given time.
+	leafKey := mustGenerateKey()
+	createLeaf := func(ca *x509.Certificate, caKey *ecdsa.PrivateKey, notBefore time.Time) []byte {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(notBefore.Unix()),
+			Subject:      pkix.Name{CommonName: "example.com"},
+			DNSNames:     []string{"example.com"},
+			NotBefore:    notBefore,
+			NotAfter:     notBefore.Add(90 * 24 * time.Hour),
+		}
+		pemBytes, _ := mustSign(template, ca, leafKey.Public(), caKey)
+		return pemBytes
+	}
+
+	oldKey, newKey := mustGenerateKey(), mustGenerateKey()
+	oldCAPEM, oldCA := createCA(oldKey, now.Add(-30*24*time.Hour))
+	_, rotatedCA := createCA(newKey, now.Add(-time.Hour))
+	_, futureCA := createCA(newKey, now.Add(time.Hour))
+	_, renewedCA := createCA(oldKey, now.Add(-time.Hour))
+
+	oldLeaf := createLeaf(oldCA, oldKey, now.Add(-7*24*time.Hour))
+	optedIn := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{cmapi.ReissueOnCARotationAnnotationKey: "true"},
+	}}
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		issuerCA    *x509.Certificate
+		tlsCrt      []byte
+		caCrt       []byte
+
+		reason  string
+		reissue bool
+	}{
+		"do nothing if the CA certificate of the issuer was not gathered": {
+			certificate: optedIn,
+			tlsCrt:      oldLeaf,
+			caCrt:       oldCAPEM,
+		},
+		"do nothing if the Certificate has not opted in": {
+			certificate: &cmapi.Certificate{},
+			issuerCA:    rotatedCA,
+			tlsCrt:      oldLeaf,
+			caCrt:       oldCAPEM,
+		},
+		"do nothing if the certificate was issued by the current CA certificate": {
+			certificate: optedIn,
+			issuerCA:    oldCA,
+			tlsCrt:      oldLeaf,
+			caCrt:       oldCAPEM,
+		},
+		"reissue if the certificate was signed by a previous CA key": {
+			certificate: optedIn,
+			issuerCA:    rotatedCA,
+			tlsCrt:      oldLeaf,
+			caCrt:       oldCAPEM,
+			reason:      CARotated,
+			reissue:     true,
+		},
+		"reissue if the CA certificate was renewed with the same key but the Secret embeds the previous one": {
+			certificate: optedIn,
+			issuerCA:    renewedCA,
+			tlsCrt:      oldLeaf,
+			caCrt:       oldCAPEM,
+			reason:      CARotated,
+			reissue:     true,
+		},
+		"do not reissue until the rotated CA certificate is valid": {
+			certificate: optedIn,
+			issuerCA:    futureCA,
+			tlsCrt:      oldLeaf,
+			caCrt:       oldCAPEM,
+		},
+		"do nothing if the certificate was issued after the current CA certificate": {
+			certificate: optedIn,
+			issuerCA:    rotatedCA,
+			tlsCrt:      createLeaf(oldCA, oldKey, now),
+			caCrt:       oldCAPEM,
+		},
+	}
+	policy := CurrentCertificateIssuedByRotatedCA(clock)
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, _, reissue := policy(Input{
+				Certificate: test.certificate,
+				Secret: &corev1.Secret{Data: map[string][]byte{
+					corev1.TLSCertKey: test.tlsCrt,
+					cmmeta.TLSCAKey:   test.caCrt,
+				}},
+				IssuerCA: test.issuerCA,
+			})
+			if test.reason != reason {
+				t.Errorf("unexpected 'reason' exp=%s, got=%s", test.reason, reason)
+			}
+			if test.reissue != reissue {
+				t.Errorf("unexpected 'reissue' exp=%v, got=%v", test.reissue, reissue)
+			}
+		})
+	}
+}
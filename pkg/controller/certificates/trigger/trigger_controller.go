@@ -18,6 +18,7 @@ package trigger
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -41,8 +42,12 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/trigger/policies"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/scheduler"
+	"github.com/jetstack/cert-manager/pkg/tracing"
+	"github.com/jetstack/cert-manager/pkg/util/audit"
 	"github.com/jetstack/cert-manager/pkg/util/predicate"
 )
 
@@ -57,9 +62,11 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             corelisters.SecretLister
+	issuerHelper             issuer.Helper
 	client                   cmclient.Interface
 	recorder                 record.EventRecorder
 	scheduledWorkQueue       scheduler.ScheduledWorkQueue
+	renewalJitter            certificates.RenewalJitter
 
 	// The following are used for testing purposes.
 	clock              clock.Clock
@@ -75,27 +82,40 @@ func NewController(
 	recorder record.EventRecorder,
 	clock clock.Clock,
 	shouldReissue policies.Func,
+	renewalJitter certificates.RenewalJitter,
+	namespace string,
+	clusterResourceNamespace string,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
-	// create a queue used to queue up items to be processed
-	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+	// create a queue used to queue up items to be processed. Certificates
+	// which are due for renewal are processed before routine reconciles.
+	queue := controllerpkg.NewPriorityQueue(clock, workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30))
 
 	// obtain references to all the informers used by this controller
 	certificateInformer := cmFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := factory.Core().V1().Secrets()
-
-	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
+
+	// The handlers below are registered without a resync period, so that
+	// the shared informers' periodic resync does not cause every
+	// Certificate to be re-processed at once. Certificates are instead
+	// re-queued individually at their renewal time using the
+	// scheduledWorkQueue.
+	certificateInformer.Informer().AddEventHandlerWithResyncPeriod(&controllerpkg.PriorityQueuingEventHandler{
+		Queue:        queue,
+		PriorityFunc: certificatePriority(clock),
+	}, 0)
 
 	// When a CertificateRequest resource changes, enqueue the Certificate resource that owns it.
-	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+	certificateRequestInformer.Informer().AddEventHandlerWithResyncPeriod(&controllerpkg.BlockingEventHandler{
 		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(), predicate.ResourceOwnerOf),
-	})
+	}, 0)
 	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
-	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+	secretsInformer.Informer().AddEventHandlerWithResyncPeriod(&controllerpkg.BlockingEventHandler{
 		// Trigger reconciles on changes to the Secret named `spec.secretName`
-		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
 			predicate.ExtractResourceName(predicate.CertificateSecretName)),
-	})
+	}, 0)
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
@@ -103,15 +123,42 @@ func NewController(
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
 	}
 
+	// Issuers are read to apply their certificate defaults. ClusterIssuers
+	// can only be read when not scoped to a single namespace.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if namespace == "" {
+		clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	// When the CA Secret of a CA issuer changes, e.g. because the CA
+	// Certificate has been renewed, enqueue the Certificates of that issuer
+	// which are re-issued when its CA certificate is rotated.
+	secretsInformer.Informer().AddEventHandlerWithResyncPeriod(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForCASecret(log, queue, certificateInformer.Lister(), issuerInformer.Lister(), clusterIssuerLister, clusterResourceNamespace),
+	}, 0)
+
+	// Re-checks are scheduled for when a Certificate is due for renewal, so
+	// are processed with a high priority.
+	scheduledWorkQueue := scheduler.NewScheduledWorkQueue(clock, func(obj interface{}) {
+		queue.AddWithPriority(obj, controllerpkg.PriorityHigh)
+	})
+
+	issuerHelper := issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister)
+
 	return &controller{
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		issuerHelper:             issuerHelper,
 		client:                   client,
 		recorder:                 recorder,
-		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		scheduledWorkQueue:       scheduledWorkQueue,
+		renewalJitter:            renewalJitter,
 
 		// The following are used for testing purposes.
 		clock:         clock,
@@ -119,11 +166,13 @@ func NewController(
 		dataForCertificate: (&policies.Gatherer{
 			CertificateRequestLister: certificateRequestInformer.Lister(),
 			SecretLister:             secretsInformer.Lister(),
+			IssuerHelper:             issuerHelper,
+			ClusterResourceNamespace: clusterResourceNamespace,
 		}).DataForCertificate,
 	}, queue, mustSync
 }
 
-func (c *controller) ProcessItem(ctx context.Context, key string) error {
+func (c *controller) ProcessItem(ctx context.Context, key string) (err error) {
 	log := logf.FromContext(ctx).WithValues("key", key)
 	ctx = logf.NewContext(ctx, log)
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
@@ -135,11 +184,23 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	crt, err := c.certificateLister.Certificates(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
 		log.Error(err, "certificate not found for key")
+		// Stop any scheduled re-check for a Certificate which no longer exists.
+		c.scheduledWorkQueue.Forget(key)
 		return nil
 	}
 	if err != nil {
 		return err
 	}
+
+	log = logf.WithCertificate(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	ctx, span := tracing.Start(tracing.CertificateContext(ctx, crt), ControllerName, cmapi.CertificateKind, crt)
+	defer func() { tracing.End(span, err) }()
+	if certificates.IssuancePaused(crt) {
+		log.V(logf.DebugLevel).Info("issuance is paused for certificate, skipping")
+		return nil
+	}
 	if apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
@@ -148,7 +209,17 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
-	input, err := c.dataForCertificate(ctx, crt)
+	// Re-issuance explicitly requested using spec.renewRequestTime is
+	// triggered immediately, without backing off from previous failures.
+	if renewRequested(crt) {
+		message := fmt.Sprintf("Re-issuance requested at %s", crt.Spec.RenewRequestTime.Format(time.RFC3339))
+		return c.triggerIssuance(ctx, log, crt, policies.RenewRequested, message)
+	}
+
+	// Whether the Certificate needs re-issuing is decided on its spec with
+	// the certificate defaults of its issuer applied.
+	effective, _ := certificates.WithIssuerDefaults(c.issuerHelper, crt)
+	input, err := c.dataForCertificate(ctx, effective)
 	if err != nil {
 		return err
 	}
@@ -162,10 +233,23 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
+	var recheckTime time.Time
 	if crt.Status.RenewalTime != nil {
+		recheckTime = crt.Status.RenewalTime.Time
+		if crt.Status.NotBefore != nil && crt.Status.NotAfter != nil {
+			recheckTime = c.renewalJitter.Apply(crt, crt.Status.NotBefore.Time, crt.Status.NotAfter.Time, recheckTime)
+		}
+	}
+	// A rotated CA certificate which is not valid yet only triggers a
+	// re-issuance once it becomes valid, so re-check at that time if it is
+	// earlier than the renewal time.
+	if ca := input.IssuerCA; ca != nil && c.clock.Now().Before(ca.NotBefore) && (recheckTime.IsZero() || ca.NotBefore.Before(recheckTime)) {
+		recheckTime = ca.NotBefore
+	}
+	if !recheckTime.IsZero() {
 		// ensure a resync is scheduled in the future so that we re-check
 		// Certificate resources and trigger them near expiry time
-		c.scheduleRecheckOfCertificateIfRequired(log, key, crt.Status.RenewalTime.Time.Sub(c.clock.Now()))
+		c.scheduleRecheckOfCertificateIfRequired(log, key, recheckTime.Sub(c.clock.Now()))
 	}
 
 	reason, message, reissue := c.shouldReissue(input)
@@ -174,6 +258,45 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
+	// Renewals which are due outside of the Certificate's renewal window are
+	// deferred until the next window opens.
+	if reason == policies.Renewing {
+		if next, deferred := renewalDeferredUntil(log, c.clock.Now(), crt); deferred {
+			log.V(logf.InfoLevel).Info("Deferring renewal of certificate until its next renewal window", "window_opens", next)
+			c.scheduleRecheckOfCertificateIfRequired(log, key, next.Sub(c.clock.Now()))
+			return nil
+		}
+	}
+
+	return c.triggerIssuance(ctx, log, crt, reason, message)
+}
+
+// renewalDeferredUntil returns the time at which the next renewal window of
+// the Certificate opens, and true if its renewal must be deferred until then.
+// Renewals are never deferred until after the current certificate expires.
+func renewalDeferredUntil(log logr.Logger, now time.Time, crt *cmapi.Certificate) (time.Time, bool) {
+	if crt.Spec.RenewalWindow == nil {
+		return time.Time{}, false
+	}
+	next, err := certificates.NextRenewalWindowTime(crt.Spec.RenewalWindow, now)
+	if err != nil {
+		log.Error(err, "ignoring invalid renewal window")
+		return time.Time{}, false
+	}
+	if !next.After(now) {
+		return time.Time{}, false
+	}
+	if crt.Status.NotAfter != nil && !next.Before(crt.Status.NotAfter.Time) {
+		log.V(logf.InfoLevel).Info("Not deferring renewal of certificate as it expires before its next renewal window", "window_opens", next)
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// triggerIssuance sets the Issuing condition on the Certificate to True with
+// the given reason and message. Any outstanding spec.renewRequestTime is
+// recorded as handled, since the issuance will satisfy it.
+func (c *controller) triggerIssuance(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, reason, message string) error {
 	// Although the below recorder.Event already logs the event, the log
 	// line is quite unreadable (very long). Since this information is very
 	// important for the user and the operator, we log the following
@@ -182,15 +305,67 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue, reason, message)
-	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+	if renewRequested(crt) {
+		crt.Status.LastRenewRequestTime = crt.Spec.RenewRequestTime.DeepCopy()
+	}
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
 	if err != nil {
 		return err
 	}
-	c.recorder.Event(crt, corev1.EventTypeNormal, "Issuing", message)
+	if secretTampered(crt, reason) {
+		c.recorder.Event(crt, corev1.EventTypeWarning, events.ReasonSecretTampered,
+			fmt.Sprintf("Secret %q has been modified since it was issued: %s", crt.Spec.SecretName, message))
+	}
+	annotations := audit.ForCertificate(audit.DecisionReissue, crt).Set(audit.ReasonKey, reason)
+	c.recorder.AnnotatedEventf(crt, annotations.EventAnnotations(), corev1.EventTypeNormal, events.ReasonIssuing, message)
 
 	return nil
 }
 
+// secretTampered returns true if the given policy violation reason means that
+// the contents of a Secret which has previously been issued for the
+// Certificate have since been modified or removed.
+func secretTampered(crt *cmapi.Certificate, reason string) bool {
+	if crt.Status.Revision == nil {
+		return false
+	}
+	switch reason {
+	case policies.MissingData, policies.InvalidKeyPair, policies.SecretTampered:
+		return true
+	}
+	return false
+}
+
+// certificatePriority returns a function which returns the priority with
+// which a Certificate should be processed. Certificates which are due for
+// renewal, or for which a renewal has been requested, are processed before
+// any other Certificates.
+func certificatePriority(clock clock.Clock) func(obj interface{}) controllerpkg.Priority {
+	return func(obj interface{}) controllerpkg.Priority {
+		crt, ok := obj.(*cmapi.Certificate)
+		if !ok {
+			return controllerpkg.PriorityNormal
+		}
+		if renewRequested(crt) {
+			return controllerpkg.PriorityHigh
+		}
+		if crt.Status.RenewalTime != nil && !clock.Now().Before(crt.Status.RenewalTime.Time) {
+			return controllerpkg.PriorityHigh
+		}
+		return controllerpkg.PriorityNormal
+	}
+}
+
+// renewRequested returns true if spec.renewRequestTime has been set to a time
+// later than the last renewal request that triggered a re-issuance.
+func renewRequested(crt *cmapi.Certificate) bool {
+	if crt.Spec.RenewRequestTime == nil {
+		return false
+	}
+	last := crt.Status.LastRenewRequestTime
+	return last == nil || last.Before(crt.Spec.RenewRequestTime)
+}
+
 // shouldBackoffReissuingOnFailure tells us if we should back-off re-issuing for
 // an hour or not. Notably, it returns no back-off when the certificate doesn't
 // match the "next" certificate (since a mismatch means that this certificate
@@ -267,13 +442,21 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	// construct a new named logger to be reused throughout the controller
 	log := logf.FromContext(ctx.RootContext, ControllerName)
 
+	renewalJitter := certificates.RenewalJitter{
+		Percentage: ctx.CertificateOptions.RenewalJitterPercentage,
+		Window:     ctx.CertificateOptions.RenewalJitterWindow,
+	}
+
 	ctrl, queue, mustSync := NewController(log,
 		ctx.CMClient,
 		ctx.KubeSharedInformerFactory,
 		ctx.SharedInformerFactory,
 		ctx.Recorder,
 		ctx.Clock,
-		policies.NewTriggerPolicyChain(ctx.Clock).Evaluate,
+		policies.NewTriggerPolicyChain(ctx.Clock, renewalJitter, ctx.CertificateOptions.AdoptExistingSecrets).Evaluate,
+		renewalJitter,
+		ctx.Namespace,
+		ctx.IssuerOptions.ClusterResourceNamespace,
 	)
 	c.controller = ctrl
 
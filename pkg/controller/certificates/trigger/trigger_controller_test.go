@@ -18,6 +18,7 @@ package trigger
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"testing"
 	"time"
@@ -33,6 +34,7 @@ import (
 	internaltest "github.com/jetstack/cert-manager/pkg/controller/certificates/internal/test"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/trigger/policies"
 	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	schedulertest "github.com/jetstack/cert-manager/pkg/scheduler/test"
 	"github.com/jetstack/cert-manager/test/unit/gen"
 )
 
@@ -40,6 +42,10 @@ func Test_controller_ProcessItem(t *testing.T) {
 	fixedNow := metav1.NewTime(time.Now())
 	fixedClock := fakeclock.NewFakeClock(fixedNow.Time)
 
+	// The renewal window used by tests opens at a whole minute two hours
+	// from now.
+	windowOpens := fixedNow.UTC().Add(2 * time.Hour).Truncate(time.Minute)
+
 	// We don't need to full bundle, just a simple CertificateRequest.
 	createCertificateRequestOrPanic := func(crt *cmapi.Certificate) *cmapi.CertificateRequest {
 		return internaltest.MustCreateCryptoBundle(t, crt, fixedClock).CertificateRequest
@@ -68,21 +74,41 @@ func Test_controller_ProcessItem(t *testing.T) {
 		// remainder is the message.
 		wantEvent string
 
+		// wantWarningEvent, if set, is an 'event string' that is expected to
+		// be fired before wantEvent.
+		wantWarningEvent string
+
 		// wantConditions is the expected set of conditions on the Certificate
 		// resource if an Update is made.
 		// If nil, no update is expected.
 		// If empty, an update to the empty set/nil is expected.
 		wantConditions []cmapi.CertificateCondition
 
+		// wantLastRenewRequestTime is the expected status.lastRenewRequestTime
+		// on the Certificate resource if an Update is made.
+		wantLastRenewRequestTime *metav1.Time
+
+		// wantScheduledRecheck is the expected delay after which the
+		// Certificate is scheduled to be re-checked. If zero, no re-check is
+		// expected to be scheduled.
+		wantScheduledRecheck time.Duration
+
+		// wantForgotten is true if any scheduled re-check of the Certificate
+		// is expected to be cancelled.
+		wantForgotten bool
+
 		// wantErr is the expected error text returned by the controller, if any.
 		wantErr string
 	}{
-		"do nothing if an empty 'key' is used": {},
+		"do nothing if an empty 'key' is used": {
+			wantForgotten: true,
+		},
 		"do nothing if an invalid 'key' is used": {
 			key: "abc/def/ghi",
 		},
 		"do nothing if a key references a Certificate that does not exist": {
-			key: "namespace/name",
+			key:           "namespace/name",
+			wantForgotten: true,
 		},
 		"should do nothing if Certificate already has 'Issuing' condition": {
 			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
@@ -94,6 +120,56 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}),
 			),
 		},
+		"should do nothing if Certificate has the issuance-paused annotation": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.AddCertificateAnnotations(map[string]string{
+					cmapi.IssuancePausedAnnotationKey: "true",
+				}),
+			),
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "ForceTriggered", "Re-issuance forced by unit test case", true
+				}
+			},
+		},
+		"should set Issuing=True without backing off if spec.renewRequestTime is later than status.lastRenewRequestTime": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateLastFailureTime(fixedNow),
+				func(crt *cmapi.Certificate) {
+					crt.Spec.RenewRequestTime = &fixedNow
+					crt.Status.LastRenewRequestTime = &metav1.Time{Time: fixedNow.Add(-time.Hour)}
+				},
+			),
+			wantEvent: "Normal Issuing Re-issuance requested at " + fixedNow.Format(time.RFC3339),
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             "RenewRequested",
+				Message:            "Re-issuance requested at " + fixedNow.Format(time.RFC3339),
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+			wantLastRenewRequestTime: &fixedNow,
+		},
+		"should not set Issuing=True if spec.renewRequestTime has already been handled": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				func(crt *cmapi.Certificate) {
+					crt.Spec.RenewRequestTime = &fixedNow
+					crt.Status.LastRenewRequestTime = &fixedNow
+				},
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "", "", false
+				}
+			},
+		},
 		"should call shouldReissue with the correct cert, secret and current CR": {
 			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
 				gen.SetCertificateSecretName("secret-1"),
@@ -130,6 +206,81 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}
 			},
 		},
+		"should schedule a re-check of the Certificate at its renewal time": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateRenewalTIme(metav1.NewTime(fixedNow.Add(time.Hour))),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "", "", false
+				}
+			},
+			wantScheduledRecheck: time.Hour,
+		},
+		"should schedule a re-check of the Certificate when the rotated CA certificate of its issuer becomes valid, if that is before its renewal time": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateRenewalTIme(metav1.NewTime(fixedNow.Add(time.Hour))),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{
+				IssuerCA: &x509.Certificate{NotBefore: fixedNow.Add(10 * time.Minute)},
+			},
+			wantShouldReissueCalled: true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "", "", false
+				}
+			},
+			wantScheduledRecheck: 10 * time.Minute,
+		},
+		"should defer a renewal which is due outside of the Certificate's renewal window until the window opens": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateRenewalWindow(cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{{
+					Start: windowOpens.Format("15:04"),
+					End:   windowOpens.Add(time.Hour).Format("15:04"),
+				}}}),
+				gen.SetCertificateNotAfter(metav1.NewTime(fixedNow.Add(24*time.Hour))),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.Renewing, "Renewing certificate", true
+				}
+			},
+			wantScheduledRecheck: windowOpens.Sub(fixedNow.Time),
+		},
+		"should not defer a renewal until the Certificate's renewal window if the certificate expires first": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateRenewalWindow(cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{{
+					Start: windowOpens.Format("15:04"),
+					End:   windowOpens.Add(time.Hour).Format("15:04"),
+				}}}),
+				gen.SetCertificateNotAfter(metav1.NewTime(fixedNow.Add(time.Hour))),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.Renewing, "Renewing certificate", true
+				}
+			},
+			wantEvent: "Normal Issuing Renewing certificate",
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             policies.Renewing,
+				Message:            "Renewing certificate",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
 		"should log error when dataForCertificate errors": {
 			existingCertificate:             gen.Certificate("cert-1", gen.SetCertificateNamespace("testns")),
 			wantDataForCertificateCalled:    true,
@@ -158,6 +309,31 @@ func Test_controller_ProcessItem(t *testing.T) {
 				ObservedGeneration: 42,
 			}},
 		},
+		"should fire a warning event if the Secret of an issued Certificate has been tampered with": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateRevision(1),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.SecretTampered, "Secret was tampered with", true
+				}
+			},
+			wantWarningEvent: `Warning SecretTampered Secret "secret-1" has been modified since it was issued: Secret was tampered with`,
+			wantEvent:        "Normal Issuing Secret was tampered with",
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             policies.SecretTampered,
+				Message:            "Secret was tampered with",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
 		"should not set Issuing=True when cert has been failing for 59 minutes": {
 			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
 				gen.SetCertificateUID("cert-1-uid"),
@@ -174,6 +350,7 @@ func Test_controller_ProcessItem(t *testing.T) {
 				)),
 			},
 			wantShouldReissueCalled: false,
+			wantScheduledRecheck:    time.Minute,
 		},
 		"should set Issuing=True when cert has been failing for 59 minutes but cert and next CR are mismatched": {
 			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
@@ -263,6 +440,17 @@ func Test_controller_ProcessItem(t *testing.T) {
 			// to be the same as the output certiticate.
 			test.mockDataForCertificateReturn.Certificate = test.existingCertificate
 
+			var gotScheduledRecheck time.Duration
+			gotForgotten := false
+			w.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(_ interface{}, duration time.Duration) {
+					gotScheduledRecheck = duration
+				},
+				ForgetFunc: func(interface{}) {
+					gotForgotten = true
+				},
+			}
+
 			gotDataForCertificateCalled := false
 			w.dataForCertificate = func(context.Context, *cmapi.Certificate) (policies.Input, error) {
 				gotDataForCertificateCalled = true
@@ -275,6 +463,9 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}
 				expectedCert := test.existingCertificate.DeepCopy()
 				expectedCert.Status.Conditions = test.wantConditions
+				if test.wantLastRenewRequestTime != nil {
+					expectedCert.Status.LastRenewRequestTime = test.wantLastRenewRequestTime
+				}
 				builder.ExpectedActions = append(builder.ExpectedActions,
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
@@ -284,8 +475,11 @@ func Test_controller_ProcessItem(t *testing.T) {
 					)),
 				)
 			}
+			if test.wantWarningEvent != "" {
+				builder.ExpectedEvents = append(builder.ExpectedEvents, test.wantWarningEvent)
+			}
 			if test.wantEvent != "" {
-				builder.ExpectedEvents = []string{test.wantEvent}
+				builder.ExpectedEvents = append(builder.ExpectedEvents, test.wantEvent)
 			}
 
 			builder.Start()
@@ -313,6 +507,8 @@ func Test_controller_ProcessItem(t *testing.T) {
 
 			assert.Equal(t, test.wantDataForCertificateCalled, gotDataForCertificateCalled, "dataForCertificate func call")
 			assert.Equal(t, test.wantShouldReissueCalled, gotShouldReissueCalled, "shouldReissue func call")
+			assert.Equal(t, test.wantScheduledRecheck, gotScheduledRecheck, "scheduled re-check delay")
+			assert.Equal(t, test.wantForgotten, gotForgotten, "scheduled re-check cancelled")
 
 			builder.CheckAndFinish()
 		})
@@ -446,3 +642,37 @@ func Test_shouldBackoffReissuingOnFailure(t *testing.T) {
 		})
 	}
 }
+
+func Test_certificatePriority(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	now := metav1.NewTime(clock.Now())
+
+	tests := map[string]struct {
+		obj  interface{}
+		want controllerpkg.Priority
+	}{
+		"normal priority if the object is not a Certificate": {
+			obj:  gen.CertificateRequest("cr-1"),
+			want: controllerpkg.PriorityNormal,
+		},
+		"normal priority if the Certificate is not due for renewal": {
+			obj:  gen.Certificate("cert-1", gen.SetCertificateRenewalTIme(metav1.NewTime(now.Add(time.Hour)))),
+			want: controllerpkg.PriorityNormal,
+		},
+		"high priority if the Certificate is due for renewal": {
+			obj:  gen.Certificate("cert-1", gen.SetCertificateRenewalTIme(metav1.NewTime(now.Add(-time.Minute)))),
+			want: controllerpkg.PriorityHigh,
+		},
+		"high priority if a renewal has been requested": {
+			obj: gen.Certificate("cert-1", func(crt *cmapi.Certificate) {
+				crt.Spec.RenewRequestTime = &now
+			}),
+			want: controllerpkg.PriorityHigh,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, certificatePriority(clock)(test.obj))
+		})
+	}
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package truststore
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/predicate"
+)
+
+const (
+	ControllerName = "certificates-trust-store"
+
+	// PublicTrustBundleKey is the key of the public trust bundle ConfigMap
+	// containing the PEM encoded public CA certificates.
+	PublicTrustBundleKey = "ca-certificates.crt"
+)
+
+// This controller stores a `trust.pem` entry in the Secret of Certificates
+// which set `spec.trustStore.create`, containing the public CA certificates
+// of the public trust bundle ConfigMap followed by the CA certificates stored
+// in the `ca.crt` entry of the Secret. The entry is updated whenever the
+// ConfigMap or the issued CA changes.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	secretLister      corelisters.SecretLister
+	// configMapLister lists the public trust bundle ConfigMap. It is nil if
+	// no public trust bundle is configured.
+	configMapLister corelisters.ConfigMapLister
+	kubeClient      kubernetes.Interface
+	recorder        record.EventRecorder
+
+	bundleNamespace, bundleName string
+}
+
+// NewController returns a new trust store controller. The public trust bundle
+// is read from the ConfigMap named publicTrustBundle in the form
+// `<namespace>/<name>`, using configMapInformer. If publicTrustBundle is empty
+// only the issued CA certificates are stored in the trust store.
+func NewController(
+	log logr.Logger,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	configMapInformer coreinformers.ConfigMapInformer,
+	recorder record.EventRecorder,
+	publicTrustBundle string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForSecretUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	ctrl := &controller{
+		certificateLister: certificateInformer.Lister(),
+		secretLister:      secretsInformer.Lister(),
+		kubeClient:        kubeClient,
+		recorder:          recorder,
+	}
+
+	if publicTrustBundle != "" {
+		namespace, name, err := cache.SplitMetaNamespaceKey(publicTrustBundle)
+		if err != nil || namespace == "" || name == "" {
+			return nil, nil, nil, fmt.Errorf("public trust bundle ConfigMap must be in the form <namespace>/<name>, got %q", publicTrustBundle)
+		}
+		ctrl.bundleNamespace, ctrl.bundleName = namespace, name
+		ctrl.configMapLister = configMapInformer.Lister()
+
+		// When the public trust bundle changes, enqueue all Certificates which
+		// store a trust store.
+		configMapInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: func(obj interface{}) {
+				crts, err := ctrl.certificateLister.List(labels.Everything())
+				if err != nil {
+					log.Error(err, "failed listing Certificate resources")
+					return
+				}
+				for _, crt := range crts {
+					if !trustStoreEnabled(crt) {
+						continue
+					}
+					key, err := controllerpkg.KeyFunc(crt)
+					if err != nil {
+						log.Error(err, "error computing key for resource")
+						continue
+					}
+					queue.Add(key)
+				}
+			},
+		})
+		mustSync = append(mustSync, configMapInformer.Informer().HasSynced)
+	}
+
+	return ctrl, queue, mustSync, nil
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("certificate not found for key")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, crt)
+	dbg = log.V(logf.DebugLevel)
+
+	secret, err := c.secretLister.Secrets(apiutil.CertificateSecretNamespace(crt)).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("secret not found, waiting for it to be issued")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithRelatedResource(log, secret)
+	dbg = log.V(logf.DebugLevel)
+
+	var trustStore []byte
+	if trustStoreEnabled(crt) {
+		publicCAs, err := c.publicTrustBundle()
+		if err != nil {
+			c.recorder.Eventf(crt, corev1.EventTypeWarning, events.ReasonTrustStoreFailed, "Failed to read public trust bundle: %v", err)
+			return nil
+		}
+		issuedCAs, _ := decodeCertificates(secret.Data[cmmeta.TLSCAKey])
+		trustStore, err = encodeTrustStore(append(publicCAs, issuedCAs...))
+		if err != nil {
+			return err
+		}
+	}
+
+	existing, ok := secret.Data[cmmeta.TLSTrustStoreKey]
+	if bytes.Equal(existing, trustStore) && ok == (trustStore != nil) {
+		dbg.Info("trust store is up to date")
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	if trustStore == nil {
+		delete(secret.Data, cmmeta.TLSTrustStoreKey)
+	} else {
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[cmmeta.TLSTrustStoreKey] = trustStore
+	}
+	if _, err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	if trustStore == nil {
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonTrustStoreUpdated, "Removed trust store from Secret %q", secret.Name)
+	} else {
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, events.ReasonTrustStoreUpdated, "Updated trust store in Secret %q", secret.Name)
+	}
+
+	return nil
+}
+
+// publicTrustBundle returns the CA certificates of the public trust bundle
+// ConfigMap, or nil if no public trust bundle is configured.
+func (c *controller) publicTrustBundle() ([]*x509.Certificate, error) {
+	if c.configMapLister == nil {
+		return nil, nil
+	}
+
+	cm, err := c.configMapLister.ConfigMaps(c.bundleNamespace).Get(c.bundleName)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := cm.Data[PublicTrustBundleKey]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s/%s does not contain key %q", c.bundleNamespace, c.bundleName, PublicTrustBundleKey)
+	}
+
+	certs, err := decodeCertificates([]byte(data))
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("ConfigMap %s/%s does not contain any valid certificates: %v", c.bundleNamespace, c.bundleName, err)
+	}
+
+	return certs, nil
+}
+
+// decodeCertificates decodes the PEM encoded certificates in data, skipping
+// any which cannot be parsed. Public trust bundles may contain certificates
+// that are not accepted by the Go x509 parser, which must not prevent the
+// remaining certificates from being used.
+func decodeCertificates(data []byte) ([]*x509.Certificate, error) {
+	var (
+		certs   []*x509.Certificate
+		lastErr error
+		block   *pem.Block
+	)
+	for {
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		certs = append(certs, cert)
+	}
+	return certs, lastErr
+}
+
+// encodeTrustStore returns the given certificates PEM encoded without
+// duplicates, or nil if there are no certificates.
+func encodeTrustStore(certs []*x509.Certificate) ([]byte, error) {
+	var unique []*x509.Certificate
+	for _, cert := range certs {
+		duplicate := false
+		for _, u := range unique {
+			if u.Equal(cert) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			unique = append(unique, cert)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, nil
+	}
+
+	// pki.EncodeX509Chain is not used as it omits self-signed certificates,
+	// which most CA certificates are.
+	buf := &bytes.Buffer{}
+	for _, cert := range unique {
+		if err := pem.Encode(buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func trustStoreEnabled(crt *cmapi.Certificate) bool {
+	return crt.Spec.TrustStore != nil && crt.Spec.TrustStore.Create
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	// Only the public trust bundle ConfigMap is watched, rather than all
+	// ConfigMaps in the cluster.
+	var configMapInformer coreinformers.ConfigMapInformer
+	if bundle := ctx.CertificateOptions.PublicTrustBundleConfigMap; bundle != "" {
+		namespace, name, _ := cache.SplitMetaNamespaceKey(bundle)
+		bundleFactory := informers.NewSharedInformerFactoryWithOptions(ctx.Client, time.Minute*5,
+			informers.WithNamespace(namespace),
+			informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+				opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+			}),
+		)
+		configMapInformer = bundleFactory.Core().V1().ConfigMaps()
+		// the informer must be requested before the factory is started
+		configMapInformer.Informer()
+		defer bundleFactory.Start(ctx.RootContext.Done())
+	}
+
+	ctrl, queue, mustSync, err := NewController(log,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		configMapInformer,
+		ctx.Recorder,
+		ctx.CertificateOptions.PublicTrustBundleConfigMap,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package truststore
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	internaltest "github.com/jetstack/cert-manager/pkg/controller/certificates/internal/test"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	caCert := func(cn string) []byte {
+		return internaltest.MustCreateCert(t, internaltest.MustCreatePEMPrivateKey(t),
+			gen.Certificate(cn, gen.SetCertificateCommonName(cn), gen.SetCertificateIsCA(true)))
+	}
+	publicCA := caCert("public")
+	issuingCA := caCert("issuing")
+
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateSecretName("test-secret"),
+	)
+	trustStoreCrt := gen.CertificateFrom(baseCrt, func(crt *cmapi.Certificate) {
+		crt.Spec.TrustStore = &cmapi.CertificateTrustStore{Create: true}
+	})
+	secret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "test-secret"},
+			Data:       data,
+		}
+	}
+	bundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "cert-manager", Name: "public-trust-bundle"},
+		Data:       map[string]string{PublicTrustBundleKey: "# Mozilla roots\n" + string(publicCA)},
+	}
+
+	tests := map[string]struct {
+		certificate       *cmapi.Certificate
+		secret            *corev1.Secret
+		bundle            *corev1.ConfigMap
+		publicTrustBundle string
+
+		expectedTrustStore []byte
+		expectUpdate       bool
+		expectedEvents     []string
+	}{
+		"do nothing if the trust store is not enabled": {
+			certificate: baseCrt,
+			secret:      secret(map[string][]byte{cmmeta.TLSCAKey: issuingCA}),
+		},
+		"do nothing if the Secret does not exist": {
+			certificate: trustStoreCrt,
+		},
+		"remove the trust store if it is no longer enabled": {
+			certificate:    baseCrt,
+			secret:         secret(map[string][]byte{cmmeta.TLSCAKey: issuingCA, cmmeta.TLSTrustStoreKey: issuingCA}),
+			expectUpdate:   true,
+			expectedEvents: []string{`Normal TrustStoreUpdated Removed trust store from Secret "test-secret"`},
+		},
+		"store only the issuing CA if no public trust bundle is configured": {
+			certificate:        trustStoreCrt,
+			secret:             secret(map[string][]byte{cmmeta.TLSCAKey: issuingCA}),
+			expectedTrustStore: issuingCA,
+			expectUpdate:       true,
+			expectedEvents:     []string{`Normal TrustStoreUpdated Updated trust store in Secret "test-secret"`},
+		},
+		"store the public trust bundle followed by the issuing CA": {
+			certificate:        trustStoreCrt,
+			secret:             secret(map[string][]byte{cmmeta.TLSCAKey: issuingCA}),
+			bundle:             bundle,
+			publicTrustBundle:  "cert-manager/public-trust-bundle",
+			expectedTrustStore: append(append([]byte{}, publicCA...), issuingCA...),
+			expectUpdate:       true,
+			expectedEvents:     []string{`Normal TrustStoreUpdated Updated trust store in Secret "test-secret"`},
+		},
+		"do not duplicate an issuing CA which is in the public trust bundle": {
+			certificate:        trustStoreCrt,
+			secret:             secret(map[string][]byte{cmmeta.TLSCAKey: publicCA}),
+			bundle:             bundle,
+			publicTrustBundle:  "cert-manager/public-trust-bundle",
+			expectedTrustStore: publicCA,
+			expectUpdate:       true,
+			expectedEvents:     []string{`Normal TrustStoreUpdated Updated trust store in Secret "test-secret"`},
+		},
+		"do nothing if the trust store is up to date": {
+			certificate:       trustStoreCrt,
+			secret:            secret(map[string][]byte{cmmeta.TLSCAKey: issuingCA, cmmeta.TLSTrustStoreKey: append(append([]byte{}, publicCA...), issuingCA...)}),
+			bundle:            bundle,
+			publicTrustBundle: "cert-manager/public-trust-bundle",
+		},
+		"record an event if the public trust bundle does not exist": {
+			certificate:       trustStoreCrt,
+			secret:            secret(map[string][]byte{cmmeta.TLSCAKey: issuingCA}),
+			publicTrustBundle: "cert-manager/public-trust-bundle",
+			expectedEvents:    []string{`Warning TrustStoreFailed Failed to read public trust bundle: configmap "public-trust-bundle" not found`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.certificate},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.secret != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.secret)
+			}
+			if test.bundle != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.bundle)
+			}
+			if test.expectUpdate {
+				updated := test.secret.DeepCopy()
+				if test.expectedTrustStore == nil {
+					delete(updated.Data, cmmeta.TLSTrustStoreKey)
+				} else {
+					updated.Data[cmmeta.TLSTrustStoreKey] = test.expectedTrustStore
+				}
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						updated.Namespace,
+						updated,
+					)))
+			}
+			builder.Init()
+
+			ctrl, _, _, err := NewController(logf.Log,
+				builder.Client,
+				builder.KubeSharedInformerFactory,
+				builder.SharedInformerFactory,
+				builder.KubeSharedInformerFactory.Core().V1().ConfigMaps(),
+				builder.Recorder,
+				test.publicTrustBundle,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.certificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := ctrl.ProcessItem(context.Background(), key); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
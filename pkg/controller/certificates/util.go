@@ -21,15 +21,22 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
-	"reflect"
+	"hash/fnv"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/issuer"
 	"github.com/jetstack/cert-manager/pkg/util"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
@@ -43,27 +50,53 @@ const RetryAfterLastFailure = time.Hour
 // PrivateKeyMatchesSpec returns an error if the private key bit size
 // doesn't match the provided spec. RSA, Ed25519 and ECDSA are supported.
 // If any error is returned, a list of violations will also be returned.
+// References to private keys held by an external signer are checked using
+// their public key, and must be held by the provider named in the spec.
+// The algorithm and size of private keys supplied in `spec.privateKey.secretRef`
+// are not checked, as they are chosen by the user.
 func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
 	spec = *spec.DeepCopy()
 	if spec.PrivateKey == nil {
 		spec.PrivateKey = &cmapi.CertificatePrivateKey{}
 	}
+
+	var violations []string
+	externalKey, isExternal := pk.(*pki.ExternalKey)
+	switch {
+	case isExternal != (spec.PrivateKey.External != nil):
+		violations = append(violations, "spec.privateKey.external")
+	case isExternal && externalKey.Provider != spec.PrivateKey.External.Provider:
+		violations = append(violations, "spec.privateKey.external.provider")
+	}
+
+	signer, ok := pk.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", pk)
+	}
+	pub := signer.Public()
+
+	if spec.PrivateKey.SecretRef != nil {
+		return violations, nil
+	}
+
+	var algorithmViolations []string
 	switch spec.PrivateKey.Algorithm {
 	case "", cmapi.RSAKeyAlgorithm:
-		return rsaPrivateKeyMatchesSpec(pk, spec)
+		algorithmViolations = rsaPublicKeyMatchesSpec(pub, spec)
 	case cmapi.Ed25519KeyAlgorithm:
-		return ed25519PrivateKeyMatchesSpec(pk, spec)
+		algorithmViolations = ed25519PublicKeyMatchesSpec(pub)
 	case cmapi.ECDSAKeyAlgorithm:
-		return ecdsaPrivateKeyMatchesSpec(pk, spec)
+		algorithmViolations = ecdsaPublicKeyMatchesSpec(pub, spec)
 	default:
 		return nil, fmt.Errorf("unrecognised key algorithm type %q", spec.PrivateKey.Algorithm)
 	}
+	return append(violations, algorithmViolations...), nil
 }
 
-func rsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
-	rsaPk, ok := pk.(*rsa.PrivateKey)
+func rsaPublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) []string {
+	rsaPub, ok := pub.(*rsa.PublicKey)
 	if !ok {
-		return []string{"spec.keyAlgorithm"}, nil
+		return []string{"spec.keyAlgorithm"}
 	}
 	var violations []string
 	// TODO: we should not use implicit defaulting here, and instead rely on
@@ -75,16 +108,16 @@ func rsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec)
 	if spec.PrivateKey.Size > 0 {
 		keySize = spec.PrivateKey.Size
 	}
-	if rsaPk.N.BitLen() != keySize {
+	if rsaPub.N.BitLen() != keySize {
 		violations = append(violations, "spec.keySize")
 	}
-	return violations, nil
+	return violations
 }
 
-func ecdsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
-	ecdsaPk, ok := pk.(*ecdsa.PrivateKey)
+func ecdsaPublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) []string {
+	ecdsaPub, ok := pub.(*ecdsa.PublicKey)
 	if !ok {
-		return []string{"spec.keyAlgorithm"}, nil
+		return []string{"spec.keyAlgorithm"}
 	}
 	var violations []string
 	// TODO: we should not use implicit defaulting here, and instead rely on
@@ -96,19 +129,17 @@ func ecdsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec
 	if spec.PrivateKey.Size > 0 {
 		expectedKeySize = spec.PrivateKey.Size
 	}
-	if expectedKeySize != ecdsaPk.Curve.Params().BitSize {
+	if expectedKeySize != ecdsaPub.Curve.Params().BitSize {
 		violations = append(violations, "spec.keySize")
 	}
-	return violations, nil
+	return violations
 }
 
-func ed25519PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
-	_, ok := pk.(ed25519.PrivateKey)
-	if !ok {
-		return []string{"spec.keyAlgorithm"}, nil
+func ed25519PublicKeyMatchesSpec(pub crypto.PublicKey) []string {
+	if _, ok := pub.(ed25519.PublicKey); !ok {
+		return []string{"spec.keyAlgorithm"}
 	}
-
-	return nil, nil
+	return nil
 }
 
 // RequestMatchesSpec compares a CertificateRequest with a CertificateSpec
@@ -128,24 +159,39 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 	}
 
 	var violations []string
-	if x509req.Subject.CommonName != spec.CommonName {
+	if x509req.Subject.CommonName != pki.CommonNameToASCII(spec.CommonName) {
 		violations = append(violations, "spec.commonName")
 	}
-	if !util.EqualUnsorted(x509req.DNSNames, spec.DNSNames) {
+	// subjectAltNames are compared as canonicalized sets so that cosmetic
+	// changes to the spec, such as re-ordering or duplicating entries, do not
+	// cause the request to be re-created.
+	if !util.EqualDNSNamesUnsortedSet(x509req.DNSNames, spec.DNSNames) {
 		violations = append(violations, "spec.dnsNames")
 	}
-	if !util.EqualUnsorted(pki.IPAddressesToString(x509req.IPAddresses), spec.IPAddresses) {
+	if !util.EqualIPsUnsortedSet(pki.IPAddressesToString(x509req.IPAddresses), spec.IPAddresses) {
 		violations = append(violations, "spec.ipAddresses")
 	}
-	if !util.EqualUnsorted(pki.URLsToString(x509req.URIs), spec.URIs) {
+	if !util.EqualUnsortedSet(pki.URLsToString(x509req.URIs), spec.URIs) {
 		violations = append(violations, "spec.uris")
 	}
-	if !util.EqualUnsorted(x509req.EmailAddresses, spec.EmailAddresses) {
+	if !util.EqualUnsortedSet(x509req.EmailAddresses, spec.EmailAddresses) {
 		violations = append(violations, "spec.emailAddresses")
 	}
 	if x509req.Subject.SerialNumber != spec.Subject.SerialNumber {
 		violations = append(violations, "spec.subject.serialNumber")
 	}
+	uids, extraNames := subjectExtraNames(x509req.Subject)
+	var specUIDs []string
+	if len(spec.Subject.UID) > 0 {
+		specUIDs = []string{spec.Subject.UID}
+	}
+	if !util.EqualUnsorted(uids, specUIDs) {
+		violations = append(violations, "spec.subject.uid")
+	}
+	specExtraNames, err := pki.ExtraNamesForSubject(cmapi.X509Subject{ExtraNames: spec.Subject.ExtraNames})
+	if err != nil || !util.EqualUnsorted(extraNames, attributesToString(specExtraNames)) {
+		violations = append(violations, "spec.subject.extraNames")
+	}
 	if !util.EqualUnsorted(x509req.Subject.Organization, spec.Subject.Organizations) {
 		violations = append(violations, "spec.subject.organizations")
 	}
@@ -170,18 +216,103 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 	if req.Spec.IsCA != spec.IsCA {
 		violations = append(violations, "spec.isCA")
 	}
-	if !util.EqualKeyUsagesUnsorted(req.Spec.Usages, spec.Usages) {
+	if !util.EqualKeyUsagesUnsorted(usagesOrDefault(req.Spec.Usages), usagesOrDefault(apiutil.CertificateSpecUsages(&spec))) {
 		violations = append(violations, "spec.usages")
 	}
 	if spec.Duration != nil && req.Spec.Duration != nil &&
 		spec.Duration.Duration != req.Spec.Duration.Duration {
 		violations = append(violations, "spec.duration")
 	}
-	if !reflect.DeepEqual(spec.IssuerRef, req.Spec.IssuerRef) {
+	if spec.PrivateKey != nil && spec.PrivateKey.SignatureAlgorithm != "" {
+		if sigAlgo, ok := pki.X509SignatureAlgorithm(spec.PrivateKey.SignatureAlgorithm); ok && sigAlgo != x509req.SignatureAlgorithm {
+			violations = append(violations, "spec.privateKey.signatureAlgorithm")
+		}
+	}
+	// Requests to any of the issuers the Certificate may fail over to are
+	// permitted.
+	issuerRefMatches := false
+	for _, ref := range IssuerRefs(spec) {
+		if IssuerRefsEqual(ref, req.Spec.IssuerRef) {
+			issuerRefMatches = true
+			break
+		}
+	}
+	if !issuerRefMatches {
 		violations = append(violations, "spec.issuerRef")
 	}
 
-	return violations, nil
+	return withoutTransformedFields(req, violations), nil
+}
+
+// withoutTransformedFields removes the fields which were changed by CSR
+// transformers when the given CertificateRequest was created from the given
+// violations, as they are expected to differ from the spec.
+func withoutTransformedFields(req *cmapi.CertificateRequest, violations []string) []string {
+	transformed, ok := req.Annotations[cmapi.CertificateRequestTransformedFieldsAnnotationKey]
+	if !ok || len(violations) == 0 {
+		return violations
+	}
+
+	fields := sets.NewString(strings.Split(transformed, ",")...)
+	var remaining []string
+	for _, violation := range violations {
+		if !fields.Has(violation) {
+			remaining = append(remaining, violation)
+		}
+	}
+	return remaining
+}
+
+// usagesOrDefault returns the given usages, or the default usages if none
+// are given. Certificates and CertificateRequests created before usages were
+// defaulted by the webhook have no usages set.
+func usagesOrDefault(usages []cmapi.KeyUsage) []cmapi.KeyUsage {
+	if len(usages) == 0 {
+		return cmapi.DefaultKeyUsages()
+	}
+	return usages
+}
+
+// IssuerRefsEqual returns true if the given issuer references refer to the
+// same issuer, treating an unset group or kind as its default.
+func IssuerRefsEqual(l, r cmmeta.ObjectReference) bool {
+	for _, ref := range []*cmmeta.ObjectReference{&l, &r} {
+		if ref.Group == "" {
+			ref.Group = certmanager.GroupName
+		}
+		if ref.Kind == "" {
+			ref.Kind = cmapi.IssuerKind
+		}
+	}
+	return l == r
+}
+
+// subjectExtraNames returns the UIDs of the given subject, and its other
+// attributes which are not represented by a field of pkix.Name in the format
+// n.n.n.n=value.
+func subjectExtraNames(subject pkix.Name) ([]string, []string) {
+	var uids []string
+	var extraNames []pkix.AttributeTypeAndValue
+	for _, name := range subject.Names {
+		t := name.Type
+		switch {
+		case len(t) == 4 && t[0] == 2 && t[1] == 5 && t[2] == 4:
+			// Attributes of the form 2.5.4.n are fields of pkix.Name
+		case t.Equal(pki.OIDUserID):
+			uids = append(uids, fmt.Sprint(name.Value))
+		default:
+			extraNames = append(extraNames, name)
+		}
+	}
+	return uids, attributesToString(extraNames)
+}
+
+func attributesToString(attributes []pkix.AttributeTypeAndValue) []string {
+	var s []string
+	for _, attribute := range attributes {
+		s = append(s, fmt.Sprintf("%s=%v", attribute.Type, attribute.Value))
+	}
+	return s
 }
 
 // SecretDataAltNamesMatchSpec will compare a Secret resource containing certificate
@@ -195,6 +326,13 @@ func SecretDataAltNamesMatchSpec(secret *corev1.Secret, spec cmapi.CertificateSp
 		return nil, err
 	}
 
+	return certificateAltNamesMatchSpec(x509cert, spec), nil
+}
+
+// certificateAltNamesMatchSpec compares the names of an x509 certificate with
+// those requested by a CertificateSpec, as described by
+// SecretDataAltNamesMatchSpec.
+func certificateAltNamesMatchSpec(x509cert *x509.Certificate, spec cmapi.CertificateSpec) []string {
 	var violations []string
 
 	// Perform a 'loose' check on the x509 certificate to determine if the
@@ -202,36 +340,107 @@ func SecretDataAltNamesMatchSpec(secret *corev1.Secret, spec cmapi.CertificateSp
 	// This check allows names to move between the DNSNames and CommonName
 	// field freely in order to account for CAs behaviour of promoting DNSNames
 	// to be CommonNames or vice-versa.
-	expectedDNSNames := sets.NewString(spec.DNSNames...)
-	if spec.CommonName != "" {
-		expectedDNSNames.Insert(spec.CommonName)
+	specDNSNames := util.CanonicalDNSNames(spec.DNSNames)
+	certDNSNames := util.CanonicalDNSNames(x509cert.DNSNames)
+	specCommonName := util.NormalizeDNSName(spec.CommonName)
+	certCommonName := util.NormalizeDNSName(x509cert.Subject.CommonName)
+
+	expectedDNSNames := sets.NewString(specDNSNames...)
+	if specCommonName != "" {
+		expectedDNSNames.Insert(specCommonName)
 	}
-	allDNSNames := sets.NewString(x509cert.DNSNames...)
-	if x509cert.Subject.CommonName != "" {
-		allDNSNames.Insert(x509cert.Subject.CommonName)
+	allDNSNames := sets.NewString(certDNSNames...)
+	if certCommonName != "" {
+		allDNSNames.Insert(certCommonName)
 	}
 	if !allDNSNames.Equal(expectedDNSNames) {
 		// We know a mismatch occurred, so now determine which fields mismatched.
-		if (spec.CommonName != "" && !allDNSNames.Has(spec.CommonName)) || (x509cert.Subject.CommonName != "" && !expectedDNSNames.Has(x509cert.Subject.CommonName)) {
+		if (specCommonName != "" && !allDNSNames.Has(specCommonName)) || (certCommonName != "" && !expectedDNSNames.Has(certCommonName)) {
 			violations = append(violations, "spec.commonName")
 		}
 
-		if !allDNSNames.HasAll(spec.DNSNames...) || !expectedDNSNames.HasAll(x509cert.DNSNames...) {
+		if !allDNSNames.HasAll(specDNSNames...) || !expectedDNSNames.HasAll(certDNSNames...) {
 			violations = append(violations, "spec.dnsNames")
 		}
 	}
 
-	if !util.EqualUnsorted(pki.IPAddressesToString(x509cert.IPAddresses), spec.IPAddresses) {
+	if !util.EqualIPsUnsortedSet(pki.IPAddressesToString(x509cert.IPAddresses), spec.IPAddresses) {
 		violations = append(violations, "spec.ipAddresses")
 	}
-	if !util.EqualUnsorted(pki.URLsToString(x509cert.URIs), spec.URIs) {
+	if !util.EqualUnsortedSet(pki.URLsToString(x509cert.URIs), spec.URIs) {
 		violations = append(violations, "spec.uris")
 	}
-	if !util.EqualUnsorted(x509cert.EmailAddresses, spec.EmailAddresses) {
+	if !util.EqualUnsortedSet(x509cert.EmailAddresses, spec.EmailAddresses) {
 		violations = append(violations, "spec.emailAddresses")
 	}
 
-	return violations, nil
+	return violations
+}
+
+// IssuedCertificateMatchesSpec compares a certificate returned by an issuer
+// with the CertificateSpec it was requested for, and returns a list of field
+// names on the Certificate that the issued certificate does not honour.
+// Names are compared as described by SecretDataAltNamesMatchSpec. Issued
+// certificates may carry more key usages than were requested, but not fewer,
+// and their validity period may differ from the requested duration by up to
+// the larger of an hour and 1% of the duration.
+func IssuedCertificateMatchesSpec(x509cert *x509.Certificate, spec cmapi.CertificateSpec) []string {
+	violations := certificateAltNamesMatchSpec(x509cert, spec)
+
+	if x509cert.IsCA != spec.IsCA {
+		violations = append(violations, "spec.isCA")
+	}
+	if !issuedUsagesMatchSpec(x509cert, spec) {
+		violations = append(violations, "spec.usages")
+	}
+	if spec.Duration != nil {
+		tolerance := spec.Duration.Duration / 100
+		if tolerance < time.Hour {
+			tolerance = time.Hour
+		}
+		diff := x509cert.NotAfter.Sub(x509cert.NotBefore) - spec.Duration.Duration
+		if diff > tolerance || diff < -tolerance {
+			violations = append(violations, "spec.duration")
+		}
+	}
+
+	return violations
+}
+
+// issuedUsagesMatchSpec returns true if the issued certificate permits every
+// usage requested by the spec. Certificates without a key usage or extended
+// key usage extension are unrestricted. The key encipherment usage is only
+// meaningful for RSA keys, so it is not required of certificates for other
+// key types.
+func issuedUsagesMatchSpec(x509cert *x509.Certificate, spec cmapi.CertificateSpec) bool {
+	ku, ekus, err := pki.BuildKeyUsages(apiutil.CertificateSpecUsages(&spec), spec.IsCA)
+	if err != nil {
+		return false
+	}
+
+	if _, ok := x509cert.PublicKey.(*rsa.PublicKey); !ok {
+		ku &^= x509.KeyUsageKeyEncipherment
+	}
+	if x509cert.KeyUsage != 0 && ku&^x509cert.KeyUsage != 0 {
+		return false
+	}
+
+	if len(x509cert.ExtKeyUsage) == 0 {
+		return true
+	}
+	issued := make(map[x509.ExtKeyUsage]bool)
+	for _, eku := range x509cert.ExtKeyUsage {
+		issued[eku] = true
+	}
+	if issued[x509.ExtKeyUsageAny] {
+		return true
+	}
+	for _, eku := range ekus {
+		if !issued[eku] {
+			return false
+		}
+	}
+	return true
 }
 
 // staticTemporarySerialNumber is a fixed serial number we use for temporary certificates
@@ -320,3 +529,163 @@ func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Dura
 	rt := metav1.NewTime(notAfter.Add(-1 * renewBefore).Truncate(time.Second))
 	return &rt
 }
+
+// RenewalJitter configures how far the renewal of a Certificate may be
+// brought forward, so that Certificates that were issued at the same time are
+// not all renewed at the same time.
+type RenewalJitter struct {
+	// Percentage is the maximum jitter as a percentage of the period between
+	// the renewal time and the expiry of the certificate.
+	Percentage int
+
+	// Window is the maximum jitter as an absolute duration. If set, Window
+	// takes precedence over Percentage.
+	Window time.Duration
+}
+
+// Apply returns the given renewal time brought forward by an amount of jitter
+// that is no larger than the configured maximum. The jitter is derived from
+// the Certificate's namespace and name and the notBefore time of its current
+// certificate, so that it is stable between calls for the same certificate
+// but spread across different Certificates. The returned time is never
+// earlier than notBefore.
+func (j RenewalJitter) Apply(crt *cmapi.Certificate, notBefore, notAfter, renewalTime time.Time) time.Time {
+	window := j.Window
+	if window == 0 && j.Percentage > 0 {
+		window = notAfter.Sub(renewalTime) * time.Duration(j.Percentage) / 100
+	}
+	if window <= 0 {
+		return renewalTime
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%s/%d", crt.Namespace, crt.Name, notBefore.Unix())
+	jitter := time.Duration(h.Sum64() % uint64(window)).Truncate(time.Second)
+
+	jittered := renewalTime.Add(-jitter)
+	if jittered.Before(notBefore) {
+		return notBefore
+	}
+	return jittered
+}
+
+// NextRenewalWindowTime returns the earliest time, not before t, at which a
+// renewal may be initiated within the given renewal window. If t lies within
+// one of the windows, t itself is returned. An error is returned if the time
+// zone or any of the windows cannot be parsed, or if the window never opens.
+func NextRenewalWindowTime(window *cmapi.CertificateRenewalWindow, t time.Time) (time.Time, error) {
+	loc := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(window.TimeZone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time zone %q: %w", window.TimeZone, err)
+		}
+	}
+
+	local := t.In(loc)
+	var next time.Time
+	for _, w := range window.Windows {
+		start, err := time.Parse("15:04", w.Start)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid window start time %q: %w", w.Start, err)
+		}
+		end, err := time.Parse("15:04", w.End)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid window end time %q: %w", w.End, err)
+		}
+		weekdays := sets.NewString(w.Weekdays...)
+
+		// A window which opened on the previous day may still be open, and
+		// every window opens at least once within the next week.
+		for day := -1; day <= 7; day++ {
+			opens := time.Date(local.Year(), local.Month(), local.Day()+day, start.Hour(), start.Minute(), 0, 0, loc)
+			if weekdays.Len() > 0 && !weekdays.Has(opens.Weekday().String()) {
+				continue
+			}
+			closes := time.Date(local.Year(), local.Month(), local.Day()+day, end.Hour(), end.Minute(), 0, 0, loc)
+			if !closes.After(opens) {
+				closes = time.Date(local.Year(), local.Month(), local.Day()+day+1, end.Hour(), end.Minute(), 0, 0, loc)
+			}
+			if !t.Before(opens) && t.Before(closes) {
+				return t, nil
+			}
+			if opens.After(t) && (next.IsZero() || opens.Before(next)) {
+				next = opens
+			}
+		}
+	}
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("renewal window never opens")
+	}
+	return next, nil
+}
+
+// IssuancePaused returns true if the Certificate has the
+// 'cert-manager.io/issuance-paused' annotation set to "true", in which case
+// the certificates controllers must not begin or progress an issuance.
+func IssuancePaused(crt *cmapi.Certificate) bool {
+	return crt.Annotations[cmapi.IssuancePausedAnnotationKey] == "true"
+}
+
+// IssuerChangeCanary returns true if the Certificate has the
+// 'cert-manager.io/issuer-change-canary' annotation set to "true", in which
+// case a certificate issued after a change of issuer is stored in the canary
+// Secret of the Certificate until it has been verified.
+func IssuerChangeCanary(crt *cmapi.Certificate) bool {
+	return crt.Annotations[cmapi.IssuerChangeCanaryAnnotationKey] == "true"
+}
+
+// CanarySecretName returns the name of the Secret in which a certificate
+// issued after a change of issuer is stored until it has been verified.
+func CanarySecretName(crt *cmapi.Certificate) string {
+	return crt.Spec.SecretName + "-next"
+}
+
+// ReissueOnCARotation returns true if the Certificate has the
+// 'cert-manager.io/reissue-on-ca-rotation' annotation set to "true", in which
+// case it is re-issued when the CA certificate of its CA issuer is renewed.
+func ReissueOnCARotation(crt *cmapi.Certificate) bool {
+	return crt.Annotations[cmapi.ReissueOnCARotationAnnotationKey] == "true"
+}
+
+// IssuerRefs returns the issuers that may issue the Certificate, in order of
+// preference: `spec.issuerRef` followed by `spec.fallbackIssuerRefs`.
+func IssuerRefs(spec cmapi.CertificateSpec) []cmmeta.ObjectReference {
+	return append([]cmmeta.ObjectReference{spec.IssuerRef}, spec.FallbackIssuerRefs...)
+}
+
+// ActiveIssuerRef returns the issuer that should be used for the next
+// issuance of the Certificate. The Certificate fails over to the next of its
+// `spec.fallbackIssuerRefs` after every `spec.issuerFailoverThreshold`
+// consecutive failed issuances, returning to `spec.issuerRef` after the last.
+func ActiveIssuerRef(crt *cmapi.Certificate) cmmeta.ObjectReference {
+	refs := IssuerRefs(crt.Spec)
+	if crt.Status.FailedIssuanceAttempts == nil {
+		return refs[0]
+	}
+
+	threshold := cmapi.DefaultIssuerFailoverThreshold
+	if crt.Spec.IssuerFailoverThreshold != nil && *crt.Spec.IssuerFailoverThreshold > 0 {
+		threshold = *crt.Spec.IssuerFailoverThreshold
+	}
+
+	return refs[(*crt.Status.FailedIssuanceAttempts/threshold)%len(refs)]
+}
+
+// WithIssuerDefaults returns a copy of the Certificate with the certificate
+// defaults of its active issuer applied to the fields which it does not set,
+// along with a description of each value that was applied. The Certificate
+// itself is returned if the issuer cannot be found or sets no defaults.
+// The returned Certificate must only be used to compute the effective spec,
+// and never be used to update the Certificate.
+func WithIssuerDefaults(helper issuer.Helper, crt *cmapi.Certificate) (*cmapi.Certificate, []string) {
+	iss, err := helper.GetGenericIssuer(ActiveIssuerRef(crt), crt.Namespace)
+	if err != nil || iss.GetSpec().CertificateDefaults == nil {
+		return crt, nil
+	}
+
+	crt = crt.DeepCopy()
+	applied := apiutil.ApplyIssuerCertificateDefaults(iss.GetSpec().CertificateDefaults, &crt.Spec)
+	return crt, applied
+}
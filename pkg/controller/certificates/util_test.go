@@ -18,8 +18,11 @@ package certificates
 
 import (
 	"crypto"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,7 +31,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
 )
 
 func mustGenerateRSA(t *testing.T, keySize int) crypto.PrivateKey {
@@ -60,6 +65,8 @@ func TestPrivateKeyMatchesSpec(t *testing.T) {
 		key          crypto.PrivateKey
 		expectedAlgo cmapi.PrivateKeyAlgorithm
 		expectedSize int
+		external     *cmapi.CertificateExternalPrivateKey
+		secretRef    *cmmeta.SecretKeySelector
 		violations   []string
 		err          string
 	}{
@@ -95,6 +102,45 @@ func TestPrivateKeyMatchesSpec(t *testing.T) {
 			key:          mustGenerateEd25519(t),
 			expectedAlgo: cmapi.Ed25519KeyAlgorithm,
 		},
+		"should match an external key using its public key": {
+			key:          &pki.ExternalKey{Provider: "kms", Reference: "key-1", PublicKey: mustGenerateECDSA(t, pki.ECCurve256).(crypto.Signer).Public()},
+			expectedAlgo: cmapi.ECDSAKeyAlgorithm,
+			expectedSize: 256,
+			external:     &cmapi.CertificateExternalPrivateKey{Provider: "kms"},
+		},
+		"should not match an external key if keySize is incorrect": {
+			key:          &pki.ExternalKey{Provider: "kms", Reference: "key-1", PublicKey: mustGenerateECDSA(t, pki.ECCurve256).(crypto.Signer).Public()},
+			expectedAlgo: cmapi.ECDSAKeyAlgorithm,
+			expectedSize: pki.ECCurve384,
+			external:     &cmapi.CertificateExternalPrivateKey{Provider: "kms"},
+			violations:   []string{"spec.keySize"},
+		},
+		"should not match an external key held by a different provider": {
+			key:          &pki.ExternalKey{Provider: "kms", Reference: "key-1", PublicKey: mustGenerateRSA(t, 2048).(crypto.Signer).Public()},
+			expectedAlgo: cmapi.RSAKeyAlgorithm,
+			external:     &cmapi.CertificateExternalPrivateKey{Provider: "hsm"},
+			violations:   []string{"spec.privateKey.external.provider"},
+		},
+		"should not match an external key if no external key is requested": {
+			key:          &pki.ExternalKey{Provider: "kms", Reference: "key-1", PublicKey: mustGenerateRSA(t, 2048).(crypto.Signer).Public()},
+			expectedAlgo: cmapi.RSAKeyAlgorithm,
+			violations:   []string{"spec.privateKey.external"},
+		},
+		"should not match a private key if an external key is requested": {
+			key:          mustGenerateRSA(t, 2048),
+			expectedAlgo: cmapi.RSAKeyAlgorithm,
+			external:     &cmapi.CertificateExternalPrivateKey{Provider: "kms"},
+			violations:   []string{"spec.privateKey.external"},
+		},
+		"should match a key of any algorithm and size if the key is supplied in a Secret": {
+			key:       mustGenerateECDSA(t, pki.ECCurve384),
+			secretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "key"}},
+		},
+		"should not match an external key if the key is supplied in a Secret": {
+			key:        &pki.ExternalKey{Provider: "kms", Reference: "key-1", PublicKey: mustGenerateRSA(t, 2048).(crypto.Signer).Public()},
+			secretRef:  &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "key"}},
+			violations: []string{"spec.privateKey.external"},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -104,6 +150,8 @@ func TestPrivateKeyMatchesSpec(t *testing.T) {
 					PrivateKey: &cmapi.CertificatePrivateKey{
 						Algorithm: test.expectedAlgo,
 						Size:      test.expectedSize,
+						External:  test.external,
+						SecretRef: test.secretRef,
 					},
 				},
 			)
@@ -124,6 +172,299 @@ func TestPrivateKeyMatchesSpec(t *testing.T) {
 	}
 }
 
+func TestRequestMatchesSpecSubjectExtraNames(t *testing.T) {
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	specWithSubject := func(subject *cmapi.X509Subject) cmapi.CertificateSpec {
+		return cmapi.CertificateSpec{CommonName: "device", Subject: subject}
+	}
+	requestForSpec := func(spec cmapi.CertificateSpec) *cmapi.CertificateRequest {
+		csr, err := pki.GenerateCSR(&cmapi.Certificate{Spec: spec})
+		if err != nil {
+			t.Fatal(err)
+		}
+		csrDER, err := pki.EncodeCSR(csr, pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return gen.CertificateRequest("test", gen.SetCertificateRequestCSR(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})))
+	}
+
+	device := &cmapi.X509Subject{
+		SerialNumber: "1234",
+		UID:          "device-1",
+		ExtraNames:   []string{"1.3.6.1.4.1.311.60.2.1.3=GB"},
+	}
+	req := requestForSpec(specWithSubject(device))
+
+	tests := map[string]struct {
+		spec       cmapi.CertificateSpec
+		violations []string
+	}{
+		"matching subject": {
+			spec: specWithSubject(device),
+		},
+		"different UID": {
+			spec: specWithSubject(&cmapi.X509Subject{
+				SerialNumber: "1234",
+				UID:          "device-2",
+				ExtraNames:   []string{"1.3.6.1.4.1.311.60.2.1.3=GB"},
+			}),
+			violations: []string{"spec.subject.uid"},
+		},
+		"missing extra names": {
+			spec: specWithSubject(&cmapi.X509Subject{
+				SerialNumber: "1234",
+				UID:          "device-1",
+			}),
+			violations: []string{"spec.subject.extraNames"},
+		},
+		"no subject": {
+			spec:       specWithSubject(nil),
+			violations: []string{"spec.subject.serialNumber", "spec.subject.uid", "spec.subject.extraNames"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := RequestMatchesSpec(req, test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, v := range violations {
+				if strings.HasPrefix(v, "spec.subject.") {
+					got = append(got, v)
+				}
+			}
+			assert.Equal(t, test.violations, got)
+		})
+	}
+}
+
+func TestRequestMatchesSpecInternationalizedDomainNames(t *testing.T) {
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := cmapi.CertificateSpec{
+		CommonName: "bücher.example",
+		DNSNames:   []string{"bücher.example", "*.bücher.example"},
+	}
+	csr, err := pki.GenerateCSR(&cmapi.Certificate{Spec: spec})
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := pki.EncodeCSR(csr, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := gen.CertificateRequest("test", gen.SetCertificateRequestCSR(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})))
+
+	// the request contains the A-label form of the names in the spec
+	violations, err := RequestMatchesSpec(req, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, violations)
+
+	spec.DNSNames = []string{"bücher.example", "*.straße.example"}
+	violations, err = RequestMatchesSpec(req, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"spec.dnsNames"}, violations)
+}
+
+func TestRequestMatchesSpecTransformedFields(t *testing.T) {
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the request was created with an organizational unit added by a CSR
+	// transformer
+	csr, err := pki.GenerateCSR(&cmapi.Certificate{Spec: cmapi.CertificateSpec{
+		CommonName: "example.com",
+		Subject:    &cmapi.X509Subject{OrganizationalUnits: []string{"transformed"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := pki.EncodeCSR(csr, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	tests := map[string]struct {
+		annotations map[string]string
+		spec        cmapi.CertificateSpec
+		violations  []string
+	}{
+		"transformed fields are not violations": {
+			annotations: map[string]string{cmapi.CertificateRequestTransformedFieldsAnnotationKey: "spec.subject.organizationalUnits"},
+			spec:        cmapi.CertificateSpec{CommonName: "example.com"},
+		},
+		"fields are violations if the request was not transformed": {
+			spec:       cmapi.CertificateSpec{CommonName: "example.com"},
+			violations: []string{"spec.subject.organizationalUnits"},
+		},
+		"fields which were not transformed are still violations": {
+			annotations: map[string]string{cmapi.CertificateRequestTransformedFieldsAnnotationKey: "spec.subject.organizationalUnits"},
+			spec: cmapi.CertificateSpec{
+				CommonName: "example.com",
+				Subject:    &cmapi.X509Subject{SerialNumber: "1234"},
+			},
+			violations: []string{"spec.subject.serialNumber"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := gen.CertificateRequest("test",
+				gen.SetCertificateRequestCSR(csrPEM),
+				gen.SetCertificateRequestAnnotations(test.annotations),
+			)
+			violations, err := RequestMatchesSpec(req, test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, v := range violations {
+				if strings.HasPrefix(v, "spec.subject.") {
+					got = append(got, v)
+				}
+			}
+			assert.Equal(t, test.violations, got)
+		})
+	}
+}
+
+func TestRequestMatchesSpecSignatureAlgorithm(t *testing.T) {
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	specWithSignatureAlgorithm := func(sigAlgo cmapi.SignatureAlgorithm) cmapi.CertificateSpec {
+		return cmapi.CertificateSpec{
+			CommonName: "example.com",
+			PrivateKey: &cmapi.CertificatePrivateKey{SignatureAlgorithm: sigAlgo},
+		}
+	}
+	req := func() *cmapi.CertificateRequest {
+		csr, err := pki.GenerateCSR(&cmapi.Certificate{Spec: specWithSignatureAlgorithm(cmapi.SHA256WithRSAPSS)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		csrDER, err := pki.EncodeCSR(csr, pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return gen.CertificateRequest("test", gen.SetCertificateRequestCSR(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})))
+	}()
+
+	tests := map[string]struct {
+		spec       cmapi.CertificateSpec
+		violations []string
+	}{
+		"matching signature algorithm": {
+			spec: specWithSignatureAlgorithm(cmapi.SHA256WithRSAPSS),
+		},
+		"no signature algorithm requested": {
+			spec: specWithSignatureAlgorithm(""),
+		},
+		"different signature algorithm": {
+			spec:       specWithSignatureAlgorithm(cmapi.SHA256WithRSA),
+			violations: []string{"spec.privateKey.signatureAlgorithm"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := RequestMatchesSpec(req, test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, v := range violations {
+				if strings.HasPrefix(v, "spec.privateKey.") {
+					got = append(got, v)
+				}
+			}
+			assert.Equal(t, test.violations, got)
+		})
+	}
+}
+
+func TestRequestMatchesSpecDefaults(t *testing.T) {
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := cmapi.CertificateSpec{CommonName: "example.com", DNSNames: []string{"example.com"}}
+	csr, err := pki.GenerateCSR(&cmapi.Certificate{Spec: spec})
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := pki.EncodeCSR(csr, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	defaulted := spec
+	defaulted.IssuerRef = cmmeta.ObjectReference{Name: "ca", Kind: "Issuer", Group: "cert-manager.io"}
+	defaulted.Usages = cmapi.DefaultKeyUsages()
+
+	tests := map[string]struct {
+		req        *cmapi.CertificateRequest
+		spec       cmapi.CertificateSpec
+		violations []string
+	}{
+		"request created before defaulting matches a defaulted spec": {
+			req: gen.CertificateRequest("test",
+				gen.SetCertificateRequestCSR(csrPEM),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca"}),
+			),
+			spec: defaulted,
+		},
+		"request created after defaulting matches a spec which is not defaulted": {
+			req: gen.CertificateRequest("test",
+				gen.SetCertificateRequestCSR(csrPEM),
+				gen.SetCertificateRequestIssuer(defaulted.IssuerRef),
+				gen.SetCertificateRequestKeyUsages(cmapi.DefaultKeyUsages()...),
+			),
+			spec: func() cmapi.CertificateSpec {
+				spec := spec
+				spec.IssuerRef = cmmeta.ObjectReference{Name: "ca"}
+				return spec
+			}(),
+		},
+		"different usages and issuer are still violations": {
+			req: gen.CertificateRequest("test",
+				gen.SetCertificateRequestCSR(csrPEM),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca", Kind: "ClusterIssuer"}),
+				gen.SetCertificateRequestKeyUsages(cmapi.UsageServerAuth),
+			),
+			spec:       defaulted,
+			violations: []string{"spec.usages", "spec.issuerRef"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := RequestMatchesSpec(test.req, test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, test.violations, violations)
+		})
+	}
+}
+
 func TestSecretDataAltNamesMatchSpec(t *testing.T) {
 	tests := map[string]struct {
 		data       []byte
@@ -198,6 +539,34 @@ func TestSecretDataAltNamesMatchSpec(t *testing.T) {
 			}),
 			violations: []string{"spec.commonName", "spec.dnsNames"},
 		},
+		"should match if dnsNames are duplicated or differ only in case": {
+			spec: cmapi.CertificateSpec{
+				CommonName: "CN",
+				DNSNames:   []string{"at", "least", "one", "AT"},
+			},
+			data: selfSignCertificate(t, cmapi.CertificateSpec{
+				CommonName: "cn",
+				DNSNames:   []string{"one", "least", "at"},
+			}),
+		},
+		"should match if internationalized dnsNames are punycode encoded on the certificate": {
+			spec: cmapi.CertificateSpec{
+				DNSNames: []string{"bücher.example"},
+			},
+			data: selfSignCertificate(t, cmapi.CertificateSpec{
+				DNSNames: []string{"xn--bcher-kva.example"},
+			}),
+		},
+		"should match if ipAddresses are duplicated or use a different representation": {
+			spec: cmapi.CertificateSpec{
+				CommonName:  "cn",
+				IPAddresses: []string{"2001:db8:0:0::1", "10.0.0.1", "10.0.0.1"},
+			},
+			data: selfSignCertificate(t, cmapi.CertificateSpec{
+				CommonName:  "cn",
+				IPAddresses: []string{"10.0.0.1", "2001:db8::1"},
+			}),
+		},
 		"should not match if certificate has more dnsNames than spec": {
 			spec: cmapi.CertificateSpec{
 				CommonName: "cn",
@@ -295,6 +664,107 @@ func selfSignCertificate(t *testing.T, spec cmapi.CertificateSpec) []byte {
 	return pemData
 }
 
+func TestIssuedCertificateMatchesSpec(t *testing.T) {
+	spec := cmapi.CertificateSpec{
+		DNSNames: []string{"example.com"},
+		Duration: &metav1.Duration{Duration: time.Hour * 24 * 30},
+		Usages:   []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth},
+	}
+
+	tests := map[string]struct {
+		spec       cmapi.CertificateSpec
+		ecdsa      bool
+		modify     func(*x509.Certificate)
+		violations []string
+	}{
+		"should match a certificate issued as requested": {
+			spec: spec,
+		},
+		"should match if the duration is within the tolerance": {
+			spec: spec,
+			modify: func(c *x509.Certificate) {
+				c.NotBefore = c.NotBefore.Add(-time.Minute * 5)
+			},
+		},
+		"should match if additional usages were issued": {
+			spec: spec,
+			modify: func(c *x509.Certificate) {
+				c.ExtKeyUsage = append(c.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+			},
+		},
+		"should match if the certificate has no extended key usage extension": {
+			spec: spec,
+			modify: func(c *x509.Certificate) {
+				c.ExtKeyUsage = nil
+			},
+		},
+		"should not require key encipherment of ECDSA certificates": {
+			spec: func() cmapi.CertificateSpec {
+				s := *spec.DeepCopy()
+				s.PrivateKey = &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm}
+				return s
+			}(),
+			ecdsa: true,
+			modify: func(c *x509.Certificate) {
+				c.KeyUsage = x509.KeyUsageDigitalSignature
+			},
+		},
+		"should report names that were rewritten by the issuer": {
+			spec: spec,
+			modify: func(c *x509.Certificate) {
+				c.DNSNames = []string{"www.example.com"}
+			},
+			violations: []string{"spec.dnsNames"},
+		},
+		"should report missing usages and a different duration": {
+			spec: spec,
+			modify: func(c *x509.Certificate) {
+				c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+				c.NotAfter = c.NotBefore.Add(time.Hour * 24 * 90)
+			},
+			violations: []string{"spec.usages", "spec.duration"},
+		},
+		"should report a certificate which is unexpectedly a CA": {
+			spec: spec,
+			modify: func(c *x509.Certificate) {
+				c.IsCA = true
+			},
+			violations: []string{"spec.isCA"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var pk crypto.Signer
+			var err error
+			if test.ecdsa {
+				pk, err = pki.GenerateECPrivateKey(pki.ECCurve256)
+			} else {
+				pk, err = pki.GenerateRSAPrivateKey(2048)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			template, err := pki.GenerateTemplate(&cmapi.Certificate{Spec: test.spec})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.modify != nil {
+				test.modify(template)
+			}
+			_, x509cert, err := pki.SignCertificate(template, template, pk.Public(), pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			violations := IssuedCertificateMatchesSpec(x509cert, test.spec)
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Errorf("violations did not match, got=%s, exp=%s", violations, test.violations)
+			}
+		})
+	}
+}
+
 func TestRenewalTime(t *testing.T) {
 	type scenario struct {
 		notBefore           time.Time
@@ -361,3 +831,187 @@ func TestRenewalTime(t *testing.T) {
 		})
 	}
 }
+
+func TestRenewalJitterApply(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	notBefore := now
+	notAfter := now.Add(time.Hour * 90)
+	renewalTime := now.Add(time.Hour * 60)
+
+	tests := map[string]struct {
+		jitter     RenewalJitter
+		renewal    time.Time
+		minRenewal time.Time
+	}{
+		"no jitter configured should not change the renewal time": {
+			jitter:     RenewalJitter{},
+			renewal:    renewalTime,
+			minRenewal: renewalTime,
+		},
+		"percentage should bring renewal forward by at most that percentage of the renewal window": {
+			jitter:     RenewalJitter{Percentage: 10},
+			renewal:    renewalTime,
+			minRenewal: renewalTime.Add(-time.Hour * 3),
+		},
+		"window should bring renewal forward by at most the window": {
+			jitter:     RenewalJitter{Window: time.Hour},
+			renewal:    renewalTime,
+			minRenewal: renewalTime.Add(-time.Hour),
+		},
+		"renewal should never be brought forward before notBefore": {
+			jitter:     RenewalJitter{Window: time.Hour * 1000},
+			renewal:    renewalTime,
+			minRenewal: notBefore,
+		},
+	}
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			crt := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}}
+
+			got := test.jitter.Apply(crt, notBefore, notAfter, test.renewal)
+			assert.False(t, got.After(test.renewal), "expected renewal time %s to not be after %s", got, test.renewal)
+			assert.False(t, got.Before(test.minRenewal), "expected renewal time %s to not be before %s", got, test.minRenewal)
+			assert.Equal(t, got, got.Truncate(time.Second), "expected renewal time to be truncated to the second")
+
+			// The jitter must be stable for the same certificate.
+			assert.Equal(t, got, test.jitter.Apply(crt, notBefore, notAfter, test.renewal))
+		})
+	}
+
+	// Different Certificates issued at the same time should be spread out.
+	jitter := RenewalJitter{Window: time.Hour}
+	seen := make(map[time.Time]bool)
+	for i := 0; i < 10; i++ {
+		crt := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("test-%d", i)}}
+		seen[jitter.Apply(crt, notBefore, notAfter, renewalTime)] = true
+	}
+	assert.Greater(t, len(seen), 1, "expected renewal jitter to differ between certificates")
+}
+
+func TestNextRenewalWindowTime(t *testing.T) {
+	// 2 January 2021 was a Saturday.
+	date := func(day, hour, min int) time.Time {
+		return time.Date(2021, time.January, day, hour, min, 0, 0, time.UTC)
+	}
+	weekend := cmapi.CertificateRenewalWindowRange{Weekdays: []string{"Saturday"}, Start: "22:00", End: "04:00"}
+	nightly := cmapi.CertificateRenewalWindowRange{Start: "01:00", End: "03:00"}
+
+	tests := map[string]struct {
+		window  cmapi.CertificateRenewalWindow
+		now     time.Time
+		expNext time.Time
+		expErr  bool
+	}{
+		"time within a window should be returned unchanged": {
+			window:  cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{weekend}},
+			now:     date(2, 23, 0),
+			expNext: date(2, 23, 0),
+		},
+		"window opened on the previous day should still be open after midnight": {
+			window:  cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{weekend}},
+			now:     date(3, 2, 0),
+			expNext: date(3, 2, 0),
+		},
+		"time before a window should return the time the window opens": {
+			window:  cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{weekend}},
+			now:     date(1, 12, 0),
+			expNext: date(2, 22, 0),
+		},
+		"time after a weekly window should return the time it opens the next week": {
+			window:  cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{weekend}},
+			now:     date(3, 5, 0),
+			expNext: date(9, 22, 0),
+		},
+		"daily window should open on the next day": {
+			window:  cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{nightly}},
+			now:     date(3, 4, 0),
+			expNext: date(4, 1, 0),
+		},
+		"earliest of several windows should be returned": {
+			window:  cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{weekend, nightly}},
+			now:     date(1, 12, 0),
+			expNext: date(2, 1, 0),
+		},
+		"window times should be interpreted in the configured time zone": {
+			window:  cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{{Start: "02:00", End: "03:00"}}, TimeZone: "Europe/Berlin"},
+			now:     date(2, 0, 30),
+			expNext: date(2, 1, 0),
+		},
+		"invalid time zone should error": {
+			window: cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{nightly}, TimeZone: "Nowhere/Special"},
+			now:    date(1, 12, 0),
+			expErr: true,
+		},
+		"invalid start time should error": {
+			window: cmapi.CertificateRenewalWindow{Windows: []cmapi.CertificateRenewalWindowRange{{Start: "25:00", End: "03:00"}}},
+			now:    date(1, 12, 0),
+			expErr: true,
+		},
+		"no windows should error": {
+			window: cmapi.CertificateRenewalWindow{},
+			now:    date(1, 12, 0),
+			expErr: true,
+		},
+	}
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			next, err := NextRenewalWindowTime(&test.window, test.now)
+			if test.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, test.expNext.Equal(next), "expected next renewal window time %s, got %s", test.expNext, next)
+		})
+	}
+}
+
+func TestActiveIssuerRef(t *testing.T) {
+	primary := cmmeta.ObjectReference{Name: "primary"}
+	secondary := cmmeta.ObjectReference{Name: "secondary", Kind: "ClusterIssuer"}
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateIssuer(primary),
+		gen.SetCertificateFallbackIssuers(secondary),
+	)
+
+	tests := map[string]struct {
+		crt      *cmapi.Certificate
+		expected cmmeta.ObjectReference
+	}{
+		"use the primary issuer if there have been no failures": {
+			crt:      baseCrt,
+			expected: primary,
+		},
+		"use the primary issuer if there are no fallback issuers": {
+			crt: gen.Certificate("test",
+				gen.SetCertificateIssuer(primary),
+				gen.SetCertificateFailedIssuanceAttempts(10),
+			),
+			expected: primary,
+		},
+		"use the primary issuer until the default threshold is reached": {
+			crt:      gen.CertificateFrom(baseCrt, gen.SetCertificateFailedIssuanceAttempts(2)),
+			expected: primary,
+		},
+		"fail over once the default threshold is reached": {
+			crt:      gen.CertificateFrom(baseCrt, gen.SetCertificateFailedIssuanceAttempts(3)),
+			expected: secondary,
+		},
+		"fail over once the configured threshold is reached": {
+			crt: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateIssuerFailoverThreshold(1),
+				gen.SetCertificateFailedIssuanceAttempts(1),
+			),
+			expected: secondary,
+		},
+		"return to the primary issuer once all fallback issuers have failed": {
+			crt:      gen.CertificateFrom(baseCrt, gen.SetCertificateFailedIssuanceAttempts(6)),
+			expected: primary,
+		},
+	}
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, test.expected, ActiveIssuerRef(test.crt))
+		})
+	}
+}
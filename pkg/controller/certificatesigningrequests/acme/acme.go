@@ -40,6 +40,7 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests"
 	ctrlutil "github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
@@ -102,7 +103,7 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 	if err != nil {
 		message := fmt.Sprintf("Failed to decode CSR in spec.request: %s", err)
 		log.Error(err, message)
-		a.recorder.Event(csr, corev1.EventTypeWarning, "RequestParsingError", message)
+		a.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonRequestParsingError, message)
 		ctrlutil.CertificateSigningRequestSetFailed(csr, "RequestParsingError", message)
 		_, uerr := a.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return uerr
@@ -115,7 +116,7 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 		message := fmt.Sprintf("The CSR PEM requests a commonName that is not present in the list of dnsNames or ipAddresses. If a commonName is set, ACME requires that the value is also present in the list of dnsNames or ipAddresses: %s", err)
 
 		log.Error(err, message)
-		a.recorder.Event(csr, corev1.EventTypeWarning, "InvalidOrder", message)
+		a.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonInvalidOrder, message)
 		ctrlutil.CertificateSigningRequestSetFailed(csr, "InvalidOrder", message)
 		_, uerr := a.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return uerr
@@ -127,7 +128,7 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 		message := fmt.Sprintf("Failed to build order: %s", err)
 
 		log.Error(err, message)
-		a.recorder.Event(csr, corev1.EventTypeWarning, "OrderBuildingError", message)
+		a.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonOrderBuildingError, message)
 		ctrlutil.CertificateSigningRequestSetFailed(csr, "OrderBuildingError", message)
 		_, uerr := a.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return uerr
@@ -145,7 +146,7 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 
 		message := fmt.Sprintf("Created Order resource %s/%s",
 			expectedOrder.Namespace, expectedOrder.Name)
-		a.recorder.Event(csr, corev1.EventTypeNormal, "OrderCreated", message)
+		a.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonOrderCreated, message)
 		log.V(logf.DebugLevel).Info(message)
 		return nil
 	}
@@ -172,14 +173,14 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 		err := fmt.Errorf("order is in %q state: %s", order.Status.State, order.Status.Reason)
 		message := fmt.Sprintf("Failed to wait for order resource %s/%s to become ready: %s", expectedOrder.Namespace, expectedOrder.Name, err)
 
-		a.recorder.Event(csr, corev1.EventTypeWarning, "OrderFailed", message)
+		a.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonOrderFailed, message)
 		ctrlutil.CertificateSigningRequestSetFailed(csr, "OrderFailed", message)
 		_, uerr := a.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return uerr
 	}
 
 	if order.Status.State != cmacme.Valid {
-		a.recorder.Event(csr, corev1.EventTypeNormal, "OrderPending",
+		a.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonOrderPending,
 			fmt.Sprintf("Waiting on certificate issuance from order %s/%s: %q",
 				expectedOrder.Namespace, order.Name, order.Status.State))
 
@@ -188,7 +189,7 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 	}
 
 	if len(order.Status.Certificate) == 0 {
-		a.recorder.Event(csr, corev1.EventTypeNormal, "OrderPending",
+		a.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonOrderPending,
 			fmt.Sprintf("Waiting for order-controller to add certificate data to Order %s/%s",
 				expectedOrder.Namespace, order.Name))
 
@@ -199,7 +200,7 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 	x509Cert, err := pki.DecodeX509CertificateBytes(order.Status.Certificate)
 	if err != nil {
 		message := fmt.Sprintf("Deleting Order with bad certificate: %s", err)
-		a.recorder.Event(csr, corev1.EventTypeWarning, "OrderBadCertificate", message)
+		a.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonOrderBadCertificate, message)
 		log.Error(err, "failed to decode x509 certificate data on Order resource.")
 		// Deleting the order here will cause a re-sync since the Order is owned by
 		// this CertificateSigningRequest.
@@ -207,7 +208,7 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 	}
 
 	if ok, err := pki.PublicKeyMatchesCertificate(req.PublicKey, x509Cert); err != nil || !ok {
-		a.recorder.Event(csr, corev1.EventTypeWarning, "OrderBadCertificate", "Deleting Order as the signed certificate's key does not match the request")
+		a.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonOrderBadCertificate, "Deleting Order as the signed certificate's key does not match the request")
 		log.Error(err, "The public key in Order.Status.Certificate does not match the public key in CertificateSigningRequest.Spec.Request. Deleting the order.")
 		// Deleting the order here will cause a re-sync since the Order is owned by
 		// this CertificateSigningRequest.
@@ -218,12 +219,12 @@ func (a *ACME) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningR
 	csr, err = a.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 	if err != nil {
 		message := "Error updating certificate"
-		a.recorder.Eventf(csr, corev1.EventTypeWarning, "SigningError", "%s: %s", message, err)
+		a.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonSigningError, "%s: %s", message, err)
 		return err
 	}
 
 	log.V(logf.DebugLevel).Info("certificate issued")
-	a.recorder.Event(csr, corev1.EventTypeNormal, "CertificateIssued", "Certificate fetched from issuer successfully")
+	a.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonCertificateIssued, "Certificate fetched from issuer successfully")
 
 	return nil
 }
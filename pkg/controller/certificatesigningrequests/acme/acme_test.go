@@ -316,6 +316,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -376,6 +397,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -439,6 +481,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -501,6 +564,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewCreateAction(
 						cmacme.SchemeGroupVersion.WithResource("orders"),
 						gen.DefaultTestNamespace,
@@ -551,6 +635,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -600,6 +705,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -668,6 +794,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -717,6 +864,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -766,6 +934,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewDeleteAction(
 						cmacme.SchemeGroupVersion.WithResource("orders"),
 						gen.DefaultTestNamespace,
@@ -820,6 +1009,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewDeleteAction(
 						cmacme.SchemeGroupVersion.WithResource("orders"),
 						gen.DefaultTestNamespace,
@@ -875,6 +1085,27 @@ func Test_ProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -35,9 +35,10 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	caissuer "github.com/jetstack/cert-manager/pkg/issuer/ca"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	cmerrors "github.com/jetstack/cert-manager/pkg/util/errors"
-	"github.com/jetstack/cert-manager/pkg/util/kube"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -95,30 +96,30 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
 
 	// get a copy of the CA certificate named on the Issuer
-	caCerts, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, c.secretsLister, resourceNamespace, issuerObj.GetSpec().CA.SecretName)
+	caCerts, caKey, err := caissuer.KeyPair(ctx, c.secretsLister, c.issuerOptions, issuerObj)
 	if apierrors.IsNotFound(err) {
 		message := fmt.Sprintf("Referenced secret %s/%s not found", resourceNamespace, secretName)
-		c.recorder.Event(csr, corev1.EventTypeWarning, "SecretMissing", message)
+		c.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonSecretMissing, message)
 		return nil
 	}
 
 	if cmerrors.IsInvalidData(err) {
 		message := fmt.Sprintf("Failed to parse signing CA keypair from secret %s/%s", resourceNamespace, secretName)
-		c.recorder.Eventf(csr, corev1.EventTypeWarning, "SecretInvalidData", "%s: %s", message, err)
+		c.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonSecretInvalidData, "%s: %s", message, err)
 		return nil
 	}
 
 	if err != nil {
 		// We are probably in a network error here so we should backoff and retry
 		message := fmt.Sprintf("Failed to get certificate key pair from secret %s/%s", resourceNamespace, secretName)
-		c.recorder.Eventf(csr, corev1.EventTypeWarning, "SecretGetError", "%s: %s", message, err)
+		c.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonSecretGetError, "%s: %s", message, err)
 		return err
 	}
 
 	template, err := c.templateGenerator(csr)
 	if err != nil {
 		message := fmt.Sprintf("Error generating certificate template: %s", err)
-		c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+		c.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonSigningError, message)
 		util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
 		_, err = c.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -126,11 +127,12 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().CA.CRLDistributionPoints
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
+	template.NotBefore = template.NotBefore.Add(-c.issuerOptions.Backdate(nil))
 
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := fmt.Sprintf("Error signing certificate: %s", err)
-		c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+		c.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonSigningError, message)
 		util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
 		_, err := c.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -140,12 +142,12 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 	csr, err = c.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 	if err != nil {
 		message := "Error updating certificate"
-		c.recorder.Eventf(csr, corev1.EventTypeWarning, "SigningError", "%s: %s", message, err)
+		c.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonSigningError, "%s: %s", message, err)
 		return err
 	}
 
 	log.V(logf.DebugLevel).Info("certificate issued")
-	c.recorder.Event(csr, corev1.EventTypeNormal, "CertificateIssued", "Certificate fetched from issuer successfully")
+	c.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonCertificateIssued, "Certificate fetched from issuer successfully")
 
 	return nil
 }
@@ -235,6 +235,27 @@ func TestSign(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -274,6 +295,27 @@ func TestSign(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -309,6 +351,27 @@ func TestSign(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 			fakeLister: &testlisters.FakeSecretLister{
@@ -356,6 +419,27 @@ func TestSign(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -394,6 +478,27 @@ func TestSign(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -448,6 +553,27 @@ func TestSign(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -512,6 +638,27 @@ func TestSign(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
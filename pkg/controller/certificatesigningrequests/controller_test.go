@@ -27,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
@@ -36,6 +37,7 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/fake"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
 	"github.com/jetstack/cert-manager/test/unit/gen"
 )
@@ -89,8 +91,9 @@ func TestController(t *testing.T) {
 		sarReaction     func(t *testing.T) coretesting.ReactionFunc
 		wantSARCreation []*authzv1.SubjectAccessReview
 
-		// wantEvent, if set, is an 'event string' that is expected to be fired.
-		wantEvent string
+		// wantEvent, if set, is the event that is expected to be fired. Only
+		// the object, type and reason of the event are compared.
+		wantEvent *testpkg.Event
 
 		// wantConditions is the expected set of conditions on the
 		// CertificateSigningRequest resource if an Update is made.
@@ -168,7 +171,11 @@ func TestController(t *testing.T) {
 			),
 			signerImpl:  signerExpectNoCall,
 			sarReaction: sarReactionExpectNoCall,
-			wantEvent:   "Normal WaitingApproval Waiting for the Approved condition before issuing",
+			wantEvent: &testpkg.Event{
+				Object: types.NamespacedName{Name: "csr-1"},
+				Type:   corev1.EventTypeNormal,
+				Reason: events.ReasonWaitingApproval,
+			},
 		},
 		"do nothing if CertificateSigningRequest already has a non empty Certificate present": {
 			signerType: apiutil.IssuerCA,
@@ -199,7 +206,11 @@ func TestController(t *testing.T) {
 			signerImpl:     signerExpectNoCall,
 			sarReaction:    sarReactionExpectNoCall,
 			existingIssuer: nil,
-			wantEvent:      "Warning IssuerNotFound Referenced Issuer hello/world not found",
+			wantEvent: &testpkg.Event{
+				Object: types.NamespacedName{Name: "csr-1"},
+				Type:   corev1.EventTypeWarning,
+				Reason: events.ReasonIssuerNotFound,
+			},
 		},
 		"if CertificateSigningRequest references an Issuer that does not yet have a type, should fire an event it doesn't have a type": {
 			signerType: apiutil.IssuerCA,
@@ -215,7 +226,11 @@ func TestController(t *testing.T) {
 			signerImpl:     signerExpectNoCall,
 			sarReaction:    sarReactionExpectNoCall,
 			existingIssuer: gen.Issuer("world", gen.SetIssuerNamespace("hello")),
-			wantEvent:      "Warning IssuerTypeMissing Referenced Issuer hello/world is missing type",
+			wantEvent: &testpkg.Event{
+				Object: types.NamespacedName{Name: "csr-1"},
+				Type:   corev1.EventTypeWarning,
+				Reason: events.ReasonIssuerTypeMissing,
+			},
 		},
 		"if CertificateSigningRequest references an Issuer which does not match the same signer type, should ignore": {
 			signerType: apiutil.IssuerSelfSigned,
@@ -373,7 +388,11 @@ func TestController(t *testing.T) {
 					SecretName: "tls",
 				}),
 			),
-			wantEvent: "Warning DeniedReference Requester may not reference Namespaced Issuer hello/world",
+			wantEvent: &testpkg.Event{
+				Object: types.NamespacedName{Name: "csr-1"},
+				Type:   corev1.EventTypeWarning,
+				Reason: events.ReasonDeniedReference,
+			},
 			wantConditions: []certificatesv1.CertificateSigningRequestCondition{
 				{
 					Type:    certificatesv1.CertificateApproved,
@@ -391,6 +410,357 @@ func TestController(t *testing.T) {
 				},
 			},
 		},
+		"if CertificateSigningRequest references a clusterissuers signer but the requesting user is not authorized for the signerName, should update Failed": {
+			signerType: apiutil.IssuerCA,
+			existingCSR: gen.CertificateSigningRequest("csr-1",
+				gen.SetCertificateSigningRequestSignerName("clusterissuers.cert-manager.io/world"),
+				gen.SetCertificateSigningRequestUsername("user-1"),
+				gen.SetCertificateSigningRequestGroups([]string{"group-1", "group-2"}),
+				gen.SetCertificateSigningRequestUID("uid-1"),
+				gen.SetCertificateSigningRequestExtra(map[string]certificatesv1.ExtraValue{
+					"extra": []string{"1", "2"},
+				}),
+				gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+					Type:    certificatesv1.CertificateApproved,
+					Status:  corev1.ConditionTrue,
+					Reason:  "ApprovedReason",
+					Message: "Approved message",
+				}),
+			),
+			signerImpl: signerExpectNoCall,
+			sarReaction: func(t *testing.T) coretesting.ReactionFunc {
+				return func(_ coretesting.Action) (bool, runtime.Object, error) {
+					return true, &authzv1.SubjectAccessReview{
+						Status: authzv1.SubjectAccessReviewStatus{
+							Allowed: false,
+						},
+					}, nil
+				}
+			},
+			wantSARCreation: []*authzv1.SubjectAccessReview{
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "clusterissuers.cert-manager.io/world",
+						},
+					},
+				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "clusterissuers.cert-manager.io/*",
+						},
+					},
+				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "sign",
+							Name:     "clusterissuers.cert-manager.io/world",
+						},
+					},
+				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "sign",
+							Name:     "clusterissuers.cert-manager.io/*",
+						},
+					},
+				},
+			},
+			existingIssuer: gen.ClusterIssuer("world",
+				gen.SetIssuerCA(cmapi.CAIssuer{
+					SecretName: "tls",
+				}),
+			),
+			wantEvent: &testpkg.Event{
+				Object: types.NamespacedName{Name: "csr-1"},
+				Type:   corev1.EventTypeWarning,
+				Reason: events.ReasonDeniedReference,
+			},
+			wantConditions: []certificatesv1.CertificateSigningRequestCondition{
+				{
+					Type:    certificatesv1.CertificateApproved,
+					Status:  corev1.ConditionTrue,
+					Reason:  "ApprovedReason",
+					Message: "Approved message",
+				},
+				{
+					Type:               certificatesv1.CertificateFailed,
+					Status:             corev1.ConditionTrue,
+					Reason:             "DeniedReference",
+					Message:            "Requester may not reference ClusterIssuer world",
+					LastTransitionTime: metaFixedClockStart,
+					LastUpdateTime:     metaFixedClockStart,
+				},
+			},
+		},
+		"if CertificateSigningRequest references a issuers signer that the requesting user may reference but is not authorized for the signerName, should update Failed": {
+			signerType: apiutil.IssuerCA,
+			existingCSR: gen.CertificateSigningRequest("csr-1",
+				gen.SetCertificateSigningRequestSignerName("issuers.cert-manager.io/hello.world"),
+				gen.SetCertificateSigningRequestUsername("user-1"),
+				gen.SetCertificateSigningRequestGroups([]string{"group-1", "group-2"}),
+				gen.SetCertificateSigningRequestUID("uid-1"),
+				gen.SetCertificateSigningRequestExtra(map[string]certificatesv1.ExtraValue{
+					"extra": []string{"1", "2"},
+				}),
+				gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+					Type:    certificatesv1.CertificateApproved,
+					Status:  corev1.ConditionTrue,
+					Reason:  "ApprovedReason",
+					Message: "Approved message",
+				}),
+			),
+			signerImpl: signerExpectNoCall,
+			sarReaction: func(t *testing.T) coretesting.ReactionFunc {
+				return func(action coretesting.Action) (bool, runtime.Object, error) {
+					sar := action.(coretesting.CreateAction).GetObject().(*authzv1.SubjectAccessReview)
+					return true, &authzv1.SubjectAccessReview{
+						Status: authzv1.SubjectAccessReviewStatus{
+							Allowed: sar.Spec.ResourceAttributes.Verb == "reference",
+						},
+					}, nil
+				}
+			},
+			wantSARCreation: []*authzv1.SubjectAccessReview{
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:     "cert-manager.io",
+							Resource:  "signers",
+							Verb:      "reference",
+							Namespace: "hello",
+							Name:      "world",
+							Version:   "*",
+						},
+					},
+				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "issuers.cert-manager.io/hello.world",
+						},
+					},
+				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "issuers.cert-manager.io/*",
+						},
+					},
+				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "sign",
+							Name:     "issuers.cert-manager.io/hello.world",
+						},
+					},
+				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "sign",
+							Name:     "issuers.cert-manager.io/*",
+						},
+					},
+				},
+			},
+			existingIssuer: gen.Issuer("world", gen.SetIssuerNamespace("hello"),
+				gen.SetIssuerCA(cmapi.CAIssuer{
+					SecretName: "tls",
+				}),
+			),
+			wantEvent: &testpkg.Event{
+				Object: types.NamespacedName{Name: "csr-1"},
+				Type:   corev1.EventTypeWarning,
+				Reason: events.ReasonDeniedReference,
+			},
+			wantConditions: []certificatesv1.CertificateSigningRequestCondition{
+				{
+					Type:    certificatesv1.CertificateApproved,
+					Status:  corev1.ConditionTrue,
+					Reason:  "ApprovedReason",
+					Message: "Approved message",
+				},
+				{
+					Type:               certificatesv1.CertificateFailed,
+					Status:             corev1.ConditionTrue,
+					Reason:             "DeniedReference",
+					Message:            "Requester may not approve or sign for Namespaced Issuer hello/world",
+					LastTransitionTime: metaFixedClockStart,
+					LastUpdateTime:     metaFixedClockStart,
+				},
+			},
+		},
+		"if CertificateSigningRequest references a clusterissuers signer and the requesting user may approve all clusterissuers signers, should sign": {
+			signerType: apiutil.IssuerCA,
+			existingCSR: gen.CertificateSigningRequest("csr-1",
+				gen.SetCertificateSigningRequestSignerName("clusterissuers.cert-manager.io/world"),
+				gen.SetCertificateSigningRequestUsername("user-1"),
+				gen.SetCertificateSigningRequestGroups([]string{"group-1", "group-2"}),
+				gen.SetCertificateSigningRequestUID("uid-1"),
+				gen.SetCertificateSigningRequestExtra(map[string]certificatesv1.ExtraValue{
+					"extra": []string{"1", "2"},
+				}),
+				gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+					Type:    certificatesv1.CertificateApproved,
+					Status:  corev1.ConditionTrue,
+					Reason:  "ApprovedReason",
+					Message: "Approved message",
+				}),
+			),
+			signerImpl: func(t *testing.T) Signer {
+				return &fake.Signer{
+					FakeSign: func(context.Context, *certificatesv1.CertificateSigningRequest, cmapi.GenericIssuer) error {
+						return nil
+					},
+				}
+			},
+			sarReaction: func(t *testing.T) coretesting.ReactionFunc {
+				return func(action coretesting.Action) (bool, runtime.Object, error) {
+					sar := action.(coretesting.CreateAction).GetObject().(*authzv1.SubjectAccessReview)
+					return true, &authzv1.SubjectAccessReview{
+						Status: authzv1.SubjectAccessReviewStatus{
+							Allowed: sar.Spec.ResourceAttributes.Name == "clusterissuers.cert-manager.io/*",
+						},
+					}, nil
+				}
+			},
+			wantSARCreation: []*authzv1.SubjectAccessReview{
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "clusterissuers.cert-manager.io/world",
+						},
+					},
+				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "clusterissuers.cert-manager.io/*",
+						},
+					},
+				},
+			},
+			existingIssuer: gen.ClusterIssuer("world",
+				gen.SetIssuerCA(cmapi.CAIssuer{
+					SecretName: "tls",
+				}),
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:    cmapi.IssuerConditionReady,
+					Status:  cmmeta.ConditionTrue,
+					Reason:  "IssuerReady",
+					Message: "Issuer ready message",
+				}),
+			),
+			wantErr: false,
+		},
 		"if CertificateSigningRequest references a issuers signer but the Issuer is not ready, fire event not Ready": {
 			signerType: apiutil.IssuerCA,
 			existingCSR: gen.CertificateSigningRequest("csr-1",
@@ -430,8 +800,29 @@ func TestController(t *testing.T) {
 						},
 					},
 				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "issuers.cert-manager.io/hello.world",
+						},
+					},
+				},
+			},
+			wantEvent: &testpkg.Event{
+				Object: types.NamespacedName{Name: "csr-1"},
+				Type:   corev1.EventTypeWarning,
+				Reason: events.ReasonIssuerNotReady,
 			},
-			wantEvent: "Warning IssuerNotReady Referenced Issuer hello/world does not have a Ready status condition",
 			existingIssuer: gen.Issuer("world", gen.SetIssuerNamespace("hello"),
 				gen.SetIssuerCA(cmapi.CAIssuer{
 					SecretName: "tls",
@@ -483,6 +874,23 @@ func TestController(t *testing.T) {
 						},
 					},
 				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "issuers.cert-manager.io/hello.world",
+						},
+					},
+				},
 			},
 			existingIssuer: gen.Issuer("world", gen.SetIssuerNamespace("hello"),
 				gen.SetIssuerCA(cmapi.CAIssuer{
@@ -542,6 +950,23 @@ func TestController(t *testing.T) {
 						},
 					},
 				},
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra: map[string]authzv1.ExtraValue{
+							"extra": []string{"1", "2"},
+						},
+						UID: "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:    "certificates.k8s.io",
+							Resource: "signers",
+							Verb:     "approve",
+							Name:     "issuers.cert-manager.io/hello.world",
+						},
+					},
+				},
 			},
 			existingIssuer: gen.Issuer("world", gen.SetIssuerNamespace("hello"),
 				gen.SetIssuerCA(cmapi.CAIssuer{
@@ -612,8 +1037,8 @@ func TestController(t *testing.T) {
 					)),
 				)
 			}
-			if test.wantEvent != "" {
-				builder.ExpectedEvents = []string{test.wantEvent}
+			if test.wantEvent != nil {
+				builder.ExpectedStructuredEvents = []testpkg.Event{*test.wantEvent}
 			}
 
 			builder.Start()
@@ -37,6 +37,7 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	cmerrors "github.com/jetstack/cert-manager/pkg/util/errors"
 	"github.com/jetstack/cert-manager/pkg/util/kube"
@@ -97,7 +98,7 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 	if !ok || len(secretName) == 0 {
 		message := fmt.Sprintf("Missing private key reference annotation: %q", experimentalapi.CertificateSigningRequestPrivateKeyAnnotationKey)
 		log.Error(errors.New(message), "")
-		s.recorder.Event(csr, corev1.EventTypeWarning, "MissingAnnotation", message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonMissingAnnotation, message)
 		util.CertificateSigningRequestSetFailed(csr, "MissingAnnotation", message)
 		_, err := s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -109,7 +110,7 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 	if apierrors.IsNotFound(err) {
 		message := fmt.Sprintf("Referenced Secret %s/%s not found", resourceNamespace, secretName)
 		log.Error(err, message)
-		s.recorder.Event(csr, corev1.EventTypeWarning, "SecretNotFound", message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonSecretNotFound, message)
 		util.CertificateSigningRequestSetFailed(csr, "SecretNotFound", message)
 		_, err = s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -118,7 +119,7 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 	if cmerrors.IsInvalidData(err) {
 		message := fmt.Sprintf("Failed to parse signing key from secret %s/%s", resourceNamespace, secretName)
 		log.Error(err, message)
-		s.recorder.Eventf(csr, corev1.EventTypeWarning, "ErrorParsingKey", "%s: %s", message, err)
+		s.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonErrorParsingKey, "%s: %s", message, err)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorParsingKey", message)
 		_, err = s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -128,7 +129,7 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 		// We are probably in a network error here so we should backoff and retry
 		message := fmt.Sprintf("Failed to get certificate CA key from secret %s/%s", resourceNamespace, secretName)
 		log.Error(err, message)
-		s.recorder.Eventf(csr, corev1.EventTypeWarning, "ErrorGettingSecret", "%s: %s", message, err)
+		s.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonErrorGettingSecret, "%s: %s", message, err)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorGettingSecret", message)
 		_, err = s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -138,20 +139,21 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 	if err != nil {
 		message := fmt.Sprintf("Error generating certificate template: %s", err)
 		log.Error(err, message)
-		s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorGenerating", message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorGenerating, message)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorGenerating", message)
 		_, err = s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
 	}
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().SelfSigned.CRLDistributionPoints
+	template.NotBefore = template.NotBefore.Add(-s.issuerOptions.Backdate(nil))
 
 	// extract the public component of the key
 	publickey, err := pki.PublicKeyForPrivateKey(privatekey)
 	if err != nil {
 		message := "Failed to get public key from private key"
 		log.Error(err, message)
-		s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorPublicKey", message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorPublicKey, message)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorPublicKey", message)
 		_, err = s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -165,7 +167,7 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 
 		message := "Referenced private key in Secret does not match that in the request"
 		log.Error(err, message)
-		s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorKeyMatch", message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorKeyMatch, message)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorKeyMatch", message)
 		_, err = s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -174,7 +176,7 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 	certPEM, _, err := s.signingFn(template, template, publickey, privatekey)
 	if err != nil {
 		message := fmt.Sprintf("Error signing certificate: %s", err)
-		s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorSigning", message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorSigning, message)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorSigning", message)
 		_, err = s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -184,12 +186,12 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 	csr, err = s.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 	if err != nil {
 		message := "Error updating certificate"
-		s.recorder.Eventf(csr, corev1.EventTypeWarning, "ErrorUpdate", "%s: %s", message, err)
+		s.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonErrorUpdate, "%s: %s", message, err)
 		return err
 	}
 
 	log.V(logf.DebugLevel).Info("self signed certificate issued")
-	s.recorder.Event(csr, corev1.EventTypeNormal, "CertificateIssued", "Certificate self signed successfully")
+	s.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonCertificateIssued, "Certificate self signed successfully")
 
 	return nil
 }
@@ -195,6 +195,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -257,6 +278,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -333,6 +375,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -400,6 +463,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -467,6 +551,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -538,6 +643,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -609,6 +735,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -19,6 +19,7 @@ package certificatesigningrequests
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	authzv1 "k8s.io/api/authorization/v1"
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -31,6 +32,7 @@ import (
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 )
 
@@ -63,7 +65,7 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 		return nil
 	}
 	if !util.CertificateSigningRequestIsApproved(csr) {
-		c.recorder.Event(csr, corev1.EventTypeNormal, "WaitingApproval", "Waiting for the Approved condition before issuing")
+		c.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonWaitingApproval, "Waiting for the Approved condition before issuing")
 		dbg.Info("certificate signing request is not approved so skipping processing")
 		return nil
 	}
@@ -85,7 +87,7 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 		Group: ref.Group,
 	}, ref.Namespace)
 	if apierrors.IsNotFound(err) {
-		c.recorder.Eventf(csr, corev1.EventTypeWarning, "IssuerNotFound", "Referenced %s %s/%s not found", kind, ref.Namespace, ref.Name)
+		c.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonIssuerNotFound, "Referenced %s %s/%s not found", kind, ref.Namespace, ref.Name)
 		return nil
 	}
 
@@ -99,7 +101,7 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 
 	signerType, err := apiutil.NameForIssuer(issuerObj)
 	if err != nil {
-		c.recorder.Eventf(csr, corev1.EventTypeWarning, "IssuerTypeMissing", "Referenced %s %s/%s is missing type", kind, ref.Namespace, ref.Name)
+		c.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonIssuerTypeMissing, "Referenced %s %s/%s is missing type", kind, ref.Namespace, ref.Name)
 		return nil
 	}
 
@@ -117,7 +119,7 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 
 		if !ok {
 			message := fmt.Sprintf("Requester may not reference Namespaced Issuer %s/%s", ref.Namespace, ref.Name)
-			c.recorder.Event(csr, corev1.EventTypeWarning, "DeniedReference", message)
+			c.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonDeniedReference, message)
 			util.CertificateSigningRequestSetFailed(csr, "DeniedReference", message)
 			if _, err := c.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{}); err != nil {
 				return err
@@ -127,12 +129,31 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 		}
 	}
 
+	ok, err = c.userIsAuthorizedForSignerName(ctx, csr)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		message := fmt.Sprintf("Requester may not reference ClusterIssuer %s", ref.Name)
+		if kind == cmapi.IssuerKind {
+			message = fmt.Sprintf("Requester may not approve or sign for Namespaced Issuer %s/%s", ref.Namespace, ref.Name)
+		}
+		c.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonDeniedReference, message)
+		util.CertificateSigningRequestSetFailed(csr, "DeniedReference", message)
+		if _, err := c.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
 	// check ready condition
 	if !apiutil.IssuerHasCondition(issuerObj, cmapi.IssuerCondition{
 		Type:   cmapi.IssuerConditionReady,
 		Status: cmmeta.ConditionTrue,
 	}) {
-		c.recorder.Eventf(csr, corev1.EventTypeWarning, "IssuerNotReady", "Referenced %s %s/%s does not have a Ready status condition",
+		c.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonIssuerNotReady, "Referenced %s %s/%s does not have a Ready status condition",
 			kind, issuerObj.GetNamespace(), issuerObj.GetName())
 		return nil
 	}
@@ -151,37 +172,78 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 // namespace: <referenced signer namespace>
 // name: <either the name of the signer or '*' for all signer names in that namespace>
 func (c *Controller) userCanReferenceSigner(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, issuerNamespace, issuerName string) (bool, error) {
+	for _, name := range []string{issuerName, "*"} {
+		ok, err := c.subjectAccessReview(ctx, csr, &authzv1.ResourceAttributes{
+			Group:     certmanager.GroupName,
+			Resource:  "signers",
+			Verb:      "reference",
+			Namespace: issuerNamespace,
+			Name:      name,
+			Version:   "*",
+		})
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
+
+// userIsAuthorizedForSignerName will return true if the CSR requester has a
+// bound role that allows them to approve or sign for the CSR's signerName,
+// mirroring the authorization used by kube-controller-manager for its built-in
+// signers. This prevents any user that is able to create
+// CertificateSigningRequests from having them signed by any Issuer or
+// ClusterIssuer.
+// The user must have the permissions:
+// group: certificates.k8s.io
+// resource: signers
+// verb: approve or sign
+// name: <either the signerName or '<signer type>.cert-manager.io/*' for all signers of that type>
+func (c *Controller) userIsAuthorizedForSignerName(ctx context.Context, csr *certificatesv1.CertificateSigningRequest) (bool, error) {
+	names := []string{csr.Spec.SignerName}
+	if i := strings.Index(csr.Spec.SignerName, "/"); i > 0 {
+		names = append(names, csr.Spec.SignerName[:i]+"/*")
+	}
+
+	for _, verb := range []string{"approve", "sign"} {
+		for _, name := range names {
+			ok, err := c.subjectAccessReview(ctx, csr, &authzv1.ResourceAttributes{
+				Group:    certificatesv1.GroupName,
+				Resource: "signers",
+				Verb:     verb,
+				Name:     name,
+			})
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// subjectAccessReview will return true if the CSR requester is allowed to
+// perform the action described by the given resource attributes.
+func (c *Controller) subjectAccessReview(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, attrs *authzv1.ResourceAttributes) (bool, error) {
 	extra := make(map[string]authzv1.ExtraValue)
 	for k, v := range csr.Spec.Extra {
 		extra[k] = authzv1.ExtraValue(v)
 	}
 
-	for _, name := range []string{issuerName, "*"} {
-		resp, err := c.sarClient.Create(ctx, &authzv1.SubjectAccessReview{
-			Spec: authzv1.SubjectAccessReviewSpec{
-				User:   csr.Spec.Username,
-				Groups: csr.Spec.Groups,
-				Extra:  extra,
-				UID:    csr.Spec.UID,
-
-				ResourceAttributes: &authzv1.ResourceAttributes{
-					Group:     certmanager.GroupName,
-					Resource:  "signers",
-					Verb:      "reference",
-					Namespace: issuerNamespace,
-					Name:      name,
-					Version:   "*",
-				},
-			},
-		}, metav1.CreateOptions{})
-		if err != nil {
-			return false, err
-		}
+	resp, err := c.sarClient.Create(ctx, &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:   csr.Spec.Username,
+			Groups: csr.Spec.Groups,
+			Extra:  extra,
+			UID:    csr.Spec.UID,
 
-		if resp.Status.Allowed {
-			return true, nil
-		}
+			ResourceAttributes: attrs,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
 	}
 
-	return false, nil
+	return resp.Status.Allowed, nil
 }
@@ -32,9 +32,11 @@ import (
 
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/jetstack/cert-manager/pkg/apis/experimental/v1alpha1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	internalvault "github.com/jetstack/cert-manager/pkg/internal/vault"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
@@ -90,7 +92,7 @@ func (v *Vault) Sign(ctx context.Context, csr *certificatesv1.CertificateSigning
 	if apierrors.IsNotFound(err) {
 		message := "Required secret resource not found"
 		log.Error(err, message)
-		v.recorder.Event(csr, corev1.EventTypeWarning, "SecretNotFound", message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonSecretNotFound, message)
 		util.CertificateSigningRequestSetFailed(csr, "SecretNotFound", message)
 		_, err := v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -99,7 +101,7 @@ func (v *Vault) Sign(ctx context.Context, csr *certificatesv1.CertificateSigning
 	if err != nil {
 		message := fmt.Sprintf("Failed to initialise vault client for signing: %s", err)
 		log.Error(err, message)
-		v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorVaultInit", message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorVaultInit, message)
 		return err
 	}
 
@@ -107,17 +109,18 @@ func (v *Vault) Sign(ctx context.Context, csr *certificatesv1.CertificateSigning
 	if err != nil {
 		message := fmt.Sprintf("Failed to parse requested duration: %s", err)
 		log.Error(err, message)
-		v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorParseDuration", message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorParseDuration, message)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorParseDuration", message)
 		_, err := v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
 	}
 
-	certPEM, _, err := client.Sign(csr.Spec.Request, duration)
+	isCA := csr.Annotations[experimentalapi.CertificateSigningRequestIsCAAnnotationKey] == "true"
+	certPEM, _, err := client.Sign(csr.Spec.Request, duration, isCA)
 	if err != nil {
 		message := fmt.Sprintf("Vault failed to sign: %s", err)
 		log.Error(err, message)
-		v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorSigning", message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorSigning, message)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorSigning", message)
 		_, err := v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return err
@@ -129,12 +132,12 @@ func (v *Vault) Sign(ctx context.Context, csr *certificatesv1.CertificateSigning
 	csr, err = v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 	if err != nil {
 		message := "Error updating certificate"
-		v.recorder.Eventf(csr, corev1.EventTypeWarning, "ErrorUpdate", "%s: %s", message, err)
+		v.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonErrorUpdate, "%s: %s", message, err)
 		return err
 	}
 
 	log.V(logf.DebugLevel).Info("vault certificate issued")
-	v.recorder.Event(csr, corev1.EventTypeNormal, "CertificateIssued", "Certificate signed successfully")
+	v.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonCertificateIssued, "Certificate signed successfully")
 
 	return nil
 }
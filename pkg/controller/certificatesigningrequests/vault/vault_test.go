@@ -160,6 +160,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -222,6 +243,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -265,6 +307,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -327,6 +390,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -388,6 +472,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
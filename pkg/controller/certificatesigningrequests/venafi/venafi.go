@@ -36,6 +36,7 @@ import (
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/util"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	venaficlient "github.com/jetstack/cert-manager/pkg/issuer/venafi/client"
 	venafiapi "github.com/jetstack/cert-manager/pkg/issuer/venafi/client/api"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
@@ -94,14 +95,14 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 	client, err := v.clientBuilder(resourceNamespace, v.secretsLister, issuerObj)
 	if apierrors.IsNotFound(err) {
 		message := "Required secret resource not found"
-		v.recorder.Event(csr, corev1.EventTypeWarning, "SecretNotFound", message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonSecretNotFound, message)
 		log.Error(err, message)
 		return nil
 	}
 
 	if err != nil {
 		message := fmt.Sprintf("Failed to initialise venafi client for signing: %s", err)
-		v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorVenafiInit", message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorVenafiInit, message)
 		log.Error(err, message)
 		return err
 	}
@@ -111,7 +112,7 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 		err := json.Unmarshal([]byte(annotation), &customFields)
 		if err != nil {
 			message := fmt.Sprintf("Failed to parse %q annotation: %s", experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey, err)
-			v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorCustomFields", message)
+			v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorCustomFields, message)
 			util.CertificateSigningRequestSetFailed(csr, "ErrorCustomFields", message)
 			_, userr := v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 			return userr
@@ -122,7 +123,7 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 	if err != nil {
 		message := fmt.Sprintf("Failed to parse requested duration: %s", err)
 		log.Error(err, message)
-		v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorParseDuration", message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorParseDuration, message)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorParseDuration", message)
 		_, userr := v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return userr
@@ -142,7 +143,7 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 
 			case venaficlient.ErrCustomFieldsType:
 				log.Error(err, "")
-				v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorCustomFields", err.Error())
+				v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorCustomFields, err.Error())
 				util.CertificateSigningRequestSetFailed(csr, "ErrorCustomFields", err.Error())
 				_, userr := v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 				return userr
@@ -150,7 +151,7 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 			default:
 				message := fmt.Sprintf("Failed to request venafi certificate: %s", err)
 				log.Error(err, message)
-				v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorRequest", message)
+				v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorRequest, message)
 				util.CertificateSigningRequestSetFailed(csr, "ErrorRequest", message)
 				_, userr := v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 				return userr
@@ -171,19 +172,19 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 		case endpoint.ErrCertificatePending:
 			message := "Venafi certificate still in a pending state, waiting"
 			log.V(2).Info(message, "error", err.Error())
-			v.recorder.Event(csr, corev1.EventTypeNormal, "IssuancePending", message)
+			v.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonIssuancePending, message)
 			return err
 
 		case endpoint.ErrRetrieveCertificateTimeout:
 			message := "Venafi retrieve certificate timeout, retrying"
 			log.Error(err, message)
-			v.recorder.Event(csr, corev1.EventTypeWarning, "RetrieveCertificateTimeout", message)
+			v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonRetrieveCertificateTimeout, message)
 			return err
 
 		default:
 			message := fmt.Sprintf("Failed to obtain venafi certificate: %s", err)
 			log.Error(err, message)
-			v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorRetrieve", message)
+			v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorRetrieve, message)
 			return err
 		}
 	}
@@ -192,7 +193,7 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 	if err != nil {
 		message := fmt.Sprintf("Failed to parse returned certificate bundle: %s", err)
 		log.Error(err, message)
-		v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorParse", message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, events.ReasonErrorParse, message)
 		util.CertificateSigningRequestSetFailed(csr, "ErrorParse", message)
 		_, userr := v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 		return userr
@@ -202,12 +203,12 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 	csr, err = v.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
 	if err != nil {
 		message := "Error updating certificate"
-		v.recorder.Eventf(csr, corev1.EventTypeWarning, "SigningError", "%s: %s", message, err)
+		v.recorder.Eventf(csr, corev1.EventTypeWarning, events.ReasonSigningError, "%s: %s", message, err)
 		return err
 	}
 
 	log.V(logf.DebugLevel).Info("certificate issued")
-	v.recorder.Event(csr, corev1.EventTypeNormal, "CertificateIssued", "Certificate fetched from venafi issuer successfully")
+	v.recorder.Event(csr, corev1.EventTypeNormal, events.ReasonCertificateIssued, "Certificate fetched from venafi issuer successfully")
 
 	return nil
 }
@@ -183,6 +183,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -226,6 +247,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -271,6 +313,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -339,6 +402,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -411,6 +495,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -482,6 +587,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -551,6 +677,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"",
@@ -616,6 +763,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -667,6 +835,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -718,6 +907,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 				},
 			},
 		},
@@ -768,6 +978,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -841,6 +1072,27 @@ func TestProcessItem(t *testing.T) {
 							},
 						},
 					)),
+					testpkg.NewAction(coretesting.NewCreateAction(
+						authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+						"",
+						&authzv1.SubjectAccessReview{
+							Spec: authzv1.SubjectAccessReviewSpec{
+								User:   "user-1",
+								Groups: []string{"group-1", "group-2"},
+								Extra: map[string]authzv1.ExtraValue{
+									"extra": []string{"1", "2"},
+								},
+								UID: "uid-1",
+
+								ResourceAttributes: &authzv1.ResourceAttributes{
+									Group:    certificatesv1.GroupName,
+									Resource: "signers",
+									Verb:     "approve",
+									Name:     baseCSR.Spec.SignerName,
+								},
+							},
+						},
+					)),
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
 						"status",
@@ -0,0 +1,352 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustercertificates
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	ControllerName = "clustercertificates"
+)
+
+var clusterCertificateGvk = cmapi.SchemeGroupVersion.WithKind(cmapi.ClusterCertificateKind)
+
+// This controller issues ClusterCertificates by creating a Certificate from
+// the ClusterCertificate's template in `spec.namespace`, which is then
+// processed by the certificates controllers like any other Certificate. The
+// status of the Certificate is copied to the ClusterCertificate, and the
+// Certificate's Secret is copied to each of the namespaces in
+// `spec.secretNamespaces`.
+//
+// The Certificate and the Secret copies are controlled by the
+// ClusterCertificate, so they are garbage collected when it is deleted.
+type controller struct {
+	clusterCertificateLister cmlisters.ClusterCertificateLister
+	certificateLister        cmlisters.CertificateLister
+	secretLister             corelisters.SecretLister
+	client                   cmclient.Interface
+	kubeClient               kubernetes.Interface
+	recorder                 record.EventRecorder
+
+	// copiedAnnotationPrefixes defines which annotations are copied from the
+	// ClusterCertificate to the Certificate.
+	copiedAnnotationPrefixes []string
+}
+
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	copiedAnnotationPrefixes []string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	clusterCertificateInformer := cmFactory.Certmanager().V1().ClusterCertificates()
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	c := &controller{
+		clusterCertificateLister: clusterCertificateInformer.Lister(),
+		certificateLister:        certificateInformer.Lister(),
+		secretLister:             secretsInformer.Lister(),
+		client:                   client,
+		kubeClient:               kubeClient,
+		recorder:                 recorder,
+		copiedAnnotationPrefixes: copiedAnnotationPrefixes,
+	}
+
+	clusterCertificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: controllerpkg.HandleOwnedResourceNamespacedFunc(log, queue, clusterCertificateGvk, func(_, name string) (interface{}, error) {
+			return c.clusterCertificateLister.Get(name)
+		}),
+	})
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueClusterCertificateForSecret(queue)})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		clusterCertificateInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+	}
+
+	return c, queue, mustSync
+}
+
+// enqueueClusterCertificateForSecret returns a function which enqueues the
+// ClusterCertificate that a Secret is copied from, or that the Secret is
+// copied to other namespaces for.
+func (c *controller) enqueueClusterCertificateForSecret(queue workqueue.Interface) func(obj interface{}) {
+	return func(obj interface{}) {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			return
+		}
+		if name, ok := secret.Labels[cmapi.ClusterCertificateNameLabelKey]; ok {
+			queue.Add(name)
+			return
+		}
+		crtName, ok := secret.Annotations[cmapi.CertificateNameKey]
+		if !ok {
+			return
+		}
+		crt, err := c.certificateLister.Certificates(secret.Namespace).Get(crtName)
+		if err != nil {
+			return
+		}
+		ref := metav1.GetControllerOf(crt)
+		if ref == nil || ref.Kind != cmapi.ClusterCertificateKind || ref.APIVersion != cmapi.SchemeGroupVersion.String() {
+			return
+		}
+		queue.Add(ref.Name)
+	}
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	cc, err := c.clusterCertificateLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("clustercertificate not found for key")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, cc)
+	ctx = logf.NewContext(ctx, log)
+
+	crt, err := c.syncCertificate(ctx, cc)
+	if err != nil || crt == nil {
+		return err
+	}
+
+	if !apiequality.Semantic.DeepEqual(cc.Status, crt.Status) {
+		cc = cc.DeepCopy()
+		cc.Status = *crt.Status.DeepCopy()
+		if cc, err = c.client.CertmanagerV1().ClusterCertificates().UpdateStatus(ctx, cc, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return c.syncSecretCopies(ctx, cc)
+}
+
+// syncCertificate creates or updates the Certificate which issues the
+// ClusterCertificate. If a Certificate with the same name exists in the
+// namespace but is not controlled by the ClusterCertificate, nil is returned.
+func (c *controller) syncCertificate(ctx context.Context, cc *cmapi.ClusterCertificate) (*cmapi.Certificate, error) {
+	log := logf.FromContext(ctx)
+	annotations := controllerpkg.BuildAnnotationsToCopy(cc.Annotations, c.copiedAnnotationPrefixes)
+
+	crt, err := c.certificateLister.Certificates(cc.Spec.Namespace).Get(cc.Name)
+	if apierrors.IsNotFound(err) {
+		crt = &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            cc.Name,
+				Namespace:       cc.Spec.Namespace,
+				Labels:          cc.Labels,
+				Annotations:     annotations,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cc, clusterCertificateGvk)},
+			},
+			Spec: *cc.Spec.Template.DeepCopy(),
+		}
+		crt, err = c.client.CertmanagerV1().Certificates(crt.Namespace).Create(ctx, crt, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		log.V(logf.InfoLevel).Info("created Certificate", "certificate", crt.Namespace+"/"+crt.Name)
+		c.recorder.Eventf(cc, corev1.EventTypeNormal, events.ReasonCreateCertificate, "Successfully created Certificate %q in namespace %q", crt.Name, crt.Namespace)
+		return crt, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !metav1.IsControlledBy(crt, cc) {
+		c.recorder.Eventf(cc, corev1.EventTypeWarning, events.ReasonConflict, "Certificate %q in namespace %q already exists and is not controlled by this ClusterCertificate", crt.Name, crt.Namespace)
+		return nil, nil
+	}
+
+	updated := crt.DeepCopy()
+	updated.Spec = *cc.Spec.Template.DeepCopy()
+	for k, v := range annotations {
+		if updated.Annotations == nil {
+			updated.Annotations = make(map[string]string)
+		}
+		updated.Annotations[k] = v
+	}
+	if apiequality.Semantic.DeepEqual(crt.Spec, updated.Spec) && apiequality.Semantic.DeepEqual(crt.Annotations, updated.Annotations) {
+		return crt, nil
+	}
+
+	crt, err = c.client.CertmanagerV1().Certificates(updated.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	log.V(logf.InfoLevel).Info("updated Certificate", "certificate", crt.Namespace+"/"+crt.Name)
+	c.recorder.Eventf(cc, corev1.EventTypeNormal, events.ReasonUpdateCertificate, "Successfully updated Certificate %q in namespace %q", crt.Name, crt.Namespace)
+	return crt, nil
+}
+
+// syncSecretCopies copies the Certificate's Secret to each of the
+// ClusterCertificate's `spec.secretNamespaces`, and deletes copies which are
+// no longer needed.
+func (c *controller) syncSecretCopies(ctx context.Context, cc *cmapi.ClusterCertificate) error {
+	log := logf.FromContext(ctx)
+	secretName := cc.Spec.Template.SecretName
+
+	copies, err := c.secretLister.List(labels.SelectorFromSet(labels.Set{cmapi.ClusterCertificateNameLabelKey: cc.Name}))
+	if err != nil {
+		return err
+	}
+	wanted := sets.NewString(cc.Spec.SecretNamespaces...)
+	existing := make(map[string]*corev1.Secret)
+	for _, secret := range copies {
+		if !metav1.IsControlledBy(secret, cc) {
+			continue
+		}
+		if wanted.Has(secret.Namespace) && secret.Name == secretName {
+			existing[secret.Namespace] = secret
+			continue
+		}
+		err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		logf.WithRelatedResource(log, secret).V(logf.InfoLevel).Info("deleted Secret copy which is no longer needed")
+	}
+
+	source, err := c.secretLister.Secrets(cc.Spec.Namespace).Get(secretName)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("Secret has not been issued yet, not copying it")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range cc.Spec.SecretNamespaces {
+		secret, ok := existing[namespace]
+		if !ok {
+			_, err := c.secretLister.Secrets(namespace).Get(secretName)
+			if err == nil {
+				c.recorder.Eventf(cc, corev1.EventTypeWarning, events.ReasonConflict, "Secret %q in namespace %q already exists and is not controlled by this ClusterCertificate", secretName, namespace)
+				continue
+			}
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            secretName,
+					Namespace:       namespace,
+					Labels:          map[string]string{cmapi.ClusterCertificateNameLabelKey: cc.Name},
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cc, clusterCertificateGvk)},
+				},
+				Type: source.Type,
+				Data: source.DeepCopy().Data,
+			}
+			if _, err := c.kubeClient.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+				return err
+			}
+			c.recorder.Eventf(cc, corev1.EventTypeNormal, events.ReasonSecretCopied, "Copied Secret %q to namespace %q", secretName, namespace)
+			continue
+		}
+
+		if apiequality.Semantic.DeepEqual(secret.Data, source.Data) {
+			continue
+		}
+		secret = secret.DeepCopy()
+		secret.Data = source.DeepCopy().Data
+		if _, err := c.kubeClient.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		c.recorder.Eventf(cc, corev1.EventTypeNormal, events.ReasonSecretCopied, "Updated the copy of Secret %q in namespace %q", secretName, namespace)
+	}
+
+	return nil
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.CertificateOptions.CopiedAnnotationPrefixes,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
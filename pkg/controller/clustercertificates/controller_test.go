@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustercertificates
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	template := cmapi.CertificateSpec{
+		CommonName: "kube-apiserver",
+		SecretName: "apiserver-tls",
+		IssuerRef:  cmmeta.ObjectReference{Name: "ca", Kind: cmapi.ClusterIssuerKind},
+	}
+	cc := &cmapi.ClusterCertificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "apiserver", UID: "cc-uid"},
+		Spec: cmapi.ClusterCertificateSpec{
+			Namespace: "kube-system",
+			Template:  template,
+		},
+	}
+	ccRef := *metav1.NewControllerRef(cc, clusterCertificateGvk)
+	withSecretNamespaces := func(namespaces ...string) *cmapi.ClusterCertificate {
+		cc := cc.DeepCopy()
+		cc.Spec.SecretNamespaces = namespaces
+		return cc
+	}
+
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "kube-system",
+			Name:            "apiserver",
+			OwnerReferences: []metav1.OwnerReference{ccRef},
+		},
+		Spec: template,
+	}
+	// Annotations are copied from the ClusterCertificate when the Certificate
+	// is created, so it has a non-nil map of annotations.
+	createdCrt := crt.DeepCopy()
+	createdCrt.Annotations = map[string]string{}
+	unownedCrt := crt.DeepCopy()
+	unownedCrt.OwnerReferences = nil
+	readyCondition := cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}
+	readyCrt := gen.CertificateFrom(crt, gen.SetCertificateStatusCondition(readyCondition))
+	readyCC := cc.DeepCopy()
+	readyCC.Status.Conditions = []cmapi.CertificateCondition{readyCondition}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "kube-system",
+			Name:        "apiserver-tls",
+			Annotations: map[string]string{cmapi.CertificateNameKey: "apiserver"},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+	}
+	secretCopy := func(namespace string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       namespace,
+				Name:            "apiserver-tls",
+				Labels:          map[string]string{cmapi.ClusterCertificateNameLabelKey: "apiserver"},
+				OwnerReferences: []metav1.OwnerReference{ccRef},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}
+	}
+
+	certificates := cmapi.SchemeGroupVersion.WithResource("certificates")
+	secrets := corev1.SchemeGroupVersion.WithResource("secrets")
+
+	tests := map[string]struct {
+		existingCMObjects   []runtime.Object
+		existingKubeObjects []runtime.Object
+		expectedActions     []testpkg.Action
+		expectedEvents      []string
+	}{
+		"create the Certificate if it does not exist": {
+			existingCMObjects: []runtime.Object{cc},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(certificates, "kube-system", createdCrt)),
+			},
+			expectedEvents: []string{`Normal CreateCertificate Successfully created Certificate "apiserver" in namespace "kube-system"`},
+		},
+		"update the Certificate if it does not match the template": {
+			existingCMObjects: []runtime.Object{cc, gen.CertificateFrom(crt, gen.SetCertificateCommonName("old"))},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(certificates, "kube-system", crt)),
+			},
+			expectedEvents: []string{`Normal UpdateCertificate Successfully updated Certificate "apiserver" in namespace "kube-system"`},
+		},
+		"do nothing if the Certificate is not controlled by the ClusterCertificate": {
+			existingCMObjects: []runtime.Object{cc, unownedCrt},
+			expectedEvents:    []string{`Warning Conflict Certificate "apiserver" in namespace "kube-system" already exists and is not controlled by this ClusterCertificate`},
+		},
+		"copy the status of the Certificate": {
+			existingCMObjects: []runtime.Object{cc, readyCrt},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("clustercertificates"), "status", "", readyCC)),
+			},
+		},
+		"copy the Secret to each of the secret namespaces": {
+			existingCMObjects:   []runtime.Object{withSecretNamespaces("ingress"), crt},
+			existingKubeObjects: []runtime.Object{secret},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(secrets, "ingress", secretCopy("ingress", secret.Data))),
+			},
+			expectedEvents: []string{`Normal SecretCopied Copied Secret "apiserver-tls" to namespace "ingress"`},
+		},
+		"update copies of the Secret which are out of date": {
+			existingCMObjects:   []runtime.Object{withSecretNamespaces("ingress"), crt},
+			existingKubeObjects: []runtime.Object{secret, secretCopy("ingress", map[string][]byte{corev1.TLSCertKey: []byte("old")})},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(secrets, "ingress", secretCopy("ingress", secret.Data))),
+			},
+			expectedEvents: []string{`Normal SecretCopied Updated the copy of Secret "apiserver-tls" in namespace "ingress"`},
+		},
+		"delete copies of the Secret in namespaces which are no longer listed": {
+			existingCMObjects:   []runtime.Object{cc, crt},
+			existingKubeObjects: []runtime.Object{secret, secretCopy("ingress", secret.Data)},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(secrets, "ingress", "apiserver-tls")),
+			},
+		},
+		"do not overwrite a Secret which is not a copy": {
+			existingCMObjects: []runtime.Object{withSecretNamespaces("ingress"), crt},
+			existingKubeObjects: []runtime.Object{secret, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ingress", Name: "apiserver-tls"},
+			}},
+			expectedEvents: []string{`Warning Conflict Secret "apiserver-tls" in namespace "ingress" already exists and is not controlled by this ClusterCertificate`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(time.Now()),
+				CertManagerObjects: test.existingCMObjects,
+				KubeObjects:        test.existingKubeObjects,
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.ProcessItem(context.Background(), cc.Name); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterissuers
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/issuerkinds"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+)
+
+const (
+	// ControllerName is the name of the ClusterIssuers controller.
+	ControllerName = "clusterissuers"
+)
+
+func init() {
+	issuerkinds.Register(issuerkinds.Kind{
+		ControllerName: ControllerName,
+		NewObject:      func() client.Object { return &cmapi.ClusterIssuer{} },
+		NewObjectList:  func() client.ObjectList { return &cmapi.ClusterIssuerList{} },
+		ClusterScoped:  true,
+		SecretNames: func(obj client.Object) []string {
+			return issuer.SecretNames(obj.(*cmapi.ClusterIssuer).GetSpec())
+		},
+		Setup: setup,
+	})
+}
+
+// setup sets up the issuer implementation configured on a ClusterIssuer, which
+// updates its Ready condition.
+func setup(ctx context.Context, cmctx *controllerpkg.Context, obj client.Object) error {
+	i, err := issuer.NewFactory(cmctx).IssuerFor(obj.(*cmapi.ClusterIssuer))
+	if err != nil {
+		return err
+	}
+	return i.Setup(ctx)
+}
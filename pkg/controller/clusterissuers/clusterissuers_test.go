@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterissuers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/issuerkinds"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	_ "github.com/jetstack/cert-manager/pkg/issuer/selfsigned"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestKind(t *testing.T) {
+	kind, ok := issuerkinds.Get(ControllerName)
+	require.True(t, ok, "expected the clusterissuers kind to be registered")
+
+	assert.IsType(t, &cmapi.ClusterIssuer{}, kind.NewObject())
+	assert.IsType(t, &cmapi.ClusterIssuerList{}, kind.NewObjectList())
+	assert.Equal(t, true, kind.ClusterScoped)
+	assert.Equal(t, []string{"ca"}, kind.SecretNames(gen.ClusterIssuer("test", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"}))))
+}
+
+func TestSetup(t *testing.T) {
+	tests := map[string]struct {
+		issuer *cmapi.ClusterIssuer
+
+		expectErr   bool
+		expectReady bool
+	}{
+		"the Ready condition of the ClusterIssuer is set by its issuer implementation": {
+			issuer:      gen.ClusterIssuer("test", gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{})),
+			expectReady: true,
+		},
+		"an error is returned if the ClusterIssuer has no issuer type configured": {
+			issuer:    gen.ClusterIssuer("test"),
+			expectErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := &testpkg.Builder{T: t}
+			b.Init()
+			defer b.Stop()
+
+			err := setup(context.TODO(), b.Context, test.issuer)
+			if test.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectReady, apiutil.IssuerHasCondition(test.issuer, cmapi.IssuerCondition{
+				Type:   cmapi.IssuerConditionReady,
+				Status: cmmeta.ConditionTrue,
+			}))
+		})
+	}
+}
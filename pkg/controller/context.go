@@ -34,6 +34,8 @@ import (
 	clientset "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	informers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
 	"github.com/jetstack/cert-manager/pkg/metrics"
+	"github.com/jetstack/cert-manager/pkg/secretstore"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 // Context contains various types that are used by controller implementations.
@@ -84,6 +86,10 @@ type Context struct {
 	// Metrics is used for exposing Prometheus metrics across the controllers
 	Metrics *metrics.Metrics
 
+	// DrainTimeout is how long controllers wait for in-flight work to
+	// complete when shutting down, before cancelling it.
+	DrainTimeout time.Duration
+
 	IssuerOptions
 	ACMEOptions
 	IngressShimOptions
@@ -104,6 +110,11 @@ type IssuerOptions struct {
 	// IssuerAmbientCredentials controls whether an issuer should pick up ambient
 	// credentials, such as those from metadata services, to construct clients.
 	IssuerAmbientCredentials bool
+
+	// IssuanceBackdate is the default amount of time that the notBefore of
+	// certificates signed by cert-manager is backdated by, to tolerate clock
+	// skew.
+	IssuanceBackdate time.Duration
 }
 
 type ACMEOptions struct {
@@ -123,6 +134,14 @@ type ACMEOptions struct {
 	// HTTP01SolverResourceLimitsMemory defines the ACME pod's resource limits Memory size
 	HTTP01SolverResourceLimitsMemory resource.Quantity
 
+	// HTTP01SelfCheckService makes HTTP01 self checks connect to the solver
+	// Service rather than to the public address of the domain being validated.
+	HTTP01SelfCheckService bool
+
+	// HTTP01SelfCheckURLTemplate, if set, is a Go template for the URL which
+	// HTTP01 self checks are performed against.
+	HTTP01SelfCheckURLTemplate string
+
 	// DNS01CheckAuthoritative is a flag for controlling if auth nss are used
 	// for checking propagation of an RR. This is the ideal scenario
 	DNS01CheckAuthoritative bool
@@ -137,6 +156,11 @@ type ACMEOptions struct {
 
 	// DNS01CheckRetryPeriod is the time the controller should wait between checking if a ACME dns entry exists.
 	DNS01CheckRetryPeriod time.Duration
+
+	// OrderAuthorizationRetries is the number of times an Order is
+	// resubmitted to the ACME server after one of its authorizations failed,
+	// before the Order is marked as failed.
+	OrderAuthorizationRetries int
 }
 
 // IngressShimOptions contain default Issuer GVK config for the certificate-shim controllers.
@@ -156,6 +180,37 @@ type CertificateOptions struct {
 	// CopiedAnnotationPrefixes defines which annotations should be copied
 	// Certificate -> CertificateRequest, CertificateRequest -> Order.
 	CopiedAnnotationPrefixes []string
+	// RenewalJitterPercentage is the maximum amount that the renewal of a
+	// certificate may be brought forward by, as a percentage of the period
+	// between its renewal time and expiry.
+	RenewalJitterPercentage int
+	// RenewalJitterWindow is the maximum amount of time that the renewal of a
+	// certificate may be brought forward by. Takes precedence over
+	// RenewalJitterPercentage if set.
+	RenewalJitterWindow time.Duration
+	// AdoptExistingSecrets controls whether a Certificate which has not been
+	// issued yet adopts an existing Secret containing a certificate that was
+	// not issued by cert-manager, if the certificate is valid for its spec.
+	AdoptExistingSecrets bool
+	// AIAFetchAllowedHosts are the hosts from which certificates missing from
+	// the chain of a Certificate may be fetched.
+	AIAFetchAllowedHosts []string
+	// AIAFetchCacheTTL is how long fetched certificates are cached for.
+	AIAFetchCacheTTL time.Duration
+	// PublicTrustBundleConfigMap is the ConfigMap, in the form
+	// <namespace>/<name>, containing the public CA certificates stored in the
+	// trust store of Certificates.
+	PublicTrustBundleConfigMap string
+	// ExternalKeyProviders are the providers, keyed by name, which generate
+	// and hold the private keys of Certificates with an external private key.
+	ExternalKeyProviders map[string]pki.ExternalKeyProvider
+	// KMSPlugins are the plugins, keyed by name, which encrypt the data keys
+	// of sealed private keys.
+	KMSPlugins map[string]pki.KMSPlugin
+	// SecretStore is the external secret store which the data issued for
+	// Certificates is written to, in addition to their Secrets. If nil, data
+	// is only written to Kubernetes Secrets.
+	SecretStore secretstore.Store
 }
 
 type SchedulerOptions struct {
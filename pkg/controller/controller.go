@@ -32,6 +32,11 @@ import (
 	"github.com/jetstack/cert-manager/pkg/metrics"
 )
 
+// queueMetricsSamplePeriod is how often the depth of a controller's queue,
+// and how long its longest running item has been processed for, are
+// recorded in its metrics.
+const queueMetricsSamplePeriod = 5 * time.Second
+
 type runFunc func(context.Context)
 
 type runDurationFunc struct {
@@ -52,6 +57,7 @@ func NewController(
 	mustSync []cache.InformerSynced,
 	runDurationFuncs []runDurationFunc,
 	queue workqueue.RateLimitingInterface,
+	drainTimeout time.Duration,
 ) Interface {
 	return &controller{
 		ctx:              ctx,
@@ -61,6 +67,9 @@ func NewController(
 		mustSync:         mustSync,
 		runDurationFuncs: runDurationFuncs,
 		queue:            queue,
+		drainTimeout:     drainTimeout,
+		draining:         make(chan struct{}),
+		processing:       make(map[interface{}]time.Time),
 	}
 }
 
@@ -91,6 +100,20 @@ type controller struct {
 
 	// metrics is used to expose Prometheus, shared by all controllers
 	metrics *metrics.Metrics
+
+	// drainTimeout is how long in-flight calls to the syncHandler are given
+	// to complete once the controller has been signalled to exit, before
+	// their context is cancelled.
+	drainTimeout time.Duration
+
+	// draining is closed once the controller has been signalled to exit, after
+	// which no new items are processed.
+	draining chan struct{}
+
+	// processing records when each item currently being processed was
+	// handed to a worker.
+	processingLock sync.Mutex
+	processing     map[interface{}]time.Time
 }
 
 // Run starts the controller loop
@@ -105,12 +128,18 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) error {
 		return fmt.Errorf("error waiting for informer caches to sync")
 	}
 
+	// Items are processed with a context which is not cancelled when the
+	// controller is signalled to exit, so that in-flight calls to the
+	// syncHandler can complete rather than leaving resources half updated.
+	workCtx, cancelWork := context.WithCancel(detachedContext{ctx})
+	defer cancelWork()
+
 	var wg sync.WaitGroup
 	for i := 0; i < workers; i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			c.worker(ctx)
+			c.worker(workCtx)
 		}()
 	}
 
@@ -122,15 +151,82 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) error {
 		go wait.Until(func() { f.fn(ctx) }, f.duration, stopCh)
 	}
 
+	go wait.Until(c.recordQueueMetrics, queueMetricsSamplePeriod, stopCh)
+
 	<-stopCh
 	log.V(logf.InfoLevel).Info("shutting down queue as workqueue signaled shutdown")
+	close(c.draining)
 	c.queue.ShutDown()
-	log.V(logf.DebugLevel).Info("waiting for workers to exit...")
-	wg.Wait()
+
+	workersExited := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(workersExited)
+	}()
+
+	log.V(logf.DebugLevel).Info("waiting for workers to exit...", "drain_timeout", c.drainTimeout)
+	select {
+	case <-workersExited:
+	case <-time.After(c.drainTimeout):
+		log.V(logf.InfoLevel).Info("in-flight work did not complete within the drain timeout, cancelling it", "drain_timeout", c.drainTimeout)
+		cancelWork()
+		<-workersExited
+	}
 	log.V(logf.DebugLevel).Info("workers exited")
 	return nil
 }
 
+// recordQueueMetrics exposes the number of items waiting in the queue for
+// each priority, and how long the longest running item has been processed
+// for. Items in queues without priorities are reported as PriorityNormal.
+func (c *controller) recordQueueMetrics() {
+	if queue, ok := c.queue.(PriorityQueue); ok {
+		for _, priority := range Priorities {
+			c.metrics.SetControllerQueueDepth(c.name, priority.String(), queue.LenWithPriority(priority))
+		}
+	} else {
+		c.metrics.SetControllerQueueDepth(c.name, PriorityNormal.String(), c.queue.Len())
+	}
+
+	c.processingLock.Lock()
+	defer c.processingLock.Unlock()
+	var longest time.Duration
+	for _, started := range c.processing {
+		if d := time.Since(started); d > longest {
+			longest = d
+		}
+	}
+	c.metrics.SetLongestRunningProcessor(c.name, longest)
+}
+
+// startProcessing records that the item is being processed, and returns the
+// time at which processing started.
+func (c *controller) startProcessing(obj interface{}) time.Time {
+	c.processingLock.Lock()
+	defer c.processingLock.Unlock()
+	started := time.Now()
+	c.processing[obj] = started
+	return started
+}
+
+// finishProcessing records that the item is no longer being processed.
+func (c *controller) finishProcessing(obj interface{}) {
+	c.processingLock.Lock()
+	defer c.processingLock.Unlock()
+	delete(c.processing, obj)
+}
+
+// detachedContext carries the values of its parent, but is never cancelled
+// and has no deadline.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
 func (c *controller) worker(ctx context.Context) {
 	log := logf.FromContext(c.ctx)
 
@@ -141,6 +237,16 @@ func (c *controller) worker(ctx context.Context) {
 			break
 		}
 
+		// Once draining, items remaining in the queue are dropped rather
+		// than processed. They will be processed again by the next leader
+		// when its informers sync.
+		select {
+		case <-c.draining:
+			c.queue.Done(obj)
+			continue
+		default:
+		}
+
 		var key string
 		// use an inlined function so we can use defer
 		func() {
@@ -155,7 +261,10 @@ func (c *controller) worker(ctx context.Context) {
 			// Increase sync count for this controller
 			c.metrics.IncrementSyncCallCount(c.name)
 
+			started := c.startProcessing(obj)
 			err := c.syncHandler(ctx, key)
+			c.finishProcessing(obj)
+			c.metrics.ObserveReconcile(c.name, time.Since(started), err)
 			if err != nil {
 				if strings.Contains(err.Error(), genericregistry.OptimisticLockErrorMsg) {
 					log.Info("re-queuing item due to optimistic locking on resource", "error", err.Error())
@@ -163,6 +272,7 @@ func (c *controller) worker(ctx context.Context) {
 					log.Error(err, "re-queuing item due to error processing")
 				}
 
+				c.metrics.IncrementQueueRetries(c.name)
 				c.queue.AddRateLimited(obj)
 				return
 			}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/metrics"
+)
+
+func TestRunDrain(t *testing.T) {
+	tests := map[string]struct {
+		drainTimeout time.Duration
+
+		expectCancelled bool
+	}{
+		"in-flight work completes within the drain timeout": {
+			drainTimeout: time.Minute,
+		},
+		"in-flight work is cancelled after the drain timeout": {
+			drainTimeout:    time.Millisecond * 10,
+			expectCancelled: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var processed int32
+			var cancelled int32
+			syncFunc := func(ctx context.Context, key string) error {
+				if atomic.AddInt32(&processed, 1) == 1 {
+					close(started)
+				}
+				select {
+				case <-release:
+				case <-ctx.Done():
+					atomic.StoreInt32(&cancelled, 1)
+				}
+				return nil
+			}
+
+			queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			c := NewController(ctx, "test", metrics.New(logf.Log, clock.RealClock{}), syncFunc, nil, nil, queue, test.drainTimeout)
+
+			stopCh := make(chan struct{})
+			runErr := make(chan error)
+			go func() {
+				runErr <- c.Run(1, stopCh)
+			}()
+
+			queue.Add("in-flight")
+			queue.Add("queued")
+			<-started
+
+			// Signal the controller to exit while the first item is in
+			// flight, as happens when the root context is cancelled.
+			cancel()
+			close(stopCh)
+			if !test.expectCancelled {
+				time.AfterFunc(time.Millisecond*10, func() { close(release) })
+			}
+
+			if err := <-runErr; err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := atomic.LoadInt32(&cancelled) == 1; got != test.expectCancelled {
+				t.Errorf("expected in-flight work to be cancelled: %v, got: %v", test.expectCancelled, got)
+			}
+			if got := atomic.LoadInt32(&processed); got != 1 {
+				t.Errorf("expected queued items not to be processed once draining, got %d items processed", got)
+			}
+		})
+	}
+}
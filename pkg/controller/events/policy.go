@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+)
+
+// Policy controls which of the Events of a controller are recorded.
+type Policy struct {
+	// DisableNormal drops all Events of type Normal.
+	DisableNormal bool
+
+	// WarningAggregationPeriod is the period over which repeated Warning
+	// Events are aggregated. A Warning Event is recorded the first time it
+	// occurs, and any repetitions of it with the same reason and message for
+	// the same object during the period are recorded as a single summary
+	// Event at the end of the period. Aggregation is disabled if zero.
+	WarningAggregationPeriod time.Duration
+}
+
+// Recorder is a record.EventRecorder which records Events according to a
+// Policy.
+type Recorder struct {
+	recorder record.EventRecorder
+	clock    clock.Clock
+	policy   Policy
+
+	lock       sync.Mutex
+	aggregates map[aggregateKey]*aggregate
+}
+
+var _ record.EventRecorder = &Recorder{}
+
+type aggregateKey struct {
+	object  string
+	reason  string
+	message string
+}
+
+// aggregate counts the repetitions of a Warning Event since the start of the
+// current aggregation period.
+type aggregate struct {
+	object      runtime.Object
+	annotations map[string]string
+	start       time.Time
+	repeated    int
+}
+
+// NewRecorder returns a Recorder which records the Events allowed by policy
+// using recorder.
+func NewRecorder(recorder record.EventRecorder, clock clock.Clock, policy Policy) *Recorder {
+	return &Recorder{
+		recorder:   recorder,
+		clock:      clock,
+		policy:     policy,
+		aggregates: make(map[aggregateKey]*aggregate),
+	}
+}
+
+// Run records the summaries of aggregated Warning Events as their
+// aggregation periods end, until stopCh is closed.
+func (r *Recorder) Run(stopCh <-chan struct{}) {
+	if r.policy.WarningAggregationPeriod <= 0 {
+		return
+	}
+	wait.Until(r.flush, r.policy.WarningAggregationPeriod/2, stopCh)
+}
+
+func (r *Recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.record(object, nil, eventtype, reason, message)
+}
+
+func (r *Recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.record(object, nil, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *Recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.record(object, annotations, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *Recorder) record(object runtime.Object, annotations map[string]string, eventtype, reason, message string) {
+	if eventtype == corev1.EventTypeNormal && r.policy.DisableNormal {
+		return
+	}
+	if eventtype != corev1.EventTypeWarning || r.policy.WarningAggregationPeriod <= 0 {
+		r.emit(object, annotations, eventtype, reason, message)
+		return
+	}
+
+	key := aggregateKey{object: objectKey(object), reason: reason, message: message}
+	now := r.clock.Now()
+
+	r.lock.Lock()
+	a, ok := r.aggregates[key]
+	switch {
+	case !ok:
+		r.aggregates[key] = &aggregate{object: object, annotations: annotations, start: now}
+	case now.Before(a.start.Add(r.policy.WarningAggregationPeriod)):
+		a.object, a.annotations = object, annotations
+		a.repeated++
+		r.lock.Unlock()
+		return
+	default:
+		// The period ended before its summary was recorded, so this
+		// repetition is included in the summary.
+		a.object, a.annotations = object, annotations
+		a.repeated++
+		message = summary(message, a.repeated, now.Sub(a.start))
+		a.start, a.repeated = now, 0
+	}
+	r.lock.Unlock()
+
+	r.emit(object, annotations, eventtype, reason, message)
+}
+
+// flush records a summary of each aggregated Warning Event whose aggregation
+// period has ended. A new period is started for Events which were repeated,
+// so that continuing repetitions are summarised once per period.
+func (r *Recorder) flush() {
+	type pending struct {
+		key aggregateKey
+		aggregate
+	}
+	var summaries []pending
+
+	now := r.clock.Now()
+	r.lock.Lock()
+	for key, a := range r.aggregates {
+		if now.Before(a.start.Add(r.policy.WarningAggregationPeriod)) {
+			continue
+		}
+		if a.repeated == 0 {
+			delete(r.aggregates, key)
+			continue
+		}
+		summaries = append(summaries, pending{key: key, aggregate: *a})
+		a.start, a.repeated = now, 0
+	}
+	r.lock.Unlock()
+
+	for _, s := range summaries {
+		r.emit(s.object, s.annotations, corev1.EventTypeWarning, s.key.reason, summary(s.key.message, s.repeated, now.Sub(s.start)))
+	}
+}
+
+func (r *Recorder) emit(object runtime.Object, annotations map[string]string, eventtype, reason, message string) {
+	if annotations != nil {
+		r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+		return
+	}
+	r.recorder.Event(object, eventtype, reason, message)
+}
+
+func summary(message string, repeated int, period time.Duration) string {
+	return fmt.Sprintf("%s (repeated %d times in the last %s)", message, repeated, duration.HumanDuration(period))
+}
+
+// objectKey identifies the object an Event is recorded for.
+func objectKey(object runtime.Object) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return fmt.Sprintf("%T", object)
+	}
+	return fmt.Sprintf("%T/%s/%s/%s", object, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID())
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestRecorder(t *testing.T) {
+	crt := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "crt", UID: "uid-1"}}
+	other := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "other", UID: "uid-2"}}
+
+	type step struct {
+		// advance the clock by this amount before recording the Event
+		advance time.Duration
+		// flush any aggregated Events instead of recording an Event
+		flush bool
+
+		object    *cmapi.Certificate
+		eventtype string
+		message   string
+	}
+
+	tests := map[string]struct {
+		policy    Policy
+		steps     []step
+		expEvents []string
+	}{
+		"all events are recorded by default": {
+			steps: []step{
+				{object: crt, eventtype: corev1.EventTypeNormal, message: "normal"},
+				{object: crt, eventtype: corev1.EventTypeWarning, message: "warning"},
+				{object: crt, eventtype: corev1.EventTypeWarning, message: "warning"},
+			},
+			expEvents: []string{"Normal Failed normal", "Warning Failed warning", "Warning Failed warning"},
+		},
+		"normal events are dropped if disabled": {
+			policy: Policy{DisableNormal: true},
+			steps: []step{
+				{object: crt, eventtype: corev1.EventTypeNormal, message: "normal"},
+				{object: crt, eventtype: corev1.EventTypeWarning, message: "warning"},
+			},
+			expEvents: []string{"Warning Failed warning"},
+		},
+		"repeated warnings are summarised at the end of the period": {
+			policy: Policy{WarningAggregationPeriod: time.Minute * 5},
+			steps: []step{
+				{object: crt, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+				{advance: time.Second * 10, object: crt, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+				{advance: time.Second * 10, object: crt, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+				{advance: time.Second * 10, object: crt, eventtype: corev1.EventTypeWarning, message: "other failure"},
+				{advance: time.Second * 10, object: other, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+				{advance: time.Minute, flush: true},
+				{advance: time.Minute * 4, flush: true},
+				// the summary starts a new period
+				{advance: time.Minute, object: crt, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+				// periods in which the warning was not repeated are dropped
+				{advance: time.Minute * 10, flush: true},
+				{advance: time.Minute * 10, flush: true},
+				{advance: time.Minute, object: crt, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+			},
+			expEvents: []string{
+				"Warning Failed self check failed",
+				"Warning Failed other failure",
+				"Warning Failed self check failed",
+				"Warning Failed self check failed (repeated 2 times in the last 5m40s)",
+				"Warning Failed self check failed (repeated 1 times in the last 11m)",
+				"Warning Failed self check failed",
+			},
+		},
+		"repetitions after the end of an unflushed period are summarised straight away": {
+			policy: Policy{WarningAggregationPeriod: time.Minute * 5},
+			steps: []step{
+				{object: crt, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+				{advance: time.Minute, object: crt, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+				{advance: time.Minute * 5, object: crt, eventtype: corev1.EventTypeWarning, message: "self check failed"},
+			},
+			expEvents: []string{
+				"Warning Failed self check failed",
+				"Warning Failed self check failed (repeated 2 times in the last 6m)",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeRecorder := record.NewFakeRecorder(len(test.steps) * 2)
+			fakeClock := fakeclock.NewFakeClock(time.Now())
+			r := NewRecorder(fakeRecorder, fakeClock, test.policy)
+
+			for _, s := range test.steps {
+				fakeClock.Step(s.advance)
+				if s.flush {
+					r.flush()
+					continue
+				}
+				r.Event(s.object, s.eventtype, ReasonFailed, s.message)
+			}
+			close(fakeRecorder.Events)
+
+			var got []string
+			for e := range fakeRecorder.Events {
+				got = append(got, e)
+			}
+			if !reflect.DeepEqual(got, test.expEvents) {
+				t.Errorf("unexpected events, exp=%q, got=%q", test.expEvents, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events contains the reasons used by cert-manager controllers when
+// recording Kubernetes Events. All Events emitted by a controller must use
+// one of the reasons registered in this package so that users can rely on
+// a stable set of reasons when filtering or alerting on Events.
+package events
+
+import (
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Reasons used by the Certificate controllers.
+const (
+	ReasonIssuing        = "Issuing"
+	ReasonReused         = "Reused"
+	ReasonGenerated      = "Generated"
+	ReasonDecodeFailed   = "DecodeFailed"
+	ReasonDeleted        = "Deleted"
+	ReasonRequested      = "Requested"
+	ReasonRequestFailed  = "RequestFailed"
+	ReasonDryRun         = "DryRun"
+	ReasonSecretTampered = "SecretTampered"
+
+	ReasonIssuanceDeadlineExceeded = "IssuanceDeadlineExceeded"
+	ReasonChainIncomplete          = "ChainIncomplete"
+	ReasonIssuedWithWarnings       = "IssuedWithWarnings"
+
+	ReasonOCSPStapleUpdated  = "OCSPStapleUpdated"
+	ReasonOCSPStapleFailed   = "OCSPStapleFailed"
+	ReasonCertificateRevoked = "Revoked"
+	ReasonOCSPCheckFailed    = "OCSPCheckFailed"
+
+	ReasonIPAddressesUpdated = "IPAddressesUpdated"
+
+	ReasonTrustStoreUpdated = "TrustStoreUpdated"
+	ReasonTrustStoreFailed  = "TrustStoreFailed"
+
+	ReasonExternalKeyProviderNotFound = "ExternalKeyProviderNotFound"
+	ReasonExternalKeyFailed           = "ExternalKeyFailed"
+
+	ReasonKMSPluginNotFound = "KMSPluginNotFound"
+	ReasonSealedKeyFailed   = "SealedKeyFailed"
+
+	ReasonAdopted = "Adopted"
+
+	ReasonSecretAccessDenied = "SecretAccessDenied"
+
+	ReasonCSRTransformFailed = "CSRTransformFailed"
+
+	ReasonCanaryIssued   = "CanaryIssued"
+	ReasonCanaryPromoted = "CanaryPromoted"
+
+	ReasonDualCertificateIssued = "DualCertificateIssued"
+	ReasonDualCertificateFailed = "DualCertificateFailed"
+)
+
+// Reasons used by the CertificateRequest controllers. These are also used as
+// the reason of the CertificateRequest Ready condition.
+const (
+	ReasonCertificateIssued   = "CertificateIssued"
+	ReasonApproved            = "cert-manager.io"
+	ReasonBadConfig           = "BadConfig"
+	ReasonCMPInitError        = "CMPInitError"
+	ReasonCustomFieldsError   = "CustomFieldsError"
+	ReasonDecodeError         = "DecodeError"
+	ReasonErrorGenerating     = "ErrorGenerating"
+	ReasonErrorGettingSecret  = "ErrorGettingSecret"
+	ReasonErrorKeyMatch       = "ErrorKeyMatch"
+	ReasonErrorParsingKey     = "ErrorParsingKey"
+	ReasonErrorPublicKey      = "ErrorPublicKey"
+	ReasonErrorSigning        = "ErrorSigning"
+	ReasonInvalidOrder        = "InvalidOrder"
+	ReasonIssuancePending     = "IssuancePending"
+	ReasonIssuerNotFound      = "IssuerNotFound"
+	ReasonIssuerNotReady      = "IssuerNotReady"
+	ReasonIssuerTypeMissing   = "IssuerTypeMissing"
+	ReasonMissingAnnotation   = "MissingAnnotation"
+	ReasonMissingSecret       = "MissingSecret"
+	ReasonOrderBuildingError  = "OrderBuildingError"
+	ReasonOrderCreated        = "OrderCreated"
+	ReasonOrderCreatingError  = "OrderCreatingError"
+	ReasonOrderFailed         = "OrderFailed"
+	ReasonOrderGetError       = "OrderGetError"
+	ReasonOrderPending        = "OrderPending"
+	ReasonParseError          = "ParseError"
+	ReasonRequestError        = "RequestError"
+	ReasonRequestParsingError = "RequestParsingError"
+	ReasonRetrieveError       = "RetrieveError"
+	ReasonSCEPInitError       = "SCEPInitError"
+	ReasonSecretGetError      = "SecretGetError"
+	ReasonSecretInvalidData   = "SecretInvalidData"
+	ReasonSecretMissing       = "SecretMissing"
+	ReasonSigningError        = "SigningError"
+	ReasonVaultInitError      = "VaultInitError"
+	ReasonVenafiInitError     = "VenafiInitError"
+)
+
+// Reasons used by the CertificateSigningRequest controllers, in addition to
+// those shared with the CertificateRequest controllers.
+const (
+	ReasonWaitingApproval            = "WaitingApproval"
+	ReasonDeniedReference            = "DeniedReference"
+	ReasonErrorCustomFields          = "ErrorCustomFields"
+	ReasonErrorParse                 = "ErrorParse"
+	ReasonErrorParseDuration         = "ErrorParseDuration"
+	ReasonErrorRequest               = "ErrorRequest"
+	ReasonErrorRetrieve              = "ErrorRetrieve"
+	ReasonErrorUpdate                = "ErrorUpdate"
+	ReasonErrorVaultInit             = "ErrorVaultInit"
+	ReasonErrorVenafiInit            = "ErrorVenafiInit"
+	ReasonOrderBadCertificate        = "OrderBadCertificate"
+	ReasonRetrieveCertificateTimeout = "RetrieveCertificateTimeout"
+	ReasonSecretNotFound             = "SecretNotFound"
+)
+
+// Reasons used by the ACME Order and Challenge controllers.
+const (
+	ReasonCreated        = "Created"
+	ReasonSolver         = "Solver"
+	ReasonComplete       = "Complete"
+	ReasonStarted        = "Started"
+	ReasonPresented      = "Presented"
+	ReasonPresentError   = "PresentError"
+	ReasonDomainVerified = "DomainVerified"
+	ReasonCleanUpError   = "CleanUpError"
+	ReasonFailed         = "Failed"
+	ReasonRetrying       = "Retrying"
+
+	ReasonAcmeDNSAccountRegistered = "AcmeDNSAccountRegistered"
+	ReasonRateLimitNearlyExhausted = "RateLimitNearlyExhausted"
+)
+
+// Reasons used by the Issuer and ClusterIssuer controllers.
+const (
+	ReasonReady                     = "Ready"
+	ReasonInitIssuerFailed          = "ErrInitIssuer"
+	ReasonGetKeyPairFailed          = "ErrGetKeyPair"
+	ReasonInvalidKeyPair            = "ErrInvalidKeyPair"
+	ReasonKeyPairVerified           = "KeyPairVerified"
+	ReasonInvalidURL                = "InvalidURL"
+	ReasonAccountRegistrationFailed = "ErrRegisterACMEAccount"
+	ReasonAccountUpdateFailed       = "ErrUpdateACMEAccount"
+	ReasonTermsOfServiceChanged     = "TermsOfServiceChanged"
+	ReasonBootstrapCA               = "BootstrapCA"
+	ReasonBootstrapCAFailed         = "ErrBootstrapCA"
+)
+
+// Reasons used when enforcing the policy of an Issuer or ClusterIssuer.
+const (
+	ReasonIssuerPolicyViolation = "IssuerPolicyViolation"
+	ReasonDurationClamped       = "DurationClamped"
+)
+
+// Reasons used by the ingress-shim and gateway-shim controllers. The
+// ClusterCertificate controller also uses the Certificate reasons.
+const (
+	ReasonCreateCertificate = "CreateCertificate"
+	ReasonUpdateCertificate = "UpdateCertificate"
+	ReasonDeleteCertificate = "DeleteCertificate"
+)
+
+// Reasons used by the ClusterCertificate controller.
+const (
+	ReasonSecretCopied = "SecretCopied"
+	ReasonConflict     = "Conflict"
+)
+
+// Reasons used by the notifications controller.
+const (
+	ReasonNotificationSent   = "NotificationSent"
+	ReasonNotificationFailed = "NotificationFailed"
+)
+
+// Reasons used by the controller process itself.
+const (
+	ReasonShutdown = "Shutdown"
+)
+
+// Reasons used by the webhook.
+const (
+	// ReasonValidationWarning is the reason of Events recording the warnings
+	// returned when an object was admitted.
+	ReasonValidationWarning = "ValidationWarning"
+)
+
+// registered is the set of all reasons that may be used when recording an
+// Event. The issuing controller forwards the reason of a failed
+// CertificateRequest, so the CertificateRequest condition reasons are
+// registered too.
+var registered = newReasonSet(
+	ReasonIssuing, ReasonReused, ReasonGenerated, ReasonDecodeFailed,
+	ReasonDeleted, ReasonRequested, ReasonRequestFailed, ReasonDryRun,
+	ReasonSecretTampered, ReasonOCSPStapleUpdated, ReasonOCSPStapleFailed,
+	ReasonCertificateRevoked, ReasonOCSPCheckFailed, ReasonIPAddressesUpdated,
+	ReasonIssuanceDeadlineExceeded, ReasonChainIncomplete, ReasonIssuedWithWarnings,
+	ReasonTrustStoreUpdated, ReasonTrustStoreFailed,
+	ReasonExternalKeyProviderNotFound, ReasonExternalKeyFailed,
+	ReasonKMSPluginNotFound, ReasonSealedKeyFailed,
+	ReasonAdopted, ReasonSecretAccessDenied, ReasonCSRTransformFailed,
+	ReasonCanaryIssued, ReasonCanaryPromoted,
+	ReasonDualCertificateIssued, ReasonDualCertificateFailed,
+
+	ReasonCertificateIssued, ReasonApproved, ReasonBadConfig, ReasonCMPInitError,
+	ReasonCustomFieldsError, ReasonDecodeError, ReasonErrorGenerating,
+	ReasonErrorGettingSecret, ReasonErrorKeyMatch, ReasonErrorParsingKey,
+	ReasonErrorPublicKey, ReasonErrorSigning, ReasonInvalidOrder,
+	ReasonIssuancePending, ReasonIssuerNotFound, ReasonIssuerNotReady,
+	ReasonIssuerTypeMissing, ReasonMissingAnnotation, ReasonMissingSecret,
+	ReasonOrderBuildingError, ReasonOrderCreated, ReasonOrderCreatingError,
+	ReasonOrderFailed, ReasonOrderGetError, ReasonOrderPending,
+	ReasonParseError, ReasonRequestError, ReasonRequestParsingError,
+	ReasonRetrieveError, ReasonSCEPInitError, ReasonSecretGetError,
+	ReasonSecretInvalidData, ReasonSecretMissing, ReasonSigningError,
+	ReasonVaultInitError, ReasonVenafiInitError,
+
+	ReasonWaitingApproval, ReasonDeniedReference, ReasonErrorCustomFields,
+	ReasonErrorParse, ReasonErrorParseDuration, ReasonErrorRequest,
+	ReasonErrorRetrieve, ReasonErrorUpdate, ReasonErrorVaultInit,
+	ReasonErrorVenafiInit, ReasonOrderBadCertificate,
+	ReasonRetrieveCertificateTimeout, ReasonSecretNotFound,
+
+	ReasonCreated, ReasonSolver, ReasonComplete, ReasonStarted,
+	ReasonPresented, ReasonPresentError, ReasonDomainVerified,
+	ReasonCleanUpError, ReasonFailed, ReasonRetrying, ReasonAcmeDNSAccountRegistered,
+	ReasonRateLimitNearlyExhausted,
+
+	ReasonReady, ReasonInitIssuerFailed, ReasonGetKeyPairFailed,
+	ReasonInvalidKeyPair, ReasonKeyPairVerified, ReasonInvalidURL,
+	ReasonAccountRegistrationFailed, ReasonAccountUpdateFailed,
+	ReasonTermsOfServiceChanged, ReasonBootstrapCA, ReasonBootstrapCAFailed,
+
+	ReasonIssuerPolicyViolation, ReasonDurationClamped,
+
+	ReasonCreateCertificate, ReasonUpdateCertificate, ReasonDeleteCertificate,
+
+	ReasonSecretCopied, ReasonConflict,
+
+	ReasonNotificationSent, ReasonNotificationFailed,
+
+	ReasonShutdown,
+
+	ReasonValidationWarning,
+
+	cmapi.CertificateRequestReasonPending, cmapi.CertificateRequestReasonFailed,
+	cmapi.CertificateRequestReasonIssued, cmapi.CertificateRequestReasonDenied,
+)
+
+type reasonSet map[string]struct{}
+
+func newReasonSet(reasons ...string) reasonSet {
+	s := make(reasonSet, len(reasons))
+	for _, r := range reasons {
+		s[r] = struct{}{}
+	}
+	return s
+}
+
+// IsRegistered returns true if the given reason is one of the reasons
+// registered in this package.
+func IsRegistered(reason string) bool {
+	_, ok := registered[reason]
+	return ok
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestIsRegistered(t *testing.T) {
+	tests := map[string]bool{
+		ReasonIssuing:                        true,
+		ReasonCertificateIssued:              true,
+		ReasonDeniedReference:                true,
+		ReasonAccountRegistrationFailed:      true,
+		cmapi.CertificateRequestReasonFailed: true,
+		"":                                   false,
+		"SomethingHappened":                  false,
+	}
+
+	for reason, exp := range tests {
+		t.Run(reason, func(t *testing.T) {
+			if got := IsRegistered(reason); got != exp {
+				t.Errorf("unexpected result for reason %q, exp=%t got=%t", reason, exp, got)
+			}
+		})
+	}
+}
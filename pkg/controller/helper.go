@@ -17,6 +17,10 @@ limitations under the License.
 package controller
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -41,3 +45,13 @@ func (o IssuerOptions) CanUseAmbientCredentials(iss cmapi.GenericIssuer) bool {
 	}
 	return false
 }
+
+// Backdate returns the amount of time that the notBefore of a signed
+// certificate should be backdated by. The given backdate, typically taken from
+// a CertificateRequest, is used if set, otherwise the IssuanceBackdate default.
+func (o IssuerOptions) Backdate(backdate *metav1.Duration) time.Duration {
+	if backdate != nil {
+		return backdate.Duration
+	}
+	return o.IssuanceBackdate
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuancequotas
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/metrics"
+	"github.com/jetstack/cert-manager/pkg/scheduler"
+)
+
+const (
+	// ControllerName is the string used to refer to this controller
+	// when enabling or disabling it from command line flags.
+	ControllerName = "issuancequotas"
+)
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+// This controller counts the Certificates and recently created
+// CertificateRequests in the namespace of each IssuanceQuota, records them in
+// the status of the IssuanceQuota and exposes them as metrics. Quotas are
+// enforced by the webhook, not by this controller.
+type controller struct {
+	issuanceQuotaLister      cmlisters.IssuanceQuotaLister
+	certificateLister        cmlisters.CertificateLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+	client                   cmclient.Interface
+	metrics                  *metrics.Metrics
+	clock                    clock.Clock
+
+	// scheduledWorkQueue is used to recount an IssuanceQuota when the
+	// earliest CertificateRequest counted against it leaves its period.
+	scheduledWorkQueue scheduler.ScheduledWorkQueue
+}
+
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	cmFactory cminformers.SharedInformerFactory,
+	metrics *metrics.Metrics,
+	clock clock.Clock,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	issuanceQuotaInformer := cmFactory.Certmanager().V1().IssuanceQuotas()
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
+
+	c := &controller{
+		issuanceQuotaLister:      issuanceQuotaInformer.Lister(),
+		certificateLister:        certificateInformer.Lister(),
+		certificateRequestLister: certificateRequestInformer.Lister(),
+		client:                   client,
+		metrics:                  metrics,
+		clock:                    clock,
+		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(clock, queue.Add),
+	}
+
+	issuanceQuotaInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueIssuanceQuotasInNamespace(log, queue)})
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueIssuanceQuotasInNamespace(log, queue)})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		issuanceQuotaInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+		certificateRequestInformer.Informer().HasSynced,
+	}
+
+	return c, queue, mustSync
+}
+
+// enqueueIssuanceQuotasInNamespace returns a function which enqueues all of
+// the IssuanceQuotas in the namespace of the given object.
+func (c *controller) enqueueIssuanceQuotasInNamespace(log logr.Logger, queue workqueue.Interface) func(obj interface{}) {
+	return func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		metaObj, ok := obj.(metav1.Object)
+		if !ok {
+			log.Error(nil, "object does not implement metav1.Object")
+			return
+		}
+
+		quotas, err := c.issuanceQuotaLister.IssuanceQuotas(metaObj.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			log.Error(err, "failed to list issuancequotas")
+			return
+		}
+		for _, quota := range quotas {
+			key, err := controllerpkg.KeyFunc(quota)
+			if err != nil {
+				log.Error(err, "error computing key for resource")
+				continue
+			}
+			queue.Add(key)
+		}
+	}
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	quota, err := c.issuanceQuotaLister.IssuanceQuotas(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("issuancequota not found for key")
+		c.metrics.RemoveIssuanceQuota(key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, quota)
+	ctx = logf.NewContext(ctx, log)
+
+	crts, err := c.certificateLister.Certificates(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	reqs, err := c.certificateRequestLister.CertificateRequests(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	// Count the CertificateRequests created within the period of the quota,
+	// and recount when the earliest of them leaves the period.
+	period := apiutil.IssuanceQuotaCertificateRequestsPeriod(quota)
+	since := c.clock.Now().Add(-period)
+	var (
+		numReqs  int32
+		earliest time.Time
+	)
+	for _, req := range reqs {
+		created := req.CreationTimestamp.Time
+		if !created.After(since) {
+			continue
+		}
+		numReqs++
+		if earliest.IsZero() || created.Before(earliest) {
+			earliest = created
+		}
+	}
+	if !earliest.IsZero() {
+		c.scheduledWorkQueue.Add(key, earliest.Sub(since))
+	}
+
+	if quota.Status.Certificates != int32(len(crts)) || quota.Status.CertificateRequests != numReqs {
+		quota = quota.DeepCopy()
+		quota.Status.Certificates = int32(len(crts))
+		quota.Status.CertificateRequests = numReqs
+		now := metav1.NewTime(c.clock.Now())
+		quota.Status.LastUpdateTime = &now
+
+		log.V(logf.DebugLevel).Info("updating issuancequota usage", "certificates", quota.Status.Certificates, "certificaterequests", numReqs)
+		if quota, err = c.client.CertmanagerV1().IssuanceQuotas(namespace).UpdateStatus(ctx, quota, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	c.metrics.UpdateIssuanceQuota(quota)
+
+	return nil
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.SharedInformerFactory,
+		ctx.Metrics,
+		ctx.Clock,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
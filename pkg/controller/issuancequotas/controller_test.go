@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuancequotas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now()
+	fixedClock := fakeclock.NewFakeClock(now)
+	nowMetaTime := metav1.NewTime(now)
+
+	quota := &cmapi.IssuanceQuota{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "quota"},
+	}
+	withStatus := func(crts, reqs int32) *cmapi.IssuanceQuota {
+		quota := quota.DeepCopy()
+		quota.Status = cmapi.IssuanceQuotaStatus{
+			Certificates:        crts,
+			CertificateRequests: reqs,
+			LastUpdateTime:      &nowMetaTime,
+		}
+		return quota
+	}
+
+	crt := func(name string) *cmapi.Certificate {
+		return gen.Certificate(name, gen.SetCertificateNamespace(gen.DefaultTestNamespace))
+	}
+	req := func(name string, age time.Duration) *cmapi.CertificateRequest {
+		req := gen.CertificateRequest(name, gen.SetCertificateRequestNamespace(gen.DefaultTestNamespace))
+		req.CreationTimestamp = metav1.NewTime(now.Add(-age))
+		return req
+	}
+
+	tests := map[string]struct {
+		existingCMObjects []runtime.Object
+		expectedStatus    *cmapi.IssuanceQuota
+	}{
+		"count the Certificates and CertificateRequests in the namespace": {
+			existingCMObjects: []runtime.Object{quota, crt("a"), crt("b"), req("a", time.Minute)},
+			expectedStatus:    withStatus(2, 1),
+		},
+		"do not count CertificateRequests created before the period": {
+			existingCMObjects: []runtime.Object{quota, crt("a"), req("a", time.Minute), req("b", 2*time.Hour)},
+			expectedStatus:    withStatus(1, 1),
+		},
+		"do not count resources in other namespaces": {
+			existingCMObjects: []runtime.Object{quota, gen.Certificate("a", gen.SetCertificateNamespace("other"))},
+		},
+		"do not update the status if the counts have not changed": {
+			existingCMObjects: []runtime.Object{withStatus(1, 0), crt("a")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				CertManagerObjects: test.existingCMObjects,
+			}
+			if test.expectedStatus != nil {
+				builder.ExpectedActions = []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("issuancequotas"), "status", test.expectedStatus.Namespace, test.expectedStatus)),
+				}
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.ProcessItem(context.Background(), quota.Namespace+"/"+quota.Name); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
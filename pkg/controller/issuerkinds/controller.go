@@ -0,0 +1,393 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerkinds
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/jetstack/cert-manager/pkg/api"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/metrics"
+)
+
+const (
+	messageErrorInitIssuer = "Error initializing issuer: "
+)
+
+// queueMetricsSamplePeriod is how often the depth of the queue, and how long
+// the longest running reconcile has been running for, are recorded in the
+// controller's metrics.
+const queueMetricsSamplePeriod = 5 * time.Second
+
+// controller runs a controller-runtime manager which sets up the resources
+// of a single issuer kind. Each issuer kind has its own manager, so that the
+// informers for resources of the kind are isolated from other kinds.
+type controller struct {
+	ctx  *controllerpkg.Context
+	kind Kind
+
+	// secretInformer is shared with the issuer implementations, which read
+	// the Secrets referenced by issuers through its lister.
+	secretInformer cache.SharedIndexInformer
+}
+
+func newController(ctx *controllerpkg.Context, kind Kind) *controller {
+	return &controller{
+		ctx:  ctx,
+		kind: kind,
+		// The informer must be obtained before the shared informer factory
+		// is started, so that it is started along with it.
+		secretInformer: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Informer(),
+	}
+}
+
+// Run starts a controller-runtime manager with 'workers' concurrent
+// reconciles, and blocks until stopCh is closed.
+// As for controllers run by pkg/controller, resources remaining in the queue
+// are dropped once stopCh is closed, and reconciles in flight are given the
+// drain timeout to complete before their context is cancelled.
+func (c *controller) Run(workers int, stopCh <-chan struct{}) error {
+	log := logf.FromContext(c.ctx.RootContext, c.kind.ControllerName)
+
+	// Reconciles in flight are waited for below rather than by the manager.
+	noGracefulShutdown := time.Duration(0)
+	mgr, err := ctrl.NewManager(c.ctx.RESTConfig, ctrl.Options{
+		Scheme:                  api.Scheme,
+		Namespace:               c.ctx.Namespace,
+		MetricsBindAddress:      "0",
+		GracefulShutdownTimeout: &noGracefulShutdown,
+		Logger:                  log,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating manager: %v", err)
+	}
+
+	r := newReconciler(c.kind, mgr.GetClient(), c.ctx, log)
+	defer r.cancelWork()
+	err = ctrl.NewControllerManagedBy(mgr).
+		Named(c.kind.ControllerName).
+		For(c.kind.NewObject()).
+		Watches(&queueSource{Source: &source.Informer{Informer: c.secretInformer}, r: r}, handler.EnqueueRequestsFromMapFunc(r.requestsForSecret)).
+		WithOptions(crcontroller.Options{MaxConcurrentReconciles: workers}).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("error creating controller: %v", err)
+	}
+
+	if !cache.WaitForCacheSync(stopCh, c.secretInformer.HasSynced) {
+		return fmt.Errorf("error waiting for informer caches to sync")
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx.RootContext)
+	defer cancel()
+	go func() {
+		select {
+		case <-stopCh:
+		case <-ctx.Done():
+		}
+		log.V(logf.InfoLevel).Info("shutting down queue as workqueue signaled shutdown")
+		r.stop()
+		cancel()
+	}()
+
+	go wait.Until(r.recordQueueMetrics, queueMetricsSamplePeriod, stopCh)
+
+	log.V(logf.DebugLevel).Info("starting control loop")
+	err = mgr.Start(ctx)
+
+	r.stop()
+	log.V(logf.DebugLevel).Info("waiting for workers to exit...", "drain_timeout", c.ctx.DrainTimeout)
+	r.drain(c.ctx.DrainTimeout)
+	log.V(logf.DebugLevel).Info("workers exited")
+	return err
+}
+
+// queueSource is an event source which records the queue of the controller
+// it is started by in the reconciler, so that the depth of the queue can be
+// exposed in the controller's metrics.
+type queueSource struct {
+	source.Source
+	r *reconciler
+}
+
+func (s *queueSource) Start(ctx context.Context, h handler.EventHandler, queue workqueue.RateLimitingInterface, predicates ...predicate.Predicate) error {
+	s.r.processingLock.Lock()
+	s.r.queue = queue
+	s.r.processingLock.Unlock()
+	return s.Source.Start(ctx, h, queue, predicates...)
+}
+
+// reconciler sets up the resources of an issuer kind.
+type reconciler struct {
+	kind     Kind
+	client   client.Client
+	cmctx    *controllerpkg.Context
+	recorder record.EventRecorder
+	metrics  *metrics.Metrics
+	log      logr.Logger
+
+	// clusterResourceNamespace is the namespace of the Secrets referenced by
+	// cluster scoped issuers.
+	clusterResourceNamespace string
+
+	// workCtx is the context resources are set up with. Unlike the context
+	// of the manager, it is not cancelled as soon as the controller is
+	// signalled to exit, so that reconciles in flight can complete.
+	workCtx    context.Context
+	cancelWork context.CancelFunc
+
+	// draining is closed once the controller has been signalled to exit,
+	// after which requests remaining in the queue are dropped.
+	draining chan struct{}
+	stopOnce sync.Once
+
+	// processing records when each request currently being reconciled was
+	// handed to a worker, and inFlight counts them so that they can be
+	// waited for when draining.
+	processingLock sync.Mutex
+	processing     map[reconcile.Request]time.Time
+	inFlight       sync.WaitGroup
+
+	// queue is the queue of the controller, once it has been started.
+	queue workqueue.RateLimitingInterface
+}
+
+var _ reconcile.Reconciler = &reconciler{}
+
+func newReconciler(kind Kind, cl client.Client, cmctx *controllerpkg.Context, log logr.Logger) *reconciler {
+	workCtx, cancelWork := context.WithCancel(context.Background())
+	return &reconciler{
+		kind:                     kind,
+		client:                   cl,
+		cmctx:                    cmctx,
+		recorder:                 cmctx.Recorder,
+		metrics:                  cmctx.Metrics,
+		log:                      log,
+		clusterResourceNamespace: cmctx.IssuerOptions.ClusterResourceNamespace,
+		workCtx:                  workCtx,
+		cancelWork:               cancelWork,
+		draining:                 make(chan struct{}),
+		processing:               make(map[reconcile.Request]time.Time),
+	}
+}
+
+// Reconcile sets up the resource of the request, recording the outcome in
+// the controller's metrics. The context passed by controller-runtime is
+// cancelled as soon as the controller is signalled to exit, so the resource
+// is set up using workCtx instead.
+func (r *reconciler) Reconcile(_ context.Context, req reconcile.Request) (reconcile.Result, error) {
+	started, ok := r.startProcessing(req)
+	if !ok {
+		// Once draining, requests remaining in the queue are dropped
+		// rather than processed. They will be processed again by the next
+		// leader when its informers sync.
+		return reconcile.Result{}, nil
+	}
+
+	// Increase sync count for this controller
+	r.metrics.IncrementSyncCallCount(r.kind.ControllerName)
+
+	result, err := r.reconcile(r.workCtx, req)
+	r.finishProcessing(req)
+	r.metrics.ObserveReconcile(r.kind.ControllerName, time.Since(started), err)
+	if err != nil {
+		r.metrics.IncrementQueueRetries(r.kind.ControllerName)
+	}
+	return result, err
+}
+
+// startProcessing records that the request is being reconciled, and returns
+// the time at which it started. It returns false if the controller is
+// draining, in which case the request must not be reconciled.
+func (r *reconciler) startProcessing(req reconcile.Request) (time.Time, bool) {
+	r.processingLock.Lock()
+	defer r.processingLock.Unlock()
+	select {
+	case <-r.draining:
+		return time.Time{}, false
+	default:
+	}
+	r.inFlight.Add(1)
+	started := time.Now()
+	r.processing[req] = started
+	return started, true
+}
+
+// finishProcessing records that the request is no longer being reconciled.
+func (r *reconciler) finishProcessing(req reconcile.Request) {
+	r.processingLock.Lock()
+	defer r.processingLock.Unlock()
+	delete(r.processing, req)
+	r.inFlight.Done()
+}
+
+// stop stops any further requests from being reconciled.
+func (r *reconciler) stop() {
+	r.stopOnce.Do(func() {
+		// draining is closed with the lock held, so that no reconcile can
+		// start once drain is waiting for those in flight.
+		r.processingLock.Lock()
+		defer r.processingLock.Unlock()
+		close(r.draining)
+	})
+}
+
+// drain waits for reconciles in flight to complete, cancelling their context
+// if they have not completed within timeout. It must only be called once
+// stop has been called.
+func (r *reconciler) drain(timeout time.Duration) {
+	finished := make(chan struct{})
+	go func() {
+		r.inFlight.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(timeout):
+		r.log.V(logf.InfoLevel).Info("in-flight work did not complete within the drain timeout, cancelling it", "drain_timeout", timeout)
+		r.cancelWork()
+		<-finished
+	}
+}
+
+// recordQueueMetrics exposes the number of requests waiting in the queue,
+// and how long the longest running reconcile has been running for. The
+// queue has no priorities, so its depth is reported as PriorityNormal.
+func (r *reconciler) recordQueueMetrics() {
+	r.processingLock.Lock()
+	defer r.processingLock.Unlock()
+
+	if r.queue != nil {
+		r.metrics.SetControllerQueueDepth(r.kind.ControllerName, controllerpkg.PriorityNormal.String(), r.queue.Len())
+	}
+
+	var longest time.Duration
+	for _, started := range r.processing {
+		if d := time.Since(started); d > longest {
+			longest = d
+		}
+	}
+	r.metrics.SetLongestRunningProcessor(r.kind.ControllerName, longest)
+}
+
+// reconcile sets up the resource of the request, persisting any changes made
+// to its status.
+func (r *reconciler) reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	log := r.log.WithValues(logf.ResourceNameKey, req.Name, logf.ResourceNamespaceKey, req.Namespace)
+
+	obj := r.kind.NewObject()
+	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(logf.DebugLevel).Info("resource in work queue no longer exists")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	log = logf.WithResource(r.log, obj)
+	ctx = logf.NewContext(ctx, log)
+
+	// allow a maximum of 10s
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	objCopy := obj.DeepCopyObject().(client.Object)
+	defer func() {
+		if apiequality.Semantic.DeepEqual(obj, objCopy) {
+			return
+		}
+		if saveErr := r.client.Status().Update(ctx, objCopy); saveErr != nil {
+			err = utilerrors.NewAggregate([]error{saveErr, err})
+		}
+	}()
+
+	if err := r.kind.Setup(ctx, r.cmctx, objCopy); err != nil {
+		s := messageErrorInitIssuer + err.Error()
+		log.V(logf.WarnLevel).Info(s)
+		r.recorder.Event(objCopy, corev1.EventTypeWarning, events.ReasonInitIssuerFailed, s)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// requestsForSecret returns a request for each resource of the issuer kind
+// which references the given Secret.
+func (r *reconciler) requestsForSecret(secret client.Object) []reconcile.Request {
+	log := logf.WithResource(r.log, secret)
+
+	namespace := secret.GetNamespace()
+	if r.kind.ClusterScoped {
+		if namespace != r.clusterResourceNamespace {
+			return nil
+		}
+		namespace = ""
+	}
+
+	list := r.kind.NewObjectList()
+	if err := r.client.List(context.TODO(), list, client.InNamespace(namespace)); err != nil {
+		log.Error(err, "error listing issuers observing secret")
+		return nil
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		log.Error(err, "error listing issuers observing secret")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		for _, name := range r.kind.SecretNames(obj) {
+			if name == secret.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+					Namespace: obj.GetNamespace(),
+					Name:      obj.GetName(),
+				}})
+				break
+			}
+		}
+	}
+	return requests
+}
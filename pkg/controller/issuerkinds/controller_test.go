@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerkinds
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/jetstack/cert-manager/pkg/api"
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/metrics"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func testKind(clusterScoped bool, setup func(ctx context.Context, cmctx *controllerpkg.Context, obj client.Object) error) Kind {
+	kind := Kind{
+		ControllerName: "issuers",
+		NewObject:      func() client.Object { return &cmapi.Issuer{} },
+		NewObjectList:  func() client.ObjectList { return &cmapi.IssuerList{} },
+		SecretNames: func(obj client.Object) []string {
+			if ca := obj.(cmapi.GenericIssuer).GetSpec().CA; ca != nil {
+				return []string{ca.SecretName}
+			}
+			return nil
+		},
+		Setup: setup,
+	}
+	if clusterScoped {
+		kind.ControllerName = "clusterissuers"
+		kind.NewObject = func() client.Object { return &cmapi.ClusterIssuer{} }
+		kind.NewObjectList = func() client.ObjectList { return &cmapi.ClusterIssuerList{} }
+		kind.ClusterScoped = true
+	}
+	return kind
+}
+
+func testContext(recorder record.EventRecorder) *controllerpkg.Context {
+	return &controllerpkg.Context{
+		Recorder: recorder,
+		Metrics:  metrics.New(logf.Log, clock.RealClock{}),
+		IssuerOptions: controllerpkg.IssuerOptions{
+			ClusterResourceNamespace: "cert-manager",
+		},
+	}
+}
+
+var readyCondition = cmapi.IssuerCondition{
+	Type:    cmapi.IssuerConditionReady,
+	Status:  cmmeta.ConditionTrue,
+	Reason:  "Ready",
+	Message: "ready",
+}
+
+func setReady(_ context.Context, _ *controllerpkg.Context, obj client.Object) error {
+	apiutil.SetIssuerCondition(obj.(cmapi.GenericIssuer), obj.GetGeneration(), readyCondition.Type, readyCondition.Status, readyCondition.Reason, readyCondition.Message)
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	issuer := gen.Issuer("test", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"}))
+
+	tests := map[string]struct {
+		setup        func(ctx context.Context, cmctx *controllerpkg.Context, obj client.Object) error
+		existing     []client.Object
+		expectReady  bool
+		expectUpdate bool
+		expectErr    bool
+		expectEvent  bool
+	}{
+		"status updated by setup is persisted": {
+			setup:        setReady,
+			existing:     []client.Object{issuer},
+			expectReady:  true,
+			expectUpdate: true,
+		},
+		"status which is not changed by setup is not updated": {
+			setup:       setReady,
+			existing:    []client.Object{gen.IssuerFrom(issuer, gen.AddIssuerCondition(readyCondition))},
+			expectReady: true,
+		},
+		"setup error is recorded as an event and returned": {
+			setup: func(context.Context, *controllerpkg.Context, client.Object) error {
+				return errors.New("boom")
+			},
+			existing:    []client.Object{issuer},
+			expectErr:   true,
+			expectEvent: true,
+		},
+		"issuer which no longer exists is ignored": {
+			setup: func(context.Context, *controllerpkg.Context, client.Object) error {
+				t.Fatal("unexpected call to setup")
+				return nil
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(api.Scheme).WithObjects(test.existing...).Build()
+			recorder := record.NewFakeRecorder(1)
+			r := newReconciler(testKind(false, test.setup), cl, testContext(recorder), logf.Log)
+
+			key := types.NamespacedName{Namespace: gen.DefaultTestNamespace, Name: "test"}
+			var existing cmapi.Issuer
+			if len(test.existing) > 0 {
+				require.NoError(t, cl.Get(context.TODO(), key, &existing))
+			}
+
+			_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+			if test.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectEvent, len(recorder.Events) == 1)
+
+			if len(test.existing) == 0 {
+				return
+			}
+			var got cmapi.Issuer
+			require.NoError(t, cl.Get(context.TODO(), key, &got))
+			assert.Equal(t, test.expectReady, apiutil.IssuerHasCondition(&got, readyCondition))
+			assert.Equal(t, test.expectUpdate, got.ResourceVersion != existing.ResourceVersion)
+		})
+	}
+}
+
+func TestRequestsForSecret(t *testing.T) {
+	secret := func(namespace, name string) client.Object {
+		return &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+
+	tests := map[string]struct {
+		clusterScoped bool
+		existing      []client.Object
+		secret        client.Object
+		expected      []reconcile.Request
+	}{
+		"issuer referencing the secret": {
+			existing: []client.Object{
+				gen.Issuer("ca-issuer", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"})),
+				gen.Issuer("other-issuer", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "other"})),
+			},
+			secret: secret(gen.DefaultTestNamespace, "ca"),
+			expected: []reconcile.Request{{NamespacedName: types.NamespacedName{
+				Namespace: gen.DefaultTestNamespace,
+				Name:      "ca-issuer",
+			}}},
+		},
+		"issuer in another namespace": {
+			existing: []client.Object{
+				gen.Issuer("ca-issuer", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"})),
+			},
+			secret: secret("other", "ca"),
+		},
+		"cluster issuer referencing a secret in the cluster resource namespace": {
+			clusterScoped: true,
+			existing: []client.Object{
+				gen.ClusterIssuer("ca-issuer", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"})),
+			},
+			secret:   secret("cert-manager", "ca"),
+			expected: []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "ca-issuer"}}},
+		},
+		"cluster issuer referencing a secret in another namespace": {
+			clusterScoped: true,
+			existing: []client.Object{
+				gen.ClusterIssuer("ca-issuer", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"})),
+			},
+			secret: secret(gen.DefaultTestNamespace, "ca"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(api.Scheme).WithObjects(test.existing...).Build()
+			r := newReconciler(testKind(test.clusterScoped, setReady), cl, testContext(nil), logf.Log)
+			assert.Equal(t, test.expected, r.requestsForSecret(test.secret))
+		})
+	}
+}
+
+func TestReconcileMetrics(t *testing.T) {
+	issuer := gen.Issuer("test")
+	cl := fake.NewClientBuilder().WithScheme(api.Scheme).WithObjects(issuer).Build()
+	cmctx := testContext(record.NewFakeRecorder(1))
+	r := newReconciler(testKind(false, func(context.Context, *controllerpkg.Context, client.Object) error {
+		return errors.New("boom")
+	}), cl, cmctx, logf.Log)
+
+	// Reconcile a request which fails, and one which no longer exists.
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: gen.DefaultTestNamespace, Name: "test"}})
+	assert.Error(t, err)
+	_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: gen.DefaultTestNamespace, Name: "deleted"}})
+	assert.NoError(t, err)
+
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+	queue.Add("a")
+	queue.Add("b")
+	noop := source.Func(func(context.Context, handler.EventHandler, workqueue.RateLimitingInterface, ...predicate.Predicate) error {
+		return nil
+	})
+	require.NoError(t, (&queueSource{Source: noop, r: r}).Start(context.TODO(), &handler.EnqueueRequestForObject{}, queue))
+	r.recordQueueMetrics()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	server := cmctx.Metrics.NewServer(ln, false, false)
+	resp := httptest.NewRecorder()
+	server.Handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	for _, expected := range []string{
+		`certmanager_controller_sync_call_count{controller="issuers"} 2`,
+		`certmanager_controller_reconcile_total{controller="issuers",result="error"} 1`,
+		`certmanager_controller_reconcile_total{controller="issuers",result="success"} 1`,
+		`certmanager_controller_reconcile_duration_seconds_count{controller="issuers"} 2`,
+		`certmanager_controller_queue_retries_total{controller="issuers"} 1`,
+		`certmanager_controller_queue_depth{controller="issuers",priority="normal"} 2`,
+		`certmanager_controller_longest_running_processor_seconds{controller="issuers"} 0`,
+	} {
+		assert.Contains(t, resp.Body.String(), expected+"\n")
+	}
+}
+
+func TestReconcileDrain(t *testing.T) {
+	tests := map[string]struct {
+		drainTimeout time.Duration
+
+		expectCancelled bool
+	}{
+		"in-flight work completes within the drain timeout": {
+			drainTimeout: time.Minute,
+		},
+		"in-flight work is cancelled after the drain timeout": {
+			drainTimeout:    time.Millisecond * 10,
+			expectCancelled: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var processed int32
+			var cancelled int32
+			setup := func(ctx context.Context, _ *controllerpkg.Context, _ client.Object) error {
+				if atomic.AddInt32(&processed, 1) == 1 {
+					close(started)
+				}
+				select {
+				case <-release:
+				case <-ctx.Done():
+					atomic.StoreInt32(&cancelled, 1)
+				}
+				return nil
+			}
+
+			cl := fake.NewClientBuilder().WithScheme(api.Scheme).WithObjects(gen.Issuer("in-flight"), gen.Issuer("queued")).Build()
+			r := newReconciler(testKind(false, setup), cl, testContext(record.NewFakeRecorder(1)), logf.Log)
+
+			// controller-runtime cancels the context of the reconcile as
+			// soon as the controller is signalled to exit.
+			reconcileCtx, cancel := context.WithCancel(context.Background())
+			reconciled := make(chan struct{})
+			go func() {
+				defer close(reconciled)
+				_, _ = r.Reconcile(reconcileCtx, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: gen.DefaultTestNamespace, Name: "in-flight"}})
+			}()
+			<-started
+
+			cancel()
+			r.stop()
+			_, err := r.Reconcile(reconcileCtx, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: gen.DefaultTestNamespace, Name: "queued"}})
+			assert.NoError(t, err)
+
+			if !test.expectCancelled {
+				time.AfterFunc(time.Millisecond*10, func() { close(release) })
+			}
+			r.drain(test.drainTimeout)
+			<-reconciled
+
+			if got := atomic.LoadInt32(&cancelled) == 1; got != test.expectCancelled {
+				t.Errorf("expected in-flight work to be cancelled: %v, got: %v", test.expectCancelled, got)
+			}
+			if got := atomic.LoadInt32(&processed); got != 1 {
+				t.Errorf("expected queued requests not to be processed once draining, got %d requests processed", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerkinds
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+)
+
+// Kind describes a kind of issuer resource, such as Issuer or ClusterIssuer,
+// whose resources are set up by a controller-runtime based controller.
+// Issuer kinds which are not part of cert-manager can be compiled into the
+// controller by registering a Kind from the init function of their package.
+type Kind struct {
+	// ControllerName is the name of the controller which sets up resources
+	// of this kind, as used in the --controllers flag.
+	ControllerName string
+
+	// NewObject returns a new empty resource of this kind.
+	NewObject func() client.Object
+
+	// NewObjectList returns a new empty list of resources of this kind.
+	NewObjectList func() client.ObjectList
+
+	// ClusterScoped is true if resources of this kind are cluster scoped.
+	// Secrets referenced by cluster scoped resources are read from the
+	// cluster resource namespace, and their controller is not run if
+	// cert-manager is scoped to a single namespace.
+	ClusterScoped bool
+
+	// SecretNames returns the names of the Secret resources referenced by
+	// the given resource, so that it is set up again when one of them
+	// changes.
+	SecretNames func(obj client.Object) []string
+
+	// Setup checks whether the given resource is able to issue
+	// certificates, updating its status accordingly. Only changes to the
+	// status of the resource are persisted.
+	Setup func(ctx context.Context, cmctx *controllerpkg.Context, obj client.Object) error
+}
+
+var (
+	kinds     = make(map[string]Kind)
+	kindsLock sync.RWMutex
+)
+
+// Register registers an issuer kind, along with a controller named after
+// its ControllerName which sets up resources of the kind.
+func Register(kind Kind) {
+	kindsLock.Lock()
+	defer kindsLock.Unlock()
+	kinds[kind.ControllerName] = kind
+	controllerpkg.Register(kind.ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return newController(ctx, kind), nil
+	})
+}
+
+// Get returns the issuer kind registered with the given controller name, and
+// false if there is none.
+func Get(controllerName string) (Kind, bool) {
+	kindsLock.RLock()
+	defer kindsLock.RUnlock()
+	kind, ok := kinds[controllerName]
+	return kind, ok
+}
+
+// ControllerNames returns the sorted controller names of all registered
+// issuer kinds.
+func ControllerNames() []string {
+	kindsLock.RLock()
+	defer kindsLock.RUnlock()
+	names := make([]string, 0, len(kinds))
+	for name := range kinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
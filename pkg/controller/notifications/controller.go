@@ -0,0 +1,408 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/informers"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/scheduler"
+)
+
+const (
+	// ControllerName is the name of the notifications controller. It is not
+	// enabled by default.
+	ControllerName = "notifications"
+)
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+// This controller posts a webhook notification for each NotificationRoute
+// when a Certificate selected by it crosses one of its expiry thresholds,
+// repeatedly fails to be issued, or is found to be revoked. The
+// notifications which have been sent are recorded in the status of the
+// NotificationRoute so that each one is only sent once while its event is
+// ongoing.
+type controller struct {
+	notificationRouteLister cmlisters.NotificationRouteLister
+	certificateLister       cmlisters.CertificateLister
+	secretLister            corelisters.SecretLister
+	client                  cmclient.Interface
+	recorder                record.EventRecorder
+	clock                   clock.Clock
+	httpClient              *http.Client
+
+	// scheduledWorkQueue is used to re-check a NotificationRoute when the
+	// next expiry threshold of one of its Certificates is crossed.
+	scheduledWorkQueue scheduler.ScheduledWorkQueue
+}
+
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	httpClient *http.Client,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	notificationRouteInformer := cmFactory.Certmanager().V1().NotificationRoutes()
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	c := &controller{
+		notificationRouteLister: notificationRouteInformer.Lister(),
+		certificateLister:       certificateInformer.Lister(),
+		secretLister:            secretsInformer.Lister(),
+		client:                  client,
+		recorder:                recorder,
+		clock:                   clock,
+		httpClient:              httpClient,
+		scheduledWorkQueue:      scheduler.NewScheduledWorkQueue(clock, queue.Add),
+	}
+
+	notificationRouteInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueNotificationRoutesInNamespace(log, queue)})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		notificationRouteInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+	}
+
+	return c, queue, mustSync
+}
+
+// enqueueNotificationRoutesInNamespace returns a function which enqueues all
+// of the NotificationRoutes in the namespace of the given object.
+func (c *controller) enqueueNotificationRoutesInNamespace(log logr.Logger, queue workqueue.Interface) func(obj interface{}) {
+	return func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		metaObj, ok := obj.(metav1.Object)
+		if !ok {
+			log.Error(nil, "object does not implement metav1.Object")
+			return
+		}
+
+		routes, err := c.notificationRouteLister.NotificationRoutes(metaObj.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			log.Error(err, "failed to list notificationroutes")
+			return
+		}
+		for _, route := range routes {
+			key, err := controllerpkg.KeyFunc(route)
+			if err != nil {
+				log.Error(err, "error computing key for resource")
+				continue
+			}
+			queue.Add(key)
+		}
+	}
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	route, err := c.notificationRouteLister.NotificationRoutes(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info("notificationroute not found for key")
+		c.scheduledWorkQueue.Forget(key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithResource(log, route)
+	ctx = logf.NewContext(ctx, log)
+	dbg = log.V(logf.DebugLevel)
+
+	selector := labels.Everything()
+	if route.Spec.Selector != nil {
+		selector, err = metav1.LabelSelectorAsSelector(route.Spec.Selector)
+		if err != nil {
+			// The selector is validated by the webhook, so this should
+			// never happen.
+			log.Error(err, "invalid selector on notificationroute")
+			return nil
+		}
+	}
+	crts, err := c.certificateLister.Certificates(namespace).List(selector)
+	if err != nil {
+		return err
+	}
+
+	now := c.clock.Now()
+	var (
+		active  []*notification
+		recheck time.Duration
+	)
+	for _, crt := range crts {
+		ns, next := activeNotifications(route, crt, now)
+		active = append(active, ns...)
+		if next > 0 && (recheck == 0 || next < recheck) {
+			recheck = next
+		}
+	}
+	if recheck > 0 {
+		dbg.Info("scheduling recheck of notificationroute for the next expiry threshold", "recheck_in", recheck.String())
+		c.scheduledWorkQueue.Add(key, recheck)
+	}
+
+	sent := make(map[string]cmapi.SentNotification, len(route.Status.Notifications))
+	for _, s := range route.Status.Notifications {
+		sent[sentNotificationKey(s.Certificate, s.Event, s.ID)] = s
+	}
+
+	// Only the notifications whose event is still ongoing are kept in the
+	// status, so that a notification is sent again if its event recurs.
+	var (
+		notifications []cmapi.SentNotification
+		errs          []error
+	)
+	for _, n := range active {
+		if s, ok := sent[sentNotificationKey(n.Certificate, n.Event, n.id)]; ok {
+			notifications = append(notifications, s)
+			continue
+		}
+
+		n.Route = route.Name
+		n.Time = now
+		if err := c.send(ctx, route, n); err != nil {
+			c.recorder.Eventf(route, corev1.EventTypeWarning, events.ReasonNotificationFailed, "Failed to send %s notification for Certificate %s: %v", n.Event, n.Certificate, err)
+			errs = append(errs, err)
+			continue
+		}
+
+		log.V(logf.InfoLevel).Info("sent notification", "event", n.Event, "certificate", n.Certificate)
+		c.recorder.Eventf(route, corev1.EventTypeNormal, events.ReasonNotificationSent, "Sent %s notification for Certificate %s", n.Event, n.Certificate)
+		notifications = append(notifications, cmapi.SentNotification{
+			Certificate: n.Certificate,
+			Event:       n.Event,
+			ID:          n.id,
+			Time:        metav1.NewTime(now),
+		})
+	}
+
+	if !reflect.DeepEqual(notifications, route.Status.Notifications) {
+		route = route.DeepCopy()
+		route.Status.Notifications = notifications
+		if _, err := c.client.CertmanagerV1().NotificationRoutes(namespace).UpdateStatus(ctx, route, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// send renders the payload of the notification and posts it to the webhook
+// of the NotificationRoute.
+func (c *controller) send(ctx context.Context, route *cmapi.NotificationRoute, n *notification) error {
+	webhook := &route.Spec.Webhook
+
+	url := webhook.URL
+	if webhook.URLSecretRef != nil {
+		var err error
+		url, err = c.secretValue(route.Namespace, webhook.URLSecretRef)
+		if err != nil {
+			return err
+		}
+	}
+	if url == "" && webhook.Format == cmapi.NotificationFormatPagerDuty {
+		url = pagerDutyEventsURL
+	}
+	if url == "" {
+		return fmt.Errorf("no webhook url configured")
+	}
+
+	if webhook.RoutingKeySecretRef != nil {
+		var err error
+		n.RoutingKey, err = c.secretValue(route.Namespace, webhook.RoutingKeySecretRef)
+		if err != nil {
+			return err
+		}
+	}
+
+	payload, err := renderPayload(webhook, n)
+	if err != nil {
+		return err
+	}
+	return post(ctx, c.httpClient, url, payload)
+}
+
+// secretValue returns the value of the key of a Secret referenced by a
+// NotificationRoute.
+func (c *controller) secretValue(namespace string, ref *cmmeta.SecretKeySelector) (string, error) {
+	secret, err := c.secretLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+	}
+	return string(value), nil
+}
+
+// activeNotifications returns the notifications of the NotificationRoute
+// whose event is ongoing for the Certificate, and the time until the next
+// expiry threshold of the Certificate is crossed, or zero if there is none.
+func activeNotifications(route *cmapi.NotificationRoute, crt *cmapi.Certificate, now time.Time) ([]*notification, time.Duration) {
+	var (
+		active []*notification
+		next   time.Duration
+	)
+	newNotification := func(event cmapi.NotificationEvent, id, message string) *notification {
+		n := &notification{
+			Event:       event,
+			Namespace:   crt.Namespace,
+			Certificate: crt.Name,
+			Message:     message,
+			id:          id,
+		}
+		active = append(active, n)
+		return n
+	}
+
+	if apiutil.NotificationRouteSendsEvent(route, cmapi.NotificationEventExpiring) && crt.Status.NotAfter != nil {
+		notAfter := crt.Status.NotAfter.Time
+		remaining := notAfter.Sub(now)
+
+		// Only the smallest threshold that has been crossed is notified, so
+		// that a single notification is sent for a newly issued certificate
+		// which is already within several thresholds.
+		var crossed time.Duration
+		for _, threshold := range apiutil.NotificationRouteExpiryThresholds(route) {
+			if remaining <= threshold {
+				if crossed == 0 || threshold < crossed {
+					crossed = threshold
+				}
+				continue
+			}
+			if untilCrossed := remaining - threshold; next == 0 || untilCrossed < next {
+				next = untilCrossed
+			}
+		}
+		if crossed > 0 {
+			message := fmt.Sprintf("Certificate expires in %s", remaining.Round(time.Minute))
+			if remaining <= 0 {
+				message = fmt.Sprintf("Certificate expired at %s", notAfter.Format(time.RFC3339))
+			}
+			n := newNotification(cmapi.NotificationEventExpiring, crossed.String(), message)
+			n.NotAfter = &notAfter
+			n.Threshold = crossed.String()
+		}
+	}
+
+	if apiutil.NotificationRouteSendsEvent(route, cmapi.NotificationEventIssuanceFailed) && crt.Status.FailedIssuanceAttempts != nil {
+		attempts := *crt.Status.FailedIssuanceAttempts
+		if attempts >= apiutil.NotificationRouteIssuanceFailureThreshold(route) {
+			message := fmt.Sprintf("Issuance has failed %d consecutive times", attempts)
+			if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil && cond.Status == cmmeta.ConditionFalse {
+				message = fmt.Sprintf("%s: %s", message, cond.Message)
+			}
+			n := newNotification(cmapi.NotificationEventIssuanceFailed, "", message)
+			n.FailedIssuanceAttempts = attempts
+		}
+	}
+
+	if apiutil.NotificationRouteSendsEvent(route, cmapi.NotificationEventRevoked) {
+		cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing)
+		if cond != nil && cond.Status == cmmeta.ConditionTrue && cond.Reason == events.ReasonCertificateRevoked {
+			var id string
+			if cond.LastTransitionTime != nil {
+				id = cond.LastTransitionTime.UTC().Format(time.RFC3339)
+			}
+			newNotification(cmapi.NotificationEventRevoked, id, cond.Message)
+		}
+	}
+
+	return active, next
+}
+
+func sentNotificationKey(certificate string, event cmapi.NotificationEvent, id string) string {
+	return certificate + "/" + string(event) + "/" + id
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Clock,
+		&http.Client{Timeout: time.Second * 10},
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	fixedClock := fakeclock.NewFakeClock(now)
+	nowMetaTime := metav1.NewTime(now)
+
+	route := &cmapi.NotificationRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "route"},
+	}
+	withNotifications := func(notifications ...cmapi.SentNotification) *cmapi.NotificationRoute {
+		route := route.DeepCopy()
+		route.Status.Notifications = notifications
+		return route
+	}
+	expiring := cmapi.SentNotification{Certificate: "a", Event: cmapi.NotificationEventExpiring, ID: "168h0m0s", Time: nowMetaTime}
+
+	crt := func(name string, mods ...gen.CertificateModifier) *cmapi.Certificate {
+		return gen.Certificate(name, append(mods, gen.SetCertificateNamespace(gen.DefaultTestNamespace))...)
+	}
+	expiresIn := func(d time.Duration) gen.CertificateModifier {
+		return gen.SetCertificateNotAfter(metav1.NewTime(now.Add(d)))
+	}
+	failedAttempts := func(n int) gen.CertificateModifier {
+		return func(crt *cmapi.Certificate) {
+			crt.Status.FailedIssuanceAttempts = &n
+		}
+	}
+
+	tests := map[string]struct {
+		existingCMObjects []runtime.Object
+		webhookStatus     int
+
+		expectedNotifications []notification
+		expectedStatus        *cmapi.NotificationRoute
+		expectedEvents        []string
+		expectedErr           bool
+	}{
+		"send a notification when a certificate crosses an expiry threshold": {
+			existingCMObjects:     []runtime.Object{route, crt("a", expiresIn(72*time.Hour))},
+			expectedNotifications: []notification{{Event: cmapi.NotificationEventExpiring, Certificate: "a", Threshold: "168h0m0s"}},
+			expectedStatus:        withNotifications(expiring),
+			expectedEvents:        []string{"Normal NotificationSent Sent Expiring notification for Certificate a"},
+		},
+		"only notify the smallest expiry threshold that has been crossed": {
+			existingCMObjects:     []runtime.Object{withNotifications(expiring), crt("a", expiresIn(time.Hour))},
+			expectedNotifications: []notification{{Event: cmapi.NotificationEventExpiring, Certificate: "a", Threshold: "24h0m0s"}},
+			expectedStatus:        withNotifications(cmapi.SentNotification{Certificate: "a", Event: cmapi.NotificationEventExpiring, ID: "24h0m0s", Time: nowMetaTime}),
+			expectedEvents:        []string{"Normal NotificationSent Sent Expiring notification for Certificate a"},
+		},
+		"do not send a notification which has already been sent": {
+			existingCMObjects: []runtime.Object{withNotifications(expiring), crt("a", expiresIn(72*time.Hour))},
+		},
+		"remove notifications whose event is no longer ongoing": {
+			existingCMObjects: []runtime.Object{withNotifications(expiring), crt("a", expiresIn(90*24*time.Hour))},
+			expectedStatus:    route,
+		},
+		"send a notification when issuance fails repeatedly": {
+			existingCMObjects:     []runtime.Object{route, crt("a", failedAttempts(3)), crt("b", failedAttempts(2))},
+			expectedNotifications: []notification{{Event: cmapi.NotificationEventIssuanceFailed, Certificate: "a", FailedIssuanceAttempts: 3}},
+			expectedStatus:        withNotifications(cmapi.SentNotification{Certificate: "a", Event: cmapi.NotificationEventIssuanceFailed, Time: nowMetaTime}),
+			expectedEvents:        []string{"Normal NotificationSent Sent IssuanceFailed notification for Certificate a"},
+		},
+		"do not send notifications for events the route does not select": {
+			existingCMObjects: []runtime.Object{
+				&cmapi.NotificationRoute{
+					ObjectMeta: route.ObjectMeta,
+					Spec:       cmapi.NotificationRouteSpec{Events: []cmapi.NotificationEvent{cmapi.NotificationEventRevoked}},
+				},
+				crt("a", expiresIn(time.Hour), failedAttempts(5)),
+			},
+		},
+		"do not record a notification if the webhook fails": {
+			existingCMObjects:     []runtime.Object{route, crt("a", expiresIn(72*time.Hour))},
+			webhookStatus:         http.StatusInternalServerError,
+			expectedNotifications: []notification{{Event: cmapi.NotificationEventExpiring, Certificate: "a", Threshold: "168h0m0s"}},
+			expectedEvents:        []string{"Warning NotificationFailed Failed to send Expiring notification for Certificate a: webhook responded with status 500: failed"},
+			expectedErr:           true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				received []notification
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var n notification
+				if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+					t.Errorf("failed to decode notification: %v", err)
+				}
+				mu.Lock()
+				received = append(received, notification{Event: n.Event, Certificate: n.Certificate, Threshold: n.Threshold, FailedIssuanceAttempts: n.FailedIssuanceAttempts})
+				mu.Unlock()
+				if test.webhookStatus != 0 {
+					w.WriteHeader(test.webhookStatus)
+					w.Write([]byte("failed"))
+				}
+			}))
+			defer server.Close()
+
+			for i, obj := range test.existingCMObjects {
+				if r, ok := obj.(*cmapi.NotificationRoute); ok {
+					r = r.DeepCopy()
+					r.Spec.Webhook.URL = server.URL
+					test.existingCMObjects[i] = r
+				}
+			}
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				CertManagerObjects: test.existingCMObjects,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.expectedStatus != nil {
+				expected := test.expectedStatus.DeepCopy()
+				expected.Spec.Webhook.URL = server.URL
+				builder.ExpectedActions = []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("notificationroutes"), "status", expected.Namespace, expected)),
+				}
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			w.httpClient = server.Client()
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.ProcessItem(context.Background(), route.Namespace+"/"+route.Name)
+			if (err != nil) != test.expectedErr {
+				t.Errorf("unexpected error, exp=%t, got=%v", test.expectedErr, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(received) != len(test.expectedNotifications) {
+				t.Errorf("expected %d notifications to be sent, got %v", len(test.expectedNotifications), received)
+			} else {
+				for i := range received {
+					if received[i] != test.expectedNotifications[i] {
+						t.Errorf("unexpected notification, exp=%+v, got=%+v", test.expectedNotifications[i], received[i])
+					}
+				}
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"text/template"
+	"time"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// pagerDutyEventsURL is the URL of the PagerDuty Events API v2, which
+// notifications in the PagerDuty format are posted to if the webhook does
+// not specify a URL.
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// notification is sent to the webhook of a NotificationRoute. Its fields are
+// available to the template of the webhook.
+type notification struct {
+	Event                  cmapi.NotificationEvent `json:"event"`
+	Namespace              string                  `json:"namespace"`
+	Certificate            string                  `json:"certificate"`
+	Route                  string                  `json:"route"`
+	Message                string                  `json:"message"`
+	NotAfter               *time.Time              `json:"notAfter,omitempty"`
+	Threshold              string                  `json:"threshold,omitempty"`
+	FailedIssuanceAttempts int                     `json:"failedIssuanceAttempts,omitempty"`
+	Time                   time.Time               `json:"time"`
+	RoutingKey             string                  `json:"-"`
+
+	// id identifies the occurrence of the event, and is recorded in the
+	// status of the NotificationRoute once the notification has been sent.
+	id string
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// parseTemplate parses the payload template of a NotificationRoute webhook.
+func parseTemplate(text string) (*template.Template, error) {
+	return template.New("payload").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+}
+
+// renderPayload renders the payload posted to the webhook for the given
+// notification.
+func renderPayload(webhook *cmapi.NotificationWebhook, n *notification) ([]byte, error) {
+	if webhook.Template != "" {
+		tmpl, err := parseTemplate(webhook.Template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template: %w", err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, n); err != nil {
+			return nil, fmt.Errorf("failed to execute template: %w", err)
+		}
+		return buf.Bytes(), nil
+	}
+
+	switch webhook.Format {
+	case cmapi.NotificationFormatSlack:
+		return json.Marshal(map[string]string{
+			"text": fmt.Sprintf("*%s*: Certificate `%s/%s`: %s", n.Event, n.Namespace, n.Certificate, n.Message),
+		})
+	case cmapi.NotificationFormatPagerDuty:
+		severity := "warning"
+		if n.Event == cmapi.NotificationEventRevoked {
+			severity = "critical"
+		}
+		return json.Marshal(map[string]interface{}{
+			"routing_key":  n.RoutingKey,
+			"event_action": "trigger",
+			"dedup_key":    fmt.Sprintf("%s/%s/%s/%s", n.Namespace, n.Certificate, n.Event, n.id),
+			"payload": map[string]interface{}{
+				"summary":        fmt.Sprintf("Certificate %s/%s: %s", n.Namespace, n.Certificate, n.Message),
+				"source":         "cert-manager",
+				"severity":       severity,
+				"timestamp":      n.Time.Format(time.RFC3339),
+				"custom_details": n,
+			},
+		})
+	default:
+		return json.Marshal(n)
+	}
+}
+
+// post posts the payload to the given URL, and returns an error if the
+// webhook does not respond with a 2xx status code.
+func post(ctx context.Context, client *http.Client, url string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	return nil
+}
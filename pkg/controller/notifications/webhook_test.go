@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestRenderPayload(t *testing.T) {
+	n := &notification{
+		Event:       cmapi.NotificationEventRevoked,
+		Namespace:   "ns",
+		Certificate: "crt",
+		Route:       "route",
+		Message:     "revoked",
+		Time:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		RoutingKey:  "key",
+		id:          "2021-01-01T00:00:00Z",
+	}
+
+	tests := map[string]struct {
+		webhook     cmapi.NotificationWebhook
+		expected    string
+		expectedErr bool
+	}{
+		"JSON is the default format": {
+			expected: `{"event":"Revoked","namespace":"ns","certificate":"crt","route":"route","message":"revoked","time":"2021-01-01T00:00:00Z"}`,
+		},
+		"Slack format": {
+			webhook:  cmapi.NotificationWebhook{Format: cmapi.NotificationFormatSlack},
+			expected: `{"text":"*Revoked*: Certificate ` + "`ns/crt`" + `: revoked"}`,
+		},
+		"PagerDuty format": {
+			webhook:  cmapi.NotificationWebhook{Format: cmapi.NotificationFormatPagerDuty},
+			expected: `{"dedup_key":"ns/crt/Revoked/2021-01-01T00:00:00Z","event_action":"trigger","payload":{"custom_details":{"event":"Revoked","namespace":"ns","certificate":"crt","route":"route","message":"revoked","time":"2021-01-01T00:00:00Z"},"severity":"critical","source":"cert-manager","summary":"Certificate ns/crt: revoked","timestamp":"2021-01-01T00:00:00Z"},"routing_key":"key"}`,
+		},
+		"template overrides the format": {
+			webhook:  cmapi.NotificationWebhook{Format: cmapi.NotificationFormatSlack, Template: `{"summary": {{ printf "%s/%s: %s" .Namespace .Certificate .Message | json }}, "key": "{{ .RoutingKey }}"}`},
+			expected: `{"summary": "ns/crt: revoked", "key": "key"}`,
+		},
+		"template referencing an unknown field fails": {
+			webhook:     cmapi.NotificationWebhook{Template: `{{ .Unknown }}`},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			payload, err := renderPayload(&test.webhook, n)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("unexpected error, exp=%t, got=%v", test.expectedErr, err)
+			}
+			if string(payload) != test.expected {
+				t.Errorf("unexpected payload\nexp=%s\ngot=%s", test.expected, payload)
+			}
+		})
+	}
+}
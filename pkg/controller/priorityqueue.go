@@ -0,0 +1,455 @@
+/*
+Copyright 2020 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"container/heap"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+)
+
+// Priority is the priority with which an item is processed from a
+// PriorityQueue. Items with a higher priority are always handed to workers
+// before items with a lower priority.
+type Priority int
+
+const (
+	// PriorityNormal is used for routine reconciles.
+	PriorityNormal Priority = iota
+
+	// PriorityHigh is used for work which should preempt routine reconciles,
+	// such as renewing Certificates which are near expiry or for which a
+	// renewal has been requested by a user.
+	PriorityHigh
+)
+
+// Priorities is the list of all defined priorities, from highest to lowest.
+var Priorities = []Priority{PriorityHigh, PriorityNormal}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("%d", int(p))
+	}
+}
+
+// PriorityQueue is a rate limiting workqueue which hands out items with a
+// higher priority before items with a lower priority. Items added using the
+// workqueue.RateLimitingInterface methods are queued with PriorityNormal,
+// except for items re-queued after processing, which keep the priority they
+// were processed with.
+type PriorityQueue interface {
+	workqueue.RateLimitingInterface
+
+	// AddWithPriority adds an item to the queue with the given priority. If
+	// the item is already queued with a lower priority, its priority is
+	// raised. The priority of a queued item is never lowered.
+	AddWithPriority(item interface{}, priority Priority)
+
+	// LenWithPriority returns the number of items waiting to be processed
+	// with the given priority.
+	LenWithPriority(priority Priority) int
+}
+
+// NewPriorityQueue returns a new PriorityQueue which uses the given rate
+// limiter to determine the delay when items are re-queued with
+// AddRateLimited.
+func NewPriorityQueue(clock clock.Clock, rateLimiter workqueue.RateLimiter) PriorityQueue {
+	q := &priorityQueue{
+		clock:          clock,
+		rateLimiter:    rateLimiter,
+		queues:         make(map[Priority][]interface{}),
+		dirty:          make(map[interface{}]Priority),
+		processing:     make(map[interface{}]Priority),
+		waitingByItem:  make(map[interface{}]*waitingItem),
+		waitingChanged: make(chan struct{}, 1),
+		stopCh:         make(chan struct{}),
+	}
+	q.cond = sync.NewCond(&q.lock)
+	go q.waitingLoop()
+	return q
+}
+
+type priorityQueue struct {
+	clock       clock.Clock
+	rateLimiter workqueue.RateLimiter
+
+	lock sync.Mutex
+	cond *sync.Cond
+
+	// queues contains the items waiting to be processed for each priority,
+	// in the order that they were added.
+	queues map[Priority][]interface{}
+
+	// dirty contains every item which needs to be processed, along with the
+	// priority it should be processed with.
+	dirty map[interface{}]Priority
+
+	// processing contains the items which are currently being processed,
+	// along with the priority they are being processed with. Items which are
+	// added whilst being processed are only queued once Done is called.
+	processing map[interface{}]Priority
+
+	// waiting contains the items added with AddAfter which are not yet ready
+	// to be queued, ordered by the time they are ready. waitingByItem holds
+	// the entry of each waiting item, so that an item only waits once.
+	waiting       waitingHeap
+	waitingByItem map[interface{}]*waitingItem
+
+	// waitingChanged is signalled when the first item to be ready changes,
+	// so that waitingLoop can reset its timer.
+	waitingChanged chan struct{}
+
+	shuttingDown bool
+	stopCh       chan struct{}
+}
+
+var _ PriorityQueue = &priorityQueue{}
+
+// Add adds an item to the queue with PriorityNormal.
+func (q *priorityQueue) Add(item interface{}) {
+	q.AddWithPriority(item, PriorityNormal)
+}
+
+// AddWithPriority adds an item to the queue with the given priority.
+func (q *priorityQueue) AddWithPriority(item interface{}, priority Priority) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	q.add(item, priority)
+}
+
+// add adds an item to the queue with the given priority. The lock must be
+// held when calling add.
+func (q *priorityQueue) add(item interface{}, priority Priority) {
+	if existing, ok := q.dirty[item]; ok {
+		if priority <= existing {
+			return
+		}
+		q.dirty[item] = priority
+		if _, ok := q.processing[item]; !ok {
+			q.remove(existing, item)
+			q.queues[priority] = append(q.queues[priority], item)
+		}
+		return
+	}
+
+	q.dirty[item] = priority
+	if _, ok := q.processing[item]; ok {
+		return
+	}
+	q.queues[priority] = append(q.queues[priority], item)
+	q.cond.Signal()
+}
+
+// remove removes the item from the queue for the given priority. The lock
+// must be held when calling remove.
+func (q *priorityQueue) remove(priority Priority, item interface{}) {
+	queue := q.queues[priority]
+	for i := range queue {
+		if queue[i] == item {
+			q.queues[priority] = append(queue[:i], queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// Len returns the number of items waiting to be processed.
+func (q *priorityQueue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.len()
+}
+
+func (q *priorityQueue) len() int {
+	n := 0
+	for _, queue := range q.queues {
+		n += len(queue)
+	}
+	return n
+}
+
+// LenWithPriority returns the number of items waiting to be processed with
+// the given priority.
+func (q *priorityQueue) LenWithPriority(priority Priority) int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.queues[priority])
+}
+
+// Get blocks until it can return the item with the highest priority to be
+// processed. If shutdown is true, the caller should end their goroutine. Done
+// must be called with the item once it has been processed.
+func (q *priorityQueue) Get() (item interface{}, shutdown bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for q.len() == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	if q.len() == 0 {
+		// We must be shutting down.
+		return nil, true
+	}
+
+	var highest Priority
+	found := false
+	for priority, queue := range q.queues {
+		if len(queue) > 0 && (!found || priority > highest) {
+			highest, found = priority, true
+		}
+	}
+	queue := q.queues[highest]
+	item, q.queues[highest] = queue[0], queue[1:]
+	q.processing[item] = highest
+	delete(q.dirty, item)
+	return item, false
+}
+
+// Done marks the item as done processing. If it was added again whilst being
+// processed, it is re-queued.
+func (q *priorityQueue) Done(item interface{}) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	delete(q.processing, item)
+	if priority, ok := q.dirty[item]; ok {
+		q.queues[priority] = append(q.queues[priority], item)
+		q.cond.Signal()
+	}
+}
+
+// ShutDown causes Get to return shutdown=true once the queue is empty, and
+// any further additions to be ignored.
+func (q *priorityQueue) ShutDown() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	q.shuttingDown = true
+	close(q.stopCh)
+	q.cond.Broadcast()
+}
+
+func (q *priorityQueue) ShuttingDown() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.shuttingDown
+}
+
+// AddAfter adds an item to the queue once the given duration has passed. If
+// the item is currently being processed, it keeps the priority it is being
+// processed with. Otherwise it is added with PriorityNormal.
+// An item waits to be added at most once: if it is already waiting, it is
+// added at the earlier of the two times, with the higher of the two
+// priorities.
+func (q *priorityQueue) AddAfter(item interface{}, duration time.Duration) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	priority, ok := q.processing[item]
+	if !ok {
+		priority = PriorityNormal
+	}
+
+	if duration <= 0 {
+		q.add(item, priority)
+		return
+	}
+
+	readyAt := q.clock.Now().Add(duration)
+	if existing, ok := q.waitingByItem[item]; ok {
+		if priority > existing.priority {
+			existing.priority = priority
+		}
+		if readyAt.Before(existing.readyAt) {
+			existing.readyAt = readyAt
+			heap.Fix(&q.waiting, existing.index)
+			q.signalWaitingChanged(existing)
+		}
+		return
+	}
+
+	entry := &waitingItem{item: item, priority: priority, readyAt: readyAt}
+	heap.Push(&q.waiting, entry)
+	q.waitingByItem[item] = entry
+	q.signalWaitingChanged(entry)
+}
+
+// signalWaitingChanged wakes up waitingLoop without blocking if the given
+// entry is now the first to be ready, so that the loop resets its timer. A
+// pending signal is enough for the loop to pick up every change.
+func (q *priorityQueue) signalWaitingChanged(entry *waitingItem) {
+	if entry.index != 0 {
+		return
+	}
+	select {
+	case q.waitingChanged <- struct{}{}:
+	default:
+	}
+}
+
+// waitingLoop adds waiting items to the queue once they are ready, using a
+// single timer for the item which is ready first, until the queue is shut
+// down.
+func (q *priorityQueue) waitingLoop() {
+	for {
+		q.lock.Lock()
+		if q.shuttingDown {
+			q.lock.Unlock()
+			return
+		}
+		now := q.clock.Now()
+		for len(q.waiting) > 0 && !q.waiting[0].readyAt.After(now) {
+			entry := heap.Pop(&q.waiting).(*waitingItem)
+			delete(q.waitingByItem, entry.item)
+			q.add(entry.item, entry.priority)
+		}
+
+		var timer clock.Timer
+		var readyCh <-chan time.Time
+		if len(q.waiting) > 0 {
+			timer = q.clock.NewTimer(q.waiting[0].readyAt.Sub(now))
+			readyCh = timer.C()
+		}
+		q.lock.Unlock()
+
+		select {
+		case <-q.stopCh:
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-readyCh:
+		case <-q.waitingChanged:
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+	}
+}
+
+// AddRateLimited adds an item to the queue once the rate limiter says it is
+// ok.
+func (q *priorityQueue) AddRateLimited(item interface{}) {
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+// Forget stops the rate limiter from tracking the item.
+func (q *priorityQueue) Forget(item interface{}) {
+	q.rateLimiter.Forget(item)
+}
+
+// NumRequeues returns how many times the item has been re-queued by the rate
+// limiter.
+func (q *priorityQueue) NumRequeues(item interface{}) int {
+	return q.rateLimiter.NumRequeues(item)
+}
+
+// waitingItem is an item waiting to be added to a priorityQueue.
+type waitingItem struct {
+	item     interface{}
+	priority Priority
+	readyAt  time.Time
+
+	// index is the index of the item in the waitingHeap.
+	index int
+}
+
+// waitingHeap is a heap of waiting items, ordered by the time they are ready.
+type waitingHeap []*waitingItem
+
+func (h waitingHeap) Len() int           { return len(h) }
+func (h waitingHeap) Less(i, j int) bool { return h[i].readyAt.Before(h[j].readyAt) }
+
+func (h waitingHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *waitingHeap) Push(x interface{}) {
+	entry := x.(*waitingItem)
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *waitingHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return entry
+}
+
+// PriorityQueuingEventHandler is an implementation of
+// cache.ResourceEventHandler that queues objects that are
+// added/updated/deleted with the priority returned by PriorityFunc.
+type PriorityQueuingEventHandler struct {
+	Queue PriorityQueue
+
+	// PriorityFunc returns the priority an object should be queued with.
+	PriorityFunc func(obj interface{}) Priority
+}
+
+// Enqueue adds a key for an object to the workqueue.
+func (q *PriorityQueuingEventHandler) Enqueue(obj interface{}) {
+	key, err := KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	q.Queue.AddWithPriority(key, q.PriorityFunc(obj))
+}
+
+// OnAdd adds a newly created object to the workqueue.
+func (q *PriorityQueuingEventHandler) OnAdd(obj interface{}) {
+	q.Enqueue(obj)
+}
+
+// OnUpdate adds an updated object to the workqueue.
+func (q *PriorityQueuingEventHandler) OnUpdate(old, new interface{}) {
+	if reflect.DeepEqual(old, new) {
+		return
+	}
+	q.Enqueue(new)
+}
+
+// OnDelete adds a deleted object to the workqueue for processing.
+func (q *PriorityQueuingEventHandler) OnDelete(obj interface{}) {
+	tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+	if ok {
+		obj = tombstone.Obj
+	}
+	q.Enqueue(obj)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	fakeclock "k8s.io/utils/clock/testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	type addition struct {
+		item     string
+		priority Priority
+	}
+	tests := map[string]struct {
+		additions []addition
+		want      []string
+	}{
+		"items with the same priority are processed in order": {
+			additions: []addition{{"a", PriorityNormal}, {"b", PriorityNormal}, {"c", PriorityNormal}},
+			want:      []string{"a", "b", "c"},
+		},
+		"items with a higher priority are processed first": {
+			additions: []addition{{"a", PriorityNormal}, {"b", PriorityHigh}, {"c", PriorityNormal}, {"d", PriorityHigh}},
+			want:      []string{"b", "d", "a", "c"},
+		},
+		"adding a queued item with a higher priority raises its priority": {
+			additions: []addition{{"a", PriorityNormal}, {"b", PriorityNormal}, {"b", PriorityHigh}},
+			want:      []string{"b", "a"},
+		},
+		"adding a queued item with a lower priority does not lower its priority": {
+			additions: []addition{{"a", PriorityNormal}, {"b", PriorityHigh}, {"b", PriorityNormal}},
+			want:      []string{"b", "a"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			q := NewPriorityQueue(fakeclock.NewFakeClock(time.Now()), workqueue.DefaultControllerRateLimiter())
+			for _, a := range test.additions {
+				q.AddWithPriority(a.item, a.priority)
+			}
+			if q.Len() != len(test.want) {
+				t.Fatalf("expected queue length %d, got %d", len(test.want), q.Len())
+			}
+
+			var got []string
+			for q.Len() > 0 {
+				item, shutdown := q.Get()
+				if shutdown {
+					t.Fatal("unexpected shutdown")
+				}
+				got = append(got, item.(string))
+				q.Done(item)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expected items to be processed in order %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPriorityQueueAddWhileProcessing(t *testing.T) {
+	q := NewPriorityQueue(fakeclock.NewFakeClock(time.Now()), workqueue.DefaultControllerRateLimiter())
+	q.Add("a")
+
+	item, _ := q.Get()
+	q.AddWithPriority("a", PriorityHigh)
+	if q.Len() != 0 {
+		t.Fatalf("expected item being processed not to be queued, got queue length %d", q.Len())
+	}
+
+	q.Done(item)
+	if n := q.LenWithPriority(PriorityHigh); n != 1 {
+		t.Fatalf("expected item to be re-queued with high priority once done, got %d high priority items", n)
+	}
+}
+
+func TestPriorityQueueAddRateLimitedKeepsPriority(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	q := NewPriorityQueue(clock, workqueue.NewItemExponentialFailureRateLimiter(time.Second, time.Minute))
+	defer q.ShutDown()
+
+	q.AddWithPriority("a", PriorityHigh)
+	item, _ := q.Get()
+	q.AddRateLimited(item)
+	q.Done(item)
+	if q.Len() != 0 {
+		t.Fatalf("expected rate limited item not to be queued before its delay, got queue length %d", q.Len())
+	}
+
+	// Wait for the timer to have been registered before stepping the clock.
+	for !clock.HasWaiters() {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Step(time.Second)
+	for q.Len() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if n := q.LenWithPriority(PriorityHigh); n != 1 {
+		t.Errorf("expected rate limited item to keep its high priority, got %d high priority items", n)
+	}
+	if n := q.NumRequeues(item); n != 1 {
+		t.Errorf("expected 1 requeue, got %d", n)
+	}
+}
+
+func TestPriorityQueueShutDown(t *testing.T) {
+	q := NewPriorityQueue(fakeclock.NewFakeClock(time.Now()), workqueue.DefaultControllerRateLimiter())
+	q.Add("a")
+	q.ShutDown()
+
+	// Items already queued are still handed out after shutting down.
+	if item, shutdown := q.Get(); shutdown || item != "a" {
+		t.Fatalf("expected to get queued item after shutdown, got item=%v shutdown=%t", item, shutdown)
+	}
+	q.Add("b")
+	if _, shutdown := q.Get(); !shutdown {
+		t.Errorf("expected Get to signal shutdown once the queue is empty")
+	}
+}
+
+// waitForQueued waits until the queue holds the given number of items with
+// the given priority, as items added with AddAfter are queued asynchronously.
+func waitForQueued(t *testing.T, q PriorityQueue, priority Priority, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for q.LenWithPriority(priority) != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d items queued with %s priority, got %d", n, priority, q.LenWithPriority(priority))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func waitingLen(q PriorityQueue) int {
+	pq := q.(*priorityQueue)
+	pq.lock.Lock()
+	defer pq.lock.Unlock()
+	return len(pq.waiting)
+}
+
+func TestPriorityQueueAddAfterOrdersItems(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	q := NewPriorityQueue(clock, workqueue.DefaultControllerRateLimiter())
+	defer q.ShutDown()
+
+	q.AddAfter("c", 3*time.Second)
+	q.AddAfter("a", time.Second)
+	q.AddAfter("b", 2*time.Second)
+	if n := waitingLen(q); n != 3 {
+		t.Fatalf("expected 3 waiting items, got %d", n)
+	}
+
+	for i, want := range []string{"a", "b", "c"} {
+		clock.Step(time.Second)
+		waitForQueued(t, q, PriorityNormal, 1)
+		item, _ := q.Get()
+		if item != want {
+			t.Errorf("expected item %q to be ready after %ds, got %q", want, i+1, item)
+		}
+		q.Done(item)
+	}
+	if n := waitingLen(q); n != 0 {
+		t.Errorf("expected no waiting items, got %d", n)
+	}
+}
+
+func TestPriorityQueueAddAfterDropsSupersededEntries(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	q := NewPriorityQueue(clock, workqueue.DefaultControllerRateLimiter())
+	defer q.ShutDown()
+
+	q.AddAfter("a", 10*time.Second)
+	q.AddAfter("a", 5*time.Second)
+	q.AddAfter("a", 20*time.Second)
+	if n := waitingLen(q); n != 1 {
+		t.Fatalf("expected an item added several times to wait once, got %d waiting items", n)
+	}
+
+	// The item is added at the earliest of the requested times.
+	clock.Step(5 * time.Second)
+	waitForQueued(t, q, PriorityNormal, 1)
+	if n := waitingLen(q); n != 0 {
+		t.Errorf("expected no waiting items once the item was queued, got %d", n)
+	}
+	item, _ := q.Get()
+	q.Done(item)
+
+	// The superseded entries are not added later.
+	clock.Step(20 * time.Second)
+	time.Sleep(10 * time.Millisecond)
+	if q.Len() != 0 {
+		t.Errorf("expected superseded entries not to be queued, got queue length %d", q.Len())
+	}
+}
+
+func TestPriorityQueueAddAfterKeepsHighestPriority(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	q := NewPriorityQueue(clock, workqueue.DefaultControllerRateLimiter())
+	defer q.ShutDown()
+
+	q.AddWithPriority("a", PriorityHigh)
+	item, _ := q.Get()
+	q.AddAfter(item, 10*time.Second)
+	q.Done(item)
+
+	// Adding the item again once it is no longer processed would add it with
+	// PriorityNormal, but it keeps the priority of its waiting entry.
+	q.AddAfter("a", 5*time.Second)
+	clock.Step(5 * time.Second)
+	waitForQueued(t, q, PriorityHigh, 1)
+	if n := q.LenWithPriority(PriorityNormal); n != 0 {
+		t.Errorf("expected no items with normal priority, got %d", n)
+	}
+}
+
+func TestPriorityQueueShutDownStopsWaiting(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	q := NewPriorityQueue(clock, workqueue.DefaultControllerRateLimiter())
+
+	q.AddAfter("a", time.Second)
+	for !clock.HasWaiters() {
+		time.Sleep(time.Millisecond)
+	}
+	q.ShutDown()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for clock.HasWaiters() {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the timer of waiting items to be stopped on shutdown")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	q.AddAfter("b", time.Second)
+	if n := waitingLen(q); n != 1 {
+		t.Errorf("expected items added after shutting down not to wait, got %d waiting items", n)
+	}
+}
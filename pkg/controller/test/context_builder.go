@@ -41,6 +41,7 @@ import (
 	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
 	informers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
 	"github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	"github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/metrics"
 	"github.com/jetstack/cert-manager/pkg/util"
@@ -67,6 +68,19 @@ type Builder struct {
 	ExpectedEvents     []string
 	StringGenerator    StringGenerator
 
+	// ExpectedStructuredEvents, if not nil, is used in place of
+	// ExpectedEvents to assert on the Events recorded during the test by
+	// their object, type and reason. The message of a recorded Event is only
+	// compared if the expected Event has a non-empty Message.
+	ExpectedStructuredEvents []Event
+
+	// AllowUnregisteredEventReasons disables the check that all Events
+	// recorded during the test use a reason registered in the events
+	// package. This should only be set when a controller forwards a reason
+	// that is not set by cert-manager, e.g. a CertificateRequest condition
+	// reason set by an external issuer.
+	AllowUnregisteredEventReasons bool
+
 	// Clock will be the Clock set on the controller context.
 	// If not specified, the RealClock will be used.
 	Clock *fakeclock.FakeClock
@@ -222,16 +236,55 @@ func (b *Builder) AllReactorsCalled() error {
 	return utilerrors.NewAggregate(errs)
 }
 
+// AllEventsCalled checks that the Events recorded during the test match the
+// expected Events, and that every recorded Event uses a reason registered in
+// the events package.
 func (b *Builder) AllEventsCalled() error {
 	var errs []error
-	if !util.EqualSorted(b.ExpectedEvents, b.Events()) {
+	if b.ExpectedStructuredEvents != nil {
+		if !structuredEventsMatch(b.ExpectedStructuredEvents, b.StructuredEvents()) {
+			errs = append(errs, fmt.Errorf("got unexpected events, exp='%v' got='%v'",
+				b.ExpectedStructuredEvents, b.StructuredEvents()))
+		}
+	} else if !util.EqualSorted(b.ExpectedEvents, b.Events()) {
 		errs = append(errs, fmt.Errorf("got unexpected events, exp='%s' got='%s'",
 			b.ExpectedEvents, b.Events()))
 	}
 
+	for _, e := range b.StructuredEvents() {
+		if !b.AllowUnregisteredEventReasons && !events.IsRegistered(e.Reason) {
+			errs = append(errs, fmt.Errorf("event %q recorded for %s uses a reason that is not registered in the events package", e, e.Object))
+		}
+	}
+
 	return utilerrors.NewAggregate(errs)
 }
 
+// structuredEventsMatch returns true if every recorded Event is matched by
+// exactly one expected Event, regardless of order.
+func structuredEventsMatch(expected, recorded []Event) bool {
+	if len(expected) != len(recorded) {
+		return false
+	}
+
+	matched := make([]bool, len(recorded))
+	for _, exp := range expected {
+		found := false
+		for i, rec := range recorded {
+			if !matched[i] && exp.matches(rec) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
 // AllActionsExecuted skips the "list" and "watch" action verbs.
 func (b *Builder) AllActionsExecuted() error {
 	firedActions := b.FakeCMClient().Actions()
@@ -344,6 +397,16 @@ func (b *Builder) Events() []string {
 	return nil
 }
 
+// StructuredEvents returns the Events recorded during the test along with the
+// object that each was recorded against.
+func (b *Builder) StructuredEvents() []Event {
+	if e, ok := b.Recorder.(*FakeRecorder); ok {
+		return e.StructuredEvents
+	}
+
+	return nil
+}
+
 func mustAllSync(in map[reflect.Type]bool) error {
 	var errs []error
 	for t, started := range in {
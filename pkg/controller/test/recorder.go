@@ -19,28 +19,73 @@ package test
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
+// Event is a structured representation of an Event recorded by the
+// FakeRecorder.
+type Event struct {
+	// Object is the namespace and name of the object that the Event was
+	// recorded against.
+	Object types.NamespacedName
+	// Type is the type of the Event, e.g. Normal or Warning.
+	Type string
+	// Reason is the reason of the Event.
+	Reason string
+	// Message is the message of the Event. When used as an expectation, an
+	// empty Message matches any recorded message.
+	Message string
+}
+
+// String returns the Event in the same "Type Reason Message" format used by
+// FakeRecorder.Events.
+func (e Event) String() string {
+	return fmt.Sprintf("%s %s %s", e.Type, e.Reason, e.Message)
+}
+
+// matches returns true if the recorded Event satisfies the expected Event.
+func (e Event) matches(recorded Event) bool {
+	if e.Object != recorded.Object || e.Type != recorded.Type || e.Reason != recorded.Reason {
+		return false
+	}
+	return len(e.Message) == 0 || e.Message == recorded.Message
+}
+
 // FakeRecorder is used as a fake during tests. It is thread safe. It is usable
 // when created manually and not by NewFakeRecorder, however all events may be
 // thrown away in this case.
 type FakeRecorder struct {
 	Events []string
+
+	// StructuredEvents contains the same Events as Events, along with the
+	// object that each Event was recorded against.
+	StructuredEvents []Event
 }
 
 func (f *FakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
-	f.Events = append(f.Events, fmt.Sprintf("%s %s %s", eventtype, reason, message))
+	f.record(object, eventtype, reason, message)
 }
 
 func (f *FakeRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
-	f.Events = append(f.Events, fmt.Sprintf(eventtype+" "+reason+" "+messageFmt, args...))
+	f.record(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
 }
 
 func (f *FakeRecorder) PastEventf(object runtime.Object, timestamp metav1.Time, eventtype, reason, messageFmt string, args ...interface{}) {
 }
 
 func (f *FakeRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
-	f.Eventf(object, eventtype, reason, messageFmt, args)
+	f.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func (f *FakeRecorder) record(object runtime.Object, eventtype, reason, message string) {
+	e := Event{Type: eventtype, Reason: reason, Message: message}
+	if obj, err := meta.Accessor(object); err == nil {
+		e.Object = types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	}
+
+	f.Events = append(f.Events, e.String())
+	f.StructuredEvents = append(f.StructuredEvents, e)
 }
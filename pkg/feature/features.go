@@ -40,6 +40,20 @@ const (
 	// ExperimentalGatewayAPISupport enables the gateway-shim controller and adds support for
 	// the Gateway API to the HTTP-01 challenge solver.
 	ExperimentalGatewayAPISupport featuregate.Feature = "ExperimentalGatewayAPISupport"
+
+	// alpha: v1.6.0
+	//
+	// ValidationRulesetV2 makes the webhook enforce the v2 validation ruleset.
+	// Until it is enabled, resources which violate the ruleset are admitted
+	// with warnings.
+	ValidationRulesetV2 featuregate.Feature = "ValidationRulesetV2"
+
+	// alpha: v1.6.0
+	//
+	// SealedPrivateKeys enables storing the private keys of Certificates
+	// annotated with `cert-manager.io/private-key-kms-plugin` encrypted with
+	// a KMS envelope.
+	SealedPrivateKeys featuregate.Feature = "SealedPrivateKeys"
 )
 
 func init() {
@@ -53,4 +67,6 @@ var defaultCertManagerFeatureGates = map[featuregate.Feature]featuregate.Feature
 	ValidateCAA: {Default: false, PreRelease: featuregate.Alpha},
 	ExperimentalCertificateSigningRequestControllers: {Default: false, PreRelease: featuregate.Alpha},
 	ExperimentalGatewayAPISupport:                    {Default: false, PreRelease: featuregate.Alpha},
+	ValidationRulesetV2:                              {Default: false, PreRelease: featuregate.Alpha},
+	SealedPrivateKeys:                                {Default: false, PreRelease: featuregate.Alpha},
 }
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/component-base/featuregate"
+
+	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
+)
+
+// Ruleset is a versioned set of validations which are stricter than those of
+// the API version they apply to. Tightening validation would otherwise start
+// rejecting resources which were previously accepted, so the validations of a
+// ruleset are only returned as warnings until the feature gate of the
+// ruleset is enabled.
+type Ruleset struct {
+	// Version of the ruleset, which is included in warnings.
+	Version string
+
+	// Feature is the feature gate which enforces the ruleset.
+	Feature featuregate.Feature
+}
+
+// Enforced returns true if violations of the ruleset are returned as errors.
+func (r Ruleset) Enforced() bool {
+	return utilfeature.DefaultFeatureGate.Enabled(r.Feature)
+}
+
+// Apply returns the given violations of the ruleset as errors if the ruleset
+// is enforced, and as warnings otherwise.
+func (r Ruleset) Apply(el field.ErrorList) (field.ErrorList, WarningList) {
+	if len(el) == 0 {
+		return nil, nil
+	}
+	if r.Enforced() {
+		return el, nil
+	}
+
+	warnings := make(WarningList, 0, len(el))
+	for _, err := range el {
+		warnings = append(warnings, fmt.Sprintf("%s (this will be rejected by validation ruleset %s, which is enforced by the %s feature gate)", err.Error(), r.Version, r.Feature))
+	}
+	return nil, warnings
+}
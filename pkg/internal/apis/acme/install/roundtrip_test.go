@@ -21,9 +21,14 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
 
+	apitesting "github.com/jetstack/cert-manager/pkg/api/testing"
 	acmefuzzer "github.com/jetstack/cert-manager/pkg/internal/apis/acme/fuzzer"
 )
 
 func TestRoundTripTypes(t *testing.T) {
 	roundtrip.RoundTripTestForAPIGroup(t, Install, acmefuzzer.Funcs)
 }
+
+func TestRoundTripExternalVersions(t *testing.T) {
+	apitesting.RoundTripExternalVersions(t, Install, acmefuzzer.Funcs)
+}
@@ -128,4 +128,24 @@ type ChallengeStatus struct {
 	// State contains the current 'state' of the challenge.
 	// If not set, the state of the challenge is unknown.
 	State State
+
+	// Details of the acme-dns account used to present the challenge. Only
+	// set for challenges solved with the acme-dns DNS01 provider.
+	AcmeDNS *ACMEDNSAccountStatus
+
+	// Problem is the problem document returned by the ACME server when the
+	// challenge or its authorization failed.
+	Problem *ACMEProblem
+}
+
+// ACMEDNSAccountStatus describes the acme-dns account used to present a
+// challenge.
+type ACMEDNSAccountStatus struct {
+	// The full domain of the account. The _acme-challenge record of the
+	// domain being validated must be a CNAME pointing at it.
+	FullDomain string
+
+	// True if the account was registered by cert-manager and stored in the
+	// account secret.
+	Registered bool
 }
@@ -57,6 +57,21 @@ type ACMEIssuer struct {
 	// Defaults to false.
 	SkipTLSVerify bool
 
+	// CABundle is a PEM encoded bundle of CA certificates used to validate
+	// the TLS certificate of the ACME server, for private ACME servers whose
+	// certificate is not issued by a publicly trusted CA. If not set the
+	// system root certificates are used. Mutually exclusive with
+	// SkipTLSVerify.
+	CABundle []byte
+
+	// ClientCertificateSecretRef is a reference to a kubernetes.io/tls Secret
+	// containing a client certificate and private key to present to the ACME
+	// server, for private ACME servers which require mutual TLS.
+	// The Secret is read whenever a new connection is made to the ACME
+	// server, so renewed client certificates are used without changes to the
+	// issuer.
+	ClientCertificateSecretRef *cmmeta.LocalObjectReference
+
 	// ExternalAccountBinding is a reference to a CA external account of the ACME
 	// server.
 	// If set, upon registration cert-manager will attempt to associate the given
@@ -127,6 +142,12 @@ const (
 // Configures an issuer to solve challenges using the specified options.
 // Only one of HTTP01 or DNS01 may be provided.
 type ACMEChallengeSolver struct {
+	// Name of the solver. It must be unique within the list of solvers of
+	// the issuer. A Certificate can pin this solver for all of its DNS names,
+	// bypassing selector based matching, by setting the
+	// `acme.cert-manager.io/solver-name` annotation to this name.
+	Name string
+
 	// Selector selects a set of DNSNames on the Certificate resource that
 	// should be solved using this challenge solver.
 	// If not specified, the solver will be treated as the 'default' solver
@@ -220,6 +241,24 @@ type ACMEChallengeSolverHTTP01Ingress struct {
 	// Optional ingress template used to configure the ACME challenge solver
 	// ingress used for HTTP01 challenges
 	IngressTemplate *ACMEChallengeSolverHTTP01IngressTemplate
+
+	// If set, challenges are solved by a long-lived solver Deployment which
+	// is shared by all Challenges in the namespace that use this solver
+	// configuration, rather than by a pod and service created for each
+	// Challenge. The keys of Challenges are registered with the shared
+	// solver through a ConfigMap. An Ingress is still created for each
+	// Challenge, unless 'name' is set.
+	SharedSolver *ACMEChallengeSolverHTTP01SharedSolver
+}
+
+// ACMEChallengeSolverHTTP01SharedSolver configures the long-lived challenge
+// solver Deployment shared by the Challenges which use an HTTP01 ingress
+// solver.
+type ACMEChallengeSolverHTTP01SharedSolver struct {
+	// Replicas is the number of pods of the shared solver Deployment.
+	// Defaults to 1. Leave unset if the Deployment is scaled by a
+	// HorizontalPodAutoscaler.
+	Replicas *int32
 }
 
 type ACMEChallengeSolverHTTP01GatewayHTTPRoute struct {
@@ -319,6 +358,18 @@ type ACMEChallengeSolverDNS01 struct {
 	// Use the DigitalOcean DNS API to manage DNS01 challenge records.
 	DigitalOcean *ACMEIssuerDNS01ProviderDigitalOcean
 
+	// Use the OVH API to manage DNS01 challenge records.
+	OVH *ACMEIssuerDNS01ProviderOVH
+
+	// Use the Gandi LiveDNS API to manage DNS01 challenge records.
+	Gandi *ACMEIssuerDNS01ProviderGandi
+
+	// Use the Infoblox WAPI to manage DNS01 challenge records.
+	Infoblox *ACMEIssuerDNS01ProviderInfoblox
+
+	// Use the BlueCat Address Manager API to manage DNS01 challenge records.
+	BlueCat *ACMEIssuerDNS01ProviderBlueCat
+
 	// Use the 'ACME DNS' (https://github.com/joohoi/acme-dns) API to manage
 	// DNS01 challenge records.
 	AcmeDNS *ACMEIssuerDNS01ProviderAcmeDNS
@@ -347,6 +398,8 @@ const (
 	// This is useful if you do not want to grant cert-manager access to your
 	// root DNS zone, and instead delegate the _acme-challenge.example.com
 	// subdomain to some other, less privileged domain.
+	// The dnsZones selectors of solvers using this strategy are also matched
+	// against the name the CNAMEs point to.
 	FollowStrategy = "Follow"
 )
 
@@ -369,7 +422,8 @@ type ACMEIssuerDNS01ProviderCloudDNS struct {
 
 // ACMEIssuerDNS01ProviderCloudflare is a structure containing the DNS
 // configuration for Cloudflare.
-// One of `apiKeySecretRef` or `apiTokenSecretRef` must be provided.
+// One of `apiKeySecretRef`, `apiTokenSecretRef` or `zoneAPITokenSecretRefs`
+// must be provided.
 type ACMEIssuerDNS01ProviderCloudflare struct {
 	// Email of the account, only required when using API key based authentication.
 	Email string
@@ -381,6 +435,12 @@ type ACMEIssuerDNS01ProviderCloudflare struct {
 
 	// API token used to authenticate with Cloudflare.
 	APIToken *cmmeta.SecretKeySelector
+
+	// API tokens used to authenticate with Cloudflare, keyed by the DNS zone
+	// they are scoped to. The token of the longest zone containing the
+	// challenge record is used, falling back to apiTokenSecretRef or
+	// apiKeySecretRef if no zone matches.
+	ZoneAPITokens map[string]cmmeta.SecretKeySelector
 }
 
 // ACMEIssuerDNS01ProviderDigitalOcean is a structure containing the DNS
@@ -389,6 +449,92 @@ type ACMEIssuerDNS01ProviderDigitalOcean struct {
 	Token cmmeta.SecretKeySelector
 }
 
+// ACMEIssuerDNS01ProviderOVH is a structure containing the DNS
+// configuration for OVH.
+type ACMEIssuerDNS01ProviderOVH struct {
+	// Endpoint of the OVH API. Either the name of an OVH API region
+	// (`ovh-eu`, `ovh-ca`, `ovh-us`, `kimsufi-eu`, `kimsufi-ca`,
+	// `soyoustart-eu` or `soyoustart-ca`) or the URL of the API.
+	// Defaults to `ovh-eu`.
+	Endpoint string
+
+	// Application key of the OVH API application.
+	ApplicationKey string
+
+	// Application secret of the OVH API application.
+	ApplicationSecret cmmeta.SecretKeySelector
+
+	// Consumer key authorizing the OVH API application to manage the DNS
+	// zones of the account.
+	ConsumerKey cmmeta.SecretKeySelector
+}
+
+// ACMEIssuerDNS01ProviderGandi is a structure containing the DNS
+// configuration for Gandi LiveDNS.
+type ACMEIssuerDNS01ProviderGandi struct {
+	// Personal access token used to authenticate with the Gandi API. The
+	// token must be allowed to manage the technical configuration of the
+	// domains.
+	PersonalAccessToken cmmeta.SecretKeySelector
+}
+
+// ACMEIssuerDNS01ProviderInfoblox is a structure containing the DNS
+// configuration for the Infoblox WAPI.
+type ACMEIssuerDNS01ProviderInfoblox struct {
+	// URL of the Infoblox Grid Manager, for example `https://gm.example.com`.
+	Server string
+
+	// Version of the WAPI. Defaults to `2.10`.
+	WAPIVersion string
+
+	// Username used to authenticate with the WAPI.
+	Username string
+
+	// Password used to authenticate with the WAPI.
+	Password cmmeta.SecretKeySelector
+
+	// DNS view in which challenge records are created. Defaults to
+	// `default`.
+	View string
+
+	// TTL of challenge records in seconds. Defaults to 60.
+	TTL int32
+
+	// PEM encoded CA bundle used to validate the certificate of the Grid
+	// Manager. If not set, the system trust store is used.
+	CABundle []byte
+}
+
+// ACMEIssuerDNS01ProviderBlueCat is a structure containing the DNS
+// configuration for BlueCat Address Manager.
+type ACMEIssuerDNS01ProviderBlueCat struct {
+	// URL of BlueCat Address Manager, for example `https://bam.example.com`.
+	Server string
+
+	// Username of the API user used to authenticate with Address Manager.
+	Username string
+
+	// Password of the API user used to authenticate with Address Manager.
+	Password cmmeta.SecretKeySelector
+
+	// Name of the configuration containing the DNS view.
+	Configuration string
+
+	// Name of the DNS view in which challenge records are created.
+	View string
+
+	// Name of the zone in which challenge records are created. If not set,
+	// the deepest zone of the view containing the challenge record is used.
+	Zone string
+
+	// TTL of challenge records in seconds. Defaults to 60.
+	TTL int32
+
+	// PEM encoded CA bundle used to validate the certificate of Address
+	// Manager. If not set, the system trust store is used.
+	CABundle []byte
+}
+
 // ACMEIssuerDNS01ProviderRoute53 is a structure containing the Route 53
 // configuration for AWS
 type ACMEIssuerDNS01ProviderRoute53 struct {
@@ -455,6 +601,14 @@ type ACMEIssuerDNS01ProviderAcmeDNS struct {
 	Host string
 
 	AccountSecret cmmeta.SecretKeySelector
+
+	// If true, an account is registered with the acme-dns server for each
+	// domain that has no account in the account secret, and is stored back
+	// into the account secret. A CNAME record pointing the _acme-challenge
+	// record of the domain at the full domain of the account must be created
+	// for the challenge to succeed; it is reported in the status of the
+	// Challenge. The account secret must exist, but the key may be missing.
+	AllowRegistration bool
 }
 
 // ACMEIssuerDNS01ProviderRFC2136 is a structure containing the
@@ -515,4 +669,28 @@ type ACMEIssuerStatus struct {
 	// ACME account, in order to track changes made to registered account
 	// associated with the  Issuer
 	LastRegisteredEmail string
+
+	// Directory is the metadata published in the directory of the ACME
+	// server, as observed when the Issuer was last set up.
+	Directory *ACMEDirectoryMetadata
+}
+
+// ACMEDirectoryMetadata is the metadata published in the directory of an ACME
+// server, as defined in RFC 8555 section 7.1.1.
+type ACMEDirectoryMetadata struct {
+	// TermsOfService is the URL of the current terms of service of the ACME
+	// server.
+	TermsOfService string
+
+	// Website is the URL of a website providing more information about the
+	// ACME server.
+	Website string
+
+	// CAAIdentities are the hostnames that the ACME server recognises as
+	// referring to itself for the purposes of CAA record validation.
+	CAAIdentities []string
+
+	// ExternalAccountRequired indicates that the ACME server requires an
+	// External Account Binding to register new accounts.
+	ExternalAccountRequired bool
 }
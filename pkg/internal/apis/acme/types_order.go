@@ -109,6 +109,20 @@ type OrderStatus struct {
 	// FailureTime stores the time that this order failed.
 	// This is used to influence garbage collection and back-off.
 	FailureTime *metav1.Time
+
+	// AuthorizationRetries is the number of times the order has been
+	// resubmitted to the ACME server after one of its authorizations failed.
+	AuthorizationRetries int
+
+	// Problem is the problem document returned by the ACME server when the
+	// order was rejected or became invalid.
+	Problem *ACMEProblem
+
+	// FailedAuthorizations records the authorizations of this Order which
+	// failed, including those of ACME orders which were replaced when the
+	// Order was resubmitted after a failed authorization. Only the most
+	// recent failures are kept.
+	FailedAuthorizations []ACMEAuthorizationFailure
 }
 
 // ACMEAuthorization contains data returned from the ACME server on an
@@ -166,6 +180,55 @@ type ACMEChallenge struct {
 	Type string
 }
 
+// ACMEProblem is a problem document (RFC 7807) returned by the ACME server,
+// either in response to a request or on an object which has become invalid.
+type ACMEProblem struct {
+	// Type is a URI reference identifying the type of the problem, usually
+	// of the form 'urn:ietf:params:acme:error:<type>'.
+	Type string
+
+	// Detail is a human readable explanation of this occurrence of the
+	// problem.
+	Detail string
+
+	// Status is the HTTP status code of the response which carried the
+	// problem document, if any.
+	Status int
+
+	// Instance is a URL identifying this occurrence of the problem. Some
+	// problem types use it to point at a page the user must visit before the
+	// request can succeed.
+	Instance string
+}
+
+// ACMEAuthorizationFailure records an authorization of an Order which could
+// not be completed.
+type ACMEAuthorizationFailure struct {
+	// URL is the URL of the challenge which was attempted to complete the
+	// authorization.
+	URL string
+
+	// Identifier is the DNS name which failed to be validated.
+	Identifier string
+
+	// Wildcard will be true if the authorization was for a wildcard DNS name.
+	Wildcard bool
+
+	// Type is the type of the challenge which was attempted, e.g. 'http-01'
+	// or 'dns-01'.
+	Type string
+
+	// Reason is the reason recorded on the Challenge when it failed.
+	Reason string
+
+	// Problem is the problem document returned by the ACME server for the
+	// failed challenge, if any.
+	Problem *ACMEProblem
+
+	// Time is the time at which the failure was observed.
+	Time metav1.Time
+}
+
 // State represents the state of an ACME resource, such as an Order.
 // The possible options here map to the corresponding values in the
 // ACME specification.
@@ -52,6 +52,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEAuthorizationFailure)(nil), (*acme.ACMEAuthorizationFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEAuthorizationFailure_To_acme_ACMEAuthorizationFailure(a.(*v1alpha2.ACMEAuthorizationFailure), b.(*acme.ACMEAuthorizationFailure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEAuthorizationFailure)(nil), (*v1alpha2.ACMEAuthorizationFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEAuthorizationFailure_To_v1alpha2_ACMEAuthorizationFailure(a.(*acme.ACMEAuthorizationFailure), b.(*v1alpha2.ACMEAuthorizationFailure), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEChallenge)(nil), (*acme.ACMEChallenge)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ACMEChallenge_To_acme_ACMEChallenge(a.(*v1alpha2.ACMEChallenge), b.(*acme.ACMEChallenge), scope)
 	}); err != nil {
@@ -162,6 +172,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEChallengeSolverHTTP01SharedSolver)(nil), (*acme.ACMEChallengeSolverHTTP01SharedSolver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver_To_acme_ACMEChallengeSolverHTTP01SharedSolver(a.(*v1alpha2.ACMEChallengeSolverHTTP01SharedSolver), b.(*acme.ACMEChallengeSolverHTTP01SharedSolver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverHTTP01SharedSolver)(nil), (*v1alpha2.ACMEChallengeSolverHTTP01SharedSolver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverHTTP01SharedSolver_To_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver(a.(*acme.ACMEChallengeSolverHTTP01SharedSolver), b.(*v1alpha2.ACMEChallengeSolverHTTP01SharedSolver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEDNSAccountStatus)(nil), (*acme.ACMEDNSAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEDNSAccountStatus_To_acme_ACMEDNSAccountStatus(a.(*v1alpha2.ACMEDNSAccountStatus), b.(*acme.ACMEDNSAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEDNSAccountStatus)(nil), (*v1alpha2.ACMEDNSAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEDNSAccountStatus_To_v1alpha2_ACMEDNSAccountStatus(a.(*acme.ACMEDNSAccountStatus), b.(*v1alpha2.ACMEDNSAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEDirectoryMetadata)(nil), (*acme.ACMEDirectoryMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEDirectoryMetadata_To_acme_ACMEDirectoryMetadata(a.(*v1alpha2.ACMEDirectoryMetadata), b.(*acme.ACMEDirectoryMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEDirectoryMetadata)(nil), (*v1alpha2.ACMEDirectoryMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEDirectoryMetadata_To_v1alpha2_ACMEDirectoryMetadata(a.(*acme.ACMEDirectoryMetadata), b.(*v1alpha2.ACMEDirectoryMetadata), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEExternalAccountBinding)(nil), (*acme.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(a.(*v1alpha2.ACMEExternalAccountBinding), b.(*acme.ACMEExternalAccountBinding), scope)
 	}); err != nil {
@@ -212,6 +252,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderBlueCat)(nil), (*acme.ACMEIssuerDNS01ProviderBlueCat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEIssuerDNS01ProviderBlueCat_To_acme_ACMEIssuerDNS01ProviderBlueCat(a.(*v1alpha2.ACMEIssuerDNS01ProviderBlueCat), b.(*acme.ACMEIssuerDNS01ProviderBlueCat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderBlueCat)(nil), (*v1alpha2.ACMEIssuerDNS01ProviderBlueCat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderBlueCat_To_v1alpha2_ACMEIssuerDNS01ProviderBlueCat(a.(*acme.ACMEIssuerDNS01ProviderBlueCat), b.(*v1alpha2.ACMEIssuerDNS01ProviderBlueCat), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderCloudDNS)(nil), (*acme.ACMEIssuerDNS01ProviderCloudDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ACMEIssuerDNS01ProviderCloudDNS_To_acme_ACMEIssuerDNS01ProviderCloudDNS(a.(*v1alpha2.ACMEIssuerDNS01ProviderCloudDNS), b.(*acme.ACMEIssuerDNS01ProviderCloudDNS), scope)
 	}); err != nil {
@@ -242,6 +292,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderGandi)(nil), (*acme.ACMEIssuerDNS01ProviderGandi)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(a.(*v1alpha2.ACMEIssuerDNS01ProviderGandi), b.(*acme.ACMEIssuerDNS01ProviderGandi), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderGandi)(nil), (*v1alpha2.ACMEIssuerDNS01ProviderGandi)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha2_ACMEIssuerDNS01ProviderGandi(a.(*acme.ACMEIssuerDNS01ProviderGandi), b.(*v1alpha2.ACMEIssuerDNS01ProviderGandi), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderInfoblox)(nil), (*acme.ACMEIssuerDNS01ProviderInfoblox)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEIssuerDNS01ProviderInfoblox_To_acme_ACMEIssuerDNS01ProviderInfoblox(a.(*v1alpha2.ACMEIssuerDNS01ProviderInfoblox), b.(*acme.ACMEIssuerDNS01ProviderInfoblox), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderInfoblox)(nil), (*v1alpha2.ACMEIssuerDNS01ProviderInfoblox)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderInfoblox_To_v1alpha2_ACMEIssuerDNS01ProviderInfoblox(a.(*acme.ACMEIssuerDNS01ProviderInfoblox), b.(*v1alpha2.ACMEIssuerDNS01ProviderInfoblox), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderOVH)(nil), (*acme.ACMEIssuerDNS01ProviderOVH)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEIssuerDNS01ProviderOVH_To_acme_ACMEIssuerDNS01ProviderOVH(a.(*v1alpha2.ACMEIssuerDNS01ProviderOVH), b.(*acme.ACMEIssuerDNS01ProviderOVH), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderOVH)(nil), (*v1alpha2.ACMEIssuerDNS01ProviderOVH)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderOVH_To_v1alpha2_ACMEIssuerDNS01ProviderOVH(a.(*acme.ACMEIssuerDNS01ProviderOVH), b.(*v1alpha2.ACMEIssuerDNS01ProviderOVH), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderRFC2136)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(a.(*v1alpha2.ACMEIssuerDNS01ProviderRFC2136), b.(*acme.ACMEIssuerDNS01ProviderRFC2136), scope)
 	}); err != nil {
@@ -282,6 +362,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEProblem)(nil), (*acme.ACMEProblem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEProblem_To_acme_ACMEProblem(a.(*v1alpha2.ACMEProblem), b.(*acme.ACMEProblem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEProblem)(nil), (*v1alpha2.ACMEProblem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEProblem_To_v1alpha2_ACMEProblem(a.(*acme.ACMEProblem), b.(*v1alpha2.ACMEProblem), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha2.AzureManagedIdentity)(nil), (*acme.AzureManagedIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AzureManagedIdentity_To_acme_AzureManagedIdentity(a.(*v1alpha2.AzureManagedIdentity), b.(*acme.AzureManagedIdentity), scope)
 	}); err != nil {
@@ -413,6 +503,38 @@ func Convert_acme_ACMEAuthorization_To_v1alpha2_ACMEAuthorization(in *acme.ACMEA
 	return autoConvert_acme_ACMEAuthorization_To_v1alpha2_ACMEAuthorization(in, out, s)
 }
 
+func autoConvert_v1alpha2_ACMEAuthorizationFailure_To_acme_ACMEAuthorizationFailure(in *v1alpha2.ACMEAuthorizationFailure, out *acme.ACMEAuthorizationFailure, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Identifier = in.Identifier
+	out.Wildcard = in.Wildcard
+	out.Type = in.Type
+	out.Reason = in.Reason
+	out.Problem = (*acme.ACMEProblem)(unsafe.Pointer(in.Problem))
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1alpha2_ACMEAuthorizationFailure_To_acme_ACMEAuthorizationFailure is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEAuthorizationFailure_To_acme_ACMEAuthorizationFailure(in *v1alpha2.ACMEAuthorizationFailure, out *acme.ACMEAuthorizationFailure, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEAuthorizationFailure_To_acme_ACMEAuthorizationFailure(in, out, s)
+}
+
+func autoConvert_acme_ACMEAuthorizationFailure_To_v1alpha2_ACMEAuthorizationFailure(in *acme.ACMEAuthorizationFailure, out *v1alpha2.ACMEAuthorizationFailure, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Identifier = in.Identifier
+	out.Wildcard = in.Wildcard
+	out.Type = in.Type
+	out.Reason = in.Reason
+	out.Problem = (*v1alpha2.ACMEProblem)(unsafe.Pointer(in.Problem))
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_acme_ACMEAuthorizationFailure_To_v1alpha2_ACMEAuthorizationFailure is an autogenerated conversion function.
+func Convert_acme_ACMEAuthorizationFailure_To_v1alpha2_ACMEAuthorizationFailure(in *acme.ACMEAuthorizationFailure, out *v1alpha2.ACMEAuthorizationFailure, s conversion.Scope) error {
+	return autoConvert_acme_ACMEAuthorizationFailure_To_v1alpha2_ACMEAuthorizationFailure(in, out, s)
+}
+
 func autoConvert_v1alpha2_ACMEChallenge_To_acme_ACMEChallenge(in *v1alpha2.ACMEChallenge, out *acme.ACMEChallenge, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Token = in.Token
@@ -438,6 +560,7 @@ func Convert_acme_ACMEChallenge_To_v1alpha2_ACMEChallenge(in *acme.ACMEChallenge
 }
 
 func autoConvert_v1alpha2_ACMEChallengeSolver_To_acme_ACMEChallengeSolver(in *v1alpha2.ACMEChallengeSolver, out *acme.ACMEChallengeSolver, s conversion.Scope) error {
+	out.Name = in.Name
 	out.Selector = (*acme.CertificateDNSNameSelector)(unsafe.Pointer(in.Selector))
 	out.HTTP01 = (*acme.ACMEChallengeSolverHTTP01)(unsafe.Pointer(in.HTTP01))
 	if in.DNS01 != nil {
@@ -458,6 +581,7 @@ func Convert_v1alpha2_ACMEChallengeSolver_To_acme_ACMEChallengeSolver(in *v1alph
 }
 
 func autoConvert_acme_ACMEChallengeSolver_To_v1alpha2_ACMEChallengeSolver(in *acme.ACMEChallengeSolver, out *v1alpha2.ACMEChallengeSolver, s conversion.Scope) error {
+	out.Name = in.Name
 	out.Selector = (*v1alpha2.CertificateDNSNameSelector)(unsafe.Pointer(in.Selector))
 	out.HTTP01 = (*v1alpha2.ACMEChallengeSolverHTTP01)(unsafe.Pointer(in.HTTP01))
 	if in.DNS01 != nil {
@@ -533,6 +657,42 @@ func autoConvert_v1alpha2_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDN
 	} else {
 		out.DigitalOcean = nil
 	}
+	if in.OVH != nil {
+		in, out := &in.OVH, &out.OVH
+		*out = new(acme.ACMEIssuerDNS01ProviderOVH)
+		if err := Convert_v1alpha2_ACMEIssuerDNS01ProviderOVH_To_acme_ACMEIssuerDNS01ProviderOVH(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OVH = nil
+	}
+	if in.Gandi != nil {
+		in, out := &in.Gandi, &out.Gandi
+		*out = new(acme.ACMEIssuerDNS01ProviderGandi)
+		if err := Convert_v1alpha2_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Gandi = nil
+	}
+	if in.Infoblox != nil {
+		in, out := &in.Infoblox, &out.Infoblox
+		*out = new(acme.ACMEIssuerDNS01ProviderInfoblox)
+		if err := Convert_v1alpha2_ACMEIssuerDNS01ProviderInfoblox_To_acme_ACMEIssuerDNS01ProviderInfoblox(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Infoblox = nil
+	}
+	if in.BlueCat != nil {
+		in, out := &in.BlueCat, &out.BlueCat
+		*out = new(acme.ACMEIssuerDNS01ProviderBlueCat)
+		if err := Convert_v1alpha2_ACMEIssuerDNS01ProviderBlueCat_To_acme_ACMEIssuerDNS01ProviderBlueCat(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueCat = nil
+	}
 	if in.AcmeDNS != nil {
 		in, out := &in.AcmeDNS, &out.AcmeDNS
 		*out = new(acme.ACMEIssuerDNS01ProviderAcmeDNS)
@@ -616,6 +776,42 @@ func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1alpha2_ACMEChallengeSolverDN
 	} else {
 		out.DigitalOcean = nil
 	}
+	if in.OVH != nil {
+		in, out := &in.OVH, &out.OVH
+		*out = new(v1alpha2.ACMEIssuerDNS01ProviderOVH)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderOVH_To_v1alpha2_ACMEIssuerDNS01ProviderOVH(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OVH = nil
+	}
+	if in.Gandi != nil {
+		in, out := &in.Gandi, &out.Gandi
+		*out = new(v1alpha2.ACMEIssuerDNS01ProviderGandi)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha2_ACMEIssuerDNS01ProviderGandi(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Gandi = nil
+	}
+	if in.Infoblox != nil {
+		in, out := &in.Infoblox, &out.Infoblox
+		*out = new(v1alpha2.ACMEIssuerDNS01ProviderInfoblox)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderInfoblox_To_v1alpha2_ACMEIssuerDNS01ProviderInfoblox(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Infoblox = nil
+	}
+	if in.BlueCat != nil {
+		in, out := &in.BlueCat, &out.BlueCat
+		*out = new(v1alpha2.ACMEIssuerDNS01ProviderBlueCat)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderBlueCat_To_v1alpha2_ACMEIssuerDNS01ProviderBlueCat(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueCat = nil
+	}
 	if in.AcmeDNS != nil {
 		in, out := &in.AcmeDNS, &out.AcmeDNS
 		*out = new(v1alpha2.ACMEIssuerDNS01ProviderAcmeDNS)
@@ -693,6 +889,7 @@ func autoConvert_v1alpha2_ACMEChallengeSolverHTTP01Ingress_To_acme_ACMEChallenge
 	out.Name = in.Name
 	out.PodTemplate = (*acme.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
 	out.IngressTemplate = (*acme.ACMEChallengeSolverHTTP01IngressTemplate)(unsafe.Pointer(in.IngressTemplate))
+	out.SharedSolver = (*acme.ACMEChallengeSolverHTTP01SharedSolver)(unsafe.Pointer(in.SharedSolver))
 	return nil
 }
 
@@ -707,6 +904,7 @@ func autoConvert_acme_ACMEChallengeSolverHTTP01Ingress_To_v1alpha2_ACMEChallenge
 	out.Name = in.Name
 	out.PodTemplate = (*v1alpha2.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
 	out.IngressTemplate = (*v1alpha2.ACMEChallengeSolverHTTP01IngressTemplate)(unsafe.Pointer(in.IngressTemplate))
+	out.SharedSolver = (*v1alpha2.ACMEChallengeSolverHTTP01SharedSolver)(unsafe.Pointer(in.SharedSolver))
 	return nil
 }
 
@@ -841,6 +1039,74 @@ func Convert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1alpha2_ACMEChall
 	return autoConvert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1alpha2_ACMEChallengeSolverHTTP01IngressTemplate(in, out, s)
 }
 
+func autoConvert_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver_To_acme_ACMEChallengeSolverHTTP01SharedSolver(in *v1alpha2.ACMEChallengeSolverHTTP01SharedSolver, out *acme.ACMEChallengeSolverHTTP01SharedSolver, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	return nil
+}
+
+// Convert_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver_To_acme_ACMEChallengeSolverHTTP01SharedSolver is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver_To_acme_ACMEChallengeSolverHTTP01SharedSolver(in *v1alpha2.ACMEChallengeSolverHTTP01SharedSolver, out *acme.ACMEChallengeSolverHTTP01SharedSolver, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver_To_acme_ACMEChallengeSolverHTTP01SharedSolver(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverHTTP01SharedSolver_To_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver(in *acme.ACMEChallengeSolverHTTP01SharedSolver, out *v1alpha2.ACMEChallengeSolverHTTP01SharedSolver, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverHTTP01SharedSolver_To_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverHTTP01SharedSolver_To_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver(in *acme.ACMEChallengeSolverHTTP01SharedSolver, out *v1alpha2.ACMEChallengeSolverHTTP01SharedSolver, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverHTTP01SharedSolver_To_v1alpha2_ACMEChallengeSolverHTTP01SharedSolver(in, out, s)
+}
+
+func autoConvert_v1alpha2_ACMEDNSAccountStatus_To_acme_ACMEDNSAccountStatus(in *v1alpha2.ACMEDNSAccountStatus, out *acme.ACMEDNSAccountStatus, s conversion.Scope) error {
+	out.FullDomain = in.FullDomain
+	out.Registered = in.Registered
+	return nil
+}
+
+// Convert_v1alpha2_ACMEDNSAccountStatus_To_acme_ACMEDNSAccountStatus is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEDNSAccountStatus_To_acme_ACMEDNSAccountStatus(in *v1alpha2.ACMEDNSAccountStatus, out *acme.ACMEDNSAccountStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEDNSAccountStatus_To_acme_ACMEDNSAccountStatus(in, out, s)
+}
+
+func autoConvert_acme_ACMEDNSAccountStatus_To_v1alpha2_ACMEDNSAccountStatus(in *acme.ACMEDNSAccountStatus, out *v1alpha2.ACMEDNSAccountStatus, s conversion.Scope) error {
+	out.FullDomain = in.FullDomain
+	out.Registered = in.Registered
+	return nil
+}
+
+// Convert_acme_ACMEDNSAccountStatus_To_v1alpha2_ACMEDNSAccountStatus is an autogenerated conversion function.
+func Convert_acme_ACMEDNSAccountStatus_To_v1alpha2_ACMEDNSAccountStatus(in *acme.ACMEDNSAccountStatus, out *v1alpha2.ACMEDNSAccountStatus, s conversion.Scope) error {
+	return autoConvert_acme_ACMEDNSAccountStatus_To_v1alpha2_ACMEDNSAccountStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_ACMEDirectoryMetadata_To_acme_ACMEDirectoryMetadata(in *v1alpha2.ACMEDirectoryMetadata, out *acme.ACMEDirectoryMetadata, s conversion.Scope) error {
+	out.TermsOfService = in.TermsOfService
+	out.Website = in.Website
+	out.CAAIdentities = *(*[]string)(unsafe.Pointer(&in.CAAIdentities))
+	out.ExternalAccountRequired = in.ExternalAccountRequired
+	return nil
+}
+
+// Convert_v1alpha2_ACMEDirectoryMetadata_To_acme_ACMEDirectoryMetadata is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEDirectoryMetadata_To_acme_ACMEDirectoryMetadata(in *v1alpha2.ACMEDirectoryMetadata, out *acme.ACMEDirectoryMetadata, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEDirectoryMetadata_To_acme_ACMEDirectoryMetadata(in, out, s)
+}
+
+func autoConvert_acme_ACMEDirectoryMetadata_To_v1alpha2_ACMEDirectoryMetadata(in *acme.ACMEDirectoryMetadata, out *v1alpha2.ACMEDirectoryMetadata, s conversion.Scope) error {
+	out.TermsOfService = in.TermsOfService
+	out.Website = in.Website
+	out.CAAIdentities = *(*[]string)(unsafe.Pointer(&in.CAAIdentities))
+	out.ExternalAccountRequired = in.ExternalAccountRequired
+	return nil
+}
+
+// Convert_acme_ACMEDirectoryMetadata_To_v1alpha2_ACMEDirectoryMetadata is an autogenerated conversion function.
+func Convert_acme_ACMEDirectoryMetadata_To_v1alpha2_ACMEDirectoryMetadata(in *acme.ACMEDirectoryMetadata, out *v1alpha2.ACMEDirectoryMetadata, s conversion.Scope) error {
+	return autoConvert_acme_ACMEDirectoryMetadata_To_v1alpha2_ACMEDirectoryMetadata(in, out, s)
+}
+
 func autoConvert_v1alpha2_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(in *v1alpha2.ACMEExternalAccountBinding, out *acme.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
@@ -874,6 +1140,16 @@ func autoConvert_v1alpha2_ACMEIssuer_To_acme_ACMEIssuer(in *v1alpha2.ACMEIssuer,
 	out.Server = in.Server
 	out.PreferredChain = in.PreferredChain
 	out.SkipTLSVerify = in.SkipTLSVerify
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertificateSecretRef != nil {
+		in, out := &in.ClientCertificateSecretRef, &out.ClientCertificateSecretRef
+		*out = new(meta.LocalObjectReference)
+		if err := metav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertificateSecretRef = nil
+	}
 	if in.ExternalAccountBinding != nil {
 		in, out := &in.ExternalAccountBinding, &out.ExternalAccountBinding
 		*out = new(acme.ACMEExternalAccountBinding)
@@ -912,6 +1188,16 @@ func autoConvert_acme_ACMEIssuer_To_v1alpha2_ACMEIssuer(in *acme.ACMEIssuer, out
 	out.Server = in.Server
 	out.PreferredChain = in.PreferredChain
 	out.SkipTLSVerify = in.SkipTLSVerify
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertificateSecretRef != nil {
+		in, out := &in.ClientCertificateSecretRef, &out.ClientCertificateSecretRef
+		*out = new(apismetav1.LocalObjectReference)
+		if err := metav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertificateSecretRef = nil
+	}
 	if in.ExternalAccountBinding != nil {
 		in, out := &in.ExternalAccountBinding, &out.ExternalAccountBinding
 		*out = new(v1alpha2.ACMEExternalAccountBinding)
@@ -950,6 +1236,7 @@ func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
 		return err
 	}
+	out.AllowRegistration = in.AllowRegistration
 	return nil
 }
 
@@ -963,6 +1250,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderAcmeDNS_To_v1alpha2_ACMEIssuerDNS01
 	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
 		return err
 	}
+	out.AllowRegistration = in.AllowRegistration
 	return nil
 }
 
@@ -1059,6 +1347,44 @@ func Convert_acme_ACMEIssuerDNS01ProviderAzureDNS_To_v1alpha2_ACMEIssuerDNS01Pro
 	return autoConvert_acme_ACMEIssuerDNS01ProviderAzureDNS_To_v1alpha2_ACMEIssuerDNS01ProviderAzureDNS(in, out, s)
 }
 
+func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderBlueCat_To_acme_ACMEIssuerDNS01ProviderBlueCat(in *v1alpha2.ACMEIssuerDNS01ProviderBlueCat, out *acme.ACMEIssuerDNS01ProviderBlueCat, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Username = in.Username
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Password, &out.Password, s); err != nil {
+		return err
+	}
+	out.Configuration = in.Configuration
+	out.View = in.View
+	out.Zone = in.Zone
+	out.TTL = in.TTL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1alpha2_ACMEIssuerDNS01ProviderBlueCat_To_acme_ACMEIssuerDNS01ProviderBlueCat is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEIssuerDNS01ProviderBlueCat_To_acme_ACMEIssuerDNS01ProviderBlueCat(in *v1alpha2.ACMEIssuerDNS01ProviderBlueCat, out *acme.ACMEIssuerDNS01ProviderBlueCat, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEIssuerDNS01ProviderBlueCat_To_acme_ACMEIssuerDNS01ProviderBlueCat(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderBlueCat_To_v1alpha2_ACMEIssuerDNS01ProviderBlueCat(in *acme.ACMEIssuerDNS01ProviderBlueCat, out *v1alpha2.ACMEIssuerDNS01ProviderBlueCat, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Username = in.Username
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Password, &out.Password, s); err != nil {
+		return err
+	}
+	out.Configuration = in.Configuration
+	out.View = in.View
+	out.Zone = in.Zone
+	out.TTL = in.TTL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderBlueCat_To_v1alpha2_ACMEIssuerDNS01ProviderBlueCat is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderBlueCat_To_v1alpha2_ACMEIssuerDNS01ProviderBlueCat(in *acme.ACMEIssuerDNS01ProviderBlueCat, out *v1alpha2.ACMEIssuerDNS01ProviderBlueCat, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderBlueCat_To_v1alpha2_ACMEIssuerDNS01ProviderBlueCat(in, out, s)
+}
+
 func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderCloudDNS_To_acme_ACMEIssuerDNS01ProviderCloudDNS(in *v1alpha2.ACMEIssuerDNS01ProviderCloudDNS, out *acme.ACMEIssuerDNS01ProviderCloudDNS, s conversion.Scope) error {
 	if in.ServiceAccount != nil {
 		in, out := &in.ServiceAccount, &out.ServiceAccount
@@ -1119,6 +1445,19 @@ func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderCloudflare_To_acme_ACMEIssuerDN
 	} else {
 		out.APIToken = nil
 	}
+	if in.ZoneAPITokens != nil {
+		in, out := &in.ZoneAPITokens, &out.ZoneAPITokens
+		*out = make(map[string]meta.SecretKeySelector, len(*in))
+		for key, val := range *in {
+			newVal := new(meta.SecretKeySelector)
+			if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&val, newVal, s); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.ZoneAPITokens = nil
+	}
 	return nil
 }
 
@@ -1147,6 +1486,19 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1alpha2_ACMEIssuerDN
 	} else {
 		out.APIToken = nil
 	}
+	if in.ZoneAPITokens != nil {
+		in, out := &in.ZoneAPITokens, &out.ZoneAPITokens
+		*out = make(map[string]apismetav1.SecretKeySelector, len(*in))
+		for key, val := range *in {
+			newVal := new(apismetav1.SecretKeySelector)
+			if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&val, newVal, s); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.ZoneAPITokens = nil
+	}
 	return nil
 }
 
@@ -1179,6 +1531,100 @@ func Convert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha2_ACMEIssuerDNS0
 	return autoConvert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha2_ACMEIssuerDNS01ProviderDigitalOcean(in, out, s)
 }
 
+func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in *v1alpha2.ACMEIssuerDNS01ProviderGandi, out *acme.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PersonalAccessToken, &out.PersonalAccessToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in *v1alpha2.ACMEIssuerDNS01ProviderGandi, out *acme.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha2_ACMEIssuerDNS01ProviderGandi(in *acme.ACMEIssuerDNS01ProviderGandi, out *v1alpha2.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PersonalAccessToken, &out.PersonalAccessToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha2_ACMEIssuerDNS01ProviderGandi is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha2_ACMEIssuerDNS01ProviderGandi(in *acme.ACMEIssuerDNS01ProviderGandi, out *v1alpha2.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha2_ACMEIssuerDNS01ProviderGandi(in, out, s)
+}
+
+func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderInfoblox_To_acme_ACMEIssuerDNS01ProviderInfoblox(in *v1alpha2.ACMEIssuerDNS01ProviderInfoblox, out *acme.ACMEIssuerDNS01ProviderInfoblox, s conversion.Scope) error {
+	out.Server = in.Server
+	out.WAPIVersion = in.WAPIVersion
+	out.Username = in.Username
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Password, &out.Password, s); err != nil {
+		return err
+	}
+	out.View = in.View
+	out.TTL = in.TTL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1alpha2_ACMEIssuerDNS01ProviderInfoblox_To_acme_ACMEIssuerDNS01ProviderInfoblox is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEIssuerDNS01ProviderInfoblox_To_acme_ACMEIssuerDNS01ProviderInfoblox(in *v1alpha2.ACMEIssuerDNS01ProviderInfoblox, out *acme.ACMEIssuerDNS01ProviderInfoblox, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEIssuerDNS01ProviderInfoblox_To_acme_ACMEIssuerDNS01ProviderInfoblox(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderInfoblox_To_v1alpha2_ACMEIssuerDNS01ProviderInfoblox(in *acme.ACMEIssuerDNS01ProviderInfoblox, out *v1alpha2.ACMEIssuerDNS01ProviderInfoblox, s conversion.Scope) error {
+	out.Server = in.Server
+	out.WAPIVersion = in.WAPIVersion
+	out.Username = in.Username
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Password, &out.Password, s); err != nil {
+		return err
+	}
+	out.View = in.View
+	out.TTL = in.TTL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderInfoblox_To_v1alpha2_ACMEIssuerDNS01ProviderInfoblox is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderInfoblox_To_v1alpha2_ACMEIssuerDNS01ProviderInfoblox(in *acme.ACMEIssuerDNS01ProviderInfoblox, out *v1alpha2.ACMEIssuerDNS01ProviderInfoblox, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderInfoblox_To_v1alpha2_ACMEIssuerDNS01ProviderInfoblox(in, out, s)
+}
+
+func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderOVH_To_acme_ACMEIssuerDNS01ProviderOVH(in *v1alpha2.ACMEIssuerDNS01ProviderOVH, out *acme.ACMEIssuerDNS01ProviderOVH, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.ApplicationKey = in.ApplicationKey
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ApplicationSecret, &out.ApplicationSecret, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ConsumerKey, &out.ConsumerKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_ACMEIssuerDNS01ProviderOVH_To_acme_ACMEIssuerDNS01ProviderOVH is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEIssuerDNS01ProviderOVH_To_acme_ACMEIssuerDNS01ProviderOVH(in *v1alpha2.ACMEIssuerDNS01ProviderOVH, out *acme.ACMEIssuerDNS01ProviderOVH, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEIssuerDNS01ProviderOVH_To_acme_ACMEIssuerDNS01ProviderOVH(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderOVH_To_v1alpha2_ACMEIssuerDNS01ProviderOVH(in *acme.ACMEIssuerDNS01ProviderOVH, out *v1alpha2.ACMEIssuerDNS01ProviderOVH, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.ApplicationKey = in.ApplicationKey
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ApplicationSecret, &out.ApplicationSecret, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ConsumerKey, &out.ConsumerKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderOVH_To_v1alpha2_ACMEIssuerDNS01ProviderOVH is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderOVH_To_v1alpha2_ACMEIssuerDNS01ProviderOVH(in *acme.ACMEIssuerDNS01ProviderOVH, out *v1alpha2.ACMEIssuerDNS01ProviderOVH, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderOVH_To_v1alpha2_ACMEIssuerDNS01ProviderOVH(in, out, s)
+}
+
 func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(in *v1alpha2.ACMEIssuerDNS01ProviderRFC2136, out *acme.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
@@ -1268,6 +1714,7 @@ func Convert_acme_ACMEIssuerDNS01ProviderWebhook_To_v1alpha2_ACMEIssuerDNS01Prov
 func autoConvert_v1alpha2_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *v1alpha2.ACMEIssuerStatus, out *acme.ACMEIssuerStatus, s conversion.Scope) error {
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.Directory = (*acme.ACMEDirectoryMetadata)(unsafe.Pointer(in.Directory))
 	return nil
 }
 
@@ -1279,6 +1726,7 @@ func Convert_v1alpha2_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *v1alpha2.ACM
 func autoConvert_acme_ACMEIssuerStatus_To_v1alpha2_ACMEIssuerStatus(in *acme.ACMEIssuerStatus, out *v1alpha2.ACMEIssuerStatus, s conversion.Scope) error {
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.Directory = (*v1alpha2.ACMEDirectoryMetadata)(unsafe.Pointer(in.Directory))
 	return nil
 }
 
@@ -1287,6 +1735,32 @@ func Convert_acme_ACMEIssuerStatus_To_v1alpha2_ACMEIssuerStatus(in *acme.ACMEIss
 	return autoConvert_acme_ACMEIssuerStatus_To_v1alpha2_ACMEIssuerStatus(in, out, s)
 }
 
+func autoConvert_v1alpha2_ACMEProblem_To_acme_ACMEProblem(in *v1alpha2.ACMEProblem, out *acme.ACMEProblem, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Detail = in.Detail
+	out.Status = in.Status
+	out.Instance = in.Instance
+	return nil
+}
+
+// Convert_v1alpha2_ACMEProblem_To_acme_ACMEProblem is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEProblem_To_acme_ACMEProblem(in *v1alpha2.ACMEProblem, out *acme.ACMEProblem, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEProblem_To_acme_ACMEProblem(in, out, s)
+}
+
+func autoConvert_acme_ACMEProblem_To_v1alpha2_ACMEProblem(in *acme.ACMEProblem, out *v1alpha2.ACMEProblem, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Detail = in.Detail
+	out.Status = in.Status
+	out.Instance = in.Instance
+	return nil
+}
+
+// Convert_acme_ACMEProblem_To_v1alpha2_ACMEProblem is an autogenerated conversion function.
+func Convert_acme_ACMEProblem_To_v1alpha2_ACMEProblem(in *acme.ACMEProblem, out *v1alpha2.ACMEProblem, s conversion.Scope) error {
+	return autoConvert_acme_ACMEProblem_To_v1alpha2_ACMEProblem(in, out, s)
+}
+
 func autoConvert_v1alpha2_AzureManagedIdentity_To_acme_AzureManagedIdentity(in *v1alpha2.AzureManagedIdentity, out *acme.AzureManagedIdentity, s conversion.Scope) error {
 	out.ClientID = in.ClientID
 	out.ResourceID = in.ResourceID
@@ -1446,6 +1920,8 @@ func autoConvert_v1alpha2_ChallengeStatus_To_acme_ChallengeStatus(in *v1alpha2.C
 	out.Presented = in.Presented
 	out.Reason = in.Reason
 	out.State = acme.State(in.State)
+	out.AcmeDNS = (*acme.ACMEDNSAccountStatus)(unsafe.Pointer(in.AcmeDNS))
+	out.Problem = (*acme.ACMEProblem)(unsafe.Pointer(in.Problem))
 	return nil
 }
 
@@ -1459,6 +1935,8 @@ func autoConvert_acme_ChallengeStatus_To_v1alpha2_ChallengeStatus(in *acme.Chall
 	out.Presented = in.Presented
 	out.Reason = in.Reason
 	out.State = v1alpha2.State(in.State)
+	out.AcmeDNS = (*v1alpha2.ACMEDNSAccountStatus)(unsafe.Pointer(in.AcmeDNS))
+	out.Problem = (*v1alpha2.ACMEProblem)(unsafe.Pointer(in.Problem))
 	return nil
 }
 
@@ -1573,6 +2051,9 @@ func autoConvert_v1alpha2_OrderStatus_To_acme_OrderStatus(in *v1alpha2.OrderStat
 	out.State = acme.State(in.State)
 	out.Reason = in.Reason
 	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.AuthorizationRetries = in.AuthorizationRetries
+	out.Problem = (*acme.ACMEProblem)(unsafe.Pointer(in.Problem))
+	out.FailedAuthorizations = *(*[]acme.ACMEAuthorizationFailure)(unsafe.Pointer(&in.FailedAuthorizations))
 	return nil
 }
 
@@ -1589,6 +2070,9 @@ func autoConvert_acme_OrderStatus_To_v1alpha2_OrderStatus(in *acme.OrderStatus,
 	out.Reason = in.Reason
 	out.Authorizations = *(*[]v1alpha2.ACMEAuthorization)(unsafe.Pointer(&in.Authorizations))
 	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.AuthorizationRetries = in.AuthorizationRetries
+	out.Problem = (*v1alpha2.ACMEProblem)(unsafe.Pointer(in.Problem))
+	out.FailedAuthorizations = *(*[]v1alpha2.ACMEAuthorizationFailure)(unsafe.Pointer(&in.FailedAuthorizations))
 	return nil
 }
 
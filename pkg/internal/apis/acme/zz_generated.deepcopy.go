@@ -54,6 +54,28 @@ func (in *ACMEAuthorization) DeepCopy() *ACMEAuthorization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEAuthorizationFailure) DeepCopyInto(out *ACMEAuthorizationFailure) {
+	*out = *in
+	if in.Problem != nil {
+		in, out := &in.Problem, &out.Problem
+		*out = new(ACMEProblem)
+		**out = **in
+	}
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEAuthorizationFailure.
+func (in *ACMEAuthorizationFailure) DeepCopy() *ACMEAuthorizationFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEAuthorizationFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEChallenge) DeepCopyInto(out *ACMEChallenge) {
 	*out = *in
@@ -134,6 +156,26 @@ func (in *ACMEChallengeSolverDNS01) DeepCopyInto(out *ACMEChallengeSolverDNS01)
 		*out = new(ACMEIssuerDNS01ProviderDigitalOcean)
 		**out = **in
 	}
+	if in.OVH != nil {
+		in, out := &in.OVH, &out.OVH
+		*out = new(ACMEIssuerDNS01ProviderOVH)
+		**out = **in
+	}
+	if in.Gandi != nil {
+		in, out := &in.Gandi, &out.Gandi
+		*out = new(ACMEIssuerDNS01ProviderGandi)
+		**out = **in
+	}
+	if in.Infoblox != nil {
+		in, out := &in.Infoblox, &out.Infoblox
+		*out = new(ACMEIssuerDNS01ProviderInfoblox)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueCat != nil {
+		in, out := &in.BlueCat, &out.BlueCat
+		*out = new(ACMEIssuerDNS01ProviderBlueCat)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AcmeDNS != nil {
 		in, out := &in.AcmeDNS, &out.AcmeDNS
 		*out = new(ACMEIssuerDNS01ProviderAcmeDNS)
@@ -229,6 +271,11 @@ func (in *ACMEChallengeSolverHTTP01Ingress) DeepCopyInto(out *ACMEChallengeSolve
 		*out = new(ACMEChallengeSolverHTTP01IngressTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SharedSolver != nil {
+		in, out := &in.SharedSolver, &out.SharedSolver
+		*out = new(ACMEChallengeSolverHTTP01SharedSolver)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -372,6 +419,64 @@ func (in *ACMEChallengeSolverHTTP01IngressTemplate) DeepCopy() *ACMEChallengeSol
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEChallengeSolverHTTP01SharedSolver) DeepCopyInto(out *ACMEChallengeSolverHTTP01SharedSolver) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEChallengeSolverHTTP01SharedSolver.
+func (in *ACMEChallengeSolverHTTP01SharedSolver) DeepCopy() *ACMEChallengeSolverHTTP01SharedSolver {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEChallengeSolverHTTP01SharedSolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEDNSAccountStatus) DeepCopyInto(out *ACMEDNSAccountStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEDNSAccountStatus.
+func (in *ACMEDNSAccountStatus) DeepCopy() *ACMEDNSAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEDNSAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEDirectoryMetadata) DeepCopyInto(out *ACMEDirectoryMetadata) {
+	*out = *in
+	if in.CAAIdentities != nil {
+		in, out := &in.CAAIdentities, &out.CAAIdentities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEDirectoryMetadata.
+func (in *ACMEDirectoryMetadata) DeepCopy() *ACMEDirectoryMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEDirectoryMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEExternalAccountBinding) DeepCopyInto(out *ACMEExternalAccountBinding) {
 	*out = *in
@@ -392,6 +497,16 @@ func (in *ACMEExternalAccountBinding) DeepCopy() *ACMEExternalAccountBinding {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEIssuer) DeepCopyInto(out *ACMEIssuer) {
 	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientCertificateSecretRef != nil {
+		in, out := &in.ClientCertificateSecretRef, &out.ClientCertificateSecretRef
+		*out = new(meta.LocalObjectReference)
+		**out = **in
+	}
 	if in.ExternalAccountBinding != nil {
 		in, out := &in.ExternalAccountBinding, &out.ExternalAccountBinding
 		*out = new(ACMEExternalAccountBinding)
@@ -480,6 +595,28 @@ func (in *ACMEIssuerDNS01ProviderAzureDNS) DeepCopy() *ACMEIssuerDNS01ProviderAz
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuerDNS01ProviderBlueCat) DeepCopyInto(out *ACMEIssuerDNS01ProviderBlueCat) {
+	*out = *in
+	out.Password = in.Password
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuerDNS01ProviderBlueCat.
+func (in *ACMEIssuerDNS01ProviderBlueCat) DeepCopy() *ACMEIssuerDNS01ProviderBlueCat {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuerDNS01ProviderBlueCat)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEIssuerDNS01ProviderCloudDNS) DeepCopyInto(out *ACMEIssuerDNS01ProviderCloudDNS) {
 	*out = *in
@@ -514,6 +651,13 @@ func (in *ACMEIssuerDNS01ProviderCloudflare) DeepCopyInto(out *ACMEIssuerDNS01Pr
 		*out = new(meta.SecretKeySelector)
 		**out = **in
 	}
+	if in.ZoneAPITokens != nil {
+		in, out := &in.ZoneAPITokens, &out.ZoneAPITokens
+		*out = make(map[string]meta.SecretKeySelector, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -544,6 +688,63 @@ func (in *ACMEIssuerDNS01ProviderDigitalOcean) DeepCopy() *ACMEIssuerDNS01Provid
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuerDNS01ProviderGandi) DeepCopyInto(out *ACMEIssuerDNS01ProviderGandi) {
+	*out = *in
+	out.PersonalAccessToken = in.PersonalAccessToken
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuerDNS01ProviderGandi.
+func (in *ACMEIssuerDNS01ProviderGandi) DeepCopy() *ACMEIssuerDNS01ProviderGandi {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuerDNS01ProviderGandi)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuerDNS01ProviderInfoblox) DeepCopyInto(out *ACMEIssuerDNS01ProviderInfoblox) {
+	*out = *in
+	out.Password = in.Password
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuerDNS01ProviderInfoblox.
+func (in *ACMEIssuerDNS01ProviderInfoblox) DeepCopy() *ACMEIssuerDNS01ProviderInfoblox {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuerDNS01ProviderInfoblox)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuerDNS01ProviderOVH) DeepCopyInto(out *ACMEIssuerDNS01ProviderOVH) {
+	*out = *in
+	out.ApplicationSecret = in.ApplicationSecret
+	out.ConsumerKey = in.ConsumerKey
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuerDNS01ProviderOVH.
+func (in *ACMEIssuerDNS01ProviderOVH) DeepCopy() *ACMEIssuerDNS01ProviderOVH {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuerDNS01ProviderOVH)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEIssuerDNS01ProviderRFC2136) DeepCopyInto(out *ACMEIssuerDNS01ProviderRFC2136) {
 	*out = *in
@@ -602,6 +803,11 @@ func (in *ACMEIssuerDNS01ProviderWebhook) DeepCopy() *ACMEIssuerDNS01ProviderWeb
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEIssuerStatus) DeepCopyInto(out *ACMEIssuerStatus) {
 	*out = *in
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(ACMEDirectoryMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -615,6 +821,22 @@ func (in *ACMEIssuerStatus) DeepCopy() *ACMEIssuerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEProblem) DeepCopyInto(out *ACMEProblem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEProblem.
+func (in *ACMEProblem) DeepCopy() *ACMEProblem {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEProblem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureManagedIdentity) DeepCopyInto(out *AzureManagedIdentity) {
 	*out = *in
@@ -670,7 +892,7 @@ func (in *Challenge) DeepCopyInto(out *Challenge) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -746,6 +968,16 @@ func (in *ChallengeSpec) DeepCopy() *ChallengeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChallengeStatus) DeepCopyInto(out *ChallengeStatus) {
 	*out = *in
+	if in.AcmeDNS != nil {
+		in, out := &in.AcmeDNS, &out.AcmeDNS
+		*out = new(ACMEDNSAccountStatus)
+		**out = **in
+	}
+	if in.Problem != nil {
+		in, out := &in.Problem, &out.Problem
+		*out = new(ACMEProblem)
+		**out = **in
+	}
 	return
 }
 
@@ -876,6 +1108,18 @@ func (in *OrderStatus) DeepCopyInto(out *OrderStatus) {
 		in, out := &in.FailureTime, &out.FailureTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Problem != nil {
+		in, out := &in.Problem, &out.Problem
+		*out = new(ACMEProblem)
+		**out = **in
+	}
+	if in.FailedAuthorizations != nil {
+		in, out := &in.FailedAuthorizations, &out.FailedAuthorizations
+		*out = make([]ACMEAuthorizationFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
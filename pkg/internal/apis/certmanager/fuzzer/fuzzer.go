@@ -41,6 +41,34 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []interface{} {
 			if s.Spec.Duration == nil {
 				s.Spec.Duration = &metav1.Duration{Duration: v1.DefaultCertificateDuration}
 			}
+			if s.Spec.RenewBefore == nil {
+				s.Spec.RenewBefore = &metav1.Duration{Duration: s.Spec.Duration.Duration / 3}
+			}
+			if s.Spec.IssuerRef.Group == "" {
+				s.Spec.IssuerRef.Group = certmanager.SchemeGroupVersion.Group
+			}
+			for i := range s.Spec.FallbackIssuerRefs {
+				if s.Spec.FallbackIssuerRefs[i].Group == "" {
+					s.Spec.FallbackIssuerRefs[i].Group = certmanager.SchemeGroupVersion.Group
+				}
+			}
+			if s.Spec.PrivateKey == nil {
+				s.Spec.PrivateKey = &certmanager.CertificatePrivateKey{}
+			}
+			if s.Spec.PrivateKey.Algorithm == "" {
+				s.Spec.PrivateKey.Algorithm = certmanager.RSAKeyAlgorithm
+			}
+			if s.Spec.PrivateKey.Size == 0 {
+				switch s.Spec.PrivateKey.Algorithm {
+				case certmanager.RSAKeyAlgorithm:
+					s.Spec.PrivateKey.Size = 2048
+				case certmanager.ECDSAKeyAlgorithm:
+					s.Spec.PrivateKey.Size = 256
+				}
+			}
+			if len(s.Spec.Usages) == 0 && len(s.Spec.Profile) == 0 {
+				s.Spec.Usages = []certmanager.KeyUsage{certmanager.UsageDigitalSignature, certmanager.UsageKeyEncipherment}
+			}
 		},
 		func(s *certmanager.CertificateRequest, c fuzz.Continue) {
 			c.FuzzNoCustom(s) // fuzz self without calling this function again
@@ -26,6 +26,7 @@ import (
 	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	cmidentity "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/identity"
+	cmmutation "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/mutation"
 	v1 "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/v1"
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/v1alpha2"
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/v1alpha3"
@@ -57,4 +58,5 @@ func InstallValidation(registry *validation.Registry) {
 // mutation registry
 func InstallMutation(registry *mutation.Registry) {
 	utilruntime.Must(cmidentity.AddToMutationRegistry(registry))
+	utilruntime.Must(cmmutation.AddToMutationRegistry(registry))
 }
@@ -21,9 +21,14 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
 
+	apitesting "github.com/jetstack/cert-manager/pkg/api/testing"
 	cmfuzzer "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/fuzzer"
 )
 
 func TestRoundTripTypes(t *testing.T) {
 	roundtrip.RoundTripTestForAPIGroup(t, Install, cmfuzzer.Funcs)
 }
+
+func TestRoundTripExternalVersions(t *testing.T) {
+	apitesting.RoundTripExternalVersions(t, Install, cmfuzzer.Funcs)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmapiv1 "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/v1"
+)
+
+// MutateUpdateCertificate unsets a defaulted spec.renewBefore when
+// spec.duration is changed, so that it is defaulted again for the new
+// duration instead of keeping the value defaulted for the old one.
+// A renewBefore which was explicitly set to the default is treated the same
+// way, which keeps it at the same fraction of the duration.
+func MutateUpdateCertificate(_ *admissionv1.AdmissionRequest, oldObj, newObj runtime.Object) {
+	oldCrt, newCrt := oldObj.(*cmapi.Certificate), newObj.(*cmapi.Certificate)
+
+	oldRenewBefore, newRenewBefore := oldCrt.Spec.RenewBefore, newCrt.Spec.RenewBefore
+	if oldRenewBefore == nil || newRenewBefore == nil || *oldRenewBefore != *newRenewBefore {
+		return
+	}
+	if oldRenewBefore.Duration != cmapiv1.DefaultRenewBefore(oldCrt.Spec.Duration) {
+		return
+	}
+	if cmapiv1.DefaultRenewBefore(oldCrt.Spec.Duration) == cmapiv1.DefaultRenewBefore(newCrt.Spec.Duration) {
+		return
+	}
+
+	newCrt.Spec.RenewBefore = nil
+}
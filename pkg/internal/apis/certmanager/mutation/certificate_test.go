@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestMutateUpdateCertificate(t *testing.T) {
+	duration := func(d time.Duration) *metav1.Duration { return &metav1.Duration{Duration: d} }
+	crt := func(d, renewBefore *metav1.Duration) *cmapi.Certificate {
+		return &cmapi.Certificate{Spec: cmapi.CertificateSpec{Duration: d, RenewBefore: renewBefore}}
+	}
+
+	tests := map[string]struct {
+		old, new            *cmapi.Certificate
+		expectedRenewBefore *metav1.Duration
+	}{
+		"unset a defaulted renewBefore when the duration changes": {
+			old: crt(nil, duration(30*24*time.Hour)),
+			new: crt(duration(24*time.Hour), duration(30*24*time.Hour)),
+		},
+		"keep a defaulted renewBefore when the duration is unchanged": {
+			old:                 crt(duration(3*time.Hour), duration(time.Hour)),
+			new:                 crt(duration(3*time.Hour), duration(time.Hour)),
+			expectedRenewBefore: duration(time.Hour),
+		},
+		"keep a renewBefore which is not the default": {
+			old:                 crt(nil, duration(10*24*time.Hour)),
+			new:                 crt(duration(30*24*time.Hour), duration(10*24*time.Hour)),
+			expectedRenewBefore: duration(10 * 24 * time.Hour),
+		},
+		"keep a renewBefore which is changed along with the duration": {
+			old:                 crt(nil, duration(30*24*time.Hour)),
+			new:                 crt(duration(24*time.Hour), duration(time.Hour)),
+			expectedRenewBefore: duration(time.Hour),
+		},
+		"leave an unset renewBefore to be defaulted": {
+			old: crt(nil, duration(30*24*time.Hour)),
+			new: crt(duration(24*time.Hour), nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			MutateUpdateCertificate(nil, test.old, test.new)
+			if !reflect.DeepEqual(test.new.Spec.RenewBefore, test.expectedRenewBefore) {
+				t.Errorf("unexpected renewBefore, exp=%v, got=%v", test.expectedRenewBefore, test.new.Spec.RenewBefore)
+			}
+		})
+	}
+}
@@ -41,6 +41,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Certificate{},
 		&CertificateList{},
+		&ClusterCertificate{},
+		&ClusterCertificateList{},
+		&IssuanceQuota{},
+		&IssuanceQuotaList{},
+		&NotificationRoute{},
+		&NotificationRouteList{},
+		&SecretAccessGrant{},
+		&SecretAccessGrantList{},
 		&Issuer{},
 		&IssuerList{},
 		&ClusterIssuer{},
@@ -42,6 +42,10 @@ const (
 	// Annotation key for the name of the certificate that a resource is related to.
 	CertificateNameKey = "cert-manager.io/certificate-name"
 
+	// Label key for the name of the ClusterCertificate that a Secret has been
+	// copied from.
+	ClusterCertificateNameLabelKey = "cert-manager.io/cluster-certificate-name"
+
 	// Annotation key used to denote whether a Secret is named on a Certificate
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
@@ -93,6 +97,10 @@ const (
 	IssuerKind             = "Issuer"
 	CertificateKind        = "Certificate"
 	CertificateRequestKind = "CertificateRequest"
+	ClusterCertificateKind = "ClusterCertificate"
+	IssuanceQuotaKind      = "IssuanceQuota"
+	NotificationRouteKind  = "NotificationRoute"
+	SecretAccessGrantKind  = "SecretAccessGrant"
 )
 
 const (
@@ -127,6 +135,15 @@ const (
 	// The value is an array with objects containing the name and value keys
 	// for example: `[{"name": "custom-field", "value": "custom-value"}]`
 	VenafiCustomFieldsAnnotationKey = "venafi.cert-manager.io/custom-fields"
+
+	// VenafiApplicationAnnotationKey is the annotation that selects the
+	// Venafi as a Service application a certificate is requested for.
+	VenafiApplicationAnnotationKey = "venafi.cert-manager.io/application"
+
+	// VenafiIssuingTemplateAnnotationKey is the annotation that selects the
+	// alias of the Venafi as a Service issuing template a certificate is
+	// requested with.
+	VenafiIssuingTemplateAnnotationKey = "venafi.cert-manager.io/issuing-template"
 )
 
 // KeyUsage specifies valid usage contexts for keys.
@@ -73,6 +73,14 @@ type CertificateRequestSpec struct {
 	// This option may be ignored/overridden by some issuer types.
 	Duration *metav1.Duration
 
+	// The amount of time to backdate the 'notBefore' time of the issued
+	// certificate by, to tolerate clock skew between the issuer and the
+	// clients that validate the certificate. The 'notAfter' time of the
+	// certificate is not changed. If not set, the default configured on the
+	// cert-manager controller is used.
+	// This option may be ignored by some issuer types.
+	Backdate *metav1.Duration
+
 	// IssuerRef is a reference to the issuer for this CertificateRequest.  If
 	// the `kind` field is not set, or set to `Issuer`, an Issuer resource with
 	// the given name in the same namespace as the CertificateRequest will be
@@ -95,6 +103,12 @@ type CertificateRequestSpec struct {
 	// Defaults to `digital signature` and `key encipherment` if not specified.
 	Usages []KeyUsage
 
+	// Extensions is a list of additional X.509 extensions to add to the
+	// certificate. Extensions are only added by the CA and SelfSigned issuers,
+	// and only if they are allowed by the `allowedExtensions` policy of the
+	// issuer.
+	Extensions []X509Extension
+
 	// Username contains the name of the user that created the CertificateRequest.
 	// Populated by the cert-manager webhook on creation and immutable.
 	Username string
@@ -109,6 +123,19 @@ type CertificateRequestSpec struct {
 	Extra map[string][]string
 }
 
+// X509Extension is an additional X.509 extension to add to a certificate.
+type X509Extension struct {
+	// ID is the object identifier of the extension in dotted notation, for
+	// example `1.3.6.1.4.1.311.20.2`.
+	ID string
+
+	// Critical marks the extension as critical.
+	Critical bool
+
+	// Value is the DER encoded value of the extension.
+	Value []byte
+}
+
 // CertificateRequestStatus defines the observed state of CertificateRequest and
 // resulting signed certificate.
 type CertificateRequestStatus struct {
@@ -180,4 +207,11 @@ const (
 	// denied, and must never be signed. Condition must never have a status of
 	// `False`, and cannot be modified once set.
 	CertificateRequestConditionDenied CertificateRequestConditionType = "Denied"
+
+	// CertificateRequestConditionDurationClamped indicates that the duration
+	// of the certificate was clamped to the durations allowed by the policy
+	// of the issuer, as the requested duration was outside of them. It is
+	// also set if the issuer signed the certificate with a shorter duration
+	// than requested, such as when it exceeds the maximum TTL of a Vault role.
+	CertificateRequestConditionDurationClamped CertificateRequestConditionType = "DurationClamped"
 )
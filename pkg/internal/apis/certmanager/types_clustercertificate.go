@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A ClusterCertificate is a cluster-scoped Certificate, for certificates used
+// by cluster-wide components which do not belong to any one namespace.
+//
+// It is issued by a Certificate which is created from `spec.template` in
+// `spec.namespace`, so the Secret is stored in that namespace. The Secret is
+// then copied to each of the namespaces listed in `spec.secretNamespaces`.
+type ClusterCertificate struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Desired state of the ClusterCertificate resource.
+	Spec ClusterCertificateSpec
+
+	// Status of the ClusterCertificate. This is copied from the status of the
+	// Certificate which issues it.
+	Status CertificateStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterCertificateList is a list of ClusterCertificates
+type ClusterCertificateList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []ClusterCertificate
+}
+
+// ClusterCertificateSpec defines the desired state of a ClusterCertificate.
+type ClusterCertificateSpec struct {
+	// Namespace is the namespace in which the Certificate that issues this
+	// ClusterCertificate is created, and in which its Secret is stored.
+	// The Certificate has the same name as the ClusterCertificate, and
+	// `template.issuerRef` may refer to an Issuer in this namespace.
+	Namespace string
+
+	// SecretNamespaces is a list of additional namespaces that the Secret is
+	// copied to. The copies are kept up to date with the Secret, and are
+	// deleted when their namespace is removed from this list.
+	SecretNamespaces []string
+
+	// Template is the spec of the Certificate that issues this
+	// ClusterCertificate.
+	Template CertificateSpec
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// An IssuanceQuota limits the number of Certificates in a namespace, and the
+// rate at which CertificateRequests may be created in it.
+//
+// Quotas are enforced by the webhook when Certificates and
+// CertificateRequests are created. If a namespace has more than one
+// IssuanceQuota, all of them are enforced.
+type IssuanceQuota struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Desired state of the IssuanceQuota resource.
+	Spec IssuanceQuotaSpec
+
+	// Status of the IssuanceQuota. This is set and managed automatically by
+	// the issuancequotas controller, if it is enabled.
+	Status IssuanceQuotaStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IssuanceQuotaList is a list of IssuanceQuotas
+type IssuanceQuotaList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []IssuanceQuota
+}
+
+// IssuanceQuotaSpec defines the limits of an IssuanceQuota.
+type IssuanceQuotaSpec struct {
+	// MaxCertificates is the maximum number of Certificates that may exist in
+	// the namespace. If not set, the number of Certificates is not limited.
+	MaxCertificates *int32
+
+	// MaxCertificateRequests is the maximum number of CertificateRequests that
+	// may be created in the namespace within `certificateRequestsPeriod`.
+	// If not set, the rate of CertificateRequests is not limited.
+	MaxCertificateRequests *int32
+
+	// CertificateRequestsPeriod is the period over which CertificateRequests
+	// are counted against `maxCertificateRequests`. Defaults to 1 hour.
+	CertificateRequestsPeriod *metav1.Duration
+}
+
+// IssuanceQuotaStatus records how much of an IssuanceQuota is used.
+type IssuanceQuotaStatus struct {
+	// Certificates is the number of Certificates in the namespace.
+	Certificates int32
+
+	// CertificateRequests is the number of CertificateRequests created in the
+	// namespace within the last `certificateRequestsPeriod`.
+	CertificateRequests int32
+
+	// LastUpdateTime is the time at which the usage was last counted.
+	LastUpdateTime *metav1.Time
+}
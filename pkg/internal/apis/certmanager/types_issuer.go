@@ -82,8 +82,112 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig
+
+	// Policy restricts the certificates which will be signed by this issuer.
+	// Certificates and CertificateRequests which violate the policy are
+	// rejected by the webhook, and CertificateRequests are rejected or have
+	// their duration clamped by the issuer's controller.
+	Policy *IssuerPolicy
+
+	// CertificateDefaults are applied to the Certificates which reference
+	// this issuer when the corresponding fields are not set on the
+	// Certificate. The values that were applied are recorded in the
+	// `IssuerDefaultsApplied` condition of the Certificate.
+	CertificateDefaults *IssuerCertificateDefaults
+}
+
+// IssuerCertificateDefaults are the values applied to the Certificates which
+// reference an issuer when the corresponding fields are not set.
+type IssuerCertificateDefaults struct {
+	// Duration is applied to Certificates that do not set spec.duration.
+	Duration *metav1.Duration
+
+	// Usages are applied to Certificates that do not set spec.usages, or
+	// whose usages are the defaults set by the webhook.
+	Usages []KeyUsage
+
+	// Organizations are applied to Certificates that do not set
+	// spec.subject.organizations.
+	Organizations []string
+}
+
+// IssuerPolicy restricts the certificates which will be signed by an issuer.
+type IssuerPolicy struct {
+	// MinDuration is the minimum duration of the certificates signed by the
+	// issuer.
+	MinDuration *metav1.Duration
+
+	// MaxDuration is the maximum duration of the certificates signed by the
+	// issuer.
+	MaxDuration *metav1.Duration
+
+	// DurationEnforcement controls what happens to a request whose duration
+	// is outside of the minimum and maximum durations. If set to `Reject`,
+	// the request is rejected. If set to `Clamp`, the certificate is signed
+	// with its duration clamped to the allowed range, and the clamp is
+	// reported in the CertificateRequest's conditions.
+	// Defaults to `Reject`.
+	DurationEnforcement DurationEnforcement
+
+	// AllowedPrivateKeyAlgorithms is the list of private key algorithms
+	// that the issuer will sign certificates for.
+	// If not set, all private key algorithms are allowed.
+	AllowedPrivateKeyAlgorithms []PrivateKeyAlgorithm
+
+	// RequesterIdentity restricts the subject of certificates to the identity
+	// of the user that created the CertificateRequest, for example requiring
+	// the common name to be the name of the requester's service account.
+	// As the CertificateRequests of Certificates are created by cert-manager,
+	// this is intended for issuers used to sign CertificateRequests that are
+	// created directly.
+	RequesterIdentity *RequesterIdentityPolicy
+
+	// AllowedExtensions is the list of object identifiers, in dotted
+	// notation, of the additional X.509 extensions that may be requested in
+	// the `extensions` of CertificateRequests. Requests for any other
+	// extension are rejected.
+	AllowedExtensions []string
 }
 
+// RequesterIdentityPolicy restricts the subject of certificates to the
+// identity of the user that created the CertificateRequest.
+//
+// Each field is a template which is expanded with the identity of the
+// requester. The following variables are supported:
+// `${username}`, `${uid}` and `${namespace}`, which is the namespace of the
+// CertificateRequest; and `${serviceaccount.name}` and
+// `${serviceaccount.namespace}`, which are only set if the requester is a
+// service account. Requests from other users are rejected by templates which
+// use them.
+type RequesterIdentityPolicy struct {
+	// CommonName is a template for the common name of the certificate. If
+	// set, the common name must be equal to the expanded template.
+	CommonName string
+
+	// DNSNames is a list of templates for the DNS names of the certificate.
+	// If set, each DNS name must be equal to one of the expanded templates.
+	DNSNames []string
+
+	// URIs is a list of templates for the URIs of the certificate. If set,
+	// each URI must be equal to one of the expanded templates.
+	URIs []string
+}
+
+// DurationEnforcement controls what happens to a request whose duration is
+// outside of the durations allowed by an issuer's policy.
+type DurationEnforcement string
+
+const (
+	// RejectDurationEnforcement rejects requests whose duration is outside
+	// of the allowed durations.
+	RejectDurationEnforcement DurationEnforcement = "Reject"
+
+	// ClampDurationEnforcement signs requests whose duration is outside of
+	// the allowed durations with their duration clamped to the allowed
+	// range.
+	ClampDurationEnforcement DurationEnforcement = "Clamp"
+)
+
 type IssuerConfig struct {
 	// ACME configures this issuer to communicate with a RFC8555 (ACME) server
 	// to obtain signed x509 certificates.
@@ -105,6 +209,16 @@ type IssuerConfig struct {
 	// Venafi configures this issuer to sign certificates using a Venafi TPP
 	// or Venafi Cloud policy zone.
 	Venafi *VenafiIssuer
+
+	// SCEP configures this issuer to enroll certificates with a Simple
+	// Certificate Enrollment Protocol (RFC 8894) server, such as Microsoft
+	// NDES.
+	SCEP *SCEPIssuer
+
+	// CMP configures this issuer to enroll certificates with a Certificate
+	// Management Protocol (RFC 4210) server, such as EJBCA or Insta
+	// Certifier.
+	CMP *CMPIssuer
 }
 
 // Configures an issuer to sign certificates using a Venafi TPP
@@ -155,6 +269,81 @@ type VenafiCloud struct {
 	APITokenSecretRef cmmeta.SecretKeySelector
 }
 
+// SCEPIssuer configures an issuer to enroll certificates with a Simple
+// Certificate Enrollment Protocol (SCEP) server.
+type SCEPIssuer struct {
+	// URL is the URL of the SCEP server, for example:
+	// "https://ndes.example.com/certsrv/mscep/mscep.dll".
+	URL string
+
+	// ChallengePasswordSecretRef is a reference to a key in a Secret containing
+	// the challenge password to include in certificate signing requests.
+	// The challenge password is visible to anyone able to read the
+	// CertificateRequests of this issuer.
+	ChallengePasswordSecretRef *cmmeta.SecretKeySelector
+
+	// CAFingerprint is the hex encoded SHA-256 fingerprint of the DER encoded
+	// CA certificate of the SCEP server, optionally separated by colons.
+	// If specified, the CA certificates returned by the server are only
+	// trusted if one of them has this fingerprint.
+	CAFingerprint string
+
+	// CABundle is a PEM encoded bundle of CA certificates used to validate
+	// the TLS certificate of the SCEP server. Only used if the URL is using
+	// HTTPS. If not set the system root certificates are used.
+	CABundle []byte
+}
+
+// CMPIssuer configures an issuer to enroll certificates with a Certificate
+// Management Protocol (CMPv2) server.
+// Certificates are requested with an initialization request when MAC
+// protection is configured, and with a certification request when signature
+// protection is configured. Certificates which were issued by this issuer and
+// have not expired are renewed with a key update request, signed by the
+// private key of the certificate being renewed.
+// As the private key of a request is not available to the issuer, the CMP
+// server must accept proof of possession verified by a registration
+// authority.
+type CMPIssuer struct {
+	// URL is the URL of the CMP server, for example:
+	// "https://ejbca.example.com/ejbca/publicweb/cmp/cert-manager".
+	URL string
+
+	// MAC protects requests with a password-based MAC, using a shared secret
+	// issued by the CA. Exactly one of mac or signature must be specified.
+	MAC *CMPMACProtection
+
+	// Signature protects requests with a signature, using a private key and
+	// certificate trusted by the CA. Exactly one of mac or signature must be
+	// specified.
+	Signature *CMPSignatureProtection
+
+	// CABundle is a PEM encoded bundle of CA certificates used to validate
+	// the TLS certificate of the CMP server, and the signers of responses
+	// protected by a signature. If not set the system root certificates are
+	// used to validate the TLS certificate of the CMP server, and responses
+	// protected by a signature are rejected.
+	CABundle []byte
+}
+
+// CMPMACProtection configures password-based MAC protection of CMP messages.
+type CMPMACProtection struct {
+	// Reference is the reference value the CA associates with the shared
+	// secret, sent as the sender key identifier of requests.
+	Reference string
+
+	// SecretRef is a reference to a key in a Secret containing the shared
+	// secret.
+	SecretRef cmmeta.SecretKeySelector
+}
+
+// CMPSignatureProtection configures signature protection of CMP messages.
+type CMPSignatureProtection struct {
+	// SecretRef is a reference to a kubernetes.io/tls Secret containing the
+	// private key and certificate used to sign requests.
+	SecretRef cmmeta.LocalObjectReference
+}
+
 // Configures an issuer to 'self sign' certificates using the
 // private key used to create the CertificateRequest object.
 type SelfSignedIssuer struct {
@@ -162,6 +351,42 @@ type SelfSignedIssuer struct {
 	// the location of the CRL from which the revocation of this certificate can be checked.
 	// If not set certificate will be issued without CDP. Values are strings.
 	CRLDistributionPoints []string
+
+	// BootstrapCA configures the issuer to bootstrap a CA hierarchy: a
+	// self-signed root CA certificate, an intermediate CA certificate signed
+	// by the root, and a CA issuer which signs using the intermediate.
+	// The Certificates are created in the namespace of the issuer, or the
+	// cluster resource namespace for a ClusterIssuer, and all of the
+	// created resources are owned by this issuer.
+	BootstrapCA *SelfSignedBootstrapCA
+}
+
+// SelfSignedBootstrapCA configures the CA hierarchy bootstrapped by a
+// SelfSigned issuer.
+type SelfSignedBootstrapCA struct {
+	// IssuerName is the name of the CA issuer which signs using the
+	// intermediate CA certificate, which is of the same kind as the SelfSigned
+	// issuer. The intermediate CA Certificate and its Secret are given the
+	// same name. The root CA Certificate, its Secret and the CA issuer which
+	// signs using it are given this name suffixed with "-root".
+	IssuerName string
+
+	// CommonName of the CA certificates. The common names of the root and
+	// intermediate CA certificates are suffixed with " Root CA" and
+	// " Intermediate CA" respectively.
+	CommonName string
+
+	// Full X509 name specification of the CA certificates.
+	Subject *X509Subject
+
+	// RootDuration is the requested duration of the root CA certificate.
+	// Defaults to 10 years.
+	RootDuration *metav1.Duration
+
+	// IntermediateDuration is the requested duration of the intermediate CA
+	// certificate. It must be shorter than the duration of the root CA
+	// certificate. Defaults to 5 years.
+	IntermediateDuration *metav1.Duration
 }
 
 // Configures an issuer to sign certificates using a HashiCorp Vault
@@ -175,8 +400,19 @@ type VaultIssuer struct {
 
 	// Path is the mount path of the Vault PKI backend's `sign` endpoint, e.g:
 	// "my_pki_mount/sign/my-role-name".
+	// CA certificates are signed as intermediates by the
+	// `root/sign-intermediate` endpoint of the same PKI backend.
 	Path string
 
+	// SigningMode selects the endpoint of the Vault PKI backend which signs
+	// certificates. If set to `Role`, certificates are signed by the `sign`
+	// endpoint of the role in `path`, which applies the constraints and
+	// defaults of the role to the request. If set to `Verbatim`, they are
+	// signed by the `sign-verbatim` endpoint of the same PKI backend, which
+	// keeps the subject and extensions of the request as they are.
+	// Defaults to `Role`.
+	SigningMode VaultSigningMode
+
 	// Name of the vault namespace. Namespaces is a set of features within Vault Enterprise that allows Vault environments to support Secure Multi-tenancy. e.g: "ns1"
 	// More about namespaces can be found here https://www.vaultproject.io/docs/enterprise/namespaces
 	Namespace string
@@ -188,6 +424,20 @@ type VaultIssuer struct {
 	CABundle []byte
 }
 
+// VaultSigningMode selects the endpoint of a Vault PKI backend which signs
+// certificates.
+type VaultSigningMode string
+
+const (
+	// RoleVaultSigningMode signs certificates using the `sign` endpoint of a
+	// role.
+	RoleVaultSigningMode VaultSigningMode = "Role"
+
+	// VerbatimVaultSigningMode signs certificates using the `sign-verbatim`
+	// endpoint.
+	VerbatimVaultSigningMode VaultSigningMode = "Verbatim"
+)
+
 // Configuration used to authenticate with a Vault server.
 // Only one of `tokenSecretRef`, `appRole` or `kubernetes` may be specified.
 type VaultAuth struct {
@@ -256,6 +506,85 @@ type CAIssuer struct {
 	// certificate will be issued with no OCSP servers set. For example, an
 	// OCSP server URL could be "http://ocsp.int-x3.letsencrypt.org".
 	OCSPServers []string
+
+	// KMS configures the private key of the CA to be held by a cloud key
+	// management service, so that it never leaves the service. If set, the
+	// Secret named by `secretName` only needs to contain the CA certificate,
+	// and any private key in it is ignored.
+	KMS *CAIssuerKMS
+
+	// PKCS11 configures the private key of the CA to be held by a PKCS#11
+	// token, such as a hardware security module, so that it never leaves the
+	// token. If set, the Secret named by `secretName` only needs to contain
+	// the CA certificate, and any private key in it is ignored. Only one of
+	// `kms` or `pkcs11` may be set. PKCS#11 support is only available if
+	// cert-manager is built with the `pkcs11` build tag.
+	PKCS11 *CAIssuerPKCS11
+}
+
+// CAIssuerKMS configures the cloud key management service holding the private
+// key of a CA issuer. Exactly one of `aws` or `googleCloud` must be set.
+type CAIssuerKMS struct {
+	// AWS configures an asymmetric signing key held by AWS KMS.
+	AWS *CAIssuerAWSKMS
+
+	// GoogleCloud configures an asymmetric signing key version held by
+	// Google Cloud KMS.
+	GoogleCloud *CAIssuerGoogleCloudKMS
+}
+
+// CAIssuerAWSKMS configures an asymmetric signing key held by AWS KMS.
+type CAIssuerAWSKMS struct {
+	// KeyID is the ID or ARN of the key, or of an alias of the key.
+	KeyID string
+
+	// Region is the AWS region of the key.
+	Region string
+
+	// The AccessKeyID is used for authentication. If not set we fall-back to
+	// using ambient credentials, if the Issuer is allowed to use them.
+	AccessKeyID string
+
+	// The SecretAccessKey is used for authentication, and must be set if
+	// AccessKeyID is set.
+	SecretAccessKey *cmmeta.SecretKeySelector
+
+	// Role is a Role ARN which will be assumed using either the explicit
+	// credentials AccessKeyID/SecretAccessKey or the ambient credentials.
+	Role string
+}
+
+// CAIssuerGoogleCloudKMS configures an asymmetric signing key version held by
+// Google Cloud KMS.
+type CAIssuerGoogleCloudKMS struct {
+	// KeyVersion is the resource name of the key version, in the form
+	// `projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*`.
+	KeyVersion string
+
+	// ServiceAccount is a reference to a key of a Secret containing a service
+	// account key in JSON format. If not set we fall-back to using ambient
+	// credentials, if the Issuer is allowed to use them.
+	ServiceAccount *cmmeta.SecretKeySelector
+}
+
+// CAIssuerPKCS11 configures the PKCS#11 token holding the private key of a CA
+// issuer.
+type CAIssuerPKCS11 struct {
+	// ModulePath is the path of the PKCS#11 module of the token, which is a
+	// shared library that must be present in the filesystem of the
+	// cert-manager controller.
+	ModulePath string
+
+	// Slot is the ID of the slot holding the token.
+	Slot int64
+
+	// KeyLabel is the label of the private key on the token. If not set, the
+	// token must hold exactly one private key.
+	KeyLabel string
+
+	// PIN is a reference to a key of a Secret containing the user PIN of the
+	// token.
+	PIN cmmeta.SecretKeySelector
 }
 
 // IssuerStatus contains status information about an Issuer
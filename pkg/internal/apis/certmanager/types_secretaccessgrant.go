@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A SecretAccessGrant permits Certificates in other namespaces to store their
+// private key and certificate in Secrets in the namespace of the
+// SecretAccessGrant, by setting `spec.secretNamespace`.
+//
+// Grants are checked by the webhook when Certificates are created or updated,
+// and by the certificates-issuing controller before it writes to a Secret in
+// another namespace. If a namespace has more than one SecretAccessGrant, a
+// Certificate is permitted if any of them permits it.
+type SecretAccessGrant struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Desired state of the SecretAccessGrant resource.
+	Spec SecretAccessGrantSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecretAccessGrantList is a list of SecretAccessGrants
+type SecretAccessGrantList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []SecretAccessGrant
+}
+
+// SecretAccessGrantSpec defines which Certificates may store their data in
+// which Secrets of the namespace.
+type SecretAccessGrantSpec struct {
+	// From lists the Certificates which are permitted to target Secrets in
+	// this namespace.
+	From []SecretAccessGrantFrom
+
+	// SecretNames limits the Secrets that permitted Certificates may target.
+	// If not set, any Secret in the namespace may be targeted.
+	SecretNames []string
+}
+
+// SecretAccessGrantFrom selects Certificates which are permitted by a
+// SecretAccessGrant.
+type SecretAccessGrantFrom struct {
+	// Namespace is the namespace of the permitted Certificates.
+	Namespace string
+
+	// CertificateNames limits the permitted Certificates to those with the
+	// given names. If not set, all Certificates in the namespace are
+	// permitted.
+	CertificateNames []string
+}
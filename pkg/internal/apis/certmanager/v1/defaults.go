@@ -17,9 +17,76 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	// defaultRSAKeySize is the size of RSA private keys when
+	// spec.privateKey.size is not set.
+	defaultRSAKeySize = 2048
+
+	// defaultECDSAKeySize is the size of ECDSA private keys when
+	// spec.privateKey.size is not set.
+	defaultECDSAKeySize = 256
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_CertificateSpec fills in the values that controllers would
+// otherwise assume when a field of a Certificate spec is not set, so that
+// stored Certificates are fully specified.
+func SetDefaults_CertificateSpec(obj *cmapi.CertificateSpec) {
+	if obj.IssuerRef.Group == "" {
+		obj.IssuerRef.Group = certmanager.GroupName
+	}
+	for i := range obj.FallbackIssuerRefs {
+		if obj.FallbackIssuerRefs[i].Group == "" {
+			obj.FallbackIssuerRefs[i].Group = certmanager.GroupName
+		}
+	}
+
+	if obj.PrivateKey == nil {
+		obj.PrivateKey = &cmapi.CertificatePrivateKey{}
+	}
+	if obj.PrivateKey.Algorithm == "" {
+		obj.PrivateKey.Algorithm = cmapi.RSAKeyAlgorithm
+	}
+	if obj.PrivateKey.Size == 0 {
+		switch obj.PrivateKey.Algorithm {
+		case cmapi.RSAKeyAlgorithm:
+			obj.PrivateKey.Size = defaultRSAKeySize
+		case cmapi.ECDSAKeyAlgorithm:
+			obj.PrivateKey.Size = defaultECDSAKeySize
+		}
+	}
+
+	// The usages of a profile are implied by the profile itself, and
+	// spec.usages must not be set alongside it.
+	if len(obj.Usages) == 0 && len(obj.Profile) == 0 {
+		obj.Usages = cmapi.DefaultKeyUsages()
+	}
+
+	// Certificates are renewed when two thirds of their duration has
+	// elapsed unless spec.renewBefore is set.
+	if obj.RenewBefore == nil {
+		obj.RenewBefore = &metav1.Duration{Duration: DefaultRenewBefore(obj.Duration)}
+	}
+}
+
+// DefaultRenewBefore returns the renewBefore that is defaulted for a
+// Certificate with the given duration, which is one third of the duration.
+func DefaultRenewBefore(duration *metav1.Duration) time.Duration {
+	d := cmapi.DefaultCertificateDuration
+	if duration != nil {
+		d = duration.Duration
+	}
+	return d / 3
+}
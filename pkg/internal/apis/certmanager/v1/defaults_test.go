@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+)
+
+func TestSetDefaults_CertificateSpec(t *testing.T) {
+	tests := map[string]struct {
+		spec     cmapi.CertificateSpec
+		expected cmapi.CertificateSpec
+	}{
+		"defaults are set on an empty spec": {
+			expected: cmapi.CertificateSpec{
+				IssuerRef:   cmmeta.ObjectReference{Group: "cert-manager.io"},
+				PrivateKey:  &cmapi.CertificatePrivateKey{Algorithm: cmapi.RSAKeyAlgorithm, Size: 2048},
+				Usages:      cmapi.DefaultKeyUsages(),
+				RenewBefore: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			},
+		},
+		"ECDSA key size and renewBefore follow the spec": {
+			spec: cmapi.CertificateSpec{
+				Duration:   &metav1.Duration{Duration: 3 * time.Hour},
+				PrivateKey: &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+			},
+			expected: cmapi.CertificateSpec{
+				Duration:    &metav1.Duration{Duration: 3 * time.Hour},
+				IssuerRef:   cmmeta.ObjectReference{Group: "cert-manager.io"},
+				PrivateKey:  &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm, Size: 256},
+				Usages:      cmapi.DefaultKeyUsages(),
+				RenewBefore: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		"values which are set are not changed": {
+			spec: cmapi.CertificateSpec{
+				IssuerRef:          cmmeta.ObjectReference{Group: "example.com"},
+				FallbackIssuerRefs: []cmmeta.ObjectReference{{Name: "fallback"}},
+				PrivateKey:         &cmapi.CertificatePrivateKey{Algorithm: cmapi.Ed25519KeyAlgorithm},
+				Profile:            cmapi.ServerCertificateProfile,
+				RenewBefore:        &metav1.Duration{Duration: time.Hour},
+			},
+			expected: cmapi.CertificateSpec{
+				IssuerRef:          cmmeta.ObjectReference{Group: "example.com"},
+				FallbackIssuerRefs: []cmmeta.ObjectReference{{Name: "fallback", Group: "cert-manager.io"}},
+				PrivateKey:         &cmapi.CertificatePrivateKey{Algorithm: cmapi.Ed25519KeyAlgorithm},
+				Profile:            cmapi.ServerCertificateProfile,
+				RenewBefore:        &metav1.Duration{Duration: time.Hour},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			SetDefaults_CertificateSpec(&test.spec)
+			assert.Equal(t, test.expected, test.spec)
+		})
+	}
+}
@@ -30,8 +30,8 @@ import (
 	acmev1 "github.com/jetstack/cert-manager/pkg/internal/apis/acme/v1"
 	certmanager "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	meta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
-	internalapismetav1 "github.com/jetstack/cert-manager/pkg/internal/apis/meta/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "github.com/jetstack/cert-manager/pkg/internal/apis/meta/v1"
+	pkgapismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -53,6 +53,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CAIssuerAWSKMS)(nil), (*certmanager.CAIssuerAWSKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(a.(*v1.CAIssuerAWSKMS), b.(*certmanager.CAIssuerAWSKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerAWSKMS)(nil), (*v1.CAIssuerAWSKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerAWSKMS_To_v1_CAIssuerAWSKMS(a.(*certmanager.CAIssuerAWSKMS), b.(*v1.CAIssuerAWSKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CAIssuerGoogleCloudKMS)(nil), (*certmanager.CAIssuerGoogleCloudKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(a.(*v1.CAIssuerGoogleCloudKMS), b.(*certmanager.CAIssuerGoogleCloudKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerGoogleCloudKMS)(nil), (*v1.CAIssuerGoogleCloudKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1_CAIssuerGoogleCloudKMS(a.(*certmanager.CAIssuerGoogleCloudKMS), b.(*v1.CAIssuerGoogleCloudKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CAIssuerKMS)(nil), (*certmanager.CAIssuerKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CAIssuerKMS_To_certmanager_CAIssuerKMS(a.(*v1.CAIssuerKMS), b.(*certmanager.CAIssuerKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerKMS)(nil), (*v1.CAIssuerKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerKMS_To_v1_CAIssuerKMS(a.(*certmanager.CAIssuerKMS), b.(*v1.CAIssuerKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CAIssuerPKCS11)(nil), (*certmanager.CAIssuerPKCS11)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(a.(*v1.CAIssuerPKCS11), b.(*certmanager.CAIssuerPKCS11), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerPKCS11)(nil), (*v1.CAIssuerPKCS11)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerPKCS11_To_v1_CAIssuerPKCS11(a.(*certmanager.CAIssuerPKCS11), b.(*v1.CAIssuerPKCS11), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CMPIssuer)(nil), (*certmanager.CMPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CMPIssuer_To_certmanager_CMPIssuer(a.(*v1.CMPIssuer), b.(*certmanager.CMPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPIssuer)(nil), (*v1.CMPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPIssuer_To_v1_CMPIssuer(a.(*certmanager.CMPIssuer), b.(*v1.CMPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CMPMACProtection)(nil), (*certmanager.CMPMACProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CMPMACProtection_To_certmanager_CMPMACProtection(a.(*v1.CMPMACProtection), b.(*certmanager.CMPMACProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPMACProtection)(nil), (*v1.CMPMACProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPMACProtection_To_v1_CMPMACProtection(a.(*certmanager.CMPMACProtection), b.(*v1.CMPMACProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CMPSignatureProtection)(nil), (*certmanager.CMPSignatureProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(a.(*v1.CMPSignatureProtection), b.(*certmanager.CMPSignatureProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPSignatureProtection)(nil), (*v1.CMPSignatureProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPSignatureProtection_To_v1_CMPSignatureProtection(a.(*certmanager.CMPSignatureProtection), b.(*v1.CMPSignatureProtection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.Certificate)(nil), (*certmanager.Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Certificate_To_certmanager_Certificate(a.(*v1.Certificate), b.(*certmanager.Certificate), scope)
 	}); err != nil {
@@ -63,6 +133,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateACMESolverStatus)(nil), (*certmanager.CertificateACMESolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(a.(*v1.CertificateACMESolverStatus), b.(*certmanager.CertificateACMESolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateACMESolverStatus)(nil), (*v1.CertificateACMESolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateACMESolverStatus_To_v1_CertificateACMESolverStatus(a.(*certmanager.CertificateACMESolverStatus), b.(*v1.CertificateACMESolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateAdditionalOutputFormat)(nil), (*certmanager.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(a.(*v1.CertificateAdditionalOutputFormat), b.(*certmanager.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalOutputFormat)(nil), (*v1.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(a.(*certmanager.CertificateAdditionalOutputFormat), b.(*v1.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateCAChain)(nil), (*certmanager.CertificateCAChain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateCAChain_To_certmanager_CertificateCAChain(a.(*v1.CertificateCAChain), b.(*certmanager.CertificateCAChain), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateCAChain)(nil), (*v1.CertificateCAChain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateCAChain_To_v1_CertificateCAChain(a.(*certmanager.CertificateCAChain), b.(*v1.CertificateCAChain), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(a.(*v1.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -73,6 +173,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateExternalPrivateKey)(nil), (*certmanager.CertificateExternalPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(a.(*v1.CertificateExternalPrivateKey), b.(*certmanager.CertificateExternalPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateExternalPrivateKey)(nil), (*v1.CertificateExternalPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateExternalPrivateKey_To_v1_CertificateExternalPrivateKey(a.(*certmanager.CertificateExternalPrivateKey), b.(*v1.CertificateExternalPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateIssuanceAttempt)(nil), (*certmanager.CertificateIssuanceAttempt)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(a.(*v1.CertificateIssuanceAttempt), b.(*certmanager.CertificateIssuanceAttempt), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuanceAttempt)(nil), (*v1.CertificateIssuanceAttempt)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuanceAttempt_To_v1_CertificateIssuanceAttempt(a.(*certmanager.CertificateIssuanceAttempt), b.(*v1.CertificateIssuanceAttempt), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateIssuanceDetails)(nil), (*certmanager.CertificateIssuanceDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(a.(*v1.CertificateIssuanceDetails), b.(*certmanager.CertificateIssuanceDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuanceDetails)(nil), (*v1.CertificateIssuanceDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuanceDetails_To_v1_CertificateIssuanceDetails(a.(*certmanager.CertificateIssuanceDetails), b.(*v1.CertificateIssuanceDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateIssuancePlan)(nil), (*certmanager.CertificateIssuancePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(a.(*v1.CertificateIssuancePlan), b.(*certmanager.CertificateIssuancePlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuancePlan)(nil), (*v1.CertificateIssuancePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuancePlan_To_v1_CertificateIssuancePlan(a.(*certmanager.CertificateIssuancePlan), b.(*v1.CertificateIssuancePlan), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*v1.CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -103,6 +243,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRenewalWindow)(nil), (*certmanager.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(a.(*v1.CertificateRenewalWindow), b.(*certmanager.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindow)(nil), (*v1.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(a.(*certmanager.CertificateRenewalWindow), b.(*v1.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRenewalWindowRange)(nil), (*certmanager.CertificateRenewalWindowRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(a.(*v1.CertificateRenewalWindowRange), b.(*certmanager.CertificateRenewalWindowRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindowRange)(nil), (*v1.CertificateRenewalWindowRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindowRange_To_v1_CertificateRenewalWindowRange(a.(*certmanager.CertificateRenewalWindowRange), b.(*v1.CertificateRenewalWindowRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateDualCertificate)(nil), (*certmanager.CertificateDualCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(a.(*v1.CertificateDualCertificate), b.(*certmanager.CertificateDualCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateDualCertificate)(nil), (*v1.CertificateDualCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateDualCertificate_To_v1_CertificateDualCertificate(a.(*certmanager.CertificateDualCertificate), b.(*v1.CertificateDualCertificate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -173,6 +343,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateTrustStore)(nil), (*certmanager.CertificateTrustStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateTrustStore_To_certmanager_CertificateTrustStore(a.(*v1.CertificateTrustStore), b.(*certmanager.CertificateTrustStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateTrustStore)(nil), (*v1.CertificateTrustStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateTrustStore_To_v1_CertificateTrustStore(a.(*certmanager.CertificateTrustStore), b.(*v1.CertificateTrustStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ClusterCertificate)(nil), (*certmanager.ClusterCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterCertificate_To_certmanager_ClusterCertificate(a.(*v1.ClusterCertificate), b.(*certmanager.ClusterCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.ClusterCertificate)(nil), (*v1.ClusterCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_ClusterCertificate_To_v1_ClusterCertificate(a.(*certmanager.ClusterCertificate), b.(*v1.ClusterCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ClusterCertificateList)(nil), (*certmanager.ClusterCertificateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterCertificateList_To_certmanager_ClusterCertificateList(a.(*v1.ClusterCertificateList), b.(*certmanager.ClusterCertificateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.ClusterCertificateList)(nil), (*v1.ClusterCertificateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_ClusterCertificateList_To_v1_ClusterCertificateList(a.(*certmanager.ClusterCertificateList), b.(*v1.ClusterCertificateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ClusterCertificateSpec)(nil), (*certmanager.ClusterCertificateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterCertificateSpec_To_certmanager_ClusterCertificateSpec(a.(*v1.ClusterCertificateSpec), b.(*certmanager.ClusterCertificateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.ClusterCertificateSpec)(nil), (*v1.ClusterCertificateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_ClusterCertificateSpec_To_v1_ClusterCertificateSpec(a.(*certmanager.ClusterCertificateSpec), b.(*v1.ClusterCertificateSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ClusterIssuer)(nil), (*certmanager.ClusterIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(a.(*v1.ClusterIssuer), b.(*certmanager.ClusterIssuer), scope)
 	}); err != nil {
@@ -193,6 +403,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuanceQuota)(nil), (*certmanager.IssuanceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuanceQuota_To_certmanager_IssuanceQuota(a.(*v1.IssuanceQuota), b.(*certmanager.IssuanceQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuanceQuota)(nil), (*v1.IssuanceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuanceQuota_To_v1_IssuanceQuota(a.(*certmanager.IssuanceQuota), b.(*v1.IssuanceQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuanceQuotaList)(nil), (*certmanager.IssuanceQuotaList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuanceQuotaList_To_certmanager_IssuanceQuotaList(a.(*v1.IssuanceQuotaList), b.(*certmanager.IssuanceQuotaList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuanceQuotaList)(nil), (*v1.IssuanceQuotaList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuanceQuotaList_To_v1_IssuanceQuotaList(a.(*certmanager.IssuanceQuotaList), b.(*v1.IssuanceQuotaList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuanceQuotaSpec)(nil), (*certmanager.IssuanceQuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuanceQuotaSpec_To_certmanager_IssuanceQuotaSpec(a.(*v1.IssuanceQuotaSpec), b.(*certmanager.IssuanceQuotaSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuanceQuotaSpec)(nil), (*v1.IssuanceQuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuanceQuotaSpec_To_v1_IssuanceQuotaSpec(a.(*certmanager.IssuanceQuotaSpec), b.(*v1.IssuanceQuotaSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuanceQuotaStatus)(nil), (*certmanager.IssuanceQuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuanceQuotaStatus_To_certmanager_IssuanceQuotaStatus(a.(*v1.IssuanceQuotaStatus), b.(*certmanager.IssuanceQuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuanceQuotaStatus)(nil), (*v1.IssuanceQuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuanceQuotaStatus_To_v1_IssuanceQuotaStatus(a.(*certmanager.IssuanceQuotaStatus), b.(*v1.IssuanceQuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.Issuer)(nil), (*certmanager.Issuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Issuer_To_certmanager_Issuer(a.(*v1.Issuer), b.(*certmanager.Issuer), scope)
 	}); err != nil {
@@ -213,6 +463,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerCertificateDefaults)(nil), (*certmanager.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(a.(*v1.IssuerCertificateDefaults), b.(*certmanager.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerCertificateDefaults)(nil), (*v1.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults(a.(*certmanager.IssuerCertificateDefaults), b.(*v1.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.IssuerConfig)(nil), (*certmanager.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(a.(*v1.IssuerConfig), b.(*certmanager.IssuerConfig), scope)
 	}); err != nil {
@@ -233,6 +493,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerPolicy)(nil), (*certmanager.IssuerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerPolicy_To_certmanager_IssuerPolicy(a.(*v1.IssuerPolicy), b.(*certmanager.IssuerPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerPolicy)(nil), (*v1.IssuerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerPolicy_To_v1_IssuerPolicy(a.(*certmanager.IssuerPolicy), b.(*v1.IssuerPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.IssuerSpec)(nil), (*certmanager.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(a.(*v1.IssuerSpec), b.(*certmanager.IssuerSpec), scope)
 	}); err != nil {
@@ -263,6 +533,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationRoute)(nil), (*certmanager.NotificationRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationRoute_To_certmanager_NotificationRoute(a.(*v1.NotificationRoute), b.(*certmanager.NotificationRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationRoute)(nil), (*v1.NotificationRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationRoute_To_v1_NotificationRoute(a.(*certmanager.NotificationRoute), b.(*v1.NotificationRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationRouteList)(nil), (*certmanager.NotificationRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationRouteList_To_certmanager_NotificationRouteList(a.(*v1.NotificationRouteList), b.(*certmanager.NotificationRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationRouteList)(nil), (*v1.NotificationRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationRouteList_To_v1_NotificationRouteList(a.(*certmanager.NotificationRouteList), b.(*v1.NotificationRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationRouteSpec)(nil), (*certmanager.NotificationRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationRouteSpec_To_certmanager_NotificationRouteSpec(a.(*v1.NotificationRouteSpec), b.(*certmanager.NotificationRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationRouteSpec)(nil), (*v1.NotificationRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationRouteSpec_To_v1_NotificationRouteSpec(a.(*certmanager.NotificationRouteSpec), b.(*v1.NotificationRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationRouteStatus)(nil), (*certmanager.NotificationRouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationRouteStatus_To_certmanager_NotificationRouteStatus(a.(*v1.NotificationRouteStatus), b.(*certmanager.NotificationRouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationRouteStatus)(nil), (*v1.NotificationRouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationRouteStatus_To_v1_NotificationRouteStatus(a.(*certmanager.NotificationRouteStatus), b.(*v1.NotificationRouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NotificationWebhook)(nil), (*certmanager.NotificationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NotificationWebhook_To_certmanager_NotificationWebhook(a.(*v1.NotificationWebhook), b.(*certmanager.NotificationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NotificationWebhook)(nil), (*v1.NotificationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NotificationWebhook_To_v1_NotificationWebhook(a.(*certmanager.NotificationWebhook), b.(*v1.NotificationWebhook), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.PKCS12Keystore)(nil), (*certmanager.PKCS12Keystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(a.(*v1.PKCS12Keystore), b.(*certmanager.PKCS12Keystore), scope)
 	}); err != nil {
@@ -273,6 +593,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.RequesterIdentityPolicy)(nil), (*certmanager.RequesterIdentityPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(a.(*v1.RequesterIdentityPolicy), b.(*certmanager.RequesterIdentityPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.RequesterIdentityPolicy)(nil), (*v1.RequesterIdentityPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_RequesterIdentityPolicy_To_v1_RequesterIdentityPolicy(a.(*certmanager.RequesterIdentityPolicy), b.(*v1.RequesterIdentityPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.SCEPIssuer)(nil), (*certmanager.SCEPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SCEPIssuer_To_certmanager_SCEPIssuer(a.(*v1.SCEPIssuer), b.(*certmanager.SCEPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SCEPIssuer)(nil), (*v1.SCEPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SCEPIssuer_To_v1_SCEPIssuer(a.(*certmanager.SCEPIssuer), b.(*v1.SCEPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.SecretAccessGrant)(nil), (*certmanager.SecretAccessGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecretAccessGrant_To_certmanager_SecretAccessGrant(a.(*v1.SecretAccessGrant), b.(*certmanager.SecretAccessGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SecretAccessGrant)(nil), (*v1.SecretAccessGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SecretAccessGrant_To_v1_SecretAccessGrant(a.(*certmanager.SecretAccessGrant), b.(*v1.SecretAccessGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.SecretAccessGrantFrom)(nil), (*certmanager.SecretAccessGrantFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecretAccessGrantFrom_To_certmanager_SecretAccessGrantFrom(a.(*v1.SecretAccessGrantFrom), b.(*certmanager.SecretAccessGrantFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SecretAccessGrantFrom)(nil), (*v1.SecretAccessGrantFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SecretAccessGrantFrom_To_v1_SecretAccessGrantFrom(a.(*certmanager.SecretAccessGrantFrom), b.(*v1.SecretAccessGrantFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.SecretAccessGrantList)(nil), (*certmanager.SecretAccessGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecretAccessGrantList_To_certmanager_SecretAccessGrantList(a.(*v1.SecretAccessGrantList), b.(*certmanager.SecretAccessGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SecretAccessGrantList)(nil), (*v1.SecretAccessGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SecretAccessGrantList_To_v1_SecretAccessGrantList(a.(*certmanager.SecretAccessGrantList), b.(*v1.SecretAccessGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.SecretAccessGrantSpec)(nil), (*certmanager.SecretAccessGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecretAccessGrantSpec_To_certmanager_SecretAccessGrantSpec(a.(*v1.SecretAccessGrantSpec), b.(*certmanager.SecretAccessGrantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SecretAccessGrantSpec)(nil), (*v1.SecretAccessGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SecretAccessGrantSpec_To_v1_SecretAccessGrantSpec(a.(*certmanager.SecretAccessGrantSpec), b.(*v1.SecretAccessGrantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.SelfSignedBootstrapCA)(nil), (*certmanager.SelfSignedBootstrapCA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(a.(*v1.SelfSignedBootstrapCA), b.(*certmanager.SelfSignedBootstrapCA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SelfSignedBootstrapCA)(nil), (*v1.SelfSignedBootstrapCA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SelfSignedBootstrapCA_To_v1_SelfSignedBootstrapCA(a.(*certmanager.SelfSignedBootstrapCA), b.(*v1.SelfSignedBootstrapCA), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*v1.SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
 	}); err != nil {
@@ -283,6 +673,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.SentNotification)(nil), (*certmanager.SentNotification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SentNotification_To_certmanager_SentNotification(a.(*v1.SentNotification), b.(*certmanager.SentNotification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SentNotification)(nil), (*v1.SentNotification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SentNotification_To_v1_SentNotification(a.(*certmanager.SentNotification), b.(*v1.SentNotification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.VaultAppRole)(nil), (*certmanager.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_VaultAppRole_To_certmanager_VaultAppRole(a.(*v1.VaultAppRole), b.(*certmanager.VaultAppRole), scope)
 	}); err != nil {
@@ -353,6 +753,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.X509Extension)(nil), (*certmanager.X509Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_X509Extension_To_certmanager_X509Extension(a.(*v1.X509Extension), b.(*certmanager.X509Extension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.X509Extension)(nil), (*v1.X509Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_X509Extension_To_v1_X509Extension(a.(*certmanager.X509Extension), b.(*v1.X509Extension), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.X509Subject)(nil), (*certmanager.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_X509Subject_To_certmanager_X509Subject(a.(*v1.X509Subject), b.(*certmanager.X509Subject), scope)
 	}); err != nil {
@@ -380,6 +790,24 @@ func autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in *v1.CAIssuer, out *certm
 	out.SecretName = in.SecretName
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(certmanager.CAIssuerKMS)
+		if err := Convert_v1_CAIssuerKMS_To_certmanager_CAIssuerKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KMS = nil
+	}
+	if in.PKCS11 != nil {
+		in, out := &in.PKCS11, &out.PKCS11
+		*out = new(certmanager.CAIssuerPKCS11)
+		if err := Convert_v1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PKCS11 = nil
+	}
 	return nil
 }
 
@@ -392,6 +820,24 @@ func autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, o
 	out.SecretName = in.SecretName
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(v1.CAIssuerKMS)
+		if err := Convert_certmanager_CAIssuerKMS_To_v1_CAIssuerKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KMS = nil
+	}
+	if in.PKCS11 != nil {
+		in, out := &in.PKCS11, &out.PKCS11
+		*out = new(v1.CAIssuerPKCS11)
+		if err := Convert_certmanager_CAIssuerPKCS11_To_v1_CAIssuerPKCS11(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PKCS11 = nil
+	}
 	return nil
 }
 
@@ -400,6 +846,280 @@ func Convert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, out *
 	return autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in, out, s)
 }
 
+func autoConvert_v1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in *v1.CAIssuerAWSKMS, out *certmanager.CAIssuerAWSKMS, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.Region = in.Region
+	out.AccessKeyID = in.AccessKeyID
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretAccessKey = nil
+	}
+	out.Role = in.Role
+	return nil
+}
+
+// Convert_v1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS is an autogenerated conversion function.
+func Convert_v1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in *v1.CAIssuerAWSKMS, out *certmanager.CAIssuerAWSKMS, s conversion.Scope) error {
+	return autoConvert_v1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerAWSKMS_To_v1_CAIssuerAWSKMS(in *certmanager.CAIssuerAWSKMS, out *v1.CAIssuerAWSKMS, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.Region = in.Region
+	out.AccessKeyID = in.AccessKeyID
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(apismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretAccessKey = nil
+	}
+	out.Role = in.Role
+	return nil
+}
+
+// Convert_certmanager_CAIssuerAWSKMS_To_v1_CAIssuerAWSKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerAWSKMS_To_v1_CAIssuerAWSKMS(in *certmanager.CAIssuerAWSKMS, out *v1.CAIssuerAWSKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerAWSKMS_To_v1_CAIssuerAWSKMS(in, out, s)
+}
+
+func autoConvert_v1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in *v1.CAIssuerGoogleCloudKMS, out *certmanager.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	out.KeyVersion = in.KeyVersion
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccount = nil
+	}
+	return nil
+}
+
+// Convert_v1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS is an autogenerated conversion function.
+func Convert_v1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in *v1.CAIssuerGoogleCloudKMS, out *certmanager.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	return autoConvert_v1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerGoogleCloudKMS_To_v1_CAIssuerGoogleCloudKMS(in *certmanager.CAIssuerGoogleCloudKMS, out *v1.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	out.KeyVersion = in.KeyVersion
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(apismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccount = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1_CAIssuerGoogleCloudKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1_CAIssuerGoogleCloudKMS(in *certmanager.CAIssuerGoogleCloudKMS, out *v1.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerGoogleCloudKMS_To_v1_CAIssuerGoogleCloudKMS(in, out, s)
+}
+
+func autoConvert_v1_CAIssuerKMS_To_certmanager_CAIssuerKMS(in *v1.CAIssuerKMS, out *certmanager.CAIssuerKMS, s conversion.Scope) error {
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(certmanager.CAIssuerAWSKMS)
+		if err := Convert_v1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWS = nil
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(certmanager.CAIssuerGoogleCloudKMS)
+		if err := Convert_v1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCloud = nil
+	}
+	return nil
+}
+
+// Convert_v1_CAIssuerKMS_To_certmanager_CAIssuerKMS is an autogenerated conversion function.
+func Convert_v1_CAIssuerKMS_To_certmanager_CAIssuerKMS(in *v1.CAIssuerKMS, out *certmanager.CAIssuerKMS, s conversion.Scope) error {
+	return autoConvert_v1_CAIssuerKMS_To_certmanager_CAIssuerKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerKMS_To_v1_CAIssuerKMS(in *certmanager.CAIssuerKMS, out *v1.CAIssuerKMS, s conversion.Scope) error {
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(v1.CAIssuerAWSKMS)
+		if err := Convert_certmanager_CAIssuerAWSKMS_To_v1_CAIssuerAWSKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWS = nil
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(v1.CAIssuerGoogleCloudKMS)
+		if err := Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1_CAIssuerGoogleCloudKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCloud = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerKMS_To_v1_CAIssuerKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerKMS_To_v1_CAIssuerKMS(in *certmanager.CAIssuerKMS, out *v1.CAIssuerKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerKMS_To_v1_CAIssuerKMS(in, out, s)
+}
+
+func autoConvert_v1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in *v1.CAIssuerPKCS11, out *certmanager.CAIssuerPKCS11, s conversion.Scope) error {
+	out.ModulePath = in.ModulePath
+	out.Slot = in.Slot
+	out.KeyLabel = in.KeyLabel
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PIN, &out.PIN, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11 is an autogenerated conversion function.
+func Convert_v1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in *v1.CAIssuerPKCS11, out *certmanager.CAIssuerPKCS11, s conversion.Scope) error {
+	return autoConvert_v1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerPKCS11_To_v1_CAIssuerPKCS11(in *certmanager.CAIssuerPKCS11, out *v1.CAIssuerPKCS11, s conversion.Scope) error {
+	out.ModulePath = in.ModulePath
+	out.Slot = in.Slot
+	out.KeyLabel = in.KeyLabel
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PIN, &out.PIN, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerPKCS11_To_v1_CAIssuerPKCS11 is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerPKCS11_To_v1_CAIssuerPKCS11(in *certmanager.CAIssuerPKCS11, out *v1.CAIssuerPKCS11, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerPKCS11_To_v1_CAIssuerPKCS11(in, out, s)
+}
+
+func autoConvert_v1_CMPIssuer_To_certmanager_CMPIssuer(in *v1.CMPIssuer, out *certmanager.CMPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(certmanager.CMPMACProtection)
+		if err := Convert_v1_CMPMACProtection_To_certmanager_CMPMACProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MAC = nil
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(certmanager.CMPSignatureProtection)
+		if err := Convert_v1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Signature = nil
+	}
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1_CMPIssuer_To_certmanager_CMPIssuer is an autogenerated conversion function.
+func Convert_v1_CMPIssuer_To_certmanager_CMPIssuer(in *v1.CMPIssuer, out *certmanager.CMPIssuer, s conversion.Scope) error {
+	return autoConvert_v1_CMPIssuer_To_certmanager_CMPIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_CMPIssuer_To_v1_CMPIssuer(in *certmanager.CMPIssuer, out *v1.CMPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(v1.CMPMACProtection)
+		if err := Convert_certmanager_CMPMACProtection_To_v1_CMPMACProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MAC = nil
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(v1.CMPSignatureProtection)
+		if err := Convert_certmanager_CMPSignatureProtection_To_v1_CMPSignatureProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Signature = nil
+	}
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_certmanager_CMPIssuer_To_v1_CMPIssuer is an autogenerated conversion function.
+func Convert_certmanager_CMPIssuer_To_v1_CMPIssuer(in *certmanager.CMPIssuer, out *v1.CMPIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPIssuer_To_v1_CMPIssuer(in, out, s)
+}
+
+func autoConvert_v1_CMPMACProtection_To_certmanager_CMPMACProtection(in *v1.CMPMACProtection, out *certmanager.CMPMACProtection, s conversion.Scope) error {
+	out.Reference = in.Reference
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CMPMACProtection_To_certmanager_CMPMACProtection is an autogenerated conversion function.
+func Convert_v1_CMPMACProtection_To_certmanager_CMPMACProtection(in *v1.CMPMACProtection, out *certmanager.CMPMACProtection, s conversion.Scope) error {
+	return autoConvert_v1_CMPMACProtection_To_certmanager_CMPMACProtection(in, out, s)
+}
+
+func autoConvert_certmanager_CMPMACProtection_To_v1_CMPMACProtection(in *certmanager.CMPMACProtection, out *v1.CMPMACProtection, s conversion.Scope) error {
+	out.Reference = in.Reference
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CMPMACProtection_To_v1_CMPMACProtection is an autogenerated conversion function.
+func Convert_certmanager_CMPMACProtection_To_v1_CMPMACProtection(in *certmanager.CMPMACProtection, out *v1.CMPMACProtection, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPMACProtection_To_v1_CMPMACProtection(in, out, s)
+}
+
+func autoConvert_v1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in *v1.CMPSignatureProtection, out *certmanager.CMPSignatureProtection, s conversion.Scope) error {
+	if err := metav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection is an autogenerated conversion function.
+func Convert_v1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in *v1.CMPSignatureProtection, out *certmanager.CMPSignatureProtection, s conversion.Scope) error {
+	return autoConvert_v1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in, out, s)
+}
+
+func autoConvert_certmanager_CMPSignatureProtection_To_v1_CMPSignatureProtection(in *certmanager.CMPSignatureProtection, out *v1.CMPSignatureProtection, s conversion.Scope) error {
+	if err := metav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CMPSignatureProtection_To_v1_CMPSignatureProtection is an autogenerated conversion function.
+func Convert_certmanager_CMPSignatureProtection_To_v1_CMPSignatureProtection(in *certmanager.CMPSignatureProtection, out *v1.CMPSignatureProtection, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPSignatureProtection_To_v1_CMPSignatureProtection(in, out, s)
+}
+
 func autoConvert_v1_Certificate_To_certmanager_Certificate(in *v1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -432,34 +1152,228 @@ func Convert_certmanager_Certificate_To_v1_Certificate(in *certmanager.Certifica
 	return autoConvert_certmanager_Certificate_To_v1_Certificate(in, out, s)
 }
 
+func autoConvert_v1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in *v1.CertificateACMESolverStatus, out *certmanager.CertificateACMESolverStatus, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.Type = in.Type
+	out.SolverIndex = (*int)(unsafe.Pointer(in.SolverIndex))
+	out.MatchedSelectors = *(*[]string)(unsafe.Pointer(&in.MatchedSelectors))
+	out.Zone = in.Zone
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus is an autogenerated conversion function.
+func Convert_v1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in *v1.CertificateACMESolverStatus, out *certmanager.CertificateACMESolverStatus, s conversion.Scope) error {
+	return autoConvert_v1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateACMESolverStatus_To_v1_CertificateACMESolverStatus(in *certmanager.CertificateACMESolverStatus, out *v1.CertificateACMESolverStatus, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.Type = in.Type
+	out.SolverIndex = (*int)(unsafe.Pointer(in.SolverIndex))
+	out.MatchedSelectors = *(*[]string)(unsafe.Pointer(&in.MatchedSelectors))
+	out.Zone = in.Zone
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateACMESolverStatus_To_v1_CertificateACMESolverStatus is an autogenerated conversion function.
+func Convert_certmanager_CertificateACMESolverStatus_To_v1_CertificateACMESolverStatus(in *certmanager.CertificateACMESolverStatus, out *v1.CertificateACMESolverStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateACMESolverStatus_To_v1_CertificateACMESolverStatus(in, out, s)
+}
+
+func autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = v1.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_v1_CertificateCAChain_To_certmanager_CertificateCAChain(in *v1.CertificateCAChain, out *certmanager.CertificateCAChain, s conversion.Scope) error {
+	out.Composition = certmanager.CertificateCAChainComposition(in.Composition)
+	out.CompleteChain = in.CompleteChain
+	return nil
+}
+
+// Convert_v1_CertificateCAChain_To_certmanager_CertificateCAChain is an autogenerated conversion function.
+func Convert_v1_CertificateCAChain_To_certmanager_CertificateCAChain(in *v1.CertificateCAChain, out *certmanager.CertificateCAChain, s conversion.Scope) error {
+	return autoConvert_v1_CertificateCAChain_To_certmanager_CertificateCAChain(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateCAChain_To_v1_CertificateCAChain(in *certmanager.CertificateCAChain, out *v1.CertificateCAChain, s conversion.Scope) error {
+	out.Composition = v1.CertificateCAChainComposition(in.Composition)
+	out.CompleteChain = in.CompleteChain
+	return nil
+}
+
+// Convert_certmanager_CertificateCAChain_To_v1_CertificateCAChain is an autogenerated conversion function.
+func Convert_certmanager_CertificateCAChain_To_v1_CertificateCAChain(in *certmanager.CertificateCAChain, out *v1.CertificateCAChain, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateCAChain_To_v1_CertificateCAChain(in, out, s)
+}
+
 func autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
 	return nil
 }
 
-// Convert_v1_CertificateCondition_To_certmanager_CertificateCondition is an autogenerated conversion function.
-func Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
-	return autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in, out, s)
+// Convert_v1_CertificateCondition_To_certmanager_CertificateCondition is an autogenerated conversion function.
+func Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
+	return autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *v1.CertificateCondition, s conversion.Scope) error {
+	out.Type = v1.CertificateConditionType(in.Type)
+	out.Status = apismetav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_certmanager_CertificateCondition_To_v1_CertificateCondition is an autogenerated conversion function.
+func Convert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *v1.CertificateCondition, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in, out, s)
+}
+
+func autoConvert_v1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in *v1.CertificateExternalPrivateKey, out *certmanager.CertificateExternalPrivateKey, s conversion.Scope) error {
+	out.Provider = in.Provider
+	return nil
+}
+
+// Convert_v1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey is an autogenerated conversion function.
+func Convert_v1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in *v1.CertificateExternalPrivateKey, out *certmanager.CertificateExternalPrivateKey, s conversion.Scope) error {
+	return autoConvert_v1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateExternalPrivateKey_To_v1_CertificateExternalPrivateKey(in *certmanager.CertificateExternalPrivateKey, out *v1.CertificateExternalPrivateKey, s conversion.Scope) error {
+	out.Provider = in.Provider
+	return nil
+}
+
+// Convert_certmanager_CertificateExternalPrivateKey_To_v1_CertificateExternalPrivateKey is an autogenerated conversion function.
+func Convert_certmanager_CertificateExternalPrivateKey_To_v1_CertificateExternalPrivateKey(in *certmanager.CertificateExternalPrivateKey, out *v1.CertificateExternalPrivateKey, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateExternalPrivateKey_To_v1_CertificateExternalPrivateKey(in, out, s)
+}
+
+func autoConvert_v1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in *v1.CertificateIssuanceAttempt, out *certmanager.CertificateIssuanceAttempt, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Outcome = certmanager.CertificateIssuanceOutcome(in.Outcome)
+	out.Message = in.Message
+	out.RequestName = in.RequestName
+	return nil
+}
+
+// Convert_v1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt is an autogenerated conversion function.
+func Convert_v1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in *v1.CertificateIssuanceAttempt, out *certmanager.CertificateIssuanceAttempt, s conversion.Scope) error {
+	return autoConvert_v1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateIssuanceAttempt_To_v1_CertificateIssuanceAttempt(in *certmanager.CertificateIssuanceAttempt, out *v1.CertificateIssuanceAttempt, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Outcome = v1.CertificateIssuanceOutcome(in.Outcome)
+	out.Message = in.Message
+	out.RequestName = in.RequestName
+	return nil
+}
+
+// Convert_certmanager_CertificateIssuanceAttempt_To_v1_CertificateIssuanceAttempt is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuanceAttempt_To_v1_CertificateIssuanceAttempt(in *certmanager.CertificateIssuanceAttempt, out *v1.CertificateIssuanceAttempt, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuanceAttempt_To_v1_CertificateIssuanceAttempt(in, out, s)
+}
+
+func autoConvert_v1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in *v1.CertificateIssuanceDetails, out *certmanager.CertificateIssuanceDetails, s conversion.Scope) error {
+	if err := metav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.SerialNumber = in.SerialNumber
+	out.Fingerprint = in.Fingerprint
+	return nil
+}
+
+// Convert_v1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails is an autogenerated conversion function.
+func Convert_v1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in *v1.CertificateIssuanceDetails, out *certmanager.CertificateIssuanceDetails, s conversion.Scope) error {
+	return autoConvert_v1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateIssuanceDetails_To_v1_CertificateIssuanceDetails(in *certmanager.CertificateIssuanceDetails, out *v1.CertificateIssuanceDetails, s conversion.Scope) error {
+	if err := metav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.SerialNumber = in.SerialNumber
+	out.Fingerprint = in.Fingerprint
+	return nil
+}
+
+// Convert_certmanager_CertificateIssuanceDetails_To_v1_CertificateIssuanceDetails is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuanceDetails_To_v1_CertificateIssuanceDetails(in *certmanager.CertificateIssuanceDetails, out *v1.CertificateIssuanceDetails, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuanceDetails_To_v1_CertificateIssuanceDetails(in, out, s)
+}
+
+func autoConvert_v1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in *v1.CertificateIssuancePlan, out *certmanager.CertificateIssuancePlan, s conversion.Scope) error {
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.IsCA = in.IsCA
+	out.Extensions = *(*[]certmanager.X509Extension)(unsafe.Pointer(&in.Extensions))
+	if err := metav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan is an autogenerated conversion function.
+func Convert_v1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in *v1.CertificateIssuancePlan, out *certmanager.CertificateIssuancePlan, s conversion.Scope) error {
+	return autoConvert_v1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *v1.CertificateCondition, s conversion.Scope) error {
-	out.Type = v1.CertificateConditionType(in.Type)
-	out.Status = apismetav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_certmanager_CertificateIssuancePlan_To_v1_CertificateIssuancePlan(in *certmanager.CertificateIssuancePlan, out *v1.CertificateIssuancePlan, s conversion.Scope) error {
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.IsCA = in.IsCA
+	out.Extensions = *(*[]v1.X509Extension)(unsafe.Pointer(&in.Extensions))
+	if err := metav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_certmanager_CertificateCondition_To_v1_CertificateCondition is an autogenerated conversion function.
-func Convert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *v1.CertificateCondition, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in, out, s)
+// Convert_certmanager_CertificateIssuancePlan_To_v1_CertificateIssuancePlan is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuancePlan_To_v1_CertificateIssuancePlan(in *certmanager.CertificateIssuancePlan, out *v1.CertificateIssuancePlan, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuancePlan_To_v1_CertificateIssuancePlan(in, out, s)
 }
 
 func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
@@ -560,9 +1474,20 @@ func Convert_certmanager_CertificateList_To_v1_CertificateList(in *certmanager.C
 
 func autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.External = (*certmanager.CertificateExternalPrivateKey)(unsafe.Pointer(in.External))
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretRef = nil
+	}
 	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.SignatureAlgorithm = certmanager.SignatureAlgorithm(in.SignatureAlgorithm)
 	return nil
 }
 
@@ -573,9 +1498,20 @@ func Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v
 
 func autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = v1.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.External = (*v1.CertificateExternalPrivateKey)(unsafe.Pointer(in.External))
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretRef = nil
+	}
 	out.Encoding = v1.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.SignatureAlgorithm = v1.SignatureAlgorithm(in.SignatureAlgorithm)
 	return nil
 }
 
@@ -584,6 +1520,74 @@ func Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *c
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in, out, s)
 }
 
+func autoConvert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Windows = *(*[]certmanager.CertificateRenewalWindowRange)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Windows = *(*[]v1.CertificateRenewalWindowRange)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_v1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in *v1.CertificateRenewalWindowRange, out *certmanager.CertificateRenewalWindowRange, s conversion.Scope) error {
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in *v1.CertificateRenewalWindowRange, out *certmanager.CertificateRenewalWindowRange, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindowRange_To_v1_CertificateRenewalWindowRange(in *certmanager.CertificateRenewalWindowRange, out *v1.CertificateRenewalWindowRange, s conversion.Scope) error {
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindowRange_To_v1_CertificateRenewalWindowRange is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindowRange_To_v1_CertificateRenewalWindowRange(in *certmanager.CertificateRenewalWindowRange, out *v1.CertificateRenewalWindowRange, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindowRange_To_v1_CertificateRenewalWindowRange(in, out, s)
+}
+
+func autoConvert_v1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in *v1.CertificateDualCertificate, out *certmanager.CertificateDualCertificate, s conversion.Scope) error {
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate is an autogenerated conversion function.
+func Convert_v1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in *v1.CertificateDualCertificate, out *certmanager.CertificateDualCertificate, s conversion.Scope) error {
+	return autoConvert_v1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateDualCertificate_To_v1_CertificateDualCertificate(in *certmanager.CertificateDualCertificate, out *v1.CertificateDualCertificate, s conversion.Scope) error {
+	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_certmanager_CertificateDualCertificate_To_v1_CertificateDualCertificate is an autogenerated conversion function.
+func Convert_certmanager_CertificateDualCertificate_To_v1_CertificateDualCertificate(in *certmanager.CertificateDualCertificate, out *v1.CertificateDualCertificate, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateDualCertificate_To_v1_CertificateDualCertificate(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *v1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -619,7 +1623,7 @@ func Convert_certmanager_CertificateRequest_To_v1_CertificateRequest(in *certman
 func autoConvert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateRequestConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	return nil
@@ -633,7 +1637,7 @@ func Convert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCon
 func autoConvert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in *certmanager.CertificateRequestCondition, out *v1.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = v1.CertificateRequestConditionType(in.Type)
 	out.Status = apismetav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	return nil
@@ -687,13 +1691,15 @@ func Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in
 }
 
 func autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Backdate = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Backdate))
+	if err := metav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Extensions = *(*[]certmanager.X509Extension)(unsafe.Pointer(&in.Extensions))
 	out.Username = in.Username
 	out.UID = in.UID
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
@@ -707,13 +1713,15 @@ func Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in
 }
 
 func autoConvert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(in *certmanager.CertificateRequestSpec, out *v1.CertificateRequestSpec, s conversion.Scope) error {
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Backdate = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Backdate))
+	if err := metav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Extensions = *(*[]v1.X509Extension)(unsafe.Pointer(&in.Extensions))
 	out.Username = in.Username
 	out.UID = in.UID
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
@@ -730,7 +1738,7 @@ func autoConvert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestSt
 	out.Conditions = *(*[]certmanager.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
-	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -743,7 +1751,7 @@ func autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestSt
 	out.Conditions = *(*[]v1.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
-	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -755,6 +1763,7 @@ func Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus
 func autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Keys = *(*map[string]string)(unsafe.Pointer(&in.Keys))
 	return nil
 }
 
@@ -766,6 +1775,7 @@ func Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTempla
 func autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Keys = *(*map[string]string)(unsafe.Pointer(&in.Keys))
 	return nil
 }
 
@@ -777,13 +1787,18 @@ func Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTempla
 func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
 	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
 	out.CommonName = in.CommonName
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*pkgapismetav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewalWindow = (*certmanager.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
+	out.IssuanceDeadline = (*pkgapismetav1.Duration)(unsafe.Pointer(in.IssuanceDeadline))
+	out.RenewRequestTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.RenewRequestTime))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	// WARNING: in.URIs requires manual conversion: does not exist in peer-type
 	// WARNING: in.EmailAddresses requires manual conversion: does not exist in peer-type
 	out.SecretName = in.SecretName
+	out.SecretNamespace = in.SecretNamespace
+	out.AdditionalSecretNames = *(*[]string)(unsafe.Pointer(&in.AdditionalSecretNames))
 	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
@@ -794,12 +1809,37 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 	} else {
 		out.Keystores = nil
 	}
-	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CAChain = (*certmanager.CertificateCAChain)(unsafe.Pointer(in.CAChain))
+	out.TrustStore = (*certmanager.CertificateTrustStore)(unsafe.Pointer(in.TrustStore))
+	out.DualCertificate = (*certmanager.CertificateDualCertificate)(unsafe.Pointer(in.DualCertificate))
+	if err := metav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]meta.ObjectReference, len(*in))
+		for i := range *in {
+			if err := metav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.IssuerFailoverThreshold = (*int)(unsafe.Pointer(in.IssuerFailoverThreshold))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.Profile = certmanager.CertificateProfile(in.Profile)
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(certmanager.CertificatePrivateKey)
+		if err := Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKey = nil
+	}
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -808,13 +1848,18 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanager.CertificateSpec, out *v1.CertificateSpec, s conversion.Scope) error {
 	out.Subject = (*v1.X509Subject)(unsafe.Pointer(in.Subject))
 	out.CommonName = in.CommonName
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*pkgapismetav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewalWindow = (*v1.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
+	out.IssuanceDeadline = (*pkgapismetav1.Duration)(unsafe.Pointer(in.IssuanceDeadline))
+	out.RenewRequestTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.RenewRequestTime))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	// WARNING: in.URISANs requires manual conversion: does not exist in peer-type
 	// WARNING: in.EmailSANs requires manual conversion: does not exist in peer-type
 	out.SecretName = in.SecretName
+	out.SecretNamespace = in.SecretNamespace
+	out.AdditionalSecretNames = *(*[]string)(unsafe.Pointer(&in.AdditionalSecretNames))
 	out.SecretTemplate = (*v1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
@@ -825,12 +1870,37 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	} else {
 		out.Keystores = nil
 	}
-	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.AdditionalOutputFormats = *(*[]v1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CAChain = (*v1.CertificateCAChain)(unsafe.Pointer(in.CAChain))
+	out.TrustStore = (*v1.CertificateTrustStore)(unsafe.Pointer(in.TrustStore))
+	out.DualCertificate = (*v1.CertificateDualCertificate)(unsafe.Pointer(in.DualCertificate))
+	if err := metav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]apismetav1.ObjectReference, len(*in))
+		for i := range *in {
+			if err := metav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.IssuerFailoverThreshold = (*int)(unsafe.Pointer(in.IssuerFailoverThreshold))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.PrivateKey = (*v1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.Profile = v1.CertificateProfile(in.Profile)
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(v1.CertificatePrivateKey)
+		if err := Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKey = nil
+	}
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -838,12 +1908,27 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 
 func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
-	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
-	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
-	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
-	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.LastFailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastRenewRequestTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastRenewRequestTime))
+	out.NotBefore = (*pkgapismetav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.ActiveRequestName = in.ActiveRequestName
+	out.LastIssuanceDetails = (*certmanager.CertificateIssuanceDetails)(unsafe.Pointer(in.LastIssuanceDetails))
+	out.IssuanceHistory = *(*[]certmanager.CertificateIssuanceAttempt)(unsafe.Pointer(&in.IssuanceHistory))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	if in.IssuancePlan != nil {
+		in, out := &in.IssuancePlan, &out.IssuancePlan
+		*out = new(certmanager.CertificateIssuancePlan)
+		if err := Convert_v1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IssuancePlan = nil
+	}
+	out.ACMESolvers = *(*[]certmanager.CertificateACMESolverStatus)(unsafe.Pointer(&in.ACMESolvers))
 	return nil
 }
 
@@ -854,12 +1939,27 @@ func Convert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.Certif
 
 func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanager.CertificateStatus, out *v1.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.CertificateCondition)(unsafe.Pointer(&in.Conditions))
-	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
-	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
-	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
-	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.LastFailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastRenewRequestTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastRenewRequestTime))
+	out.NotBefore = (*pkgapismetav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.ActiveRequestName = in.ActiveRequestName
+	out.LastIssuanceDetails = (*v1.CertificateIssuanceDetails)(unsafe.Pointer(in.LastIssuanceDetails))
+	out.IssuanceHistory = *(*[]v1.CertificateIssuanceAttempt)(unsafe.Pointer(&in.IssuanceHistory))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	if in.IssuancePlan != nil {
+		in, out := &in.IssuancePlan, &out.IssuancePlan
+		*out = new(v1.CertificateIssuancePlan)
+		if err := Convert_certmanager_CertificateIssuancePlan_To_v1_CertificateIssuancePlan(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IssuancePlan = nil
+	}
+	out.ACMESolvers = *(*[]v1.CertificateACMESolverStatus)(unsafe.Pointer(&in.ACMESolvers))
 	return nil
 }
 
@@ -868,6 +1968,128 @@ func Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanag
 	return autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in, out, s)
 }
 
+func autoConvert_v1_CertificateTrustStore_To_certmanager_CertificateTrustStore(in *v1.CertificateTrustStore, out *certmanager.CertificateTrustStore, s conversion.Scope) error {
+	out.Create = in.Create
+	return nil
+}
+
+// Convert_v1_CertificateTrustStore_To_certmanager_CertificateTrustStore is an autogenerated conversion function.
+func Convert_v1_CertificateTrustStore_To_certmanager_CertificateTrustStore(in *v1.CertificateTrustStore, out *certmanager.CertificateTrustStore, s conversion.Scope) error {
+	return autoConvert_v1_CertificateTrustStore_To_certmanager_CertificateTrustStore(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateTrustStore_To_v1_CertificateTrustStore(in *certmanager.CertificateTrustStore, out *v1.CertificateTrustStore, s conversion.Scope) error {
+	out.Create = in.Create
+	return nil
+}
+
+// Convert_certmanager_CertificateTrustStore_To_v1_CertificateTrustStore is an autogenerated conversion function.
+func Convert_certmanager_CertificateTrustStore_To_v1_CertificateTrustStore(in *certmanager.CertificateTrustStore, out *v1.CertificateTrustStore, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateTrustStore_To_v1_CertificateTrustStore(in, out, s)
+}
+
+func autoConvert_v1_ClusterCertificate_To_certmanager_ClusterCertificate(in *v1.ClusterCertificate, out *certmanager.ClusterCertificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ClusterCertificateSpec_To_certmanager_ClusterCertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CertificateStatus_To_certmanager_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterCertificate_To_certmanager_ClusterCertificate is an autogenerated conversion function.
+func Convert_v1_ClusterCertificate_To_certmanager_ClusterCertificate(in *v1.ClusterCertificate, out *certmanager.ClusterCertificate, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCertificate_To_certmanager_ClusterCertificate(in, out, s)
+}
+
+func autoConvert_certmanager_ClusterCertificate_To_v1_ClusterCertificate(in *certmanager.ClusterCertificate, out *v1.ClusterCertificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_ClusterCertificateSpec_To_v1_ClusterCertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ClusterCertificate_To_v1_ClusterCertificate is an autogenerated conversion function.
+func Convert_certmanager_ClusterCertificate_To_v1_ClusterCertificate(in *certmanager.ClusterCertificate, out *v1.ClusterCertificate, s conversion.Scope) error {
+	return autoConvert_certmanager_ClusterCertificate_To_v1_ClusterCertificate(in, out, s)
+}
+
+func autoConvert_v1_ClusterCertificateList_To_certmanager_ClusterCertificateList(in *v1.ClusterCertificateList, out *certmanager.ClusterCertificateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.ClusterCertificate, len(*in))
+		for i := range *in {
+			if err := Convert_v1_ClusterCertificate_To_certmanager_ClusterCertificate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_ClusterCertificateList_To_certmanager_ClusterCertificateList is an autogenerated conversion function.
+func Convert_v1_ClusterCertificateList_To_certmanager_ClusterCertificateList(in *v1.ClusterCertificateList, out *certmanager.ClusterCertificateList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCertificateList_To_certmanager_ClusterCertificateList(in, out, s)
+}
+
+func autoConvert_certmanager_ClusterCertificateList_To_v1_ClusterCertificateList(in *certmanager.ClusterCertificateList, out *v1.ClusterCertificateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.ClusterCertificate, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_ClusterCertificate_To_v1_ClusterCertificate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_ClusterCertificateList_To_v1_ClusterCertificateList is an autogenerated conversion function.
+func Convert_certmanager_ClusterCertificateList_To_v1_ClusterCertificateList(in *certmanager.ClusterCertificateList, out *v1.ClusterCertificateList, s conversion.Scope) error {
+	return autoConvert_certmanager_ClusterCertificateList_To_v1_ClusterCertificateList(in, out, s)
+}
+
+func autoConvert_v1_ClusterCertificateSpec_To_certmanager_ClusterCertificateSpec(in *v1.ClusterCertificateSpec, out *certmanager.ClusterCertificateSpec, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.SecretNamespaces = *(*[]string)(unsafe.Pointer(&in.SecretNamespaces))
+	if err := Convert_v1_CertificateSpec_To_certmanager_CertificateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterCertificateSpec_To_certmanager_ClusterCertificateSpec is an autogenerated conversion function.
+func Convert_v1_ClusterCertificateSpec_To_certmanager_ClusterCertificateSpec(in *v1.ClusterCertificateSpec, out *certmanager.ClusterCertificateSpec, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCertificateSpec_To_certmanager_ClusterCertificateSpec(in, out, s)
+}
+
+func autoConvert_certmanager_ClusterCertificateSpec_To_v1_ClusterCertificateSpec(in *certmanager.ClusterCertificateSpec, out *v1.ClusterCertificateSpec, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.SecretNamespaces = *(*[]string)(unsafe.Pointer(&in.SecretNamespaces))
+	if err := Convert_certmanager_CertificateSpec_To_v1_CertificateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ClusterCertificateSpec_To_v1_ClusterCertificateSpec is an autogenerated conversion function.
+func Convert_certmanager_ClusterCertificateSpec_To_v1_ClusterCertificateSpec(in *certmanager.ClusterCertificateSpec, out *v1.ClusterCertificateSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_ClusterCertificateSpec_To_v1_ClusterCertificateSpec(in, out, s)
+}
+
 func autoConvert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in *v1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -942,6 +2164,108 @@ func Convert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in *certmanag
 	return autoConvert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in, out, s)
 }
 
+func autoConvert_v1_IssuanceQuota_To_certmanager_IssuanceQuota(in *v1.IssuanceQuota, out *certmanager.IssuanceQuota, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_IssuanceQuotaSpec_To_certmanager_IssuanceQuotaSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_IssuanceQuotaStatus_To_certmanager_IssuanceQuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_IssuanceQuota_To_certmanager_IssuanceQuota is an autogenerated conversion function.
+func Convert_v1_IssuanceQuota_To_certmanager_IssuanceQuota(in *v1.IssuanceQuota, out *certmanager.IssuanceQuota, s conversion.Scope) error {
+	return autoConvert_v1_IssuanceQuota_To_certmanager_IssuanceQuota(in, out, s)
+}
+
+func autoConvert_certmanager_IssuanceQuota_To_v1_IssuanceQuota(in *certmanager.IssuanceQuota, out *v1.IssuanceQuota, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_IssuanceQuotaSpec_To_v1_IssuanceQuotaSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_IssuanceQuotaStatus_To_v1_IssuanceQuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_IssuanceQuota_To_v1_IssuanceQuota is an autogenerated conversion function.
+func Convert_certmanager_IssuanceQuota_To_v1_IssuanceQuota(in *certmanager.IssuanceQuota, out *v1.IssuanceQuota, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuanceQuota_To_v1_IssuanceQuota(in, out, s)
+}
+
+func autoConvert_v1_IssuanceQuotaList_To_certmanager_IssuanceQuotaList(in *v1.IssuanceQuotaList, out *certmanager.IssuanceQuotaList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.IssuanceQuota)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_IssuanceQuotaList_To_certmanager_IssuanceQuotaList is an autogenerated conversion function.
+func Convert_v1_IssuanceQuotaList_To_certmanager_IssuanceQuotaList(in *v1.IssuanceQuotaList, out *certmanager.IssuanceQuotaList, s conversion.Scope) error {
+	return autoConvert_v1_IssuanceQuotaList_To_certmanager_IssuanceQuotaList(in, out, s)
+}
+
+func autoConvert_certmanager_IssuanceQuotaList_To_v1_IssuanceQuotaList(in *certmanager.IssuanceQuotaList, out *v1.IssuanceQuotaList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.IssuanceQuota)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_IssuanceQuotaList_To_v1_IssuanceQuotaList is an autogenerated conversion function.
+func Convert_certmanager_IssuanceQuotaList_To_v1_IssuanceQuotaList(in *certmanager.IssuanceQuotaList, out *v1.IssuanceQuotaList, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuanceQuotaList_To_v1_IssuanceQuotaList(in, out, s)
+}
+
+func autoConvert_v1_IssuanceQuotaSpec_To_certmanager_IssuanceQuotaSpec(in *v1.IssuanceQuotaSpec, out *certmanager.IssuanceQuotaSpec, s conversion.Scope) error {
+	out.MaxCertificates = (*int32)(unsafe.Pointer(in.MaxCertificates))
+	out.MaxCertificateRequests = (*int32)(unsafe.Pointer(in.MaxCertificateRequests))
+	out.CertificateRequestsPeriod = (*pkgapismetav1.Duration)(unsafe.Pointer(in.CertificateRequestsPeriod))
+	return nil
+}
+
+// Convert_v1_IssuanceQuotaSpec_To_certmanager_IssuanceQuotaSpec is an autogenerated conversion function.
+func Convert_v1_IssuanceQuotaSpec_To_certmanager_IssuanceQuotaSpec(in *v1.IssuanceQuotaSpec, out *certmanager.IssuanceQuotaSpec, s conversion.Scope) error {
+	return autoConvert_v1_IssuanceQuotaSpec_To_certmanager_IssuanceQuotaSpec(in, out, s)
+}
+
+func autoConvert_certmanager_IssuanceQuotaSpec_To_v1_IssuanceQuotaSpec(in *certmanager.IssuanceQuotaSpec, out *v1.IssuanceQuotaSpec, s conversion.Scope) error {
+	out.MaxCertificates = (*int32)(unsafe.Pointer(in.MaxCertificates))
+	out.MaxCertificateRequests = (*int32)(unsafe.Pointer(in.MaxCertificateRequests))
+	out.CertificateRequestsPeriod = (*pkgapismetav1.Duration)(unsafe.Pointer(in.CertificateRequestsPeriod))
+	return nil
+}
+
+// Convert_certmanager_IssuanceQuotaSpec_To_v1_IssuanceQuotaSpec is an autogenerated conversion function.
+func Convert_certmanager_IssuanceQuotaSpec_To_v1_IssuanceQuotaSpec(in *certmanager.IssuanceQuotaSpec, out *v1.IssuanceQuotaSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuanceQuotaSpec_To_v1_IssuanceQuotaSpec(in, out, s)
+}
+
+func autoConvert_v1_IssuanceQuotaStatus_To_certmanager_IssuanceQuotaStatus(in *v1.IssuanceQuotaStatus, out *certmanager.IssuanceQuotaStatus, s conversion.Scope) error {
+	out.Certificates = in.Certificates
+	out.CertificateRequests = in.CertificateRequests
+	out.LastUpdateTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1_IssuanceQuotaStatus_To_certmanager_IssuanceQuotaStatus is an autogenerated conversion function.
+func Convert_v1_IssuanceQuotaStatus_To_certmanager_IssuanceQuotaStatus(in *v1.IssuanceQuotaStatus, out *certmanager.IssuanceQuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1_IssuanceQuotaStatus_To_certmanager_IssuanceQuotaStatus(in, out, s)
+}
+
+func autoConvert_certmanager_IssuanceQuotaStatus_To_v1_IssuanceQuotaStatus(in *certmanager.IssuanceQuotaStatus, out *v1.IssuanceQuotaStatus, s conversion.Scope) error {
+	out.Certificates = in.Certificates
+	out.CertificateRequests = in.CertificateRequests
+	out.LastUpdateTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_certmanager_IssuanceQuotaStatus_To_v1_IssuanceQuotaStatus is an autogenerated conversion function.
+func Convert_certmanager_IssuanceQuotaStatus_To_v1_IssuanceQuotaStatus(in *certmanager.IssuanceQuotaStatus, out *v1.IssuanceQuotaStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuanceQuotaStatus_To_v1_IssuanceQuotaStatus(in, out, s)
+}
+
 func autoConvert_v1_Issuer_To_certmanager_Issuer(in *v1.Issuer, out *certmanager.Issuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -977,7 +2301,7 @@ func Convert_certmanager_Issuer_To_v1_Issuer(in *certmanager.Issuer, out *v1.Iss
 func autoConvert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *v1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanager.IssuerConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
@@ -992,7 +2316,7 @@ func Convert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *v1.IssuerCond
 func autoConvert_certmanager_IssuerCondition_To_v1_IssuerCondition(in *certmanager.IssuerCondition, out *v1.IssuerCondition, s conversion.Scope) error {
 	out.Type = v1.IssuerConditionType(in.Type)
 	out.Status = apismetav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
@@ -1004,6 +2328,30 @@ func Convert_certmanager_IssuerCondition_To_v1_IssuerCondition(in *certmanager.I
 	return autoConvert_certmanager_IssuerCondition_To_v1_IssuerCondition(in, out, s)
 }
 
+func autoConvert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *v1.IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	return nil
+}
+
+// Convert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *v1.IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *v1.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	return nil
+}
+
+// Convert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *v1.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults(in, out, s)
+}
+
 func autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
 	if in.ACME != nil {
 		in, out := &in.ACME, &out.ACME
@@ -1014,7 +2362,15 @@ func autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig
 	} else {
 		out.ACME = nil
 	}
-	out.CA = (*certmanager.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(certmanager.CAIssuer)
+		if err := Convert_v1_CAIssuer_To_certmanager_CAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CA = nil
+	}
 	if in.Vault != nil {
 		in, out := &in.Vault, &out.Vault
 		*out = new(certmanager.VaultIssuer)
@@ -1032,7 +2388,25 @@ func autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig
 			return err
 		}
 	} else {
-		out.Venafi = nil
+		out.Venafi = nil
+	}
+	if in.SCEP != nil {
+		in, out := &in.SCEP, &out.SCEP
+		*out = new(certmanager.SCEPIssuer)
+		if err := Convert_v1_SCEPIssuer_To_certmanager_SCEPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SCEP = nil
+	}
+	if in.CMP != nil {
+		in, out := &in.CMP, &out.CMP
+		*out = new(certmanager.CMPIssuer)
+		if err := Convert_v1_CMPIssuer_To_certmanager_CMPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CMP = nil
 	}
 	return nil
 }
@@ -1052,7 +2426,15 @@ func autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.Iss
 	} else {
 		out.ACME = nil
 	}
-	out.CA = (*v1.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1.CAIssuer)
+		if err := Convert_certmanager_CAIssuer_To_v1_CAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CA = nil
+	}
 	if in.Vault != nil {
 		in, out := &in.Vault, &out.Vault
 		*out = new(v1.VaultIssuer)
@@ -1072,6 +2454,24 @@ func autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.Iss
 	} else {
 		out.Venafi = nil
 	}
+	if in.SCEP != nil {
+		in, out := &in.SCEP, &out.SCEP
+		*out = new(v1.SCEPIssuer)
+		if err := Convert_certmanager_SCEPIssuer_To_v1_SCEPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SCEP = nil
+	}
+	if in.CMP != nil {
+		in, out := &in.CMP, &out.CMP
+		*out = new(v1.CMPIssuer)
+		if err := Convert_certmanager_CMPIssuer_To_v1_CMPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CMP = nil
+	}
 	return nil
 }
 
@@ -1122,10 +2522,42 @@ func Convert_certmanager_IssuerList_To_v1_IssuerList(in *certmanager.IssuerList,
 	return autoConvert_certmanager_IssuerList_To_v1_IssuerList(in, out, s)
 }
 
+func autoConvert_v1_IssuerPolicy_To_certmanager_IssuerPolicy(in *v1.IssuerPolicy, out *certmanager.IssuerPolicy, s conversion.Scope) error {
+	out.MinDuration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.DurationEnforcement = certmanager.DurationEnforcement(in.DurationEnforcement)
+	out.AllowedPrivateKeyAlgorithms = *(*[]certmanager.PrivateKeyAlgorithm)(unsafe.Pointer(&in.AllowedPrivateKeyAlgorithms))
+	out.RequesterIdentity = (*certmanager.RequesterIdentityPolicy)(unsafe.Pointer(in.RequesterIdentity))
+	out.AllowedExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtensions))
+	return nil
+}
+
+// Convert_v1_IssuerPolicy_To_certmanager_IssuerPolicy is an autogenerated conversion function.
+func Convert_v1_IssuerPolicy_To_certmanager_IssuerPolicy(in *v1.IssuerPolicy, out *certmanager.IssuerPolicy, s conversion.Scope) error {
+	return autoConvert_v1_IssuerPolicy_To_certmanager_IssuerPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerPolicy_To_v1_IssuerPolicy(in *certmanager.IssuerPolicy, out *v1.IssuerPolicy, s conversion.Scope) error {
+	out.MinDuration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.DurationEnforcement = v1.DurationEnforcement(in.DurationEnforcement)
+	out.AllowedPrivateKeyAlgorithms = *(*[]v1.PrivateKeyAlgorithm)(unsafe.Pointer(&in.AllowedPrivateKeyAlgorithms))
+	out.RequesterIdentity = (*v1.RequesterIdentityPolicy)(unsafe.Pointer(in.RequesterIdentity))
+	out.AllowedExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtensions))
+	return nil
+}
+
+// Convert_certmanager_IssuerPolicy_To_v1_IssuerPolicy is an autogenerated conversion function.
+func Convert_certmanager_IssuerPolicy_To_v1_IssuerPolicy(in *certmanager.IssuerPolicy, out *v1.IssuerPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerPolicy_To_v1_IssuerPolicy(in, out, s)
+}
+
 func autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
 	if err := Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Policy = (*certmanager.IssuerPolicy)(unsafe.Pointer(in.Policy))
+	out.CertificateDefaults = (*certmanager.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1138,6 +2570,8 @@ func autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerS
 	if err := Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Policy = (*v1.IssuerPolicy)(unsafe.Pointer(in.Policy))
+	out.CertificateDefaults = (*v1.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1186,7 +2620,7 @@ func Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(in *certmanager.IssuerS
 
 func autoConvert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *v1.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1199,7 +2633,7 @@ func Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *v1.JKSKeystore, out *
 
 func autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeystore, out *v1.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1210,9 +2644,195 @@ func Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeysto
 	return autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in, out, s)
 }
 
+func autoConvert_v1_NotificationRoute_To_certmanager_NotificationRoute(in *v1.NotificationRoute, out *certmanager.NotificationRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_NotificationRouteSpec_To_certmanager_NotificationRouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_NotificationRouteStatus_To_certmanager_NotificationRouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NotificationRoute_To_certmanager_NotificationRoute is an autogenerated conversion function.
+func Convert_v1_NotificationRoute_To_certmanager_NotificationRoute(in *v1.NotificationRoute, out *certmanager.NotificationRoute, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRoute_To_certmanager_NotificationRoute(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationRoute_To_v1_NotificationRoute(in *certmanager.NotificationRoute, out *v1.NotificationRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_NotificationRouteSpec_To_v1_NotificationRouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_NotificationRouteStatus_To_v1_NotificationRouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_NotificationRoute_To_v1_NotificationRoute is an autogenerated conversion function.
+func Convert_certmanager_NotificationRoute_To_v1_NotificationRoute(in *certmanager.NotificationRoute, out *v1.NotificationRoute, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationRoute_To_v1_NotificationRoute(in, out, s)
+}
+
+func autoConvert_v1_NotificationRouteList_To_certmanager_NotificationRouteList(in *v1.NotificationRouteList, out *certmanager.NotificationRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.NotificationRoute, len(*in))
+		for i := range *in {
+			if err := Convert_v1_NotificationRoute_To_certmanager_NotificationRoute(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_NotificationRouteList_To_certmanager_NotificationRouteList is an autogenerated conversion function.
+func Convert_v1_NotificationRouteList_To_certmanager_NotificationRouteList(in *v1.NotificationRouteList, out *certmanager.NotificationRouteList, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRouteList_To_certmanager_NotificationRouteList(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationRouteList_To_v1_NotificationRouteList(in *certmanager.NotificationRouteList, out *v1.NotificationRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.NotificationRoute, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_NotificationRoute_To_v1_NotificationRoute(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_NotificationRouteList_To_v1_NotificationRouteList is an autogenerated conversion function.
+func Convert_certmanager_NotificationRouteList_To_v1_NotificationRouteList(in *certmanager.NotificationRouteList, out *v1.NotificationRouteList, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationRouteList_To_v1_NotificationRouteList(in, out, s)
+}
+
+func autoConvert_v1_NotificationRouteSpec_To_certmanager_NotificationRouteSpec(in *v1.NotificationRouteSpec, out *certmanager.NotificationRouteSpec, s conversion.Scope) error {
+	out.Selector = (*pkgapismetav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Events = *(*[]certmanager.NotificationEvent)(unsafe.Pointer(&in.Events))
+	out.ExpiryThresholds = *(*[]pkgapismetav1.Duration)(unsafe.Pointer(&in.ExpiryThresholds))
+	out.IssuanceFailureThreshold = (*int32)(unsafe.Pointer(in.IssuanceFailureThreshold))
+	if err := Convert_v1_NotificationWebhook_To_certmanager_NotificationWebhook(&in.Webhook, &out.Webhook, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NotificationRouteSpec_To_certmanager_NotificationRouteSpec is an autogenerated conversion function.
+func Convert_v1_NotificationRouteSpec_To_certmanager_NotificationRouteSpec(in *v1.NotificationRouteSpec, out *certmanager.NotificationRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRouteSpec_To_certmanager_NotificationRouteSpec(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationRouteSpec_To_v1_NotificationRouteSpec(in *certmanager.NotificationRouteSpec, out *v1.NotificationRouteSpec, s conversion.Scope) error {
+	out.Selector = (*pkgapismetav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Events = *(*[]v1.NotificationEvent)(unsafe.Pointer(&in.Events))
+	out.ExpiryThresholds = *(*[]pkgapismetav1.Duration)(unsafe.Pointer(&in.ExpiryThresholds))
+	out.IssuanceFailureThreshold = (*int32)(unsafe.Pointer(in.IssuanceFailureThreshold))
+	if err := Convert_certmanager_NotificationWebhook_To_v1_NotificationWebhook(&in.Webhook, &out.Webhook, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_NotificationRouteSpec_To_v1_NotificationRouteSpec is an autogenerated conversion function.
+func Convert_certmanager_NotificationRouteSpec_To_v1_NotificationRouteSpec(in *certmanager.NotificationRouteSpec, out *v1.NotificationRouteSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationRouteSpec_To_v1_NotificationRouteSpec(in, out, s)
+}
+
+func autoConvert_v1_NotificationRouteStatus_To_certmanager_NotificationRouteStatus(in *v1.NotificationRouteStatus, out *certmanager.NotificationRouteStatus, s conversion.Scope) error {
+	out.Notifications = *(*[]certmanager.SentNotification)(unsafe.Pointer(&in.Notifications))
+	return nil
+}
+
+// Convert_v1_NotificationRouteStatus_To_certmanager_NotificationRouteStatus is an autogenerated conversion function.
+func Convert_v1_NotificationRouteStatus_To_certmanager_NotificationRouteStatus(in *v1.NotificationRouteStatus, out *certmanager.NotificationRouteStatus, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRouteStatus_To_certmanager_NotificationRouteStatus(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationRouteStatus_To_v1_NotificationRouteStatus(in *certmanager.NotificationRouteStatus, out *v1.NotificationRouteStatus, s conversion.Scope) error {
+	out.Notifications = *(*[]v1.SentNotification)(unsafe.Pointer(&in.Notifications))
+	return nil
+}
+
+// Convert_certmanager_NotificationRouteStatus_To_v1_NotificationRouteStatus is an autogenerated conversion function.
+func Convert_certmanager_NotificationRouteStatus_To_v1_NotificationRouteStatus(in *certmanager.NotificationRouteStatus, out *v1.NotificationRouteStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationRouteStatus_To_v1_NotificationRouteStatus(in, out, s)
+}
+
+func autoConvert_v1_NotificationWebhook_To_certmanager_NotificationWebhook(in *v1.NotificationWebhook, out *certmanager.NotificationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.URLSecretRef = nil
+	}
+	out.Format = certmanager.NotificationFormat(in.Format)
+	if in.RoutingKeySecretRef != nil {
+		in, out := &in.RoutingKeySecretRef, &out.RoutingKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RoutingKeySecretRef = nil
+	}
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_v1_NotificationWebhook_To_certmanager_NotificationWebhook is an autogenerated conversion function.
+func Convert_v1_NotificationWebhook_To_certmanager_NotificationWebhook(in *v1.NotificationWebhook, out *certmanager.NotificationWebhook, s conversion.Scope) error {
+	return autoConvert_v1_NotificationWebhook_To_certmanager_NotificationWebhook(in, out, s)
+}
+
+func autoConvert_certmanager_NotificationWebhook_To_v1_NotificationWebhook(in *certmanager.NotificationWebhook, out *v1.NotificationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.URLSecretRef = nil
+	}
+	out.Format = v1.NotificationFormat(in.Format)
+	if in.RoutingKeySecretRef != nil {
+		in, out := &in.RoutingKeySecretRef, &out.RoutingKeySecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RoutingKeySecretRef = nil
+	}
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_certmanager_NotificationWebhook_To_v1_NotificationWebhook is an autogenerated conversion function.
+func Convert_certmanager_NotificationWebhook_To_v1_NotificationWebhook(in *certmanager.NotificationWebhook, out *v1.NotificationWebhook, s conversion.Scope) error {
+	return autoConvert_certmanager_NotificationWebhook_To_v1_NotificationWebhook(in, out, s)
+}
+
 func autoConvert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1225,7 +2845,7 @@ func Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1.PKCS12Keysto
 
 func autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *v1.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1236,8 +2856,195 @@ func Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKC
 	return autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in, out, s)
 }
 
+func autoConvert_v1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in *v1.RequesterIdentityPolicy, out *certmanager.RequesterIdentityPolicy, s conversion.Scope) error {
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	return nil
+}
+
+// Convert_v1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy is an autogenerated conversion function.
+func Convert_v1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in *v1.RequesterIdentityPolicy, out *certmanager.RequesterIdentityPolicy, s conversion.Scope) error {
+	return autoConvert_v1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_RequesterIdentityPolicy_To_v1_RequesterIdentityPolicy(in *certmanager.RequesterIdentityPolicy, out *v1.RequesterIdentityPolicy, s conversion.Scope) error {
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	return nil
+}
+
+// Convert_certmanager_RequesterIdentityPolicy_To_v1_RequesterIdentityPolicy is an autogenerated conversion function.
+func Convert_certmanager_RequesterIdentityPolicy_To_v1_RequesterIdentityPolicy(in *certmanager.RequesterIdentityPolicy, out *v1.RequesterIdentityPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_RequesterIdentityPolicy_To_v1_RequesterIdentityPolicy(in, out, s)
+}
+
+func autoConvert_v1_SCEPIssuer_To_certmanager_SCEPIssuer(in *v1.SCEPIssuer, out *certmanager.SCEPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.ChallengePasswordSecretRef != nil {
+		in, out := &in.ChallengePasswordSecretRef, &out.ChallengePasswordSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ChallengePasswordSecretRef = nil
+	}
+	out.CAFingerprint = in.CAFingerprint
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1_SCEPIssuer_To_certmanager_SCEPIssuer is an autogenerated conversion function.
+func Convert_v1_SCEPIssuer_To_certmanager_SCEPIssuer(in *v1.SCEPIssuer, out *certmanager.SCEPIssuer, s conversion.Scope) error {
+	return autoConvert_v1_SCEPIssuer_To_certmanager_SCEPIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_SCEPIssuer_To_v1_SCEPIssuer(in *certmanager.SCEPIssuer, out *v1.SCEPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.ChallengePasswordSecretRef != nil {
+		in, out := &in.ChallengePasswordSecretRef, &out.ChallengePasswordSecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ChallengePasswordSecretRef = nil
+	}
+	out.CAFingerprint = in.CAFingerprint
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_certmanager_SCEPIssuer_To_v1_SCEPIssuer is an autogenerated conversion function.
+func Convert_certmanager_SCEPIssuer_To_v1_SCEPIssuer(in *certmanager.SCEPIssuer, out *v1.SCEPIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SCEPIssuer_To_v1_SCEPIssuer(in, out, s)
+}
+
+func autoConvert_v1_SecretAccessGrant_To_certmanager_SecretAccessGrant(in *v1.SecretAccessGrant, out *certmanager.SecretAccessGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_SecretAccessGrantSpec_To_certmanager_SecretAccessGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_SecretAccessGrant_To_certmanager_SecretAccessGrant is an autogenerated conversion function.
+func Convert_v1_SecretAccessGrant_To_certmanager_SecretAccessGrant(in *v1.SecretAccessGrant, out *certmanager.SecretAccessGrant, s conversion.Scope) error {
+	return autoConvert_v1_SecretAccessGrant_To_certmanager_SecretAccessGrant(in, out, s)
+}
+
+func autoConvert_certmanager_SecretAccessGrant_To_v1_SecretAccessGrant(in *certmanager.SecretAccessGrant, out *v1.SecretAccessGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_SecretAccessGrantSpec_To_v1_SecretAccessGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_SecretAccessGrant_To_v1_SecretAccessGrant is an autogenerated conversion function.
+func Convert_certmanager_SecretAccessGrant_To_v1_SecretAccessGrant(in *certmanager.SecretAccessGrant, out *v1.SecretAccessGrant, s conversion.Scope) error {
+	return autoConvert_certmanager_SecretAccessGrant_To_v1_SecretAccessGrant(in, out, s)
+}
+
+func autoConvert_v1_SecretAccessGrantFrom_To_certmanager_SecretAccessGrantFrom(in *v1.SecretAccessGrantFrom, out *certmanager.SecretAccessGrantFrom, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.CertificateNames = *(*[]string)(unsafe.Pointer(&in.CertificateNames))
+	return nil
+}
+
+// Convert_v1_SecretAccessGrantFrom_To_certmanager_SecretAccessGrantFrom is an autogenerated conversion function.
+func Convert_v1_SecretAccessGrantFrom_To_certmanager_SecretAccessGrantFrom(in *v1.SecretAccessGrantFrom, out *certmanager.SecretAccessGrantFrom, s conversion.Scope) error {
+	return autoConvert_v1_SecretAccessGrantFrom_To_certmanager_SecretAccessGrantFrom(in, out, s)
+}
+
+func autoConvert_certmanager_SecretAccessGrantFrom_To_v1_SecretAccessGrantFrom(in *certmanager.SecretAccessGrantFrom, out *v1.SecretAccessGrantFrom, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.CertificateNames = *(*[]string)(unsafe.Pointer(&in.CertificateNames))
+	return nil
+}
+
+// Convert_certmanager_SecretAccessGrantFrom_To_v1_SecretAccessGrantFrom is an autogenerated conversion function.
+func Convert_certmanager_SecretAccessGrantFrom_To_v1_SecretAccessGrantFrom(in *certmanager.SecretAccessGrantFrom, out *v1.SecretAccessGrantFrom, s conversion.Scope) error {
+	return autoConvert_certmanager_SecretAccessGrantFrom_To_v1_SecretAccessGrantFrom(in, out, s)
+}
+
+func autoConvert_v1_SecretAccessGrantList_To_certmanager_SecretAccessGrantList(in *v1.SecretAccessGrantList, out *certmanager.SecretAccessGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.SecretAccessGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_SecretAccessGrantList_To_certmanager_SecretAccessGrantList is an autogenerated conversion function.
+func Convert_v1_SecretAccessGrantList_To_certmanager_SecretAccessGrantList(in *v1.SecretAccessGrantList, out *certmanager.SecretAccessGrantList, s conversion.Scope) error {
+	return autoConvert_v1_SecretAccessGrantList_To_certmanager_SecretAccessGrantList(in, out, s)
+}
+
+func autoConvert_certmanager_SecretAccessGrantList_To_v1_SecretAccessGrantList(in *certmanager.SecretAccessGrantList, out *v1.SecretAccessGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.SecretAccessGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_SecretAccessGrantList_To_v1_SecretAccessGrantList is an autogenerated conversion function.
+func Convert_certmanager_SecretAccessGrantList_To_v1_SecretAccessGrantList(in *certmanager.SecretAccessGrantList, out *v1.SecretAccessGrantList, s conversion.Scope) error {
+	return autoConvert_certmanager_SecretAccessGrantList_To_v1_SecretAccessGrantList(in, out, s)
+}
+
+func autoConvert_v1_SecretAccessGrantSpec_To_certmanager_SecretAccessGrantSpec(in *v1.SecretAccessGrantSpec, out *certmanager.SecretAccessGrantSpec, s conversion.Scope) error {
+	out.From = *(*[]certmanager.SecretAccessGrantFrom)(unsafe.Pointer(&in.From))
+	out.SecretNames = *(*[]string)(unsafe.Pointer(&in.SecretNames))
+	return nil
+}
+
+// Convert_v1_SecretAccessGrantSpec_To_certmanager_SecretAccessGrantSpec is an autogenerated conversion function.
+func Convert_v1_SecretAccessGrantSpec_To_certmanager_SecretAccessGrantSpec(in *v1.SecretAccessGrantSpec, out *certmanager.SecretAccessGrantSpec, s conversion.Scope) error {
+	return autoConvert_v1_SecretAccessGrantSpec_To_certmanager_SecretAccessGrantSpec(in, out, s)
+}
+
+func autoConvert_certmanager_SecretAccessGrantSpec_To_v1_SecretAccessGrantSpec(in *certmanager.SecretAccessGrantSpec, out *v1.SecretAccessGrantSpec, s conversion.Scope) error {
+	out.From = *(*[]v1.SecretAccessGrantFrom)(unsafe.Pointer(&in.From))
+	out.SecretNames = *(*[]string)(unsafe.Pointer(&in.SecretNames))
+	return nil
+}
+
+// Convert_certmanager_SecretAccessGrantSpec_To_v1_SecretAccessGrantSpec is an autogenerated conversion function.
+func Convert_certmanager_SecretAccessGrantSpec_To_v1_SecretAccessGrantSpec(in *certmanager.SecretAccessGrantSpec, out *v1.SecretAccessGrantSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_SecretAccessGrantSpec_To_v1_SecretAccessGrantSpec(in, out, s)
+}
+
+func autoConvert_v1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in *v1.SelfSignedBootstrapCA, out *certmanager.SelfSignedBootstrapCA, s conversion.Scope) error {
+	out.IssuerName = in.IssuerName
+	out.CommonName = in.CommonName
+	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
+	out.RootDuration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.RootDuration))
+	out.IntermediateDuration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.IntermediateDuration))
+	return nil
+}
+
+// Convert_v1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA is an autogenerated conversion function.
+func Convert_v1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in *v1.SelfSignedBootstrapCA, out *certmanager.SelfSignedBootstrapCA, s conversion.Scope) error {
+	return autoConvert_v1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in, out, s)
+}
+
+func autoConvert_certmanager_SelfSignedBootstrapCA_To_v1_SelfSignedBootstrapCA(in *certmanager.SelfSignedBootstrapCA, out *v1.SelfSignedBootstrapCA, s conversion.Scope) error {
+	out.IssuerName = in.IssuerName
+	out.CommonName = in.CommonName
+	out.Subject = (*v1.X509Subject)(unsafe.Pointer(in.Subject))
+	out.RootDuration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.RootDuration))
+	out.IntermediateDuration = (*pkgapismetav1.Duration)(unsafe.Pointer(in.IntermediateDuration))
+	return nil
+}
+
+// Convert_certmanager_SelfSignedBootstrapCA_To_v1_SelfSignedBootstrapCA is an autogenerated conversion function.
+func Convert_certmanager_SelfSignedBootstrapCA_To_v1_SelfSignedBootstrapCA(in *certmanager.SelfSignedBootstrapCA, out *v1.SelfSignedBootstrapCA, s conversion.Scope) error {
+	return autoConvert_certmanager_SelfSignedBootstrapCA_To_v1_SelfSignedBootstrapCA(in, out, s)
+}
+
 func autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.BootstrapCA = (*certmanager.SelfSignedBootstrapCA)(unsafe.Pointer(in.BootstrapCA))
 	return nil
 }
 
@@ -1248,6 +3055,7 @@ func Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSign
 
 func autoConvert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.BootstrapCA = (*v1.SelfSignedBootstrapCA)(unsafe.Pointer(in.BootstrapCA))
 	return nil
 }
 
@@ -1256,10 +3064,36 @@ func Convert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in *certmanager
 	return autoConvert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in, out, s)
 }
 
+func autoConvert_v1_SentNotification_To_certmanager_SentNotification(in *v1.SentNotification, out *certmanager.SentNotification, s conversion.Scope) error {
+	out.Certificate = in.Certificate
+	out.Event = certmanager.NotificationEvent(in.Event)
+	out.ID = in.ID
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1_SentNotification_To_certmanager_SentNotification is an autogenerated conversion function.
+func Convert_v1_SentNotification_To_certmanager_SentNotification(in *v1.SentNotification, out *certmanager.SentNotification, s conversion.Scope) error {
+	return autoConvert_v1_SentNotification_To_certmanager_SentNotification(in, out, s)
+}
+
+func autoConvert_certmanager_SentNotification_To_v1_SentNotification(in *certmanager.SentNotification, out *v1.SentNotification, s conversion.Scope) error {
+	out.Certificate = in.Certificate
+	out.Event = v1.NotificationEvent(in.Event)
+	out.ID = in.ID
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_certmanager_SentNotification_To_v1_SentNotification is an autogenerated conversion function.
+func Convert_certmanager_SentNotification_To_v1_SentNotification(in *certmanager.SentNotification, out *v1.SentNotification, s conversion.Scope) error {
+	return autoConvert_certmanager_SentNotification_To_v1_SentNotification(in, out, s)
+}
+
 func autoConvert_v1_VaultAppRole_To_certmanager_VaultAppRole(in *v1.VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1273,7 +3107,7 @@ func Convert_v1_VaultAppRole_To_certmanager_VaultAppRole(in *v1.VaultAppRole, ou
 func autoConvert_certmanager_VaultAppRole_To_v1_VaultAppRole(in *certmanager.VaultAppRole, out *v1.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1288,7 +3122,7 @@ func autoConvert_v1_VaultAuth_To_certmanager_VaultAuth(in *v1.VaultAuth, out *ce
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
 		*out = new(meta.SecretKeySelector)
-		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1324,7 +3158,7 @@ func autoConvert_certmanager_VaultAuth_To_v1_VaultAuth(in *certmanager.VaultAuth
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
 		*out = new(apismetav1.SecretKeySelector)
-		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1362,6 +3196,7 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *v1.VaultIssuer, o
 	}
 	out.Server = in.Server
 	out.Path = in.Path
+	out.SigningMode = certmanager.VaultSigningMode(in.SigningMode)
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	return nil
@@ -1378,6 +3213,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	}
 	out.Server = in.Server
 	out.Path = in.Path
+	out.SigningMode = v1.VaultSigningMode(in.SigningMode)
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	return nil
@@ -1390,7 +3226,7 @@ func Convert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.VaultIssu
 
 func autoConvert_v1_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(in *v1.VaultKubernetesAuth, out *certmanager.VaultKubernetesAuth, s conversion.Scope) error {
 	out.Path = in.Path
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1404,7 +3240,7 @@ func Convert_v1_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(in *v1.Va
 
 func autoConvert_certmanager_VaultKubernetesAuth_To_v1_VaultKubernetesAuth(in *certmanager.VaultKubernetesAuth, out *v1.VaultKubernetesAuth, s conversion.Scope) error {
 	out.Path = in.Path
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1418,7 +3254,7 @@ func Convert_certmanager_VaultKubernetesAuth_To_v1_VaultKubernetesAuth(in *certm
 
 func autoConvert_v1_VenafiCloud_To_certmanager_VenafiCloud(in *v1.VenafiCloud, out *certmanager.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1431,7 +3267,7 @@ func Convert_v1_VenafiCloud_To_certmanager_VenafiCloud(in *v1.VenafiCloud, out *
 
 func autoConvert_certmanager_VenafiCloud_To_v1_VenafiCloud(in *certmanager.VenafiCloud, out *v1.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1500,7 +3336,7 @@ func Convert_certmanager_VenafiIssuer_To_v1_VenafiIssuer(in *certmanager.VenafiI
 
 func autoConvert_v1_VenafiTPP_To_certmanager_VenafiTPP(in *v1.VenafiTPP, out *certmanager.VenafiTPP, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := internalapismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := metav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
@@ -1514,7 +3350,7 @@ func Convert_v1_VenafiTPP_To_certmanager_VenafiTPP(in *v1.VenafiTPP, out *certma
 
 func autoConvert_certmanager_VenafiTPP_To_v1_VenafiTPP(in *certmanager.VenafiTPP, out *v1.VenafiTPP, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := internalapismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := metav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
@@ -1526,6 +3362,30 @@ func Convert_certmanager_VenafiTPP_To_v1_VenafiTPP(in *certmanager.VenafiTPP, ou
 	return autoConvert_certmanager_VenafiTPP_To_v1_VenafiTPP(in, out, s)
 }
 
+func autoConvert_v1_X509Extension_To_certmanager_X509Extension(in *v1.X509Extension, out *certmanager.X509Extension, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_v1_X509Extension_To_certmanager_X509Extension is an autogenerated conversion function.
+func Convert_v1_X509Extension_To_certmanager_X509Extension(in *v1.X509Extension, out *certmanager.X509Extension, s conversion.Scope) error {
+	return autoConvert_v1_X509Extension_To_certmanager_X509Extension(in, out, s)
+}
+
+func autoConvert_certmanager_X509Extension_To_v1_X509Extension(in *certmanager.X509Extension, out *v1.X509Extension, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_certmanager_X509Extension_To_v1_X509Extension is an autogenerated conversion function.
+func Convert_certmanager_X509Extension_To_v1_X509Extension(in *certmanager.X509Extension, out *v1.X509Extension, s conversion.Scope) error {
+	return autoConvert_certmanager_X509Extension_To_v1_X509Extension(in, out, s)
+}
+
 func autoConvert_v1_X509Subject_To_certmanager_X509Subject(in *v1.X509Subject, out *certmanager.X509Subject, s conversion.Scope) error {
 	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
 	out.Countries = *(*[]string)(unsafe.Pointer(&in.Countries))
@@ -1535,6 +3395,7 @@ func autoConvert_v1_X509Subject_To_certmanager_X509Subject(in *v1.X509Subject, o
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
+	out.UID = in.UID
 	out.ExtraNames = *(*[]string)(unsafe.Pointer(&in.ExtraNames))
 	return nil
 }
@@ -1553,6 +3414,7 @@ func autoConvert_certmanager_X509Subject_To_v1_X509Subject(in *certmanager.X509S
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
+	out.UID = in.UID
 	out.ExtraNames = *(*[]string)(unsafe.Pointer(&in.ExtraNames))
 	return nil
 }
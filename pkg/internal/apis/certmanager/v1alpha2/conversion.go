@@ -101,6 +101,36 @@ func Convert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec(in *certman
 	return nil
 }
 
+func Convert_v1alpha2_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in *v1alpha2.SelfSignedBootstrapCA, out *certmanager.SelfSignedBootstrapCA, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in, out, s); err != nil {
+		return err
+	}
+
+	if len(in.Organization) > 0 {
+		if out.Subject == nil {
+			out.Subject = &certmanager.X509Subject{}
+		}
+
+		out.Subject.Organizations = in.Organization
+	}
+
+	return nil
+}
+
+func Convert_certmanager_SelfSignedBootstrapCA_To_v1alpha2_SelfSignedBootstrapCA(in *certmanager.SelfSignedBootstrapCA, out *v1alpha2.SelfSignedBootstrapCA, s conversion.Scope) error {
+	if err := autoConvert_certmanager_SelfSignedBootstrapCA_To_v1alpha2_SelfSignedBootstrapCA(in, out, s); err != nil {
+		return err
+	}
+
+	if in.Subject != nil {
+		out.Organization = in.Subject.Organizations
+	} else {
+		out.Organization = nil
+	}
+
+	return nil
+}
+
 func Convert_certmanager_X509Subject_To_v1alpha2_X509Subject(in *certmanager.X509Subject, out *v1alpha2.X509Subject, s conversion.Scope) error {
 	return autoConvert_certmanager_X509Subject_To_v1alpha2_X509Subject(in, out, s)
 }
@@ -126,3 +156,77 @@ func Convert_certmanager_CertificateRequestSpec_To_v1alpha2_CertificateRequestSp
 	out.CSRPEM = in.Request
 	return nil
 }
+
+func Convert_v1alpha2_IssuerPolicy_To_certmanager_IssuerPolicy(in *v1alpha2.IssuerPolicy, out *certmanager.IssuerPolicy, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_IssuerPolicy_To_certmanager_IssuerPolicy(in, out, s); err != nil {
+		return err
+	}
+
+	if in.AllowedPrivateKeyAlgorithms != nil {
+		out.AllowedPrivateKeyAlgorithms = make([]certmanager.PrivateKeyAlgorithm, len(in.AllowedPrivateKeyAlgorithms))
+		for i, alg := range in.AllowedPrivateKeyAlgorithms {
+			switch alg {
+			case v1alpha2.ECDSAKeyAlgorithm:
+				out.AllowedPrivateKeyAlgorithms[i] = certmanager.ECDSAKeyAlgorithm
+			case v1alpha2.RSAKeyAlgorithm:
+				out.AllowedPrivateKeyAlgorithms[i] = certmanager.RSAKeyAlgorithm
+			default:
+				out.AllowedPrivateKeyAlgorithms[i] = certmanager.PrivateKeyAlgorithm(alg)
+			}
+		}
+	}
+
+	return nil
+}
+
+func Convert_certmanager_IssuerPolicy_To_v1alpha2_IssuerPolicy(in *certmanager.IssuerPolicy, out *v1alpha2.IssuerPolicy, s conversion.Scope) error {
+	if err := autoConvert_certmanager_IssuerPolicy_To_v1alpha2_IssuerPolicy(in, out, s); err != nil {
+		return err
+	}
+
+	if in.AllowedPrivateKeyAlgorithms != nil {
+		out.AllowedPrivateKeyAlgorithms = make([]v1alpha2.KeyAlgorithm, len(in.AllowedPrivateKeyAlgorithms))
+		for i, alg := range in.AllowedPrivateKeyAlgorithms {
+			switch alg {
+			case certmanager.ECDSAKeyAlgorithm:
+				out.AllowedPrivateKeyAlgorithms[i] = v1alpha2.ECDSAKeyAlgorithm
+			case certmanager.RSAKeyAlgorithm:
+				out.AllowedPrivateKeyAlgorithms[i] = v1alpha2.RSAKeyAlgorithm
+			default:
+				out.AllowedPrivateKeyAlgorithms[i] = v1alpha2.KeyAlgorithm(alg)
+			}
+		}
+	}
+
+	return nil
+}
+
+func Convert_v1alpha2_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in *v1alpha2.CertificateDualCertificate, out *certmanager.CertificateDualCertificate, s conversion.Scope) error {
+	switch in.Algorithm {
+	case v1alpha2.ECDSAKeyAlgorithm:
+		out.Algorithm = certmanager.ECDSAKeyAlgorithm
+	case v1alpha2.RSAKeyAlgorithm:
+		out.Algorithm = certmanager.RSAKeyAlgorithm
+	default:
+		out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	}
+
+	out.Size = in.Size
+
+	return nil
+}
+
+func Convert_certmanager_CertificateDualCertificate_To_v1alpha2_CertificateDualCertificate(in *certmanager.CertificateDualCertificate, out *v1alpha2.CertificateDualCertificate, s conversion.Scope) error {
+	switch in.Algorithm {
+	case certmanager.ECDSAKeyAlgorithm:
+		out.Algorithm = v1alpha2.ECDSAKeyAlgorithm
+	case certmanager.RSAKeyAlgorithm:
+		out.Algorithm = v1alpha2.RSAKeyAlgorithm
+	default:
+		out.Algorithm = v1alpha2.KeyAlgorithm(in.Algorithm)
+	}
+
+	out.Size = in.Size
+
+	return nil
+}
@@ -87,10 +87,6 @@ func Convert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *certman
 	return nil
 }
 
-func Convert_certmanager_X509Subject_To_v1alpha3_X509Subject(in *certmanager.X509Subject, out *v1alpha3.X509Subject, s conversion.Scope) error {
-	return autoConvert_certmanager_X509Subject_To_v1alpha3_X509Subject(in, out, s)
-}
-
 func Convert_certmanager_CertificatePrivateKey_To_v1alpha3_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1alpha3.CertificatePrivateKey, s conversion.Scope) error {
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1alpha3_CertificatePrivateKey(in, out, s)
 }
@@ -112,3 +108,77 @@ func Convert_certmanager_CertificateRequestSpec_To_v1alpha3_CertificateRequestSp
 	out.CSRPEM = in.Request
 	return nil
 }
+
+func Convert_v1alpha3_IssuerPolicy_To_certmanager_IssuerPolicy(in *v1alpha3.IssuerPolicy, out *certmanager.IssuerPolicy, s conversion.Scope) error {
+	if err := autoConvert_v1alpha3_IssuerPolicy_To_certmanager_IssuerPolicy(in, out, s); err != nil {
+		return err
+	}
+
+	if in.AllowedPrivateKeyAlgorithms != nil {
+		out.AllowedPrivateKeyAlgorithms = make([]certmanager.PrivateKeyAlgorithm, len(in.AllowedPrivateKeyAlgorithms))
+		for i, alg := range in.AllowedPrivateKeyAlgorithms {
+			switch alg {
+			case v1alpha3.ECDSAKeyAlgorithm:
+				out.AllowedPrivateKeyAlgorithms[i] = certmanager.ECDSAKeyAlgorithm
+			case v1alpha3.RSAKeyAlgorithm:
+				out.AllowedPrivateKeyAlgorithms[i] = certmanager.RSAKeyAlgorithm
+			default:
+				out.AllowedPrivateKeyAlgorithms[i] = certmanager.PrivateKeyAlgorithm(alg)
+			}
+		}
+	}
+
+	return nil
+}
+
+func Convert_certmanager_IssuerPolicy_To_v1alpha3_IssuerPolicy(in *certmanager.IssuerPolicy, out *v1alpha3.IssuerPolicy, s conversion.Scope) error {
+	if err := autoConvert_certmanager_IssuerPolicy_To_v1alpha3_IssuerPolicy(in, out, s); err != nil {
+		return err
+	}
+
+	if in.AllowedPrivateKeyAlgorithms != nil {
+		out.AllowedPrivateKeyAlgorithms = make([]v1alpha3.KeyAlgorithm, len(in.AllowedPrivateKeyAlgorithms))
+		for i, alg := range in.AllowedPrivateKeyAlgorithms {
+			switch alg {
+			case certmanager.ECDSAKeyAlgorithm:
+				out.AllowedPrivateKeyAlgorithms[i] = v1alpha3.ECDSAKeyAlgorithm
+			case certmanager.RSAKeyAlgorithm:
+				out.AllowedPrivateKeyAlgorithms[i] = v1alpha3.RSAKeyAlgorithm
+			default:
+				out.AllowedPrivateKeyAlgorithms[i] = v1alpha3.KeyAlgorithm(alg)
+			}
+		}
+	}
+
+	return nil
+}
+
+func Convert_v1alpha3_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in *v1alpha3.CertificateDualCertificate, out *certmanager.CertificateDualCertificate, s conversion.Scope) error {
+	switch in.Algorithm {
+	case v1alpha3.ECDSAKeyAlgorithm:
+		out.Algorithm = certmanager.ECDSAKeyAlgorithm
+	case v1alpha3.RSAKeyAlgorithm:
+		out.Algorithm = certmanager.RSAKeyAlgorithm
+	default:
+		out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	}
+
+	out.Size = in.Size
+
+	return nil
+}
+
+func Convert_certmanager_CertificateDualCertificate_To_v1alpha3_CertificateDualCertificate(in *certmanager.CertificateDualCertificate, out *v1alpha3.CertificateDualCertificate, s conversion.Scope) error {
+	switch in.Algorithm {
+	case certmanager.ECDSAKeyAlgorithm:
+		out.Algorithm = v1alpha3.ECDSAKeyAlgorithm
+	case certmanager.RSAKeyAlgorithm:
+		out.Algorithm = v1alpha3.RSAKeyAlgorithm
+	default:
+		out.Algorithm = v1alpha3.KeyAlgorithm(in.Algorithm)
+	}
+
+	out.Size = in.Size
+
+	return nil
+}
@@ -30,8 +30,8 @@ import (
 	acmev1alpha3 "github.com/jetstack/cert-manager/pkg/internal/apis/acme/v1alpha3"
 	certmanager "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	meta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
-	apismetav1 "github.com/jetstack/cert-manager/pkg/internal/apis/meta/v1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "github.com/jetstack/cert-manager/pkg/internal/apis/meta/v1"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -53,6 +53,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CAIssuerAWSKMS)(nil), (*certmanager.CAIssuerAWSKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(a.(*v1alpha3.CAIssuerAWSKMS), b.(*certmanager.CAIssuerAWSKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerAWSKMS)(nil), (*v1alpha3.CAIssuerAWSKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerAWSKMS_To_v1alpha3_CAIssuerAWSKMS(a.(*certmanager.CAIssuerAWSKMS), b.(*v1alpha3.CAIssuerAWSKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CAIssuerGoogleCloudKMS)(nil), (*certmanager.CAIssuerGoogleCloudKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(a.(*v1alpha3.CAIssuerGoogleCloudKMS), b.(*certmanager.CAIssuerGoogleCloudKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerGoogleCloudKMS)(nil), (*v1alpha3.CAIssuerGoogleCloudKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1alpha3_CAIssuerGoogleCloudKMS(a.(*certmanager.CAIssuerGoogleCloudKMS), b.(*v1alpha3.CAIssuerGoogleCloudKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CAIssuerKMS)(nil), (*certmanager.CAIssuerKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CAIssuerKMS_To_certmanager_CAIssuerKMS(a.(*v1alpha3.CAIssuerKMS), b.(*certmanager.CAIssuerKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerKMS)(nil), (*v1alpha3.CAIssuerKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerKMS_To_v1alpha3_CAIssuerKMS(a.(*certmanager.CAIssuerKMS), b.(*v1alpha3.CAIssuerKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CAIssuerPKCS11)(nil), (*certmanager.CAIssuerPKCS11)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(a.(*v1alpha3.CAIssuerPKCS11), b.(*certmanager.CAIssuerPKCS11), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerPKCS11)(nil), (*v1alpha3.CAIssuerPKCS11)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerPKCS11_To_v1alpha3_CAIssuerPKCS11(a.(*certmanager.CAIssuerPKCS11), b.(*v1alpha3.CAIssuerPKCS11), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CMPIssuer)(nil), (*certmanager.CMPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CMPIssuer_To_certmanager_CMPIssuer(a.(*v1alpha3.CMPIssuer), b.(*certmanager.CMPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPIssuer)(nil), (*v1alpha3.CMPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPIssuer_To_v1alpha3_CMPIssuer(a.(*certmanager.CMPIssuer), b.(*v1alpha3.CMPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CMPMACProtection)(nil), (*certmanager.CMPMACProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CMPMACProtection_To_certmanager_CMPMACProtection(a.(*v1alpha3.CMPMACProtection), b.(*certmanager.CMPMACProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPMACProtection)(nil), (*v1alpha3.CMPMACProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPMACProtection_To_v1alpha3_CMPMACProtection(a.(*certmanager.CMPMACProtection), b.(*v1alpha3.CMPMACProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CMPSignatureProtection)(nil), (*certmanager.CMPSignatureProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(a.(*v1alpha3.CMPSignatureProtection), b.(*certmanager.CMPSignatureProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPSignatureProtection)(nil), (*v1alpha3.CMPSignatureProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPSignatureProtection_To_v1alpha3_CMPSignatureProtection(a.(*certmanager.CMPSignatureProtection), b.(*v1alpha3.CMPSignatureProtection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.Certificate)(nil), (*certmanager.Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_Certificate_To_certmanager_Certificate(a.(*v1alpha3.Certificate), b.(*certmanager.Certificate), scope)
 	}); err != nil {
@@ -63,6 +133,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateACMESolverStatus)(nil), (*certmanager.CertificateACMESolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(a.(*v1alpha3.CertificateACMESolverStatus), b.(*certmanager.CertificateACMESolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateACMESolverStatus)(nil), (*v1alpha3.CertificateACMESolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateACMESolverStatus_To_v1alpha3_CertificateACMESolverStatus(a.(*certmanager.CertificateACMESolverStatus), b.(*v1alpha3.CertificateACMESolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateAdditionalOutputFormat)(nil), (*certmanager.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(a.(*v1alpha3.CertificateAdditionalOutputFormat), b.(*certmanager.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalOutputFormat)(nil), (*v1alpha3.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat(a.(*certmanager.CertificateAdditionalOutputFormat), b.(*v1alpha3.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateCAChain)(nil), (*certmanager.CertificateCAChain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateCAChain_To_certmanager_CertificateCAChain(a.(*v1alpha3.CertificateCAChain), b.(*certmanager.CertificateCAChain), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateCAChain)(nil), (*v1alpha3.CertificateCAChain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateCAChain_To_v1alpha3_CertificateCAChain(a.(*certmanager.CertificateCAChain), b.(*v1alpha3.CertificateCAChain), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(a.(*v1alpha3.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -73,6 +173,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateExternalPrivateKey)(nil), (*certmanager.CertificateExternalPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(a.(*v1alpha3.CertificateExternalPrivateKey), b.(*certmanager.CertificateExternalPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateExternalPrivateKey)(nil), (*v1alpha3.CertificateExternalPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateExternalPrivateKey_To_v1alpha3_CertificateExternalPrivateKey(a.(*certmanager.CertificateExternalPrivateKey), b.(*v1alpha3.CertificateExternalPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateIssuanceAttempt)(nil), (*certmanager.CertificateIssuanceAttempt)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(a.(*v1alpha3.CertificateIssuanceAttempt), b.(*certmanager.CertificateIssuanceAttempt), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuanceAttempt)(nil), (*v1alpha3.CertificateIssuanceAttempt)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuanceAttempt_To_v1alpha3_CertificateIssuanceAttempt(a.(*certmanager.CertificateIssuanceAttempt), b.(*v1alpha3.CertificateIssuanceAttempt), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateIssuanceDetails)(nil), (*certmanager.CertificateIssuanceDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(a.(*v1alpha3.CertificateIssuanceDetails), b.(*certmanager.CertificateIssuanceDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuanceDetails)(nil), (*v1alpha3.CertificateIssuanceDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuanceDetails_To_v1alpha3_CertificateIssuanceDetails(a.(*certmanager.CertificateIssuanceDetails), b.(*v1alpha3.CertificateIssuanceDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateIssuancePlan)(nil), (*certmanager.CertificateIssuancePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(a.(*v1alpha3.CertificateIssuancePlan), b.(*certmanager.CertificateIssuancePlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuancePlan)(nil), (*v1alpha3.CertificateIssuancePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuancePlan_To_v1alpha3_CertificateIssuancePlan(a.(*certmanager.CertificateIssuancePlan), b.(*v1alpha3.CertificateIssuancePlan), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*v1alpha3.CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -98,6 +238,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateRenewalWindow)(nil), (*certmanager.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(a.(*v1alpha3.CertificateRenewalWindow), b.(*certmanager.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindow)(nil), (*v1alpha3.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow(a.(*certmanager.CertificateRenewalWindow), b.(*v1alpha3.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateRenewalWindowRange)(nil), (*certmanager.CertificateRenewalWindowRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(a.(*v1alpha3.CertificateRenewalWindowRange), b.(*certmanager.CertificateRenewalWindowRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindowRange)(nil), (*v1alpha3.CertificateRenewalWindowRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindowRange_To_v1alpha3_CertificateRenewalWindowRange(a.(*certmanager.CertificateRenewalWindowRange), b.(*v1alpha3.CertificateRenewalWindowRange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1alpha3.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -158,6 +318,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateTrustStore)(nil), (*certmanager.CertificateTrustStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateTrustStore_To_certmanager_CertificateTrustStore(a.(*v1alpha3.CertificateTrustStore), b.(*certmanager.CertificateTrustStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateTrustStore)(nil), (*v1alpha3.CertificateTrustStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateTrustStore_To_v1alpha3_CertificateTrustStore(a.(*certmanager.CertificateTrustStore), b.(*v1alpha3.CertificateTrustStore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.ClusterIssuer)(nil), (*certmanager.ClusterIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ClusterIssuer_To_certmanager_ClusterIssuer(a.(*v1alpha3.ClusterIssuer), b.(*certmanager.ClusterIssuer), scope)
 	}); err != nil {
@@ -198,6 +368,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.IssuerCertificateDefaults)(nil), (*certmanager.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(a.(*v1alpha3.IssuerCertificateDefaults), b.(*certmanager.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerCertificateDefaults)(nil), (*v1alpha3.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerCertificateDefaults_To_v1alpha3_IssuerCertificateDefaults(a.(*certmanager.IssuerCertificateDefaults), b.(*v1alpha3.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.IssuerConfig)(nil), (*certmanager.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_IssuerConfig_To_certmanager_IssuerConfig(a.(*v1alpha3.IssuerConfig), b.(*certmanager.IssuerConfig), scope)
 	}); err != nil {
@@ -258,6 +438,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.RequesterIdentityPolicy)(nil), (*certmanager.RequesterIdentityPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(a.(*v1alpha3.RequesterIdentityPolicy), b.(*certmanager.RequesterIdentityPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.RequesterIdentityPolicy)(nil), (*v1alpha3.RequesterIdentityPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_RequesterIdentityPolicy_To_v1alpha3_RequesterIdentityPolicy(a.(*certmanager.RequesterIdentityPolicy), b.(*v1alpha3.RequesterIdentityPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SCEPIssuer)(nil), (*certmanager.SCEPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SCEPIssuer_To_certmanager_SCEPIssuer(a.(*v1alpha3.SCEPIssuer), b.(*certmanager.SCEPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SCEPIssuer)(nil), (*v1alpha3.SCEPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SCEPIssuer_To_v1alpha3_SCEPIssuer(a.(*certmanager.SCEPIssuer), b.(*v1alpha3.SCEPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.SelfSignedBootstrapCA)(nil), (*certmanager.SelfSignedBootstrapCA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(a.(*v1alpha3.SelfSignedBootstrapCA), b.(*certmanager.SelfSignedBootstrapCA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SelfSignedBootstrapCA)(nil), (*v1alpha3.SelfSignedBootstrapCA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SelfSignedBootstrapCA_To_v1alpha3_SelfSignedBootstrapCA(a.(*certmanager.SelfSignedBootstrapCA), b.(*v1alpha3.SelfSignedBootstrapCA), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*v1alpha3.SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
 	}); err != nil {
@@ -338,11 +548,31 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.X509Extension)(nil), (*certmanager.X509Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_X509Extension_To_certmanager_X509Extension(a.(*v1alpha3.X509Extension), b.(*certmanager.X509Extension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.X509Extension)(nil), (*v1alpha3.X509Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_X509Extension_To_v1alpha3_X509Extension(a.(*certmanager.X509Extension), b.(*v1alpha3.X509Extension), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.X509Subject)(nil), (*certmanager.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_X509Subject_To_certmanager_X509Subject(a.(*v1alpha3.X509Subject), b.(*certmanager.X509Subject), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.X509Subject)(nil), (*v1alpha3.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_X509Subject_To_v1alpha3_X509Subject(a.(*certmanager.X509Subject), b.(*v1alpha3.X509Subject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*certmanager.CertificateDualCertificate)(nil), (*v1alpha3.CertificateDualCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateDualCertificate_To_v1alpha3_CertificateDualCertificate(a.(*certmanager.CertificateDualCertificate), b.(*v1alpha3.CertificateDualCertificate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*certmanager.CertificatePrivateKey)(nil), (*v1alpha3.CertificatePrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_certmanager_CertificatePrivateKey_To_v1alpha3_CertificatePrivateKey(a.(*certmanager.CertificatePrivateKey), b.(*v1alpha3.CertificatePrivateKey), scope)
 	}); err != nil {
@@ -358,8 +588,13 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*certmanager.X509Subject)(nil), (*v1alpha3.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_X509Subject_To_v1alpha3_X509Subject(a.(*certmanager.X509Subject), b.(*v1alpha3.X509Subject), scope)
+	if err := s.AddConversionFunc((*certmanager.IssuerPolicy)(nil), (*v1alpha3.IssuerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerPolicy_To_v1alpha3_IssuerPolicy(a.(*certmanager.IssuerPolicy), b.(*v1alpha3.IssuerPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha3.CertificateDualCertificate)(nil), (*certmanager.CertificateDualCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(a.(*v1alpha3.CertificateDualCertificate), b.(*certmanager.CertificateDualCertificate), scope)
 	}); err != nil {
 		return err
 	}
@@ -373,6 +608,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.IssuerPolicy)(nil), (*certmanager.IssuerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_IssuerPolicy_To_certmanager_IssuerPolicy(a.(*v1alpha3.IssuerPolicy), b.(*certmanager.IssuerPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -380,6 +620,24 @@ func autoConvert_v1alpha3_CAIssuer_To_certmanager_CAIssuer(in *v1alpha3.CAIssuer
 	out.SecretName = in.SecretName
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(certmanager.CAIssuerKMS)
+		if err := Convert_v1alpha3_CAIssuerKMS_To_certmanager_CAIssuerKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KMS = nil
+	}
+	if in.PKCS11 != nil {
+		in, out := &in.PKCS11, &out.PKCS11
+		*out = new(certmanager.CAIssuerPKCS11)
+		if err := Convert_v1alpha3_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PKCS11 = nil
+	}
 	return nil
 }
 
@@ -392,74 +650,560 @@ func autoConvert_certmanager_CAIssuer_To_v1alpha3_CAIssuer(in *certmanager.CAIss
 	out.SecretName = in.SecretName
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(v1alpha3.CAIssuerKMS)
+		if err := Convert_certmanager_CAIssuerKMS_To_v1alpha3_CAIssuerKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KMS = nil
+	}
+	if in.PKCS11 != nil {
+		in, out := &in.PKCS11, &out.PKCS11
+		*out = new(v1alpha3.CAIssuerPKCS11)
+		if err := Convert_certmanager_CAIssuerPKCS11_To_v1alpha3_CAIssuerPKCS11(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PKCS11 = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuer_To_v1alpha3_CAIssuer is an autogenerated conversion function.
+func Convert_certmanager_CAIssuer_To_v1alpha3_CAIssuer(in *certmanager.CAIssuer, out *v1alpha3.CAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuer_To_v1alpha3_CAIssuer(in, out, s)
+}
+
+func autoConvert_v1alpha3_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in *v1alpha3.CAIssuerAWSKMS, out *certmanager.CAIssuerAWSKMS, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.Region = in.Region
+	out.AccessKeyID = in.AccessKeyID
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(meta.SecretKeySelector)
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretAccessKey = nil
+	}
+	out.Role = in.Role
+	return nil
+}
+
+// Convert_v1alpha3_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS is an autogenerated conversion function.
+func Convert_v1alpha3_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in *v1alpha3.CAIssuerAWSKMS, out *certmanager.CAIssuerAWSKMS, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerAWSKMS_To_v1alpha3_CAIssuerAWSKMS(in *certmanager.CAIssuerAWSKMS, out *v1alpha3.CAIssuerAWSKMS, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.Region = in.Region
+	out.AccessKeyID = in.AccessKeyID
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(metav1.SecretKeySelector)
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretAccessKey = nil
+	}
+	out.Role = in.Role
+	return nil
+}
+
+// Convert_certmanager_CAIssuerAWSKMS_To_v1alpha3_CAIssuerAWSKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerAWSKMS_To_v1alpha3_CAIssuerAWSKMS(in *certmanager.CAIssuerAWSKMS, out *v1alpha3.CAIssuerAWSKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerAWSKMS_To_v1alpha3_CAIssuerAWSKMS(in, out, s)
+}
+
+func autoConvert_v1alpha3_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in *v1alpha3.CAIssuerGoogleCloudKMS, out *certmanager.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	out.KeyVersion = in.KeyVersion
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(meta.SecretKeySelector)
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccount = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS is an autogenerated conversion function.
+func Convert_v1alpha3_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in *v1alpha3.CAIssuerGoogleCloudKMS, out *certmanager.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerGoogleCloudKMS_To_v1alpha3_CAIssuerGoogleCloudKMS(in *certmanager.CAIssuerGoogleCloudKMS, out *v1alpha3.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	out.KeyVersion = in.KeyVersion
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(metav1.SecretKeySelector)
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccount = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1alpha3_CAIssuerGoogleCloudKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1alpha3_CAIssuerGoogleCloudKMS(in *certmanager.CAIssuerGoogleCloudKMS, out *v1alpha3.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerGoogleCloudKMS_To_v1alpha3_CAIssuerGoogleCloudKMS(in, out, s)
+}
+
+func autoConvert_v1alpha3_CAIssuerKMS_To_certmanager_CAIssuerKMS(in *v1alpha3.CAIssuerKMS, out *certmanager.CAIssuerKMS, s conversion.Scope) error {
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(certmanager.CAIssuerAWSKMS)
+		if err := Convert_v1alpha3_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWS = nil
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(certmanager.CAIssuerGoogleCloudKMS)
+		if err := Convert_v1alpha3_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCloud = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_CAIssuerKMS_To_certmanager_CAIssuerKMS is an autogenerated conversion function.
+func Convert_v1alpha3_CAIssuerKMS_To_certmanager_CAIssuerKMS(in *v1alpha3.CAIssuerKMS, out *certmanager.CAIssuerKMS, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CAIssuerKMS_To_certmanager_CAIssuerKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerKMS_To_v1alpha3_CAIssuerKMS(in *certmanager.CAIssuerKMS, out *v1alpha3.CAIssuerKMS, s conversion.Scope) error {
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(v1alpha3.CAIssuerAWSKMS)
+		if err := Convert_certmanager_CAIssuerAWSKMS_To_v1alpha3_CAIssuerAWSKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWS = nil
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(v1alpha3.CAIssuerGoogleCloudKMS)
+		if err := Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1alpha3_CAIssuerGoogleCloudKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCloud = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerKMS_To_v1alpha3_CAIssuerKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerKMS_To_v1alpha3_CAIssuerKMS(in *certmanager.CAIssuerKMS, out *v1alpha3.CAIssuerKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerKMS_To_v1alpha3_CAIssuerKMS(in, out, s)
+}
+
+func autoConvert_v1alpha3_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in *v1alpha3.CAIssuerPKCS11, out *certmanager.CAIssuerPKCS11, s conversion.Scope) error {
+	out.ModulePath = in.ModulePath
+	out.Slot = in.Slot
+	out.KeyLabel = in.KeyLabel
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PIN, &out.PIN, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11 is an autogenerated conversion function.
+func Convert_v1alpha3_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in *v1alpha3.CAIssuerPKCS11, out *certmanager.CAIssuerPKCS11, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerPKCS11_To_v1alpha3_CAIssuerPKCS11(in *certmanager.CAIssuerPKCS11, out *v1alpha3.CAIssuerPKCS11, s conversion.Scope) error {
+	out.ModulePath = in.ModulePath
+	out.Slot = in.Slot
+	out.KeyLabel = in.KeyLabel
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PIN, &out.PIN, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerPKCS11_To_v1alpha3_CAIssuerPKCS11 is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerPKCS11_To_v1alpha3_CAIssuerPKCS11(in *certmanager.CAIssuerPKCS11, out *v1alpha3.CAIssuerPKCS11, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerPKCS11_To_v1alpha3_CAIssuerPKCS11(in, out, s)
+}
+
+func autoConvert_v1alpha3_CMPIssuer_To_certmanager_CMPIssuer(in *v1alpha3.CMPIssuer, out *certmanager.CMPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(certmanager.CMPMACProtection)
+		if err := Convert_v1alpha3_CMPMACProtection_To_certmanager_CMPMACProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MAC = nil
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(certmanager.CMPSignatureProtection)
+		if err := Convert_v1alpha3_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Signature = nil
+	}
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1alpha3_CMPIssuer_To_certmanager_CMPIssuer is an autogenerated conversion function.
+func Convert_v1alpha3_CMPIssuer_To_certmanager_CMPIssuer(in *v1alpha3.CMPIssuer, out *certmanager.CMPIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CMPIssuer_To_certmanager_CMPIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_CMPIssuer_To_v1alpha3_CMPIssuer(in *certmanager.CMPIssuer, out *v1alpha3.CMPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(v1alpha3.CMPMACProtection)
+		if err := Convert_certmanager_CMPMACProtection_To_v1alpha3_CMPMACProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MAC = nil
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(v1alpha3.CMPSignatureProtection)
+		if err := Convert_certmanager_CMPSignatureProtection_To_v1alpha3_CMPSignatureProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Signature = nil
+	}
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_certmanager_CMPIssuer_To_v1alpha3_CMPIssuer is an autogenerated conversion function.
+func Convert_certmanager_CMPIssuer_To_v1alpha3_CMPIssuer(in *certmanager.CMPIssuer, out *v1alpha3.CMPIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPIssuer_To_v1alpha3_CMPIssuer(in, out, s)
+}
+
+func autoConvert_v1alpha3_CMPMACProtection_To_certmanager_CMPMACProtection(in *v1alpha3.CMPMACProtection, out *certmanager.CMPMACProtection, s conversion.Scope) error {
+	out.Reference = in.Reference
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_CMPMACProtection_To_certmanager_CMPMACProtection is an autogenerated conversion function.
+func Convert_v1alpha3_CMPMACProtection_To_certmanager_CMPMACProtection(in *v1alpha3.CMPMACProtection, out *certmanager.CMPMACProtection, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CMPMACProtection_To_certmanager_CMPMACProtection(in, out, s)
+}
+
+func autoConvert_certmanager_CMPMACProtection_To_v1alpha3_CMPMACProtection(in *certmanager.CMPMACProtection, out *v1alpha3.CMPMACProtection, s conversion.Scope) error {
+	out.Reference = in.Reference
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CMPMACProtection_To_v1alpha3_CMPMACProtection is an autogenerated conversion function.
+func Convert_certmanager_CMPMACProtection_To_v1alpha3_CMPMACProtection(in *certmanager.CMPMACProtection, out *v1alpha3.CMPMACProtection, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPMACProtection_To_v1alpha3_CMPMACProtection(in, out, s)
+}
+
+func autoConvert_v1alpha3_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in *v1alpha3.CMPSignatureProtection, out *certmanager.CMPSignatureProtection, s conversion.Scope) error {
+	if err := v1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_CMPSignatureProtection_To_certmanager_CMPSignatureProtection is an autogenerated conversion function.
+func Convert_v1alpha3_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in *v1alpha3.CMPSignatureProtection, out *certmanager.CMPSignatureProtection, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in, out, s)
+}
+
+func autoConvert_certmanager_CMPSignatureProtection_To_v1alpha3_CMPSignatureProtection(in *certmanager.CMPSignatureProtection, out *v1alpha3.CMPSignatureProtection, s conversion.Scope) error {
+	if err := v1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CMPSignatureProtection_To_v1alpha3_CMPSignatureProtection is an autogenerated conversion function.
+func Convert_certmanager_CMPSignatureProtection_To_v1alpha3_CMPSignatureProtection(in *certmanager.CMPSignatureProtection, out *v1alpha3.CMPSignatureProtection, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPSignatureProtection_To_v1alpha3_CMPSignatureProtection(in, out, s)
+}
+
+func autoConvert_v1alpha3_Certificate_To_certmanager_Certificate(in *v1alpha3.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha3_CertificateStatus_To_certmanager_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_Certificate_To_certmanager_Certificate is an autogenerated conversion function.
+func Convert_v1alpha3_Certificate_To_certmanager_Certificate(in *v1alpha3.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
+	return autoConvert_v1alpha3_Certificate_To_certmanager_Certificate(in, out, s)
+}
+
+func autoConvert_certmanager_Certificate_To_v1alpha3_Certificate(in *certmanager.Certificate, out *v1alpha3.Certificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_CertificateStatus_To_v1alpha3_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_Certificate_To_v1alpha3_Certificate is an autogenerated conversion function.
+func Convert_certmanager_Certificate_To_v1alpha3_Certificate(in *certmanager.Certificate, out *v1alpha3.Certificate, s conversion.Scope) error {
+	return autoConvert_certmanager_Certificate_To_v1alpha3_Certificate(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in *v1alpha3.CertificateACMESolverStatus, out *certmanager.CertificateACMESolverStatus, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.Type = in.Type
+	out.SolverIndex = (*int)(unsafe.Pointer(in.SolverIndex))
+	out.MatchedSelectors = *(*[]string)(unsafe.Pointer(&in.MatchedSelectors))
+	out.Zone = in.Zone
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha3_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in *v1alpha3.CertificateACMESolverStatus, out *certmanager.CertificateACMESolverStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateACMESolverStatus_To_v1alpha3_CertificateACMESolverStatus(in *certmanager.CertificateACMESolverStatus, out *v1alpha3.CertificateACMESolverStatus, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.Type = in.Type
+	out.SolverIndex = (*int)(unsafe.Pointer(in.SolverIndex))
+	out.MatchedSelectors = *(*[]string)(unsafe.Pointer(&in.MatchedSelectors))
+	out.Zone = in.Zone
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateACMESolverStatus_To_v1alpha3_CertificateACMESolverStatus is an autogenerated conversion function.
+func Convert_certmanager_CertificateACMESolverStatus_To_v1alpha3_CertificateACMESolverStatus(in *certmanager.CertificateACMESolverStatus, out *v1alpha3.CertificateACMESolverStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateACMESolverStatus_To_v1alpha3_CertificateACMESolverStatus(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1alpha3.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1alpha3.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1alpha3.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = v1alpha3.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1alpha3.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateCAChain_To_certmanager_CertificateCAChain(in *v1alpha3.CertificateCAChain, out *certmanager.CertificateCAChain, s conversion.Scope) error {
+	out.Composition = certmanager.CertificateCAChainComposition(in.Composition)
+	out.CompleteChain = in.CompleteChain
+	return nil
+}
+
+// Convert_v1alpha3_CertificateCAChain_To_certmanager_CertificateCAChain is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateCAChain_To_certmanager_CertificateCAChain(in *v1alpha3.CertificateCAChain, out *certmanager.CertificateCAChain, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateCAChain_To_certmanager_CertificateCAChain(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateCAChain_To_v1alpha3_CertificateCAChain(in *certmanager.CertificateCAChain, out *v1alpha3.CertificateCAChain, s conversion.Scope) error {
+	out.Composition = v1alpha3.CertificateCAChainComposition(in.Composition)
+	out.CompleteChain = in.CompleteChain
+	return nil
+}
+
+// Convert_certmanager_CertificateCAChain_To_v1alpha3_CertificateCAChain is an autogenerated conversion function.
+func Convert_certmanager_CertificateCAChain_To_v1alpha3_CertificateCAChain(in *certmanager.CertificateCAChain, out *v1alpha3.CertificateCAChain, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateCAChain_To_v1alpha3_CertificateCAChain(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(in *v1alpha3.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
+	out.Type = certmanager.CertificateConditionType(in.Type)
+	out.Status = meta.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(in *v1alpha3.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateCondition_To_v1alpha3_CertificateCondition(in *certmanager.CertificateCondition, out *v1alpha3.CertificateCondition, s conversion.Scope) error {
+	out.Type = v1alpha3.CertificateConditionType(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_certmanager_CertificateCondition_To_v1alpha3_CertificateCondition is an autogenerated conversion function.
+func Convert_certmanager_CertificateCondition_To_v1alpha3_CertificateCondition(in *certmanager.CertificateCondition, out *v1alpha3.CertificateCondition, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateCondition_To_v1alpha3_CertificateCondition(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in *v1alpha3.CertificateExternalPrivateKey, out *certmanager.CertificateExternalPrivateKey, s conversion.Scope) error {
+	out.Provider = in.Provider
+	return nil
+}
+
+// Convert_v1alpha3_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in *v1alpha3.CertificateExternalPrivateKey, out *certmanager.CertificateExternalPrivateKey, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateExternalPrivateKey_To_v1alpha3_CertificateExternalPrivateKey(in *certmanager.CertificateExternalPrivateKey, out *v1alpha3.CertificateExternalPrivateKey, s conversion.Scope) error {
+	out.Provider = in.Provider
+	return nil
+}
+
+// Convert_certmanager_CertificateExternalPrivateKey_To_v1alpha3_CertificateExternalPrivateKey is an autogenerated conversion function.
+func Convert_certmanager_CertificateExternalPrivateKey_To_v1alpha3_CertificateExternalPrivateKey(in *certmanager.CertificateExternalPrivateKey, out *v1alpha3.CertificateExternalPrivateKey, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateExternalPrivateKey_To_v1alpha3_CertificateExternalPrivateKey(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in *v1alpha3.CertificateIssuanceAttempt, out *certmanager.CertificateIssuanceAttempt, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Outcome = certmanager.CertificateIssuanceOutcome(in.Outcome)
+	out.Message = in.Message
+	out.RequestName = in.RequestName
+	return nil
+}
+
+// Convert_v1alpha3_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in *v1alpha3.CertificateIssuanceAttempt, out *certmanager.CertificateIssuanceAttempt, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateIssuanceAttempt_To_v1alpha3_CertificateIssuanceAttempt(in *certmanager.CertificateIssuanceAttempt, out *v1alpha3.CertificateIssuanceAttempt, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Outcome = v1alpha3.CertificateIssuanceOutcome(in.Outcome)
+	out.Message = in.Message
+	out.RequestName = in.RequestName
 	return nil
 }
 
-// Convert_certmanager_CAIssuer_To_v1alpha3_CAIssuer is an autogenerated conversion function.
-func Convert_certmanager_CAIssuer_To_v1alpha3_CAIssuer(in *certmanager.CAIssuer, out *v1alpha3.CAIssuer, s conversion.Scope) error {
-	return autoConvert_certmanager_CAIssuer_To_v1alpha3_CAIssuer(in, out, s)
+// Convert_certmanager_CertificateIssuanceAttempt_To_v1alpha3_CertificateIssuanceAttempt is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuanceAttempt_To_v1alpha3_CertificateIssuanceAttempt(in *certmanager.CertificateIssuanceAttempt, out *v1alpha3.CertificateIssuanceAttempt, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuanceAttempt_To_v1alpha3_CertificateIssuanceAttempt(in, out, s)
 }
 
-func autoConvert_v1alpha3_Certificate_To_certmanager_Certificate(in *v1alpha3.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha3_CertificateStatus_To_certmanager_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+func autoConvert_v1alpha3_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in *v1alpha3.CertificateIssuanceDetails, out *certmanager.CertificateIssuanceDetails, s conversion.Scope) error {
+	if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.SerialNumber = in.SerialNumber
+	out.Fingerprint = in.Fingerprint
 	return nil
 }
 
-// Convert_v1alpha3_Certificate_To_certmanager_Certificate is an autogenerated conversion function.
-func Convert_v1alpha3_Certificate_To_certmanager_Certificate(in *v1alpha3.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
-	return autoConvert_v1alpha3_Certificate_To_certmanager_Certificate(in, out, s)
+// Convert_v1alpha3_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in *v1alpha3.CertificateIssuanceDetails, out *certmanager.CertificateIssuanceDetails, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in, out, s)
 }
 
-func autoConvert_certmanager_Certificate_To_v1alpha3_Certificate(in *certmanager.Certificate, out *v1alpha3.Certificate, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_certmanager_CertificateStatus_To_v1alpha3_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+func autoConvert_certmanager_CertificateIssuanceDetails_To_v1alpha3_CertificateIssuanceDetails(in *certmanager.CertificateIssuanceDetails, out *v1alpha3.CertificateIssuanceDetails, s conversion.Scope) error {
+	if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.SerialNumber = in.SerialNumber
+	out.Fingerprint = in.Fingerprint
 	return nil
 }
 
-// Convert_certmanager_Certificate_To_v1alpha3_Certificate is an autogenerated conversion function.
-func Convert_certmanager_Certificate_To_v1alpha3_Certificate(in *certmanager.Certificate, out *v1alpha3.Certificate, s conversion.Scope) error {
-	return autoConvert_certmanager_Certificate_To_v1alpha3_Certificate(in, out, s)
+// Convert_certmanager_CertificateIssuanceDetails_To_v1alpha3_CertificateIssuanceDetails is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuanceDetails_To_v1alpha3_CertificateIssuanceDetails(in *certmanager.CertificateIssuanceDetails, out *v1alpha3.CertificateIssuanceDetails, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuanceDetails_To_v1alpha3_CertificateIssuanceDetails(in, out, s)
 }
 
-func autoConvert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(in *v1alpha3.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
-	out.Type = certmanager.CertificateConditionType(in.Type)
-	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_v1alpha3_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in *v1alpha3.CertificateIssuancePlan, out *certmanager.CertificateIssuancePlan, s conversion.Scope) error {
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.IsCA = in.IsCA
+	out.Extensions = *(*[]certmanager.X509Extension)(unsafe.Pointer(&in.Extensions))
+	if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition is an autogenerated conversion function.
-func Convert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(in *v1alpha3.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
-	return autoConvert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(in, out, s)
+// Convert_v1alpha3_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in *v1alpha3.CertificateIssuancePlan, out *certmanager.CertificateIssuancePlan, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateCondition_To_v1alpha3_CertificateCondition(in *certmanager.CertificateCondition, out *v1alpha3.CertificateCondition, s conversion.Scope) error {
-	out.Type = v1alpha3.CertificateConditionType(in.Type)
-	out.Status = metav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_certmanager_CertificateIssuancePlan_To_v1alpha3_CertificateIssuancePlan(in *certmanager.CertificateIssuancePlan, out *v1alpha3.CertificateIssuancePlan, s conversion.Scope) error {
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]v1alpha3.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.IsCA = in.IsCA
+	out.Extensions = *(*[]v1alpha3.X509Extension)(unsafe.Pointer(&in.Extensions))
+	if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_certmanager_CertificateCondition_To_v1alpha3_CertificateCondition is an autogenerated conversion function.
-func Convert_certmanager_CertificateCondition_To_v1alpha3_CertificateCondition(in *certmanager.CertificateCondition, out *v1alpha3.CertificateCondition, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateCondition_To_v1alpha3_CertificateCondition(in, out, s)
+// Convert_certmanager_CertificateIssuancePlan_To_v1alpha3_CertificateIssuancePlan is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuancePlan_To_v1alpha3_CertificateIssuancePlan(in *certmanager.CertificateIssuancePlan, out *v1alpha3.CertificateIssuancePlan, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuancePlan_To_v1alpha3_CertificateIssuancePlan(in, out, s)
 }
 
 func autoConvert_v1alpha3_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1alpha3.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
@@ -560,6 +1304,17 @@ func Convert_certmanager_CertificateList_To_v1alpha3_CertificateList(in *certman
 
 func autoConvert_v1alpha3_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1alpha3.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.External = (*certmanager.CertificateExternalPrivateKey)(unsafe.Pointer(in.External))
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretRef = nil
+	}
+	out.SignatureAlgorithm = certmanager.SignatureAlgorithm(in.SignatureAlgorithm)
 	return nil
 }
 
@@ -570,12 +1325,81 @@ func Convert_v1alpha3_CertificatePrivateKey_To_certmanager_CertificatePrivateKey
 
 func autoConvert_certmanager_CertificatePrivateKey_To_v1alpha3_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1alpha3.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = v1alpha3.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.External = (*v1alpha3.CertificateExternalPrivateKey)(unsafe.Pointer(in.External))
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretRef = nil
+	}
+	out.SignatureAlgorithm = v1alpha3.SignatureAlgorithm(in.SignatureAlgorithm)
 	// WARNING: in.Encoding requires manual conversion: does not exist in peer-type
 	// WARNING: in.Algorithm requires manual conversion: does not exist in peer-type
 	// WARNING: in.Size requires manual conversion: does not exist in peer-type
 	return nil
 }
 
+func autoConvert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1alpha3.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Windows = *(*[]certmanager.CertificateRenewalWindowRange)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1alpha3.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1alpha3.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Windows = *(*[]v1alpha3.CertificateRenewalWindowRange)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1alpha3.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in *v1alpha3.CertificateRenewalWindowRange, out *certmanager.CertificateRenewalWindowRange, s conversion.Scope) error {
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha3_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in *v1alpha3.CertificateRenewalWindowRange, out *certmanager.CertificateRenewalWindowRange, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindowRange_To_v1alpha3_CertificateRenewalWindowRange(in *certmanager.CertificateRenewalWindowRange, out *v1alpha3.CertificateRenewalWindowRange, s conversion.Scope) error {
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindowRange_To_v1alpha3_CertificateRenewalWindowRange is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindowRange_To_v1alpha3_CertificateRenewalWindowRange(in *certmanager.CertificateRenewalWindowRange, out *v1alpha3.CertificateRenewalWindowRange, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindowRange_To_v1alpha3_CertificateRenewalWindowRange(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in *v1alpha3.CertificateDualCertificate, out *certmanager.CertificateDualCertificate, s conversion.Scope) error {
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+func autoConvert_certmanager_CertificateDualCertificate_To_v1alpha3_CertificateDualCertificate(in *certmanager.CertificateDualCertificate, out *v1alpha3.CertificateDualCertificate, s conversion.Scope) error {
+	out.Algorithm = v1alpha3.KeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
 func autoConvert_v1alpha3_CertificateRequest_To_certmanager_CertificateRequest(in *v1alpha3.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha3_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -611,7 +1435,7 @@ func Convert_certmanager_CertificateRequest_To_v1alpha3_CertificateRequest(in *c
 func autoConvert_v1alpha3_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1alpha3.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateRequestConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	return nil
@@ -625,7 +1449,7 @@ func Convert_v1alpha3_CertificateRequestCondition_To_certmanager_CertificateRequ
 func autoConvert_certmanager_CertificateRequestCondition_To_v1alpha3_CertificateRequestCondition(in *certmanager.CertificateRequestCondition, out *v1alpha3.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = v1alpha3.CertificateRequestConditionType(in.Type)
 	out.Status = metav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	return nil
@@ -679,13 +1503,15 @@ func Convert_certmanager_CertificateRequestList_To_v1alpha3_CertificateRequestLi
 }
 
 func autoConvert_v1alpha3_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1alpha3.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
-	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
-	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Backdate = (*apismetav1.Duration)(unsafe.Pointer(in.Backdate))
+	if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	// WARNING: in.CSRPEM requires manual conversion: does not exist in peer-type
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Extensions = *(*[]certmanager.X509Extension)(unsafe.Pointer(&in.Extensions))
 	out.Username = in.Username
 	out.UID = in.UID
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
@@ -694,13 +1520,15 @@ func autoConvert_v1alpha3_CertificateRequestSpec_To_certmanager_CertificateReque
 }
 
 func autoConvert_certmanager_CertificateRequestSpec_To_v1alpha3_CertificateRequestSpec(in *certmanager.CertificateRequestSpec, out *v1alpha3.CertificateRequestSpec, s conversion.Scope) error {
-	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
-	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Backdate = (*apismetav1.Duration)(unsafe.Pointer(in.Backdate))
+	if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	// WARNING: in.Request requires manual conversion: does not exist in peer-type
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1alpha3.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Extensions = *(*[]v1alpha3.X509Extension)(unsafe.Pointer(&in.Extensions))
 	out.Username = in.Username
 	out.UID = in.UID
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
@@ -712,7 +1540,7 @@ func autoConvert_v1alpha3_CertificateRequestStatus_To_certmanager_CertificateReq
 	out.Conditions = *(*[]certmanager.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
-	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*apismetav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -725,7 +1553,7 @@ func autoConvert_certmanager_CertificateRequestStatus_To_v1alpha3_CertificateReq
 	out.Conditions = *(*[]v1alpha3.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
-	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*apismetav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -737,6 +1565,7 @@ func Convert_certmanager_CertificateRequestStatus_To_v1alpha3_CertificateRequest
 func autoConvert_v1alpha3_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1alpha3.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Keys = *(*map[string]string)(unsafe.Pointer(&in.Keys))
 	return nil
 }
 
@@ -748,6 +1577,7 @@ func Convert_v1alpha3_CertificateSecretTemplate_To_certmanager_CertificateSecret
 func autoConvert_certmanager_CertificateSecretTemplate_To_v1alpha3_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1alpha3.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Keys = *(*map[string]string)(unsafe.Pointer(&in.Keys))
 	return nil
 }
 
@@ -757,23 +1587,20 @@ func Convert_certmanager_CertificateSecretTemplate_To_v1alpha3_CertificateSecret
 }
 
 func autoConvert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(in *v1alpha3.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
-	if in.Subject != nil {
-		in, out := &in.Subject, &out.Subject
-		*out = new(certmanager.X509Subject)
-		if err := Convert_v1alpha3_X509Subject_To_certmanager_X509Subject(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.Subject = nil
-	}
+	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
 	out.CommonName = in.CommonName
-	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
-	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*apismetav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewalWindow = (*certmanager.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
+	out.IssuanceDeadline = (*apismetav1.Duration)(unsafe.Pointer(in.IssuanceDeadline))
+	out.RenewRequestTime = (*apismetav1.Time)(unsafe.Pointer(in.RenewRequestTime))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URISANs = *(*[]string)(unsafe.Pointer(&in.URISANs))
 	out.EmailSANs = *(*[]string)(unsafe.Pointer(&in.EmailSANs))
 	out.SecretName = in.SecretName
+	out.SecretNamespace = in.SecretNamespace
+	out.AdditionalSecretNames = *(*[]string)(unsafe.Pointer(&in.AdditionalSecretNames))
 	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
@@ -784,11 +1611,36 @@ func autoConvert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(in *v1a
 	} else {
 		out.Keystores = nil
 	}
-	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CAChain = (*certmanager.CertificateCAChain)(unsafe.Pointer(in.CAChain))
+	out.TrustStore = (*certmanager.CertificateTrustStore)(unsafe.Pointer(in.TrustStore))
+	if in.DualCertificate != nil {
+		in, out := &in.DualCertificate, &out.DualCertificate
+		*out = new(certmanager.CertificateDualCertificate)
+		if err := Convert_v1alpha3_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DualCertificate = nil
+	}
+	if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]meta.ObjectReference, len(*in))
+		for i := range *in {
+			if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.IssuerFailoverThreshold = (*int)(unsafe.Pointer(in.IssuerFailoverThreshold))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Profile = certmanager.CertificateProfile(in.Profile)
 	// WARNING: in.KeySize requires manual conversion: does not exist in peer-type
 	// WARNING: in.KeyAlgorithm requires manual conversion: does not exist in peer-type
 	// WARNING: in.KeyEncoding requires manual conversion: does not exist in peer-type
@@ -807,23 +1659,20 @@ func autoConvert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(in *v1a
 }
 
 func autoConvert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *certmanager.CertificateSpec, out *v1alpha3.CertificateSpec, s conversion.Scope) error {
-	if in.Subject != nil {
-		in, out := &in.Subject, &out.Subject
-		*out = new(v1alpha3.X509Subject)
-		if err := Convert_certmanager_X509Subject_To_v1alpha3_X509Subject(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.Subject = nil
-	}
+	out.Subject = (*v1alpha3.X509Subject)(unsafe.Pointer(in.Subject))
 	out.CommonName = in.CommonName
-	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
-	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*apismetav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewalWindow = (*v1alpha3.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
+	out.IssuanceDeadline = (*apismetav1.Duration)(unsafe.Pointer(in.IssuanceDeadline))
+	out.RenewRequestTime = (*apismetav1.Time)(unsafe.Pointer(in.RenewRequestTime))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URISANs = *(*[]string)(unsafe.Pointer(&in.URISANs))
 	out.EmailSANs = *(*[]string)(unsafe.Pointer(&in.EmailSANs))
 	out.SecretName = in.SecretName
+	out.SecretNamespace = in.SecretNamespace
+	out.AdditionalSecretNames = *(*[]string)(unsafe.Pointer(&in.AdditionalSecretNames))
 	out.SecretTemplate = (*v1alpha3.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
@@ -834,11 +1683,36 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *cer
 	} else {
 		out.Keystores = nil
 	}
-	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.AdditionalOutputFormats = *(*[]v1alpha3.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CAChain = (*v1alpha3.CertificateCAChain)(unsafe.Pointer(in.CAChain))
+	out.TrustStore = (*v1alpha3.CertificateTrustStore)(unsafe.Pointer(in.TrustStore))
+	if in.DualCertificate != nil {
+		in, out := &in.DualCertificate, &out.DualCertificate
+		*out = new(v1alpha3.CertificateDualCertificate)
+		if err := Convert_certmanager_CertificateDualCertificate_To_v1alpha3_CertificateDualCertificate(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DualCertificate = nil
+	}
+	if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]metav1.ObjectReference, len(*in))
+		for i := range *in {
+			if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.IssuerFailoverThreshold = (*int)(unsafe.Pointer(in.IssuerFailoverThreshold))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1alpha3.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Profile = v1alpha3.CertificateProfile(in.Profile)
 	if in.PrivateKey != nil {
 		in, out := &in.PrivateKey, &out.PrivateKey
 		*out = new(v1alpha3.CertificatePrivateKey)
@@ -855,12 +1729,27 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *cer
 
 func autoConvert_v1alpha3_CertificateStatus_To_certmanager_CertificateStatus(in *v1alpha3.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
-	out.LastFailureTime = (*v1.Time)(unsafe.Pointer(in.LastFailureTime))
-	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
-	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
-	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.LastFailureTime = (*apismetav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastRenewRequestTime = (*apismetav1.Time)(unsafe.Pointer(in.LastRenewRequestTime))
+	out.NotBefore = (*apismetav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*apismetav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*apismetav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.ActiveRequestName = in.ActiveRequestName
+	out.LastIssuanceDetails = (*certmanager.CertificateIssuanceDetails)(unsafe.Pointer(in.LastIssuanceDetails))
+	out.IssuanceHistory = *(*[]certmanager.CertificateIssuanceAttempt)(unsafe.Pointer(&in.IssuanceHistory))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	if in.IssuancePlan != nil {
+		in, out := &in.IssuancePlan, &out.IssuancePlan
+		*out = new(certmanager.CertificateIssuancePlan)
+		if err := Convert_v1alpha3_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IssuancePlan = nil
+	}
+	out.ACMESolvers = *(*[]certmanager.CertificateACMESolverStatus)(unsafe.Pointer(&in.ACMESolvers))
 	return nil
 }
 
@@ -871,12 +1760,27 @@ func Convert_v1alpha3_CertificateStatus_To_certmanager_CertificateStatus(in *v1a
 
 func autoConvert_certmanager_CertificateStatus_To_v1alpha3_CertificateStatus(in *certmanager.CertificateStatus, out *v1alpha3.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1alpha3.CertificateCondition)(unsafe.Pointer(&in.Conditions))
-	out.LastFailureTime = (*v1.Time)(unsafe.Pointer(in.LastFailureTime))
-	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
-	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
-	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.LastFailureTime = (*apismetav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastRenewRequestTime = (*apismetav1.Time)(unsafe.Pointer(in.LastRenewRequestTime))
+	out.NotBefore = (*apismetav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*apismetav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*apismetav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.ActiveRequestName = in.ActiveRequestName
+	out.LastIssuanceDetails = (*v1alpha3.CertificateIssuanceDetails)(unsafe.Pointer(in.LastIssuanceDetails))
+	out.IssuanceHistory = *(*[]v1alpha3.CertificateIssuanceAttempt)(unsafe.Pointer(&in.IssuanceHistory))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	if in.IssuancePlan != nil {
+		in, out := &in.IssuancePlan, &out.IssuancePlan
+		*out = new(v1alpha3.CertificateIssuancePlan)
+		if err := Convert_certmanager_CertificateIssuancePlan_To_v1alpha3_CertificateIssuancePlan(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IssuancePlan = nil
+	}
+	out.ACMESolvers = *(*[]v1alpha3.CertificateACMESolverStatus)(unsafe.Pointer(&in.ACMESolvers))
 	return nil
 }
 
@@ -885,6 +1789,26 @@ func Convert_certmanager_CertificateStatus_To_v1alpha3_CertificateStatus(in *cer
 	return autoConvert_certmanager_CertificateStatus_To_v1alpha3_CertificateStatus(in, out, s)
 }
 
+func autoConvert_v1alpha3_CertificateTrustStore_To_certmanager_CertificateTrustStore(in *v1alpha3.CertificateTrustStore, out *certmanager.CertificateTrustStore, s conversion.Scope) error {
+	out.Create = in.Create
+	return nil
+}
+
+// Convert_v1alpha3_CertificateTrustStore_To_certmanager_CertificateTrustStore is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateTrustStore_To_certmanager_CertificateTrustStore(in *v1alpha3.CertificateTrustStore, out *certmanager.CertificateTrustStore, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateTrustStore_To_certmanager_CertificateTrustStore(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateTrustStore_To_v1alpha3_CertificateTrustStore(in *certmanager.CertificateTrustStore, out *v1alpha3.CertificateTrustStore, s conversion.Scope) error {
+	out.Create = in.Create
+	return nil
+}
+
+// Convert_certmanager_CertificateTrustStore_To_v1alpha3_CertificateTrustStore is an autogenerated conversion function.
+func Convert_certmanager_CertificateTrustStore_To_v1alpha3_CertificateTrustStore(in *certmanager.CertificateTrustStore, out *v1alpha3.CertificateTrustStore, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateTrustStore_To_v1alpha3_CertificateTrustStore(in, out, s)
+}
+
 func autoConvert_v1alpha3_ClusterIssuer_To_certmanager_ClusterIssuer(in *v1alpha3.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha3_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -994,7 +1918,7 @@ func Convert_certmanager_Issuer_To_v1alpha3_Issuer(in *certmanager.Issuer, out *
 func autoConvert_v1alpha3_IssuerCondition_To_certmanager_IssuerCondition(in *v1alpha3.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanager.IssuerConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
@@ -1009,7 +1933,7 @@ func Convert_v1alpha3_IssuerCondition_To_certmanager_IssuerCondition(in *v1alpha
 func autoConvert_certmanager_IssuerCondition_To_v1alpha3_IssuerCondition(in *certmanager.IssuerCondition, out *v1alpha3.IssuerCondition, s conversion.Scope) error {
 	out.Type = v1alpha3.IssuerConditionType(in.Type)
 	out.Status = metav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
@@ -1021,6 +1945,30 @@ func Convert_certmanager_IssuerCondition_To_v1alpha3_IssuerCondition(in *certman
 	return autoConvert_certmanager_IssuerCondition_To_v1alpha3_IssuerCondition(in, out, s)
 }
 
+func autoConvert_v1alpha3_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *v1alpha3.IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	return nil
+}
+
+// Convert_v1alpha3_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_v1alpha3_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *v1alpha3.IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha3_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerCertificateDefaults_To_v1alpha3_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *v1alpha3.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Usages = *(*[]v1alpha3.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	return nil
+}
+
+// Convert_certmanager_IssuerCertificateDefaults_To_v1alpha3_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_certmanager_IssuerCertificateDefaults_To_v1alpha3_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *v1alpha3.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerCertificateDefaults_To_v1alpha3_IssuerCertificateDefaults(in, out, s)
+}
+
 func autoConvert_v1alpha3_IssuerConfig_To_certmanager_IssuerConfig(in *v1alpha3.IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
 	if in.ACME != nil {
 		in, out := &in.ACME, &out.ACME
@@ -1031,7 +1979,15 @@ func autoConvert_v1alpha3_IssuerConfig_To_certmanager_IssuerConfig(in *v1alpha3.
 	} else {
 		out.ACME = nil
 	}
-	out.CA = (*certmanager.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(certmanager.CAIssuer)
+		if err := Convert_v1alpha3_CAIssuer_To_certmanager_CAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CA = nil
+	}
 	if in.Vault != nil {
 		in, out := &in.Vault, &out.Vault
 		*out = new(certmanager.VaultIssuer)
@@ -1051,6 +2007,24 @@ func autoConvert_v1alpha3_IssuerConfig_To_certmanager_IssuerConfig(in *v1alpha3.
 	} else {
 		out.Venafi = nil
 	}
+	if in.SCEP != nil {
+		in, out := &in.SCEP, &out.SCEP
+		*out = new(certmanager.SCEPIssuer)
+		if err := Convert_v1alpha3_SCEPIssuer_To_certmanager_SCEPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SCEP = nil
+	}
+	if in.CMP != nil {
+		in, out := &in.CMP, &out.CMP
+		*out = new(certmanager.CMPIssuer)
+		if err := Convert_v1alpha3_CMPIssuer_To_certmanager_CMPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CMP = nil
+	}
 	return nil
 }
 
@@ -1069,7 +2043,15 @@ func autoConvert_certmanager_IssuerConfig_To_v1alpha3_IssuerConfig(in *certmanag
 	} else {
 		out.ACME = nil
 	}
-	out.CA = (*v1alpha3.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1alpha3.CAIssuer)
+		if err := Convert_certmanager_CAIssuer_To_v1alpha3_CAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CA = nil
+	}
 	if in.Vault != nil {
 		in, out := &in.Vault, &out.Vault
 		*out = new(v1alpha3.VaultIssuer)
@@ -1089,6 +2071,24 @@ func autoConvert_certmanager_IssuerConfig_To_v1alpha3_IssuerConfig(in *certmanag
 	} else {
 		out.Venafi = nil
 	}
+	if in.SCEP != nil {
+		in, out := &in.SCEP, &out.SCEP
+		*out = new(v1alpha3.SCEPIssuer)
+		if err := Convert_certmanager_SCEPIssuer_To_v1alpha3_SCEPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SCEP = nil
+	}
+	if in.CMP != nil {
+		in, out := &in.CMP, &out.CMP
+		*out = new(v1alpha3.CMPIssuer)
+		if err := Convert_certmanager_CMPIssuer_To_v1alpha3_CMPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CMP = nil
+	}
 	return nil
 }
 
@@ -1139,10 +2139,40 @@ func Convert_certmanager_IssuerList_To_v1alpha3_IssuerList(in *certmanager.Issue
 	return autoConvert_certmanager_IssuerList_To_v1alpha3_IssuerList(in, out, s)
 }
 
+func autoConvert_v1alpha3_IssuerPolicy_To_certmanager_IssuerPolicy(in *v1alpha3.IssuerPolicy, out *certmanager.IssuerPolicy, s conversion.Scope) error {
+	out.MinDuration = (*apismetav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*apismetav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.DurationEnforcement = certmanager.DurationEnforcement(in.DurationEnforcement)
+	out.AllowedPrivateKeyAlgorithms = *(*[]certmanager.PrivateKeyAlgorithm)(unsafe.Pointer(&in.AllowedPrivateKeyAlgorithms))
+	out.RequesterIdentity = (*certmanager.RequesterIdentityPolicy)(unsafe.Pointer(in.RequesterIdentity))
+	out.AllowedExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtensions))
+	return nil
+}
+
+func autoConvert_certmanager_IssuerPolicy_To_v1alpha3_IssuerPolicy(in *certmanager.IssuerPolicy, out *v1alpha3.IssuerPolicy, s conversion.Scope) error {
+	out.MinDuration = (*apismetav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*apismetav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.DurationEnforcement = v1alpha3.DurationEnforcement(in.DurationEnforcement)
+	out.AllowedPrivateKeyAlgorithms = *(*[]v1alpha3.KeyAlgorithm)(unsafe.Pointer(&in.AllowedPrivateKeyAlgorithms))
+	out.RequesterIdentity = (*v1alpha3.RequesterIdentityPolicy)(unsafe.Pointer(in.RequesterIdentity))
+	out.AllowedExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtensions))
+	return nil
+}
+
 func autoConvert_v1alpha3_IssuerSpec_To_certmanager_IssuerSpec(in *v1alpha3.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha3_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(certmanager.IssuerPolicy)
+		if err := Convert_v1alpha3_IssuerPolicy_To_certmanager_IssuerPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Policy = nil
+	}
+	out.CertificateDefaults = (*certmanager.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1155,6 +2185,16 @@ func autoConvert_certmanager_IssuerSpec_To_v1alpha3_IssuerSpec(in *certmanager.I
 	if err := Convert_certmanager_IssuerConfig_To_v1alpha3_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(v1alpha3.IssuerPolicy)
+		if err := Convert_certmanager_IssuerPolicy_To_v1alpha3_IssuerPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Policy = nil
+	}
+	out.CertificateDefaults = (*v1alpha3.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1203,7 +2243,7 @@ func Convert_certmanager_IssuerStatus_To_v1alpha3_IssuerStatus(in *certmanager.I
 
 func autoConvert_v1alpha3_JKSKeystore_To_certmanager_JKSKeystore(in *v1alpha3.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1216,7 +2256,7 @@ func Convert_v1alpha3_JKSKeystore_To_certmanager_JKSKeystore(in *v1alpha3.JKSKey
 
 func autoConvert_certmanager_JKSKeystore_To_v1alpha3_JKSKeystore(in *certmanager.JKSKeystore, out *v1alpha3.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1229,7 +2269,7 @@ func Convert_certmanager_JKSKeystore_To_v1alpha3_JKSKeystore(in *certmanager.JKS
 
 func autoConvert_v1alpha3_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1alpha3.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1242,7 +2282,7 @@ func Convert_v1alpha3_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1alpha3.
 
 func autoConvert_certmanager_PKCS12Keystore_To_v1alpha3_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *v1alpha3.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1253,8 +2293,103 @@ func Convert_certmanager_PKCS12Keystore_To_v1alpha3_PKCS12Keystore(in *certmanag
 	return autoConvert_certmanager_PKCS12Keystore_To_v1alpha3_PKCS12Keystore(in, out, s)
 }
 
+func autoConvert_v1alpha3_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in *v1alpha3.RequesterIdentityPolicy, out *certmanager.RequesterIdentityPolicy, s conversion.Scope) error {
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	return nil
+}
+
+// Convert_v1alpha3_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy is an autogenerated conversion function.
+func Convert_v1alpha3_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in *v1alpha3.RequesterIdentityPolicy, out *certmanager.RequesterIdentityPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha3_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_RequesterIdentityPolicy_To_v1alpha3_RequesterIdentityPolicy(in *certmanager.RequesterIdentityPolicy, out *v1alpha3.RequesterIdentityPolicy, s conversion.Scope) error {
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	return nil
+}
+
+// Convert_certmanager_RequesterIdentityPolicy_To_v1alpha3_RequesterIdentityPolicy is an autogenerated conversion function.
+func Convert_certmanager_RequesterIdentityPolicy_To_v1alpha3_RequesterIdentityPolicy(in *certmanager.RequesterIdentityPolicy, out *v1alpha3.RequesterIdentityPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_RequesterIdentityPolicy_To_v1alpha3_RequesterIdentityPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha3_SCEPIssuer_To_certmanager_SCEPIssuer(in *v1alpha3.SCEPIssuer, out *certmanager.SCEPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.ChallengePasswordSecretRef != nil {
+		in, out := &in.ChallengePasswordSecretRef, &out.ChallengePasswordSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ChallengePasswordSecretRef = nil
+	}
+	out.CAFingerprint = in.CAFingerprint
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1alpha3_SCEPIssuer_To_certmanager_SCEPIssuer is an autogenerated conversion function.
+func Convert_v1alpha3_SCEPIssuer_To_certmanager_SCEPIssuer(in *v1alpha3.SCEPIssuer, out *certmanager.SCEPIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SCEPIssuer_To_certmanager_SCEPIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_SCEPIssuer_To_v1alpha3_SCEPIssuer(in *certmanager.SCEPIssuer, out *v1alpha3.SCEPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.ChallengePasswordSecretRef != nil {
+		in, out := &in.ChallengePasswordSecretRef, &out.ChallengePasswordSecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ChallengePasswordSecretRef = nil
+	}
+	out.CAFingerprint = in.CAFingerprint
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_certmanager_SCEPIssuer_To_v1alpha3_SCEPIssuer is an autogenerated conversion function.
+func Convert_certmanager_SCEPIssuer_To_v1alpha3_SCEPIssuer(in *certmanager.SCEPIssuer, out *v1alpha3.SCEPIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SCEPIssuer_To_v1alpha3_SCEPIssuer(in, out, s)
+}
+
+func autoConvert_v1alpha3_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in *v1alpha3.SelfSignedBootstrapCA, out *certmanager.SelfSignedBootstrapCA, s conversion.Scope) error {
+	out.IssuerName = in.IssuerName
+	out.CommonName = in.CommonName
+	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
+	out.RootDuration = (*apismetav1.Duration)(unsafe.Pointer(in.RootDuration))
+	out.IntermediateDuration = (*apismetav1.Duration)(unsafe.Pointer(in.IntermediateDuration))
+	return nil
+}
+
+// Convert_v1alpha3_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA is an autogenerated conversion function.
+func Convert_v1alpha3_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in *v1alpha3.SelfSignedBootstrapCA, out *certmanager.SelfSignedBootstrapCA, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in, out, s)
+}
+
+func autoConvert_certmanager_SelfSignedBootstrapCA_To_v1alpha3_SelfSignedBootstrapCA(in *certmanager.SelfSignedBootstrapCA, out *v1alpha3.SelfSignedBootstrapCA, s conversion.Scope) error {
+	out.IssuerName = in.IssuerName
+	out.CommonName = in.CommonName
+	out.Subject = (*v1alpha3.X509Subject)(unsafe.Pointer(in.Subject))
+	out.RootDuration = (*apismetav1.Duration)(unsafe.Pointer(in.RootDuration))
+	out.IntermediateDuration = (*apismetav1.Duration)(unsafe.Pointer(in.IntermediateDuration))
+	return nil
+}
+
+// Convert_certmanager_SelfSignedBootstrapCA_To_v1alpha3_SelfSignedBootstrapCA is an autogenerated conversion function.
+func Convert_certmanager_SelfSignedBootstrapCA_To_v1alpha3_SelfSignedBootstrapCA(in *certmanager.SelfSignedBootstrapCA, out *v1alpha3.SelfSignedBootstrapCA, s conversion.Scope) error {
+	return autoConvert_certmanager_SelfSignedBootstrapCA_To_v1alpha3_SelfSignedBootstrapCA(in, out, s)
+}
+
 func autoConvert_v1alpha3_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1alpha3.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.BootstrapCA = (*certmanager.SelfSignedBootstrapCA)(unsafe.Pointer(in.BootstrapCA))
 	return nil
 }
 
@@ -1265,6 +2400,7 @@ func Convert_v1alpha3_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1alp
 
 func autoConvert_certmanager_SelfSignedIssuer_To_v1alpha3_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1alpha3.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.BootstrapCA = (*v1alpha3.SelfSignedBootstrapCA)(unsafe.Pointer(in.BootstrapCA))
 	return nil
 }
 
@@ -1276,7 +2412,7 @@ func Convert_certmanager_SelfSignedIssuer_To_v1alpha3_SelfSignedIssuer(in *certm
 func autoConvert_v1alpha3_VaultAppRole_To_certmanager_VaultAppRole(in *v1alpha3.VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1290,7 +2426,7 @@ func Convert_v1alpha3_VaultAppRole_To_certmanager_VaultAppRole(in *v1alpha3.Vaul
 func autoConvert_certmanager_VaultAppRole_To_v1alpha3_VaultAppRole(in *certmanager.VaultAppRole, out *v1alpha3.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1305,7 +2441,7 @@ func autoConvert_v1alpha3_VaultAuth_To_certmanager_VaultAuth(in *v1alpha3.VaultA
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
 		*out = new(meta.SecretKeySelector)
-		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1341,7 +2477,7 @@ func autoConvert_certmanager_VaultAuth_To_v1alpha3_VaultAuth(in *certmanager.Vau
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
 		*out = new(metav1.SecretKeySelector)
-		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1379,6 +2515,7 @@ func autoConvert_v1alpha3_VaultIssuer_To_certmanager_VaultIssuer(in *v1alpha3.Va
 	}
 	out.Server = in.Server
 	out.Path = in.Path
+	out.SigningMode = certmanager.VaultSigningMode(in.SigningMode)
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	return nil
@@ -1395,6 +2532,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1alpha3_VaultIssuer(in *certmanager
 	}
 	out.Server = in.Server
 	out.Path = in.Path
+	out.SigningMode = v1alpha3.VaultSigningMode(in.SigningMode)
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	return nil
@@ -1407,7 +2545,7 @@ func Convert_certmanager_VaultIssuer_To_v1alpha3_VaultIssuer(in *certmanager.Vau
 
 func autoConvert_v1alpha3_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(in *v1alpha3.VaultKubernetesAuth, out *certmanager.VaultKubernetesAuth, s conversion.Scope) error {
 	out.Path = in.Path
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1421,7 +2559,7 @@ func Convert_v1alpha3_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(in
 
 func autoConvert_certmanager_VaultKubernetesAuth_To_v1alpha3_VaultKubernetesAuth(in *certmanager.VaultKubernetesAuth, out *v1alpha3.VaultKubernetesAuth, s conversion.Scope) error {
 	out.Path = in.Path
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1435,7 +2573,7 @@ func Convert_certmanager_VaultKubernetesAuth_To_v1alpha3_VaultKubernetesAuth(in
 
 func autoConvert_v1alpha3_VenafiCloud_To_certmanager_VenafiCloud(in *v1alpha3.VenafiCloud, out *certmanager.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1448,7 +2586,7 @@ func Convert_v1alpha3_VenafiCloud_To_certmanager_VenafiCloud(in *v1alpha3.Venafi
 
 func autoConvert_certmanager_VenafiCloud_To_v1alpha3_VenafiCloud(in *certmanager.VenafiCloud, out *v1alpha3.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1517,7 +2655,7 @@ func Convert_certmanager_VenafiIssuer_To_v1alpha3_VenafiIssuer(in *certmanager.V
 
 func autoConvert_v1alpha3_VenafiTPP_To_certmanager_VenafiTPP(in *v1alpha3.VenafiTPP, out *certmanager.VenafiTPP, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := apismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := v1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
@@ -1531,7 +2669,7 @@ func Convert_v1alpha3_VenafiTPP_To_certmanager_VenafiTPP(in *v1alpha3.VenafiTPP,
 
 func autoConvert_certmanager_VenafiTPP_To_v1alpha3_VenafiTPP(in *certmanager.VenafiTPP, out *v1alpha3.VenafiTPP, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := apismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := v1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
@@ -1543,6 +2681,30 @@ func Convert_certmanager_VenafiTPP_To_v1alpha3_VenafiTPP(in *certmanager.VenafiT
 	return autoConvert_certmanager_VenafiTPP_To_v1alpha3_VenafiTPP(in, out, s)
 }
 
+func autoConvert_v1alpha3_X509Extension_To_certmanager_X509Extension(in *v1alpha3.X509Extension, out *certmanager.X509Extension, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_v1alpha3_X509Extension_To_certmanager_X509Extension is an autogenerated conversion function.
+func Convert_v1alpha3_X509Extension_To_certmanager_X509Extension(in *v1alpha3.X509Extension, out *certmanager.X509Extension, s conversion.Scope) error {
+	return autoConvert_v1alpha3_X509Extension_To_certmanager_X509Extension(in, out, s)
+}
+
+func autoConvert_certmanager_X509Extension_To_v1alpha3_X509Extension(in *certmanager.X509Extension, out *v1alpha3.X509Extension, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_certmanager_X509Extension_To_v1alpha3_X509Extension is an autogenerated conversion function.
+func Convert_certmanager_X509Extension_To_v1alpha3_X509Extension(in *certmanager.X509Extension, out *v1alpha3.X509Extension, s conversion.Scope) error {
+	return autoConvert_certmanager_X509Extension_To_v1alpha3_X509Extension(in, out, s)
+}
+
 func autoConvert_v1alpha3_X509Subject_To_certmanager_X509Subject(in *v1alpha3.X509Subject, out *certmanager.X509Subject, s conversion.Scope) error {
 	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
 	out.Countries = *(*[]string)(unsafe.Pointer(&in.Countries))
@@ -1552,6 +2714,8 @@ func autoConvert_v1alpha3_X509Subject_To_certmanager_X509Subject(in *v1alpha3.X5
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
+	out.UID = in.UID
+	out.ExtraNames = *(*[]string)(unsafe.Pointer(&in.ExtraNames))
 	return nil
 }
 
@@ -1569,6 +2733,12 @@ func autoConvert_certmanager_X509Subject_To_v1alpha3_X509Subject(in *certmanager
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
-	// WARNING: in.ExtraNames requires manual conversion: does not exist in peer-type
+	out.UID = in.UID
+	out.ExtraNames = *(*[]string)(unsafe.Pointer(&in.ExtraNames))
 	return nil
 }
+
+// Convert_certmanager_X509Subject_To_v1alpha3_X509Subject is an autogenerated conversion function.
+func Convert_certmanager_X509Subject_To_v1alpha3_X509Subject(in *certmanager.X509Subject, out *v1alpha3.X509Subject, s conversion.Scope) error {
+	return autoConvert_certmanager_X509Subject_To_v1alpha3_X509Subject(in, out, s)
+}
@@ -30,8 +30,8 @@ import (
 	acmev1beta1 "github.com/jetstack/cert-manager/pkg/internal/apis/acme/v1beta1"
 	certmanager "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	meta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
-	apismetav1 "github.com/jetstack/cert-manager/pkg/internal/apis/meta/v1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "github.com/jetstack/cert-manager/pkg/internal/apis/meta/v1"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -53,6 +53,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CAIssuerAWSKMS)(nil), (*certmanager.CAIssuerAWSKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(a.(*v1beta1.CAIssuerAWSKMS), b.(*certmanager.CAIssuerAWSKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerAWSKMS)(nil), (*v1beta1.CAIssuerAWSKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerAWSKMS_To_v1beta1_CAIssuerAWSKMS(a.(*certmanager.CAIssuerAWSKMS), b.(*v1beta1.CAIssuerAWSKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CAIssuerGoogleCloudKMS)(nil), (*certmanager.CAIssuerGoogleCloudKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(a.(*v1beta1.CAIssuerGoogleCloudKMS), b.(*certmanager.CAIssuerGoogleCloudKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerGoogleCloudKMS)(nil), (*v1beta1.CAIssuerGoogleCloudKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1beta1_CAIssuerGoogleCloudKMS(a.(*certmanager.CAIssuerGoogleCloudKMS), b.(*v1beta1.CAIssuerGoogleCloudKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CAIssuerKMS)(nil), (*certmanager.CAIssuerKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CAIssuerKMS_To_certmanager_CAIssuerKMS(a.(*v1beta1.CAIssuerKMS), b.(*certmanager.CAIssuerKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerKMS)(nil), (*v1beta1.CAIssuerKMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerKMS_To_v1beta1_CAIssuerKMS(a.(*certmanager.CAIssuerKMS), b.(*v1beta1.CAIssuerKMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CAIssuerPKCS11)(nil), (*certmanager.CAIssuerPKCS11)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(a.(*v1beta1.CAIssuerPKCS11), b.(*certmanager.CAIssuerPKCS11), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerPKCS11)(nil), (*v1beta1.CAIssuerPKCS11)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerPKCS11_To_v1beta1_CAIssuerPKCS11(a.(*certmanager.CAIssuerPKCS11), b.(*v1beta1.CAIssuerPKCS11), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CMPIssuer)(nil), (*certmanager.CMPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CMPIssuer_To_certmanager_CMPIssuer(a.(*v1beta1.CMPIssuer), b.(*certmanager.CMPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPIssuer)(nil), (*v1beta1.CMPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPIssuer_To_v1beta1_CMPIssuer(a.(*certmanager.CMPIssuer), b.(*v1beta1.CMPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CMPMACProtection)(nil), (*certmanager.CMPMACProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CMPMACProtection_To_certmanager_CMPMACProtection(a.(*v1beta1.CMPMACProtection), b.(*certmanager.CMPMACProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPMACProtection)(nil), (*v1beta1.CMPMACProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPMACProtection_To_v1beta1_CMPMACProtection(a.(*certmanager.CMPMACProtection), b.(*v1beta1.CMPMACProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CMPSignatureProtection)(nil), (*certmanager.CMPSignatureProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(a.(*v1beta1.CMPSignatureProtection), b.(*certmanager.CMPSignatureProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CMPSignatureProtection)(nil), (*v1beta1.CMPSignatureProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CMPSignatureProtection_To_v1beta1_CMPSignatureProtection(a.(*certmanager.CMPSignatureProtection), b.(*v1beta1.CMPSignatureProtection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.Certificate)(nil), (*certmanager.Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Certificate_To_certmanager_Certificate(a.(*v1beta1.Certificate), b.(*certmanager.Certificate), scope)
 	}); err != nil {
@@ -63,6 +133,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateACMESolverStatus)(nil), (*certmanager.CertificateACMESolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(a.(*v1beta1.CertificateACMESolverStatus), b.(*certmanager.CertificateACMESolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateACMESolverStatus)(nil), (*v1beta1.CertificateACMESolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateACMESolverStatus_To_v1beta1_CertificateACMESolverStatus(a.(*certmanager.CertificateACMESolverStatus), b.(*v1beta1.CertificateACMESolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateAdditionalOutputFormat)(nil), (*certmanager.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(a.(*v1beta1.CertificateAdditionalOutputFormat), b.(*certmanager.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalOutputFormat)(nil), (*v1beta1.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(a.(*certmanager.CertificateAdditionalOutputFormat), b.(*v1beta1.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateCAChain)(nil), (*certmanager.CertificateCAChain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateCAChain_To_certmanager_CertificateCAChain(a.(*v1beta1.CertificateCAChain), b.(*certmanager.CertificateCAChain), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateCAChain)(nil), (*v1beta1.CertificateCAChain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateCAChain_To_v1beta1_CertificateCAChain(a.(*certmanager.CertificateCAChain), b.(*v1beta1.CertificateCAChain), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(a.(*v1beta1.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -73,6 +173,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateExternalPrivateKey)(nil), (*certmanager.CertificateExternalPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(a.(*v1beta1.CertificateExternalPrivateKey), b.(*certmanager.CertificateExternalPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateExternalPrivateKey)(nil), (*v1beta1.CertificateExternalPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateExternalPrivateKey_To_v1beta1_CertificateExternalPrivateKey(a.(*certmanager.CertificateExternalPrivateKey), b.(*v1beta1.CertificateExternalPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateIssuanceAttempt)(nil), (*certmanager.CertificateIssuanceAttempt)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(a.(*v1beta1.CertificateIssuanceAttempt), b.(*certmanager.CertificateIssuanceAttempt), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuanceAttempt)(nil), (*v1beta1.CertificateIssuanceAttempt)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuanceAttempt_To_v1beta1_CertificateIssuanceAttempt(a.(*certmanager.CertificateIssuanceAttempt), b.(*v1beta1.CertificateIssuanceAttempt), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateIssuanceDetails)(nil), (*certmanager.CertificateIssuanceDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(a.(*v1beta1.CertificateIssuanceDetails), b.(*certmanager.CertificateIssuanceDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuanceDetails)(nil), (*v1beta1.CertificateIssuanceDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuanceDetails_To_v1beta1_CertificateIssuanceDetails(a.(*certmanager.CertificateIssuanceDetails), b.(*v1beta1.CertificateIssuanceDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateIssuancePlan)(nil), (*certmanager.CertificateIssuancePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(a.(*v1beta1.CertificateIssuancePlan), b.(*certmanager.CertificateIssuancePlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateIssuancePlan)(nil), (*v1beta1.CertificateIssuancePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateIssuancePlan_To_v1beta1_CertificateIssuancePlan(a.(*certmanager.CertificateIssuancePlan), b.(*v1beta1.CertificateIssuancePlan), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*v1beta1.CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -103,6 +243,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateRenewalWindow)(nil), (*certmanager.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(a.(*v1beta1.CertificateRenewalWindow), b.(*certmanager.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindow)(nil), (*v1beta1.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow(a.(*certmanager.CertificateRenewalWindow), b.(*v1beta1.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateRenewalWindowRange)(nil), (*certmanager.CertificateRenewalWindowRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(a.(*v1beta1.CertificateRenewalWindowRange), b.(*certmanager.CertificateRenewalWindowRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindowRange)(nil), (*v1beta1.CertificateRenewalWindowRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindowRange_To_v1beta1_CertificateRenewalWindowRange(a.(*certmanager.CertificateRenewalWindowRange), b.(*v1beta1.CertificateRenewalWindowRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateDualCertificate)(nil), (*certmanager.CertificateDualCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(a.(*v1beta1.CertificateDualCertificate), b.(*certmanager.CertificateDualCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateDualCertificate)(nil), (*v1beta1.CertificateDualCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateDualCertificate_To_v1beta1_CertificateDualCertificate(a.(*certmanager.CertificateDualCertificate), b.(*v1beta1.CertificateDualCertificate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1beta1.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -183,6 +353,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateTrustStore)(nil), (*certmanager.CertificateTrustStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateTrustStore_To_certmanager_CertificateTrustStore(a.(*v1beta1.CertificateTrustStore), b.(*certmanager.CertificateTrustStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateTrustStore)(nil), (*v1beta1.CertificateTrustStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateTrustStore_To_v1beta1_CertificateTrustStore(a.(*certmanager.CertificateTrustStore), b.(*v1beta1.CertificateTrustStore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.ClusterIssuer)(nil), (*certmanager.ClusterIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ClusterIssuer_To_certmanager_ClusterIssuer(a.(*v1beta1.ClusterIssuer), b.(*certmanager.ClusterIssuer), scope)
 	}); err != nil {
@@ -223,6 +403,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerCertificateDefaults)(nil), (*certmanager.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(a.(*v1beta1.IssuerCertificateDefaults), b.(*certmanager.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerCertificateDefaults)(nil), (*v1beta1.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerCertificateDefaults_To_v1beta1_IssuerCertificateDefaults(a.(*certmanager.IssuerCertificateDefaults), b.(*v1beta1.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerConfig)(nil), (*certmanager.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(a.(*v1beta1.IssuerConfig), b.(*certmanager.IssuerConfig), scope)
 	}); err != nil {
@@ -243,6 +433,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerPolicy)(nil), (*certmanager.IssuerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IssuerPolicy_To_certmanager_IssuerPolicy(a.(*v1beta1.IssuerPolicy), b.(*certmanager.IssuerPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerPolicy)(nil), (*v1beta1.IssuerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerPolicy_To_v1beta1_IssuerPolicy(a.(*certmanager.IssuerPolicy), b.(*v1beta1.IssuerPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerSpec)(nil), (*certmanager.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(a.(*v1beta1.IssuerSpec), b.(*certmanager.IssuerSpec), scope)
 	}); err != nil {
@@ -283,6 +483,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.RequesterIdentityPolicy)(nil), (*certmanager.RequesterIdentityPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(a.(*v1beta1.RequesterIdentityPolicy), b.(*certmanager.RequesterIdentityPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.RequesterIdentityPolicy)(nil), (*v1beta1.RequesterIdentityPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_RequesterIdentityPolicy_To_v1beta1_RequesterIdentityPolicy(a.(*certmanager.RequesterIdentityPolicy), b.(*v1beta1.RequesterIdentityPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.SCEPIssuer)(nil), (*certmanager.SCEPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SCEPIssuer_To_certmanager_SCEPIssuer(a.(*v1beta1.SCEPIssuer), b.(*certmanager.SCEPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SCEPIssuer)(nil), (*v1beta1.SCEPIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SCEPIssuer_To_v1beta1_SCEPIssuer(a.(*certmanager.SCEPIssuer), b.(*v1beta1.SCEPIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.SelfSignedBootstrapCA)(nil), (*certmanager.SelfSignedBootstrapCA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(a.(*v1beta1.SelfSignedBootstrapCA), b.(*certmanager.SelfSignedBootstrapCA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SelfSignedBootstrapCA)(nil), (*v1beta1.SelfSignedBootstrapCA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SelfSignedBootstrapCA_To_v1beta1_SelfSignedBootstrapCA(a.(*certmanager.SelfSignedBootstrapCA), b.(*v1beta1.SelfSignedBootstrapCA), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*v1beta1.SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
 	}); err != nil {
@@ -363,12 +593,22 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.X509Extension)(nil), (*certmanager.X509Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_X509Extension_To_certmanager_X509Extension(a.(*v1beta1.X509Extension), b.(*certmanager.X509Extension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.X509Extension)(nil), (*v1beta1.X509Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_X509Extension_To_v1beta1_X509Extension(a.(*certmanager.X509Extension), b.(*v1beta1.X509Extension), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.X509Subject)(nil), (*certmanager.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_X509Subject_To_certmanager_X509Subject(a.(*v1beta1.X509Subject), b.(*certmanager.X509Subject), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*certmanager.X509Subject)(nil), (*v1beta1.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+	if err := s.AddGeneratedConversionFunc((*certmanager.X509Subject)(nil), (*v1beta1.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_certmanager_X509Subject_To_v1beta1_X509Subject(a.(*certmanager.X509Subject), b.(*v1beta1.X509Subject), scope)
 	}); err != nil {
 		return err
@@ -380,6 +620,24 @@ func autoConvert_v1beta1_CAIssuer_To_certmanager_CAIssuer(in *v1beta1.CAIssuer,
 	out.SecretName = in.SecretName
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(certmanager.CAIssuerKMS)
+		if err := Convert_v1beta1_CAIssuerKMS_To_certmanager_CAIssuerKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KMS = nil
+	}
+	if in.PKCS11 != nil {
+		in, out := &in.PKCS11, &out.PKCS11
+		*out = new(certmanager.CAIssuerPKCS11)
+		if err := Convert_v1beta1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PKCS11 = nil
+	}
 	return nil
 }
 
@@ -392,6 +650,24 @@ func autoConvert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in *certmanager.CAIssu
 	out.SecretName = in.SecretName
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(v1beta1.CAIssuerKMS)
+		if err := Convert_certmanager_CAIssuerKMS_To_v1beta1_CAIssuerKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KMS = nil
+	}
+	if in.PKCS11 != nil {
+		in, out := &in.PKCS11, &out.PKCS11
+		*out = new(v1beta1.CAIssuerPKCS11)
+		if err := Convert_certmanager_CAIssuerPKCS11_To_v1beta1_CAIssuerPKCS11(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PKCS11 = nil
+	}
 	return nil
 }
 
@@ -400,6 +676,280 @@ func Convert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in *certmanager.CAIssuer,
 	return autoConvert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in, out, s)
 }
 
+func autoConvert_v1beta1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in *v1beta1.CAIssuerAWSKMS, out *certmanager.CAIssuerAWSKMS, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.Region = in.Region
+	out.AccessKeyID = in.AccessKeyID
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(meta.SecretKeySelector)
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretAccessKey = nil
+	}
+	out.Role = in.Role
+	return nil
+}
+
+// Convert_v1beta1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS is an autogenerated conversion function.
+func Convert_v1beta1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in *v1beta1.CAIssuerAWSKMS, out *certmanager.CAIssuerAWSKMS, s conversion.Scope) error {
+	return autoConvert_v1beta1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerAWSKMS_To_v1beta1_CAIssuerAWSKMS(in *certmanager.CAIssuerAWSKMS, out *v1beta1.CAIssuerAWSKMS, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.Region = in.Region
+	out.AccessKeyID = in.AccessKeyID
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(metav1.SecretKeySelector)
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretAccessKey = nil
+	}
+	out.Role = in.Role
+	return nil
+}
+
+// Convert_certmanager_CAIssuerAWSKMS_To_v1beta1_CAIssuerAWSKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerAWSKMS_To_v1beta1_CAIssuerAWSKMS(in *certmanager.CAIssuerAWSKMS, out *v1beta1.CAIssuerAWSKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerAWSKMS_To_v1beta1_CAIssuerAWSKMS(in, out, s)
+}
+
+func autoConvert_v1beta1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in *v1beta1.CAIssuerGoogleCloudKMS, out *certmanager.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	out.KeyVersion = in.KeyVersion
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(meta.SecretKeySelector)
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccount = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS is an autogenerated conversion function.
+func Convert_v1beta1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in *v1beta1.CAIssuerGoogleCloudKMS, out *certmanager.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	return autoConvert_v1beta1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerGoogleCloudKMS_To_v1beta1_CAIssuerGoogleCloudKMS(in *certmanager.CAIssuerGoogleCloudKMS, out *v1beta1.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	out.KeyVersion = in.KeyVersion
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(metav1.SecretKeySelector)
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccount = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1beta1_CAIssuerGoogleCloudKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1beta1_CAIssuerGoogleCloudKMS(in *certmanager.CAIssuerGoogleCloudKMS, out *v1beta1.CAIssuerGoogleCloudKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerGoogleCloudKMS_To_v1beta1_CAIssuerGoogleCloudKMS(in, out, s)
+}
+
+func autoConvert_v1beta1_CAIssuerKMS_To_certmanager_CAIssuerKMS(in *v1beta1.CAIssuerKMS, out *certmanager.CAIssuerKMS, s conversion.Scope) error {
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(certmanager.CAIssuerAWSKMS)
+		if err := Convert_v1beta1_CAIssuerAWSKMS_To_certmanager_CAIssuerAWSKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWS = nil
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(certmanager.CAIssuerGoogleCloudKMS)
+		if err := Convert_v1beta1_CAIssuerGoogleCloudKMS_To_certmanager_CAIssuerGoogleCloudKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCloud = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_CAIssuerKMS_To_certmanager_CAIssuerKMS is an autogenerated conversion function.
+func Convert_v1beta1_CAIssuerKMS_To_certmanager_CAIssuerKMS(in *v1beta1.CAIssuerKMS, out *certmanager.CAIssuerKMS, s conversion.Scope) error {
+	return autoConvert_v1beta1_CAIssuerKMS_To_certmanager_CAIssuerKMS(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerKMS_To_v1beta1_CAIssuerKMS(in *certmanager.CAIssuerKMS, out *v1beta1.CAIssuerKMS, s conversion.Scope) error {
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(v1beta1.CAIssuerAWSKMS)
+		if err := Convert_certmanager_CAIssuerAWSKMS_To_v1beta1_CAIssuerAWSKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWS = nil
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(v1beta1.CAIssuerGoogleCloudKMS)
+		if err := Convert_certmanager_CAIssuerGoogleCloudKMS_To_v1beta1_CAIssuerGoogleCloudKMS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCloud = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerKMS_To_v1beta1_CAIssuerKMS is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerKMS_To_v1beta1_CAIssuerKMS(in *certmanager.CAIssuerKMS, out *v1beta1.CAIssuerKMS, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerKMS_To_v1beta1_CAIssuerKMS(in, out, s)
+}
+
+func autoConvert_v1beta1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in *v1beta1.CAIssuerPKCS11, out *certmanager.CAIssuerPKCS11, s conversion.Scope) error {
+	out.ModulePath = in.ModulePath
+	out.Slot = in.Slot
+	out.KeyLabel = in.KeyLabel
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PIN, &out.PIN, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11 is an autogenerated conversion function.
+func Convert_v1beta1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in *v1beta1.CAIssuerPKCS11, out *certmanager.CAIssuerPKCS11, s conversion.Scope) error {
+	return autoConvert_v1beta1_CAIssuerPKCS11_To_certmanager_CAIssuerPKCS11(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerPKCS11_To_v1beta1_CAIssuerPKCS11(in *certmanager.CAIssuerPKCS11, out *v1beta1.CAIssuerPKCS11, s conversion.Scope) error {
+	out.ModulePath = in.ModulePath
+	out.Slot = in.Slot
+	out.KeyLabel = in.KeyLabel
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PIN, &out.PIN, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuerPKCS11_To_v1beta1_CAIssuerPKCS11 is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerPKCS11_To_v1beta1_CAIssuerPKCS11(in *certmanager.CAIssuerPKCS11, out *v1beta1.CAIssuerPKCS11, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerPKCS11_To_v1beta1_CAIssuerPKCS11(in, out, s)
+}
+
+func autoConvert_v1beta1_CMPIssuer_To_certmanager_CMPIssuer(in *v1beta1.CMPIssuer, out *certmanager.CMPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(certmanager.CMPMACProtection)
+		if err := Convert_v1beta1_CMPMACProtection_To_certmanager_CMPMACProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MAC = nil
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(certmanager.CMPSignatureProtection)
+		if err := Convert_v1beta1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Signature = nil
+	}
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1beta1_CMPIssuer_To_certmanager_CMPIssuer is an autogenerated conversion function.
+func Convert_v1beta1_CMPIssuer_To_certmanager_CMPIssuer(in *v1beta1.CMPIssuer, out *certmanager.CMPIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_CMPIssuer_To_certmanager_CMPIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_CMPIssuer_To_v1beta1_CMPIssuer(in *certmanager.CMPIssuer, out *v1beta1.CMPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(v1beta1.CMPMACProtection)
+		if err := Convert_certmanager_CMPMACProtection_To_v1beta1_CMPMACProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MAC = nil
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(v1beta1.CMPSignatureProtection)
+		if err := Convert_certmanager_CMPSignatureProtection_To_v1beta1_CMPSignatureProtection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Signature = nil
+	}
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_certmanager_CMPIssuer_To_v1beta1_CMPIssuer is an autogenerated conversion function.
+func Convert_certmanager_CMPIssuer_To_v1beta1_CMPIssuer(in *certmanager.CMPIssuer, out *v1beta1.CMPIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPIssuer_To_v1beta1_CMPIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_CMPMACProtection_To_certmanager_CMPMACProtection(in *v1beta1.CMPMACProtection, out *certmanager.CMPMACProtection, s conversion.Scope) error {
+	out.Reference = in.Reference
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_CMPMACProtection_To_certmanager_CMPMACProtection is an autogenerated conversion function.
+func Convert_v1beta1_CMPMACProtection_To_certmanager_CMPMACProtection(in *v1beta1.CMPMACProtection, out *certmanager.CMPMACProtection, s conversion.Scope) error {
+	return autoConvert_v1beta1_CMPMACProtection_To_certmanager_CMPMACProtection(in, out, s)
+}
+
+func autoConvert_certmanager_CMPMACProtection_To_v1beta1_CMPMACProtection(in *certmanager.CMPMACProtection, out *v1beta1.CMPMACProtection, s conversion.Scope) error {
+	out.Reference = in.Reference
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CMPMACProtection_To_v1beta1_CMPMACProtection is an autogenerated conversion function.
+func Convert_certmanager_CMPMACProtection_To_v1beta1_CMPMACProtection(in *certmanager.CMPMACProtection, out *v1beta1.CMPMACProtection, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPMACProtection_To_v1beta1_CMPMACProtection(in, out, s)
+}
+
+func autoConvert_v1beta1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in *v1beta1.CMPSignatureProtection, out *certmanager.CMPSignatureProtection, s conversion.Scope) error {
+	if err := v1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection is an autogenerated conversion function.
+func Convert_v1beta1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in *v1beta1.CMPSignatureProtection, out *certmanager.CMPSignatureProtection, s conversion.Scope) error {
+	return autoConvert_v1beta1_CMPSignatureProtection_To_certmanager_CMPSignatureProtection(in, out, s)
+}
+
+func autoConvert_certmanager_CMPSignatureProtection_To_v1beta1_CMPSignatureProtection(in *certmanager.CMPSignatureProtection, out *v1beta1.CMPSignatureProtection, s conversion.Scope) error {
+	if err := v1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CMPSignatureProtection_To_v1beta1_CMPSignatureProtection is an autogenerated conversion function.
+func Convert_certmanager_CMPSignatureProtection_To_v1beta1_CMPSignatureProtection(in *certmanager.CMPSignatureProtection, out *v1beta1.CMPSignatureProtection, s conversion.Scope) error {
+	return autoConvert_certmanager_CMPSignatureProtection_To_v1beta1_CMPSignatureProtection(in, out, s)
+}
+
 func autoConvert_v1beta1_Certificate_To_certmanager_Certificate(in *v1beta1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -411,55 +961,249 @@ func autoConvert_v1beta1_Certificate_To_certmanager_Certificate(in *v1beta1.Cert
 	return nil
 }
 
-// Convert_v1beta1_Certificate_To_certmanager_Certificate is an autogenerated conversion function.
-func Convert_v1beta1_Certificate_To_certmanager_Certificate(in *v1beta1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
-	return autoConvert_v1beta1_Certificate_To_certmanager_Certificate(in, out, s)
+// Convert_v1beta1_Certificate_To_certmanager_Certificate is an autogenerated conversion function.
+func Convert_v1beta1_Certificate_To_certmanager_Certificate(in *v1beta1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
+	return autoConvert_v1beta1_Certificate_To_certmanager_Certificate(in, out, s)
+}
+
+func autoConvert_certmanager_Certificate_To_v1beta1_Certificate(in *certmanager.Certificate, out *v1beta1.Certificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_CertificateStatus_To_v1beta1_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_Certificate_To_v1beta1_Certificate is an autogenerated conversion function.
+func Convert_certmanager_Certificate_To_v1beta1_Certificate(in *certmanager.Certificate, out *v1beta1.Certificate, s conversion.Scope) error {
+	return autoConvert_certmanager_Certificate_To_v1beta1_Certificate(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in *v1beta1.CertificateACMESolverStatus, out *certmanager.CertificateACMESolverStatus, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.Type = in.Type
+	out.SolverIndex = (*int)(unsafe.Pointer(in.SolverIndex))
+	out.MatchedSelectors = *(*[]string)(unsafe.Pointer(&in.MatchedSelectors))
+	out.Zone = in.Zone
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus is an autogenerated conversion function.
+func Convert_v1beta1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in *v1beta1.CertificateACMESolverStatus, out *certmanager.CertificateACMESolverStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateACMESolverStatus_To_certmanager_CertificateACMESolverStatus(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateACMESolverStatus_To_v1beta1_CertificateACMESolverStatus(in *certmanager.CertificateACMESolverStatus, out *v1beta1.CertificateACMESolverStatus, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.Type = in.Type
+	out.SolverIndex = (*int)(unsafe.Pointer(in.SolverIndex))
+	out.MatchedSelectors = *(*[]string)(unsafe.Pointer(&in.MatchedSelectors))
+	out.Zone = in.Zone
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateACMESolverStatus_To_v1beta1_CertificateACMESolverStatus is an autogenerated conversion function.
+func Convert_certmanager_CertificateACMESolverStatus_To_v1beta1_CertificateACMESolverStatus(in *certmanager.CertificateACMESolverStatus, out *v1beta1.CertificateACMESolverStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateACMESolverStatus_To_v1beta1_CertificateACMESolverStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1beta1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1beta1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1beta1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = v1beta1.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1beta1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateCAChain_To_certmanager_CertificateCAChain(in *v1beta1.CertificateCAChain, out *certmanager.CertificateCAChain, s conversion.Scope) error {
+	out.Composition = certmanager.CertificateCAChainComposition(in.Composition)
+	out.CompleteChain = in.CompleteChain
+	return nil
+}
+
+// Convert_v1beta1_CertificateCAChain_To_certmanager_CertificateCAChain is an autogenerated conversion function.
+func Convert_v1beta1_CertificateCAChain_To_certmanager_CertificateCAChain(in *v1beta1.CertificateCAChain, out *certmanager.CertificateCAChain, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateCAChain_To_certmanager_CertificateCAChain(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateCAChain_To_v1beta1_CertificateCAChain(in *certmanager.CertificateCAChain, out *v1beta1.CertificateCAChain, s conversion.Scope) error {
+	out.Composition = v1beta1.CertificateCAChainComposition(in.Composition)
+	out.CompleteChain = in.CompleteChain
+	return nil
+}
+
+// Convert_certmanager_CertificateCAChain_To_v1beta1_CertificateCAChain is an autogenerated conversion function.
+func Convert_certmanager_CertificateCAChain_To_v1beta1_CertificateCAChain(in *certmanager.CertificateCAChain, out *v1beta1.CertificateCAChain, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateCAChain_To_v1beta1_CertificateCAChain(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(in *v1beta1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
+	out.Type = certmanager.CertificateConditionType(in.Type)
+	out.Status = meta.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition is an autogenerated conversion function.
+func Convert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(in *v1beta1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in *certmanager.CertificateCondition, out *v1beta1.CertificateCondition, s conversion.Scope) error {
+	out.Type = v1beta1.CertificateConditionType(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition is an autogenerated conversion function.
+func Convert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in *certmanager.CertificateCondition, out *v1beta1.CertificateCondition, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in *v1beta1.CertificateExternalPrivateKey, out *certmanager.CertificateExternalPrivateKey, s conversion.Scope) error {
+	out.Provider = in.Provider
+	return nil
+}
+
+// Convert_v1beta1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey is an autogenerated conversion function.
+func Convert_v1beta1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in *v1beta1.CertificateExternalPrivateKey, out *certmanager.CertificateExternalPrivateKey, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateExternalPrivateKey_To_certmanager_CertificateExternalPrivateKey(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateExternalPrivateKey_To_v1beta1_CertificateExternalPrivateKey(in *certmanager.CertificateExternalPrivateKey, out *v1beta1.CertificateExternalPrivateKey, s conversion.Scope) error {
+	out.Provider = in.Provider
+	return nil
+}
+
+// Convert_certmanager_CertificateExternalPrivateKey_To_v1beta1_CertificateExternalPrivateKey is an autogenerated conversion function.
+func Convert_certmanager_CertificateExternalPrivateKey_To_v1beta1_CertificateExternalPrivateKey(in *certmanager.CertificateExternalPrivateKey, out *v1beta1.CertificateExternalPrivateKey, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateExternalPrivateKey_To_v1beta1_CertificateExternalPrivateKey(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in *v1beta1.CertificateIssuanceAttempt, out *certmanager.CertificateIssuanceAttempt, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Outcome = certmanager.CertificateIssuanceOutcome(in.Outcome)
+	out.Message = in.Message
+	out.RequestName = in.RequestName
+	return nil
+}
+
+// Convert_v1beta1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt is an autogenerated conversion function.
+func Convert_v1beta1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in *v1beta1.CertificateIssuanceAttempt, out *certmanager.CertificateIssuanceAttempt, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateIssuanceAttempt_To_certmanager_CertificateIssuanceAttempt(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateIssuanceAttempt_To_v1beta1_CertificateIssuanceAttempt(in *certmanager.CertificateIssuanceAttempt, out *v1beta1.CertificateIssuanceAttempt, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Outcome = v1beta1.CertificateIssuanceOutcome(in.Outcome)
+	out.Message = in.Message
+	out.RequestName = in.RequestName
+	return nil
+}
+
+// Convert_certmanager_CertificateIssuanceAttempt_To_v1beta1_CertificateIssuanceAttempt is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuanceAttempt_To_v1beta1_CertificateIssuanceAttempt(in *certmanager.CertificateIssuanceAttempt, out *v1beta1.CertificateIssuanceAttempt, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuanceAttempt_To_v1beta1_CertificateIssuanceAttempt(in, out, s)
 }
 
-func autoConvert_certmanager_Certificate_To_v1beta1_Certificate(in *certmanager.Certificate, out *v1beta1.Certificate, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+func autoConvert_v1beta1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in *v1beta1.CertificateIssuanceDetails, out *certmanager.CertificateIssuanceDetails, s conversion.Scope) error {
+	if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
-	if err := Convert_certmanager_CertificateStatus_To_v1beta1_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.SerialNumber = in.SerialNumber
+	out.Fingerprint = in.Fingerprint
+	return nil
+}
+
+// Convert_v1beta1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails is an autogenerated conversion function.
+func Convert_v1beta1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in *v1beta1.CertificateIssuanceDetails, out *certmanager.CertificateIssuanceDetails, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateIssuanceDetails_To_certmanager_CertificateIssuanceDetails(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateIssuanceDetails_To_v1beta1_CertificateIssuanceDetails(in *certmanager.CertificateIssuanceDetails, out *v1beta1.CertificateIssuanceDetails, s conversion.Scope) error {
+	if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.SerialNumber = in.SerialNumber
+	out.Fingerprint = in.Fingerprint
 	return nil
 }
 
-// Convert_certmanager_Certificate_To_v1beta1_Certificate is an autogenerated conversion function.
-func Convert_certmanager_Certificate_To_v1beta1_Certificate(in *certmanager.Certificate, out *v1beta1.Certificate, s conversion.Scope) error {
-	return autoConvert_certmanager_Certificate_To_v1beta1_Certificate(in, out, s)
+// Convert_certmanager_CertificateIssuanceDetails_To_v1beta1_CertificateIssuanceDetails is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuanceDetails_To_v1beta1_CertificateIssuanceDetails(in *certmanager.CertificateIssuanceDetails, out *v1beta1.CertificateIssuanceDetails, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuanceDetails_To_v1beta1_CertificateIssuanceDetails(in, out, s)
 }
 
-func autoConvert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(in *v1beta1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
-	out.Type = certmanager.CertificateConditionType(in.Type)
-	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_v1beta1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in *v1beta1.CertificateIssuancePlan, out *certmanager.CertificateIssuancePlan, s conversion.Scope) error {
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.IsCA = in.IsCA
+	out.Extensions = *(*[]certmanager.X509Extension)(unsafe.Pointer(&in.Extensions))
+	if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition is an autogenerated conversion function.
-func Convert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(in *v1beta1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
-	return autoConvert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(in, out, s)
+// Convert_v1beta1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan is an autogenerated conversion function.
+func Convert_v1beta1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in *v1beta1.CertificateIssuancePlan, out *certmanager.CertificateIssuancePlan, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in *certmanager.CertificateCondition, out *v1beta1.CertificateCondition, s conversion.Scope) error {
-	out.Type = v1beta1.CertificateConditionType(in.Type)
-	out.Status = metav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_certmanager_CertificateIssuancePlan_To_v1beta1_CertificateIssuancePlan(in *certmanager.CertificateIssuancePlan, out *v1beta1.CertificateIssuancePlan, s conversion.Scope) error {
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]v1beta1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.IsCA = in.IsCA
+	out.Extensions = *(*[]v1beta1.X509Extension)(unsafe.Pointer(&in.Extensions))
+	if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition is an autogenerated conversion function.
-func Convert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in *certmanager.CertificateCondition, out *v1beta1.CertificateCondition, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in, out, s)
+// Convert_certmanager_CertificateIssuancePlan_To_v1beta1_CertificateIssuancePlan is an autogenerated conversion function.
+func Convert_certmanager_CertificateIssuancePlan_To_v1beta1_CertificateIssuancePlan(in *certmanager.CertificateIssuancePlan, out *v1beta1.CertificateIssuancePlan, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateIssuancePlan_To_v1beta1_CertificateIssuancePlan(in, out, s)
 }
 
 func autoConvert_v1beta1_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1beta1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
@@ -560,9 +1304,20 @@ func Convert_certmanager_CertificateList_To_v1beta1_CertificateList(in *certmana
 
 func autoConvert_v1beta1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1beta1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.External = (*certmanager.CertificateExternalPrivateKey)(unsafe.Pointer(in.External))
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretRef = nil
+	}
 	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.SignatureAlgorithm = certmanager.SignatureAlgorithm(in.SignatureAlgorithm)
 	return nil
 }
 
@@ -573,9 +1328,20 @@ func Convert_v1beta1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(
 
 func autoConvert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1beta1.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = v1beta1.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.External = (*v1beta1.CertificateExternalPrivateKey)(unsafe.Pointer(in.External))
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretRef = nil
+	}
 	out.Encoding = v1beta1.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = v1beta1.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.SignatureAlgorithm = v1beta1.SignatureAlgorithm(in.SignatureAlgorithm)
 	return nil
 }
 
@@ -584,6 +1350,74 @@ func Convert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1beta1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Windows = *(*[]certmanager.CertificateRenewalWindowRange)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1beta1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1beta1.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Windows = *(*[]v1beta1.CertificateRenewalWindowRange)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1beta1.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in *v1beta1.CertificateRenewalWindowRange, out *certmanager.CertificateRenewalWindowRange, s conversion.Scope) error {
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1beta1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange is an autogenerated conversion function.
+func Convert_v1beta1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in *v1beta1.CertificateRenewalWindowRange, out *certmanager.CertificateRenewalWindowRange, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateRenewalWindowRange_To_certmanager_CertificateRenewalWindowRange(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindowRange_To_v1beta1_CertificateRenewalWindowRange(in *certmanager.CertificateRenewalWindowRange, out *v1beta1.CertificateRenewalWindowRange, s conversion.Scope) error {
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindowRange_To_v1beta1_CertificateRenewalWindowRange is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindowRange_To_v1beta1_CertificateRenewalWindowRange(in *certmanager.CertificateRenewalWindowRange, out *v1beta1.CertificateRenewalWindowRange, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindowRange_To_v1beta1_CertificateRenewalWindowRange(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in *v1beta1.CertificateDualCertificate, out *certmanager.CertificateDualCertificate, s conversion.Scope) error {
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1beta1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate is an autogenerated conversion function.
+func Convert_v1beta1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in *v1beta1.CertificateDualCertificate, out *certmanager.CertificateDualCertificate, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateDualCertificate_To_certmanager_CertificateDualCertificate(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateDualCertificate_To_v1beta1_CertificateDualCertificate(in *certmanager.CertificateDualCertificate, out *v1beta1.CertificateDualCertificate, s conversion.Scope) error {
+	out.Algorithm = v1beta1.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_certmanager_CertificateDualCertificate_To_v1beta1_CertificateDualCertificate is an autogenerated conversion function.
+func Convert_certmanager_CertificateDualCertificate_To_v1beta1_CertificateDualCertificate(in *certmanager.CertificateDualCertificate, out *v1beta1.CertificateDualCertificate, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateDualCertificate_To_v1beta1_CertificateDualCertificate(in, out, s)
+}
+
 func autoConvert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(in *v1beta1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -619,7 +1453,7 @@ func Convert_certmanager_CertificateRequest_To_v1beta1_CertificateRequest(in *ce
 func autoConvert_v1beta1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1beta1.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateRequestConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	return nil
@@ -633,7 +1467,7 @@ func Convert_v1beta1_CertificateRequestCondition_To_certmanager_CertificateReque
 func autoConvert_certmanager_CertificateRequestCondition_To_v1beta1_CertificateRequestCondition(in *certmanager.CertificateRequestCondition, out *v1beta1.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = v1beta1.CertificateRequestConditionType(in.Type)
 	out.Status = metav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	return nil
@@ -687,13 +1521,15 @@ func Convert_certmanager_CertificateRequestList_To_v1beta1_CertificateRequestLis
 }
 
 func autoConvert_v1beta1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1beta1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
-	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
-	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Backdate = (*apismetav1.Duration)(unsafe.Pointer(in.Backdate))
+	if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Extensions = *(*[]certmanager.X509Extension)(unsafe.Pointer(&in.Extensions))
 	out.Username = in.Username
 	out.UID = in.UID
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
@@ -707,13 +1543,15 @@ func Convert_v1beta1_CertificateRequestSpec_To_certmanager_CertificateRequestSpe
 }
 
 func autoConvert_certmanager_CertificateRequestSpec_To_v1beta1_CertificateRequestSpec(in *certmanager.CertificateRequestSpec, out *v1beta1.CertificateRequestSpec, s conversion.Scope) error {
-	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
-	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Backdate = (*apismetav1.Duration)(unsafe.Pointer(in.Backdate))
+	if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1beta1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Extensions = *(*[]v1beta1.X509Extension)(unsafe.Pointer(&in.Extensions))
 	out.Username = in.Username
 	out.UID = in.UID
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
@@ -730,7 +1568,7 @@ func autoConvert_v1beta1_CertificateRequestStatus_To_certmanager_CertificateRequ
 	out.Conditions = *(*[]certmanager.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
-	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*apismetav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -743,7 +1581,7 @@ func autoConvert_certmanager_CertificateRequestStatus_To_v1beta1_CertificateRequ
 	out.Conditions = *(*[]v1beta1.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
-	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*apismetav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -755,6 +1593,7 @@ func Convert_certmanager_CertificateRequestStatus_To_v1beta1_CertificateRequestS
 func autoConvert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1beta1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Keys = *(*map[string]string)(unsafe.Pointer(&in.Keys))
 	return nil
 }
 
@@ -766,6 +1605,7 @@ func Convert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretT
 func autoConvert_certmanager_CertificateSecretTemplate_To_v1beta1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1beta1.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Keys = *(*map[string]string)(unsafe.Pointer(&in.Keys))
 	return nil
 }
 
@@ -775,23 +1615,20 @@ func Convert_certmanager_CertificateSecretTemplate_To_v1beta1_CertificateSecretT
 }
 
 func autoConvert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(in *v1beta1.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
-	if in.Subject != nil {
-		in, out := &in.Subject, &out.Subject
-		*out = new(certmanager.X509Subject)
-		if err := Convert_v1beta1_X509Subject_To_certmanager_X509Subject(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.Subject = nil
-	}
+	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
 	out.CommonName = in.CommonName
-	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
-	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*apismetav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewalWindow = (*certmanager.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
+	out.IssuanceDeadline = (*apismetav1.Duration)(unsafe.Pointer(in.IssuanceDeadline))
+	out.RenewRequestTime = (*apismetav1.Time)(unsafe.Pointer(in.RenewRequestTime))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URISANs = *(*[]string)(unsafe.Pointer(&in.URISANs))
 	out.EmailSANs = *(*[]string)(unsafe.Pointer(&in.EmailSANs))
 	out.SecretName = in.SecretName
+	out.SecretNamespace = in.SecretNamespace
+	out.AdditionalSecretNames = *(*[]string)(unsafe.Pointer(&in.AdditionalSecretNames))
 	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
@@ -802,12 +1639,37 @@ func autoConvert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(in *v1be
 	} else {
 		out.Keystores = nil
 	}
-	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CAChain = (*certmanager.CertificateCAChain)(unsafe.Pointer(in.CAChain))
+	out.TrustStore = (*certmanager.CertificateTrustStore)(unsafe.Pointer(in.TrustStore))
+	out.DualCertificate = (*certmanager.CertificateDualCertificate)(unsafe.Pointer(in.DualCertificate))
+	if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]meta.ObjectReference, len(*in))
+		for i := range *in {
+			if err := v1.Convert_v1_ObjectReference_To_meta_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.IssuerFailoverThreshold = (*int)(unsafe.Pointer(in.IssuerFailoverThreshold))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.Profile = certmanager.CertificateProfile(in.Profile)
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(certmanager.CertificatePrivateKey)
+		if err := Convert_v1beta1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKey = nil
+	}
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -819,23 +1681,20 @@ func Convert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(in *v1beta1.
 }
 
 func autoConvert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(in *certmanager.CertificateSpec, out *v1beta1.CertificateSpec, s conversion.Scope) error {
-	if in.Subject != nil {
-		in, out := &in.Subject, &out.Subject
-		*out = new(v1beta1.X509Subject)
-		if err := Convert_certmanager_X509Subject_To_v1beta1_X509Subject(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.Subject = nil
-	}
+	out.Subject = (*v1beta1.X509Subject)(unsafe.Pointer(in.Subject))
 	out.CommonName = in.CommonName
-	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
-	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*apismetav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewalWindow = (*v1beta1.CertificateRenewalWindow)(unsafe.Pointer(in.RenewalWindow))
+	out.IssuanceDeadline = (*apismetav1.Duration)(unsafe.Pointer(in.IssuanceDeadline))
+	out.RenewRequestTime = (*apismetav1.Time)(unsafe.Pointer(in.RenewRequestTime))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URISANs = *(*[]string)(unsafe.Pointer(&in.URISANs))
 	out.EmailSANs = *(*[]string)(unsafe.Pointer(&in.EmailSANs))
 	out.SecretName = in.SecretName
+	out.SecretNamespace = in.SecretNamespace
+	out.AdditionalSecretNames = *(*[]string)(unsafe.Pointer(&in.AdditionalSecretNames))
 	out.SecretTemplate = (*v1beta1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
@@ -846,12 +1705,37 @@ func autoConvert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(in *cert
 	} else {
 		out.Keystores = nil
 	}
-	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	out.AdditionalOutputFormats = *(*[]v1beta1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CAChain = (*v1beta1.CertificateCAChain)(unsafe.Pointer(in.CAChain))
+	out.TrustStore = (*v1beta1.CertificateTrustStore)(unsafe.Pointer(in.TrustStore))
+	out.DualCertificate = (*v1beta1.CertificateDualCertificate)(unsafe.Pointer(in.DualCertificate))
+	if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]metav1.ObjectReference, len(*in))
+		for i := range *in {
+			if err := v1.Convert_meta_ObjectReference_To_v1_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.IssuerFailoverThreshold = (*int)(unsafe.Pointer(in.IssuerFailoverThreshold))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1beta1.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.PrivateKey = (*v1beta1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.Profile = v1beta1.CertificateProfile(in.Profile)
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(v1beta1.CertificatePrivateKey)
+		if err := Convert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKey = nil
+	}
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -864,12 +1748,27 @@ func Convert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(in *certmana
 
 func autoConvert_v1beta1_CertificateStatus_To_certmanager_CertificateStatus(in *v1beta1.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
-	out.LastFailureTime = (*v1.Time)(unsafe.Pointer(in.LastFailureTime))
-	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
-	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
-	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.LastFailureTime = (*apismetav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastRenewRequestTime = (*apismetav1.Time)(unsafe.Pointer(in.LastRenewRequestTime))
+	out.NotBefore = (*apismetav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*apismetav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*apismetav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.ActiveRequestName = in.ActiveRequestName
+	out.LastIssuanceDetails = (*certmanager.CertificateIssuanceDetails)(unsafe.Pointer(in.LastIssuanceDetails))
+	out.IssuanceHistory = *(*[]certmanager.CertificateIssuanceAttempt)(unsafe.Pointer(&in.IssuanceHistory))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	if in.IssuancePlan != nil {
+		in, out := &in.IssuancePlan, &out.IssuancePlan
+		*out = new(certmanager.CertificateIssuancePlan)
+		if err := Convert_v1beta1_CertificateIssuancePlan_To_certmanager_CertificateIssuancePlan(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IssuancePlan = nil
+	}
+	out.ACMESolvers = *(*[]certmanager.CertificateACMESolverStatus)(unsafe.Pointer(&in.ACMESolvers))
 	return nil
 }
 
@@ -880,12 +1779,27 @@ func Convert_v1beta1_CertificateStatus_To_certmanager_CertificateStatus(in *v1be
 
 func autoConvert_certmanager_CertificateStatus_To_v1beta1_CertificateStatus(in *certmanager.CertificateStatus, out *v1beta1.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1beta1.CertificateCondition)(unsafe.Pointer(&in.Conditions))
-	out.LastFailureTime = (*v1.Time)(unsafe.Pointer(in.LastFailureTime))
-	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
-	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
-	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.LastFailureTime = (*apismetav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastRenewRequestTime = (*apismetav1.Time)(unsafe.Pointer(in.LastRenewRequestTime))
+	out.NotBefore = (*apismetav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*apismetav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*apismetav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.ActiveRequestName = in.ActiveRequestName
+	out.LastIssuanceDetails = (*v1beta1.CertificateIssuanceDetails)(unsafe.Pointer(in.LastIssuanceDetails))
+	out.IssuanceHistory = *(*[]v1beta1.CertificateIssuanceAttempt)(unsafe.Pointer(&in.IssuanceHistory))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	if in.IssuancePlan != nil {
+		in, out := &in.IssuancePlan, &out.IssuancePlan
+		*out = new(v1beta1.CertificateIssuancePlan)
+		if err := Convert_certmanager_CertificateIssuancePlan_To_v1beta1_CertificateIssuancePlan(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IssuancePlan = nil
+	}
+	out.ACMESolvers = *(*[]v1beta1.CertificateACMESolverStatus)(unsafe.Pointer(&in.ACMESolvers))
 	return nil
 }
 
@@ -894,6 +1808,26 @@ func Convert_certmanager_CertificateStatus_To_v1beta1_CertificateStatus(in *cert
 	return autoConvert_certmanager_CertificateStatus_To_v1beta1_CertificateStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateTrustStore_To_certmanager_CertificateTrustStore(in *v1beta1.CertificateTrustStore, out *certmanager.CertificateTrustStore, s conversion.Scope) error {
+	out.Create = in.Create
+	return nil
+}
+
+// Convert_v1beta1_CertificateTrustStore_To_certmanager_CertificateTrustStore is an autogenerated conversion function.
+func Convert_v1beta1_CertificateTrustStore_To_certmanager_CertificateTrustStore(in *v1beta1.CertificateTrustStore, out *certmanager.CertificateTrustStore, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateTrustStore_To_certmanager_CertificateTrustStore(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateTrustStore_To_v1beta1_CertificateTrustStore(in *certmanager.CertificateTrustStore, out *v1beta1.CertificateTrustStore, s conversion.Scope) error {
+	out.Create = in.Create
+	return nil
+}
+
+// Convert_certmanager_CertificateTrustStore_To_v1beta1_CertificateTrustStore is an autogenerated conversion function.
+func Convert_certmanager_CertificateTrustStore_To_v1beta1_CertificateTrustStore(in *certmanager.CertificateTrustStore, out *v1beta1.CertificateTrustStore, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateTrustStore_To_v1beta1_CertificateTrustStore(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterIssuer_To_certmanager_ClusterIssuer(in *v1beta1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1003,7 +1937,7 @@ func Convert_certmanager_Issuer_To_v1beta1_Issuer(in *certmanager.Issuer, out *v
 func autoConvert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in *v1beta1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanager.IssuerConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
@@ -1018,7 +1952,7 @@ func Convert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in *v1beta1.
 func autoConvert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in *certmanager.IssuerCondition, out *v1beta1.IssuerCondition, s conversion.Scope) error {
 	out.Type = v1beta1.IssuerConditionType(in.Type)
 	out.Status = metav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.LastTransitionTime = (*apismetav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
@@ -1030,6 +1964,30 @@ func Convert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in *certmana
 	return autoConvert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in, out, s)
 }
 
+func autoConvert_v1beta1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *v1beta1.IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	return nil
+}
+
+// Convert_v1beta1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_v1beta1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *v1beta1.IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerCertificateDefaults_To_v1beta1_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *v1beta1.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Usages = *(*[]v1beta1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	return nil
+}
+
+// Convert_certmanager_IssuerCertificateDefaults_To_v1beta1_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_certmanager_IssuerCertificateDefaults_To_v1beta1_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *v1beta1.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerCertificateDefaults_To_v1beta1_IssuerCertificateDefaults(in, out, s)
+}
+
 func autoConvert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in *v1beta1.IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
 	if in.ACME != nil {
 		in, out := &in.ACME, &out.ACME
@@ -1040,7 +1998,15 @@ func autoConvert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in *v1beta1.Is
 	} else {
 		out.ACME = nil
 	}
-	out.CA = (*certmanager.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(certmanager.CAIssuer)
+		if err := Convert_v1beta1_CAIssuer_To_certmanager_CAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CA = nil
+	}
 	if in.Vault != nil {
 		in, out := &in.Vault, &out.Vault
 		*out = new(certmanager.VaultIssuer)
@@ -1060,6 +2026,24 @@ func autoConvert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in *v1beta1.Is
 	} else {
 		out.Venafi = nil
 	}
+	if in.SCEP != nil {
+		in, out := &in.SCEP, &out.SCEP
+		*out = new(certmanager.SCEPIssuer)
+		if err := Convert_v1beta1_SCEPIssuer_To_certmanager_SCEPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SCEP = nil
+	}
+	if in.CMP != nil {
+		in, out := &in.CMP, &out.CMP
+		*out = new(certmanager.CMPIssuer)
+		if err := Convert_v1beta1_CMPIssuer_To_certmanager_CMPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CMP = nil
+	}
 	return nil
 }
 
@@ -1078,7 +2062,15 @@ func autoConvert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in *certmanage
 	} else {
 		out.ACME = nil
 	}
-	out.CA = (*v1beta1.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1beta1.CAIssuer)
+		if err := Convert_certmanager_CAIssuer_To_v1beta1_CAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CA = nil
+	}
 	if in.Vault != nil {
 		in, out := &in.Vault, &out.Vault
 		*out = new(v1beta1.VaultIssuer)
@@ -1098,6 +2090,24 @@ func autoConvert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in *certmanage
 	} else {
 		out.Venafi = nil
 	}
+	if in.SCEP != nil {
+		in, out := &in.SCEP, &out.SCEP
+		*out = new(v1beta1.SCEPIssuer)
+		if err := Convert_certmanager_SCEPIssuer_To_v1beta1_SCEPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SCEP = nil
+	}
+	if in.CMP != nil {
+		in, out := &in.CMP, &out.CMP
+		*out = new(v1beta1.CMPIssuer)
+		if err := Convert_certmanager_CMPIssuer_To_v1beta1_CMPIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CMP = nil
+	}
 	return nil
 }
 
@@ -1148,10 +2158,42 @@ func Convert_certmanager_IssuerList_To_v1beta1_IssuerList(in *certmanager.Issuer
 	return autoConvert_certmanager_IssuerList_To_v1beta1_IssuerList(in, out, s)
 }
 
+func autoConvert_v1beta1_IssuerPolicy_To_certmanager_IssuerPolicy(in *v1beta1.IssuerPolicy, out *certmanager.IssuerPolicy, s conversion.Scope) error {
+	out.MinDuration = (*apismetav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*apismetav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.DurationEnforcement = certmanager.DurationEnforcement(in.DurationEnforcement)
+	out.AllowedPrivateKeyAlgorithms = *(*[]certmanager.PrivateKeyAlgorithm)(unsafe.Pointer(&in.AllowedPrivateKeyAlgorithms))
+	out.RequesterIdentity = (*certmanager.RequesterIdentityPolicy)(unsafe.Pointer(in.RequesterIdentity))
+	out.AllowedExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtensions))
+	return nil
+}
+
+// Convert_v1beta1_IssuerPolicy_To_certmanager_IssuerPolicy is an autogenerated conversion function.
+func Convert_v1beta1_IssuerPolicy_To_certmanager_IssuerPolicy(in *v1beta1.IssuerPolicy, out *certmanager.IssuerPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerPolicy_To_certmanager_IssuerPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerPolicy_To_v1beta1_IssuerPolicy(in *certmanager.IssuerPolicy, out *v1beta1.IssuerPolicy, s conversion.Scope) error {
+	out.MinDuration = (*apismetav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*apismetav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.DurationEnforcement = v1beta1.DurationEnforcement(in.DurationEnforcement)
+	out.AllowedPrivateKeyAlgorithms = *(*[]v1beta1.PrivateKeyAlgorithm)(unsafe.Pointer(&in.AllowedPrivateKeyAlgorithms))
+	out.RequesterIdentity = (*v1beta1.RequesterIdentityPolicy)(unsafe.Pointer(in.RequesterIdentity))
+	out.AllowedExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtensions))
+	return nil
+}
+
+// Convert_certmanager_IssuerPolicy_To_v1beta1_IssuerPolicy is an autogenerated conversion function.
+func Convert_certmanager_IssuerPolicy_To_v1beta1_IssuerPolicy(in *certmanager.IssuerPolicy, out *v1beta1.IssuerPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerPolicy_To_v1beta1_IssuerPolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in *v1beta1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Policy = (*certmanager.IssuerPolicy)(unsafe.Pointer(in.Policy))
+	out.CertificateDefaults = (*certmanager.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1164,6 +2206,8 @@ func autoConvert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in *certmanager.Is
 	if err := Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Policy = (*v1beta1.IssuerPolicy)(unsafe.Pointer(in.Policy))
+	out.CertificateDefaults = (*v1beta1.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1212,7 +2256,7 @@ func Convert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(in *certmanager.Is
 
 func autoConvert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore(in *v1beta1.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1225,7 +2269,7 @@ func Convert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore(in *v1beta1.JKSKeyst
 
 func autoConvert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore(in *certmanager.JKSKeystore, out *v1beta1.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1238,7 +2282,7 @@ func Convert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore(in *certmanager.JKSK
 
 func autoConvert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1beta1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1251,7 +2295,7 @@ func Convert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1beta1.PK
 
 func autoConvert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *v1beta1.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1262,8 +2306,103 @@ func Convert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in *certmanage
 	return autoConvert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in, out, s)
 }
 
+func autoConvert_v1beta1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in *v1beta1.RequesterIdentityPolicy, out *certmanager.RequesterIdentityPolicy, s conversion.Scope) error {
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	return nil
+}
+
+// Convert_v1beta1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy is an autogenerated conversion function.
+func Convert_v1beta1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in *v1beta1.RequesterIdentityPolicy, out *certmanager.RequesterIdentityPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_RequesterIdentityPolicy_To_certmanager_RequesterIdentityPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_RequesterIdentityPolicy_To_v1beta1_RequesterIdentityPolicy(in *certmanager.RequesterIdentityPolicy, out *v1beta1.RequesterIdentityPolicy, s conversion.Scope) error {
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	return nil
+}
+
+// Convert_certmanager_RequesterIdentityPolicy_To_v1beta1_RequesterIdentityPolicy is an autogenerated conversion function.
+func Convert_certmanager_RequesterIdentityPolicy_To_v1beta1_RequesterIdentityPolicy(in *certmanager.RequesterIdentityPolicy, out *v1beta1.RequesterIdentityPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_RequesterIdentityPolicy_To_v1beta1_RequesterIdentityPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_SCEPIssuer_To_certmanager_SCEPIssuer(in *v1beta1.SCEPIssuer, out *certmanager.SCEPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.ChallengePasswordSecretRef != nil {
+		in, out := &in.ChallengePasswordSecretRef, &out.ChallengePasswordSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ChallengePasswordSecretRef = nil
+	}
+	out.CAFingerprint = in.CAFingerprint
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1beta1_SCEPIssuer_To_certmanager_SCEPIssuer is an autogenerated conversion function.
+func Convert_v1beta1_SCEPIssuer_To_certmanager_SCEPIssuer(in *v1beta1.SCEPIssuer, out *certmanager.SCEPIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_SCEPIssuer_To_certmanager_SCEPIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_SCEPIssuer_To_v1beta1_SCEPIssuer(in *certmanager.SCEPIssuer, out *v1beta1.SCEPIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	if in.ChallengePasswordSecretRef != nil {
+		in, out := &in.ChallengePasswordSecretRef, &out.ChallengePasswordSecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ChallengePasswordSecretRef = nil
+	}
+	out.CAFingerprint = in.CAFingerprint
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_certmanager_SCEPIssuer_To_v1beta1_SCEPIssuer is an autogenerated conversion function.
+func Convert_certmanager_SCEPIssuer_To_v1beta1_SCEPIssuer(in *certmanager.SCEPIssuer, out *v1beta1.SCEPIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SCEPIssuer_To_v1beta1_SCEPIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in *v1beta1.SelfSignedBootstrapCA, out *certmanager.SelfSignedBootstrapCA, s conversion.Scope) error {
+	out.IssuerName = in.IssuerName
+	out.CommonName = in.CommonName
+	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
+	out.RootDuration = (*apismetav1.Duration)(unsafe.Pointer(in.RootDuration))
+	out.IntermediateDuration = (*apismetav1.Duration)(unsafe.Pointer(in.IntermediateDuration))
+	return nil
+}
+
+// Convert_v1beta1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA is an autogenerated conversion function.
+func Convert_v1beta1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in *v1beta1.SelfSignedBootstrapCA, out *certmanager.SelfSignedBootstrapCA, s conversion.Scope) error {
+	return autoConvert_v1beta1_SelfSignedBootstrapCA_To_certmanager_SelfSignedBootstrapCA(in, out, s)
+}
+
+func autoConvert_certmanager_SelfSignedBootstrapCA_To_v1beta1_SelfSignedBootstrapCA(in *certmanager.SelfSignedBootstrapCA, out *v1beta1.SelfSignedBootstrapCA, s conversion.Scope) error {
+	out.IssuerName = in.IssuerName
+	out.CommonName = in.CommonName
+	out.Subject = (*v1beta1.X509Subject)(unsafe.Pointer(in.Subject))
+	out.RootDuration = (*apismetav1.Duration)(unsafe.Pointer(in.RootDuration))
+	out.IntermediateDuration = (*apismetav1.Duration)(unsafe.Pointer(in.IntermediateDuration))
+	return nil
+}
+
+// Convert_certmanager_SelfSignedBootstrapCA_To_v1beta1_SelfSignedBootstrapCA is an autogenerated conversion function.
+func Convert_certmanager_SelfSignedBootstrapCA_To_v1beta1_SelfSignedBootstrapCA(in *certmanager.SelfSignedBootstrapCA, out *v1beta1.SelfSignedBootstrapCA, s conversion.Scope) error {
+	return autoConvert_certmanager_SelfSignedBootstrapCA_To_v1beta1_SelfSignedBootstrapCA(in, out, s)
+}
+
 func autoConvert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1beta1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.BootstrapCA = (*certmanager.SelfSignedBootstrapCA)(unsafe.Pointer(in.BootstrapCA))
 	return nil
 }
 
@@ -1274,6 +2413,7 @@ func Convert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1beta
 
 func autoConvert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1beta1.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.BootstrapCA = (*v1beta1.SelfSignedBootstrapCA)(unsafe.Pointer(in.BootstrapCA))
 	return nil
 }
 
@@ -1285,7 +2425,7 @@ func Convert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer(in *certma
 func autoConvert_v1beta1_VaultAppRole_To_certmanager_VaultAppRole(in *v1beta1.VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1299,7 +2439,7 @@ func Convert_v1beta1_VaultAppRole_To_certmanager_VaultAppRole(in *v1beta1.VaultA
 func autoConvert_certmanager_VaultAppRole_To_v1beta1_VaultAppRole(in *certmanager.VaultAppRole, out *v1beta1.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1314,7 +2454,7 @@ func autoConvert_v1beta1_VaultAuth_To_certmanager_VaultAuth(in *v1beta1.VaultAut
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
 		*out = new(meta.SecretKeySelector)
-		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1350,7 +2490,7 @@ func autoConvert_certmanager_VaultAuth_To_v1beta1_VaultAuth(in *certmanager.Vaul
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
 		*out = new(metav1.SecretKeySelector)
-		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1388,6 +2528,7 @@ func autoConvert_v1beta1_VaultIssuer_To_certmanager_VaultIssuer(in *v1beta1.Vaul
 	}
 	out.Server = in.Server
 	out.Path = in.Path
+	out.SigningMode = certmanager.VaultSigningMode(in.SigningMode)
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	return nil
@@ -1404,6 +2545,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1beta1_VaultIssuer(in *certmanager.
 	}
 	out.Server = in.Server
 	out.Path = in.Path
+	out.SigningMode = v1beta1.VaultSigningMode(in.SigningMode)
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	return nil
@@ -1416,7 +2558,7 @@ func Convert_certmanager_VaultIssuer_To_v1beta1_VaultIssuer(in *certmanager.Vaul
 
 func autoConvert_v1beta1_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(in *v1beta1.VaultKubernetesAuth, out *certmanager.VaultKubernetesAuth, s conversion.Scope) error {
 	out.Path = in.Path
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1430,7 +2572,7 @@ func Convert_v1beta1_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(in *
 
 func autoConvert_certmanager_VaultKubernetesAuth_To_v1beta1_VaultKubernetesAuth(in *certmanager.VaultKubernetesAuth, out *v1beta1.VaultKubernetesAuth, s conversion.Scope) error {
 	out.Path = in.Path
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1444,7 +2586,7 @@ func Convert_certmanager_VaultKubernetesAuth_To_v1beta1_VaultKubernetesAuth(in *
 
 func autoConvert_v1beta1_VenafiCloud_To_certmanager_VenafiCloud(in *v1beta1.VenafiCloud, out *certmanager.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
+	if err := v1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1457,7 +2599,7 @@ func Convert_v1beta1_VenafiCloud_To_certmanager_VenafiCloud(in *v1beta1.VenafiCl
 
 func autoConvert_certmanager_VenafiCloud_To_v1beta1_VenafiCloud(in *certmanager.VenafiCloud, out *v1beta1.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
+	if err := v1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1526,7 +2668,7 @@ func Convert_certmanager_VenafiIssuer_To_v1beta1_VenafiIssuer(in *certmanager.Ve
 
 func autoConvert_v1beta1_VenafiTPP_To_certmanager_VenafiTPP(in *v1beta1.VenafiTPP, out *certmanager.VenafiTPP, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := apismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := v1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
@@ -1540,7 +2682,7 @@ func Convert_v1beta1_VenafiTPP_To_certmanager_VenafiTPP(in *v1beta1.VenafiTPP, o
 
 func autoConvert_certmanager_VenafiTPP_To_v1beta1_VenafiTPP(in *certmanager.VenafiTPP, out *v1beta1.VenafiTPP, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := apismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := v1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
@@ -1552,6 +2694,30 @@ func Convert_certmanager_VenafiTPP_To_v1beta1_VenafiTPP(in *certmanager.VenafiTP
 	return autoConvert_certmanager_VenafiTPP_To_v1beta1_VenafiTPP(in, out, s)
 }
 
+func autoConvert_v1beta1_X509Extension_To_certmanager_X509Extension(in *v1beta1.X509Extension, out *certmanager.X509Extension, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_v1beta1_X509Extension_To_certmanager_X509Extension is an autogenerated conversion function.
+func Convert_v1beta1_X509Extension_To_certmanager_X509Extension(in *v1beta1.X509Extension, out *certmanager.X509Extension, s conversion.Scope) error {
+	return autoConvert_v1beta1_X509Extension_To_certmanager_X509Extension(in, out, s)
+}
+
+func autoConvert_certmanager_X509Extension_To_v1beta1_X509Extension(in *certmanager.X509Extension, out *v1beta1.X509Extension, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_certmanager_X509Extension_To_v1beta1_X509Extension is an autogenerated conversion function.
+func Convert_certmanager_X509Extension_To_v1beta1_X509Extension(in *certmanager.X509Extension, out *v1beta1.X509Extension, s conversion.Scope) error {
+	return autoConvert_certmanager_X509Extension_To_v1beta1_X509Extension(in, out, s)
+}
+
 func autoConvert_v1beta1_X509Subject_To_certmanager_X509Subject(in *v1beta1.X509Subject, out *certmanager.X509Subject, s conversion.Scope) error {
 	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
 	out.Countries = *(*[]string)(unsafe.Pointer(&in.Countries))
@@ -1561,6 +2727,8 @@ func autoConvert_v1beta1_X509Subject_To_certmanager_X509Subject(in *v1beta1.X509
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
+	out.UID = in.UID
+	out.ExtraNames = *(*[]string)(unsafe.Pointer(&in.ExtraNames))
 	return nil
 }
 
@@ -1578,6 +2746,12 @@ func autoConvert_certmanager_X509Subject_To_v1beta1_X509Subject(in *certmanager.
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
-	// WARNING: in.ExtraNames requires manual conversion: does not exist in peer-type
+	out.UID = in.UID
+	out.ExtraNames = *(*[]string)(unsafe.Pointer(&in.ExtraNames))
 	return nil
 }
+
+// Convert_certmanager_X509Subject_To_v1beta1_X509Subject is an autogenerated conversion function.
+func Convert_certmanager_X509Subject_To_v1beta1_X509Subject(in *certmanager.X509Subject, out *v1beta1.X509Subject, s conversion.Scope) error {
+	return autoConvert_certmanager_X509Subject_To_v1beta1_X509Subject(in, out, s)
+}
@@ -21,11 +21,15 @@ import (
 	"net"
 	"net/mail"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/jetstack/cert-manager/pkg/api/util"
@@ -33,6 +37,8 @@ import (
 	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
 	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	cmutil "github.com/jetstack/cert-manager/pkg/util"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 // Validation functions for cert-manager Certificate types
@@ -42,18 +48,45 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	if crt.SecretName == "" {
 		el = append(el, field.Required(fldPath.Child("secretName"), "must be specified"))
 	}
+	if len(crt.AdditionalSecretNames) > 0 {
+		el = append(el, validateAdditionalSecretNames(crt, fldPath.Child("additionalSecretNames"))...)
+	}
+	if crt.SecretNamespace != "" {
+		for _, msg := range apivalidation.ValidateNamespaceName(crt.SecretNamespace, false) {
+			el = append(el, field.Invalid(fldPath.Child("secretNamespace"), crt.SecretNamespace, msg))
+		}
+	}
 
 	el = append(el, validateIssuerRef(crt.IssuerRef, fldPath)...)
+	for i, ref := range crt.FallbackIssuerRefs {
+		el = append(el, validateIssuerObjectReference(ref, fldPath.Child("fallbackIssuerRefs").Index(i))...)
+	}
+	if crt.IssuerFailoverThreshold != nil && *crt.IssuerFailoverThreshold < 1 {
+		el = append(el, field.Invalid(fldPath.Child("issuerFailoverThreshold"), *crt.IssuerFailoverThreshold, "must not be less than 1"))
+	}
 
 	if len(crt.CommonName) == 0 && len(crt.DNSNames) == 0 && len(crt.URISANs) == 0 && len(crt.EmailSANs) == 0 && len(crt.IPAddresses) == 0 {
 		el = append(el, field.Invalid(fldPath, "", "at least one of commonName, dnsNames, uris ipAddresses, or emailAddresses must be set"))
 	}
 
+	if crt.Subject != nil {
+		for i, extraName := range crt.Subject.ExtraNames {
+			if _, err := pki.ParseExtraName(extraName); err != nil {
+				el = append(el, field.Invalid(fldPath.Child("subject", "extraNames").Index(i), extraName, err.Error()))
+			}
+		}
+	}
+
 	// if a common name has been specified, ensure it is no longer than 64 chars
-	if len(crt.CommonName) > 64 {
+	// once any internationalized domain name has been encoded in A-label form
+	if len(pki.CommonNameToASCII(crt.CommonName)) > 64 {
 		el = append(el, field.TooLong(fldPath.Child("commonName"), crt.CommonName, 64))
 	}
 
+	if len(crt.DNSNames) > 0 {
+		el = append(el, validateDNSNames(crt, fldPath)...)
+	}
+
 	if len(crt.IPAddresses) > 0 {
 		el = append(el, validateIPAddresses(crt, fldPath)...)
 	}
@@ -77,14 +110,32 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		default:
 			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa or ecdsa"))
 		}
+		if crt.PrivateKey.External != nil {
+			el = append(el, validateExternalPrivateKey(crt, fldPath.Child("privateKey", "external"))...)
+		}
+		if crt.PrivateKey.SecretRef != nil {
+			el = append(el, validatePrivateKeySecretRef(crt, fldPath.Child("privateKey", "secretRef"))...)
+		}
+		if crt.PrivateKey.SignatureAlgorithm != "" {
+			el = append(el, validateSignatureAlgorithm(crt, fldPath.Child("privateKey", "signatureAlgorithm"))...)
+		}
 	}
 
 	if crt.Duration != nil || crt.RenewBefore != nil {
 		el = append(el, ValidateDuration(crt, fldPath)...)
 	}
+	if crt.IssuanceDeadline != nil && crt.IssuanceDeadline.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("issuanceDeadline"), crt.IssuanceDeadline.Duration, "must be greater than zero"))
+	}
+	if crt.RenewalWindow != nil {
+		el = append(el, validateRenewalWindow(crt.RenewalWindow, fldPath.Child("renewalWindow"))...)
+	}
 	if len(crt.Usages) > 0 {
 		el = append(el, validateUsages(crt, fldPath)...)
 	}
+	if len(crt.Profile) > 0 {
+		el = append(el, validateProfile(crt, fldPath)...)
+	}
 	if crt.RevisionHistoryLimit != nil && *crt.RevisionHistoryLimit < 1 {
 		el = append(el, field.Invalid(fldPath.Child("revisionHistoryLimit"), *crt.RevisionHistoryLimit, "must not be less than 1"))
 	}
@@ -96,6 +147,29 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		if len(crt.SecretTemplate.Annotations) > 0 {
 			el = append(el, validateSecretTemplateAnnotations(crt, fldPath)...)
 		}
+		if len(crt.SecretTemplate.Keys) > 0 {
+			el = append(el, validateSecretTemplateKeys(crt, fldPath)...)
+		}
+	}
+
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, validateAdditionalOutputFormats(crt, fldPath)...)
+	}
+
+	if crt.CAChain != nil {
+		switch crt.CAChain.Composition {
+		case "", internalcmapi.CAChainCompositionIssuerProvided, internalcmapi.CAChainCompositionRoot,
+			internalcmapi.CAChainCompositionIntermediates, internalcmapi.CAChainCompositionFullChain:
+		default:
+			el = append(el, field.NotSupported(fldPath.Child("caChain", "composition"), crt.CAChain.Composition, []string{
+				string(internalcmapi.CAChainCompositionIssuerProvided), string(internalcmapi.CAChainCompositionRoot),
+				string(internalcmapi.CAChainCompositionIntermediates), string(internalcmapi.CAChainCompositionFullChain),
+			}))
+		}
+	}
+
+	if crt.DualCertificate != nil {
+		el = append(el, validateDualCertificate(crt, fldPath.Child("dualCertificate"))...)
 	}
 
 	return el
@@ -104,21 +178,82 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 func ValidateCertificate(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, validation.WarningList) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
-	w := validateAPIVersion(a.RequestKind)
+	allErrs = append(allErrs, validateCertificateAnnotations(crt.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateSealedPrivateKey(crt, field.NewPath("metadata", "annotations"))...)
+	errs, warnings := validateCertificateSpecV2(&crt.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, errs...)
+	w := append(validateAPIVersion(a.RequestKind), warnings...)
 	return allErrs, w
 }
 
 func ValidateUpdateCertificate(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, validation.WarningList) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
-	w := validateAPIVersion(a.RequestKind)
+	allErrs = append(allErrs, validateCertificateAnnotations(crt.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateSealedPrivateKey(crt, field.NewPath("metadata", "annotations"))...)
+	errs, warnings := validateCertificateSpecV2(&crt.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, errs...)
+	w := append(validateAPIVersion(a.RequestKind), warnings...)
 	return allErrs, w
 }
 
+// validateCertificateAnnotations validates the values of the cert-manager
+// annotations that change how a Certificate is processed.
+func validateCertificateAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if v, ok := annotations[cmapi.IssuancePausedAnnotationKey]; ok && v != "true" && v != "false" {
+		el = append(el, field.NotSupported(fldPath.Key(cmapi.IssuancePausedAnnotationKey), v, []string{"true", "false"}))
+	}
+	el = append(el, validateVenafiAnnotations(annotations, fldPath)...)
+
+	return el
+}
+
+// validateVenafiAnnotations validates the annotations that select the Venafi
+// as a Service application and issuing template. Both must be set together,
+// as they are joined into a single zone of the form
+// "<application>\<issuing template alias>".
+func validateVenafiAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	_, hasApplication := annotations[cmapi.VenafiApplicationAnnotationKey]
+	_, hasTemplate := annotations[cmapi.VenafiIssuingTemplateAnnotationKey]
+	switch {
+	case !hasApplication && !hasTemplate:
+		return nil
+	case !hasApplication:
+		el = append(el, field.Required(fldPath.Key(cmapi.VenafiApplicationAnnotationKey),
+			fmt.Sprintf("must be set when %s is set", cmapi.VenafiIssuingTemplateAnnotationKey)))
+	case !hasTemplate:
+		el = append(el, field.Required(fldPath.Key(cmapi.VenafiIssuingTemplateAnnotationKey),
+			fmt.Sprintf("must be set when %s is set", cmapi.VenafiApplicationAnnotationKey)))
+	}
+
+	for _, key := range []string{cmapi.VenafiApplicationAnnotationKey, cmapi.VenafiIssuingTemplateAnnotationKey} {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		if strings.TrimSpace(value) == "" {
+			el = append(el, field.Invalid(fldPath.Key(key), value, "must not be empty"))
+		} else if strings.Contains(value, "\\") {
+			el = append(el, field.Invalid(fldPath.Key(key), value, "must not contain a backslash"))
+		}
+	}
+
+	return el
+}
+
 func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) field.ErrorList {
+	return validateIssuerObjectReference(issuerRef, fldPath.Child("issuerRef"))
+}
+
+// validateIssuerObjectReference validates a reference to an issuer found at
+// issuerRefPath.
+func validateIssuerObjectReference(issuerRef cmmeta.ObjectReference, issuerRefPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
-	issuerRefPath := fldPath.Child("issuerRef")
 	if issuerRef.Name == "" {
 		el = append(el, field.Required(issuerRefPath.Child("name"), "must be specified"))
 	}
@@ -147,6 +282,18 @@ func validateIPAddresses(a *internalcmapi.CertificateSpec, fldPath *field.Path)
 	return el
 }
 
+// validateDNSNames ensures that internationalized domain names can be encoded
+// in their A-label form, which is used in the certificate request.
+func validateDNSNames(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, d := range a.DNSNames {
+		if _, err := cmutil.DNSNameToASCII(d); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("dnsNames").Index(i), d, err.Error()))
+		}
+	}
+	return el
+}
+
 func validateEmailAddresses(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	if len(a.EmailSANs) <= 0 {
 		return nil
@@ -177,6 +324,43 @@ func validateUsages(a *internalcmapi.CertificateSpec, fldPath *field.Path) field
 	return el
 }
 
+func validateProfile(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	profilePath := fldPath.Child("profile")
+
+	switch crt.Profile {
+	case internalcmapi.ServerCertificateProfile, internalcmapi.PeerCertificateProfile:
+		if len(crt.DNSNames) == 0 && len(crt.IPAddresses) == 0 {
+			el = append(el, field.Invalid(profilePath, crt.Profile, "at least one of dnsNames or ipAddresses must be set"))
+		}
+	case internalcmapi.ClientCertificateProfile:
+		if len(crt.CommonName) == 0 && len(crt.URISANs) == 0 && len(crt.EmailSANs) == 0 {
+			el = append(el, field.Invalid(profilePath, crt.Profile, "at least one of commonName, uris or emailAddresses must be set"))
+		}
+	case internalcmapi.CodeSigningCertificateProfile:
+		if len(crt.CommonName) == 0 {
+			el = append(el, field.Invalid(profilePath, crt.Profile, "commonName must be set"))
+		}
+		if len(crt.DNSNames) > 0 || len(crt.IPAddresses) > 0 {
+			el = append(el, field.Invalid(profilePath, crt.Profile, "dnsNames and ipAddresses must not be set"))
+		}
+	default:
+		return append(el, field.NotSupported(profilePath, crt.Profile, []string{
+			string(internalcmapi.ServerCertificateProfile), string(internalcmapi.ClientCertificateProfile),
+			string(internalcmapi.PeerCertificateProfile), string(internalcmapi.CodeSigningCertificateProfile),
+		}))
+	}
+
+	if len(crt.Usages) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("usages"), "must not be set when a profile is set"))
+	}
+	if crt.IsCA {
+		el = append(el, field.Forbidden(fldPath.Child("isCA"), "must not be set when a profile is set"))
+	}
+
+	return el
+}
+
 func validateSecretTemplateLabels(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	return metavalidation.ValidateLabels(crt.SecretTemplate.Labels, fldPath.Child("secretTemplate", "labels"))
 }
@@ -195,6 +379,289 @@ func validateSecretTemplateAnnotations(crt *internalcmapi.CertificateSpec, fldPa
 	return el
 }
 
+// secretTemplateSourceKeys are the standard Secret data keys which may be
+// copied to custom keys using spec.secretTemplate.keys.
+var secretTemplateSourceKeys = []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, cmmeta.TLSCAKey}
+
+// secretManagedKeys are the Secret data keys written by cert-manager, which
+// cannot be used as the target of spec.secretTemplate.keys as they would be
+// overwritten, or removed, by cert-manager.
+var secretManagedKeys = []string{
+	corev1.TLSCertKey,
+	corev1.TLSPrivateKeyKey,
+	cmmeta.TLSCAKey,
+	cmmeta.TLSOCSPStapleKey,
+	cmmeta.TLSTrustStoreKey,
+	"keystore.jks",
+	"keystore.p12",
+	"truststore.jks",
+	"truststore.p12",
+	"key.der",
+	"cert.der",
+	"tls-combined.pem",
+}
+
+func validateSecretTemplateKeys(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	keysPath := fldPath.Child("secretTemplate", "keys")
+	targets := sets.NewString()
+	// Iterate over the keys in order so that errors are reported consistently.
+	for _, src := range sets.StringKeySet(crt.SecretTemplate.Keys).List() {
+		target := crt.SecretTemplate.Keys[src]
+		if !cmutil.Contains(secretTemplateSourceKeys, src) {
+			el = append(el, field.NotSupported(keysPath, src, secretTemplateSourceKeys))
+			continue
+		}
+		for _, msg := range k8svalidation.IsConfigMapKey(target) {
+			el = append(el, field.Invalid(keysPath.Key(src), target, msg))
+		}
+		if cmutil.Contains(secretManagedKeys, target) {
+			el = append(el, field.Invalid(keysPath.Key(src), target, "must not be a Secret data key managed by cert-manager"))
+		}
+		if targets.Has(target) {
+			el = append(el, field.Duplicate(keysPath.Key(src), target))
+		}
+		targets.Insert(target)
+	}
+
+	return el
+}
+
+// validateExternalPrivateKey validates a private key held by an external
+// signer. The private key cannot be written to keystores or additional
+// output formats, as it is never available to cert-manager.
+func validateExternalPrivateKey(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if crt.PrivateKey.External.Provider == "" {
+		el = append(el, field.Required(fldPath.Child("provider"), "must be specified"))
+	}
+	if crt.Keystores != nil && ((crt.Keystores.JKS != nil && crt.Keystores.JKS.Create) || (crt.Keystores.PKCS12 != nil && crt.Keystores.PKCS12.Create)) {
+		el = append(el, field.Forbidden(fldPath, "cannot be used with keystores"))
+	}
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, field.Forbidden(fldPath, "cannot be used with additionalOutputFormats"))
+	}
+	return el
+}
+
+// validateSealedPrivateKey validates a Certificate whose private key is
+// sealed by the KMS plugin named in its annotations. The private key cannot be
+// written to keystores or additional output formats, as only its encrypted
+// form is available when the Secret is written.
+func validateSealedPrivateKey(crt *internalcmapi.Certificate, fldPath *field.Path) field.ErrorList {
+	if crt.Annotations[cmapi.PrivateKeyKMSPluginAnnotationKey] == "" {
+		return nil
+	}
+
+	el := field.ErrorList{}
+	fldPath = fldPath.Key(cmapi.PrivateKeyKMSPluginAnnotationKey)
+	keystores := crt.Spec.Keystores
+	if keystores != nil && ((keystores.JKS != nil && keystores.JKS.Create) || (keystores.PKCS12 != nil && keystores.PKCS12.Create)) {
+		el = append(el, field.Forbidden(fldPath, "cannot be used with keystores"))
+	}
+	if len(crt.Spec.AdditionalOutputFormats) > 0 {
+		el = append(el, field.Forbidden(fldPath, "cannot be used with additionalOutputFormats"))
+	}
+	return el
+}
+
+// validatePrivateKeySecretRef validates a private key supplied in a Secret.
+// The private key is never generated or rotated by cert-manager, so options
+// which control key generation cannot be used.
+func validatePrivateKeySecretRef(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if crt.PrivateKey.SecretRef.Name == "" {
+		el = append(el, field.Required(fldPath.Child("name"), "must be specified"))
+	}
+	if crt.PrivateKey.External != nil {
+		el = append(el, field.Forbidden(fldPath, "cannot be used with external"))
+	}
+	if crt.PrivateKey.Algorithm != "" {
+		el = append(el, field.Forbidden(fldPath, "cannot be used with algorithm"))
+	}
+	if crt.PrivateKey.Size != 0 {
+		el = append(el, field.Forbidden(fldPath, "cannot be used with size"))
+	}
+	if crt.PrivateKey.RotationPolicy == internalcmapi.RotationPolicyAlways {
+		el = append(el, field.Forbidden(fldPath, "cannot be used with the Always rotation policy"))
+	}
+	return el
+}
+
+// signatureAlgorithmKeyAlgorithms maps each supported signature algorithm to
+// the private key algorithm it can be used with.
+var signatureAlgorithmKeyAlgorithms = map[internalcmapi.SignatureAlgorithm]internalcmapi.PrivateKeyAlgorithm{
+	internalcmapi.SHA256WithRSA:    internalcmapi.RSAKeyAlgorithm,
+	internalcmapi.SHA384WithRSA:    internalcmapi.RSAKeyAlgorithm,
+	internalcmapi.SHA512WithRSA:    internalcmapi.RSAKeyAlgorithm,
+	internalcmapi.SHA256WithRSAPSS: internalcmapi.RSAKeyAlgorithm,
+	internalcmapi.SHA384WithRSAPSS: internalcmapi.RSAKeyAlgorithm,
+	internalcmapi.SHA512WithRSAPSS: internalcmapi.RSAKeyAlgorithm,
+	internalcmapi.ECDSAWithSHA256:  internalcmapi.ECDSAKeyAlgorithm,
+	internalcmapi.ECDSAWithSHA384:  internalcmapi.ECDSAKeyAlgorithm,
+	internalcmapi.ECDSAWithSHA512:  internalcmapi.ECDSAKeyAlgorithm,
+	internalcmapi.PureEd25519:      internalcmapi.Ed25519KeyAlgorithm,
+}
+
+// ecdsaSignatureAlgorithmCurves maps each ECDSA signature algorithm to the
+// curve size whose digest it matches.
+var ecdsaSignatureAlgorithmCurves = map[internalcmapi.SignatureAlgorithm]int{
+	internalcmapi.ECDSAWithSHA256: 256,
+	internalcmapi.ECDSAWithSHA384: 384,
+	internalcmapi.ECDSAWithSHA512: 521,
+}
+
+func validateSignatureAlgorithm(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	sigAlgo := crt.PrivateKey.SignatureAlgorithm
+	keyAlgo, ok := signatureAlgorithmKeyAlgorithms[sigAlgo]
+	if !ok {
+		supported := sets.NewString()
+		for a := range signatureAlgorithmKeyAlgorithms {
+			supported.Insert(string(a))
+		}
+		return append(el, field.NotSupported(fldPath, sigAlgo, supported.List()))
+	}
+	// The algorithm of a referenced or external key is only known at
+	// issuance time, so compatibility is checked when the request is built.
+	if crt.PrivateKey.SecretRef != nil || crt.PrivateKey.External != nil {
+		return el
+	}
+	algorithm := crt.PrivateKey.Algorithm
+	if algorithm == "" {
+		algorithm = internalcmapi.RSAKeyAlgorithm
+	}
+	if algorithm != keyAlgo {
+		return append(el, field.Invalid(fldPath, sigAlgo, fmt.Sprintf("cannot be used with the %s private key algorithm", algorithm)))
+	}
+	if algorithm == internalcmapi.ECDSAKeyAlgorithm {
+		size := crt.PrivateKey.Size
+		if size == 0 {
+			size = 256
+		}
+		if ecdsaSignatureAlgorithmCurves[sigAlgo] != size {
+			el = append(el, field.Invalid(fldPath, sigAlgo, fmt.Sprintf("does not match the digest for an ECDSA key of size %d", size)))
+		}
+	}
+	return el
+}
+
+func validateAdditionalSecretNames(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	seen := sets.NewString(crt.SecretName)
+	for i, name := range crt.AdditionalSecretNames {
+		if name == "" {
+			el = append(el, field.Required(fldPath.Index(i), "must be specified"))
+			continue
+		}
+		for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+			el = append(el, field.Invalid(fldPath.Index(i), name, msg))
+		}
+		if seen.Has(name) {
+			el = append(el, field.Duplicate(fldPath.Index(i), name))
+		}
+		seen.Insert(name)
+	}
+	return el
+}
+
+func validateRenewalWindow(window *internalcmapi.CertificateRenewalWindow, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if window.TimeZone != "" {
+		if _, err := time.LoadLocation(window.TimeZone); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("timeZone"), window.TimeZone, "must be the name of an IANA time zone"))
+		}
+	}
+	if len(window.Windows) == 0 {
+		el = append(el, field.Required(fldPath.Child("windows"), "at least one window must be specified"))
+	}
+
+	var weekdays []string
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		weekdays = append(weekdays, d.String())
+	}
+	validWeekdays := sets.NewString(weekdays...)
+	for i, w := range window.Windows {
+		wPath := fldPath.Child("windows").Index(i)
+		for j, day := range w.Weekdays {
+			if !validWeekdays.Has(day) {
+				el = append(el, field.NotSupported(wPath.Child("weekdays").Index(j), day, weekdays))
+			}
+		}
+		if _, err := time.Parse("15:04", w.Start); err != nil {
+			el = append(el, field.Invalid(wPath.Child("start"), w.Start, "must be a time of day in the format HH:MM"))
+		}
+		if _, err := time.Parse("15:04", w.End); err != nil {
+			el = append(el, field.Invalid(wPath.Child("end"), w.End, "must be a time of day in the format HH:MM"))
+		}
+	}
+	return el
+}
+
+// validateDualCertificate validates the second certificate of a Certificate,
+// whose private key must use a different algorithm to the private key of the
+// Certificate.
+func validateDualCertificate(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	dual := crt.DualCertificate
+	switch dual.Algorithm {
+	case "":
+		return append(el, field.Required(fldPath.Child("algorithm"), "must be specified"))
+	case internalcmapi.RSAKeyAlgorithm:
+		if dual.Size > 0 && (dual.Size < 2048 || dual.Size > 8192) {
+			el = append(el, field.Invalid(fldPath.Child("size"), dual.Size, "must be between 2048 & 8192 for rsa algorithm"))
+		}
+	case internalcmapi.ECDSAKeyAlgorithm:
+		if dual.Size > 0 && dual.Size != 256 && dual.Size != 384 && dual.Size != 521 {
+			el = append(el, field.NotSupported(fldPath.Child("size"), dual.Size, []string{"256", "384", "521"}))
+		}
+	case internalcmapi.Ed25519KeyAlgorithm:
+	default:
+		return append(el, field.NotSupported(fldPath.Child("algorithm"), dual.Algorithm, []string{
+			string(internalcmapi.RSAKeyAlgorithm), string(internalcmapi.ECDSAKeyAlgorithm), string(internalcmapi.Ed25519KeyAlgorithm),
+		}))
+	}
+
+	// The algorithm of a referenced or external key is only known at
+	// issuance time.
+	if crt.PrivateKey != nil && (crt.PrivateKey.SecretRef != nil || crt.PrivateKey.External != nil) {
+		return el
+	}
+	algorithm := internalcmapi.RSAKeyAlgorithm
+	if crt.PrivateKey != nil && crt.PrivateKey.Algorithm != "" {
+		algorithm = crt.PrivateKey.Algorithm
+	}
+	if dual.Algorithm == algorithm {
+		el = append(el, field.Invalid(fldPath.Child("algorithm"), dual.Algorithm, "must differ from the private key algorithm of the certificate"))
+	}
+	return el
+}
+
+func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	seen := make(map[internalcmapi.CertificateOutputFormatType]bool)
+	for i, f := range crt.AdditionalOutputFormats {
+		fmtPath := fldPath.Child("additionalOutputFormats").Index(i).Child("type")
+		switch f.Type {
+		case internalcmapi.CertificateOutputFormatDER, internalcmapi.CertificateOutputFormatCombinedPEM:
+		default:
+			el = append(el, field.NotSupported(fmtPath, f.Type, []string{
+				string(internalcmapi.CertificateOutputFormatDER),
+				string(internalcmapi.CertificateOutputFormatCombinedPEM),
+			}))
+			continue
+		}
+		if seen[f.Type] {
+			el = append(el, field.Duplicate(fmtPath, f.Type))
+		}
+		seen[f.Type] = true
+	}
+
+	return el
+}
+
 func ValidateDuration(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
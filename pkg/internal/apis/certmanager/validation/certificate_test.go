@@ -25,6 +25,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmapiv1alpha2 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha2"
@@ -77,6 +78,88 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 		},
+		"valid with issuance paused": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{cmapi.IssuancePausedAnnotationKey: "true"},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid issuance paused annotation value": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{cmapi.IssuancePausedAnnotationKey: "yes"},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(field.NewPath("metadata", "annotations").Key(cmapi.IssuancePausedAnnotationKey), "yes", []string{"true", "false"}),
+			},
+		},
+		"valid with venafi application and issuing template": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						cmapi.VenafiApplicationAnnotationKey:     "my-application",
+						cmapi.VenafiIssuingTemplateAnnotationKey: "my-template",
+					},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with venafi application but no issuing template": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{cmapi.VenafiApplicationAnnotationKey: "my-application"},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(field.NewPath("metadata", "annotations").Key(cmapi.VenafiIssuingTemplateAnnotationKey),
+					"must be set when venafi.cert-manager.io/application is set"),
+			},
+		},
+		"invalid with empty venafi application and issuing template with a backslash": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						cmapi.VenafiApplicationAnnotationKey:     "",
+						cmapi.VenafiIssuingTemplateAnnotationKey: `app\template`,
+					},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(field.NewPath("metadata", "annotations").Key(cmapi.VenafiApplicationAnnotationKey), "", "must not be empty"),
+				field.Invalid(field.NewPath("metadata", "annotations").Key(cmapi.VenafiIssuingTemplateAnnotationKey), `app\template`, "must not contain a backslash"),
+			},
+		},
 		"valid with blank issuerRef kind": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -416,6 +499,47 @@ func TestValidateCertificate(t *testing.T) {
 				field.TooLong(fldPath.Child("commonName"), "this-is-a-big-long-string-which-has-exactly-sixty-five-characters", 64),
 			},
 		},
+		"invalid certificate with internationalized commonName longer than 64 bytes in A-label form": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "ü.ü.ü.ü.ü.ü.ü.ü.ü.example",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.TooLong(fldPath.Child("commonName"), "ü.ü.ü.ü.ü.ü.ü.ü.ü.example", 64),
+			},
+		},
+		"valid certificate with internationalized commonName and dnsNames": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "bücher.example",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					DNSNames:   []string{"bücher.example", "*.bücher.example", "xn--strae-oqa.example"},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with dnsName that is not a valid internationalized domain name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					DNSNames:   []string{"bücher.example", "bü cher.example"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("dnsNames").Index(1), "bü cher.example", `invalid internationalized domain name "bü cher.example": idna: disallowed rune U+0020`),
+			},
+			warnings: validation.WarningList{
+				field.Invalid(fldPath.Child("dnsNames").Index(1), "bü cher.example", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')").Error() +
+					" (this will be rejected by validation ruleset v2, which is enforced by the ValidationRulesetV2 feature gate)",
+			},
+		},
 		"valid certificate with no commonName and second dnsName longer than 64 bytes": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -485,7 +609,7 @@ func TestValidateCertificate(t *testing.T) {
 					SecretName: "abc",
 					IssuerRef:  validIssuerRef,
 					URISANs: []string{
-						"foo.bar",
+						"spiffe://foo.bar",
 					},
 				},
 			},
@@ -565,6 +689,322 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
 			},
 		},
+		"valid certificate with issuance deadline": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:       "abc",
+					SecretName:       "abc",
+					IssuerRef:        validIssuerRef,
+					IssuanceDeadline: &metav1.Duration{Duration: time.Minute * 10},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with issuance deadline of zero": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:       "abc",
+					SecretName:       "abc",
+					IssuerRef:        validIssuerRef,
+					IssuanceDeadline: &metav1.Duration{},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issuanceDeadline"), time.Duration(0), "must be greater than zero"),
+			},
+		},
+		"valid certificate with renewal window": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RenewalWindow: &internalcmapi.CertificateRenewalWindow{
+						Windows: []internalcmapi.CertificateRenewalWindowRange{
+							{Weekdays: []string{"Saturday", "Sunday"}, Start: "22:00", End: "04:00"},
+						},
+						TimeZone: "Europe/Berlin",
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with malformed renewal window": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RenewalWindow: &internalcmapi.CertificateRenewalWindow{
+						Windows: []internalcmapi.CertificateRenewalWindowRange{
+							{Weekdays: []string{"Sat"}, Start: "22", End: "24:00"},
+						},
+						TimeZone: "Nowhere/Special",
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewalWindow", "timeZone"), "Nowhere/Special", "must be the name of an IANA time zone"),
+				field.NotSupported(fldPath.Child("renewalWindow", "windows").Index(0).Child("weekdays").Index(0), "Sat",
+					[]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}),
+				field.Invalid(fldPath.Child("renewalWindow", "windows").Index(0).Child("start"), "22", "must be a time of day in the format HH:MM"),
+				field.Invalid(fldPath.Child("renewalWindow", "windows").Index(0).Child("end"), "24:00", "must be a time of day in the format HH:MM"),
+			},
+		},
+		"invalid certificate with renewal window without windows": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:    "abc",
+					SecretName:    "abc",
+					IssuerRef:     validIssuerRef,
+					RenewalWindow: &internalcmapi.CertificateRenewalWindow{},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("renewalWindow", "windows"), "at least one window must be specified"),
+			},
+		},
+		"valid certificate with an ECDSA dual certificate": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "abc",
+					SecretName:      "abc",
+					IssuerRef:       validIssuerRef,
+					DualCertificate: &internalcmapi.CertificateDualCertificate{Algorithm: internalcmapi.ECDSAKeyAlgorithm, Size: 384},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with a dual certificate of the same algorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "abc",
+					SecretName:      "abc",
+					IssuerRef:       validIssuerRef,
+					PrivateKey:      &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm},
+					DualCertificate: &internalcmapi.CertificateDualCertificate{Algorithm: internalcmapi.ECDSAKeyAlgorithm},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("dualCertificate", "algorithm"), internalcmapi.ECDSAKeyAlgorithm, "must differ from the private key algorithm of the certificate"),
+			},
+		},
+		"invalid certificate with a dual certificate of an invalid size": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "abc",
+					SecretName:      "abc",
+					IssuerRef:       validIssuerRef,
+					DualCertificate: &internalcmapi.CertificateDualCertificate{Algorithm: internalcmapi.ECDSAKeyAlgorithm, Size: 2048},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("dualCertificate", "size"), 2048, []string{"256", "384", "521"}),
+			},
+		},
+		"valid certificate with subject UID and extra names": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "device",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Subject: &internalcmapi.X509Subject{
+						UID:        "device-1",
+						ExtraNames: []string{"1.3.6.1.4.1.311.60.2.1.3=GB"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with malformed subject extra name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "device",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Subject: &internalcmapi.X509Subject{
+						ExtraNames: []string{"1.3.6.1.4.1.311.60.2.1.3=GB", "GB"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("subject", "extraNames").Index(1), "GB", "invalid extraNames format in GB. Should be n.n.n.n=value"),
+			},
+		},
+		"valid client certificate profile": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "alice",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Profile:    internalcmapi.ClientCertificateProfile,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid server certificate profile without DNS names or IP addresses": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Profile:    internalcmapi.ServerCertificateProfile,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("profile"), internalcmapi.ServerCertificateProfile, "at least one of dnsNames or ipAddresses must be set"),
+			},
+		},
+		"invalid code signing certificate profile with DNS names and usages": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					DNSNames:   []string{"example.com"},
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Profile:    internalcmapi.CodeSigningCertificateProfile,
+					Usages:     []internalcmapi.KeyUsage{internalcmapi.UsageCodeSigning},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("profile"), internalcmapi.CodeSigningCertificateProfile, "dnsNames and ipAddresses must not be set"),
+				field.Forbidden(fldPath.Child("usages"), "must not be set when a profile is set"),
+			},
+		},
+		"invalid unknown certificate profile": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Profile:    "email",
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("profile"), internalcmapi.CertificateProfile("email"), []string{"server", "client", "peer", "code-signing"}),
+			},
+		},
+		"valid certificate with fallback issuers": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:              "abc",
+					SecretName:              "abc",
+					IssuerRef:               validIssuerRef,
+					FallbackIssuerRefs:      []cmmeta.ObjectReference{validIssuerRef},
+					IssuerFailoverThreshold: pointer.IntPtr(2),
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with fallback issuer without a name and threshold of zero": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:              "abc",
+					SecretName:              "abc",
+					IssuerRef:               validIssuerRef,
+					FallbackIssuerRefs:      []cmmeta.ObjectReference{validIssuerRef, {Kind: "Issuer"}},
+					IssuerFailoverThreshold: pointer.IntPtr(0),
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("fallbackIssuerRefs").Index(1).Child("name"), "must be specified"),
+				field.Invalid(fldPath.Child("issuerFailoverThreshold"), 0, "must not be less than 1"),
+			},
+		},
+		"valid certificate with additional secret names": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:            "abc",
+					SecretName:            "abc",
+					AdditionalSecretNames: []string{"def", "ghi"},
+					IssuerRef:             validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with empty, invalid and duplicate additional secret names": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:            "abc",
+					SecretName:            "abc",
+					AdditionalSecretNames: []string{"", "Def", "abc", "ghi", "ghi"},
+					IssuerRef:             validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("additionalSecretNames").Index(0), "must be specified"),
+				field.Invalid(fldPath.Child("additionalSecretNames").Index(1), "Def", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+				field.Duplicate(fldPath.Child("additionalSecretNames").Index(2), "abc"),
+				field.Duplicate(fldPath.Child("additionalSecretNames").Index(4), "ghi"),
+			},
+		},
+		"valid certificate with secret namespace": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "abc",
+					SecretName:      "abc",
+					SecretNamespace: "other",
+					IssuerRef:       validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with invalid secret namespace": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "abc",
+					SecretName:      "abc",
+					SecretNamespace: "Other",
+					IssuerRef:       validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretNamespace"), "Other", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		"valid certificate with CA chain composition": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					CAChain: &internalcmapi.CertificateCAChain{
+						Composition:   internalcmapi.CAChainCompositionFullChain,
+						CompleteChain: true,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with unknown CA chain composition": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					CAChain: &internalcmapi.CertificateCAChain{
+						Composition: "Leaf",
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("caChain", "composition"), internalcmapi.CertificateCAChainComposition("Leaf"),
+					[]string{"IssuerProvided", "Root", "Intermediates", "FullChain"}),
+			},
+		},
 		"v1alpha2 certificate created": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -730,6 +1170,312 @@ func TestValidateCertificate(t *testing.T) {
 						"alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
 			},
 		},
+		"valid with 'CertificateSecretTemplate' keys": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Keys: map[string]string{
+							"tls.crt": "server.crt",
+							"tls.key": "server.key",
+							"ca.crt":  "ca.pem",
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with unsupported, reserved and duplicate 'CertificateSecretTemplate' keys": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Keys: map[string]string{
+							"ca.crt":  "server.pem",
+							"tls.crt": "server.pem",
+							"tls.key": "ca.crt",
+							"other":   "other.pem",
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("secretTemplate", "keys"), "other", []string{"tls.crt", "tls.key", "ca.crt"}),
+				field.Duplicate(fldPath.Child("secretTemplate", "keys").Key("tls.crt"), "server.pem"),
+				field.Invalid(fldPath.Child("secretTemplate", "keys").Key("tls.key"), "ca.crt", "must not be a Secret data key managed by cert-manager"),
+			},
+		},
+		"invalid with 'CertificateSecretTemplate' keys targeting keys written for keystores and output formats": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Keys: map[string]string{
+							"ca.crt":  "truststore.jks",
+							"tls.crt": "tls-combined.pem",
+							"tls.key": "key.der",
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "keys").Key("ca.crt"), "truststore.jks", "must not be a Secret data key managed by cert-manager"),
+				field.Invalid(fldPath.Child("secretTemplate", "keys").Key("tls.crt"), "tls-combined.pem", "must not be a Secret data key managed by cert-manager"),
+				field.Invalid(fldPath.Child("secretTemplate", "keys").Key("tls.key"), "key.der", "must not be a Secret data key managed by cert-manager"),
+			},
+		},
+		"invalid with malformed 'CertificateSecretTemplate' key name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Keys: map[string]string{
+							"tls.crt": "server/crt",
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "keys").Key("tls.crt"), "server/crt", "a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')"),
+			},
+		},
+		"valid with additionalOutputFormats": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+						{Type: internalcmapi.CertificateOutputFormatDER},
+						{Type: internalcmapi.CertificateOutputFormatCombinedPEM},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with unknown or duplicate additionalOutputFormats": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+						{Type: internalcmapi.CertificateOutputFormatDER},
+						{Type: "PKCS7"},
+						{Type: internalcmapi.CertificateOutputFormatDER},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("additionalOutputFormats").Index(1).Child("type"), internalcmapi.CertificateOutputFormatType("PKCS7"), []string{"DER", "CombinedPEM"}),
+				field.Duplicate(fldPath.Child("additionalOutputFormats").Index(2).Child("type"), internalcmapi.CertificateOutputFormatDER),
+			},
+		},
+		"valid with an external private key": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.ECDSAKeyAlgorithm,
+						External:  &internalcmapi.CertificateExternalPrivateKey{Provider: "kms"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid external private key with keystores and additionalOutputFormats": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						External: &internalcmapi.CertificateExternalPrivateKey{},
+					},
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{Create: true},
+					},
+					AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+						{Type: internalcmapi.CertificateOutputFormatDER},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("privateKey", "external", "provider"), "must be specified"),
+				field.Forbidden(fldPath.Child("privateKey", "external"), "cannot be used with keystores"),
+				field.Forbidden(fldPath.Child("privateKey", "external"), "cannot be used with additionalOutputFormats"),
+			},
+		},
+		"valid with a sealed private key": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{cmapi.PrivateKeyKMSPluginAnnotationKey: "aws-kms"},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid sealed private key with keystores and additionalOutputFormats": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{cmapi.PrivateKeyKMSPluginAnnotationKey: "aws-kms"},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						JKS: &internalcmapi.JKSKeystore{Create: true},
+					},
+					AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+						{Type: internalcmapi.CertificateOutputFormatDER},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("metadata", "annotations").Key(cmapi.PrivateKeyKMSPluginAnnotationKey), "cannot be used with keystores"),
+				field.Forbidden(field.NewPath("metadata", "annotations").Key(cmapi.PrivateKeyKMSPluginAnnotationKey), "cannot be used with additionalOutputFormats"),
+			},
+		},
+		"valid with a private key supplied in a Secret": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Encoding:  internalcmapi.PKCS8,
+						SecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "key"}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid private key supplied in a Secret with key generation options": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy: internalcmapi.RotationPolicyAlways,
+						Algorithm:      internalcmapi.ECDSAKeyAlgorithm,
+						Size:           384,
+						External:       &internalcmapi.CertificateExternalPrivateKey{Provider: "kms"},
+						SecretRef:      &cmmeta.SecretKeySelector{},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("privateKey", "secretRef", "name"), "must be specified"),
+				field.Forbidden(fldPath.Child("privateKey", "secretRef"), "cannot be used with external"),
+				field.Forbidden(fldPath.Child("privateKey", "secretRef"), "cannot be used with algorithm"),
+				field.Forbidden(fldPath.Child("privateKey", "secretRef"), "cannot be used with size"),
+				field.Forbidden(fldPath.Child("privateKey", "secretRef"), "cannot be used with the Always rotation policy"),
+			},
+		},
+		"valid with an RSA-PSS signature algorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						SignatureAlgorithm: internalcmapi.SHA256WithRSAPSS,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"valid with a curve-matched ECDSA signature algorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm:          internalcmapi.ECDSAKeyAlgorithm,
+						Size:               521,
+						SignatureAlgorithm: internalcmapi.ECDSAWithSHA512,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with an unsupported signature algorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						SignatureAlgorithm: "MD5WithRSA",
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("privateKey", "signatureAlgorithm"), internalcmapi.SignatureAlgorithm("MD5WithRSA"), []string{
+					"ECDSAWithSHA256", "ECDSAWithSHA384", "ECDSAWithSHA512", "PureEd25519",
+					"SHA256WithRSA", "SHA256WithRSAPSS", "SHA384WithRSA", "SHA384WithRSAPSS", "SHA512WithRSA", "SHA512WithRSAPSS",
+				}),
+			},
+		},
+		"invalid with a signature algorithm for a different key algorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm:          internalcmapi.ECDSAKeyAlgorithm,
+						SignatureAlgorithm: internalcmapi.SHA256WithRSAPSS,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "signatureAlgorithm"), internalcmapi.SHA256WithRSAPSS, "cannot be used with the ECDSA private key algorithm"),
+			},
+		},
+		"invalid with an ECDSA digest not matching the curve": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm:          internalcmapi.ECDSAKeyAlgorithm,
+						SignatureAlgorithm: internalcmapi.ECDSAWithSHA384,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "signatureAlgorithm"), internalcmapi.ECDSAWithSHA384, "does not match the digest for an ECDSA key of size 256"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -44,6 +44,7 @@ func ValidateCertificateRequest(a *admissionv1.AdmissionRequest, obj runtime.Obj
 	allErrs := ValidateCertificateRequestSpec(&cr.Spec, field.NewPath("spec"), true)
 	allErrs = append(allErrs,
 		ValidateCertificateRequestApprovalCondition(cr.Status.Conditions, field.NewPath("status", "conditions"))...)
+	allErrs = append(allErrs, validateVenafiAnnotations(cr.Annotations, field.NewPath("metadata", "annotations"))...)
 
 	w := validateAPIVersion(a.RequestKind)
 
@@ -114,6 +115,31 @@ func ValidateCertificateRequestSpec(crSpec *cmapi.CertificateRequestSpec, fldPat
 		}
 	}
 
+	if crSpec.Backdate != nil && crSpec.Backdate.Duration < 0 {
+		el = append(el, field.Invalid(fldPath.Child("backdate"), crSpec.Backdate.Duration, "backdate must not be negative"))
+	}
+
+	if len(crSpec.Extensions) > 0 {
+		el = append(el, validateExtensions(crSpec.Extensions, fldPath.Child("extensions"))...)
+	}
+
+	return el
+}
+
+func validateExtensions(extensions []cmapi.X509Extension, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	ids := make(map[string]bool)
+	for i, extension := range extensions {
+		if _, err := pki.ParseExtensionID(extension.ID); err != nil {
+			el = append(el, field.Invalid(fldPath.Index(i).Child("id"), extension.ID, err.Error()))
+		} else if ids[extension.ID] {
+			el = append(el, field.Duplicate(fldPath.Index(i).Child("id"), extension.ID))
+		}
+		ids[extension.ID] = true
+		if len(extension.Value) == 0 {
+			el = append(el, field.Required(fldPath.Index(i).Child("value"), "must be specified"))
+		}
+	}
 	return el
 }
 
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -566,6 +567,87 @@ func TestValidateCertificateRequest(t *testing.T) {
 			a:     someAdmissionRequest,
 			wantE: []*field.Error{},
 		},
+		"Test csr with a backdate set": {
+			cr: &cminternal.CertificateRequest{
+				Spec: cminternal.CertificateRequestSpec{
+					Request:   mustGenerateCSR(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com"))),
+					IssuerRef: validIssuerRef,
+					Backdate:  &metav1.Duration{Duration: time.Minute * 5},
+				},
+			},
+			a:     someAdmissionRequest,
+			wantE: []*field.Error{},
+		},
+		"Test csr with a negative backdate": {
+			cr: &cminternal.CertificateRequest{
+				Spec: cminternal.CertificateRequestSpec{
+					Request:   mustGenerateCSR(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com"))),
+					IssuerRef: validIssuerRef,
+					Backdate:  &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			a: someAdmissionRequest,
+			wantE: []*field.Error{
+				field.Invalid(fldPath.Child("backdate"), nil, "backdate must not be negative"),
+			},
+		},
+		"Test csr with additional extensions": {
+			cr: &cminternal.CertificateRequest{
+				Spec: cminternal.CertificateRequestSpec{
+					Request:   mustGenerateCSR(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com"))),
+					IssuerRef: validIssuerRef,
+					Extensions: []cminternal.X509Extension{
+						{ID: "1.3.6.1.4.1.311.20.2", Value: []byte{0x0c, 0x02, 0x43, 0x41}},
+					},
+				},
+			},
+			a:     someAdmissionRequest,
+			wantE: []*field.Error{},
+		},
+		"Test csr with invalid, standard and duplicate additional extensions": {
+			cr: &cminternal.CertificateRequest{
+				Spec: cminternal.CertificateRequestSpec{
+					Request:   mustGenerateCSR(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com"))),
+					IssuerRef: validIssuerRef,
+					Extensions: []cminternal.X509Extension{
+						{ID: "1.3.6.1.4.1.311.20.2", Value: []byte{0x0c, 0x02, 0x43, 0x41}},
+						{ID: "1.3.6.1.4.1.311.20.2", Value: []byte{0x0c, 0x02, 0x43, 0x41}},
+						{ID: "2.5.29.19", Critical: true, Value: []byte{0x30, 0x03, 0x01, 0x01, 0xff}},
+						{ID: "extension"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			wantE: []*field.Error{
+				field.Duplicate(fldPath.Child("extensions").Index(1).Child("id"), nil),
+				field.Invalid(fldPath.Child("extensions").Index(2).Child("id"), nil, "2.5.29.19 is a standard certificate extension which may not be requested"),
+				field.Invalid(fldPath.Child("extensions").Index(3).Child("id"), nil, `invalid object identifier "extension"`),
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "spec.extensions[3].value",
+					Detail: "must be specified",
+				},
+			},
+		},
+		"Test csr with a venafi issuing template but no application": {
+			cr: &cminternal.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{cmapi.VenafiIssuingTemplateAnnotationKey: "my-template"},
+				},
+				Spec: cminternal.CertificateRequestSpec{
+					Request:   mustGenerateCSR(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com"))),
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			wantE: []*field.Error{
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "metadata.annotations[venafi.cert-manager.io/application]",
+					Detail: "must be set when venafi.cert-manager.io/issuing-template is set",
+				},
+			},
+		},
 		"Test csr with double signature usages": {
 			cr: &cminternal.CertificateRequest{
 				Spec: cminternal.CertificateRequestSpec{
@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+// Validation functions for cert-manager ClusterCertificate types.
+
+func ValidateClusterCertificate(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	crt := obj.(*cmapi.ClusterCertificate)
+	allErrs := ValidateClusterCertificateSpec(&crt.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateCertificateAnnotations(crt.Annotations, field.NewPath("metadata", "annotations"))...)
+	return allErrs, validateAPIVersion(a.RequestKind)
+}
+
+func ValidateUpdateClusterCertificate(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	oldCrt := oldObj.(*cmapi.ClusterCertificate)
+	crt := obj.(*cmapi.ClusterCertificate)
+	allErrs := ValidateClusterCertificateSpec(&crt.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateCertificateAnnotations(crt.Annotations, field.NewPath("metadata", "annotations"))...)
+	// The Certificate which issues the ClusterCertificate is not moved to
+	// another namespace, so changing the namespace is not allowed.
+	if oldCrt.Spec.Namespace != crt.Spec.Namespace {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "namespace"), "field is immutable"))
+	}
+	return allErrs, validateAPIVersion(a.RequestKind)
+}
+
+func ValidateClusterCertificateSpec(spec *cmapi.ClusterCertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if spec.Namespace == "" {
+		el = append(el, field.Required(fldPath.Child("namespace"), "must be specified"))
+	} else {
+		for _, msg := range apivalidation.ValidateNamespaceName(spec.Namespace, false) {
+			el = append(el, field.Invalid(fldPath.Child("namespace"), spec.Namespace, msg))
+		}
+	}
+
+	seen := sets.NewString(spec.Namespace)
+	for i, ns := range spec.SecretNamespaces {
+		nsPath := fldPath.Child("secretNamespaces").Index(i)
+		for _, msg := range apivalidation.ValidateNamespaceName(ns, false) {
+			el = append(el, field.Invalid(nsPath, ns, msg))
+		}
+		if seen.Has(ns) {
+			el = append(el, field.Duplicate(nsPath, ns))
+		}
+		seen.Insert(ns)
+	}
+
+	el = append(el, ValidateCertificateSpec(&spec.Template, fldPath.Child("template"))...)
+
+	return el
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestValidateClusterCertificate(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	a := &admissionv1.AdmissionRequest{
+		RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "ClusterCertificate"},
+	}
+	template := cmapi.CertificateSpec{
+		CommonName: "kube-apiserver",
+		SecretName: "abc",
+		IssuerRef:  validIssuerRef,
+	}
+
+	scenarios := map[string]struct {
+		spec cmapi.ClusterCertificateSpec
+		errs []*field.Error
+	}{
+		"valid ClusterCertificate": {
+			spec: cmapi.ClusterCertificateSpec{
+				Namespace:        "kube-system",
+				SecretNamespaces: []string{"ingress", "monitoring"},
+				Template:         template,
+			},
+		},
+		"ClusterCertificate without a namespace": {
+			spec: cmapi.ClusterCertificateSpec{
+				Template: template,
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("namespace"), "must be specified"),
+			},
+		},
+		"ClusterCertificate with an invalid secret namespace": {
+			spec: cmapi.ClusterCertificateSpec{
+				Namespace:        "kube-system",
+				SecretNamespaces: []string{"Not_Valid"},
+				Template:         template,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretNamespaces").Index(0), "Not_Valid", `a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`),
+			},
+		},
+		"ClusterCertificate with duplicate secret namespaces": {
+			spec: cmapi.ClusterCertificateSpec{
+				Namespace:        "kube-system",
+				SecretNamespaces: []string{"ingress", "kube-system", "ingress"},
+				Template:         template,
+			},
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("secretNamespaces").Index(1), "kube-system"),
+				field.Duplicate(fldPath.Child("secretNamespaces").Index(2), "ingress"),
+			},
+		},
+		"ClusterCertificate with an invalid template": {
+			spec: cmapi.ClusterCertificateSpec{
+				Namespace: "kube-system",
+				Template: cmapi.CertificateSpec{
+					CommonName: "kube-apiserver",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("template", "secretName"), "must be specified"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, _ := ValidateClusterCertificate(a, &cmapi.ClusterCertificate{Spec: s.spec})
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected errors %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected error %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateUpdateClusterCertificate(t *testing.T) {
+	a := &admissionv1.AdmissionRequest{
+		RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "ClusterCertificate"},
+	}
+	oldCrt := &cmapi.ClusterCertificate{
+		Spec: cmapi.ClusterCertificateSpec{
+			Namespace: "kube-system",
+			Template: cmapi.CertificateSpec{
+				CommonName: "kube-apiserver",
+				SecretName: "abc",
+				IssuerRef:  validIssuerRef,
+			},
+		},
+	}
+
+	crt := oldCrt.DeepCopy()
+	crt.Spec.SecretNamespaces = []string{"ingress"}
+	if errs, _ := ValidateUpdateClusterCertificate(a, oldCrt, crt); len(errs) != 0 {
+		t.Errorf("Expected no errors when adding a secret namespace but got %v", errs)
+	}
+
+	crt = oldCrt.DeepCopy()
+	crt.Spec.Namespace = "ingress"
+	errs, _ := ValidateUpdateClusterCertificate(a, oldCrt, crt)
+	expectedErrs := field.ErrorList{field.Forbidden(field.NewPath("spec", "namespace"), "field is immutable")}
+	if !reflect.DeepEqual(errs, expectedErrs) {
+		t.Errorf("Expected errors %v but got %v", expectedErrs, errs)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+// Validation functions for cert-manager IssuanceQuota types.
+
+func ValidateIssuanceQuota(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	quota := obj.(*cmapi.IssuanceQuota)
+	return ValidateIssuanceQuotaSpec(&quota.Spec, field.NewPath("spec")), validateAPIVersion(a.RequestKind)
+}
+
+func ValidateUpdateIssuanceQuota(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	quota := obj.(*cmapi.IssuanceQuota)
+	return ValidateIssuanceQuotaSpec(&quota.Spec, field.NewPath("spec")), validateAPIVersion(a.RequestKind)
+}
+
+func ValidateIssuanceQuotaSpec(spec *cmapi.IssuanceQuotaSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if spec.MaxCertificates != nil && *spec.MaxCertificates < 0 {
+		el = append(el, field.Invalid(fldPath.Child("maxCertificates"), *spec.MaxCertificates, "must not be negative"))
+	}
+	if spec.MaxCertificateRequests != nil && *spec.MaxCertificateRequests < 0 {
+		el = append(el, field.Invalid(fldPath.Child("maxCertificateRequests"), *spec.MaxCertificateRequests, "must not be negative"))
+	}
+	if spec.CertificateRequestsPeriod != nil && spec.CertificateRequestsPeriod.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("certificateRequestsPeriod"), spec.CertificateRequestsPeriod.Duration.String(), "must be greater than zero"))
+	}
+
+	return el
+}
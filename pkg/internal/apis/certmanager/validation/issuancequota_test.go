@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestValidateIssuanceQuota(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	a := &admissionv1.AdmissionRequest{
+		RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "IssuanceQuota"},
+	}
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	scenarios := map[string]struct {
+		spec cmapi.IssuanceQuotaSpec
+		errs []*field.Error
+	}{
+		"empty quota": {},
+		"valid quota": {
+			spec: cmapi.IssuanceQuotaSpec{
+				MaxCertificates:           int32Ptr(100),
+				MaxCertificateRequests:    int32Ptr(0),
+				CertificateRequestsPeriod: &metav1.Duration{Duration: time.Hour * 24},
+			},
+		},
+		"negative limits": {
+			spec: cmapi.IssuanceQuotaSpec{
+				MaxCertificates:        int32Ptr(-1),
+				MaxCertificateRequests: int32Ptr(-2),
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("maxCertificates"), int32(-1), "must not be negative"),
+				field.Invalid(fldPath.Child("maxCertificateRequests"), int32(-2), "must not be negative"),
+			},
+		},
+		"zero period": {
+			spec: cmapi.IssuanceQuotaSpec{
+				CertificateRequestsPeriod: &metav1.Duration{},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("certificateRequestsPeriod"), "0s", "must be greater than zero"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, _ := ValidateIssuanceQuota(a, &cmapi.IssuanceQuota{Spec: s.spec})
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected errors %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected error %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
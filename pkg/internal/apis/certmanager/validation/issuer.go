@@ -19,18 +19,25 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	apivalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
 	cmacme "github.com/jetstack/cert-manager/pkg/internal/apis/acme"
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/validation/util"
 	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 // Validation functions for cert-manager Issuer types.
@@ -51,7 +58,83 @@ func ValidateUpdateIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.O
 }
 
 func ValidateIssuerSpec(iss *certmanager.IssuerSpec, fldPath *field.Path) (field.ErrorList, validation.WarningList) {
-	return ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el, warnings := ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	if iss.Policy != nil {
+		el = append(el, ValidateIssuerPolicy(iss.Policy, fldPath.Child("policy"))...)
+	}
+	if iss.CertificateDefaults != nil {
+		el = append(el, ValidateIssuerCertificateDefaults(iss.CertificateDefaults, fldPath.Child("certificateDefaults"))...)
+	}
+	return el, warnings
+}
+
+func ValidateIssuerCertificateDefaults(defaults *certmanager.IssuerCertificateDefaults, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if defaults.Duration != nil && defaults.Duration.Duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("duration"), defaults.Duration.Duration, fmt.Sprintf("certificate duration must be greater than %s", cmapi.MinimumCertificateDuration)))
+	}
+	for i, u := range defaults.Usages {
+		_, kok := apiutil.KeyUsageType(cmapi.KeyUsage(u))
+		_, ekok := apiutil.ExtKeyUsageType(cmapi.KeyUsage(u))
+		if !kok && !ekok {
+			el = append(el, field.Invalid(fldPath.Child("usages").Index(i), u, "unknown keyusage"))
+		}
+	}
+	return el
+}
+
+func ValidateIssuerPolicy(policy *certmanager.IssuerPolicy, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if policy.MinDuration != nil && policy.MinDuration.Duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("minDuration"), policy.MinDuration.Duration, fmt.Sprintf("certificate duration must be greater than %s", cmapi.MinimumCertificateDuration)))
+	}
+	if policy.MaxDuration != nil && policy.MinDuration != nil && policy.MaxDuration.Duration < policy.MinDuration.Duration {
+		el = append(el, field.Invalid(fldPath.Child("maxDuration"), policy.MaxDuration.Duration, "must not be shorter than minDuration"))
+	}
+	switch policy.DurationEnforcement {
+	case "", certmanager.RejectDurationEnforcement, certmanager.ClampDurationEnforcement:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("durationEnforcement"), policy.DurationEnforcement,
+			[]string{string(certmanager.RejectDurationEnforcement), string(certmanager.ClampDurationEnforcement)}))
+	}
+	for i, alg := range policy.AllowedPrivateKeyAlgorithms {
+		switch alg {
+		case certmanager.RSAKeyAlgorithm, certmanager.ECDSAKeyAlgorithm, certmanager.Ed25519KeyAlgorithm:
+		default:
+			el = append(el, field.NotSupported(fldPath.Child("allowedPrivateKeyAlgorithms").Index(i), alg,
+				[]string{string(certmanager.RSAKeyAlgorithm), string(certmanager.ECDSAKeyAlgorithm), string(certmanager.Ed25519KeyAlgorithm)}))
+		}
+	}
+	for i, id := range policy.AllowedExtensions {
+		if _, err := pki.ParseExtensionID(id); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("allowedExtensions").Index(i), id, err.Error()))
+		}
+	}
+	if policy.RequesterIdentity != nil {
+		el = append(el, validateRequesterIdentityPolicy(policy.RequesterIdentity, fldPath.Child("requesterIdentity"))...)
+	}
+	return el
+}
+
+func validateRequesterIdentityPolicy(identity *certmanager.RequesterIdentityPolicy, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(identity.CommonName) == 0 && len(identity.DNSNames) == 0 && len(identity.URIs) == 0 {
+		el = append(el, field.Required(fldPath, "at least one of commonName, dnsNames or uris must be set"))
+	}
+	if err := apiutil.ValidateRequesterIdentityTemplate(identity.CommonName); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("commonName"), identity.CommonName, err.Error()))
+	}
+	for i, dnsName := range identity.DNSNames {
+		if err := apiutil.ValidateRequesterIdentityTemplate(dnsName); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("dnsNames").Index(i), dnsName, err.Error()))
+		}
+	}
+	for i, uri := range identity.URIs {
+		if err := apiutil.ValidateRequesterIdentityTemplate(uri); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("uris").Index(i), uri, err.Error()))
+		}
+	}
+	return el
 }
 
 func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (field.ErrorList, validation.WarningList) {
@@ -99,6 +182,22 @@ func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (f
 			el = append(el, ValidateVenafiIssuerConfig(iss.Venafi, fldPath.Child("venafi"))...)
 		}
 	}
+	if iss.SCEP != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("scep"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateSCEPIssuerConfig(iss.SCEP, fldPath.Child("scep"))...)
+		}
+	}
+	if iss.CMP != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("cmp"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateCMPIssuerConfig(iss.CMP, fldPath.Child("cmp"))...)
+		}
+	}
 	if numConfigs == 0 {
 		el = append(el, field.Required(fldPath, "at least one issuer must be configured"))
 	}
@@ -115,6 +214,17 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 	if len(iss.Server) == 0 {
 		el = append(el, field.Required(fldPath.Child("server"), "acme server URL is a required field"))
 	}
+	if len(iss.CABundle) > 0 {
+		if iss.SkipTLSVerify {
+			el = append(el, field.Forbidden(fldPath.Child("caBundle"), "may not be specified when skipTLSVerify is true"))
+		}
+		if ok := x509.NewCertPool().AppendCertsFromPEM(iss.CABundle); !ok {
+			el = append(el, field.Invalid(fldPath.Child("caBundle"), "", "Specified CA bundle is invalid"))
+		}
+	}
+	if ref := iss.ClientCertificateSecretRef; ref != nil && len(ref.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("clientCertificateSecretRef", "name"), "secret name is required"))
+	}
 
 	if eab := iss.ExternalAccountBinding; eab != nil {
 		eabFldPath := fldPath.Child("externalAccountBinding")
@@ -129,8 +239,17 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		}
 	}
 
+	solverNames := make(map[string]bool)
 	for i, sol := range iss.Solvers {
 		el = append(el, ValidateACMEIssuerChallengeSolverConfig(&sol, fldPath.Child("solvers").Index(i))...)
+		if len(sol.Name) == 0 {
+			continue
+		}
+		// solvers are pinned by name, so names must identify a single solver
+		if solverNames[sol.Name] {
+			el = append(el, field.Duplicate(fldPath.Child("solvers").Index(i).Child("name"), sol.Name))
+		}
+		solverNames[sol.Name] = true
 	}
 
 	return el, warnings
@@ -192,6 +311,9 @@ func ValidateACMEIssuerChallengeSolverHTTP01IngressConfig(ingress *cmacme.ACMECh
 	default:
 		el = append(el, field.Invalid(fldPath.Child("serviceType"), ingress.ServiceType, `must be empty, "ClusterIP" or "NodePort"`))
 	}
+	if ingress.SharedSolver != nil && ingress.SharedSolver.Replicas != nil && *ingress.SharedSolver.Replicas < 0 {
+		el = append(el, field.Invalid(fldPath.Child("sharedSolver", "replicas"), *ingress.SharedSolver.Replicas, "must not be negative"))
+	}
 
 	return el
 }
@@ -220,11 +342,106 @@ func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) fiel
 			el = append(el, field.Invalid(fldPath.Child("ocspServer").Index(i), ocspURL, "must be a valid URL, e.g., http://ocsp.int-x3.letsencrypt.org"))
 		}
 	}
+	if iss.KMS != nil {
+		el = append(el, ValidateCAIssuerKMSConfig(iss.KMS, fldPath.Child("kms"))...)
+	}
+	if iss.PKCS11 != nil {
+		if iss.KMS != nil {
+			el = append(el, field.Forbidden(fldPath.Child("pkcs11"), "may not specify both kms and pkcs11"))
+		} else {
+			el = append(el, ValidateCAIssuerPKCS11Config(iss.PKCS11, fldPath.Child("pkcs11"))...)
+		}
+	}
+	return el
+}
+
+func ValidateCAIssuerPKCS11Config(cfg *certmanager.CAIssuerPKCS11, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(cfg.ModulePath) == 0 {
+		el = append(el, field.Required(fldPath.Child("modulePath"), ""))
+	} else if !filepath.IsAbs(cfg.ModulePath) {
+		el = append(el, field.Invalid(fldPath.Child("modulePath"), cfg.ModulePath, "must be an absolute path"))
+	}
+	if cfg.Slot < 0 {
+		el = append(el, field.Invalid(fldPath.Child("slot"), cfg.Slot, "must not be negative"))
+	}
+	el = append(el, ValidateSecretKeySelector(&cfg.PIN, fldPath.Child("pinSecretRef"))...)
+	return el
+}
+
+func ValidateCAIssuerKMSConfig(kms *certmanager.CAIssuerKMS, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	numProviders := 0
+	if kms.AWS != nil {
+		numProviders++
+		awsPath := fldPath.Child("aws")
+		if len(kms.AWS.KeyID) == 0 {
+			el = append(el, field.Required(awsPath.Child("keyID"), ""))
+		}
+		if len(kms.AWS.Region) == 0 {
+			el = append(el, field.Required(awsPath.Child("region"), ""))
+		}
+		if kms.AWS.SecretAccessKey != nil {
+			el = append(el, ValidateSecretKeySelector(kms.AWS.SecretAccessKey, awsPath.Child("secretAccessKeySecretRef"))...)
+			if len(kms.AWS.AccessKeyID) == 0 {
+				el = append(el, field.Required(awsPath.Child("accessKeyID"), "must be specified when secretAccessKeySecretRef is specified"))
+			}
+		} else if len(kms.AWS.AccessKeyID) > 0 {
+			el = append(el, field.Required(awsPath.Child("secretAccessKeySecretRef"), "must be specified when accessKeyID is specified"))
+		}
+	}
+	if kms.GoogleCloud != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("googleCloud"), "may not specify more than one KMS"))
+		} else {
+			numProviders++
+			gcPath := fldPath.Child("googleCloud")
+			if len(kms.GoogleCloud.KeyVersion) == 0 {
+				el = append(el, field.Required(gcPath.Child("keyVersion"), ""))
+			}
+			if kms.GoogleCloud.ServiceAccount != nil {
+				el = append(el, ValidateSecretKeySelector(kms.GoogleCloud.ServiceAccount, gcPath.Child("serviceAccountSecretRef"))...)
+			}
+		}
+	}
+	if numProviders == 0 {
+		el = append(el, field.Required(fldPath, "no KMS configured"))
+	}
 	return el
 }
 
 func ValidateSelfSignedIssuerConfig(iss *certmanager.SelfSignedIssuer, fldPath *field.Path) field.ErrorList {
-	return nil
+	el := field.ErrorList{}
+	if iss.BootstrapCA != nil {
+		el = append(el, ValidateSelfSignedBootstrapCA(iss.BootstrapCA, fldPath.Child("bootstrapCA"))...)
+	}
+	return el
+}
+
+func ValidateSelfSignedBootstrapCA(cfg *certmanager.SelfSignedBootstrapCA, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(cfg.IssuerName) == 0 {
+		el = append(el, field.Required(fldPath.Child("issuerName"), ""))
+	} else {
+		// the name of the root CA resources is suffixed with "-root"
+		for _, msg := range apivalidation.IsDNS1123Subdomain(cfg.IssuerName + "-root") {
+			el = append(el, field.Invalid(fldPath.Child("issuerName"), cfg.IssuerName, msg))
+		}
+	}
+	if len(cfg.CommonName) == 0 {
+		el = append(el, field.Required(fldPath.Child("commonName"), ""))
+	}
+	if cfg.RootDuration != nil && cfg.RootDuration.Duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("rootDuration"), cfg.RootDuration.Duration, fmt.Sprintf("certificate duration must be greater than %s", cmapi.MinimumCertificateDuration)))
+	}
+	if cfg.IntermediateDuration != nil {
+		if cfg.IntermediateDuration.Duration < cmapi.MinimumCertificateDuration {
+			el = append(el, field.Invalid(fldPath.Child("intermediateDuration"), cfg.IntermediateDuration.Duration, fmt.Sprintf("certificate duration must be greater than %s", cmapi.MinimumCertificateDuration)))
+		} else if cfg.RootDuration != nil && cfg.IntermediateDuration.Duration >= cfg.RootDuration.Duration {
+			el = append(el, field.Invalid(fldPath.Child("intermediateDuration"), cfg.IntermediateDuration.Duration, "must be shorter than rootDuration"))
+		}
+	}
+	return el
 }
 
 func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path) field.ErrorList {
@@ -235,6 +452,12 @@ func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path
 	if len(iss.Path) == 0 {
 		el = append(el, field.Required(fldPath.Child("path"), ""))
 	}
+	switch iss.SigningMode {
+	case "", certmanager.RoleVaultSigningMode, certmanager.VerbatimVaultSigningMode:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("signingMode"), iss.SigningMode,
+			[]string{string(certmanager.RoleVaultSigningMode), string(certmanager.VerbatimVaultSigningMode)}))
+	}
 
 	// check if caBundle is valid
 	certs := iss.CABundle
@@ -285,6 +508,63 @@ func ValidateVenafiIssuerConfig(iss *certmanager.VenafiIssuer, fldPath *field.Pa
 	return el
 }
 
+func ValidateSCEPIssuerConfig(iss *certmanager.SCEPIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(iss.URL) == 0 {
+		el = append(el, field.Required(fldPath.Child("url"), ""))
+	} else if u, err := url.Parse(iss.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		el = append(el, field.Invalid(fldPath.Child("url"), iss.URL, "must be an absolute http or https URL"))
+	}
+	if iss.ChallengePasswordSecretRef != nil {
+		el = append(el, ValidateSecretKeySelector(iss.ChallengePasswordSecretRef, fldPath.Child("challengePasswordSecretRef"))...)
+	}
+	if len(iss.CAFingerprint) > 0 {
+		if _, err := pki.ParseSHA256Fingerprint(iss.CAFingerprint); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("caFingerprint"), iss.CAFingerprint, err.Error()))
+		}
+	}
+	if len(iss.CABundle) > 0 {
+		if ok := x509.NewCertPool().AppendCertsFromPEM(iss.CABundle); !ok {
+			el = append(el, field.Invalid(fldPath.Child("caBundle"), "", "Specified CA bundle is invalid"))
+		}
+	}
+
+	return el
+}
+
+func ValidateCMPIssuerConfig(iss *certmanager.CMPIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(iss.URL) == 0 {
+		el = append(el, field.Required(fldPath.Child("url"), ""))
+	} else if u, err := url.Parse(iss.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		el = append(el, field.Invalid(fldPath.Child("url"), iss.URL, "must be an absolute http or https URL"))
+	}
+
+	switch {
+	case iss.MAC != nil && iss.Signature != nil:
+		el = append(el, field.Forbidden(fldPath.Child("signature"), "may not specify more than one of mac or signature"))
+	case iss.MAC != nil:
+		if len(iss.MAC.Reference) == 0 {
+			el = append(el, field.Required(fldPath.Child("mac", "reference"), ""))
+		}
+		el = append(el, ValidateSecretKeySelector(&iss.MAC.SecretRef, fldPath.Child("mac", "secretRef"))...)
+	case iss.Signature != nil:
+		if len(iss.Signature.SecretRef.Name) == 0 {
+			el = append(el, field.Required(fldPath.Child("signature", "secretRef", "name"), "secret name is required"))
+		}
+	default:
+		el = append(el, field.Required(fldPath, "one of mac or signature must be specified"))
+	}
+
+	if len(iss.CABundle) > 0 {
+		if ok := x509.NewCertPool().AppendCertsFromPEM(iss.CABundle); !ok {
+			el = append(el, field.Invalid(fldPath.Child("caBundle"), "", "Specified CA bundle is invalid"))
+		}
+	}
+
+	return el
+}
+
 // This list must be kept in sync with pkg/issuer/acme/dns/rfc2136/rfc2136.go
 var supportedTSIGAlgorithms = []string{
 	"HMACMD5",
@@ -293,6 +573,17 @@ var supportedTSIGAlgorithms = []string{
 	"HMACSHA512",
 }
 
+// This list must be kept in sync with pkg/issuer/acme/dns/ovh/ovh.go
+var supportedOVHEndpoints = []string{
+	"ovh-eu",
+	"ovh-ca",
+	"ovh-us",
+	"kimsufi-eu",
+	"kimsufi-ca",
+	"soyoustart-eu",
+	"soyoustart-ca",
+}
+
 func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -390,8 +681,21 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if p.Cloudflare.APIKey != nil && p.Cloudflare.APIToken != nil {
 				el = append(el, field.Forbidden(fldPath.Child("cloudflare"), "apiKeySecretRef and apiTokenSecretRef cannot both be specified"))
 			}
-			if p.Cloudflare.APIKey == nil && p.Cloudflare.APIToken == nil {
-				el = append(el, field.Required(fldPath.Child("cloudflare"), "apiKeySecretRef or apiTokenSecretRef is required"))
+			zones := make([]string, 0, len(p.Cloudflare.ZoneAPITokens))
+			for zone := range p.Cloudflare.ZoneAPITokens {
+				zones = append(zones, zone)
+			}
+			sort.Strings(zones)
+			for _, zone := range zones {
+				zoneFldPath := fldPath.Child("cloudflare", "zoneAPITokenSecretRefs").Key(zone)
+				if len(strings.Trim(zone, ".")) == 0 {
+					el = append(el, field.Invalid(zoneFldPath, zone, "zone must not be empty"))
+				}
+				apiToken := p.Cloudflare.ZoneAPITokens[zone]
+				el = append(el, ValidateSecretKeySelector(&apiToken, zoneFldPath)...)
+			}
+			if p.Cloudflare.APIKey == nil && p.Cloudflare.APIToken == nil && len(p.Cloudflare.ZoneAPITokens) == 0 {
+				el = append(el, field.Required(fldPath.Child("cloudflare"), "apiKeySecretRef, apiTokenSecretRef or zoneAPITokenSecretRefs is required"))
 			}
 			if len(p.Cloudflare.Email) == 0 && p.Cloudflare.APIKey != nil {
 				el = append(el, field.Required(fldPath.Child("cloudflare", "email"), ""))
@@ -425,6 +729,77 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, ValidateSecretKeySelector(&p.DigitalOcean.Token, fldPath.Child("digitalocean", "tokenSecretRef"))...)
 		}
 	}
+	if p.OVH != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("ovh"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if len(p.OVH.Endpoint) > 0 && !isSupportedOVHEndpoint(p.OVH.Endpoint) {
+				if u, err := url.Parse(p.OVH.Endpoint); err != nil || u.Scheme != "https" || u.Host == "" {
+					el = append(el, field.Invalid(fldPath.Child("ovh", "endpoint"), p.OVH.Endpoint, fmt.Sprintf("endpoint must be one of %s or an https URL", strings.Join(supportedOVHEndpoints, ", "))))
+				}
+			}
+			if len(p.OVH.ApplicationKey) == 0 {
+				el = append(el, field.Required(fldPath.Child("ovh", "applicationKey"), ""))
+			}
+			el = append(el, ValidateSecretKeySelector(&p.OVH.ApplicationSecret, fldPath.Child("ovh", "applicationSecretSecretRef"))...)
+			el = append(el, ValidateSecretKeySelector(&p.OVH.ConsumerKey, fldPath.Child("ovh", "consumerKeySecretRef"))...)
+		}
+	}
+	if p.Gandi != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("gandi"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			el = append(el, ValidateSecretKeySelector(&p.Gandi.PersonalAccessToken, fldPath.Child("gandi", "personalAccessTokenSecretRef"))...)
+		}
+	}
+	if p.Infoblox != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("infoblox"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			el = append(el, validateDNS01ServerURL(p.Infoblox.Server, fldPath.Child("infoblox", "server"))...)
+			if len(p.Infoblox.Username) == 0 {
+				el = append(el, field.Required(fldPath.Child("infoblox", "username"), ""))
+			}
+			el = append(el, ValidateSecretKeySelector(&p.Infoblox.Password, fldPath.Child("infoblox", "passwordSecretRef"))...)
+			if p.Infoblox.TTL < 0 {
+				el = append(el, field.Invalid(fldPath.Child("infoblox", "ttl"), p.Infoblox.TTL, "ttl must not be negative"))
+			}
+			if len(p.Infoblox.CABundle) > 0 {
+				if ok := x509.NewCertPool().AppendCertsFromPEM(p.Infoblox.CABundle); !ok {
+					el = append(el, field.Invalid(fldPath.Child("infoblox", "caBundle"), "", "Specified CA bundle is invalid"))
+				}
+			}
+		}
+	}
+	if p.BlueCat != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("bluecat"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			el = append(el, validateDNS01ServerURL(p.BlueCat.Server, fldPath.Child("bluecat", "server"))...)
+			if len(p.BlueCat.Username) == 0 {
+				el = append(el, field.Required(fldPath.Child("bluecat", "username"), ""))
+			}
+			el = append(el, ValidateSecretKeySelector(&p.BlueCat.Password, fldPath.Child("bluecat", "passwordSecretRef"))...)
+			if len(p.BlueCat.Configuration) == 0 {
+				el = append(el, field.Required(fldPath.Child("bluecat", "configuration"), ""))
+			}
+			if len(p.BlueCat.View) == 0 {
+				el = append(el, field.Required(fldPath.Child("bluecat", "view"), ""))
+			}
+			if p.BlueCat.TTL < 0 {
+				el = append(el, field.Invalid(fldPath.Child("bluecat", "ttl"), p.BlueCat.TTL, "ttl must not be negative"))
+			}
+			if len(p.BlueCat.CABundle) > 0 {
+				if ok := x509.NewCertPool().AppendCertsFromPEM(p.BlueCat.CABundle); !ok {
+					el = append(el, field.Invalid(fldPath.Child("bluecat", "caBundle"), "", "Specified CA bundle is invalid"))
+				}
+			}
+		}
+	}
 	if p.RFC2136 != nil {
 		if numProviders > 0 {
 			el = append(el, field.Forbidden(fldPath.Child("rfc2136"), "may not specify more than one provider type"))
@@ -478,6 +853,27 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 	return el
 }
 
+// validateDNS01ServerURL validates the server of a DNS01 provider, which must
+// be an https URL.
+func validateDNS01ServerURL(server string, fldPath *field.Path) field.ErrorList {
+	if len(server) == 0 {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	if u, err := url.Parse(server); err != nil || u.Scheme != "https" || u.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, server, "server must be an https URL")}
+	}
+	return nil
+}
+
+func isSupportedOVHEndpoint(endpoint string) bool {
+	for _, e := range supportedOVHEndpoints {
+		if e == endpoint {
+			return true
+		}
+	}
+	return false
+}
+
 func ValidateSecretKeySelector(sks *cmmeta.SecretKeySelector, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	if sks.Name == "" {
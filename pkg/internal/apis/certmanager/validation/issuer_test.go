@@ -20,13 +20,16 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apivalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	cmapiv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmapiv1alpha2 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha2"
@@ -94,6 +97,23 @@ func TestValidateVaultIssuerConfig(t *testing.T) {
 				field.Invalid(fldPath.Child("caBundle"), "", "Specified CA bundle is invalid"),
 			},
 		},
+		"vault issuer with verbatim signing mode": {
+			spec: &cmapi.VaultIssuer{
+				Server:      "something",
+				Path:        "a/b/c",
+				SigningMode: cmapi.VerbatimVaultSigningMode,
+			},
+		},
+		"vault issuer with unsupported signing mode": {
+			spec: &cmapi.VaultIssuer{
+				Server:      "something",
+				Path:        "a/b/c",
+				SigningMode: "Other",
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("signingMode"), cmapi.VaultSigningMode("Other"), []string{"Role", "Verbatim"}),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -129,6 +149,28 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				field.Required(fldPath.Child("server"), "acme server URL is a required field"),
 			},
 		},
+		"acme issuer with invalid CA bundle and skipTLSVerify": {
+			spec: &cmacme.ACMEIssuer{
+				Server:        "valid-server",
+				PrivateKey:    validSecretKeyRef,
+				SkipTLSVerify: true,
+				CABundle:      []byte("invalid"),
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("caBundle"), "may not be specified when skipTLSVerify is true"),
+				field.Invalid(fldPath.Child("caBundle"), "", "Specified CA bundle is invalid"),
+			},
+		},
+		"acme issuer with client certificate missing secret name": {
+			spec: &cmacme.ACMEIssuer{
+				Server:                     "valid-server",
+				PrivateKey:                 validSecretKeyRef,
+				ClientCertificateSecretRef: &cmmeta.LocalObjectReference{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("clientCertificateSecretRef", "name"), "secret name is required"),
+			},
+		},
 		"acme solver without any config": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -142,6 +184,36 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				field.Required(fldPath.Child("solvers").Index(0), "no solver type configured"),
 			},
 		},
+		"acme solvers with duplicate names": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						Name: "clouddns",
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+					},
+					{
+						Name: "other",
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+					},
+					{
+						Name: "clouddns",
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("solvers").Index(2).Child("name"), "clouddns"),
+			},
+		},
 		"acme solver with valid dns01 config": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -580,6 +652,27 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 				field.Invalid(fldPath.Child("ingress", "serviceType"), corev1.ServiceType("InvalidServiceType"), `must be empty, "ClusterIP" or "NodePort"`),
 			},
 		},
+		"acme issuer with valid http01 shared solver config": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{
+						Replicas: pointer.Int32Ptr(2),
+					},
+				},
+			},
+		},
+		"acme issuer with negative http01 shared solver replicas": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{
+						Replicas: pointer.Int32Ptr(-1),
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ingress", "sharedSolver", "replicas"), int32(-1), "must not be negative"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -680,7 +773,32 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("cloudflare"), "apiKeySecretRef or apiTokenSecretRef is required"),
+				field.Required(fldPath.Child("cloudflare"), "apiKeySecretRef, apiTokenSecretRef or zoneAPITokenSecretRefs is required"),
+			},
+		},
+		"valid cloudflare zone api tokens": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+					ZoneAPITokens: map[string]cmmeta.SecretKeySelector{
+						"example.com":     validSecretKeyRef,
+						"sub.example.com": validSecretKeyRef,
+					},
+				},
+			},
+		},
+		"invalid cloudflare zone api tokens": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+					ZoneAPITokens: map[string]cmmeta.SecretKeySelector{
+						".":           validSecretKeyRef,
+						"example.com": {},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("cloudflare", "zoneAPITokenSecretRefs").Key("."), ".", "zone must not be empty"),
+				field.Required(fldPath.Child("cloudflare", "zoneAPITokenSecretRefs").Key("example.com").Child("name"), "secret name is required"),
+				field.Required(fldPath.Child("cloudflare", "zoneAPITokenSecretRefs").Key("example.com").Child("key"), "secret key is required"),
 			},
 		},
 		"both cloudflare api token and key specified": {
@@ -705,6 +823,109 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Required(fldPath.Child("cloudflare", "email"), ""),
 			},
 		},
+		"valid ovh config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				OVH: &cmacme.ACMEIssuerDNS01ProviderOVH{
+					Endpoint:          "ovh-ca",
+					ApplicationKey:    "valid",
+					ApplicationSecret: validSecretKeyRef,
+					ConsumerKey:       validSecretKeyRef,
+				},
+			},
+		},
+		"valid ovh config with endpoint URL": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				OVH: &cmacme.ACMEIssuerDNS01ProviderOVH{
+					Endpoint:          "https://eu.api.ovh.com/1.0",
+					ApplicationKey:    "valid",
+					ApplicationSecret: validSecretKeyRef,
+					ConsumerKey:       validSecretKeyRef,
+				},
+			},
+		},
+		"invalid ovh config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				OVH: &cmacme.ACMEIssuerDNS01ProviderOVH{
+					Endpoint:    "ovh-mars",
+					ConsumerKey: validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ovh", "endpoint"), "ovh-mars", "endpoint must be one of ovh-eu, ovh-ca, ovh-us, kimsufi-eu, kimsufi-ca, soyoustart-eu, soyoustart-ca or an https URL"),
+				field.Required(fldPath.Child("ovh", "applicationKey"), ""),
+				field.Required(fldPath.Child("ovh", "applicationSecretSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("ovh", "applicationSecretSecretRef", "key"), "secret key is required"),
+			},
+		},
+		"valid gandi config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Gandi: &cmacme.ACMEIssuerDNS01ProviderGandi{
+					PersonalAccessToken: validSecretKeyRef,
+				},
+			},
+		},
+		"missing gandi personal access token": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Gandi: &cmacme.ACMEIssuerDNS01ProviderGandi{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("gandi", "personalAccessTokenSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("gandi", "personalAccessTokenSecretRef", "key"), "secret key is required"),
+			},
+		},
+		"valid infoblox config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Infoblox: &cmacme.ACMEIssuerDNS01ProviderInfoblox{
+					Server:   "https://gm.example.com",
+					Username: "admin",
+					Password: validSecretKeyRef,
+					View:     "external",
+					TTL:      120,
+				},
+			},
+		},
+		"invalid infoblox config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Infoblox: &cmacme.ACMEIssuerDNS01ProviderInfoblox{
+					Server:   "http://gm.example.com",
+					Password: validSecretKeyRef,
+					TTL:      -1,
+					CABundle: []byte("not a certificate"),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("infoblox", "server"), "http://gm.example.com", "server must be an https URL"),
+				field.Required(fldPath.Child("infoblox", "username"), ""),
+				field.Invalid(fldPath.Child("infoblox", "ttl"), int32(-1), "ttl must not be negative"),
+				field.Invalid(fldPath.Child("infoblox", "caBundle"), "", "Specified CA bundle is invalid"),
+			},
+		},
+		"valid bluecat config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				BlueCat: &cmacme.ACMEIssuerDNS01ProviderBlueCat{
+					Server:        "https://bam.example.com",
+					Username:      "api",
+					Password:      validSecretKeyRef,
+					Configuration: "main",
+					View:          "internal",
+					Zone:          "example.com",
+				},
+			},
+		},
+		"invalid bluecat config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				BlueCat: &cmacme.ACMEIssuerDNS01ProviderBlueCat{
+					Username: "api",
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("bluecat", "server"), ""),
+				field.Required(fldPath.Child("bluecat", "passwordSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("bluecat", "passwordSecretRef", "key"), "secret key is required"),
+				field.Required(fldPath.Child("bluecat", "configuration"), ""),
+				field.Required(fldPath.Child("bluecat", "view"), ""),
+			},
+		},
 		"missing route53 region": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{},
@@ -1204,6 +1425,391 @@ func TestValidateSecretKeySelector(t *testing.T) {
 	}
 }
 
+func TestValidateIssuerPolicy(t *testing.T) {
+	fldPath := field.NewPath("")
+	scenarios := map[string]struct {
+		policy *cmapi.IssuerPolicy
+		errs   []*field.Error
+	}{
+		"empty policy": {
+			policy: &cmapi.IssuerPolicy{},
+		},
+		"valid policy": {
+			policy: &cmapi.IssuerPolicy{
+				MinDuration:                 &metav1.Duration{Duration: time.Hour * 24},
+				MaxDuration:                 &metav1.Duration{Duration: time.Hour * 24 * 90},
+				DurationEnforcement:         cmapi.ClampDurationEnforcement,
+				AllowedPrivateKeyAlgorithms: []cmapi.PrivateKeyAlgorithm{cmapi.ECDSAKeyAlgorithm, cmapi.Ed25519KeyAlgorithm},
+				RequesterIdentity: &cmapi.RequesterIdentityPolicy{
+					CommonName: "${serviceaccount.name}",
+					URIs:       []string{"spiffe://cluster.local/ns/${namespace}/sa/${serviceaccount.name}"},
+				},
+			},
+		},
+		"empty requester identity": {
+			policy: &cmapi.IssuerPolicy{
+				RequesterIdentity: &cmapi.RequesterIdentityPolicy{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("requesterIdentity"), "at least one of commonName, dnsNames or uris must be set"),
+			},
+		},
+		"requester identity template with an unsupported variable": {
+			policy: &cmapi.IssuerPolicy{
+				RequesterIdentity: &cmapi.RequesterIdentityPolicy{
+					DNSNames: []string{"${serviceaccount.name}.svc", "${groups}.svc"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("requesterIdentity", "dnsNames").Index(1), "${groups}.svc", "unsupported variables: groups"),
+			},
+		},
+		"invalid allowed extension": {
+			policy: &cmapi.IssuerPolicy{
+				AllowedExtensions: []string{"1.3.6.1.4.1.311.20.2", "2.5.29.17"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("allowedExtensions").Index(1), "2.5.29.17", "2.5.29.17 is a standard certificate extension which may not be requested"),
+			},
+		},
+		"minimum duration too short": {
+			policy: &cmapi.IssuerPolicy{
+				MinDuration: &metav1.Duration{Duration: time.Minute},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("minDuration"), time.Minute, "certificate duration must be greater than 1h0m0s"),
+			},
+		},
+		"maximum duration shorter than minimum duration": {
+			policy: &cmapi.IssuerPolicy{
+				MinDuration: &metav1.Duration{Duration: time.Hour * 24},
+				MaxDuration: &metav1.Duration{Duration: time.Hour * 2},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("maxDuration"), time.Hour*2, "must not be shorter than minDuration"),
+			},
+		},
+		"unsupported duration enforcement and key algorithm": {
+			policy: &cmapi.IssuerPolicy{
+				DurationEnforcement:         "Ignore",
+				AllowedPrivateKeyAlgorithms: []cmapi.PrivateKeyAlgorithm{cmapi.RSAKeyAlgorithm, "DSA"},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("durationEnforcement"), cmapi.DurationEnforcement("Ignore"), []string{"Reject", "Clamp"}),
+				field.NotSupported(fldPath.Child("allowedPrivateKeyAlgorithms").Index(1), cmapi.PrivateKeyAlgorithm("DSA"), []string{"RSA", "ECDSA", "Ed25519"}),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateIssuerPolicy(s.policy, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateIssuerCertificateDefaults(t *testing.T) {
+	fldPath := field.NewPath("")
+	scenarios := map[string]struct {
+		defaults *cmapi.IssuerCertificateDefaults
+		errs     []*field.Error
+	}{
+		"valid defaults": {
+			defaults: &cmapi.IssuerCertificateDefaults{
+				Duration:      &metav1.Duration{Duration: time.Hour * 24 * 30},
+				Usages:        []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+				Organizations: []string{"Example Org"},
+			},
+		},
+		"duration too short": {
+			defaults: &cmapi.IssuerCertificateDefaults{
+				Duration: &metav1.Duration{Duration: time.Minute},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("duration"), time.Minute, "certificate duration must be greater than 1h0m0s"),
+			},
+		},
+		"unknown usage": {
+			defaults: &cmapi.IssuerCertificateDefaults{
+				Usages: []cmapi.KeyUsage{cmapi.UsageServerAuth, "nonexistent"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages").Index(1), cmapi.KeyUsage("nonexistent"), "unknown keyusage"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateIssuerCertificateDefaults(s.defaults, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSelfSignedBootstrapCA(t *testing.T) {
+	fldPath := field.NewPath("")
+	scenarios := map[string]struct {
+		cfg  *cmapi.SelfSignedBootstrapCA
+		errs []*field.Error
+	}{
+		"valid bootstrap CA": {
+			cfg: &cmapi.SelfSignedBootstrapCA{IssuerName: "ca", CommonName: "Example"},
+		},
+		"valid bootstrap CA with durations": {
+			cfg: &cmapi.SelfSignedBootstrapCA{
+				IssuerName:           "ca",
+				CommonName:           "Example",
+				RootDuration:         &metav1.Duration{Duration: time.Hour * 24 * 365},
+				IntermediateDuration: &metav1.Duration{Duration: time.Hour * 24 * 90},
+			},
+		},
+		"missing issuerName and commonName": {
+			cfg: &cmapi.SelfSignedBootstrapCA{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("issuerName"), ""),
+				field.Required(fldPath.Child("commonName"), ""),
+			},
+		},
+		"invalid issuerName": {
+			cfg: &cmapi.SelfSignedBootstrapCA{IssuerName: "CA", CommonName: "Example"},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issuerName"), "CA", apivalidation.IsDNS1123Subdomain("CA-root")[0]),
+			},
+		},
+		"durations too short": {
+			cfg: &cmapi.SelfSignedBootstrapCA{
+				IssuerName:           "ca",
+				CommonName:           "Example",
+				RootDuration:         &metav1.Duration{Duration: time.Minute},
+				IntermediateDuration: &metav1.Duration{Duration: time.Minute},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("rootDuration"), time.Minute, "certificate duration must be greater than 1h0m0s"),
+				field.Invalid(fldPath.Child("intermediateDuration"), time.Minute, "certificate duration must be greater than 1h0m0s"),
+			},
+		},
+		"intermediate duration not shorter than root duration": {
+			cfg: &cmapi.SelfSignedBootstrapCA{
+				IssuerName:           "ca",
+				CommonName:           "Example",
+				RootDuration:         &metav1.Duration{Duration: time.Hour * 24},
+				IntermediateDuration: &metav1.Duration{Duration: time.Hour * 24},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("intermediateDuration"), time.Hour*24, "must be shorter than rootDuration"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateSelfSignedBootstrapCA(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCAIssuerKMSConfig(t *testing.T) {
+	fldPath := field.NewPath("")
+	validSecretKeyRef := &cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "name"},
+		Key:                  "key",
+	}
+	scenarios := map[string]struct {
+		cfg  *cmapi.CAIssuerKMS
+		errs []*field.Error
+	}{
+		"valid aws kms with ambient credentials": {
+			cfg: &cmapi.CAIssuerKMS{
+				AWS: &cmapi.CAIssuerAWSKMS{KeyID: "alias/ca", Region: "eu-west-1"},
+			},
+		},
+		"valid aws kms with static credentials": {
+			cfg: &cmapi.CAIssuerKMS{
+				AWS: &cmapi.CAIssuerAWSKMS{
+					KeyID:           "alias/ca",
+					Region:          "eu-west-1",
+					AccessKeyID:     "AKIA",
+					SecretAccessKey: validSecretKeyRef,
+				},
+			},
+		},
+		"aws kms missing keyID and region": {
+			cfg: &cmapi.CAIssuerKMS{
+				AWS: &cmapi.CAIssuerAWSKMS{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("aws", "keyID"), ""),
+				field.Required(fldPath.Child("aws", "region"), ""),
+			},
+		},
+		"aws kms with accessKeyID but no secretAccessKeySecretRef": {
+			cfg: &cmapi.CAIssuerKMS{
+				AWS: &cmapi.CAIssuerAWSKMS{KeyID: "alias/ca", Region: "eu-west-1", AccessKeyID: "AKIA"},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("aws", "secretAccessKeySecretRef"), "must be specified when accessKeyID is specified"),
+			},
+		},
+		"aws kms with secretAccessKeySecretRef but no accessKeyID": {
+			cfg: &cmapi.CAIssuerKMS{
+				AWS: &cmapi.CAIssuerAWSKMS{KeyID: "alias/ca", Region: "eu-west-1", SecretAccessKey: validSecretKeyRef},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("aws", "accessKeyID"), "must be specified when secretAccessKeySecretRef is specified"),
+			},
+		},
+		"valid google cloud kms": {
+			cfg: &cmapi.CAIssuerKMS{
+				GoogleCloud: &cmapi.CAIssuerGoogleCloudKMS{
+					KeyVersion:     "projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1",
+					ServiceAccount: validSecretKeyRef,
+				},
+			},
+		},
+		"google cloud kms missing keyVersion": {
+			cfg: &cmapi.CAIssuerKMS{
+				GoogleCloud: &cmapi.CAIssuerGoogleCloudKMS{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("googleCloud", "keyVersion"), ""),
+			},
+		},
+		"multiple kms configured": {
+			cfg: &cmapi.CAIssuerKMS{
+				AWS:         &cmapi.CAIssuerAWSKMS{KeyID: "alias/ca", Region: "eu-west-1"},
+				GoogleCloud: &cmapi.CAIssuerGoogleCloudKMS{KeyVersion: "projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("googleCloud"), "may not specify more than one KMS"),
+			},
+		},
+		"no kms configured": {
+			cfg: &cmapi.CAIssuerKMS{},
+			errs: []*field.Error{
+				field.Required(fldPath, "no KMS configured"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateCAIssuerKMSConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCAIssuerPKCS11Config(t *testing.T) {
+	fldPath := field.NewPath("")
+	validPKCS11 := func() *cmapi.CAIssuerPKCS11 {
+		return &cmapi.CAIssuerPKCS11{
+			ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+			Slot:       0,
+			PIN: cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: "name"},
+				Key:                  "pin",
+			},
+		}
+	}
+	scenarios := map[string]struct {
+		cfg  *cmapi.CAIssuer
+		errs []*field.Error
+	}{
+		"valid pkcs11": {
+			cfg: &cmapi.CAIssuer{SecretName: "ca", PKCS11: validPKCS11()},
+		},
+		"valid pkcs11 with key label": {
+			cfg: &cmapi.CAIssuer{SecretName: "ca", PKCS11: func() *cmapi.CAIssuerPKCS11 {
+				cfg := validPKCS11()
+				cfg.KeyLabel = "root"
+				return cfg
+			}()},
+		},
+		"pkcs11 missing module path and pin": {
+			cfg: &cmapi.CAIssuer{SecretName: "ca", PKCS11: &cmapi.CAIssuerPKCS11{}},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("pkcs11", "modulePath"), ""),
+				field.Required(fldPath.Child("pkcs11", "pinSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("pkcs11", "pinSecretRef", "key"), "secret key is required"),
+			},
+		},
+		"pkcs11 with relative module path and negative slot": {
+			cfg: &cmapi.CAIssuer{SecretName: "ca", PKCS11: func() *cmapi.CAIssuerPKCS11 {
+				cfg := validPKCS11()
+				cfg.ModulePath = "libsofthsm2.so"
+				cfg.Slot = -1
+				return cfg
+			}()},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("pkcs11", "modulePath"), "libsofthsm2.so", "must be an absolute path"),
+				field.Invalid(fldPath.Child("pkcs11", "slot"), int64(-1), "must not be negative"),
+			},
+		},
+		"both kms and pkcs11 configured": {
+			cfg: &cmapi.CAIssuer{
+				SecretName: "ca",
+				KMS: &cmapi.CAIssuerKMS{
+					AWS: &cmapi.CAIssuerAWSKMS{KeyID: "alias/ca", Region: "eu-west-1"},
+				},
+				PKCS11: validPKCS11(),
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("pkcs11"), "may not specify both kms and pkcs11"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateCAIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateVenafiIssuerConfig(t *testing.T) {
 	fldPath := field.NewPath("test")
 	scenarios := map[string]struct {
@@ -1267,6 +1873,139 @@ func TestValidateVenafiIssuerConfig(t *testing.T) {
 	}
 }
 
+func TestValidateSCEPIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.SCEPIssuer
+		errs []*field.Error
+	}{
+		"valid": {
+			cfg: &cmapi.SCEPIssuer{
+				URL: "https://ndes.example.com/certsrv/mscep/mscep.dll",
+				ChallengePasswordSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "scep"},
+					Key:                  "password",
+				},
+				CAFingerprint: "9F:86:D0:81:88:4C:7D:65:9A:2F:EA:A0:C5:5A:D0:15:A3:BF:4F:1B:2B:0B:82:2C:D1:5D:6C:15:B0:F0:0A:08",
+			},
+		},
+		"missing url": {
+			cfg: &cmapi.SCEPIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("url"), ""),
+			},
+		},
+		"relative url": {
+			cfg: &cmapi.SCEPIssuer{URL: "ndes.example.com/scep"},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("url"), "ndes.example.com/scep", "must be an absolute http or https URL"),
+			},
+		},
+		"invalid fields": {
+			cfg: &cmapi.SCEPIssuer{
+				URL: "http://scep.example.com/scep",
+				ChallengePasswordSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "scep"},
+				},
+				CAFingerprint: "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3",
+				CABundle:      []byte("invalid"),
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("challengePasswordSecretRef", "key"), "secret key is required"),
+				field.Invalid(fldPath.Child("caFingerprint"), "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3", "fingerprint must be 32 bytes long, got 20"),
+				field.Invalid(fldPath.Child("caBundle"), "", "Specified CA bundle is invalid"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateSCEPIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCMPIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	macSecretRef := cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "cmp"},
+		Key:                  "secret",
+	}
+	scenarios := map[string]struct {
+		cfg  *cmapi.CMPIssuer
+		errs []*field.Error
+	}{
+		"valid mac protection": {
+			cfg: &cmapi.CMPIssuer{
+				URL: "https://ejbca.example.com/ejbca/publicweb/cmp/cert-manager",
+				MAC: &cmapi.CMPMACProtection{Reference: "cert-manager", SecretRef: macSecretRef},
+			},
+		},
+		"valid signature protection": {
+			cfg: &cmapi.CMPIssuer{
+				URL: "https://ejbca.example.com/ejbca/publicweb/cmp/cert-manager",
+				Signature: &cmapi.CMPSignatureProtection{
+					SecretRef: cmmeta.LocalObjectReference{Name: "cmp-ra"},
+				},
+			},
+		},
+		"missing url and protection": {
+			cfg: &cmapi.CMPIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("url"), ""),
+				field.Required(fldPath, "one of mac or signature must be specified"),
+			},
+		},
+		"both mac and signature protection": {
+			cfg: &cmapi.CMPIssuer{
+				URL:       "http://cmp.example.com/cmp",
+				MAC:       &cmapi.CMPMACProtection{Reference: "cert-manager", SecretRef: macSecretRef},
+				Signature: &cmapi.CMPSignatureProtection{SecretRef: cmmeta.LocalObjectReference{Name: "cmp-ra"}},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("signature"), "may not specify more than one of mac or signature"),
+			},
+		},
+		"invalid fields": {
+			cfg: &cmapi.CMPIssuer{
+				URL:      "cmp.example.com/cmp",
+				MAC:      &cmapi.CMPMACProtection{SecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "cmp"}}},
+				CABundle: []byte("invalid"),
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("url"), "cmp.example.com/cmp", "must be an absolute http or https URL"),
+				field.Required(fldPath.Child("mac", "reference"), ""),
+				field.Required(fldPath.Child("mac", "secretRef", "key"), "secret key is required"),
+				field.Invalid(fldPath.Child("caBundle"), "", "Specified CA bundle is invalid"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateCMPIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateVenafiTPP(t *testing.T) {
 	fldPath := field.NewPath("test")
 	scenarios := map[string]struct {
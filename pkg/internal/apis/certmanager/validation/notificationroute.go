@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net/url"
+	"text/template"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+// Validation functions for cert-manager NotificationRoute types.
+
+func ValidateNotificationRoute(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	route := obj.(*cmapi.NotificationRoute)
+	return ValidateNotificationRouteSpec(&route.Spec, field.NewPath("spec")), validateAPIVersion(a.RequestKind)
+}
+
+func ValidateUpdateNotificationRoute(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	route := obj.(*cmapi.NotificationRoute)
+	return ValidateNotificationRouteSpec(&route.Spec, field.NewPath("spec")), validateAPIVersion(a.RequestKind)
+}
+
+func ValidateNotificationRouteSpec(spec *cmapi.NotificationRouteSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if spec.Selector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(spec.Selector, fldPath.Child("selector"))...)
+	}
+	for i, event := range spec.Events {
+		switch event {
+		case cmapi.NotificationEventExpiring, cmapi.NotificationEventIssuanceFailed, cmapi.NotificationEventRevoked:
+		default:
+			el = append(el, field.NotSupported(fldPath.Child("events").Index(i), event, []string{
+				string(cmapi.NotificationEventExpiring), string(cmapi.NotificationEventIssuanceFailed), string(cmapi.NotificationEventRevoked),
+			}))
+		}
+	}
+	for i, threshold := range spec.ExpiryThresholds {
+		if threshold.Duration <= 0 {
+			el = append(el, field.Invalid(fldPath.Child("expiryThresholds").Index(i), threshold.Duration.String(), "must be greater than zero"))
+		}
+	}
+	if spec.IssuanceFailureThreshold != nil && *spec.IssuanceFailureThreshold < 1 {
+		el = append(el, field.Invalid(fldPath.Child("issuanceFailureThreshold"), *spec.IssuanceFailureThreshold, "must be at least 1"))
+	}
+
+	el = append(el, validateNotificationWebhook(&spec.Webhook, fldPath.Child("webhook"))...)
+
+	return el
+}
+
+func validateNotificationWebhook(webhook *cmapi.NotificationWebhook, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	switch webhook.Format {
+	case "", cmapi.NotificationFormatJSON, cmapi.NotificationFormatSlack:
+	case cmapi.NotificationFormatPagerDuty:
+		if webhook.RoutingKeySecretRef == nil {
+			el = append(el, field.Required(fldPath.Child("routingKeySecretRef"), "required when format is PagerDuty"))
+		}
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("format"), webhook.Format, []string{
+			string(cmapi.NotificationFormatJSON), string(cmapi.NotificationFormatSlack), string(cmapi.NotificationFormatPagerDuty),
+		}))
+	}
+
+	switch {
+	case webhook.URL != "" && webhook.URLSecretRef != nil:
+		el = append(el, field.Forbidden(fldPath.Child("urlSecretRef"), "only one of url or urlSecretRef may be set"))
+	case webhook.URL == "" && webhook.URLSecretRef == nil && webhook.Format != cmapi.NotificationFormatPagerDuty:
+		el = append(el, field.Required(fldPath.Child("url"), "one of url or urlSecretRef must be set"))
+	}
+	if webhook.URL != "" {
+		if u, err := url.Parse(webhook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			el = append(el, field.Invalid(fldPath.Child("url"), webhook.URL, "must be an absolute http or https URL"))
+		}
+	}
+	if webhook.URLSecretRef != nil {
+		el = append(el, ValidateSecretKeySelector(webhook.URLSecretRef, fldPath.Child("urlSecretRef"))...)
+	}
+	if webhook.RoutingKeySecretRef != nil {
+		el = append(el, ValidateSecretKeySelector(webhook.RoutingKeySecretRef, fldPath.Child("routingKeySecretRef"))...)
+	}
+
+	if webhook.Template != "" {
+		// The notifications controller provides the json function when
+		// executing the template.
+		funcs := template.FuncMap{"json": func(interface{}) (string, error) { return "", nil }}
+		if _, err := template.New("payload").Funcs(funcs).Parse(webhook.Template); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("template"), webhook.Template, err.Error()))
+		}
+	}
+
+	return el
+}
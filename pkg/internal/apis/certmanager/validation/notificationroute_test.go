@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+)
+
+func TestValidateNotificationRoute(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	a := &admissionv1.AdmissionRequest{
+		RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "NotificationRoute"},
+	}
+	int32Ptr := func(i int32) *int32 { return &i }
+	secretRef := &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "secret"}, Key: "key"}
+
+	scenarios := map[string]struct {
+		spec cmapi.NotificationRouteSpec
+		errs []*field.Error
+	}{
+		"valid route": {
+			spec: cmapi.NotificationRouteSpec{
+				Events:                   []cmapi.NotificationEvent{cmapi.NotificationEventExpiring},
+				ExpiryThresholds:         []metav1.Duration{{Duration: time.Hour}},
+				IssuanceFailureThreshold: int32Ptr(1),
+				Webhook: cmapi.NotificationWebhook{
+					URL:      "https://example.com/hook",
+					Template: `{"text": {{ .Message | json }}}`,
+				},
+			},
+		},
+		"valid PagerDuty route without url": {
+			spec: cmapi.NotificationRouteSpec{
+				Webhook: cmapi.NotificationWebhook{Format: cmapi.NotificationFormatPagerDuty, RoutingKeySecretRef: secretRef},
+			},
+		},
+		"missing url": {
+			errs: []*field.Error{
+				field.Required(fldPath.Child("webhook", "url"), "one of url or urlSecretRef must be set"),
+			},
+		},
+		"both url and urlSecretRef": {
+			spec: cmapi.NotificationRouteSpec{
+				Webhook: cmapi.NotificationWebhook{URL: "https://example.com", URLSecretRef: secretRef},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("webhook", "urlSecretRef"), "only one of url or urlSecretRef may be set"),
+			},
+		},
+		"PagerDuty without routing key": {
+			spec: cmapi.NotificationRouteSpec{
+				Webhook: cmapi.NotificationWebhook{Format: cmapi.NotificationFormatPagerDuty},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("webhook", "routingKeySecretRef"), "required when format is PagerDuty"),
+			},
+		},
+		"invalid thresholds and url": {
+			spec: cmapi.NotificationRouteSpec{
+				ExpiryThresholds:         []metav1.Duration{{}},
+				IssuanceFailureThreshold: int32Ptr(0),
+				Webhook:                  cmapi.NotificationWebhook{URL: "example.com"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("expiryThresholds").Index(0), "0s", "must be greater than zero"),
+				field.Invalid(fldPath.Child("issuanceFailureThreshold"), int32(0), "must be at least 1"),
+				field.Invalid(fldPath.Child("webhook", "url"), "example.com", "must be an absolute http or https URL"),
+			},
+		},
+		"invalid template": {
+			spec: cmapi.NotificationRouteSpec{
+				Webhook: cmapi.NotificationWebhook{URL: "https://example.com", Template: "{{ .Message"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("webhook", "template"), "{{ .Message", "template: payload:1: unclosed action"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, _ := ValidateNotificationRoute(a, &cmapi.NotificationRoute{Spec: s.spec})
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected errors %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected error %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/validation/util"
 )
@@ -63,7 +64,7 @@ func newApproval(scheme *runtime.Scheme) *approval {
 	}
 }
 
-func (a *approval) Init(client kubernetes.Interface) {
+func (a *approval) Init(client kubernetes.Interface, _ cmclient.Interface) {
 	a.sarclient = client.AuthorizationV1().SubjectAccessReviews()
 	a.discoverclient = client.Discovery()
 }
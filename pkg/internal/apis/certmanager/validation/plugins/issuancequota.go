@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+)
+
+// issuanceQuota is responsible for rejecting Certificates and
+// CertificateRequests which would exceed an IssuanceQuota in their namespace.
+type issuanceQuota struct {
+	cmclient cmclient.Interface
+	clock    clock.Clock
+}
+
+func newIssuanceQuota() *issuanceQuota {
+	return &issuanceQuota{clock: clock.RealClock{}}
+}
+
+func (q *issuanceQuota) Init(_ kubernetes.Interface, cmclient cmclient.Interface) {
+	q.cmclient = cmclient
+}
+
+// Validate will return an error if creating the Certificate or
+// CertificateRequest would exceed the limits of any IssuanceQuota in its
+// namespace. Only creates are reviewed, so resources which already exceed a
+// quota may still be updated or deleted. If the IssuanceQuota resource is not
+// installed, nothing is rejected.
+func (q *issuanceQuota) Validate(ctx context.Context, req *admissionv1.AdmissionRequest, _, _ runtime.Object) *field.Error {
+	if req.Operation != admissionv1.Create || req.RequestKind.Group != certmanager.GroupName {
+		return nil
+	}
+	if req.RequestKind.Kind != cmapi.CertificateKind && req.RequestKind.Kind != cmapi.CertificateRequestKind {
+		return nil
+	}
+
+	fldPath := field.NewPath("metadata", "namespace")
+
+	if q.cmclient == nil {
+		return field.InternalError(fldPath, errors.New("issuance quota validation not initialised"))
+	}
+
+	quotas, err := q.cmclient.CertmanagerV1().IssuanceQuotas(req.Namespace).List(ctx, metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return field.InternalError(fldPath, err)
+	}
+
+	for i := range quotas.Items {
+		quota := &quotas.Items[i]
+
+		var (
+			used  int32
+			limit *int32
+			kind  string
+			err   error
+		)
+		switch req.RequestKind.Kind {
+		case cmapi.CertificateKind:
+			limit, kind = quota.Spec.MaxCertificates, "Certificates"
+			if limit != nil {
+				used, err = q.countCertificates(ctx, req.Namespace)
+			}
+		case cmapi.CertificateRequestKind:
+			limit, kind = quota.Spec.MaxCertificateRequests, "CertificateRequests"
+			if limit != nil {
+				used, err = q.countCertificateRequests(ctx, quota)
+			}
+		}
+		if err != nil {
+			return field.InternalError(fldPath, err)
+		}
+
+		if limit != nil && used >= *limit {
+			return field.Forbidden(fldPath, fmt.Sprintf("exceeded IssuanceQuota %q: %d of %d %s used", quota.Name, used, *limit, kind))
+		}
+	}
+
+	return nil
+}
+
+func (q *issuanceQuota) countCertificates(ctx context.Context, namespace string) (int32, error) {
+	crts, err := q.cmclient.CertmanagerV1().Certificates(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return int32(len(crts.Items)), nil
+}
+
+// countCertificateRequests returns the number of CertificateRequests created
+// in the namespace of the quota within its period.
+func (q *issuanceQuota) countCertificateRequests(ctx context.Context, quota *cmapi.IssuanceQuota) (int32, error) {
+	reqs, err := q.cmclient.CertmanagerV1().CertificateRequests(quota.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	since := q.clock.Now().Add(-apiutil.IssuanceQuotaCertificateRequestsPeriod(quota))
+	var count int32
+	for _, req := range reqs.Items {
+		if req.CreationTimestamp.Time.After(since) {
+			count++
+		}
+	}
+	return count, nil
+}
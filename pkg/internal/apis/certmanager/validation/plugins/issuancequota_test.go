@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestIssuanceQuotaValidate(t *testing.T) {
+	now := time.Now()
+
+	quota := func(maxCrts, maxReqs *int32) *cmapi.IssuanceQuota {
+		return &cmapi.IssuanceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "ns"},
+			Spec: cmapi.IssuanceQuotaSpec{
+				MaxCertificates:        maxCrts,
+				MaxCertificateRequests: maxReqs,
+			},
+		}
+	}
+	crt := func(name string) *cmapi.Certificate {
+		return &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+	}
+	cr := func(name string, age time.Duration) *cmapi.CertificateRequest {
+		return &cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "ns",
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		}}
+	}
+
+	req := func(op admissionv1.Operation, kind string) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			Namespace: "ns",
+			RequestKind: &metav1.GroupVersionKind{
+				Group: "cert-manager.io",
+				Kind:  kind,
+			},
+		}
+	}
+
+	fldPath := field.NewPath("metadata", "namespace")
+
+	tests := map[string]struct {
+		req      *admissionv1.AdmissionRequest
+		obj      runtime.Object
+		existing []runtime.Object
+
+		expErr *field.Error
+	}{
+		"if there are no quotas, exit nil": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      &internalcmapi.Certificate{},
+			existing: []runtime.Object{crt("a")},
+		},
+		"if the request is an UPDATE operation, exit nil": {
+			req:      req(admissionv1.Update, "Certificate"),
+			obj:      &internalcmapi.Certificate{},
+			existing: []runtime.Object{quota(pointer.Int32Ptr(1), nil), crt("a")},
+		},
+		"if the Certificate quota is not reached, exit nil": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      &internalcmapi.Certificate{},
+			existing: []runtime.Object{quota(pointer.Int32Ptr(2), nil), crt("a")},
+		},
+		"if the Certificate quota is reached, error": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      &internalcmapi.Certificate{},
+			existing: []runtime.Object{quota(pointer.Int32Ptr(1), nil), crt("a")},
+			expErr:   field.Forbidden(fldPath, `exceeded IssuanceQuota "quota": 1 of 1 Certificates used`),
+		},
+		"if the quota does not limit Certificates, exit nil": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      &internalcmapi.Certificate{},
+			existing: []runtime.Object{quota(nil, pointer.Int32Ptr(0)), crt("a")},
+		},
+		"if the CertificateRequest quota is reached, error": {
+			req:      req(admissionv1.Create, "CertificateRequest"),
+			obj:      &internalcmapi.CertificateRequest{},
+			existing: []runtime.Object{quota(nil, pointer.Int32Ptr(1)), cr("a", time.Minute)},
+			expErr:   field.Forbidden(fldPath, `exceeded IssuanceQuota "quota": 1 of 1 CertificateRequests used`),
+		},
+		"if CertificateRequests were created before the period, exit nil": {
+			req:      req(admissionv1.Create, "CertificateRequest"),
+			obj:      &internalcmapi.CertificateRequest{},
+			existing: []runtime.Object{quota(nil, pointer.Int32Ptr(1)), cr("a", 2*time.Hour)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			q := newIssuanceQuota()
+			q.clock = fakeclock.NewFakeClock(now)
+			q.Init(nil, cmfake.NewSimpleClientset(test.existing...))
+
+			err := q.Validate(context.TODO(), test.req, nil, test.obj)
+			if !reflect.DeepEqual(test.expErr, err) {
+				t.Errorf("unexpected error, exp=%#+v got=%#+v",
+					test.expErr, err)
+			}
+		})
+	}
+}
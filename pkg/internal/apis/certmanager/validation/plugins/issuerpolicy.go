@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// issuerPolicy is responsible for rejecting Certificates and
+// CertificateRequests which violate the policy of the issuer they reference,
+// so that the violation is reported when the resource is applied rather than
+// when the issuer attempts to sign it.
+type issuerPolicy struct {
+	cmclient cmclient.Interface
+}
+
+func newIssuerPolicy() *issuerPolicy {
+	return &issuerPolicy{}
+}
+
+func (p *issuerPolicy) Init(_ kubernetes.Interface, cmclient cmclient.Interface) {
+	p.cmclient = cmclient
+}
+
+// Validate will return an error if the Certificate or CertificateRequest being
+// created requests a duration or private key algorithm which is not allowed by
+// the policy of the referenced issuer. Durations are not rejected if the
+// issuer clamps them instead. Updates are only reviewed if they change the
+// referenced issuer, the duration or the private key algorithm. Resources
+// referencing an issuer which does not exist are not rejected.
+func (p *issuerPolicy) Validate(ctx context.Context, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error {
+	if req.RequestKind.Group != certmanager.GroupName {
+		return nil
+	}
+
+	var (
+		issuerRef  cmmeta.ObjectReference
+		duration   *metav1.Duration
+		algorithm  cmapi.PrivateKeyAlgorithm
+		algPath    *field.Path
+		specPath   = field.NewPath("spec")
+		hasChanged = req.Operation == admissionv1.Create
+	)
+
+	switch req.RequestKind.Kind {
+	case cmapi.CertificateKind:
+		if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+			return nil
+		}
+		crt, ok := obj.(*internalcmapi.Certificate)
+		if !ok {
+			return nil
+		}
+		if oldCrt, ok := oldObj.(*internalcmapi.Certificate); ok && req.Operation == admissionv1.Update {
+			hasChanged = oldCrt.Spec.IssuerRef != crt.Spec.IssuerRef ||
+				!apiequality.Semantic.DeepEqual(oldCrt.Spec.Duration, crt.Spec.Duration) ||
+				certificateKeyAlgorithm(oldCrt) != certificateKeyAlgorithm(crt)
+		}
+		issuerRef, duration = crt.Spec.IssuerRef, crt.Spec.Duration
+		algorithm, algPath = certificateKeyAlgorithm(crt), specPath.Child("privateKey", "algorithm")
+
+	case cmapi.CertificateRequestKind:
+		// The spec of CertificateRequests is immutable
+		if req.Operation != admissionv1.Create {
+			return nil
+		}
+		cr, ok := obj.(*internalcmapi.CertificateRequest)
+		if !ok {
+			return nil
+		}
+		issuerRef, duration = cr.Spec.IssuerRef, cr.Spec.Duration
+		// An invalid request is rejected by the CertificateRequest validation
+		if csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request); err == nil {
+			algorithm, algPath = pki.PrivateKeyAlgorithmForPublicKeyAlgorithm(csr.PublicKeyAlgorithm), specPath.Child("request")
+		}
+
+	default:
+		return nil
+	}
+
+	if !hasChanged {
+		return nil
+	}
+
+	if p.cmclient == nil {
+		return field.InternalError(specPath, errors.New("issuer policy validation not initialised"))
+	}
+
+	issuerObj, err := p.getIssuer(ctx, issuerRef, req.Namespace)
+	if apierrors.IsNotFound(err) || issuerObj == nil {
+		return nil
+	}
+	if err != nil {
+		return field.InternalError(specPath.Child("issuerRef"), err)
+	}
+
+	policy := issuerObj.GetSpec().Policy
+	if policy == nil {
+		return nil
+	}
+
+	if algPath != nil && !apiutil.IssuerPolicyAllowsKeyAlgorithm(policy, algorithm) {
+		return field.Invalid(algPath, algorithm,
+			fmt.Sprintf("private key algorithm is not allowed by the policy of %s %q", issuerKind(issuerRef), issuerRef.Name))
+	}
+
+	requested := apiutil.DefaultCertDuration(duration)
+	if _, err := apiutil.IssuerPolicyDuration(policy, requested); err != nil {
+		return field.Invalid(specPath.Child("duration"), requested,
+			fmt.Sprintf("%v (%s %q)", err, issuerKind(issuerRef), issuerRef.Name))
+	}
+
+	return nil
+}
+
+// getIssuer returns the referenced issuer, or nil if the reference is not to
+// a cert-manager issuer.
+func (p *issuerPolicy) getIssuer(ctx context.Context, ref cmmeta.ObjectReference, namespace string) (cmapi.GenericIssuer, error) {
+	if ref.Group != "" && ref.Group != certmanager.GroupName {
+		return nil, nil
+	}
+
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		return p.cmclient.CertmanagerV1().Issuers(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	case cmapi.ClusterIssuerKind:
+		return p.cmclient.CertmanagerV1().ClusterIssuers().Get(ctx, ref.Name, metav1.GetOptions{})
+	default:
+		return nil, nil
+	}
+}
+
+func certificateKeyAlgorithm(crt *internalcmapi.Certificate) cmapi.PrivateKeyAlgorithm {
+	if crt.Spec.PrivateKey == nil || crt.Spec.PrivateKey.Algorithm == "" {
+		return cmapi.RSAKeyAlgorithm
+	}
+	return cmapi.PrivateKeyAlgorithm(crt.Spec.PrivateKey.Algorithm)
+}
+
+func issuerKind(ref cmmeta.ObjectReference) string {
+	if ref.Kind == "" {
+		return cmapi.IssuerKind
+	}
+	return ref.Kind
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"reflect"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+func TestIssuerPolicyValidate(t *testing.T) {
+	policy := cmapi.IssuerPolicy{
+		MaxDuration:                 &metav1.Duration{Duration: time.Hour * 24 * 30},
+		AllowedPrivateKeyAlgorithms: []cmapi.PrivateKeyAlgorithm{cmapi.ECDSAKeyAlgorithm},
+	}
+	issuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ns"},
+		Spec:       cmapi.IssuerSpec{Policy: &policy},
+	}
+	clampPolicy := policy
+	clampPolicy.DurationEnforcement = cmapi.ClampDurationEnforcement
+	clusterIssuer := &cmapi.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca"},
+		Spec:       cmapi.IssuerSpec{Policy: &clampPolicy},
+	}
+	noPolicyIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "no-policy", Namespace: "ns"},
+	}
+
+	newCrt := func(issuerRef cmmeta.ObjectReference, duration time.Duration, algorithm internalcmapi.PrivateKeyAlgorithm) *internalcmapi.Certificate {
+		return &internalcmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "crt", Namespace: "ns"},
+			Spec: internalcmapi.CertificateSpec{
+				IssuerRef:  issuerRef,
+				Duration:   &metav1.Duration{Duration: duration},
+				PrivateKey: &internalcmapi.CertificatePrivateKey{Algorithm: algorithm},
+			},
+		}
+	}
+
+	ecKey, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := pki.EncodeCSR(&x509.CertificateRequest{Subject: pkix.Name{CommonName: "test"}}, ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+	newCR := func(issuerRef cmmeta.ObjectReference, duration time.Duration) *internalcmapi.CertificateRequest {
+		return &internalcmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "cr", Namespace: "ns"},
+			Spec: internalcmapi.CertificateRequestSpec{
+				IssuerRef: issuerRef,
+				Duration:  &metav1.Duration{Duration: duration},
+				Request:   csrPEM,
+			},
+		}
+	}
+
+	req := func(op admissionv1.Operation, kind string) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			Namespace: "ns",
+			RequestKind: &metav1.GroupVersionKind{
+				Group: "cert-manager.io",
+				Kind:  kind,
+			},
+		}
+	}
+
+	issuerRef := cmmeta.ObjectReference{Name: "ca"}
+	clusterIssuerRef := cmmeta.ObjectReference{Name: "ca", Kind: "ClusterIssuer"}
+	day := time.Hour * 24
+
+	tests := map[string]struct {
+		req         *admissionv1.AdmissionRequest
+		oldObj, obj runtime.Object
+
+		expErr *field.Error
+	}{
+		"if the request is not for a Certificate or CertificateRequest, exit nil": {
+			req: req(admissionv1.Create, "Issuer"),
+			obj: &internalcmapi.Issuer{},
+		},
+		"if the referenced issuer does not exist, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt(cmmeta.ObjectReference{Name: "not-found"}, day*90, internalcmapi.RSAKeyAlgorithm),
+		},
+		"if the referenced issuer is not a cert-manager issuer, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt(cmmeta.ObjectReference{Name: "ca", Group: "example.com"}, day*90, internalcmapi.RSAKeyAlgorithm),
+		},
+		"if the referenced issuer has no policy, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt(cmmeta.ObjectReference{Name: "no-policy"}, day*90, internalcmapi.RSAKeyAlgorithm),
+		},
+		"if the Certificate is allowed by the policy, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt(issuerRef, day, internalcmapi.ECDSAKeyAlgorithm),
+		},
+		"if the Certificate's private key algorithm is not allowed, error": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt(issuerRef, day, internalcmapi.RSAKeyAlgorithm),
+			expErr: field.Invalid(field.NewPath("spec", "privateKey", "algorithm"), cmapi.RSAKeyAlgorithm,
+				`private key algorithm is not allowed by the policy of Issuer "ca"`),
+		},
+		"if the Certificate's duration is not allowed, error": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt(issuerRef, day*90, internalcmapi.ECDSAKeyAlgorithm),
+			expErr: field.Invalid(field.NewPath("spec", "duration"), day*90,
+				`duration 2160h0m0s is longer than the maximum duration 720h0m0s allowed by the issuer (Issuer "ca")`),
+		},
+		"if the Certificate's duration is clamped by the issuer, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt(clusterIssuerRef, day*90, internalcmapi.ECDSAKeyAlgorithm),
+		},
+		"if an update does not change the issuer, duration or private key algorithm, exit nil": {
+			req:    req(admissionv1.Update, "Certificate"),
+			oldObj: newCrt(issuerRef, day*90, internalcmapi.ECDSAKeyAlgorithm),
+			obj:    newCrt(issuerRef, day*90, internalcmapi.ECDSAKeyAlgorithm),
+		},
+		"if an update changes the duration to one which is not allowed, error": {
+			req:    req(admissionv1.Update, "Certificate"),
+			oldObj: newCrt(issuerRef, day, internalcmapi.ECDSAKeyAlgorithm),
+			obj:    newCrt(issuerRef, day*90, internalcmapi.ECDSAKeyAlgorithm),
+			expErr: field.Invalid(field.NewPath("spec", "duration"), day*90,
+				`duration 2160h0m0s is longer than the maximum duration 720h0m0s allowed by the issuer (Issuer "ca")`),
+		},
+		"if the CertificateRequest is allowed by the policy, exit nil": {
+			req: req(admissionv1.Create, "CertificateRequest"),
+			obj: newCR(issuerRef, day),
+		},
+		"if the CertificateRequest's duration is not allowed, error": {
+			req: req(admissionv1.Create, "CertificateRequest"),
+			obj: newCR(issuerRef, day*90),
+			expErr: field.Invalid(field.NewPath("spec", "duration"), day*90,
+				`duration 2160h0m0s is longer than the maximum duration 720h0m0s allowed by the issuer (Issuer "ca")`),
+		},
+		"if the CertificateRequest is updated, exit nil": {
+			req:    req(admissionv1.Update, "CertificateRequest"),
+			oldObj: newCR(issuerRef, day*90),
+			obj:    newCR(issuerRef, day*90),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := newIssuerPolicy()
+			p.Init(nil, cmfake.NewSimpleClientset(issuer, clusterIssuer, noPolicyIssuer))
+
+			err := p.Validate(context.TODO(), test.req, test.oldObj, test.obj)
+			if !reflect.DeepEqual(test.expErr, err) {
+				t.Errorf("unexpected error, exp=%#+v got=%#+v",
+					test.expErr, err)
+			}
+		})
+	}
+}
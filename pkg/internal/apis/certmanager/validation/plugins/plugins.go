@@ -23,16 +23,42 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/kubernetes"
+
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 )
 
 // Plugin is an admission plugin that will run during admission webhook events.
 type Plugin interface {
-	Init(client kubernetes.Interface)
+	Init(client kubernetes.Interface, cmclient cmclient.Interface)
 	Validate(ctx context.Context, admissionSpec *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error
 }
 
+// Startable is implemented by plugins which need to run in the background
+// until the webhook is stopped, such as to keep an informer cache up to date.
+type Startable interface {
+	// Start starts the plugin in the background, and blocks until the
+	// plugin is ready to validate requests or stopCh is closed.
+	Start(stopCh <-chan struct{})
+}
+
+// Configurable is implemented by plugins which accept configuration.
+type Configurable interface {
+	Configure(config Config)
+}
+
+// Config is the configuration of the plugins which implement Configurable.
+type Config struct {
+	// SANPolicy limits the subject alternative names of Certificates.
+	SANPolicy SANPolicy
+}
+
 func All(scheme *runtime.Scheme) []Plugin {
 	return []Plugin{
 		newApproval(scheme),
+		newSecretNameCollision(),
+		newIssuerPolicy(),
+		newIssuanceQuota(),
+		newSecretAccessGrant(),
+		newSANPolicy(),
 	}
 }
@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+// SANPolicy configures the limits placed on the subject alternative names
+// of Certificates. The zero value places no limits.
+type SANPolicy struct {
+	// MaxSANs is the maximum number of DNS names, IP addresses, URIs and
+	// email addresses that a Certificate may request in total. Zero means no
+	// limit.
+	MaxSANs int
+
+	// MaxWildcardDepth is the maximum number of wildcard labels in each DNS
+	// name of a Certificate, so that a value of 1 rejects names such as
+	// `*.*.example.com`. Zero means no limit.
+	MaxWildcardDepth int
+
+	// DenyPublicSuffixWildcards rejects wildcard DNS names directly beneath
+	// a public suffix, such as `*.com` or `*.co.uk`.
+	DenyPublicSuffixWildcards bool
+
+	// ExemptNamespaces are the namespaces whose Certificates are not subject
+	// to the policy.
+	ExemptNamespaces []string
+}
+
+// sanPolicy is responsible for rejecting Certificates whose subject
+// alternative names exceed the limits of the configured SANPolicy, to guard
+// against accidentally requesting absurd certificates.
+type sanPolicy struct {
+	policy SANPolicy
+}
+
+func newSANPolicy() *sanPolicy {
+	return &sanPolicy{}
+}
+
+func (s *sanPolicy) Init(_ kubernetes.Interface, _ cmclient.Interface) {}
+
+func (s *sanPolicy) Configure(config Config) {
+	s.policy = config.SANPolicy
+}
+
+// Validate will return an error if the Certificate being created or updated
+// requests subject alternative names which are not permitted by the
+// SANPolicy. Updates which do not change the requested names are not
+// reviewed, so that Certificates admitted before the policy was configured
+// may still be updated.
+func (s *sanPolicy) Validate(_ context.Context, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return nil
+	}
+
+	// Only Validate over Certificate resources
+	if req.RequestKind.Group != certmanager.GroupName || req.RequestKind.Kind != cmapi.CertificateKind {
+		return nil
+	}
+
+	crt, ok := obj.(*internalcmapi.Certificate)
+	if !ok {
+		return nil
+	}
+
+	for _, ns := range s.policy.ExemptNamespaces {
+		if ns == req.Namespace {
+			return nil
+		}
+	}
+
+	if oldCrt, ok := oldObj.(*internalcmapi.Certificate); ok && req.Operation == admissionv1.Update {
+		if sansEqual(oldCrt.Spec, crt.Spec) {
+			return nil
+		}
+	}
+
+	fldPath := field.NewPath("spec")
+
+	if max := s.policy.MaxSANs; max > 0 {
+		count := len(crt.Spec.DNSNames) + len(crt.Spec.IPAddresses) + len(crt.Spec.URISANs) + len(crt.Spec.EmailSANs)
+		if count > max {
+			return field.TooMany(fldPath, count, max)
+		}
+	}
+
+	if err := s.validateWildcard(fldPath.Child("commonName"), crt.Spec.CommonName); err != nil {
+		return err
+	}
+	for i, name := range crt.Spec.DNSNames {
+		if err := s.validateWildcard(fldPath.Child("dnsNames").Index(i), name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateWildcard returns an error if the given DNS name is a wildcard
+// which is not permitted by the SANPolicy.
+func (s *sanPolicy) validateWildcard(fldPath *field.Path, name string) *field.Error {
+	labels := strings.Split(strings.TrimSuffix(name, "."), ".")
+
+	depth := 0
+	for _, label := range labels {
+		if strings.Contains(label, "*") {
+			depth++
+		}
+	}
+	if depth == 0 {
+		return nil
+	}
+
+	if max := s.policy.MaxWildcardDepth; max > 0 && depth > max {
+		return field.Forbidden(fldPath, fmt.Sprintf("%q contains %d wildcard labels, but at most %d are permitted", name, depth, max))
+	}
+
+	if s.policy.DenyPublicSuffixWildcards && labels[0] == "*" && len(labels) > 1 {
+		base := strings.ToLower(strings.Join(labels[1:], "."))
+		if suffix, _ := publicsuffix.PublicSuffix(base); suffix == base {
+			return field.Forbidden(fldPath, fmt.Sprintf("%q is a wildcard for the public suffix %q", name, base))
+		}
+	}
+
+	return nil
+}
+
+func sansEqual(l, r internalcmapi.CertificateSpec) bool {
+	return l.CommonName == r.CommonName &&
+		apiequality.Semantic.DeepEqual(l.DNSNames, r.DNSNames) &&
+		apiequality.Semantic.DeepEqual(l.IPAddresses, r.IPAddresses) &&
+		apiequality.Semantic.DeepEqual(l.URISANs, r.URISANs) &&
+		apiequality.Semantic.DeepEqual(l.EmailSANs, r.EmailSANs)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestSANPolicyValidate(t *testing.T) {
+	policy := SANPolicy{
+		MaxSANs:                   3,
+		MaxWildcardDepth:          1,
+		DenyPublicSuffixWildcards: true,
+		ExemptNamespaces:          []string{"exempt"},
+	}
+
+	newCrt := func(commonName string, dnsNames ...string) *internalcmapi.Certificate {
+		return &internalcmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+			Spec:       internalcmapi.CertificateSpec{CommonName: commonName, DNSNames: dnsNames},
+		}
+	}
+
+	req := func(op admissionv1.Operation, namespace, kind string) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			Namespace: namespace,
+			RequestKind: &metav1.GroupVersionKind{
+				Group: "cert-manager.io",
+				Kind:  kind,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		policy      SANPolicy
+		req         *admissionv1.AdmissionRequest
+		oldObj, obj runtime.Object
+
+		expErr *field.Error
+	}{
+		"if the request is not for a Certificate, exit nil": {
+			policy: policy,
+			req:    req(admissionv1.Create, "ns", "CertificateRequest"),
+			obj:    &internalcmapi.CertificateRequest{},
+		},
+		"if no policy is configured, exit nil": {
+			req: req(admissionv1.Create, "ns", "Certificate"),
+			obj: newCrt("", "a.example.com", "b.example.com", "c.example.com", "d.example.com", "*.*.com"),
+		},
+		"if the Certificate is within the limits, exit nil": {
+			policy: policy,
+			req:    req(admissionv1.Create, "ns", "Certificate"),
+			obj:    newCrt("*.example.com", "*.example.com", "example.com", "*.example.co.uk"),
+		},
+		"if too many SANs are requested, return error": {
+			policy: policy,
+			req:    req(admissionv1.Create, "ns", "Certificate"),
+			obj:    newCrt("", "a.example.com", "b.example.com", "c.example.com", "d.example.com"),
+			expErr: field.TooMany(field.NewPath("spec"), 4, 3),
+		},
+		"if a DNS name has too many wildcard labels, return error": {
+			policy: policy,
+			req:    req(admissionv1.Create, "ns", "Certificate"),
+			obj:    newCrt("", "example.com", "*.*.example.com"),
+			expErr: field.Forbidden(field.NewPath("spec", "dnsNames").Index(1), `"*.*.example.com" contains 2 wildcard labels, but at most 1 are permitted`),
+		},
+		"if a DNS name is a wildcard for a public suffix, return error": {
+			policy: policy,
+			req:    req(admissionv1.Create, "ns", "Certificate"),
+			obj:    newCrt("", "*.co.uk"),
+			expErr: field.Forbidden(field.NewPath("spec", "dnsNames").Index(0), `"*.co.uk" is a wildcard for the public suffix "co.uk"`),
+		},
+		"if the common name is a wildcard for a public suffix, return error": {
+			policy: policy,
+			req:    req(admissionv1.Create, "ns", "Certificate"),
+			obj:    newCrt("*.COM", "example.com"),
+			expErr: field.Forbidden(field.NewPath("spec", "commonName"), `"*.COM" is a wildcard for the public suffix "com"`),
+		},
+		"if the Certificate is in an exempt namespace, exit nil": {
+			policy: policy,
+			req:    req(admissionv1.Create, "exempt", "Certificate"),
+			obj:    newCrt("", "*.com"),
+		},
+		"if an update does not change the requested names, exit nil": {
+			policy: policy,
+			req:    req(admissionv1.Update, "ns", "Certificate"),
+			oldObj: newCrt("", "*.com"),
+			obj:    newCrt("", "*.com"),
+		},
+		"if an update changes the requested names, return error": {
+			policy: policy,
+			req:    req(admissionv1.Update, "ns", "Certificate"),
+			oldObj: newCrt("", "*.com"),
+			obj:    newCrt("", "*.com", "example.com"),
+			expErr: field.Forbidden(field.NewPath("spec", "dnsNames").Index(0), `"*.com" is a wildcard for the public suffix "com"`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newSANPolicy()
+			s.Configure(Config{SANPolicy: test.policy})
+
+			err := s.Validate(context.TODO(), test.req, test.oldObj, test.obj)
+			if !reflect.DeepEqual(err, test.expErr) {
+				t.Errorf("unexpected error, exp=%v got=%v", test.expErr, err)
+			}
+		})
+	}
+}
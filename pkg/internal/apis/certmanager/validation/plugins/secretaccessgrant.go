@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+// secretAccessGrant is responsible for rejecting Certificates which target
+// Secrets in another namespace without a SecretAccessGrant in that namespace
+// permitting it.
+type secretAccessGrant struct {
+	cmclient cmclient.Interface
+}
+
+func newSecretAccessGrant() *secretAccessGrant {
+	return &secretAccessGrant{}
+}
+
+func (s *secretAccessGrant) Init(_ kubernetes.Interface, cmclient cmclient.Interface) {
+	s.cmclient = cmclient
+}
+
+// Validate will return an error if the Certificate being created or updated
+// sets spec.secretNamespace to another namespace, and no SecretAccessGrant in
+// that namespace permits the Certificate to target all of its Secrets. Only
+// updates which change the targeted Secrets are reviewed, so that revoking a
+// grant does not block Certificates from being updated or deleted; the
+// certificates-issuing controller will stop writing to the Secrets instead.
+func (s *secretAccessGrant) Validate(ctx context.Context, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return nil
+	}
+	if req.RequestKind.Group != certmanager.GroupName || req.RequestKind.Kind != cmapi.CertificateKind {
+		return nil
+	}
+
+	crt, ok := obj.(*internalcmapi.Certificate)
+	if !ok {
+		return nil
+	}
+	if crt.Spec.SecretNamespace == "" || crt.Spec.SecretNamespace == req.Namespace {
+		return nil
+	}
+
+	if oldCrt, ok := oldObj.(*internalcmapi.Certificate); ok && req.Operation == admissionv1.Update {
+		if oldCrt.Spec.SecretNamespace == crt.Spec.SecretNamespace &&
+			oldCrt.Spec.SecretName == crt.Spec.SecretName &&
+			reflect.DeepEqual(oldCrt.Spec.AdditionalSecretNames, crt.Spec.AdditionalSecretNames) {
+			return nil
+		}
+	}
+
+	fldPath := field.NewPath("spec", "secretNamespace")
+
+	if s.cmclient == nil {
+		return field.InternalError(fldPath, errors.New("secret access grant validation not initialised"))
+	}
+
+	// If the SecretAccessGrant resource is not installed, no grants exist and
+	// so the Certificate is rejected.
+	grants, err := s.cmclient.CertmanagerV1().SecretAccessGrants(crt.Spec.SecretNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return field.InternalError(fldPath, err)
+	}
+
+	secretNames := append([]string{crt.Spec.SecretName}, crt.Spec.AdditionalSecretNames...)
+	if grants != nil {
+		for i := range grants.Items {
+			if apiutil.SecretAccessGrantPermits(&grants.Items[i], req.Namespace, crt.Name, secretNames) {
+				return nil
+			}
+		}
+	}
+
+	return field.Forbidden(fldPath, fmt.Sprintf("no SecretAccessGrant in namespace %q permits this Certificate to target Secrets %v", crt.Spec.SecretNamespace, secretNames))
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestSecretAccessGrantValidate(t *testing.T) {
+	grant := func(namespace string, from ...cmapi.SecretAccessGrantFrom) *cmapi.SecretAccessGrant {
+		return &cmapi.SecretAccessGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: namespace},
+			Spec:       cmapi.SecretAccessGrantSpec{From: from},
+		}
+	}
+	crt := func(secretNamespace, secretName string) *internalcmapi.Certificate {
+		return &internalcmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "crt", Namespace: "ns"},
+			Spec: internalcmapi.CertificateSpec{
+				SecretName:      secretName,
+				SecretNamespace: secretNamespace,
+			},
+		}
+	}
+	req := func(op admissionv1.Operation, kind string) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			Namespace: "ns",
+			RequestKind: &metav1.GroupVersionKind{
+				Group: "cert-manager.io",
+				Kind:  kind,
+			},
+		}
+	}
+
+	fldPath := field.NewPath("spec", "secretNamespace")
+
+	tests := map[string]struct {
+		req      *admissionv1.AdmissionRequest
+		oldObj   runtime.Object
+		obj      runtime.Object
+		existing []runtime.Object
+
+		expErr *field.Error
+	}{
+		"if the request is not for a Certificate, exit nil": {
+			req: req(admissionv1.Create, "CertificateRequest"),
+			obj: &internalcmapi.CertificateRequest{},
+		},
+		"if the Certificate does not set a secret namespace, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: crt("", "tls"),
+		},
+		"if the secret namespace is the Certificate namespace, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: crt("ns", "tls"),
+		},
+		"if there is no grant in the secret namespace, error": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      crt("other", "tls"),
+			existing: []runtime.Object{grant("ns", cmapi.SecretAccessGrantFrom{Namespace: "ns"})},
+			expErr:   field.Forbidden(fldPath, `no SecretAccessGrant in namespace "other" permits this Certificate to target Secrets [tls]`),
+		},
+		"if the grant permits another namespace, error": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      crt("other", "tls"),
+			existing: []runtime.Object{grant("other", cmapi.SecretAccessGrantFrom{Namespace: "elsewhere"})},
+			expErr:   field.Forbidden(fldPath, `no SecretAccessGrant in namespace "other" permits this Certificate to target Secrets [tls]`),
+		},
+		"if a grant permits the Certificate, exit nil": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      crt("other", "tls"),
+			existing: []runtime.Object{grant("other", cmapi.SecretAccessGrantFrom{Namespace: "ns", CertificateNames: []string{"crt"}})},
+		},
+		"if an update does not change the targeted Secrets, exit nil": {
+			req:    req(admissionv1.Update, "Certificate"),
+			oldObj: crt("other", "tls"),
+			obj:    crt("other", "tls"),
+		},
+		"if an update changes the targeted Secrets without a grant, error": {
+			req:    req(admissionv1.Update, "Certificate"),
+			oldObj: crt("", "tls"),
+			obj:    crt("other", "tls"),
+			expErr: field.Forbidden(fldPath, `no SecretAccessGrant in namespace "other" permits this Certificate to target Secrets [tls]`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newSecretAccessGrant()
+			s.Init(nil, cmfake.NewSimpleClientset(test.existing...))
+
+			err := s.Validate(context.TODO(), test.req, test.oldObj, test.obj)
+			if !reflect.DeepEqual(test.expErr, err) {
+				t.Errorf("unexpected error, exp=%#+v got=%#+v",
+					test.expErr, err)
+			}
+		})
+	}
+}
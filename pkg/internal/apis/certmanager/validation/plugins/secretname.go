@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions/certmanager/v1"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmapiv1 "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/v1"
+)
+
+// secretNameIndex is the name of the index of Certificates by the namespace
+// and name of the Secrets they reference.
+const secretNameIndex = "secretName"
+
+// secretNameCollision is responsible for rejecting Certificates which
+// reference a Secret, through spec.secretName or spec.additionalSecretNames,
+// that is already referenced by another Certificate. Secrets are compared by
+// their effective namespace, so a Certificate using spec.secretNamespace
+// collides with Certificates in other namespaces that reference the same
+// Secret. Two Certificates writing to the same Secret will continuously
+// overwrite each other's data, causing endless re-issuance.
+// Certificates are read from an informer cache indexed by the Secret they
+// reference, rather than listed on every admission request.
+type secretNameCollision struct {
+	informer cache.SharedIndexInformer
+}
+
+func newSecretNameCollision() *secretNameCollision {
+	return &secretNameCollision{}
+}
+
+func (s *secretNameCollision) Init(_ kubernetes.Interface, cmclient cmclient.Interface) {
+	s.informer = cminformers.NewCertificateInformer(cmclient, metav1.NamespaceAll, 0, cache.Indexers{
+		secretNameIndex: secretNameIndexFunc,
+	})
+}
+
+// Start starts the informer which caches Certificates, and waits for its
+// cache to sync.
+func (s *secretNameCollision) Start(stopCh <-chan struct{}) {
+	go s.informer.Run(stopCh)
+	cache.WaitForCacheSync(stopCh, s.informer.HasSynced)
+}
+
+// secretNameIndexFunc returns the index keys of all Secrets referenced by a
+// Certificate.
+func secretNameIndexFunc(obj interface{}) ([]string, error) {
+	crt, ok := obj.(*cmapi.Certificate)
+	if !ok {
+		return nil, nil
+	}
+	keys := sets.NewString()
+	for _, name := range apiutil.CertificateSecretNames(crt) {
+		keys.Insert(secretNameIndexKey(apiutil.CertificateSecretNamespace(crt), name))
+	}
+	return keys.List(), nil
+}
+
+func secretNameIndexKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// secretNamePath returns the field path of the i'th name returned by
+// apiutil.CertificateSecretNames.
+func secretNamePath(i int) *field.Path {
+	if i == 0 {
+		return field.NewPath("spec", "secretName")
+	}
+	return field.NewPath("spec", "additionalSecretNames").Index(i - 1)
+}
+
+// Validate will return an error if the Certificate being created or updated
+// references a Secret that is already referenced by a different Certificate,
+// through either spec.secretName or spec.additionalSecretNames, in the
+// namespace given by spec.secretNamespace or else the Certificate's own. Validation can be skipped by setting the
+// "cert-manager.io/allow-secret-name-collision" annotation to "true" on the
+// Certificate being admitted.
+func (s *secretNameCollision) Validate(_ context.Context, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return nil
+	}
+
+	// Only Validate over Certificate resources
+	if req.RequestKind.Group != certmanager.GroupName || req.RequestKind.Kind != cmapi.CertificateKind {
+		return nil
+	}
+
+	crt, ok := obj.(*internalcmapi.Certificate)
+	if !ok {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "secretName")
+
+	if crt.Annotations[cmapi.AllowSecretNameCollisionAnnotationKey] == "true" {
+		return nil
+	}
+
+	// Only review updates which change the referenced Secrets so that
+	// Certificates which already collide are not blocked from being updated
+	// or deleted.
+	if oldCrt, ok := oldObj.(*internalcmapi.Certificate); ok && req.Operation == admissionv1.Update {
+		if oldCrt.Spec.SecretNamespace == crt.Spec.SecretNamespace &&
+			oldCrt.Spec.SecretName == crt.Spec.SecretName &&
+			reflect.DeepEqual(oldCrt.Spec.AdditionalSecretNames, crt.Spec.AdditionalSecretNames) {
+			return nil
+		}
+	}
+
+	if s.informer == nil {
+		return field.InternalError(fldPath, errors.New("secret name collision validation not initialised"))
+	}
+	if !s.informer.HasSynced() {
+		return field.InternalError(fldPath, errors.New("the Certificate cache has not synced yet"))
+	}
+
+	v1Crt := new(cmapi.Certificate)
+	if err := cmapiv1.Convert_certmanager_Certificate_To_v1_Certificate(crt, v1Crt, nil); err != nil {
+		return field.InternalError(fldPath, err)
+	}
+	if v1Crt.Namespace == "" {
+		v1Crt.Namespace = req.Namespace
+	}
+
+	secretNamespace := apiutil.CertificateSecretNamespace(v1Crt)
+	for i, secretName := range apiutil.CertificateSecretNames(v1Crt) {
+		existing, err := s.informer.GetIndexer().ByIndex(secretNameIndex, secretNameIndexKey(secretNamespace, secretName))
+		if err != nil {
+			return field.InternalError(fldPath, err)
+		}
+
+		for _, obj := range existing {
+			existing, ok := obj.(*cmapi.Certificate)
+			if !ok || (existing.Namespace == v1Crt.Namespace && existing.Name == v1Crt.Name) {
+				continue
+			}
+
+			existingName := existing.Name
+			if existing.Namespace != v1Crt.Namespace {
+				existingName = existing.Namespace + "/" + existing.Name
+			}
+
+			return field.Invalid(secretNamePath(i), secretName,
+				fmt.Sprintf("secret is already referenced by Certificate %q; set the annotation %q to \"true\" to allow this",
+					existingName, cmapi.AllowSecretNameCollisionAnnotationKey))
+		}
+	}
+
+	return nil
+}
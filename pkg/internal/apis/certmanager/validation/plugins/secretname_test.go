@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestSecretNameCollisionValidate(t *testing.T) {
+	existing := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "ns"},
+		Spec:       cmapi.CertificateSpec{SecretName: "tls"},
+	}
+
+	newCrt := func(name, secretName string, annotations map[string]string) *internalcmapi.Certificate {
+		return &internalcmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Annotations: annotations},
+			Spec:       internalcmapi.CertificateSpec{SecretName: secretName},
+		}
+	}
+
+	withAdditionalSecretNames := func(crt *internalcmapi.Certificate, names ...string) *internalcmapi.Certificate {
+		crt.Spec.AdditionalSecretNames = names
+		return crt
+	}
+
+	existingWithAdditional := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "ns"},
+		Spec:       cmapi.CertificateSpec{SecretName: "tls", AdditionalSecretNames: []string{"tls-copy"}},
+	}
+
+	withSecretNamespace := func(crt *internalcmapi.Certificate, namespace string) *internalcmapi.Certificate {
+		crt.Spec.SecretNamespace = namespace
+		return crt
+	}
+
+	existingInSharedNamespace := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "other-ns"},
+		Spec:       cmapi.CertificateSpec{SecretName: "tls", SecretNamespace: "shared"},
+	}
+
+	req := func(op admissionv1.Operation, kind string) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			Namespace: "ns",
+			RequestKind: &metav1.GroupVersionKind{
+				Group: "cert-manager.io",
+				Kind:  kind,
+			},
+		}
+	}
+
+	collisionErr := field.Invalid(field.NewPath("spec", "secretName"), "tls",
+		`secret is already referenced by Certificate "existing"; set the annotation "cert-manager.io/allow-secret-name-collision" to "true" to allow this`)
+
+	additionalCollisionErr := field.Invalid(field.NewPath("spec", "additionalSecretNames").Index(1), "tls",
+		`secret is already referenced by Certificate "existing"; set the annotation "cert-manager.io/allow-secret-name-collision" to "true" to allow this`)
+
+	crossNamespaceCollisionErr := field.Invalid(field.NewPath("spec", "secretName"), "tls",
+		`secret is already referenced by Certificate "other-ns/existing"; set the annotation "cert-manager.io/allow-secret-name-collision" to "true" to allow this`)
+
+	tests := map[string]struct {
+		req         *admissionv1.AdmissionRequest
+		oldObj, obj runtime.Object
+		existing    []runtime.Object
+
+		expErr *field.Error
+	}{
+		"if the request is not for a Certificate, exit nil": {
+			req:      req(admissionv1.Create, "CertificateRequest"),
+			obj:      &internalcmapi.CertificateRequest{},
+			existing: []runtime.Object{existing},
+			expErr:   nil,
+		},
+		"if the request is a DELETE operation, exit nil": {
+			req:      req(admissionv1.Delete, "Certificate"),
+			obj:      newCrt("new", "tls", nil),
+			existing: []runtime.Object{existing},
+			expErr:   nil,
+		},
+		"if no other Certificate references the Secret, exit nil": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      newCrt("new", "other-tls", nil),
+			existing: []runtime.Object{existing},
+			expErr:   nil,
+		},
+		"if a Certificate in another namespace references the Secret, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt("new", "tls", nil),
+			existing: []runtime.Object{&cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "other-ns"},
+				Spec:       cmapi.CertificateSpec{SecretName: "tls"},
+			}},
+			expErr: nil,
+		},
+		"if the only Certificate referencing the Secret is itself, exit nil": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      newCrt("existing", "tls", nil),
+			existing: []runtime.Object{existing},
+			expErr:   nil,
+		},
+		"if another Certificate references the Secret on create, error": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      newCrt("new", "tls", nil),
+			existing: []runtime.Object{existing},
+			expErr:   collisionErr,
+		},
+		"if another Certificate references the Secret but the override annotation is set, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt("new", "tls", map[string]string{
+				cmapi.AllowSecretNameCollisionAnnotationKey: "true",
+			}),
+			existing: []runtime.Object{existing},
+			expErr:   nil,
+		},
+		"if an update does not change the secretName, exit nil": {
+			req:      req(admissionv1.Update, "Certificate"),
+			oldObj:   newCrt("new", "tls", nil),
+			obj:      newCrt("new", "tls", map[string]string{"foo": "bar"}),
+			existing: []runtime.Object{existing},
+			expErr:   nil,
+		},
+		"if an update changes the secretName to one already referenced, error": {
+			req:      req(admissionv1.Update, "Certificate"),
+			oldObj:   newCrt("new", "other-tls", nil),
+			obj:      newCrt("new", "tls", nil),
+			existing: []runtime.Object{existing},
+			expErr:   collisionErr,
+		},
+		"if an additionalSecretName of the Certificate is already referenced, error": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      withAdditionalSecretNames(newCrt("new", "other-tls", nil), "other-tls-copy", "tls"),
+			existing: []runtime.Object{existing},
+			expErr:   additionalCollisionErr,
+		},
+		"if the secretName is an additionalSecretName of another Certificate, error": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      newCrt("new", "tls-copy", nil),
+			existing: []runtime.Object{existingWithAdditional},
+			expErr: field.Invalid(field.NewPath("spec", "secretName"), "tls-copy",
+				`secret is already referenced by Certificate "existing"; set the annotation "cert-manager.io/allow-secret-name-collision" to "true" to allow this`),
+		},
+		"if an update adds an additionalSecretName already referenced, error": {
+			req:      req(admissionv1.Update, "Certificate"),
+			oldObj:   withAdditionalSecretNames(newCrt("new", "other-tls", nil), "other-tls-copy"),
+			obj:      withAdditionalSecretNames(newCrt("new", "other-tls", nil), "other-tls-copy", "tls"),
+			existing: []runtime.Object{existing},
+			expErr:   additionalCollisionErr,
+		},
+		"if an update does not change the additionalSecretNames, exit nil": {
+			req:      req(admissionv1.Update, "Certificate"),
+			oldObj:   withAdditionalSecretNames(newCrt("new", "other-tls", nil), "tls"),
+			obj:      withAdditionalSecretNames(newCrt("new", "other-tls", map[string]string{"foo": "bar"}), "tls"),
+			existing: []runtime.Object{existing},
+			expErr:   nil,
+		},
+		"if a Certificate in another namespace references the Secret in the same secretNamespace, error": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      withSecretNamespace(newCrt("new", "tls", nil), "shared"),
+			existing: []runtime.Object{existingInSharedNamespace},
+			expErr:   crossNamespaceCollisionErr,
+		},
+		"if a Certificate of the same name in another namespace references the Secret in the same secretNamespace, error": {
+			req:      req(admissionv1.Create, "Certificate"),
+			obj:      withSecretNamespace(newCrt("existing", "tls", nil), "shared"),
+			existing: []runtime.Object{existingInSharedNamespace},
+			expErr:   crossNamespaceCollisionErr,
+		},
+		"if the secretNamespace is the namespace of another Certificate referencing the Secret, error": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: withSecretNamespace(newCrt("new", "tls", nil), "other-ns"),
+			existing: []runtime.Object{&cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "other-ns"},
+				Spec:       cmapi.CertificateSpec{SecretName: "tls"},
+			}},
+			expErr: crossNamespaceCollisionErr,
+		},
+		"if another Certificate in the namespace references the Secret name in a different secretNamespace, exit nil": {
+			req: req(admissionv1.Create, "Certificate"),
+			obj: newCrt("new", "tls", nil),
+			existing: []runtime.Object{&cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "ns"},
+				Spec:       cmapi.CertificateSpec{SecretName: "tls", SecretNamespace: "shared"},
+			}},
+			expErr: nil,
+		},
+		"if an update changes the secretNamespace to one where the Secret is already referenced, error": {
+			req:      req(admissionv1.Update, "Certificate"),
+			oldObj:   newCrt("new", "tls", nil),
+			obj:      withSecretNamespace(newCrt("new", "tls", nil), "shared"),
+			existing: []runtime.Object{existingInSharedNamespace},
+			expErr:   crossNamespaceCollisionErr,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			s := newSecretNameCollision()
+			s.Init(nil, cmfake.NewSimpleClientset(test.existing...))
+			s.Start(stopCh)
+
+			err := s.Validate(context.TODO(), test.req, test.oldObj, test.obj)
+			if !reflect.DeepEqual(test.expErr, err) {
+				t.Errorf("unexpected error, exp=%#+v got=%#+v",
+					test.expErr, err)
+			}
+		})
+	}
+}
+
+func TestSecretNameCollisionValidateBeforeSync(t *testing.T) {
+	s := newSecretNameCollision()
+	s.Init(nil, cmfake.NewSimpleClientset())
+
+	crt := &internalcmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "ns"},
+		Spec:       internalcmapi.CertificateSpec{SecretName: "tls"},
+	}
+	req := &admissionv1.AdmissionRequest{
+		Operation:   admissionv1.Create,
+		RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io", Kind: "Certificate"},
+	}
+
+	err := s.Validate(context.TODO(), req, nil, crt)
+	if err == nil || err.Type != field.ErrorTypeInternal {
+		t.Errorf("expected an internal error before the Certificate cache has synced, got=%v", err)
+	}
+}
@@ -29,6 +29,13 @@ func AddToValidationRegistry(reg *validation.Registry) error {
 		return err
 	}
 
+	if err := reg.AddValidateFunc(&cmapi.ClusterCertificate{}, ValidateClusterCertificate); err != nil {
+		return err
+	}
+	if err := reg.AddValidateUpdateFunc(&cmapi.ClusterCertificate{}, ValidateUpdateClusterCertificate); err != nil {
+		return err
+	}
+
 	if err := reg.AddValidateFunc(&cmapi.CertificateRequest{}, ValidateCertificateRequest); err != nil {
 		return err
 	}
@@ -43,6 +50,27 @@ func AddToValidationRegistry(reg *validation.Registry) error {
 		return err
 	}
 
+	if err := reg.AddValidateFunc(&cmapi.IssuanceQuota{}, ValidateIssuanceQuota); err != nil {
+		return err
+	}
+	if err := reg.AddValidateUpdateFunc(&cmapi.IssuanceQuota{}, ValidateUpdateIssuanceQuota); err != nil {
+		return err
+	}
+
+	if err := reg.AddValidateFunc(&cmapi.NotificationRoute{}, ValidateNotificationRoute); err != nil {
+		return err
+	}
+	if err := reg.AddValidateUpdateFunc(&cmapi.NotificationRoute{}, ValidateUpdateNotificationRoute); err != nil {
+		return err
+	}
+
+	if err := reg.AddValidateFunc(&cmapi.SecretAccessGrant{}, ValidateSecretAccessGrant); err != nil {
+		return err
+	}
+	if err := reg.AddValidateUpdateFunc(&cmapi.SecretAccessGrant{}, ValidateUpdateSecretAccessGrant); err != nil {
+		return err
+	}
+
 	if err := reg.AddValidateFunc(&cmapi.Issuer{}, ValidateIssuer); err != nil {
 		return err
 	}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net/url"
+	"strings"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/feature"
+	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmutil "github.com/jetstack/cert-manager/pkg/util"
+)
+
+// rulesetV2 holds validations which were added after the v1 API was
+// released. Violations are returned as warnings until the
+// ValidationRulesetV2 feature gate is enabled.
+var rulesetV2 = validation.Ruleset{Version: "v2", Feature: feature.ValidationRulesetV2}
+
+// validateCertificateSpecV2 validates the given Certificate spec against the
+// v2 validation ruleset.
+func validateCertificateSpecV2(crt *internalcmapi.CertificateSpec, fldPath *field.Path) (field.ErrorList, validation.WarningList) {
+	el := field.ErrorList{}
+
+	seen := make(map[string]bool, len(crt.DNSNames))
+	for i, name := range crt.DNSNames {
+		idxPath := fldPath.Child("dnsNames").Index(i)
+		// DNS names are case insensitive and may be a wildcard for a single
+		// label. Internationalized domain names are checked in the A-label
+		// form that is used in certificate requests.
+		canonical := cmutil.NormalizeDNSName(strings.TrimSuffix(name, "."))
+		if seen[canonical] {
+			el = append(el, field.Duplicate(idxPath, name))
+			continue
+		}
+		seen[canonical] = true
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(strings.TrimPrefix(canonical, "*.")) {
+			el = append(el, field.Invalid(idxPath, name, msg))
+		}
+	}
+
+	for i, uri := range crt.URISANs {
+		if u, err := url.Parse(uri); err != nil || !u.IsAbs() {
+			el = append(el, field.Invalid(fldPath.Child("uris").Index(i), uri, "must be an absolute URI"))
+		}
+	}
+
+	return rulesetV2.Apply(el)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	"github.com/jetstack/cert-manager/pkg/feature"
+	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
+)
+
+func TestValidateCertificateSpecV2(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	spec := &internalcmapi.CertificateSpec{
+		DNSNames: []string{"example.com", "*.example.com", "Example.com", "foo_bar.example.com", "bücher.example", "xn--bcher-kva.example"},
+		URISANs:  []string{"spiffe://example.com/workload", "example.com"},
+	}
+	violations := field.ErrorList{
+		field.Duplicate(fldPath.Child("dnsNames").Index(2), "Example.com"),
+		field.Invalid(fldPath.Child("dnsNames").Index(3), "foo_bar.example.com", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+		field.Duplicate(fldPath.Child("dnsNames").Index(5), "xn--bcher-kva.example"),
+		field.Invalid(fldPath.Child("uris").Index(1), "example.com", "must be an absolute URI"),
+	}
+
+	t.Run("violations are warnings when the ruleset is not enforced", func(t *testing.T) {
+		errs, warnings := validateCertificateSpecV2(spec, fldPath)
+		assert.Empty(t, errs)
+		var expected validation.WarningList
+		for _, err := range violations {
+			expected = append(expected, err.Error()+" (this will be rejected by validation ruleset v2, which is enforced by the ValidationRulesetV2 feature gate)")
+		}
+		assert.Equal(t, expected, warnings)
+	})
+
+	t.Run("violations are errors when the ruleset is enforced", func(t *testing.T) {
+		defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.ValidationRulesetV2, true)()
+		errs, warnings := validateCertificateSpecV2(spec, fldPath)
+		assert.Equal(t, violations, errs)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("a valid spec has no violations", func(t *testing.T) {
+		errs, warnings := validateCertificateSpecV2(&internalcmapi.CertificateSpec{DNSNames: []string{"example.com", "*.bücher.example"}}, fldPath)
+		assert.Empty(t, errs)
+		assert.Empty(t, warnings)
+	})
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+// Validation functions for cert-manager SecretAccessGrant types.
+
+func ValidateSecretAccessGrant(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	grant := obj.(*cmapi.SecretAccessGrant)
+	return ValidateSecretAccessGrantSpec(&grant.Spec, field.NewPath("spec")), validateAPIVersion(a.RequestKind)
+}
+
+func ValidateUpdateSecretAccessGrant(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	grant := obj.(*cmapi.SecretAccessGrant)
+	return ValidateSecretAccessGrantSpec(&grant.Spec, field.NewPath("spec")), validateAPIVersion(a.RequestKind)
+}
+
+func ValidateSecretAccessGrantSpec(spec *cmapi.SecretAccessGrantSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(spec.From) == 0 {
+		el = append(el, field.Required(fldPath.Child("from"), "must be specified"))
+	}
+	for i, from := range spec.From {
+		fromPath := fldPath.Child("from").Index(i)
+		if from.Namespace == "" {
+			el = append(el, field.Required(fromPath.Child("namespace"), "must be specified"))
+		} else {
+			for _, msg := range apivalidation.ValidateNamespaceName(from.Namespace, false) {
+				el = append(el, field.Invalid(fromPath.Child("namespace"), from.Namespace, msg))
+			}
+		}
+		el = append(el, validateNames(from.CertificateNames, fromPath.Child("certificateNames"))...)
+	}
+	el = append(el, validateNames(spec.SecretNames, fldPath.Child("secretNames"))...)
+
+	return el
+}
+
+// validateNames checks that each name is a non-empty, unique DNS subdomain,
+// as required for the names of Certificates and Secrets.
+func validateNames(names []string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	seen := sets.NewString()
+	for i, name := range names {
+		if name == "" {
+			el = append(el, field.Required(fldPath.Index(i), "must be specified"))
+			continue
+		}
+		for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+			el = append(el, field.Invalid(fldPath.Index(i), name, msg))
+		}
+		if seen.Has(name) {
+			el = append(el, field.Duplicate(fldPath.Index(i), name))
+		}
+		seen.Insert(name)
+	}
+	return el
+}
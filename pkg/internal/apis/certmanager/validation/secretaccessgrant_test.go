@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestValidateSecretAccessGrant(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	a := &admissionv1.AdmissionRequest{
+		RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "SecretAccessGrant"},
+	}
+
+	scenarios := map[string]struct {
+		spec cmapi.SecretAccessGrantSpec
+		errs []*field.Error
+	}{
+		"valid grant": {
+			spec: cmapi.SecretAccessGrantSpec{
+				From: []cmapi.SecretAccessGrantFrom{
+					{Namespace: "team-a"},
+					{Namespace: "team-b", CertificateNames: []string{"web", "api"}},
+				},
+				SecretNames: []string{"web-tls"},
+			},
+		},
+		"missing from": {
+			errs: []*field.Error{
+				field.Required(fldPath.Child("from"), "must be specified"),
+			},
+		},
+		"missing and invalid namespaces": {
+			spec: cmapi.SecretAccessGrantSpec{
+				From: []cmapi.SecretAccessGrantFrom{
+					{},
+					{Namespace: "team.a"},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("from").Index(0).Child("namespace"), "must be specified"),
+				field.Invalid(fldPath.Child("from").Index(1).Child("namespace"), "team.a", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		"empty and duplicate names": {
+			spec: cmapi.SecretAccessGrantSpec{
+				From: []cmapi.SecretAccessGrantFrom{
+					{Namespace: "team-a", CertificateNames: []string{"web", "web"}},
+				},
+				SecretNames: []string{""},
+			},
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("from").Index(0).Child("certificateNames").Index(1), "web"),
+				field.Required(fldPath.Child("secretNames").Index(0), "must be specified"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, _ := ValidateSecretAccessGrant(a, &cmapi.SecretAccessGrant{Spec: s.spec})
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected errors %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected error %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
@@ -72,4 +72,12 @@ type SecretKeySelector struct {
 const (
 	// Used as a data key in Secret resources to store a CA certificate.
 	TLSCAKey = "ca.crt"
+
+	// Used as a data key in Secret resources to store a DER encoded OCSP
+	// response for the certificate, suitable for OCSP stapling.
+	TLSOCSPStapleKey = "tls.ocsp-staple"
+
+	// Used as a data key in Secret resources to store a bundle of trusted CA
+	// certificates, including public CAs, for use by clients.
+	TLSTrustStoreKey = "trust.pem"
 )
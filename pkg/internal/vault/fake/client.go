@@ -26,6 +26,9 @@ type Client struct {
 	NewRequestS  *vault.Request
 	RawRequestFn func(r *vault.Request) (*vault.Response, error)
 	token        string
+
+	// RequestPath is the path of the last request created by NewRequest.
+	RequestPath string
 }
 
 func NewFakeClient() *Client {
@@ -50,6 +53,7 @@ func (c *Client) WithRawRequest(resp *vault.Response, err error) *Client {
 }
 
 func (c *Client) NewRequest(method, requestPath string) *vault.Request {
+	c.RequestPath = requestPath
 	return c.NewRequestS
 }
 
@@ -28,14 +28,14 @@ import (
 
 type Vault struct {
 	NewFn                           func(string, corelisters.SecretLister, v1.GenericIssuer) (*Vault, error)
-	SignFn                          func([]byte, time.Duration) ([]byte, []byte, error)
+	SignFn                          func([]byte, time.Duration, bool) ([]byte, []byte, error)
 	IsVaultInitializedAndUnsealedFn func() error
 }
 
 // New returns a new fake Vault
 func New() *Vault {
 	v := &Vault{
-		SignFn: func([]byte, time.Duration) ([]byte, []byte, error) {
+		SignFn: func([]byte, time.Duration, bool) ([]byte, []byte, error) {
 			return nil, nil, nil
 		},
 		IsVaultInitializedAndUnsealedFn: func() error {
@@ -51,13 +51,13 @@ func New() *Vault {
 }
 
 // Sign implements `vault.Interface`.
-func (v *Vault) Sign(csrPEM []byte, duration time.Duration) ([]byte, []byte, error) {
-	return v.SignFn(csrPEM, duration)
+func (v *Vault) Sign(csrPEM []byte, duration time.Duration, isCA bool) ([]byte, []byte, error) {
+	return v.SignFn(csrPEM, duration, isCA)
 }
 
 // WithSign sets the fake Vault's Sign function.
 func (v *Vault) WithSign(certPEM, caPEM []byte, err error) *Vault {
-	v.SignFn = func([]byte, time.Duration) ([]byte, []byte, error) {
+	v.SignFn = func([]byte, time.Duration, bool) ([]byte, []byte, error) {
 		return certPEM, caPEM, err
 	}
 	return v
@@ -46,7 +46,7 @@ type ClientBuilder func(namespace string, secretsLister corelisters.SecretLister
 // Vault's certificate.
 // TODO: Sys() is duplicated here and in Client interface
 type Interface interface {
-	Sign(csrPEM []byte, duration time.Duration) (certPEM []byte, caPEM []byte, err error)
+	Sign(csrPEM []byte, duration time.Duration, isCA bool) (certPEM []byte, caPEM []byte, err error)
 	Sys() *vault.Sys
 	IsVaultInitializedAndUnsealed() error
 }
@@ -101,7 +101,12 @@ func New(namespace string, secretsLister corelisters.SecretLister, issuer v1.Gen
 }
 
 // Sign will connect to a Vault instance to sign a certificate signing request.
-func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []byte, err error) {
+// The request is signed by the endpoint selected by the signing mode of the
+// issuer, with the given duration as its TTL. Requests for CA certificates are
+// signed as intermediate CAs by the `root/sign-intermediate` endpoint of the
+// PKI secrets engine that the issuer's path belongs to, as the endpoints of
+// roles only sign leaf certificates.
+func (v *Vault) Sign(csrPEM []byte, duration time.Duration, isCA bool) (cert []byte, ca []byte, err error) {
 	csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to decode CSR for signing: %s", err)
@@ -119,7 +124,16 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	}
 
 	vaultIssuer := v.issuer.GetSpec().Vault
-	url := path.Join("/v1", vaultIssuer.Path)
+	endpoint, err := signPath(vaultIssuer, isCA)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign certificate by vault: %w", err)
+	}
+	if isCA {
+		// keep the subject and extensions of the CSR, such as its basic
+		// constraints, rather than those of the issuing CA
+		parameters["use_csr_values"] = "true"
+	}
+	url := path.Join("/v1", endpoint)
 
 	request := v.client.NewRequest("POST", url)
 
@@ -145,6 +159,46 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	return extractCertificatesFromVaultCertificateSecret(&vaultResult)
 }
 
+// signPath returns the path of the endpoint which signs a request with the
+// given Vault issuer. CA certificates are signed as intermediates by the
+// PKI secrets engine that the issuer's path belongs to, and other
+// certificates by the endpoint selected by the signing mode of the issuer.
+func signPath(vaultIssuer *v1.VaultIssuer, isCA bool) (string, error) {
+	if !isCA && vaultIssuer.SigningMode != v1.VerbatimVaultSigningMode {
+		return vaultIssuer.Path, nil
+	}
+
+	prefix, role, err := splitSignPath(vaultIssuer.Path)
+	if err != nil {
+		return "", err
+	}
+	if isCA {
+		// the sign-intermediate endpoint of a specific issuer is part of
+		// the issuer's path, whereas that of the default issuer is an
+		// endpoint of the root
+		if len(prefix) >= 3 && prefix[len(prefix)-2] == "issuer" {
+			return path.Join(append(prefix, "sign-intermediate")...), nil
+		}
+		return path.Join(append(prefix, "root", "sign-intermediate")...), nil
+	}
+	return path.Join(append(prefix, "sign-verbatim", role)...), nil
+}
+
+// splitSignPath splits the path of a `sign`, `sign-verbatim` or `issue`
+// endpoint of a Vault PKI secrets engine into the segments preceding the
+// endpoint, which are the mount path and optionally a specific issuer, and
+// the name of the role, if any.
+func splitSignPath(signPath string) (prefix []string, role string, err error) {
+	segments := strings.Split(strings.Trim(signPath, "/"), "/")
+	for i := 1; i < len(segments); i++ {
+		switch segments[i] {
+		case "sign", "sign-verbatim", "issue":
+			return segments[:i], path.Join(segments[i+1:]...), nil
+		}
+	}
+	return nil, "", fmt.Errorf("path %q is not a sign endpoint of a Vault PKI secrets engine", signPath)
+}
+
 func (v *Vault) setToken(client Client) error {
 	tokenRef := v.issuer.GetSpec().Vault.Auth.TokenSecretRef
 	if tokenRef != nil {
@@ -181,9 +181,11 @@ type testSignT struct {
 	fakeClient *vaultfake.Client
 
 	csrPEM       []byte
+	isCA         bool
 	expectedErr  error
 	expectedCert string
 	expectedCA   string
+	expectedPath string
 }
 
 func signedCertificateSecret(issuingCaPEM string, caPEM ...string) *certutil.Secret {
@@ -275,6 +277,34 @@ func TestSign(t *testing.T) {
 			expectedCA:   testRootCa,
 		},
 
+		"a CA csr should be signed as an intermediate by the PKI secrets engine of the issuer's path": {
+			csrPEM: csrPEM,
+			isCA:   true,
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapi.VaultIssuer{Path: "pki_int/sign/my-role"}),
+			),
+			fakeClient: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+				Response: &http.Response{
+					Body: io.NopCloser(bytes.NewReader(rootBundleData))},
+			}, nil),
+			expectedErr:  nil,
+			expectedCert: testLeafCertificate + testIntermediateCa,
+			expectedCA:   testRootCa,
+			expectedPath: "/v1/pki_int/root/sign-intermediate",
+		},
+
+		"a CA csr should error if the issuer's path is not a sign endpoint": {
+			csrPEM: csrPEM,
+			isCA:   true,
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapi.VaultIssuer{Path: "pki_int"}),
+			),
+			fakeClient:   vaultfake.NewFakeClient(),
+			expectedErr:  errors.New(`failed to sign certificate by vault: path "pki_int" is not a sign endpoint of a Vault PKI secrets engine`),
+			expectedCert: "",
+			expectedCA:   "",
+		},
+
 		"vault issuer with namespace specified": {
 			csrPEM: csrPEM,
 			issuer: gen.Issuer("vault-issuer",
@@ -298,7 +328,7 @@ func TestSign(t *testing.T) {
 			client:        test.fakeClient,
 		}
 
-		cert, ca, err := v.Sign(test.csrPEM, time.Minute)
+		cert, ca, err := v.Sign(test.csrPEM, time.Minute, test.isCA)
 		if ((test.expectedErr == nil) != (err == nil)) &&
 			test.expectedErr != nil &&
 			test.expectedErr.Error() != err.Error() {
@@ -328,6 +358,11 @@ func TestSign(t *testing.T) {
 			t.Errorf("unexpected ca in response bundle, exp=%s got=%s; %s",
 				test.expectedCA, ca, name)
 		}
+
+		if test.expectedPath != "" && test.expectedPath != test.fakeClient.RequestPath {
+			t.Errorf("%s: unexpected request path, exp=%s got=%s",
+				name, test.expectedPath, test.fakeClient.RequestPath)
+		}
 	}
 }
 
@@ -337,6 +372,68 @@ type testExtractCertificatesFromVaultCertT struct {
 	expectedCA   string
 }
 
+func TestSignPath(t *testing.T) {
+	tests := map[string]struct {
+		issuer       cmapi.VaultIssuer
+		isCA         bool
+		expectedPath string
+		expectedErr  bool
+	}{
+		"role signing mode uses the issuer's path": {
+			issuer:       cmapi.VaultIssuer{Path: "pki/sign/my-role"},
+			expectedPath: "pki/sign/my-role",
+		},
+		"verbatim signing mode uses the sign-verbatim endpoint of the role": {
+			issuer:       cmapi.VaultIssuer{Path: "pki/sign/my-role", SigningMode: cmapi.VerbatimVaultSigningMode},
+			expectedPath: "pki/sign-verbatim/my-role",
+		},
+		"verbatim signing mode without a role": {
+			issuer:       cmapi.VaultIssuer{Path: "pki/sign-verbatim", SigningMode: cmapi.VerbatimVaultSigningMode},
+			expectedPath: "pki/sign-verbatim",
+		},
+		"verbatim signing mode of a specific issuer": {
+			issuer:       cmapi.VaultIssuer{Path: "pki/issuer/my-issuer/sign/my-role", SigningMode: cmapi.VerbatimVaultSigningMode},
+			expectedPath: "pki/issuer/my-issuer/sign-verbatim/my-role",
+		},
+		"verbatim signing mode with a mount path only": {
+			issuer:      cmapi.VaultIssuer{Path: "pki", SigningMode: cmapi.VerbatimVaultSigningMode},
+			expectedErr: true,
+		},
+		"CA certificates are signed by the sign-intermediate endpoint": {
+			issuer:       cmapi.VaultIssuer{Path: "pki/sign/my-role"},
+			isCA:         true,
+			expectedPath: "pki/root/sign-intermediate",
+		},
+		"CA certificates are signed by the sign-intermediate endpoint in a nested mount": {
+			issuer:       cmapi.VaultIssuer{Path: "/team/pki_int/issue/my-role", SigningMode: cmapi.VerbatimVaultSigningMode},
+			isCA:         true,
+			expectedPath: "team/pki_int/root/sign-intermediate",
+		},
+		"CA certificates are signed by the sign-intermediate endpoint of a specific issuer": {
+			issuer:       cmapi.VaultIssuer{Path: "pki/issuer/my-issuer/sign/my-role"},
+			isCA:         true,
+			expectedPath: "pki/issuer/my-issuer/sign-intermediate",
+		},
+		"CA certificates with a mount path only": {
+			issuer:      cmapi.VaultIssuer{Path: "pki"},
+			isCA:        true,
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path, err := signPath(&test.issuer, test.isCA)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expectedErr, err)
+			}
+			if path != test.expectedPath {
+				t.Errorf("unexpected path, exp=%s got=%s", test.expectedPath, path)
+			}
+		})
+	}
+}
+
 func TestExtractCertificatesFromVaultCertificateSecret(t *testing.T) {
 	tests := map[string]testExtractCertificatesFromVaultCertT{
 		"when a Vault engine is a root CA": {
@@ -19,6 +19,7 @@ package acme
 import (
 	"context"
 	"crypto"
+	"crypto/tls"
 	"fmt"
 
 	core "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -47,6 +48,11 @@ type Acme struct {
 	// It can be stubbed in unit tests.
 	keyFromSecret keyFromSecretFunc
 
+	// clientCertificateFromSecret returns the client certificate used to
+	// connect to the ACME server from a Kubernetes secret. It can be stubbed
+	// in unit tests.
+	clientCertificateFromSecret clientCertificateFromSecretFunc
+
 	// clientBuilder builds a new ACME client.
 	clientBuilder accounts.NewClientFunc
 
@@ -68,14 +74,15 @@ func New(ctx *controller.Context, issuer v1.GenericIssuer) (issuer.Interface, er
 	secretsLister := ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister()
 
 	a := &Acme{
-		issuer:                   issuer,
-		keyFromSecret:            newKeyFromSecret(secretsLister),
-		clientBuilder:            accounts.NewClient,
-		secretsClient:            ctx.Client.CoreV1(),
-		recorder:                 ctx.Recorder,
-		clusterResourceNamespace: ctx.IssuerOptions.ClusterResourceNamespace,
-		accountRegistry:          ctx.ACMEOptions.AccountRegistry,
-		metrics:                  ctx.Metrics,
+		issuer:                      issuer,
+		keyFromSecret:               newKeyFromSecret(secretsLister),
+		clientCertificateFromSecret: newClientCertificateFromSecret(secretsLister),
+		clientBuilder:               accounts.NewClient,
+		secretsClient:               ctx.Client.CoreV1(),
+		recorder:                    ctx.Recorder,
+		clusterResourceNamespace:    ctx.IssuerOptions.ClusterResourceNamespace,
+		accountRegistry:             ctx.ACMEOptions.AccountRegistry,
+		metrics:                     ctx.Metrics,
 	}
 
 	return a, nil
@@ -92,6 +99,27 @@ func newKeyFromSecret(secretLister corelisters.SecretLister) keyFromSecretFunc {
 	}
 }
 
+// clientCertificateFromSecretFunc accepts name and namespace of a
+// kubernetes.io/tls secret, and returns the certificate and private key stored
+// in it.
+type clientCertificateFromSecretFunc func(ctx context.Context, namespace, name string) (*tls.Certificate, error)
+
+// newClientCertificateFromSecret returns an implementation of
+// clientCertificateFromSecretFunc for a secrets lister.
+func newClientCertificateFromSecret(secretLister corelisters.SecretLister) clientCertificateFromSecretFunc {
+	return func(ctx context.Context, namespace, name string) (*tls.Certificate, error) {
+		certs, key, err := kube.SecretTLSKeyPair(ctx, secretLister, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		cert := &tls.Certificate{PrivateKey: key, Leaf: certs[0]}
+		for _, c := range certs {
+			cert.Certificate = append(cert.Certificate, c.Raw)
+		}
+		return cert, nil
+	}
+}
+
 // Register this Issuer with the issuer factory
 func init() {
 	issuer.RegisterIssuer(apiutil.IssuerACME, New)
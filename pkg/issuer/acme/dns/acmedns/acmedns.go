@@ -72,6 +72,26 @@ func (c *DNSProvider) Present(domain, fqdn, value string) error {
 	return fmt.Errorf("account credentials not found for domain %s", domain)
 }
 
+// Account returns the account credentials for domain, if there are any.
+func (c *DNSProvider) Account(domain string) (goacmedns.Account, bool) {
+	account, exists := c.accounts[domain]
+	return account, exists
+}
+
+// RegisterAccount registers a new account with the acme-dns server. The
+// account is not used until it is added with AddAccount.
+func (c *DNSProvider) RegisterAccount() (goacmedns.Account, error) {
+	return c.client.RegisterAccount(nil)
+}
+
+// AddAccount sets the account credentials used for domain.
+func (c *DNSProvider) AddAccount(domain string, account goacmedns.Account) {
+	if c.accounts == nil {
+		c.accounts = make(map[string]goacmedns.Account)
+	}
+	c.accounts[domain] = account
+}
+
 // CleanUp removes the record matching the specified parameters. It is not
 // implemented for the ACME-DNS provider.
 func (c *DNSProvider) CleanUp(_, _, _ string) error {
@@ -17,6 +17,9 @@ limitations under the License.
 package acmedns
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -66,6 +69,45 @@ func TestNoValidJson(t *testing.T) {
 	assert.Error(t, err, "Expected error constructing DNSProvider from invalid JSON")
 }
 
+func TestRegisterAccount(t *testing.T) {
+	var updates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/register":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"fulldomain":"abc.auth.example.com","subdomain":"abc","username":"user","password":"pass"}`))
+		case "/update":
+			if r.Header.Get("X-Api-User") != "user" || r.Header.Get("X-Api-Key") != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			var update struct{ SubDomain, Txt string }
+			json.NewDecoder(r.Body).Decode(&update)
+			updates = append(updates, update.SubDomain+"="+update.Txt)
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewDNSProviderHostBytes(server.URL, []byte("{}"), util.RecursiveNameservers)
+	assert.NoError(t, err)
+
+	_, exists := provider.Account("example.com")
+	assert.False(t, exists)
+	assert.EqualError(t, provider.Present("example.com", "", "value"), "account credentials not found for domain example.com")
+
+	account, err := provider.RegisterAccount()
+	assert.NoError(t, err)
+	assert.Equal(t, "abc.auth.example.com", account.FullDomain)
+	assert.Equal(t, server.URL, account.ServerURL)
+
+	provider.AddAccount("example.com", account)
+	_, exists = provider.Account("example.com")
+	assert.True(t, exists)
+	assert.NoError(t, provider.Present("example.com", "", "value"))
+	assert.Equal(t, []string{"abc=value"}, updates)
+}
+
 func TestLiveAcmeDnsPresent(t *testing.T) {
 	if !acmednsLiveTest {
 		t.Skip("skipping live test")
@@ -0,0 +1,387 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bluecat implements a DNS provider for solving the DNS-01
+// challenge using the REST API of BlueCat Address Manager.
+package bluecat
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+	pkgutil "github.com/jetstack/cert-manager/pkg/util"
+)
+
+const (
+	// DefaultTTL is the TTL of challenge records when none is given.
+	DefaultTTL = 60
+
+	apiPath = "/Services/REST/v1"
+)
+
+// authTokenRegexp extracts the token from the response of the login
+// method, which has the form
+// "Session Token-> BAMAuthToken: <token> <- for User : <username>".
+var authTokenRegexp = regexp.MustCompile(`BAMAuthToken: [^ ]+`)
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	baseURL          string
+	username         string
+	password         string
+	configuration    string
+	view             string
+	zone             string
+	ttl              int32
+	client           *http.Client
+}
+
+// entity represents an object of Address Manager
+type entity struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Properties string `json:"properties"`
+}
+
+// NewDNSProviderCredentials uses the supplied credentials to return a
+// DNSProvider instance configured for the Address Manager at server.
+// Challenge records are created in the given zone of the view of the
+// configuration, or in the deepest zone of the view containing the record if
+// zone is empty. If caBundle is not empty it is used to validate the
+// certificate of Address Manager.
+func NewDNSProviderCredentials(server, username, password, configuration, view, zone string, ttl int32, caBundle []byte, dns01Nameservers []string) (*DNSProvider, error) {
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("BlueCat username and password are required")
+	}
+	if configuration == "" || view == "" {
+		return nil, fmt.Errorf("BlueCat configuration and view are required")
+	}
+
+	u, err := url.Parse(server)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("BlueCat server %q is not a valid URL", server)
+	}
+
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caBundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no certificates could be parsed from the BlueCat CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return &DNSProvider{
+		dns01Nameservers: dns01Nameservers,
+		baseURL:          strings.TrimSuffix(server, "/") + apiPath,
+		username:         username,
+		password:         password,
+		configuration:    configuration,
+		view:             view,
+		zone:             util.UnFqdn(zone),
+		ttl:              ttl,
+		client:           &http.Client{Timeout: 30 * time.Second, Transport: transport},
+	}, nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge, and deploys
+// the zone containing it.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	s, err := c.login()
+	if err != nil {
+		return err
+	}
+	defer s.logout()
+
+	zone, name, err := s.findZone(fqdn)
+	if err != nil {
+		return err
+	}
+
+	records, err := s.findTxtRecords(zone, name, value)
+	if err != nil {
+		return err
+	}
+	if len(records) > 0 {
+		// the record is already set to the desired value
+		return nil
+	}
+
+	properties := fmt.Sprintf("ttl=%d|absoluteName=%s|txt=%s|", c.ttl, util.UnFqdn(fqdn), value)
+	query := url.Values{}
+	query.Set("parentId", strconv.FormatInt(zone.ID, 10))
+	if err := s.makeRequest(http.MethodPost, "/addEntity?"+query.Encode(), entity{Name: name, Type: "TXTRecord", Properties: properties}, nil); err != nil {
+		return err
+	}
+
+	return s.deploy(zone)
+}
+
+// CleanUp removes the TXT record matching the specified parameters, and
+// deploys the zone containing it.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	s, err := c.login()
+	if err != nil {
+		return err
+	}
+	defer s.logout()
+
+	zone, name, err := s.findZone(fqdn)
+	if err != nil {
+		return err
+	}
+
+	records, err := s.findTxtRecords(zone, name, value)
+	if err != nil {
+		return err
+	}
+	// Nothing to cleanup
+	if len(records) == 0 {
+		return nil
+	}
+
+	for _, record := range records {
+		query := url.Values{}
+		query.Set("objectId", strconv.FormatInt(record.ID, 10))
+		if err := s.makeRequest(http.MethodDelete, "/delete?"+query.Encode(), nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return s.deploy(zone)
+}
+
+// session is an authenticated session with Address Manager.
+type session struct {
+	*DNSProvider
+	token string
+}
+
+func (c *DNSProvider) login() (*session, error) {
+	query := url.Values{}
+	query.Set("username", c.username)
+	query.Set("password", c.password)
+
+	var msg string
+	s := &session{DNSProvider: c}
+	if err := s.makeRequest(http.MethodGet, "/login?"+query.Encode(), nil, &msg); err != nil {
+		return nil, fmt.Errorf("error logging in to BlueCat Address Manager as %q: %v", c.username, err)
+	}
+
+	s.token = authTokenRegexp.FindString(msg)
+	if s.token == "" {
+		return nil, fmt.Errorf("error logging in to BlueCat Address Manager as %q: no token in response", c.username)
+	}
+	return s, nil
+}
+
+func (s *session) logout() {
+	// The session expires anyway, so errors are ignored.
+	_ = s.makeRequest(http.MethodGet, "/logout", nil, nil)
+}
+
+// findZone returns the zone in which the TXT record for fqdn is created, and
+// the name of the record relative to the zone.
+func (s *session) findZone(fqdn string) (*entity, string, error) {
+	conf, err := s.getEntityByName(0, s.configuration, "Configuration")
+	if err != nil {
+		return nil, "", err
+	}
+	if conf == nil {
+		return nil, "", fmt.Errorf("BlueCat configuration %q not found", s.configuration)
+	}
+	view, err := s.getEntityByName(conf.ID, s.view, "View")
+	if err != nil {
+		return nil, "", err
+	}
+	if view == nil {
+		return nil, "", fmt.Errorf("BlueCat view %q not found in configuration %q", s.view, s.configuration)
+	}
+
+	name := util.UnFqdn(fqdn)
+	if s.zone != "" {
+		if !strings.HasSuffix(name, "."+s.zone) {
+			return nil, "", fmt.Errorf("%q is not in BlueCat zone %q", name, s.zone)
+		}
+		zone, depth, err := s.walkZones(view, s.zone)
+		if err != nil {
+			return nil, "", err
+		}
+		if zone == nil || depth != len(strings.Split(s.zone, ".")) {
+			return nil, "", fmt.Errorf("BlueCat zone %q not found in view %q", s.zone, s.view)
+		}
+		return zone, strings.TrimSuffix(name, "."+s.zone), nil
+	}
+
+	zone, depth, err := s.walkZones(view, name)
+	if err != nil {
+		return nil, "", err
+	}
+	if zone == nil {
+		return nil, "", fmt.Errorf("no BlueCat zone for %q found in view %q", name, s.view)
+	}
+	labels := strings.Split(name, ".")
+	return zone, strings.Join(labels[:len(labels)-depth], "."), nil
+}
+
+// walkZones returns the deepest zone of the view containing name, and the
+// number of labels of its name. Zones are nested by label from the top level
+// domain down.
+func (s *session) walkZones(view *entity, name string) (*entity, int, error) {
+	labels := strings.Split(name, ".")
+
+	var zone *entity
+	parent := view
+	depth := 0
+	for i := len(labels) - 1; i >= 0; i-- {
+		child, err := s.getEntityByName(parent.ID, labels[i], "Zone")
+		if err != nil {
+			return nil, 0, err
+		}
+		if child == nil {
+			break
+		}
+		zone, parent = child, child
+		depth++
+	}
+	return zone, depth, nil
+}
+
+// getEntityByName returns the child of a parent with the given name and
+// type, or nil if there is none.
+func (s *session) getEntityByName(parentID int64, name, entityType string) (*entity, error) {
+	query := url.Values{}
+	query.Set("parentId", strconv.FormatInt(parentID, 10))
+	query.Set("name", name)
+	query.Set("type", entityType)
+
+	var e entity
+	if err := s.makeRequest(http.MethodGet, "/getEntityByName?"+query.Encode(), nil, &e); err != nil {
+		return nil, err
+	}
+	// Address Manager returns an empty entity if none is found.
+	if e.ID == 0 {
+		return nil, nil
+	}
+	return &e, nil
+}
+
+func (s *session) findTxtRecords(zone *entity, name, value string) ([]entity, error) {
+	query := url.Values{}
+	query.Set("parentId", strconv.FormatInt(zone.ID, 10))
+	query.Set("name", name)
+	query.Set("type", "TXTRecord")
+	query.Set("start", "0")
+	query.Set("count", "100")
+
+	var entities []entity
+	if err := s.makeRequest(http.MethodGet, "/getEntitiesByName?"+query.Encode(), nil, &entities); err != nil {
+		return nil, err
+	}
+
+	var records []entity
+	for _, e := range entities {
+		if parseProperties(e.Properties)["txt"] == value {
+			records = append(records, e)
+		}
+	}
+	return records, nil
+}
+
+// deploy deploys the changes made to the zone to its DNS servers.
+func (s *session) deploy(zone *entity) error {
+	query := url.Values{}
+	query.Set("entityId", strconv.FormatInt(zone.ID, 10))
+	return s.makeRequest(http.MethodPost, "/quickDeploy?"+query.Encode(), nil, nil)
+}
+
+func (s *session) makeRequest(method, uri string, reqBody, respBody interface{}) error {
+	var body []byte
+	if reqBody != nil {
+		var err error
+		body, err = json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, s.baseURL+uri, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", s.token)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", pkgutil.CertManagerUserAgent)
+
+	// Only the path is included in errors, as the query of the login
+	// method contains the password.
+	path := uri
+	if i := strings.Index(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("while querying the BlueCat API for %s %q: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	respData, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("while querying the BlueCat API for %s %q: %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(respData)))
+	}
+
+	if respBody == nil || len(respData) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respData, respBody)
+}
+
+// parseProperties parses the properties of an entity, which have the form
+// "key=value|key=value|".
+func parseProperties(properties string) map[string]string {
+	m := make(map[string]string)
+	for _, p := range strings.Split(properties, "|") {
+		if i := strings.Index(p, "="); i > 0 {
+			m[p[:i]] = p[i+1:]
+		}
+	}
+	return m
+}
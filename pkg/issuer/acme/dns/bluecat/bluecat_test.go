@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bluecat
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// fakeBAM is a fake of the REST API of BlueCat Address Manager, with the
+// configuration "main", the view "internal" and the zones "com" and
+// "example.com".
+type fakeBAM struct {
+	t *testing.T
+
+	lock     sync.Mutex
+	entities map[int64]entity
+	parents  map[int64]int64
+	nextID   int64
+	deploys  []int64
+	loggedIn bool
+}
+
+func newFakeBAM(t *testing.T) *fakeBAM {
+	f := &fakeBAM{t: t, entities: map[int64]entity{}, parents: map[int64]int64{}}
+	conf := f.add(0, entity{Name: "main", Type: "Configuration"})
+	view := f.add(conf, entity{Name: "internal", Type: "View"})
+	com := f.add(view, entity{Name: "com", Type: "Zone"})
+	f.add(com, entity{Name: "example", Type: "Zone"})
+	return f
+}
+
+func (f *fakeBAM) add(parentID int64, e entity) int64 {
+	f.nextID++
+	e.ID = f.nextID
+	f.entities[e.ID] = e
+	f.parents[e.ID] = parentID
+	return e.ID
+}
+
+func (f *fakeBAM) txtRecords() []entity {
+	var records []entity
+	for _, e := range f.entities {
+		if e.Type == "TXTRecord" {
+			records = append(records, e)
+		}
+	}
+	return records
+}
+
+func (f *fakeBAM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, apiPath)
+	q := r.URL.Query()
+	if path == "/login" {
+		if q.Get("username") != "api" || q.Get("password") != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode("Invalid username or password")
+			return
+		}
+		f.loggedIn = true
+		json.NewEncoder(w).Encode("Session Token-> BAMAuthToken: tok <- for User : api")
+		return
+	}
+	if r.Header.Get("Authorization") != "BAMAuthToken: tok" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	parentID, _ := strconv.ParseInt(q.Get("parentId"), 10, 64)
+
+	switch {
+	case r.Method == http.MethodGet && path == "/logout":
+		f.loggedIn = false
+	case r.Method == http.MethodGet && path == "/getEntityByName":
+		found := entity{}
+		for id, e := range f.entities {
+			if f.parents[id] == parentID && e.Name == q.Get("name") && e.Type == q.Get("type") {
+				found = e
+			}
+		}
+		json.NewEncoder(w).Encode(found)
+	case r.Method == http.MethodGet && path == "/getEntitiesByName":
+		found := []entity{}
+		for id, e := range f.entities {
+			if f.parents[id] == parentID && e.Name == q.Get("name") && e.Type == q.Get("type") {
+				found = append(found, e)
+			}
+		}
+		json.NewEncoder(w).Encode(found)
+	case r.Method == http.MethodPost && path == "/addEntity":
+		var e entity
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			f.t.Errorf("error decoding entity: %v", err)
+		}
+		json.NewEncoder(w).Encode(f.add(parentID, e))
+	case r.Method == http.MethodDelete && path == "/delete":
+		id, _ := strconv.ParseInt(q.Get("objectId"), 10, 64)
+		delete(f.entities, id)
+		delete(f.parents, id)
+	case r.Method == http.MethodPost && path == "/quickDeploy":
+		id, _ := strconv.ParseInt(q.Get("entityId"), 10, 64)
+		f.deploys = append(f.deploys, id)
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestProvider(t *testing.T, password, zone string) (*DNSProvider, *fakeBAM) {
+	fake := newFakeBAM(t)
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	provider, err := NewDNSProviderCredentials(server.URL, "api", password, "main", "internal", zone, 0, nil, util.RecursiveNameservers)
+	assert.NoError(t, err)
+	return provider, fake
+}
+
+func TestNewDNSProviderCredentials(t *testing.T) {
+	provider, err := NewDNSProviderCredentials("https://bam.example.com/", "api", "password", "main", "internal", "example.com.", 120, nil, util.RecursiveNameservers)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://bam.example.com/Services/REST/v1", provider.baseURL)
+	assert.Equal(t, "example.com", provider.zone)
+	assert.Equal(t, int32(120), provider.ttl)
+
+	_, err = NewDNSProviderCredentials("https://bam.example.com", "api", "password", "main", "", "", 0, nil, util.RecursiveNameservers)
+	assert.EqualError(t, err, "BlueCat configuration and view are required")
+
+	_, err = NewDNSProviderCredentials("https://bam.example.com", "", "password", "main", "internal", "", 0, nil, util.RecursiveNameservers)
+	assert.EqualError(t, err, "BlueCat username and password are required")
+
+	_, err = NewDNSProviderCredentials("bam.example.com", "api", "password", "main", "internal", "", 0, nil, util.RecursiveNameservers)
+	assert.EqualError(t, err, `BlueCat server "bam.example.com" is not a valid URL`)
+}
+
+func TestBlueCatPresentAndCleanUp(t *testing.T) {
+	provider, fake := newTestProvider(t, "password", "")
+	fqdn := "_acme-challenge.www.example.com."
+
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "value"))
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "other-value"))
+	// presenting an existing value is a no-op
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "value"))
+
+	records := fake.txtRecords()
+	assert.Len(t, records, 2)
+	for _, rec := range records {
+		// records are created in the deepest zone, example.com
+		assert.Equal(t, int64(4), fake.parents[rec.ID])
+		assert.Equal(t, "_acme-challenge.www", rec.Name)
+		assert.Equal(t, "60", parseProperties(rec.Properties)["ttl"])
+		assert.Equal(t, "_acme-challenge.www.example.com", parseProperties(rec.Properties)["absoluteName"])
+	}
+	assert.Equal(t, []int64{4, 4}, fake.deploys)
+
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "value"))
+	records = fake.txtRecords()
+	assert.Len(t, records, 1)
+	assert.Equal(t, "other-value", parseProperties(records[0].Properties)["txt"])
+	assert.Equal(t, []int64{4, 4, 4}, fake.deploys)
+
+	// cleaning up a missing value is a no-op
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "value"))
+	assert.Equal(t, []int64{4, 4, 4}, fake.deploys)
+	assert.False(t, fake.loggedIn)
+}
+
+func TestBlueCatExplicitZone(t *testing.T) {
+	provider, fake := newTestProvider(t, "password", "com")
+
+	assert.NoError(t, provider.Present("www.example.com", "_acme-challenge.www.example.com.", "value"))
+	records := fake.txtRecords()
+	assert.Len(t, records, 1)
+	assert.Equal(t, int64(3), fake.parents[records[0].ID])
+	assert.Equal(t, "_acme-challenge.www.example", records[0].Name)
+
+	provider.zone = "example.org"
+	err := provider.Present("www.example.com", "_acme-challenge.www.example.com.", "value")
+	assert.EqualError(t, err, `"_acme-challenge.www.example.com" is not in BlueCat zone "example.org"`)
+}
+
+func TestBlueCatInvalidCredentials(t *testing.T) {
+	provider, _ := newTestProvider(t, "wrong", "")
+
+	err := provider.Present("www.example.com", "_acme-challenge.www.example.com.", "value")
+	assert.EqualError(t, err, `error logging in to BlueCat Address Manager as "api": while querying the BlueCat API for GET "/login": 401: "Invalid username or password"`)
+}
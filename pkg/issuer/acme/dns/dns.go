@@ -23,9 +23,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cpu/goacmedns"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/jetstack/cert-manager/pkg/acme/webhook"
 	whapi "github.com/jetstack/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
@@ -33,12 +37,17 @@ import (
 	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	"github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/acmedns"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/akamai"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/azuredns"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/bluecat"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/gandi"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/infoblox"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/ovh"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/rfc2136"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
@@ -63,6 +72,10 @@ type dnsProviderConstructors struct {
 	azureDNS     func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*azuredns.DNSProvider, error)
 	acmeDNS      func(host string, accountJson []byte, dns01Nameservers []string) (*acmedns.DNSProvider, error)
 	digitalOcean func(token string, dns01Nameservers []string) (*digitalocean.DNSProvider, error)
+	ovh          func(endpoint, applicationKey, applicationSecret, consumerKey string, dns01Nameservers []string) (*ovh.DNSProvider, error)
+	gandi        func(personalAccessToken string, dns01Nameservers []string) (*gandi.DNSProvider, error)
+	infoblox     func(server, wapiVersion, username, password, view string, ttl int32, caBundle []byte, dns01Nameservers []string) (*infoblox.DNSProvider, error)
+	blueCat      func(server, username, password, configuration, view, zone string, ttl int32, caBundle []byte, dns01Nameservers []string) (*bluecat.DNSProvider, error)
 }
 
 // Solver is a solver for the acme dns01 challenge.
@@ -99,11 +112,90 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return err
 	}
 
+	if p, ok := slv.(*acmedns.DNSProvider); ok && p != nil {
+		if err := s.prepareAcmeDNSAccount(ctx, p, providerConfig.AcmeDNS, s.ResourceNamespace(issuer), ch); err != nil {
+			return err
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("presenting DNS01 challenge for domain")
 
 	return slv.Present(ch.Spec.DNSName, fqdn, ch.Spec.Key)
 }
 
+// prepareAcmeDNSAccount records the acme-dns account of the challenged
+// domain in the status of the challenge. If the domain has no account and
+// registration is allowed, an account is registered and stored in the account
+// secret first.
+func (s *Solver) prepareAcmeDNSAccount(ctx context.Context, p *acmedns.DNSProvider, cfg *cmacme.ACMEIssuerDNS01ProviderAcmeDNS, namespace string, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx)
+	domain := ch.Spec.DNSName
+
+	if account, exists := p.Account(domain); exists {
+		registered := ch.Status.AcmeDNS != nil && ch.Status.AcmeDNS.Registered
+		ch.Status.AcmeDNS = &cmacme.ACMEDNSAccountStatus{FullDomain: account.FullDomain, Registered: registered}
+		return nil
+	}
+	if !cfg.AllowRegistration {
+		return nil
+	}
+
+	var account *goacmedns.Account
+	registered := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// The account secret is read from the API server rather than the
+		// lister, so that accounts stored by concurrent syncs are not lost.
+		secret, err := s.Client.CoreV1().Secrets(namespace).Get(ctx, cfg.AccountSecret.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		accounts := make(map[string]goacmedns.Account)
+		if data := secret.Data[cfg.AccountSecret.Key]; len(data) > 0 {
+			if err := json.Unmarshal(data, &accounts); err != nil {
+				return fmt.Errorf("error unmarshalling acmedns accounts secret: %v", err)
+			}
+		}
+		if existing, exists := accounts[domain]; exists {
+			account = &existing
+			return nil
+		}
+
+		if account == nil {
+			newAccount, err := p.RegisterAccount()
+			if err != nil {
+				return fmt.Errorf("error registering acmedns account for %q: %v", domain, err)
+			}
+			account = &newAccount
+			registered = true
+		}
+
+		accounts[domain] = *account
+		data, err := json.Marshal(accounts)
+		if err != nil {
+			return err
+		}
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[cfg.AccountSecret.Key] = data
+		_, err = s.Client.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error storing acmedns account in secret %q: %v", cfg.AccountSecret.Name, err)
+	}
+
+	p.AddAccount(domain, *account)
+	ch.Status.AcmeDNS = &cmacme.ACMEDNSAccountStatus{FullDomain: account.FullDomain, Registered: registered}
+	if registered {
+		log.V(logf.InfoLevel).Info("registered acmedns account", "fulldomain", account.FullDomain)
+		s.Recorder.Eventf(ch, corev1.EventTypeNormal, events.ReasonAcmeDNSAccountRegistered,
+			"Registered acme-dns account for %s; create a CNAME record from _acme-challenge.%s to %s", domain, domain, account.FullDomain)
+	}
+	return nil
+}
+
 // Check verifies that the DNS records for the ACME challenge have propagated.
 func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	log := logf.WithResource(logf.FromContext(ctx, "Check"), ch).WithValues("domain", ch.Spec.DNSName)
@@ -121,6 +213,9 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 		return err
 	}
 	if !ok {
+		if ch.Status.AcmeDNS != nil {
+			return fmt.Errorf("DNS record for %q not yet propagated; check that _acme-challenge.%s is a CNAME record for %s", ch.Spec.DNSName, ch.Spec.DNSName, ch.Status.AcmeDNS.FullDomain)
+		}
 		return fmt.Errorf("DNS record for %q not yet propagated", ch.Spec.DNSName)
 	}
 
@@ -164,6 +259,27 @@ func followCNAME(strategy cmacme.CNAMEStrategy) bool {
 	return strategy == cmacme.FollowStrategy
 }
 
+// cloudflareZoneAPIToken returns the reference to the API token scoped to the
+// longest zone containing fqdn, or nil if there is none.
+func cloudflareZoneAPIToken(cfg *cmacme.ACMEIssuerDNS01ProviderCloudflare, fqdn string) *cmmeta.SecretKeySelector {
+	fqdn = strings.ToLower(util.ToFqdn(fqdn))
+
+	var longest string
+	var token *cmmeta.SecretKeySelector
+	for zone, ref := range cfg.ZoneAPITokens {
+		zone = strings.ToLower(util.ToFqdn(strings.TrimPrefix(zone, ".")))
+		if fqdn != zone && !strings.HasSuffix(fqdn, "."+zone) {
+			continue
+		}
+		if len(zone) > len(longest) {
+			longest = zone
+			ref := ref
+			token = &ref
+		}
+	}
+	return token
+}
+
 func extractChallengeSolverConfig(ch *cmacme.Challenge) (*cmacme.ACMEChallengeSolverDNS01, error) {
 	if ch.Spec.Solver.DNS01 == nil {
 		return nil, fmt.Errorf("no dns01 challenge solver configuration found")
@@ -172,6 +288,55 @@ func extractChallengeSolverConfig(ch *cmacme.Challenge) (*cmacme.ACMEChallengeSo
 	return ch.Spec.Solver.DNS01, nil
 }
 
+// SecretNames returns the names of the Secret resources which hold the
+// credentials of the DNS provider configured by the given DNS01 solver.
+// Providers are constructed with the current contents of these Secrets every
+// time a challenge is presented or cleaned up, so Challenges which are still
+// being processed should be retried when one of them changes.
+// The Secrets used by webhook solvers are not known to cert-manager and are
+// not returned.
+func SecretNames(cfg *cmacme.ACMEChallengeSolverDNS01) []string {
+	var selectors []*cmmeta.SecretKeySelector
+	switch {
+	case cfg.Akamai != nil:
+		selectors = append(selectors, &cfg.Akamai.ClientToken, &cfg.Akamai.ClientSecret, &cfg.Akamai.AccessToken)
+	case cfg.CloudDNS != nil:
+		selectors = append(selectors, cfg.CloudDNS.ServiceAccount)
+	case cfg.Cloudflare != nil:
+		selectors = append(selectors, cfg.Cloudflare.APIKey, cfg.Cloudflare.APIToken)
+		for _, token := range cfg.Cloudflare.ZoneAPITokens {
+			token := token
+			selectors = append(selectors, &token)
+		}
+	case cfg.Route53 != nil:
+		selectors = append(selectors, &cfg.Route53.SecretAccessKey)
+	case cfg.AzureDNS != nil:
+		selectors = append(selectors, cfg.AzureDNS.ClientSecret)
+	case cfg.DigitalOcean != nil:
+		selectors = append(selectors, &cfg.DigitalOcean.Token)
+	case cfg.OVH != nil:
+		selectors = append(selectors, &cfg.OVH.ApplicationSecret, &cfg.OVH.ConsumerKey)
+	case cfg.Gandi != nil:
+		selectors = append(selectors, &cfg.Gandi.PersonalAccessToken)
+	case cfg.Infoblox != nil:
+		selectors = append(selectors, &cfg.Infoblox.Password)
+	case cfg.BlueCat != nil:
+		selectors = append(selectors, &cfg.BlueCat.Password)
+	case cfg.AcmeDNS != nil:
+		selectors = append(selectors, &cfg.AcmeDNS.AccountSecret)
+	case cfg.RFC2136 != nil:
+		selectors = append(selectors, &cfg.RFC2136.TSIGSecret)
+	}
+
+	var names []string
+	for _, selector := range selectors {
+		if selector != nil && selector.Name != "" {
+			names = append(names, selector.Name)
+		}
+	}
+	return names
+}
+
 // solverForChallenge returns a Solver for the given providerName.
 // The providerName is the name of an ACME DNS-01 challenge provider as
 // specified on the Issuer resource for the Solver.
@@ -246,13 +411,28 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			return nil, nil, fmt.Errorf("API key and API token secret references are both present")
 		}
 
+		// tokens scoped to the zone containing the challenge record take
+		// precedence over the account wide API key or token.
+		fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), s.DNS01Nameservers...)
+		if err != nil {
+			return nil, nil, err
+		}
+		zoneAPIToken := cloudflareZoneAPIToken(providerConfig.Cloudflare, fqdn)
+
 		var saSecretName, saSecretKey string
-		if providerConfig.Cloudflare.APIKey != nil {
+		switch {
+		case zoneAPIToken != nil:
+			dbg.Info("using API token scoped to zone of challenge record", "fqdn", fqdn)
+			saSecretName = zoneAPIToken.Name
+			saSecretKey = zoneAPIToken.Key
+		case providerConfig.Cloudflare.APIKey != nil:
 			saSecretName = providerConfig.Cloudflare.APIKey.Name
 			saSecretKey = providerConfig.Cloudflare.APIKey.Key
-		} else {
+		case providerConfig.Cloudflare.APIToken != nil:
 			saSecretName = providerConfig.Cloudflare.APIToken.Name
 			saSecretKey = providerConfig.Cloudflare.APIToken.Key
+		default:
+			return nil, nil, fmt.Errorf("no API token secret reference matches the zone of %q", fqdn)
 		}
 
 		saSecret, err := s.secretLister.Secrets(resourceNamespace).Get(saSecretName)
@@ -266,7 +446,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		}
 
 		var apiKey, apiToken string
-		if providerConfig.Cloudflare.APIKey != nil {
+		if zoneAPIToken == nil && providerConfig.Cloudflare.APIKey != nil {
 			apiKey = string(keyData)
 		} else {
 			apiToken = string(keyData)
@@ -290,6 +470,77 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating digitalocean challenge solver: %s", err.Error())
 		}
+	case providerConfig.OVH != nil:
+		dbg.Info("preparing to create OVH provider")
+		applicationSecret, err := s.loadSecretData(&providerConfig.OVH.ApplicationSecret, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting ovh application secret")
+		}
+
+		consumerKey, err := s.loadSecretData(&providerConfig.OVH.ConsumerKey, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting ovh consumer key")
+		}
+
+		impl, err = s.dnsProviderConstructors.ovh(
+			providerConfig.OVH.Endpoint,
+			providerConfig.OVH.ApplicationKey,
+			strings.TrimSpace(string(applicationSecret)),
+			strings.TrimSpace(string(consumerKey)),
+			s.DNS01Nameservers)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating ovh challenge solver")
+		}
+	case providerConfig.Gandi != nil:
+		dbg.Info("preparing to create Gandi provider")
+		personalAccessToken, err := s.loadSecretData(&providerConfig.Gandi.PersonalAccessToken, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting gandi personal access token")
+		}
+
+		impl, err = s.dnsProviderConstructors.gandi(strings.TrimSpace(string(personalAccessToken)), s.DNS01Nameservers)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating gandi challenge solver")
+		}
+	case providerConfig.Infoblox != nil:
+		dbg.Info("preparing to create Infoblox provider")
+		password, err := s.loadSecretData(&providerConfig.Infoblox.Password, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting infoblox password")
+		}
+
+		impl, err = s.dnsProviderConstructors.infoblox(
+			providerConfig.Infoblox.Server,
+			providerConfig.Infoblox.WAPIVersion,
+			providerConfig.Infoblox.Username,
+			strings.TrimSpace(string(password)),
+			providerConfig.Infoblox.View,
+			providerConfig.Infoblox.TTL,
+			providerConfig.Infoblox.CABundle,
+			s.DNS01Nameservers)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating infoblox challenge solver")
+		}
+	case providerConfig.BlueCat != nil:
+		dbg.Info("preparing to create BlueCat provider")
+		password, err := s.loadSecretData(&providerConfig.BlueCat.Password, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting bluecat password")
+		}
+
+		impl, err = s.dnsProviderConstructors.blueCat(
+			providerConfig.BlueCat.Server,
+			providerConfig.BlueCat.Username,
+			strings.TrimSpace(string(password)),
+			providerConfig.BlueCat.Configuration,
+			providerConfig.BlueCat.View,
+			providerConfig.BlueCat.Zone,
+			providerConfig.BlueCat.TTL,
+			providerConfig.BlueCat.CABundle,
+			s.DNS01Nameservers)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating bluecat challenge solver")
+		}
 	case providerConfig.Route53 != nil:
 		dbg.Info("preparing to create Route53 provider")
 		secretAccessKey := ""
@@ -358,9 +609,13 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		}
 
 		accountSecretBytes, ok := accountSecret.Data[providerConfig.AcmeDNS.AccountSecret.Key]
-		if !ok {
+		if !ok && !providerConfig.AcmeDNS.AllowRegistration {
 			return nil, nil, fmt.Errorf("error getting acmedns accounts secret: key '%s' not found in secret", providerConfig.AcmeDNS.AccountSecret.Key)
 		}
+		if len(accountSecretBytes) == 0 && providerConfig.AcmeDNS.AllowRegistration {
+			// accounts are registered on demand
+			accountSecretBytes = []byte("{}")
+		}
 
 		impl, err = s.dnsProviderConstructors.acmeDNS(
 			providerConfig.AcmeDNS.Host,
@@ -479,6 +734,10 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 			azuredns.NewDNSProviderCredentials,
 			acmedns.NewDNSProviderHostBytes,
 			digitalocean.NewDNSProviderCredentials,
+			ovh.NewDNSProviderCredentials,
+			gandi.NewDNSProviderCredentials,
+			infoblox.NewDNSProviderCredentials,
+			bluecat.NewDNSProviderCredentials,
 		},
 		webhookSolvers: initialized,
 	}, nil
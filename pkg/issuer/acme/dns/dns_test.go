@@ -18,9 +18,14 @@ package dns
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
+	"github.com/cpu/goacmedns"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -351,6 +356,330 @@ func TestSolveForDigitalOcean(t *testing.T) {
 
 }
 
+func TestSolveForOVH(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("ovh", "default", map[string][]byte{
+					"application-secret": []byte("FAKE-SECRET\n"),
+					"consumer-key":       []byte("FAKE-CONSUMER-KEY"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						OVH: &cmacme.ACMEIssuerDNS01ProviderOVH{
+							Endpoint:       "ovh-eu",
+							ApplicationKey: "FAKE-KEY",
+							ApplicationSecret: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "ovh",
+								},
+								Key: "application-secret",
+							},
+							ConsumerKey: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "ovh",
+								},
+								Key: "consumer-key",
+							},
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedOVHCall := []fakeDNSProviderCall{
+		{
+			name: "ovh",
+			args: []interface{}{"ovh-eu", "FAKE-KEY", "FAKE-SECRET", "FAKE-CONSUMER-KEY", util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedOVHCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedOVHCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForGandi(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("gandi", "default", map[string][]byte{
+					"token": []byte("FAKE-TOKEN"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						Gandi: &cmacme.ACMEIssuerDNS01ProviderGandi{
+							PersonalAccessToken: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "gandi",
+								},
+								Key: "token",
+							},
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedGandiCall := []fakeDNSProviderCall{
+		{
+			name: "gandi",
+			args: []interface{}{"FAKE-TOKEN", util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedGandiCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedGandiCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForInfoblox(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("infoblox", "default", map[string][]byte{
+					"password": []byte("FAKE-PASSWORD\n"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						Infoblox: &cmacme.ACMEIssuerDNS01ProviderInfoblox{
+							Server:      "https://gm.example.com",
+							WAPIVersion: "2.7",
+							Username:    "admin",
+							Password: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "infoblox",
+								},
+								Key: "password",
+							},
+							View: "external",
+							TTL:  120,
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedInfobloxCall := []fakeDNSProviderCall{
+		{
+			name: "infoblox",
+			args: []interface{}{"https://gm.example.com", "2.7", "admin", "FAKE-PASSWORD", "external", int32(120), []byte(nil), util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedInfobloxCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedInfobloxCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForBlueCat(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("bluecat", "default", map[string][]byte{
+					"password": []byte("FAKE-PASSWORD"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						BlueCat: &cmacme.ACMEIssuerDNS01ProviderBlueCat{
+							Server:   "https://bam.example.com",
+							Username: "api",
+							Password: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "bluecat",
+								},
+								Key: "password",
+							},
+							Configuration: "main",
+							View:          "internal",
+							Zone:          "example.com",
+							CABundle:      []byte("FAKE-CA"),
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedBlueCatCall := []fakeDNSProviderCall{
+		{
+			name: "bluecat",
+			args: []interface{}{"https://bam.example.com", "api", "FAKE-PASSWORD", "main", "internal", "example.com", int32(0), []byte("FAKE-CA"), util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedBlueCatCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedBlueCatCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForCloudflareZoneAPITokens(t *testing.T) {
+	secretRef := func(name string) cmmeta.SecretKeySelector {
+		return cmmeta.SecretKeySelector{
+			LocalObjectReference: cmmeta.LocalObjectReference{
+				Name: name,
+			},
+			Key: "api-token",
+		}
+	}
+	fallbackToken := secretRef("cloudflare-token")
+
+	tests := map[string]struct {
+		dnsName       string
+		fallback      *cmmeta.SecretKeySelector
+		expectedToken string
+		expectErr     bool
+	}{
+		"selects the token of the zone of the challenge record": {
+			dnsName:       "www.example.com",
+			expectedToken: "example-com-token",
+		},
+		"selects the token of the longest matching zone": {
+			dnsName:       "www.sub.example.com",
+			expectedToken: "sub-example-com-token",
+		},
+		"matches zones case insensitively": {
+			dnsName:       "WWW.Example.COM",
+			expectedToken: "example-com-token",
+		},
+		"does not match a zone that is only a suffix of a label": {
+			dnsName:   "www.notexample.com",
+			expectErr: true,
+		},
+		"falls back to the api token if no zone matches": {
+			dnsName:       "www.example.org",
+			fallback:      &fallbackToken,
+			expectedToken: "fallback-token",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &solverFixture{
+				Builder: &test.Builder{
+					KubeObjects: []runtime.Object{
+						newSecret("example-com", "default", map[string][]byte{
+							"api-token": []byte("example-com-token"),
+						}),
+						newSecret("sub-example-com", "default", map[string][]byte{
+							"api-token": []byte("sub-example-com-token"),
+						}),
+						newSecret("cloudflare-token", "default", map[string][]byte{
+							"api-token": []byte("fallback-token"),
+						}),
+					},
+				},
+				Issuer: newIssuer("test", "default"),
+				Challenge: &cmacme.Challenge{
+					Spec: cmacme.ChallengeSpec{
+						DNSName: tc.dnsName,
+						Solver: cmacme.ACMEChallengeSolver{
+							DNS01: &cmacme.ACMEChallengeSolverDNS01{
+								Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+									Email:    "test",
+									APIToken: tc.fallback,
+									ZoneAPITokens: map[string]cmmeta.SecretKeySelector{
+										"example.com":      secretRef("example-com"),
+										"sub.example.com.": secretRef("sub-example-com"),
+									},
+								},
+							},
+						},
+					},
+				},
+				dnsProviders: newFakeDNSProviders(),
+			}
+
+			f.Setup(t)
+			defer f.Finish(t)
+
+			s := f.Solver
+			_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected solverFor to error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected solverFor to not error, but got: %s", err)
+			}
+
+			expectedCFCall := []fakeDNSProviderCall{
+				{
+					name: "cloudflare",
+					args: []interface{}{"test", "", tc.expectedToken, util.RecursiveNameservers},
+				},
+			}
+			if !reflect.DeepEqual(expectedCFCall, f.dnsProviders.calls) {
+				t.Fatalf("expected %+v == %+v", expectedCFCall, f.dnsProviders.calls)
+			}
+		})
+	}
+}
+
 func TestRoute53TrimCreds(t *testing.T) {
 	f := &solverFixture{
 		Builder: &test.Builder{
@@ -584,3 +913,127 @@ func TestRoute53AssumeRole(t *testing.T) {
 		}
 	}
 }
+
+func TestPrepareAcmeDNSAccount(t *testing.T) {
+	registrations := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/register" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		registrations++
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"fulldomain":"new.auth.example.com","subdomain":"new","username":"user","password":"pass"}`))
+	}))
+	defer server.Close()
+
+	existing := goacmedns.Account{FullDomain: "old.auth.example.com", SubDomain: "old", Username: "user", Password: "pass"}
+	existingJSON, _ := json.Marshal(map[string]goacmedns.Account{"other.example.com": existing})
+	cfg := &cmacme.ACMEIssuerDNS01ProviderAcmeDNS{
+		Host: server.URL,
+		AccountSecret: cmmeta.SecretKeySelector{
+			LocalObjectReference: cmmeta.LocalObjectReference{Name: "acmedns"},
+			Key:                  "acmedns.json",
+		},
+	}
+
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("acmedns", "default", map[string][]byte{"acmedns.json": existingJSON}),
+			},
+		},
+	}
+	f.Setup(t)
+	defer f.Finish(t)
+
+	p, err := acmedns.NewDNSProviderHostBytes(server.URL, existingJSON, util.RecursiveNameservers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an existing account is reported, but not registered
+	ch := &cmacme.Challenge{Spec: cmacme.ChallengeSpec{DNSName: "other.example.com"}}
+	if err := f.Solver.prepareAcmeDNSAccount(context.Background(), p, cfg, "default", ch); err != nil {
+		t.Fatal(err)
+	}
+	if expected := (&cmacme.ACMEDNSAccountStatus{FullDomain: "old.auth.example.com"}); !reflect.DeepEqual(expected, ch.Status.AcmeDNS) {
+		t.Errorf("expected %+v == %+v", expected, ch.Status.AcmeDNS)
+	}
+
+	// a missing account is not registered unless allowed
+	ch = &cmacme.Challenge{Spec: cmacme.ChallengeSpec{DNSName: "example.com"}}
+	if err := f.Solver.prepareAcmeDNSAccount(context.Background(), p, cfg, "default", ch); err != nil {
+		t.Fatal(err)
+	}
+	if ch.Status.AcmeDNS != nil || registrations != 0 {
+		t.Errorf("expected no account to be registered, got %+v and %d registrations", ch.Status.AcmeDNS, registrations)
+	}
+
+	cfg.AllowRegistration = true
+	for i := 0; i < 2; i++ {
+		if err := f.Solver.prepareAcmeDNSAccount(context.Background(), p, cfg, "default", ch); err != nil {
+			t.Fatal(err)
+		}
+		if expected := (&cmacme.ACMEDNSAccountStatus{FullDomain: "new.auth.example.com", Registered: true}); !reflect.DeepEqual(expected, ch.Status.AcmeDNS) {
+			t.Errorf("expected %+v == %+v", expected, ch.Status.AcmeDNS)
+		}
+	}
+	if registrations != 1 {
+		t.Errorf("expected 1 registration, got %d", registrations)
+	}
+
+	secret, err := f.Client.CoreV1().Secrets("default").Get(context.Background(), "acmedns", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var accounts map[string]goacmedns.Account
+	if err := json.Unmarshal(secret.Data["acmedns.json"], &accounts); err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 2 || accounts["other.example.com"] != existing || accounts["example.com"].FullDomain != "new.auth.example.com" {
+		t.Errorf("unexpected accounts stored in secret: %+v", accounts)
+	}
+	if len(f.Events()) != 1 {
+		t.Errorf("expected 1 event, got %v", f.Events())
+	}
+}
+
+func TestSecretNames(t *testing.T) {
+	selector := func(name string) cmmeta.SecretKeySelector {
+		return cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: name}, Key: "key"}
+	}
+	apiToken := selector("api-token")
+	tests := map[string]struct {
+		cfg      cmacme.ACMEChallengeSolverDNS01
+		expected []string
+	}{
+		"Cloudflare with an API token and a zone API token": {
+			cfg: cmacme.ACMEChallengeSolverDNS01{Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+				APIToken:      &apiToken,
+				ZoneAPITokens: map[string]cmmeta.SecretKeySelector{"example.com": selector("zone-token")},
+			}},
+			expected: []string{"api-token", "zone-token"},
+		},
+		"Route53 with ambient credentials": {
+			cfg: cmacme.ACMEChallengeSolverDNS01{Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{Region: "eu-west-1"}},
+		},
+		"OVH": {
+			cfg: cmacme.ACMEChallengeSolverDNS01{OVH: &cmacme.ACMEIssuerDNS01ProviderOVH{
+				ApplicationSecret: selector("ovh"),
+				ConsumerKey:       selector("ovh"),
+			}},
+			expected: []string{"ovh", "ovh"},
+		},
+		"webhook": {
+			cfg: cmacme.ACMEChallengeSolverDNS01{Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{GroupName: "example.com", SolverName: "example"}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := SecretNames(&test.cfg); !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
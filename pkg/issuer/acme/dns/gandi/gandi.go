@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gandi implements a DNS provider for solving the DNS-01 challenge
+// using the Gandi LiveDNS API.
+package gandi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+	pkgutil "github.com/jetstack/cert-manager/pkg/util"
+)
+
+// LiveDNSAPIURL is the endpoint of the Gandi LiveDNS API.
+const LiveDNSAPIURL = "https://api.gandi.net/v5/livedns"
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers       []string
+	baseURL                string
+	personalAccessToken    string
+	client                 *http.Client
+	findHostedDomainByFqdn func(string, []string) (string, error)
+	TTL                    int
+}
+
+// gandiRRSet represents the TXT records of a name in a Gandi LiveDNS domain
+type gandiRRSet struct {
+	TTL    int      `json:"rrset_ttl,omitempty"`
+	Values []string `json:"rrset_values"`
+}
+
+var errNoExistingRecord = errors.New("no existing record found")
+
+// NewDNSProvider returns a DNSProvider instance configured for Gandi.
+// Credentials must be passed in the environment variable:
+// GANDI_PERSONAL_ACCESS_TOKEN.
+func NewDNSProvider(dns01Nameservers []string) (*DNSProvider, error) {
+	return NewDNSProviderCredentials(os.Getenv("GANDI_PERSONAL_ACCESS_TOKEN"), dns01Nameservers)
+}
+
+// NewDNSProviderCredentials uses the supplied personal access token to
+// return a DNSProvider instance configured for Gandi.
+func NewDNSProviderCredentials(personalAccessToken string, dns01Nameservers []string) (*DNSProvider, error) {
+	if personalAccessToken == "" {
+		return nil, fmt.Errorf("Gandi personal access token missing")
+	}
+
+	return &DNSProvider{
+		dns01Nameservers:       dns01Nameservers,
+		baseURL:                LiveDNSAPIURL,
+		personalAccessToken:    personalAccessToken,
+		client:                 &http.Client{Timeout: 30 * time.Second},
+		findHostedDomainByFqdn: findHostedDomainByFqdn,
+		// 300 seconds is the minimum TTL accepted by Gandi LiveDNS.
+		TTL: 300,
+	}, nil
+}
+
+func findHostedDomainByFqdn(fqdn string, ns []string) (string, error) {
+	zone, err := util.FindZoneByFqdn(fqdn, ns)
+	if err != nil {
+		return "", err
+	}
+
+	return util.UnFqdn(zone), nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge. Other values
+// of the TXT record, such as those of concurrent challenges for the same
+// name, are preserved.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	zone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return err
+	}
+	name := nameForZone(fqdn, zone)
+
+	rrset, err := c.getTxtRRSet(zone, name)
+	if err != nil && err != errNoExistingRecord {
+		return err
+	}
+	for _, v := range rrset.Values {
+		if txtValue(v) == value {
+			// the record is already set to the desired value
+			return nil
+		}
+	}
+
+	rrset.TTL = c.TTL
+	rrset.Values = append(rrset.Values, `"`+value+`"`)
+	return c.makeRequest(http.MethodPut, recordURI(zone, name), rrset, nil)
+}
+
+// CleanUp removes the value of the TXT record matching the specified
+// parameters, and the record itself if no other values remain.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	zone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return err
+	}
+	name := nameForZone(fqdn, zone)
+
+	rrset, err := c.getTxtRRSet(zone, name)
+	// Nothing to cleanup
+	if err == errNoExistingRecord {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var values []string
+	for _, v := range rrset.Values {
+		if txtValue(v) != value {
+			values = append(values, v)
+		}
+	}
+	switch {
+	case len(values) == len(rrset.Values):
+		return nil
+	case len(values) == 0:
+		return c.makeRequest(http.MethodDelete, recordURI(zone, name), nil, nil)
+	default:
+		rrset.Values = values
+		return c.makeRequest(http.MethodPut, recordURI(zone, name), rrset, nil)
+	}
+}
+
+func (c *DNSProvider) getTxtRRSet(zone, name string) (gandiRRSet, error) {
+	var rrset gandiRRSet
+	err := c.makeRequest(http.MethodGet, recordURI(zone, name), nil, &rrset)
+	return rrset, err
+}
+
+func (c *DNSProvider) makeRequest(method, uri string, reqBody, respBody interface{}) error {
+	var body []byte
+	if reqBody != nil {
+		var err error
+		body, err = json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+uri, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.personalAccessToken)
+	req.Header.Set("User-Agent", pkgutil.CertManagerUserAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("while querying the Gandi API for %s %q: %v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	respData, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if method == http.MethodGet && resp.StatusCode == http.StatusNotFound {
+		return errNoExistingRecord
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Cause   string `json:"cause"`
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(respData, &apiErr); err == nil && apiErr.Message != "" {
+			return fmt.Errorf("while querying the Gandi API for %s %q: %d: %s: %s", method, uri, resp.StatusCode, apiErr.Cause, apiErr.Message)
+		}
+		return fmt.Errorf("while querying the Gandi API for %s %q: %d", method, uri, resp.StatusCode)
+	}
+
+	if respBody == nil || len(respData) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respData, respBody)
+}
+
+func recordURI(zone, name string) string {
+	return fmt.Sprintf("/domains/%s/records/%s/TXT", zone, name)
+}
+
+// nameForZone returns the name of fqdn relative to zone.
+func nameForZone(fqdn, zone string) string {
+	return strings.TrimSuffix(util.UnFqdn(fqdn), "."+zone)
+}
+
+// txtValue returns the value of a TXT record, which may be quoted.
+func txtValue(v string) string {
+	return strings.Trim(v, `"`)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gandi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+const testRecordPath = "/domains/example.com/records/_acme-challenge.www/TXT"
+
+// fakeGandi is a fake of the records API of Gandi LiveDNS for a single
+// TXT record.
+type fakeGandi struct {
+	t *testing.T
+
+	lock  sync.Mutex
+	rrset *gandiRRSet
+}
+
+func (f *fakeGandi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"code":403,"cause":"Forbidden","message":"Access was denied to this resource."}`)
+		return
+	}
+	if r.URL.Path != testRecordPath {
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if f.rrset == nil {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":404,"cause":"Not Found","message":"Can't find the DNS record"}`)
+			return
+		}
+		json.NewEncoder(w).Encode(f.rrset)
+	case http.MethodPut:
+		f.rrset = &gandiRRSet{}
+		if err := json.NewDecoder(r.Body).Decode(f.rrset); err != nil {
+			f.t.Errorf("error decoding record: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		f.rrset = nil
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func newTestProvider(t *testing.T, token string) (*DNSProvider, *fakeGandi) {
+	fake := &fakeGandi{t: t}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	provider, err := NewDNSProviderCredentials(token, util.RecursiveNameservers)
+	assert.NoError(t, err)
+	provider.baseURL = server.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com", nil
+	}
+	return provider, fake
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	_, err := NewDNSProviderCredentials("", util.RecursiveNameservers)
+	assert.EqualError(t, err, "Gandi personal access token missing")
+}
+
+func TestGandiPresentAndCleanUp(t *testing.T) {
+	provider, fake := newTestProvider(t, "token")
+	fqdn := "_acme-challenge.www.example.com."
+
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "value"))
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "other-value"))
+	// presenting an existing value is a no-op
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "value"))
+	assert.Equal(t, &gandiRRSet{TTL: 300, Values: []string{`"value"`, `"other-value"`}}, fake.rrset)
+
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "value"))
+	assert.Equal(t, &gandiRRSet{TTL: 300, Values: []string{`"other-value"`}}, fake.rrset)
+
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "other-value"))
+	assert.Nil(t, fake.rrset)
+
+	// cleaning up a missing record is a no-op
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "other-value"))
+}
+
+func TestGandiInvalidCredentials(t *testing.T) {
+	provider, _ := newTestProvider(t, "wrong")
+
+	err := provider.Present("www.example.com", "_acme-challenge.www.example.com.", "value")
+	assert.EqualError(t, err, `while querying the Gandi API for GET "`+testRecordPath+`": 403: Forbidden: Access was denied to this resource.`)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package infoblox implements a DNS provider for solving the DNS-01
+// challenge using the Infoblox WAPI.
+package infoblox
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+	pkgutil "github.com/jetstack/cert-manager/pkg/util"
+)
+
+const (
+	// DefaultWAPIVersion is the version of the WAPI used when none is given.
+	DefaultWAPIVersion = "2.10"
+	// DefaultView is the DNS view used when none is given.
+	DefaultView = "default"
+	// DefaultTTL is the TTL of challenge records when none is given.
+	DefaultTTL = 60
+)
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	baseURL          string
+	username         string
+	password         string
+	view             string
+	ttl              int32
+	client           *http.Client
+}
+
+// txtRecord represents a record:txt object of the WAPI
+type txtRecord struct {
+	Ref    string `json:"_ref,omitempty"`
+	Name   string `json:"name"`
+	Text   string `json:"text"`
+	View   string `json:"view,omitempty"`
+	TTL    int32  `json:"ttl,omitempty"`
+	UseTTL bool   `json:"use_ttl,omitempty"`
+}
+
+// NewDNSProviderCredentials uses the supplied credentials to return a
+// DNSProvider instance configured for the Infoblox WAPI of the Grid Manager
+// at server. Challenge records are created in the given DNS view with the
+// given TTL. If caBundle is not empty it is used to validate the certificate
+// of the Grid Manager.
+func NewDNSProviderCredentials(server, wapiVersion, username, password, view string, ttl int32, caBundle []byte, dns01Nameservers []string) (*DNSProvider, error) {
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("Infoblox username and password are required")
+	}
+
+	u, err := url.Parse(server)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("Infoblox server %q is not a valid URL", server)
+	}
+
+	if wapiVersion == "" {
+		wapiVersion = DefaultWAPIVersion
+	}
+	if view == "" {
+		view = DefaultView
+	}
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caBundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no certificates could be parsed from the Infoblox CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return &DNSProvider{
+		dns01Nameservers: dns01Nameservers,
+		baseURL:          fmt.Sprintf("%s/wapi/v%s", strings.TrimSuffix(server, "/"), wapiVersion),
+		username:         username,
+		password:         password,
+		view:             view,
+		ttl:              ttl,
+		client:           &http.Client{Timeout: 30 * time.Second, Transport: transport},
+	}, nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	records, err := c.findTxtRecords(fqdn, value)
+	if err != nil {
+		return err
+	}
+	if len(records) > 0 {
+		// the record is already set to the desired value
+		return nil
+	}
+
+	rec := txtRecord{
+		Name:   util.UnFqdn(fqdn),
+		Text:   value,
+		View:   c.view,
+		TTL:    c.ttl,
+		UseTTL: true,
+	}
+	return c.makeRequest(http.MethodPost, "/record:txt", rec, nil)
+}
+
+// CleanUp removes the TXT record matching the specified parameters
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	records, err := c.findTxtRecords(fqdn, value)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if err := c.makeRequest(http.MethodDelete, "/"+record.Ref, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *DNSProvider) findTxtRecords(fqdn, value string) ([]txtRecord, error) {
+	query := url.Values{}
+	query.Set("name", util.UnFqdn(fqdn))
+	query.Set("text", value)
+	query.Set("view", c.view)
+
+	var records []txtRecord
+	if err := c.makeRequest(http.MethodGet, "/record:txt?"+query.Encode(), nil, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (c *DNSProvider) makeRequest(method, uri string, reqBody, respBody interface{}) error {
+	var body []byte
+	if reqBody != nil {
+		var err error
+		body, err = json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+uri, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", pkgutil.CertManagerUserAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("while querying the Infoblox WAPI for %s %q: %v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	respData, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Error string `json:"Error"`
+			Text  string `json:"text"`
+		}
+		if err := json.Unmarshal(respData, &apiErr); err == nil && apiErr.Text != "" {
+			return fmt.Errorf("while querying the Infoblox WAPI for %s %q: %d: %s", method, uri, resp.StatusCode, apiErr.Text)
+		}
+		return fmt.Errorf("while querying the Infoblox WAPI for %s %q: %d", method, uri, resp.StatusCode)
+	}
+
+	if respBody == nil || len(respData) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respData, respBody)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// fakeWAPI is a fake of the record:txt API of the Infoblox WAPI.
+type fakeWAPI struct {
+	t *testing.T
+
+	lock    sync.Mutex
+	records map[string]txtRecord
+	nextRef int
+}
+
+func (f *fakeWAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "password" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, "/wapi/v2.10/") {
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/wapi/v2.10/")
+
+	switch {
+	case r.Method == http.MethodGet && path == "record:txt":
+		records := []txtRecord{}
+		for _, rec := range f.records {
+			q := r.URL.Query()
+			if rec.Name == q.Get("name") && rec.Text == q.Get("text") && rec.View == q.Get("view") {
+				records = append(records, rec)
+			}
+		}
+		json.NewEncoder(w).Encode(records)
+	case r.Method == http.MethodPost && path == "record:txt":
+		var rec txtRecord
+		if err := json.NewDecoder(r.Body).Decode(&rec); err != nil {
+			f.t.Errorf("error decoding record: %v", err)
+		}
+		if rec.View != "internal" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"Error":"AdmConDataNotFoundError","code":"Client.Ibap.Data.NotFound","text":"View %s not found"}`, rec.View)
+			return
+		}
+		f.nextRef++
+		rec.Ref = fmt.Sprintf("record:txt/%d:%s/%s", f.nextRef, rec.Name, rec.View)
+		f.records[rec.Ref] = rec
+		json.NewEncoder(w).Encode(rec.Ref)
+	case r.Method == http.MethodDelete:
+		if _, ok := f.records[path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.records, path)
+		json.NewEncoder(w).Encode(path)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestProvider(t *testing.T, view string) (*DNSProvider, *fakeWAPI) {
+	fake := &fakeWAPI{t: t, records: map[string]txtRecord{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	provider, err := NewDNSProviderCredentials(server.URL, "", "admin", "password", view, 0, nil, util.RecursiveNameservers)
+	assert.NoError(t, err)
+	return provider, fake
+}
+
+func TestNewDNSProviderCredentials(t *testing.T) {
+	provider, err := NewDNSProviderCredentials("https://gm.example.com/", "2.7", "admin", "password", "", 120, nil, util.RecursiveNameservers)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://gm.example.com/wapi/v2.7", provider.baseURL)
+	assert.Equal(t, "default", provider.view)
+	assert.Equal(t, int32(120), provider.ttl)
+
+	_, err = NewDNSProviderCredentials("gm.example.com", "", "admin", "password", "", 0, nil, util.RecursiveNameservers)
+	assert.EqualError(t, err, `Infoblox server "gm.example.com" is not a valid URL`)
+
+	_, err = NewDNSProviderCredentials("https://gm.example.com", "", "admin", "", "", 0, nil, util.RecursiveNameservers)
+	assert.EqualError(t, err, "Infoblox username and password are required")
+
+	_, err = NewDNSProviderCredentials("https://gm.example.com", "", "admin", "password", "", 0, []byte("not a certificate"), util.RecursiveNameservers)
+	assert.EqualError(t, err, "no certificates could be parsed from the Infoblox CA bundle")
+}
+
+func TestInfobloxPresentAndCleanUp(t *testing.T) {
+	provider, fake := newTestProvider(t, "internal")
+	fqdn := "_acme-challenge.www.example.com."
+
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "value"))
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "other-value"))
+	// presenting an existing value is a no-op
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "value"))
+	assert.Len(t, fake.records, 2)
+	for _, rec := range fake.records {
+		assert.Equal(t, "_acme-challenge.www.example.com", rec.Name)
+		assert.Equal(t, int32(60), rec.TTL)
+		assert.True(t, rec.UseTTL)
+	}
+
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "value"))
+	assert.Len(t, fake.records, 1)
+	for _, rec := range fake.records {
+		assert.Equal(t, "other-value", rec.Text)
+	}
+
+	// cleaning up a missing value is a no-op
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "value"))
+	assert.Len(t, fake.records, 1)
+}
+
+func TestInfobloxErrors(t *testing.T) {
+	provider, _ := newTestProvider(t, "external")
+	err := provider.Present("www.example.com", "_acme-challenge.www.example.com.", "value")
+	assert.EqualError(t, err, `while querying the Infoblox WAPI for POST "/record:txt": 400: View external not found`)
+
+	provider.password = "wrong"
+	err = provider.Present("www.example.com", "_acme-challenge.www.example.com.", "value")
+	assert.EqualError(t, err, `while querying the Infoblox WAPI for GET "/record:txt?name=_acme-challenge.www.example.com&text=value&view=external": 401`)
+}
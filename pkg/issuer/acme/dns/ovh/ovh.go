@@ -0,0 +1,320 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ovh implements a DNS provider for solving the DNS-01 challenge
+// using the OVH API.
+package ovh
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+	pkgutil "github.com/jetstack/cert-manager/pkg/util"
+)
+
+// DefaultEndpoint is the OVH API region used when no endpoint is given.
+const DefaultEndpoint = "ovh-eu"
+
+// Endpoints maps the names of the OVH API regions to their URLs.
+var Endpoints = map[string]string{
+	"ovh-eu":        "https://eu.api.ovh.com/1.0",
+	"ovh-ca":        "https://ca.api.ovh.com/1.0",
+	"ovh-us":        "https://api.us.ovhcloud.com/1.0",
+	"kimsufi-eu":    "https://eu.api.kimsufi.com/1.0",
+	"kimsufi-ca":    "https://ca.api.kimsufi.com/1.0",
+	"soyoustart-eu": "https://eu.api.soyoustart.com/1.0",
+	"soyoustart-ca": "https://ca.api.soyoustart.com/1.0",
+}
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers       []string
+	endpoint               string
+	applicationKey         string
+	applicationSecret      string
+	consumerKey            string
+	client                 *http.Client
+	findHostedDomainByFqdn func(string, []string) (string, error)
+	TTL                    int
+
+	// timeDelta is the difference between the clock of the OVH API and the
+	// local clock, used to timestamp signed requests.
+	timeDeltaOnce sync.Once
+	timeDelta     time.Duration
+	timeDeltaErr  error
+}
+
+// ovhRecord represents a record of an OVH DNS zone
+type ovhRecord struct {
+	ID        int64  `json:"id,omitempty"`
+	FieldType string `json:"fieldType"`
+	SubDomain string `json:"subDomain"`
+	Target    string `json:"target"`
+	TTL       int    `json:"ttl,omitempty"`
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for OVH.
+// Credentials must be passed in the environment variables: OVH_ENDPOINT,
+// OVH_APPLICATION_KEY, OVH_APPLICATION_SECRET and OVH_CONSUMER_KEY.
+func NewDNSProvider(dns01Nameservers []string) (*DNSProvider, error) {
+	return NewDNSProviderCredentials(
+		os.Getenv("OVH_ENDPOINT"),
+		os.Getenv("OVH_APPLICATION_KEY"),
+		os.Getenv("OVH_APPLICATION_SECRET"),
+		os.Getenv("OVH_CONSUMER_KEY"),
+		dns01Nameservers)
+}
+
+// NewDNSProviderCredentials uses the supplied credentials to return a
+// DNSProvider instance configured for OVH. The endpoint is either the name
+// of an OVH API region or the URL of the API, and defaults to ovh-eu.
+func NewDNSProviderCredentials(endpoint, applicationKey, applicationSecret, consumerKey string, dns01Nameservers []string) (*DNSProvider, error) {
+	if applicationKey == "" || applicationSecret == "" || consumerKey == "" {
+		return nil, fmt.Errorf("OVH application key, application secret and consumer key are required")
+	}
+
+	endpointURL, err := EndpointURL(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DNSProvider{
+		dns01Nameservers:       dns01Nameservers,
+		endpoint:               endpointURL,
+		applicationKey:         applicationKey,
+		applicationSecret:      applicationSecret,
+		consumerKey:            consumerKey,
+		client:                 &http.Client{Timeout: 30 * time.Second},
+		findHostedDomainByFqdn: findHostedDomainByFqdn,
+		TTL:                    60,
+	}, nil
+}
+
+// EndpointURL returns the URL of the OVH API for the name of an OVH API
+// region or a URL.
+func EndpointURL(endpoint string) (string, error) {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	if u, ok := Endpoints[endpoint]; ok {
+		return u, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("OVH endpoint %q is neither a known region nor a valid URL", endpoint)
+	}
+	return strings.TrimSuffix(endpoint, "/"), nil
+}
+
+func findHostedDomainByFqdn(fqdn string, ns []string) (string, error) {
+	zone, err := util.FindZoneByFqdn(fqdn, ns)
+	if err != nil {
+		return "", err
+	}
+
+	return util.UnFqdn(zone), nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	zone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return err
+	}
+	subDomain := subDomainForZone(fqdn, zone)
+
+	records, err := c.findTxtRecords(zone, subDomain)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if txtValue(record.Target) == value {
+			// the record is already set to the desired value
+			return nil
+		}
+	}
+
+	rec := ovhRecord{
+		FieldType: "TXT",
+		SubDomain: subDomain,
+		Target:    value,
+		TTL:       c.TTL,
+	}
+	if err := c.makeRequest(http.MethodPost, fmt.Sprintf("/domain/zone/%s/record", zone), rec, nil); err != nil {
+		return err
+	}
+
+	return c.refreshZone(zone)
+}
+
+// CleanUp removes the TXT record matching the specified parameters
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	zone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return err
+	}
+
+	records, err := c.findTxtRecords(zone, subDomainForZone(fqdn, zone))
+	if err != nil {
+		return err
+	}
+
+	deleted := false
+	for _, record := range records {
+		if txtValue(record.Target) != value {
+			continue
+		}
+		if err := c.makeRequest(http.MethodDelete, fmt.Sprintf("/domain/zone/%s/record/%d", zone, record.ID), nil, nil); err != nil {
+			return err
+		}
+		deleted = true
+	}
+	// Nothing to cleanup
+	if !deleted {
+		return nil
+	}
+
+	return c.refreshZone(zone)
+}
+
+func (c *DNSProvider) findTxtRecords(zone, subDomain string) ([]ovhRecord, error) {
+	query := url.Values{}
+	query.Set("fieldType", "TXT")
+	query.Set("subDomain", subDomain)
+
+	var ids []int64
+	if err := c.makeRequest(http.MethodGet, fmt.Sprintf("/domain/zone/%s/record?%s", zone, query.Encode()), nil, &ids); err != nil {
+		return nil, err
+	}
+
+	records := make([]ovhRecord, 0, len(ids))
+	for _, id := range ids {
+		var record ovhRecord
+		if err := c.makeRequest(http.MethodGet, fmt.Sprintf("/domain/zone/%s/record/%d", zone, id), nil, &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// refreshZone applies the changes made to the records of the zone.
+func (c *DNSProvider) refreshZone(zone string) error {
+	return c.makeRequest(http.MethodPost, fmt.Sprintf("/domain/zone/%s/refresh", zone), nil, nil)
+}
+
+// getTimeDelta returns the difference between the clock of the OVH API and
+// the local clock. It is only queried once per DNSProvider.
+func (c *DNSProvider) getTimeDelta() (time.Duration, error) {
+	c.timeDeltaOnce.Do(func() {
+		var serverTime int64
+		if c.timeDeltaErr = c.do(http.MethodGet, "/auth/time", nil, &serverTime, false); c.timeDeltaErr != nil {
+			return
+		}
+		c.timeDelta = time.Until(time.Unix(serverTime, 0))
+	})
+	return c.timeDelta, c.timeDeltaErr
+}
+
+func (c *DNSProvider) makeRequest(method, uri string, reqBody, respBody interface{}) error {
+	return c.do(method, uri, reqBody, respBody, true)
+}
+
+func (c *DNSProvider) do(method, uri string, reqBody, respBody interface{}, sign bool) error {
+	var body []byte
+	if reqBody != nil {
+		var err error
+		body, err = json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	target := c.endpoint + uri
+	req, err := http.NewRequest(method, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", pkgutil.CertManagerUserAgent)
+	req.Header.Set("X-Ovh-Application", c.applicationKey)
+
+	if sign {
+		timeDelta, err := c.getTimeDelta()
+		if err != nil {
+			return err
+		}
+		timestamp := strconv.FormatInt(time.Now().Add(timeDelta).Unix(), 10)
+		// The signature covers the application secret, consumer key,
+		// method, full URL, body and timestamp of the request.
+		h := sha1.New()
+		h.Write([]byte(strings.Join([]string{c.applicationSecret, c.consumerKey, method, target, string(body), timestamp}, "+")))
+
+		req.Header.Set("X-Ovh-Consumer", c.consumerKey)
+		req.Header.Set("X-Ovh-Timestamp", timestamp)
+		req.Header.Set("X-Ovh-Signature", fmt.Sprintf("$1$%x", h.Sum(nil)))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("while querying the OVH API for %s %q: %v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	respData, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(respData, &apiErr); err == nil && apiErr.Message != "" {
+			return fmt.Errorf("while querying the OVH API for %s %q: %d: %s", method, uri, resp.StatusCode, apiErr.Message)
+		}
+		return fmt.Errorf("while querying the OVH API for %s %q: %d", method, uri, resp.StatusCode)
+	}
+
+	if respBody == nil || len(respData) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respData, respBody)
+}
+
+// subDomainForZone returns the name of fqdn relative to zone.
+func subDomainForZone(fqdn, zone string) string {
+	return strings.TrimSuffix(util.UnFqdn(fqdn), "."+zone)
+}
+
+// txtValue returns the value of a TXT record target, which may be quoted.
+func txtValue(target string) string {
+	return strings.Trim(target, `"`)
+}
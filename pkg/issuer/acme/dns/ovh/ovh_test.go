@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovh
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+const (
+	testApplicationKey    = "app-key"
+	testApplicationSecret = "app-secret"
+	testConsumerKey       = "consumer-key"
+)
+
+// fakeOVH is a fake of the DNS zone API of OVH, which verifies the
+// signature of requests.
+type fakeOVH struct {
+	t *testing.T
+
+	lock      sync.Mutex
+	records   map[int64]ovhRecord
+	nextID    int64
+	refreshes int
+}
+
+func (f *fakeOVH) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.URL.Path == "/auth/time" {
+		fmt.Fprint(w, time.Now().Unix())
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	h := sha1.New()
+	h.Write([]byte(strings.Join([]string{
+		testApplicationSecret, testConsumerKey, r.Method,
+		"http://" + r.Host + r.URL.RequestURI(), string(body),
+		r.Header.Get("X-Ovh-Timestamp"),
+	}, "+")))
+	if r.Header.Get("X-Ovh-Application") != testApplicationKey ||
+		r.Header.Get("X-Ovh-Consumer") != testConsumerKey ||
+		r.Header.Get("X-Ovh-Signature") != fmt.Sprintf("$1$%x", h.Sum(nil)) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Invalid signature"}`)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/domain/zone/example.com")
+	switch {
+	case r.Method == http.MethodGet && path == "/record":
+		ids := []int64{}
+		for id, rec := range f.records {
+			if rec.FieldType == r.URL.Query().Get("fieldType") && rec.SubDomain == r.URL.Query().Get("subDomain") {
+				ids = append(ids, id)
+			}
+		}
+		json.NewEncoder(w).Encode(ids)
+	case r.Method == http.MethodPost && path == "/record":
+		var rec ovhRecord
+		if err := json.Unmarshal(body, &rec); err != nil {
+			f.t.Errorf("error decoding record: %v", err)
+		}
+		f.nextID++
+		rec.ID = f.nextID
+		f.records[rec.ID] = rec
+		json.NewEncoder(w).Encode(rec)
+	case r.Method == http.MethodPost && path == "/refresh":
+		f.refreshes++
+	case strings.HasPrefix(path, "/record/"):
+		id, _ := strconv.ParseInt(strings.TrimPrefix(path, "/record/"), 10, 64)
+		rec, ok := f.records[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"The requested object does not exist"}`)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(f.records, id)
+			return
+		}
+		json.NewEncoder(w).Encode(rec)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestProvider(t *testing.T) (*DNSProvider, *fakeOVH) {
+	fake := &fakeOVH{t: t, records: map[int64]ovhRecord{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	provider, err := NewDNSProviderCredentials(server.URL, testApplicationKey, testApplicationSecret, testConsumerKey, util.RecursiveNameservers)
+	assert.NoError(t, err)
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com", nil
+	}
+	return provider, fake
+}
+
+func TestNewDNSProviderCredentials(t *testing.T) {
+	provider, err := NewDNSProviderCredentials("", "key", "secret", "consumer", util.RecursiveNameservers)
+	assert.NoError(t, err)
+	assert.Equal(t, Endpoints[DefaultEndpoint], provider.endpoint)
+
+	provider, err = NewDNSProviderCredentials("ovh-ca", "key", "secret", "consumer", util.RecursiveNameservers)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://ca.api.ovh.com/1.0", provider.endpoint)
+
+	_, err = NewDNSProviderCredentials("ovh-mars", "key", "secret", "consumer", util.RecursiveNameservers)
+	assert.EqualError(t, err, `OVH endpoint "ovh-mars" is neither a known region nor a valid URL`)
+
+	_, err = NewDNSProviderCredentials("", "key", "", "consumer", util.RecursiveNameservers)
+	assert.EqualError(t, err, "OVH application key, application secret and consumer key are required")
+}
+
+func TestOVHPresentAndCleanUp(t *testing.T) {
+	provider, fake := newTestProvider(t)
+	fqdn := "_acme-challenge.www.example.com."
+
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "value"))
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "other-value"))
+	assert.Len(t, fake.records, 2)
+	for _, rec := range fake.records {
+		assert.Equal(t, "TXT", rec.FieldType)
+		assert.Equal(t, "_acme-challenge.www", rec.SubDomain)
+		assert.Equal(t, 60, rec.TTL)
+	}
+	assert.Equal(t, 2, fake.refreshes)
+
+	// presenting an existing value is a no-op
+	assert.NoError(t, provider.Present("www.example.com", fqdn, "value"))
+	assert.Len(t, fake.records, 2)
+	assert.Equal(t, 2, fake.refreshes)
+
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "value"))
+	assert.Len(t, fake.records, 1)
+	for _, rec := range fake.records {
+		assert.Equal(t, "other-value", rec.Target)
+	}
+	assert.Equal(t, 3, fake.refreshes)
+
+	// cleaning up a missing value is a no-op
+	assert.NoError(t, provider.CleanUp("www.example.com", fqdn, "value"))
+	assert.Equal(t, 3, fake.refreshes)
+}
+
+func TestOVHInvalidCredentials(t *testing.T) {
+	provider, _ := newTestProvider(t)
+	provider.consumerKey = "wrong"
+
+	err := provider.Present("www.example.com", "_acme-challenge.www.example.com.", "value")
+	assert.EqualError(t, err, `while querying the OVH API for GET "/domain/zone/example.com/record?fieldType=TXT&subDomain=_acme-challenge.www": 403: Invalid signature`)
+}
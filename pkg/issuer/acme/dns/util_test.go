@@ -25,9 +25,13 @@ import (
 	"github.com/jetstack/cert-manager/pkg/controller/test"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/acmedns"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/azuredns"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/bluecat"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/gandi"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/infoblox"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/ovh"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	"github.com/jetstack/cert-manager/test/unit/gen"
@@ -155,6 +159,22 @@ func newFakeDNSProviders() *fakeDNSProviders {
 			f.call("digitalocean", token, util.RecursiveNameservers)
 			return nil, nil
 		},
+		ovh: func(endpoint, applicationKey, applicationSecret, consumerKey string, dns01Nameservers []string) (*ovh.DNSProvider, error) {
+			f.call("ovh", endpoint, applicationKey, applicationSecret, consumerKey, util.RecursiveNameservers)
+			return nil, nil
+		},
+		gandi: func(personalAccessToken string, dns01Nameservers []string) (*gandi.DNSProvider, error) {
+			f.call("gandi", personalAccessToken, util.RecursiveNameservers)
+			return nil, nil
+		},
+		infoblox: func(server, wapiVersion, username, password, view string, ttl int32, caBundle []byte, dns01Nameservers []string) (*infoblox.DNSProvider, error) {
+			f.call("infoblox", server, wapiVersion, username, password, view, ttl, caBundle, util.RecursiveNameservers)
+			return nil, nil
+		},
+		blueCat: func(server, username, password, configuration, view, zone string, ttl int32, caBundle []byte, dns01Nameservers []string) (*bluecat.DNSProvider, error) {
+			f.call("bluecat", server, username, password, configuration, view, zone, ttl, caBundle, util.RecursiveNameservers)
+			return nil, nil
+		},
 	}
 	return f
 }
@@ -24,10 +24,13 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	k8snet "k8s.io/utils/net"
@@ -66,9 +69,26 @@ type Solver struct {
 
 	testReachability reachabilityTest
 	requiredPasses   int
+
+	// selfCheckService and selfCheckURLTemplate select where self checks are
+	// performed against instead of the public address of the domain.
+	selfCheckService     bool
+	selfCheckURLTemplate *template.Template
 }
 
-type reachabilityTest func(ctx context.Context, url *url.URL, key string) error
+// reachabilityTest checks that the key is served at url. If host is not
+// empty, it is used as the Host header of the request.
+type reachabilityTest func(ctx context.Context, url *url.URL, host, key string) error
+
+// selfCheckURLTemplateData is passed to the self check URL template.
+type selfCheckURLTemplateData struct {
+	// Domain is the domain name being validated.
+	Domain string
+	// Token is the challenge token.
+	Token string
+	// Path is the path that the challenge is served at.
+	Path string
+}
 
 // NewSolver returns a new ACME HTTP01 solver for the given *controller.Context.
 func NewSolver(ctx *controller.Context) (*Solver, error) {
@@ -80,6 +100,13 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 	if err != nil {
 		return nil, err
 	}
+	var selfCheckURLTemplate *template.Template
+	if ctx.ACMEOptions.HTTP01SelfCheckURLTemplate != "" {
+		selfCheckURLTemplate, err = template.New("self-check-url").Parse(ctx.ACMEOptions.HTTP01SelfCheckURLTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP01 self check URL template: %v", err)
+		}
+	}
 	return &Solver{
 		Context:              ctx,
 		podLister:            ctx.KubeSharedInformerFactory.Core().V1().Pods().Lister(),
@@ -89,6 +116,8 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 		httpRouteLister:      ctx.GWShared.Networking().V1alpha1().HTTPRoutes().Lister(),
 		testReachability:     testReachability,
 		requiredPasses:       5,
+		selfCheckService:     ctx.ACMEOptions.HTTP01SelfCheckService,
+		selfCheckURLTemplate: selfCheckURLTemplate,
 	}, nil
 }
 
@@ -120,6 +149,10 @@ func getServiceType(ch *cmacme.Challenge) (corev1.ServiceType, error) {
 func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	ctx = http01LogCtx(ctx)
 
+	if usesSharedSolver(ch) {
+		return s.presentShared(ctx, ch)
+	}
+
 	_, podErr := s.ensurePod(ctx, ch)
 	svc, svcErr := s.ensureService(ctx, ch)
 	if svcErr != nil {
@@ -167,13 +200,19 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 
 	ctx, cancel := context.WithTimeout(ctx, HTTP01Timeout)
 	defer cancel()
-	url := s.buildChallengeUrl(ch)
+	url, host, err := s.selfCheckTarget(ctx, ch)
+	if err != nil {
+		return err
+	}
 	log = log.WithValues("url", url)
+	if host != "" {
+		log = log.WithValues("host", host)
+	}
 	ctx = logf.NewContext(ctx, log)
 
 	log.V(logf.DebugLevel).Info("running self check multiple times to ensure challenge has propagated", "required_passes", s.requiredPasses)
 	for i := 0; i < s.requiredPasses; i++ {
-		err := s.testReachability(ctx, url, ch.Spec.Key)
+		err := s.testReachability(ctx, url, host, ch.Spec.Key)
 		if err != nil {
 			return err
 		}
@@ -187,12 +226,16 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 }
 
 // CleanUp will ensure the created service, ingress and pod are clean/deleted of any
-// cert-manager created data.
+// cert-manager created data. Keys registered with a shared solver are removed,
+// but the shared solver itself is left in place.
 func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	var errs []error
 	errs = append(errs, s.cleanupPods(ctx, ch))
 	errs = append(errs, s.cleanupServices(ctx, ch))
 	errs = append(errs, s.cleanupIngresses(ctx, ch))
+	if usesSharedSolver(ch) {
+		errs = append(errs, s.cleanupSharedSolverKey(ctx, ch))
+	}
 	return utilerrors.NewAggregate(errs)
 }
 
@@ -210,9 +253,75 @@ func (s *Solver) buildChallengeUrl(ch *cmacme.Challenge) *url.URL {
 	return url
 }
 
+// selfCheckTarget returns the URL that the self check for the challenge is
+// performed against, and the Host header to send if it differs from the host
+// of the URL.
+// By default this is the public URL that the ACME server will validate. If
+// the controller is configured to, the self check is instead performed from
+// within the cluster, against the solver Service or a templated URL, for
+// environments where the public address of the domain cannot be reached from
+// within the cluster.
+func (s *Solver) selfCheckTarget(ctx context.Context, ch *cmacme.Challenge) (*url.URL, string, error) {
+	public := s.buildChallengeUrl(ch)
+
+	switch {
+	case s.selfCheckURLTemplate != nil:
+		var buf strings.Builder
+		err := s.selfCheckURLTemplate.Execute(&buf, selfCheckURLTemplateData{
+			Domain: ch.Spec.DNSName,
+			Token:  ch.Spec.Token,
+			Path:   public.Path,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to build HTTP01 self check URL: %v", err)
+		}
+		u, err := url.Parse(buf.String())
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to parse HTTP01 self check URL %q: %v", buf.String(), err)
+		}
+		return u, public.Host, nil
+
+	case s.selfCheckService:
+		svc, err := s.solverServiceForChallenge(ctx, ch)
+		if err != nil {
+			return nil, "", err
+		}
+		if svc.Spec.ClusterIP == "" || svc.Spec.ClusterIP == corev1.ClusterIPNone {
+			return nil, "", fmt.Errorf("HTTP01 solver service %s/%s has no cluster IP to perform the self check against", svc.Namespace, svc.Name)
+		}
+		return &url.URL{
+			Scheme: "http",
+			Host:   net.JoinHostPort(svc.Spec.ClusterIP, strconv.Itoa(acmeSolverListenPort)),
+			Path:   public.Path,
+		}, public.Host, nil
+	}
+
+	return public, "", nil
+}
+
+// solverServiceForChallenge returns the Service serving the challenge.
+func (s *Solver) solverServiceForChallenge(ctx context.Context, ch *cmacme.Challenge) (*corev1.Service, error) {
+	if usesSharedSolver(ch) {
+		hash, err := sharedSolverHash(ch.Spec.Solver.HTTP01.Ingress)
+		if err != nil {
+			return nil, err
+		}
+		return s.Client.CoreV1().Services(ch.Namespace).Get(ctx, sharedSolverName(hash), metav1.GetOptions{})
+	}
+	svcs, err := s.getServicesForChallenge(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+	if len(svcs) != 1 {
+		return nil, fmt.Errorf("expected one HTTP01 solver service for challenge, found %d", len(svcs))
+	}
+	return svcs[0], nil
+}
+
 // testReachability will attempt to connect to the 'domain' with 'path' and
-// check if the returned body equals 'key'
-func testReachability(ctx context.Context, url *url.URL, key string) error {
+// check if the returned body equals 'key'. If 'host' is not empty it is sent
+// as the Host header of the request.
+func testReachability(ctx context.Context, url *url.URL, host, key string) error {
 	log := logf.FromContext(ctx)
 	log.V(logf.DebugLevel).Info("performing HTTP01 reachability check")
 
@@ -220,6 +329,9 @@ func testReachability(ctx context.Context, url *url.URL, key string) error {
 	if err != nil {
 		return err
 	}
+	if host != "" {
+		req.Host = host
+	}
 	req.Header.Set("User-Agent", pkgutil.CertManagerUserAgent)
 
 	// The ACME spec says that a verifier should try on http port 80 first, but to follow any
@@ -21,6 +21,9 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 )
@@ -28,9 +31,9 @@ import (
 // countReachabilityTestCalls is a wrapper function that allows us to count the number
 // of calls to a reachabilityTest.
 func countReachabilityTestCalls(counter *int, t reachabilityTest) reachabilityTest {
-	return func(ctx context.Context, url *url.URL, key string) error {
+	return func(ctx context.Context, url *url.URL, host, key string) error {
 		*counter++
-		return t(ctx, url, key)
+		return t(ctx, url, host, key)
 	}
 }
 
@@ -44,14 +47,14 @@ func TestCheck(t *testing.T) {
 	tests := []testT{
 		{
 			name: "should pass",
-			reachabilityTest: func(context.Context, *url.URL, string) error {
+			reachabilityTest: func(context.Context, *url.URL, string, string) error {
 				return nil
 			},
 			expectedErr: false,
 		},
 		{
 			name: "should error",
-			reachabilityTest: func(context.Context, *url.URL, string) error {
+			reachabilityTest: func(context.Context, *url.URL, string, string) error {
 				return fmt.Errorf("failed")
 			},
 			expectedErr: true,
@@ -87,3 +90,96 @@ func TestCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestSelfCheckTarget(t *testing.T) {
+	newChallenge := func() *cmacme.Challenge {
+		return &cmacme.Challenge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: defaultTestNamespace,
+			},
+			Spec: cmacme.ChallengeSpec{
+				DNSName: "example.com",
+				Token:   "token",
+				Solver: cmacme.ACMEChallengeSolver{
+					HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+						Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+					},
+				},
+			},
+		}
+	}
+	tests := map[string]struct {
+		fixture solverFixture
+		// configure sets the self check options of the solver under test
+		configure func(*Solver)
+
+		expectedURL  string
+		expectedHost string
+	}{
+		"should use the public URL by default": {
+			fixture:     solverFixture{Challenge: newChallenge()},
+			expectedURL: "http://example.com/.well-known/acme-challenge/token",
+		},
+		"should use the URL template and send the domain as Host header": {
+			fixture: solverFixture{Challenge: newChallenge()},
+			configure: func(s *Solver) {
+				s.selfCheckURLTemplate = template.Must(template.New("").Parse("http://ingress.internal:8080{{.Path}}?domain={{.Domain}}"))
+			},
+			expectedURL:  "http://ingress.internal:8080/.well-known/acme-challenge/token?domain=example.com",
+			expectedHost: "example.com",
+		},
+		"should use the cluster IP of the solver service": {
+			fixture: solverFixture{
+				Challenge: newChallenge(),
+				PreFn: func(t *testing.T, s *solverFixture) {
+					svc, err := buildService(s.Challenge)
+					if err != nil {
+						t.Fatal(err)
+					}
+					svc.Name = "solver"
+					svc.Spec.ClusterIP = "10.0.0.1"
+					if _, err := s.FakeKubeClient().CoreV1().Services(defaultTestNamespace).Create(context.TODO(), svc, metav1.CreateOptions{}); err != nil {
+						t.Fatal(err)
+					}
+				},
+			},
+			configure: func(s *Solver) {
+				s.selfCheckService = true
+			},
+			expectedURL:  "http://10.0.0.1:8089/.well-known/acme-challenge/token",
+			expectedHost: "example.com",
+		},
+		"should fail if there is no solver service": {
+			fixture: solverFixture{Challenge: newChallenge(), Err: true},
+			configure: func(s *Solver) {
+				s.selfCheckService = true
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.fixture.Setup(t)
+			defer test.fixture.Finish(t)
+			if test.configure != nil {
+				test.configure(test.fixture.Solver)
+			}
+			u, host, err := test.fixture.Solver.selfCheckTarget(context.TODO(), test.fixture.Challenge)
+			if err != nil && !test.fixture.Err {
+				t.Fatalf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.fixture.Err {
+				t.Fatalf("Expected function to get an error, but got: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if u.String() != test.expectedURL {
+				t.Errorf("expected URL %q, got %q", test.expectedURL, u.String())
+			}
+			if host != test.expectedHost {
+				t.Errorf("expected host %q, got %q", test.expectedHost, host)
+			}
+		})
+	}
+}
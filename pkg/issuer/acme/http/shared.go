@@ -0,0 +1,298 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	// sharedSolverChallengesDir is the directory in which the ConfigMap
+	// holding the keys of a shared solver is mounted.
+	sharedSolverChallengesDir = "/var/run/acmesolver/challenges"
+	sharedSolverVolumeName    = "challenges"
+)
+
+// A shared solver is a Deployment and Service serving the keys of all
+// Challenges in a namespace that use the same HTTP01 ingress solver
+// configuration. The keys are registered in a ConfigMap which is mounted into
+// the solver pods, so that solving a Challenge only requires updating the
+// ConfigMap and creating an Ingress.
+// The Deployment, Service and ConfigMap outlive the Challenges they serve, so
+// that they can be reused by subsequent Challenges.
+
+func usesSharedSolver(ch *cmacme.Challenge) bool {
+	return ch.Spec.Solver.HTTP01 != nil &&
+		ch.Spec.Solver.HTTP01.Ingress != nil &&
+		ch.Spec.Solver.HTTP01.Ingress.SharedSolver != nil
+}
+
+// sharedSolverHash returns a hash of the parts of the solver configuration
+// which affect the shared solver pods and service. Challenges with equal
+// hashes share the same solver.
+func sharedSolverHash(cfg *cmacme.ACMEChallengeSolverHTTP01Ingress) (string, error) {
+	data, err := json.Marshal(struct {
+		ServiceType corev1.ServiceType                                  `json:"serviceType"`
+		PodTemplate *cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate `json:"podTemplate"`
+	}{cfg.ServiceType, cfg.PodTemplate})
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New32a()
+	h.Write(data)
+	return fmt.Sprintf("%08x", h.Sum32()), nil
+}
+
+func sharedSolverName(hash string) string {
+	return "cm-acme-http-solver-shared-" + hash
+}
+
+func sharedSolverLabels(hash string) map[string]string {
+	return map[string]string{
+		cmacme.SolverIdentificationLabelKey: "true",
+		cmacme.SharedSolverLabelKey:         hash,
+	}
+}
+
+// presentShared registers the key of the challenge with the shared solver,
+// ensures the shared solver is running, and routes requests for the
+// challenge to it.
+func (s *Solver) presentShared(ctx context.Context, ch *cmacme.Challenge) error {
+	cfg := ch.Spec.Solver.HTTP01.Ingress
+	hash, err := sharedSolverHash(cfg)
+	if err != nil {
+		return err
+	}
+	name := sharedSolverName(hash)
+	log := logf.FromContext(ctx).WithValues("shared_solver", name)
+	ctx = logf.NewContext(ctx, log)
+
+	if err := s.ensureSharedSolverKey(ctx, ch, name, hash); err != nil {
+		return err
+	}
+	if err := s.ensureSharedSolverDeployment(ctx, ch, name, hash); err != nil {
+		return err
+	}
+	svc, err := s.ensureSharedSolverService(ctx, ch, name, hash)
+	if err != nil {
+		return err
+	}
+	_, err = s.ensureIngress(ctx, ch, svc.Name)
+	return err
+}
+
+// ensureSharedSolverKey ensures the key of the challenge is registered in the
+// ConfigMap of the shared solver.
+func (s *Solver) ensureSharedSolverKey(ctx context.Context, ch *cmacme.Challenge, name, hash string) error {
+	log := logf.FromContext(ctx).WithName("ensureSharedSolverKey")
+
+	cm, err := s.Client.CoreV1().ConfigMaps(ch.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		log.V(logf.InfoLevel).Info("creating HTTP01 shared solver configmap")
+		_, err := s.Client.CoreV1().ConfigMaps(ch.Namespace).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ch.Namespace,
+				Labels:    sharedSolverLabels(hash),
+			},
+			Data: map[string]string{ch.Spec.Token: ch.Spec.Key},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if key, ok := cm.Data[ch.Spec.Token]; ok && key == ch.Spec.Key {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("registering challenge key with HTTP01 shared solver")
+	cm = cm.DeepCopy()
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[ch.Spec.Token] = ch.Spec.Key
+	// A conflict is returned as an error so that the challenge is retried
+	// against the latest version of the ConfigMap.
+	_, err = s.Client.CoreV1().ConfigMaps(ch.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// ensureSharedSolverDeployment ensures the Deployment of the shared solver
+// exists, and that its number of replicas matches the solver configuration if
+// one has been set.
+func (s *Solver) ensureSharedSolverDeployment(ctx context.Context, ch *cmacme.Challenge, name, hash string) error {
+	log := logf.FromContext(ctx).WithName("ensureSharedSolverDeployment")
+
+	existing, err := s.Client.AppsV1().Deployments(ch.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		log.V(logf.InfoLevel).Info("creating HTTP01 shared solver deployment")
+		_, err := s.Client.AppsV1().Deployments(ch.Namespace).Create(ctx, s.buildSharedSolverDeployment(ch, name, hash), metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	replicas := ch.Spec.Solver.HTTP01.Ingress.SharedSolver.Replicas
+	if replicas == nil || (existing.Spec.Replicas != nil && *existing.Spec.Replicas == *replicas) {
+		return nil
+	}
+	log.V(logf.DebugLevel).Info("updating replicas of HTTP01 shared solver deployment", "replicas", *replicas)
+	existing = existing.DeepCopy()
+	existing.Spec.Replicas = replicas
+	_, err = s.Client.AppsV1().Deployments(ch.Namespace).Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// buildSharedSolverDeployment builds the Deployment of the shared solver. Its
+// pods are configured in the same way as the pods created for individual
+// challenges, but serve the keys found in the shared solver ConfigMap.
+func (s *Solver) buildSharedSolverDeployment(ch *cmacme.Challenge, name, hash string) *appsv1.Deployment {
+	cfg := ch.Spec.Solver.HTTP01.Ingress
+
+	pod := s.buildDefaultPod(ch)
+	pod.Labels = make(map[string]string)
+	pod.Spec.RestartPolicy = corev1.RestartPolicyAlways
+	pod.Spec.Containers[0].Args = []string{
+		fmt.Sprintf("--listen-port=%d", acmeSolverListenPort),
+		fmt.Sprintf("--challenges-dir=%s", sharedSolverChallengesDir),
+	}
+	pod.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      sharedSolverVolumeName,
+			MountPath: sharedSolverChallengesDir,
+			ReadOnly:  true,
+		},
+	}
+	pod.Spec.Volumes = []corev1.Volume{
+		{
+			Name: sharedSolverVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				},
+			},
+		},
+	}
+	pod = s.mergePodObjectMetaWithPodTemplate(pod, cfg.PodTemplate)
+
+	// The selector labels are set after merging the pod template so that
+	// they cannot be overridden.
+	labels := sharedSolverLabels(hash)
+	for k, v := range labels {
+		pod.Labels[k] = v
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ch.Namespace,
+			Labels:    sharedSolverLabels(hash),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: cfg.SharedSolver.Replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      pod.Labels,
+					Annotations: pod.Annotations,
+				},
+				Spec: pod.Spec,
+			},
+		},
+	}
+}
+
+// ensureSharedSolverService ensures the Service of the shared solver exists.
+func (s *Solver) ensureSharedSolverService(ctx context.Context, ch *cmacme.Challenge, name, hash string) (*corev1.Service, error) {
+	log := logf.FromContext(ctx).WithName("ensureSharedSolverService")
+
+	svc, err := s.Client.CoreV1().Services(ch.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if !k8sErrors.IsNotFound(err) {
+		return svc, err
+	}
+
+	log.V(logf.InfoLevel).Info("creating HTTP01 shared solver service")
+	serviceType := ch.Spec.Solver.HTTP01.Ingress.ServiceType
+	if serviceType == "" {
+		serviceType = corev1.ServiceTypeNodePort
+	}
+	labels := sharedSolverLabels(hash)
+	return s.Client.CoreV1().Services(ch.Namespace).Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ch.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				"auth.istio.io/8089": "NONE",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: serviceType,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       acmeSolverListenPort,
+					TargetPort: intstr.FromInt(acmeSolverListenPort),
+				},
+			},
+			Selector: labels,
+		},
+	}, metav1.CreateOptions{})
+}
+
+// cleanupSharedSolverKey removes the key of the challenge from the ConfigMap
+// of the shared solver. The shared solver itself is left running so that it
+// can be reused.
+func (s *Solver) cleanupSharedSolverKey(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "cleanupSharedSolverKey")
+
+	hash, err := sharedSolverHash(ch.Spec.Solver.HTTP01.Ingress)
+	if err != nil {
+		return err
+	}
+	name := sharedSolverName(hash)
+	cm, err := s.Client.CoreV1().ConfigMaps(ch.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, ok := cm.Data[ch.Spec.Token]; !ok {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("removing challenge key from HTTP01 shared solver", "shared_solver", name)
+	cm = cm.DeepCopy()
+	delete(cm.Data, ch.Spec.Token)
+	_, err = s.Client.CoreV1().ConfigMaps(ch.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
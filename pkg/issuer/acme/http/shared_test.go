@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+)
+
+func sharedSolverChallenge(name, token, key string, replicas *int32) *cmacme.Challenge {
+	return &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultTestNamespace,
+		},
+		Spec: cmacme.ChallengeSpec{
+			DNSName: name + ".example.com",
+			Token:   token,
+			Key:     key,
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+						SharedSolver: &cmacme.ACMEChallengeSolverHTTP01SharedSolver{
+							Replicas: replicas,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPresentSharedSolver(t *testing.T) {
+	other := sharedSolverChallenge("other", "other-token", "other-key", pointer.Int32Ptr(3))
+
+	tests := map[string]solverFixture{
+		"should create the shared solver and register the challenge key": {
+			Challenge: sharedSolverChallenge("test", "token", "key", nil),
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				name := sharedSolverNameForTest(t, s.Challenge)
+				cl := s.Builder.FakeKubeClient()
+
+				cm, err := cl.CoreV1().ConfigMaps(defaultTestNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("expected shared solver configmap to exist: %v", err)
+				}
+				if cm.Data["token"] != "key" {
+					t.Errorf("expected key to be registered, got data %v", cm.Data)
+				}
+
+				dep, err := cl.AppsV1().Deployments(defaultTestNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("expected shared solver deployment to exist: %v", err)
+				}
+				if len(dep.Spec.Template.Spec.Volumes) != 1 || dep.Spec.Template.Spec.Volumes[0].ConfigMap.Name != name {
+					t.Errorf("expected deployment to mount the shared solver configmap, got volumes %v", dep.Spec.Template.Spec.Volumes)
+				}
+				if len(dep.OwnerReferences) != 0 {
+					t.Errorf("expected shared solver deployment not to be owned by the challenge")
+				}
+
+				if _, err := cl.CoreV1().Services(defaultTestNamespace).Get(context.TODO(), name, metav1.GetOptions{}); err != nil {
+					t.Errorf("expected shared solver service to exist: %v", err)
+				}
+
+				pods, err := cl.CoreV1().Pods(defaultTestNamespace).List(context.TODO(), metav1.ListOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(pods.Items) != 0 {
+					t.Errorf("expected no challenge solver pods to be created, got %d", len(pods.Items))
+				}
+
+				ings, err := s.Solver.getIngressesForChallenge(context.TODO(), s.Challenge)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(ings) != 1 || ingressServiceName(ings[0]) != name {
+					t.Errorf("expected one ingress routing to the shared solver service %q, got %v", name, ings)
+				}
+			},
+		},
+		"should reuse an existing shared solver and reconcile its replicas": {
+			Challenge: sharedSolverChallenge("test", "token", "key", pointer.Int32Ptr(3)),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				if err := s.Solver.Present(context.TODO(), nil, sharedSolverChallenge("other", "other-token", "other-key", nil)); err != nil {
+					t.Fatalf("error preparing test: %v", err)
+				}
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				name := sharedSolverNameForTest(t, s.Challenge)
+				if otherName := sharedSolverNameForTest(t, other); otherName != name {
+					t.Fatalf("expected challenges to share a solver, got %q and %q", name, otherName)
+				}
+				cl := s.Builder.FakeKubeClient()
+
+				cm, err := cl.CoreV1().ConfigMaps(defaultTestNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if cm.Data["token"] != "key" || cm.Data["other-token"] != "other-key" {
+					t.Errorf("expected keys of both challenges to be registered, got data %v", cm.Data)
+				}
+
+				dep, err := cl.AppsV1().Deployments(defaultTestNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 3 {
+					t.Errorf("expected deployment to be scaled to 3 replicas, got %v", dep.Spec.Replicas)
+				}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			err := test.Solver.Present(context.TODO(), nil, test.Challenge)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, err)
+		})
+	}
+}
+
+func TestCleanUpSharedSolver(t *testing.T) {
+	test := solverFixture{
+		Challenge: sharedSolverChallenge("test", "token", "key", nil),
+		PreFn: func(t *testing.T, s *solverFixture) {
+			for _, ch := range []*cmacme.Challenge{s.Challenge, sharedSolverChallenge("other", "other-token", "other-key", nil)} {
+				if err := s.Solver.Present(context.TODO(), nil, ch); err != nil {
+					t.Fatalf("error preparing test: %v", err)
+				}
+			}
+		},
+		CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+			name := sharedSolverNameForTest(t, s.Challenge)
+			cl := s.Builder.FakeKubeClient()
+
+			cm, err := cl.CoreV1().ConfigMaps(defaultTestNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := cm.Data["token"]; ok {
+				t.Errorf("expected key of the cleaned up challenge to be removed, got data %v", cm.Data)
+			}
+			if cm.Data["other-token"] != "other-key" {
+				t.Errorf("expected key of the other challenge to be kept, got data %v", cm.Data)
+			}
+			if _, err := cl.AppsV1().Deployments(defaultTestNamespace).Get(context.TODO(), name, metav1.GetOptions{}); err != nil {
+				t.Errorf("expected shared solver deployment to be kept: %v", err)
+			}
+			ings, err := s.Solver.getIngressesForChallenge(context.TODO(), s.Challenge)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ings) != 0 {
+				t.Errorf("expected ingress of the challenge to be deleted, got %d", len(ings))
+			}
+		},
+	}
+	test.Setup(t)
+	err := test.Solver.CleanUp(context.TODO(), nil, test.Challenge)
+	if err != nil {
+		t.Errorf("Expected function to not error, but got: %v", err)
+	}
+	test.Finish(t, err)
+}
+
+func sharedSolverNameForTest(t *testing.T, ch *cmacme.Challenge) string {
+	hash, err := sharedSolverHash(ch.Spec.Solver.HTTP01.Ingress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sharedSolverName(hash)
+}
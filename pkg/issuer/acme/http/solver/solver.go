@@ -19,7 +19,10 @@ package solver
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"path"
+	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -32,16 +35,29 @@ type HTTP01Solver struct {
 	Token  string
 	Key    string
 
+	// ChallengesDir, if set, is a directory containing a file for each
+	// challenge token, holding the key to respond with. When set, the solver
+	// serves the keys of all challenges found in the directory and Domain,
+	// Token and Key are ignored.
+	ChallengesDir string
+
 	http.Server
 }
 
 func (h *HTTP01Solver) Listen(log logr.Logger) error {
-	log.Info("starting listener",
-		"expected_domain", h.Domain,
-		"expected_token", h.Token,
-		"expected_key", h.Key,
-		"listen_port", h.ListenPort,
-	)
+	if h.ChallengesDir != "" {
+		log.Info("starting listener",
+			"challenges_dir", h.ChallengesDir,
+			"listen_port", h.ListenPort,
+		)
+	} else {
+		log.Info("starting listener",
+			"expected_domain", h.Domain,
+			"expected_token", h.Token,
+			"expected_key", h.Key,
+			"listen_port", h.ListenPort,
+		)
+	}
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// extract vars from the request
@@ -69,6 +85,20 @@ func (h *HTTP01Solver) Listen(log logr.Logger) error {
 			return
 		}
 
+		if h.ChallengesDir != "" {
+			key, ok := h.keyFromChallengesDir(token)
+			if !ok {
+				log.Info("no key found for token", "challenges_dir", h.ChallengesDir)
+				http.NotFound(w, r)
+				return
+			}
+			log.Info("got successful challenge request, writing key")
+			w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, key)
+			return
+		}
+
 		log.Info("comparing host", "expected_host", h.Domain)
 		if h.Domain != host {
 			log.Info("invalid host", "expected_host", h.Domain)
@@ -97,3 +127,21 @@ func (h *HTTP01Solver) Listen(log logr.Logger) error {
 
 	return h.Server.ListenAndServe()
 }
+
+// validToken matches the characters that may appear in an ACME challenge
+// token, which is base64url encoded. Any other token is rejected before it is
+// used to build a file path.
+var validToken = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// keyFromChallengesDir returns the key stored for the given token in the
+// challenges directory.
+func (h *HTTP01Solver) keyFromChallengesDir(token string) (string, bool) {
+	if !validToken.MatchString(token) {
+		return "", false
+	}
+	key, err := os.ReadFile(filepath.Join(h.ChallengesDir, token))
+	if err != nil {
+		return "", false
+	}
+	return string(key), true
+}
@@ -19,6 +19,8 @@ package acme
 import (
 	"context"
 	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"fmt"
 	"net/url"
@@ -33,19 +35,18 @@ import (
 	"github.com/jetstack/cert-manager/pkg/acme/accounts"
 	"github.com/jetstack/cert-manager/pkg/acme/client"
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util/errors"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 const (
-	errorAccountRegistrationFailed = "ErrRegisterACMEAccount"
 	errorAccountVerificationFailed = "ErrVerifyACMEAccount"
-	errorAccountUpdateFailed       = "ErrUpdateACMEAccount"
 	errorInvalidConfig             = "InvalidConfig"
-	errorInvalidURL                = "InvalidURL"
 
 	successAccountRegistered = "ACMEAccountRegistered"
 	successAccountVerified   = "ACMEAccountVerified"
@@ -63,6 +64,8 @@ const (
 	messageTemplateFailedToParseURL        = "Failed to parse existing ACME server URI %q: %v"
 	messageTemplateFailedToParseAccountURL = "Failed to parse existing ACME account URI %q: %v"
 	messageTemplateFailedToGetEABKey       = "failed to get External Account Binding key from secret: %v"
+	messageTemplateFailedToLoadTLSConfig   = "Failed to configure TLS for the ACME server: %v"
+	messageTemplateTermsOfServiceChanged   = "The ACME server terms of service changed from %q to %q. Review the new terms of service, as the ACME server may require them to be agreed to before issuing further certificates"
 )
 
 // Setup will verify an existing ACME registration, or create one if not
@@ -112,7 +115,7 @@ func (a *Acme) Setup(ctx context.Context) error {
 		pk, err = a.createAccountPrivateKey(ctx, privateKeySelector, ns)
 		if err != nil {
 			msg = messageAccountRegistrationFailed + err.Error()
-			reason = errorAccountRegistrationFailed
+			reason = events.ReasonAccountRegistrationFailed
 			return fmt.Errorf(msg)
 		}
 		// We clear the ACME account URI as we have generated a new private key
@@ -155,7 +158,17 @@ func (a *Acme) Setup(ctx context.Context) error {
 	// We could therefore move the removing of the client up to the start of
 	// this function.
 	a.accountRegistry.RemoveClient(string(a.issuer.GetUID()))
-	httpClient := accounts.BuildHTTPClient(a.metrics, a.issuer.GetSpec().ACME.SkipTLSVerify)
+	tlsConfig, err := a.tlsConfig(ctx, ns)
+	if err != nil {
+		reason = errorInvalidConfig
+		msg = fmt.Sprintf(messageTemplateFailedToLoadTLSConfig, err)
+		if errors.IsInvalidData(err) {
+			// absorb errors as retrying will not help resolve this error
+			return nil
+		}
+		return fmt.Errorf(msg)
+	}
+	httpClient := accounts.BuildHTTPClientWithTLSConfig(a.metrics, tlsConfig)
 	cl := a.clientBuilder(httpClient, *a.issuer.GetSpec().ACME, rsaPk)
 
 	// TODO: perform a complex check to determine whether we need to verify
@@ -169,9 +182,9 @@ func (a *Acme) Setup(ctx context.Context) error {
 	rawServerURL := a.issuer.GetSpec().ACME.Server
 	parsedServerURL, err := url.Parse(rawServerURL)
 	if err != nil {
-		reason = errorInvalidURL
+		reason = events.ReasonInvalidURL
 		msg = fmt.Sprintf(messageTemplateFailedToParseURL, rawServerURL, err)
-		a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorInvalidURL, msg)
+		a.recorder.Event(a.issuer, corev1.EventTypeWarning, events.ReasonInvalidURL, msg)
 		// absorb errors as retrying will not help resolve this error
 		return nil
 	}
@@ -179,13 +192,15 @@ func (a *Acme) Setup(ctx context.Context) error {
 	rawAccountURL := a.issuer.GetStatus().ACMEStatus().URI
 	parsedAccountURL, err := url.Parse(rawAccountURL)
 	if err != nil {
-		reason = errorInvalidURL
+		reason = events.ReasonInvalidURL
 		msg = fmt.Sprintf(messageTemplateFailedToParseAccountURL, rawAccountURL, err)
-		a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorInvalidURL, msg)
+		a.recorder.Event(a.issuer, corev1.EventTypeWarning, events.ReasonInvalidURL, msg)
 		// absorb errors as retrying will not help resolve this error
 		return nil
 	}
 
+	a.updateDirectoryMetadata(ctx, cl)
+
 	hasReadyCondition := apiutil.IssuerHasCondition(a.issuer, v1.IssuerCondition{
 		Type:   v1.IssuerConditionReady,
 		Status: cmmeta.ConditionTrue,
@@ -228,15 +243,15 @@ func (a *Acme) Setup(ctx context.Context) error {
 		// Do not re-try if we fail to get the MAC key as it does not exist at the reference.
 		case apierrors.IsNotFound(err), errors.IsInvalidData(err):
 			log.Error(err, "failed to verify ACME account")
-			reason = errorAccountRegistrationFailed
+			reason = events.ReasonAccountRegistrationFailed
 			msg = messageAccountRegistrationFailed + err.Error()
 			a.recorder.Event(a.issuer, corev1.EventTypeWarning,
-				errorAccountRegistrationFailed,
+				events.ReasonAccountRegistrationFailed,
 				msg)
 			return nil
 
 		case err != nil:
-			reason = errorAccountRegistrationFailed
+			reason = events.ReasonAccountRegistrationFailed
 			msg = messageAccountRegistrationFailed + err.Error()
 			return fmt.Errorf(msg)
 		}
@@ -254,7 +269,7 @@ func (a *Acme) Setup(ctx context.Context) error {
 		// TODO: this error could be from an account registration or an attempt
 		// to retrieve an existing account- perhaps we should log different
 		// messages in those two scenarios.
-		reason = errorAccountRegistrationFailed
+		reason = events.ReasonAccountRegistrationFailed
 		msg = messageAccountRegistrationFailed + err.Error()
 		log.Error(err, "failed to register an ACME account")
 
@@ -282,10 +297,10 @@ func (a *Acme) Setup(ctx context.Context) error {
 	specEmail := a.issuer.GetSpec().ACME.Email
 	account, registeredEmail, err := ensureEmailUpToDate(ctx, cl, account, specEmail)
 	if err != nil {
-		reason = errorAccountUpdateFailed
+		reason = events.ReasonAccountUpdateFailed
 		msg = messageAccountUpdateFailed + err.Error()
 		log.Error(err, "failed to update ACME account")
-		a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorAccountUpdateFailed, msg)
+		a.recorder.Event(a.issuer, corev1.EventTypeWarning, events.ReasonAccountUpdateFailed, msg)
 
 		acmeErr, ok := err.(*acmeapi.Error)
 		// If this is not an ACME error, we will simply return it and retry later
@@ -318,6 +333,33 @@ func (a *Acme) Setup(ctx context.Context) error {
 	return nil
 }
 
+// updateDirectoryMetadata stores the metadata of the ACME server directory in
+// the Issuer's status, recording an event if the terms of service changed
+// since they were last observed. Failing to discover the directory is not
+// fatal, as the metadata is informational only.
+func (a *Acme) updateDirectoryMetadata(ctx context.Context, cl client.Interface) {
+	log := logf.FromContext(ctx)
+
+	dir, err := cl.Discover(ctx)
+	if err != nil {
+		log.Error(err, "failed to discover ACME server directory metadata")
+		return
+	}
+
+	status := a.issuer.GetStatus().ACMEStatus()
+	if status.Directory != nil && status.Directory.TermsOfService != "" && status.Directory.TermsOfService != dir.Terms {
+		a.recorder.Eventf(a.issuer, corev1.EventTypeWarning, events.ReasonTermsOfServiceChanged,
+			messageTemplateTermsOfServiceChanged, status.Directory.TermsOfService, dir.Terms)
+	}
+
+	status.Directory = &cmacme.ACMEDirectoryMetadata{
+		TermsOfService:          dir.Terms,
+		Website:                 dir.Website,
+		CAAIdentities:           dir.CAA,
+		ExternalAccountRequired: dir.ExternalAccountRequired,
+	}
+}
+
 func ensureEmailUpToDate(ctx context.Context, cl client.Interface, acc *acmeapi.Account, specEmail string) (*acmeapi.Account, string, error) {
 	log := logf.FromContext(ctx)
 
@@ -381,6 +423,36 @@ func (a *Acme) registerAccount(ctx context.Context, cl client.Interface, eabAcco
 	return acc, nil
 }
 
+// tlsConfig returns the TLS configuration used to connect to the ACME server.
+// The client certificate is read from its secret on every new connection, so
+// that a renewed certificate is picked up without the Issuer being updated.
+func (a *Acme) tlsConfig(ctx context.Context, ns string) (*tls.Config, error) {
+	spec := a.issuer.GetSpec().ACME
+	config := &tls.Config{InsecureSkipVerify: spec.SkipTLSVerify}
+
+	if len(spec.CABundle) > 0 {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(spec.CABundle) {
+			return nil, errors.NewInvalidData("no certificates could be parsed from the CA bundle")
+		}
+	}
+
+	if spec.ClientCertificateSecretRef != nil {
+		name := spec.ClientCertificateSecretRef.Name
+		// Load the client certificate once to surface a missing or invalid
+		// secret on the Issuer's status.
+		if _, err := a.clientCertificateFromSecret(ctx, ns, name); err != nil {
+			return nil, err
+		}
+		// The HTTP client outlives the context of this call.
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return a.clientCertificateFromSecret(context.Background(), ns, name)
+		}
+	}
+
+	return config, nil
+}
+
 func (a *Acme) getEABKey(ctx context.Context, ns string) ([]byte, error) {
 	eab := a.issuer.GetSpec().ACME.ExternalAccountBinding.Key
 	sec, err := a.secretsClient.Secrets(ns).Get(ctx, eab.Name, metav1.GetOptions{})
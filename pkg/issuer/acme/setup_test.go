@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/rsa"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -40,6 +41,7 @@ import (
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	controllertest "github.com/jetstack/cert-manager/pkg/controller/test"
 	"github.com/jetstack/cert-manager/pkg/util"
 	"github.com/jetstack/cert-manager/pkg/util/errors"
@@ -108,6 +110,9 @@ func TestAcme_Setup(t *testing.T) {
 		// Error returned by keyFromSecret stub.
 		kfsErr error
 
+		// Error returned by clientCertificateFromSecret stub.
+		ccfsErr error
+
 		// Whether RemoveClient should be called.
 		removeClientShouldBeCalled bool
 
@@ -122,6 +127,11 @@ func TestAcme_Setup(t *testing.T) {
 		// Error returned by cl.GetReg
 		getRegErr error
 
+		// Directory returned by cl.Discover
+		directory acmeapi.Directory
+		// Error returned by cl.Discover
+		discoverErr error
+
 		// Error returned when creating ACME account key.
 		acmePrivKeySecretCreateErr error
 		// ACME account key created by createAccountPrivateKey.
@@ -134,8 +144,11 @@ func TestAcme_Setup(t *testing.T) {
 		expectedRegisteredAcc *acmeapi.Account
 		// expected issuer conditions after Setup has been called.
 		expectedConditions []cmapi.IssuerCondition
-		expectedEvents     []string
-		wantsErr           bool
+		// expected ACME directory metadata in the issuer's status after
+		// Setup has been called, not checked if nil.
+		expectedDirectory *cmacme.ACMEDirectoryMetadata
+		expectedEvents    []string
+		wantsErr          bool
 	}{
 		"LetsEncrypt ACME v1 prod URL specified, return early": {
 			issuer: gen.IssuerFrom(baseIssuer,
@@ -162,7 +175,7 @@ func TestAcme_Setup(t *testing.T) {
 			acmePrivKeySecretCreateErr: someErr,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionReason(events.ReasonAccountRegistrationFailed),
 					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+someErr.Error())),
 			},
 			wantsErr: true,
@@ -225,11 +238,11 @@ func TestAcme_Setup(t *testing.T) {
 			removeClientShouldBeCalled: true,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorInvalidURL),
+					gen.SetIssuerConditionReason(events.ReasonInvalidURL),
 					gen.SetIssuerConditionMessage(invalidURLMessage)),
 			},
 			expectedEvents: []string{
-				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorInvalidURL, invalidURLMessage)},
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, events.ReasonInvalidURL, invalidURLMessage)},
 		},
 		"ACME account URL is an invalid URL": {
 			issuer: gen.IssuerFrom(baseIssuer,
@@ -238,11 +251,11 @@ func TestAcme_Setup(t *testing.T) {
 			removeClientShouldBeCalled: true,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorInvalidURL),
+					gen.SetIssuerConditionReason(events.ReasonInvalidURL),
 					gen.SetIssuerConditionMessage(invalidAccountURLMessage)),
 			},
 			expectedEvents: []string{
-				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorInvalidURL, invalidAccountURLMessage),
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, events.ReasonInvalidURL, invalidAccountURLMessage),
 			},
 		},
 		"ACME Issuer is ready, URL and email are matching": {
@@ -263,6 +276,42 @@ func TestAcme_Setup(t *testing.T) {
 			removeClientShouldBeCalled: true,
 			addClientShouldBeCalled:    true,
 		},
+		"ACME server CA bundle is invalid": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMECABundle([]byte(someString))),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorInvalidConfig),
+					gen.SetIssuerConditionMessage(fmt.Sprintf(messageTemplateFailedToLoadTLSConfig, "no certificates could be parsed from the CA bundle"))),
+			},
+		},
+		"client certificate for ACME server specified, but the corresponding secret is not found": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEClientCertificateSecretRef(someString)),
+			kfsKey:                     rsaPrivKey,
+			ccfsErr:                    notFoundErr,
+			removeClientShouldBeCalled: true,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorInvalidConfig),
+					gen.SetIssuerConditionMessage(fmt.Sprintf(messageTemplateFailedToLoadTLSConfig, notFoundErr))),
+			},
+			wantsErr: true,
+		},
+		"client certificate for ACME server specified, but the corresponding secret contains invalid data": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEClientCertificateSecretRef(someString)),
+			kfsKey:                     rsaPrivKey,
+			ccfsErr:                    invalidDataErr,
+			removeClientShouldBeCalled: true,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorInvalidConfig),
+					gen.SetIssuerConditionMessage(fmt.Sprintf(messageTemplateFailedToLoadTLSConfig, invalidDataErr))),
+			},
+		},
 		"EAB for issuer specified, but the corresponding secret is not found": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEAB(someString, someString)),
@@ -271,11 +320,11 @@ func TestAcme_Setup(t *testing.T) {
 			eabSecretGetErr:            notFoundErr,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionReason(events.ReasonAccountRegistrationFailed),
 					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+notFoundErr.Error())),
 			},
 			expectedEvents: []string{
-				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountRegistrationFailed, messageAccountRegistrationFailed+notFoundErr.Error()),
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, events.ReasonAccountRegistrationFailed, messageAccountRegistrationFailed+notFoundErr.Error()),
 			},
 		},
 		"EAB for issuer specified, attempting to retrieve secret fails with unknown error": {
@@ -286,7 +335,7 @@ func TestAcme_Setup(t *testing.T) {
 			eabSecretGetErr:            someErr,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionReason(events.ReasonAccountRegistrationFailed),
 					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+fmt.Sprintf(messageTemplateFailedToGetEABKey, someErr))),
 			},
 			wantsErr: true,
@@ -299,7 +348,7 @@ func TestAcme_Setup(t *testing.T) {
 			expectedRegisteredAcc:      &acmeapi.Account{},
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionReason(events.ReasonAccountRegistrationFailed),
 					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+someErr.Error())),
 			},
 			wantsErr: true,
@@ -312,7 +361,7 @@ func TestAcme_Setup(t *testing.T) {
 			registerErr:                acmeErr450,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionReason(events.ReasonAccountRegistrationFailed),
 					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+acmeErr450.Error())),
 			},
 		},
@@ -324,7 +373,7 @@ func TestAcme_Setup(t *testing.T) {
 			registerErr:                acmeErr500,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionReason(events.ReasonAccountRegistrationFailed),
 					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+acmeErr500.Error())),
 			},
 			wantsErr: true,
@@ -338,7 +387,7 @@ func TestAcme_Setup(t *testing.T) {
 			getRegErr:                  someErr,
 			expectedConditions: []cmapi.IssuerCondition{
 				*gen.IssuerConditionFrom(readyFalseCondition,
-					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionReason(events.ReasonAccountRegistrationFailed),
 					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+someErr.Error())),
 			},
 			wantsErr: true,
@@ -382,6 +431,61 @@ func TestAcme_Setup(t *testing.T) {
 					gen.SetIssuerConditionMessage(messageAccountRegistered)),
 			},
 		},
+		"ACME directory metadata is stored in the issuer's status": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.AddIssuerCondition(*gen.IssuerConditionFrom(readyTrueCondition))),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			directory: acmeapi.Directory{
+				Terms:                   "https://example.com/tos-v1.pdf",
+				Website:                 "https://example.com",
+				CAA:                     []string{"example.com"},
+				ExternalAccountRequired: true,
+			},
+			expectedConditions: []cmapi.IssuerCondition{*gen.IssuerConditionFrom(readyTrueCondition)},
+			expectedDirectory: &cmacme.ACMEDirectoryMetadata{
+				TermsOfService:          "https://example.com/tos-v1.pdf",
+				Website:                 "https://example.com",
+				CAAIdentities:           []string{"example.com"},
+				ExternalAccountRequired: true,
+			},
+		},
+		"ACME server terms of service changed, an event is recorded": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.SetIssuerACMEDirectory(&cmacme.ACMEDirectoryMetadata{TermsOfService: "https://example.com/tos-v1.pdf"}),
+				gen.AddIssuerCondition(*gen.IssuerConditionFrom(readyTrueCondition))),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			directory:                  acmeapi.Directory{Terms: "https://example.com/tos-v2.pdf"},
+			expectedConditions:         []cmapi.IssuerCondition{*gen.IssuerConditionFrom(readyTrueCondition)},
+			expectedDirectory:          &cmacme.ACMEDirectoryMetadata{TermsOfService: "https://example.com/tos-v2.pdf"},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, events.ReasonTermsOfServiceChanged,
+					fmt.Sprintf(messageTemplateTermsOfServiceChanged, "https://example.com/tos-v1.pdf", "https://example.com/tos-v2.pdf")),
+			},
+		},
+		"Discovering the ACME directory fails, the existing metadata is kept": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.SetIssuerACMEDirectory(&cmacme.ACMEDirectoryMetadata{TermsOfService: "https://example.com/tos-v1.pdf"}),
+				gen.AddIssuerCondition(*gen.IssuerConditionFrom(readyTrueCondition))),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			discoverErr:                someErr,
+			expectedConditions:         []cmapi.IssuerCondition{*gen.IssuerConditionFrom(readyTrueCondition)},
+			expectedDirectory:          &cmacme.ACMEDirectoryMetadata{TermsOfService: "https://example.com/tos-v1.pdf"},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -404,6 +508,11 @@ func TestAcme_Setup(t *testing.T) {
 			kfsWasCalled := false
 			kfs := keyFromSecretMockBuilder(&(kfsWasCalled), test.kfsKey, test.kfsErr)
 
+			// Set up a mock clientCertificateFromSecret.
+			ccfs := func(context.Context, string, string) (*tls.Certificate, error) {
+				return &tls.Certificate{}, test.ccfsErr
+			}
+
 			// Mock ACME accounts registry.
 			removeClientWasCalled := false
 			addClientWasCalled := false
@@ -426,6 +535,9 @@ func TestAcme_Setup(t *testing.T) {
 				FakeGetReg: func(context.Context, string) (*acmeapi.Account, error) {
 					return test.getRegAcc, test.getRegErr
 				},
+				FakeDiscover: func(context.Context) (acmeapi.Directory, error) {
+					return test.directory, test.discoverErr
+				},
 			}
 
 			// Mock events recorder.
@@ -437,6 +549,8 @@ func TestAcme_Setup(t *testing.T) {
 				keyFromSecret:   kfs,
 				clientBuilder:   clientBuilderMock(&cl),
 				recorder:        recorder,
+
+				clientCertificateFromSecret: ccfs,
 			}
 
 			// Stub the clock to get consistent last transition times on conditions.
@@ -479,6 +593,15 @@ func TestAcme_Setup(t *testing.T) {
 					test.expectedConditions, gotConditions)
 			}
 
+			// Verify the ACME directory metadata stored in the issuer's status.
+			if test.expectedDirectory != nil {
+				gotDirectory := a.issuer.GetStatus().ACMEStatus().Directory
+				if !reflect.DeepEqual(gotDirectory, test.expectedDirectory) {
+					t.Errorf("Expected issuer's ACME directory metadata: %#+v\ngot: %#+v",
+						test.expectedDirectory, gotDirectory)
+				}
+			}
+
 			// Verify that the expected events were recorded.
 			if !util.EqualSorted(test.expectedEvents, recorder.Events) {
 				t.Errorf("Expected events:\n%+#v\ngot:%+#v",
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"golang.org/x/oauth2/google"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/util"
+	"github.com/jetstack/cert-manager/pkg/util/errors"
+	"github.com/jetstack/cert-manager/pkg/util/kmssigner"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// KeyPair returns the certificate chain and the signer of the private key of
+// the given CA issuer.
+// If the private key is held by a cloud KMS or a PKCS#11 token, only the
+// certificate chain is read from the issuer's Secret, and the returned signer
+// signs using the KMS or PKCS#11 key, whose public key must match the CA
+// certificate. Otherwise both are read from the issuer's Secret.
+func KeyPair(ctx context.Context, secretsLister corelisters.SecretLister, issuerOptions controller.IssuerOptions, issuerObj v1.GenericIssuer) ([]*x509.Certificate, crypto.Signer, error) {
+	spec := issuerObj.GetSpec().CA
+	namespace := issuerOptions.ResourceNamespace(issuerObj)
+	if spec.KMS == nil && spec.PKCS11 == nil {
+		return kube.SecretTLSKeyPairAndCA(ctx, secretsLister, namespace, spec.SecretName)
+	}
+
+	certs, err := kube.SecretTLSCertChainAndCA(ctx, secretsLister, namespace, spec.SecretName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if spec.PKCS11 != nil {
+		signer, err := pkcs11Signer(secretsLister, namespace, spec.PKCS11, certs[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		return certs, signer, nil
+	}
+
+	signer, err := kmsSigner(ctx, secretsLister, namespace, spec.KMS, issuerOptions.CanUseAmbientCredentials(issuerObj))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matches, err := pki.PublicKeyMatchesCertificate(signer.Public(), certs[0])
+	if err != nil {
+		return nil, nil, errors.NewInvalidData("failed to compare the public key of the KMS key with the CA certificate: %v", err)
+	}
+	if !matches {
+		return nil, nil, errors.NewInvalidData("the public key of the KMS key does not match the CA certificate")
+	}
+
+	return certs, signer, nil
+}
+
+func kmsSigner(ctx context.Context, secretsLister corelisters.SecretLister, namespace string, cfg *v1.CAIssuerKMS, ambient bool) (crypto.Signer, error) {
+	switch {
+	case cfg.AWS != nil:
+		return awsKMSSigner(ctx, secretsLister, namespace, cfg.AWS, ambient)
+	case cfg.GoogleCloud != nil:
+		return googleCloudKMSSigner(ctx, secretsLister, namespace, cfg.GoogleCloud, ambient)
+	default:
+		return nil, errors.NewInvalidData("no KMS configured for the CA issuer")
+	}
+}
+
+func awsKMSSigner(ctx context.Context, secretsLister corelisters.SecretLister, namespace string, cfg *v1.CAIssuerAWSKMS, ambient bool) (crypto.Signer, error) {
+	sessionOpts := session.Options{
+		Config: *aws.NewConfig().WithRegion(cfg.Region),
+	}
+
+	if cfg.AccessKeyID != "" || cfg.SecretAccessKey != nil {
+		if cfg.AccessKeyID == "" || cfg.SecretAccessKey == nil {
+			return nil, errors.NewInvalidData("both accessKeyID and secretAccessKeySecretRef must be set to use explicit AWS credentials")
+		}
+		secretAccessKey, err := loadSecretData(secretsLister, namespace, cfg.SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentials(cfg.AccessKeyID, string(secretAccessKey), "")
+		// also disable 'ambient' region sources
+		sessionOpts.SharedConfigState = session.SharedConfigDisable
+	} else if !ambient {
+		return nil, errors.NewInvalidData("no AWS credentials configured and the issuer is not allowed to use ambient credentials")
+	}
+
+	sess, err := session.NewSessionWithOptions(sessionOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create aws session: %s", err)
+	}
+	sess.Handlers.Build.PushBack(request.WithAppendUserAgent(util.CertManagerUserAgent))
+
+	var configs []*aws.Config
+	if cfg.Role != "" {
+		configs = append(configs, &aws.Config{Credentials: stscreds.NewCredentials(sess, cfg.Role, func(p *stscreds.AssumeRoleProvider) {
+			p.RoleSessionName = "cert-manager"
+		})})
+	}
+
+	return kmssigner.NewAWS(ctx, kms.New(sess, configs...), cfg.KeyID)
+}
+
+func googleCloudKMSSigner(ctx context.Context, secretsLister corelisters.SecretLister, namespace string, cfg *v1.CAIssuerGoogleCloudKMS, ambient bool) (crypto.Signer, error) {
+	var client *http.Client
+	switch {
+	case cfg.ServiceAccount != nil:
+		saBytes, err := loadSecretData(secretsLister, namespace, cfg.ServiceAccount)
+		if err != nil {
+			return nil, err
+		}
+		conf, err := google.JWTConfigFromJSON(saBytes, cloudkms.CloudkmsScope)
+		if err != nil {
+			return nil, errors.NewInvalidData("unable to parse Google Cloud service account key: %v", err)
+		}
+		client = conf.Client(ctx)
+	case ambient:
+		var err error
+		client, err = google.DefaultClient(ctx, cloudkms.CloudkmsScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get ambient Google Cloud credentials: %w", err)
+		}
+	default:
+		return nil, errors.NewInvalidData("no Google Cloud service account configured and the issuer is not allowed to use ambient credentials")
+	}
+
+	service, err := cloudkms.NewService(ctx, option.WithHTTPClient(client), option.WithUserAgent(util.CertManagerUserAgent))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Google Cloud KMS client: %w", err)
+	}
+
+	return kmssigner.NewGoogleCloud(ctx, service, cfg.KeyVersion)
+}
+
+func loadSecretData(secretsLister corelisters.SecretLister, namespace string, selector *cmmeta.SecretKeySelector) ([]byte, error) {
+	secret, err := secretsLister.Secrets(namespace).Get(selector.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := secret.Data[selector.Key]
+	if !ok {
+		return nil, errors.NewInvalidData("no key %q in secret %q", selector.Key, namespace+"/"+selector.Name)
+	}
+	return data, nil
+}
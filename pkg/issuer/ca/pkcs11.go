@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"crypto"
+	"crypto/x509"
+	"errors"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmerrors "github.com/jetstack/cert-manager/pkg/util/errors"
+	"github.com/jetstack/cert-manager/pkg/util/pkcs11signer"
+)
+
+// pkcs11Signer returns a signer which signs using the private key of the
+// given CA certificate held by a PKCS#11 token.
+func pkcs11Signer(secretsLister corelisters.SecretLister, namespace string, cfg *v1.CAIssuerPKCS11, caCert *x509.Certificate) (crypto.Signer, error) {
+	pin, err := loadSecretData(secretsLister, namespace, &cfg.PIN)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := pkcs11signer.Open(pkcs11signer.Config{
+		ModulePath: cfg.ModulePath,
+		Slot:       uint(cfg.Slot),
+		PIN:        string(pin),
+		KeyLabel:   cfg.KeyLabel,
+	})
+	if errors.Is(err, pkcs11signer.ErrNotSupported) {
+		// retrying will not help until cert-manager is rebuilt
+		return nil, cmerrors.NewInvalidData("%v", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := pkcs11signer.New(key, caCert.PublicKey)
+	if errors.Is(err, pkcs11signer.ErrPublicKeyMismatch) {
+		return nil, cmerrors.NewInvalidData("the private key on the PKCS#11 token does not match the CA certificate")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
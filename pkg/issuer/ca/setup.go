@@ -24,16 +24,12 @@ import (
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util/kube"
 )
 
 const (
-	errorGetKeyPair     = "ErrGetKeyPair"
-	errorInvalidKeyPair = "ErrInvalidKeyPair"
-
-	successKeyPairVerified = "KeyPairVerified"
-
 	messageErrorGetKeyPair = "Error getting keypair for CA issuer: "
 
 	messageKeyPairVerified = "Signing CA verified"
@@ -46,17 +42,23 @@ func (c *CA) Setup(ctx context.Context) error {
 	if err != nil {
 		log.Error(err, "error getting signing CA TLS certificate")
 		s := messageErrorGetKeyPair + err.Error()
-		c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorGetKeyPair, s)
-		apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorGetKeyPair, s)
+		c.Recorder.Event(c.issuer, corev1.EventTypeWarning, events.ReasonGetKeyPairFailed, s)
+		apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, events.ReasonGetKeyPairFailed, s)
 		return err
 	}
 
-	_, err = kube.SecretTLSKey(ctx, c.secretsLister, c.resourceNamespace, c.issuer.GetSpec().CA.SecretName)
+	if spec := c.issuer.GetSpec().CA; spec.KMS != nil || spec.PKCS11 != nil {
+		// verify the KMS or PKCS#11 key can be accessed and matches the CA
+		// certificate
+		_, _, err = KeyPair(ctx, c.secretsLister, c.IssuerOptions, c.issuer)
+	} else {
+		_, err = kube.SecretTLSKey(ctx, c.secretsLister, c.resourceNamespace, c.issuer.GetSpec().CA.SecretName)
+	}
 	if err != nil {
 		log.Error(err, "error getting signing CA private key")
 		s := messageErrorGetKeyPair + err.Error()
-		c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorGetKeyPair, s)
-		apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorGetKeyPair, s)
+		c.Recorder.Event(c.issuer, corev1.EventTypeWarning, events.ReasonGetKeyPairFailed, s)
+		apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, events.ReasonGetKeyPairFailed, s)
 		return err
 	}
 
@@ -64,15 +66,15 @@ func (c *CA) Setup(ctx context.Context) error {
 	if !cert.IsCA {
 		s := messageErrorGetKeyPair + "certificate is not a CA"
 		log.Error(nil, "signing certificate is not a CA")
-		c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorInvalidKeyPair, s)
-		apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorInvalidKeyPair, s)
+		c.Recorder.Event(c.issuer, corev1.EventTypeWarning, events.ReasonInvalidKeyPair, s)
+		apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, events.ReasonInvalidKeyPair, s)
 		// Don't return an error here as there is nothing more we can do
 		return nil
 	}
 
 	log.V(logf.DebugLevel).Info("signing CA verified")
-	c.Recorder.Event(c.issuer, corev1.EventTypeNormal, successKeyPairVerified, messageKeyPairVerified)
-	apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successKeyPairVerified, messageKeyPairVerified)
+	c.Recorder.Event(c.issuer, corev1.EventTypeNormal, events.ReasonKeyPairVerified, messageKeyPairVerified)
+	apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, events.ReasonKeyPairVerified, messageKeyPairVerified)
 
 	return nil
 }
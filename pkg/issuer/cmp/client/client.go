@@ -0,0 +1,565 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client implements the end entity side of the Certificate Management
+// Protocol (RFC 4210), over HTTP as defined in RFC 6712.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util"
+	"github.com/jetstack/cert-manager/pkg/util/kube"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	contentTypePKIXCMP    = "application/pkixcmp"
+	maxResponseBodySize   = 1 << 20 // 1 MiB
+	defaultRequestTimeout = 30 * time.Second
+	nonceSize             = 16
+	transactionIDSize     = 16
+)
+
+// Interface is a client for a CMP server.
+type Interface interface {
+	// Enroll requests a certificate for the DER encoded certificate signing
+	// request, returning the issued certificate followed by its chain.
+	// If update is set, the certificate is requested with a key update
+	// request protected by the key pair being updated. Otherwise an
+	// initialization request is sent for issuers protected by a MAC, and a
+	// certification request for issuers protected by a signature.
+	// If the CMP server has not issued the certificate yet a *PendingError
+	// is returned, and Poll should be called with its transaction ID. If the
+	// CMP server rejected the request a *FailedError is returned.
+	Enroll(ctx context.Context, csrDER []byte, update *KeyPair) ([]*x509.Certificate, error)
+
+	// Poll polls for the certificate of a request which Enroll returned a
+	// *PendingError for. The certificate signing request and key pair must
+	// be the same as those passed to Enroll.
+	Poll(ctx context.Context, csrDER []byte, transactionID string, update *KeyPair) ([]*x509.Certificate, error)
+}
+
+// ClientBuilder builds a client for the CMP server of an issuer, loading the
+// credentials of the issuer from Secrets in the given namespace.
+type ClientBuilder func(namespace string, secretsLister corelisters.SecretLister, iss *cmapi.CMPIssuer) (Interface, error)
+
+// KeyPair is a certificate and its private key.
+type KeyPair struct {
+	Certificate *x509.Certificate
+	PrivateKey  crypto.Signer
+}
+
+// PendingError is returned when the certificate has not been issued yet, for
+// example because the request awaits manual approval.
+type PendingError struct {
+	TransactionID string
+}
+
+func (e *PendingError) Error() string {
+	return fmt.Sprintf("certificate request %s is pending on the CMP server", e.TransactionID)
+}
+
+// FailedError is returned when the CMP server rejected the request.
+type FailedError struct {
+	Status string
+}
+
+func (e *FailedError) Error() string {
+	return fmt.Sprintf("CMP server rejected the certificate request: %s", e.Status)
+}
+
+type client struct {
+	url        *url.URL
+	httpClient *http.Client
+
+	// roots verify the signers of responses protected by a signature
+	roots *x509.CertPool
+
+	// Only one of macReference and signature is set, depending on how the
+	// issuer protects its requests.
+	macReference []byte
+	macSecret    []byte
+	signature    *signatureProtection
+}
+
+// New builds a client for the CMP server of the issuer.
+func New(namespace string, secretsLister corelisters.SecretLister, iss *cmapi.CMPIssuer) (Interface, error) {
+	u, err := url.Parse(iss.URL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CMP server URL: %w", err)
+	}
+
+	c := &client{
+		url: u,
+		httpClient: &http.Client{
+			Timeout:   defaultRequestTimeout,
+			Transport: http.DefaultTransport.(*http.Transport).Clone(),
+		},
+	}
+	if len(iss.CABundle) > 0 {
+		c.roots = x509.NewCertPool()
+		if ok := c.roots.AppendCertsFromPEM(iss.CABundle); !ok {
+			return nil, errors.New("error loading CA bundle: no valid certificates found")
+		}
+		c.httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{RootCAs: c.roots}
+	}
+
+	switch {
+	case iss.MAC != nil:
+		ref := iss.MAC.SecretRef
+		secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+		}
+		c.macReference = []byte(iss.MAC.Reference)
+		c.macSecret = bytes.TrimSpace(value)
+	case iss.Signature != nil:
+		certs, key, err := kube.SecretTLSKeyPair(context.TODO(), secretsLister, namespace, iss.Signature.SecretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		c.signature, err = newSignatureProtection(key, certs[0])
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("CMP issuer must specify one of mac or signature")
+	}
+
+	return c, nil
+}
+
+func (c *client) Enroll(ctx context.Context, csrDER []byte, update *KeyPair) ([]*x509.Certificate, error) {
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate signing request: %w", err)
+	}
+
+	t, err := c.newTransaction(csr, update, nil)
+	if err != nil {
+		return nil, err
+	}
+	reqs, err := certReqMessages(csr, update)
+	if err != nil {
+		return nil, err
+	}
+	bodyType := bodyCR
+	switch {
+	case update != nil:
+		bodyType = bodyKUR
+	case c.signature == nil:
+		bodyType = bodyIR
+	}
+	req, err := body(bodyType, reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.certificate(ctx, csr, req)
+}
+
+func (c *client) Poll(ctx context.Context, csrDER []byte, transactionID string, update *KeyPair) ([]*x509.Certificate, error) {
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate signing request: %w", err)
+	}
+	id, err := hex.DecodeString(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding transaction ID %q: %w", transactionID, err)
+	}
+
+	t, err := c.newTransaction(csr, update, id)
+	if err != nil {
+		return nil, err
+	}
+	req, err := body(bodyPollReq, []pollReq{{CertReqID: certReqID}})
+	if err != nil {
+		return nil, err
+	}
+
+	return t.certificate(ctx, csr, req)
+}
+
+// transaction holds the state of the messages exchanged with the CMP server
+// to request a certificate.
+type transaction struct {
+	client     *client
+	protection protection
+	id         []byte
+	recipient  asn1.RawValue
+
+	// recipNonce is the senderNonce of the last response
+	recipNonce []byte
+}
+
+// newTransaction starts a new transaction to request a certificate, or
+// continues the transaction with the given ID.
+func (c *client) newTransaction(csr *x509.CertificateRequest, update *KeyPair, id []byte) (*transaction, error) {
+	t := &transaction{
+		client:    c,
+		id:        id,
+		recipient: directoryName(nil),
+	}
+	if t.id == nil {
+		t.id = make([]byte, transactionIDSize)
+		if _, err := rand.Read(t.id); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	switch {
+	case update != nil:
+		// Key update requests are protected by the key pair being updated,
+		// and sent to the CA which issued it.
+		t.protection, err = newSignatureProtection(update.PrivateKey, update.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		t.recipient = directoryName(update.Certificate.RawIssuer)
+	case c.signature != nil:
+		t.protection = c.signature
+	default:
+		t.protection = &macProtection{
+			reference: c.macReference,
+			secret:    c.macSecret,
+			sender:    csr.RawSubject,
+		}
+	}
+	return t, nil
+}
+
+// certificate sends a request for a certificate, returning the certificate
+// for the request followed by its chain. The issued certificate is confirmed
+// with a certConf message unless the CMP server granted implicit
+// confirmation.
+func (t *transaction) certificate(ctx context.Context, csr *x509.CertificateRequest, req asn1.RawValue) ([]*x509.Certificate, error) {
+	header, rep, extraCerts, err := t.exchange(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rep.Tag {
+	case bodyIP, bodyCP, bodyKUP:
+	case bodyPollRep:
+		return nil, &PendingError{TransactionID: hex.EncodeToString(t.id)}
+	default:
+		return nil, fmt.Errorf("unexpected CMP response %s", bodyName(rep.Tag))
+	}
+
+	var content certRepMessage
+	if _, err := asn1.Unmarshal(rep.Bytes, &content); err != nil {
+		return nil, fmt.Errorf("error decoding CMP %s response: %w", bodyName(rep.Tag), err)
+	}
+	if len(content.Response) != 1 || content.Response[0].CertReqID != certReqID {
+		return nil, errors.New("CMP response does not contain a response to the certificate request")
+	}
+	resp := content.Response[0]
+	switch resp.Status.Status {
+	case statusAccepted, statusGrantedWithMods:
+	case statusWaiting:
+		return nil, &PendingError{TransactionID: hex.EncodeToString(t.id)}
+	case statusRejection:
+		return nil, &FailedError{Status: resp.Status.String()}
+	default:
+		return nil, fmt.Errorf("unexpected CMP response %s", resp.Status)
+	}
+
+	// Only certificates are supported, not encrypted certificates.
+	certOrEncCert := resp.CertifiedKeyPair.CertOrEncCert
+	if certOrEncCert.Class != asn1.ClassContextSpecific || certOrEncCert.Tag != 0 {
+		return nil, errors.New("CMP response does not contain a certificate")
+	}
+	leaf, err := x509.ParseCertificate(certOrEncCert.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate in CMP response: %w", err)
+	}
+	if ok, _ := pki.PublicKeysEqual(leaf.PublicKey, csr.PublicKey); !ok {
+		return nil, errors.New("CMP server did not return a certificate for the public key of the request")
+	}
+
+	if !header.hasGeneralInfo(oidImplicitConfirm) {
+		if err := t.confirm(ctx, leaf); err != nil {
+			return nil, err
+		}
+	}
+
+	var candidates []*x509.Certificate
+	for _, raw := range append(content.CAPubs, extraCerts...) {
+		if cert, err := x509.ParseCertificate(raw.FullBytes); err == nil {
+			candidates = append(candidates, cert)
+		}
+	}
+	return buildChain(leaf, candidates), nil
+}
+
+// confirm confirms the acceptance of the issued certificate.
+func (t *transaction) confirm(ctx context.Context, cert *x509.Certificate) error {
+	h := certHash(cert.SignatureAlgorithm).New()
+	h.Write(cert.Raw)
+	req, err := body(bodyCertConf, []certStatus{{CertHash: h.Sum(nil), CertReqID: certReqID}})
+	if err != nil {
+		return err
+	}
+
+	_, rep, _, err := t.exchange(ctx, req, false)
+	if err != nil {
+		return err
+	}
+	if rep.Tag != bodyPKIConf {
+		return fmt.Errorf("unexpected CMP response %s to certificate confirmation", bodyName(rep.Tag))
+	}
+	return nil
+}
+
+// exchange sends a request to the CMP server, returning the header, body and
+// extra certificates of its response once the response has been verified.
+// Error responses are returned as a *FailedError.
+func (t *transaction) exchange(ctx context.Context, req asn1.RawValue, implicitConfirm bool) (*pkiHeader, *asn1.RawValue, []asn1.RawValue, error) {
+	senderNonce := make([]byte, nonceSize)
+	if _, err := rand.Read(senderNonce); err != nil {
+		return nil, nil, nil, err
+	}
+	header := pkiHeader{
+		PVNO:          pvnoCMP2000,
+		Recipient:     t.recipient,
+		MessageTime:   time.Now().UTC().Truncate(time.Second),
+		TransactionID: t.id,
+		SenderNonce:   senderNonce,
+		RecipNonce:    t.recipNonce,
+	}
+	if implicitConfirm {
+		header.GeneralInfo = []infoTypeAndValue{{InfoType: oidImplicitConfirm, InfoValue: asn1.NullRawValue}}
+	}
+	if err := t.protection.header(&header); err != nil {
+		return nil, nil, nil, err
+	}
+
+	message, err := t.protect(header, req)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	data, err := t.client.do(ctx, message)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var rep pkiMessage
+	if rest, err := asn1.Unmarshal(data, &rep); err != nil {
+		return nil, nil, nil, fmt.Errorf("error decoding CMP response: %w", err)
+	} else if len(rest) > 0 {
+		return nil, nil, nil, errors.New("trailing data after CMP response")
+	}
+	var repHeader pkiHeader
+	if _, err := asn1.Unmarshal(rep.Header.FullBytes, &repHeader); err != nil {
+		return nil, nil, nil, fmt.Errorf("error decoding CMP response header: %w", err)
+	}
+
+	// Servers may not be able to protect errors, for example when the
+	// protection of the request could not be verified.
+	if rep.Body.Tag == bodyError && len(rep.Protection.Bytes) == 0 {
+		return nil, nil, nil, errorResponse(rep.Body)
+	}
+	if err := t.verify(&repHeader, &rep); err != nil {
+		return nil, nil, nil, err
+	}
+	if !bytes.Equal(repHeader.TransactionID, t.id) {
+		return nil, nil, nil, errors.New("CMP response transaction ID does not match request")
+	}
+	if !bytes.Equal(repHeader.RecipNonce, senderNonce) {
+		return nil, nil, nil, errors.New("CMP response recipient nonce does not match request")
+	}
+	t.recipNonce = repHeader.SenderNonce
+
+	if rep.Body.Tag == bodyError {
+		return nil, nil, nil, errorResponse(rep.Body)
+	}
+	return &repHeader, &rep.Body, rep.ExtraCerts, nil
+}
+
+// protect returns the DER encoded PKIMessage for the header and body.
+func (t *transaction) protect(header pkiHeader, req asn1.RawValue) ([]byte, error) {
+	rawHeader, err := asn1.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	rawBody, err := asn1.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	part, err := asn1.Marshal(protectedPart{
+		Header: asn1.RawValue{FullBytes: rawHeader},
+		Body:   asn1.RawValue{FullBytes: rawBody},
+	})
+	if err != nil {
+		return nil, err
+	}
+	protection, err := t.protection.protect(&header, part)
+	if err != nil {
+		return nil, fmt.Errorf("error protecting CMP request: %w", err)
+	}
+
+	return asn1.Marshal(pkiMessage{
+		Header:     asn1.RawValue{FullBytes: rawHeader},
+		Body:       asn1.RawValue{FullBytes: rawBody},
+		Protection: asn1.BitString{Bytes: protection, BitLength: len(protection) * 8},
+		ExtraCerts: t.protection.extraCerts(),
+	})
+}
+
+// verify verifies the protection of a response, using the MAC secret of the
+// issuer or the CA bundle of the issuer.
+func (t *transaction) verify(header *pkiHeader, rep *pkiMessage) error {
+	if len(rep.Protection.Bytes) == 0 {
+		return errors.New("CMP response is not protected")
+	}
+	part, err := asn1.Marshal(protectedPart{Header: rep.Header, Body: rep.Body})
+	if err != nil {
+		return err
+	}
+
+	if header.ProtectionAlg.Algorithm.Equal(oidPasswordBasedMac) {
+		if t.client.macSecret == nil {
+			return errors.New("CMP response is protected by a MAC, but the issuer has no MAC secret")
+		}
+		mac, err := passwordBasedMAC(t.client.macSecret, header.ProtectionAlg, part)
+		if err != nil {
+			return err
+		}
+		if !hmac.Equal(mac, rep.Protection.Bytes) {
+			return errors.New("CMP response MAC could not be verified")
+		}
+		return nil
+	}
+
+	var certs []*x509.Certificate
+	for _, raw := range rep.ExtraCerts {
+		cert, err := x509.ParseCertificate(raw.FullBytes)
+		if err != nil {
+			return fmt.Errorf("error parsing certificate in CMP response: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	return verifySignature(header, part, rep.Protection.Bytes, certs, t.client.roots)
+}
+
+// errorResponse returns the error of an error response.
+func errorResponse(rep asn1.RawValue) error {
+	var content errorMsgContent
+	if _, err := asn1.Unmarshal(rep.Bytes, &content); err != nil {
+		return fmt.Errorf("error decoding CMP error response: %w", err)
+	}
+	status := content.PKIStatusInfo.String()
+	if len(content.ErrorDetails) > 0 {
+		status += " (" + strings.Join(content.ErrorDetails, ", ") + ")"
+	}
+	return &FailedError{Status: status}
+}
+
+// certHash returns the hash used to confirm a certificate, which is the hash
+// of the algorithm the certificate was signed with.
+func certHash(alg x509.SignatureAlgorithm) crypto.Hash {
+	switch alg {
+	case x509.SHA1WithRSA, x509.ECDSAWithSHA1:
+		return crypto.SHA1
+	case x509.SHA384WithRSA, x509.SHA384WithRSAPSS, x509.ECDSAWithSHA384:
+		return crypto.SHA384
+	case x509.SHA512WithRSA, x509.SHA512WithRSAPSS, x509.ECDSAWithSHA512, x509.PureEd25519:
+		return crypto.SHA512
+	default:
+		return crypto.SHA256
+	}
+}
+
+// buildChain returns the issued certificate followed by its chain, built from
+// the other certificates returned by the CMP server.
+func buildChain(leaf *x509.Certificate, candidates []*x509.Certificate) []*x509.Certificate {
+	chain := []*x509.Certificate{leaf}
+	for current := leaf; !bytes.Equal(current.RawIssuer, current.RawSubject); {
+		var parent *x509.Certificate
+		for _, cert := range candidates {
+			if !cert.Equal(current) && current.CheckSignatureFrom(cert) == nil {
+				parent = cert
+				break
+			}
+		}
+		if parent == nil || len(chain) > len(candidates) {
+			break
+		}
+		chain = append(chain, parent)
+		current = parent
+	}
+	return chain
+}
+
+func bodyName(tag int) string {
+	if name, ok := bodyNames[tag]; ok {
+		return name
+	}
+	return fmt.Sprintf("body type %d", tag)
+}
+
+// do sends a DER encoded PKIMessage to the CMP server, returning the DER
+// encoded response.
+func (c *client) do(ctx context.Context, message []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url.String(), bytes.NewReader(message))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", util.CertManagerUserAgent)
+	req.Header.Set("Content-Type", contentTypePKIXCMP)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling CMP server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading CMP response: %w", err)
+	}
+	// Error messages may be returned with any status code.
+	contentType := strings.TrimSpace(strings.Split(resp.Header.Get("Content-Type"), ";")[0])
+	if contentType != contentTypePKIXCMP {
+		return nil, fmt.Errorf("CMP request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
@@ -0,0 +1,401 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	testlisters "github.com/jetstack/cert-manager/test/unit/listers"
+)
+
+const testMACSecret = "password"
+
+// testServer is a CMP server which issues certificates signed by its CA.
+type testServer struct {
+	t      *testing.T
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+	status int
+
+	// implicitConfirm is whether the server grants implicit confirmation
+	implicitConfirm bool
+
+	// bodies are the types of the requests received by the server
+	bodies []int
+	// oldCertSerial is the serial number of the certificate updated by the
+	// last key update request
+	oldCertSerial *big.Int
+}
+
+func newTestServer(t *testing.T) *testServer {
+	key, cert := newTestCertificate(t, "cmp-ca", nil, nil)
+	return &testServer{t: t, caKey: key, caCert: cert, status: statusAccepted}
+}
+
+// newTestCertificate generates a key and certificate, signed by the parent or
+// self-signed if parent is nil.
+func newTestCertificate(t *testing.T, cn string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*ecdsa.PrivateKey, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		SubjectKeyId:          []byte(cn),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, cert
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil || r.Header.Get("Content-Type") != contentTypePKIXCMP {
+		s.t.Errorf("unexpected request: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var req pkiMessage
+	if _, err := asn1.Unmarshal(data, &req); err != nil {
+		s.t.Fatalf("error decoding request: %v", err)
+	}
+	var header pkiHeader
+	if _, err := asn1.Unmarshal(req.Header.FullBytes, &header); err != nil {
+		s.t.Fatalf("error decoding request header: %v", err)
+	}
+	s.bodies = append(s.bodies, req.Body.Tag)
+
+	part, err := asn1.Marshal(protectedPart{Header: req.Header, Body: req.Body})
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	mac := header.ProtectionAlg.Algorithm.Equal(oidPasswordBasedMac)
+	if mac {
+		expected, err := passwordBasedMAC([]byte(testMACSecret), header.ProtectionAlg, part)
+		if err != nil || !bytes.Equal(expected, req.Protection.Bytes) {
+			s.t.Fatalf("request MAC could not be verified: %v", err)
+		}
+	} else {
+		signer, err := x509.ParseCertificate(req.ExtraCerts[0].FullBytes)
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		if err := signer.CheckSignature(x509.ECDSAWithSHA256, part, req.Protection.Bytes); err != nil {
+			s.t.Fatalf("request signature could not be verified: %v", err)
+		}
+	}
+
+	var repBody asn1.RawValue
+	var extraCerts []asn1.RawValue
+	switch req.Body.Tag {
+	case bodyIR, bodyCR, bodyKUR:
+		var reqs []certReqMsg
+		if _, err := asn1.Unmarshal(req.Body.Bytes, &reqs); err != nil {
+			s.t.Fatalf("error decoding certificate request: %v", err)
+		}
+		if req.Body.Tag == bodyKUR {
+			var oldCertID certID
+			if _, err := asn1.Unmarshal(reqs[0].CertReq.Controls[0].Value.FullBytes, &oldCertID); err != nil {
+				s.t.Fatal(err)
+			}
+			s.oldCertSerial = oldCertID.SerialNumber
+		}
+		repBody = s.certRep(req.Body.Tag+1, reqs[0].CertReq.CertTemplate)
+		extraCerts = []asn1.RawValue{{FullBytes: s.caCert.Raw}}
+	case bodyCertConf:
+		repBody = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: bodyPKIConf, IsCompound: true, Bytes: asn1.NullBytes}
+	default:
+		s.t.Fatalf("unexpected request %s", bodyName(req.Body.Tag))
+	}
+
+	repHeader := pkiHeader{
+		PVNO:          pvnoCMP2000,
+		Sender:        directoryName(s.caCert.RawSubject),
+		Recipient:     header.Sender,
+		TransactionID: header.TransactionID,
+		SenderNonce:   []byte("server nonce"),
+		RecipNonce:    header.SenderNonce,
+	}
+	if s.implicitConfirm {
+		repHeader.GeneralInfo = []infoTypeAndValue{{InfoType: oidImplicitConfirm, InfoValue: asn1.NullRawValue}}
+	}
+	var p protection = &macProtection{secret: []byte(testMACSecret)}
+	if !mac {
+		p, err = newSignatureProtection(s.caKey, s.caCert)
+		if err != nil {
+			s.t.Fatal(err)
+		}
+	}
+	if err := p.header(&repHeader); err != nil {
+		s.t.Fatal(err)
+	}
+	t := &transaction{protection: p}
+	message, err := t.protect(repHeader, repBody)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	if extraCerts != nil {
+		var rep pkiMessage
+		if _, err := asn1.Unmarshal(message, &rep); err != nil {
+			s.t.Fatal(err)
+		}
+		rep.ExtraCerts = extraCerts
+		if message, err = asn1.Marshal(rep); err != nil {
+			s.t.Fatal(err)
+		}
+	}
+
+	w.Header().Set("Content-Type", contentTypePKIXCMP)
+	w.Write(message)
+}
+
+// certRep returns an ip, cp or kup response to a certificate request.
+func (s *testServer) certRep(bodyType int, template certTemplate) asn1.RawValue {
+	resp := certResponse{CertReqID: certReqID, Status: pkiStatusInfo{Status: s.status}}
+	switch s.status {
+	case statusAccepted:
+		spki, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSequence, IsCompound: true, Bytes: template.PublicKey.Bytes})
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		pub, err := x509.ParsePKIXPublicKey(spki)
+		if err != nil {
+			s.t.Fatalf("error parsing public key of request: %v", err)
+		}
+		der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			RawSubject:   template.Subject.Bytes,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+		}, s.caCert, pub, s.caKey)
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		resp.CertifiedKeyPair.CertOrEncCert = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+	case statusRejection:
+		resp.Status.StatusString = []string{"not authorized"}
+		resp.Status.FailInfo = asn1.BitString{Bytes: []byte{0x00, 0x00, 0x01}, BitLength: 24}
+	}
+
+	rep, err := body(bodyType, certRepMessage{Response: []certResponse{resp}})
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return rep
+}
+
+func newCSR(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "example.com"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return csr
+}
+
+func tlsSecret(t *testing.T, key *ecdsa.PrivateKey, cert *x509.Certificate) *corev1.Secret {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cmp-signer", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+			corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		},
+	}
+}
+
+func TestEnroll(t *testing.T) {
+	tests := map[string]struct {
+		signature       bool
+		update          bool
+		status          int
+		implicitConfirm bool
+		expBodies       []int
+		expErr          error
+	}{
+		"issues a certificate with an initialization request protected by a MAC": {
+			expBodies: []int{bodyIR, bodyCertConf},
+		},
+		"does not confirm the certificate if the server grants implicit confirmation": {
+			implicitConfirm: true,
+			expBodies:       []int{bodyIR},
+		},
+		"issues a certificate with a certification request protected by a signature": {
+			signature: true,
+			expBodies: []int{bodyCR, bodyCertConf},
+		},
+		"issues a certificate with a key update request protected by the old key": {
+			update:    true,
+			expBodies: []int{bodyKUR, bodyCertConf},
+		},
+		"returns a pending error if the request is waiting": {
+			status: statusWaiting,
+			expErr: &PendingError{},
+		},
+		"returns a failed error if the request was rejected": {
+			status: statusRejection,
+			expErr: &FailedError{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := newTestServer(t)
+			srv.status = test.status
+			srv.implicitConfirm = test.implicitConfirm
+			httpSrv := httptest.NewServer(srv)
+			defer httpSrv.Close()
+
+			iss := &cmapi.CMPIssuer{
+				URL:      httpSrv.URL,
+				CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.caCert.Raw}),
+			}
+			var secret *corev1.Secret
+			if test.signature {
+				key, cert := newTestCertificate(t, "cmp-signer", srv.caCert, srv.caKey)
+				secret = tlsSecret(t, key, cert)
+				iss.Signature = &cmapi.CMPSignatureProtection{SecretRef: cmmeta.LocalObjectReference{Name: "cmp-signer"}}
+			} else {
+				secret = &corev1.Secret{Data: map[string][]byte{"secret": []byte(testMACSecret + "\n")}}
+				iss.MAC = &cmapi.CMPMACProtection{
+					Reference: "reference",
+					SecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "cmp-mac"}, Key: "secret"},
+				}
+			}
+			secretsLister := testlisters.FakeSecretListerFrom(testlisters.NewFakeSecretLister(),
+				testlisters.SetFakeSecretNamespaceListerGet(secret, nil))
+
+			c, err := New("default", secretsLister, iss)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var update *KeyPair
+			if test.update {
+				key, cert := newTestCertificate(t, "example.com", srv.caCert, srv.caKey)
+				update = &KeyPair{Certificate: cert, PrivateKey: key}
+			}
+
+			chain, err := c.Enroll(context.TODO(), newCSR(t), update)
+			switch test.expErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			case *PendingError:
+				var pendingErr *PendingError
+				if !errors.As(err, &pendingErr) || pendingErr.TransactionID == "" {
+					t.Fatalf("expected pending error, got: %v", err)
+				}
+				return
+			case *FailedError:
+				var failedErr *FailedError
+				if !errors.As(err, &failedErr) {
+					t.Fatalf("expected failed error, got: %v", err)
+				}
+				if failedErr.Status != "status 2: notAuthorized, not authorized" {
+					t.Errorf("unexpected status %q", failedErr.Status)
+				}
+				return
+			}
+
+			if len(srv.bodies) != len(test.expBodies) {
+				t.Fatalf("expected requests %v, got %v", test.expBodies, srv.bodies)
+			}
+			for i := range srv.bodies {
+				if srv.bodies[i] != test.expBodies[i] {
+					t.Errorf("expected requests %v, got %v", test.expBodies, srv.bodies)
+				}
+			}
+			if test.update && (srv.oldCertSerial == nil || srv.oldCertSerial.Cmp(update.Certificate.SerialNumber) != 0) {
+				t.Errorf("expected key update request for certificate %s, got %v", update.Certificate.SerialNumber, srv.oldCertSerial)
+			}
+			if len(chain) != 2 {
+				t.Fatalf("expected certificate and CA in chain, got %d certificates", len(chain))
+			}
+			if chain[0].Subject.CommonName != "example.com" || !chain[1].Equal(srv.caCert) {
+				t.Errorf("unexpected chain: %s, %s", chain[0].Subject, chain[1].Subject)
+			}
+		})
+	}
+}
+
+func TestEnrollUntrustedSigner(t *testing.T) {
+	srv := newTestServer(t)
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+
+	key, cert := newTestCertificate(t, "cmp-signer", srv.caCert, srv.caKey)
+	_, otherCA := newTestCertificate(t, "other-ca", nil, nil)
+	secretsLister := testlisters.FakeSecretListerFrom(testlisters.NewFakeSecretLister(),
+		testlisters.SetFakeSecretNamespaceListerGet(tlsSecret(t, key, cert), nil))
+
+	c, err := New("default", secretsLister, &cmapi.CMPIssuer{
+		URL:       httpSrv.URL,
+		CABundle:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: otherCA.Raw}),
+		Signature: &cmapi.CMPSignatureProtection{SecretRef: cmmeta.LocalObjectReference{Name: "cmp-signer"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Enroll(context.TODO(), newCSR(t), nil); err == nil {
+		t.Errorf("expected error for response signed by a certificate not in the CA bundle")
+	}
+}
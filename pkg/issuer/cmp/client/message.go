@@ -0,0 +1,280 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// The ASN.1 structures of CMP messages, as defined in RFC 4210 appendix F,
+// and of certificate requests, as defined in RFC 4211 appendix B. The CMP
+// module uses explicit tags, and the CRMF module implicit tags.
+
+const (
+	pvnoCMP2000 = 2
+
+	bodyIR       = 0
+	bodyIP       = 1
+	bodyCR       = 2
+	bodyCP       = 3
+	bodyKUR      = 7
+	bodyKUP      = 8
+	bodyPKIConf  = 19
+	bodyError    = 23
+	bodyCertConf = 24
+	bodyPollReq  = 25
+	bodyPollRep  = 26
+
+	statusAccepted        = 0
+	statusGrantedWithMods = 1
+	statusRejection       = 2
+	statusWaiting         = 3
+
+	generalNameDirectoryName = 4
+
+	// All requests contain a single certificate request with this ID.
+	certReqID = 0
+)
+
+// nullDN is the DER encoding of an empty sequence of RDNs.
+var nullDN = []byte{0x30, 0x00}
+
+var (
+	oidImplicitConfirm  = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 4, 13}
+	oidRegCtrlOldCertID = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 5, 1, 5}
+)
+
+var bodyNames = map[int]string{
+	bodyIR:       "ir",
+	bodyIP:       "ip",
+	bodyCR:       "cr",
+	bodyCP:       "cp",
+	bodyKUR:      "kur",
+	bodyKUP:      "kup",
+	bodyPKIConf:  "pkiconf",
+	bodyError:    "error",
+	bodyCertConf: "certConf",
+	bodyPollReq:  "pollReq",
+	bodyPollRep:  "pollRep",
+}
+
+// failInfoNames are the names of the bits of PKIFailureInfo.
+var failInfoNames = []string{
+	"badAlg", "badMessageCheck", "badRequest", "badTime", "badCertId",
+	"badDataFormat", "wrongAuthority", "incorrectData", "missingTimeStamp",
+	"badPOP", "certRevoked", "certConfirmed", "wrongIntegrity",
+	"badRecipientNonce", "timeNotAvailable", "unacceptedPolicy",
+	"unacceptedExtension", "addInfoNotAvailable", "badSenderNonce",
+	"badCertTemplate", "signerNotTrusted", "transactionIdInUse",
+	"unsupportedVersion", "notAuthorized", "systemUnavail", "systemFailure",
+	"duplicateCertReq",
+}
+
+type pkiMessage struct {
+	Header     asn1.RawValue
+	Body       asn1.RawValue
+	Protection asn1.BitString  `asn1:"explicit,optional,tag:0"`
+	ExtraCerts []asn1.RawValue `asn1:"explicit,optional,tag:1"`
+}
+
+type protectedPart struct {
+	Header asn1.RawValue
+	Body   asn1.RawValue
+}
+
+type pkiHeader struct {
+	PVNO          int
+	Sender        asn1.RawValue
+	Recipient     asn1.RawValue
+	MessageTime   time.Time                `asn1:"generalized,explicit,optional,tag:0"`
+	ProtectionAlg pkix.AlgorithmIdentifier `asn1:"explicit,optional,tag:1"`
+	SenderKID     []byte                   `asn1:"explicit,optional,tag:2"`
+	RecipKID      []byte                   `asn1:"explicit,optional,tag:3"`
+	TransactionID []byte                   `asn1:"explicit,optional,tag:4"`
+	SenderNonce   []byte                   `asn1:"explicit,optional,tag:5"`
+	RecipNonce    []byte                   `asn1:"explicit,optional,tag:6"`
+	FreeText      []string                 `asn1:"explicit,optional,tag:7"`
+	GeneralInfo   []infoTypeAndValue       `asn1:"explicit,optional,tag:8"`
+}
+
+// hasGeneralInfo returns whether the header contains the given information.
+func (h *pkiHeader) hasGeneralInfo(infoType asn1.ObjectIdentifier) bool {
+	for _, info := range h.GeneralInfo {
+		if info.InfoType.Equal(infoType) {
+			return true
+		}
+	}
+	return false
+}
+
+type infoTypeAndValue struct {
+	InfoType  asn1.ObjectIdentifier
+	InfoValue asn1.RawValue `asn1:"optional"`
+}
+
+type certReqMsg struct {
+	CertReq certRequest
+	POPO    asn1.RawValue `asn1:"optional"`
+}
+
+type certRequest struct {
+	CertReqID    int
+	CertTemplate certTemplate
+	Controls     []attributeTypeAndValue `asn1:"optional,omitempty"`
+}
+
+// certTemplate holds the fields of a CertTemplate which are set from a
+// certificate signing request. The subject and public key are raw values,
+// as the subject is explicitly tagged and the public key implicitly tagged.
+type certTemplate struct {
+	Subject    asn1.RawValue    `asn1:"optional"`
+	PublicKey  asn1.RawValue    `asn1:"optional"`
+	Extensions []pkix.Extension `asn1:"optional,omitempty,tag:9"`
+}
+
+type attributeTypeAndValue struct {
+	Type  asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+type certID struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type certRepMessage struct {
+	CAPubs   []asn1.RawValue `asn1:"explicit,optional,tag:1"`
+	Response []certResponse
+}
+
+type certResponse struct {
+	CertReqID        int
+	Status           pkiStatusInfo
+	CertifiedKeyPair certifiedKeyPair `asn1:"optional"`
+	RspInfo          []byte           `asn1:"optional"`
+}
+
+type certifiedKeyPair struct {
+	CertOrEncCert   asn1.RawValue
+	PrivateKey      asn1.RawValue `asn1:"explicit,optional,tag:0"`
+	PublicationInfo asn1.RawValue `asn1:"explicit,optional,tag:1"`
+}
+
+type pkiStatusInfo struct {
+	Status       int
+	StatusString []string       `asn1:"optional"`
+	FailInfo     asn1.BitString `asn1:"optional"`
+}
+
+// String returns a description of the status, including the reasons given
+// by the server.
+func (s pkiStatusInfo) String() string {
+	var reasons []string
+	for i, name := range failInfoNames {
+		if s.FailInfo.At(i) == 1 {
+			reasons = append(reasons, name)
+		}
+	}
+	reasons = append(reasons, s.StatusString...)
+	if len(reasons) == 0 {
+		return fmt.Sprintf("status %d", s.Status)
+	}
+	return fmt.Sprintf("status %d: %s", s.Status, strings.Join(reasons, ", "))
+}
+
+type errorMsgContent struct {
+	PKIStatusInfo pkiStatusInfo
+	ErrorCode     int      `asn1:"optional"`
+	ErrorDetails  []string `asn1:"optional"`
+}
+
+type certStatus struct {
+	CertHash  []byte
+	CertReqID int
+}
+
+type pollReq struct {
+	CertReqID int
+}
+
+type pollRep struct {
+	CertReqID  int
+	CheckAfter int
+	Reason     []string `asn1:"optional"`
+}
+
+// directoryName returns a GeneralName for the DER encoded name.
+func directoryName(rawName []byte) asn1.RawValue {
+	if len(rawName) == 0 {
+		rawName = nullDN
+	}
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: generalNameDirectoryName, IsCompound: true, Bytes: rawName}
+}
+
+// body returns a PKIBody of the given type.
+func body(bodyType int, content interface{}) (asn1.RawValue, error) {
+	der, err := asn1.Marshal(content)
+	if err != nil {
+		return asn1.RawValue{}, err
+	}
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: bodyType, IsCompound: true, Bytes: der}, nil
+}
+
+// certReqMessages builds the content of an ir, cr or kur body for the
+// certificate signing request. Proof of possession of the private key is
+// asserted as verified by a registration authority, as the signature of the
+// certificate signing request has been verified.
+func certReqMessages(csr *x509.CertificateRequest, update *KeyPair) ([]certReqMsg, error) {
+	var spki asn1.RawValue
+	if _, err := asn1.Unmarshal(csr.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, fmt.Errorf("error decoding public key of certificate signing request: %w", err)
+	}
+
+	req := certRequest{
+		CertReqID: certReqID,
+		CertTemplate: certTemplate{
+			Subject:    asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 5, IsCompound: true, Bytes: csr.RawSubject},
+			PublicKey:  asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 6, IsCompound: true, Bytes: spki.Bytes},
+			Extensions: csr.Extensions,
+		},
+	}
+	if update != nil {
+		oldCertID, err := asn1.Marshal(certID{
+			Issuer:       directoryName(update.Certificate.RawIssuer),
+			SerialNumber: update.Certificate.SerialNumber,
+		})
+		if err != nil {
+			return nil, err
+		}
+		req.Controls = []attributeTypeAndValue{{
+			Type:  oidRegCtrlOldCertID,
+			Value: asn1.RawValue{FullBytes: oldCertID},
+		}}
+	}
+
+	return []certReqMsg{{
+		CertReq: req,
+		// raVerified [0] NULL
+		POPO: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0},
+	}}, nil
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+const (
+	pbmSaltSize       = 16
+	pbmIterationCount = 1000
+
+	// pbmMaxIterationCount bounds the work done to verify the MAC of a
+	// response.
+	pbmMaxIterationCount = 100000
+)
+
+var (
+	oidPasswordBasedMac = asn1.ObjectIdentifier{1, 2, 840, 113533, 7, 66, 13}
+
+	oidSHA1           = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256         = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 8, 1, 2}
+	oidHMACWithSHA1b  = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+)
+
+// signatureAlgorithms maps the signature algorithms used to protect messages
+// to their object identifiers.
+var signatureAlgorithms = map[x509.SignatureAlgorithm]asn1.ObjectIdentifier{
+	x509.SHA1WithRSA:     {1, 2, 840, 113549, 1, 1, 5},
+	x509.SHA256WithRSA:   {1, 2, 840, 113549, 1, 1, 11},
+	x509.SHA384WithRSA:   {1, 2, 840, 113549, 1, 1, 12},
+	x509.SHA512WithRSA:   {1, 2, 840, 113549, 1, 1, 13},
+	x509.ECDSAWithSHA256: {1, 2, 840, 10045, 4, 3, 2},
+	x509.ECDSAWithSHA384: {1, 2, 840, 10045, 4, 3, 3},
+	x509.ECDSAWithSHA512: {1, 2, 840, 10045, 4, 3, 4},
+	x509.PureEd25519:     {1, 3, 101, 112},
+}
+
+type pbmParameter struct {
+	Salt           []byte
+	OWF            pkix.AlgorithmIdentifier
+	IterationCount int
+	MAC            pkix.AlgorithmIdentifier
+}
+
+// protection protects the messages sent to a CMP server.
+type protection interface {
+	// header sets the sender and protection algorithm of the header.
+	header(h *pkiHeader) error
+
+	// protect returns the protection of the DER encoded ProtectedPart of a
+	// message whose header was set by header.
+	protect(h *pkiHeader, protectedPart []byte) ([]byte, error)
+
+	// extraCerts returns the certificates to include in messages.
+	extraCerts() []asn1.RawValue
+}
+
+// macProtection protects messages with a password-based MAC (RFC 4211
+// section 4.4), using a shared secret.
+type macProtection struct {
+	reference []byte
+	secret    []byte
+	sender    []byte
+}
+
+func (p *macProtection) header(h *pkiHeader) error {
+	salt := make([]byte, pbmSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	params, err := asn1.Marshal(pbmParameter{
+		Salt:           salt,
+		OWF:            pkix.AlgorithmIdentifier{Algorithm: oidSHA256},
+		IterationCount: pbmIterationCount,
+		MAC:            pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256},
+	})
+	if err != nil {
+		return err
+	}
+
+	h.Sender = directoryName(p.sender)
+	h.SenderKID = p.reference
+	h.ProtectionAlg = pkix.AlgorithmIdentifier{
+		Algorithm:  oidPasswordBasedMac,
+		Parameters: asn1.RawValue{FullBytes: params},
+	}
+	return nil
+}
+
+func (p *macProtection) protect(h *pkiHeader, protectedPart []byte) ([]byte, error) {
+	return passwordBasedMAC(p.secret, h.ProtectionAlg, protectedPart)
+}
+
+func (p *macProtection) extraCerts() []asn1.RawValue {
+	return nil
+}
+
+// passwordBasedMAC computes the MAC of data with the parameters of the
+// password-based MAC algorithm.
+func passwordBasedMAC(secret []byte, alg pkix.AlgorithmIdentifier, data []byte) ([]byte, error) {
+	var params pbmParameter
+	if _, err := asn1.Unmarshal(alg.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("error decoding password-based MAC parameters: %w", err)
+	}
+	if params.IterationCount < 1 || params.IterationCount > pbmMaxIterationCount {
+		return nil, fmt.Errorf("unsupported password-based MAC iteration count %d", params.IterationCount)
+	}
+
+	var owf func() hash.Hash
+	switch {
+	case params.OWF.Algorithm.Equal(oidSHA256):
+		owf = sha256.New
+	case params.OWF.Algorithm.Equal(oidSHA1):
+		owf = sha1.New
+	default:
+		return nil, fmt.Errorf("unsupported password-based MAC one-way function %s", params.OWF.Algorithm)
+	}
+	var mac func() hash.Hash
+	switch {
+	case params.MAC.Algorithm.Equal(oidHMACWithSHA256):
+		mac = sha256.New
+	case params.MAC.Algorithm.Equal(oidHMACWithSHA1), params.MAC.Algorithm.Equal(oidHMACWithSHA1b):
+		mac = sha1.New
+	default:
+		return nil, fmt.Errorf("unsupported password-based MAC algorithm %s", params.MAC.Algorithm)
+	}
+
+	// The key is derived by applying the one-way function to the secret
+	// concatenated with the salt, and then repeatedly to its own output.
+	key := append(append([]byte{}, secret...), params.Salt...)
+	for i := 0; i < params.IterationCount; i++ {
+		h := owf()
+		h.Write(key)
+		key = h.Sum(nil)
+	}
+
+	h := hmac.New(mac, key)
+	h.Write(data)
+	return h.Sum(nil), nil
+}
+
+// signatureProtection protects messages with a signature, using a private
+// key and its certificate.
+type signatureProtection struct {
+	key         crypto.Signer
+	certificate *x509.Certificate
+	algorithm   x509.SignatureAlgorithm
+}
+
+func newSignatureProtection(key crypto.Signer, cert *x509.Certificate) (*signatureProtection, error) {
+	p := &signatureProtection{key: key, certificate: cert}
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		p.algorithm = x509.SHA256WithRSA
+	case *ecdsa.PublicKey:
+		p.algorithm = x509.ECDSAWithSHA256
+	case ed25519.PublicKey:
+		p.algorithm = x509.PureEd25519
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return p, nil
+}
+
+func (p *signatureProtection) header(h *pkiHeader) error {
+	h.Sender = directoryName(p.certificate.RawSubject)
+	h.SenderKID = p.certificate.SubjectKeyId
+	h.ProtectionAlg = pkix.AlgorithmIdentifier{Algorithm: signatureAlgorithms[p.algorithm]}
+	if p.algorithm == x509.SHA256WithRSA {
+		h.ProtectionAlg.Parameters = asn1.NullRawValue
+	}
+	return nil
+}
+
+func (p *signatureProtection) protect(_ *pkiHeader, protectedPart []byte) ([]byte, error) {
+	if p.algorithm == x509.PureEd25519 {
+		return p.key.Sign(rand.Reader, protectedPart, crypto.Hash(0))
+	}
+	digest := sha256.Sum256(protectedPart)
+	return p.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+func (p *signatureProtection) extraCerts() []asn1.RawValue {
+	return []asn1.RawValue{{FullBytes: p.certificate.Raw}}
+}
+
+// verifySignature verifies the signature protecting a message. The signer is
+// looked up in the certificates of the message by the sender of the header,
+// and must be trusted by the roots using the other certificates of the
+// message as intermediates.
+func verifySignature(h *pkiHeader, protectedPart, signature []byte, certs []*x509.Certificate, roots *x509.CertPool) error {
+	if roots == nil {
+		return errors.New("response is protected by a signature, but no CA bundle is configured to verify it")
+	}
+
+	var algorithm x509.SignatureAlgorithm
+	for alg, oid := range signatureAlgorithms {
+		if oid.Equal(h.ProtectionAlg.Algorithm) {
+			algorithm = alg
+		}
+	}
+	if algorithm == x509.UnknownSignatureAlgorithm {
+		return fmt.Errorf("unsupported protection algorithm %s", h.ProtectionAlg.Algorithm)
+	}
+
+	var rawSender []byte
+	if h.Sender.Tag == generalNameDirectoryName && !bytes.Equal(h.Sender.Bytes, nullDN) {
+		rawSender = h.Sender.Bytes
+	}
+	var signer *x509.Certificate
+	for _, cert := range certs {
+		if len(h.SenderKID) > 0 && !bytes.Equal(cert.SubjectKeyId, h.SenderKID) {
+			continue
+		}
+		if len(rawSender) > 0 && !bytes.Equal(cert.RawSubject, rawSender) {
+			continue
+		}
+		if cert.CheckSignature(algorithm, protectedPart, signature) == nil {
+			signer = cert
+			break
+		}
+	}
+	if signer == nil {
+		return errors.New("response signature could not be verified with the certificates in the response")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs {
+		intermediates.AddCert(cert)
+	}
+	if _, err := signer.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return fmt.Errorf("response signer %q is not trusted: %w", signer.Subject, err)
+	}
+	return nil
+}
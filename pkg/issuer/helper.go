@@ -70,3 +70,61 @@ func (h *helperImpl) GetGenericIssuer(ref cmmeta.ObjectReference, ns string) (cm
 		return nil, fmt.Errorf(`invalid value %q for issuerRef.kind. Must be empty, %q or %q`, ref.Kind, cmapi.IssuerKind, cmapi.ClusterIssuerKind)
 	}
 }
+
+// SecretNames returns the names of the Secret resources referenced by the
+// given issuer spec, such as ACME account keys or Vault credentials.
+// Issuers are set up again whenever one of these Secrets changes, so that
+// clients which are built when an issuer is set up, such as ACME clients,
+// use rotated credentials without the controller being restarted.
+func SecretNames(spec *cmapi.IssuerSpec) []string {
+	var names []string
+	switch {
+	case spec.ACME != nil:
+		names = append(names, spec.ACME.PrivateKey.Name)
+		if spec.ACME.ExternalAccountBinding != nil {
+			names = append(names, spec.ACME.ExternalAccountBinding.Key.Name)
+		}
+		if spec.ACME.ClientCertificateSecretRef != nil {
+			names = append(names, spec.ACME.ClientCertificateSecretRef.Name)
+		}
+	case spec.CA != nil:
+		names = append(names, spec.CA.SecretName)
+		if kms := spec.CA.KMS; kms != nil {
+			if kms.AWS != nil && kms.AWS.SecretAccessKey != nil {
+				names = append(names, kms.AWS.SecretAccessKey.Name)
+			}
+			if kms.GoogleCloud != nil && kms.GoogleCloud.ServiceAccount != nil {
+				names = append(names, kms.GoogleCloud.ServiceAccount.Name)
+			}
+		}
+	case spec.Venafi != nil:
+		if spec.Venafi.TPP != nil {
+			names = append(names, spec.Venafi.TPP.CredentialsRef.Name)
+		}
+		if spec.Venafi.Cloud != nil {
+			names = append(names, spec.Venafi.Cloud.APITokenSecretRef.Name)
+		}
+	case spec.Vault != nil:
+		if spec.Vault.Auth.TokenSecretRef != nil {
+			names = append(names, spec.Vault.Auth.TokenSecretRef.Name)
+		}
+		if spec.Vault.Auth.AppRole != nil {
+			names = append(names, spec.Vault.Auth.AppRole.SecretRef.Name)
+		}
+		if spec.Vault.Auth.Kubernetes != nil {
+			names = append(names, spec.Vault.Auth.Kubernetes.SecretRef.Name)
+		}
+	case spec.SCEP != nil:
+		if spec.SCEP.ChallengePasswordSecretRef != nil {
+			names = append(names, spec.SCEP.ChallengePasswordSecretRef.Name)
+		}
+	case spec.CMP != nil:
+		if spec.CMP.MAC != nil {
+			names = append(names, spec.CMP.MAC.SecretRef.Name)
+		}
+		if spec.CMP.Signature != nil {
+			names = append(names, spec.CMP.Signature.SecretRef.Name)
+		}
+	}
+	return names
+}
@@ -22,6 +22,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	"github.com/jetstack/cert-manager/pkg/controller/test"
@@ -109,3 +110,60 @@ func TestGetGenericIssuer(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretNames(t *testing.T) {
+	tests := map[string]struct {
+		spec     v1.IssuerSpec
+		expected []string
+	}{
+		"ACME issuer with external account binding": {
+			spec: v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{ACME: &cmacme.ACMEIssuer{
+				PrivateKey:             cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "account"}},
+				ExternalAccountBinding: &cmacme.ACMEExternalAccountBinding{Key: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "eab"}}},
+			}}},
+			expected: []string{"account", "eab"},
+		},
+		"CA issuer": {
+			spec:     v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{CA: &v1.CAIssuer{SecretName: "ca"}}},
+			expected: []string{"ca"},
+		},
+		"ACME issuer with a client certificate": {
+			spec: v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{ACME: &cmacme.ACMEIssuer{
+				PrivateKey:                 cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "account"}},
+				ClientCertificateSecretRef: &cmmeta.LocalObjectReference{Name: "client-tls"},
+			}}},
+			expected: []string{"account", "client-tls"},
+		},
+		"CA issuer with an AWS KMS key": {
+			spec: v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{CA: &v1.CAIssuer{
+				SecretName: "ca",
+				KMS: &v1.CAIssuerKMS{AWS: &v1.CAIssuerAWSKMS{
+					SecretAccessKey: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "aws"}},
+				}},
+			}}},
+			expected: []string{"ca", "aws"},
+		},
+		"CMP issuer with MAC protection": {
+			spec: v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{CMP: &v1.CMPIssuer{
+				MAC: &v1.CMPMACProtection{SecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "mac"}}},
+			}}},
+			expected: []string{"mac"},
+		},
+		"Vault issuer with AppRole auth": {
+			spec: v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{Vault: &v1.VaultIssuer{Auth: v1.VaultAuth{
+				AppRole: &v1.VaultAppRole{SecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "approle"}}},
+			}}}},
+			expected: []string{"approle"},
+		},
+		"SelfSigned issuer": {
+			spec: v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{SelfSigned: &v1.SelfSignedIssuer{}}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := SecretNames(&test.spec); !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client implements the requester side of the Simple Certificate
+// Enrollment Protocol (RFC 8894).
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mozilla.org/pkcs7"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	operationGetCACaps     = "GetCACaps"
+	operationGetCACert     = "GetCACert"
+	operationPKIOperation  = "PKIOperation"
+	contentTypeCACert      = "application/x-x509-ca-cert"
+	contentTypeCARACert    = "application/x-x509-ca-ra-cert"
+	contentTypePKIMessage  = "application/x-pki-message"
+	maxResponseBodySize    = 1 << 20 // 1 MiB
+	defaultRequestTimeout  = 30 * time.Second
+	capabilityAES          = "AES"
+	capabilityPOST         = "POSTPKIOperation"
+	capabilitySHA256       = "SHA-256"
+	capabilitySCEPStandard = "SCEPStandard"
+)
+
+// Interface is a client for a SCEP server.
+type Interface interface {
+	// CACertificates returns the CA and RA certificates of the SCEP server.
+	// If the issuer pins a CA fingerprint, only the pinned CA certificate
+	// and the certificates it signed are returned.
+	CACertificates(ctx context.Context) ([]*x509.Certificate, error)
+
+	// Enroll requests a certificate for the DER encoded certificate signing
+	// request, returning the issued certificate followed by its chain.
+	// If the SCEP server has not issued the certificate yet a *PendingError
+	// is returned, and Enroll should be called again with the same request
+	// to poll for it. If the SCEP server rejected the request a
+	// *FailedError is returned.
+	Enroll(ctx context.Context, csrDER []byte) ([]*x509.Certificate, error)
+}
+
+// ClientBuilder builds a client for the SCEP server of an issuer.
+type ClientBuilder func(iss *cmapi.SCEPIssuer) (Interface, error)
+
+// PendingError is returned by Enroll when the certificate has not been issued
+// yet, for example because the request awaits manual approval.
+type PendingError struct {
+	TransactionID string
+}
+
+func (e *PendingError) Error() string {
+	return fmt.Sprintf("certificate request %s is pending on the SCEP server", e.TransactionID)
+}
+
+// FailedError is returned by Enroll when the SCEP server rejected the request.
+type FailedError struct {
+	FailInfo string
+}
+
+func (e *FailedError) Error() string {
+	return fmt.Sprintf("SCEP server rejected the certificate request: %s", e.FailInfo)
+}
+
+type client struct {
+	url         *url.URL
+	fingerprint []byte
+	httpClient  *http.Client
+}
+
+// New builds a client for the SCEP server of the issuer.
+func New(iss *cmapi.SCEPIssuer) (Interface, error) {
+	u, err := url.Parse(iss.URL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SCEP server URL: %w", err)
+	}
+
+	c := &client{
+		url: u,
+		httpClient: &http.Client{
+			Timeout:   defaultRequestTimeout,
+			Transport: http.DefaultTransport.(*http.Transport).Clone(),
+		},
+	}
+	if len(iss.CAFingerprint) > 0 {
+		c.fingerprint, err = pki.ParseSHA256Fingerprint(iss.CAFingerprint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CA fingerprint: %w", err)
+		}
+	}
+	if len(iss.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if ok := pool.AppendCertsFromPEM(iss.CABundle); !ok {
+			return nil, errors.New("error loading CA bundle: no valid certificates found")
+		}
+		c.httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return c, nil
+}
+
+// ChallengePassword returns the challenge password of the issuer, read from
+// the Secret in the given namespace. An empty password is returned if the
+// issuer does not have a challenge password.
+func ChallengePassword(secretsLister corelisters.SecretLister, namespace string, iss *cmapi.SCEPIssuer) (string, error) {
+	ref := iss.ChallengePasswordSecretRef
+	if ref == nil {
+		return "", nil
+	}
+	secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return "", err
+	}
+	password, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+	}
+	return strings.TrimSpace(string(password)), nil
+}
+
+func (c *client) CACertificates(ctx context.Context) ([]*x509.Certificate, error) {
+	body, contentType, err := c.do(ctx, http.MethodGet, operationGetCACert, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+	switch contentType {
+	case contentTypeCACert:
+		cert, err := x509.ParseCertificate(body)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CA certificate: %w", err)
+		}
+		certs = []*x509.Certificate{cert}
+	case contentTypeCARACert:
+		p7, err := pkcs7.Parse(body)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CA and RA certificates: %w", err)
+		}
+		certs = p7.Certificates
+	default:
+		return nil, fmt.Errorf("unexpected content type %q for CA certificates", contentType)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("SCEP server returned no CA certificates")
+	}
+
+	if c.fingerprint == nil {
+		return certs, nil
+	}
+	return pinCertificates(certs, c.fingerprint)
+}
+
+// pinCertificates returns the certificate with the given SHA-256 fingerprint,
+// followed by the certificates it signed, such as the RA certificates of the
+// SCEP server.
+func pinCertificates(certs []*x509.Certificate, fingerprint []byte) ([]*x509.Certificate, error) {
+	var ca *x509.Certificate
+	for _, cert := range certs {
+		if sum := sha256.Sum256(cert.Raw); bytes.Equal(sum[:], fingerprint) {
+			ca = cert
+			break
+		}
+	}
+	if ca == nil {
+		return nil, errors.New("none of the CA certificates returned by the SCEP server match the pinned fingerprint")
+	}
+
+	pinned := []*x509.Certificate{ca}
+	for _, cert := range certs {
+		if cert != ca && cert.CheckSignatureFrom(ca) == nil {
+			pinned = append(pinned, cert)
+		}
+	}
+	return pinned, nil
+}
+
+func (c *client) Enroll(ctx context.Context, csrDER []byte) ([]*x509.Certificate, error) {
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate signing request: %w", err)
+	}
+
+	caCerts, err := c.CACertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	caps, err := c.capabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := newPKCSReq(csr, caCerts, caps)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if caps.has(capabilityPOST) || caps.has(capabilitySCEPStandard) {
+		body, _, err = c.do(ctx, http.MethodPost, operationPKIOperation, req.message)
+	} else {
+		body, _, err = c.do(ctx, http.MethodGet, operationPKIOperation, req.message)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	issued, err := req.parseCertRep(body, caCerts)
+	if err != nil {
+		return nil, err
+	}
+	return buildChain(csr, issued, caCerts)
+}
+
+// buildChain returns the certificate issued for the request, followed by its
+// chain built from the other certificates returned by the SCEP server.
+func buildChain(csr *x509.CertificateRequest, issued, caCerts []*x509.Certificate) ([]*x509.Certificate, error) {
+	var leaf *x509.Certificate
+	for _, cert := range issued {
+		if ok, _ := pki.PublicKeysEqual(cert.PublicKey, csr.PublicKey); ok {
+			leaf = cert
+			break
+		}
+	}
+	if leaf == nil {
+		return nil, errors.New("SCEP server did not return a certificate for the public key of the request")
+	}
+
+	candidates := append(append([]*x509.Certificate{}, issued...), caCerts...)
+	chain := []*x509.Certificate{leaf}
+	for current := leaf; !bytes.Equal(current.RawIssuer, current.RawSubject); {
+		var parent *x509.Certificate
+		for _, cert := range candidates {
+			if cert != current && current.CheckSignatureFrom(cert) == nil {
+				parent = cert
+				break
+			}
+		}
+		if parent == nil || len(chain) > len(candidates) {
+			break
+		}
+		chain = append(chain, parent)
+		current = parent
+	}
+	return chain, nil
+}
+
+// capabilities are the capabilities advertised by the SCEP server in response
+// to GetCACaps.
+type capabilities map[string]bool
+
+func (c capabilities) has(capability string) bool {
+	return c[strings.ToUpper(capability)]
+}
+
+func (c *client) capabilities(ctx context.Context) (capabilities, error) {
+	body, _, err := c.do(ctx, http.MethodGet, operationGetCACaps, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	caps := capabilities{}
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		if capability := strings.TrimSpace(scanner.Text()); len(capability) > 0 {
+			caps[strings.ToUpper(capability)] = true
+		}
+	}
+	return caps, scanner.Err()
+}
+
+// do performs a SCEP operation, returning the response body and its content
+// type. Messages of GET requests are base64 encoded into the query.
+func (c *client) do(ctx context.Context, method, operation string, message []byte) ([]byte, string, error) {
+	u := *c.url
+	query := u.Query()
+	query.Set("operation", operation)
+	if method == http.MethodGet && message != nil {
+		query.Set("message", base64.StdEncoding.EncodeToString(message))
+	}
+	u.RawQuery = query.Encode()
+
+	var body *bytes.Reader
+	if method == http.MethodPost {
+		body = bytes.NewReader(message)
+	} else {
+		body = bytes.NewReader(nil)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("User-Agent", util.CertManagerUserAgent)
+	if method == http.MethodPost {
+		req.Header.Set("Content-Type", contentTypePKIMessage)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("error calling SCEP %s: %w", operation, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading SCEP %s response: %w", operation, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("SCEP %s failed with status %d: %s", operation, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	contentType := strings.TrimSpace(strings.Split(resp.Header.Get("Content-Type"), ";")[0])
+	return data, contentType, nil
+}
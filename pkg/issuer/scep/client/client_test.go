@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mozilla.org/pkcs7"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// testServer is a SCEP server which issues certificates signed by its CA.
+type testServer struct {
+	t      *testing.T
+	caKey  *rsa.PrivateKey
+	caCert *x509.Certificate
+	caps   []string
+	status string
+
+	// requestEncryption is the content encryption algorithm of the last
+	// request
+	requestEncryption string
+}
+
+func newTestServer(t *testing.T, caps ...string) *testServer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "scep-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testServer{t: t, caKey: key, caCert: cert, caps: caps, status: pkiStatusSuccess}
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Query().Get("operation") {
+	case operationGetCACaps:
+		w.Write([]byte(strings.Join(s.caps, "\n")))
+	case operationGetCACert:
+		w.Header().Set("Content-Type", contentTypeCACert)
+		w.Write(s.caCert.Raw)
+	case operationPKIOperation:
+		var message []byte
+		var err error
+		if r.Method == http.MethodPost {
+			message, err = ioutil.ReadAll(r.Body)
+		} else {
+			message, err = base64.StdEncoding.DecodeString(r.URL.Query().Get("message"))
+		}
+		if err != nil {
+			s.t.Errorf("error reading request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", contentTypePKIMessage)
+		w.Write(s.certRep(message))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *testServer) certRep(message []byte) []byte {
+	p7, err := pkcs7.Parse(message)
+	if err != nil {
+		s.t.Fatalf("error parsing request: %v", err)
+	}
+	if err := p7.Verify(); err != nil {
+		s.t.Fatalf("error verifying request: %v", err)
+	}
+	var messageType, transactionID string
+	var senderNonce []byte
+	if err := p7.UnmarshalSignedAttribute(oidMessageType, &messageType); err != nil || messageType != messageTypePKCSReq {
+		s.t.Fatalf("unexpected message type %q: %v", messageType, err)
+	}
+	if err := p7.UnmarshalSignedAttribute(oidTransactionID, &transactionID); err != nil {
+		s.t.Fatal(err)
+	}
+	if err := p7.UnmarshalSignedAttribute(oidSenderNonce, &senderNonce); err != nil {
+		s.t.Fatal(err)
+	}
+	signer := p7.GetOnlySigner()
+
+	envelope, err := pkcs7.Parse(p7.Content)
+	if err != nil {
+		s.t.Fatalf("error parsing request envelope: %v", err)
+	}
+	var ed envelopedData
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(p7.Content, &ci); err != nil {
+		s.t.Fatal(err)
+	}
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &ed); err != nil {
+		s.t.Fatal(err)
+	}
+	s.requestEncryption = ed.EncryptedContentInfo.ContentEncryptionAlgorithm.Algorithm.String()
+	csrDER, err := envelope.Decrypt(s.caCert, s.caKey)
+	if err != nil {
+		s.t.Fatalf("error decrypting request: %v", err)
+	}
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		s.t.Fatalf("error parsing certificate signing request: %v", err)
+	}
+
+	attributes := []pkcs7.Attribute{
+		{Type: oidMessageType, Value: messageTypeCertRep},
+		{Type: oidTransactionID, Value: transactionID},
+		{Type: oidPKIStatus, Value: s.status},
+		{Type: oidRecipientNonce, Value: senderNonce},
+	}
+	var content []byte
+	switch s.status {
+	case pkiStatusSuccess:
+		der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      csr.Subject,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+		}, s.caCert, csr.PublicKey, s.caKey)
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		degenerate, err := pkcs7.DegenerateCertificate(der)
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		content, err = pkcs7.Encrypt(degenerate, []*x509.Certificate{signer})
+		if err != nil {
+			s.t.Fatal(err)
+		}
+	case pkiStatusFailure:
+		attributes = append(attributes, pkcs7.Attribute{Type: oidFailInfo, Value: "2"})
+	}
+
+	sd, err := pkcs7.NewSignedData(content)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	if err := sd.AddSigner(s.caCert, s.caKey, pkcs7.SignerInfoConfig{ExtraSignedAttributes: attributes}); err != nil {
+		s.t.Fatal(err)
+	}
+	rep, err := sd.Finish()
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return rep
+}
+
+func newCSR(t *testing.T) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "example.com"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return csr
+}
+
+func TestEnroll(t *testing.T) {
+	tests := map[string]struct {
+		caps           []string
+		status         string
+		expEncryption  string
+		expErr         error
+		expFingerprint func(ca *x509.Certificate) string
+	}{
+		"issues a certificate with triple DES if the server does not support AES": {
+			status:        pkiStatusSuccess,
+			expEncryption: oidEncryptionAlgorithmDESEDE3CBC.String(),
+		},
+		"issues a certificate over POST with AES": {
+			caps:          []string{"POSTPKIOperation", "AES", "SHA-256"},
+			status:        pkiStatusSuccess,
+			expEncryption: oidEncryptionAlgorithmAES128CBC.String(),
+		},
+		"issues a certificate with a pinned CA": {
+			caps:          []string{"SCEPStandard"},
+			status:        pkiStatusSuccess,
+			expEncryption: oidEncryptionAlgorithmAES128CBC.String(),
+			expFingerprint: func(ca *x509.Certificate) string {
+				sum := sha256.Sum256(ca.Raw)
+				return hex.EncodeToString(sum[:])
+			},
+		},
+		"returns a pending error if the request is pending": {
+			status: pkiStatusPending,
+			expErr: &PendingError{},
+		},
+		"returns a failed error if the request was rejected": {
+			status: pkiStatusFailure,
+			expErr: &FailedError{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := newTestServer(t, test.caps...)
+			srv.status = test.status
+			httpSrv := httptest.NewServer(srv)
+			defer httpSrv.Close()
+
+			iss := &cmapi.SCEPIssuer{URL: httpSrv.URL + "/scep"}
+			if test.expFingerprint != nil {
+				iss.CAFingerprint = test.expFingerprint(srv.caCert)
+			}
+			c, err := New(iss)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			chain, err := c.Enroll(context.TODO(), newCSR(t))
+			switch test.expErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			case *PendingError:
+				var pendingErr *PendingError
+				if !errors.As(err, &pendingErr) {
+					t.Fatalf("expected pending error, got: %v", err)
+				}
+				return
+			case *FailedError:
+				var failedErr *FailedError
+				if !errors.As(err, &failedErr) {
+					t.Fatalf("expected failed error, got: %v", err)
+				}
+				return
+			}
+
+			if srv.requestEncryption != test.expEncryption {
+				t.Errorf("expected request encrypted with %s, got %s", test.expEncryption, srv.requestEncryption)
+			}
+			if len(chain) != 2 {
+				t.Fatalf("expected certificate and CA in chain, got %d certificates", len(chain))
+			}
+			if chain[0].Subject.CommonName != "example.com" || !chain[1].Equal(srv.caCert) {
+				t.Errorf("unexpected chain: %s, %s", chain[0].Subject, chain[1].Subject)
+			}
+		})
+	}
+}
+
+func TestCACertificatesFingerprintMismatch(t *testing.T) {
+	srv := newTestServer(t)
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+
+	c, err := New(&cmapi.SCEPIssuer{
+		URL:           httpSrv.URL,
+		CAFingerprint: strings.Repeat("00", sha256.Size),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CACertificates(context.TODO()); err == nil {
+		t.Errorf("expected error for CA certificate not matching the pinned fingerprint")
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"crypto/x509"
+)
+
+type Client struct {
+	CACertificatesFn func(ctx context.Context) ([]*x509.Certificate, error)
+	EnrollFn         func(ctx context.Context, csrDER []byte) ([]*x509.Certificate, error)
+}
+
+func (c *Client) CACertificates(ctx context.Context) ([]*x509.Certificate, error) {
+	return c.CACertificatesFn(ctx)
+}
+
+func (c *Client) Enroll(ctx context.Context, csrDER []byte) ([]*x509.Certificate, error) {
+	return c.EnrollFn(ctx, csrDER)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"go.mozilla.org/pkcs7"
+)
+
+// SCEP message attributes, as defined in RFC 8894 section 3.2.1.
+var (
+	oidMessageType    = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 2}
+	oidPKIStatus      = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 3}
+	oidFailInfo       = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 4}
+	oidSenderNonce    = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 5}
+	oidRecipientNonce = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 6}
+	oidTransactionID  = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 7}
+)
+
+const (
+	messageTypeCertRep  = "3"
+	messageTypePKCSReq  = "19"
+	pkiStatusSuccess    = "0"
+	pkiStatusFailure    = "2"
+	pkiStatusPending    = "3"
+	nonceSize           = 16
+	signerKeySize       = 2048
+	signerValidDuration = time.Hour
+)
+
+var failInfoReasons = map[string]string{
+	"0": "badAlg: unrecognized or unsupported algorithm",
+	"1": "badMessageCheck: integrity check failed",
+	"2": "badRequest: transaction not permitted or supported",
+	"3": "badTime: the signingTime attribute was not sufficiently close to the system time",
+	"4": "badCertId: no certificate could be identified matching the provided criteria",
+}
+
+// pkcsReq is a PKCSReq message, and the state needed to read the CertRep
+// message sent in response to it.
+type pkcsReq struct {
+	message       []byte
+	transactionID string
+	senderNonce   []byte
+
+	// The SCEP server encrypts the issued certificate for the signer of the
+	// request. The request is signed with an ephemeral key and certificate,
+	// as the private key of the certificate signing request is not available
+	// when the request is sent.
+	signerKey  *rsa.PrivateKey
+	signerCert *x509.Certificate
+}
+
+// newPKCSReq builds a PKCSReq message for the certificate signing request,
+// encrypted for the SCEP server using the strongest algorithms it supports.
+func newPKCSReq(csr *x509.CertificateRequest, caCerts []*x509.Certificate, caps capabilities) (*pkcsReq, error) {
+	recipient, err := recipientCertificate(caCerts)
+	if err != nil {
+		return nil, err
+	}
+
+	enc := encryptionDES3CBC
+	if caps.has(capabilityAES) || caps.has(capabilitySCEPStandard) {
+		enc = encryptionAES128CBC
+	}
+	envelopedCSR, err := envelope(csr.Raw, recipient, enc)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting certificate signing request: %w", err)
+	}
+
+	req := &pkcsReq{
+		// The transaction ID must be the same when polling for a pending
+		// request, so it is derived from the public key of the request.
+		transactionID: transactionID(csr),
+		senderNonce:   make([]byte, nonceSize),
+	}
+	if _, err := rand.Read(req.senderNonce); err != nil {
+		return nil, err
+	}
+	req.signerKey, req.signerCert, err = newSigner(csr)
+	if err != nil {
+		return nil, err
+	}
+
+	sd, err := pkcs7.NewSignedData(envelopedCSR)
+	if err != nil {
+		return nil, err
+	}
+	if caps.has(capabilitySHA256) || caps.has(capabilitySCEPStandard) {
+		sd.SetDigestAlgorithm(pkcs7.OIDDigestAlgorithmSHA256)
+	}
+	err = sd.AddSigner(req.signerCert, req.signerKey, pkcs7.SignerInfoConfig{
+		ExtraSignedAttributes: []pkcs7.Attribute{
+			{Type: oidMessageType, Value: messageTypePKCSReq},
+			{Type: oidTransactionID, Value: req.transactionID},
+			{Type: oidSenderNonce, Value: req.senderNonce},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error signing SCEP request: %w", err)
+	}
+	req.message, err = sd.Finish()
+	if err != nil {
+		return nil, fmt.Errorf("error signing SCEP request: %w", err)
+	}
+
+	return req, nil
+}
+
+// parseCertRep parses the CertRep message sent by the SCEP server in response
+// to the request, returning the certificates it contains.
+func (r *pkcsReq) parseCertRep(data []byte, caCerts []*x509.Certificate) ([]*x509.Certificate, error) {
+	p7, err := pkcs7.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SCEP response: %w", err)
+	}
+	if err := p7.Verify(); err != nil {
+		return nil, fmt.Errorf("error verifying SCEP response signature: %w", err)
+	}
+	signer := p7.GetOnlySigner()
+	if signer == nil {
+		return nil, errors.New("SCEP response must have exactly one signer")
+	}
+	if !trustedSigner(signer, caCerts) {
+		return nil, fmt.Errorf("SCEP response is signed by untrusted certificate %q", signer.Subject)
+	}
+
+	var messageType, transactionID, status string
+	var recipientNonce []byte
+	if err := p7.UnmarshalSignedAttribute(oidMessageType, &messageType); err != nil {
+		return nil, fmt.Errorf("error reading SCEP response message type: %w", err)
+	}
+	if messageType != messageTypeCertRep {
+		return nil, fmt.Errorf("unexpected SCEP response message type %q", messageType)
+	}
+	if err := p7.UnmarshalSignedAttribute(oidTransactionID, &transactionID); err != nil {
+		return nil, fmt.Errorf("error reading SCEP response transaction ID: %w", err)
+	}
+	if transactionID != r.transactionID {
+		return nil, fmt.Errorf("SCEP response transaction ID %q does not match request %q", transactionID, r.transactionID)
+	}
+	if err := p7.UnmarshalSignedAttribute(oidRecipientNonce, &recipientNonce); err != nil {
+		return nil, fmt.Errorf("error reading SCEP response recipient nonce: %w", err)
+	}
+	if !bytes.Equal(recipientNonce, r.senderNonce) {
+		return nil, errors.New("SCEP response recipient nonce does not match request")
+	}
+	if err := p7.UnmarshalSignedAttribute(oidPKIStatus, &status); err != nil {
+		return nil, fmt.Errorf("error reading SCEP response status: %w", err)
+	}
+
+	switch status {
+	case pkiStatusSuccess:
+	case pkiStatusPending:
+		return nil, &PendingError{TransactionID: r.transactionID}
+	case pkiStatusFailure:
+		var failInfo string
+		if err := p7.UnmarshalSignedAttribute(oidFailInfo, &failInfo); err != nil {
+			return nil, &FailedError{FailInfo: "no failure reason given"}
+		}
+		if reason, ok := failInfoReasons[failInfo]; ok {
+			failInfo = reason
+		}
+		return nil, &FailedError{FailInfo: failInfo}
+	default:
+		return nil, fmt.Errorf("unexpected SCEP response status %q", status)
+	}
+
+	envelope, err := pkcs7.Parse(p7.Content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SCEP response envelope: %w", err)
+	}
+	content, err := envelope.Decrypt(r.signerCert, r.signerKey)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting SCEP response: %w", err)
+	}
+	degenerate, err := pkcs7.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificates in SCEP response: %w", err)
+	}
+	if len(degenerate.Certificates) == 0 {
+		return nil, errors.New("SCEP response contains no certificates")
+	}
+	return degenerate.Certificates, nil
+}
+
+// recipientCertificate returns the certificate to encrypt requests for. RA
+// certificates which can be used for encryption are preferred over the CA
+// certificate.
+func recipientCertificate(caCerts []*x509.Certificate) (*x509.Certificate, error) {
+	var ca *x509.Certificate
+	for _, cert := range caCerts {
+		if _, ok := cert.PublicKey.(*rsa.PublicKey); !ok {
+			continue
+		}
+		if cert.IsCA {
+			if ca == nil {
+				ca = cert
+			}
+			continue
+		}
+		if cert.KeyUsage&(x509.KeyUsageKeyEncipherment|x509.KeyUsageDataEncipherment) != 0 {
+			return cert, nil
+		}
+	}
+	if ca == nil {
+		return nil, errors.New("SCEP server has no RSA certificate to encrypt requests for")
+	}
+	return ca, nil
+}
+
+// trustedSigner returns whether the signer of a response is one of the CA or
+// RA certificates of the SCEP server, or was signed by one of them.
+func trustedSigner(signer *x509.Certificate, caCerts []*x509.Certificate) bool {
+	for _, cert := range caCerts {
+		if signer.Equal(cert) || signer.CheckSignatureFrom(cert) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func transactionID(csr *x509.CertificateRequest) string {
+	sum := sha256.Sum256(csr.RawSubjectPublicKeyInfo)
+	return hex.EncodeToString(sum[:])
+}
+
+// newSigner generates an ephemeral key and self-signed certificate to sign a
+// request with, using the subject of the certificate signing request as RFC
+// 8894 section 2.3 recommends.
+func newSigner(csr *x509.CertificateRequest) (*rsa.PrivateKey, *x509.Certificate, error) {
+	key, err := rsa.GenerateKey(rand.Reader, signerKeySize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating SCEP signer key: %w", err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subject := pkix.Name{CommonName: "cert-manager SCEP requester"}
+	if len(csr.RawSubject) > 0 && len(csr.Subject.Names) > 0 {
+		subject = csr.Subject
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      subject,
+		NotBefore:    now.Add(-5 * time.Minute),
+		NotAfter:     now.Add(signerValidDuration),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating SCEP signer certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, cert, nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scep
+
+import (
+	"github.com/go-logr/logr"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	"github.com/jetstack/cert-manager/pkg/issuer/scep/client"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+// SCEP is an issuer for certificates from a SCEP (RFC 8894) server
+type SCEP struct {
+	issuer cmapi.GenericIssuer
+	*controller.Context
+
+	clientBuilder client.ClientBuilder
+
+	log logr.Logger
+}
+
+func NewSCEP(ctx *controller.Context, issuer cmapi.GenericIssuer) (issuer.Interface, error) {
+	return &SCEP{
+		issuer:        issuer,
+		clientBuilder: client.New,
+		Context:       ctx,
+		log:           logf.Log.WithName("scep"),
+	}, nil
+}
+
+func init() {
+	issuer.RegisterIssuer(apiutil.IssuerSCEP, NewSCEP)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selfsigned
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	// DefaultBootstrapCARootDuration is the duration of a bootstrapped root
+	// CA certificate if none is specified.
+	DefaultBootstrapCARootDuration = time.Hour * 24 * 365 * 10
+
+	// DefaultBootstrapCAIntermediateDuration is the duration of a
+	// bootstrapped intermediate CA certificate if none is specified.
+	DefaultBootstrapCAIntermediateDuration = time.Hour * 24 * 365 * 5
+
+	// bootstrapCARootSuffix is appended to the name of the bootstrapped CA
+	// issuer to name the root CA Certificate, its Secret and issuer.
+	bootstrapCARootSuffix = "-root"
+)
+
+// bootstrapCA ensures that the root and intermediate CA Certificates and the
+// CA issuers of the CA hierarchy configured by the issuer exist and are up to
+// date. The Certificates themselves are issued by the certificates controllers.
+func (c *SelfSigned) bootstrapCA(ctx context.Context, cfg *v1.SelfSignedBootstrapCA) error {
+	namespace := c.IssuerOptions.ResourceNamespace(c.issuer)
+	kind := issuerKind(c.issuer)
+	rootName := cfg.IssuerName + bootstrapCARootSuffix
+
+	rootDuration := DefaultBootstrapCARootDuration
+	if cfg.RootDuration != nil {
+		rootDuration = cfg.RootDuration.Duration
+	}
+	intermediateDuration := DefaultBootstrapCAIntermediateDuration
+	if cfg.IntermediateDuration != nil {
+		intermediateDuration = cfg.IntermediateDuration.Duration
+	}
+
+	root := c.bootstrapCACertificate(namespace, rootName, cfg, cfg.CommonName+" Root CA", rootDuration, c.issuer.GetObjectMeta().Name, kind)
+	if err := c.ensureCertificate(ctx, root); err != nil {
+		return err
+	}
+	if err := c.ensureIssuer(ctx, kind, rootName); err != nil {
+		return err
+	}
+
+	intermediate := c.bootstrapCACertificate(namespace, cfg.IssuerName, cfg, cfg.CommonName+" Intermediate CA", intermediateDuration, rootName, kind)
+	if err := c.ensureCertificate(ctx, intermediate); err != nil {
+		return err
+	}
+	return c.ensureIssuer(ctx, kind, cfg.IssuerName)
+}
+
+func (c *SelfSigned) bootstrapCACertificate(namespace, name string, cfg *v1.SelfSignedBootstrapCA, commonName string, duration time.Duration, issuerName, issuerKind string) *v1.Certificate {
+	return &v1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{c.ownerReference()},
+		},
+		Spec: v1.CertificateSpec{
+			CommonName: commonName,
+			Subject:    cfg.Subject,
+			Duration:   &metav1.Duration{Duration: duration},
+			SecretName: name,
+			IsCA:       true,
+			IssuerRef: cmmeta.ObjectReference{
+				Name:  issuerName,
+				Kind:  issuerKind,
+				Group: certmanager.GroupName,
+			},
+		},
+	}
+}
+
+// ensureCertificate creates the given Certificate, or updates its spec if it
+// already exists and is owned by the issuer.
+func (c *SelfSigned) ensureCertificate(ctx context.Context, crt *v1.Certificate) error {
+	log := logf.WithRelatedResource(logf.FromContext(ctx), crt)
+	client := c.CMClient.CertmanagerV1().Certificates(crt.Namespace)
+
+	existing, err := client.Get(ctx, crt.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err := client.Create(ctx, crt, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating bootstrap CA Certificate %s/%s: %w", crt.Namespace, crt.Name, err)
+		}
+		log.V(logf.DebugLevel).Info("created bootstrap CA Certificate")
+		c.Recorder.Eventf(c.issuer, corev1.EventTypeNormal, events.ReasonBootstrapCA, "Created bootstrap CA Certificate %s/%s", crt.Namespace, crt.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(existing, c.issuer.GetObjectMeta()) {
+		return fmt.Errorf("bootstrap CA Certificate %s/%s already exists and is not owned by the issuer", crt.Namespace, crt.Name)
+	}
+	if apiequality.Semantic.DeepEqual(existing.Spec, crt.Spec) {
+		return nil
+	}
+
+	existing = existing.DeepCopy()
+	existing.Spec = crt.Spec
+	if _, err := client.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating bootstrap CA Certificate %s/%s: %w", crt.Namespace, crt.Name, err)
+	}
+	log.V(logf.DebugLevel).Info("updated bootstrap CA Certificate")
+	return nil
+}
+
+// ensureIssuer creates a CA issuer of the given kind, which signs using the
+// CA certificate stored in the Secret of the same name, if it does not exist.
+func (c *SelfSigned) ensureIssuer(ctx context.Context, kind, name string) error {
+	meta := metav1.ObjectMeta{
+		Name:            name,
+		OwnerReferences: []metav1.OwnerReference{c.ownerReference()},
+	}
+	spec := v1.IssuerSpec{
+		IssuerConfig: v1.IssuerConfig{
+			CA: &v1.CAIssuer{SecretName: name},
+		},
+	}
+
+	var existing metav1.Object
+	var err error
+	switch kind {
+	case v1.ClusterIssuerKind:
+		existing, err = c.CMClient.CertmanagerV1().ClusterIssuers().Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = c.CMClient.CertmanagerV1().ClusterIssuers().Create(ctx, &v1.ClusterIssuer{ObjectMeta: meta, Spec: spec}, metav1.CreateOptions{})
+			return c.issuerCreated(kind, name, err)
+		}
+	default:
+		meta.Namespace = c.issuer.GetObjectMeta().Namespace
+		existing, err = c.CMClient.CertmanagerV1().Issuers(meta.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = c.CMClient.CertmanagerV1().Issuers(meta.Namespace).Create(ctx, &v1.Issuer{ObjectMeta: meta, Spec: spec}, metav1.CreateOptions{})
+			return c.issuerCreated(kind, name, err)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(existing, c.issuer.GetObjectMeta()) {
+		return fmt.Errorf("bootstrap CA %s %q already exists and is not owned by the issuer", kind, name)
+	}
+	return nil
+}
+
+func (c *SelfSigned) issuerCreated(kind, name string, err error) error {
+	if err != nil {
+		return fmt.Errorf("error creating bootstrap CA %s %q: %w", kind, name, err)
+	}
+	c.Recorder.Eventf(c.issuer, corev1.EventTypeNormal, events.ReasonBootstrapCA, "Created bootstrap CA %s %q", kind, name)
+	return nil
+}
+
+func (c *SelfSigned) ownerReference() metav1.OwnerReference {
+	return *metav1.NewControllerRef(c.issuer.GetObjectMeta(), v1.SchemeGroupVersion.WithKind(issuerKind(c.issuer)))
+}
+
+func issuerKind(iss v1.GenericIssuer) string {
+	if _, ok := iss.(*v1.ClusterIssuer); ok {
+		return v1.ClusterIssuerKind
+	}
+	return v1.IssuerKind
+}
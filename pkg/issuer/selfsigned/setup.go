@@ -19,16 +19,32 @@ package selfsigned
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
 )
 
 const (
 	successReady = "IsReady"
+
+	messageErrorBootstrapCA = "Error bootstrapping CA: "
 )
 
 func (c *SelfSigned) Setup(ctx context.Context) error {
+	if cfg := c.issuer.GetSpec().SelfSigned.BootstrapCA; cfg != nil {
+		if err := c.bootstrapCA(ctx, cfg); err != nil {
+			logf.FromContext(ctx, "setup").Error(err, "error bootstrapping CA")
+			s := messageErrorBootstrapCA + err.Error()
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, events.ReasonBootstrapCAFailed, s)
+			apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, events.ReasonBootstrapCAFailed, s)
+			return err
+		}
+	}
+
 	apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successReady, "")
 	return nil
 }
@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selfsigned
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/jetstack/cert-manager/pkg/controller"
+	controllertest "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestSetupBootstrapCA(t *testing.T) {
+	bootstrapCA := &cmapi.SelfSignedBootstrapCA{
+		IssuerName:           "ca",
+		CommonName:           "Example",
+		IntermediateDuration: &metav1.Duration{Duration: time.Hour * 24 * 365},
+	}
+	issuer := gen.Issuer("selfsigned",
+		gen.SetIssuerNamespace("test-namespace"),
+		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{BootstrapCA: bootstrapCA}),
+	)
+	issuer.UID = types.UID("issuer-uid")
+	clusterIssuer := gen.ClusterIssuer("selfsigned",
+		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{BootstrapCA: bootstrapCA}),
+	)
+	clusterIssuer.UID = types.UID("cluster-issuer-uid")
+
+	issuerOwner := *metav1.NewControllerRef(issuer, cmapi.SchemeGroupVersion.WithKind(cmapi.IssuerKind))
+
+	tests := map[string]struct {
+		issuer   cmapi.GenericIssuer
+		existing []runtime.Object
+
+		expectedCertificates []*cmapi.Certificate
+		expectedIssuerKind   string
+		expectedNamespace    string
+		expectedEvents       []string
+		expectedReady        cmmeta.ConditionStatus
+		expectedErr          bool
+	}{
+		"without bootstrapCA, the issuer is ready and nothing is created": {
+			issuer: gen.Issuer("selfsigned",
+				gen.SetIssuerNamespace("test-namespace"),
+				gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+			),
+			expectedReady: cmmeta.ConditionTrue,
+		},
+		"an Issuer creates namespaced Certificates and Issuers": {
+			issuer: issuer.DeepCopy(),
+			expectedCertificates: []*cmapi.Certificate{
+				bootstrapCertificate("test-namespace", "ca-root", "Example Root CA", DefaultBootstrapCARootDuration, "selfsigned", cmapi.IssuerKind),
+				bootstrapCertificate("test-namespace", "ca", "Example Intermediate CA", time.Hour*24*365, "ca-root", cmapi.IssuerKind),
+			},
+			expectedIssuerKind: cmapi.IssuerKind,
+			expectedNamespace:  "test-namespace",
+			expectedEvents: []string{
+				"Normal BootstrapCA Created bootstrap CA Certificate test-namespace/ca-root",
+				`Normal BootstrapCA Created bootstrap CA Issuer "ca-root"`,
+				"Normal BootstrapCA Created bootstrap CA Certificate test-namespace/ca",
+				`Normal BootstrapCA Created bootstrap CA Issuer "ca"`,
+			},
+			expectedReady: cmmeta.ConditionTrue,
+		},
+		"a ClusterIssuer creates Certificates in the cluster resource namespace and ClusterIssuers": {
+			issuer: clusterIssuer.DeepCopy(),
+			expectedCertificates: []*cmapi.Certificate{
+				bootstrapCertificate("cluster-resource-namespace", "ca-root", "Example Root CA", DefaultBootstrapCARootDuration, "selfsigned", cmapi.ClusterIssuerKind),
+				bootstrapCertificate("cluster-resource-namespace", "ca", "Example Intermediate CA", time.Hour*24*365, "ca-root", cmapi.ClusterIssuerKind),
+			},
+			expectedIssuerKind: cmapi.ClusterIssuerKind,
+			expectedNamespace:  "cluster-resource-namespace",
+			expectedEvents: []string{
+				"Normal BootstrapCA Created bootstrap CA Certificate cluster-resource-namespace/ca-root",
+				`Normal BootstrapCA Created bootstrap CA ClusterIssuer "ca-root"`,
+				"Normal BootstrapCA Created bootstrap CA Certificate cluster-resource-namespace/ca",
+				`Normal BootstrapCA Created bootstrap CA ClusterIssuer "ca"`,
+			},
+			expectedReady: cmmeta.ConditionTrue,
+		},
+		"owned resources which are out of date are updated": {
+			issuer: issuer.DeepCopy(),
+			existing: []runtime.Object{
+				withOwner(bootstrapCertificate("test-namespace", "ca-root", "Old Root CA", time.Hour, "selfsigned", cmapi.IssuerKind), issuerOwner),
+				withOwner(bootstrapCertificate("test-namespace", "ca", "Example Intermediate CA", time.Hour*24*365, "ca-root", cmapi.IssuerKind), issuerOwner),
+				gen.Issuer("ca-root", gen.SetIssuerNamespace("test-namespace"), func(iss cmapi.GenericIssuer) {
+					iss.GetObjectMeta().OwnerReferences = []metav1.OwnerReference{issuerOwner}
+				}),
+				gen.Issuer("ca", gen.SetIssuerNamespace("test-namespace"), func(iss cmapi.GenericIssuer) {
+					iss.GetObjectMeta().OwnerReferences = []metav1.OwnerReference{issuerOwner}
+				}),
+			},
+			expectedCertificates: []*cmapi.Certificate{
+				bootstrapCertificate("test-namespace", "ca-root", "Example Root CA", DefaultBootstrapCARootDuration, "selfsigned", cmapi.IssuerKind),
+				bootstrapCertificate("test-namespace", "ca", "Example Intermediate CA", time.Hour*24*365, "ca-root", cmapi.IssuerKind),
+			},
+			expectedIssuerKind: cmapi.IssuerKind,
+			expectedNamespace:  "test-namespace",
+			expectedReady:      cmmeta.ConditionTrue,
+		},
+		"a Certificate which is not owned by the issuer is not overwritten": {
+			issuer: issuer.DeepCopy(),
+			existing: []runtime.Object{
+				gen.Certificate("ca-root", gen.SetCertificateNamespace("test-namespace")),
+			},
+			expectedEvents: []string{
+				"Warning ErrBootstrapCA Error bootstrapping CA: bootstrap CA Certificate test-namespace/ca-root already exists and is not owned by the issuer",
+			},
+			expectedReady: cmmeta.ConditionFalse,
+			expectedErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			cl := cmfake.NewSimpleClientset(test.existing...)
+			s := &SelfSigned{
+				Context: &controller.Context{
+					Recorder: rec,
+					CMClient: cl,
+					IssuerOptions: controller.IssuerOptions{
+						ClusterResourceNamespace: "cluster-resource-namespace",
+					},
+				},
+				issuer: test.issuer,
+			}
+
+			err := s.Setup(context.TODO())
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expectedErr, err)
+			}
+
+			if len(rec.Events) != len(test.expectedEvents) {
+				t.Fatalf("unexpected events, exp=%q got=%q", test.expectedEvents, rec.Events)
+			}
+			for i, e := range test.expectedEvents {
+				if rec.Events[i] != e {
+					t.Errorf("unexpected event, exp=%q got=%q", e, rec.Events[i])
+				}
+			}
+
+			conditions := test.issuer.GetStatus().Conditions
+			if len(conditions) != 1 || conditions[0].Status != test.expectedReady {
+				t.Errorf("unexpected conditions, exp Ready=%s got=%+v", test.expectedReady, conditions)
+			}
+
+			for _, expected := range test.expectedCertificates {
+				crt, err := cl.CertmanagerV1().Certificates(expected.Namespace).Get(context.TODO(), expected.Name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if crt.Spec.CommonName != expected.Spec.CommonName ||
+					crt.Spec.Duration.Duration != expected.Spec.Duration.Duration ||
+					crt.Spec.IssuerRef != expected.Spec.IssuerRef ||
+					crt.Spec.SecretName != expected.Spec.SecretName ||
+					!crt.Spec.IsCA {
+					t.Errorf("unexpected Certificate spec, exp=%+v got=%+v", expected.Spec, crt.Spec)
+				}
+				if !metav1.IsControlledBy(crt, test.issuer.GetObjectMeta()) {
+					t.Errorf("expected Certificate %q to be owned by the issuer", crt.Name)
+				}
+			}
+
+			for _, name := range []string{"ca-root", "ca"} {
+				var iss cmapi.GenericIssuer
+				switch test.expectedIssuerKind {
+				case "":
+					continue
+				case cmapi.ClusterIssuerKind:
+					iss, err = cl.CertmanagerV1().ClusterIssuers().Get(context.TODO(), name, metav1.GetOptions{})
+				default:
+					iss, err = cl.CertmanagerV1().Issuers(test.expectedNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if test.existing == nil && (iss.GetSpec().CA == nil || iss.GetSpec().CA.SecretName != name) {
+					t.Errorf("expected CA issuer %q to use the Secret of the same name, got=%+v", name, iss.GetSpec())
+				}
+				if !metav1.IsControlledBy(iss.GetObjectMeta(), test.issuer.GetObjectMeta()) {
+					t.Errorf("expected issuer %q to be owned by the issuer", name)
+				}
+			}
+		})
+	}
+}
+
+func bootstrapCertificate(namespace, name, commonName string, duration time.Duration, issuerName, issuerKind string) *cmapi.Certificate {
+	return gen.Certificate(name,
+		gen.SetCertificateNamespace(namespace),
+		gen.SetCertificateCommonName(commonName),
+		gen.SetCertificateDuration(duration),
+		gen.SetCertificateSecretName(name),
+		gen.SetCertificateIsCA(true),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: issuerName, Kind: issuerKind, Group: "cert-manager.io"}),
+	)
+}
+
+func withOwner(crt *cmapi.Certificate, owner metav1.OwnerReference) *cmapi.Certificate {
+	crt.OwnerReferences = []metav1.OwnerReference{owner}
+	return crt
+}
@@ -30,6 +30,7 @@ type Connector struct {
 	RetrieveCertificateFunc   func(*certificate.Request) (*certificate.PEMCollection, error)
 	RequestCertificateFunc    func(*certificate.Request) (string, error)
 	RenewCertificateFunc      func(*certificate.RenewalRequest) (string, error)
+	SetZoneFunc               func(string)
 }
 
 func (f Connector) Default() *Connector {
@@ -73,3 +74,11 @@ func (f *Connector) RenewCertificate(req *certificate.RenewalRequest) (requestID
 	}
 	return f.Connector.RenewCertificate(req)
 }
+
+func (f *Connector) SetZone(zone string) {
+	if f.SetZoneFunc != nil {
+		f.SetZoneFunc(zone)
+		return
+	}
+	f.Connector.SetZone(zone)
+}
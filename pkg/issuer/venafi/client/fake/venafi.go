@@ -29,6 +29,7 @@ type Venafi struct {
 	RequestCertificateFn    func(csrPEM []byte, duration time.Duration, customFields []api.CustomField) (string, error)
 	RetrieveCertificateFn   func(pickupID string, csrPEM []byte, duration time.Duration, customFields []api.CustomField) ([]byte, error)
 	ReadZoneConfigurationFn func() (*endpoint.ZoneConfiguration, error)
+	SetZoneFn               func(zone string)
 }
 
 func (v *Venafi) Ping() error {
@@ -47,4 +48,10 @@ func (v *Venafi) ReadZoneConfiguration() (*endpoint.ZoneConfiguration, error) {
 	return v.ReadZoneConfigurationFn()
 }
 
+func (v *Venafi) SetZone(zone string) {
+	if v.SetZoneFn != nil {
+		v.SetZoneFn(zone)
+	}
+}
+
 func (v *Venafi) SetClient(endpoint.Connector) {}
@@ -46,6 +46,7 @@ type Interface interface {
 	RetrieveCertificate(pickupID string, csrPEM []byte, duration time.Duration, customFields []api.CustomField) ([]byte, error)
 	Ping() error
 	ReadZoneConfiguration() (*endpoint.ZoneConfiguration, error)
+	SetZone(zone string)
 	SetClient(endpoint.Connector)
 }
 
@@ -68,6 +69,7 @@ type connector interface {
 	RequestCertificate(req *certificate.Request) (requestID string, err error)
 	RetrieveCertificate(req *certificate.Request) (certificates *certificate.PEMCollection, err error)
 	RenewCertificate(req *certificate.RenewalRequest) (requestID string, err error)
+	SetZone(zone string)
 }
 
 // New constructs a Venafi client Interface. Errors may be network errors and
@@ -157,6 +159,12 @@ func (v *Venafi) ReadZoneConfiguration() (*endpoint.ZoneConfiguration, error) {
 	return v.vcertClient.ReadZoneConfiguration()
 }
 
+// SetZone overrides the zone configured on the issuer for subsequent
+// requests made with this client.
+func (v *Venafi) SetZone(zone string) {
+	v.vcertClient.SetZone(zone)
+}
+
 func (v *Venafi) SetClient(client endpoint.Connector) {
 	v.vcertClient = client
 }
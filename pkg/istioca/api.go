@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package istioca
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The types in this file mirror the messages and service defined by Istio in
+// security/v1alpha1/ca.proto, which Istio's proxies use to request workload
+// certificates. Only the fields used by the CA are declared. The protobuf
+// struct tags allow the messages to be marshalled by the default gRPC codec
+// without generated code.
+
+// IstioCertificateRequest is the istio.v1.auth.IstioCertificateRequest
+// message.
+type IstioCertificateRequest struct {
+	// Csr is the PEM encoded certificate signing request.
+	Csr string `protobuf:"bytes,1,opt,name=csr,proto3" json:"csr,omitempty"`
+
+	// ValidityDuration is the requested duration of the certificate, in
+	// seconds.
+	ValidityDuration int64 `protobuf:"varint,3,opt,name=validity_duration,json=validityDuration,proto3" json:"validity_duration,omitempty"`
+
+	// Metadata is optional information about the request. It is not used
+	// by cert-manager.
+	Metadata *structpb.Struct `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *IstioCertificateRequest) Reset()         { *m = IstioCertificateRequest{} }
+func (m *IstioCertificateRequest) String() string { return proto.CompactTextString(m) }
+func (*IstioCertificateRequest) ProtoMessage()    {}
+
+// IstioCertificateResponse is the istio.v1.auth.IstioCertificateResponse
+// message.
+type IstioCertificateResponse struct {
+	// CertChain is the PEM encoded certificate chain, one certificate per
+	// element. The first element is the signed workload certificate and the
+	// last element is the root certificate.
+	CertChain []string `protobuf:"bytes,1,rep,name=cert_chain,json=certChain,proto3" json:"cert_chain,omitempty"`
+}
+
+func (m *IstioCertificateResponse) Reset()         { *m = IstioCertificateResponse{} }
+func (m *IstioCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*IstioCertificateResponse) ProtoMessage()    {}
+
+// IstioCertificateServiceServer is the server API for the
+// istio.v1.auth.IstioCertificateService service.
+type IstioCertificateServiceServer interface {
+	// CreateCertificate signs the certificate signing request in the given
+	// request and returns the signed certificate chain.
+	CreateCertificate(context.Context, *IstioCertificateRequest) (*IstioCertificateResponse, error)
+}
+
+// RegisterIstioCertificateServiceServer registers the given implementation of
+// the istio.v1.auth.IstioCertificateService service with the gRPC server.
+func RegisterIstioCertificateServiceServer(s *grpc.Server, srv IstioCertificateServiceServer) {
+	s.RegisterService(&istioCertificateServiceDesc, srv)
+}
+
+var istioCertificateServiceDesc = grpc.ServiceDesc{
+	ServiceName: "istio.v1.auth.IstioCertificateService",
+	HandlerType: (*IstioCertificateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCertificate",
+			Handler:    createCertificateHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "security/v1alpha1/ca.proto",
+}
+
+func createCertificateHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IstioCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IstioCertificateServiceServer).CreateCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/istio.v1.auth.IstioCertificateService/CreateCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IstioCertificateServiceServer).CreateCertificate(ctx, req.(*IstioCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
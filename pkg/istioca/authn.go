@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package istioca
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	bearerTokenPrefix        = "Bearer "
+)
+
+// Identity is the identity of an authenticated workload.
+type Identity struct {
+	// Namespace is the namespace of the workload's ServiceAccount.
+	Namespace string
+
+	// ServiceAccount is the name of the workload's ServiceAccount.
+	ServiceAccount string
+}
+
+// SPIFFEID returns the SPIFFE ID of the identity in the given trust domain, as
+// used by Istio.
+func (i Identity) SPIFFEID(trustDomain string) string {
+	return fmt.Sprintf("spiffe://%s/ns/%s/sa/%s", trustDomain, i.Namespace, i.ServiceAccount)
+}
+
+// Authenticator authenticates the workload making a gRPC request.
+type Authenticator interface {
+	// Authenticate returns the identity of the workload which made the
+	// request with the given context. The returned error is a gRPC status
+	// error.
+	Authenticate(ctx context.Context) (*Identity, error)
+}
+
+// TokenReviewAuthenticator authenticates workloads by the ServiceAccount
+// token passed as a bearer token in the request metadata, using the
+// Kubernetes TokenReview API.
+type TokenReviewAuthenticator struct {
+	Client kubernetes.Interface
+
+	// Audiences are the audiences the token must be valid for. If empty,
+	// the audiences of the Kubernetes API server are used.
+	Audiences []string
+}
+
+var _ Authenticator = &TokenReviewAuthenticator{}
+
+func (a *TokenReviewAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := a.Client.AuthenticationV1().TokenReviews().Create(ctx, &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.Audiences,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to review token: %v", err)
+	}
+	if !review.Status.Authenticated {
+		return nil, status.Errorf(codes.Unauthenticated, "token is not authenticated: %s", review.Status.Error)
+	}
+
+	namespace, name, err := serviceaccount.SplitUsername(review.Status.User.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "token does not belong to a ServiceAccount: %v", err)
+	}
+
+	return &Identity{Namespace: namespace, ServiceAccount: name}, nil
+}
+
+// bearerToken returns the bearer token from the authorization metadata of the
+// request with the given context.
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "no request metadata")
+	}
+	for _, value := range md.Get(authorizationMetadataKey) {
+		if strings.HasPrefix(value, bearerTokenPrefix) {
+			return strings.TrimPrefix(value, bearerTokenPrefix), nil
+		}
+	}
+	return "", status.Error(codes.Unauthenticated, "no bearer token in request metadata")
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package istioca
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authnv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+)
+
+func TestTokenReviewAuthenticator(t *testing.T) {
+	tests := map[string]struct {
+		md           metadata.MD
+		reviewStatus authnv1.TokenReviewStatus
+		wantIdentity *Identity
+		wantCode     codes.Code
+	}{
+		"no bearer token": {
+			md:       metadata.Pairs("foo", "bar"),
+			wantCode: codes.Unauthenticated,
+		},
+		"token not authenticated": {
+			md:           metadata.Pairs("authorization", "Bearer token"),
+			reviewStatus: authnv1.TokenReviewStatus{Authenticated: false, Error: "invalid token"},
+			wantCode:     codes.Unauthenticated,
+		},
+		"token for a user which is not a ServiceAccount": {
+			md: metadata.Pairs("authorization", "Bearer token"),
+			reviewStatus: authnv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authnv1.UserInfo{Username: "alice"},
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		"token for a ServiceAccount": {
+			md: metadata.Pairs("authorization", "Bearer token"),
+			reviewStatus: authnv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authnv1.UserInfo{Username: "system:serviceaccount:foo:bar"},
+			},
+			wantIdentity: &Identity{Namespace: "foo", ServiceAccount: "bar"},
+			wantCode:     codes.OK,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := fake.NewSimpleClientset()
+			cl.PrependReactor("create", "tokenreviews", func(action coretesting.Action) (bool, runtime.Object, error) {
+				review := action.(coretesting.CreateAction).GetObject().(*authnv1.TokenReview)
+				if review.Spec.Token != "token" || !reflect.DeepEqual(review.Spec.Audiences, []string{"istio-ca"}) {
+					t.Errorf("unexpected TokenReview spec: %+v", review.Spec)
+				}
+				review.Status = test.reviewStatus
+				return true, review, nil
+			})
+
+			a := &TokenReviewAuthenticator{Client: cl, Audiences: []string{"istio-ca"}}
+			identity, err := a.Authenticate(metadata.NewIncomingContext(context.Background(), test.md))
+			if code := status.Code(err); code != test.wantCode {
+				t.Fatalf("expected code %s, got %s (%v)", test.wantCode, code, err)
+			}
+			if !reflect.DeepEqual(identity, test.wantIdentity) {
+				t.Errorf("expected identity %+v, got %+v", test.wantIdentity, identity)
+			}
+		})
+	}
+}
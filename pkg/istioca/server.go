@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package istioca implements Istio's CA gRPC service backed by cert-manager
+// issuers, so that Istio proxies can request workload certificates directly
+// rather than through a CertificateRequest resource per workload.
+package istioca
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Server implements the istio.v1.auth.IstioCertificateService service.
+// Workloads may only be issued a certificate for their own SPIFFE ID.
+type Server struct {
+	Authenticator Authenticator
+	Signer        Signer
+
+	// TrustDomain is the SPIFFE trust domain of the mesh.
+	TrustDomain string
+
+	// MaxCertificateDuration is the maximum duration of signed certificates.
+	// It is also used when a request does not specify a duration.
+	MaxCertificateDuration time.Duration
+}
+
+var _ IstioCertificateServiceServer = &Server{}
+
+func (s *Server) CreateCertificate(ctx context.Context, req *IstioCertificateRequest) (*IstioCertificateResponse, error) {
+	log := logf.FromContext(ctx, "istioca")
+
+	identity, err := s.Authenticator.Authenticate(ctx)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("failed to authenticate request", "error", err.Error())
+		return nil, err
+	}
+	spiffeID := identity.SPIFFEID(s.TrustDomain)
+	log = log.WithValues("identity", spiffeID)
+
+	duration := s.MaxCertificateDuration
+	if requested := time.Duration(req.ValidityDuration) * time.Second; requested > 0 && requested < duration {
+		duration = requested
+	}
+
+	template, err := pki.GenerateTemplateFromCSRPEMWithUsages([]byte(req.Csr), duration, false,
+		x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment,
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode certificate signing request: %v", err)
+	}
+
+	if err := validateIdentity(template, spiffeID); err != nil {
+		log.V(logf.InfoLevel).Info("denied certificate request", "reason", err.Error())
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	bundle, err := s.Signer.Sign(ctx, template)
+	if err != nil {
+		log.Error(err, "failed to sign certificate")
+		return nil, status.Errorf(codes.Internal, "failed to sign certificate: %v", err)
+	}
+
+	chain := splitPEMCertificates(bundle.ChainPEM)
+	chain = append(chain, splitPEMCertificates(bundle.CAPEM)...)
+
+	log.V(logf.DebugLevel).Info("signed workload certificate", "duration", duration)
+
+	return &IstioCertificateResponse{CertChain: chain}, nil
+}
+
+// validateIdentity returns an error if the template requests any identity
+// other than the given SPIFFE ID.
+func validateIdentity(template *x509.Certificate, spiffeID string) error {
+	if len(template.URIs) != 1 || template.URIs[0].String() != spiffeID {
+		return fmt.Errorf("certificate signing request must contain exactly one URI SAN %q", spiffeID)
+	}
+	if len(template.DNSNames) > 0 || len(template.IPAddresses) > 0 || len(template.EmailAddresses) > 0 {
+		return errors.New("certificate signing request must not contain DNS, IP or email SANs")
+	}
+	return nil
+}
+
+// splitPEMCertificates returns each PEM encoded block in the given data as a
+// separate string.
+func splitPEMCertificates(data []byte) []string {
+	var certs []string
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		certs = append(certs, string(pem.EncodeToMemory(block)))
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package istioca
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+type fakeAuthenticator struct {
+	identity *Identity
+	err      error
+}
+
+func (f *fakeAuthenticator) Authenticate(context.Context) (*Identity, error) {
+	return f.identity, f.err
+}
+
+// selfSignedCASigner signs certificates with an in-memory self-signed CA.
+type selfSignedCASigner struct {
+	caCert *x509.Certificate
+	caKey  crypto.Signer
+}
+
+func newSelfSignedCASigner(t *testing.T) *selfSignedCASigner {
+	key, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	_, cert, err := pki.SignCertificate(template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &selfSignedCASigner{caCert: cert, caKey: key}
+}
+
+func (s *selfSignedCASigner) Sign(_ context.Context, template *x509.Certificate) (pki.PEMBundle, error) {
+	return pki.SignCSRTemplate([]*x509.Certificate{s.caCert}, s.caKey, template)
+}
+
+func mustCSR(t *testing.T, uris ...string) string {
+	key, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.CertificateRequest{}
+	for _, u := range uris {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template.URIs = append(template.URIs, parsed)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func TestCreateCertificate(t *testing.T) {
+	identity := &Identity{Namespace: "foo", ServiceAccount: "bar"}
+	spiffeID := "spiffe://cluster.local/ns/foo/sa/bar"
+
+	tests := map[string]struct {
+		authn        Authenticator
+		req          *IstioCertificateRequest
+		wantCode     codes.Code
+		wantDuration time.Duration
+	}{
+		"unauthenticated requests are rejected": {
+			authn:    &fakeAuthenticator{err: status.Error(codes.Unauthenticated, "no token")},
+			req:      &IstioCertificateRequest{Csr: mustCSR(t, spiffeID)},
+			wantCode: codes.Unauthenticated,
+		},
+		"invalid CSRs are rejected": {
+			authn:    &fakeAuthenticator{identity: identity},
+			req:      &IstioCertificateRequest{Csr: "not a csr"},
+			wantCode: codes.InvalidArgument,
+		},
+		"CSRs for another identity are rejected": {
+			authn:    &fakeAuthenticator{identity: identity},
+			req:      &IstioCertificateRequest{Csr: mustCSR(t, "spiffe://cluster.local/ns/foo/sa/other")},
+			wantCode: codes.PermissionDenied,
+		},
+		"CSRs for additional identities are rejected": {
+			authn:    &fakeAuthenticator{identity: identity},
+			req:      &IstioCertificateRequest{Csr: mustCSR(t, spiffeID, "spiffe://cluster.local/ns/foo/sa/other")},
+			wantCode: codes.PermissionDenied,
+		},
+		"CSRs for the workload's identity are signed with the maximum duration by default": {
+			authn:        &fakeAuthenticator{identity: identity},
+			req:          &IstioCertificateRequest{Csr: mustCSR(t, spiffeID)},
+			wantCode:     codes.OK,
+			wantDuration: time.Hour,
+		},
+		"a shorter requested duration is used": {
+			authn:        &fakeAuthenticator{identity: identity},
+			req:          &IstioCertificateRequest{Csr: mustCSR(t, spiffeID), ValidityDuration: 600},
+			wantCode:     codes.OK,
+			wantDuration: 10 * time.Minute,
+		},
+		"a longer requested duration is capped": {
+			authn:        &fakeAuthenticator{identity: identity},
+			req:          &IstioCertificateRequest{Csr: mustCSR(t, spiffeID), ValidityDuration: 86400},
+			wantCode:     codes.OK,
+			wantDuration: time.Hour,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			signer := newSelfSignedCASigner(t)
+			s := &Server{
+				Authenticator:          test.authn,
+				Signer:                 signer,
+				TrustDomain:            "cluster.local",
+				MaxCertificateDuration: time.Hour,
+			}
+
+			resp, err := s.CreateCertificate(context.Background(), test.req)
+			if code := status.Code(err); code != test.wantCode {
+				t.Fatalf("expected code %s, got %s (%v)", test.wantCode, code, err)
+			}
+			if test.wantCode != codes.OK {
+				return
+			}
+
+			if len(resp.CertChain) != 2 {
+				t.Fatalf("expected a chain of the workload certificate and root, got %d certificates", len(resp.CertChain))
+			}
+			leaf, err := pki.DecodeX509CertificateBytes([]byte(resp.CertChain[0]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(leaf.URIs) != 1 || leaf.URIs[0].String() != spiffeID {
+				t.Errorf("expected certificate for %s, got URIs %v", spiffeID, leaf.URIs)
+			}
+			if d := leaf.NotAfter.Sub(leaf.NotBefore).Round(time.Minute); d != test.wantDuration {
+				t.Errorf("expected certificate duration %s, got %s", test.wantDuration, d)
+			}
+			root, err := pki.DecodeX509CertificateBytes([]byte(resp.CertChain[1]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !root.Equal(signer.caCert) {
+				t.Errorf("expected the last certificate in the chain to be the root CA")
+			}
+		})
+	}
+}
+
+func TestIstioCertificateServiceGRPC(t *testing.T) {
+	ln := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	RegisterIstioCertificateServiceServer(grpcServer, &Server{
+		Authenticator:          &fakeAuthenticator{identity: &Identity{Namespace: "foo", ServiceAccount: "bar"}},
+		Signer:                 newSelfSignedCASigner(t),
+		TrustDomain:            "cluster.local",
+		MaxCertificateDuration: time.Hour,
+	})
+	go grpcServer.Serve(ln)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return ln.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	resp := new(IstioCertificateResponse)
+	err = conn.Invoke(context.Background(), "/istio.v1.auth.IstioCertificateService/CreateCertificate",
+		&IstioCertificateRequest{Csr: mustCSR(t, "spiffe://cluster.local/ns/foo/sa/bar")}, resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.CertChain) != 2 {
+		t.Errorf("expected 2 certificates in the chain, got %d", len(resp.CertChain))
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package istioca
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	caissuer "github.com/jetstack/cert-manager/pkg/issuer/ca"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Signer signs workload certificates.
+type Signer interface {
+	// Sign signs the given certificate template, returning the PEM encoded
+	// certificate chain and CA.
+	Sign(ctx context.Context, template *x509.Certificate) (pki.PEMBundle, error)
+}
+
+// CAIssuerSigner signs workload certificates in-process using the key pair of
+// a cert-manager CA Issuer or ClusterIssuer. No CertificateRequest resources
+// are created.
+type CAIssuerSigner struct {
+	// IssuerRef references the Issuer or ClusterIssuer to sign with.
+	IssuerRef cmmeta.ObjectReference
+
+	// Namespace is the namespace of the Issuer. It is ignored for
+	// ClusterIssuers.
+	Namespace string
+
+	IssuerHelper  issuer.Helper
+	SecretsLister corelisters.SecretLister
+	IssuerOptions controllerpkg.IssuerOptions
+}
+
+var _ Signer = &CAIssuerSigner{}
+
+func (s *CAIssuerSigner) Sign(ctx context.Context, template *x509.Certificate) (pki.PEMBundle, error) {
+	issuerObj, err := s.IssuerHelper.GetGenericIssuer(s.IssuerRef, s.Namespace)
+	if err != nil {
+		return pki.PEMBundle{}, fmt.Errorf("failed to get issuer %s %q: %w", s.IssuerRef.Kind, s.IssuerRef.Name, err)
+	}
+	if issuerObj.GetSpec().CA == nil {
+		return pki.PEMBundle{}, fmt.Errorf("issuer %s %q is not a CA issuer", s.IssuerRef.Kind, s.IssuerRef.Name)
+	}
+	if !apiutil.IssuerHasCondition(issuerObj, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		return pki.PEMBundle{}, fmt.Errorf("issuer %s %q is not ready", s.IssuerRef.Kind, s.IssuerRef.Name)
+	}
+
+	caCerts, caKey, err := caissuer.KeyPair(ctx, s.SecretsLister, s.IssuerOptions, issuerObj)
+	if err != nil {
+		return pki.PEMBundle{}, fmt.Errorf("failed to get CA key pair: %w", err)
+	}
+
+	template.CRLDistributionPoints = issuerObj.GetSpec().CA.CRLDistributionPoints
+	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
+	template.NotBefore = template.NotBefore.Add(-s.IssuerOptions.Backdate(nil))
+
+	return pki.SignCSRTemplate(caCerts, caKey, template)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// LevelPath is the path the log level endpoint is served on.
+const LevelPath = "/debug/loglevels"
+
+// LevelStatus is the response of the log level endpoint.
+type LevelStatus struct {
+	// Global is the verbosity of loggers without an override.
+	Global int `json:"global"`
+	// Loggers are the verbosity overrides of named loggers, e.g. controllers.
+	Loggers map[string]int `json:"loggers"`
+}
+
+// LevelHandler serves the log levels, and allows them to be changed at
+// runtime:
+//
+//	GET                             returns the current levels.
+//	PUT    ?level=<n>               sets the global level.
+//	PUT    ?name=<logger>&level=<n> sets the level of the named logger.
+//	DELETE ?name=<logger>           removes the override of the named logger.
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			level, err := strconv.Atoi(r.URL.Query().Get("level"))
+			if err != nil || level < 0 {
+				http.Error(w, "level must be a non-negative integer", http.StatusBadRequest)
+				return
+			}
+			if len(name) == 0 {
+				if err := SetGlobalLevel(level); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+			} else {
+				SetLevel(name, level)
+			}
+			Log.Info("log level changed", "logger", name, "level", level)
+		case http.MethodDelete:
+			if len(name) == 0 {
+				http.Error(w, "name must be set", http.StatusBadRequest)
+				return
+			}
+			ResetLevel(name)
+			Log.Info("log level reset", "logger", name)
+		default:
+			w.Header().Set("Allow", fmt.Sprintf("%s, %s, %s", http.MethodGet, http.MethodPut, http.MethodDelete))
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LevelStatus{Global: GlobalLevel(), Loggers: Levels()})
+	})
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	jsonlogs "k8s.io/component-base/logs/json"
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/klogr"
+)
+
+const (
+	// TextFormat logs human readable lines through klog. It is the default.
+	TextFormat = "text"
+	// JSONFormat logs a JSON object per line.
+	JSONFormat = "json"
+)
+
+// Formats are the supported log formats.
+var Formats = []string{TextFormat, JSONFormat}
+
+// sink holds the logger which all loggers derived from Log write to, and the
+// verbosity overrides of named loggers. Both may be changed at runtime.
+var sink = struct {
+	lock      sync.RWMutex
+	root      logr.Logger
+	overrides map[string]int
+}{
+	root:      klogr.New(),
+	overrides: make(map[string]int),
+}
+
+// SetFormat sets the format that all loggers derived from Log write in.
+func SetFormat(format string) error {
+	var root logr.Logger
+	switch format {
+	case "", TextFormat:
+		root = klogr.New()
+	case JSONFormat:
+		root = jsonlogs.NewJSONLogger(os.Stderr)
+		// Route logs written directly to klog, e.g. by client-go, to the same
+		// logger so that every line is JSON.
+		klog.SetLogger(root)
+	default:
+		return fmt.Errorf("unsupported log format %q, must be one of %s", format, strings.Join(Formats, ", "))
+	}
+
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+	sink.root = root
+	return nil
+}
+
+// SetLevel overrides the verbosity of the logger with the given name, and of
+// all loggers derived from it, e.g. the logger of a single controller. The
+// override takes precedence over the global verbosity set with -v.
+func SetLevel(name string, level int) {
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+	sink.overrides[name] = level
+}
+
+// ResetLevel removes the verbosity override of the logger with the given name.
+func ResetLevel(name string) {
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+	delete(sink.overrides, name)
+}
+
+// Levels returns the verbosity overrides of named loggers.
+func Levels() map[string]int {
+	sink.lock.RLock()
+	defer sink.lock.RUnlock()
+	levels := make(map[string]int, len(sink.overrides))
+	for name, level := range sink.overrides {
+		levels[name] = level
+	}
+	return levels
+}
+
+// SetGlobalLevel sets the verbosity of loggers without an override, as set
+// with -v at startup.
+func SetGlobalLevel(level int) error {
+	var l klog.Level
+	return l.Set(strconv.Itoa(level))
+}
+
+// GlobalLevel returns the verbosity of loggers without an override.
+func GlobalLevel() int {
+	level := 0
+	for klog.V(klog.Level(level + 1)).Enabled() {
+		level++
+	}
+	return level
+}
+
+// ParseLevels parses verbosity overrides of the form name=level,name=level.
+func ParseLevels(s string) (map[string]int, error) {
+	levels := make(map[string]int)
+	for _, kv := range strings.Split(s, ",") {
+		if len(strings.TrimSpace(kv)) == 0 {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid log level %q, must be of the form name=level", kv)
+		}
+		level, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || level < 0 {
+			return nil, fmt.Errorf("invalid log level %q, level must be a non-negative integer", kv)
+		}
+		levels[strings.TrimSpace(parts[0])] = level
+	}
+	return levels, nil
+}
+
+// levelLogger is a logr.Logger which checks the verbosity overrides of its
+// names before writing to the current root logger. Names and values are
+// recorded rather than applied so that changes to the root logger are picked
+// up by loggers which have already been created.
+type levelLogger struct {
+	names  []string
+	values []interface{}
+	v      int
+	depth  int
+}
+
+var _ logr.CallDepthLogger = levelLogger{}
+
+// level returns the override of the most specific name of the logger which
+// has one.
+func (l levelLogger) level() (int, bool) {
+	sink.lock.RLock()
+	defer sink.lock.RUnlock()
+	if len(sink.overrides) == 0 {
+		return 0, false
+	}
+	for i := len(l.names) - 1; i >= 0; i-- {
+		if level, ok := sink.overrides[l.names[i]]; ok {
+			return level, true
+		}
+	}
+	return 0, false
+}
+
+// logger applies the names and values to the current root logger.
+func (l levelLogger) logger() logr.Logger {
+	sink.lock.RLock()
+	log := sink.root
+	sink.lock.RUnlock()
+	for _, name := range l.names {
+		log = log.WithName(name)
+	}
+	if len(l.values) > 0 {
+		log = log.WithValues(l.values...)
+	}
+	// Skip the frame of levelLogger so that the caller is reported. This is
+	// applied last as klogr does not preserve the call depth in WithName and
+	// WithValues.
+	return logr.WithCallDepth(log, l.depth+1)
+}
+
+func (l levelLogger) Enabled() bool {
+	if level, ok := l.level(); ok {
+		return l.v <= level
+	}
+	return klog.V(klog.Level(l.v)).Enabled()
+}
+
+func (l levelLogger) Info(msg string, keysAndValues ...interface{}) {
+	if !l.Enabled() {
+		return
+	}
+	l.logger().Info(msg, keysAndValues...)
+}
+
+func (l levelLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.logger().Error(err, msg, keysAndValues...)
+}
+
+func (l levelLogger) V(level int) logr.Logger {
+	l.v += level
+	return l
+}
+
+func (l levelLogger) WithName(name string) logr.Logger {
+	l.names = append(l.names[:len(l.names):len(l.names)], name)
+	return l
+}
+
+func (l levelLogger) WithValues(keysAndValues ...interface{}) logr.Logger {
+	l.values = append(l.values[:len(l.values):len(l.values)], keysAndValues...)
+	return l
+}
+
+func (l levelLogger) WithCallDepth(depth int) logr.Logger {
+	l.depth += depth
+	return l
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestLevelOverrides(t *testing.T) {
+	defer func() {
+		ResetLevel("certificates-issuing")
+		ResetLevel("orders")
+	}()
+
+	issuing := Log.WithName("controller").WithName("certificates-issuing")
+	orders := Log.WithName("controller").WithName("orders").WithValues("key", "ns/name")
+
+	if issuing.V(DebugLevel).Enabled() {
+		t.Fatalf("expected debug logs to be disabled at the default verbosity")
+	}
+
+	SetLevel("certificates-issuing", DebugLevel)
+	if !issuing.V(DebugLevel).Enabled() {
+		t.Errorf("expected debug logs to be enabled for overridden controller")
+	}
+	if issuing.V(TraceLevel).Enabled() {
+		t.Errorf("expected trace logs to be disabled for overridden controller")
+	}
+	if orders.V(DebugLevel).Enabled() {
+		t.Errorf("expected debug logs to be disabled for controller without an override")
+	}
+
+	// The most specific name of a logger takes precedence.
+	SetLevel("orders", ErrorLevel)
+	if orders.V(WarnLevel).Enabled() {
+		t.Errorf("expected warning logs to be disabled for controller with level 0")
+	}
+	if !orders.WithName("acme").V(ErrorLevel).Enabled() {
+		t.Errorf("expected derived logger to inherit the override of its parent")
+	}
+
+	ResetLevel("certificates-issuing")
+	if issuing.V(DebugLevel).Enabled() {
+		t.Errorf("expected debug logs to be disabled once the override is reset")
+	}
+}
+
+func TestParseLevels(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		exp     map[string]int
+		wantErr bool
+	}{
+		"empty": {
+			in:  "",
+			exp: map[string]int{},
+		},
+		"multiple controllers": {
+			in:  "certificates-issuing=4, orders=5",
+			exp: map[string]int{"certificates-issuing": 4, "orders": 5},
+		},
+		"missing level": {
+			in:      "orders",
+			wantErr: true,
+		},
+		"negative level": {
+			in:      "orders=-1",
+			wantErr: true,
+		},
+		"non-integer level": {
+			in:      "orders=debug",
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseLevels(test.in)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("unexpected error, wantErr=%t got=%v", test.wantErr, err)
+			}
+			if !test.wantErr && !reflect.DeepEqual(test.exp, got) {
+				t.Errorf("unexpected levels, exp=%v got=%v", test.exp, got)
+			}
+		})
+	}
+}
+
+func TestLevelHandler(t *testing.T) {
+	defer ResetLevel("orders")
+
+	do := func(method, query string) (int, LevelStatus) {
+		rec := httptest.NewRecorder()
+		LevelHandler().ServeHTTP(rec, httptest.NewRequest(method, LevelPath+query, nil))
+		var status LevelStatus
+		if rec.Code == http.StatusOK {
+			if err := json.NewDecoder(rec.Body).Decode(&status); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+		}
+		return rec.Code, status
+	}
+
+	if code, status := do(http.MethodPut, "?name=orders&level=5"); code != http.StatusOK || status.Loggers["orders"] != 5 {
+		t.Errorf("expected level to be set, code=%d status=%+v", code, status)
+	}
+	if code, status := do(http.MethodGet, ""); code != http.StatusOK || status.Loggers["orders"] != 5 {
+		t.Errorf("expected level to be returned, code=%d status=%+v", code, status)
+	}
+	if code, _ := do(http.MethodPut, "?name=orders&level=verbose"); code != http.StatusBadRequest {
+		t.Errorf("expected invalid level to be rejected, code=%d", code)
+	}
+	if code, status := do(http.MethodDelete, "?name=orders"); code != http.StatusOK || len(status.Loggers) != 0 {
+		t.Errorf("expected level to be reset, code=%d status=%+v", code, status)
+	}
+	if code, _ := do(http.MethodPost, ""); code != http.StatusMethodNotAllowed {
+		t.Errorf("expected unsupported method to be rejected, code=%d", code)
+	}
+}
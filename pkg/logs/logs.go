@@ -29,13 +29,17 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
-	"k8s.io/klog/v2/klogr"
 
 	"github.com/jetstack/cert-manager/pkg/api"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 )
 
 var (
-	Log = klogr.New().WithName("cert-manager")
+	// Log is the root logger of cert-manager. Its format and the verbosity of
+	// named loggers derived from it can be changed at runtime with SetFormat
+	// and SetLevel.
+	Log logr.Logger = levelLogger{}.WithName("cert-manager")
 )
 
 const (
@@ -91,6 +95,44 @@ const (
 	RelatedResourceVersionKey   = "related_resource_version"
 )
 
+// Keys which are added to log lines about the issuance of a Certificate, so
+// that the lines of every controller involved can be found by the same query.
+const (
+	CertificateKey = "certificate"
+	NamespaceKey   = "namespace"
+	RevisionKey    = "revision"
+	IssuerRefKey   = "issuerRef"
+)
+
+// WithCertificate adds the name, namespace, revision and issuer of the
+// Certificate to the logger.
+func WithCertificate(l logr.Logger, crt *cmapi.Certificate) logr.Logger {
+	l = l.WithValues(CertificateKey, crt.Name, NamespaceKey, crt.Namespace)
+	if crt.Status.Revision != nil {
+		l = WithRevision(l, *crt.Status.Revision)
+	}
+	return WithIssuerRef(l, crt.Spec.IssuerRef)
+}
+
+// WithRevision adds the revision of a Certificate to the logger.
+func WithRevision(l logr.Logger, revision int) logr.Logger {
+	return l.WithValues(RevisionKey, revision)
+}
+
+// WithIssuerRef adds a reference to an issuer, formatted as
+// <kind>.<group>/<name>, to the logger. An empty kind or group is logged as
+// the cert-manager default.
+func WithIssuerRef(l logr.Logger, ref cmmeta.ObjectReference) logr.Logger {
+	kind, group := ref.Kind, ref.Group
+	if len(kind) == 0 {
+		kind = "Issuer"
+	}
+	if len(group) == 0 {
+		group = "cert-manager.io"
+	}
+	return l.WithValues(IssuerRefKey, kind+"."+group+"/"+ref.Name)
+}
+
 func WithResource(l logr.Logger, obj metav1.Object) logr.Logger {
 	var gvk schema.GroupVersionKind
 
@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/publicsuffix"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+)
+
+const (
+	letsEncryptProductionHost = "acme-v02.api.letsencrypt.org"
+	letsEncryptStagingHost    = "acme-staging-v02.api.letsencrypt.org"
+)
+
+// acmeRateLimit is one of the Let's Encrypt rate limits which are estimated
+// from the orders and certificates observed by the ACME controllers.
+// https://letsencrypt.org/docs/rate-limits/
+type acmeRateLimit struct {
+	name        string
+	description string
+	window      time.Duration
+	production  int
+	staging     int
+}
+
+var (
+	acmeRateLimitNewOrders = acmeRateLimit{
+		name:        "new_orders_per_account",
+		description: "new orders",
+		window:      time.Hour * 3,
+		production:  300,
+		staging:     1500,
+	}
+	acmeRateLimitCertificatesPerDomain = acmeRateLimit{
+		name:        "certificates_per_registered_domain",
+		description: "certificates",
+		window:      time.Hour * 24 * 7,
+		production:  50,
+		staging:     30000,
+	}
+	acmeRateLimitDuplicateCertificates = acmeRateLimit{
+		name:        "duplicate_certificates",
+		description: "duplicate certificates",
+		window:      time.Hour * 24 * 7,
+		production:  5,
+		staging:     30000,
+	}
+)
+
+// max returns the limit enforced by the given ACME server, and false if the
+// server is not a Let's Encrypt server.
+func (l acmeRateLimit) max(server string) (int, bool) {
+	u, err := url.Parse(server)
+	if err != nil {
+		return 0, false
+	}
+	switch strings.ToLower(u.Hostname()) {
+	case letsEncryptProductionHost:
+		return l.production, true
+	case letsEncryptStagingHost:
+		return l.staging, true
+	}
+	return 0, false
+}
+
+// ACMERateLimitBudget is the estimated budget remaining of a Let's Encrypt
+// rate limit.
+type ACMERateLimitBudget struct {
+	// Limit is the name of the rate limit, as exposed in the
+	// acme_rate_limit_remaining metric.
+	Limit string
+
+	// Description describes what is counted against the rate limit.
+	Description string
+
+	// Key is the account URI or registered domain the rate limit applies to.
+	// For the duplicate certificates limit, it is the sorted list of names
+	// of the certificate.
+	Key string
+
+	// Window is the sliding window over which the rate limit is enforced.
+	Window time.Duration
+
+	// Used is the number of orders or certificates counted in the window.
+	Used int
+
+	// Max is the number of orders or certificates allowed in the window.
+	Max int
+}
+
+// Remaining returns the estimated number of orders or certificates that can
+// still be created in the current window.
+func (b ACMERateLimitBudget) Remaining() int {
+	if b.Used >= b.Max {
+		return 0
+	}
+	return b.Max - b.Used
+}
+
+// NearlyExhausted returns true if no more than a tenth of the budget remains.
+func (b ACMERateLimitBudget) NearlyExhausted() bool {
+	return b.Remaining()*10 <= b.Max
+}
+
+type acmeRateLimitKey struct {
+	server string
+	limit  acmeRateLimit
+	key    string
+}
+
+// acmeRateLimits records when each order or certificate counted against a
+// rate limit was created. Orders and certificates are identified so that they
+// are only counted once, no matter how often they are observed. The usage is
+// only held in memory, and is rebuilt from the existing Orders when the
+// controller is restarted.
+type acmeRateLimits struct {
+	lock  sync.Mutex
+	clock clock.Clock
+	desc  *prometheus.Desc
+	usage map[acmeRateLimitKey]map[string]time.Time
+}
+
+func newACMERateLimits(c clock.Clock) *acmeRateLimits {
+	return &acmeRateLimits{
+		clock: c,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "acme_rate_limit_remaining"),
+			"The estimated number of orders or certificates which can still be created before a Let's Encrypt rate limit is reached.",
+			[]string{"server", "limit", "key"},
+			nil,
+		),
+		usage: make(map[acmeRateLimitKey]map[string]time.Time),
+	}
+}
+
+// observe counts id against the rate limit at the given time, and returns the
+// updated budget. It returns nil if id had already been counted or if the
+// server is not a Let's Encrypt server.
+func (r *acmeRateLimits) observe(server string, limit acmeRateLimit, key, id string, at time.Time) *ACMERateLimitBudget {
+	max, ok := limit.max(server)
+	if !ok {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	k := acmeRateLimitKey{server: server, limit: limit, key: key}
+	r.prune(k)
+	if _, ok := r.usage[k][id]; ok {
+		return nil
+	}
+	// Anything which has already left the window does not need to be counted.
+	if !at.After(r.clock.Now().Add(-limit.window)) {
+		return nil
+	}
+	if r.usage[k] == nil {
+		r.usage[k] = make(map[string]time.Time)
+	}
+	r.usage[k][id] = at
+
+	return &ACMERateLimitBudget{
+		Limit:       limit.name,
+		Description: limit.description,
+		Key:         key,
+		Window:      limit.window,
+		Used:        len(r.usage[k]),
+		Max:         max,
+	}
+}
+
+// prune removes everything which has left the sliding window of k. It must
+// be called with the lock held.
+func (r *acmeRateLimits) prune(k acmeRateLimitKey) {
+	cutoff := r.clock.Now().Add(-k.limit.window)
+	for id, at := range r.usage[k] {
+		if !at.After(cutoff) {
+			delete(r.usage[k], id)
+		}
+	}
+	if len(r.usage[k]) == 0 {
+		delete(r.usage, k)
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (r *acmeRateLimits) Describe(ch chan<- *prometheus.Desc) {
+	ch <- r.desc
+}
+
+// Collect implements prometheus.Collector. The remaining budgets are computed
+// when collected so that they recover as orders and certificates leave their
+// sliding windows.
+func (r *acmeRateLimits) Collect(ch chan<- prometheus.Metric) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for k := range r.usage {
+		r.prune(k)
+	}
+	for k, ids := range r.usage {
+		max, _ := k.limit.max(k.server)
+		budget := ACMERateLimitBudget{Used: len(ids), Max: max}
+		ch <- prometheus.MustNewConstMetric(r.desc, prometheus.GaugeValue, float64(budget.Remaining()), k.server, k.limit.name, k.key)
+	}
+}
+
+// ObserveACMEOrder counts the ACME order with the given URL against the new
+// orders rate limit of the account. It returns the updated budget, or nil if
+// the order had already been counted or server is not a Let's Encrypt server.
+func (m *Metrics) ObserveACMEOrder(server, account, orderURL string, created time.Time) *ACMERateLimitBudget {
+	return m.acmeRateLimits.observe(server, acmeRateLimitNewOrders, account, orderURL, created)
+}
+
+// ObserveACMECertificate counts the certificate identified by id against the
+// certificates per registered domain and duplicate certificate rate limits.
+// It returns the updated budgets, which are empty if the certificate had
+// already been counted or server is not a Let's Encrypt server.
+func (m *Metrics) ObserveACMECertificate(server, id string, dnsNames []string, issued time.Time) []ACMERateLimitBudget {
+	names := sets.NewString()
+	domains := sets.NewString()
+	for _, name := range dnsNames {
+		name = strings.ToLower(name)
+		names.Insert(name)
+		domains.Insert(registeredDomain(name))
+	}
+	if names.Len() == 0 {
+		return nil
+	}
+
+	var budgets []ACMERateLimitBudget
+	for _, domain := range domains.List() {
+		if b := m.acmeRateLimits.observe(server, acmeRateLimitCertificatesPerDomain, domain, id, issued); b != nil {
+			budgets = append(budgets, *b)
+		}
+	}
+	if b := m.acmeRateLimits.observe(server, acmeRateLimitDuplicateCertificates, strings.Join(names.List(), ","), id, issued); b != nil {
+		budgets = append(budgets, *b)
+	}
+
+	sort.SliceStable(budgets, func(i, j int) bool {
+		return budgets[i].Remaining() < budgets[j].Remaining()
+	})
+	return budgets
+}
+
+// registeredDomain returns the domain that certificates for the given name
+// are counted against. Names which are not below a public suffix are returned
+// unchanged.
+func registeredDomain(name string) string {
+	domain, err := publicsuffix.EffectiveTLDPlusOne(strings.TrimPrefix(name, "*."))
+	if err != nil {
+		return name
+	}
+	return domain
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	logtesting "github.com/jetstack/cert-manager/pkg/logs/testing"
+)
+
+const acmeRateLimitRemainingMetadata = `
+	# HELP certmanager_acme_rate_limit_remaining The estimated number of orders or certificates which can still be created before a Let's Encrypt rate limit is reached.
+	# TYPE certmanager_acme_rate_limit_remaining gauge
+`
+
+const (
+	letsEncryptProduction = "https://acme-v02.api.letsencrypt.org/directory"
+	letsEncryptAccount    = "https://acme-v02.api.letsencrypt.org/acme/acct/1"
+)
+
+func TestACMERateLimitMetrics(t *testing.T) {
+	now := time.Now()
+	fakeClock := fakeclock.NewFakeClock(now)
+	m := New(logtesting.TestLogger{T: t}, fakeClock)
+
+	// Orders for ACME servers other than Let's Encrypt are not tracked.
+	if b := m.ObserveACMEOrder("https://acme.example.com/directory", "account", "order-0", now); b != nil {
+		t.Errorf("expected order for other ACME server not to be tracked, got %+v", b)
+	}
+
+	for i := 0; i < 270; i++ {
+		b := m.ObserveACMEOrder(letsEncryptProduction, letsEncryptAccount, fmt.Sprintf("order-%d", i), now.Add(-time.Hour))
+		if b == nil {
+			t.Fatalf("expected order %d to be counted", i)
+		}
+		if b.NearlyExhausted() != (i >= 269) {
+			t.Errorf("order %d: unexpected NearlyExhausted %t with %d remaining", i, b.NearlyExhausted(), b.Remaining())
+		}
+	}
+	// Observing the same order again does not count it twice.
+	if b := m.ObserveACMEOrder(letsEncryptProduction, letsEncryptAccount, "order-0", now); b != nil {
+		t.Errorf("expected order to only be counted once, got %+v", b)
+	}
+
+	dnsNames := []string{"a.example.com", "*.B.example.com", "example.org"}
+	budgets := m.ObserveACMECertificate(letsEncryptProduction, "order-0", dnsNames, now)
+	if len(budgets) != 3 {
+		t.Fatalf("expected 3 budgets, got %+v", budgets)
+	}
+	if budgets[0].Limit != "duplicate_certificates" || budgets[0].Remaining() != 4 {
+		t.Errorf("expected the duplicate certificates budget to be the most exhausted, got %+v", budgets[0])
+	}
+	if b := m.ObserveACMECertificate(letsEncryptProduction, "order-0", dnsNames, now); b != nil {
+		t.Errorf("expected certificate to only be counted once, got %+v", b)
+	}
+
+	if err := testutil.CollectAndCompare(m.acmeRateLimits,
+		strings.NewReader(acmeRateLimitRemainingMetadata+`
+	certmanager_acme_rate_limit_remaining{key="*.b.example.com,a.example.com,example.org",limit="duplicate_certificates",server="https://acme-v02.api.letsencrypt.org/directory"} 4
+	certmanager_acme_rate_limit_remaining{key="example.com",limit="certificates_per_registered_domain",server="https://acme-v02.api.letsencrypt.org/directory"} 49
+	certmanager_acme_rate_limit_remaining{key="example.org",limit="certificates_per_registered_domain",server="https://acme-v02.api.letsencrypt.org/directory"} 49
+	certmanager_acme_rate_limit_remaining{key="https://acme-v02.api.letsencrypt.org/acme/acct/1",limit="new_orders_per_account",server="https://acme-v02.api.letsencrypt.org/directory"} 30
+`),
+		"certmanager_acme_rate_limit_remaining",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	// Once the orders leave their sliding window, the budget is restored and
+	// the metric is no longer exposed.
+	fakeClock.Step(time.Hour * 2)
+	if err := testutil.CollectAndCompare(m.acmeRateLimits,
+		strings.NewReader(acmeRateLimitRemainingMetadata+`
+	certmanager_acme_rate_limit_remaining{key="*.b.example.com,a.example.com,example.org",limit="duplicate_certificates",server="https://acme-v02.api.letsencrypt.org/directory"} 4
+	certmanager_acme_rate_limit_remaining{key="example.com",limit="certificates_per_registered_domain",server="https://acme-v02.api.letsencrypt.org/directory"} 49
+	certmanager_acme_rate_limit_remaining{key="example.org",limit="certificates_per_registered_domain",server="https://acme-v02.api.letsencrypt.org/directory"} 49
+`),
+		"certmanager_acme_rate_limit_remaining",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	// Orders created before the start of the window are not counted.
+	if b := m.ObserveACMEOrder(letsEncryptProduction, letsEncryptAccount, "order-old", now.Add(-time.Hour)); b != nil {
+		t.Errorf("expected order outside the window not to be counted, got %+v", b)
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/jetstack/cert-manager/pkg/acme"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmacmelisters "github.com/jetstack/cert-manager/pkg/client/listers/acme/v1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+)
+
+const (
+	// AnalysisPath is the path on the metrics server that the certificate
+	// analysis is served on.
+	AnalysisPath = "/analysis"
+
+	// ReadinessPath is the path on the metrics server that the readiness
+	// probe is served on, if one has been set.
+	ReadinessPath = "/readyz"
+
+	// DefaultAnalysisExpiringWithin is the default window before their
+	// expiry within which Certificates are reported as nearing expiry.
+	DefaultAnalysisExpiringWithin = time.Hour * 24 * 7
+
+	// DefaultAnalysisOrderStuckAfter is the default time after which Orders
+	// which have not reached a final state are reported as stuck.
+	DefaultAnalysisOrderStuckAfter = time.Hour
+)
+
+// CertificateAnalysis is a summary of the Certificates and Orders which need
+// the attention of an operator.
+type CertificateAnalysis struct {
+	// GeneratedAt is the time at which the analysis was generated.
+	GeneratedAt metav1.Time `json:"generatedAt"`
+
+	// ExpiringCertificates are the Certificates which expire within the
+	// requested window.
+	ExpiringCertificates []CertificateSummary `json:"expiringCertificates"`
+
+	// FailedCertificates are the Certificates whose last issuance failed.
+	FailedCertificates []CertificateSummary `json:"failedCertificates"`
+
+	// StuckOrders are the ACME Orders which have not reached a final state
+	// within the requested time.
+	StuckOrders []OrderSummary `json:"stuckOrders"`
+}
+
+// CertificateSummary describes a single Certificate in a CertificateAnalysis.
+type CertificateSummary struct {
+	Namespace       string       `json:"namespace"`
+	Name            string       `json:"name"`
+	Ready           bool         `json:"ready"`
+	Message         string       `json:"message,omitempty"`
+	NotAfter        *metav1.Time `json:"notAfter,omitempty"`
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+}
+
+// OrderSummary describes a single ACME Order in a CertificateAnalysis.
+type OrderSummary struct {
+	Namespace         string      `json:"namespace"`
+	Name              string      `json:"name"`
+	State             string      `json:"state"`
+	Reason            string      `json:"reason,omitempty"`
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+}
+
+// analysisListers are the listers used to build the certificate analysis.
+type analysisListers struct {
+	certificates cmlisters.CertificateLister
+	orders       cmacmelisters.OrderLister
+	hasSynced    []cache.InformerSynced
+}
+
+// analysis guards the listers, which are set by the certificates-metrics
+// controller once it has been started after leader election.
+type analysis struct {
+	lock    sync.RWMutex
+	listers *analysisListers
+}
+
+// SetAnalysisListers sets the listers used to build the certificate analysis
+// served on AnalysisPath. The analysis is unavailable until the listers have
+// been set and all of the given informers have synced.
+func (m *Metrics) SetAnalysisListers(certificates cmlisters.CertificateLister, orders cmacmelisters.OrderLister, hasSynced ...cache.InformerSynced) {
+	m.analysis.lock.Lock()
+	defer m.analysis.lock.Unlock()
+	m.analysis.listers = &analysisListers{
+		certificates: certificates,
+		orders:       orders,
+		hasSynced:    hasSynced,
+	}
+}
+
+// Analyze builds a CertificateAnalysis. Certificates which expire within
+// expiringWithin, and Orders which have not reached a final state after
+// orderStuckAfter, are reported.
+func (m *Metrics) Analyze(expiringWithin, orderStuckAfter time.Duration) (*CertificateAnalysis, error) {
+	listers, err := m.syncedAnalysisListers("certificate analysis")
+	if err != nil {
+		return nil, err
+	}
+
+	now := m.clock.Now()
+	result := &CertificateAnalysis{
+		GeneratedAt:          metav1.NewTime(now),
+		ExpiringCertificates: []CertificateSummary{},
+		FailedCertificates:   []CertificateSummary{},
+		StuckOrders:          []OrderSummary{},
+	}
+
+	crts, err := listers.certificates.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, crt := range crts {
+		if crt.Status.NotAfter != nil && crt.Status.NotAfter.Time.Before(now.Add(expiringWithin)) {
+			result.ExpiringCertificates = append(result.ExpiringCertificates, summarizeCertificate(crt))
+		}
+		if crt.Status.LastFailureTime != nil {
+			result.FailedCertificates = append(result.FailedCertificates, summarizeCertificate(crt))
+		}
+	}
+
+	if listers.orders != nil {
+		orders, err := listers.orders.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range orders {
+			if acme.IsFinalState(order.Status.State) || now.Sub(order.CreationTimestamp.Time) < orderStuckAfter {
+				continue
+			}
+			result.StuckOrders = append(result.StuckOrders, OrderSummary{
+				Namespace:         order.Namespace,
+				Name:              order.Name,
+				State:             string(order.Status.State),
+				Reason:            order.Status.Reason,
+				CreationTimestamp: order.CreationTimestamp,
+			})
+		}
+	}
+
+	sortCertificateSummaries(result.ExpiringCertificates)
+	sortCertificateSummaries(result.FailedCertificates)
+	sort.Slice(result.StuckOrders, func(i, j int) bool {
+		a, b := result.StuckOrders[i], result.StuckOrders[j]
+		return a.Namespace < b.Namespace || (a.Namespace == b.Namespace && a.Name < b.Name)
+	})
+
+	return result, nil
+}
+
+// syncedAnalysisListers returns the listers set by SetAnalysisListers, or an
+// error describing why what cannot yet be built from them.
+func (m *Metrics) syncedAnalysisListers(what string) (*analysisListers, error) {
+	m.analysis.lock.RLock()
+	listers := m.analysis.listers
+	m.analysis.lock.RUnlock()
+
+	if listers == nil {
+		return nil, fmt.Errorf("%s is only available on the elected leader with the certificates-metrics controller enabled", what)
+	}
+	for _, hasSynced := range listers.hasSynced {
+		if !hasSynced() {
+			return nil, fmt.Errorf("%s is unavailable until the informer caches have synced", what)
+		}
+	}
+	return listers, nil
+}
+
+// analysisHandler serves the certificate analysis as JSON. The expiringWithin
+// and orderStuckAfter query parameters may be used to override the defaults.
+func (m *Metrics) analysisHandler(w http.ResponseWriter, r *http.Request) {
+	expiringWithin, err := durationParam(r, "expiringWithin", DefaultAnalysisExpiringWithin)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	orderStuckAfter, err := durationParam(r, "orderStuckAfter", DefaultAnalysisOrderStuckAfter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := m.Analyze(expiringWithin, orderStuckAfter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		m.log.Error(err, "failed to write certificate analysis")
+	}
+}
+
+func durationParam(r *http.Request, name string, def time.Duration) (time.Duration, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return d, nil
+}
+
+func summarizeCertificate(crt *cmapi.Certificate) CertificateSummary {
+	summary := CertificateSummary{
+		Namespace:       crt.Namespace,
+		Name:            crt.Name,
+		NotAfter:        crt.Status.NotAfter,
+		LastFailureTime: crt.Status.LastFailureTime,
+	}
+	for _, c := range crt.Status.Conditions {
+		if c.Type == cmapi.CertificateConditionReady {
+			summary.Ready = c.Status == cmmeta.ConditionTrue
+			summary.Message = c.Message
+		}
+	}
+	return summary
+}
+
+func sortCertificateSummaries(summaries []CertificateSummary) {
+	sort.Slice(summaries, func(i, j int) bool {
+		a, b := summaries[i], summaries[j]
+		return a.Namespace < b.Namespace || (a.Namespace == b.Namespace && a.Name < b.Name)
+	})
+}
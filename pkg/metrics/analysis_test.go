@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmacmelisters "github.com/jetstack/cert-manager/pkg/client/listers/acme/v1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	logtesting "github.com/jetstack/cert-manager/pkg/logs/testing"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestAnalysisHandler(t *testing.T) {
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	crtIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, crt := range []*cmapi.Certificate{
+		gen.Certificate("healthy",
+			gen.SetCertificateNamespace("ns"),
+			gen.SetCertificateNotAfter(metav1.NewTime(now.Add(time.Hour*24*60))),
+		),
+		gen.Certificate("expiring",
+			gen.SetCertificateNamespace("ns"),
+			gen.SetCertificateNotAfter(metav1.NewTime(now.Add(time.Hour*24))),
+			gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+				Type:    cmapi.CertificateConditionReady,
+				Status:  cmmeta.ConditionTrue,
+				Message: "Certificate is up to date and has not expired",
+			}),
+		),
+		gen.Certificate("failed",
+			gen.SetCertificateNamespace("ns"),
+			gen.SetCertificateLastFailureTime(metav1.NewTime(now.Add(-time.Minute))),
+		),
+	} {
+		if err := crtIndexer.Add(crt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	orderIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, order := range []*cmacme.Order{
+		withCreationTimestamp(gen.Order("pending-new", gen.SetOrderNamespace("ns"), gen.SetOrderState(cmacme.Pending)), now.Add(-time.Minute)),
+		withCreationTimestamp(gen.Order("pending-old", gen.SetOrderNamespace("ns"), gen.SetOrderState(cmacme.Pending)), now.Add(-time.Hour*2)),
+		withCreationTimestamp(gen.Order("valid-old", gen.SetOrderNamespace("ns"), gen.SetOrderState(cmacme.Valid)), now.Add(-time.Hour*2)),
+	} {
+		if err := orderIndexer.Add(order); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	synced := true
+	m := New(logtesting.TestLogger{T: t}, fakeclock.NewFakeClock(now))
+
+	serve := func(url string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		m.analysisHandler(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		return rec
+	}
+
+	if rec := serve(AnalysisPath); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected %d before the listers are set, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+
+	m.SetAnalysisListers(cmlisters.NewCertificateLister(crtIndexer), cmacmelisters.NewOrderLister(orderIndexer),
+		func() bool { return synced })
+
+	synced = false
+	if rec := serve(AnalysisPath); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected %d before the informers have synced, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+	synced = true
+
+	if rec := serve(AnalysisPath + "?expiringWithin=never"); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected %d for an invalid duration, got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	tests := map[string]struct {
+		url string
+
+		expExpiring, expFailed, expStuck []string
+	}{
+		"defaults report certificates expiring within a week and orders pending for an hour": {
+			url:         AnalysisPath,
+			expExpiring: []string{"expiring"},
+			expFailed:   []string{"failed"},
+			expStuck:    []string{"pending-old"},
+		},
+		"query parameters override the defaults": {
+			url:         AnalysisPath + "?expiringWithin=2160h&orderStuckAfter=30s",
+			expExpiring: []string{"expiring", "healthy"},
+			expFailed:   []string{"failed"},
+			expStuck:    []string{"pending-new", "pending-old"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := serve(test.url)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("unexpected status code %d: %s", rec.Code, rec.Body.String())
+			}
+
+			var analysis CertificateAnalysis
+			if err := json.Unmarshal(rec.Body.Bytes(), &analysis); err != nil {
+				t.Fatal(err)
+			}
+
+			var expiring, failed, stuck []string
+			for _, c := range analysis.ExpiringCertificates {
+				expiring = append(expiring, c.Name)
+			}
+			for _, c := range analysis.FailedCertificates {
+				failed = append(failed, c.Name)
+			}
+			for _, o := range analysis.StuckOrders {
+				stuck = append(stuck, o.Name)
+			}
+			assertNames(t, "expiring certificates", test.expExpiring, expiring)
+			assertNames(t, "failed certificates", test.expFailed, failed)
+			assertNames(t, "stuck orders", test.expStuck, stuck)
+		})
+	}
+}
+
+func withCreationTimestamp(order *cmacme.Order, t time.Time) *cmacme.Order {
+	order.CreationTimestamp = metav1.NewTime(t)
+	return order
+}
+
+func assertNames(t *testing.T, what string, exp, got []string) {
+	t.Helper()
+	if len(exp) != len(got) {
+		t.Errorf("unexpected %s, exp=%v got=%v", what, exp, got)
+		return
+	}
+	for i := range exp {
+		if exp[i] != got[i] {
+			t.Errorf("unexpected %s, exp=%v got=%v", what, exp, got)
+			return
+		}
+	}
+}
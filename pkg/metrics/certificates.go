@@ -18,9 +18,12 @@ limitations under the License.
 // cert-manager exposes the following metrics:
 // certificate_expiration_timestamp_seconds{name, namespace}
 // certificate_ready_status{name, namespace, condition}
+// certificate_revoked_count{name, namespace}
 // acme_client_request_count{"scheme", "host", "path", "method", "status"}
 // acme_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // controller_sync_call_count{"controller"}
+// issuance_quota_used{name, namespace, resource}
+// issuance_quota_limit{name, namespace, resource}
 package metrics
 
 import (
@@ -90,6 +93,14 @@ func (m *Metrics) updateCertificateReadyStatus(crt *cmapi.Certificate, current c
 	}
 }
 
+// IncrementCertificateRevokedCount will increase the count of times that
+// Certificate has been found to be revoked.
+func (m *Metrics) IncrementCertificateRevokedCount(crt *cmapi.Certificate) {
+	m.certificateRevokedCount.With(prometheus.Labels{
+		"name":      crt.Name,
+		"namespace": crt.Namespace}).Inc()
+}
+
 // RemoveCertificate will delete the Certificate metrics from continuing to be
 // exposed.
 func (m *Metrics) RemoveCertificate(key string) {
@@ -100,6 +111,7 @@ func (m *Metrics) RemoveCertificate(key string) {
 	}
 
 	m.certificateExpiryTimeSeconds.DeleteLabelValues(name, namespace)
+	m.certificateRevokedCount.DeleteLabelValues(name, namespace)
 	for _, condition := range readyConditionStatuses {
 		m.certificateReadyStatus.DeleteLabelValues(name, namespace, string(condition))
 	}
@@ -255,3 +255,31 @@ func TestCertificateCache(t *testing.T) {
 		t.Errorf("unexpected collecting result:\n%s", err)
 	}
 }
+
+func TestCertificateRevokedCount(t *testing.T) {
+	const revokedMetadata = `
+	# HELP certmanager_certificate_revoked_count The number of times the certificate has been found to be revoked by its issuer's OCSP responder.
+	# TYPE certmanager_certificate_revoked_count counter
+`
+	m := New(logtesting.TestLogger{T: t}, clock.RealClock{})
+
+	crt := gen.Certificate("crt1")
+	m.IncrementCertificateRevokedCount(crt)
+	m.IncrementCertificateRevokedCount(crt)
+	if err := testutil.CollectAndCompare(m.certificateRevokedCount,
+		strings.NewReader(revokedMetadata+`
+        certmanager_certificate_revoked_count{name="crt1",namespace="default-unit-test-ns"} 2
+`),
+		"certmanager_certificate_revoked_count",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	m.RemoveCertificate("default-unit-test-ns/crt1")
+	if err := testutil.CollectAndCompare(m.certificateRevokedCount,
+		strings.NewReader(revokedMetadata),
+		"certmanager_certificate_revoked_count",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+)
+
+const (
+	// InventoryPath is the path on the metrics server that the certificate
+	// inventory is served on.
+	InventoryPath = "/inventory"
+
+	// InventoryFormatJSON and InventoryFormatCSV are the values of the format
+	// query parameter accepted on InventoryPath.
+	InventoryFormatJSON = "json"
+	InventoryFormatCSV  = "csv"
+)
+
+// CertificateInventory is a snapshot of every Certificate managed by
+// cert-manager.
+type CertificateInventory struct {
+	// GeneratedAt is the time at which the inventory was generated.
+	GeneratedAt metav1.Time `json:"generatedAt"`
+
+	// Certificates are the entries of the inventory, sorted by namespace and
+	// name.
+	Certificates []InventoryEntry `json:"certificates"`
+}
+
+// InventoryEntry describes a single Certificate in a CertificateInventory.
+type InventoryEntry struct {
+	Namespace      string                 `json:"namespace"`
+	Name           string                 `json:"name"`
+	IssuerRef      cmmeta.ObjectReference `json:"issuerRef"`
+	SecretName     string                 `json:"secretName"`
+	CommonName     string                 `json:"commonName,omitempty"`
+	DNSNames       []string               `json:"dnsNames,omitempty"`
+	IPAddresses    []string               `json:"ipAddresses,omitempty"`
+	URIs           []string               `json:"uris,omitempty"`
+	EmailAddresses []string               `json:"emailAddresses,omitempty"`
+	KeyAlgorithm   string                 `json:"keyAlgorithm"`
+	KeySize        int                    `json:"keySize,omitempty"`
+	Ready          bool                   `json:"ready"`
+	NotAfter       *metav1.Time           `json:"notAfter,omitempty"`
+	Revision       *int                   `json:"revision,omitempty"`
+}
+
+// inventoryCSVHeader is the header row of the CSV encoding of an inventory.
+// Multi-valued columns are joined with spaces.
+var inventoryCSVHeader = []string{
+	"namespace", "name", "issuerName", "issuerKind", "issuerGroup", "secretName",
+	"commonName", "dnsNames", "ipAddresses", "uris", "emailAddresses",
+	"keyAlgorithm", "keySize", "ready", "notAfter", "revision",
+}
+
+// Inventory builds a CertificateInventory of every Certificate in the
+// cluster.
+func (m *Metrics) Inventory() (*CertificateInventory, error) {
+	listers, err := m.syncedAnalysisListers("certificate inventory")
+	if err != nil {
+		return nil, err
+	}
+
+	crts, err := listers.certificates.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CertificateInventory{
+		GeneratedAt:  metav1.NewTime(m.clock.Now()),
+		Certificates: make([]InventoryEntry, 0, len(crts)),
+	}
+	for _, crt := range crts {
+		result.Certificates = append(result.Certificates, inventoryEntry(crt))
+	}
+	sortInventoryEntries(result.Certificates)
+
+	return result, nil
+}
+
+// inventoryHandler serves the certificate inventory as JSON, or as CSV if
+// the format query parameter is set to csv.
+func (m *Metrics) inventoryHandler(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = InventoryFormatJSON
+	}
+	if format != InventoryFormatJSON && format != InventoryFormatCSV {
+		http.Error(w, fmt.Sprintf("invalid format %q, must be one of %q or %q", format, InventoryFormatJSON, InventoryFormatCSV), http.StatusBadRequest)
+		return
+	}
+
+	result, err := m.Inventory()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	if format == InventoryFormatCSV {
+		w.Header().Set("Content-Type", "text/csv")
+		err = writeInventoryCSV(w, result)
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(result)
+	}
+	if err != nil {
+		m.log.Error(err, "failed to write certificate inventory")
+	}
+}
+
+func inventoryEntry(crt *cmapi.Certificate) InventoryEntry {
+	entry := InventoryEntry{
+		Namespace:      crt.Namespace,
+		Name:           crt.Name,
+		IssuerRef:      crt.Spec.IssuerRef,
+		SecretName:     crt.Spec.SecretName,
+		CommonName:     crt.Spec.CommonName,
+		DNSNames:       crt.Spec.DNSNames,
+		IPAddresses:    crt.Spec.IPAddresses,
+		URIs:           crt.Spec.URIs,
+		EmailAddresses: crt.Spec.EmailAddresses,
+		KeyAlgorithm:   string(cmapi.RSAKeyAlgorithm),
+		NotAfter:       crt.Status.NotAfter,
+		Revision:       crt.Status.Revision,
+	}
+	if pk := crt.Spec.PrivateKey; pk != nil {
+		if pk.Algorithm != "" {
+			entry.KeyAlgorithm = string(pk.Algorithm)
+		}
+		entry.KeySize = pk.Size
+	}
+	for _, c := range crt.Status.Conditions {
+		if c.Type == cmapi.CertificateConditionReady {
+			entry.Ready = c.Status == cmmeta.ConditionTrue
+		}
+	}
+	return entry
+}
+
+func writeInventoryCSV(w io.Writer, inventory *CertificateInventory) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(inventoryCSVHeader); err != nil {
+		return err
+	}
+	for _, e := range inventory.Certificates {
+		var keySize, notAfter, revision string
+		if e.KeySize != 0 {
+			keySize = strconv.Itoa(e.KeySize)
+		}
+		if e.NotAfter != nil {
+			notAfter = e.NotAfter.UTC().Format(time.RFC3339)
+		}
+		if e.Revision != nil {
+			revision = strconv.Itoa(*e.Revision)
+		}
+		if err := cw.Write([]string{
+			e.Namespace, e.Name, e.IssuerRef.Name, e.IssuerRef.Kind, e.IssuerRef.Group, e.SecretName,
+			e.CommonName, strings.Join(e.DNSNames, " "), strings.Join(e.IPAddresses, " "), strings.Join(e.URIs, " "), strings.Join(e.EmailAddresses, " "),
+			e.KeyAlgorithm, keySize, strconv.FormatBool(e.Ready), notAfter, revision,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func sortInventoryEntries(entries []InventoryEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		return a.Namespace < b.Namespace || (a.Namespace == b.Namespace && a.Name < b.Name)
+	})
+}
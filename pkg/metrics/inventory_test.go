@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	logtesting "github.com/jetstack/cert-manager/pkg/logs/testing"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestInventoryHandler(t *testing.T) {
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	crtIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, crt := range []*cmapi.Certificate{
+		gen.Certificate("web",
+			gen.SetCertificateNamespace("ns-b"),
+			gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca", Kind: "ClusterIssuer", Group: "cert-manager.io"}),
+			gen.SetCertificateSecretName("web-tls"),
+			gen.SetCertificateDNSNames("example.com", "www.example.com"),
+			gen.SetCertificateKeyAlgorithm(cmapi.ECDSAKeyAlgorithm),
+			gen.SetCertificateKeySize(256),
+			gen.SetCertificateNotAfter(metav1.NewTime(now.Add(time.Hour*24*60))),
+			gen.SetCertificateRevision(3),
+			gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+				Type:   cmapi.CertificateConditionReady,
+				Status: cmmeta.ConditionTrue,
+			}),
+		),
+		gen.Certificate("pending",
+			gen.SetCertificateNamespace("ns-a"),
+			gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "acme"}),
+			gen.SetCertificateSecretName("pending-tls"),
+			gen.SetCertificateURIs("spiffe://example.com/pending"),
+		),
+	} {
+		if err := crtIndexer.Add(crt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := New(logtesting.TestLogger{T: t}, fakeclock.NewFakeClock(now))
+
+	serve := func(url string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		m.inventoryHandler(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		return rec
+	}
+
+	if rec := serve(InventoryPath); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected %d before the listers are set, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+
+	m.SetAnalysisListers(cmlisters.NewCertificateLister(crtIndexer), nil, func() bool { return true })
+
+	if rec := serve(InventoryPath + "?format=xml"); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected %d for an invalid format, got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	t.Run("json", func(t *testing.T) {
+		rec := serve(InventoryPath)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected status code %d: %s", rec.Code, rec.Body.String())
+		}
+
+		var inventory CertificateInventory
+		if err := json.Unmarshal(rec.Body.Bytes(), &inventory); err != nil {
+			t.Fatal(err)
+		}
+		if len(inventory.Certificates) != 2 {
+			t.Fatalf("expected 2 certificates, got %d", len(inventory.Certificates))
+		}
+
+		pending, web := inventory.Certificates[0], inventory.Certificates[1]
+		if pending.Name != "pending" || web.Name != "web" {
+			t.Fatalf("expected certificates sorted by namespace, got %q, %q", pending.Name, web.Name)
+		}
+		if pending.KeyAlgorithm != string(cmapi.RSAKeyAlgorithm) || pending.Ready || pending.NotAfter != nil {
+			t.Errorf("unexpected entry for pending certificate: %+v", pending)
+		}
+		if web.KeyAlgorithm != string(cmapi.ECDSAKeyAlgorithm) || web.KeySize != 256 || !web.Ready ||
+			web.Revision == nil || *web.Revision != 3 || web.IssuerRef.Kind != "ClusterIssuer" ||
+			!reflect.DeepEqual(web.DNSNames, []string{"example.com", "www.example.com"}) {
+			t.Errorf("unexpected entry for web certificate: %+v", web)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		rec := serve(InventoryPath + "?format=csv")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected status code %d: %s", rec.Code, rec.Body.String())
+		}
+
+		records, err := csv.NewReader(rec.Body).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := [][]string{
+			inventoryCSVHeader,
+			{"ns-a", "pending", "acme", "", "", "pending-tls", "", "", "", "spiffe://example.com/pending", "", "RSA", "", "false", "", ""},
+			{"ns-b", "web", "ca", "ClusterIssuer", "cert-manager.io", "web-tls", "", "example.com www.example.com", "", "", "", "ECDSA", "256", "true", "2021-11-30T00:00:00Z", "3"},
+		}
+		if !reflect.DeepEqual(exp, records) {
+			t.Errorf("unexpected csv records, exp=%v got=%v", exp, records)
+		}
+	})
+}
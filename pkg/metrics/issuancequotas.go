@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	quotaResourceCertificates        = "certificates"
+	quotaResourceCertificateRequests = "certificaterequests"
+)
+
+// UpdateIssuanceQuota will update the usage and limit metrics of that
+// IssuanceQuota from its spec and status. Limits which are not set are not
+// exposed.
+func (m *Metrics) UpdateIssuanceQuota(quota *cmapi.IssuanceQuota) {
+	m.updateIssuanceQuotaResource(quota, quotaResourceCertificates, quota.Status.Certificates, quota.Spec.MaxCertificates)
+	m.updateIssuanceQuotaResource(quota, quotaResourceCertificateRequests, quota.Status.CertificateRequests, quota.Spec.MaxCertificateRequests)
+}
+
+func (m *Metrics) updateIssuanceQuotaResource(quota *cmapi.IssuanceQuota, resource string, used int32, limit *int32) {
+	labels := prometheus.Labels{
+		"name":      quota.Name,
+		"namespace": quota.Namespace,
+		"resource":  resource,
+	}
+
+	m.issuanceQuotaUsed.With(labels).Set(float64(used))
+	if limit == nil {
+		m.issuanceQuotaLimit.Delete(labels)
+		return
+	}
+	m.issuanceQuotaLimit.With(labels).Set(float64(*limit))
+}
+
+// RemoveIssuanceQuota will delete the IssuanceQuota metrics from continuing
+// to be exposed.
+func (m *Metrics) RemoveIssuanceQuota(key string) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		m.log.Error(err, "failed to get namespace and name from key")
+		return
+	}
+
+	for _, resource := range []string{quotaResourceCertificates, quotaResourceCertificateRequests} {
+		m.issuanceQuotaUsed.DeleteLabelValues(name, namespace, resource)
+		m.issuanceQuotaLimit.DeleteLabelValues(name, namespace, resource)
+	}
+}
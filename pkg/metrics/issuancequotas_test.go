@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	logtesting "github.com/jetstack/cert-manager/pkg/logs/testing"
+)
+
+const quotaUsedMetadata = `
+	# HELP certmanager_issuance_quota_used The amount of a resource counted against the issuance quota.
+	# TYPE certmanager_issuance_quota_used gauge
+`
+
+const quotaLimitMetadata = `
+	# HELP certmanager_issuance_quota_limit The limit of a resource set by the issuance quota.
+	# TYPE certmanager_issuance_quota_limit gauge
+`
+
+func TestIssuanceQuotaMetrics(t *testing.T) {
+	m := New(logtesting.TestLogger{T: t}, clock.RealClock{})
+
+	quota := &cmapi.IssuanceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "test-ns"},
+		Spec:       cmapi.IssuanceQuotaSpec{MaxCertificates: pointer.Int32Ptr(10)},
+		Status:     cmapi.IssuanceQuotaStatus{Certificates: 3, CertificateRequests: 5},
+	}
+	m.UpdateIssuanceQuota(quota)
+
+	if err := testutil.CollectAndCompare(m.issuanceQuotaUsed,
+		strings.NewReader(quotaUsedMetadata+`
+	certmanager_issuance_quota_used{name="quota",namespace="test-ns",resource="certificaterequests"} 5
+	certmanager_issuance_quota_used{name="quota",namespace="test-ns",resource="certificates"} 3
+`),
+		"certmanager_issuance_quota_used",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	// The CertificateRequests limit is not set so should not be exposed
+	if err := testutil.CollectAndCompare(m.issuanceQuotaLimit,
+		strings.NewReader(quotaLimitMetadata+`
+	certmanager_issuance_quota_limit{name="quota",namespace="test-ns",resource="certificates"} 10
+`),
+		"certmanager_issuance_quota_limit",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	m.RemoveIssuanceQuota("test-ns/quota")
+
+	if n := testutil.CollectAndCount(m.issuanceQuotaUsed); n != 0 {
+		t.Errorf("expected no issuance_quota_used metrics after removal, got %d", n)
+	}
+	if n := testutil.CollectAndCount(m.issuanceQuotaLimit); n != 0 {
+		t.Errorf("expected no issuance_quota_limit metrics after removal, got %d", n)
+	}
+}
@@ -18,9 +18,21 @@ limitations under the License.
 // cert-manager exposes the following metrics:
 // certificate_expiration_timestamp_seconds{name, namespace}
 // certificate_ready_status{name, namespace, condition}
+// certificate_revoked_count{name, namespace}
 // acme_client_request_count{"scheme", "host", "path", "method", "status"}
 // acme_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // controller_sync_call_count{"controller"}
+// controller_queue_depth{"controller", "priority"}
+// controller_queue_retries_total{"controller"}
+// controller_reconcile_total{"controller", "result"}
+// controller_reconcile_duration_seconds{"controller"}
+// controller_longest_running_processor_seconds{"controller"}
+// issuance_quota_used{name, namespace, resource}
+// issuance_quota_limit{name, namespace, resource}
+// acme_rate_limit_remaining{server, limit, key}
+// A JSON summary of Certificates nearing expiry or failing issuance, and of
+// stuck ACME Orders, is also served on /analysis, and an inventory of every
+// Certificate is served as JSON or CSV on /inventory.
 package metrics
 
 import (
@@ -36,6 +48,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util/profiling"
 )
 
@@ -52,13 +65,27 @@ const (
 type Metrics struct {
 	log      logr.Logger
 	registry *prometheus.Registry
+	clock    clock.Clock
+	analysis analysis
+
+	// readiness is served on ReadinessPath if set.
+	readiness http.Handler
 
 	clockTimeSeconds                 prometheus.CounterFunc
 	certificateExpiryTimeSeconds     *prometheus.GaugeVec
 	certificateReadyStatus           *prometheus.GaugeVec
+	certificateRevokedCount          *prometheus.CounterVec
 	acmeClientRequestDurationSeconds *prometheus.SummaryVec
 	acmeClientRequestCount           *prometheus.CounterVec
 	controllerSyncCallCount          *prometheus.CounterVec
+	controllerQueueDepth             *prometheus.GaugeVec
+	controllerQueueRetries           *prometheus.CounterVec
+	controllerReconcileTotal         *prometheus.CounterVec
+	controllerReconcileDuration      *prometheus.HistogramVec
+	controllerLongestRunning         *prometheus.GaugeVec
+	issuanceQuotaUsed                *prometheus.GaugeVec
+	issuanceQuotaLimit               *prometheus.GaugeVec
+	acmeRateLimits                   *acmeRateLimits
 }
 
 var readyConditionStatuses = [...]cmmeta.ConditionStatus{cmmeta.ConditionTrue, cmmeta.ConditionFalse, cmmeta.ConditionUnknown}
@@ -94,6 +121,15 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			[]string{"name", "namespace", "condition"},
 		)
 
+		certificateRevokedCount = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "certificate_revoked_count",
+				Help:      "The number of times the certificate has been found to be revoked by its issuer's OCSP responder.",
+			},
+			[]string{"name", "namespace"},
+		)
+
 		// acmeClientRequestCount is a Prometheus summary to collect the number of
 		// requests made to each endpoint with the ACME client.
 		acmeClientRequestCount = prometheus.NewCounterVec(
@@ -127,38 +163,129 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			},
 			[]string{"controller"},
 		)
+
+		controllerQueueDepth = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "controller_queue_depth",
+				Help:      "The number of items waiting to be processed by a controller, by priority.",
+			},
+			[]string{"controller", "priority"},
+		)
+
+		controllerQueueRetries = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "controller_queue_retries_total",
+				Help:      "The number of items re-queued by a controller after failing to be processed.",
+			},
+			[]string{"controller"},
+		)
+
+		controllerReconcileTotal = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "controller_reconcile_total",
+				Help:      "The number of items processed by a controller, by result.",
+			},
+			[]string{"controller", "result"},
+		)
+
+		controllerReconcileDuration = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "controller_reconcile_duration_seconds",
+				Help:      "The time taken by a controller to process an item.",
+				Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+			},
+			[]string{"controller"},
+		)
+
+		controllerLongestRunning = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "controller_longest_running_processor_seconds",
+				Help:      "How long the longest running item currently being processed by a controller has been running.",
+			},
+			[]string{"controller"},
+		)
+
+		issuanceQuotaUsed = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "issuance_quota_used",
+				Help:      "The amount of a resource counted against the issuance quota.",
+			},
+			[]string{"name", "namespace", "resource"},
+		)
+
+		issuanceQuotaLimit = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "issuance_quota_limit",
+				Help:      "The limit of a resource set by the issuance quota.",
+			},
+			[]string{"name", "namespace", "resource"},
+		)
 	)
 
 	// Create server and register Prometheus metrics handler
 	m := &Metrics{
 		log:      log.WithName("metrics"),
 		registry: prometheus.NewRegistry(),
+		clock:    c,
 
 		clockTimeSeconds:                 clockTimeSeconds,
 		certificateExpiryTimeSeconds:     certificateExpiryTimeSeconds,
 		certificateReadyStatus:           certificateReadyStatus,
+		certificateRevokedCount:          certificateRevokedCount,
 		acmeClientRequestCount:           acmeClientRequestCount,
 		acmeClientRequestDurationSeconds: acmeClientRequestDurationSeconds,
 		controllerSyncCallCount:          controllerSyncCallCount,
+		controllerQueueDepth:             controllerQueueDepth,
+		controllerQueueRetries:           controllerQueueRetries,
+		controllerReconcileTotal:         controllerReconcileTotal,
+		controllerReconcileDuration:      controllerReconcileDuration,
+		controllerLongestRunning:         controllerLongestRunning,
+		issuanceQuotaUsed:                issuanceQuotaUsed,
+		issuanceQuotaLimit:               issuanceQuotaLimit,
+		acmeRateLimits:                   newACMERateLimits(c),
 	}
 
 	return m
 }
 
 // Start will register the Prometheus metrics, and start the Prometheus server
-func (m *Metrics) NewServer(ln net.Listener, enablePprof bool) *http.Server {
+func (m *Metrics) NewServer(ln net.Listener, enablePprof, enableLogLevels bool) *http.Server {
 	m.registry.MustRegister(m.clockTimeSeconds)
 	m.registry.MustRegister(m.certificateExpiryTimeSeconds)
 	m.registry.MustRegister(m.certificateReadyStatus)
+	m.registry.MustRegister(m.certificateRevokedCount)
 	m.registry.MustRegister(m.acmeClientRequestDurationSeconds)
 	m.registry.MustRegister(m.acmeClientRequestCount)
 	m.registry.MustRegister(m.controllerSyncCallCount)
+	m.registry.MustRegister(m.controllerQueueDepth)
+	m.registry.MustRegister(m.controllerQueueRetries)
+	m.registry.MustRegister(m.controllerReconcileTotal)
+	m.registry.MustRegister(m.controllerReconcileDuration)
+	m.registry.MustRegister(m.controllerLongestRunning)
+	m.registry.MustRegister(m.issuanceQuotaUsed)
+	m.registry.MustRegister(m.issuanceQuotaLimit)
+	m.registry.MustRegister(m.acmeRateLimits)
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc(AnalysisPath, m.analysisHandler)
+	mux.HandleFunc(InventoryPath, m.inventoryHandler)
+	if m.readiness != nil {
+		mux.Handle(ReadinessPath, m.readiness)
+	}
 	if enablePprof {
 		profiling.Install(mux)
 	}
+	if enableLogLevels {
+		mux.Handle(logf.LevelPath, logf.LevelHandler())
+	}
 
 	server := &http.Server{
 		Addr:           ln.Addr().String(),
@@ -170,7 +297,42 @@ func (m *Metrics) NewServer(ln net.Listener, enablePprof bool) *http.Server {
 	return server
 }
 
+// SetReadinessProbe sets the handler served on ReadinessPath. It must be
+// called before NewServer.
+func (m *Metrics) SetReadinessProbe(h http.Handler) {
+	m.readiness = h
+}
+
 // IncrementSyncCallCount will increase the sync counter for that controller.
 func (m *Metrics) IncrementSyncCallCount(controllerName string) {
 	m.controllerSyncCallCount.WithLabelValues(controllerName).Inc()
 }
+
+// SetControllerQueueDepth will set the number of items waiting to be
+// processed with the given priority by that controller.
+func (m *Metrics) SetControllerQueueDepth(controllerName, priority string, depth int) {
+	m.controllerQueueDepth.WithLabelValues(controllerName, priority).Set(float64(depth))
+}
+
+// IncrementQueueRetries will increase the number of items re-queued by that
+// controller after failing to be processed.
+func (m *Metrics) IncrementQueueRetries(controllerName string) {
+	m.controllerQueueRetries.WithLabelValues(controllerName).Inc()
+}
+
+// ObserveReconcile will record the duration and result of that controller
+// processing an item.
+func (m *Metrics) ObserveReconcile(controllerName string, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	m.controllerReconcileTotal.WithLabelValues(controllerName, result).Inc()
+	m.controllerReconcileDuration.WithLabelValues(controllerName).Observe(duration.Seconds())
+}
+
+// SetLongestRunningProcessor will set how long the longest running item
+// currently being processed by that controller has been running.
+func (m *Metrics) SetLongestRunningProcessor(controllerName string, duration time.Duration) {
+	m.controllerLongestRunning.WithLabelValues(controllerName).Set(duration.Seconds())
+}
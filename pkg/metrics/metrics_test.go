@@ -17,6 +17,7 @@ limitations under the License.
 package metrics
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -58,3 +59,67 @@ func TestClockMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerQueueDepthMetrics(t *testing.T) {
+	m := New(logtesting.TestLogger{T: t}, fixedClock)
+	m.SetControllerQueueDepth("test-controller", "high", 2)
+	m.SetControllerQueueDepth("test-controller", "normal", 5)
+
+	if err := testutil.CollectAndCompare(m.controllerQueueDepth,
+		strings.NewReader(`
+	# HELP certmanager_controller_queue_depth The number of items waiting to be processed by a controller, by priority.
+	# TYPE certmanager_controller_queue_depth gauge
+	certmanager_controller_queue_depth{controller="test-controller",priority="high"} 2
+	certmanager_controller_queue_depth{controller="test-controller",priority="normal"} 5
+`),
+		"certmanager_controller_queue_depth",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestControllerReconcileMetrics(t *testing.T) {
+	m := New(logtesting.TestLogger{T: t}, fixedClock)
+	m.ObserveReconcile("test-controller", time.Millisecond*20, nil)
+	m.ObserveReconcile("test-controller", time.Second*3, errors.New("failed"))
+	m.IncrementQueueRetries("test-controller")
+	m.SetLongestRunningProcessor("test-controller", time.Second*90)
+
+	if err := testutil.CollectAndCompare(m.controllerReconcileTotal,
+		strings.NewReader(`
+	# HELP certmanager_controller_reconcile_total The number of items processed by a controller, by result.
+	# TYPE certmanager_controller_reconcile_total counter
+	certmanager_controller_reconcile_total{controller="test-controller",result="error"} 1
+	certmanager_controller_reconcile_total{controller="test-controller",result="success"} 1
+`),
+		"certmanager_controller_reconcile_total",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	if err := testutil.CollectAndCompare(m.controllerQueueRetries,
+		strings.NewReader(`
+	# HELP certmanager_controller_queue_retries_total The number of items re-queued by a controller after failing to be processed.
+	# TYPE certmanager_controller_queue_retries_total counter
+	certmanager_controller_queue_retries_total{controller="test-controller"} 1
+`),
+		"certmanager_controller_queue_retries_total",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	if err := testutil.CollectAndCompare(m.controllerLongestRunning,
+		strings.NewReader(`
+	# HELP certmanager_controller_longest_running_processor_seconds How long the longest running item currently being processed by a controller has been running.
+	# TYPE certmanager_controller_longest_running_processor_seconds gauge
+	certmanager_controller_longest_running_processor_seconds{controller="test-controller"} 90
+`),
+		"certmanager_controller_longest_running_processor_seconds",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	if n := testutil.CollectAndCount(m.controllerReconcileDuration); n != 1 {
+		t.Errorf("expected a single reconcile duration histogram, got %d", n)
+	}
+}
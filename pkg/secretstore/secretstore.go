@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretstore defines the external secret store backends which the
+// issuing controller writes the data issued for Certificates to, in addition
+// to the Secret named by their spec.secretName.
+// Backends register themselves with Register, and are selected using the
+// controller's --secret-store flag.
+package secretstore
+
+import (
+	"context"
+
+	"github.com/spf13/pflag"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Data is the private key, certificate and CA data issued for a Certificate.
+type Data struct {
+	PrivateKey, Certificate, CA []byte
+}
+
+// Store stores the data issued for Certificates in an external secret store.
+type Store interface {
+	// Put stores the given data for the Certificate, replacing any data
+	// previously stored for it.
+	Put(ctx context.Context, crt *cmapi.Certificate, data Data) error
+}
+
+// Backend is a secret store backend which can be selected using the
+// controller's --secret-store flag.
+type Backend interface {
+	// AddFlags adds the flags which configure the backend to the given flag
+	// set. Flag names should be prefixed with the name of the backend.
+	AddFlags(fs *pflag.FlagSet)
+
+	// New returns a Store configured by the backend's flags.
+	New() (Store, error)
+}
+
+var (
+	known = make(map[string]Backend)
+)
+
+// Known returns a map of the registered secret store backends
+func Known() map[string]Backend {
+	return known
+}
+
+// Register registers a secret store backend with the secretstore package
+func Register(name string, b Backend) {
+	known[name] = b
+}
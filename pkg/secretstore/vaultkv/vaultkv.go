@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vaultkv implements a secret store backend which writes the data
+// issued for Certificates to a HashiCorp Vault KV secrets engine.
+package vaultkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/secretstore"
+)
+
+// BackendName is the name the Vault KV backend is registered with, and which
+// is selected using the controller's --secret-store flag.
+const BackendName = "vault-kv"
+
+func init() {
+	secretstore.Register(BackendName, &backend{})
+}
+
+// backend holds the flags which configure the Vault KV secret store.
+type backend struct {
+	address    string
+	namespace  string
+	tokenFile  string
+	mount      string
+	pathPrefix string
+	kvVersion  int
+}
+
+func (b *backend) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&b.address, "vault-kv-secret-store-address", "", ""+
+		"The address of the Vault server that the '"+BackendName+"' secret store writes to.")
+	fs.StringVar(&b.namespace, "vault-kv-secret-store-namespace", "", ""+
+		"The Vault Enterprise namespace that the '"+BackendName+"' secret store writes to.")
+	fs.StringVar(&b.tokenFile, "vault-kv-secret-store-token-file", "", ""+
+		"Path to a file containing the Vault token that the '"+BackendName+"' secret store "+
+		"authenticates with. The file is read for every write, so that the token can be rotated.")
+	fs.StringVar(&b.mount, "vault-kv-secret-store-mount", "secret", ""+
+		"The path that the KV secrets engine written to by the '"+BackendName+"' secret store is mounted at.")
+	fs.StringVar(&b.pathPrefix, "vault-kv-secret-store-path-prefix", "cert-manager", ""+
+		"The path within the KV secrets engine under which the data of each Certificate is written, "+
+		"as <path-prefix>/<namespace>/<secret name>.")
+	fs.IntVar(&b.kvVersion, "vault-kv-secret-store-kv-version", 2, ""+
+		"The version of the KV secrets engine written to by the '"+BackendName+"' secret store, either 1 or 2.")
+}
+
+func (b *backend) New() (secretstore.Store, error) {
+	if b.address == "" {
+		return nil, fmt.Errorf("vault-kv-secret-store-address must be set to use the %q secret store", BackendName)
+	}
+	if b.tokenFile == "" {
+		return nil, fmt.Errorf("vault-kv-secret-store-token-file must be set to use the %q secret store", BackendName)
+	}
+	if b.kvVersion != 1 && b.kvVersion != 2 {
+		return nil, fmt.Errorf("invalid value for vault-kv-secret-store-kv-version: %d must be 1 or 2", b.kvVersion)
+	}
+
+	cfg := vault.DefaultConfig()
+	cfg.Address = b.address
+	client, err := vault.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing Vault client: %w", err)
+	}
+	if b.namespace != "" {
+		client.SetNamespace(b.namespace)
+	}
+
+	return &Store{
+		client:     client,
+		tokenFile:  b.tokenFile,
+		mount:      b.mount,
+		pathPrefix: b.pathPrefix,
+		kvVersion:  b.kvVersion,
+	}, nil
+}
+
+// Store writes the data issued for Certificates to a Vault KV secrets engine.
+// The data of each Secret of a Certificate is written to
+// <mount>/<path prefix>/<namespace>/<secret name>, using the same keys as
+// the Kubernetes Secret.
+type Store struct {
+	client     *vault.Client
+	tokenFile  string
+	mount      string
+	pathPrefix string
+	kvVersion  int
+}
+
+var _ secretstore.Store = &Store{}
+
+// Put writes the given data for each of the Certificate's Secret names.
+func (s *Store) Put(ctx context.Context, crt *cmapi.Certificate, data secretstore.Data) error {
+	token, err := os.ReadFile(s.tokenFile)
+	if err != nil {
+		return fmt.Errorf("error reading Vault token: %w", err)
+	}
+	s.client.SetToken(strings.TrimSpace(string(token)))
+
+	values := map[string]interface{}{
+		corev1.TLSCertKey:       string(data.Certificate),
+		corev1.TLSPrivateKeyKey: string(data.PrivateKey),
+		cmmeta.TLSCAKey:         string(data.CA),
+	}
+	var body map[string]interface{} = values
+	if s.kvVersion == 2 {
+		body = map[string]interface{}{"data": values}
+	}
+
+	namespace := apiutil.CertificateSecretNamespace(crt)
+	for _, name := range apiutil.CertificateSecretNames(crt) {
+		if err := s.write(ctx, s.secretPath(namespace, name), body); err != nil {
+			return fmt.Errorf("error writing data for Secret %s/%s to Vault: %w", namespace, name, err)
+		}
+	}
+	return nil
+}
+
+func (s *Store) write(ctx context.Context, secretPath string, body map[string]interface{}) error {
+	request := s.client.NewRequest("PUT", secretPath)
+	if err := request.SetJSONBody(body); err != nil {
+		return err
+	}
+	resp, err := s.client.RawRequestWithContext(ctx, request)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// secretPath returns the API path that the data of the named Secret is
+// written to.
+func (s *Store) secretPath(namespace, name string) string {
+	if s.kvVersion == 2 {
+		return path.Join("/v1", s.mount, "data", s.pathPrefix, namespace, name)
+	}
+	return path.Join("/v1", s.mount, s.pathPrefix, namespace, name)
+}
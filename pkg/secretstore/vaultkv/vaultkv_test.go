@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultkv
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/secretstore"
+)
+
+func TestStorePut(t *testing.T) {
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+		Spec: cmapi.CertificateSpec{
+			SecretName:            "output",
+			AdditionalSecretNames: []string{"copy"},
+		},
+	}
+	data := secretstore.Data{PrivateKey: []byte("key"), Certificate: []byte("cert"), CA: []byte("ca")}
+
+	tests := map[string]struct {
+		kvVersion     int
+		expectedPaths []string
+		expectedBody  map[string]interface{}
+	}{
+		"KV version 1": {
+			kvVersion:     1,
+			expectedPaths: []string{"/v1/secret/cert-manager/ns/output", "/v1/secret/cert-manager/ns/copy"},
+			expectedBody:  map[string]interface{}{"tls.crt": "cert", "tls.key": "key", "ca.crt": "ca"},
+		},
+		"KV version 2": {
+			kvVersion:     2,
+			expectedPaths: []string{"/v1/secret/data/cert-manager/ns/output", "/v1/secret/data/cert-manager/ns/copy"},
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{"tls.crt": "cert", "tls.key": "key", "ca.crt": "ca"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("unexpected method %s", r.Method)
+				}
+				if token := r.Header.Get("X-Vault-Token"); token != "token" {
+					t.Errorf("unexpected Vault token %q", token)
+				}
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(body, test.expectedBody) {
+					t.Errorf("unexpected body, exp=%v, got=%v", test.expectedBody, body)
+				}
+				paths = append(paths, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			tokenFile := filepath.Join(t.TempDir(), "token")
+			if err := os.WriteFile(tokenFile, []byte("token\n"), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			b := &backend{
+				address:    server.URL,
+				tokenFile:  tokenFile,
+				mount:      "secret",
+				pathPrefix: "cert-manager",
+				kvVersion:  test.kvVersion,
+			}
+			store, err := b.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := store.Put(context.TODO(), crt, data); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(paths, test.expectedPaths) {
+				t.Errorf("unexpected paths written, exp=%v, got=%v", test.expectedPaths, paths)
+			}
+		})
+	}
+}
+
+func TestBackendNew(t *testing.T) {
+	tests := map[string]*backend{
+		"address not set":    {tokenFile: "token", kvVersion: 2},
+		"token file not set": {address: "https://vault", kvVersion: 2},
+		"invalid KV version": {address: "https://vault", tokenFile: "token", kvVersion: 3},
+	}
+	for name, b := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := b.New(); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
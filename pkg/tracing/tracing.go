@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing instruments the issuance pipeline with OpenTelemetry spans.
+//
+// Each issuance of a Certificate is recorded as a single trace. The
+// controllers which reconcile the Certificate itself derive the trace from
+// the UID of the Certificate and the revision being issued, so that their
+// spans are correlated without having to store any state. The trace is then
+// carried to the CertificateRequest, Order and Challenge resources created
+// for the issuance in the TraceParentAnnotationKey annotation, so that their
+// spans become children of the span which created them.
+//
+// Spans are only recorded once Setup has been called; until then every
+// function in this package is a no-op.
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util"
+)
+
+// TraceParentAnnotationKey is the annotation which carries the W3C trace
+// context of the issuance a resource was created for.
+const TraceParentAnnotationKey = "tracing.cert-manager.io/traceparent"
+
+// Attribute keys added to every span.
+const (
+	KindKey      = attribute.Key("cert-manager.kind")
+	NamespaceKey = attribute.Key("cert-manager.namespace")
+	NameKey      = attribute.Key("cert-manager.name")
+	UIDKey       = attribute.Key("cert-manager.uid")
+	RevisionKey  = attribute.Key("cert-manager.revision")
+)
+
+const instrumentationName = "github.com/jetstack/cert-manager"
+
+// Options configures the export of spans.
+type Options struct {
+	// Endpoint is the host:port of the OTLP gRPC collector spans are exported
+	// to.
+	Endpoint string
+	// Insecure disables TLS when connecting to the collector.
+	Insecure bool
+	// SamplingRatio is the fraction of issuances which are traced, between 0
+	// and 1.
+	SamplingRatio float64
+	// ServiceName identifies the component the spans are recorded by.
+	ServiceName string
+}
+
+// Setup starts exporting spans to an OTLP collector. The returned function
+// flushes any spans which have not yet been exported and stops the export.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	driverOpts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(opts.Endpoint)}
+	if opts.Insecure {
+		driverOpts = append(driverOpts, otlpgrpc.WithInsecure())
+	}
+	exporter, err := otlp.NewExporter(ctx, otlpgrpc.NewDriver(driverOpts...))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OTLP exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx, resource.WithAttributes(
+		semconv.ServiceNameKey.String(opts.ServiceName),
+		semconv.ServiceVersionKey.String(util.AppVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error creating tracing resource: %w", err)
+	}
+
+	// Sample on the trace ID so that every controller makes the same decision
+	// for a given issuance.
+	sampler := sdktrace.TraceIDRatioBased(opts.SamplingRatio)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler,
+			sdktrace.WithRemoteParentSampled(sampler),
+			sdktrace.WithRemoteParentNotSampled(sampler),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// IssuanceContext returns a context carrying the trace of the issuance of the
+// given revision of the Certificate with the given UID. The trace and parent
+// span IDs are derived from the UID and revision, so every call for the same
+// issuance returns the same trace.
+func IssuanceContext(ctx context.Context, uid types.UID, revision int) context.Context {
+	sum := sha256.Sum256([]byte(string(uid) + "/" + strconv.Itoa(revision)))
+
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+}
+
+// CertificateContext returns a context carrying the trace of the issuance of
+// the next revision of the Certificate.
+func CertificateContext(ctx context.Context, crt *cmapi.Certificate) context.Context {
+	return IssuanceContext(ctx, crt.UID, NextRevision(crt))
+}
+
+// NextRevision returns the revision which the next issuance of the
+// Certificate will have.
+func NextRevision(crt *cmapi.Certificate) int {
+	if crt.Status.Revision == nil {
+		return 1
+	}
+	return *crt.Status.Revision + 1
+}
+
+// ObjectContext returns a context carrying the trace of the issuance the
+// resource was created for. The trace is read from the
+// TraceParentAnnotationKey annotation if present. Otherwise, if the resource
+// is a CertificateRequest owned by a Certificate, the trace is derived from
+// the Certificate's UID and the revision of the request. If neither is
+// possible the context is returned unchanged, and spans started from it
+// begin a new trace.
+func ObjectContext(ctx context.Context, obj metav1.Object) context.Context {
+	annotations := obj.GetAnnotations()
+	if traceParent := annotations[TraceParentAnnotationKey]; len(traceParent) > 0 {
+		return propagation.TraceContext{}.Extract(ctx, propagation.HeaderCarrier{
+			"Traceparent": []string{traceParent},
+		})
+	}
+
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.Kind != cmapi.CertificateKind {
+		return ctx
+	}
+	revision, err := strconv.Atoi(annotations[cmapi.CertificateRequestRevisionAnnotationKey])
+	if err != nil {
+		return ctx
+	}
+	return IssuanceContext(ctx, owner.UID, revision)
+}
+
+// InjectAnnotation records the trace of the span in the context on the
+// resource, so that spans started by controllers which reconcile it become
+// children of that span. Nothing is recorded if no span is being recorded.
+func InjectAnnotation(ctx context.Context, obj metav1.Object) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() || !trace.SpanFromContext(ctx).IsRecording() {
+		return
+	}
+
+	carrier := propagation.HeaderCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	traceParent := carrier.Get("traceparent")
+	if len(traceParent) == 0 {
+		return
+	}
+
+	annotations := make(map[string]string, len(obj.GetAnnotations())+1)
+	for k, v := range obj.GetAnnotations() {
+		annotations[k] = v
+	}
+	annotations[TraceParentAnnotationKey] = traceParent
+	obj.SetAnnotations(annotations)
+}
+
+// Start starts a span with the given name for an operation on the resource.
+// The kind of the resource is given explicitly as objects read from listers
+// do not have their TypeMeta set.
+func Start(ctx context.Context, name, kind string, obj metav1.Object, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append([]attribute.KeyValue{
+		KindKey.String(kind),
+		NamespaceKey.String(obj.GetNamespace()),
+		NameKey.String(obj.GetName()),
+		UIDKey.String(string(obj.GetUID())),
+	}, attrs...)
+	if revision, ok := obj.GetAnnotations()[cmapi.CertificateRequestRevisionAnnotationKey]; ok {
+		attrs = append(attrs, RevisionKey.String(revision))
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error, if any, on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
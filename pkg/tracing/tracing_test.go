@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestIssuanceContext(t *testing.T) {
+	traceID := func(ctx context.Context) trace.TraceID {
+		return trace.SpanContextFromContext(ctx).TraceID()
+	}
+
+	a := IssuanceContext(context.Background(), "uid", 1)
+	if !trace.SpanContextFromContext(a).IsValid() {
+		t.Fatalf("expected a valid span context")
+	}
+	if b := IssuanceContext(context.Background(), "uid", 1); traceID(a) != traceID(b) {
+		t.Errorf("expected the same issuance to have the same trace, got %s and %s", traceID(a), traceID(b))
+	}
+	if b := IssuanceContext(context.Background(), "uid", 2); traceID(a) == traceID(b) {
+		t.Errorf("expected different revisions to have different traces")
+	}
+	if b := IssuanceContext(context.Background(), "other-uid", 1); traceID(a) == traceID(b) {
+		t.Errorf("expected different Certificates to have different traces")
+	}
+}
+
+func TestObjectContext(t *testing.T) {
+	crt := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "crt", UID: "crt-uid"}}
+	cr := &cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{cmapi.CertificateRequestRevisionAnnotationKey: "1"},
+		OwnerReferences: []metav1.OwnerReference{
+			*metav1.NewControllerRef(crt, cmapi.SchemeGroupVersion.WithKind(cmapi.CertificateKind)),
+		},
+	}}
+
+	exp := trace.SpanContextFromContext(CertificateContext(context.Background(), crt)).TraceID()
+	if got := trace.SpanContextFromContext(ObjectContext(context.Background(), cr)).TraceID(); exp != got {
+		t.Errorf("expected CertificateRequest to be correlated with its Certificate, exp=%s got=%s", exp, got)
+	}
+
+	if sc := trace.SpanContextFromContext(ObjectContext(context.Background(), &cmapi.CertificateRequest{})); sc.IsValid() {
+		t.Errorf("expected no trace for a CertificateRequest without a Certificate, got %s", sc.TraceID())
+	}
+}
+
+func TestInjectAnnotation(t *testing.T) {
+	order := &cmacme.Order{}
+
+	// Nothing is recorded whilst tracing is disabled.
+	ctx, span := Start(IssuanceContext(context.Background(), "uid", 1), "test", cmacme.OrderKind, order)
+	InjectAnnotation(ctx, order)
+	End(span, nil)
+	if _, ok := order.Annotations[TraceParentAnnotationKey]; ok {
+		t.Fatalf("expected no annotation to be set whilst tracing is disabled")
+	}
+
+	provider := sdktrace.NewTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ctx, span = Start(IssuanceContext(context.Background(), "uid", 1), "test", cmacme.OrderKind, order)
+	defer End(span, nil)
+	InjectAnnotation(ctx, order)
+	if _, ok := order.Annotations[TraceParentAnnotationKey]; !ok {
+		t.Fatalf("expected the trace to be recorded on the resource")
+	}
+
+	got := trace.SpanContextFromContext(ObjectContext(context.Background(), order))
+	if exp := span.SpanContext(); got.TraceID() != exp.TraceID() || got.SpanID() != exp.SpanID() {
+		t.Errorf("expected the span to be the parent of spans for the resource, exp=%s/%s got=%s/%s",
+			exp.TraceID(), exp.SpanID(), got.TraceID(), got.SpanID())
+	}
+}
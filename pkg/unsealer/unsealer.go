@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package unsealer writes the files of a Certificate's Secret volume to
+// another directory, decrypting private keys which are sealed by a KMS
+// plugin, so that they can be consumed by applications which expect a plain
+// text private key. It is intended to be run as an init container or sidecar
+// of a Pod which mounts the Secret, writing to an in-memory volume shared
+// with the application.
+package unsealer
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Unsealer copies the files of a Secret volume from SourceDir to TargetDir,
+// unsealing sealed private keys using Plugins.
+type Unsealer struct {
+	// Plugins are the KMS plugins, keyed by name, which are used to decrypt
+	// the data keys of sealed private keys.
+	Plugins map[string]pki.KMSPlugin
+	// SourceDir is the directory the Secret is mounted at.
+	SourceDir string
+	// TargetDir is the directory the files are written to.
+	TargetDir string
+}
+
+// Unseal writes every data key of the mounted Secret to TargetDir. Files
+// containing a sealed private key are written with the unsealed private key,
+// PKCS#8 encoded, and readable only by their owner. Each file is written
+// atomically, so that applications never read a partially written file.
+func (u *Unsealer) Unseal(ctx context.Context) error {
+	entries, err := os.ReadDir(u.SourceDir)
+	if err != nil {
+		return fmt.Errorf("error reading Secret volume: %w", err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		// Secret volumes hold their data in hidden directories, such as
+		// ..data, which the data keys are symlinks into.
+		if strings.HasPrefix(name, "..") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(u.SourceDir, name))
+		if err != nil {
+			return fmt.Errorf("error reading %q: %w", name, err)
+		}
+
+		var perm os.FileMode = 0644
+		if isSealedPrivateKey(data) {
+			data, err = u.unsealPrivateKey(ctx, data)
+			if err != nil {
+				return fmt.Errorf("error unsealing %q: %w", name, err)
+			}
+			perm = 0600
+		}
+
+		if err := writeFile(filepath.Join(u.TargetDir, name), data, perm); err != nil {
+			return fmt.Errorf("error writing %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (u *Unsealer) unsealPrivateKey(ctx context.Context, data []byte) ([]byte, error) {
+	key, err := pki.DecodePrivateKeyBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	sealed, ok := key.(*pki.SealedKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a sealed private key, got %T", key)
+	}
+
+	plugin, ok := u.Plugins[sealed.Plugin]
+	if !ok {
+		return nil, fmt.Errorf("KMS plugin %q is not configured", sealed.Plugin)
+	}
+	pk, err := pki.UnsealPrivateKey(ctx, plugin, sealed)
+	if err != nil {
+		return nil, err
+	}
+	return pki.EncodePKCS8PrivateKey(pk)
+}
+
+// isSealedPrivateKey returns true if the given data is a PEM encoded sealed
+// private key.
+func isSealedPrivateKey(data []byte) bool {
+	block, _ := pem.Decode(bytes.TrimSpace(data))
+	return block != nil && block.Type == pki.SealedPrivateKeyPEMType
+}
+
+// writeFile writes data to a temporary file in the same directory as path,
+// and renames it to path.
+func writeFile(path string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
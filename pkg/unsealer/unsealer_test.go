@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unsealer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// fakeKMSPlugin "encrypts" data keys by reversing them.
+type fakeKMSPlugin struct{}
+
+func (fakeKMSPlugin) Encrypt(_ context.Context, dataKey []byte) ([]byte, error) {
+	return reverse(dataKey), nil
+}
+
+func (fakeKMSPlugin) Decrypt(_ context.Context, encryptedDataKey []byte) ([]byte, error) {
+	return reverse(encryptedDataKey), nil
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
+
+func TestUnseal(t *testing.T) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := pki.SealPrivateKey(context.TODO(), fakeKMSPlugin{}, "fake", pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealedPEM, err := pki.EncodeSealedKey(sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sourceDir, targetDir := t.TempDir(), t.TempDir()
+	for name, data := range map[string][]byte{
+		"tls.key":         sealedPEM,
+		"tls.crt":         []byte("certificate"),
+		"..data/tls.crt":  []byte("certificate"),
+		"custom.key.pem":  sealedPEM,
+		"..2021_01_01/ca": []byte("ca"),
+	} {
+		path := filepath.Join(sourceDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	u := &Unsealer{Plugins: map[string]pki.KMSPlugin{"fake": fakeKMSPlugin{}}, SourceDir: sourceDir, TargetDir: targetDir}
+	if err := u.Unseal(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(targetDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Errorf("expected 3 files to be written, got %d", len(entries))
+	}
+
+	crt, err := os.ReadFile(filepath.Join(targetDir, "tls.crt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(crt, []byte("certificate")) {
+		t.Errorf("unexpected tls.crt contents %q", crt)
+	}
+
+	for _, name := range []string{"tls.key", "custom.key.pem"} {
+		path := filepath.Join(targetDir, name)
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("expected %s to be written with mode 0600, got %v", name, info.Mode().Perm())
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := pki.DecodePrivateKeyBytes(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !pk.Equal(key) {
+			t.Errorf("%s does not contain the unsealed private key", name)
+		}
+	}
+
+	u.Plugins = nil
+	if err := u.Unseal(context.TODO()); err == nil {
+		t.Errorf("expected an error if the KMS plugin is not configured")
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit builds the annotations which record issuance decisions, so
+// that an audit trail of issuance is available from the Kubernetes audit log
+// and from Events without scraping controller logs.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// EventAnnotationPrefix is the prefix of the annotations added to Events
+// which record issuance decisions.
+const EventAnnotationPrefix = "audit.cert-manager.io/"
+
+// Decisions which are recorded.
+const (
+	// DecisionRequested records that a CertificateRequest was created.
+	DecisionRequested = "requested"
+	// DecisionApproved records that a CertificateRequest was approved.
+	DecisionApproved = "approved"
+	// DecisionDenied records that a CertificateRequest was denied.
+	DecisionDenied = "denied"
+	// DecisionSigned records that a CertificateRequest was signed.
+	DecisionSigned = "signed"
+	// DecisionReissue records that a Certificate is to be re-issued.
+	DecisionReissue = "reissue"
+)
+
+// Keys of the recorded annotations.
+const (
+	DecisionKey            = "decision"
+	DecidedByKey           = "decided-by"
+	ReasonKey              = "reason"
+	RequestorKey           = "requestor"
+	IssuerKey              = "issuer"
+	CommonNameKey          = "common-name"
+	DNSNamesKey            = "dns-names"
+	IPAddressesKey         = "ip-addresses"
+	URIsKey                = "uris"
+	EmailAddressesKey      = "email-addresses"
+	PublicKeySHA256Key     = "public-key-sha256"
+	CertificateSHA256Key   = "certificate-sha256"
+	CertificateSerialKey   = "certificate-serial-number"
+	CertificateNotAfterKey = "certificate-not-after"
+	CertificateNameKey     = "certificate-name"
+	CertificateRevisionKey = "certificate-revision"
+)
+
+// Annotations records a single issuance decision.
+type Annotations map[string]string
+
+// New returns Annotations recording the given decision.
+func New(decision string) Annotations {
+	return Annotations{DecisionKey: decision}
+}
+
+// Set sets the annotation with the given key, unless value is empty.
+func (a Annotations) Set(key, value string) Annotations {
+	if len(value) > 0 {
+		a[key] = value
+	}
+	return a
+}
+
+// SetList sets the annotation with the given key to the comma separated
+// values, unless there are none.
+func (a Annotations) SetList(key string, values []string) Annotations {
+	return a.Set(key, strings.Join(values, ","))
+}
+
+// SetIssuer records the referenced issuer as <kind>.<group>/<name>. An empty
+// kind or group is recorded as the cert-manager defaults.
+func (a Annotations) SetIssuer(group, kind, name string) Annotations {
+	if len(kind) == 0 {
+		kind = "Issuer"
+	}
+	if len(group) == 0 {
+		group = "cert-manager.io"
+	}
+	return a.Set(IssuerKey, kind+"."+group+"/"+name)
+}
+
+// SetRequest records the subject, SANs and public key fingerprint of the given
+// PEM encoded x509 certificate signing request. Nothing is recorded if the
+// request cannot be decoded.
+func (a Annotations) SetRequest(csrPEM []byte) Annotations {
+	csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+	if err != nil {
+		return a
+	}
+
+	var ips, uris []string
+	for _, ip := range csr.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+	for _, uri := range csr.URIs {
+		uris = append(uris, uri.String())
+	}
+
+	return a.Set(CommonNameKey, csr.Subject.CommonName).
+		SetList(DNSNamesKey, csr.DNSNames).
+		SetList(IPAddressesKey, ips).
+		SetList(URIsKey, uris).
+		SetList(EmailAddressesKey, csr.EmailAddresses).
+		Set(PublicKeySHA256Key, fingerprint(csr.RawSubjectPublicKeyInfo))
+}
+
+// SetCertificate records the fingerprint, serial number and expiry of the
+// given PEM encoded x509 certificate. Nothing is recorded if the certificate
+// cannot be decoded.
+func (a Annotations) SetCertificate(certPEM []byte) Annotations {
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return a
+	}
+
+	return a.Set(CertificateSHA256Key, fingerprint(cert.Raw)).
+		Set(CertificateSerialKey, cert.SerialNumber.String()).
+		Set(CertificateNotAfterKey, cert.NotAfter.UTC().Format(time.RFC3339)).
+		Set(PublicKeySHA256Key, fingerprint(cert.RawSubjectPublicKeyInfo))
+}
+
+// WithPrefix returns a copy of the annotations with every key prefixed.
+func (a Annotations) WithPrefix(prefix string) map[string]string {
+	out := make(map[string]string, len(a))
+	for k, v := range a {
+		out[prefix+k] = v
+	}
+	return out
+}
+
+// EventAnnotations returns the annotations to add to an Event which records
+// the decision.
+func (a Annotations) EventAnnotations() map[string]string {
+	return a.WithPrefix(EventAnnotationPrefix)
+}
+
+func fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// ForCertificateRequest returns Annotations recording the given decision for
+// the CertificateRequest, including its requestor, issuer and request.
+func ForCertificateRequest(decision string, cr *cmapi.CertificateRequest) Annotations {
+	return New(decision).
+		Set(RequestorKey, cr.Spec.Username).
+		SetIssuer(cr.Spec.IssuerRef.Group, cr.Spec.IssuerRef.Kind, cr.Spec.IssuerRef.Name).
+		Set(CertificateNameKey, cr.Annotations[cmapi.CertificateNameKey]).
+		Set(CertificateRevisionKey, cr.Annotations[cmapi.CertificateRequestRevisionAnnotationKey]).
+		SetRequest(cr.Spec.Request)
+}
+
+// ForCertificate returns Annotations recording the given decision for the
+// Certificate, including its issuer and requested SANs.
+func ForCertificate(decision string, crt *cmapi.Certificate) Annotations {
+	return New(decision).
+		SetIssuer(crt.Spec.IssuerRef.Group, crt.Spec.IssuerRef.Kind, crt.Spec.IssuerRef.Name).
+		Set(CertificateNameKey, crt.Name).
+		Set(CommonNameKey, crt.Spec.CommonName).
+		SetList(DNSNamesKey, crt.Spec.DNSNames).
+		SetList(IPAddressesKey, crt.Spec.IPAddresses).
+		SetList(URIsKey, crt.Spec.URIs).
+		SetList(EmailAddressesKey, crt.Spec.EmailAddresses)
+}
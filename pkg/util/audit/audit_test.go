@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+func TestForCertificateRequest(t *testing.T) {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csrDER, err := pki.EncodeCSR(&x509.CertificateRequest{
+		Subject:        pkix.Name{CommonName: "example.com"},
+		DNSNames:       []string{"example.com", "www.example.com"},
+		IPAddresses:    []net.IP{net.ParseIP("10.0.0.1")},
+		EmailAddresses: []string{"admin@example.com"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	certDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}, &x509.Certificate{Subject: pkix.Name{CommonName: "ca"}}, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cr := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				cmapi.CertificateNameKey:                      "example",
+				cmapi.CertificateRequestRevisionAnnotationKey: "2",
+			},
+		},
+		Spec: cmapi.CertificateRequestSpec{
+			Username:  "system:serviceaccount:cert-manager:cert-manager",
+			IssuerRef: cmmeta.ObjectReference{Name: "ca", Kind: "ClusterIssuer"},
+			Request:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}),
+		},
+	}
+
+	got := ForCertificateRequest(DecisionSigned, cr).
+		SetCertificate(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+
+	exp := Annotations{
+		DecisionKey:            DecisionSigned,
+		RequestorKey:           "system:serviceaccount:cert-manager:cert-manager",
+		IssuerKey:              "ClusterIssuer.cert-manager.io/ca",
+		CertificateNameKey:     "example",
+		CertificateRevisionKey: "2",
+		CommonNameKey:          "example.com",
+		DNSNamesKey:            "example.com,www.example.com",
+		IPAddressesKey:         "10.0.0.1",
+		EmailAddressesKey:      "admin@example.com",
+		PublicKeySHA256Key:     sha256Hex(csr.RawSubjectPublicKeyInfo),
+		CertificateSHA256Key:   sha256Hex(certDER),
+		CertificateSerialKey:   "1234",
+		CertificateNotAfterKey: "2030-01-01T00:00:00Z",
+	}
+	if !reflect.DeepEqual(exp, got) {
+		t.Errorf("unexpected annotations, exp=%v got=%v", exp, got)
+	}
+
+	if v := got.EventAnnotations()["audit.cert-manager.io/decision"]; v != DecisionSigned {
+		t.Errorf("expected event annotations to be prefixed, got=%v", got.EventAnnotations())
+	}
+}
+
+func TestSetRequestInvalid(t *testing.T) {
+	got := New(DecisionRequested).SetRequest([]byte("not a csr"))
+	if exp := (Annotations{DecisionKey: DecisionRequested}); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected nothing to be recorded for an invalid request, got=%v", got)
+	}
+}
+
+func sha256Hex(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	cmapiv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	// Use v1alpha2 API to ensure that the API server has also connected to the
 	// cert-manager conversion webhook.
 	// TODO(wallrj): Only change this when the old deprecated APIs are removed,
@@ -61,11 +62,29 @@ type Interface interface {
 
 type cmapiChecker struct {
 	client client.Client
+	// v1Only is true if the checker creates a v1 Certificate rather than a
+	// v1alpha2 Certificate.
+	v1Only bool
 }
 
 // New returns a cert-manager API checker
 func New(restcfg *rest.Config, scheme *runtime.Scheme, namespace string) (Interface, error) {
-	if err := cmapi.AddToScheme(scheme); err != nil {
+	return newChecker(restcfg, scheme, namespace, false)
+}
+
+// NewV1Only returns a cert-manager API checker which only uses the v1 API.
+// It must be used if cert-manager is installed without the conversion
+// webhook, in which case the older API versions are not served.
+func NewV1Only(restcfg *rest.Config, scheme *runtime.Scheme, namespace string) (Interface, error) {
+	return newChecker(restcfg, scheme, namespace, true)
+}
+
+func newChecker(restcfg *rest.Config, scheme *runtime.Scheme, namespace string, v1Only bool) (Interface, error) {
+	addToScheme := cmapi.AddToScheme
+	if v1Only {
+		addToScheme = cmapiv1.AddToScheme
+	}
+	if err := addToScheme(scheme); err != nil {
 		return nil, errors.Wrap(err, "while configuring scheme")
 	}
 
@@ -78,6 +97,7 @@ func New(restcfg *rest.Config, scheme *runtime.Scheme, namespace string) (Interf
 
 	return &cmapiChecker{
 		client: client.NewNamespacedClient(client.NewDryRunClient(cl), namespace),
+		v1Only: v1Only,
 	}, nil
 }
 
@@ -85,20 +105,32 @@ func New(restcfg *rest.Config, scheme *runtime.Scheme, namespace string) (Interf
 // Certificate resource in order to verify that CRDs are installed and all the
 // required webhooks are reachable by the K8S API server.
 // We use v1alpha2 API to ensure that the API server has also connected to the
-// cert-manager conversion webhook.
+// cert-manager conversion webhook, unless the checker only uses the v1 API.
 func (o *cmapiChecker) Check(ctx context.Context) error {
-	cert := &cmapi.Certificate{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: "cmapichecker-",
-		},
+	objectMeta := metav1.ObjectMeta{
+		GenerateName: "cmapichecker-",
+	}
+	issuerRef := cmmeta.ObjectReference{
+		Name: "cmapichecker",
+	}
+	var cert client.Object = &cmapi.Certificate{
+		ObjectMeta: objectMeta,
 		Spec: cmapi.CertificateSpec{
 			DNSNames:   []string{"cmapichecker.example"},
 			SecretName: "cmapichecker",
-			IssuerRef: cmmeta.ObjectReference{
-				Name: "cmapichecker",
-			},
+			IssuerRef:  issuerRef,
 		},
 	}
+	if o.v1Only {
+		cert = &cmapiv1.Certificate{
+			ObjectMeta: objectMeta,
+			Spec: cmapiv1.CertificateSpec{
+				DNSNames:   []string{"cmapichecker.example"},
+				SecretName: "cmapichecker",
+				IssuerRef:  issuerRef,
+			},
+		}
+	}
 
 	if err := o.client.Create(ctx, cert); err != nil {
 		return &ApiCheckError{
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	cmapiv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha2"
 )
 
@@ -185,3 +186,20 @@ func runTest(t *testing.T, test testT) {
 		}
 	}
 }
+
+func TestCmapiCheckerV1Only(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := cmapiv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	// The v1alpha2 API is not registered with the client's scheme, so only a
+	// checker which uses the v1 API succeeds.
+	if err := (&cmapiChecker{client: cl}).Check(context.TODO()); err == nil {
+		t.Errorf("expected checking the v1alpha2 API to fail")
+	}
+	if err := (&cmapiChecker{client: cl, v1Only: true}).Check(context.TODO()); err != nil {
+		t.Errorf("unexpected error checking the v1 API: %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmssigner
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// AWSSigner is a crypto.Signer which signs using an asymmetric key held by
+// AWS KMS.
+type AWSSigner struct {
+	ctx       context.Context
+	client    kmsiface.KMSAPI
+	keyID     string
+	publicKey crypto.PublicKey
+}
+
+var _ crypto.Signer = &AWSSigner{}
+
+// NewAWS returns a signer which signs using the AWS KMS key with the given ID
+// or ARN, which must be an asymmetric key used to sign and verify. The given
+// context is used for all requests made by the signer.
+func NewAWS(ctx context.Context, client kmsiface.KMSAPI, keyID string) (*AWSSigner, error) {
+	out, err := client.GetPublicKeyWithContext(ctx, &kms.GetPublicKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of AWS KMS key %q: %w", keyID, err)
+	}
+	if usage := aws.StringValue(out.KeyUsage); usage != kms.KeyUsageTypeSignVerify {
+		return nil, fmt.Errorf("AWS KMS key %q has usage %q, expected %q", keyID, usage, kms.KeyUsageTypeSignVerify)
+	}
+	pub, err := x509.ParsePKIXPublicKey(out.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key of AWS KMS key %q: %w", keyID, err)
+	}
+
+	return &AWSSigner{
+		ctx:       ctx,
+		client:    client,
+		keyID:     keyID,
+		publicKey: pub,
+	}, nil
+}
+
+// Public returns the public key of the AWS KMS key.
+func (s *AWSSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign signs the given digest using the AWS KMS key.
+func (s *AWSSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	algorithm, err := awsSigningAlgorithm(s.publicKey, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.client.SignWithContext(s.ctx, &kms.SignInput{
+		KeyId:            aws.String(s.keyID),
+		Message:          digest,
+		MessageType:      aws.String(kms.MessageTypeDigest),
+		SigningAlgorithm: aws.String(algorithm),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign using AWS KMS key %q: %w", s.keyID, err)
+	}
+	return out.Signature, nil
+}
+
+// awsSigningAlgorithm returns the AWS KMS signing algorithm for the given
+// public key type and signer options.
+func awsSigningAlgorithm(pub crypto.PublicKey, opts crypto.SignerOpts) (string, error) {
+	_, pss := opts.(*rsa.PSSOptions)
+	hash := opts.HashFunc()
+
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		switch {
+		case pss && hash == crypto.SHA256:
+			return kms.SigningAlgorithmSpecRsassaPssSha256, nil
+		case pss && hash == crypto.SHA384:
+			return kms.SigningAlgorithmSpecRsassaPssSha384, nil
+		case pss && hash == crypto.SHA512:
+			return kms.SigningAlgorithmSpecRsassaPssSha512, nil
+		case hash == crypto.SHA256:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha256, nil
+		case hash == crypto.SHA384:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha384, nil
+		case hash == crypto.SHA512:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha512, nil
+		}
+	case *ecdsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return kms.SigningAlgorithmSpecEcdsaSha256, nil
+		case crypto.SHA384:
+			return kms.SigningAlgorithmSpecEcdsaSha384, nil
+		case crypto.SHA512:
+			return kms.SigningAlgorithmSpecEcdsaSha512, nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported AWS KMS public key type %T", pub)
+	}
+
+	return "", fmt.Errorf("unsupported hash function %v for %T AWS KMS key", hash, pub)
+}
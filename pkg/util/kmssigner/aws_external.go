@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmssigner
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/errors"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// AWSExternalKeyProviderName is the name that the external key provider
+// returned by NewAWSExternalKeyProvider is configured with in the controller,
+// and which Certificates name in spec.privateKey.external.provider.
+const AWSExternalKeyProviderName = "aws-kms"
+
+// AWSExternalKeyProvider is an external key provider which generates the
+// private keys of Certificates as asymmetric keys held by AWS KMS, referenced
+// by their ARN.
+type AWSExternalKeyProvider struct {
+	client kmsiface.KMSAPI
+}
+
+var _ pki.ExternalKeyProvider = &AWSExternalKeyProvider{}
+
+// NewAWSExternalKeyProvider returns an external key provider which generates
+// and signs using keys held by AWS KMS, using the given client.
+func NewAWSExternalKeyProvider(client kmsiface.KMSAPI) *AWSExternalKeyProvider {
+	return &AWSExternalKeyProvider{client: client}
+}
+
+// Generate creates a new AWS KMS signing key with the algorithm and size
+// requested by the Certificate.
+func (p *AWSExternalKeyProvider) Generate(ctx context.Context, crt *v1.Certificate) (*pki.ExternalKey, error) {
+	spec, err := awsKeySpec(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := p.client.CreateKeyWithContext(ctx, &kms.CreateKeyInput{
+		CustomerMasterKeySpec: aws.String(spec),
+		KeyUsage:              aws.String(kms.KeyUsageTypeSignVerify),
+		Description:           aws.String(fmt.Sprintf("cert-manager private key of Certificate %s/%s", crt.Namespace, crt.Name)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS KMS key: %w", err)
+	}
+	arn := aws.StringValue(out.KeyMetadata.Arn)
+
+	signer, err := NewAWS(ctx, p.client, arn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pki.ExternalKey{
+		Provider:  AWSExternalKeyProviderName,
+		Reference: arn,
+		PublicKey: signer.Public(),
+	}, nil
+}
+
+// Signer returns a signer which signs using the referenced AWS KMS key, after
+// checking that its public key has not changed.
+func (p *AWSExternalKeyProvider) Signer(ctx context.Context, key *pki.ExternalKey) (crypto.Signer, error) {
+	signer, err := NewAWS(ctx, p.client, key.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := pki.PublicKeysEqual(signer.Public(), key.PublicKey)
+	if err != nil {
+		return nil, errors.NewInvalidData("failed to compare the public key of AWS KMS key %q: %v", key.Reference, err)
+	}
+	if !matches {
+		return nil, errors.NewInvalidData("the public key of AWS KMS key %q does not match the external key reference", key.Reference)
+	}
+
+	return signer, nil
+}
+
+// awsKeySpec returns the AWS KMS key spec of the private key requested by the
+// given Certificate, defaulting the algorithm and size as cert-manager does
+// for private keys that it generates itself.
+func awsKeySpec(crt *v1.Certificate) (string, error) {
+	var algorithm v1.PrivateKeyAlgorithm
+	var size int
+	if crt.Spec.PrivateKey != nil {
+		algorithm, size = crt.Spec.PrivateKey.Algorithm, crt.Spec.PrivateKey.Size
+	}
+	if algorithm == "" {
+		algorithm = v1.RSAKeyAlgorithm
+	}
+
+	switch algorithm {
+	case v1.RSAKeyAlgorithm:
+		switch size {
+		case 0, 2048:
+			return kms.CustomerMasterKeySpecRsa2048, nil
+		case 3072:
+			return kms.CustomerMasterKeySpecRsa3072, nil
+		case 4096:
+			return kms.CustomerMasterKeySpecRsa4096, nil
+		}
+	case v1.ECDSAKeyAlgorithm:
+		switch size {
+		case 0, pki.ECCurve256:
+			return kms.CustomerMasterKeySpecEccNistP256, nil
+		case pki.ECCurve384:
+			return kms.CustomerMasterKeySpecEccNistP384, nil
+		case pki.ECCurve521:
+			return kms.CustomerMasterKeySpecEccNistP521, nil
+		}
+	default:
+		return "", errors.NewInvalidData("private key algorithm %q is not supported by AWS KMS", algorithm)
+	}
+	return "", errors.NewInvalidData("%s private key size %d is not supported by AWS KMS", algorithm, size)
+}
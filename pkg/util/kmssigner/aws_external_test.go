@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmssigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// CreateKeyWithContext records the spec of the created key, which is always
+// the key held by the fake with the ID "key".
+func (f *fakeAWSKMS) CreateKeyWithContext(_ aws.Context, in *kms.CreateKeyInput, _ ...request.Option) (*kms.CreateKeyOutput, error) {
+	f.createdKeySpec = aws.StringValue(in.CustomerMasterKeySpec)
+	return &kms.CreateKeyOutput{KeyMetadata: &kms.KeyMetadata{KeyId: aws.String("key"), Arn: aws.String("key")}}, nil
+}
+
+func TestAWSExternalKeyProvider(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &fakeAWSKMS{key: key, usage: kms.KeyUsageTypeSignVerify}
+	provider := NewAWSExternalKeyProvider(client)
+
+	crt := &v1.Certificate{Spec: v1.CertificateSpec{PrivateKey: &v1.CertificatePrivateKey{
+		Algorithm: v1.ECDSAKeyAlgorithm,
+		Size:      384,
+	}}}
+	extKey, err := provider.Generate(context.TODO(), crt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.createdKeySpec != kms.CustomerMasterKeySpecEccNistP384 {
+		t.Errorf("unexpected key spec, exp=%s, got=%s", kms.CustomerMasterKeySpecEccNistP384, client.createdKeySpec)
+	}
+	if extKey.Provider != AWSExternalKeyProviderName || extKey.Reference != "key" {
+		t.Errorf("unexpected external key reference %s/%s", extKey.Provider, extKey.Reference)
+	}
+
+	signer, err := provider.Signer(context.TODO(), extKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustSelfSign(t, signer, 0)
+
+	_, err = provider.Signer(context.TODO(), &pki.ExternalKey{Provider: AWSExternalKeyProviderName, Reference: "key", PublicKey: otherKey.Public()})
+	if err == nil {
+		t.Errorf("expected an error if the public key of the KMS key does not match the reference")
+	}
+}
+
+func TestAWSKeySpec(t *testing.T) {
+	tests := map[string]struct {
+		privateKey   *v1.CertificatePrivateKey
+		expectedSpec string
+		expectedErr  bool
+	}{
+		"default to RSA 2048": {
+			expectedSpec: kms.CustomerMasterKeySpecRsa2048,
+		},
+		"RSA 4096": {
+			privateKey:   &v1.CertificatePrivateKey{Algorithm: v1.RSAKeyAlgorithm, Size: 4096},
+			expectedSpec: kms.CustomerMasterKeySpecRsa4096,
+		},
+		"default ECDSA to P-256": {
+			privateKey:   &v1.CertificatePrivateKey{Algorithm: v1.ECDSAKeyAlgorithm},
+			expectedSpec: kms.CustomerMasterKeySpecEccNistP256,
+		},
+		"ECDSA P-521": {
+			privateKey:   &v1.CertificatePrivateKey{Algorithm: v1.ECDSAKeyAlgorithm, Size: 521},
+			expectedSpec: kms.CustomerMasterKeySpecEccNistP521,
+		},
+		"error on an unsupported RSA key size": {
+			privateKey:  &v1.CertificatePrivateKey{Algorithm: v1.RSAKeyAlgorithm, Size: 8192},
+			expectedErr: true,
+		},
+		"error on Ed25519": {
+			privateKey:  &v1.CertificatePrivateKey{Algorithm: v1.Ed25519KeyAlgorithm},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec, err := awsKeySpec(&v1.Certificate{Spec: v1.CertificateSpec{PrivateKey: test.privateKey}})
+			if test.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if spec != test.expectedSpec {
+				t.Errorf("unexpected key spec, exp=%s, got=%s", test.expectedSpec, spec)
+			}
+		})
+	}
+}
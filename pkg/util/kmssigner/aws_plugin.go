@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmssigner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// AWSKMSPluginName is the name that the KMS plugin returned by
+// NewAWSKMSPlugin is configured with, and which Certificates name in the
+// cert-manager.io/private-key-kms-plugin annotation.
+const AWSKMSPluginName = "aws-kms"
+
+// AWSKMSPlugin is a KMS plugin which encrypts the data keys of sealed private
+// keys using a symmetric AWS KMS key.
+type AWSKMSPlugin struct {
+	client kmsiface.KMSAPI
+	keyID  string
+}
+
+var _ pki.KMSPlugin = &AWSKMSPlugin{}
+
+// NewAWSKMSPlugin returns a KMS plugin which encrypts data keys using the
+// symmetric AWS KMS key with the given ID, ARN or alias. The key ID is only
+// needed to encrypt: AWS KMS ciphertexts identify the key they were
+// encrypted with, so a plugin with an empty key ID can still decrypt.
+func NewAWSKMSPlugin(client kmsiface.KMSAPI, keyID string) *AWSKMSPlugin {
+	return &AWSKMSPlugin{client: client, keyID: keyID}
+}
+
+// Encrypt encrypts the given data key with the configured AWS KMS key.
+func (p *AWSKMSPlugin) Encrypt(ctx context.Context, dataKey []byte) ([]byte, error) {
+	if p.keyID == "" {
+		return nil, fmt.Errorf("no AWS KMS key is configured to encrypt data keys with")
+	}
+
+	out, err := p.client.EncryptWithContext(ctx, &kms.EncryptInput{
+		KeyId:     aws.String(p.keyID),
+		Plaintext: dataKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt data key with AWS KMS key %q: %w", p.keyID, err)
+	}
+	return out.CiphertextBlob, nil
+}
+
+// Decrypt decrypts a data key encrypted by Encrypt.
+func (p *AWSKMSPlugin) Decrypt(ctx context.Context, encryptedDataKey []byte) ([]byte, error) {
+	in := &kms.DecryptInput{CiphertextBlob: encryptedDataKey}
+	if p.keyID != "" {
+		in.KeyId = aws.String(p.keyID)
+	}
+
+	out, err := p.client.DecryptWithContext(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key with AWS KMS: %w", err)
+	}
+	return out.Plaintext, nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmssigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// fakeAWSKMSEncryption holds a single symmetric key with the ID "key", and
+// "encrypts" by prefixing the plaintext with the ID of the key.
+type fakeAWSKMSEncryption struct {
+	kmsiface.KMSAPI
+}
+
+func (f *fakeAWSKMSEncryption) EncryptWithContext(_ aws.Context, in *kms.EncryptInput, _ ...request.Option) (*kms.EncryptOutput, error) {
+	if aws.StringValue(in.KeyId) != "key" {
+		return nil, fmt.Errorf("key %q not found", aws.StringValue(in.KeyId))
+	}
+	return &kms.EncryptOutput{CiphertextBlob: append([]byte("key:"), in.Plaintext...)}, nil
+}
+
+func (f *fakeAWSKMSEncryption) DecryptWithContext(_ aws.Context, in *kms.DecryptInput, _ ...request.Option) (*kms.DecryptOutput, error) {
+	if in.KeyId != nil && aws.StringValue(in.KeyId) != "key" {
+		return nil, fmt.Errorf("ciphertext was not encrypted with key %q", aws.StringValue(in.KeyId))
+	}
+	if !bytes.HasPrefix(in.CiphertextBlob, []byte("key:")) {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+	return &kms.DecryptOutput{Plaintext: bytes.TrimPrefix(in.CiphertextBlob, []byte("key:"))}, nil
+}
+
+func TestAWSKMSPlugin(t *testing.T) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &fakeAWSKMSEncryption{}
+	sealed, err := pki.SealPrivateKey(context.TODO(), NewAWSKMSPlugin(client, "key"), AWSKMSPluginName, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a plugin without a key ID, as used to unseal keys, can only decrypt
+	unsealer := NewAWSKMSPlugin(client, "")
+	unsealed, err := pki.UnsealPrivateKey(context.TODO(), unsealer, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pk.Equal(unsealed) {
+		t.Errorf("unsealed private key does not match the sealed private key")
+	}
+	if _, err := unsealer.Encrypt(context.TODO(), []byte("data key")); err == nil {
+		t.Errorf("expected an error encrypting without a key ID")
+	}
+
+	if _, err := NewAWSKMSPlugin(client, "other").Decrypt(context.TODO(), sealed.EncryptedDataKey); err == nil {
+		t.Errorf("expected an error decrypting with another key")
+	}
+}
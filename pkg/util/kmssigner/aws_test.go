@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmssigner
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// fakeAWSKMS holds a single key with the ID "key", signing using a local
+// private key.
+type fakeAWSKMS struct {
+	kmsiface.KMSAPI
+
+	key   crypto.Signer
+	usage string
+
+	signingAlgorithm string
+	createdKeySpec   string
+}
+
+func (f *fakeAWSKMS) GetPublicKeyWithContext(_ aws.Context, in *kms.GetPublicKeyInput, _ ...request.Option) (*kms.GetPublicKeyOutput, error) {
+	if aws.StringValue(in.KeyId) != "key" {
+		return nil, fmt.Errorf("key %q not found", aws.StringValue(in.KeyId))
+	}
+	der, err := x509.MarshalPKIXPublicKey(f.key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &kms.GetPublicKeyOutput{KeyId: in.KeyId, KeyUsage: aws.String(f.usage), PublicKey: der}, nil
+}
+
+func (f *fakeAWSKMS) SignWithContext(_ aws.Context, in *kms.SignInput, _ ...request.Option) (*kms.SignOutput, error) {
+	if aws.StringValue(in.MessageType) != kms.MessageTypeDigest {
+		return nil, fmt.Errorf("unexpected message type %q", aws.StringValue(in.MessageType))
+	}
+	f.signingAlgorithm = aws.StringValue(in.SigningAlgorithm)
+
+	var opts crypto.SignerOpts
+	switch f.signingAlgorithm {
+	case kms.SigningAlgorithmSpecEcdsaSha256, kms.SigningAlgorithmSpecRsassaPkcs1V15Sha256:
+		opts = crypto.SHA256
+	case kms.SigningAlgorithmSpecEcdsaSha384:
+		opts = crypto.SHA384
+	case kms.SigningAlgorithmSpecRsassaPssSha256:
+		opts = &rsa.PSSOptions{Hash: crypto.SHA256, SaltLength: rsa.PSSSaltLengthEqualsHash}
+	default:
+		return nil, fmt.Errorf("unexpected signing algorithm %q", f.signingAlgorithm)
+	}
+	sig, err := f.key.Sign(rand.Reader, in.Message, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &kms.SignOutput{KeyId: in.KeyId, Signature: sig, SigningAlgorithm: in.SigningAlgorithm}, nil
+}
+
+// mustSelfSign signs a CA certificate template using the given signer, and
+// verifies the signature of the resulting certificate.
+func mustSelfSign(t *testing.T, signer crypto.Signer, sigAlgo x509.SignatureAlgorithm) {
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kms-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		SignatureAlgorithm:    sigAlgo,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, signer.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cert.CheckSignatureFrom(cert); err != nil {
+		t.Errorf("failed to verify certificate signed using KMS signer: %v", err)
+	}
+}
+
+func TestAWSSigner(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		key     crypto.Signer
+		usage   string
+		keyID   string
+		sigAlgo x509.SignatureAlgorithm
+
+		expectedErr              bool
+		expectedSigningAlgorithm string
+	}{
+		"sign using an ECDSA key": {
+			key:                      ecKey,
+			expectedSigningAlgorithm: kms.SigningAlgorithmSpecEcdsaSha384,
+		},
+		"sign using an RSA key": {
+			key:                      rsaKey,
+			expectedSigningAlgorithm: kms.SigningAlgorithmSpecRsassaPkcs1V15Sha256,
+		},
+		"sign using an RSA key with PSS padding": {
+			key:                      rsaKey,
+			sigAlgo:                  x509.SHA256WithRSAPSS,
+			expectedSigningAlgorithm: kms.SigningAlgorithmSpecRsassaPssSha256,
+		},
+		"error if the key is not a signing key": {
+			key:         ecKey,
+			usage:       "ENCRYPT_DECRYPT",
+			expectedErr: true,
+		},
+		"error if the key does not exist": {
+			key:         ecKey,
+			keyID:       "missing",
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.usage == "" {
+				test.usage = kms.KeyUsageTypeSignVerify
+			}
+			if test.keyID == "" {
+				test.keyID = "key"
+			}
+			client := &fakeAWSKMS{key: test.key, usage: test.usage}
+
+			signer, err := NewAWS(context.TODO(), client, test.keyID)
+			if test.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			mustSelfSign(t, signer, test.sigAlgo)
+			if client.signingAlgorithm != test.expectedSigningAlgorithm {
+				t.Errorf("unexpected signing algorithm, exp=%s, got=%s", test.expectedSigningAlgorithm, client.signingAlgorithm)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmssigner
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"strings"
+
+	cloudkms "google.golang.org/api/cloudkms/v1"
+)
+
+// GoogleCloudSigner is a crypto.Signer which signs using an asymmetric key
+// version held by Google Cloud KMS.
+// Unlike AWS KMS keys, a Google Cloud KMS key version only supports a single
+// signature algorithm, which is returned by SignatureAlgorithm.
+type GoogleCloudSigner struct {
+	ctx        context.Context
+	service    *cloudkms.Service
+	keyVersion string
+	publicKey  crypto.PublicKey
+
+	hash               crypto.Hash
+	signatureAlgorithm x509.SignatureAlgorithm
+}
+
+var _ crypto.Signer = &GoogleCloudSigner{}
+
+// NewGoogleCloud returns a signer which signs using the Google Cloud KMS key
+// version with the given resource name, in the form
+// `projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*`.
+// The key version must be an asymmetric signing key. The given context is
+// used for all requests made by the signer.
+func NewGoogleCloud(ctx context.Context, service *cloudkms.Service, keyVersion string) (*GoogleCloudSigner, error) {
+	out, err := service.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.GetPublicKey(keyVersion).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of Google Cloud KMS key version %q: %w", keyVersion, err)
+	}
+
+	block, _ := pem.Decode([]byte(out.Pem))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode public key PEM of Google Cloud KMS key version %q", keyVersion)
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key of Google Cloud KMS key version %q: %w", keyVersion, err)
+	}
+
+	hash, sigAlgo, err := googleCloudSignatureAlgorithm(out.Algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("Google Cloud KMS key version %q: %w", keyVersion, err)
+	}
+
+	return &GoogleCloudSigner{
+		ctx:                ctx,
+		service:            service,
+		keyVersion:         keyVersion,
+		publicKey:          pub,
+		hash:               hash,
+		signatureAlgorithm: sigAlgo,
+	}, nil
+}
+
+// Public returns the public key of the Google Cloud KMS key version.
+func (s *GoogleCloudSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// SignatureAlgorithm returns the only signature algorithm supported by the
+// Google Cloud KMS key version.
+func (s *GoogleCloudSigner) SignatureAlgorithm() x509.SignatureAlgorithm {
+	return s.signatureAlgorithm
+}
+
+// Sign signs the given digest using the Google Cloud KMS key version. The
+// hash function and padding must be those of the key version's algorithm.
+func (s *GoogleCloudSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != s.hash {
+		return nil, fmt.Errorf("Google Cloud KMS key version %q only supports the hash function %v, got %v", s.keyVersion, s.hash, opts.HashFunc())
+	}
+	_, pss := opts.(*rsa.PSSOptions)
+	if isPSS(s.signatureAlgorithm) != pss {
+		return nil, fmt.Errorf("Google Cloud KMS key version %q only supports the signature algorithm %v", s.keyVersion, s.signatureAlgorithm)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(digest)
+	d := &cloudkms.Digest{}
+	switch s.hash {
+	case crypto.SHA256:
+		d.Sha256 = encoded
+	case crypto.SHA384:
+		d.Sha384 = encoded
+	case crypto.SHA512:
+		d.Sha512 = encoded
+	}
+
+	out, err := s.service.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.AsymmetricSign(s.keyVersion, &cloudkms.AsymmetricSignRequest{Digest: d}).Context(s.ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign using Google Cloud KMS key version %q: %w", s.keyVersion, err)
+	}
+	return base64.StdEncoding.DecodeString(out.Signature)
+}
+
+// googleCloudSignatureAlgorithm returns the hash function and signature
+// algorithm of the given Google Cloud KMS key version algorithm, such as
+// `EC_SIGN_P256_SHA256` or `RSA_SIGN_PKCS1_2048_SHA256`.
+func googleCloudSignatureAlgorithm(algorithm string) (crypto.Hash, x509.SignatureAlgorithm, error) {
+	var hash crypto.Hash
+	switch {
+	case strings.HasSuffix(algorithm, "_SHA256"):
+		hash = crypto.SHA256
+	case strings.HasSuffix(algorithm, "_SHA384"):
+		hash = crypto.SHA384
+	case strings.HasSuffix(algorithm, "_SHA512"):
+		hash = crypto.SHA512
+	default:
+		return 0, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported key algorithm %q", algorithm)
+	}
+
+	algorithms := map[string]map[crypto.Hash]x509.SignatureAlgorithm{
+		"EC_SIGN_P":       {crypto.SHA256: x509.ECDSAWithSHA256, crypto.SHA384: x509.ECDSAWithSHA384, crypto.SHA512: x509.ECDSAWithSHA512},
+		"RSA_SIGN_PKCS1_": {crypto.SHA256: x509.SHA256WithRSA, crypto.SHA384: x509.SHA384WithRSA, crypto.SHA512: x509.SHA512WithRSA},
+		"RSA_SIGN_PSS_":   {crypto.SHA256: x509.SHA256WithRSAPSS, crypto.SHA384: x509.SHA384WithRSAPSS, crypto.SHA512: x509.SHA512WithRSAPSS},
+	}
+	for prefix, byHash := range algorithms {
+		if strings.HasPrefix(algorithm, prefix) {
+			return hash, byHash[hash], nil
+		}
+	}
+	return 0, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported key algorithm %q", algorithm)
+}
+
+func isPSS(algorithm x509.SignatureAlgorithm) bool {
+	switch algorithm {
+	case x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS:
+		return true
+	}
+	return false
+}
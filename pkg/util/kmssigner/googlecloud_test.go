@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmssigner
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cloudkms "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+)
+
+const testKeyVersion = "projects/p/locations/global/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"
+
+// fakeGoogleCloudKMS serves the Google Cloud KMS API for a single key version,
+// signing using a local private key.
+func fakeGoogleCloudKMS(t *testing.T, key crypto.Signer, algorithm string, opts crypto.SignerOpts) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/"+testKeyVersion+"/publicKey":
+			der, err := x509.MarshalPKIXPublicKey(key.Public())
+			if err != nil {
+				t.Fatal(err)
+			}
+			json.NewEncoder(w).Encode(&cloudkms.PublicKey{
+				Name:      testKeyVersion,
+				Algorithm: algorithm,
+				Pem:       string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/"+testKeyVersion+":asymmetricSign":
+			req := &cloudkms.AsymmetricSignRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Fatal(err)
+			}
+			digest, err := base64.StdEncoding.DecodeString(req.Digest.Sha256 + req.Digest.Sha384 + req.Digest.Sha512)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig, err := key.Sign(rand.Reader, digest, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			json.NewEncoder(w).Encode(&cloudkms.AsymmetricSignResponse{Name: testKeyVersion, Signature: base64.StdEncoding.EncodeToString(sig)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestGoogleCloudSigner(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		key       crypto.Signer
+		algorithm string
+		opts      crypto.SignerOpts
+
+		expectedSigAlgo x509.SignatureAlgorithm
+		expectedErr     bool
+		expectedSignErr bool
+	}{
+		"sign using an ECDSA key version": {
+			key:             ecKey,
+			algorithm:       "EC_SIGN_P256_SHA256",
+			opts:            crypto.SHA256,
+			expectedSigAlgo: x509.ECDSAWithSHA256,
+		},
+		"sign using an RSA key version with PSS padding": {
+			key:             rsaKey,
+			algorithm:       "RSA_SIGN_PSS_2048_SHA512",
+			opts:            &rsa.PSSOptions{Hash: crypto.SHA512, SaltLength: rsa.PSSSaltLengthEqualsHash},
+			expectedSigAlgo: x509.SHA512WithRSAPSS,
+		},
+		"fail to sign using a signature algorithm not supported by the key version": {
+			key:             rsaKey,
+			algorithm:       "RSA_SIGN_PKCS1_2048_SHA512",
+			opts:            crypto.SHA512,
+			expectedSigAlgo: x509.SHA512WithRSA,
+			expectedSignErr: true,
+		},
+		"error if the key version is not a signing key": {
+			key:         rsaKey,
+			algorithm:   "RSA_DECRYPT_OAEP_2048_SHA256",
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := fakeGoogleCloudKMS(t, test.key, test.algorithm, test.opts)
+			defer server.Close()
+			service, err := cloudkms.NewService(context.TODO(), option.WithEndpoint(server.URL+"/"), option.WithHTTPClient(server.Client()))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			signer, err := NewGoogleCloud(context.TODO(), service, testKeyVersion)
+			if test.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if signer.SignatureAlgorithm() != test.expectedSigAlgo {
+				t.Errorf("unexpected signature algorithm, exp=%v, got=%v", test.expectedSigAlgo, signer.SignatureAlgorithm())
+			}
+
+			if test.expectedSignErr {
+				_, err := signer.Sign(rand.Reader, make([]byte, 32), crypto.SHA256)
+				if err == nil || !strings.Contains(err.Error(), "only supports") {
+					t.Errorf("expected an unsupported signature algorithm error, got: %v", err)
+				}
+				return
+			}
+			mustSelfSign(t, signer, signer.SignatureAlgorithm())
+		})
+	}
+}
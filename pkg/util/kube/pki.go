@@ -102,16 +102,41 @@ func SecretTLSKeyPairAndCA(ctx context.Context, secretLister corelisters.SecretL
 		return nil, nil, err
 	}
 
+	certs, err = appendSecretCA(certs, secret)
+	if err != nil {
+		return nil, key, err
+	}
+	return certs, key, nil
+}
+
+// SecretTLSCertChainAndCA returns the X.509 certificate chain contained in the
+// target Secret. If the ca.crt field exists on the Secret, it is parsed and
+// added to the end of the certificate chain.
+func SecretTLSCertChainAndCA(ctx context.Context, secretLister corelisters.SecretLister, namespace, name string) ([]*x509.Certificate, error) {
+	certs, err := SecretTLSCertChain(ctx, secretLister, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := secretLister.Secrets(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendSecretCA(certs, secret)
+}
+
+func appendSecretCA(certs []*x509.Certificate, secret *corev1.Secret) ([]*x509.Certificate, error) {
 	caBytes, ok := secret.Data[cmmeta.TLSCAKey]
 	if !ok || len(caBytes) == 0 {
-		return certs, key, nil
+		return certs, nil
 	}
 	ca, err := pki.DecodeX509CertificateBytes(caBytes)
 	if err != nil {
-		return nil, key, errors.NewInvalidData(err.Error())
+		return nil, errors.NewInvalidData(err.Error())
 	}
 
-	return append(certs, ca), key, nil
+	return append(certs, ca), nil
 }
 
 func SecretTLSKeyPair(ctx context.Context, secretLister corelisters.SecretLister, namespace, name string) ([]*x509.Certificate, crypto.Signer, error) {
@@ -0,0 +1,24 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pkcs11signer implements crypto.Signer using private keys held by
+// PKCS#11 tokens, such as hardware security modules, so that the private key
+// never leaves the token.
+//
+// Access to PKCS#11 modules requires cgo, and is only compiled in if
+// cert-manager is built with the `pkcs11` build tag. Otherwise Open always
+// returns an error.
+package pkcs11signer
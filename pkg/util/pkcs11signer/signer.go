@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkcs11signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// PKCS#11 mechanism types and mask generation functions used by Signer.
+const (
+	CKM_RSA_PKCS     = 0x00000001
+	CKM_RSA_PKCS_PSS = 0x0000000d
+	CKM_SHA256       = 0x00000250
+	CKM_SHA384       = 0x00000260
+	CKM_SHA512       = 0x00000270
+	CKM_ECDSA        = 0x00001041
+
+	CKG_MGF1_SHA256 = 0x00000002
+	CKG_MGF1_SHA384 = 0x00000003
+	CKG_MGF1_SHA512 = 0x00000004
+)
+
+// ErrPublicKeyMismatch is returned by New if the private key on the token
+// does not match the given public key.
+var ErrPublicKeyMismatch = errors.New("the private key on the PKCS#11 token does not match the public key")
+
+// Mechanism is a PKCS#11 signing mechanism.
+type Mechanism struct {
+	// Type is the PKCS#11 mechanism type, such as CKM_ECDSA.
+	Type uint
+
+	// PSS holds the parameters of the CKM_RSA_PKCS_PSS mechanism.
+	PSS *PSSParams
+}
+
+// PSSParams are the parameters of the CKM_RSA_PKCS_PSS mechanism.
+type PSSParams struct {
+	Hash       uint
+	MGF        uint
+	SaltLength uint
+}
+
+// Key is a private key held by a PKCS#11 token.
+type Key interface {
+	// Sign signs the given data with the private key using the given
+	// mechanism, and returns the signature in the format defined by the
+	// mechanism.
+	Sign(mechanism Mechanism, data []byte) ([]byte, error)
+}
+
+// Signer is a crypto.Signer which signs using a private key held by a
+// PKCS#11 token.
+type Signer struct {
+	key       Key
+	publicKey crypto.PublicKey
+}
+
+var _ crypto.Signer = &Signer{}
+
+// New returns a signer which signs using the given key on a PKCS#11 token,
+// whose public key is pub. PKCS#11 does not require a token to hold the
+// public key of a private key, so the public key must be known in advance,
+// e.g. from the certificate of the key. New checks that the key matches the
+// public key by signing a random digest, and returns ErrPublicKeyMismatch if
+// it does not.
+func New(key Key, pub crypto.PublicKey) (*Signer, error) {
+	s := &Signer{key: key, publicKey: pub}
+
+	digest := make([]byte, crypto.SHA256.Size())
+	if _, err := io.ReadFull(rand.Reader, digest); err != nil {
+		return nil, err
+	}
+	sig, err := s.Sign(rand.Reader, digest, crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	var valid bool
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest, sig) == nil
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(pub, digest, sig)
+	}
+	if !valid {
+		return nil, ErrPublicKeyMismatch
+	}
+
+	return s, nil
+}
+
+// Public returns the public key of the PKCS#11 key.
+func (s *Signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign signs the given digest using the PKCS#11 key.
+func (s *Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	hash := opts.HashFunc()
+	if len(digest) != hash.Size() {
+		return nil, fmt.Errorf("digest length %d does not match hash function %v", len(digest), hash)
+	}
+
+	switch pub := s.publicKey.(type) {
+	case *rsa.PublicKey:
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			params, err := pssParams(pub, pssOpts)
+			if err != nil {
+				return nil, err
+			}
+			return s.key.Sign(Mechanism{Type: CKM_RSA_PKCS_PSS, PSS: params}, digest)
+		}
+
+		prefix, ok := pkcs1DigestInfoPrefixes[hash]
+		if !ok {
+			return nil, fmt.Errorf("unsupported hash function %v for RSA PKCS#11 key", hash)
+		}
+		return s.key.Sign(Mechanism{Type: CKM_RSA_PKCS}, append(append([]byte{}, prefix...), digest...))
+
+	case *ecdsa.PublicKey:
+		sig, err := s.key.Sign(Mechanism{Type: CKM_ECDSA}, digest)
+		if err != nil {
+			return nil, err
+		}
+		return ecdsaSignatureToASN1(pub, sig)
+
+	default:
+		return nil, fmt.Errorf("unsupported PKCS#11 public key type %T", pub)
+	}
+}
+
+// pkcs1DigestInfoPrefixes are the DER encoded DigestInfo prefixes of digests
+// signed using the CKM_RSA_PKCS mechanism, which only pads the data it signs.
+var pkcs1DigestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// pssParams returns the parameters of the CKM_RSA_PKCS_PSS mechanism for the
+// given RSA key and PSS options.
+func pssParams(pub *rsa.PublicKey, opts *rsa.PSSOptions) (*PSSParams, error) {
+	var params PSSParams
+	switch opts.Hash {
+	case crypto.SHA256:
+		params.Hash, params.MGF = CKM_SHA256, CKG_MGF1_SHA256
+	case crypto.SHA384:
+		params.Hash, params.MGF = CKM_SHA384, CKG_MGF1_SHA384
+	case crypto.SHA512:
+		params.Hash, params.MGF = CKM_SHA512, CKG_MGF1_SHA512
+	default:
+		return nil, fmt.Errorf("unsupported hash function %v for RSA PSS PKCS#11 key", opts.Hash)
+	}
+
+	switch opts.SaltLength {
+	case rsa.PSSSaltLengthEqualsHash:
+		params.SaltLength = uint(opts.Hash.Size())
+	case rsa.PSSSaltLengthAuto:
+		// as crypto/rsa, use the maximum salt length when signing
+		params.SaltLength = uint((pub.N.BitLen()-1+7)/8 - 2 - opts.Hash.Size())
+	default:
+		if opts.SaltLength < 0 {
+			return nil, fmt.Errorf("invalid RSA PSS salt length %d", opts.SaltLength)
+		}
+		params.SaltLength = uint(opts.SaltLength)
+	}
+
+	return &params, nil
+}
+
+// ecdsaSignatureToASN1 converts an ECDSA signature returned by the CKM_ECDSA
+// mechanism, which is the concatenation of r and s, to the ASN.1 encoding
+// expected from a crypto.Signer.
+func ecdsaSignatureToASN1(pub *ecdsa.PublicKey, sig []byte) ([]byte, error) {
+	size := (pub.Curve.Params().BitSize + 7) / 8
+	if len(sig) != 2*size {
+		return nil, fmt.Errorf("invalid ECDSA signature length %d returned by PKCS#11 token", len(sig))
+	}
+
+	return asn1.Marshal(struct {
+		R, S *big.Int
+	}{
+		R: new(big.Int).SetBytes(sig[:size]),
+		S: new(big.Int).SetBytes(sig[size:]),
+	})
+}
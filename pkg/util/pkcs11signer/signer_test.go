@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkcs11signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// fakeKey implements the PKCS#11 signing mechanisms used by Signer using a
+// local private key.
+type fakeKey struct {
+	key crypto.Signer
+
+	mechanism Mechanism
+}
+
+func (f *fakeKey) Sign(mechanism Mechanism, data []byte) ([]byte, error) {
+	f.mechanism = mechanism
+
+	switch key := f.key.(type) {
+	case *rsa.PrivateKey:
+		switch mechanism.Type {
+		case CKM_RSA_PKCS:
+			// the data is the DigestInfo of the digest, which is signed
+			// without a hash function
+			return rsa.SignPKCS1v15(rand.Reader, key, 0, data)
+		case CKM_RSA_PKCS_PSS:
+			hashes := map[uint]crypto.Hash{CKM_SHA256: crypto.SHA256, CKM_SHA384: crypto.SHA384, CKM_SHA512: crypto.SHA512}
+			hash, ok := hashes[mechanism.PSS.Hash]
+			if !ok {
+				return nil, fmt.Errorf("unexpected PSS hash %x", mechanism.PSS.Hash)
+			}
+			return rsa.SignPSS(rand.Reader, key, hash, data, &rsa.PSSOptions{Hash: hash, SaltLength: int(mechanism.PSS.SaltLength)})
+		}
+	case *ecdsa.PrivateKey:
+		if mechanism.Type == CKM_ECDSA {
+			r, s, err := ecdsa.Sign(rand.Reader, key, data)
+			if err != nil {
+				return nil, err
+			}
+			size := (key.Curve.Params().BitSize + 7) / 8
+			sig := make([]byte, 2*size)
+			r.FillBytes(sig[:size])
+			s.FillBytes(sig[size:])
+			return sig, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported mechanism %x for %T", mechanism.Type, f.key)
+}
+
+// mustSelfSign signs a CA certificate template using the given signer, and
+// verifies the signature of the resulting certificate.
+func mustSelfSign(t *testing.T, signer crypto.Signer, sigAlgo x509.SignatureAlgorithm) {
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "pkcs11-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		SignatureAlgorithm:    sigAlgo,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, signer.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cert.CheckSignatureFrom(cert); err != nil {
+		t.Errorf("failed to verify certificate signed using PKCS#11 signer: %v", err)
+	}
+}
+
+func TestSigner(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherRSAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		key     crypto.Signer
+		pub     crypto.PublicKey
+		sigAlgo x509.SignatureAlgorithm
+
+		expectedErr       error
+		expectedMechanism Mechanism
+	}{
+		"sign using an ECDSA key": {
+			key:               ecKey,
+			expectedMechanism: Mechanism{Type: CKM_ECDSA},
+		},
+		"sign using an RSA key": {
+			key:               rsaKey,
+			expectedMechanism: Mechanism{Type: CKM_RSA_PKCS},
+		},
+		"sign using an RSA key with SHA-512": {
+			key:               rsaKey,
+			sigAlgo:           x509.SHA512WithRSA,
+			expectedMechanism: Mechanism{Type: CKM_RSA_PKCS},
+		},
+		"sign using an RSA key with PSS padding": {
+			key:     rsaKey,
+			sigAlgo: x509.SHA384WithRSAPSS,
+			expectedMechanism: Mechanism{Type: CKM_RSA_PKCS_PSS, PSS: &PSSParams{
+				Hash:       CKM_SHA384,
+				MGF:        CKG_MGF1_SHA384,
+				SaltLength: 48,
+			}},
+		},
+		"error if the key does not match the public key": {
+			key:         rsaKey,
+			pub:         otherRSAKey.Public(),
+			expectedErr: ErrPublicKeyMismatch,
+		},
+		"error if the key type does not match the public key": {
+			key:         ecKey,
+			pub:         rsaKey.Public(),
+			expectedErr: errors.New("unsupported mechanism 1 for *ecdsa.PrivateKey"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.pub == nil {
+				test.pub = test.key.Public()
+			}
+			key := &fakeKey{key: test.key}
+
+			signer, err := New(key, test.pub)
+			if (test.expectedErr == nil) != (err == nil) || (err != nil && err.Error() != test.expectedErr.Error()) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			mustSelfSign(t, signer, test.sigAlgo)
+			if key.mechanism.Type != test.expectedMechanism.Type {
+				t.Errorf("unexpected mechanism, exp=%x, got=%x", test.expectedMechanism.Type, key.mechanism.Type)
+			}
+			if exp, got := test.expectedMechanism.PSS, key.mechanism.PSS; (exp == nil) != (got == nil) || (exp != nil && *exp != *got) {
+				t.Errorf("unexpected PSS parameters, exp=%+v, got=%+v", exp, got)
+			}
+		})
+	}
+}
+
+func TestSignerInvalidDigest(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := New(&fakeKey{key: ecKey}, ecKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.Sign(rand.Reader, make([]byte, 20), crypto.SHA256); err == nil {
+		t.Errorf("expected an error signing a digest of the wrong length")
+	}
+}
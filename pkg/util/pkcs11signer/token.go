@@ -0,0 +1,39 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkcs11signer
+
+import "errors"
+
+// ErrNotSupported is returned by Open if cert-manager was built without
+// PKCS#11 support.
+var ErrNotSupported = errors.New("PKCS#11 is not supported by this build of cert-manager, which must be built with the pkcs11 build tag")
+
+// Config identifies a private key on a PKCS#11 token.
+type Config struct {
+	// ModulePath is the path of the PKCS#11 module of the token.
+	ModulePath string
+
+	// Slot is the ID of the slot holding the token.
+	Slot uint
+
+	// PIN is the user PIN of the token.
+	PIN string
+
+	// KeyLabel is the label of the private key. If empty, the token must
+	// hold exactly one private key.
+	KeyLabel string
+}
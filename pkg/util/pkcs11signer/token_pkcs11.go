@@ -0,0 +1,386 @@
+//go:build pkcs11
+// +build pkcs11
+
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkcs11signer
+
+/*
+#cgo linux LDFLAGS: -ldl
+
+#include <dlfcn.h>
+#include <stdlib.h>
+#include <string.h>
+
+// The subset of the PKCS#11 v2.40 C API used to sign with a private key.
+// Structures are not packed, as specified for Unix platforms.
+
+typedef unsigned long CK_ULONG;
+typedef CK_ULONG CK_RV;
+typedef CK_ULONG CK_SLOT_ID;
+typedef CK_ULONG CK_SESSION_HANDLE;
+typedef CK_ULONG CK_OBJECT_HANDLE;
+
+typedef struct {
+	CK_ULONG type;
+	void *pValue;
+	CK_ULONG ulValueLen;
+} CK_ATTRIBUTE;
+
+typedef struct {
+	CK_ULONG mechanism;
+	void *pParameter;
+	CK_ULONG ulParameterLen;
+} CK_MECHANISM;
+
+typedef struct {
+	CK_ULONG hashAlg;
+	CK_ULONG mgf;
+	CK_ULONG sLen;
+} CK_RSA_PKCS_PSS_PARAMS;
+
+typedef struct {
+	void *CreateMutex;
+	void *DestroyMutex;
+	void *LockMutex;
+	void *UnlockMutex;
+	CK_ULONG flags;
+	void *pReserved;
+} CK_C_INITIALIZE_ARGS;
+
+// CK_FUNCTION_LIST is accessed by the index of each function in the list.
+typedef struct {
+	unsigned char major;
+	unsigned char minor;
+	void *functions[1];
+} CK_FUNCTION_LIST;
+
+#define FN_C_Initialize 0
+#define FN_C_OpenSession 12
+#define FN_C_CloseSession 13
+#define FN_C_Login 18
+#define FN_C_FindObjectsInit 26
+#define FN_C_FindObjects 27
+#define FN_C_FindObjectsFinal 28
+#define FN_C_SignInit 42
+#define FN_C_Sign 43
+
+#define CKF_OS_LOCKING_OK 0x2
+#define CKF_SERIAL_SESSION 0x4
+#define CKU_USER 1
+
+// p11_open loads the PKCS#11 module at the given path and gets its function
+// list. If the module cannot be loaded, NULL is returned and err is set to a
+// copy of the error of the dynamic linker, which must be freed.
+static void *p11_open(const char *path, CK_FUNCTION_LIST **list, CK_RV *rv, char **err) {
+	void *handle = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+	if (handle == NULL) {
+		*err = strdup(dlerror());
+		return NULL;
+	}
+	CK_RV (*getFunctionList)(CK_FUNCTION_LIST **) = dlsym(handle, "C_GetFunctionList");
+	if (getFunctionList == NULL) {
+		*err = strdup(dlerror());
+		dlclose(handle);
+		return NULL;
+	}
+	*rv = getFunctionList(list);
+	return handle;
+}
+
+static CK_RV p11_initialize(CK_FUNCTION_LIST *list) {
+	CK_C_INITIALIZE_ARGS args = {0};
+	args.flags = CKF_OS_LOCKING_OK;
+	return ((CK_RV (*)(void *))list->functions[FN_C_Initialize])(&args);
+}
+
+static CK_RV p11_open_session(CK_FUNCTION_LIST *list, CK_SLOT_ID slot, CK_SESSION_HANDLE *session) {
+	return ((CK_RV (*)(CK_SLOT_ID, CK_ULONG, void *, void *, CK_SESSION_HANDLE *))list->functions[FN_C_OpenSession])(slot, CKF_SERIAL_SESSION, NULL, NULL, session);
+}
+
+static CK_RV p11_close_session(CK_FUNCTION_LIST *list, CK_SESSION_HANDLE session) {
+	return ((CK_RV (*)(CK_SESSION_HANDLE))list->functions[FN_C_CloseSession])(session);
+}
+
+static CK_RV p11_login(CK_FUNCTION_LIST *list, CK_SESSION_HANDLE session, char *pin, CK_ULONG pinLen) {
+	return ((CK_RV (*)(CK_SESSION_HANDLE, CK_ULONG, char *, CK_ULONG))list->functions[FN_C_Login])(session, CKU_USER, pin, pinLen);
+}
+
+static CK_RV p11_find_objects(CK_FUNCTION_LIST *list, CK_SESSION_HANDLE session, CK_ATTRIBUTE *template, CK_ULONG count, CK_OBJECT_HANDLE *objects, CK_ULONG max, CK_ULONG *found) {
+	CK_RV rv = ((CK_RV (*)(CK_SESSION_HANDLE, CK_ATTRIBUTE *, CK_ULONG))list->functions[FN_C_FindObjectsInit])(session, template, count);
+	if (rv != 0) {
+		return rv;
+	}
+	rv = ((CK_RV (*)(CK_SESSION_HANDLE, CK_OBJECT_HANDLE *, CK_ULONG, CK_ULONG *))list->functions[FN_C_FindObjects])(session, objects, max, found);
+	CK_RV finalRV = ((CK_RV (*)(CK_SESSION_HANDLE))list->functions[FN_C_FindObjectsFinal])(session);
+	if (rv != 0) {
+		return rv;
+	}
+	return finalRV;
+}
+
+// p11_sign_init starts signing data, and returns the length of the signature
+// in sigLen. Querying the length of the signature does not terminate the
+// signing operation, which is completed by p11_sign.
+static CK_RV p11_sign_init(CK_FUNCTION_LIST *list, CK_SESSION_HANDLE session, CK_MECHANISM *mechanism, CK_OBJECT_HANDLE key, unsigned char *data, CK_ULONG dataLen, CK_ULONG *sigLen) {
+	CK_RV rv = ((CK_RV (*)(CK_SESSION_HANDLE, CK_MECHANISM *, CK_OBJECT_HANDLE))list->functions[FN_C_SignInit])(session, mechanism, key);
+	if (rv != 0) {
+		return rv;
+	}
+	return ((CK_RV (*)(CK_SESSION_HANDLE, unsigned char *, CK_ULONG, unsigned char *, CK_ULONG *))list->functions[FN_C_Sign])(session, data, dataLen, NULL, sigLen);
+}
+
+static CK_RV p11_sign(CK_FUNCTION_LIST *list, CK_SESSION_HANDLE session, unsigned char *data, CK_ULONG dataLen, unsigned char *sig, CK_ULONG *sigLen) {
+	return ((CK_RV (*)(CK_SESSION_HANDLE, unsigned char *, CK_ULONG, unsigned char *, CK_ULONG *))list->functions[FN_C_Sign])(session, data, dataLen, sig, sigLen);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+const (
+	ckaClass      = 0x00000000
+	ckaLabel      = 0x00000003
+	ckoPrivateKey = 0x00000003
+
+	ckrOK                         = 0x00000000
+	ckrUserAlreadyLoggedIn        = 0x00000100
+	ckrCryptokiAlreadyInitialized = 0x00000191
+)
+
+var (
+	// modules holds the PKCS#11 modules which have been loaded and
+	// initialized, by path. Modules are never unloaded, as C_Initialize
+	// may only be called once by an application.
+	modules   = map[string]*C.CK_FUNCTION_LIST{}
+	modulesMu sync.Mutex
+
+	// keys holds the keys which have been opened, so that a logged in
+	// session is reused for every signature made with the key.
+	keys   = map[Config]*tokenKey{}
+	keysMu sync.Mutex
+)
+
+// Open returns the private key identified by the given config.
+// Keys are cached, so that the PKCS#11 module is loaded and the token is
+// logged into only once. A key is opened again if signing with it fails, e.g.
+// because the token was removed.
+func Open(cfg Config) (Key, error) {
+	keysMu.Lock()
+	defer keysMu.Unlock()
+
+	if key, ok := keys[cfg]; ok {
+		if !key.isBroken() {
+			return key, nil
+		}
+		key.close()
+		delete(keys, cfg)
+	}
+
+	list, err := loadModule(cfg.ModulePath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := openKey(list, cfg)
+	if err != nil {
+		return nil, err
+	}
+	keys[cfg] = key
+	return key, nil
+}
+
+func loadModule(path string) (*C.CK_FUNCTION_LIST, error) {
+	modulesMu.Lock()
+	defer modulesMu.Unlock()
+
+	if list, ok := modules[path]; ok {
+		return list, nil
+	}
+
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	var list *C.CK_FUNCTION_LIST
+	var rv C.CK_RV
+	var cErr *C.char
+	if handle := C.p11_open(cPath, &list, &rv, &cErr); handle == nil {
+		defer C.free(unsafe.Pointer(cErr))
+		return nil, fmt.Errorf("failed to load PKCS#11 module %q: %s", path, C.GoString(cErr))
+	}
+	if rv != ckrOK {
+		return nil, fmt.Errorf("failed to get function list of PKCS#11 module %q: %w", path, ckError(rv))
+	}
+	if rv := C.p11_initialize(list); rv != ckrOK && rv != ckrCryptokiAlreadyInitialized {
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module %q: %w", path, ckError(rv))
+	}
+
+	modules[path] = list
+	return list, nil
+}
+
+// tokenKey is a private key on a PKCS#11 token, which is signed with using a
+// logged in session. PKCS#11 sessions may not be used concurrently.
+type tokenKey struct {
+	list    *C.CK_FUNCTION_LIST
+	session C.CK_SESSION_HANDLE
+	handle  C.CK_OBJECT_HANDLE
+
+	mu     sync.Mutex
+	broken bool
+}
+
+func openKey(list *C.CK_FUNCTION_LIST, cfg Config) (*tokenKey, error) {
+	var session C.CK_SESSION_HANDLE
+	if rv := C.p11_open_session(list, C.CK_SLOT_ID(cfg.Slot), &session); rv != ckrOK {
+		return nil, fmt.Errorf("failed to open session with PKCS#11 token in slot %d: %w", cfg.Slot, ckError(rv))
+	}
+	key := &tokenKey{list: list, session: session}
+
+	cPIN := C.CString(cfg.PIN)
+	defer C.free(unsafe.Pointer(cPIN))
+	// the login state is shared by all sessions of the application with the
+	// token, so another key on the same token may have logged in already
+	if rv := C.p11_login(list, session, cPIN, C.CK_ULONG(len(cfg.PIN))); rv != ckrOK && rv != ckrUserAlreadyLoggedIn {
+		key.close()
+		return nil, fmt.Errorf("failed to log in to PKCS#11 token in slot %d: %w", cfg.Slot, ckError(rv))
+	}
+
+	handle, err := key.findPrivateKey(cfg.KeyLabel)
+	if err != nil {
+		key.close()
+		return nil, fmt.Errorf("PKCS#11 token in slot %d: %w", cfg.Slot, err)
+	}
+	key.handle = handle
+
+	return key, nil
+}
+
+// findPrivateKey returns the handle of the private key with the given label,
+// or of the only private key on the token if label is empty.
+func (k *tokenKey) findPrivateKey(label string) (C.CK_OBJECT_HANDLE, error) {
+	// the template and its values are passed to C, so must be allocated in
+	// C memory
+	template := (*[2]C.CK_ATTRIBUTE)(C.calloc(2, C.size_t(unsafe.Sizeof(C.CK_ATTRIBUTE{}))))
+	defer C.free(unsafe.Pointer(template))
+	class := (*C.CK_ULONG)(C.malloc(C.size_t(unsafe.Sizeof(C.CK_ULONG(0)))))
+	defer C.free(unsafe.Pointer(class))
+	*class = ckoPrivateKey
+	template[0] = C.CK_ATTRIBUTE{_type: ckaClass, pValue: unsafe.Pointer(class), ulValueLen: C.CK_ULONG(unsafe.Sizeof(*class))}
+	count := 1
+	if label != "" {
+		cLabel := C.CBytes([]byte(label))
+		defer C.free(cLabel)
+		template[1] = C.CK_ATTRIBUTE{_type: ckaLabel, pValue: cLabel, ulValueLen: C.CK_ULONG(len(label))}
+		count++
+	}
+
+	// find up to two keys, to detect ambiguous keys
+	objects := (*[2]C.CK_OBJECT_HANDLE)(C.calloc(2, C.size_t(unsafe.Sizeof(C.CK_OBJECT_HANDLE(0)))))
+	defer C.free(unsafe.Pointer(objects))
+	found := (*C.CK_ULONG)(C.malloc(C.size_t(unsafe.Sizeof(C.CK_ULONG(0)))))
+	defer C.free(unsafe.Pointer(found))
+	if rv := C.p11_find_objects(k.list, k.session, &template[0], C.CK_ULONG(count), &objects[0], 2, found); rv != ckrOK {
+		return 0, fmt.Errorf("failed to find private key: %w", ckError(rv))
+	}
+
+	switch {
+	case *found == 0 && label != "":
+		return 0, fmt.Errorf("no private key with label %q", label)
+	case *found == 0:
+		return 0, fmt.Errorf("no private key")
+	case *found > 1 && label != "":
+		return 0, fmt.Errorf("more than one private key with label %q", label)
+	case *found > 1:
+		return 0, fmt.Errorf("more than one private key, a key label must be configured")
+	}
+	return objects[0], nil
+}
+
+// Sign signs the given data with the key using the given mechanism.
+func (k *tokenKey) Sign(mechanism Mechanism, data []byte) ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.broken {
+		return nil, fmt.Errorf("failed to sign using PKCS#11 key: the session with the token has been closed")
+	}
+	sig, err := k.sign(mechanism, data)
+	if err != nil {
+		// the session may no longer be usable, so open it again the next
+		// time the key is opened
+		k.broken = true
+	}
+	return sig, err
+}
+
+func (k *tokenKey) sign(mechanism Mechanism, data []byte) ([]byte, error) {
+	mech := (*C.CK_MECHANISM)(C.calloc(1, C.size_t(unsafe.Sizeof(C.CK_MECHANISM{}))))
+	defer C.free(unsafe.Pointer(mech))
+	mech.mechanism = C.CK_ULONG(mechanism.Type)
+	if mechanism.PSS != nil {
+		params := (*C.CK_RSA_PKCS_PSS_PARAMS)(C.malloc(C.size_t(unsafe.Sizeof(C.CK_RSA_PKCS_PSS_PARAMS{}))))
+		defer C.free(unsafe.Pointer(params))
+		*params = C.CK_RSA_PKCS_PSS_PARAMS{
+			hashAlg: C.CK_ULONG(mechanism.PSS.Hash),
+			mgf:     C.CK_ULONG(mechanism.PSS.MGF),
+			sLen:    C.CK_ULONG(mechanism.PSS.SaltLength),
+		}
+		mech.pParameter = unsafe.Pointer(params)
+		mech.ulParameterLen = C.CK_ULONG(unsafe.Sizeof(*params))
+	}
+
+	cData := C.CBytes(data)
+	defer C.free(cData)
+	sigLen := (*C.CK_ULONG)(C.malloc(C.size_t(unsafe.Sizeof(C.CK_ULONG(0)))))
+	defer C.free(unsafe.Pointer(sigLen))
+
+	if rv := C.p11_sign_init(k.list, k.session, mech, k.handle, (*C.uchar)(cData), C.CK_ULONG(len(data)), sigLen); rv != ckrOK {
+		return nil, fmt.Errorf("failed to sign using PKCS#11 key: %w", ckError(rv))
+	}
+	sig := C.malloc(C.size_t(*sigLen))
+	defer C.free(sig)
+	if rv := C.p11_sign(k.list, k.session, (*C.uchar)(cData), C.CK_ULONG(len(data)), (*C.uchar)(sig), sigLen); rv != ckrOK {
+		return nil, fmt.Errorf("failed to sign using PKCS#11 key: %w", ckError(rv))
+	}
+
+	return C.GoBytes(sig, C.int(*sigLen)), nil
+}
+
+func (k *tokenKey) isBroken() bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.broken
+}
+
+// close closes the session of the key, after which it can no longer be used.
+func (k *tokenKey) close() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.broken = true
+	C.p11_close_session(k.list, k.session)
+}
+
+// ckError is a PKCS#11 return value other than CKR_OK.
+type ckError C.CK_RV
+
+func (e ckError) Error() string {
+	return fmt.Sprintf("PKCS#11 error 0x%08X", uint(e))
+}
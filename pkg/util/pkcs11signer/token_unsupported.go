@@ -1,5 +1,8 @@
+//go:build !pkcs11
+// +build !pkcs11
+
 /*
-Copyright 2020 The cert-manager Authors.
+Copyright 2021 The cert-manager Authors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,15 +17,10 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package v1beta1
-
-import (
-	"k8s.io/apimachinery/pkg/conversion"
-
-	"github.com/jetstack/cert-manager/pkg/apis/certmanager/v1beta1"
-	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
-)
+package pkcs11signer
 
-func Convert_certmanager_X509Subject_To_v1beta1_X509Subject(in *certmanager.X509Subject, out *v1beta1.X509Subject, s conversion.Scope) error {
-	return autoConvert_certmanager_X509Subject_To_v1beta1_X509Subject(in, out, s)
+// Open always returns ErrNotSupported, as cert-manager was built without the
+// pkcs11 build tag.
+func Open(Config) (Key, error) {
+	return nil, ErrNotSupported
 }
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+)
+
+// OIDChallengePassword is the PKCS #9 challengePassword attribute, used by
+// enrollment protocols such as SCEP to authenticate a certificate signing
+// request.
+var OIDChallengePassword = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 7}
+
+type certificateRequest struct {
+	TBSCSR             tbsCertificateRequest
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	SignatureValue     asn1.BitString
+}
+
+type tbsCertificateRequest struct {
+	Version       int
+	Subject       asn1.RawValue
+	PublicKey     asn1.RawValue
+	RawAttributes []asn1.RawValue `asn1:"tag:0"`
+}
+
+type csrAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// EncodeCSRWithChallengePassword encodes the certificate signing request
+// like EncodeCSR, with the given challenge password added to its attributes.
+func EncodeCSRWithChallengePassword(template *x509.CertificateRequest, key crypto.Signer, password string) ([]byte, error) {
+	derBytes, err := EncodeCSR(template, key)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := x509.ParseCertificateRequest(derBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var csr certificateRequest
+	if _, err := asn1.Unmarshal(derBytes, &csr); err != nil {
+		return nil, fmt.Errorf("error decoding certificate signing request: %w", err)
+	}
+
+	value, err := asn1.MarshalWithParams(password, "printable")
+	if err != nil {
+		// the password contains characters which are not allowed in a
+		// PrintableString
+		value, err = asn1.MarshalWithParams(password, "utf8")
+		if err != nil {
+			return nil, err
+		}
+	}
+	attribute, err := asn1.Marshal(csrAttribute{
+		Type:   OIDChallengePassword,
+		Values: []asn1.RawValue{{FullBytes: value}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	csr.TBSCSR.RawAttributes = append(csr.TBSCSR.RawAttributes, asn1.RawValue{FullBytes: attribute})
+
+	tbs, err := asn1.Marshal(csr.TBSCSR)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signWithAlgorithm(key, parsed.SignatureAlgorithm, tbs)
+	if err != nil {
+		return nil, fmt.Errorf("error signing certificate signing request: %w", err)
+	}
+	csr.SignatureValue = asn1.BitString{Bytes: signature, BitLength: len(signature) * 8}
+
+	return asn1.Marshal(csr)
+}
+
+// ChallengePasswordFromCSR returns the challenge password of the DER encoded
+// certificate signing request, and whether it has one.
+func ChallengePasswordFromCSR(derBytes []byte) (string, bool, error) {
+	var csr certificateRequest
+	if _, err := asn1.Unmarshal(derBytes, &csr); err != nil {
+		return "", false, fmt.Errorf("error decoding certificate signing request: %w", err)
+	}
+
+	for _, raw := range csr.TBSCSR.RawAttributes {
+		var attribute csrAttribute
+		if _, err := asn1.Unmarshal(raw.FullBytes, &attribute); err != nil {
+			return "", false, fmt.Errorf("error decoding certificate signing request attribute: %w", err)
+		}
+		if !attribute.Type.Equal(OIDChallengePassword) || len(attribute.Values) == 0 {
+			continue
+		}
+		var password string
+		if _, err := asn1.Unmarshal(attribute.Values[0].FullBytes, &password); err != nil {
+			return "", false, fmt.Errorf("error decoding challenge password: %w", err)
+		}
+		return password, true, nil
+	}
+
+	return "", false, nil
+}
+
+// signWithAlgorithm signs the data with the key, using the given signature
+// algorithm.
+func signWithAlgorithm(key crypto.Signer, algorithm x509.SignatureAlgorithm, data []byte) ([]byte, error) {
+	var opts crypto.SignerOpts
+	switch algorithm {
+	case x509.SHA1WithRSA, x509.ECDSAWithSHA1:
+		opts = crypto.SHA1
+	case x509.SHA256WithRSA, x509.ECDSAWithSHA256:
+		opts = crypto.SHA256
+	case x509.SHA384WithRSA, x509.ECDSAWithSHA384:
+		opts = crypto.SHA384
+	case x509.SHA512WithRSA, x509.ECDSAWithSHA512:
+		opts = crypto.SHA512
+	case x509.SHA256WithRSAPSS:
+		opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+	case x509.SHA384WithRSAPSS:
+		opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA384}
+	case x509.SHA512WithRSAPSS:
+		opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA512}
+	case x509.PureEd25519:
+		// Ed25519 signs the message itself rather than a digest
+		return key.Sign(rand.Reader, data, crypto.Hash(0))
+	default:
+		return nil, fmt.Errorf("unsupported signature algorithm %s", algorithm)
+	}
+
+	h := opts.HashFunc().New()
+	h.Write(data)
+	return key.Sign(rand.Reader, h.Sum(nil), opts)
+}
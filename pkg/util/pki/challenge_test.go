@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+)
+
+func TestEncodeCSRWithChallengePassword(t *testing.T) {
+	rsaKey, err := GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKey, err := GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		key       crypto.Signer
+		algorithm x509.SignatureAlgorithm
+		password  string
+	}{
+		"rsa key with printable password": {
+			key:       rsaKey,
+			algorithm: x509.SHA256WithRSA,
+			password:  "one-time password",
+		},
+		"rsa key with PSS signature": {
+			key:       rsaKey,
+			algorithm: x509.SHA384WithRSAPSS,
+			password:  "password",
+		},
+		"ecdsa key with non-printable password": {
+			key:       ecKey,
+			algorithm: x509.ECDSAWithSHA256,
+			password:  "p@ssw0rd!",
+		},
+		"ed25519 key": {
+			key:       edKey,
+			algorithm: x509.PureEd25519,
+			password:  "password",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			template := &x509.CertificateRequest{
+				Subject:            pkix.Name{CommonName: "example.com"},
+				DNSNames:           []string{"example.com"},
+				SignatureAlgorithm: test.algorithm,
+			}
+			der, err := EncodeCSRWithChallengePassword(template, test.key, test.password)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			csr, err := x509.ParseCertificateRequest(der)
+			if err != nil {
+				t.Fatalf("failed to parse CSR: %v", err)
+			}
+			if err := csr.CheckSignature(); err != nil {
+				t.Errorf("expected CSR to have a valid signature: %v", err)
+			}
+			if len(csr.DNSNames) != 1 || csr.DNSNames[0] != "example.com" {
+				t.Errorf("expected extensions to be preserved, got DNS names %v", csr.DNSNames)
+			}
+
+			password, ok, err := ChallengePasswordFromCSR(der)
+			if err != nil {
+				t.Fatalf("unexpected error reading challenge password: %v", err)
+			}
+			if !ok || password != test.password {
+				t.Errorf("unexpected challenge password, exp=%q got=%q (found=%t)", test.password, password, ok)
+			}
+		})
+	}
+}
+
+func TestChallengePasswordFromCSRWithoutPassword(t *testing.T) {
+	key, err := GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := EncodeCSR(&x509.CertificateRequest{Subject: pkix.Name{CommonName: "example.com"}}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok, err := ChallengePasswordFromCSR(der); err != nil || ok {
+		t.Errorf("expected no challenge password, found=%t err=%v", ok, err)
+	}
+}
+
+func TestParseSHA256Fingerprint(t *testing.T) {
+	tests := map[string]struct {
+		fingerprint string
+		wantErr     bool
+	}{
+		"hex": {
+			fingerprint: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		},
+		"colon separated": {
+			fingerprint: "9F:86:D0:81:88:4C:7D:65:9A:2F:EA:A0:C5:5A:D0:15:A3:BF:4F:1B:2B:0B:82:2C:D1:5D:6C:15:B0:F0:0A:08",
+		},
+		"not hex": {
+			fingerprint: "not-a-fingerprint",
+			wantErr:     true,
+		},
+		"SHA-1 fingerprint": {
+			fingerprint: "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3",
+			wantErr:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSHA256Fingerprint(test.fingerprint)
+			if (err != nil) != test.wantErr {
+				t.Errorf("unexpected error, wantErr=%t got=%v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"crypto"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
@@ -35,6 +36,7 @@ import (
 	"github.com/hashicorp/vault/sdk/helper/certutil"
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util"
 )
 
 func IPAddressesForCertificate(crt *v1.Certificate) []net.IP {
@@ -58,13 +60,37 @@ func URIsForCertificate(crt *v1.Certificate) ([]*url.URL, error) {
 	return uris, nil
 }
 
+// DNSNamesForCertificate returns the DNS names to request for the
+// Certificate. Internationalized domain names are encoded in their A-label
+// form, as required for subjectAltNames and ACME identifiers.
 func DNSNamesForCertificate(crt *v1.Certificate) ([]string, error) {
 	_, err := URLsFromStrings(crt.Spec.DNSNames)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse DNSNames: %s", err)
 	}
 
-	return crt.Spec.DNSNames, nil
+	var dnsNames []string
+	for _, name := range crt.Spec.DNSNames {
+		ascii, err := util.DNSNameToASCII(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse DNSNames: %s", err)
+		}
+		dnsNames = append(dnsNames, ascii)
+	}
+
+	return dnsNames, nil
+}
+
+// CommonNameToASCII returns the common name to request for a Certificate
+// with the given commonName. A common name which is an internationalized
+// domain name is encoded in its A-label form so that it matches the
+// corresponding DNS name. Any other common name is returned unchanged.
+func CommonNameToASCII(commonName string) string {
+	ascii, err := util.DNSNameToASCII(commonName)
+	if err != nil {
+		return commonName
+	}
+	return ascii
 }
 
 func URLsFromStrings(urlStrs []string) ([]*url.URL, error) {
@@ -142,6 +168,51 @@ func SubjectForCertificate(crt *v1.Certificate) v1.X509Subject {
 	return *crt.Spec.Subject
 }
 
+// OIDUserID is the OID of the userID (UID) attribute of a subject.
+var OIDUserID = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+
+// ExtraNamesForSubject returns the attributes of the given subject which are
+// not represented by a field of pkix.Name, which are its UID followed by its
+// extra names.
+func ExtraNamesForSubject(subject v1.X509Subject) ([]pkix.AttributeTypeAndValue, error) {
+	extraNames := []pkix.AttributeTypeAndValue{}
+	if len(subject.UID) > 0 {
+		extraNames = append(extraNames, pkix.AttributeTypeAndValue{
+			Type:  OIDUserID,
+			Value: subject.UID,
+		})
+	}
+
+	for _, typeValue := range subject.ExtraNames {
+		extraName, err := ParseExtraName(typeValue)
+		if err != nil {
+			return nil, err
+		}
+		extraNames = append(extraNames, extraName)
+	}
+
+	return extraNames, nil
+}
+
+// ParseExtraName parses an extra name of a subject in the format
+// n.n.n.n=value.
+func ParseExtraName(typeValue string) (pkix.AttributeTypeAndValue, error) {
+	parts := strings.SplitN(typeValue, "=", 2)
+	if len(parts) != 2 {
+		return pkix.AttributeTypeAndValue{}, fmt.Errorf("invalid extraNames format in %s. Should be n.n.n.n=value", typeValue)
+	}
+
+	oid, err := certutil.StringToOid(parts[0])
+	if err != nil {
+		return pkix.AttributeTypeAndValue{}, fmt.Errorf("invalid OID format in %s. Should be n.n.n.n=value", typeValue)
+	}
+
+	return pkix.AttributeTypeAndValue{
+		Type:  oid,
+		Value: parts[1],
+	}, nil
+}
+
 var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
 
 func BuildKeyUsages(usages []v1.KeyUsage, isCA bool) (ku x509.KeyUsage, eku []x509.ExtKeyUsage, err error) {
@@ -179,7 +250,7 @@ func BuildCertManagerKeyUsages(ku x509.KeyUsage, eku []x509.ExtKeyUsage) []v1.Ke
 // The CSR will not be signed, and should be passed to either EncodeCSR or
 // to the x509.CreateCertificateRequest function.
 func GenerateCSR(crt *v1.Certificate) (*x509.CertificateRequest, error) {
-	commonName := crt.Spec.CommonName
+	commonName := CommonNameToASCII(crt.Spec.CommonName)
 	iPAddresses := IPAddressesForCertificate(crt)
 	organization := OrganizationForCertificate(crt)
 	subject := SubjectForCertificate(crt)
@@ -211,21 +282,9 @@ func GenerateCSR(crt *v1.Certificate) (*x509.CertificateRequest, error) {
 		}
 	}
 
-	extraNames := []pkix.AttributeTypeAndValue{}
-	for _, typeValue := range subject.ExtraNames {
-		parts := strings.Split(typeValue, "=")
-		if len(parts) == 2 {
-			oid, err := certutil.StringToOid(parts[0])
-			if err != nil {
-				return nil, fmt.Errorf("invalid OID format in %s. Should be n.n.n.n=value", typeValue)
-			}
-			extraNames = append(extraNames, pkix.AttributeTypeAndValue{
-				Type:  oid,
-				Value: parts[1],
-			})
-		} else {
-			return nil, fmt.Errorf("invalid extraNames format in %s. Should be n.n.n.n=value", typeValue)
-		}
+	extraNames, err := ExtraNamesForSubject(subject)
+	if err != nil {
+		return nil, err
 	}
 
 	return &x509.CertificateRequest{
@@ -256,7 +315,7 @@ func GenerateCSR(crt *v1.Certificate) (*x509.CertificateRequest, error) {
 }
 
 func buildKeyUsagesExtensionsForCertificate(crt *v1.Certificate) ([]pkix.Extension, error) {
-	ku, ekus, err := BuildKeyUsages(crt.Spec.Usages, crt.Spec.IsCA)
+	ku, ekus, err := BuildKeyUsages(apiutil.CertificateSpecUsages(&crt.Spec), crt.Spec.IsCA)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build key usages: %w", err)
 	}
@@ -292,8 +351,11 @@ func buildKeyUsagesExtensionsForCertificate(crt *v1.Certificate) ([]pkix.Extensi
 // generated by GenerateCSR.
 // The PublicKey field must be populated by the caller.
 func GenerateTemplate(crt *v1.Certificate) (*x509.Certificate, error) {
-	commonName := crt.Spec.CommonName
-	dnsNames := crt.Spec.DNSNames
+	commonName := CommonNameToASCII(crt.Spec.CommonName)
+	dnsNames, err := DNSNamesForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
 	ipAddresses := IPAddressesForCertificate(crt)
 	organization := OrganizationForCertificate(crt)
 	subject := SubjectForCertificate(crt)
@@ -301,7 +363,7 @@ func GenerateTemplate(crt *v1.Certificate) (*x509.Certificate, error) {
 	if err != nil {
 		return nil, err
 	}
-	keyUsages, extKeyUsages, err := BuildKeyUsages(crt.Spec.Usages, crt.Spec.IsCA)
+	keyUsages, extKeyUsages, err := BuildKeyUsages(apiutil.CertificateSpecUsages(&crt.Spec), crt.Spec.IsCA)
 	if err != nil {
 		return nil, err
 	}
@@ -322,21 +384,9 @@ func GenerateTemplate(crt *v1.Certificate) (*x509.Certificate, error) {
 		return nil, err
 	}
 
-	extraNames := []pkix.AttributeTypeAndValue{}
-	for _, typeValue := range subject.ExtraNames {
-		parts := strings.Split(typeValue, "=")
-		if len(parts) == 2 {
-			oid, err := certutil.StringToOid(parts[0])
-			if err != nil {
-				return nil, fmt.Errorf("invalid OID format in %s. Should be n.n.n.n=value", typeValue)
-			}
-			extraNames = append(extraNames, pkix.AttributeTypeAndValue{
-				Type:  oid,
-				Value: parts[1],
-			})
-		} else {
-			return nil, fmt.Errorf("invalid extraNames format in %s. Should be n.n.n.n=value", typeValue)
-		}
+	extraNames, err := ExtraNamesForSubject(subject)
+	if err != nil {
+		return nil, err
 	}
 
 	return &x509.Certificate{
@@ -381,7 +431,16 @@ func GenerateTemplateFromCertificateRequest(cr *v1.CertificateRequest) (*x509.Ce
 	if err != nil {
 		return nil, err
 	}
-	return GenerateTemplateFromCSRPEMWithUsages(cr.Spec.Request, certDuration, cr.Spec.IsCA, keyUsage, extKeyUsage)
+	extensions, err := ExtensionsForCertificateRequest(cr)
+	if err != nil {
+		return nil, err
+	}
+	template, err := GenerateTemplateFromCSRPEMWithUsages(cr.Spec.Request, certDuration, cr.Spec.IsCA, keyUsage, extKeyUsage)
+	if err != nil {
+		return nil, err
+	}
+	template.ExtraExtensions = append(template.ExtraExtensions, extensions...)
+	return template, nil
 }
 
 func GenerateTemplateFromCSRPEM(csrPEM []byte, duration time.Duration, isCA bool) (*x509.Certificate, error) {
@@ -412,6 +471,13 @@ func GenerateTemplateFromCSRPEMWithUsages(csrPEM []byte, duration time.Duration,
 		return nil, fmt.Errorf("failed to generate serial number: %s", err.Error())
 	}
 
+	// RSA-PSS is never chosen by default, so a CSR signed with it is an
+	// explicit request for the certificate to be signed with it too.
+	var sigAlgo x509.SignatureAlgorithm
+	if IsRSAPSS(csr.SignatureAlgorithm) {
+		sigAlgo = csr.SignatureAlgorithm
+	}
+
 	for _, name := range csr.Subject.Names {
 		// Reverse of crypto/x509/pkix.go:158
 		t := name.Type
@@ -428,6 +494,7 @@ func GenerateTemplateFromCSRPEMWithUsages(csrPEM []byte, duration time.Duration,
 		Version:               2,
 		BasicConstraintsValid: true,
 		SerialNumber:          serialNumber,
+		SignatureAlgorithm:    sigAlgo,
 		PublicKeyAlgorithm:    csr.PublicKeyAlgorithm,
 		PublicKey:             csr.PublicKey,
 		IsCA:                  isCA,
@@ -482,6 +549,18 @@ func SignCSRTemplate(caCerts []*x509.Certificate, caKey crypto.Signer, template
 
 	issuingCACert := caCerts[0]
 
+	if IsRSAPSS(template.SignatureAlgorithm) {
+		if _, ok := caKey.Public().(*rsa.PublicKey); !ok {
+			return PEMBundle{}, fmt.Errorf("signature algorithm %s was requested but the CA key is not an RSA key", template.SignatureAlgorithm)
+		}
+	}
+
+	// Some signers, such as keys held by a cloud KMS, only support a single
+	// signature algorithm which must be used instead of the default.
+	if s, ok := caKey.(signatureAlgorithmSigner); ok && template.SignatureAlgorithm == x509.UnknownSignatureAlgorithm {
+		template.SignatureAlgorithm = s.SignatureAlgorithm()
+	}
+
 	_, cert, err := SignCertificate(template, issuingCACert, template.PublicKey, caKey)
 	if err != nil {
 		return PEMBundle{}, err
@@ -594,5 +673,74 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 	default:
 		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa' or 'rsa", crt.Spec.PrivateKey.Algorithm)
 	}
+
+	if crt.Spec.PrivateKey != nil && crt.Spec.PrivateKey.SignatureAlgorithm != "" {
+		requested, err := requestedSignatureAlgorithm(crt.Spec.PrivateKey.SignatureAlgorithm, pubKeyAlgo, sigAlgo)
+		if err != nil {
+			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, err
+		}
+		sigAlgo = requested
+	}
 	return pubKeyAlgo, sigAlgo, nil
 }
+
+// signatureAlgorithms maps the signature algorithms which may be requested
+// in `spec.privateKey.signatureAlgorithm` to the public key algorithm they
+// require.
+var signatureAlgorithms = map[v1.SignatureAlgorithm]struct {
+	sigAlgo    x509.SignatureAlgorithm
+	pubKeyAlgo x509.PublicKeyAlgorithm
+}{
+	v1.SHA256WithRSA:    {x509.SHA256WithRSA, x509.RSA},
+	v1.SHA384WithRSA:    {x509.SHA384WithRSA, x509.RSA},
+	v1.SHA512WithRSA:    {x509.SHA512WithRSA, x509.RSA},
+	v1.SHA256WithRSAPSS: {x509.SHA256WithRSAPSS, x509.RSA},
+	v1.SHA384WithRSAPSS: {x509.SHA384WithRSAPSS, x509.RSA},
+	v1.SHA512WithRSAPSS: {x509.SHA512WithRSAPSS, x509.RSA},
+	v1.ECDSAWithSHA256:  {x509.ECDSAWithSHA256, x509.ECDSA},
+	v1.ECDSAWithSHA384:  {x509.ECDSAWithSHA384, x509.ECDSA},
+	v1.ECDSAWithSHA512:  {x509.ECDSAWithSHA512, x509.ECDSA},
+	v1.PureEd25519:      {x509.PureEd25519, x509.Ed25519},
+}
+
+// requestedSignatureAlgorithm returns the x509 signature algorithm for the
+// requested algorithm, or an error if it cannot be used with the given key.
+// ECDSA digests must match the curve of the key, so the only ECDSA algorithm
+// accepted is the default for the key size.
+// X509SignatureAlgorithm returns the x509 signature algorithm corresponding
+// to a signature algorithm which may be requested in
+// `spec.privateKey.signatureAlgorithm`, and false if it is not supported.
+func X509SignatureAlgorithm(requested v1.SignatureAlgorithm) (x509.SignatureAlgorithm, bool) {
+	algo, ok := signatureAlgorithms[requested]
+	return algo.sigAlgo, ok
+}
+
+func requestedSignatureAlgorithm(requested v1.SignatureAlgorithm, pubKeyAlgo x509.PublicKeyAlgorithm, defaultSigAlgo x509.SignatureAlgorithm) (x509.SignatureAlgorithm, error) {
+	algo, ok := signatureAlgorithms[requested]
+	if !ok {
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported signature algorithm specified: %s", requested)
+	}
+	if algo.pubKeyAlgo != pubKeyAlgo {
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("signature algorithm %s cannot be used with a %s private key", requested, pubKeyAlgo)
+	}
+	if pubKeyAlgo == x509.ECDSA && algo.sigAlgo != defaultSigAlgo {
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("signature algorithm %s does not match the curve of the private key, use %s", requested, defaultSigAlgo)
+	}
+	return algo.sigAlgo, nil
+}
+
+// IsRSAPSS returns true if the given signature algorithm is an RSA-PSS
+// algorithm.
+func IsRSAPSS(algorithm x509.SignatureAlgorithm) bool {
+	switch algorithm {
+	case x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS:
+		return true
+	}
+	return false
+}
+
+// signatureAlgorithmSigner is implemented by signers which choose the
+// signature algorithm of the certificates they sign.
+type signatureAlgorithmSigner interface {
+	SignatureAlgorithm() x509.SignatureAlgorithm
+}
@@ -19,9 +19,11 @@ package pki
 import (
 	"bytes"
 	"crypto"
+	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
+	"encoding/pem"
 	"math/big"
 	"reflect"
 	"testing"
@@ -352,6 +354,73 @@ func TestSignatureAlgorithmForCertificate(t *testing.T) {
 	}
 }
 
+func TestSignatureAlgorithmRequested(t *testing.T) {
+	tests := map[string]struct {
+		keyAlgo         cmapi.PrivateKeyAlgorithm
+		keySize         int
+		sigAlgo         cmapi.SignatureAlgorithm
+		expectErr       bool
+		expectedSigAlgo x509.SignatureAlgorithm
+	}{
+		"RSA-PSS with an RSA key": {
+			keyAlgo:         cmapi.RSAKeyAlgorithm,
+			keySize:         2048,
+			sigAlgo:         cmapi.SHA384WithRSAPSS,
+			expectedSigAlgo: x509.SHA384WithRSAPSS,
+		},
+		"PKCS #1 v1.5 with a larger digest than the default for the key size": {
+			keyAlgo:         cmapi.RSAKeyAlgorithm,
+			keySize:         2048,
+			sigAlgo:         cmapi.SHA512WithRSA,
+			expectedSigAlgo: x509.SHA512WithRSA,
+		},
+		"RSA-PSS with the default key algorithm": {
+			sigAlgo:         cmapi.SHA256WithRSAPSS,
+			expectedSigAlgo: x509.SHA256WithRSAPSS,
+		},
+		"curve-matched digest with a P-521 key": {
+			keyAlgo:         cmapi.ECDSAKeyAlgorithm,
+			keySize:         521,
+			sigAlgo:         cmapi.ECDSAWithSHA512,
+			expectedSigAlgo: x509.ECDSAWithSHA512,
+		},
+		"digest not matching the curve of an ECDSA key": {
+			keyAlgo:   cmapi.ECDSAKeyAlgorithm,
+			keySize:   256,
+			sigAlgo:   cmapi.ECDSAWithSHA384,
+			expectErr: true,
+		},
+		"RSA-PSS with an ECDSA key": {
+			keyAlgo:   cmapi.ECDSAKeyAlgorithm,
+			sigAlgo:   cmapi.SHA256WithRSAPSS,
+			expectErr: true,
+		},
+		"Ed25519 with an Ed25519 key": {
+			keyAlgo:         cmapi.Ed25519KeyAlgorithm,
+			sigAlgo:         cmapi.PureEd25519,
+			expectedSigAlgo: x509.PureEd25519,
+		},
+		"unknown signature algorithm": {
+			keyAlgo:   cmapi.RSAKeyAlgorithm,
+			sigAlgo:   cmapi.SignatureAlgorithm("MD5WithRSA"),
+			expectErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := buildCertificateWithKeyParams(test.keyAlgo, test.keySize)
+			crt.Spec.PrivateKey.SignatureAlgorithm = test.sigAlgo
+			_, sigAlgo, err := SignatureAlgorithm(crt)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSigAlgo, sigAlgo)
+		})
+	}
+}
+
 func TestRemoveDuplicates(t *testing.T) {
 	type testT struct {
 		input  []string
@@ -463,12 +532,45 @@ func TestGenerateCSR(t *testing.T) {
 				ExtraExtensions:    defaultExtraExtensions,
 			},
 		},
+		{
+			name: "Generate CSR from certificate with internationalized domain names",
+			crt:  &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "Bücher.example", DNSNames: []string{"bücher.example", "*.bücher.example"}}},
+			want: &x509.CertificateRequest{
+				Version:            0,
+				SignatureAlgorithm: x509.SHA256WithRSA,
+				PublicKeyAlgorithm: x509.RSA,
+				Subject:            pkix.Name{CommonName: "xn--bcher-kva.example"},
+				DNSNames:           []string{"xn--bcher-kva.example", "*.xn--bcher-kva.example"},
+				ExtraExtensions:    defaultExtraExtensions,
+			},
+		},
+		{
+			name: "Generate CSR from certificate with a common name which is not a domain name",
+			crt:  &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "Müller GmbH"}},
+			want: &x509.CertificateRequest{
+				Version:            0,
+				SignatureAlgorithm: x509.SHA256WithRSA,
+				PublicKeyAlgorithm: x509.RSA,
+				Subject:            pkix.Name{CommonName: "Müller GmbH"},
+				ExtraExtensions:    defaultExtraExtensions,
+			},
+		},
+		{
+			name:    "Error on generating CSR from certificate with an invalid internationalized domain name",
+			crt:     &cmapi.Certificate{Spec: cmapi.CertificateSpec{DNSNames: []string{"bü cher.example"}}},
+			wantErr: true,
+		},
 		{
 			name:    "Error on generating CSR from certificate with no subject",
 			crt:     &cmapi.Certificate{Spec: cmapi.CertificateSpec{}},
 			wantErr: true,
 		},
 	}
+	pk, err := GenerateRSAPrivateKey(MinRSAKeySize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got, err := GenerateCSR(tt.crt)
@@ -476,13 +578,78 @@ func TestGenerateCSR(t *testing.T) {
 				t.Errorf("GenerateCSR() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GenerateCSR() got = %v, want %v", got, tt.want)
+			if err != nil {
+				return
+			}
+
+			if got.SignatureAlgorithm != tt.want.SignatureAlgorithm || got.PublicKeyAlgorithm != tt.want.PublicKeyAlgorithm {
+				t.Errorf("GenerateCSR() got algorithms = %v/%v, want %v/%v", got.SignatureAlgorithm, got.PublicKeyAlgorithm, tt.want.SignatureAlgorithm, tt.want.PublicKeyAlgorithm)
+			}
+			if !reflect.DeepEqual(got.Subject.ToRDNSequence(), tt.want.Subject.ToRDNSequence()) {
+				t.Errorf("GenerateCSR() got subject = %v, want %v", got.Subject, tt.want.Subject)
+			}
+			if !reflect.DeepEqual(got.DNSNames, tt.want.DNSNames) {
+				t.Errorf("GenerateCSR() got DNS names = %v, want %v", got.DNSNames, tt.want.DNSNames)
+			}
+			if !reflect.DeepEqual(got.ExtraExtensions, tt.want.ExtraExtensions) {
+				t.Errorf("GenerateCSR() got extra extensions = %v, want %v", got.ExtraExtensions, tt.want.ExtraExtensions)
+			}
+
+			// the names must also be encoded as expected in the subject
+			// alternative names extension of the signed request
+			gotSAN, wantSAN := mustEncodedSANExtension(t, got, pk), mustEncodedSANExtension(t, tt.want, pk)
+			if !bytes.Equal(gotSAN, wantSAN) {
+				t.Errorf("GenerateCSR() got SAN extension = %x, want %x", gotSAN, wantSAN)
 			}
 		})
 	}
 }
 
+// oidExtensionSubjectAltName is the object identifier of the subject
+// alternative names extension.
+var oidExtensionSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+// mustEncodedSANExtension signs the given CSR template, and returns the value
+// of the subject alternative names extension of the signed request, if any.
+func mustEncodedSANExtension(t *testing.T, template *x509.CertificateRequest, pk crypto.Signer) []byte {
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ext := range csr.Extensions {
+		if ext.Id.Equal(oidExtensionSubjectAltName) {
+			return ext.Value
+		}
+	}
+	return nil
+}
+
+func TestExtraNamesForSubject(t *testing.T) {
+	got, err := ExtraNamesForSubject(cmapi.X509Subject{
+		UID:        "device-1",
+		ExtraNames: []string{"1.3.6.1.4.1.311.60.2.1.3=GB", "2.5.4.97=VAT=GB123"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []pkix.AttributeTypeAndValue{
+		{Type: OIDUserID, Value: "device-1"},
+		{Type: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 60, 2, 1, 3}, Value: "GB"},
+		{Type: asn1.ObjectIdentifier{2, 5, 4, 97}, Value: "VAT=GB123"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected extra names, want=%v got=%v", want, got)
+	}
+
+	if _, err := ExtraNamesForSubject(cmapi.X509Subject{ExtraNames: []string{"GB"}}); err == nil {
+		t.Errorf("expected an extra name without an OID to be rejected")
+	}
+}
+
 func Test_buildKeyUsagesExtensionsForCertificate(t *testing.T) {
 	// 0xa0 = DigitalSignature and Encipherment usage
 	asn1DefaultKeyUsage, err := asn1.Marshal(asn1.BitString{Bytes: []byte{0xa0}, BitLength: asn1BitLength([]byte{0xa0})})
@@ -555,6 +722,25 @@ func Test_buildKeyUsagesExtensionsForCertificate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Test peer profile set",
+			crt: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					Profile: cmapi.PeerCertificateProfile,
+				},
+			},
+			want: []pkix.Extension{
+				{
+					Id:    OIDExtensionKeyUsage,
+					Value: asn1DefaultKeyUsage,
+				},
+				{
+					Id:    OIDExtensionExtendedKeyUsage,
+					Value: asn1ServerClientAuth,
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -762,3 +948,98 @@ func TestEncodeX509Chain(t *testing.T) {
 		})
 	}
 }
+
+// algorithmSigner is a crypto.Signer which chooses its signature algorithm,
+// such as a signer backed by a KMS key.
+type algorithmSigner struct {
+	crypto.Signer
+	algorithm x509.SignatureAlgorithm
+}
+
+func (s algorithmSigner) SignatureAlgorithm() x509.SignatureAlgorithm {
+	return s.algorithm
+}
+
+func TestSignCSRTemplateSignerSignatureAlgorithm(t *testing.T) {
+	caPK, err := GenerateECPrivateKey(384)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(0),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Minute),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+	}
+	_, caCert, err := SignCertificate(caTmpl, caTmpl, caPK.Public(), caPK)
+	require.NoError(t, err)
+
+	leafPK, err := GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	leafTmpl := &x509.Certificate{
+		Version:      2,
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Minute),
+		PublicKey:    leafPK.Public(),
+	}
+
+	signer := algorithmSigner{Signer: caPK, algorithm: x509.ECDSAWithSHA384}
+	bundle, err := SignCSRTemplate([]*x509.Certificate{caCert}, signer, leafTmpl)
+	require.NoError(t, err)
+
+	leaf, err := DecodeX509CertificateBytes(bundle.ChainPEM)
+	require.NoError(t, err)
+	assert.Equal(t, x509.ECDSAWithSHA384, leaf.SignatureAlgorithm)
+}
+
+func TestSignCSRTemplateRSAPSS(t *testing.T) {
+	caTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(0),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Minute),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+	}
+	rsaCAPK, err := GenerateRSAPrivateKey(2048)
+	require.NoError(t, err)
+	_, rsaCACert, err := SignCertificate(caTmpl, caTmpl, rsaCAPK.Public(), rsaCAPK)
+	require.NoError(t, err)
+	ecCAPK, err := GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	_, ecCACert, err := SignCertificate(caTmpl, caTmpl, ecCAPK.Public(), ecCAPK)
+	require.NoError(t, err)
+
+	leafPK, err := GenerateRSAPrivateKey(2048)
+	require.NoError(t, err)
+	crt := buildCertificateWithKeyParams(cmapi.RSAKeyAlgorithm, 2048)
+	crt.Spec.PrivateKey.SignatureAlgorithm = cmapi.SHA256WithRSAPSS
+	csrTmpl, err := GenerateCSR(crt)
+	require.NoError(t, err)
+	csrDER, err := EncodeCSR(csrTmpl, leafPK)
+	require.NoError(t, err)
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	t.Run("RSA CA signs with RSA-PSS", func(t *testing.T) {
+		tmpl, err := GenerateTemplateFromCSRPEM(csrPEM, time.Hour, false)
+		require.NoError(t, err)
+		bundle, err := SignCSRTemplate([]*x509.Certificate{rsaCACert}, rsaCAPK, tmpl)
+		require.NoError(t, err)
+		leaf, err := DecodeX509CertificateBytes(bundle.ChainPEM)
+		require.NoError(t, err)
+		assert.Equal(t, x509.SHA256WithRSAPSS, leaf.SignatureAlgorithm)
+	})
+
+	t.Run("ECDSA CA cannot sign with RSA-PSS", func(t *testing.T) {
+		tmpl, err := GenerateTemplateFromCSRPEM(csrPEM, time.Hour, false)
+		require.NoError(t, err)
+		_, err = SignCSRTemplate([]*x509.Certificate{ecCACert}, ecCAPK, tmpl)
+		assert.Error(t, err)
+	})
+}
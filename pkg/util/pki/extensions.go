@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/hashicorp/vault/sdk/helper/certutil"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// oidCertificateExtensions is the arc of the standard certificate extensions
+// (id-ce). These are set by the issuer, such as the key usages and subject
+// alternative names, so may not be requested as additional extensions.
+var oidCertificateExtensions = asn1.ObjectIdentifier{2, 5, 29}
+
+// ParseExtensionID parses the object identifier of an additional X.509
+// extension in dotted notation. An error is returned if the identifier is
+// invalid or belongs to a standard certificate extension.
+func ParseExtensionID(id string) (asn1.ObjectIdentifier, error) {
+	oid, err := certutil.StringToOid(id)
+	if err != nil || len(oid) < 2 {
+		return nil, fmt.Errorf("invalid object identifier %q", id)
+	}
+	if len(oid) > len(oidCertificateExtensions) && oid[:len(oidCertificateExtensions)].Equal(oidCertificateExtensions) {
+		return nil, fmt.Errorf("%s is a standard certificate extension which may not be requested", oid)
+	}
+	return oid, nil
+}
+
+// ExtensionsForCertificateRequest returns the additional X.509 extensions
+// requested by the given CertificateRequest.
+func ExtensionsForCertificateRequest(cr *v1.CertificateRequest) ([]pkix.Extension, error) {
+	var extensions []pkix.Extension
+	for _, extension := range cr.Spec.Extensions {
+		oid, err := ParseExtensionID(extension.ID)
+		if err != nil {
+			return nil, err
+		}
+		extensions = append(extensions, pkix.Extension{
+			Id:       oid,
+			Critical: extension.Critical,
+			Value:    extension.Value,
+		})
+	}
+	return extensions, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestParseExtensionID(t *testing.T) {
+	tests := map[string]struct {
+		id      string
+		want    asn1.ObjectIdentifier
+		wantErr bool
+	}{
+		"a private extension is allowed": {
+			id:   "1.3.6.1.4.1.311.20.2",
+			want: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2},
+		},
+		"a standard certificate extension is rejected": {
+			id:      "2.5.29.19",
+			wantErr: true,
+		},
+		"an invalid identifier is rejected": {
+			id:      "1.3.six",
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseExtensionID(test.id)
+			if test.wantErr != (err != nil) {
+				t.Fatalf("unexpected error, wantErr=%t got=%v", test.wantErr, err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("unexpected object identifier, want=%s got=%s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateTemplateFromCertificateRequestExtensions(t *testing.T) {
+	pk, err := GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := GenerateCSR(&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "device"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := EncodeCSR(csr, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value := []byte{0x0c, 0x02, 0x43, 0x41}
+	cr := &cmapi.CertificateRequest{
+		Spec: cmapi.CertificateRequestSpec{
+			Request:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}),
+			Extensions: []cmapi.X509Extension{{ID: "1.3.6.1.4.1.311.20.2", Critical: true, Value: value}},
+		},
+	}
+
+	template, err := GenerateTemplateFromCertificateRequest(cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certDER, err := x509.CreateCertificate(nil, template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, extension := range cert.Extensions {
+		if extension.Id.Equal(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2}) {
+			if !extension.Critical || string(extension.Value) != string(value) {
+				t.Errorf("unexpected extension %v", extension)
+			}
+			return
+		}
+	}
+	t.Errorf("expected the requested extension to be added to the certificate")
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/errors"
+)
+
+const (
+	// ExternalPrivateKeyPEMType is the PEM block type of a reference to a
+	// private key held by an external signer. The block contains the PKIX
+	// encoded public key of the private key.
+	ExternalPrivateKeyPEMType = "CERT-MANAGER EXTERNAL KEY REFERENCE"
+
+	externalKeyProviderHeader  = "Provider"
+	externalKeyReferenceHeader = "Reference"
+)
+
+// ExternalKeyProvider generates private keys which are held by an external
+// signer, such as an HSM or a cloud KMS, and signs using them.
+type ExternalKeyProvider interface {
+	// Generate generates a new private key for the given Certificate,
+	// returning a reference to it.
+	Generate(ctx context.Context, crt *v1.Certificate) (*ExternalKey, error)
+
+	// Signer returns a crypto.Signer which signs using the referenced
+	// private key.
+	Signer(ctx context.Context, key *ExternalKey) (crypto.Signer, error)
+}
+
+// ExternalKey is a reference to a private key held by an external signer.
+// It implements crypto.Signer so that it can be used in place of a private
+// key, but it cannot sign: a crypto.Signer which can must be obtained from the
+// ExternalKeyProvider named by Provider.
+type ExternalKey struct {
+	// Provider is the name of the ExternalKeyProvider holding the key.
+	Provider string
+	// Reference identifies the key within the provider.
+	Reference string
+	// PublicKey is the public key of the referenced private key.
+	PublicKey crypto.PublicKey
+}
+
+// Public returns the public key of the referenced private key.
+func (k *ExternalKey) Public() crypto.PublicKey {
+	return k.PublicKey
+}
+
+// Sign always returns an error, as the private key is held by the external
+// signer.
+func (k *ExternalKey) Sign(io.Reader, []byte, crypto.SignerOpts) ([]byte, error) {
+	return nil, fmt.Errorf("private key %q is held by external key provider %q and cannot be used to sign directly", k.Reference, k.Provider)
+}
+
+// EncodeExternalKey will marshal a reference to a private key held by an
+// external signer into PEM format.
+func EncodeExternalKey(k *ExternalKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(k.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding external key reference: %w", err)
+	}
+
+	block := &pem.Block{
+		Type: ExternalPrivateKeyPEMType,
+		Headers: map[string]string{
+			externalKeyProviderHeader:  k.Provider,
+			externalKeyReferenceHeader: k.Reference,
+		},
+		Bytes: der,
+	}
+	return pem.EncodeToMemory(block), nil
+}
+
+func decodeExternalKey(block *pem.Block) (*ExternalKey, error) {
+	provider, reference := block.Headers[externalKeyProviderHeader], block.Headers[externalKeyReferenceHeader]
+	if provider == "" || reference == "" {
+		return nil, errors.NewInvalidData("error parsing external key reference: provider and reference must be set")
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.NewInvalidData("error parsing external key reference: %s", err.Error())
+	}
+
+	return &ExternalKey{
+		Provider:  provider,
+		Reference: reference,
+		PublicKey: pub,
+	}, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/rand"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestExternalKeyRoundTrip(t *testing.T) {
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &ExternalKey{Provider: "kms", Reference: "projects/p/keys/k/versions/1", PublicKey: pk.Public()}
+
+	// the encoding of the Certificate is ignored for external keys
+	data, err := EncodePrivateKey(key, v1.PKCS1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "PRIVATE KEY") {
+		t.Errorf("expected external key reference not to be encoded as a private key, got=%s", data)
+	}
+
+	decoded, err := DecodePrivateKeyBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	external, ok := decoded.(*ExternalKey)
+	if !ok {
+		t.Fatalf("expected decoded key to be an *ExternalKey, got=%T", decoded)
+	}
+	if external.Provider != key.Provider || external.Reference != key.Reference {
+		t.Errorf("unexpected provider or reference, exp=%s/%s, got=%s/%s", key.Provider, key.Reference, external.Provider, external.Reference)
+	}
+	if matches, err := PublicKeysEqual(external.Public(), pk.Public()); err != nil || !matches {
+		t.Errorf("expected decoded public key to match, err=%v", err)
+	}
+
+	if _, err := external.Sign(rand.Reader, make([]byte, 32), crypto.SHA256); err == nil {
+		t.Errorf("expected signing with an external key reference to fail")
+	}
+}
+
+func TestDecodeExternalKeyMissingReference(t *testing.T) {
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := EncodeExternalKey(&ExternalKey{Provider: "kms", PublicKey: pk.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != ExternalPrivateKeyPEMType {
+		t.Fatalf("unexpected PEM block: %v", block)
+	}
+
+	if _, err := DecodePrivateKeyBytes(data); err == nil {
+		t.Errorf("expected an error decoding an external key reference without a reference")
+	}
+}
@@ -126,6 +126,15 @@ func GenerateEd25519PrivateKey() (ed25519.PrivateKey, error) {
 // the type of key encoding and then inspecting the type of key provided.
 // It only supports encoding RSA or ECDSA keys.
 func EncodePrivateKey(pk crypto.PrivateKey, keyEncoding v1.PrivateKeyEncoding) ([]byte, error) {
+	// references to keys held by an external signer and sealed keys have a
+	// single encoding
+	switch k := pk.(type) {
+	case *ExternalKey:
+		return EncodeExternalKey(k)
+	case *SealedKey:
+		return EncodeSealedKey(k)
+	}
+
 	switch keyEncoding {
 	case v1.PrivateKeyEncoding(""), v1.PKCS1:
 		switch k := pk.(type) {
@@ -189,6 +198,21 @@ func PublicKeyForPrivateKey(pk crypto.PrivateKey) (crypto.PublicKey, error) {
 	}
 }
 
+// PrivateKeyAlgorithmForPublicKeyAlgorithm returns the private key algorithm
+// of keys with the given public key algorithm.
+func PrivateKeyAlgorithmForPublicKeyAlgorithm(algorithm x509.PublicKeyAlgorithm) v1.PrivateKeyAlgorithm {
+	switch algorithm {
+	case x509.RSA:
+		return v1.RSAKeyAlgorithm
+	case x509.ECDSA:
+		return v1.ECDSAKeyAlgorithm
+	case x509.Ed25519:
+		return v1.Ed25519KeyAlgorithm
+	default:
+		return v1.PrivateKeyAlgorithm(algorithm.String())
+	}
+}
+
 // PublicKeyMatchesCertificate checks whether the given public key matches the
 // public key in the given x509.Certificate.
 // Returns false and no error if the public key is *not* the same as the certificate's key
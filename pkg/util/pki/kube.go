@@ -48,14 +48,21 @@ func GenerateTemplateFromCertificateSigningRequest(csr *certificatesv1.Certifica
 
 // DurationFromCertificateSigningRequest returns the duration that the user may
 // have requested using the annotation
-// "experimental.cert-manager.io/request-duration".
+// "experimental.cert-manager.io/request-duration", or the native
+// spec.expirationSeconds field if the annotation is not present.
 // Returns the cert-manager default certificate duration when the user hasn't
-// provided the annotation.
+// requested a duration.
 func DurationFromCertificateSigningRequest(csr *certificatesv1.CertificateSigningRequest) (time.Duration, error) {
 	requestedDuration, ok := csr.Annotations[experimentalapi.CertificateSigningRequestDurationAnnotationKey]
 	if !ok {
-		// The user may not have set a duration annotation. Use the default
-		// duration in this case.
+		// Clients which speak the native CSR API, such as the kubelet, request
+		// a duration using spec.expirationSeconds.
+		if csr.Spec.ExpirationSeconds != nil {
+			return time.Duration(*csr.Spec.ExpirationSeconds) * time.Second, nil
+		}
+
+		// The user may not have set a duration. Use the default duration in
+		// this case.
 		return cmapi.DefaultCertificateDuration, nil
 	}
 
@@ -98,6 +98,55 @@ func TestGenerateTemplateFromCertificateSigningRequest(t *testing.T) {
 				DNSNames: []string{"example.com", "foo.example.com"},
 			},
 		},
+		"a CSR with expirationSeconds and no duration annotation should use expirationSeconds": {
+			csr: gen.CertificateSigningRequest("",
+				gen.SetCertificateSigningRequestExpirationSeconds(1200),
+				gen.SetCertificateSigningRequestUsages([]certificatesv1.KeyUsage{
+					certificatesv1.UsageDigitalSignature,
+				}),
+				gen.SetCertificateSigningRequestRequest(csr),
+			),
+			expCertificate: &x509.Certificate{
+				Version:               2,
+				BasicConstraintsValid: true,
+				SerialNumber:          nil,
+				PublicKeyAlgorithm:    x509.RSA,
+				PublicKey:             pk.Public(),
+				IsCA:                  false,
+				Subject: pkix.Name{
+					CommonName: "example.com",
+				},
+				NotBefore: time.Now(),
+				NotAfter:  time.Now().Add(20 * time.Minute),
+				KeyUsage:  x509.KeyUsageDigitalSignature,
+				DNSNames:  []string{"example.com", "foo.example.com"},
+			},
+		},
+		"a CSR with both expirationSeconds and a duration annotation should prefer the annotation": {
+			csr: gen.CertificateSigningRequest("",
+				gen.SetCertificateSigningRequestExpirationSeconds(1200),
+				gen.SetCertificateSigningRequestDuration("10m"),
+				gen.SetCertificateSigningRequestUsages([]certificatesv1.KeyUsage{
+					certificatesv1.UsageDigitalSignature,
+				}),
+				gen.SetCertificateSigningRequestRequest(csr),
+			),
+			expCertificate: &x509.Certificate{
+				Version:               2,
+				BasicConstraintsValid: true,
+				SerialNumber:          nil,
+				PublicKeyAlgorithm:    x509.RSA,
+				PublicKey:             pk.Public(),
+				IsCA:                  false,
+				Subject: pkix.Name{
+					CommonName: "example.com",
+				},
+				NotBefore: time.Now(),
+				NotAfter:  time.Now().Add(10 * time.Minute),
+				KeyUsage:  x509.KeyUsageDigitalSignature,
+				DNSNames:  []string{"example.com", "foo.example.com"},
+			},
+		},
 		"a CSR with isCA=false that is valid should return a valid *x509.Certificate": {
 			csr: gen.CertificateSigningRequest("",
 				gen.SetCertificateSigningRequestDuration("10m"),
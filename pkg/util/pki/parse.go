@@ -19,14 +19,21 @@ package pki
 import (
 	"crypto"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
+	"fmt"
+	"strings"
 
 	"github.com/jetstack/cert-manager/pkg/util/errors"
 )
 
 // DecodePrivateKeyBytes will decode a PEM encoded private key into a crypto.Signer.
-// It supports ECDSA and RSA private keys only. All other types will return err.
+// It supports ECDSA and RSA private keys, references to private keys held
+// by an external signer, which are returned as an *ExternalKey, and sealed
+// private keys, which are returned as a *SealedKey. All other types will
+// return err.
 func DecodePrivateKeyBytes(keyBytes []byte) (crypto.Signer, error) {
 	// decode the private key pem
 	block, _ := pem.Decode(keyBytes)
@@ -64,6 +71,10 @@ func DecodePrivateKeyBytes(keyBytes []byte) (crypto.Signer, error) {
 			return nil, errors.NewInvalidData("rsa private key failed validation: %s", err.Error())
 		}
 		return key, nil
+	case ExternalPrivateKeyPEMType:
+		return decodeExternalKey(block)
+	case SealedPrivateKeyPEMType:
+		return decodeSealedKey(block)
 	default:
 		return nil, errors.NewInvalidData("unknown private key type: %s", block.Type)
 	}
@@ -360,3 +371,16 @@ func (c *chainNode) root() *chainNode {
 func isSelfSignedCertificate(cert *x509.Certificate) bool {
 	return cert.CheckSignatureFrom(cert) == nil
 }
+
+// ParseSHA256Fingerprint decodes a hex encoded SHA-256 fingerprint, which may
+// be separated by colons as printed by `openssl x509 -fingerprint -sha256`.
+func ParseSHA256Fingerprint(fingerprint string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	if err != nil {
+		return nil, fmt.Errorf("fingerprint is not hex encoded: %w", err)
+	}
+	if len(b) != sha256.Size {
+		return nil, fmt.Errorf("fingerprint must be %d bytes long, got %d", sha256.Size, len(b))
+	}
+	return b, nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+
+	"github.com/jetstack/cert-manager/pkg/util/errors"
+)
+
+const (
+	// SealedPrivateKeyPEMType is the PEM block type of a private key which is
+	// encrypted with a data key, which is in turn encrypted by a KMS plugin.
+	// The block contains the encrypted PKCS#8 encoded private key.
+	SealedPrivateKeyPEMType = "CERT-MANAGER SEALED PRIVATE KEY"
+
+	sealedKeyPluginHeader    = "Plugin"
+	sealedKeyDataKeyHeader   = "Data-Key"
+	sealedKeyNonceHeader     = "Nonce"
+	sealedKeyPublicKeyHeader = "Public-Key"
+
+	// sealedKeyDataKeySize is the size of the AES-256 data keys which
+	// private keys are encrypted with.
+	sealedKeyDataKeySize = 32
+)
+
+// KMSPlugin encrypts and decrypts the data keys of sealed private keys using
+// a key encryption key held by a KMS, which never leaves the KMS.
+type KMSPlugin interface {
+	// Encrypt encrypts the given data key.
+	Encrypt(ctx context.Context, dataKey []byte) ([]byte, error)
+
+	// Decrypt decrypts a data key encrypted by Encrypt.
+	Decrypt(ctx context.Context, encryptedDataKey []byte) ([]byte, error)
+}
+
+// SealedKey is a private key encrypted with a data key, which is in turn
+// encrypted by a KMS plugin. It implements crypto.Signer so that it can be
+// used in place of a private key where only its public key is needed, but it
+// cannot sign: UnsealPrivateKey must be used to decrypt it first.
+type SealedKey struct {
+	// Plugin is the name of the KMS plugin which encrypted the data key.
+	Plugin string
+	// EncryptedDataKey is the data key encrypted by the KMS plugin.
+	EncryptedDataKey []byte
+	// Nonce is the AES-GCM nonce the private key was encrypted with.
+	Nonce []byte
+	// Ciphertext is the encrypted PKCS#8 encoded private key.
+	Ciphertext []byte
+	// PublicKey is the public key of the sealed private key.
+	PublicKey crypto.PublicKey
+}
+
+// Public returns the public key of the sealed private key.
+func (k *SealedKey) Public() crypto.PublicKey {
+	return k.PublicKey
+}
+
+// Sign always returns an error, as the private key must be unsealed first.
+func (k *SealedKey) Sign(io.Reader, []byte, crypto.SignerOpts) ([]byte, error) {
+	return nil, fmt.Errorf("private key is sealed by KMS plugin %q and cannot be used to sign directly", k.Plugin)
+}
+
+// SealPrivateKey encrypts the given private key with a new data key, which is
+// encrypted by the given KMS plugin. The public key is authenticated along
+// with the private key, so that a sealed key cannot be paired with another
+// public key.
+func SealPrivateKey(ctx context.Context, plugin KMSPlugin, pluginName string, pk crypto.Signer) (*SealedKey, error) {
+	pubDER, err := x509.MarshalPKIXPublicKey(pk.Public())
+	if err != nil {
+		return nil, fmt.Errorf("error encoding public key: %w", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(pk)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding private key: %w", err)
+	}
+
+	dataKey := make([]byte, sealedKeyDataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, fmt.Errorf("error generating data key: %w", err)
+	}
+	aead, err := newSealedKeyAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("error generating nonce: %w", err)
+	}
+
+	encryptedDataKey, err := plugin.Encrypt(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting data key with KMS plugin %q: %w", pluginName, err)
+	}
+
+	return &SealedKey{
+		Plugin:           pluginName,
+		EncryptedDataKey: encryptedDataKey,
+		Nonce:            nonce,
+		Ciphertext:       aead.Seal(nil, nonce, keyDER, pubDER),
+		PublicKey:        pk.Public(),
+	}, nil
+}
+
+// UnsealPrivateKey decrypts the data key of the given sealed private key using
+// the given KMS plugin, and returns the decrypted private key.
+func UnsealPrivateKey(ctx context.Context, plugin KMSPlugin, k *SealedKey) (crypto.Signer, error) {
+	pubDER, err := x509.MarshalPKIXPublicKey(k.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding public key: %w", err)
+	}
+
+	dataKey, err := plugin.Decrypt(ctx, k.EncryptedDataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting data key with KMS plugin %q: %w", k.Plugin, err)
+	}
+	aead, err := newSealedKeyAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(k.Nonce) != aead.NonceSize() {
+		return nil, errors.NewInvalidData("error decrypting sealed private key: invalid nonce")
+	}
+	keyDER, err := aead.Open(nil, k.Nonce, k.Ciphertext, pubDER)
+	if err != nil {
+		return nil, errors.NewInvalidData("error decrypting sealed private key: %s", err.Error())
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(keyDER)
+	if err != nil {
+		return nil, errors.NewInvalidData("error parsing sealed private key: %s", err.Error())
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.NewInvalidData("error parsing sealed private key: invalid key type")
+	}
+	return signer, nil
+}
+
+func newSealedKeyAEAD(dataKey []byte) (cipher.AEAD, error) {
+	if len(dataKey) != sealedKeyDataKeySize {
+		return nil, fmt.Errorf("invalid data key size %d", len(dataKey))
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncodeSealedKey will marshal a sealed private key into PEM format.
+func EncodeSealedKey(k *SealedKey) ([]byte, error) {
+	pubDER, err := x509.MarshalPKIXPublicKey(k.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding sealed private key: %w", err)
+	}
+
+	block := &pem.Block{
+		Type: SealedPrivateKeyPEMType,
+		Headers: map[string]string{
+			sealedKeyPluginHeader:    k.Plugin,
+			sealedKeyDataKeyHeader:   base64.StdEncoding.EncodeToString(k.EncryptedDataKey),
+			sealedKeyNonceHeader:     base64.StdEncoding.EncodeToString(k.Nonce),
+			sealedKeyPublicKeyHeader: base64.StdEncoding.EncodeToString(pubDER),
+		},
+		Bytes: k.Ciphertext,
+	}
+	return pem.EncodeToMemory(block), nil
+}
+
+func decodeSealedKey(block *pem.Block) (*SealedKey, error) {
+	plugin := block.Headers[sealedKeyPluginHeader]
+	if plugin == "" {
+		return nil, errors.NewInvalidData("error parsing sealed private key: plugin must be set")
+	}
+
+	var decoded [3][]byte
+	for i, header := range []string{sealedKeyDataKeyHeader, sealedKeyNonceHeader, sealedKeyPublicKeyHeader} {
+		b, err := base64.StdEncoding.DecodeString(block.Headers[header])
+		if err != nil || len(b) == 0 {
+			return nil, errors.NewInvalidData("error parsing sealed private key: invalid %s header", header)
+		}
+		decoded[i] = b
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(decoded[2])
+	if err != nil {
+		return nil, errors.NewInvalidData("error parsing sealed private key: %s", err.Error())
+	}
+
+	return &SealedKey{
+		Plugin:           plugin,
+		EncryptedDataKey: decoded[0],
+		Nonce:            decoded[1],
+		Ciphertext:       block.Bytes,
+		PublicKey:        pub,
+	}, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// xorKMSPlugin "encrypts" data keys by XORing them with a fixed key, which is
+// enough to check that data keys are passed through the plugin.
+type xorKMSPlugin struct {
+	key byte
+}
+
+func (p xorKMSPlugin) Encrypt(_ context.Context, dataKey []byte) ([]byte, error) {
+	return p.xor(dataKey), nil
+}
+
+func (p xorKMSPlugin) Decrypt(_ context.Context, encryptedDataKey []byte) ([]byte, error) {
+	return p.xor(encryptedDataKey), nil
+}
+
+func (p xorKMSPlugin) xor(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i := range in {
+		out[i] = in[i] ^ p.key
+	}
+	return out
+}
+
+type failingKMSPlugin struct{}
+
+func (failingKMSPlugin) Encrypt(context.Context, []byte) ([]byte, error) {
+	return nil, fmt.Errorf("unavailable")
+}
+
+func (failingKMSPlugin) Decrypt(context.Context, []byte) ([]byte, error) {
+	return nil, fmt.Errorf("unavailable")
+}
+
+func TestSealedKeyRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	plugin := xorKMSPlugin{key: 0x5a}
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := SealPrivateKey(ctx, plugin, "kms", pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the encoding of the Certificate is ignored for sealed keys
+	data, err := EncodePrivateKey(sealed, v1.PKCS1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := EncodePKCS8PrivateKey(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, keyDER) {
+		t.Errorf("expected sealed key not to contain the private key")
+	}
+
+	decoded, err := DecodePrivateKeyBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedSealed, ok := decoded.(*SealedKey)
+	if !ok {
+		t.Fatalf("expected decoded key to be a *SealedKey, got=%T", decoded)
+	}
+	if decodedSealed.Plugin != "kms" {
+		t.Errorf("unexpected plugin, exp=kms got=%s", decodedSealed.Plugin)
+	}
+	if matches, err := PublicKeysEqual(decodedSealed.Public(), pk.Public()); err != nil || !matches {
+		t.Errorf("expected decoded public key to match, err=%v", err)
+	}
+	if _, err := decodedSealed.Sign(rand.Reader, make([]byte, 32), crypto.SHA256); err == nil {
+		t.Errorf("expected signing with a sealed key to fail")
+	}
+
+	unsealed, err := UnsealPrivateKey(ctx, plugin, decodedSealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsealedDER, err := EncodePKCS8PrivateKey(unsealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(unsealedDER, keyDER) {
+		t.Errorf("expected the unsealed private key to match the sealed private key")
+	}
+}
+
+func TestUnsealPrivateKeyErrors(t *testing.T) {
+	ctx := context.Background()
+	plugin := xorKMSPlugin{key: 0x5a}
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := SealPrivateKey(ctx, plugin, "kms", pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		plugin KMSPlugin
+		key    func(k SealedKey) *SealedKey
+	}{
+		"should fail if the KMS plugin fails": {
+			plugin: failingKMSPlugin{},
+			key:    func(k SealedKey) *SealedKey { return &k },
+		},
+		"should fail if the data key was encrypted with another key": {
+			plugin: xorKMSPlugin{key: 0x01},
+			key:    func(k SealedKey) *SealedKey { return &k },
+		},
+		"should fail if the public key has been replaced": {
+			plugin: plugin,
+			key: func(k SealedKey) *SealedKey {
+				k.PublicKey = other.Public()
+				return &k
+			},
+		},
+		"should fail if the ciphertext has been modified": {
+			plugin: plugin,
+			key: func(k SealedKey) *SealedKey {
+				k.Ciphertext = append([]byte{}, k.Ciphertext...)
+				k.Ciphertext[0] ^= 0xff
+				return &k
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := UnsealPrivateKey(ctx, test.plugin, test.key(*sealed)); err == nil {
+				t.Errorf("expected an error unsealing the private key")
+			}
+		})
+	}
+
+	if _, err := SealPrivateKey(ctx, failingKMSPlugin{}, "kms", pk); err == nil {
+		t.Errorf("expected an error sealing a private key if the KMS plugin fails")
+	}
+}
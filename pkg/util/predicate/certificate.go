@@ -31,6 +31,57 @@ func CertificateSecretName(name string) Func {
 	}
 }
 
+// CertificateAdditionalSecretName returns a predicate that used to filter
+// Certificates to only those with the given name in
+// 'spec.additionalSecretNames'.
+func CertificateAdditionalSecretName(name string) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		for _, n := range crt.Spec.AdditionalSecretNames {
+			if n == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// CertificateSecretNamespace returns a predicate that used to filter
+// Certificates to only those which store their Secrets in the given
+// namespace, either by setting 'spec.secretNamespace' or by leaving it unset
+// and being in that namespace.
+func CertificateSecretNamespace(namespace string) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		if crt.Spec.SecretNamespace == "" {
+			return crt.Namespace == namespace
+		}
+		return crt.Spec.SecretNamespace == namespace
+	}
+}
+
+// CertificatePrivateKeySecretName returns a predicate that used to filter
+// Certificates to only those with the given 'spec.privateKey.secretRef.name'.
+func CertificatePrivateKeySecretName(name string) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		if crt.Spec.PrivateKey == nil || crt.Spec.PrivateKey.SecretRef == nil {
+			return false
+		}
+		return crt.Spec.PrivateKey.SecretRef.Name == name
+	}
+}
+
+// CertificateServiceIPSANsService returns a predicate that used to filter
+// Certificates to only those with the 'cert-manager.io/ip-sans-from-service'
+// annotation set to the given Service name.
+func CertificateServiceIPSANsService(name string) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		return crt.Annotations[cmapi.ServiceIPSANsAnnotationKey] == name
+	}
+}
+
 // CertificateSecretName returns a predicate that used to filter Certificates
 // to only those with the given 'status.nextPrivateKeySecretName'.
 // It is not possible to select Certificates with a 'nil' secret name using
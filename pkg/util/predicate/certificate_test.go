@@ -19,9 +19,11 @@ package predicate
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 )
 
 func TestCertificateSecretName(t *testing.T) {
@@ -56,6 +58,81 @@ func TestCertificateSecretName(t *testing.T) {
 	}
 }
 
+func TestCertificateAdditionalSecretName(t *testing.T) {
+	certWithAdditionalSecretNames := func(s ...string) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{SecretName: "primary", AdditionalSecretNames: s},
+		}
+	}
+	tests := map[string]struct {
+		secretName string
+		cert       *cmapi.Certificate
+		expected   bool
+	}{
+		"returns true if an additional secret name matches": {
+			secretName: "abc",
+			cert:       certWithAdditionalSecretNames("def", "abc"),
+			expected:   true,
+		},
+		"returns false if no additional secret name matches": {
+			secretName: "abc",
+			cert:       certWithAdditionalSecretNames("abcd"),
+			expected:   false,
+		},
+		"returns false if only the primary secret name matches": {
+			secretName: "primary",
+			cert:       certWithAdditionalSecretNames(),
+			expected:   false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateAdditionalSecretName(test.secretName)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateSecretNamespace(t *testing.T) {
+	certInNamespace := func(namespace, secretNamespace string) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+			Spec:       cmapi.CertificateSpec{SecretNamespace: secretNamespace},
+		}
+	}
+	tests := map[string]struct {
+		namespace string
+		cert      *cmapi.Certificate
+		expected  bool
+	}{
+		"returns true if the secret namespace is unset and the Certificate namespace matches": {
+			namespace: "abc",
+			cert:      certInNamespace("abc", ""),
+			expected:  true,
+		},
+		"returns true if the secret namespace matches": {
+			namespace: "abc",
+			cert:      certInNamespace("def", "abc"),
+			expected:  true,
+		},
+		"returns false if only the Certificate namespace matches": {
+			namespace: "abc",
+			cert:      certInNamespace("abc", "def"),
+			expected:  false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateSecretNamespace(test.namespace)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
 func TestCertificateNextPrivateKeySecretName(t *testing.T) {
 	certWithSecretName := func(s *string) *cmapi.Certificate {
 		return &cmapi.Certificate{
@@ -92,3 +169,84 @@ func TestCertificateNextPrivateKeySecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificatePrivateKeySecretName(t *testing.T) {
+	certWithSecretRef := func(ref *cmmeta.SecretKeySelector) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{PrivateKey: &cmapi.CertificatePrivateKey{SecretRef: ref}},
+		}
+	}
+	tests := map[string]struct {
+		secretName string
+		cert       *cmapi.Certificate
+		expected   bool
+	}{
+		"returns true if secret name matches": {
+			secretName: "abc",
+			cert:       certWithSecretRef(&cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "abc"}}),
+			expected:   true,
+		},
+		"returns false if secret name does not match": {
+			secretName: "abc",
+			cert:       certWithSecretRef(&cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "abcd"}}),
+			expected:   false,
+		},
+		"returns false if secret ref is nil": {
+			secretName: "",
+			cert:       certWithSecretRef(nil),
+			expected:   false,
+		},
+		"returns false if private key is nil": {
+			secretName: "",
+			cert:       &cmapi.Certificate{},
+			expected:   false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificatePrivateKeySecretName(test.secretName)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateServiceIPSANsService(t *testing.T) {
+	certWithAnnotation := func(s string) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{cmapi.ServiceIPSANsAnnotationKey: s},
+			},
+		}
+	}
+	tests := map[string]struct {
+		serviceName string
+		cert        *cmapi.Certificate
+		expected    bool
+	}{
+		"returns true if service name matches": {
+			serviceName: "abc",
+			cert:        certWithAnnotation("abc"),
+			expected:    true,
+		},
+		"returns false if service name does not match": {
+			serviceName: "abc",
+			cert:        certWithAnnotation("abcd"),
+			expected:    false,
+		},
+		"returns false if the annotation is not set": {
+			serviceName: "abc",
+			cert:        &cmapi.Certificate{},
+			expected:    false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateServiceIPSANsService(test.serviceName)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
@@ -17,11 +17,16 @@ limitations under the License.
 package util
 
 import (
+	"fmt"
 	"math/rand"
 	"net"
 	"net/url"
 	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 )
@@ -70,6 +75,128 @@ func EqualUnsorted(s1 []string, s2 []string) bool {
 	return true
 }
 
+// EqualUnsortedSet returns true if both slices contain the same set of
+// strings, ignoring ordering and duplicate entries.
+func EqualUnsortedSet(s1, s2 []string) bool {
+	return EqualSorted(canonicalSet(s1), canonicalSet(s2))
+}
+
+// EqualDNSNamesUnsortedSet returns true if both slices contain the same set
+// of DNS names once normalized with NormalizeDNSName, ignoring ordering and
+// duplicate entries.
+func EqualDNSNamesUnsortedSet(s1, s2 []string) bool {
+	return EqualSorted(CanonicalDNSNames(s1), CanonicalDNSNames(s2))
+}
+
+// EqualIPsUnsortedSet returns true if both slices contain the same set of IP
+// addresses, ignoring ordering, duplicate entries and differences in textual
+// representation (e.g. "::1" and "0:0::1").
+func EqualIPsUnsortedSet(s1, s2 []string) bool {
+	return EqualSorted(CanonicalIPAddresses(s1), CanonicalIPAddresses(s2))
+}
+
+// idnaProfile converts internationalized domain names using the
+// non-transitional processing of UTS #46, as implemented by browsers and
+// publicly trusted CAs.
+var idnaProfile = idna.New(idna.MapForLookup(), idna.BidiRule())
+
+// DNSNameToASCII returns the A-label form of a DNS name, with any
+// internationalized labels encoded using punycode. A leading wildcard label
+// is preserved. Names which only contain ASCII characters are returned
+// unchanged.
+func DNSNameToASCII(name string) (string, error) {
+	if isASCII(name) {
+		return name, nil
+	}
+	prefix, domain := splitWildcard(name)
+	ascii, err := idnaProfile.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("invalid internationalized domain name %q: %v", name, err)
+	}
+	return prefix + ascii, nil
+}
+
+// DNSNameToUnicode returns the U-label form of a DNS name, with any punycode
+// encoded labels decoded. It is intended for displaying names to users. If
+// the name cannot be decoded, it is returned unchanged.
+func DNSNameToUnicode(name string) string {
+	if !strings.Contains(strings.ToLower(name), "xn--") {
+		return name
+	}
+	prefix, domain := splitWildcard(name)
+	unicode, err := idnaProfile.ToUnicode(domain)
+	if err != nil {
+		return name
+	}
+	return prefix + unicode
+}
+
+func splitWildcard(name string) (string, string) {
+	if strings.HasPrefix(name, "*.") {
+		return "*.", name[2:]
+	}
+	return "", name
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeDNSName returns the canonical form of a DNS name, being lower
+// case and with any internationalized labels encoded using punycode.
+// If the name cannot be encoded, the lower cased name is returned.
+func NormalizeDNSName(name string) string {
+	if ascii, err := DNSNameToASCII(name); err == nil {
+		name = ascii
+	}
+	return strings.ToLower(name)
+}
+
+// CanonicalDNSNames returns a sorted and de-duplicated copy of the given DNS
+// names, each normalized using NormalizeDNSName.
+func CanonicalDNSNames(names []string) []string {
+	normalized := make([]string, len(names))
+	for i, name := range names {
+		normalized[i] = NormalizeDNSName(name)
+	}
+	return canonicalSet(normalized)
+}
+
+// CanonicalIPAddresses returns a sorted and de-duplicated copy of the given
+// IP addresses, each formatted in its canonical textual representation.
+// Entries that cannot be parsed as IP addresses are retained as-is.
+func CanonicalIPAddresses(ips []string) []string {
+	normalized := make([]string, len(ips))
+	for i, ip := range ips {
+		normalized[i] = ip
+		if parsed := net.ParseIP(ip); parsed != nil {
+			normalized[i] = parsed.String()
+		}
+	}
+	return canonicalSet(normalized)
+}
+
+// canonicalSet returns a sorted copy of the given slice with any duplicate
+// entries removed.
+func canonicalSet(s []string) []string {
+	out := make([]string, 0, len(s))
+	seen := make(map[string]struct{}, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
+
 // Test for equal URL slices even if unsorted. Panics if any element is nil
 func EqualURLsUnsorted(s1, s2 []*url.URL) bool {
 	if len(s1) != len(s2) {
@@ -100,6 +100,96 @@ func TestEqualIPsUnsorted(t *testing.T) {
 	}
 }
 
+func TestEqualUnsortedSet(t *testing.T) {
+	tests := append([]testT{
+		{
+			desc:  "slices differing only by duplicates should be equal",
+			s1:    []string{"a", "b", "a"},
+			s2:    []string{"b", "a"},
+			equal: true,
+		},
+	}, stringSliceTestData...)
+	for _, test := range tests {
+		t.Run(test.desc, func(test testT) func(*testing.T) {
+			return func(t *testing.T) {
+				if actual := EqualUnsortedSet(test.s1, test.s2); actual != test.equal {
+					t.Errorf("EqualUnsortedSet(%+v, %+v) = %t, but expected %t", test.s1, test.s2, actual, test.equal)
+				}
+			}
+		}(test))
+	}
+}
+
+func TestEqualDNSNamesUnsortedSet(t *testing.T) {
+	tests := append([]testT{
+		{
+			desc:  "names differing only by case should be equal",
+			s1:    []string{"Example.COM"},
+			s2:    []string{"example.com"},
+			equal: true,
+		},
+		{
+			desc:  "internationalized names should equal their punycode encoding",
+			s1:    []string{"bücher.example", "example.com"},
+			s2:    []string{"example.com", "xn--bcher-kva.example"},
+			equal: true,
+		},
+		{
+			desc:  "duplicate names should be ignored",
+			s1:    []string{"example.com", "EXAMPLE.com"},
+			s2:    []string{"example.com"},
+			equal: true,
+		},
+	}, stringSliceTestData...)
+	for _, test := range tests {
+		t.Run(test.desc, func(test testT) func(*testing.T) {
+			return func(t *testing.T) {
+				if actual := EqualDNSNamesUnsortedSet(test.s1, test.s2); actual != test.equal {
+					t.Errorf("EqualDNSNamesUnsortedSet(%+v, %+v) = %t, but expected %t", test.s1, test.s2, actual, test.equal)
+				}
+			}
+		}(test))
+	}
+}
+
+func TestEqualIPsUnsortedSet(t *testing.T) {
+	tests := []testT{
+		{
+			desc:  "equal but out of order addresses should be equal",
+			s1:    []string{"10.0.0.1", "10.0.0.2"},
+			s2:    []string{"10.0.0.2", "10.0.0.1"},
+			equal: true,
+		},
+		{
+			desc:  "differing textual representations should be equal",
+			s1:    []string{"::1", "2001:db8:0:0::1"},
+			s2:    []string{"2001:db8::1", "0:0::1"},
+			equal: true,
+		},
+		{
+			desc:  "duplicate addresses should be ignored",
+			s1:    []string{"10.0.0.1", "10.0.0.1"},
+			s2:    []string{"10.0.0.1"},
+			equal: true,
+		},
+		{
+			desc:  "different addresses should not be equal",
+			s1:    []string{"10.0.0.1"},
+			s2:    []string{"10.0.0.2"},
+			equal: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(test testT) func(*testing.T) {
+			return func(t *testing.T) {
+				if actual := EqualIPsUnsortedSet(test.s1, test.s2); actual != test.equal {
+					t.Errorf("EqualIPsUnsortedSet(%+v, %+v) = %t, but expected %t", test.s1, test.s2, actual, test.equal)
+				}
+			}
+		}(test))
+	}
+}
+
 func TestContains(t *testing.T) {
 	type testT struct {
 		desc  string
@@ -163,3 +253,41 @@ func parseIPs(t *testing.T, ipStrs []string) []net.IP {
 
 	return ips
 }
+
+func TestDNSNameToASCII(t *testing.T) {
+	tests := map[string]struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		"ascii name is unchanged":          {name: "example.com", want: "example.com"},
+		"unicode name is converted":        {name: "bücher.example", want: "xn--bcher-kva.example"},
+		"wildcard prefix is preserved":     {name: "*.bücher.example", want: "*.xn--bcher-kva.example"},
+		"non-transitional mapping is used": {name: "straße.example", want: "xn--strae-oqa.example"},
+		"invalid name returns an error":    {name: "bü_cher.example", wantErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := DNSNameToASCII(test.name)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.wantErr && got != test.want {
+				t.Errorf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestDNSNameToUnicode(t *testing.T) {
+	tests := map[string]string{
+		"example.com":             "example.com",
+		"xn--bcher-kva.example":   "bücher.example",
+		"*.xn--bcher-kva.example": "*.bücher.example",
+	}
+	for name, want := range tests {
+		if got := DNSNameToUnicode(name); got != want {
+			t.Errorf("DNSNameToUnicode(%q): expected %q, got %q", name, want, got)
+		}
+	}
+}
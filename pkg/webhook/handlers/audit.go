@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	"github.com/jetstack/cert-manager/pkg/util/audit"
+)
+
+// auditAnnotations returns the audit annotations which record the issuance
+// decision made by an allowed admission request, or nil if the request does
+// not make one. The API server prefixes the keys with the name of the
+// webhook when adding them to the audit log.
+// Decisions are recorded when a CertificateRequest is created, approved,
+// denied or signed, and when a Certificate is marked for re-issuance.
+func auditAnnotations(scheme *runtime.Scheme, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) map[string]string {
+	var annotations audit.Annotations
+
+	switch obj := obj.(type) {
+	case *internalcmapi.CertificateRequest:
+		decision, reason := certificateRequestDecision(req, oldObj, obj)
+		if len(decision) == 0 {
+			return nil
+		}
+		cr := new(cmapi.CertificateRequest)
+		if err := scheme.Convert(obj, cr, nil); err != nil {
+			return nil
+		}
+		annotations = audit.ForCertificateRequest(decision, cr).Set(audit.ReasonKey, reason)
+		if decision == audit.DecisionSigned {
+			annotations.SetCertificate(cr.Status.Certificate)
+		}
+
+	case *internalcmapi.Certificate:
+		oldCrt, ok := oldObj.(*internalcmapi.Certificate)
+		if !ok || req.Operation != admissionv1.Update {
+			return nil
+		}
+		cond := certificateCondition(obj, internalcmapi.CertificateConditionIssuing)
+		if cond == nil || cond.Status != cmmeta.ConditionTrue {
+			return nil
+		}
+		if old := certificateCondition(oldCrt, internalcmapi.CertificateConditionIssuing); old != nil && old.Status == cmmeta.ConditionTrue {
+			return nil
+		}
+		crt := new(cmapi.Certificate)
+		if err := scheme.Convert(obj, crt, nil); err != nil {
+			return nil
+		}
+		annotations = audit.ForCertificate(audit.DecisionReissue, crt).Set(audit.ReasonKey, cond.Reason)
+
+	default:
+		return nil
+	}
+
+	return annotations.Set(audit.DecidedByKey, req.UserInfo.Username)
+}
+
+// certificateRequestDecision returns the decision made by the admission
+// request for the CertificateRequest, along with the reason given for it.
+func certificateRequestDecision(req *admissionv1.AdmissionRequest, oldObj runtime.Object, cr *internalcmapi.CertificateRequest) (string, string) {
+	if req.Operation == admissionv1.Create {
+		return audit.DecisionRequested, ""
+	}
+
+	oldCR, ok := oldObj.(*internalcmapi.CertificateRequest)
+	if !ok || req.Operation != admissionv1.Update {
+		return "", ""
+	}
+
+	if len(oldCR.Status.Certificate) == 0 && len(cr.Status.Certificate) > 0 {
+		return audit.DecisionSigned, ""
+	}
+	if certificateRequestCondition(oldCR, internalcmapi.CertificateRequestConditionApproved) == nil {
+		if cond := certificateRequestCondition(cr, internalcmapi.CertificateRequestConditionApproved); cond != nil {
+			return audit.DecisionApproved, cond.Reason
+		}
+	}
+	if certificateRequestCondition(oldCR, internalcmapi.CertificateRequestConditionDenied) == nil {
+		if cond := certificateRequestCondition(cr, internalcmapi.CertificateRequestConditionDenied); cond != nil {
+			return audit.DecisionDenied, cond.Reason
+		}
+	}
+
+	return "", ""
+}
+
+func certificateRequestCondition(cr *internalcmapi.CertificateRequest, conditionType internalcmapi.CertificateRequestConditionType) *internalcmapi.CertificateRequestCondition {
+	for i, cond := range cr.Status.Conditions {
+		if cond.Type == conditionType {
+			return &cr.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func certificateCondition(crt *internalcmapi.Certificate, conditionType internalcmapi.CertificateConditionType) *internalcmapi.CertificateCondition {
+	for i, cond := range crt.Status.Conditions {
+		if cond.Type == conditionType {
+			return &crt.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cminstall "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/install"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+)
+
+func TestAuditAnnotations(t *testing.T) {
+	scheme := runtime.NewScheme()
+	cminstall.Install(scheme)
+
+	newCR := func(conditions ...internalcmapi.CertificateRequestCondition) *internalcmapi.CertificateRequest {
+		return &internalcmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "cr", Namespace: "ns"},
+			Spec: internalcmapi.CertificateRequestSpec{
+				Username:  "alice",
+				IssuerRef: cmmeta.ObjectReference{Name: "ca"},
+			},
+			Status: internalcmapi.CertificateRequestStatus{Conditions: conditions},
+		}
+	}
+	approved := internalcmapi.CertificateRequestCondition{
+		Type: internalcmapi.CertificateRequestConditionApproved, Status: cmmeta.ConditionTrue, Reason: "policy.example.com",
+	}
+	denied := internalcmapi.CertificateRequestCondition{
+		Type: internalcmapi.CertificateRequestConditionDenied, Status: cmmeta.ConditionTrue, Reason: "policy.example.com",
+	}
+	newCrt := func(issuing cmmeta.ConditionStatus) *internalcmapi.Certificate {
+		crt := &internalcmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "crt", Namespace: "ns"},
+			Spec: internalcmapi.CertificateSpec{
+				DNSNames:  []string{"example.com", "www.example.com"},
+				IssuerRef: cmmeta.ObjectReference{Name: "ca", Kind: "ClusterIssuer", Group: "cert-manager.io"},
+			},
+		}
+		if len(issuing) > 0 {
+			crt.Status.Conditions = []internalcmapi.CertificateCondition{{
+				Type: internalcmapi.CertificateConditionIssuing, Status: issuing, Reason: "Renewing",
+			}}
+		}
+		return crt
+	}
+	req := func(op admissionv1.Operation) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			UserInfo:  authenticationv1.UserInfo{Username: "bob"},
+		}
+	}
+
+	tests := map[string]struct {
+		req         *admissionv1.AdmissionRequest
+		oldObj, obj runtime.Object
+
+		exp map[string]string
+	}{
+		"creating a CertificateRequest records the request": {
+			req: req(admissionv1.Create),
+			obj: newCR(),
+			exp: map[string]string{
+				"decision":   "requested",
+				"decided-by": "bob",
+				"requestor":  "alice",
+				"issuer":     "Issuer.cert-manager.io/ca",
+			},
+		},
+		"approving a CertificateRequest records the approval": {
+			req:    req(admissionv1.Update),
+			oldObj: newCR(),
+			obj:    newCR(approved),
+			exp: map[string]string{
+				"decision":   "approved",
+				"decided-by": "bob",
+				"reason":     "policy.example.com",
+				"requestor":  "alice",
+				"issuer":     "Issuer.cert-manager.io/ca",
+			},
+		},
+		"denying a CertificateRequest records the denial": {
+			req:    req(admissionv1.Update),
+			oldObj: newCR(),
+			obj:    newCR(denied),
+			exp: map[string]string{
+				"decision":   "denied",
+				"decided-by": "bob",
+				"reason":     "policy.example.com",
+				"requestor":  "alice",
+				"issuer":     "Issuer.cert-manager.io/ca",
+			},
+		},
+		"updating an approved CertificateRequest records nothing": {
+			req:    req(admissionv1.Update),
+			oldObj: newCR(approved),
+			obj:    newCR(approved),
+		},
+		"marking a Certificate for issuance records the re-issuance": {
+			req:    req(admissionv1.Update),
+			oldObj: newCrt(""),
+			obj:    newCrt(cmmeta.ConditionTrue),
+			exp: map[string]string{
+				"decision":         "reissue",
+				"decided-by":       "bob",
+				"reason":           "Renewing",
+				"issuer":           "ClusterIssuer.cert-manager.io/ca",
+				"certificate-name": "crt",
+				"dns-names":        "example.com,www.example.com",
+			},
+		},
+		"updating a Certificate which is already being issued records nothing": {
+			req:    req(admissionv1.Update),
+			oldObj: newCrt(cmmeta.ConditionTrue),
+			obj:    newCrt(cmmeta.ConditionTrue),
+		},
+		"creating an Issuer records nothing": {
+			req: req(admissionv1.Create),
+			obj: &internalcmapi.Issuer{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := auditAnnotations(scheme, test.req, test.oldObj, test.obj)
+			if len(test.exp) == 0 && len(got) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(test.exp, got) {
+				t.Errorf("unexpected audit annotations, exp=%v got=%v", test.exp, got)
+			}
+		})
+	}
+}
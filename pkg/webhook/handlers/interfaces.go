@@ -22,8 +22,19 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/validation/plugins"
 )
 
+// PluginConfig is the configuration of the plugins registered for a
+// validating admission hook.
+type PluginConfig = plugins.Config
+
+// SANPolicy limits the subject alternative names of admitted Certificates.
+type SANPolicy = plugins.SANPolicy
+
 type ValidatingAdmissionHook interface {
 	// Validate is called to decide whether to accept the admission request. The returned AdmissionResponse
 	// must not use the Patch field.
@@ -31,7 +42,21 @@ type ValidatingAdmissionHook interface {
 
 	// InitPlugins will initialise all plugins which are registered for this
 	// validating admission hook.
-	InitPlugins(client kubernetes.Interface)
+	InitPlugins(client kubernetes.Interface, cmclient cmclient.Interface)
+
+	// StartPlugins will start all plugins which are registered for this
+	// validating admission hook and need to run in the background, until
+	// stopCh is closed. It blocks until the plugins are ready to validate
+	// requests. It must be called after InitPlugins.
+	StartPlugins(stopCh <-chan struct{})
+
+	// ConfigurePlugins will pass the given configuration to all plugins which
+	// are registered for this validating admission hook and accept it.
+	ConfigurePlugins(config PluginConfig)
+
+	// SetEventRecorder sets the recorder used to record the warnings returned
+	// for admitted objects as events on those objects.
+	SetEventRecorder(recorder record.EventRecorder)
 }
 
 type MutatingAdmissionHook interface {
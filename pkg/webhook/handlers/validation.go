@@ -22,41 +22,71 @@ import (
 
 	"github.com/go-logr/logr"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	"github.com/jetstack/cert-manager/pkg/controller/events"
 	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/validation/plugins"
 )
 
 type registryBackedValidator struct {
 	log      logr.Logger
+	scheme   *runtime.Scheme
 	decoder  runtime.Decoder
 	registry *validation.Registry
 
 	plugins []plugins.Plugin
+
+	// recorder is used to record warnings as events, if set.
+	recorder record.EventRecorder
 }
 
 func NewRegistryBackedValidator(log logr.Logger, scheme *runtime.Scheme, registry *validation.Registry) *registryBackedValidator {
 	factory := serializer.NewCodecFactory(scheme)
 	return &registryBackedValidator{
 		log:      log,
+		scheme:   scheme,
 		decoder:  factory.UniversalDecoder(),
 		registry: registry,
 		plugins:  plugins.All(scheme),
 	}
 }
 
-func (r *registryBackedValidator) InitPlugins(client kubernetes.Interface) {
+func (r *registryBackedValidator) InitPlugins(client kubernetes.Interface, cmclient cmclient.Interface) {
+	for _, plugin := range r.plugins {
+		plugin.Init(client, cmclient)
+	}
+}
+
+func (r *registryBackedValidator) StartPlugins(stopCh <-chan struct{}) {
 	for _, plugin := range r.plugins {
-		plugin.Init(client)
+		if s, ok := plugin.(plugins.Startable); ok {
+			s.Start(stopCh)
+		}
+	}
+}
+
+func (r *registryBackedValidator) ConfigurePlugins(config PluginConfig) {
+	for _, plugin := range r.plugins {
+		if c, ok := plugin.(plugins.Configurable); ok {
+			c.Configure(config)
+		}
 	}
 }
 
+func (r *registryBackedValidator) SetEventRecorder(recorder record.EventRecorder) {
+	r.recorder = recorder
+}
+
 func (r *registryBackedValidator) Validate(ctx context.Context, admissionSpec *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
 	status := &admissionv1.AdmissionResponse{}
 	status.UID = admissionSpec.UID
@@ -127,5 +157,32 @@ func (r *registryBackedValidator) Validate(ctx context.Context, admissionSpec *a
 	}
 
 	status.Allowed = true
+	status.AuditAnnotations = auditAnnotations(r.scheme, admissionSpec, oldObj, obj)
+	r.recordWarnings(admissionSpec, obj, warnings)
 	return status
 }
+
+// recordWarnings records the given warnings as events on the admitted object,
+// so that they are visible to users who did not see the response, such as
+// those applying manifests through a GitOps tool.
+func (r *registryBackedValidator) recordWarnings(admissionSpec *admissionv1.AdmissionRequest, obj runtime.Object, warnings validation.WarningList) {
+	if r.recorder == nil || len(warnings) == 0 || len(admissionSpec.Name) == 0 {
+		return
+	}
+	if admissionSpec.DryRun != nil && *admissionSpec.DryRun {
+		return
+	}
+
+	ref := &corev1.ObjectReference{
+		APIVersion: schema.GroupVersion{Group: admissionSpec.Kind.Group, Version: admissionSpec.Kind.Version}.String(),
+		Kind:       admissionSpec.Kind.Kind,
+		Namespace:  admissionSpec.Namespace,
+		Name:       admissionSpec.Name,
+	}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		ref.UID = accessor.GetUID()
+	}
+	for _, warning := range warnings {
+		r.recorder.Event(ref, corev1.EventTypeWarning, events.ReasonValidationWarning, warning)
+	}
+}
@@ -17,17 +17,22 @@ limitations under the License.
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/webhook/handlers/testdata/apis/testgroup"
 	"github.com/jetstack/cert-manager/pkg/webhook/handlers/testdata/apis/testgroup/install"
 	v1 "github.com/jetstack/cert-manager/pkg/webhook/handlers/testdata/apis/testgroup/v1"
 	v2 "github.com/jetstack/cert-manager/pkg/webhook/handlers/testdata/apis/testgroup/v2"
@@ -260,3 +265,71 @@ func TestRegistryBackedValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryBackedValidatorRecordsWarnings(t *testing.T) {
+	scheme := runtime.NewScheme()
+	registry := validation.NewRegistry(scheme)
+	install.Install(scheme)
+	if err := registry.AddValidateFunc(&testgroup.TestType{}, func(_ *admissionv1.AdmissionRequest, _ runtime.Object) (field.ErrorList, validation.WarningList) {
+		return nil, validation.WarningList{"testField is deprecated"}
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	dryRun := true
+	request := func(name string, dryRun *bool) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			UID:         types.UID("abc"),
+			Kind:        metav1.GroupVersionKind{Group: v1.SchemeGroupVersion.Group, Version: v1.SchemeGroupVersion.Version, Kind: "TestType"},
+			RequestKind: &metav1.GroupVersionKind{Group: v1.SchemeGroupVersion.Group, Version: v1.SchemeGroupVersion.Version, Kind: "TestType"},
+			Name:        name,
+			Namespace:   "abc",
+			Operation:   admissionv1.Create,
+			DryRun:      dryRun,
+			Object: runtime.RawExtension{
+				Raw: []byte(`{"apiVersion": "testgroup.testing.cert-manager.io/v1", "kind": "TestType", "metadata": {"name": "testing", "namespace": "abc"}}`),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		request        *admissionv1.AdmissionRequest
+		expectedEvents []string
+	}{
+		"warnings are recorded as events": {
+			request:        request("testing", nil),
+			expectedEvents: []string{"Warning ValidationWarning testField is deprecated"},
+		},
+		"warnings are not recorded for dry run requests": {
+			request: request("testing", &dryRun),
+		},
+		"warnings are not recorded for objects without a name": {
+			request: request("", nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			c := NewRegistryBackedValidator(logf.Log, scheme, registry)
+			c.SetEventRecorder(recorder)
+
+			resp := c.Validate(context.TODO(), test.request)
+			if !resp.Allowed {
+				t.Fatalf("expected request to be allowed: %v", resp.Result)
+			}
+			if !reflect.DeepEqual(resp.Warnings, []string{"testField is deprecated"}) {
+				t.Errorf("unexpected warnings: %v", resp.Warnings)
+			}
+
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if !reflect.DeepEqual(events, test.expectedEvents) {
+				t.Errorf("unexpected events, exp=%v, got=%v", test.expectedEvents, events)
+			}
+		})
+	}
+}
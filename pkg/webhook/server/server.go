@@ -193,6 +193,14 @@ func (s *Server) Run(stopCh <-chan struct{}) error {
 		s.Log.V(logf.InfoLevel).Info("listening for insecure connections", "address", s.ListenAddr)
 	}
 
+	// start any validation plugins which keep state, such as informer
+	// caches, up to date in the background. Serving is not held up until
+	// they are ready, as syncing their caches may itself need the conversion
+	// webhook; plugins reject requests until then.
+	if s.ValidationWebhook != nil {
+		go s.ValidationWebhook.StartPlugins(gctx.Done())
+	}
+
 	s.listener = listener
 	mux := http.NewServeMux()
 	mux.HandleFunc("/validate", s.handle(s.validate))
@@ -0,0 +1,301 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tls
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	coreclientset "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	crlog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/jetstack/cert-manager/cmd/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// SecretSource provides certificate data for a golang HTTP server using the
+// kubernetes.io/tls Secret resource named SecretName, which is typically
+// managed by a cert-manager Certificate using an in-cluster Issuer.
+// Until that Secret contains a valid key pair, certificates are provided by
+// the Fallback source instead, allowing the webhook to bootstrap itself.
+type SecretSource struct {
+	// Namespace of the serving certificate, fallback CA and CA bundle Secret
+	// resources.
+	SecretNamespace string
+
+	// SecretName is the name of the Secret containing the serving certificate.
+	SecretName string
+
+	// Fallback is used to provide certificates until the serving certificate
+	// Secret contains a valid key pair.
+	Fallback *DynamicSource
+
+	// CABundleSecretName is the name of a Secret which will be kept up to date
+	// with a CA bundle containing both the CA of the serving certificate and
+	// the CA of the Fallback source. If set, the CA bundles of the webhook
+	// configurations should be injected from this Secret so that they are
+	// updated before the serving certificate is switched over.
+	CABundleSecretName string
+
+	// RESTConfig used to connect to the apiserver.
+	RESTConfig *rest.Config
+
+	// Log is an optional logger to write informational and error messages to.
+	// If not specified, no messages will be logged.
+	Log logr.Logger
+
+	servingLister    corelisters.SecretNamespaceLister
+	fallbackCALister corelisters.SecretNamespaceLister
+	client           coreclientset.SecretInterface
+
+	cachedCertificate *tls.Certificate
+	lock              sync.Mutex
+}
+
+var _ CertificateSource = &SecretSource{}
+
+func (s *SecretSource) Run(stopCh <-chan struct{}) error {
+	ctx := util.ContextWithStopCh(context.Background(), stopCh)
+
+	if s.Log == nil {
+		s.Log = crlog.NullLogger{}
+	}
+	if s.SecretNamespace == "" {
+		return fmt.Errorf("SecretNamespace must be set")
+	}
+	if s.SecretName == "" {
+		return fmt.Errorf("SecretName must be set")
+	}
+	if s.Fallback == nil {
+		return fmt.Errorf("Fallback must be set")
+	}
+
+	cl, err := kubernetes.NewForConfig(s.RESTConfig)
+	if err != nil {
+		return err
+	}
+	s.client = cl.CoreV1().Secrets(s.SecretNamespace)
+
+	// Run the fallback source in a separate goroutine
+	fallbackErrChan := make(chan error)
+	go func() {
+		defer close(fallbackErrChan)
+		fallbackErrChan <- s.Fallback.Run(stopCh)
+	}()
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { s.handleChange(ctx) },
+		UpdateFunc: func(interface{}, interface{}) { s.handleChange(ctx) },
+		DeleteFunc: func(interface{}) { s.handleChange(ctx) },
+	}
+	var mustSync []cache.InformerSynced
+	s.servingLister, mustSync = s.watchSecret(ctx, cl, s.SecretName, handler, mustSync)
+	s.fallbackCALister, mustSync = s.watchSecret(ctx, cl, s.Fallback.Authority.SecretName, handler, mustSync)
+	if !cache.WaitForCacheSync(ctx.Done(), mustSync...) {
+		return fmt.Errorf("failed waiting for informer caches to sync")
+	}
+
+	// continuously check the Secret resources every 10s in case any events
+	// have been missed.
+	if err := wait.PollImmediateUntil(time.Second*10, func() (done bool, err error) {
+		select {
+		case err, ok := <-fallbackErrChan:
+			if err != nil {
+				return true, fmt.Errorf("failed to run fallback certificate source: %w", err)
+			}
+			if !ok {
+				return true, context.Canceled
+			}
+		default:
+		}
+
+		s.handleChange(ctx)
+		return false, nil
+	}, stopCh); err != nil {
+		<-fallbackErrChan
+		return err
+	}
+
+	return nil
+}
+
+// watchSecret starts an informer which only watches the named Secret, so
+// that RBAC may be restricted to the Secrets used by the source.
+func (s *SecretSource) watchSecret(ctx context.Context, cl kubernetes.Interface, name string, handler cache.ResourceEventHandler, mustSync []cache.InformerSynced) (corelisters.SecretNamespaceLister, []cache.InformerSynced) {
+	escapedName := fields.EscapeValue(name)
+	factory := informers.NewSharedInformerFactoryWithOptions(cl, time.Minute,
+		informers.WithNamespace(s.SecretNamespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = "metadata.name=" + escapedName
+		}),
+	)
+	informer := factory.Core().V1().Secrets().Informer()
+	informer.AddEventHandler(handler)
+	factory.Start(ctx.Done())
+	return factory.Core().V1().Secrets().Lister().Secrets(s.SecretNamespace), append(mustSync, informer.HasSynced)
+}
+
+func (s *SecretSource) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.cachedCertificate == nil {
+		return s.Fallback.GetCertificate(hello)
+	}
+	return s.cachedCertificate, nil
+}
+
+func (s *SecretSource) Healthy() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.cachedCertificate != nil || s.Fallback.Healthy()
+}
+
+func (s *SecretSource) handleChange(ctx context.Context) {
+	if err := s.sync(ctx); err != nil {
+		s.Log.Error(err, "error syncing serving certificate", "secret_namespace", s.SecretNamespace, "secret_name", s.SecretName)
+	}
+}
+
+// sync updates the cached serving certificate from the serving certificate
+// Secret. If a CA bundle Secret is configured, it is updated to contain the
+// CA of the serving certificate before the serving certificate is used.
+func (s *SecretSource) sync(ctx context.Context) error {
+	secret, err := s.servingLister.Get(s.SecretName)
+	if apierrors.IsNotFound(err) {
+		s.setCertificate(nil)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		s.Log.V(logf.DebugLevel).Info("serving certificate Secret does not contain a valid key pair, using fallback", "error", err.Error())
+		s.setCertificate(nil)
+		return nil
+	}
+
+	if s.CABundleSecretName != "" {
+		caData := secret.Data[cmmeta.TLSCAKey]
+		if fallbackCA, err := s.fallbackCALister.Get(s.Fallback.Authority.SecretName); err == nil {
+			caData = appendCertificates(fallbackCA.Data[cmmeta.TLSCAKey], caData)
+		}
+		if err := s.ensureCABundle(ctx, caData); err != nil {
+			return fmt.Errorf("failed to update CA bundle Secret: %w", err)
+		}
+	}
+
+	s.setCertificate(&cert)
+	return nil
+}
+
+func (s *SecretSource) setCertificate(cert *tls.Certificate) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if cert != nil && (s.cachedCertificate == nil || !bytes.Equal(s.cachedCertificate.Certificate[0], cert.Certificate[0])) {
+		s.Log.V(logf.InfoLevel).Info("Updated serving certificate from Secret", "secret_namespace", s.SecretNamespace, "secret_name", s.SecretName)
+	}
+	s.cachedCertificate = cert
+}
+
+// ensureCABundle will ensure the CA bundle Secret contains the given CA data.
+// The Secret is annotated to allow the cainjector to inject from it.
+func (s *SecretSource) ensureCABundle(ctx context.Context, caData []byte) error {
+	bundle, err := s.client.Get(ctx, s.CABundleSecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = s.client.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: s.SecretNamespace,
+				Name:      s.CABundleSecretName,
+				Annotations: map[string]string{
+					cmapi.AllowsInjectionFromSecretAnnotation: "true",
+				},
+			},
+			Data: map[string][]byte{
+				cmmeta.TLSCAKey: caData,
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(bundle.Data[cmmeta.TLSCAKey], caData) {
+		return nil
+	}
+
+	bundle = bundle.DeepCopy()
+	if bundle.Data == nil {
+		bundle.Data = make(map[string][]byte)
+	}
+	bundle.Data[cmmeta.TLSCAKey] = caData
+	_, err = s.client.Update(ctx, bundle, metav1.UpdateOptions{})
+	return err
+}
+
+// appendCertificates returns the PEM encoded certificates in a followed by
+// those in b which are not already present in a.
+func appendCertificates(a, b []byte) []byte {
+	existing, err := pki.DecodeX509CertificateChainBytes(a)
+	if err != nil {
+		return b
+	}
+	additional, err := pki.DecodeX509CertificateChainBytes(b)
+	if err != nil {
+		return a
+	}
+
+	out := append([]byte{}, a...)
+	for _, crt := range additional {
+		found := false
+		for _, e := range existing {
+			if e.Equal(crt) {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		pem, err := pki.EncodeX509(crt)
+		if err != nil {
+			continue
+		}
+		out = append(out, pem...)
+	}
+	return out
+}
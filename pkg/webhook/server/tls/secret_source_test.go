@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tls
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	logtesting "github.com/jetstack/cert-manager/pkg/logs/testing"
+	"github.com/jetstack/cert-manager/pkg/webhook/authority"
+)
+
+func TestSecretSource_Sync(t *testing.T) {
+	pkBytes, certBytes := generatePrivateKeyAndCertificate(t, "serving")
+	_, fallbackCABytes := generatePrivateKeyAndCertificate(t, "fallback")
+
+	secret := func(name string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "cert-manager", Name: name},
+			Data:       data,
+		}
+	}
+
+	tests := map[string]struct {
+		secrets []*corev1.Secret
+
+		expectCertificate bool
+		expectedCABundle  []byte
+	}{
+		"use the fallback if the serving certificate Secret does not exist": {
+			secrets: []*corev1.Secret{
+				secret("ca", map[string][]byte{cmmeta.TLSCAKey: fallbackCABytes}),
+			},
+		},
+		"use the fallback if the serving certificate Secret does not contain a valid key pair": {
+			secrets: []*corev1.Secret{
+				secret("ca", map[string][]byte{cmmeta.TLSCAKey: fallbackCABytes}),
+				secret("serving", map[string][]byte{corev1.TLSCertKey: certBytes}),
+			},
+		},
+		"use the serving certificate and update the CA bundle with both CAs": {
+			secrets: []*corev1.Secret{
+				secret("ca", map[string][]byte{cmmeta.TLSCAKey: fallbackCABytes}),
+				secret("serving", map[string][]byte{
+					corev1.TLSCertKey:       certBytes,
+					corev1.TLSPrivateKeyKey: pkBytes,
+					cmmeta.TLSCAKey:         certBytes,
+				}),
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "cert-manager",
+						Name:        "ca-bundle",
+						Annotations: map[string]string{cmapi.AllowsInjectionFromSecretAnnotation: "true"},
+					},
+					Data: map[string][]byte{cmmeta.TLSCAKey: fallbackCABytes},
+				},
+			},
+			expectCertificate: true,
+			expectedCABundle:  append(append([]byte{}, fallbackCABytes...), certBytes...),
+		},
+		"create the CA bundle Secret and do not duplicate CAs": {
+			secrets: []*corev1.Secret{
+				secret("ca", map[string][]byte{cmmeta.TLSCAKey: certBytes}),
+				secret("serving", map[string][]byte{
+					corev1.TLSCertKey:       certBytes,
+					corev1.TLSPrivateKeyKey: pkBytes,
+					cmmeta.TLSCAKey:         certBytes,
+				}),
+			},
+			expectCertificate: true,
+			expectedCABundle:  certBytes,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			cl := fake.NewSimpleClientset()
+			for _, s := range test.secrets {
+				if err := indexer.Add(s); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := cl.CoreV1().Secrets(s.Namespace).Create(context.TODO(), s, metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			lister := corelisters.NewSecretLister(indexer).Secrets("cert-manager")
+
+			source := &SecretSource{
+				SecretNamespace:    "cert-manager",
+				SecretName:         "serving",
+				CABundleSecretName: "ca-bundle",
+				Fallback: &DynamicSource{
+					Authority: &authority.DynamicAuthority{SecretNamespace: "cert-manager", SecretName: "ca"},
+				},
+				Log:              logtesting.TestLogger{T: t},
+				servingLister:    lister,
+				fallbackCALister: lister,
+				client:           cl.CoreV1().Secrets("cert-manager"),
+			}
+
+			if err := source.sync(context.TODO()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if test.expectCertificate != (source.cachedCertificate != nil) {
+				t.Errorf("expected serving certificate to be used: %v, got: %v", test.expectCertificate, source.cachedCertificate != nil)
+			}
+
+			bundle, err := cl.CoreV1().Secrets("cert-manager").Get(context.TODO(), "ca-bundle", metav1.GetOptions{})
+			if test.expectedCABundle == nil {
+				if err == nil && !bytes.Equal(bundle.Data[cmmeta.TLSCAKey], fallbackCABytes) {
+					t.Errorf("expected CA bundle Secret to not be modified")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get CA bundle Secret: %v", err)
+			}
+			if !bytes.Equal(bundle.Data[cmmeta.TLSCAKey], test.expectedCABundle) {
+				t.Errorf("unexpected CA bundle, exp=%q, got=%q", test.expectedCABundle, bundle.Data[cmmeta.TLSCAKey])
+			}
+			if bundle.Annotations[cmapi.AllowsInjectionFromSecretAnnotation] != "true" {
+				t.Errorf("expected CA bundle Secret to allow injection")
+			}
+		})
+	}
+}
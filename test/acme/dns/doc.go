@@ -0,0 +1,41 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dns contains a conformance test suite for DNS01 webhook solvers.
+//
+// Authors of webhook solvers can run the suite against their implementation
+// from a Go test:
+//
+//	func TestRunsSuite(t *testing.T) {
+//		fixture := dns.NewFixture(&mySolver{},
+//			dns.SetResolvedZone("example.com."),
+//			dns.SetManifestPath("testdata/my-solver"),
+//			dns.SetStrict(true),
+//		)
+//		fixture.RunConformance(t)
+//	}
+//
+// The basic tests check the behaviour the challenges controller relies on
+// from every solver: that Present creates a record which propagates, that
+// CleanUp removes it, and that both may safely be called more than once.
+// The extended tests check behaviour which issuing many Certificates
+// relies on: that records for the same name are independent, that they
+// have a short TTL, and that challenges can be solved concurrently.
+//
+// The suite is updated alongside the challenges controller whenever the
+// controller begins to rely on further behaviour from solvers, so that
+// solvers which pass it remain compatible with new releases.
+package dns
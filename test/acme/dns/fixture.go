@@ -76,6 +76,17 @@ type fixture struct {
 
 	pollInterval     time.Duration
 	propagationLimit time.Duration
+
+	// maxRecordTTL is the longest TTL a presented record may have.
+	// This field can be set using the SetMaxRecordTTL Option.
+	// Default: 5m
+	maxRecordTTL time.Duration
+
+	// concurrentChallenges is the number of challenges presented at once
+	// when testing concurrent challenges.
+	// This field can be set using the SetConcurrentChallenges Option.
+	// Default: 4
+	concurrentChallenges int
 }
 
 func (f *fixture) setup(t *testing.T) func() {
@@ -118,6 +129,8 @@ func (f *fixture) RunBasic(t *testing.T) {
 	defer f.setup(t)()
 	t.Run("Basic", func(t *testing.T) {
 		t.Run("PresentRecord", f.TestBasicPresentRecord)
+		t.Run("PresentIsIdempotent", f.TestBasicPresentIsIdempotent)
+		t.Run("CleanUpIsIdempotent", f.TestBasicCleanUpIsIdempotent)
 	})
 }
 
@@ -125,5 +138,7 @@ func (f *fixture) RunExtended(t *testing.T) {
 	defer f.setup(t)()
 	t.Run("Extended", func(t *testing.T) {
 		t.Run("DeletingOneRecordRetainsOthers", f.TestExtendedDeletingOneRecordRetainsOthers)
+		t.Run("RecordTTL", f.TestExtendedRecordTTL)
+		t.Run("ConcurrentChallenges", f.TestExtendedConcurrentChallenges)
 	})
 }
@@ -175,3 +175,19 @@ func SetDNSName(s string) Option {
 		f.dnsName = s
 	}
 }
+
+// SetMaxRecordTTL defines the longest TTL that records presented by the
+// solver may have.
+func SetMaxRecordTTL(d time.Duration) Option {
+	return func(f *fixture) {
+		f.maxRecordTTL = d
+	}
+}
+
+// SetConcurrentChallenges defines the number of challenges presented at
+// once when testing that the solver supports concurrent challenges.
+func SetConcurrentChallenges(n int) Option {
+	return func(f *fixture) {
+		f.concurrentChallenges = n
+	}
+}
@@ -17,9 +17,13 @@ limitations under the License.
 package dns
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/wait"
+
+	whapi "github.com/jetstack/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
 )
 
 // TestBasicPresentRecord will perform a basic validation that the Present
@@ -120,3 +124,188 @@ func (f *fixture) TestExtendedDeletingOneRecordRetainsOthers(t *testing.T) {
 		return
 	}
 }
+
+// TestBasicPresentIsIdempotent validates that calling Present for a record
+// which has already been presented succeeds and leaves the record in place.
+// The challenges controller calls Present again if it fails to persist that
+// a challenge has been presented, e.g. due to a conflicting update.
+func (f *fixture) TestBasicPresentIsIdempotent(t *testing.T) {
+	ns, cleanup := f.setupNamespace(t, "basic-present-is-idempotent")
+	defer cleanup()
+	ch := f.buildChallengeRequest(t, ns)
+
+	for i := 0; i < 2; i++ {
+		if err := f.testSolver.Present(ch); err != nil {
+			t.Errorf("expected call %d to Present to not error, but got: %v", i+1, err)
+			return
+		}
+	}
+	defer f.testSolver.CleanUp(ch)
+
+	if err := wait.PollUntil(f.getPollInterval(),
+		f.recordHasPropagatedCheck(ch.ResolvedFQDN, ch.Key),
+		closingStopCh(f.getPropagationLimit())); err != nil {
+		t.Errorf("error waiting for DNS record propagation: %v", err)
+		return
+	}
+
+	if err := f.testSolver.CleanUp(ch); err != nil {
+		t.Errorf("expected CleanUp to not error, but got: %v", err)
+	}
+
+	if err := wait.PollUntil(f.getPollInterval(),
+		f.recordHasBeenDeletedCheck(ch.ResolvedFQDN, ch.Key),
+		closingStopCh(f.getPropagationLimit())); err != nil {
+		t.Errorf("error waiting for record to be deleted: %v", err)
+		return
+	}
+}
+
+// TestBasicCleanUpIsIdempotent validates that calling CleanUp for a record
+// which has already been cleaned up, or which was never presented, succeeds.
+// The challenges controller calls CleanUp again if it fails to persist that
+// a challenge has been cleaned up, and when a challenge is deleted before
+// Present has succeeded.
+func (f *fixture) TestBasicCleanUpIsIdempotent(t *testing.T) {
+	ns, cleanup := f.setupNamespace(t, "basic-cleanup-is-idempotent")
+	defer cleanup()
+	ch := f.buildChallengeRequest(t, ns)
+
+	if err := f.testSolver.CleanUp(ch); err != nil {
+		t.Errorf("expected CleanUp of a record which was never presented to not error, but got: %v", err)
+		return
+	}
+
+	if err := f.testSolver.Present(ch); err != nil {
+		t.Errorf("expected Present to not error, but got: %v", err)
+		return
+	}
+	defer f.testSolver.CleanUp(ch)
+
+	if err := wait.PollUntil(f.getPollInterval(),
+		f.recordHasPropagatedCheck(ch.ResolvedFQDN, ch.Key),
+		closingStopCh(f.getPropagationLimit())); err != nil {
+		t.Errorf("error waiting for DNS record propagation: %v", err)
+		return
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := f.testSolver.CleanUp(ch); err != nil {
+			t.Errorf("expected call %d to CleanUp to not error, but got: %v", i+1, err)
+			return
+		}
+	}
+
+	if err := wait.PollUntil(f.getPollInterval(),
+		f.recordHasBeenDeletedCheck(ch.ResolvedFQDN, ch.Key),
+		closingStopCh(f.getPropagationLimit())); err != nil {
+		t.Errorf("error waiting for record to be deleted: %v", err)
+		return
+	}
+}
+
+// TestExtendedRecordTTL validates that presented records have a TTL no
+// longer than the configured maximum.
+// Resolvers cache the absence of a record for no longer than its TTL, so a
+// long TTL delays the propagation check and validation of later challenges
+// for the same name.
+func (f *fixture) TestExtendedRecordTTL(t *testing.T) {
+	ns, cleanup := f.setupNamespace(t, "extended-record-ttl")
+	defer cleanup()
+	ch := f.buildChallengeRequest(t, ns)
+
+	if err := f.testSolver.Present(ch); err != nil {
+		t.Errorf("expected Present to not error, but got: %v", err)
+		return
+	}
+	defer f.testSolver.CleanUp(ch)
+
+	if err := wait.PollUntil(f.getPollInterval(),
+		f.recordHasPropagatedCheck(ch.ResolvedFQDN, ch.Key),
+		closingStopCh(f.getPropagationLimit())); err != nil {
+		t.Errorf("error waiting for DNS record propagation: %v", err)
+		return
+	}
+
+	ttl, err := f.recordTTL(ch.ResolvedFQDN, ch.Key)
+	if err != nil {
+		t.Errorf("error looking up TTL of record: %v", err)
+		return
+	}
+	if ttl > f.getMaxRecordTTL() {
+		t.Errorf("expected record TTL to be at most %v, but got: %v", f.getMaxRecordTTL(), ttl)
+	}
+}
+
+// TestExtendedConcurrentChallenges validates that a DNS01 provider can
+// present and clean up several challenges for the same record name at once.
+// The challenges controller processes challenges concurrently, and a
+// Certificate for both a domain and its wildcard results in two challenges
+// for the same record name.
+// In strict mode each challenge has a different key, and every record must
+// be retained until its own challenge is cleaned up. Otherwise all
+// challenges share the same key.
+func (f *fixture) TestExtendedConcurrentChallenges(t *testing.T) {
+	ns, cleanup := f.setupNamespace(t, "extended-concurrent-challenges")
+	defer cleanup()
+
+	chs := make([]*whapi.ChallengeRequest, f.getConcurrentChallenges())
+	for i := range chs {
+		chs[i] = f.buildChallengeRequest(t, ns)
+		if f.strictMode {
+			chs[i].Key = fmt.Sprintf("%s-%d", chs[i].Key, i)
+		}
+	}
+
+	// run calls fn for every challenge at once, and returns the first error.
+	run := func(fn func(*whapi.ChallengeRequest) error) error {
+		var wg sync.WaitGroup
+		errs := make([]error, len(chs))
+		for i := range chs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = fn(chs[i])
+			}(i)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := run(f.testSolver.Present)
+	defer run(f.testSolver.CleanUp)
+	if err != nil {
+		t.Errorf("expected concurrent calls to Present to not error, but got: %v", err)
+		return
+	}
+
+	var propagated, deleted []wait.ConditionFunc
+	for _, ch := range chs {
+		propagated = append(propagated, f.recordHasPropagatedCheck(ch.ResolvedFQDN, ch.Key))
+		deleted = append(deleted, f.recordHasBeenDeletedCheck(ch.ResolvedFQDN, ch.Key))
+	}
+
+	if err := wait.PollUntil(f.getPollInterval(),
+		allConditions(propagated...),
+		closingStopCh(f.getPropagationLimit())); err != nil {
+		t.Errorf("error waiting for DNS record propagation: %v", err)
+		return
+	}
+
+	if err := run(f.testSolver.CleanUp); err != nil {
+		t.Errorf("expected concurrent calls to CleanUp to not error, but got: %v", err)
+		return
+	}
+
+	if err := wait.PollUntil(f.getPollInterval(),
+		allConditions(deleted...),
+		closingStopCh(f.getPropagationLimit())); err != nil {
+		t.Errorf("error waiting for records to be deleted: %v", err)
+		return
+	}
+}
@@ -34,8 +34,10 @@ import (
 )
 
 var (
-	defaultPollInterval     = time.Second * 3
-	defaultPropagationLimit = time.Minute * 2
+	defaultPollInterval         = time.Second * 3
+	defaultPropagationLimit     = time.Minute * 2
+	defaultMaxRecordTTL         = time.Minute * 5
+	defaultConcurrentChallenges = 4
 )
 
 func (f *fixture) setupNamespace(t *testing.T, name string) (string, func()) {
@@ -161,3 +163,40 @@ func (f *fixture) getPropagationLimit() time.Duration {
 		return defaultPropagationLimit
 	}
 }
+
+func (f *fixture) getMaxRecordTTL() time.Duration {
+	if f.maxRecordTTL != 0 {
+		return f.maxRecordTTL
+	}
+	return defaultMaxRecordTTL
+}
+
+func (f *fixture) getConcurrentChallenges() int {
+	if f.concurrentChallenges != 0 {
+		return f.concurrentChallenges
+	}
+	return defaultConcurrentChallenges
+}
+
+// recordTTL returns the TTL of the TXT record with the given value.
+func (f *fixture) recordTTL(fqdn, value string) (time.Duration, error) {
+	msg, err := util.DNSQuery(fqdn, dns.TypeTXT, []string{f.testDNSServer}, *f.useAuthoritative)
+	if err != nil {
+		return 0, err
+	}
+	if msg.Rcode != dns.RcodeSuccess {
+		return 0, fmt.Errorf("unexpected error from DNS server: %v", dns.RcodeToString[msg.Rcode])
+	}
+	for _, rr := range msg.Answer {
+		txt, ok := rr.(*dns.TXT)
+		if !ok {
+			continue
+		}
+		for _, k := range txt.Txt {
+			if k == value {
+				return time.Duration(txt.Hdr.Ttl) * time.Second, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no TXT record with value %q found for %q", value, fqdn)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pebble
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AddA makes challtestsrv answer queries for the A records of host with the
+// given addresses. Names without A records resolve to 127.0.0.1.
+func (s *Server) AddA(host string, addresses ...string) error {
+	return s.challTestSrvRequest("/add-a", map[string]interface{}{
+		"host":      host,
+		"addresses": addresses,
+	})
+}
+
+// ClearA removes the A records of host.
+func (s *Server) ClearA(host string) error {
+	return s.challTestSrvRequest("/clear-a", map[string]interface{}{
+		"host": host,
+	})
+}
+
+// SetTXT sets the TXT record of the given fully qualified name, for
+// presenting DNS01 challenges.
+func (s *Server) SetTXT(fqdn, value string) error {
+	return s.challTestSrvRequest("/set-txt", map[string]interface{}{
+		"host":  fqdn,
+		"value": value,
+	})
+}
+
+// ClearTXT removes the TXT record of the given fully qualified name.
+func (s *Server) ClearTXT(fqdn string) error {
+	return s.challTestSrvRequest("/clear-txt", map[string]interface{}{
+		"host": fqdn,
+	})
+}
+
+func (s *Server) challTestSrvRequest(path string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := http.Post(s.challTestSrvURL+path, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("challtestsrv request to %s failed with status code %d", path, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pebble runs a pebble ACME server for use in Go tests.
+//
+// Tests of issuers and solvers can obtain real certificates from a local
+// ACME server without a Kubernetes cluster:
+//
+//	func TestIssue(t *testing.T) {
+//		srv := pebble.Run(t,
+//			pebble.SetNonceRejectPercent(10),
+//			pebble.SetRateLimitedOrders(1),
+//		)
+//		if err := srv.AddA("example.com", "127.0.0.1"); err != nil {
+//			t.Fatal(err)
+//		}
+//		cl := acme.Client{DirectoryURL: srv.DirectoryURL, HTTPClient: srv.HTTPClient()}
+//		...
+//	}
+//
+// The pebble and pebble-challtestsrv binaries are looked up on the PATH, or
+// at the paths in the PEBBLE and PEBBLE_CHALLTESTSRV environment variables.
+// Tests are skipped if they cannot be found. Pebble resolves all names using
+// challtestsrv, whose records can be changed while the test runs.
+package pebble
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pebble
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Option applies a configuration option to the server being run
+type Option func(*Server)
+
+func applyDefaults(s *Server) {
+	if s.pebblePath == "" {
+		s.pebblePath = lookPath("PEBBLE", "pebble")
+	}
+	if s.challTestSrvPath == "" {
+		s.challTestSrvPath = lookPath("PEBBLE_CHALLTESTSRV", "pebble-challtestsrv")
+	}
+	if s.http01Port == 0 {
+		s.http01Port = 5002
+	}
+}
+
+// lookPath returns the path in the given environment variable, or else the
+// path of the named binary on the PATH. It returns an empty string if neither
+// is found.
+func lookPath(env, name string) string {
+	if p := os.Getenv(env); p != "" {
+		return p
+	}
+	p, err := exec.LookPath(name)
+	if err != nil {
+		return ""
+	}
+	return p
+}
+
+// SetPebblePath sets the path of the pebble binary.
+func SetPebblePath(path string) Option {
+	return func(s *Server) {
+		s.pebblePath = path
+	}
+}
+
+// SetChallTestSrvPath sets the path of the pebble-challtestsrv binary.
+func SetChallTestSrvPath(path string) Option {
+	return func(s *Server) {
+		s.challTestSrvPath = path
+	}
+}
+
+// SetHTTP01Port sets the port that pebble connects to when validating HTTP01
+// challenges.
+// Default: 5002
+func SetHTTP01Port(port int) Option {
+	return func(s *Server) {
+		s.http01Port = port
+	}
+}
+
+// SetNonceRejectPercent sets the percentage of valid nonces that pebble
+// rejects with a badNonce error, which clients are expected to retry.
+// Default: 0
+func SetNonceRejectPercent(percent int) Option {
+	return func(s *Server) {
+		s.nonceRejectPercent = percent
+	}
+}
+
+// SetRateLimitedOrders sets the number of new order requests that are
+// rejected with a rateLimited error before orders are accepted.
+// Default: 0
+func SetRateLimitedOrders(n int) Option {
+	return func(s *Server) {
+		s.rateLimitedOrders = n
+	}
+}
+
+// SetAlwaysValid makes pebble consider all challenges valid without
+// validating them, for tests which are not concerned with solvers.
+func SetAlwaysValid(b bool) Option {
+	return func(s *Server) {
+		s.alwaysValid = b
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pebble
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Server is a pebble ACME server, and the challtestsrv DNS server it
+// resolves names with, running for the duration of a test.
+type Server struct {
+	// DirectoryURL is the URL of the ACME directory of the server.
+	DirectoryURL string
+
+	// DNSServer is the address of the DNS server that pebble resolves names
+	// with.
+	DNSServer string
+
+	pebblePath         string
+	challTestSrvPath   string
+	http01Port         int
+	nonceRejectPercent int
+	rateLimitedOrders  int
+	alwaysValid        bool
+
+	// caPEM is the certificate that the ACME directory is served with.
+	caPEM []byte
+	// managementURL is the URL of the pebble management interface, and
+	// challTestSrvURL that of the challtestsrv management interface.
+	managementURL   string
+	challTestSrvURL string
+}
+
+// Run starts pebble and challtestsrv, and stops them when the test finishes.
+// The test is skipped if either binary cannot be found.
+func Run(t *testing.T, opts ...Option) *Server {
+	t.Helper()
+
+	s := &Server{}
+	for _, o := range opts {
+		o(s)
+	}
+	applyDefaults(s)
+	if s.pebblePath == "" || s.challTestSrvPath == "" {
+		t.Skip("pebble and pebble-challtestsrv binaries are required, set PEBBLE and PEBBLE_CHALLTESTSRV or add them to the PATH")
+	}
+
+	dir := t.TempDir()
+	cert, err := generateServingCertificate()
+	if err != nil {
+		t.Fatalf("failed to generate serving certificate: %v", err)
+	}
+	s.caPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+
+	dnsAddr := freeAddr(t)
+	challTestSrvAddr := freeAddr(t)
+	startProcess(t, s.challTestSrvPath,
+		[]string{
+			"-defaultIPv4", "127.0.0.1",
+			"-defaultIPv6", "",
+			"-dns01", dnsAddr,
+			"-management", challTestSrvAddr,
+			"-http01", "",
+			"-https01", "",
+			"-tlsalpn01", "",
+		},
+		nil,
+	)
+	s.DNSServer = dnsAddr
+	s.challTestSrvURL = "http://" + challTestSrvAddr
+
+	listenAddr := freeAddr(t)
+	managementAddr := freeAddr(t)
+	configPath, err := writeConfig(dir, cert, listenAddr, managementAddr, s.http01Port)
+	if err != nil {
+		t.Fatalf("failed to write pebble configuration: %v", err)
+	}
+	env := []string{
+		"PEBBLE_VA_NOSLEEP=1",
+		"PEBBLE_WFE_NONCEREJECT=" + strconv.Itoa(s.nonceRejectPercent),
+	}
+	if s.alwaysValid {
+		env = append(env, "PEBBLE_VA_ALWAYS_VALID=1")
+	}
+	startProcess(t, s.pebblePath, []string{"-config", configPath, "-dnsserver", dnsAddr}, env)
+	s.DirectoryURL = "https://" + listenAddr + "/dir"
+	s.managementURL = "https://" + managementAddr
+
+	if s.rateLimitedOrders > 0 {
+		proxy, err := newRateLimiter(listenAddr, s.rootCAs(), s.rateLimitedOrders)
+		if err != nil {
+			t.Fatalf("failed to create rate limiting proxy: %v", err)
+		}
+		srv := httptest.NewUnstartedServer(proxy)
+		srv.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+		srv.StartTLS()
+		t.Cleanup(srv.Close)
+		s.DirectoryURL = srv.URL + "/dir"
+	}
+
+	if err := s.waitForDirectory(10 * time.Second); err != nil {
+		t.Fatalf("pebble did not become ready: %v", err)
+	}
+
+	return s
+}
+
+// CABundle returns the PEM encoded certificate that the ACME directory is
+// served with.
+func (s *Server) CABundle() []byte {
+	return s.caPEM
+}
+
+// HTTPClient returns a client which trusts the certificate that the ACME
+// directory is served with.
+func (s *Server) HTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: s.rootCAs()},
+		},
+	}
+}
+
+// RootCertificate returns the PEM encoded root certificate that pebble issues
+// certificates from. It is generated each time pebble starts.
+func (s *Server) RootCertificate() ([]byte, error) {
+	resp, err := s.HTTPClient().Get(s.managementURL + "/roots/0")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (s *Server) rootCAs() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(s.caPEM)
+	return pool
+}
+
+func (s *Server) waitForDirectory(timeout time.Duration) error {
+	cl := s.HTTPClient()
+	var lastErr error
+	for deadline := time.Now().Add(timeout); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		resp, err := cl.Get(s.DirectoryURL)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			return nil
+		}
+		lastErr = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return lastErr
+}
+
+// startProcess starts the given binary, and kills it when the test finishes.
+func startProcess(t *testing.T, path string, args, env []string) {
+	t.Helper()
+
+	cmd := exec.Command(path, args...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("failed to start %s: %v", path, err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+}
+
+// freeAddr returns a local address which is not in use.
+func freeAddr(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func writeConfig(dir string, cert tls.Certificate, listenAddr, managementAddr string, http01Port int) (string, error) {
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600); err != nil {
+		return "", err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		return "", err
+	}
+
+	config, err := json.Marshal(map[string]interface{}{
+		"pebble": map[string]interface{}{
+			"listenAddress":                  listenAddr,
+			"managementListenAddress":        managementAddr,
+			"certificate":                    certPath,
+			"privateKey":                     keyPath,
+			"httpPort":                       http01Port,
+			"tlsPort":                        443,
+			"externalAccountBindingRequired": false,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	configPath := filepath.Join(dir, "config.json")
+	return configPath, os.WriteFile(configPath, config, 0600)
+}
+
+// generateServingCertificate generates a self-signed certificate for
+// 127.0.0.1 and localhost.
+func generateServingCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "pebble"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
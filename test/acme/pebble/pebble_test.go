@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pebble
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	s := Run(t, SetRateLimitedOrders(1))
+
+	resp, err := s.HTTPClient().Get(s.DirectoryURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected the ACME directory to be served, got status %d", resp.StatusCode)
+	}
+
+	if err := s.AddA("example.com", "127.0.0.2"); err != nil {
+		t.Errorf("failed to add A record: %v", err)
+	}
+	if _, err := s.RootCertificate(); err != nil {
+		t.Errorf("failed to get root certificate: %v", err)
+	}
+}
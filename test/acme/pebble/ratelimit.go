@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pebble
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+)
+
+// newOrderPath is the path of the pebble new order endpoint.
+const newOrderPath = "/order-plz"
+
+// rateLimiter proxies requests to pebble, rejecting the first new order
+// requests with a rateLimited problem as ACME servers do when a rate limit
+// is exceeded.
+// The Host header of requests is passed on to pebble, so the URLs in its
+// responses point at the proxy.
+type rateLimiter struct {
+	proxy *httputil.ReverseProxy
+
+	lock      sync.Mutex
+	remaining int
+}
+
+func newRateLimiter(pebbleAddr string, rootCAs *x509.CertPool, limitedOrders int) (*rateLimiter, error) {
+	target, err := url.Parse("https://" + pebbleAddr)
+	if err != nil {
+		return nil, err
+	}
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.Transport = &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: rootCAs},
+	}
+	return &rateLimiter{
+		proxy:     proxy,
+		remaining: limitedOrders,
+	}, nil
+}
+
+func (r *rateLimiter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodPost && req.URL.Path == newOrderPath && r.limit() {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprintf(w, `{"type":"urn:ietf:params:acme:error:rateLimited","detail":"too many new orders","status":%d}`, http.StatusTooManyRequests)
+		return
+	}
+	r.proxy.ServeHTTP(w, req)
+}
+
+// limit reports whether the current request should be rate limited.
+func (r *rateLimiter) limit() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.remaining == 0 {
+		return false
+	}
+	r.remaining--
+	return true
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pebble
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRateLimiter(t *testing.T) {
+	cert, err := generateServingCertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{caPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})}
+
+	// the backend records the Host header of the requests it receives
+	var hosts []string
+	backend := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	backend.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	backend.StartTLS()
+	defer backend.Close()
+
+	limiter, err := newRateLimiter(strings.TrimPrefix(backend.URL, "https://"), s.rootCAs(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy := httptest.NewUnstartedServer(limiter)
+	proxy.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	proxy.StartTLS()
+	defer proxy.Close()
+
+	cl := s.HTTPClient()
+	post := func(path string) *http.Response {
+		resp, err := cl.Post(proxy.URL+path, "application/jose+json", strings.NewReader("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	for i := 0; i < 2; i++ {
+		resp := post(newOrderPath)
+		var problem struct {
+			Type string `json:"type"`
+		}
+		err := json.NewDecoder(resp.Body).Decode(&problem)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusTooManyRequests || problem.Type != "urn:ietf:params:acme:error:rateLimited" {
+			t.Errorf("expected new order request %d to be rate limited, got status %d and problem %q", i, resp.StatusCode, problem.Type)
+		}
+	}
+
+	for _, path := range []string{"/new-acct", newOrderPath} {
+		resp := post(path)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Errorf("expected request to %s to be proxied, got status %d", path, resp.StatusCode)
+		}
+	}
+
+	proxyHost := strings.TrimPrefix(proxy.URL, "https://")
+	if len(hosts) != 2 || hosts[0] != proxyHost || hosts[1] != proxyHost {
+		t.Errorf("expected the backend to receive 2 requests for host %q, got %v", proxyHost, hosts)
+	}
+}
@@ -127,7 +127,10 @@ func TestAcmeOrdersController(t *testing.T) {
 		accountRegistry,
 		framework.NewEventRecorder(t),
 		clock.RealClock{},
+		metrics.New(logf.Log, clock.RealClock{}),
 		false,
+		0,
+		nil,
 	)
 	c := controllerpkg.NewController(
 		ctx,
@@ -137,6 +140,7 @@ func TestAcmeOrdersController(t *testing.T) {
 		mustSync,
 		nil,
 		queue,
+		time.Second,
 	)
 
 	// Ensure the controller is started now and stopped after the tests.
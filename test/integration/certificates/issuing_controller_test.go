@@ -59,7 +59,7 @@ func TestIssuingController(t *testing.T) {
 		EnableOwnerRef: true,
 	}
 
-	ctrl, queue, mustSync := issuing.NewController(logf.Log, kubeClient, cmCl, factory, cmFactory, framework.NewEventRecorder(t), clock.RealClock{}, controllerOptions)
+	ctrl, queue, mustSync := issuing.NewController(logf.Log, kubeClient, cmCl, factory, cmFactory, framework.NewEventRecorder(t), clock.RealClock{}, controllerOptions, "")
 	c := controllerpkg.NewController(
 		ctx,
 		"issuing_test",
@@ -68,6 +68,7 @@ func TestIssuingController(t *testing.T) {
 		mustSync,
 		nil,
 		queue,
+		time.Second,
 	)
 	stopController := framework.StartInformersAndController(t, factory, cmFactory, c)
 	defer stopController()
@@ -263,7 +264,7 @@ func TestIssuingController_PKCS8_PrivateKey(t *testing.T) {
 		EnableOwnerRef: true,
 	}
 
-	ctrl, queue, mustSync := issuing.NewController(logf.Log, kubeClient, cmCl, factory, cmFactory, framework.NewEventRecorder(t), clock.RealClock{}, controllerOptions)
+	ctrl, queue, mustSync := issuing.NewController(logf.Log, kubeClient, cmCl, factory, cmFactory, framework.NewEventRecorder(t), clock.RealClock{}, controllerOptions, "")
 	c := controllerpkg.NewController(
 		ctx,
 		"issuing_test",
@@ -272,6 +273,7 @@ func TestIssuingController_PKCS8_PrivateKey(t *testing.T) {
 		mustSync,
 		nil,
 		queue,
+		time.Second,
 	)
 	stopController := framework.StartInformersAndController(t, factory, cmFactory, c)
 	defer stopController()
@@ -68,7 +68,7 @@ func TestMetricsController(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	server := metricsHandler.NewServer(ln, false)
+	server := metricsHandler.NewServer(ln, false, false)
 
 	doneCh := make(chan struct{})
 	go func() {
@@ -96,6 +96,7 @@ func TestMetricsController(t *testing.T) {
 		mustSync,
 		nil,
 		queue,
+		time.Second,
 	)
 	stopController := framework.StartInformersAndController(t, factory, cmFactory, c)
 	defer stopController()
@@ -121,12 +122,24 @@ func TestMetricsController(t *testing.T) {
 			return err
 		}
 
-		output, err := io.ReadAll(resp.Body)
+		body, err := io.ReadAll(resp.Body)
 		if err != nil {
 			return err
 		}
 
-		if strings.TrimSpace(string(output)) != strings.TrimSpace(expectedOutput) {
+		// The durations of reconciles and the sampled queue metrics vary
+		// between runs, so are not compared.
+		var lines []string
+		for _, line := range strings.Split(string(body), "\n") {
+			if !strings.Contains(line, "certmanager_controller_reconcile_duration_seconds") &&
+				!strings.Contains(line, "certmanager_controller_queue_depth") &&
+				!strings.Contains(line, "certmanager_controller_longest_running_processor_seconds") {
+				lines = append(lines, line)
+			}
+		}
+		output := strings.Join(lines, "\n")
+
+		if strings.TrimSpace(output) != strings.TrimSpace(expectedOutput) {
 			return fmt.Errorf("got unexpected metrics output\nexp:\n%s\ngot:\n%s\n",
 				expectedOutput, output)
 		}
@@ -175,6 +188,9 @@ certmanager_certificate_ready_status{condition="False",name="testcrt",namespace=
 certmanager_certificate_ready_status{condition="True",name="testcrt",namespace="testns"} 0
 certmanager_certificate_ready_status{condition="Unknown",name="testcrt",namespace="testns"} 1
 ` + clockMetric + `
+# HELP certmanager_controller_reconcile_total The number of items processed by a controller, by result.
+# TYPE certmanager_controller_reconcile_total counter
+certmanager_controller_reconcile_total{controller="metrics_test",result="success"} 1
 # HELP certmanager_controller_sync_call_count The number of sync() calls made by a controller.
 # TYPE certmanager_controller_sync_call_count counter
 certmanager_controller_sync_call_count{controller="metrics_test"} 1
@@ -205,6 +221,9 @@ certmanager_certificate_ready_status{condition="False",name="testcrt",namespace=
 certmanager_certificate_ready_status{condition="True",name="testcrt",namespace="testns"} 1
 certmanager_certificate_ready_status{condition="Unknown",name="testcrt",namespace="testns"} 0
 ` + clockMetric + `
+# HELP certmanager_controller_reconcile_total The number of items processed by a controller, by result.
+# TYPE certmanager_controller_reconcile_total counter
+certmanager_controller_reconcile_total{controller="metrics_test",result="success"} 2
 # HELP certmanager_controller_sync_call_count The number of sync() calls made by a controller.
 # TYPE certmanager_controller_sync_call_count counter
 certmanager_controller_sync_call_count{controller="metrics_test"} 2
@@ -217,6 +236,9 @@ certmanager_controller_sync_call_count{controller="metrics_test"} 2
 
 	// Should expose no Certificates and only metrics sync count increase
 	waitForMetrics(clockMetric + `
+# HELP certmanager_controller_reconcile_total The number of items processed by a controller, by result.
+# TYPE certmanager_controller_reconcile_total counter
+certmanager_controller_reconcile_total{controller="metrics_test",result="success"} 3
 # HELP certmanager_controller_sync_call_count The number of sync() calls made by a controller.
 # TYPE certmanager_controller_sync_call_count counter
 certmanager_controller_sync_call_count{controller="metrics_test"} 3
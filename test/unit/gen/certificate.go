@@ -110,6 +110,18 @@ func SetCertificateSecretName(secretName string) CertificateModifier {
 	}
 }
 
+func SetCertificateAdditionalSecretNames(names ...string) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.AdditionalSecretNames = names
+	}
+}
+
+func SetCertificateSecretNamespace(namespace string) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.SecretNamespace = namespace
+	}
+}
+
 // SetCertificateSecretTemplate sets annotations and labels to be attached to the secret metadata.
 func SetCertificateSecretTemplate(annotations, labels map[string]string) CertificateModifier {
 	return func(crt *v1.Certificate) {
@@ -120,6 +132,21 @@ func SetCertificateSecretTemplate(annotations, labels map[string]string) Certifi
 	}
 }
 
+func SetCertificateSecretTemplateKeys(keys map[string]string) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		if crt.Spec.SecretTemplate == nil {
+			crt.Spec.SecretTemplate = &v1.CertificateSecretTemplate{}
+		}
+		crt.Spec.SecretTemplate.Keys = keys
+	}
+}
+
+func SetCertificateAdditionalOutputFormats(formats ...v1.CertificateAdditionalOutputFormat) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.AdditionalOutputFormats = formats
+	}
+}
+
 func SetCertificateDuration(duration time.Duration) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.Duration = &metav1.Duration{Duration: duration}
@@ -132,6 +159,42 @@ func SetCertificateRenewBefore(renewBefore time.Duration) CertificateModifier {
 	}
 }
 
+func SetCertificateIssuanceDeadline(deadline time.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.IssuanceDeadline = &metav1.Duration{Duration: deadline}
+	}
+}
+
+func SetCertificateRenewalWindow(window v1.CertificateRenewalWindow) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.RenewalWindow = &window
+	}
+}
+
+func SetCertificateDualCertificate(dual v1.CertificateDualCertificate) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.DualCertificate = &dual
+	}
+}
+
+func SetCertificateFallbackIssuers(refs ...cmmeta.ObjectReference) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.FallbackIssuerRefs = refs
+	}
+}
+
+func SetCertificateIssuerFailoverThreshold(threshold int) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.IssuerFailoverThreshold = &threshold
+	}
+}
+
+func SetCertificateFailedIssuanceAttempts(attempts int) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.FailedIssuanceAttempts = &attempts
+	}
+}
+
 func SetCertificateNextPrivateKeySecretName(name string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Status.NextPrivateKeySecretName = &name
@@ -196,12 +259,36 @@ func SetCertificateKeyUsages(usages ...v1.KeyUsage) CertificateModifier {
 	}
 }
 
+func SetCertificateProfile(profile v1.CertificateProfile) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.Profile = profile
+	}
+}
+
 func SetCertificateRevision(revision int) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Status.Revision = &revision
 	}
 }
 
+func SetCertificateActiveRequestName(name string) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.ActiveRequestName = name
+	}
+}
+
+func SetCertificateLastIssuanceDetails(details *v1.CertificateIssuanceDetails) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.LastIssuanceDetails = details
+	}
+}
+
+func SetCertificateIssuanceHistory(attempts ...v1.CertificateIssuanceAttempt) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.IssuanceHistory = attempts
+	}
+}
+
 func SetCertificateUID(uid types.UID) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.UID = uid
@@ -261,3 +348,15 @@ func SetCertificateRevisionHistoryLimit(limit int32) CertificateModifier {
 		crt.Spec.RevisionHistoryLimit = &limit
 	}
 }
+
+func SetCertificateIssuancePlan(plan *v1.CertificateIssuancePlan) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.IssuancePlan = plan
+	}
+}
+
+func SetCertificateACMESolvers(solvers ...v1.CertificateACMESolverStatus) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.ACMESolvers = solvers
+	}
+}
@@ -68,6 +68,12 @@ func SetCertificateRequestDuration(duration *metav1.Duration) CertificateRequest
 	}
 }
 
+func SetCertificateRequestBackdate(backdate *metav1.Duration) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.Spec.Backdate = backdate
+	}
+}
+
 func SetCertificateRequestCA(ca []byte) CertificateRequestModifier {
 	return func(cr *v1.CertificateRequest) {
 		cr.Status.CA = ca
@@ -166,12 +172,24 @@ func SetCertificateRequestFailureTime(p metav1.Time) CertificateRequestModifier
 	}
 }
 
+func SetCertificateRequestCreationTimestamp(t metav1.Time) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.CreationTimestamp = t
+	}
+}
+
 func SetCertificateRequestTypeMeta(tm metav1.TypeMeta) CertificateRequestModifier {
 	return func(cr *v1.CertificateRequest) {
 		cr.TypeMeta = tm
 	}
 }
 
+func SetCertificateRequestExtensions(extensions ...v1.X509Extension) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.Spec.Extensions = extensions
+	}
+}
+
 func SetCertificateRequestUsername(username string) CertificateRequestModifier {
 	return func(cr *v1.CertificateRequest) {
 		cr.Spec.Username = username
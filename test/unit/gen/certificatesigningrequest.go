@@ -101,6 +101,12 @@ func SetCertificateSigningRequestDuration(duration string) CertificateSigningReq
 	})
 }
 
+func SetCertificateSigningRequestExpirationSeconds(seconds int32) CertificateSigningRequestModifier {
+	return func(csr *certificatesv1.CertificateSigningRequest) {
+		csr.Spec.ExpirationSeconds = &seconds
+	}
+}
+
 func SetCertificateSigningRequestCertificate(cert []byte) CertificateSigningRequestModifier {
 	return func(csr *certificatesv1.CertificateSigningRequest) {
 		csr.Status.Certificate = cert
@@ -96,6 +96,12 @@ func SetChallengeReason(s string) ChallengeModifier {
 	}
 }
 
+func SetChallengeProblem(p *cmacme.ACMEProblem) ChallengeModifier {
+	return func(ch *cmacme.Challenge) {
+		ch.Status.Problem = p
+	}
+}
+
 func SetChallengeURL(s string) ChallengeModifier {
 	return func(ch *cmacme.Challenge) {
 		ch.Spec.URL = s
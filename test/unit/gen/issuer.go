@@ -157,6 +157,26 @@ func SetIssuerACMESkipTLSVerify(shouldSkip bool) IssuerModifier {
 	}
 }
 
+func SetIssuerACMECABundle(caBundle []byte) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.CABundle = caBundle
+	}
+}
+
+func SetIssuerACMEClientCertificateSecretRef(secretName string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.ClientCertificateSecretRef = &cmmeta.LocalObjectReference{Name: secretName}
+	}
+}
+
 func SetIssuerACMEDisableAccountKeyGeneration(disabled bool) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		spec := iss.GetSpec()
@@ -226,6 +246,16 @@ func SetIssuerACMELastRegisteredEmail(email string) IssuerModifier {
 	}
 }
 
+func SetIssuerACMEDirectory(dir *cmacme.ACMEDirectoryMetadata) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		status := iss.GetStatus()
+		if status.ACME == nil {
+			status.ACME = &cmacme.ACMEIssuerStatus{}
+		}
+		status.ACME.Directory = dir
+	}
+}
+
 func SetIssuerCA(a v1.CAIssuer) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		iss.GetSpec().CA = &a
@@ -340,6 +370,30 @@ func SetIssuerVenafi(a v1.VenafiIssuer) IssuerModifier {
 	}
 }
 
+func SetIssuerSCEP(a v1.SCEPIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().SCEP = &a
+	}
+}
+
+func SetIssuerCMP(a v1.CMPIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().CMP = &a
+	}
+}
+
+func SetIssuerPolicy(p v1.IssuerPolicy) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().Policy = &p
+	}
+}
+
+func SetIssuerCertificateDefaults(d v1.IssuerCertificateDefaults) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().CertificateDefaults = &d
+	}
+}
+
 func AddIssuerCondition(c v1.IssuerCondition) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		iss.GetStatus().Conditions = append(iss.GetStatus().Conditions, c)